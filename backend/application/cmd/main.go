@@ -1,21 +1,28 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net"
 
 	grpcprom "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/rs/zerolog/log"
 	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/config"
+	minioDB "github.com/unwelcome/FrameWorkTask1/backend/application/internal/database/minio"
 	postgresDB "github.com/unwelcome/FrameWorkTask1/backend/application/internal/database/postgres"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/membership"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/messaging"
 	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/services"
 	application_proto "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
 	company_proto "github.com/unwelcome/FrameWorkTask1/backend/contracts/company/generated"
 	"github.com/unwelcome/FrameWorkTask1/backend/shared/interceptors"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/lifecycle"
 	"github.com/unwelcome/FrameWorkTask1/backend/shared/logger"
-	"github.com/unwelcome/FrameWorkTask1/backend/shared/metrics"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/mtls"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/tracing"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 func main() {
@@ -24,13 +31,51 @@ func main() {
 	loggerConf, httpLogger := logger.Setup(cfg.Log.Path, cfg.Log.ConsoleOut)
 	log.Logger = *loggerConf
 
+	// Остановка по SIGINT / SIGTERM: серверы, затем воркеры, затем соединения (см. lifecycle)
+	lc := lifecycle.New(cfg.ShutdownTimeout)
+
+	// Трассировка: спаны экспортируются в OTLP коллектор, если задан OTEL_EXPORTER_OTLP_ENDPOINT
+	shutdownTracing, err := tracing.Setup(context.Background(), "application_service", cfg.Tracing)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to setup tracing")
+	}
+	lc.OnClose("tracing", shutdownTracing)
+
 	db := postgresDB.NewDatabaseInstance(cfg.Postgres.ConnectionString())
+	lc.OnClose("postgres", lifecycle.Closer(db.Close))
+	lc.ReadinessCheck("postgres", db.Ping)
+
+	storage := minioDB.NewStorageInstance(cfg.Minio)
+	lc.ReadinessCheck("minio", storage.Ping)
+
+	rabbitMQ := messaging.NewPublisher(cfg.RabbitMQ.ConnectionString())
+	lc.OnClose("rabbitMQ", lifecycle.Closer(rabbitMQ.Close))
+
+	// Фоновая горутина публикации доменных событий из outbox-а
+	lc.Go("outbox", func(ctx context.Context) {
+		services.StartOutboxWorker(ctx, db, rabbitMQ)
+	})
+
+	// Фоновая горутина отметки заявок с нарушенным SLA
+	lc.Go("sla", func(ctx context.Context) {
+		services.StartSLAWorker(ctx, db)
+	})
+
+	// Запросы в company сервис идут по mTLS от имени пользователя, вызвавшего метод
+	clientCreds, err := mtls.ClientCredentials(cfg.TLS, cfg.CompanyService.Host)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to load tls credentials")
+	}
 
-	companyConn, err := grpc.NewClient(cfg.CompanyService.Addr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	companyConn, err := grpc.NewClient(cfg.CompanyService.Addr(),
+		grpc.WithTransportCredentials(clientCreds),
+		tracing.DialOption(),
+		grpc.WithUnaryInterceptor(interceptors.NewIdentityForwardingInterceptor()),
+	)
 	if err != nil {
 		log.Fatal().Err(err).Str("addr", cfg.CompanyService.Addr()).Msg("failed to connect to company service")
 	}
-	defer companyConn.Close()
+	lc.OnClose("company service connection", lifecycle.Closer(companyConn.Close))
 
 	companyClient := company_proto.NewCompanyServiceClient(companyConn)
 
@@ -41,21 +86,57 @@ func main() {
 
 	grpcprom.EnableHandlingTimeHistogram()
 
+	// Application сервис вызывают gateway и company сервис (архивация заявок при удалении компании)
+	serverCreds, err := mtls.ServerCredentials(cfg.TLS)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to load tls credentials")
+	}
+
 	grpcServer := grpc.NewServer(
+		grpc.Creds(serverCreds),
+		tracing.ServerOption(),
+		// Вложения передаются целиком в одном сообщении - лимит с запасом на служебные поля
+		grpc.MaxRecvMsgSize(validate.AttachmentMaxSize+1<<20),
+		grpc.MaxSendMsgSize(validate.AttachmentMaxSize+1<<20),
 		grpc.ChainUnaryInterceptor(
 			grpcprom.UnaryServerInterceptor,
 			interceptors.NewLoggingInterceptor(*httpLogger),
+			interceptors.NewIdentityInterceptor("gateway", "company"),
+		),
+		grpc.ChainStreamInterceptor(
+			grpcprom.StreamServerInterceptor,
+			interceptors.NewIdentityStreamInterceptor("gateway"),
 		),
-		grpc.StreamInterceptor(grpcprom.StreamServerInterceptor),
 	)
-	application_proto.RegisterApplicationServiceServer(grpcServer, services.NewApplicationService(db, companyClient))
+	// Роли и отделы сотрудников кешируются, кеш сбрасывается по событиям company сервиса
+	members := membership.NewCache(cfg.MembershipCache.Size, cfg.MembershipCache.TTL)
+	lc.Go("membership subscriber", messaging.NewMembershipSubscriber(cfg.RabbitMQ.ConnectionString(), members.HandleEvent, members.Purge).Run)
+
+	applicationService := services.NewApplicationService(db, storage, companyClient, members)
+	application_proto.RegisterApplicationServiceServer(grpcServer, applicationService)
+
+	// Подписка на доменные события заявок для рассылки в потоки WatchApplications
+	lc.Go("event subscriber", messaging.NewSubscriber(cfg.RabbitMQ.ConnectionString(), applicationService.HandleApplicationEvent).Run)
+
+	// Обработка открытых заявок сотрудников после ухода из компании, смены роли или удаления из отдела
+	lc.Go("membership worker", messaging.NewMembershipWorker(cfg.RabbitMQ.ConnectionString(), applicationService.HandleEmployeeOffboarding,
+		entities.EventEmployeeLeft, entities.EventEmployeeRemoved, entities.EventEmployeeRoleChanged, entities.EventEmployeeDepartmentChanged).Run)
 
 	grpcprom.Register(grpcServer)
 
-	metrics.StartServer(cfg.MetricsPort)
+	// /metrics, /livez и /readyz
+	lc.StartProbeServer(cfg.MetricsPort)
+
+	// Потоки WatchApplications не завершаются сами - закрываем их перед GracefulStop
+	stopGRPC := lifecycle.GRPCShutdown(grpcServer)
+	lc.Serve("grpc", func() error { return grpcServer.Serve(listener) }, func(ctx context.Context) error {
+		applicationService.StopWatching()
+		return stopGRPC(ctx)
+	})
+	lc.Ready()
 
 	log.Info().Int("port", cfg.Port).Msg("application service started")
-	if err := grpcServer.Serve(listener); err != nil {
-		log.Fatal().Err(err).Msg("failed to serve grpc")
+	if err := lc.Wait(); err != nil {
+		log.Fatal().Err(err).Msg("application service stopped with error")
 	}
 }
@@ -2,16 +2,30 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	sharedConfig "github.com/unwelcome/FrameWorkTask1/backend/shared/config"
 )
 
 type Config struct {
-	Port           int
-	MetricsPort    int
-	Log            LogConfig
-	Postgres       sharedConfig.PostgresConfig
-	CompanyService ServiceAddress
+	Port            int
+	MetricsPort     int
+	ShutdownTimeout time.Duration // время на graceful shutdown, должно быть меньше stop_grace_period в docker-compose
+	Log             LogConfig
+	Postgres        sharedConfig.PostgresConfig
+	Minio           sharedConfig.MinioConfig
+	RabbitMQ        sharedConfig.RabbitMQConfig
+	TLS             sharedConfig.TLSConfig
+	Tracing         sharedConfig.TracingConfig
+	CompanyService  ServiceAddress
+	MembershipCache MembershipCacheConfig
+}
+
+// MembershipCacheConfig Кеш ролей и отделов сотрудников из company сервиса.
+// TTL ограничивает устаревание прав, если событие сброса кеша потеряно; 0 выключает кеш.
+type MembershipCacheConfig struct {
+	Size int
+	TTL  time.Duration
 }
 
 type LogConfig struct {
@@ -30,16 +44,25 @@ func (s ServiceAddress) Addr() string {
 
 func NewConfig() *Config {
 	return &Config{
-		Port:        sharedConfig.MustParseInt("APPLICATION_SERVICE_PORT"),
-		MetricsPort: sharedConfig.ParseIntOrDefault("METRICS_PORT", 2112),
+		Port:            sharedConfig.MustParseInt("APPLICATION_SERVICE_PORT"),
+		MetricsPort:     sharedConfig.ParseIntOrDefault("METRICS_PORT", 2112),
+		ShutdownTimeout: sharedConfig.ParseDurationOrDefault("SHUTDOWN_TIMEOUT", 25*time.Second),
 		Log: LogConfig{
 			Path:       sharedConfig.MustGetEnv("LOG_PATH"),
 			ConsoleOut: sharedConfig.MustParseBool("LOG_CONSOLE_OUT"),
 		},
 		Postgres: sharedConfig.NewPostgresConfig(),
+		Minio:    sharedConfig.NewMinioConfig(),
+		RabbitMQ: sharedConfig.NewRabbitMQConfig(),
+		TLS:      sharedConfig.NewTLSConfig(),
+		Tracing:  sharedConfig.NewTracingConfig(),
 		CompanyService: ServiceAddress{
 			Host: sharedConfig.MustGetEnv("COMPANY_SERVICE_HOST"),
 			Port: sharedConfig.MustParseInt("COMPANY_SERVICE_PORT"),
 		},
+		MembershipCache: MembershipCacheConfig{
+			Size: sharedConfig.ParseIntOrDefault("MEMBERSHIP_CACHE_SIZE", 10000),
+			TTL:  sharedConfig.ParseDurationOrDefault("MEMBERSHIP_CACHE_TTL", time.Minute),
+		},
 	}
 }
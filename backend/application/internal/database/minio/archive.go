@@ -0,0 +1,62 @@
+package minioDB
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+// archivedMetaKey Пользовательские метаданные объекта архива с кол-вом заявок в нём
+const archivedMetaKey = "archived"
+
+// ArchiveStorage хранит архивы заявок удалённых компаний в S3-совместимом хранилище
+type ArchiveStorage interface {
+	PutArchive(ctx context.Context, dto entities.PutArchiveObjectDTO) Error.CodeError
+	GetArchive(ctx context.Context, dto entities.GetArchiveObjectDTO) (*entities.ArchiveObject, Error.CodeError)
+}
+
+type archiveStorage struct {
+	client *minio.Client
+	bucket string
+}
+
+func NewArchiveStorage(client *minio.Client, bucket string) ArchiveStorage {
+	return &archiveStorage{client: client, bucket: bucket}
+}
+
+// PutArchive Загрузка архива потоком - размер заранее неизвестен
+func (s *archiveStorage) PutArchive(ctx context.Context, dto entities.PutArchiveObjectDTO) Error.CodeError {
+	_, err := s.client.PutObject(ctx, s.bucket, dto.ObjectKey, dto.Content, -1, minio.PutObjectOptions{
+		ContentType:  "application/x-ndjson",
+		UserMetadata: map[string]string{archivedMetaKey: strconv.FormatInt(dto.Archived, 10)},
+	})
+	if err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// GetArchive Информация о записанном архиве
+func (s *archiveStorage) GetArchive(ctx context.Context, dto entities.GetArchiveObjectDTO) (*entities.ArchiveObject, Error.CodeError) {
+	info, err := s.client.StatObject(ctx, s.bucket, dto.ObjectKey, minio.StatObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == minio.NoSuchKey {
+			return nil, Error.Public(codes.NotFound, "archive not found")
+		}
+		return nil, Error.Internal(err)
+	}
+
+	archive := &entities.ArchiveObject{ObjectKey: dto.ObjectKey, Size: info.Size}
+	// Ключи метаданных возвращаются в каноничной форме заголовка
+	for key, value := range info.UserMetadata {
+		if strings.EqualFold(key, archivedMetaKey) {
+			archive.Archived, _ = strconv.ParseInt(value, 10, 64)
+		}
+	}
+	return archive, Error.CodeError{}
+}
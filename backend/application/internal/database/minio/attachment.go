@@ -0,0 +1,66 @@
+package minioDB
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+// AttachmentStorage хранит содержимое вложений в S3-совместимом хранилище.
+// Метаданные вложений хранятся в postgres (application_attachments).
+type AttachmentStorage interface {
+	PutAttachment(ctx context.Context, dto entities.PutAttachmentObjectDTO) Error.CodeError
+	GetAttachment(ctx context.Context, dto entities.GetAttachmentObjectDTO) ([]byte, Error.CodeError)
+	DeleteAttachment(ctx context.Context, dto entities.DeleteAttachmentObjectDTO) Error.CodeError
+}
+
+type attachmentStorage struct {
+	client *minio.Client
+	bucket string
+}
+
+func NewAttachmentStorage(client *minio.Client, bucket string) AttachmentStorage {
+	return &attachmentStorage{client: client, bucket: bucket}
+}
+
+// PutAttachment Загрузка содержимого вложения
+func (s *attachmentStorage) PutAttachment(ctx context.Context, dto entities.PutAttachmentObjectDTO) Error.CodeError {
+	_, err := s.client.PutObject(ctx, s.bucket, dto.ObjectKey, bytes.NewReader(dto.Content), int64(len(dto.Content)), minio.PutObjectOptions{
+		ContentType: dto.ContentType,
+	})
+	if err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// GetAttachment Получение содержимого вложения
+func (s *attachmentStorage) GetAttachment(ctx context.Context, dto entities.GetAttachmentObjectDTO) ([]byte, Error.CodeError) {
+	object, err := s.client.GetObject(ctx, s.bucket, dto.ObjectKey, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	defer object.Close()
+
+	content, err := io.ReadAll(object)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == minio.NoSuchKey {
+			return nil, Error.Public(codes.NotFound, "attachment not found")
+		}
+		return nil, Error.Internal(err)
+	}
+	return content, Error.CodeError{}
+}
+
+// DeleteAttachment Удаление содержимого вложения
+func (s *attachmentStorage) DeleteAttachment(ctx context.Context, dto entities.DeleteAttachmentObjectDTO) Error.CodeError {
+	if err := s.client.RemoveObject(ctx, s.bucket, dto.ObjectKey, minio.RemoveObjectOptions{}); err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
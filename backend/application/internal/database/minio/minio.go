@@ -0,0 +1,32 @@
+package minioDB
+
+import (
+	"context"
+
+	"github.com/minio/minio-go/v7"
+	sharedConfig "github.com/unwelcome/FrameWorkTask1/backend/shared/config"
+	sharedMinio "github.com/unwelcome/FrameWorkTask1/backend/shared/minio"
+)
+
+type StorageRepository struct {
+	Attachment AttachmentStorage
+	Archive    ArchiveStorage
+	client     *minio.Client
+	bucket     string
+}
+
+func (r *StorageRepository) Ping(ctx context.Context) error {
+	_, err := r.client.BucketExists(ctx, r.bucket)
+	return err
+}
+
+func NewStorageInstance(cfg sharedConfig.MinioConfig) *StorageRepository {
+	client := sharedMinio.Connect(cfg)
+
+	return &StorageRepository{
+		Attachment: NewAttachmentStorage(client, cfg.Bucket),
+		Archive:    NewArchiveStorage(client, cfg.Bucket),
+		client:     client,
+		bucket:     cfg.Bucket,
+	}
+}
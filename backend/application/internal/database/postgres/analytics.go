@@ -0,0 +1,197 @@
+package postgresDB
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+)
+
+// AnalyticsRepository Агрегаты по заявкам компании для дашбордов chief/analytic
+type AnalyticsRepository interface {
+	GetStatusStats(ctx context.Context, dto entities.GetStatusStatsDTO) ([]*entities.StatusStat, Error.CodeError)
+	GetLeadTimes(ctx context.Context, dto entities.AnalyticsFilterDTO) (*entities.LeadTimes, Error.CodeError)
+	GetEngineerRevisionStats(ctx context.Context, dto entities.AnalyticsFilterDTO) ([]*entities.EngineerRevisionStat, Error.CodeError)
+	GetDepartmentFailureStats(ctx context.Context, dto entities.AnalyticsFilterDTO) ([]*entities.DepartmentFailureStat, Error.CodeError)
+}
+
+type analyticsRepository struct {
+	db *sql.DB
+}
+
+func NewAnalyticsRepository(db *sql.DB) AnalyticsRepository {
+	return &analyticsRepository{db: db}
+}
+
+// analyticsFilterWhere Условия выборки заявок для аналитики (параметры $1..$4 из analyticsFilterArgs)
+const analyticsFilterWhere = `company_uuid = $1
+			AND deleted_at IS NULL
+			AND ($2 = '' OR department_uuid::text = $2)
+			AND (NULLIF($3, '') IS NULL OR created_at >= NULLIF($3, '')::timestamptz)
+			AND (NULLIF($4, '') IS NULL OR created_at < NULLIF($4, '')::timestamptz)`
+
+func analyticsFilterArgs(dto entities.AnalyticsFilterDTO) []any {
+	return []any{dto.CompanyUUID, dto.DepartmentUUID, dto.CreatedFrom, dto.CreatedTo}
+}
+
+// GetStatusStats Кол-во заявок по периодам создания, отделам и текущим статусам
+func (r *analyticsRepository) GetStatusStats(ctx context.Context, dto entities.GetStatusStatsDTO) ([]*entities.StatusStat, Error.CodeError) {
+	query := `SELECT
+			date_trunc($5, created_at, 'UTC'),
+			COALESCE(department_uuid::text, ''),
+			status,
+			COUNT(*)
+		FROM applications
+		WHERE ` + analyticsFilterWhere + `
+		GROUP BY 1, 2, 3
+		ORDER BY 1, 2, 3;`
+
+	rows, err := r.db.QueryContext(ctx, query, append(analyticsFilterArgs(dto.Filter), dto.Interval)...)
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	defer rows.Close()
+
+	stats := make([]*entities.StatusStat, 0)
+	for rows.Next() {
+		stat := &entities.StatusStat{}
+		var period time.Time
+		if err = rows.Scan(&period, &stat.DepartmentUUID, &stat.Status, &stat.Count); err != nil {
+			return nil, Error.Internal(err)
+		}
+		stat.Period = period.UTC().Format(time.RFC3339)
+		stats = append(stats, stat)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, Error.Internal(err)
+	}
+
+	return stats, Error.CodeError{}
+}
+
+// GetLeadTimes Среднее время назначения, исправления и проверки заявок.
+// Снапшот версии v сохраняется в момент перехода к версии v+1, поэтому статус, в который перешла заявка в saved_at,
+// берётся из следующего снапшота (для последнего перехода - из самой заявки).
+func (r *analyticsRepository) GetLeadTimes(ctx context.Context, dto entities.AnalyticsFilterDTO) (*entities.LeadTimes, Error.CodeError) {
+	query := `WITH scope AS (
+			SELECT uuid, version, status::text AS status, created_at
+			FROM applications
+			WHERE ` + analyticsFilterWhere + `
+		), states AS (
+			SELECT v.application_uuid, v.version, v.body->>'status' AS status, v.saved_at
+			FROM application_versions v
+			JOIN scope s ON s.uuid = v.application_uuid
+			UNION ALL
+			SELECT uuid, version, status, NULL FROM scope
+		), transitions AS (
+			SELECT
+				application_uuid,
+				saved_at AS moved_at,
+				LEAD(status) OVER (PARTITION BY application_uuid ORDER BY version) AS moved_to
+			FROM states
+		), milestones AS (
+			SELECT
+				s.created_at,
+				MIN(t.moved_at) FILTER (WHERE t.moved_to = 'assigned') AS assigned_at,
+				MIN(t.moved_at) FILTER (WHERE t.moved_to = 'pending_verification') AS first_submitted_at,
+				MAX(t.moved_at) FILTER (WHERE t.moved_to = 'pending_verification') AS last_submitted_at,
+				MIN(t.moved_at) FILTER (WHERE t.moved_to IN ('completed', 'failed')) AS closed_at
+			FROM scope s
+			LEFT JOIN transitions t ON t.application_uuid = s.uuid AND t.moved_at IS NOT NULL
+			GROUP BY s.uuid, s.created_at
+		), durations AS (
+			SELECT
+				EXTRACT(EPOCH FROM assigned_at - created_at)::float8 AS assign,
+				EXTRACT(EPOCH FROM first_submitted_at - assigned_at)::float8 AS fix,
+				EXTRACT(EPOCH FROM closed_at - last_submitted_at)::float8 AS verify
+			FROM milestones
+		)
+		SELECT
+			COALESCE(AVG(assign), 0), COUNT(assign),
+			COALESCE(AVG(fix), 0), COUNT(fix),
+			COALESCE(AVG(verify), 0), COUNT(verify)
+		FROM durations;`
+
+	times := &entities.LeadTimes{}
+	err := r.db.QueryRowContext(ctx, query, analyticsFilterArgs(dto)...).Scan(
+		&times.Assign.MeanSeconds, &times.Assign.Samples,
+		&times.Fix.MeanSeconds, &times.Fix.Samples,
+		&times.Verify.MeanSeconds, &times.Verify.Samples,
+	)
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+
+	return times, Error.CodeError{}
+}
+
+// GetEngineerRevisionStats Кол-во доработок (revision_count) по инженерам, начиная с самых частых
+func (r *analyticsRepository) GetEngineerRevisionStats(ctx context.Context, dto entities.AnalyticsFilterDTO) ([]*entities.EngineerRevisionStat, Error.CodeError) {
+	query := `SELECT
+			executed_by::text,
+			COUNT(*),
+			COUNT(*) FILTER (WHERE revision_count > 0),
+			COALESCE(SUM(revision_count), 0)
+		FROM applications
+		WHERE ` + analyticsFilterWhere + ` AND executed_by IS NOT NULL
+		GROUP BY executed_by
+		ORDER BY 4 DESC, 1;`
+
+	rows, err := r.db.QueryContext(ctx, query, analyticsFilterArgs(dto)...)
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	defer rows.Close()
+
+	stats := make([]*entities.EngineerRevisionStat, 0)
+	for rows.Next() {
+		stat := &entities.EngineerRevisionStat{}
+		if err = rows.Scan(&stat.EngineerUUID, &stat.Applications, &stat.RevisedApplications, &stat.Revisions); err != nil {
+			return nil, Error.Internal(err)
+		}
+		stats = append(stats, stat)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, Error.Internal(err)
+	}
+
+	return stats, Error.CodeError{}
+}
+
+// GetDepartmentFailureStats Кол-во закрытых, выполненных и проваленных заявок по отделам
+func (r *analyticsRepository) GetDepartmentFailureStats(ctx context.Context, dto entities.AnalyticsFilterDTO) ([]*entities.DepartmentFailureStat, Error.CodeError) {
+	query := `SELECT
+			COALESCE(department_uuid::text, ''),
+			COUNT(*) FILTER (WHERE status IN ('completed', 'failed')),
+			COUNT(*) FILTER (WHERE status = 'completed'),
+			COUNT(*) FILTER (WHERE status = 'failed')
+		FROM applications
+		WHERE ` + analyticsFilterWhere + `
+		GROUP BY 1
+		ORDER BY 1;`
+
+	rows, err := r.db.QueryContext(ctx, query, analyticsFilterArgs(dto)...)
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	defer rows.Close()
+
+	stats := make([]*entities.DepartmentFailureStat, 0)
+	for rows.Next() {
+		stat := &entities.DepartmentFailureStat{}
+		if err = rows.Scan(&stat.DepartmentUUID, &stat.Closed, &stat.Completed, &stat.Failed); err != nil {
+			return nil, Error.Internal(err)
+		}
+		stats = append(stats, stat)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, Error.Internal(err)
+	}
+
+	return stats, Error.CodeError{}
+}
@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
@@ -20,6 +22,7 @@ type ApplicationRepository interface {
 	AddApplicationFixLog(ctx context.Context, dto entities.AddFixLogDTO) Error.CodeError
 	GetApplication(ctx context.Context, dto entities.GetApplicationDTO) (*entities.Application, Error.CodeError)
 	GetApplicationFixLogs(ctx context.Context, dto entities.GetApplicationFixLogsDTO) ([]*entities.FixLog, Error.CodeError)
+	GetFixLogsByApplications(ctx context.Context, dto entities.GetFixLogsByApplicationsDTO) ([]*entities.FixLog, Error.CodeError)
 	GetApplications(ctx context.Context, dto entities.GetApplicationsDTO) ([]*entities.Application, Error.CodeError)
 	UpdateApplicationStatus(ctx context.Context, dto entities.UpdateApplicationStatusDTO) Error.CodeError
 	AssignApplicationToEmployee(ctx context.Context, dto entities.AssignApplicationDTO) Error.CodeError
@@ -27,8 +30,18 @@ type ApplicationRepository interface {
 	RecallApplication(ctx context.Context, dto entities.RecallApplicationDTO) Error.CodeError
 	TakeApplicationToVerification(ctx context.Context, dto entities.TakeApplicationToVerificationDTO) Error.CodeError
 	ReleaseApplicationVerification(ctx context.Context, dto entities.ReleaseApplicationVerificationDTO) Error.CodeError
+	GetEmployeeOpenApplications(ctx context.Context, dto entities.GetEmployeeOpenApplicationsDTO) ([]*entities.Application, Error.CodeError)
+	OffboardEmployeeApplications(ctx context.Context, dto entities.OffboardEmployeeApplicationsDTO) (int64, Error.CodeError)
+	PurgeCompanyApplications(ctx context.Context, dto entities.PurgeCompanyApplicationsDTO) (int64, Error.CodeError)
 	DeleteApplication(ctx context.Context, dto entities.DeleteApplicationDTO) Error.CodeError
 	GetApplicationHistory(ctx context.Context, dto entities.GetApplicationHistoryDTO) ([]*entities.Application, Error.CodeError)
+	GetApplicationVersion(ctx context.Context, dto entities.GetApplicationVersionDTO) (*entities.Application, Error.CodeError)
+	GetStatusTimeline(ctx context.Context, dto entities.GetStatusTimelineDTO) ([]*entities.StatusChange, Error.CodeError)
+	CountApplications(ctx context.Context, dto entities.CountApplicationsDTO) (int64, Error.CodeError)
+	CountFilteredApplications(ctx context.Context, dto entities.GetApplicationsDTO) (int64, Error.CodeError)
+	CountApplicationHistory(ctx context.Context, dto entities.GetApplicationHistoryDTO) (int64, Error.CodeError)
+	GetSLAChanges(ctx context.Context, dto entities.GetSLAChangesDTO) ([]string, Error.CodeError)
+	UpdateApplicationSLABreach(ctx context.Context, dto entities.UpdateApplicationSLABreachDTO) Error.CodeError
 }
 
 type applicationRepository struct {
@@ -51,14 +64,49 @@ func (r *applicationRepository) CreateApplication(ctx context.Context, dto entit
 	 description, 
 	 status, 
 	 revision_count,
-	 created_by
+	 priority,
+	 due_at,
+	 created_by,
+	 location_uuid,
+	 location_path,
+	 category_uuid,
+	 severity,
+	 custom_fields
 	 ) VALUES
-	($1, $2, $3, 1, $4, $5, 'created', 0, $6);`
+	($1, $2, $3, 1, $4, $5, 'created', 0, $6::application_priority, NULLIF($7, '')::timestamptz, $8, NULLIF($9, '')::uuid, $10::uuid[],
+	 NULLIF($11, '')::uuid, NULLIF($12, '')::application_severity, $13::jsonb);`
 
-	_, err := r.db.ExecContext(ctx, query, dto.ApplicationUUID, dto.CompanyUUID, dto.DepartmentUUID, dto.Title, dto.Description, dto.CreatedBy)
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	_, err = tx.ExecContext(ctx, query, dto.ApplicationUUID, dto.CompanyUUID, dto.DepartmentUUID, dto.Title, dto.Description, dto.Priority, dto.DueAt, dto.CreatedBy, dto.LocationUUID, pq.Array(dto.LocationPath),
+		dto.CategoryUUID, dto.Severity, customFieldsJSON(dto.CustomFields))
 	if err != nil {
 		return Error.Internal(err)
 	}
+
+	// Снапшота до создания нет - версия 0, событие получит версию 1
+	if err = saveEvent(ctx, tx, &entities.Application{
+		ApplicationUUID: dto.ApplicationUUID,
+		CompanyUUID:     dto.CompanyUUID,
+		DepartmentUUID:  dto.DepartmentUUID,
+	}, entities.ApplicationEvent{
+		EventType:     entities.EventApplicationCreated,
+		InitiatorUUID: dto.CreatedBy,
+		Status:        "created",
+		Priority:      dto.Priority,
+		DueAt:         dto.DueAt,
+	}); err != nil {
+		return Error.Internal(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return Error.Internal(err)
+	}
+
 	return Error.CodeError{}
 }
 
@@ -68,7 +116,7 @@ func (r *applicationRepository) AddApplicationFixLog(ctx context.Context, dto en
 	(uuid, application_uuid, text, created_by) VALUES
 	($1, $2, $3, $4);`
 
-	_, err := r.db.ExecContext(ctx, query, uuid.Must(uuid.NewV7()).String(), dto.ApplicationUUID, dto.Text, dto.CreatedBy)
+	_, err := r.db.ExecContext(ctx, query, dto.FixLogUUID, dto.ApplicationUUID, dto.Text, dto.CreatedBy)
 	if err != nil {
 		var pgErr *pq.Error
 		if errors.As(err, &pgErr) {
@@ -101,7 +149,16 @@ func (r *applicationRepository) GetApplication(ctx context.Context, dto entities
 			COALESCE(inspected_by::text, ''),
 			COALESCE(closed_at::text, ''),
 			COALESCE(deleted_at::text, ''),
-			COALESCE(deleted_by::text, '')
+			COALESCE(deleted_by::text, ''),
+			priority,
+			COALESCE(due_at::text, ''),
+			COALESCE(sla_breach, ''),
+			COALESCE(overdue_at::text, ''),
+			COALESCE(location_uuid::text, ''),
+			location_path::text[],
+			COALESCE(category_uuid::text, ''),
+			COALESCE(severity::text, ''),
+			custom_fields
 		FROM applications
 		WHERE uuid = $1;`
 
@@ -124,6 +181,15 @@ func (r *applicationRepository) GetApplication(ctx context.Context, dto entities
 		&app.ClosedAt,
 		&app.DeletedAt,
 		&app.DeletedBy,
+		&app.Priority,
+		&app.DueAt,
+		&app.SLABreach,
+		&app.OverdueAt,
+		&app.LocationUUID,
+		pq.Array(&app.LocationPath),
+		&app.CategoryUUID,
+		&app.Severity,
+		jsonColumn{&app.CustomFields},
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -167,7 +233,42 @@ func (r *applicationRepository) GetApplicationFixLogs(ctx context.Context, dto e
 	return fixLogs, Error.CodeError{}
 }
 
-// GetApplications Получение списка заявок по uuid компании с сортировкой по статусу и департаменту с offset и count (отдельно удаленные заявки)
+// GetFixLogsByApplications Получение fix log-ов нескольких заявок (выгрузка), в порядке создания
+func (r *applicationRepository) GetFixLogsByApplications(ctx context.Context, dto entities.GetFixLogsByApplicationsDTO) ([]*entities.FixLog, Error.CodeError) {
+	query := `SELECT
+			uuid,
+			application_uuid,
+			text,
+			created_at::text,
+			created_by
+		FROM application_fix_logs
+		WHERE application_uuid = ANY($1::uuid[])
+		ORDER BY application_uuid, created_at;`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(dto.ApplicationUUIDs))
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	defer rows.Close()
+
+	fixLogs := make([]*entities.FixLog, 0)
+	for rows.Next() {
+		item := &entities.FixLog{}
+		err = rows.Scan(&item.UUID, &item.ApplicationUUID, &item.Text, &item.CreatedAt, &item.CreatedBy)
+		if err != nil {
+			return nil, Error.Internal(err)
+		}
+		fixLogs = append(fixLogs, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, Error.Internal(err)
+	}
+
+	return fixLogs, Error.CodeError{}
+}
+
+// GetApplications Получение списка заявок по uuid компании с сортировкой по статусу и департаменту с offset / курсором и count (отдельно удаленные заявки)
 func (r *applicationRepository) GetApplications(ctx context.Context, dto entities.GetApplicationsDTO) ([]*entities.Application, Error.CodeError) {
 	query := `
 		SELECT
@@ -187,33 +288,23 @@ func (r *applicationRepository) GetApplications(ctx context.Context, dto entitie
 			COALESCE(inspected_by::text, ''),
 			COALESCE(closed_at::text, ''),
 			COALESCE(deleted_at::text, ''),
-			COALESCE(deleted_by::text, '')
+			COALESCE(deleted_by::text, ''),
+			priority,
+			COALESCE(due_at::text, ''),
+			COALESCE(sla_breach, ''),
+			COALESCE(overdue_at::text, ''),
+			COALESCE(location_uuid::text, ''),
+			location_path::text[],
+			COALESCE(category_uuid::text, ''),
+			COALESCE(severity::text, ''),
+			custom_fields
 		FROM applications
-		WHERE company_uuid = $1
-		  	AND (ARRAY_LENGTH($2::text[], 1) IS NULL OR status::text = ANY($2::text[]))
-		  	AND ($3 = '' OR created_by::text = $3)
-			AND ($4 = '' OR managed_by::text = $4)
-			AND ($5 = '' OR executed_by::text = $5)
-			AND ($6 = '' OR inspected_by::text = $6)
-			AND (NOT $7 OR executed_by IS NULL)
-		  	AND ($8 = '' OR department_uuid::text = $8)
-		  	AND ((NOT $9 AND deleted_at IS NULL) OR ($9 AND deleted_at IS NOT NULL))
-		ORDER BY created_at DESC, uuid
-		OFFSET $10 LIMIT $11;`
-
-	rows, err := r.db.QueryContext(ctx, query,
-		dto.CompanyUUID,        // 1
-		pq.Array(dto.Statuses), // 2
-		dto.CreatedBy,          // 3
-		dto.ManagedBy,          // 4
-		dto.ExecutedBy,         // 5
-		dto.InspectedBy,        // 6
-		dto.ExecutedByIsNull,   // 7
-		dto.DepartmentUUID,     // 8
-		dto.IsDeleted,          // 9
-		dto.Offset,             // 10
-		dto.Count,              // 11
-	)
+		WHERE ` + applicationsFilterWhere + applicationsKeyset(dto.SortBy, dto.SortOrder) + `
+		ORDER BY ` + applicationsOrderBy(dto.SortBy, dto.SortOrder) + `
+		OFFSET $25 LIMIT $26;`
+
+	args := append(applicationsFilterArgs(dto), dto.Offset, dto.Count, dto.AfterUUID)
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, Error.Internal(err)
 	}
@@ -240,6 +331,15 @@ func (r *applicationRepository) GetApplications(ctx context.Context, dto entitie
 			&app.ClosedAt,
 			&app.DeletedAt,
 			&app.DeletedBy,
+			&app.Priority,
+			&app.DueAt,
+			&app.SLABreach,
+			&app.OverdueAt,
+			&app.LocationUUID,
+			pq.Array(&app.LocationPath),
+			&app.CategoryUUID,
+			&app.Severity,
+			jsonColumn{&app.CustomFields},
 		)
 		if err != nil {
 			return nil, Error.Internal(err)
@@ -255,6 +355,150 @@ func (r *applicationRepository) GetApplications(ctx context.Context, dto entitie
 	return applications, Error.CodeError{}
 }
 
+// CountFilteredApplications Общее количество заявок под фильтром списка (без учёта пагинации)
+func (r *applicationRepository) CountFilteredApplications(ctx context.Context, dto entities.GetApplicationsDTO) (int64, Error.CodeError) {
+	var count int64
+	err := r.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM applications WHERE `+applicationsFilterWhere+`;`,
+		applicationsFilterArgs(dto)...,
+	).Scan(&count)
+	if err != nil {
+		return 0, Error.Internal(err)
+	}
+	return count, Error.CodeError{}
+}
+
+// applicationsFilterWhere Условия фильтра списка заявок (параметры $1..$24 из applicationsFilterArgs).
+// Общие для выборки страницы и подсчёта total.
+const applicationsFilterWhere = `company_uuid = $1
+		  	AND (ARRAY_LENGTH($2::text[], 1) IS NULL OR status::text = ANY($2::text[]))
+		  	AND ($3 = '' OR created_by::text = $3)
+			AND ($4 = '' OR managed_by::text = $4)
+			AND ($5 = '' OR executed_by::text = $5)
+			AND ($6 = '' OR inspected_by::text = $6)
+			AND (NOT $7 OR executed_by IS NULL)
+		  	AND ($8 = '' OR department_uuid::text = $8)
+		  	AND ((NOT $9 AND deleted_at IS NULL) OR ($9 AND deleted_at IS NOT NULL))
+		  	AND (NOT $10 OR sla_breach IS NOT NULL)
+		  	AND ($11 = '' OR search_vector @@ websearch_to_tsquery('russian', $11) OR EXISTS (
+		  		SELECT 1 FROM application_fix_logs f
+		  		WHERE f.application_uuid = applications.uuid
+		  			AND to_tsvector('russian'::regconfig, f.text) @@ websearch_to_tsquery('russian', $11)
+		  	))
+		  	AND (NULLIF($12, '') IS NULL OR created_at >= NULLIF($12, '')::timestamptz)
+		  	AND (NULLIF($13, '') IS NULL OR created_at < NULLIF($13, '')::timestamptz)
+		  	AND (NULLIF($14, '') IS NULL OR closed_at >= NULLIF($14, '')::timestamptz)
+		  	AND (NULLIF($15, '') IS NULL OR closed_at < NULLIF($15, '')::timestamptz)
+		  	AND ($16 = '' OR created_by::text = $16)
+		  	AND ($17 = '' OR executed_by::text = $17)
+		  	AND ($18 = '' OR managed_by::text = $18)
+		  	AND ($19::bigint IS NULL OR revision_count >= $19)
+		  	AND ($20::bigint IS NULL OR revision_count <= $20)
+		  	AND (NULLIF($21, '') IS NULL OR location_path @> ARRAY[NULLIF($21, '')::uuid])
+		  	AND ($22 = '' OR category_uuid::text = $22)
+		  	AND (ARRAY_LENGTH($23::text[], 1) IS NULL OR severity::text = ANY($23::text[]))
+		  	AND custom_fields @> $24::jsonb`
+
+func applicationsFilterArgs(dto entities.GetApplicationsDTO) []any {
+	return []any{
+		dto.CompanyUUID,                    // 1
+		pq.Array(dto.Statuses),             // 2
+		dto.CreatedBy,                      // 3
+		dto.ManagedBy,                      // 4
+		dto.ExecutedBy,                     // 5
+		dto.InspectedBy,                    // 6
+		dto.ExecutedByIsNull,               // 7
+		dto.DepartmentUUID,                 // 8
+		dto.IsDeleted,                      // 9
+		dto.IsOverdue,                      // 10
+		dto.Search,                         // 11
+		dto.CreatedFrom,                    // 12
+		dto.CreatedTo,                      // 13
+		dto.ClosedFrom,                     // 14
+		dto.ClosedTo,                       // 15
+		dto.Creator,                        // 16
+		dto.Executor,                       // 17
+		dto.Manager,                        // 18
+		dto.RevisionCountMin,               // 19
+		dto.RevisionCountMax,               // 20
+		dto.LocationUUID,                   // 21
+		dto.CategoryUUID,                   // 22
+		pq.Array(dto.Severities),           // 23
+		customFieldsJSON(dto.CustomFields), // 24
+	}
+}
+
+// customFieldsJSON Значения дополнительных полей для JSONB-колонки custom_fields. Пустой набор - '{}', а не null
+func customFieldsJSON(fields map[string]string) string {
+	if len(fields) == 0 {
+		return "{}"
+	}
+	body, _ := json.Marshal(fields) // map[string]string всегда сериализуется
+	return string(body)
+}
+
+// jsonColumn Сканирует JSONB-колонку в dst, как pq.Array для массивов
+type jsonColumn struct {
+	dst any
+}
+
+func (c jsonColumn) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return json.Unmarshal(v, c.dst)
+	case string:
+		return json.Unmarshal([]byte(v), c.dst)
+	default:
+		return fmt.Errorf("unsupported json column type %T", src)
+	}
+}
+
+// applicationsKeyset Условие keyset-пагинации ($27 - uuid последней заявки предыдущей страницы).
+// Курсор поддерживается только для сортировки по дате создания: UUIDv7 упорядочены по времени создания.
+func applicationsKeyset(sortBy, sortOrder string) string {
+	if sortBy != "" && sortBy != "created_at" {
+		return `
+		  	AND $27 = ''`
+	}
+	if sortOrder == "asc" {
+		return `
+		  	AND (NULLIF($27, '') IS NULL OR uuid > NULLIF($27, '')::uuid)`
+	}
+	return `
+		  	AND (NULLIF($27, '') IS NULL OR uuid < NULLIF($27, '')::uuid)`
+}
+
+// applicationsSortColumns Выражения сортировки списка заявок (ключи - validate.ApplicationSortFields).
+// Дата создания сортируется по UUIDv7 - порядок тот же, а uuid служит ключом курсора.
+// relevance использует запрос поиска из параметра $11.
+var applicationsSortColumns = map[string]string{
+	"created_at":     "uuid",
+	"updated_at":     "COALESCE(updated_at, created_at)",
+	"closed_at":      "closed_at",
+	"due_at":         "due_at",
+	"priority":       "priority",
+	"revision_count": "revision_count",
+	"relevance":      "ts_rank(search_vector, websearch_to_tsquery('russian', $11))",
+}
+
+// applicationsOrderBy Формирует ORDER BY только из известных выражений - пользовательский ввод в запрос не попадает
+func applicationsOrderBy(sortBy, sortOrder string) string {
+	column, found := applicationsSortColumns[sortBy]
+	if !found {
+		sortBy, column = "created_at", applicationsSortColumns["created_at"]
+	}
+	direction := "DESC"
+	if sortOrder == "asc" {
+		direction = "ASC"
+	}
+	if sortBy == "created_at" {
+		return column + " " + direction
+	}
+	return column + " " + direction + " NULLS LAST, uuid DESC"
+}
+
 // UpdateApplicationStatus Обновление статуса заявки
 // 'rejected' 				- manager
 // 'in_progress'  			- engineer
@@ -270,7 +514,8 @@ func (r *applicationRepository) UpdateApplicationStatus(ctx context.Context, dto
 	}
 	defer tx.Rollback()
 
-	if err := r.saveVersion(ctx, tx, dto.ApplicationUUID); err != nil {
+	prev, err := r.saveVersion(ctx, tx, dto.ApplicationUUID)
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return Error.Public(codes.NotFound, "application not found")
 		}
@@ -306,6 +551,16 @@ func (r *applicationRepository) UpdateApplicationStatus(ctx context.Context, dto
 		END
 	WHERE uuid = $1 AND deleted_at IS NULL;`
 
+	if dto.FixLogText != "" {
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO application_fix_logs (uuid, application_uuid, text, created_by) VALUES ($1, $2, $3, $4)`,
+			uuid.Must(uuid.NewV7()).String(), dto.ApplicationUUID, dto.FixLogText, dto.InitiatorUUID,
+		)
+		if err != nil {
+			return Error.Internal(err)
+		}
+	}
+
 	res, err := tx.ExecContext(ctx, query, dto.ApplicationUUID, dto.Status, dto.InitiatorUUID)
 	if err != nil {
 		return Error.Internal(err)
@@ -320,6 +575,17 @@ func (r *applicationRepository) UpdateApplicationStatus(ctx context.Context, dto
 		return Error.Public(codes.NotFound, "application not found")
 	}
 
+	if err = saveEvent(ctx, tx, prev, entities.ApplicationEvent{
+		EventType:      entities.EventApplicationStatusChanged,
+		InitiatorUUID:  dto.InitiatorUUID,
+		Status:         dto.Status,
+		PreviousStatus: prev.Status,
+		ExecutedBy:     prev.ExecutedBy,
+		InspectedBy:    prev.InspectedBy,
+	}); err != nil {
+		return Error.Internal(err)
+	}
+
 	if err = tx.Commit(); err != nil {
 		return Error.Internal(err)
 	}
@@ -336,7 +602,8 @@ func (r *applicationRepository) AssignApplicationToEmployee(ctx context.Context,
 	}
 	defer tx.Rollback()
 
-	if err := r.saveVersion(ctx, tx, dto.ApplicationUUID); err != nil {
+	prev, err := r.saveVersion(ctx, tx, dto.ApplicationUUID)
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return Error.Public(codes.NotFound, "application not found")
 		}
@@ -367,6 +634,17 @@ func (r *applicationRepository) AssignApplicationToEmployee(ctx context.Context,
 		return Error.Public(codes.NotFound, "application not found")
 	}
 
+	if err = saveEvent(ctx, tx, prev, entities.ApplicationEvent{
+		EventType:          entities.EventApplicationAssigned,
+		InitiatorUUID:      dto.InitiatorUUID,
+		Status:             "assigned",
+		PreviousStatus:     prev.Status,
+		ExecutedBy:         dto.TargetUUID,
+		PreviousExecutedBy: prev.ExecutedBy,
+	}); err != nil {
+		return Error.Internal(err)
+	}
+
 	if err = tx.Commit(); err != nil {
 		return Error.Internal(err)
 	}
@@ -383,7 +661,8 @@ func (r *applicationRepository) RedirectApplication(ctx context.Context, dto ent
 	}
 	defer tx.Rollback() //nolint:errcheck
 
-	if err := r.saveVersion(ctx, tx, dto.ApplicationUUID); err != nil {
+	prev, err := r.saveVersion(ctx, tx, dto.ApplicationUUID)
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return Error.Public(codes.NotFound, "application not found")
 		}
@@ -425,6 +704,19 @@ func (r *applicationRepository) RedirectApplication(ctx context.Context, dto ent
 		return Error.Public(codes.NotFound, "application not found")
 	}
 
+	if err = saveEvent(ctx, tx, prev, entities.ApplicationEvent{
+		EventType:              entities.EventApplicationRedirected,
+		InitiatorUUID:          dto.InitiatorUUID,
+		DepartmentUUID:         dto.TargetDepartmentUUID,
+		PreviousDepartmentUUID: prev.DepartmentUUID,
+		Status:                 "redirected",
+		PreviousStatus:         prev.Status,
+		PreviousExecutedBy:     prev.ExecutedBy,
+		Comment:                dto.FixLogText,
+	}); err != nil {
+		return Error.Internal(err)
+	}
+
 	if err = tx.Commit(); err != nil {
 		return Error.Internal(err)
 	}
@@ -441,7 +733,8 @@ func (r *applicationRepository) RecallApplication(ctx context.Context, dto entit
 	}
 	defer tx.Rollback() //nolint:errcheck
 
-	if err := r.saveVersion(ctx, tx, dto.ApplicationUUID); err != nil {
+	prev, err := r.saveVersion(ctx, tx, dto.ApplicationUUID)
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return Error.Public(codes.NotFound, "application not found")
 		}
@@ -481,6 +774,17 @@ func (r *applicationRepository) RecallApplication(ctx context.Context, dto entit
 		return Error.Public(codes.NotFound, "application not found")
 	}
 
+	if err = saveEvent(ctx, tx, prev, entities.ApplicationEvent{
+		EventType:          entities.EventApplicationRecalled,
+		InitiatorUUID:      dto.InitiatorUUID,
+		Status:             "recalled",
+		PreviousStatus:     prev.Status,
+		PreviousExecutedBy: prev.ExecutedBy,
+		Comment:            dto.FixLogText,
+	}); err != nil {
+		return Error.Internal(err)
+	}
+
 	if err = tx.Commit(); err != nil {
 		return Error.Internal(err)
 	}
@@ -497,7 +801,8 @@ func (r *applicationRepository) TakeApplicationToVerification(ctx context.Contex
 	}
 	defer tx.Rollback()
 
-	if err := r.saveVersion(ctx, tx, dto.ApplicationUUID); err != nil {
+	prev, err := r.saveVersion(ctx, tx, dto.ApplicationUUID)
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return Error.Public(codes.NotFound, "application not found")
 		}
@@ -527,6 +832,17 @@ func (r *applicationRepository) TakeApplicationToVerification(ctx context.Contex
 		return Error.Public(codes.NotFound, "application not found")
 	}
 
+	if err = saveEvent(ctx, tx, prev, entities.ApplicationEvent{
+		EventType:      entities.EventApplicationVerificationTaken,
+		InitiatorUUID:  dto.InitiatorUUID,
+		Status:         "on_verification",
+		PreviousStatus: prev.Status,
+		ExecutedBy:     prev.ExecutedBy,
+		InspectedBy:    dto.InitiatorUUID,
+	}); err != nil {
+		return Error.Internal(err)
+	}
+
 	if err = tx.Commit(); err != nil {
 		return Error.Internal(err)
 	}
@@ -543,7 +859,8 @@ func (r *applicationRepository) ReleaseApplicationVerification(ctx context.Conte
 	}
 	defer tx.Rollback() //nolint:errcheck
 
-	if err := r.saveVersion(ctx, tx, dto.ApplicationUUID); err != nil {
+	prev, err := r.saveVersion(ctx, tx, dto.ApplicationUUID)
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return Error.Public(codes.NotFound, "application not found")
 		}
@@ -582,6 +899,17 @@ func (r *applicationRepository) ReleaseApplicationVerification(ctx context.Conte
 		return Error.Public(codes.NotFound, "application not found")
 	}
 
+	if err = saveEvent(ctx, tx, prev, entities.ApplicationEvent{
+		EventType:      entities.EventApplicationVerificationReleased,
+		InitiatorUUID:  dto.InitiatorUUID,
+		Status:         "pending_verification",
+		PreviousStatus: prev.Status,
+		ExecutedBy:     prev.ExecutedBy,
+		Comment:        dto.FixLogText,
+	}); err != nil {
+		return Error.Internal(err)
+	}
+
 	if err = tx.Commit(); err != nil {
 		return Error.Internal(err)
 	}
@@ -597,7 +925,8 @@ func (r *applicationRepository) DeleteApplication(ctx context.Context, dto entit
 	}
 	defer tx.Rollback() //nolint:errcheck
 
-	if err := r.saveVersion(ctx, tx, dto.ApplicationUUID); err != nil {
+	prev, err := r.saveVersion(ctx, tx, dto.ApplicationUUID)
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return Error.Public(codes.NotFound, "application not found")
 		}
@@ -636,6 +965,14 @@ func (r *applicationRepository) DeleteApplication(ctx context.Context, dto entit
 		return Error.Public(codes.NotFound, "application not found")
 	}
 
+	if err = saveEvent(ctx, tx, prev, entities.ApplicationEvent{
+		EventType:     entities.EventApplicationDeleted,
+		InitiatorUUID: dto.DeletedBy,
+		Comment:       dto.FixLogText,
+	}); err != nil {
+		return Error.Internal(err)
+	}
+
 	if err = tx.Commit(); err != nil {
 		return Error.Internal(err)
 	}
@@ -645,9 +982,12 @@ func (r *applicationRepository) DeleteApplication(ctx context.Context, dto entit
 
 // GetApplicationHistory Получение истории изменения заявки
 func (r *applicationRepository) GetApplicationHistory(ctx context.Context, dto entities.GetApplicationHistoryDTO) ([]*entities.Application, Error.CodeError) {
-	query := `SELECT body FROM application_versions WHERE application_uuid = $1 ORDER BY version DESC OFFSET $2 LIMIT $3;`
+	query := `SELECT body FROM application_versions
+		WHERE application_uuid = $1 AND ($4 = 0 OR version < $4)
+		ORDER BY version DESC
+		OFFSET $2 LIMIT $3;`
 
-	res, err := r.db.QueryContext(ctx, query, dto.ApplicationUUID, dto.Offset, dto.Count)
+	res, err := r.db.QueryContext(ctx, query, dto.ApplicationUUID, dto.Offset, dto.Count, dto.BeforeVersion)
 	if err != nil {
 		return nil, Error.Internal(err)
 	}
@@ -680,9 +1020,231 @@ func (r *applicationRepository) GetApplicationHistory(ctx context.Context, dto e
 	return applications, Error.CodeError{}
 }
 
+// CountApplicationHistory Общее количество сохранённых версий заявки
+func (r *applicationRepository) CountApplicationHistory(ctx context.Context, dto entities.GetApplicationHistoryDTO) (int64, Error.CodeError) {
+	var count int64
+	err := r.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM application_versions WHERE application_uuid = $1;`,
+		dto.ApplicationUUID,
+	).Scan(&count)
+	if err != nil {
+		return 0, Error.Internal(err)
+	}
+	return count, Error.CodeError{}
+}
+
+// GetStatusTimeline Переходы заявок между статусами (без создания заявки).
+// Снапшот версии v сохраняется в момент перехода к версии v+1: время перехода - saved_at снапшота,
+// новый статус и автор изменения - из следующего снапшота или из самой заявки.
+func (r *applicationRepository) GetStatusTimeline(ctx context.Context, dto entities.GetStatusTimelineDTO) ([]*entities.StatusChange, Error.CodeError) {
+	query := `WITH states AS (
+			SELECT application_uuid, version, body->>'status' AS status, COALESCE(body->>'updated_by', '') AS updated_by, saved_at
+			FROM application_versions
+			WHERE application_uuid = ANY($1::uuid[])
+			UNION ALL
+			SELECT uuid, version, status::text, COALESCE(updated_by::text, ''), NULL
+			FROM applications
+			WHERE uuid = ANY($1::uuid[])
+		), transitions AS (
+			SELECT
+				application_uuid,
+				version,
+				status AS from_status,
+				saved_at,
+				LEAD(status) OVER w AS to_status,
+				LEAD(updated_by) OVER w AS changed_by
+			FROM states
+			WINDOW w AS (PARTITION BY application_uuid ORDER BY version)
+		)
+		SELECT application_uuid::text, to_status, saved_at::text, changed_by
+		FROM transitions
+		WHERE saved_at IS NOT NULL AND to_status IS DISTINCT FROM from_status
+		ORDER BY application_uuid, version;`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(dto.ApplicationUUIDs))
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	defer rows.Close()
+
+	timeline := make([]*entities.StatusChange, 0)
+	for rows.Next() {
+		change := &entities.StatusChange{}
+		if err = rows.Scan(&change.ApplicationUUID, &change.Status, &change.ChangedAt, &change.ChangedBy); err != nil {
+			return nil, Error.Internal(err)
+		}
+		timeline = append(timeline, change)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, Error.Internal(err)
+	}
+
+	return timeline, Error.CodeError{}
+}
+
+// GetApplicationVersion Получение состояния заявки на указанной версии.
+// Прошлые версии хранятся в application_versions, последняя - в самой таблице applications.
+func (r *applicationRepository) GetApplicationVersion(ctx context.Context, dto entities.GetApplicationVersionDTO) (*entities.Application, Error.CodeError) {
+	var body string
+	err := r.db.QueryRowContext(ctx,
+		`SELECT body FROM application_versions WHERE application_uuid = $1 AND version = $2;`,
+		dto.ApplicationUUID, dto.Version,
+	).Scan(&body)
+
+	switch {
+	case err == nil:
+		application := &entities.Application{}
+		if err = json.Unmarshal([]byte(body), application); err != nil {
+			return nil, Error.Internal(err)
+		}
+		return application, Error.CodeError{}
+
+	case errors.Is(err, sql.ErrNoRows):
+		// Снапшота нет - версия либо текущая, либо не существует
+		application, getErr := r.GetApplication(ctx, entities.GetApplicationDTO{ApplicationUUID: dto.ApplicationUUID})
+		if getErr.Code != 0 {
+			return nil, getErr
+		}
+		if application.Version != dto.Version {
+			return nil, Error.Public(codes.NotFound, "application version not found")
+		}
+		return application, Error.CodeError{}
+
+	default:
+		return nil, Error.Internal(err)
+	}
+}
+
+// CountApplications Количество неудалённых заявок компании в указанных статусах
+func (r *applicationRepository) CountApplications(ctx context.Context, dto entities.CountApplicationsDTO) (int64, Error.CodeError) {
+	var count int64
+	err := r.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM applications WHERE company_uuid = $1 AND status::text = ANY($2::text[]) AND deleted_at IS NULL;`,
+		dto.CompanyUUID, pq.Array(dto.Statuses),
+	).Scan(&count)
+	if err != nil {
+		return 0, Error.Internal(err)
+	}
+	return count, Error.CodeError{}
+}
+
+// slaBreachQuery Нарушенный срок SLA заявки a по политике p её компании и приоритета (NULL - нарушений нет).
+// Сроки отсчитываются от создания заявки; due_at сокращает срок передачи на проверку.
+// Закрытые, отклонённые и удалённые заявки сроков не нарушают.
+const slaBreachQuery = `CASE
+		WHEN a.closed_at IS NOT NULL OR a.deleted_at IS NOT NULL THEN NULL
+		WHEN a.status IN ('created', 'redirected', 'recalled')
+			AND a.created_at + p.assign_within_hours * INTERVAL '1 hour' < NOW() THEN 'assign'
+		WHEN a.status NOT IN ('pending_verification', 'on_verification', 'completed', 'failed', 'rejected')
+			AND LEAST(a.due_at, a.created_at + p.verification_within_hours * INTERVAL '1 hour') < NOW() THEN 'fix'
+	END`
+
+// GetSLAChanges UUID заявок, у которых сохранённое нарушение SLA расходится с текущим (от старых к новым)
+func (r *applicationRepository) GetSLAChanges(ctx context.Context, dto entities.GetSLAChangesDTO) ([]string, Error.CodeError) {
+	query := `SELECT a.uuid
+		FROM applications a
+		LEFT JOIN application_sla_policies p ON p.company_uuid = a.company_uuid AND p.priority = a.priority
+		WHERE a.deleted_at IS NULL
+			AND (a.closed_at IS NULL OR a.sla_breach IS NOT NULL)
+			AND (` + slaBreachQuery + `) IS DISTINCT FROM a.sla_breach
+		ORDER BY a.created_at, a.uuid
+		LIMIT $1;`
+
+	rows, err := r.db.QueryContext(ctx, query, dto.Count)
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	defer rows.Close()
+
+	uuids := make([]string, 0)
+	for rows.Next() {
+		var applicationUUID string
+		if err = rows.Scan(&applicationUUID); err != nil {
+			return nil, Error.Internal(err)
+		}
+		uuids = append(uuids, applicationUUID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, Error.Internal(err)
+	}
+
+	return uuids, Error.CodeError{}
+}
+
+// UpdateApplicationSLABreach Пересчёт нарушения SLA заявки. Если нарушение изменилось, сохраняет его
+// новой версией заявки и публикует событие эскалации (или снятия нарушения).
+// Изменение выполняет система, поэтому updated_at/updated_by не меняются.
+func (r *applicationRepository) UpdateApplicationSLABreach(ctx context.Context, dto entities.UpdateApplicationSLABreachDTO) Error.CodeError {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	// Строка блокируется до сохранения версии - статус заявки не изменится между расчётом и записью
+	var breach sql.NullString
+	var current string
+	err = tx.QueryRowContext(ctx, `SELECT `+slaBreachQuery+`, COALESCE(a.sla_breach, '')
+		FROM applications a
+		LEFT JOIN application_sla_policies p ON p.company_uuid = a.company_uuid AND p.priority = a.priority
+		WHERE a.uuid = $1
+		FOR UPDATE OF a;`,
+		dto.ApplicationUUID,
+	).Scan(&breach, &current)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Error.Public(codes.NotFound, "application not found")
+		}
+		return Error.Internal(err)
+	}
+	if breach.String == current {
+		return Error.CodeError{}
+	}
+
+	prev, err := r.saveVersion(ctx, tx, dto.ApplicationUUID)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	query := `UPDATE applications
+	SET
+		version = version + 1,
+		sla_breach = NULLIF($2, ''),
+		overdue_at = CASE WHEN $2 = '' THEN NULL ELSE CURRENT_TIMESTAMP END
+	WHERE uuid = $1;`
+
+	if _, err = tx.ExecContext(ctx, query, dto.ApplicationUUID, breach.String); err != nil {
+		return Error.Internal(err)
+	}
+
+	eventType := entities.EventApplicationOverdue
+	if breach.String == "" {
+		eventType = entities.EventApplicationOverdueResolved
+	}
+	if err = saveEvent(ctx, tx, prev, entities.ApplicationEvent{
+		EventType:   eventType,
+		Priority:    prev.Priority,
+		DueAt:       prev.DueAt,
+		SLABreach:   breach.String,
+		ExecutedBy:  prev.ExecutedBy,
+		InspectedBy: prev.InspectedBy,
+	}); err != nil {
+		return Error.Internal(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return Error.Internal(err)
+	}
+
+	return Error.CodeError{}
+}
+
 // saveVersion сохраняет снапшот текущего состояния заявки в application_versions внутри транзакции.
 // Использует SELECT FOR UPDATE, чтобы заблокировать строку на время транзакции.
-func (r *applicationRepository) saveVersion(ctx context.Context, tx *sql.Tx, applicationUUID string) error {
+// Возвращает сохранённый снапшот - из него формируется доменное событие (saveEvent).
+func (r *applicationRepository) saveVersion(ctx context.Context, tx *sql.Tx, applicationUUID string) (*entities.Application, error) {
 	var app entities.Application
 	err := tx.QueryRowContext(ctx, `
 		SELECT
@@ -703,7 +1265,16 @@ func (r *applicationRepository) saveVersion(ctx context.Context, tx *sql.Tx, app
 			COALESCE(inspected_by::text, ''),
 			COALESCE(closed_at::text, ''),
 			COALESCE(deleted_at::text, ''),
-			COALESCE(deleted_by::text, '')
+			COALESCE(deleted_by::text, ''),
+			priority,
+			COALESCE(due_at::text, ''),
+			COALESCE(sla_breach, ''),
+			COALESCE(overdue_at::text, ''),
+			COALESCE(location_uuid::text, ''),
+			location_path::text[],
+			COALESCE(category_uuid::text, ''),
+			COALESCE(severity::text, ''),
+			custom_fields
 		FROM applications
 		WHERE uuid = $1
 		FOR UPDATE`,
@@ -727,19 +1298,64 @@ func (r *applicationRepository) saveVersion(ctx context.Context, tx *sql.Tx, app
 		&app.ClosedAt,
 		&app.DeletedAt,
 		&app.DeletedBy,
+		&app.Priority,
+		&app.DueAt,
+		&app.SLABreach,
+		&app.OverdueAt,
+		&app.LocationUUID,
+		pq.Array(&app.LocationPath),
+		&app.CategoryUUID,
+		&app.Severity,
+		jsonColumn{&app.CustomFields},
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	body, err := json.Marshal(app)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	_, err = tx.ExecContext(ctx,
 		`INSERT INTO application_versions (uuid, application_uuid, version, body) VALUES ($1, $2, $3, $4)`,
 		uuid.Must(uuid.NewV7()).String(), app.ApplicationUUID, app.Version, body,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &app, nil
+}
+
+// saveEvent записывает доменное событие в application_outbox внутри той же транзакции, что и изменение заявки.
+// Общие поля события заполняются из снапшота заявки до изменения, публикацию выполняет outbox worker.
+func saveEvent(ctx context.Context, tx *sql.Tx, prev *entities.Application, event entities.ApplicationEvent) error {
+	event.EventUUID = uuid.Must(uuid.NewV7()).String()
+	event.EventVersion = entities.ApplicationEventVersion
+	event.OccurredAt = time.Now().UTC().Format(time.RFC3339Nano)
+	event.ApplicationUUID = prev.ApplicationUUID
+	event.CompanyUUID = prev.CompanyUUID
+	event.Version = prev.Version
+	if event.EventType != entities.EventCommentCreated {
+		event.Version++
+	}
+
+	if event.DepartmentUUID == "" {
+		event.DepartmentUUID = prev.DepartmentUUID
+	}
+	if event.Status == "" {
+		event.Status = prev.Status
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO application_outbox (uuid, event_type, application_uuid, payload) VALUES ($1, $2, $3, $4)`,
+		event.EventUUID, event.EventType, event.ApplicationUUID, payload,
+	)
 	return err
 }
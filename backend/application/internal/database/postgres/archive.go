@@ -0,0 +1,47 @@
+package postgresDB
+
+import (
+	"context"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+)
+
+// purgeCompanySettingsQueries Настройки компании, удаляемые вместе с её заявками.
+// Категории удаляются после заявок - заявки на них ссылаются
+var purgeCompanySettingsQueries = []string{
+	`DELETE FROM application_categories WHERE company_uuid = $1;`,
+	`DELETE FROM application_custom_fields WHERE company_uuid = $1;`,
+	`DELETE FROM application_workflows WHERE company_uuid = $1;`,
+	`DELETE FROM application_sla_policies WHERE company_uuid = $1;`,
+}
+
+// PurgeCompanyApplications Удаление всех заявок компании (fix log-и, версии, вложения и комментарии удаляются каскадно)
+// и её настроек заявок. Повторный вызов ничего не удаляет. Возвращает кол-во удалённых заявок
+func (r *applicationRepository) PurgeCompanyApplications(ctx context.Context, dto entities.PurgeCompanyApplicationsDTO) (int64, Error.CodeError) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, Error.Internal(err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `DELETE FROM applications WHERE company_uuid = $1;`, dto.CompanyUUID)
+	if err != nil {
+		return 0, Error.Internal(err)
+	}
+	purged, err := res.RowsAffected()
+	if err != nil {
+		return 0, Error.Internal(err)
+	}
+
+	for _, query := range purgeCompanySettingsQueries {
+		if _, err = tx.ExecContext(ctx, query, dto.CompanyUUID); err != nil {
+			return 0, Error.Internal(err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, Error.Internal(err)
+	}
+	return purged, Error.CodeError{}
+}
@@ -0,0 +1,193 @@
+package postgresDB
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+type AttachmentRepository interface {
+	CreateAttachment(ctx context.Context, dto entities.CreateAttachmentDTO) Error.CodeError
+	GetAttachment(ctx context.Context, dto entities.GetAttachmentDTO) (*entities.Attachment, Error.CodeError)
+	GetApplicationAttachments(ctx context.Context, dto entities.GetApplicationAttachmentsDTO) ([]*entities.Attachment, Error.CodeError)
+	DeleteAttachment(ctx context.Context, dto entities.DeleteAttachmentDTO) Error.CodeError
+	GetCompanyAttachmentKeys(ctx context.Context, dto entities.GetCompanyAttachmentKeysDTO) ([]string, Error.CodeError)
+}
+
+type attachmentRepository struct {
+	db *sql.DB
+}
+
+func NewAttachmentRepository(db *sql.DB) AttachmentRepository {
+	return &attachmentRepository{db: db}
+}
+
+// CreateAttachment Сохранение метаданных вложения
+func (r *attachmentRepository) CreateAttachment(ctx context.Context, dto entities.CreateAttachmentDTO) Error.CodeError {
+	query := `INSERT INTO application_attachments
+	(uuid, application_uuid, fix_log_uuid, object_key, file_name, content_type, size, created_by) VALUES
+	($1, $2, NULLIF($3, '')::uuid, $4, $5, $6, $7, $8);`
+
+	_, err := r.db.ExecContext(ctx, query,
+		dto.AttachmentUUID,
+		dto.ApplicationUUID,
+		dto.FixLogUUID,
+		dto.ObjectKey,
+		dto.FileName,
+		dto.ContentType,
+		dto.Size,
+		dto.CreatedBy,
+	)
+	if err != nil {
+		var pgErr *pq.Error
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23503" { // foreign_key_violation
+				return Error.Public(codes.NotFound, "application or fix log not found")
+			}
+		}
+
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// GetAttachment Получение метаданных вложения заявки
+func (r *attachmentRepository) GetAttachment(ctx context.Context, dto entities.GetAttachmentDTO) (*entities.Attachment, Error.CodeError) {
+	query := `SELECT
+			uuid,
+			application_uuid,
+			COALESCE(fix_log_uuid::text, ''),
+			object_key,
+			file_name,
+			content_type,
+			size,
+			created_at::text,
+			created_by
+		FROM application_attachments
+		WHERE uuid = $1 AND application_uuid = $2;`
+
+	item := &entities.Attachment{}
+	err := r.db.QueryRowContext(ctx, query, dto.AttachmentUUID, dto.ApplicationUUID).Scan(
+		&item.UUID,
+		&item.ApplicationUUID,
+		&item.FixLogUUID,
+		&item.ObjectKey,
+		&item.FileName,
+		&item.ContentType,
+		&item.Size,
+		&item.CreatedAt,
+		&item.CreatedBy,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, Error.Public(codes.NotFound, "attachment not found")
+		}
+		return nil, Error.Internal(err)
+	}
+	return item, Error.CodeError{}
+}
+
+// GetApplicationAttachments Получение всех вложений заявки (включая вложения fix log-ов)
+func (r *attachmentRepository) GetApplicationAttachments(ctx context.Context, dto entities.GetApplicationAttachmentsDTO) ([]*entities.Attachment, Error.CodeError) {
+	query := `SELECT
+			uuid,
+			application_uuid,
+			COALESCE(fix_log_uuid::text, ''),
+			object_key,
+			file_name,
+			content_type,
+			size,
+			created_at::text,
+			created_by
+		FROM application_attachments
+		WHERE application_uuid = $1
+		ORDER BY created_at, uuid;`
+
+	rows, err := r.db.QueryContext(ctx, query, dto.ApplicationUUID)
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	defer rows.Close()
+
+	attachments := make([]*entities.Attachment, 0)
+	for rows.Next() {
+		item := &entities.Attachment{}
+		err = rows.Scan(
+			&item.UUID,
+			&item.ApplicationUUID,
+			&item.FixLogUUID,
+			&item.ObjectKey,
+			&item.FileName,
+			&item.ContentType,
+			&item.Size,
+			&item.CreatedAt,
+			&item.CreatedBy,
+		)
+		if err != nil {
+			return nil, Error.Internal(err)
+		}
+		attachments = append(attachments, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, Error.Internal(err)
+	}
+
+	return attachments, Error.CodeError{}
+}
+
+// DeleteAttachment Удаление метаданных вложения
+func (r *attachmentRepository) DeleteAttachment(ctx context.Context, dto entities.DeleteAttachmentDTO) Error.CodeError {
+	res, err := r.db.ExecContext(ctx,
+		`DELETE FROM application_attachments WHERE uuid = $1 AND application_uuid = $2;`,
+		dto.AttachmentUUID, dto.ApplicationUUID,
+	)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	if affected == 0 {
+		return Error.Public(codes.NotFound, "attachment not found")
+	}
+
+	return Error.CodeError{}
+}
+
+// GetCompanyAttachmentKeys Ключи объектов всех вложений заявок компании (включая удалённые заявки)
+func (r *attachmentRepository) GetCompanyAttachmentKeys(ctx context.Context, dto entities.GetCompanyAttachmentKeysDTO) ([]string, Error.CodeError) {
+	query := `SELECT t.object_key
+		FROM application_attachments t
+		JOIN applications a ON a.uuid = t.application_uuid
+		WHERE a.company_uuid = $1;`
+
+	rows, err := r.db.QueryContext(ctx, query, dto.CompanyUUID)
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	defer rows.Close()
+
+	keys := make([]string, 0)
+	for rows.Next() {
+		var key string
+		if err = rows.Scan(&key); err != nil {
+			return nil, Error.Internal(err)
+		}
+		keys = append(keys, key)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, Error.Internal(err)
+	}
+
+	return keys, Error.CodeError{}
+}
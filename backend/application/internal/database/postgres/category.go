@@ -0,0 +1,166 @@
+package postgresDB
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+// CategoryRepository Категории дефектов компаний. Категорию, на которую ссылаются заявки, удалить нельзя.
+type CategoryRepository interface {
+	CreateCategory(ctx context.Context, dto entities.CreateCategoryDTO) Error.CodeError
+	GetCategory(ctx context.Context, dto entities.GetCategoryDTO) (*entities.Category, Error.CodeError)
+	GetCompanyCategories(ctx context.Context, dto entities.GetCompanyCategoriesDTO) ([]*entities.Category, Error.CodeError)
+	UpdateCategory(ctx context.Context, dto entities.UpdateCategoryDTO) Error.CodeError
+	DeleteCategory(ctx context.Context, dto entities.DeleteCategoryDTO) Error.CodeError
+}
+
+type categoryRepository struct {
+	db *sql.DB
+}
+
+func NewCategoryRepository(db *sql.DB) CategoryRepository {
+	return &categoryRepository{db: db}
+}
+
+// CreateCategory Создание категории. Название уникально в компании без учёта регистра
+func (r *categoryRepository) CreateCategory(ctx context.Context, dto entities.CreateCategoryDTO) Error.CodeError {
+	query := `INSERT INTO application_categories
+		(uuid, company_uuid, title, default_department_uuid, created_by) VALUES
+		($1, $2, $3, NULLIF($4, '')::uuid, $5);`
+
+	_, err := r.db.ExecContext(ctx, query, dto.UUID, dto.CompanyUUID, dto.Title, dto.DefaultDepartmentUUID, dto.CreatedBy)
+	if err != nil {
+		return categoryError(err)
+	}
+	return Error.CodeError{}
+}
+
+// GetCategory Получение категории по uuid
+func (r *categoryRepository) GetCategory(ctx context.Context, dto entities.GetCategoryDTO) (*entities.Category, Error.CodeError) {
+	query := `SELECT
+			company_uuid,
+			title,
+			COALESCE(default_department_uuid::text, ''),
+			created_at::text,
+			created_by
+		FROM application_categories
+		WHERE uuid = $1;`
+
+	category := &entities.Category{UUID: dto.CategoryUUID}
+	err := r.db.QueryRowContext(ctx, query, dto.CategoryUUID).Scan(
+		&category.CompanyUUID,
+		&category.Title,
+		&category.DefaultDepartmentUUID,
+		&category.CreatedAt,
+		&category.CreatedBy,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, Error.Public(codes.NotFound, "category not found")
+		}
+		return nil, Error.Internal(err)
+	}
+	return category, Error.CodeError{}
+}
+
+// GetCompanyCategories Получение категорий компании по названию
+func (r *categoryRepository) GetCompanyCategories(ctx context.Context, dto entities.GetCompanyCategoriesDTO) ([]*entities.Category, Error.CodeError) {
+	query := `SELECT
+			uuid,
+			title,
+			COALESCE(default_department_uuid::text, ''),
+			created_at::text,
+			created_by
+		FROM application_categories
+		WHERE company_uuid = $1
+		ORDER BY lower(title);`
+
+	rows, err := r.db.QueryContext(ctx, query, dto.CompanyUUID)
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	defer rows.Close()
+
+	categories := make([]*entities.Category, 0)
+	for rows.Next() {
+		category := &entities.Category{CompanyUUID: dto.CompanyUUID}
+		err = rows.Scan(&category.UUID, &category.Title, &category.DefaultDepartmentUUID, &category.CreatedAt, &category.CreatedBy)
+		if err != nil {
+			return nil, Error.Internal(err)
+		}
+		categories = append(categories, category)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, Error.Internal(err)
+	}
+
+	return categories, Error.CodeError{}
+}
+
+// UpdateCategory Обновление названия и департамента по умолчанию. На уже созданные заявки не влияет
+func (r *categoryRepository) UpdateCategory(ctx context.Context, dto entities.UpdateCategoryDTO) Error.CodeError {
+	query := `UPDATE application_categories
+		SET title = $2, default_department_uuid = NULLIF($3, '')::uuid
+		WHERE uuid = $1;`
+
+	res, err := r.db.ExecContext(ctx, query, dto.CategoryUUID, dto.Title, dto.DefaultDepartmentUUID)
+	if err != nil {
+		return categoryError(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	if affected == 0 {
+		return Error.Public(codes.NotFound, "category not found")
+	}
+	return Error.CodeError{}
+}
+
+// DeleteCategory Удаление категории, на которую не ссылается ни одна заявка
+func (r *categoryRepository) DeleteCategory(ctx context.Context, dto entities.DeleteCategoryDTO) Error.CodeError {
+	query := `DELETE FROM application_categories WHERE uuid = $1;`
+
+	res, err := r.db.ExecContext(ctx, query, dto.CategoryUUID)
+	if err != nil {
+		var pgErr *pq.Error
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23503" { // foreign_key_violation
+				return Error.Public(codes.FailedPrecondition, "category is used by applications")
+			}
+		}
+
+		return Error.Internal(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	if affected == 0 {
+		return Error.Public(codes.NotFound, "category not found")
+	}
+	return Error.CodeError{}
+}
+
+// categoryError Преобразование ошибки записи категории: повтор названия в компании - AlreadyExists
+func categoryError(err error) Error.CodeError {
+	var pgErr *pq.Error
+	if errors.As(err, &pgErr) {
+		if pgErr.Code == "23505" { // unique_violation
+			return Error.Public(codes.AlreadyExists, "category with this title already exists")
+		}
+	}
+
+	return Error.Internal(err)
+}
@@ -0,0 +1,335 @@
+package postgresDB
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+type CommentRepository interface {
+	CreateComment(ctx context.Context, dto entities.CreateCommentDTO) Error.CodeError
+	GetComment(ctx context.Context, dto entities.GetCommentDTO) (*entities.Comment, Error.CodeError)
+	GetCommentThreads(ctx context.Context, dto entities.GetCommentThreadsDTO) ([]*entities.Comment, Error.CodeError)
+	GetCommentReplies(ctx context.Context, dto entities.GetCommentRepliesDTO) ([]*entities.Comment, Error.CodeError)
+	CountCommentThreads(ctx context.Context, dto entities.CountCommentThreadsDTO) (int64, Error.CodeError)
+	UpdateComment(ctx context.Context, dto entities.UpdateCommentDTO) Error.CodeError
+	DeleteComment(ctx context.Context, dto entities.DeleteCommentDTO) Error.CodeError
+	GetCommentEdits(ctx context.Context, dto entities.GetCommentEditsDTO) ([]*entities.CommentEdit, Error.CodeError)
+}
+
+type commentRepository struct {
+	db *sql.DB
+}
+
+func NewCommentRepository(db *sql.DB) CommentRepository {
+	return &commentRepository{db: db}
+}
+
+const commentColumns = `
+			uuid,
+			application_uuid,
+			COALESCE(parent_uuid::text, ''),
+			text,
+			mentioned_uuids::text[],
+			created_at::text,
+			created_by,
+			COALESCE(edited_at::text, ''),
+			COALESCE(deleted_at::text, ''),
+			COALESCE(deleted_by::text, '')`
+
+// CreateComment Сохранение нового комментария вместе с событием comment.created (упоминания - для уведомлений)
+func (r *commentRepository) CreateComment(ctx context.Context, dto entities.CreateCommentDTO) Error.CodeError {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	query := `INSERT INTO application_comments
+	(uuid, application_uuid, parent_uuid, text, mentioned_uuids, created_by) VALUES
+	($1, $2, NULLIF($3, '')::uuid, $4, $5::uuid[], $6);`
+
+	_, err = tx.ExecContext(ctx, query,
+		dto.CommentUUID,
+		dto.ApplicationUUID,
+		dto.ParentUUID,
+		dto.Text,
+		pq.Array(dto.MentionedUUIDs),
+		dto.CreatedBy,
+	)
+	if err != nil {
+		var pgErr *pq.Error
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23503" { // foreign_key_violation
+				return Error.Public(codes.NotFound, "application or parent comment not found")
+			}
+		}
+
+		return Error.Internal(err)
+	}
+
+	application, err := commentApplication(ctx, tx, dto.ApplicationUUID)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	if err = saveEvent(ctx, tx, application, entities.ApplicationEvent{
+		EventType:         entities.EventCommentCreated,
+		InitiatorUUID:     dto.CreatedBy,
+		CommentUUID:       dto.CommentUUID,
+		ParentCommentUUID: dto.ParentUUID,
+		MentionedUUIDs:    dto.MentionedUUIDs,
+	}); err != nil {
+		return Error.Internal(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// GetComment Получение комментария заявки
+func (r *commentRepository) GetComment(ctx context.Context, dto entities.GetCommentDTO) (*entities.Comment, Error.CodeError) {
+	query := `SELECT` + commentColumns + `
+		FROM application_comments
+		WHERE uuid = $1 AND application_uuid = $2;`
+
+	item, err := scanComment(r.db.QueryRowContext(ctx, query, dto.CommentUUID, dto.ApplicationUUID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, Error.Public(codes.NotFound, "comment not found")
+		}
+		return nil, Error.Internal(err)
+	}
+	return item, Error.CodeError{}
+}
+
+// GetCommentThreads Получение страницы комментариев верхнего уровня (веток) от старых к новым
+func (r *commentRepository) GetCommentThreads(ctx context.Context, dto entities.GetCommentThreadsDTO) ([]*entities.Comment, Error.CodeError) {
+	query := `SELECT` + commentColumns + `
+		FROM application_comments
+		WHERE application_uuid = $1
+			AND parent_uuid IS NULL
+			AND ($4::text = '' OR uuid > NULLIF($4::text, '')::uuid)
+		ORDER BY uuid
+		OFFSET $2 LIMIT $3;`
+
+	rows, err := r.db.QueryContext(ctx, query, dto.ApplicationUUID, dto.Offset, dto.Count, dto.AfterUUID)
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	return scanComments(rows)
+}
+
+// GetCommentReplies Получение ответов в ветках с заданными комментариями верхнего уровня
+func (r *commentRepository) GetCommentReplies(ctx context.Context, dto entities.GetCommentRepliesDTO) ([]*entities.Comment, Error.CodeError) {
+	query := `SELECT` + commentColumns + `
+		FROM application_comments
+		WHERE parent_uuid = ANY($1::uuid[])
+		ORDER BY uuid;`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(dto.ParentUUIDs))
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	return scanComments(rows)
+}
+
+// CountCommentThreads Количество веток комментариев заявки
+func (r *commentRepository) CountCommentThreads(ctx context.Context, dto entities.CountCommentThreadsDTO) (int64, Error.CodeError) {
+	var count int64
+	err := r.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM application_comments WHERE application_uuid = $1 AND parent_uuid IS NULL;`,
+		dto.ApplicationUUID,
+	).Scan(&count)
+	if err != nil {
+		return 0, Error.Internal(err)
+	}
+	return count, Error.CodeError{}
+}
+
+// UpdateComment Изменение текста комментария. Заменяемая редакция сохраняется в application_comment_edits
+func (r *commentRepository) UpdateComment(ctx context.Context, dto entities.UpdateCommentDTO) Error.CodeError {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	var text string
+	var mentions []string
+	var isDeleted bool
+	err = tx.QueryRowContext(ctx,
+		`SELECT text, mentioned_uuids::text[], deleted_at IS NOT NULL
+		FROM application_comments
+		WHERE uuid = $1 AND application_uuid = $2
+		FOR UPDATE;`,
+		dto.CommentUUID, dto.ApplicationUUID,
+	).Scan(&text, pq.Array(&mentions), &isDeleted)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Error.Public(codes.NotFound, "comment not found")
+		}
+		return Error.Internal(err)
+	}
+
+	if isDeleted {
+		return Error.Public(codes.FailedPrecondition, "comment is deleted")
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO application_comment_edits (uuid, comment_uuid, text, mentioned_uuids, edited_by) VALUES ($1, $2, $3, $4::uuid[], $5);`,
+		uuid.Must(uuid.NewV7()).String(), dto.CommentUUID, text, pq.Array(mentions), dto.EditedBy,
+	)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`UPDATE application_comments SET text = $2, mentioned_uuids = $3::uuid[], edited_at = CURRENT_TIMESTAMP WHERE uuid = $1;`,
+		dto.CommentUUID, dto.Text, pq.Array(dto.MentionedUUIDs),
+	)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// DeleteComment Мягкое удаление комментария: ответы в ветке и история редакций сохраняются
+func (r *commentRepository) DeleteComment(ctx context.Context, dto entities.DeleteCommentDTO) Error.CodeError {
+	res, err := r.db.ExecContext(ctx,
+		`UPDATE application_comments SET deleted_at = CURRENT_TIMESTAMP, deleted_by = $3
+		WHERE uuid = $1 AND application_uuid = $2 AND deleted_at IS NULL;`,
+		dto.CommentUUID, dto.ApplicationUUID, dto.DeletedBy,
+	)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	if affected == 0 {
+		return Error.Public(codes.NotFound, "comment not found")
+	}
+
+	return Error.CodeError{}
+}
+
+// GetCommentEdits Получение предыдущих редакций комментария от новых к старым
+func (r *commentRepository) GetCommentEdits(ctx context.Context, dto entities.GetCommentEditsDTO) ([]*entities.CommentEdit, Error.CodeError) {
+	query := `SELECT
+			uuid,
+			comment_uuid,
+			text,
+			mentioned_uuids::text[],
+			edited_at::text,
+			edited_by
+		FROM application_comment_edits
+		WHERE comment_uuid = $1
+		ORDER BY uuid DESC;`
+
+	rows, err := r.db.QueryContext(ctx, query, dto.CommentUUID)
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	defer rows.Close()
+
+	edits := make([]*entities.CommentEdit, 0)
+	for rows.Next() {
+		item := &entities.CommentEdit{}
+		err = rows.Scan(
+			&item.UUID,
+			&item.CommentUUID,
+			&item.Text,
+			pq.Array(&item.MentionedUUIDs),
+			&item.EditedAt,
+			&item.EditedBy,
+		)
+		if err != nil {
+			return nil, Error.Internal(err)
+		}
+		edits = append(edits, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, Error.Internal(err)
+	}
+
+	return edits, Error.CodeError{}
+}
+
+// ─── Вспомогательные функции ──────────────────────────────────────────────────
+
+// commentApplication Поля заявки для события комментария. FOR SHARE не даёт заявке смениться до фиксации события
+func commentApplication(ctx context.Context, tx *sql.Tx, applicationUUID string) (*entities.Application, error) {
+	var app entities.Application
+	err := tx.QueryRowContext(ctx, `
+		SELECT uuid, company_uuid, department_uuid, version, status
+		FROM applications
+		WHERE uuid = $1
+		FOR SHARE`,
+		applicationUUID,
+	).Scan(&app.ApplicationUUID, &app.CompanyUUID, &app.DepartmentUUID, &app.Version, &app.Status)
+	if err != nil {
+		return nil, err
+	}
+	return &app, nil
+}
+
+type commentScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanComment Чтение строки с колонками commentColumns
+func scanComment(row commentScanner) (*entities.Comment, error) {
+	item := &entities.Comment{}
+	err := row.Scan(
+		&item.UUID,
+		&item.ApplicationUUID,
+		&item.ParentUUID,
+		&item.Text,
+		pq.Array(&item.MentionedUUIDs),
+		&item.CreatedAt,
+		&item.CreatedBy,
+		&item.EditedAt,
+		&item.DeletedAt,
+		&item.DeletedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func scanComments(rows *sql.Rows) ([]*entities.Comment, Error.CodeError) {
+	defer rows.Close()
+
+	comments := make([]*entities.Comment, 0)
+	for rows.Next() {
+		item, err := scanComment(rows)
+		if err != nil {
+			return nil, Error.Internal(err)
+		}
+		comments = append(comments, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, Error.Internal(err)
+	}
+
+	return comments, Error.CodeError{}
+}
@@ -0,0 +1,114 @@
+package postgresDB
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+// CustomFieldRepository Дополнительные поля заявок компаний. Значения полей хранятся в самих заявках.
+type CustomFieldRepository interface {
+	GetCompanyCustomFields(ctx context.Context, dto entities.GetCompanyCustomFieldsDTO) ([]*entities.CustomField, Error.CodeError)
+	SaveCustomField(ctx context.Context, dto entities.SaveCustomFieldDTO) Error.CodeError
+	DeleteCustomField(ctx context.Context, dto entities.DeleteCustomFieldDTO) Error.CodeError
+}
+
+type customFieldRepository struct {
+	db *sql.DB
+}
+
+func NewCustomFieldRepository(db *sql.DB) CustomFieldRepository {
+	return &customFieldRepository{db: db}
+}
+
+// GetCompanyCustomFields Получение дополнительных полей компании в порядке ключей
+func (r *customFieldRepository) GetCompanyCustomFields(ctx context.Context, dto entities.GetCompanyCustomFieldsDTO) ([]*entities.CustomField, Error.CodeError) {
+	query := `SELECT
+			key,
+			title,
+			type,
+			options,
+			required,
+			updated_at::text,
+			updated_by
+		FROM application_custom_fields
+		WHERE company_uuid = $1
+		ORDER BY key;`
+
+	rows, err := r.db.QueryContext(ctx, query, dto.CompanyUUID)
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	defer rows.Close()
+
+	fields := make([]*entities.CustomField, 0)
+	for rows.Next() {
+		field := &entities.CustomField{CompanyUUID: dto.CompanyUUID}
+		err = rows.Scan(&field.Key, &field.Title, &field.Type, pq.Array(&field.Options), &field.Required, &field.UpdatedAt, &field.UpdatedBy)
+		if err != nil {
+			return nil, Error.Internal(err)
+		}
+		fields = append(fields, field)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, Error.Internal(err)
+	}
+
+	return fields, Error.CodeError{}
+}
+
+// SaveCustomField Создание или замена дополнительного поля по ключу. Тип существующего поля не меняется -
+// значения в заявках уже проверены по старому типу
+func (r *customFieldRepository) SaveCustomField(ctx context.Context, dto entities.SaveCustomFieldDTO) Error.CodeError {
+	query := `INSERT INTO application_custom_fields
+		(company_uuid, key, title, type, options, required, updated_by) VALUES
+		($1, $2, $3, $4::custom_field_type, $5::text[], $6, $7)
+		ON CONFLICT (company_uuid, key) DO UPDATE SET
+			title = EXCLUDED.title,
+			options = EXCLUDED.options,
+			required = EXCLUDED.required,
+			updated_at = CURRENT_TIMESTAMP,
+			updated_by = EXCLUDED.updated_by
+		WHERE application_custom_fields.type = EXCLUDED.type;`
+
+	res, err := r.db.ExecContext(ctx, query, dto.CompanyUUID, dto.Key, dto.Title, dto.Type, pq.Array(dto.Options), dto.Required, dto.UpdatedBy)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	// Конфликт по ключу с другим типом - строка не вставлена и не обновлена
+	if affected == 0 {
+		return Error.Public(codes.FailedPrecondition, "custom field type can not be changed, delete the field instead")
+	}
+	return Error.CodeError{}
+}
+
+// DeleteCustomField Удаление дополнительного поля. Значения в заявках и их версиях сохраняются
+func (r *customFieldRepository) DeleteCustomField(ctx context.Context, dto entities.DeleteCustomFieldDTO) Error.CodeError {
+	query := `DELETE FROM application_custom_fields WHERE company_uuid = $1 AND key = $2;`
+
+	res, err := r.db.ExecContext(ctx, query, dto.CompanyUUID, dto.Key)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	if affected == 0 {
+		return Error.Public(codes.NotFound, "custom field not found")
+	}
+	return Error.CodeError{}
+}
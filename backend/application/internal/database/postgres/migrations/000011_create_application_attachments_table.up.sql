@@ -0,0 +1,13 @@
+CREATE TABLE application_attachments (
+    uuid             UUID         PRIMARY KEY,
+    application_uuid UUID         NOT NULL REFERENCES applications(uuid) ON DELETE CASCADE,
+    fix_log_uuid     UUID         REFERENCES application_fix_logs(uuid) ON DELETE CASCADE,
+    object_key       TEXT         NOT NULL UNIQUE,
+    file_name        TEXT         NOT NULL,
+    content_type     TEXT         NOT NULL,
+    size             BIGINT       NOT NULL,
+    created_by       UUID         NOT NULL,
+    created_at       TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_application_attachments_application_uuid ON application_attachments(application_uuid);
@@ -0,0 +1,11 @@
+CREATE TABLE application_outbox (
+    uuid             UUID        PRIMARY KEY,
+    event_type       TEXT        NOT NULL,
+    application_uuid UUID        NOT NULL,
+    payload          JSONB       NOT NULL,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    published_at     TIMESTAMPTZ
+);
+
+CREATE INDEX idx_application_outbox_unpublished ON application_outbox(created_at, uuid) WHERE published_at IS NULL;
+CREATE INDEX idx_application_outbox_published_at ON application_outbox(published_at) WHERE published_at IS NOT NULL;
@@ -0,0 +1,6 @@
+CREATE TABLE application_workflows (
+    company_uuid UUID        PRIMARY KEY,
+    definition   JSONB       NOT NULL,
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_by   UUID        NOT NULL
+);
@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS application_sla_policies;
+
+DROP INDEX IF EXISTS idx_applications_sla_open;
+DROP INDEX IF EXISTS idx_applications_company_overdue;
+
+ALTER TABLE applications DROP COLUMN IF EXISTS overdue_at;
+ALTER TABLE applications DROP COLUMN IF EXISTS sla_breach;
+ALTER TABLE applications DROP COLUMN IF EXISTS due_at;
+ALTER TABLE applications DROP COLUMN IF EXISTS priority;
+
+DROP TYPE IF EXISTS application_priority;
@@ -0,0 +1,19 @@
+CREATE TYPE application_priority AS ENUM ('low', 'normal', 'high', 'critical');
+
+ALTER TABLE applications ADD COLUMN priority   application_priority NOT NULL DEFAULT 'normal';
+ALTER TABLE applications ADD COLUMN due_at     TIMESTAMPTZ;
+ALTER TABLE applications ADD COLUMN sla_breach TEXT;
+ALTER TABLE applications ADD COLUMN overdue_at TIMESTAMPTZ;
+
+CREATE INDEX idx_applications_company_overdue ON applications(company_uuid) WHERE sla_breach IS NOT NULL AND deleted_at IS NULL;
+CREATE INDEX idx_applications_sla_open        ON applications(created_at) WHERE closed_at IS NULL AND deleted_at IS NULL;
+
+CREATE TABLE application_sla_policies (
+    company_uuid              UUID                 NOT NULL,
+    priority                  application_priority NOT NULL,
+    assign_within_hours       INTEGER,
+    verification_within_hours INTEGER,
+    updated_at                TIMESTAMPTZ          NOT NULL DEFAULT NOW(),
+    updated_by                UUID                 NOT NULL,
+    PRIMARY KEY (company_uuid, priority)
+);
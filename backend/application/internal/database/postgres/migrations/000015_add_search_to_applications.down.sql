@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_applications_company_closed;
+DROP INDEX IF EXISTS idx_applications_company_created;
+DROP INDEX IF EXISTS idx_application_fix_logs_search;
+DROP INDEX IF EXISTS idx_applications_search;
+
+ALTER TABLE applications DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,10 @@
+ALTER TABLE applications ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian'::regconfig, title), 'A') ||
+    setweight(to_tsvector('russian'::regconfig, COALESCE(description, '')), 'B')
+) STORED;
+
+CREATE INDEX idx_applications_search       ON applications USING GIN (search_vector);
+CREATE INDEX idx_application_fix_logs_search ON application_fix_logs USING GIN (to_tsvector('russian'::regconfig, text));
+
+CREATE INDEX idx_applications_company_created ON applications(company_uuid, created_at) WHERE deleted_at IS NULL;
+CREATE INDEX idx_applications_company_closed  ON applications(company_uuid, closed_at)  WHERE closed_at IS NOT NULL;
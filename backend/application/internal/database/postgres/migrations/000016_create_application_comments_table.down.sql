@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS application_comment_edits;
+DROP TABLE IF EXISTS application_comments;
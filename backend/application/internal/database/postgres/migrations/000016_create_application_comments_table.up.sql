@@ -0,0 +1,27 @@
+CREATE TABLE application_comments (
+    uuid             UUID         PRIMARY KEY,
+    application_uuid UUID         NOT NULL REFERENCES applications(uuid) ON DELETE CASCADE,
+    parent_uuid      UUID         REFERENCES application_comments(uuid) ON DELETE CASCADE,
+    text             TEXT         NOT NULL,
+    mentioned_uuids  UUID[]       NOT NULL DEFAULT '{}',
+    created_by       UUID         NOT NULL,
+    created_at       TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    edited_at        TIMESTAMPTZ,
+    deleted_at       TIMESTAMPTZ,
+    deleted_by       UUID
+);
+
+CREATE INDEX idx_application_comments_threads ON application_comments(application_uuid, uuid) WHERE parent_uuid IS NULL;
+CREATE INDEX idx_application_comments_parent  ON application_comments(parent_uuid) WHERE parent_uuid IS NOT NULL;
+
+-- Предыдущие редакции комментариев: при каждом изменении сюда переносится заменяемый текст
+CREATE TABLE application_comment_edits (
+    uuid            UUID         PRIMARY KEY,
+    comment_uuid    UUID         NOT NULL REFERENCES application_comments(uuid) ON DELETE CASCADE,
+    text            TEXT         NOT NULL,
+    mentioned_uuids UUID[]       NOT NULL DEFAULT '{}',
+    edited_by       UUID         NOT NULL,
+    edited_at       TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_application_comment_edits_comment_uuid ON application_comment_edits(comment_uuid);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_applications_location_path;
+ALTER TABLE applications DROP COLUMN IF EXISTS location_path;
+ALTER TABLE applications DROP COLUMN IF EXISTS location_uuid;
@@ -0,0 +1,5 @@
+ALTER TABLE applications ADD COLUMN location_uuid UUID;
+-- Цепочка мест от объекта (site) до location_uuid включительно: фильтр по любому уровню иерархии
+ALTER TABLE applications ADD COLUMN location_path UUID[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX idx_applications_location_path ON applications USING GIN (location_path);
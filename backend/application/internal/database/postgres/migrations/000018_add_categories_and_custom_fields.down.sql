@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS idx_applications_custom_fields;
+DROP INDEX IF EXISTS idx_applications_category;
+
+ALTER TABLE applications DROP COLUMN IF EXISTS custom_fields;
+ALTER TABLE applications DROP COLUMN IF EXISTS severity;
+ALTER TABLE applications DROP COLUMN IF EXISTS category_uuid;
+
+DROP TABLE IF EXISTS application_custom_fields;
+DROP TABLE IF EXISTS application_categories;
+
+DROP TYPE IF EXISTS custom_field_type;
+DROP TYPE IF EXISTS application_severity;
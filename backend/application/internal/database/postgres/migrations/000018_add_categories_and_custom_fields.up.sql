@@ -0,0 +1,34 @@
+CREATE TYPE application_severity AS ENUM ('minor', 'moderate', 'major', 'critical');
+CREATE TYPE custom_field_type AS ENUM ('text', 'number', 'enum', 'date');
+
+CREATE TABLE application_categories (
+    uuid                    UUID         PRIMARY KEY,
+    company_uuid            UUID         NOT NULL,
+    title                   VARCHAR(255) NOT NULL,
+    default_department_uuid UUID,
+    created_at              TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    created_by              UUID         NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_application_categories_company_title ON application_categories(company_uuid, lower(title));
+
+-- Дополнительные поля заявок компании. Значения хранятся в applications.custom_fields по key
+CREATE TABLE application_custom_fields (
+    company_uuid UUID              NOT NULL,
+    key          VARCHAR(63)       NOT NULL,
+    title        VARCHAR(255)      NOT NULL,
+    type         custom_field_type NOT NULL,
+    options      TEXT[]            NOT NULL DEFAULT '{}',
+    required     BOOLEAN           NOT NULL DEFAULT FALSE,
+    updated_at   TIMESTAMPTZ       NOT NULL DEFAULT NOW(),
+    updated_by   UUID              NOT NULL,
+    PRIMARY KEY (company_uuid, key)
+);
+
+-- Категорию с заявками удалить нельзя: заявки и их версии продолжают на неё ссылаться
+ALTER TABLE applications ADD COLUMN category_uuid UUID REFERENCES application_categories(uuid);
+ALTER TABLE applications ADD COLUMN severity      application_severity;
+ALTER TABLE applications ADD COLUMN custom_fields JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX idx_applications_category      ON applications(category_uuid) WHERE category_uuid IS NOT NULL;
+CREATE INDEX idx_applications_custom_fields ON applications USING GIN (custom_fields jsonb_path_ops);
@@ -0,0 +1,208 @@
+package postgresDB
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+)
+
+// employeeOpenApplicationsWhere Открытые заявки, связанные с сотрудником через выбранные связи:
+// инженер - заявки в работе и на доработке, инспектор - заявки на проверке,
+// менеджер - все незакрытые заявки вне пула (в пуле ответственный менеджер не учитывается)
+const employeeOpenApplicationsWhere = `company_uuid = $1 AND deleted_at IS NULL
+	AND (NULLIF($3, '') IS NULL OR department_uuid = NULLIF($3, '')::uuid)
+	AND (
+	    ('executor' = ANY($4) AND executed_by = $2 AND status IN ('assigned', 'in_progress', 'on_hold', 'on_revision'))
+	 OR ('inspector' = ANY($4) AND inspected_by = $2 AND status = 'on_verification')
+	 OR ('manager' = ANY($4) AND managed_by = $2 AND status IN ('assigned', 'in_progress', 'on_hold', 'on_revision', 'pending_verification', 'on_verification'))
+	)`
+
+// GetEmployeeOpenApplications Открытые заявки сотрудника, которые затронет его удаление, перевод или смена роли
+func (r *applicationRepository) GetEmployeeOpenApplications(ctx context.Context, dto entities.GetEmployeeOpenApplicationsDTO) ([]*entities.Application, Error.CodeError) {
+	query := `
+		SELECT
+			uuid,
+			department_uuid,
+			title,
+			status,
+			COALESCE(managed_by::text, ''),
+			COALESCE(executed_by::text, ''),
+			COALESCE(inspected_by::text, '')
+		FROM applications
+		WHERE ` + employeeOpenApplicationsWhere + `
+		ORDER BY created_at, uuid;`
+
+	rows, err := r.db.QueryContext(ctx, query, dto.CompanyUUID, dto.UserUUID, dto.DepartmentUUID, pq.Array(dto.Relations))
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	defer rows.Close()
+
+	applications := make([]*entities.Application, 0)
+	for rows.Next() {
+		app := &entities.Application{CompanyUUID: dto.CompanyUUID}
+		if err = rows.Scan(&app.ApplicationUUID, &app.DepartmentUUID, &app.Title, &app.Status, &app.ManagedBy, &app.ExecutedBy, &app.InspectedBy); err != nil {
+			return nil, Error.Internal(err)
+		}
+		applications = append(applications, app)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, Error.Internal(err)
+	}
+
+	return applications, Error.CodeError{}
+}
+
+// OffboardEmployeeApplications Освобождение открытых заявок сотрудника.
+// Без ReassignTo заявки в работе отзываются, заявки на проверке возвращаются в очередь, ответственный менеджер снимается.
+// С ReassignTo связи сотрудника передаются коллеге без смены статуса (проверка роли коллеги - на стороне сервиса).
+// Каждая заявка получает fix log, новую версию и событие в outbox. Возвращает количество изменённых заявок
+func (r *applicationRepository) OffboardEmployeeApplications(ctx context.Context, dto entities.OffboardEmployeeApplicationsDTO) (int64, Error.CodeError) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, Error.Internal(err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	applicationUUIDs, err := selectEmployeeOpenApplications(ctx, tx, dto)
+	if err != nil {
+		return 0, Error.Internal(err)
+	}
+
+	var affected int64
+	for _, applicationUUID := range applicationUUIDs {
+		prev, err := r.saveVersion(ctx, tx, applicationUUID)
+		if err != nil {
+			return 0, Error.Internal(err)
+		}
+
+		next := offboardApplication(prev, dto)
+
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO application_fix_logs (uuid, application_uuid, text, created_by) VALUES ($1, $2, $3, $4)`,
+			uuid.Must(uuid.NewV7()).String(), applicationUUID, dto.FixLogText, dto.InitiatorUUID,
+		)
+		if err != nil {
+			return 0, Error.Internal(err)
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE applications
+			SET
+				version = version + 1,
+				status = $2::application_status,
+				updated_at = CURRENT_TIMESTAMP,
+				updated_by = $3,
+				managed_by = NULLIF($4, '')::uuid,
+				executed_by = NULLIF($5, '')::uuid,
+				inspected_by = NULLIF($6, '')::uuid
+			WHERE uuid = $1`,
+			applicationUUID, next.Status, dto.InitiatorUUID, next.ManagedBy, next.ExecutedBy, next.InspectedBy,
+		)
+		if err != nil {
+			return 0, Error.Internal(err)
+		}
+
+		if err = saveEvent(ctx, tx, prev, entities.ApplicationEvent{
+			EventType:          offboardingEventType(prev, next),
+			InitiatorUUID:      dto.InitiatorUUID,
+			Status:             next.Status,
+			PreviousStatus:     prev.Status,
+			ExecutedBy:         next.ExecutedBy,
+			PreviousExecutedBy: prev.ExecutedBy,
+			InspectedBy:        next.InspectedBy,
+			Comment:            dto.FixLogText,
+		}); err != nil {
+			return 0, Error.Internal(err)
+		}
+		affected++
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, Error.Internal(err)
+	}
+
+	return affected, Error.CodeError{}
+}
+
+// selectEmployeeOpenApplications Блокировка открытых заявок сотрудника в транзакции
+func selectEmployeeOpenApplications(ctx context.Context, tx *sql.Tx, dto entities.OffboardEmployeeApplicationsDTO) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT uuid
+		FROM applications
+		WHERE `+employeeOpenApplicationsWhere+`
+		ORDER BY uuid
+		FOR UPDATE`,
+		dto.CompanyUUID, dto.UserUUID, dto.DepartmentUUID, pq.Array(dto.Relations),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var applicationUUIDs []string
+	for rows.Next() {
+		var applicationUUID string
+		if err = rows.Scan(&applicationUUID); err != nil {
+			return nil, err
+		}
+		applicationUUIDs = append(applicationUUIDs, applicationUUID)
+	}
+	return applicationUUIDs, rows.Err()
+}
+
+// offboardApplication Состояние заявки после освобождения связей сотрудника
+func offboardApplication(prev *entities.Application, dto entities.OffboardEmployeeApplicationsDTO) entities.Application {
+	next := entities.Application{
+		Status:      prev.Status,
+		ManagedBy:   prev.ManagedBy,
+		ExecutedBy:  prev.ExecutedBy,
+		InspectedBy: prev.InspectedBy,
+	}
+
+	if slices.Contains(dto.Relations, entities.RelationExecutor) && prev.ExecutedBy == dto.UserUUID {
+		switch {
+		case prev.Status == "on_verification" || prev.Status == "pending_verification":
+			// Работа сдана - исполнитель остаётся в истории заявки
+		case dto.ReassignTo != "":
+			next.ExecutedBy = dto.ReassignTo
+		case prev.Status == "on_revision":
+			// Доработка остаётся в пуле - менеджер назначит другого инженера
+			next.ExecutedBy = ""
+		default:
+			next.Status, next.ExecutedBy = "recalled", ""
+		}
+	}
+
+	if slices.Contains(dto.Relations, entities.RelationInspector) && prev.InspectedBy == dto.UserUUID && prev.Status == "on_verification" {
+		if dto.ReassignTo != "" {
+			next.InspectedBy = dto.ReassignTo
+		} else {
+			next.Status, next.InspectedBy = "pending_verification", ""
+		}
+	}
+
+	if slices.Contains(dto.Relations, entities.RelationManager) && prev.ManagedBy == dto.UserUUID {
+		next.ManagedBy = dto.ReassignTo
+	}
+
+	return next
+}
+
+// offboardingEventType Тип события заявки после освобождения связей сотрудника
+func offboardingEventType(prev *entities.Application, next entities.Application) string {
+	switch {
+	case next.Status == "recalled" && prev.Status != "recalled":
+		return entities.EventApplicationRecalled
+	case next.Status == "pending_verification" && prev.Status == "on_verification":
+		return entities.EventApplicationVerificationReleased
+	default:
+		return entities.EventApplicationReassigned
+	}
+}
@@ -0,0 +1,134 @@
+package postgresDB
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/lib/pq"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+)
+
+// OutboxRepository Доступ к application_outbox для outbox worker-а.
+// События записываются в outbox в транзакциях ApplicationRepository (saveEvent).
+type OutboxRepository interface {
+	AcquirePublisherLock(ctx context.Context) (release func(), acquired bool, err Error.CodeError)
+	GetPendingEvents(ctx context.Context, dto entities.GetPendingOutboxEventsDTO) ([]*entities.OutboxEvent, Error.CodeError)
+	MarkEventsPublished(ctx context.Context, dto entities.MarkOutboxEventsPublishedDTO) Error.CodeError
+	DeletePublishedEvents(ctx context.Context, dto entities.DeletePublishedOutboxEventsDTO) (int64, Error.CodeError)
+}
+
+type outboxRepository struct {
+	db *sql.DB
+}
+
+func NewOutboxRepository(db *sql.DB) OutboxRepository {
+	return &outboxRepository{db: db}
+}
+
+// outboxPublisherLockKey Ключ advisory lock-а публикации outbox-а
+const outboxPublisherLockKey int64 = 0x6f7574626f78 // "outbox"
+
+// AcquirePublisherLock Захват блокировки публикации outbox-а. Публикует только один экземпляр сервиса:
+// пачки событий, отправленные параллельно, нарушили бы порядок событий заявки. Блокировка сессионная и
+// держится на отдельном соединении - если экземпляр упадёт, она освободится вместе с соединением.
+// acquired = false - события публикует другой экземпляр
+func (r *outboxRepository) AcquirePublisherLock(ctx context.Context) (func(), bool, Error.CodeError) {
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return nil, false, Error.Internal(err)
+	}
+
+	var acquired bool
+	if err = conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock($1);`, outboxPublisherLockKey).Scan(&acquired); err != nil {
+		conn.Close()
+		return nil, false, Error.Internal(err)
+	}
+	if !acquired {
+		conn.Close()
+		return nil, false, Error.CodeError{}
+	}
+
+	release := func() {
+		// Отмена ctx worker-а не должна оставлять блокировку на соединении, возвращаемом в пул
+		if _, unlockErr := conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1);`, outboxPublisherLockKey); unlockErr != nil {
+			// Соединение с неснятой блокировкой не возвращаем в пул
+			conn.Raw(func(any) error { return driver.ErrBadConn })
+		}
+		conn.Close()
+	}
+	return release, true, Error.CodeError{}
+}
+
+// GetPendingEvents Получение неопубликованных событий в порядке их записи
+func (r *outboxRepository) GetPendingEvents(ctx context.Context, dto entities.GetPendingOutboxEventsDTO) ([]*entities.OutboxEvent, Error.CodeError) {
+	query := `SELECT
+			uuid,
+			event_type,
+			application_uuid,
+			payload,
+			created_at::text
+		FROM application_outbox
+		WHERE published_at IS NULL
+		ORDER BY created_at, uuid
+		LIMIT $1;`
+
+	rows, err := r.db.QueryContext(ctx, query, dto.Count)
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	defer rows.Close()
+
+	events := make([]*entities.OutboxEvent, 0)
+	for rows.Next() {
+		item := &entities.OutboxEvent{}
+		err = rows.Scan(
+			&item.UUID,
+			&item.EventType,
+			&item.ApplicationUUID,
+			&item.Payload,
+			&item.CreatedAt,
+		)
+		if err != nil {
+			return nil, Error.Internal(err)
+		}
+		events = append(events, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, Error.Internal(err)
+	}
+
+	return events, Error.CodeError{}
+}
+
+// MarkEventsPublished Отметка событий как опубликованных
+func (r *outboxRepository) MarkEventsPublished(ctx context.Context, dto entities.MarkOutboxEventsPublishedDTO) Error.CodeError {
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE application_outbox SET published_at = CURRENT_TIMESTAMP WHERE uuid = ANY($1::uuid[]) AND published_at IS NULL;`,
+		pq.Array(dto.EventUUIDs),
+	)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// DeletePublishedEvents Удаление опубликованных событий старше указанного момента
+func (r *outboxRepository) DeletePublishedEvents(ctx context.Context, dto entities.DeletePublishedOutboxEventsDTO) (int64, Error.CodeError) {
+	res, err := r.db.ExecContext(ctx,
+		`DELETE FROM application_outbox WHERE published_at IS NOT NULL AND published_at < $1;`,
+		dto.PublishedBefore,
+	)
+	if err != nil {
+		return 0, Error.Internal(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, Error.Internal(err)
+	}
+
+	return affected, Error.CodeError{}
+}
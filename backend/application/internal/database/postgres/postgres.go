@@ -18,6 +18,14 @@ var migrationsFS embed.FS
 
 type DatabaseRepository struct {
 	ApplicationRepository ApplicationRepository
+	AttachmentRepository  AttachmentRepository
+	CommentRepository     CommentRepository
+	OutboxRepository      OutboxRepository
+	WorkflowRepository    WorkflowRepository
+	SLARepository         SLARepository
+	CategoryRepository    CategoryRepository
+	CustomFieldRepository CustomFieldRepository
+	AnalyticsRepository   AnalyticsRepository
 	db                    *sql.DB
 }
 
@@ -25,6 +33,11 @@ func (r *DatabaseRepository) Ping(ctx context.Context) error {
 	return r.db.PingContext(ctx)
 }
 
+// Close Закрывает пул соединений. Новые запросы отклоняются, начатые запросы и транзакции дожидаются завершения.
+func (r *DatabaseRepository) Close() error {
+	return r.db.Close()
+}
+
 func NewDatabaseInstance(connectString string) *DatabaseRepository {
 	db := sharedPostgres.Connect(connectString)
 
@@ -51,6 +64,14 @@ func NewDatabaseInstance(connectString string) *DatabaseRepository {
 
 	return &DatabaseRepository{
 		ApplicationRepository: NewApplicationRepository(db),
+		AttachmentRepository:  NewAttachmentRepository(db),
+		CommentRepository:     NewCommentRepository(db),
+		OutboxRepository:      NewOutboxRepository(db),
+		WorkflowRepository:    NewWorkflowRepository(db),
+		SLARepository:         NewSLARepository(db),
+		CategoryRepository:    NewCategoryRepository(db),
+		CustomFieldRepository: NewCustomFieldRepository(db),
+		AnalyticsRepository:   NewAnalyticsRepository(db),
 		db:                    db,
 	}
 }
@@ -0,0 +1,98 @@
+package postgresDB
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+// SLARepository Политики SLA компаний. Приоритет без политики сроков не имеет.
+type SLARepository interface {
+	GetCompanySLAPolicies(ctx context.Context, dto entities.GetCompanySLAPoliciesDTO) ([]*entities.SLAPolicy, Error.CodeError)
+	SaveSLAPolicy(ctx context.Context, dto entities.SaveSLAPolicyDTO) Error.CodeError
+	DeleteSLAPolicy(ctx context.Context, dto entities.DeleteSLAPolicyDTO) Error.CodeError
+}
+
+type slaRepository struct {
+	db *sql.DB
+}
+
+func NewSLARepository(db *sql.DB) SLARepository {
+	return &slaRepository{db: db}
+}
+
+// GetCompanySLAPolicies Получение политик SLA компании в порядке приоритетов
+func (r *slaRepository) GetCompanySLAPolicies(ctx context.Context, dto entities.GetCompanySLAPoliciesDTO) ([]*entities.SLAPolicy, Error.CodeError) {
+	query := `SELECT
+			priority,
+			COALESCE(assign_within_hours, 0),
+			COALESCE(verification_within_hours, 0),
+			updated_at::text,
+			updated_by
+		FROM application_sla_policies
+		WHERE company_uuid = $1
+		ORDER BY priority;`
+
+	rows, err := r.db.QueryContext(ctx, query, dto.CompanyUUID)
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	defer rows.Close()
+
+	policies := make([]*entities.SLAPolicy, 0)
+	for rows.Next() {
+		policy := &entities.SLAPolicy{CompanyUUID: dto.CompanyUUID}
+		err = rows.Scan(&policy.Priority, &policy.AssignWithinHours, &policy.VerificationWithinHours, &policy.UpdatedAt, &policy.UpdatedBy)
+		if err != nil {
+			return nil, Error.Internal(err)
+		}
+		policies = append(policies, policy)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, Error.Internal(err)
+	}
+
+	return policies, Error.CodeError{}
+}
+
+// SaveSLAPolicy Создание или замена политики SLA для приоритета
+func (r *slaRepository) SaveSLAPolicy(ctx context.Context, dto entities.SaveSLAPolicyDTO) Error.CodeError {
+	query := `INSERT INTO application_sla_policies
+		(company_uuid, priority, assign_within_hours, verification_within_hours, updated_by) VALUES
+		($1, $2::application_priority, NULLIF($3, 0), NULLIF($4, 0), $5)
+		ON CONFLICT (company_uuid, priority) DO UPDATE SET
+			assign_within_hours = EXCLUDED.assign_within_hours,
+			verification_within_hours = EXCLUDED.verification_within_hours,
+			updated_at = CURRENT_TIMESTAMP,
+			updated_by = EXCLUDED.updated_by;`
+
+	_, err := r.db.ExecContext(ctx, query, dto.CompanyUUID, dto.Priority, dto.AssignWithinHours, dto.VerificationWithinHours, dto.UpdatedBy)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// DeleteSLAPolicy Удаление политики SLA приоритета
+func (r *slaRepository) DeleteSLAPolicy(ctx context.Context, dto entities.DeleteSLAPolicyDTO) Error.CodeError {
+	query := `DELETE FROM application_sla_policies WHERE company_uuid = $1 AND priority = $2::application_priority;`
+
+	res, err := r.db.ExecContext(ctx, query, dto.CompanyUUID, dto.Priority)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	if affected == 0 {
+		return Error.Public(codes.NotFound, "sla policy not found")
+	}
+	return Error.CodeError{}
+}
@@ -0,0 +1,64 @@
+package postgresDB
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+// WorkflowRepository Хранение workflow заявок, настроенных компаниями.
+// Отсутствие записи означает workflow по умолчанию (workflow.Default).
+type WorkflowRepository interface {
+	GetCompanyWorkflow(ctx context.Context, dto entities.GetCompanyWorkflowDTO) (*entities.CompanyWorkflow, Error.CodeError)
+	SaveCompanyWorkflow(ctx context.Context, dto entities.SaveCompanyWorkflowDTO) Error.CodeError
+	DeleteCompanyWorkflow(ctx context.Context, dto entities.DeleteCompanyWorkflowDTO) Error.CodeError
+}
+
+type workflowRepository struct {
+	db *sql.DB
+}
+
+func NewWorkflowRepository(db *sql.DB) WorkflowRepository {
+	return &workflowRepository{db: db}
+}
+
+// GetCompanyWorkflow Получение workflow компании
+func (r *workflowRepository) GetCompanyWorkflow(ctx context.Context, dto entities.GetCompanyWorkflowDTO) (*entities.CompanyWorkflow, Error.CodeError) {
+	query := `SELECT definition, updated_at::text, updated_by FROM application_workflows WHERE company_uuid = $1;`
+
+	wf := &entities.CompanyWorkflow{CompanyUUID: dto.CompanyUUID}
+	err := r.db.QueryRowContext(ctx, query, dto.CompanyUUID).Scan(&wf.Definition, &wf.UpdatedAt, &wf.UpdatedBy)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, Error.Public(codes.NotFound, "company workflow not found")
+		}
+		return nil, Error.Internal(err)
+	}
+	return wf, Error.CodeError{}
+}
+
+// SaveCompanyWorkflow Создание или замена workflow компании
+func (r *workflowRepository) SaveCompanyWorkflow(ctx context.Context, dto entities.SaveCompanyWorkflowDTO) Error.CodeError {
+	query := `INSERT INTO application_workflows (company_uuid, definition, updated_by) VALUES ($1, $2, $3)
+		ON CONFLICT (company_uuid) DO UPDATE SET
+			definition = EXCLUDED.definition,
+			updated_at = CURRENT_TIMESTAMP,
+			updated_by = EXCLUDED.updated_by;`
+
+	if _, err := r.db.ExecContext(ctx, query, dto.CompanyUUID, dto.Definition, dto.UpdatedBy); err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// DeleteCompanyWorkflow Удаление workflow компании (возврат к workflow по умолчанию)
+func (r *workflowRepository) DeleteCompanyWorkflow(ctx context.Context, dto entities.DeleteCompanyWorkflowDTO) Error.CodeError {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM application_workflows WHERE company_uuid = $1;`, dto.CompanyUUID); err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
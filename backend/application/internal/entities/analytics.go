@@ -0,0 +1,51 @@
+package entities
+
+// AnalyticsFilterDTO Выборка заявок компании для аналитики: созданные в [CreatedFrom, CreatedTo), без удалённых
+type AnalyticsFilterDTO struct {
+	CompanyUUID    string
+	DepartmentUUID string // пусто - вся компания
+	CreatedFrom    string // RFC3339 или YYYY-MM-DD, пусто - без ограничения
+	CreatedTo      string
+}
+
+type GetStatusStatsDTO struct {
+	Filter   AnalyticsFilterDTO
+	Interval string // day, week, month
+}
+
+// StatusStat Кол-во заявок в статусе, созданных за период, по отделам
+type StatusStat struct {
+	Period         string
+	DepartmentUUID string
+	Status         string
+	Count          int64
+}
+
+// LeadTime Среднее время этапа обработки заявок в секундах
+type LeadTime struct {
+	MeanSeconds float64
+	Samples     int64
+}
+
+// LeadTimes Время этапов обработки заявок, восстановленное по снапшотам application_versions
+type LeadTimes struct {
+	Assign LeadTime // создание -> первое назначение инженера
+	Fix    LeadTime // первое назначение -> первая отправка на проверку
+	Verify LeadTime // последняя отправка на проверку -> закрытие (completed/failed)
+}
+
+// EngineerRevisionStat Доработки заявок инженера (по текущему executed_by)
+type EngineerRevisionStat struct {
+	EngineerUUID        string
+	Applications        int64
+	RevisedApplications int64
+	Revisions           int64
+}
+
+// DepartmentFailureStat Исход закрытых заявок отдела
+type DepartmentFailureStat struct {
+	DepartmentUUID string
+	Closed         int64
+	Completed      int64
+	Failed         int64
+}
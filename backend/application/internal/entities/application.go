@@ -1,24 +1,33 @@
 package entities
 
 type Application struct {
-	ApplicationUUID string `db:"uuid" json:"uuid"`
-	CompanyUUID     string `db:"company_uuid" json:"company_uuid"`
-	DepartmentUUID  string `db:"department_uuid" json:"department_uuid"`
-	Version         int64  `db:"version" json:"version"`
-	Title           string `db:"title" json:"title"`
-	Description     string `db:"description" json:"description"`
-	Status          string `db:"status" json:"status"`
-	RevisionCount   int64  `db:"revision_count" json:"revision_count"`
-	CreatedAt       string `db:"created_at" json:"created_at"`
-	CreatedBy       string `db:"created_by" json:"created_by"`
-	UpdatedAt       string `db:"updated_at" json:"updated_at"`
-	UpdatedBy       string `db:"updated_by" json:"updated_by"`
-	ManagedBy       string `db:"managed_by" json:"managed_by"`
-	ExecutedBy      string `db:"executed_by" json:"executed_by"`
-	InspectedBy     string `db:"inspected_by" json:"inspected_by"`
-	ClosedAt        string `db:"closed_at" json:"closed_at"`
-	DeletedAt       string `db:"deleted_at" json:"deleted_at"`
-	DeletedBy       string `db:"deleted_by" json:"deleted_by"`
+	ApplicationUUID string            `db:"uuid" json:"uuid"`
+	CompanyUUID     string            `db:"company_uuid" json:"company_uuid"`
+	DepartmentUUID  string            `db:"department_uuid" json:"department_uuid"`
+	Version         int64             `db:"version" json:"version"`
+	Title           string            `db:"title" json:"title"`
+	Description     string            `db:"description" json:"description"`
+	Status          string            `db:"status" json:"status"`
+	RevisionCount   int64             `db:"revision_count" json:"revision_count"`
+	CreatedAt       string            `db:"created_at" json:"created_at"`
+	CreatedBy       string            `db:"created_by" json:"created_by"`
+	UpdatedAt       string            `db:"updated_at" json:"updated_at"`
+	UpdatedBy       string            `db:"updated_by" json:"updated_by"`
+	ManagedBy       string            `db:"managed_by" json:"managed_by"`
+	ExecutedBy      string            `db:"executed_by" json:"executed_by"`
+	InspectedBy     string            `db:"inspected_by" json:"inspected_by"`
+	ClosedAt        string            `db:"closed_at" json:"closed_at"`
+	DeletedAt       string            `db:"deleted_at" json:"deleted_at"`
+	DeletedBy       string            `db:"deleted_by" json:"deleted_by"`
+	Priority        string            `db:"priority" json:"priority"`
+	DueAt           string            `db:"due_at" json:"due_at"`
+	SLABreach       string            `db:"sla_breach" json:"sla_breach"` // нарушенный срок SLA (SLABreachAssign, SLABreachFix), пусто - без нарушений
+	OverdueAt       string            `db:"overdue_at" json:"overdue_at"`
+	LocationUUID    string            `db:"location_uuid" json:"location_uuid"`
+	LocationPath    []string          `db:"location_path" json:"location_path"` // место дефекта и все вышестоящие места, от объекта (site)
+	CategoryUUID    string            `db:"category_uuid" json:"category_uuid"`
+	Severity        string            `db:"severity" json:"severity"`
+	CustomFields    map[string]string `db:"custom_fields" json:"custom_fields"` // значения дополнительных полей по key
 }
 
 type CreateApplicationDTO struct {
@@ -27,6 +36,13 @@ type CreateApplicationDTO struct {
 	DepartmentUUID  string
 	Title           string
 	Description     string
+	Priority        string
+	DueAt           string   // RFC3339, необязательно
+	LocationUUID    string   // Необязательно
+	LocationPath    []string // Цепочка мест от объекта до LocationUUID включительно
+	CategoryUUID    string   // Необязательно
+	Severity        string   // Необязательно
+	CustomFields    map[string]string
 	CreatedBy       string
 }
 
@@ -46,12 +62,33 @@ type GetApplicationsDTO struct {
 	Count            int64
 	Offset           int64
 	IsDeleted        bool
+	IsOverdue        bool // Только заявки с нарушенным SLA
+
+	// Фильтры из запроса - накладываются поверх ролевых ограничений выше
+	Search           string // Полнотекстовый поиск по названию, описанию и fix log-ам
+	CreatedFrom      string
+	CreatedTo        string
+	ClosedFrom       string
+	ClosedTo         string
+	Creator          string
+	Executor         string
+	Manager          string
+	RevisionCountMin *int64
+	RevisionCountMax *int64
+	LocationUUID     string // Заявки в этом месте и во вложенных местах
+	CategoryUUID     string
+	Severities       []string
+	CustomFields     map[string]string // Точное совпадение значений всех указанных полей
+	SortBy           string            // Одно из validate.ApplicationSortFields, по умолчанию created_at
+	SortOrder        string            // asc / desc, по умолчанию desc
+	AfterUUID        string            // Курсор: uuid последней заявки предыдущей страницы
 }
 
 type UpdateApplicationStatusDTO struct {
 	ApplicationUUID string
 	InitiatorUUID   string
 	Status          string
+	FixLogText      string // Если указано - сообщение сохраняется в fix log заявки
 }
 
 type AssignApplicationDTO struct {
@@ -94,4 +131,22 @@ type GetApplicationHistoryDTO struct {
 	ApplicationUUID string
 	Offset          int64
 	Count           int64
+	BeforeVersion   int64 // Курсор: версия последней записи предыдущей страницы (0 - с начала)
+}
+
+type GetApplicationVersionDTO struct {
+	ApplicationUUID string
+	Version         int64
+}
+
+// StatusChange Переход заявки в статус, восстановленный по снапшотам application_versions
+type StatusChange struct {
+	ApplicationUUID string
+	Status          string
+	ChangedAt       string
+	ChangedBy       string
+}
+
+type GetStatusTimelineDTO struct {
+	ApplicationUUIDs []string
 }
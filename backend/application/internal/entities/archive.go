@@ -0,0 +1,28 @@
+package entities
+
+import "io"
+
+// ArchiveObject Архив заявок удалённой компании в хранилище
+type ArchiveObject struct {
+	ObjectKey string
+	Archived  int64 // кол-во заявок в архиве
+	Size      int64
+}
+
+type PutArchiveObjectDTO struct {
+	ObjectKey string
+	Content   io.Reader // JSON Lines, по одной выгруженной заявке на строку
+	Archived  int64
+}
+
+type GetArchiveObjectDTO struct {
+	ObjectKey string
+}
+
+type GetCompanyAttachmentKeysDTO struct {
+	CompanyUUID string
+}
+
+type PurgeCompanyApplicationsDTO struct {
+	CompanyUUID string
+}
@@ -0,0 +1,52 @@
+package entities
+
+type Attachment struct {
+	UUID            string `db:"uuid"`
+	ApplicationUUID string `db:"application_uuid"`
+	FixLogUUID      string `db:"fix_log_uuid"`
+	ObjectKey       string `db:"object_key"`
+	FileName        string `db:"file_name"`
+	ContentType     string `db:"content_type"`
+	Size            int64  `db:"size"`
+	CreatedAt       string `db:"created_at"`
+	CreatedBy       string `db:"created_by"`
+}
+
+type CreateAttachmentDTO struct {
+	AttachmentUUID  string
+	ApplicationUUID string
+	FixLogUUID      string // Если пусто - вложение относится к самой заявке
+	ObjectKey       string
+	FileName        string
+	ContentType     string
+	Size            int64
+	CreatedBy       string
+}
+
+type GetAttachmentDTO struct {
+	AttachmentUUID  string
+	ApplicationUUID string
+}
+
+type GetApplicationAttachmentsDTO struct {
+	ApplicationUUID string
+}
+
+type DeleteAttachmentDTO struct {
+	AttachmentUUID  string
+	ApplicationUUID string
+}
+
+type PutAttachmentObjectDTO struct {
+	ObjectKey   string
+	ContentType string
+	Content     []byte
+}
+
+type GetAttachmentObjectDTO struct {
+	ObjectKey string
+}
+
+type DeleteAttachmentObjectDTO struct {
+	ObjectKey string
+}
@@ -0,0 +1,68 @@
+package entities
+
+// Category Категория дефектов компании. Заявки категории попадают в DefaultDepartmentUUID, если он указан
+type Category struct {
+	UUID                  string
+	CompanyUUID           string
+	Title                 string
+	DefaultDepartmentUUID string
+	CreatedAt             string
+	CreatedBy             string
+}
+
+// CustomField Дополнительное поле заявок компании. Значения хранятся в Application.CustomFields по Key
+type CustomField struct {
+	CompanyUUID string
+	Key         string
+	Title       string
+	Type        string   // Одно из validate.CustomFieldTypes
+	Options     []string // Варианты значения для enum
+	Required    bool
+	UpdatedAt   string
+	UpdatedBy   string
+}
+
+type CreateCategoryDTO struct {
+	UUID                  string
+	CompanyUUID           string
+	Title                 string
+	DefaultDepartmentUUID string // Необязательно
+	CreatedBy             string
+}
+
+type GetCategoryDTO struct {
+	CategoryUUID string
+}
+
+type GetCompanyCategoriesDTO struct {
+	CompanyUUID string
+}
+
+type UpdateCategoryDTO struct {
+	CategoryUUID          string
+	Title                 string
+	DefaultDepartmentUUID string // Пусто - снять департамент по умолчанию
+}
+
+type DeleteCategoryDTO struct {
+	CategoryUUID string
+}
+
+type GetCompanyCustomFieldsDTO struct {
+	CompanyUUID string
+}
+
+type SaveCustomFieldDTO struct {
+	CompanyUUID string
+	Key         string
+	Title       string
+	Type        string
+	Options     []string
+	Required    bool
+	UpdatedBy   string
+}
+
+type DeleteCustomFieldDTO struct {
+	CompanyUUID string
+	Key         string
+}
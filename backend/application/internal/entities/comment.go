@@ -0,0 +1,71 @@
+package entities
+
+type Comment struct {
+	UUID            string   `db:"uuid"`
+	ApplicationUUID string   `db:"application_uuid"`
+	ParentUUID      string   `db:"parent_uuid"`
+	Text            string   `db:"text"`
+	MentionedUUIDs  []string `db:"mentioned_uuids"`
+	CreatedAt       string   `db:"created_at"`
+	CreatedBy       string   `db:"created_by"`
+	EditedAt        string   `db:"edited_at"`
+	DeletedAt       string   `db:"deleted_at"`
+	DeletedBy       string   `db:"deleted_by"`
+}
+
+// CommentEdit Предыдущая редакция комментария
+type CommentEdit struct {
+	UUID           string   `db:"uuid"`
+	CommentUUID    string   `db:"comment_uuid"`
+	Text           string   `db:"text"`
+	MentionedUUIDs []string `db:"mentioned_uuids"`
+	EditedAt       string   `db:"edited_at"`
+	EditedBy       string   `db:"edited_by"`
+}
+
+type CreateCommentDTO struct {
+	CommentUUID     string
+	ApplicationUUID string
+	ParentUUID      string // Если пусто - комментарий верхнего уровня
+	Text            string
+	MentionedUUIDs  []string
+	CreatedBy       string
+}
+
+type GetCommentDTO struct {
+	CommentUUID     string
+	ApplicationUUID string
+}
+
+type GetCommentThreadsDTO struct {
+	ApplicationUUID string
+	Offset          int64
+	Count           int64
+	AfterUUID       string // keyset-пагинация: ветки после комментария с этим UUID
+}
+
+type GetCommentRepliesDTO struct {
+	ParentUUIDs []string
+}
+
+type CountCommentThreadsDTO struct {
+	ApplicationUUID string
+}
+
+type UpdateCommentDTO struct {
+	CommentUUID     string
+	ApplicationUUID string
+	Text            string
+	MentionedUUIDs  []string
+	EditedBy        string
+}
+
+type DeleteCommentDTO struct {
+	CommentUUID     string
+	ApplicationUUID string
+	DeletedBy       string
+}
+
+type GetCommentEditsDTO struct {
+	CommentUUID string
+}
@@ -0,0 +1,74 @@
+package entities
+
+import "time"
+
+// ApplicationEventVersion Версия схемы доменных событий заявки.
+// Увеличивается при несовместимом изменении ApplicationEvent.
+const ApplicationEventVersion = 1
+
+// Типы доменных событий заявки (используются как routing key в exchange application.events)
+const (
+	EventApplicationCreated              = "application.created"
+	EventApplicationAssigned             = "application.assigned"
+	EventApplicationRedirected           = "application.redirected"
+	EventApplicationRecalled             = "application.recalled"
+	EventApplicationVerificationTaken    = "application.verification_taken"
+	EventApplicationVerificationReleased = "application.verification_released"
+	EventApplicationStatusChanged        = "application.status_changed"
+	EventApplicationReassigned           = "application.reassigned" // смена ответственных при уходе сотрудника
+	EventApplicationDeleted              = "application.deleted"
+	EventApplicationOverdue              = "application.overdue"          // эскалация: нарушен срок SLA
+	EventApplicationOverdueResolved      = "application.overdue_resolved" // нарушение SLA снято
+
+	// EventCommentCreated Новый комментарий заявки. Заявку не меняет - Version остаётся текущей версией заявки
+	EventCommentCreated = "comment.created"
+)
+
+// ApplicationEvent Доменное событие заявки, публикуемое через outbox
+type ApplicationEvent struct {
+	EventUUID       string `json:"event_uuid"`
+	EventType       string `json:"event_type"`
+	EventVersion    int    `json:"event_version"`
+	OccurredAt      string `json:"occurred_at"`
+	ApplicationUUID string `json:"application_uuid"`
+	CompanyUUID     string `json:"company_uuid"`
+	DepartmentUUID  string `json:"department_uuid"`
+	Version         int64  `json:"version"` // версия заявки после изменения
+	InitiatorUUID   string `json:"initiator_uuid"`
+
+	Status                 string `json:"status"`
+	PreviousStatus         string `json:"previous_status,omitempty"`
+	PreviousDepartmentUUID string `json:"previous_department_uuid,omitempty"`
+	ExecutedBy             string `json:"executed_by,omitempty"`
+	PreviousExecutedBy     string `json:"previous_executed_by,omitempty"`
+	InspectedBy            string `json:"inspected_by,omitempty"`
+	Comment                string `json:"comment,omitempty"`
+	Priority               string `json:"priority,omitempty"`
+	DueAt                  string `json:"due_at,omitempty"`
+	SLABreach              string `json:"sla_breach,omitempty"`
+
+	CommentUUID       string   `json:"comment_uuid,omitempty"`
+	ParentCommentUUID string   `json:"parent_comment_uuid,omitempty"`
+	MentionedUUIDs    []string `json:"mentioned_uuids,omitempty"`
+}
+
+// OutboxEvent Запись outbox-а, ожидающая публикации в брокер
+type OutboxEvent struct {
+	UUID            string
+	EventType       string
+	ApplicationUUID string
+	Payload         []byte
+	CreatedAt       string
+}
+
+type GetPendingOutboxEventsDTO struct {
+	Count int64
+}
+
+type MarkOutboxEventsPublishedDTO struct {
+	EventUUIDs []string
+}
+
+type DeletePublishedOutboxEventsDTO struct {
+	PublishedBefore time.Time
+}
@@ -9,6 +9,7 @@ type FixLog struct {
 }
 
 type AddFixLogDTO struct {
+	FixLogUUID      string
 	ApplicationUUID string
 	Text            string
 	CreatedBy       string
@@ -17,3 +18,7 @@ type AddFixLogDTO struct {
 type GetApplicationFixLogsDTO struct {
 	ApplicationUUID string
 }
+
+type GetFixLogsByApplicationsDTO struct {
+	ApplicationUUIDs []string
+}
@@ -0,0 +1,25 @@
+package entities
+
+// Типы событий изменения состава компании, публикуемых company сервисом в exchange company.membership
+const (
+	EventEmployeeRoleChanged       = "employee.role_changed"
+	EventEmployeeRemoved           = "employee.removed"
+	EventEmployeeLeft              = "employee.left"
+	EventEmployeeDepartmentChanged = "employee.department_changed"
+	EventDepartmentDeleted         = "department.deleted"
+	EventCompanyDeleted            = "company.deleted"
+)
+
+// MembershipEvent Событие изменения роли или отдела сотрудника, удаления отдела или компании
+type MembershipEvent struct {
+	EventType      string `json:"event_type"`
+	CompanyUUID    string `json:"company_uuid"`
+	UserUUID       string `json:"user_uuid,omitempty"`
+	DepartmentUUID string `json:"department_uuid,omitempty"`
+	Role           string `json:"role,omitempty"` // новая роль (employee.role_changed)
+
+	// Обработка открытых заявок сотрудника, выбранная chief при изменении (см. HandleEmployeeOffboarding)
+	InitiatorUUID    string `json:"initiator_uuid,omitempty"`
+	OpenApplications string `json:"open_applications,omitempty"`
+	ReassignTo       string `json:"reassign_to,omitempty"`
+}
@@ -0,0 +1,35 @@
+package entities
+
+// Связи сотрудника с открытой заявкой
+const (
+	RelationManager   = "manager"   // ответственный менеджер (managed_by)
+	RelationExecutor  = "executor"  // назначенный инженер (executed_by) в работе или на доработке
+	RelationInspector = "inspector" // инспектор, проверяющий заявку (inspected_by)
+)
+
+// Relations Все связи сотрудника с заявкой
+var Relations = []string{RelationManager, RelationExecutor, RelationInspector}
+
+// Политики обработки открытых заявок сотрудника при удалении, переводе или смене роли
+const (
+	OffboardingBlock    = "block"    // изменение запрещено, пока у сотрудника есть открытые заявки
+	OffboardingPool     = "pool"     // заявки возвращаются в пул отдела
+	OffboardingReassign = "reassign" // заявки переназначаются на указанного коллегу
+)
+
+type GetEmployeeOpenApplicationsDTO struct {
+	CompanyUUID    string
+	UserUUID       string
+	DepartmentUUID string   // пустой - все отделы
+	Relations      []string // учитываемые связи сотрудника с заявкой
+}
+
+type OffboardEmployeeApplicationsDTO struct {
+	CompanyUUID    string
+	UserUUID       string
+	DepartmentUUID string   // пустой - все отделы
+	Relations      []string // освобождаемые связи сотрудника с заявкой
+	ReassignTo     string   // пустой - возврат в пул отдела
+	InitiatorUUID  string
+	FixLogText     string
+}
@@ -0,0 +1,42 @@
+package entities
+
+// Нарушенные сроки SLA
+const (
+	SLABreachAssign = "assign" // заявка не назначена инженеру в срок
+	SLABreachFix    = "fix"    // заявка не передана на проверку в срок (policy или due_at)
+)
+
+// SLAPolicy Сроки обработки заявок компании для одного приоритета. 0 - без ограничения
+type SLAPolicy struct {
+	CompanyUUID             string
+	Priority                string
+	AssignWithinHours       int32
+	VerificationWithinHours int32
+	UpdatedAt               string
+	UpdatedBy               string
+}
+
+type GetCompanySLAPoliciesDTO struct {
+	CompanyUUID string
+}
+
+type SaveSLAPolicyDTO struct {
+	CompanyUUID             string
+	Priority                string
+	AssignWithinHours       int32
+	VerificationWithinHours int32
+	UpdatedBy               string
+}
+
+type DeleteSLAPolicyDTO struct {
+	CompanyUUID string
+	Priority    string
+}
+
+type GetSLAChangesDTO struct {
+	Count int64
+}
+
+type UpdateApplicationSLABreachDTO struct {
+	ApplicationUUID string
+}
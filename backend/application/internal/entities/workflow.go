@@ -0,0 +1,28 @@
+package entities
+
+// CompanyWorkflow Настроенный компанией workflow заявок (JSON workflow.Machine)
+type CompanyWorkflow struct {
+	CompanyUUID string
+	Definition  []byte
+	UpdatedAt   string
+	UpdatedBy   string
+}
+
+type GetCompanyWorkflowDTO struct {
+	CompanyUUID string
+}
+
+type SaveCompanyWorkflowDTO struct {
+	CompanyUUID string
+	Definition  []byte
+	UpdatedBy   string
+}
+
+type DeleteCompanyWorkflowDTO struct {
+	CompanyUUID string
+}
+
+type CountApplicationsDTO struct {
+	CompanyUUID string
+	Statuses    []string
+}
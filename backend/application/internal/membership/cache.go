@@ -0,0 +1,236 @@
+package membership
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+)
+
+// Cache Кеш ролей и отделов сотрудников и принадлежности отделов компаниям (LRU с TTL).
+//
+// Записи сбрасываются по событиям company.membership, которые company сервис публикует
+// при изменении роли или отдела сотрудника, удалении сотрудника, отдела или компании.
+// TTL ограничивает устаревание, если событие потеряно; при переподключении к брокеру кеш очищается целиком,
+// так как события за время разрыва не доставляются.
+//
+// Ответы с ошибкой (сотрудник не найден, нет доступа) не кешируются: вступление в компанию
+// видно сразу, без события.
+type Cache struct {
+	ttl      time.Duration
+	capacity int
+
+	mu    sync.Mutex
+	items map[string]*list.Element
+	order *list.List // от недавно использованных к давно использованным
+
+	// generation Увеличивается при каждом сбросе. Значение, загруженное до сброса,
+	// не записывается в кеш: оно могло быть прочитано из company сервиса до изменения.
+	generation uint64
+}
+
+type item struct {
+	key       string
+	companyID string
+	employee  *entities.Employee
+	dept      *entities.Department
+	expiresAt time.Time
+}
+
+// NewCache Кеш на capacity записей. ttl <= 0 выключает кеш: Get всегда промахивается.
+func NewCache(capacity int, ttl time.Duration) *Cache {
+	return &Cache{
+		ttl:      ttl,
+		capacity: capacity,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Generation Текущее поколение кеша. Читается до запроса в company сервис и передаётся в Set*.
+func (c *Cache) Generation() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generation
+}
+
+// Employee Роль и отдел сотрудника компании
+func (c *Cache) Employee(companyUUID, userUUID string) (*entities.Employee, bool) {
+	it, ok := c.get(employeeKey(companyUUID, userUUID))
+	if !ok {
+		return nil, false
+	}
+	employee := *it.employee
+	return &employee, true
+}
+
+// SetEmployee Сохраняет сотрудника, если с момента generation кеш не сбрасывался
+func (c *Cache) SetEmployee(generation uint64, companyUUID string, employee *entities.Employee) {
+	stored := *employee
+	c.set(generation, &item{
+		key:       employeeKey(companyUUID, employee.UUID),
+		companyID: companyUUID,
+		employee:  &stored,
+	})
+}
+
+// Department Компания, которой принадлежит отдел
+func (c *Cache) Department(departmentUUID string) (*entities.Department, bool) {
+	it, ok := c.get(departmentKey(departmentUUID))
+	if !ok {
+		return nil, false
+	}
+	department := *it.dept
+	return &department, true
+}
+
+// SetDepartment Сохраняет отдел, если с момента generation кеш не сбрасывался
+func (c *Cache) SetDepartment(generation uint64, department *entities.Department) {
+	stored := *department
+	c.set(generation, &item{
+		key:       departmentKey(department.DepartmentUUID),
+		companyID: department.CompanyUUID,
+		dept:      &stored,
+	})
+}
+
+// InvalidateEmployee Сбрасывает запись сотрудника компании
+func (c *Cache) InvalidateEmployee(companyUUID, userUUID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	if el, ok := c.items[employeeKey(companyUUID, userUUID)]; ok {
+		c.remove(el)
+	}
+}
+
+// InvalidateDepartment Сбрасывает отдел и сотрудников компании, состоявших в нём
+func (c *Cache) InvalidateDepartment(companyUUID, departmentUUID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	for key, el := range c.items {
+		it := el.Value.(*item)
+		if key == departmentKey(departmentUUID) ||
+			(it.employee != nil && it.companyID == companyUUID && it.employee.DepartmentUUID == departmentUUID) {
+			c.remove(el)
+		}
+	}
+}
+
+// InvalidateCompany Сбрасывает всех сотрудников и отделы компании
+func (c *Cache) InvalidateCompany(companyUUID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	for _, el := range c.items {
+		if el.Value.(*item).companyID == companyUUID {
+			c.remove(el)
+		}
+	}
+}
+
+// Purge Очищает кеш целиком
+func (c *Cache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	c.items = make(map[string]*list.Element)
+	c.order.Init()
+}
+
+// HandleEvent Обработчик событий exchange company.membership
+func (c *Cache) HandleEvent(_ context.Context, body []byte) Error.CodeError {
+	var event entities.MembershipEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return Error.Internal(fmt.Errorf("decode membership event: %w", err))
+	}
+
+	switch event.EventType {
+	case entities.EventEmployeeRoleChanged, entities.EventEmployeeRemoved, entities.EventEmployeeLeft, entities.EventEmployeeDepartmentChanged:
+		c.InvalidateEmployee(event.CompanyUUID, event.UserUUID)
+	case entities.EventDepartmentDeleted:
+		c.InvalidateDepartment(event.CompanyUUID, event.DepartmentUUID)
+	case entities.EventCompanyDeleted:
+		c.InvalidateCompany(event.CompanyUUID)
+	default:
+		// Событие нового типа: сбрасываем компанию целиком, чтобы не оставить устаревшие права
+		log.Warn().Str("event_type", event.EventType).Msg("membership cache: unknown event type, invalidating company")
+		c.InvalidateCompany(event.CompanyUUID)
+	}
+
+	return Error.CodeError{}
+}
+
+// ─── Вспомогательные функции ──────────────────────────────────────────────────
+
+func employeeKey(companyUUID, userUUID string) string {
+	return "employee:" + companyUUID + ":" + userUUID
+}
+
+func departmentKey(departmentUUID string) string {
+	return "department:" + departmentUUID
+}
+
+func (c *Cache) get(key string) (*item, bool) {
+	if c.ttl <= 0 {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	it := el.Value.(*item)
+	if time.Now().After(it.expiresAt) {
+		c.remove(el)
+		return nil, false
+	}
+
+	c.order.MoveToFront(el)
+	return it, true
+}
+
+func (c *Cache) set(generation uint64, it *item) {
+	if c.ttl <= 0 || c.capacity <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+
+	it.expiresAt = time.Now().Add(c.ttl)
+	if el, ok := c.items[it.key]; ok {
+		el.Value = it
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.items[it.key] = c.order.PushFront(it)
+	for c.order.Len() > c.capacity {
+		c.remove(c.order.Back())
+	}
+}
+
+func (c *Cache) remove(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.items, el.Value.(*item).key)
+}
@@ -0,0 +1,181 @@
+package membership
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+)
+
+const (
+	companyID = "company"
+	deptID    = "dept"
+	userID    = "user"
+)
+
+func employee(uuid, departmentUUID string) *entities.Employee {
+	return &entities.Employee{UUID: uuid, Role: "engineer", DepartmentUUID: departmentUUID}
+}
+
+func event(t *testing.T, e entities.MembershipEvent) []byte {
+	t.Helper()
+	body, err := json.Marshal(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
+
+func TestCacheEmployee(t *testing.T) {
+	t.Run("hit", func(t *testing.T) {
+		c := NewCache(10, time.Minute)
+		c.SetEmployee(c.Generation(), companyID, employee(userID, deptID))
+
+		got, ok := c.Employee(companyID, userID)
+		if !ok || got.Role != "engineer" || got.DepartmentUUID != deptID {
+			t.Fatalf("expected cached employee, got %+v %v", got, ok)
+		}
+		if _, ok = c.Employee("other", userID); ok {
+			t.Error("employee must be cached per company")
+		}
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		c := NewCache(10, time.Millisecond)
+		c.SetEmployee(c.Generation(), companyID, employee(userID, deptID))
+		time.Sleep(5 * time.Millisecond)
+
+		if _, ok := c.Employee(companyID, userID); ok {
+			t.Error("expired entry must not be returned")
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		c := NewCache(10, 0)
+		c.SetEmployee(c.Generation(), companyID, employee(userID, deptID))
+
+		if _, ok := c.Employee(companyID, userID); ok {
+			t.Error("cache with zero ttl must be disabled")
+		}
+	})
+
+	t.Run("evicts least recently used", func(t *testing.T) {
+		c := NewCache(2, time.Minute)
+		c.SetEmployee(c.Generation(), companyID, employee("a", ""))
+		c.SetEmployee(c.Generation(), companyID, employee("b", ""))
+		c.Employee(companyID, "a")
+		c.SetEmployee(c.Generation(), companyID, employee("c", ""))
+
+		if _, ok := c.Employee(companyID, "b"); ok {
+			t.Error("least recently used entry must be evicted")
+		}
+		if _, ok := c.Employee(companyID, "a"); !ok {
+			t.Error("recently used entry must stay")
+		}
+	})
+
+	t.Run("stale load after invalidation is dropped", func(t *testing.T) {
+		c := NewCache(10, time.Minute)
+		// Роль прочитана из company сервиса, затем пришло событие об её изменении
+		generation := c.Generation()
+		c.InvalidateEmployee(companyID, userID)
+		c.SetEmployee(generation, companyID, employee(userID, deptID))
+
+		if _, ok := c.Employee(companyID, userID); ok {
+			t.Error("value loaded before invalidation must not be cached")
+		}
+	})
+}
+
+func TestCacheHandleEvent(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("role changed", func(t *testing.T) {
+		c := NewCache(10, time.Minute)
+		c.SetEmployee(c.Generation(), companyID, employee(userID, deptID))
+		c.SetEmployee(c.Generation(), companyID, employee("other", deptID))
+
+		err := c.HandleEvent(ctx, event(t, entities.MembershipEvent{
+			EventType: entities.EventEmployeeRoleChanged, CompanyUUID: companyID, UserUUID: userID,
+		}))
+		if err.Code != 0 {
+			t.Fatal(err)
+		}
+		if _, ok := c.Employee(companyID, userID); ok {
+			t.Error("changed employee must be invalidated")
+		}
+		if _, ok := c.Employee(companyID, "other"); !ok {
+			t.Error("other employees must stay cached")
+		}
+	})
+
+	t.Run("employee left", func(t *testing.T) {
+		c := NewCache(10, time.Minute)
+		c.SetEmployee(c.Generation(), companyID, employee(userID, deptID))
+
+		err := c.HandleEvent(ctx, event(t, entities.MembershipEvent{
+			EventType: entities.EventEmployeeLeft, CompanyUUID: companyID, UserUUID: userID,
+		}))
+		if err.Code != 0 {
+			t.Fatal(err)
+		}
+		if _, ok := c.Employee(companyID, userID); ok {
+			t.Error("left employee must be invalidated")
+		}
+	})
+
+	t.Run("department deleted", func(t *testing.T) {
+		c := NewCache(10, time.Minute)
+		c.SetDepartment(c.Generation(), &entities.Department{DepartmentUUID: deptID, CompanyUUID: companyID})
+		c.SetEmployee(c.Generation(), companyID, employee(userID, deptID))
+		c.SetEmployee(c.Generation(), companyID, employee("other", "other-dept"))
+
+		err := c.HandleEvent(ctx, event(t, entities.MembershipEvent{
+			EventType: entities.EventDepartmentDeleted, CompanyUUID: companyID, DepartmentUUID: deptID,
+		}))
+		if err.Code != 0 {
+			t.Fatal(err)
+		}
+		if _, ok := c.Department(deptID); ok {
+			t.Error("deleted department must be invalidated")
+		}
+		if _, ok := c.Employee(companyID, userID); ok {
+			t.Error("employees of deleted department must be invalidated")
+		}
+		if _, ok := c.Employee(companyID, "other"); !ok {
+			t.Error("employees of other departments must stay cached")
+		}
+	})
+
+	t.Run("company deleted", func(t *testing.T) {
+		c := NewCache(10, time.Minute)
+		c.SetDepartment(c.Generation(), &entities.Department{DepartmentUUID: deptID, CompanyUUID: companyID})
+		c.SetEmployee(c.Generation(), companyID, employee(userID, deptID))
+		c.SetEmployee(c.Generation(), "other-company", employee(userID, ""))
+
+		err := c.HandleEvent(ctx, event(t, entities.MembershipEvent{
+			EventType: entities.EventCompanyDeleted, CompanyUUID: companyID,
+		}))
+		if err.Code != 0 {
+			t.Fatal(err)
+		}
+		if _, ok := c.Department(deptID); ok {
+			t.Error("department of deleted company must be invalidated")
+		}
+		if _, ok := c.Employee(companyID, userID); ok {
+			t.Error("employee of deleted company must be invalidated")
+		}
+		if _, ok := c.Employee("other-company", userID); !ok {
+			t.Error("other companies must stay cached")
+		}
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		c := NewCache(10, time.Minute)
+		if err := c.HandleEvent(ctx, []byte("{")); err.Code == 0 {
+			t.Error("expected decode error")
+		}
+	})
+}
@@ -0,0 +1,148 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/rs/zerolog/log"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/rabbitMQ"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/tracing"
+)
+
+// ApplicationEventsExchange Topic exchange доменных событий заявок.
+// Routing key совпадает с типом события (application.assigned, application.status_changed, ...),
+// поэтому потребители подписываются по шаблону, например application.#
+const ApplicationEventsExchange = "application.events"
+
+type Publisher interface {
+	PublishApplicationEvent(ctx context.Context, event *entities.OutboxEvent) Error.CodeError
+	Close() error
+}
+
+type publisher struct {
+	mu            sync.Mutex
+	connectString string
+	conn          *amqp.Connection
+	ch            *amqp.Channel
+}
+
+func NewPublisher(connectString string) Publisher {
+	// Подключение к rabbitMQ
+	conn, ch := rabbitMQ.Connect(connectString)
+
+	if err := setupChannel(ch); err != nil {
+		log.Fatal().Err(err).Msgf("failed to declare %s exchange", ApplicationEventsExchange)
+	}
+
+	return &publisher{
+		connectString: connectString,
+		conn:          conn,
+		ch:            ch,
+	}
+}
+
+// setupChannel Создание exchange для событий (идемпотентно) и включение publisher confirms
+func setupChannel(ch *amqp.Channel) error {
+	if err := declareExchange(ch, ApplicationEventsExchange); err != nil {
+		return err
+	}
+
+	return ch.Confirm(false)
+}
+
+// declareExchange Создание durable topic exchange (идемпотентно)
+func declareExchange(ch *amqp.Channel, name string) error {
+	return ch.ExchangeDeclare(
+		name,               // name
+		amqp.ExchangeTopic, // type
+		true,               // durable
+		false,              // auto-deleted
+		false,              // internal
+		false,              // no-wait
+		nil,
+	)
+}
+
+// PublishApplicationEvent Публикует событие из outbox-а в exchange application.events
+// и дожидается подтверждения брокера. При потере канала переподключается.
+func (p *publisher) PublishApplicationEvent(ctx context.Context, event *entities.OutboxEvent) Error.CodeError {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.ch.IsClosed() {
+		conn, ch, err := rabbitMQ.Dial(p.connectString)
+		if err != nil {
+			return Error.Internal(err)
+		}
+		if err = setupChannel(ch); err != nil {
+			_ = conn.Close()
+			return Error.Internal(err)
+		}
+		_ = p.conn.Close()
+		p.conn, p.ch = conn, ch
+	}
+
+	msg := amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		MessageId:    event.UUID,
+		Type:         event.EventType,
+		Timestamp:    time.Now(),
+		Body:         event.Payload,
+	}
+
+	// Событие публикуется воркером outbox-а - спан публикации начинает собственную трассу,
+	// подписчики продолжают её по заголовкам сообщения
+	ctx, span := tracing.StartPublish(ctx, ApplicationEventsExchange, event.EventType, &msg)
+	err := p.publishConfirmed(ctx, event.EventType, msg)
+	tracing.EndSpan(span, err)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	return Error.CodeError{}
+}
+
+// publishConfirmed Публикует сообщение в exchange application.events и дожидается подтверждения брокера
+func (p *publisher) publishConfirmed(ctx context.Context, routingKey string, msg amqp.Publishing) error {
+	confirmation, err := p.ch.PublishWithDeferredConfirmWithContext(ctx,
+		ApplicationEventsExchange, // exchange
+		routingKey,                // routing key
+		false,                     // mandatory
+		false,                     // immediate
+		msg)
+	if err != nil {
+		return err
+	}
+
+	acked, err := confirmation.WaitContext(ctx)
+	if err != nil {
+		return err
+	}
+	if !acked {
+		return fmt.Errorf("event %s was not confirmed by broker", msg.MessageId)
+	}
+	return nil
+}
+
+// Close Закрывает канал и соединение с rabbitMQ. Публикация, начатая до вызова, успевает
+// дождаться подтверждения брокера - Close ждёт её на том же mutex.
+func (p *publisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.conn.IsClosed() {
+		return nil
+	}
+
+	chErr := p.ch.Close()
+	if err := p.conn.Close(); err != nil {
+		return err
+	}
+	return chErr
+}
@@ -0,0 +1,356 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/rs/zerolog/log"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/rabbitMQ"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/tracing"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	subscriberReconnectDelay = 5 * time.Second
+	subscriberHandleTimeout  = 10 * time.Second
+	// subscriberDeliveryLimit Возвратов события в общую очередь (событие не удалось переложить в retry очередь
+	// или экземпляр упал во время обработки), после которых брокер переносит его в dead-letter очередь
+	subscriberDeliveryLimit = 5
+
+	retryAttemptHeader = "x-retry-attempt"
+)
+
+// subscriberRetryDelays Задержки повторной обработки события из общей очереди, после последней - dead-letter очередь.
+// Покрывают кратковременную недоступность Postgres или company сервиса
+var subscriberRetryDelays = []time.Duration{5 * time.Second, 30 * time.Second, 2 * time.Minute, 10 * time.Minute, 30 * time.Minute}
+
+// MembershipExchange Topic exchange событий изменения состава компаний, публикуемых company сервисом
+const MembershipExchange = "company.membership"
+
+// MembershipWorkerQueue Общая очередь экземпляров сервиса для обработки заявок сотрудников, покинувших компанию, отдел или роль
+const MembershipWorkerQueue = "application.membership"
+
+// EventHandler Обработчик события из rabbitMQ
+type EventHandler func(ctx context.Context, body []byte) Error.CodeError
+
+// Subscriber Подписка экземпляра сервиса на события topic exchange.
+//
+// Каждый экземпляр получает собственную эксклюзивную очередь, которая удаляется вместе с соединением:
+// события нужны только состоянию экземпляра (открытым потокам WatchApplications, кешу ролей),
+// поэтому пропущенные за время переподключения события не восстанавливаются.
+//
+// Подписка с именованной очередью (queue) работает иначе: очередь устойчивая и общая для всех экземпляров,
+// каждое событие обрабатывается один раз и подтверждается только после успешной обработки.
+// Повтор с задержкой - через отложенные очереди <queue>.retry.<delay>: событие публикуется в очередь
+// с x-message-ttl = delay, по истечении TTL брокер возвращает его в общую очередь. Номер попытки хранится
+// в заголовке x-retry-attempt. После последней задержки событие отклоняется, и брокер переносит его
+// в <queue>.dead-letter (x-dead-letter-exchange общей очереди).
+type Subscriber struct {
+	connectString string
+	exchange      string
+	queue         string // имя общей устойчивой очереди, пустое - эксклюзивная очередь экземпляра
+	bindingKeys   []string
+	handle        EventHandler
+	onConnect     func() // вызывается после каждой успешной подписки, может быть nil
+}
+
+// NewSubscriber Подписка на доменные события заявок для рассылки в потоки WatchApplications
+func NewSubscriber(connectString string, handle EventHandler) *Subscriber {
+	return &Subscriber{
+		connectString: connectString,
+		exchange:      ApplicationEventsExchange,
+		bindingKeys:   []string{"application.#"},
+		handle:        handle,
+	}
+}
+
+// NewMembershipSubscriber Подписка на события изменения состава компаний для сброса кеша ролей.
+// onConnect вызывается после (пере)подключения: события за время разрыва потеряны, кеш нужно очистить.
+func NewMembershipSubscriber(connectString string, handle EventHandler, onConnect func()) *Subscriber {
+	return &Subscriber{
+		connectString: connectString,
+		exchange:      MembershipExchange,
+		bindingKeys:   []string{"#"},
+		handle:        handle,
+		onConnect:     onConnect,
+	}
+}
+
+// NewMembershipWorker Обработка открытых заявок сотрудников после ухода из компании, смены роли или удаления из отдела.
+// Событие не должно потеряться, поэтому используется общая устойчивая очередь с подтверждением,
+// повтором с задержкой и dead-letter очередью
+func NewMembershipWorker(connectString string, handle EventHandler, bindingKeys ...string) *Subscriber {
+	return &Subscriber{
+		connectString: connectString,
+		exchange:      MembershipExchange,
+		queue:         MembershipWorkerQueue,
+		bindingKeys:   bindingKeys,
+		handle:        handle,
+	}
+}
+
+// Run Обработка событий до отмены ctx. При потере соединения переподключается через subscriberReconnectDelay
+func (s *Subscriber) Run(ctx context.Context) {
+	for {
+		err := s.consume(ctx)
+		if ctx.Err() != nil {
+			log.Info().Str("exchange", s.exchange).Msg("event subscriber stopped")
+			return
+		}
+
+		log.Warn().Err(err).Msgf("rabbitMQ connection lost, reconnecting in %s...", subscriberReconnectDelay)
+		select {
+		case <-time.After(subscriberReconnectDelay):
+		case <-ctx.Done():
+			log.Info().Str("exchange", s.exchange).Msg("event subscriber stopped")
+			return
+		}
+	}
+}
+
+// consume Одна сессия подключения: объявление очереди экземпляра и обработка событий
+func (s *Subscriber) consume(ctx context.Context) error {
+	conn, ch, err := rabbitMQ.Dial(s.connectString)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if err = declareExchange(ch, s.exchange); err != nil {
+		return err
+	}
+
+	queue, err := s.declareQueue(ch)
+	if err != nil {
+		return fmt.Errorf("declare subscriber queue: %w", err)
+	}
+	if s.queue != "" {
+		if err = declareRetryTopology(ch, s.queue); err != nil {
+			return err
+		}
+		// Подтверждения брокера для публикаций в retry очереди
+		if err = ch.Confirm(false); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range s.bindingKeys {
+		if err = ch.QueueBind(queue.Name, key, s.exchange, false, nil); err != nil {
+			return fmt.Errorf("bind subscriber queue: %w", err)
+		}
+	}
+
+	deliveries, err := ch.Consume(
+		queue.Name,    // queue
+		"",            // consumer
+		s.queue == "", // auto-ack
+		s.queue == "", // exclusive
+		false,         // no-local
+		false,         // no-wait
+		nil,
+	)
+	if err != nil {
+		return fmt.Errorf("consume %s: %w", queue.Name, err)
+	}
+
+	if s.onConnect != nil {
+		s.onConnect()
+	}
+
+	log.Info().Str("exchange", s.exchange).Str("queue", queue.Name).Msg("event subscriber started")
+
+	for {
+		select {
+		case d, ok := <-deliveries:
+			if !ok {
+				return fmt.Errorf("deliveries channel closed")
+			}
+			s.process(ctx, ch, d)
+
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// declareQueue Объявление эксклюзивной очереди экземпляра или общей устойчивой quorum очереди.
+// Отклонённые события общей очереди брокер переносит в её dead-letter очередь
+func (s *Subscriber) declareQueue(ch *amqp.Channel) (amqp.Queue, error) {
+	if s.queue == "" {
+		return ch.QueueDeclare(
+			"",    // name (генерируется брокером)
+			false, // durable
+			true,  // delete when unused
+			true,  // exclusive
+			false, // no-wait
+			nil,
+		)
+	}
+
+	if err := ch.Qos(1, 0, false); err != nil {
+		return amqp.Queue{}, err
+	}
+	return ch.QueueDeclare(
+		s.queue, // name
+		true,    // durable
+		false,   // delete when unused
+		false,   // exclusive
+		false,   // no-wait
+		amqp.Table{
+			amqp.QueueTypeArg:           amqp.QueueTypeQuorum,
+			"x-delivery-limit":          subscriberDeliveryLimit,
+			"x-dead-letter-exchange":    "",
+			"x-dead-letter-routing-key": deadLetterQueueName(s.queue),
+		},
+	)
+}
+
+// declareRetryTopology Объявление отложенных очередей общей очереди queue и её dead-letter очереди
+func declareRetryTopology(ch *amqp.Channel, queue string) error {
+	for _, delay := range subscriberRetryDelays {
+		_, err := ch.QueueDeclare(
+			retryQueueName(queue, delay), // name
+			true,                         // durable
+			false,                        // delete when unused
+			false,                        // exclusive
+			false,                        // no-wait
+			amqp.Table{
+				amqp.QueueTypeArg:           amqp.QueueTypeQuorum,
+				"x-message-ttl":             delay.Milliseconds(),
+				"x-dead-letter-exchange":    "",
+				"x-dead-letter-routing-key": queue,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("declare %s retry queue: %w", queue, err)
+		}
+	}
+
+	_, err := ch.QueueDeclare(
+		deadLetterQueueName(queue), // name
+		true,                       // durable
+		false,                      // delete when unused
+		false,                      // exclusive
+		false,                      // no-wait
+		amqp.Table{amqp.QueueTypeArg: amqp.QueueTypeQuorum},
+	)
+	if err != nil {
+		return fmt.Errorf("declare %s dead-letter queue: %w", queue, err)
+	}
+	return nil
+}
+
+// process Обработка одного события. В эксклюзивной очереди ошибки только логируются - повтор не имеет смысла:
+// клиенты потока получат следующее изменение заявки, устаревшая запись кеша истечёт по TTL.
+// В общей очереди событие при ошибке откладывается на повтор, а после последней попытки уходит в dead-letter очередь
+func (s *Subscriber) process(ctx context.Context, ch *amqp.Channel, d amqp.Delivery) {
+	handleCtx, cancel := context.WithTimeout(ctx, subscriberHandleTimeout)
+	defer cancel()
+
+	handleCtx, span := tracing.StartConsume(handleCtx, d.RoutingKey, d)
+	err := s.handle(handleCtx, d.Body)
+	tracing.EndSpan(span, err.Err)
+	if err.Code != 0 {
+		log.Warn().Err(err).Str("event_uuid", d.MessageId).Str("event_type", d.Type).Str("exchange", s.exchange).Msg("failed to handle event")
+	}
+
+	if s.queue == "" {
+		return
+	}
+	if err.Code != 0 {
+		s.retry(handleCtx, ch, d, err)
+		return
+	}
+	_ = d.Ack(false)
+}
+
+// retry Перенос необработанного события общей очереди в retry очередь следующей попытки или в dead-letter очередь.
+// Некорректное событие (InvalidArgument) повторять бессмысленно - оно сразу уходит в dead-letter очередь
+func (s *Subscriber) retry(ctx context.Context, ch *amqp.Channel, d amqp.Delivery, handleErr Error.CodeError) {
+	attempt := retryAttempt(d.Headers)
+	if handleErr.Code == codes.InvalidArgument || attempt >= len(subscriberRetryDelays) {
+		log.Error().Str("event_uuid", d.MessageId).Str("event_type", d.Type).Int("attempt", attempt).
+			Str("queue", deadLetterQueueName(s.queue)).Msg("event moved to dead-letter queue")
+		// Брокер переносит отклонённое событие в dead-letter очередь (x-dead-letter-exchange общей очереди)
+		_ = d.Nack(false, false)
+		return
+	}
+
+	target := retryQueueName(s.queue, subscriberRetryDelays[attempt])
+	if err := publishRetry(ctx, ch, target, d, attempt+1); err != nil {
+		// Не смогли переложить - возвращаем в общую очередь, после subscriberDeliveryLimit возвратов событие уйдёт в DLQ
+		log.Error().Err(err).Str("event_uuid", d.MessageId).Str("target", target).Msg("failed to schedule event retry")
+		_ = d.Nack(false, true)
+		return
+	}
+
+	log.Warn().Str("event_uuid", d.MessageId).Str("event_type", d.Type).Int("attempt", attempt+1).Str("retry_queue", target).Msg("event scheduled for retry")
+	_ = d.Ack(false)
+}
+
+// publishRetry Публикация копии события в retry очередь через default exchange с ожиданием подтверждения
+func publishRetry(ctx context.Context, ch *amqp.Channel, queue string, d amqp.Delivery, attempt int) error {
+	headers := amqp.Table{}
+	for k, v := range d.Headers {
+		headers[k] = v
+	}
+	headers[retryAttemptHeader] = int32(attempt)
+
+	msg := amqp.Publishing{
+		ContentType:  d.ContentType,
+		DeliveryMode: amqp.Persistent,
+		MessageId:    d.MessageId,
+		Type:         d.Type,
+		Headers:      headers,
+		Body:         d.Body,
+	}
+
+	// Повторная попытка остаётся в трассе исходного события
+	ctx, span := tracing.StartPublish(ctx, "", queue, &msg)
+	defer span.End()
+
+	confirmation, err := ch.PublishWithDeferredConfirmWithContext(ctx,
+		"",    // exchange
+		queue, // routing key
+		false, // mandatory
+		false, // immediate
+		msg)
+	if err != nil {
+		return err
+	}
+
+	acked, err := confirmation.WaitContext(ctx)
+	if err != nil {
+		return err
+	}
+	if !acked {
+		return fmt.Errorf("event was not confirmed by broker")
+	}
+	return nil
+}
+
+// retryQueueName Имя отложенной очереди включает задержку: x-message-ttl уже объявленной очереди изменить нельзя
+func retryQueueName(queue string, delay time.Duration) string {
+	return fmt.Sprintf("%s.retry.%s", queue, delay)
+}
+
+func deadLetterQueueName(queue string) string {
+	return queue + ".dead-letter"
+}
+
+// retryAttempt Номер уже выполненной повторной попытки из заголовков события
+func retryAttempt(headers amqp.Table) int {
+	switch v := headers[retryAttemptHeader].(type) {
+	case int32:
+		return int(v)
+	case int64:
+		return int(v)
+	case int:
+		return v
+	default:
+		return 0
+	}
+}
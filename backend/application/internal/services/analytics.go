@@ -0,0 +1,188 @@
+package services
+
+import (
+	"context"
+	"slices"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AnalyticsIntervals Периоды группировки статистики по статусам
+var AnalyticsIntervals = []string{"day", "week", "month"}
+
+// analyticsRoles Роли, которым доступна аналитика по всей компании
+var analyticsRoles = []string{"chief", "analytic"}
+
+// GetApplicationStatusStats Кол-во заявок по статусам и отделам в разрезе периодов создания (chief/analytic)
+func (s *ApplicationService) GetApplicationStatusStats(ctx context.Context, req *pb.GetApplicationStatusStatsRequest) (*pb.GetApplicationStatusStatsResponse, error) {
+	filter, err := analyticsFilter(req.GetInitiatorUuid(), req.GetCompanyUuid(), req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	interval := req.GetInterval()
+	if interval == "" {
+		interval = "day"
+	}
+	if !slices.Contains(AnalyticsIntervals, interval) {
+		return nil, status.Errorf(codes.InvalidArgument, "interval must be one of %v", AnalyticsIntervals)
+	}
+
+	if _, err = s.checkAnalyticsAccess(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid()); err != nil {
+		return nil, err
+	}
+
+	stats, getErr := s.db.AnalyticsRepository.GetStatusStats(ctx, entities.GetStatusStatsDTO{
+		Filter:   filter,
+		Interval: interval,
+	})
+	if err = getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.StatusStat, 0, len(stats))
+	for _, stat := range stats {
+		res = append(res, &pb.StatusStat{
+			Period:         stat.Period,
+			DepartmentUuid: stat.DepartmentUUID,
+			Status:         stat.Status,
+			Count:          stat.Count,
+		})
+	}
+
+	return &pb.GetApplicationStatusStatsResponse{Stats: res}, nil
+}
+
+// GetApplicationLeadTimes Среднее время назначения, исправления и проверки заявок (chief/analytic)
+func (s *ApplicationService) GetApplicationLeadTimes(ctx context.Context, req *pb.GetApplicationLeadTimesRequest) (*pb.GetApplicationLeadTimesResponse, error) {
+	filter, err := analyticsFilter(req.GetInitiatorUuid(), req.GetCompanyUuid(), req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = s.checkAnalyticsAccess(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid()); err != nil {
+		return nil, err
+	}
+
+	times, getErr := s.db.AnalyticsRepository.GetLeadTimes(ctx, filter)
+	if err = getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &pb.GetApplicationLeadTimesResponse{
+		Assign: &pb.LeadTime{MeanSeconds: times.Assign.MeanSeconds, Samples: times.Assign.Samples},
+		Fix:    &pb.LeadTime{MeanSeconds: times.Fix.MeanSeconds, Samples: times.Fix.Samples},
+		Verify: &pb.LeadTime{MeanSeconds: times.Verify.MeanSeconds, Samples: times.Verify.Samples},
+	}, nil
+}
+
+// GetEngineerRevisionStats Доля доработок по инженерам (chief/analytic)
+func (s *ApplicationService) GetEngineerRevisionStats(ctx context.Context, req *pb.GetEngineerRevisionStatsRequest) (*pb.GetEngineerRevisionStatsResponse, error) {
+	filter, err := analyticsFilter(req.GetInitiatorUuid(), req.GetCompanyUuid(), req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = s.checkAnalyticsAccess(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid()); err != nil {
+		return nil, err
+	}
+
+	stats, getErr := s.db.AnalyticsRepository.GetEngineerRevisionStats(ctx, filter)
+	if err = getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.EngineerRevisionStat, 0, len(stats))
+	for _, stat := range stats {
+		res = append(res, &pb.EngineerRevisionStat{
+			EngineerUuid:        stat.EngineerUUID,
+			Applications:        stat.Applications,
+			RevisedApplications: stat.RevisedApplications,
+			Revisions:           stat.Revisions,
+			RevisionRate:        rate(stat.Revisions, stat.Applications),
+		})
+	}
+
+	return &pb.GetEngineerRevisionStatsResponse{Stats: res}, nil
+}
+
+// GetDepartmentFailureStats Доля проваленных проверок среди закрытых заявок по отделам (chief/analytic)
+func (s *ApplicationService) GetDepartmentFailureStats(ctx context.Context, req *pb.GetDepartmentFailureStatsRequest) (*pb.GetDepartmentFailureStatsResponse, error) {
+	filter, err := analyticsFilter(req.GetInitiatorUuid(), req.GetCompanyUuid(), req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = s.checkAnalyticsAccess(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid()); err != nil {
+		return nil, err
+	}
+
+	stats, getErr := s.db.AnalyticsRepository.GetDepartmentFailureStats(ctx, filter)
+	if err = getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.DepartmentFailureStat, 0, len(stats))
+	for _, stat := range stats {
+		res = append(res, &pb.DepartmentFailureStat{
+			DepartmentUuid: stat.DepartmentUUID,
+			Closed:         stat.Closed,
+			Completed:      stat.Completed,
+			Failed:         stat.Failed,
+			FailureRate:    rate(stat.Failed, stat.Closed),
+		})
+	}
+
+	return &pb.GetDepartmentFailureStatsResponse{Stats: res}, nil
+}
+
+// ─── Вспомогательные функции ──────────────────────────────────────────────────
+
+// analyticsFilter Проверка общих параметров аналитических запросов
+func analyticsFilter(initiatorUUID, companyUUID string, filter *pb.AnalyticsFilter) (entities.AnalyticsFilterDTO, error) {
+	if err := validate.UUID(initiatorUUID); err != nil {
+		return entities.AnalyticsFilterDTO{}, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(companyUUID); err != nil {
+		return entities.AnalyticsFilterDTO{}, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+	if err := validate.UUID(filter.GetDepartmentUuid()); err != nil && filter.GetDepartmentUuid() != "" {
+		return entities.AnalyticsFilterDTO{}, status.Errorf(codes.InvalidArgument, "invalid department uuid")
+	}
+	if err := validate.DateFilter(filter.GetCreatedFrom(), "created_from"); err != nil && filter.GetCreatedFrom() != "" {
+		return entities.AnalyticsFilterDTO{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validate.DateFilter(filter.GetCreatedTo(), "created_to"); err != nil && filter.GetCreatedTo() != "" {
+		return entities.AnalyticsFilterDTO{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return entities.AnalyticsFilterDTO{
+		CompanyUUID:    companyUUID,
+		DepartmentUUID: filter.GetDepartmentUuid(),
+		CreatedFrom:    filter.GetCreatedFrom(),
+		CreatedTo:      filter.GetCreatedTo(),
+	}, nil
+}
+
+// checkAnalyticsAccess Аналитика и выгрузка заявок компании доступны только chief и analytic
+func (s *ApplicationService) checkAnalyticsAccess(ctx context.Context, companyUUID, initiatorUUID string) (*entities.Employee, error) {
+	initiator, err := s.getEmployeeInfo(ctx, companyUUID, initiatorUUID, initiatorUUID)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(analyticsRoles, initiator.Role) {
+		return nil, status.Error(codes.PermissionDenied, "only chief or analytic can view analytics")
+	}
+	return initiator, nil
+}
+
+// rate Доля part от total, 0 при пустой выборке
+func rate(part, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(part) / float64(total)
+}
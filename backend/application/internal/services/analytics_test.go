@@ -0,0 +1,172 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+// ─── GetApplicationStatusStats ───────────────────────────────────────────────
+
+func TestGetApplicationStatusStats(t *testing.T) {
+	get := func(svc *ApplicationService, filter *pb.AnalyticsFilter, interval string) (*pb.GetApplicationStatusStatsResponse, error) {
+		return svc.GetApplicationStatusStats(context.Background(), &pb.GetApplicationStatusStatsRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+			Filter:        filter,
+			Interval:      interval,
+		})
+	}
+
+	t.Run("analytic gets stats with default interval", func(t *testing.T) {
+		var got entities.GetStatusStatsDTO
+		svc := newAppTestService(emptyRepo(), roleClient("analytic"))
+		svc.db.AnalyticsRepository = &mockAnalyticsRepo{
+			getStatusStats: func(_ context.Context, dto entities.GetStatusStatsDTO) ([]*entities.StatusStat, Error.CodeError) {
+				got = dto
+				return []*entities.StatusStat{{Period: "2026-01-01T00:00:00Z", DepartmentUUID: deptID, Status: "created", Count: 3}}, ok()
+			},
+		}
+
+		res, err := get(svc, &pb.AnalyticsFilter{DepartmentUuid: deptID, CreatedFrom: "2026-01-01"}, "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Interval != "day" || got.Filter.CompanyUUID != companyID || got.Filter.DepartmentUUID != deptID || got.Filter.CreatedFrom != "2026-01-01" {
+			t.Errorf("unexpected filter: %+v", got)
+		}
+		if len(res.GetStats()) != 1 || res.GetStats()[0].GetCount() != 3 {
+			t.Errorf("unexpected stats: %v", res.GetStats())
+		}
+	})
+
+	t.Run("unknown interval", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("chief"))
+		_, err := get(svc, nil, "year")
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("chief"))
+		_, err := get(svc, &pb.AnalyticsFilter{CreatedTo: "01.02.2026"}, "month")
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("invalid department", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("chief"))
+		_, err := get(svc, &pb.AnalyticsFilter{DepartmentUuid: "bad"}, "month")
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	for _, role := range []string{"manager", "engineer", "inspector"} {
+		t.Run(role+" denied", func(t *testing.T) {
+			svc := newAppTestService(emptyRepo(), roleClient(role))
+			_, err := get(svc, nil, "")
+			assertCode(t, err, codes.PermissionDenied)
+		})
+	}
+}
+
+// ─── GetApplicationLeadTimes ─────────────────────────────────────────────────
+
+func TestGetApplicationLeadTimes(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("chief"))
+		svc.db.AnalyticsRepository = &mockAnalyticsRepo{
+			getLeadTimes: func(_ context.Context, _ entities.AnalyticsFilterDTO) (*entities.LeadTimes, Error.CodeError) {
+				return &entities.LeadTimes{
+					Assign: entities.LeadTime{MeanSeconds: 60, Samples: 4},
+					Fix:    entities.LeadTime{MeanSeconds: 3600, Samples: 2},
+				}, ok()
+			},
+		}
+
+		res, err := svc.GetApplicationLeadTimes(context.Background(), &pb.GetApplicationLeadTimesRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.GetAssign().GetMeanSeconds() != 60 || res.GetFix().GetSamples() != 2 || res.GetVerify().GetSamples() != 0 {
+			t.Errorf("unexpected lead times: %v", res)
+		}
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("analytic"))
+		svc.db.AnalyticsRepository = &mockAnalyticsRepo{
+			getLeadTimes: func(_ context.Context, _ entities.AnalyticsFilterDTO) (*entities.LeadTimes, Error.CodeError) {
+				return nil, internalErr()
+			},
+		}
+
+		_, err := svc.GetApplicationLeadTimes(context.Background(), &pb.GetApplicationLeadTimesRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+		})
+		assertCode(t, err, codes.Internal)
+	})
+}
+
+// ─── GetEngineerRevisionStats / GetDepartmentFailureStats ────────────────────
+
+func TestAnalyticsRates(t *testing.T) {
+	t.Run("engineer revision rate", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("analytic"))
+		svc.db.AnalyticsRepository = &mockAnalyticsRepo{
+			getEngineerRevisionStats: func(_ context.Context, _ entities.AnalyticsFilterDTO) ([]*entities.EngineerRevisionStat, Error.CodeError) {
+				return []*entities.EngineerRevisionStat{
+					{EngineerUUID: targetID, Applications: 4, RevisedApplications: 1, Revisions: 2},
+				}, ok()
+			},
+		}
+
+		res, err := svc.GetEngineerRevisionStats(context.Background(), &pb.GetEngineerRevisionStatsRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(res.GetStats()) != 1 || res.GetStats()[0].GetRevisionRate() != 0.5 {
+			t.Errorf("unexpected stats: %v", res.GetStats())
+		}
+	})
+
+	t.Run("department failure rate without closed applications", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("chief"))
+		svc.db.AnalyticsRepository = &mockAnalyticsRepo{
+			getDepartmentFailureStats: func(_ context.Context, _ entities.AnalyticsFilterDTO) ([]*entities.DepartmentFailureStat, Error.CodeError) {
+				return []*entities.DepartmentFailureStat{
+					{DepartmentUUID: deptID, Closed: 4, Completed: 3, Failed: 1},
+					{DepartmentUUID: otherDeptID},
+				}, ok()
+			},
+		}
+
+		res, err := svc.GetDepartmentFailureStats(context.Background(), &pb.GetDepartmentFailureStatsRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(res.GetStats()) != 2 || res.GetStats()[0].GetFailureRate() != 0.25 || res.GetStats()[1].GetFailureRate() != 0 {
+			t.Errorf("unexpected stats: %v", res.GetStats())
+		}
+	})
+
+	t.Run("engineer denied", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("engineer"))
+		_, err := svc.GetDepartmentFailureStats(context.Background(), &pb.GetDepartmentFailureStatsRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+		})
+		assertCode(t, err, codes.PermissionDenied)
+	})
+}
@@ -5,11 +5,14 @@ import (
 	"strings"
 
 	"github.com/google/uuid"
+	minioDB "github.com/unwelcome/FrameWorkTask1/backend/application/internal/database/minio"
 	postgresDB "github.com/unwelcome/FrameWorkTask1/backend/application/internal/database/postgres"
 	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/membership"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/workflow"
 	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
 	company_proto "github.com/unwelcome/FrameWorkTask1/backend/contracts/company/generated"
-	sharedErrors "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/cursor"
 	"github.com/unwelcome/FrameWorkTask1/backend/shared/helpers"
 	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
 	"google.golang.org/grpc/codes"
@@ -17,18 +20,24 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-var AllApplicationStatuses = []string{"created", "assigned", "in_progress", "on_hold", "completed", "failed", "redirected", "rejected", "recalled", "pending_verification", "on_verification", "on_revision"}
+var AllApplicationStatuses = workflow.Statuses
 
 type ApplicationService struct {
 	db            *postgresDB.DatabaseRepository
+	storage       *minioDB.StorageRepository
 	companyClient company_proto.CompanyServiceClient
+	members       *membership.Cache
+	watchers      *watchHub
 	pb.UnimplementedApplicationServiceServer
 }
 
-func NewApplicationService(db *postgresDB.DatabaseRepository, companyClient company_proto.CompanyServiceClient) *ApplicationService {
+func NewApplicationService(db *postgresDB.DatabaseRepository, storage *minioDB.StorageRepository, companyClient company_proto.CompanyServiceClient, members *membership.Cache) *ApplicationService {
 	return &ApplicationService{
 		db:            db,
+		storage:       storage,
 		companyClient: companyClient,
+		members:       members,
+		watchers:      newWatchHub(),
 	}
 }
 
@@ -38,7 +47,7 @@ func (s *ApplicationService) Health(ctx context.Context, _ *emptypb.Empty) (*pb.
 		Service:  "healthy",
 		Postgres: helpers.PingStatus(s.db.Ping(ctx)),
 		Redis:    "not implemented",
-		Minio:    "not implemented",
+		Minio:    helpers.PingStatus(s.storage.Ping(ctx)),
 		Mongo:    "not implemented",
 	}, nil
 }
@@ -58,6 +67,28 @@ func (s *ApplicationService) CreateApplication(ctx context.Context, req *pb.Crea
 		return nil, status.Errorf(codes.InvalidArgument, "invalid application description")
 	}
 
+	priority := req.GetPriority()
+	if priority == "" {
+		priority = "normal"
+	}
+	if err := validate.ApplicationPriority(priority); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid application priority")
+	}
+	if req.GetDueAt() != "" {
+		if err := validate.ApplicationDueAt(req.GetDueAt()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid due_at: %s", err.Error())
+		}
+	}
+	if err := validate.UUID(req.GetLocationUuid()); err != nil && req.GetLocationUuid() != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid location uuid")
+	}
+	if err := validate.UUID(req.GetCategoryUuid()); err != nil && req.GetCategoryUuid() != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid category uuid")
+	}
+	if err := validate.ApplicationSeverity(req.GetSeverity()); err != nil && req.GetSeverity() != "" {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	initiator, err := s.getEmployeeInfo(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), req.GetInitiatorUuid())
 	if err != nil {
 		return nil, err
@@ -67,14 +98,52 @@ func (s *ApplicationService) CreateApplication(ctx context.Context, req *pb.Crea
 		return nil, status.Error(codes.PermissionDenied, "only inspectors can create applications")
 	}
 
+	locationPath := make([]string, 0)
+	if req.GetLocationUuid() != "" {
+		locationPath, err = s.getLocationPath(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), req.GetLocationUuid())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Заявка категории с департаментом по умолчанию сразу попадает в пул этого департамента
+	departmentUUID := initiator.DepartmentUUID
+	if req.GetCategoryUuid() != "" {
+		category, err := s.getApplicationCategory(ctx, req.GetCompanyUuid(), req.GetCategoryUuid())
+		if err != nil {
+			return nil, err
+		}
+		if category.DefaultDepartmentUUID != "" {
+			departmentUUID = category.DefaultDepartmentUUID
+		}
+	}
+
+	fields, getFieldsErr := s.db.CustomFieldRepository.GetCompanyCustomFields(ctx, entities.GetCompanyCustomFieldsDTO{
+		CompanyUUID: req.GetCompanyUuid(),
+	})
+	if err := getFieldsErr.GRPCError(); err != nil {
+		return nil, err
+	}
+	customFields, err := applicationCustomFields(fields, req.GetCustomFields())
+	if err != nil {
+		return nil, err
+	}
+
 	applicationUUID := uuid.Must(uuid.NewV7()).String()
 
 	if err := s.db.ApplicationRepository.CreateApplication(ctx, entities.CreateApplicationDTO{
 		ApplicationUUID: applicationUUID,
 		CompanyUUID:     req.GetCompanyUuid(),
-		DepartmentUUID:  initiator.DepartmentUUID,
+		DepartmentUUID:  departmentUUID,
 		Title:           req.GetApplicationData().GetTitle(),
 		Description:     req.GetApplicationData().GetDescription(),
+		Priority:        priority,
+		DueAt:           req.GetDueAt(),
+		LocationUUID:    req.GetLocationUuid(),
+		LocationPath:    locationPath,
+		CategoryUUID:    req.GetCategoryUuid(),
+		Severity:        req.GetSeverity(),
+		CustomFields:    customFields,
 		CreatedBy:       req.GetInitiatorUuid(),
 	}).GRPCError(); err != nil {
 		return nil, err
@@ -116,13 +185,32 @@ func (s *ApplicationService) GetApplication(ctx context.Context, req *pb.GetAppl
 		return nil, err
 	}
 
+	attachments, getAttachmentsErr := s.db.AttachmentRepository.GetApplicationAttachments(ctx, entities.GetApplicationAttachmentsDTO{
+		ApplicationUUID: req.GetApplicationUuid(),
+	})
+	if err := getAttachmentsErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	// Вложения fix log-ов группируем по записям, остальные относятся к самой заявке
+	pbAttachments := make([]*pb.Attachment, 0)
+	fixLogAttachments := make(map[string][]*pb.Attachment)
+	for _, a := range attachments {
+		if a.FixLogUUID == "" {
+			pbAttachments = append(pbAttachments, attachmentToPB(a))
+		} else {
+			fixLogAttachments[a.FixLogUUID] = append(fixLogAttachments[a.FixLogUUID], attachmentToPB(a))
+		}
+	}
+
 	pbFixLogs := make([]*pb.FixLog, 0, len(fixLogs))
 	for _, fl := range fixLogs {
 		pbFixLogs = append(pbFixLogs, &pb.FixLog{
-			Uuid:      fl.UUID,
-			Text:      fl.Text,
-			CreatedAt: fl.CreatedAt,
-			CreatedBy: fl.CreatedBy,
+			Uuid:        fl.UUID,
+			Text:        fl.Text,
+			CreatedAt:   fl.CreatedAt,
+			CreatedBy:   fl.CreatedBy,
+			Attachments: fixLogAttachments[fl.UUID],
 		})
 	}
 
@@ -146,7 +234,17 @@ func (s *ApplicationService) GetApplication(ctx context.Context, req *pb.GetAppl
 			ClosedAt:        application.ClosedAt,
 			DeletedAt:       application.DeletedAt,
 			DeletedBy:       application.DeletedBy,
+			Priority:        application.Priority,
+			DueAt:           application.DueAt,
+			IsOverdue:       application.SLABreach != "",
+			SlaBreach:       application.SLABreach,
+			OverdueAt:       application.OverdueAt,
+			LocationUuid:    application.LocationUUID,
+			CategoryUuid:    application.CategoryUUID,
+			Severity:        application.Severity,
+			CustomFields:    customFieldsToPB(application.CustomFields),
 			FixLogs:         pbFixLogs,
+			Attachments:     pbAttachments,
 		},
 	}, nil
 }
@@ -165,126 +263,83 @@ func (s *ApplicationService) GetApplications(ctx context.Context, req *pb.GetApp
 	if req.GetOffset() < 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid offset")
 	}
+	position, err := cursor.Parse(req.GetCursor(), req.GetOffset())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.GetCursor() != "" && validate.UUID(position.ID) != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cursor")
+	}
 
 	initiator, err := s.getEmployeeInfo(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), req.GetInitiatorUuid())
 	if err != nil {
 		return nil, err
 	}
 
-	var applications []*entities.Application
-	var dbErr sharedErrors.CodeError
-
-	switch initiator.Role {
-
-	// Если инициатор "chief" или "analytic" - используем department_uuid и status из запроса
-	case "chief", "analytic":
-		if err := validate.UUID(req.GetDepartmentUuid()); err != nil && req.GetDepartmentUuid() != "" {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid department uuid")
-		}
-		if !helpers.ContainsAll(AllApplicationStatuses, req.GetStatuses()) {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid statuses")
-		}
-
-		applications, dbErr = s.db.ApplicationRepository.GetApplications(ctx, entities.GetApplicationsDTO{
-			CompanyUUID:    req.GetCompanyUuid(),
-			DepartmentUUID: req.GetDepartmentUuid(),
-			Statuses:       req.GetStatuses(),
-			Offset:         req.GetOffset(),
-			Count:          req.GetCount(),
-			IsDeleted:      req.GetIsDeleted(),
-		})
-
-	// Если инициатор "inspector" - department_uuid инициатора, statuses: ["pending_verification", "on_verification"]
-	case "inspector":
-		if req.GetFromPool() {
-			applications, dbErr = s.db.ApplicationRepository.GetApplications(ctx, entities.GetApplicationsDTO{
-				CompanyUUID:    req.GetCompanyUuid(),
-				DepartmentUUID: initiator.DepartmentUUID,
-				Statuses:       []string{"pending_verification"},
-				Offset:         req.GetOffset(),
-				Count:          req.GetCount(),
-			})
-		} else {
-			createdBy := req.GetInitiatorUuid()
-			inspectedBy := req.GetInitiatorUuid()
-
-			if len(req.GetStatuses()) == 0 {
-				inspectedBy = ""
-			} else {
-				createdBy = ""
-				if !helpers.ContainsAll([]string{"on_verification"}, req.GetStatuses()) {
-					return nil, status.Errorf(codes.InvalidArgument, "invalid statuses")
-				}
-			}
-
-			applications, dbErr = s.db.ApplicationRepository.GetApplications(ctx, entities.GetApplicationsDTO{
-				CompanyUUID:    req.GetCompanyUuid(),
-				DepartmentUUID: initiator.DepartmentUUID,
-				Statuses:       req.GetStatuses(),
-				CreatedBy:      createdBy,
-				InspectedBy:    inspectedBy,
-				Offset:         req.GetOffset(),
-				Count:          req.GetCount(),
-				IsDeleted:      req.GetIsDeleted(),
-			})
-		}
-
-	// Если инициатор "manager" - department_uuid инициатора, statuses: ["created", "redirected", "recalled", "on_revision"]
-	case "manager":
-		if req.GetFromPool() {
-			applications, dbErr = s.db.ApplicationRepository.GetApplications(ctx, entities.GetApplicationsDTO{
-				CompanyUUID:      req.GetCompanyUuid(),
-				DepartmentUUID:   initiator.DepartmentUUID,
-				Statuses:         []string{"created", "redirected", "recalled", "on_revision"},
-				ExecutedByIsNull: true,
-				Offset:           req.GetOffset(),
-				Count:            req.GetCount(),
-			})
-		} else {
-			applications, dbErr = s.db.ApplicationRepository.GetApplications(ctx, entities.GetApplicationsDTO{
-				CompanyUUID:    req.GetCompanyUuid(),
-				DepartmentUUID: initiator.DepartmentUUID,
-				ManagedBy:      req.GetInitiatorUuid(),
-				Offset:         req.GetOffset(),
-				Count:          req.GetCount(),
-			})
-		}
-
-	// Если инициатор "engineer" - department_uuid инициатора, statuses: ["assigned", "on_revision", "in_progress", "on_hold"]
-	case "engineer":
-		if !helpers.ContainsAll([]string{"assigned", "on_revision", "in_progress", "on_hold"}, req.GetStatuses()) {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid statuses")
-		}
-
-		applications, dbErr = s.db.ApplicationRepository.GetApplications(ctx, entities.GetApplicationsDTO{
-			CompanyUUID:    req.GetCompanyUuid(),
-			DepartmentUUID: initiator.DepartmentUUID,
-			Statuses:       req.GetStatuses(),
-			ExecutedBy:     req.GetInitiatorUuid(),
-			Offset:         req.GetOffset(),
-			Count:          req.GetCount(),
-		})
-
-	default:
-		return nil, status.Error(codes.PermissionDenied, "not allowed to get applications")
+	filter, err := applicationsFilter(initiator, applicationsFilterParams{
+		CompanyUUID:    req.GetCompanyUuid(),
+		DepartmentUUID: req.GetDepartmentUuid(),
+		Statuses:       req.GetStatuses(),
+		IsDeleted:      req.GetIsDeleted(),
+		FromPool:       req.GetFromPool(),
+		IsOverdue:      req.GetIsOverdue(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = applicationsSearchFilter(&filter, req); err != nil {
+		return nil, err
+	}
+	if req.GetCursor() != "" && filter.SortBy != "created_at" {
+		return nil, status.Errorf(codes.InvalidArgument, "cursor pagination is available only for created_at sort")
 	}
+	filter.Offset = req.GetOffset()
+	filter.AfterUUID = position.ID
+	filter.Count = req.GetCount() + 1 // лишняя заявка показывает, есть ли следующая страница
 
+	applications, dbErr := s.db.ApplicationRepository.GetApplications(ctx, filter)
+	if err := dbErr.GRPCError(); err != nil {
+		return nil, err
+	}
+	total, dbErr := s.db.ApplicationRepository.CountFilteredApplications(ctx, filter)
 	if err := dbErr.GRPCError(); err != nil {
 		return nil, err
 	}
 
+	nextCursor := ""
+	if int64(len(applications)) > req.GetCount() {
+		applications = applications[:req.GetCount()]
+		if filter.SortBy == "created_at" {
+			nextCursor = cursor.Encode(cursor.Cursor{ID: applications[len(applications)-1].ApplicationUUID})
+		}
+	}
+
 	pbApplications := make([]*pb.Application, 0, len(applications))
 	for _, app := range applications {
 		pbApplications = append(pbApplications, &pb.Application{
 			ApplicationUuid: app.ApplicationUUID,
 			Title:           app.Title,
 			Status:          app.Status,
+			RevisionCount:   app.RevisionCount,
 			CreatedAt:       app.CreatedAt,
 			UpdatedAt:       app.UpdatedAt,
+			ClosedAt:        app.ClosedAt,
+			Priority:        app.Priority,
+			DueAt:           app.DueAt,
+			IsOverdue:       app.SLABreach != "",
+			SlaBreach:       app.SLABreach,
+			LocationUuid:    app.LocationUUID,
+			CategoryUuid:    app.CategoryUUID,
+			Severity:        app.Severity,
+			CustomFields:    customFieldsToPB(app.CustomFields),
 		})
 	}
 
-	return &pb.GetApplicationsResponse{Applications: pbApplications}, nil
+	return &pb.GetApplicationsResponse{
+		Applications: pbApplications,
+		NextCursor:   nextCursor,
+		TotalCount:   total,
+	}, nil
 }
 
 // UpdateApplicationStatus Обновление статуса заявки
@@ -297,8 +352,9 @@ func (s *ApplicationService) UpdateApplicationStatus(ctx context.Context, req *p
 	}
 
 	newStatus := req.GetStatus()
+	message := strings.TrimSpace(req.GetMessage())
 
-	if !helpers.Contains([]string{"rejected", "in_progress", "on_hold", "pending_verification", "completed", "failed", "on_revision"}, newStatus) {
+	if !helpers.Contains(workflow.SettableStatuses, newStatus) {
 		return nil, status.Error(codes.InvalidArgument, "invalid status")
 	}
 
@@ -314,51 +370,16 @@ func (s *ApplicationService) UpdateApplicationStatus(ctx context.Context, req *p
 		return nil, err
 	}
 
-	currentStatus := application.Status
-
-	switch initiator.Role {
-
-	case "inspector":
-		if application.InspectedBy != req.GetInitiatorUuid() {
-			return nil, status.Error(codes.PermissionDenied, "only the responsible inspector can change status")
-		}
-		if !helpers.Contains([]string{"completed", "failed", "on_revision"}, newStatus) {
-			return nil, status.Error(codes.PermissionDenied, "inspectors can only set \"completed\", \"failed\" or \"on_revision\"")
-		}
-		if !helpers.Contains([]string{"on_verification"}, currentStatus) {
-			return nil, status.Error(codes.FailedPrecondition, "invalid status transition")
-		}
-
-	case "manager":
-		if initiator.DepartmentUUID != application.DepartmentUUID {
-			return nil, status.Error(codes.PermissionDenied, "your department is not responsible")
-		}
-		if !helpers.Contains([]string{"rejected"}, newStatus) {
-			return nil, status.Error(codes.PermissionDenied, "managers can only set \"rejected\"")
-		}
-		if !helpers.Contains([]string{"created", "redirected", "recalled", "on_revision"}, currentStatus) {
-			return nil, status.Error(codes.FailedPrecondition, "invalid status transition")
-		}
-
-	case "engineer":
-		if application.ExecutedBy != req.GetInitiatorUuid() {
-			return nil, status.Error(codes.PermissionDenied, "only the responsible engineer can change status")
-		}
-		if !helpers.Contains([]string{"in_progress", "on_hold", "pending_verification"}, newStatus) {
-			return nil, status.Error(codes.PermissionDenied, "engineers can only set \"in_progress\", \"on_hold\" or \"pending_verification\"")
-		}
-		if !helpers.Contains([]string{"assigned", "in_progress", "on_hold", "on_revision"}, currentStatus) {
-			return nil, status.Error(codes.FailedPrecondition, "invalid status transition")
-		}
-
-	default:
-		return nil, status.Error(codes.PermissionDenied, "unallowed role")
+	// Роль, ответственность и допустимость перехода определяет workflow компании
+	if err = s.checkTransition(ctx, application, initiator, workflow.ActionSetStatus, newStatus, message, codes.FailedPrecondition); err != nil {
+		return nil, err
 	}
 
 	if err := s.db.ApplicationRepository.UpdateApplicationStatus(ctx, entities.UpdateApplicationStatusDTO{
 		ApplicationUUID: req.GetApplicationUuid(),
 		InitiatorUUID:   req.GetInitiatorUuid(),
 		Status:          newStatus,
+		FixLogText:      message,
 	}).GRPCError(); err != nil {
 		return nil, err
 	}
@@ -385,19 +406,12 @@ func (s *ApplicationService) AssignApplication(ctx context.Context, req *pb.Assi
 		return nil, err
 	}
 
-	if !helpers.Contains([]string{"created", "redirected", "recalled", "on_revision"}, application.Status) {
-		return nil, status.Error(codes.InvalidArgument, "can't assign application with current status")
-	}
-
 	initiator, err := s.getEmployeeInfo(ctx, application.CompanyUUID, req.GetInitiatorUuid(), req.GetInitiatorUuid())
 	if err != nil {
 		return nil, err
 	}
-	if initiator.Role != "manager" {
-		return nil, status.Error(codes.PermissionDenied, "only managers can assign applications")
-	}
-	if application.DepartmentUUID != initiator.DepartmentUUID {
-		return nil, status.Error(codes.PermissionDenied, "your department is not responsible")
+	if err = s.checkTransition(ctx, application, initiator, workflow.ActionAssign, "", "", codes.InvalidArgument); err != nil {
+		return nil, err
 	}
 
 	target, err := s.getEmployeeInfo(ctx, application.CompanyUUID, req.GetInitiatorUuid(), req.GetTargetUuid())
@@ -445,19 +459,12 @@ func (s *ApplicationService) RedirectApplication(ctx context.Context, req *pb.Re
 		return nil, err
 	}
 
-	if !helpers.Contains([]string{"created", "redirected", "recalled", "on_revision"}, application.Status) {
-		return nil, status.Error(codes.PermissionDenied, "invalid application status")
-	}
-
 	initiator, err := s.getEmployeeInfo(ctx, application.CompanyUUID, req.GetInitiatorUuid(), req.GetInitiatorUuid())
 	if err != nil {
 		return nil, err
 	}
-	if initiator.Role != "manager" {
-		return nil, status.Error(codes.PermissionDenied, "only managers can redirect applications")
-	}
-	if initiator.DepartmentUUID != application.DepartmentUUID {
-		return nil, status.Error(codes.PermissionDenied, "your department is not responsible")
+	if err = s.checkTransition(ctx, application, initiator, workflow.ActionRedirect, "", message, codes.PermissionDenied); err != nil {
+		return nil, err
 	}
 
 	department, err := s.getDepartmentInfo(ctx, req.GetInitiatorUuid(), req.GetTargetDepartmentUuid())
@@ -500,19 +507,12 @@ func (s *ApplicationService) RecallApplication(ctx context.Context, req *pb.Reca
 		return nil, err
 	}
 
-	if !helpers.Contains([]string{"assigned", "in_progress", "on_hold", "on_revision"}, application.Status) {
-		return nil, status.Error(codes.PermissionDenied, "invalid application status")
-	}
-	if application.ManagedBy != req.GetInitiatorUuid() {
-		return nil, status.Error(codes.PermissionDenied, "only responsible manager can recall applications")
-	}
-
 	initiator, err := s.getEmployeeInfo(ctx, application.CompanyUUID, req.GetInitiatorUuid(), req.GetInitiatorUuid())
 	if err != nil {
 		return nil, err
 	}
-	if initiator.Role != "manager" {
-		return nil, status.Error(codes.PermissionDenied, "only responsible manager can recall applications")
+	if err = s.checkTransition(ctx, application, initiator, workflow.ActionRecall, "", message, codes.PermissionDenied); err != nil {
+		return nil, err
 	}
 
 	if err := s.db.ApplicationRepository.RecallApplication(ctx, entities.RecallApplicationDTO{
@@ -542,19 +542,12 @@ func (s *ApplicationService) TakeApplicationToVerification(ctx context.Context,
 		return nil, err
 	}
 
-	if application.Status != "pending_verification" {
-		return nil, status.Error(codes.PermissionDenied, "invalid application status")
-	}
-
 	initiator, err := s.getEmployeeInfo(ctx, application.CompanyUUID, req.GetInitiatorUuid(), req.GetInitiatorUuid())
 	if err != nil {
 		return nil, err
 	}
-	if initiator.Role != "inspector" {
-		return nil, status.Error(codes.PermissionDenied, "only inspector can take application on verification")
-	}
-	if initiator.DepartmentUUID != application.DepartmentUUID {
-		return nil, status.Error(codes.PermissionDenied, "your department is not responsible")
+	if err = s.checkTransition(ctx, application, initiator, workflow.ActionTakeVerification, "", "", codes.PermissionDenied); err != nil {
+		return nil, err
 	}
 
 	if err := s.db.ApplicationRepository.TakeApplicationToVerification(ctx, entities.TakeApplicationToVerificationDTO{
@@ -587,19 +580,12 @@ func (s *ApplicationService) ReleaseApplicationVerification(ctx context.Context,
 		return nil, err
 	}
 
-	if application.Status != "on_verification" {
-		return nil, status.Error(codes.PermissionDenied, "invalid application status")
-	}
-	if application.InspectedBy != req.GetInitiatorUuid() {
-		return nil, status.Error(codes.PermissionDenied, "only responsible inspector can release application")
-	}
-
 	initiator, err := s.getEmployeeInfo(ctx, application.CompanyUUID, req.GetInitiatorUuid(), req.GetInitiatorUuid())
 	if err != nil {
 		return nil, err
 	}
-	if initiator.Role != "inspector" {
-		return nil, status.Error(codes.PermissionDenied, "only responsible inspector can release application")
+	if err = s.checkTransition(ctx, application, initiator, workflow.ActionReleaseVerification, "", message, codes.PermissionDenied); err != nil {
+		return nil, err
 	}
 
 	if err := s.db.ApplicationRepository.ReleaseApplicationVerification(ctx, entities.ReleaseApplicationVerificationDTO{
@@ -614,7 +600,7 @@ func (s *ApplicationService) ReleaseApplicationVerification(ctx context.Context,
 }
 
 // AddApplicationFixLog Добавление новой записи в fix log заявки
-func (s *ApplicationService) AddApplicationFixLog(ctx context.Context, req *pb.AddApplicationFixLogRequest) (*emptypb.Empty, error) {
+func (s *ApplicationService) AddApplicationFixLog(ctx context.Context, req *pb.AddApplicationFixLogRequest) (*pb.AddApplicationFixLogResponse, error) {
 	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
 	}
@@ -637,7 +623,10 @@ func (s *ApplicationService) AddApplicationFixLog(ctx context.Context, req *pb.A
 		return nil, status.Error(codes.PermissionDenied, "only the responsible engineer can add fix logs")
 	}
 
+	fixLogUUID := uuid.Must(uuid.NewV7()).String()
+
 	if err := s.db.ApplicationRepository.AddApplicationFixLog(ctx, entities.AddFixLogDTO{
+		FixLogUUID:      fixLogUUID,
 		ApplicationUUID: req.GetApplicationUuid(),
 		Text:            message,
 		CreatedBy:       req.GetInitiatorUuid(),
@@ -645,7 +634,7 @@ func (s *ApplicationService) AddApplicationFixLog(ctx context.Context, req *pb.A
 		return nil, err
 	}
 
-	return &emptypb.Empty{}, nil
+	return &pb.AddApplicationFixLogResponse{FixLogUuid: fixLogUUID}, nil
 }
 
 // DeleteApplication Мягкое удаление заявки
@@ -668,19 +657,12 @@ func (s *ApplicationService) DeleteApplication(ctx context.Context, req *pb.Dele
 		return nil, err
 	}
 
-	if application.CreatedBy != req.GetInitiatorUuid() {
-		return nil, status.Error(codes.PermissionDenied, "only a creator can delete application")
-	}
-	if application.Status != "created" {
-		return nil, status.Error(codes.PermissionDenied, "only applications with status 'created' can be deleted")
-	}
-
 	initiator, err := s.getEmployeeInfo(ctx, application.CompanyUUID, req.GetInitiatorUuid(), req.GetInitiatorUuid())
 	if err != nil {
 		return nil, err
 	}
-	if initiator.Role != "inspector" {
-		return nil, status.Error(codes.PermissionDenied, "only a creator can delete application")
+	if err = s.checkTransition(ctx, application, initiator, workflow.ActionDelete, "", message, codes.PermissionDenied); err != nil {
+		return nil, err
 	}
 
 	if err := s.db.ApplicationRepository.DeleteApplication(ctx, entities.DeleteApplicationDTO{
@@ -708,6 +690,13 @@ func (s *ApplicationService) GetApplicationHistory(ctx context.Context, req *pb.
 	if req.GetOffset() < 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid offset")
 	}
+	position, err := cursor.Parse(req.GetCursor(), req.GetOffset())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.GetCursor() != "" && position.Version <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cursor")
+	}
 
 	application, getErr := s.db.ApplicationRepository.GetApplication(ctx, entities.GetApplicationDTO{
 		ApplicationUUID: req.GetApplicationUuid(),
@@ -728,14 +717,26 @@ func (s *ApplicationService) GetApplicationHistory(ctx context.Context, req *pb.
 		}
 	}
 
-	history, getErr := s.db.ApplicationRepository.GetApplicationHistory(ctx, entities.GetApplicationHistoryDTO{
+	historyDTO := entities.GetApplicationHistoryDTO{
 		ApplicationUUID: req.GetApplicationUuid(),
 		Offset:          req.GetOffset(),
-		Count:           req.GetCount(),
-	})
+		Count:           req.GetCount() + 1, // лишняя версия показывает, есть ли следующая страница
+		BeforeVersion:   position.Version,
+	}
+	history, getErr := s.db.ApplicationRepository.GetApplicationHistory(ctx, historyDTO)
 	if err := getErr.GRPCError(); err != nil {
 		return nil, err
 	}
+	total, getErr := s.db.ApplicationRepository.CountApplicationHistory(ctx, historyDTO)
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	nextCursor := ""
+	if int64(len(history)) > req.GetCount() {
+		history = history[:req.GetCount()]
+		nextCursor = cursor.Encode(cursor.Cursor{Version: history[len(history)-1].Version})
+	}
 
 	res := make([]*pb.Application, 0)
 	for _, app := range history {
@@ -758,18 +759,38 @@ func (s *ApplicationService) GetApplicationHistory(ctx context.Context, req *pb.
 			ClosedAt:        app.ClosedAt,
 			DeletedAt:       app.DeletedAt,
 			DeletedBy:       app.DeletedBy,
+			Priority:        app.Priority,
+			DueAt:           app.DueAt,
+			IsOverdue:       app.SLABreach != "",
+			SlaBreach:       app.SLABreach,
+			OverdueAt:       app.OverdueAt,
+			LocationUuid:    app.LocationUUID,
+			CategoryUuid:    app.CategoryUUID,
+			Severity:        app.Severity,
+			CustomFields:    customFieldsToPB(app.CustomFields),
 		})
 	}
 
 	return &pb.GetApplicationHistoryResponse{
-		History: res,
+		History:    res,
+		NextCursor: nextCursor,
+		TotalCount: total,
 	}, nil
 }
 
 // ─── Вспомогательные функции ──────────────────────────────────────────────────
 
-// getEmployeeInfo Получает роль сотрудника из company сервиса
+// getEmployeeInfo Получает роль сотрудника из company сервиса (или из кеша)
 func (s *ApplicationService) getEmployeeInfo(ctx context.Context, companyUUID, initiatorUUID, targetUUID string) (*entities.Employee, error) {
+	// Company сервис отдаёт данные сотрудника только сотруднику той же компании -
+	// из кеша отвечаем, только если там есть и инициатор, и цель
+	if _, ok := s.members.Employee(companyUUID, initiatorUUID); ok {
+		if employee, ok := s.members.Employee(companyUUID, targetUUID); ok {
+			return employee, nil
+		}
+	}
+
+	generation := s.members.Generation()
 	employeeInfo, err := s.companyClient.GetCompanyEmployee(ctx, &company_proto.GetCompanyEmployeeRequest{
 		CompanyUuid:   companyUUID,
 		InitiatorUuid: initiatorUUID,
@@ -779,15 +800,26 @@ func (s *ApplicationService) getEmployeeInfo(ctx context.Context, companyUUID, i
 		return nil, err
 	}
 
-	return &entities.Employee{
+	employee := &entities.Employee{
 		UUID:           targetUUID,
 		Role:           employeeInfo.Role,
 		DepartmentUUID: employeeInfo.DepartmentUuid,
-	}, nil
+	}
+	s.members.SetEmployee(generation, companyUUID, employee)
+
+	return employee, nil
 }
 
-// getDepartmentInfo Получает данные о департаменте из company сервиса
+// getDepartmentInfo Получает данные о департаменте из company сервиса (или из кеша)
 func (s *ApplicationService) getDepartmentInfo(ctx context.Context, initiatorUUID, departmentUUID string) (*entities.Department, error) {
+	// Как и company сервис, отдаём отдел только сотруднику его компании
+	if department, ok := s.members.Department(departmentUUID); ok {
+		if _, ok = s.members.Employee(department.CompanyUUID, initiatorUUID); ok {
+			return department, nil
+		}
+	}
+
+	generation := s.members.Generation()
 	departmentInfo, err := s.companyClient.GetDepartment(ctx, &company_proto.GetDepartmentRequest{
 		InitiatorUuid:  initiatorUUID,
 		DepartmentUuid: departmentUUID,
@@ -796,8 +828,276 @@ func (s *ApplicationService) getDepartmentInfo(ctx context.Context, initiatorUUI
 		return nil, err
 	}
 
-	return &entities.Department{
+	department := &entities.Department{
 		DepartmentUUID: departmentUUID,
 		CompanyUUID:    departmentInfo.GetCompanyUuid(),
-	}, nil
+	}
+	s.members.SetDepartment(generation, department)
+
+	return department, nil
+}
+
+// getLocationPath Получает из company сервиса цепочку мест от объекта (site) до места дефекта включительно.
+// Место чужой компании для инициатора не существует
+func (s *ApplicationService) getLocationPath(ctx context.Context, companyUUID, initiatorUUID, locationUUID string) ([]string, error) {
+	location, err := s.companyClient.GetLocation(ctx, &company_proto.GetLocationRequest{
+		InitiatorUuid: initiatorUUID,
+		LocationUuid:  locationUUID,
+	})
+	if code := status.Code(err); code == codes.NotFound || code == codes.PermissionDenied {
+		return nil, status.Error(codes.InvalidArgument, "location not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if location.GetCompanyUuid() != companyUUID {
+		return nil, status.Error(codes.InvalidArgument, "location not found")
+	}
+
+	path := make([]string, 0, len(location.GetPath()))
+	for _, item := range location.GetPath() {
+		path = append(path, item.GetLocationUuid())
+	}
+	return path, nil
+}
+
+// applicationsFilterParams Параметры фильтра из запроса GetApplications / WatchApplications
+type applicationsFilterParams struct {
+	CompanyUUID    string
+	DepartmentUUID string
+	Statuses       []string
+	IsDeleted      bool
+	FromPool       bool
+	IsOverdue      bool
+}
+
+// applicationsFilter Формирует фильтр видимых инициатору заявок в зависимости от его роли.
+// Используется и для списка заявок, и для потока изменений, чтобы правила видимости совпадали.
+func applicationsFilter(initiator *entities.Employee, params applicationsFilterParams) (entities.GetApplicationsDTO, error) {
+	filter, err := roleApplicationsFilter(initiator, params)
+	if err != nil {
+		return entities.GetApplicationsDTO{}, err
+	}
+
+	// Фильтр по нарушению SLA доступен всем ролям - он только сужает видимые заявки
+	filter.IsOverdue = params.IsOverdue
+	return filter, nil
+}
+
+// applicationsSearchFilter Дополняет фильтр поиском, диапазонами дат, участниками и сортировкой из запроса GetApplications.
+// Эти условия только сужают выборку, поэтому ролевые ограничения видимости сохраняются.
+func applicationsSearchFilter(filter *entities.GetApplicationsDTO, req *pb.GetApplicationsRequest) error {
+	search := strings.TrimSpace(req.GetSearch())
+	if err := validate.ApplicationSearch(search); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	dates := []struct {
+		value string
+		title string
+	}{
+		{req.GetCreatedFrom(), "created_from"},
+		{req.GetCreatedTo(), "created_to"},
+		{req.GetClosedFrom(), "closed_from"},
+		{req.GetClosedTo(), "closed_to"},
+	}
+	for _, d := range dates {
+		if err := validate.DateFilter(d.value, d.title); err != nil && d.value != "" {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if err := validate.UUID(req.GetCreatedBy()); err != nil && req.GetCreatedBy() != "" {
+		return status.Errorf(codes.InvalidArgument, "invalid created_by uuid")
+	}
+	if err := validate.UUID(req.GetExecutedBy()); err != nil && req.GetExecutedBy() != "" {
+		return status.Errorf(codes.InvalidArgument, "invalid executed_by uuid")
+	}
+	if err := validate.UUID(req.GetManagedBy()); err != nil && req.GetManagedBy() != "" {
+		return status.Errorf(codes.InvalidArgument, "invalid managed_by uuid")
+	}
+	if err := validate.UUID(req.GetLocationUuid()); err != nil && req.GetLocationUuid() != "" {
+		return status.Errorf(codes.InvalidArgument, "invalid location uuid")
+	}
+	if err := validate.UUID(req.GetCategoryUuid()); err != nil && req.GetCategoryUuid() != "" {
+		return status.Errorf(codes.InvalidArgument, "invalid category uuid")
+	}
+	for _, severity := range req.GetSeverities() {
+		if err := validate.ApplicationSeverity(severity); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	customFields, err := customFieldsFilter(req.GetCustomFields())
+	if err != nil {
+		return err
+	}
+
+	if req.RevisionCountMin != nil && req.GetRevisionCountMin() < 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid revision_count_min")
+	}
+	if req.RevisionCountMax != nil && req.GetRevisionCountMax() < 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid revision_count_max")
+	}
+	if req.RevisionCountMin != nil && req.RevisionCountMax != nil && req.GetRevisionCountMin() > req.GetRevisionCountMax() {
+		return status.Errorf(codes.InvalidArgument, "revision_count_min is greater than revision_count_max")
+	}
+
+	sortBy := req.GetSortBy()
+	if sortBy == "" {
+		sortBy = "created_at"
+	}
+	if err := validate.ApplicationSortBy(sortBy); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if sortBy == "relevance" && search == "" {
+		return status.Errorf(codes.InvalidArgument, "sort by relevance requires search")
+	}
+	sortOrder := req.GetSortOrder()
+	if sortOrder == "" {
+		sortOrder = "desc"
+	}
+	if err := validate.SortOrder(sortOrder); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter.Search = search
+	filter.CreatedFrom = req.GetCreatedFrom()
+	filter.CreatedTo = req.GetCreatedTo()
+	filter.ClosedFrom = req.GetClosedFrom()
+	filter.ClosedTo = req.GetClosedTo()
+	filter.Creator = req.GetCreatedBy()
+	filter.Executor = req.GetExecutedBy()
+	filter.Manager = req.GetManagedBy()
+	filter.RevisionCountMin = req.RevisionCountMin
+	filter.RevisionCountMax = req.RevisionCountMax
+	filter.LocationUUID = req.GetLocationUuid()
+	filter.CategoryUUID = req.GetCategoryUuid()
+	filter.Severities = req.GetSeverities()
+	filter.CustomFields = customFields
+	filter.SortBy = sortBy
+	filter.SortOrder = sortOrder
+	return nil
+}
+
+func roleApplicationsFilter(initiator *entities.Employee, params applicationsFilterParams) (entities.GetApplicationsDTO, error) {
+	switch initiator.Role {
+
+	// Если инициатор "chief" или "analytic" - используем department_uuid и status из запроса
+	case "chief", "analytic":
+		if err := validate.UUID(params.DepartmentUUID); err != nil && params.DepartmentUUID != "" {
+			return entities.GetApplicationsDTO{}, status.Errorf(codes.InvalidArgument, "invalid department uuid")
+		}
+		if !helpers.ContainsAll(AllApplicationStatuses, params.Statuses) {
+			return entities.GetApplicationsDTO{}, status.Errorf(codes.InvalidArgument, "invalid statuses")
+		}
+
+		return entities.GetApplicationsDTO{
+			CompanyUUID:    params.CompanyUUID,
+			DepartmentUUID: params.DepartmentUUID,
+			Statuses:       params.Statuses,
+			IsDeleted:      params.IsDeleted,
+		}, nil
+
+	// Если инициатор "inspector" - department_uuid инициатора, statuses: ["pending_verification", "on_verification"]
+	case "inspector":
+		if params.FromPool {
+			return entities.GetApplicationsDTO{
+				CompanyUUID:    params.CompanyUUID,
+				DepartmentUUID: initiator.DepartmentUUID,
+				Statuses:       []string{"pending_verification"},
+			}, nil
+		}
+
+		createdBy := initiator.UUID
+		inspectedBy := initiator.UUID
+
+		if len(params.Statuses) == 0 {
+			inspectedBy = ""
+		} else {
+			createdBy = ""
+			if !helpers.ContainsAll([]string{"on_verification"}, params.Statuses) {
+				return entities.GetApplicationsDTO{}, status.Errorf(codes.InvalidArgument, "invalid statuses")
+			}
+		}
+
+		return entities.GetApplicationsDTO{
+			CompanyUUID:    params.CompanyUUID,
+			DepartmentUUID: initiator.DepartmentUUID,
+			Statuses:       params.Statuses,
+			CreatedBy:      createdBy,
+			InspectedBy:    inspectedBy,
+			IsDeleted:      params.IsDeleted,
+		}, nil
+
+	// Если инициатор "manager" - department_uuid инициатора, statuses: ["created", "redirected", "recalled", "on_revision"]
+	case "manager":
+		if params.FromPool {
+			return entities.GetApplicationsDTO{
+				CompanyUUID:      params.CompanyUUID,
+				DepartmentUUID:   initiator.DepartmentUUID,
+				Statuses:         []string{"created", "redirected", "recalled", "on_revision"},
+				ExecutedByIsNull: true,
+			}, nil
+		}
+
+		return entities.GetApplicationsDTO{
+			CompanyUUID:    params.CompanyUUID,
+			DepartmentUUID: initiator.DepartmentUUID,
+			ManagedBy:      initiator.UUID,
+		}, nil
+
+	// Если инициатор "engineer" - department_uuid инициатора, statuses: ["assigned", "on_revision", "in_progress", "on_hold"]
+	case "engineer":
+		if !helpers.ContainsAll([]string{"assigned", "on_revision", "in_progress", "on_hold"}, params.Statuses) {
+			return entities.GetApplicationsDTO{}, status.Errorf(codes.InvalidArgument, "invalid statuses")
+		}
+
+		return entities.GetApplicationsDTO{
+			CompanyUUID:    params.CompanyUUID,
+			DepartmentUUID: initiator.DepartmentUUID,
+			Statuses:       params.Statuses,
+			ExecutedBy:     initiator.UUID,
+		}, nil
+
+	default:
+		return entities.GetApplicationsDTO{}, status.Error(codes.PermissionDenied, "not allowed to get applications")
+	}
+}
+
+// matchesApplicationsFilter Проверка заявки на соответствие фильтру.
+// Повторяет условия WHERE из ApplicationRepository.GetApplications.
+func matchesApplicationsFilter(filter entities.GetApplicationsDTO, app *entities.Application) bool {
+	switch {
+	case app.CompanyUUID != filter.CompanyUUID:
+		return false
+	case len(filter.Statuses) > 0 && !helpers.Contains(filter.Statuses, app.Status):
+		return false
+	case filter.CreatedBy != "" && app.CreatedBy != filter.CreatedBy:
+		return false
+	case filter.ManagedBy != "" && app.ManagedBy != filter.ManagedBy:
+		return false
+	case filter.ExecutedBy != "" && app.ExecutedBy != filter.ExecutedBy:
+		return false
+	case filter.InspectedBy != "" && app.InspectedBy != filter.InspectedBy:
+		return false
+	case filter.ExecutedByIsNull && app.ExecutedBy != "":
+		return false
+	case filter.DepartmentUUID != "" && app.DepartmentUUID != filter.DepartmentUUID:
+		return false
+	case filter.IsOverdue && app.SLABreach == "":
+		return false
+	case filter.LocationUUID != "" && !helpers.Contains(app.LocationPath, filter.LocationUUID):
+		return false
+	case filter.CategoryUUID != "" && app.CategoryUUID != filter.CategoryUUID:
+		return false
+	case len(filter.Severities) > 0 && !helpers.Contains(filter.Severities, app.Severity):
+		return false
+	}
+	for key, value := range filter.CustomFields {
+		if app.CustomFields[key] != value {
+			return false
+		}
+	}
+	return filter.IsDeleted == (app.DeletedAt != "")
 }
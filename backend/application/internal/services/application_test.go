@@ -8,6 +8,7 @@ import (
 	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
 	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
 	company_proto "github.com/unwelcome/FrameWorkTask1/backend/contracts/company/generated"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/cursor"
 	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -111,6 +112,73 @@ func TestCreateApplication(t *testing.T) {
 		assertCode(t, err, codes.InvalidArgument)
 	})
 
+	t.Run("with location — path saved", func(t *testing.T) {
+		var got entities.CreateApplicationDTO
+		repo := emptyRepo()
+		repo.createApplication = func(_ context.Context, dto entities.CreateApplicationDTO) Error.CodeError {
+			got = dto
+			return ok()
+		}
+
+		client := roleClient("inspector")
+		client.getLocation = func(_ context.Context, in *company_proto.GetLocationRequest, _ ...grpc.CallOption) (*company_proto.GetLocationResponse, error) {
+			return &company_proto.GetLocationResponse{
+				CompanyUuid: companyID,
+				Location:    &company_proto.Location{LocationUuid: in.GetLocationUuid()},
+				Path: []*company_proto.Location{
+					{LocationUuid: otherUserID, Kind: "site"},
+					{LocationUuid: in.GetLocationUuid(), Kind: "building"},
+				},
+			}, nil
+		}
+
+		svc := newAppTestService(repo, client)
+		_, err := svc.CreateApplication(context.Background(), &pb.CreateApplicationRequest{
+			InitiatorUuid:   initiatorID,
+			CompanyUuid:     companyID,
+			ApplicationData: &pb.ApplicationData{Title: "Title", Description: "Desc"},
+			LocationUuid:    otherDeptID,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.LocationUUID != otherDeptID || len(got.LocationPath) != 2 || got.LocationPath[0] != otherUserID {
+			t.Errorf("unexpected location %q with path %v", got.LocationUUID, got.LocationPath)
+		}
+	})
+
+	t.Run("location of another company", func(t *testing.T) {
+		client := roleClient("inspector")
+		client.getLocation = func(_ context.Context, in *company_proto.GetLocationRequest, _ ...grpc.CallOption) (*company_proto.GetLocationResponse, error) {
+			return &company_proto.GetLocationResponse{CompanyUuid: otherUserID}, nil
+		}
+
+		svc := newAppTestService(emptyRepo(), client)
+		_, err := svc.CreateApplication(context.Background(), &pb.CreateApplicationRequest{
+			InitiatorUuid:   initiatorID,
+			CompanyUuid:     companyID,
+			ApplicationData: &pb.ApplicationData{Title: "Title", Description: "Desc"},
+			LocationUuid:    otherDeptID,
+		})
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("location not found", func(t *testing.T) {
+		client := roleClient("inspector")
+		client.getLocation = func(_ context.Context, _ *company_proto.GetLocationRequest, _ ...grpc.CallOption) (*company_proto.GetLocationResponse, error) {
+			return nil, status.Error(codes.NotFound, "location not found")
+		}
+
+		svc := newAppTestService(emptyRepo(), client)
+		_, err := svc.CreateApplication(context.Background(), &pb.CreateApplicationRequest{
+			InitiatorUuid:   initiatorID,
+			CompanyUuid:     companyID,
+			ApplicationData: &pb.ApplicationData{Title: "Title", Description: "Desc"},
+			LocationUuid:    otherDeptID,
+		})
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
 	t.Run("invalid_company_uuid", func(t *testing.T) {
 		svc := newAppTestService(emptyRepo(), roleClient("inspector"))
 		_, err := svc.CreateApplication(context.Background(), &pb.CreateApplicationRequest{
@@ -131,7 +199,7 @@ func TestGetApplication(t *testing.T) {
 			return []*entities.FixLog{}, ok()
 		}
 
-		svc := newAppTestService(repo, roleClient("inspector"))
+		svc := newAttachmentTestService(repo, attachmentRepoWith(), &mockAttachmentStorage{}, roleClient("inspector"))
 		res, err := svc.GetApplication(context.Background(), &pb.GetApplicationRequest{
 			InitiatorUuid:   initiatorID, // matches CreatedBy
 			ApplicationUuid: appID,
@@ -150,7 +218,7 @@ func TestGetApplication(t *testing.T) {
 			return nil, ok()
 		}
 
-		svc := newAppTestService(repo, roleClient("chief"))
+		svc := newAttachmentTestService(repo, attachmentRepoWith(), &mockAttachmentStorage{}, roleClient("chief"))
 		_, err := svc.GetApplication(context.Background(), &pb.GetApplicationRequest{
 			InitiatorUuid:   otherUserID,
 			ApplicationUuid: appID,
@@ -160,6 +228,53 @@ func TestGetApplication(t *testing.T) {
 		}
 	})
 
+	t.Run("attachments grouped by fix log", func(t *testing.T) {
+		repo := repoWithApp(testApp())
+		repo.getApplicationFixLogs = func(_ context.Context, _ entities.GetApplicationFixLogsDTO) ([]*entities.FixLog, Error.CodeError) {
+			return []*entities.FixLog{{UUID: targetID, Text: "done", CreatedBy: initiatorID}}, ok()
+		}
+		attachmentRepo := attachmentRepoWith(
+			&entities.Attachment{UUID: deptID, ApplicationUUID: appID},
+			&entities.Attachment{UUID: otherDeptID, ApplicationUUID: appID, FixLogUUID: targetID},
+		)
+
+		svc := newAttachmentTestService(repo, attachmentRepo, &mockAttachmentStorage{}, roleClient("inspector"))
+		res, err := svc.GetApplication(context.Background(), &pb.GetApplicationRequest{
+			InitiatorUuid:   initiatorID,
+			ApplicationUuid: appID,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		app := res.GetApplication()
+		if len(app.GetAttachments()) != 1 || app.GetAttachments()[0].GetAttachmentUuid() != deptID {
+			t.Errorf("expected application attachment %q, got %v", deptID, app.GetAttachments())
+		}
+		if len(app.GetFixLogs()) != 1 || len(app.GetFixLogs()[0].GetAttachments()) != 1 ||
+			app.GetFixLogs()[0].GetAttachments()[0].GetAttachmentUuid() != otherDeptID {
+			t.Errorf("expected fix log attachment %q, got %v", otherDeptID, app.GetFixLogs())
+		}
+	})
+
+	t.Run("attachments db error", func(t *testing.T) {
+		repo := repoWithApp(testApp())
+		repo.getApplicationFixLogs = func(_ context.Context, _ entities.GetApplicationFixLogsDTO) ([]*entities.FixLog, Error.CodeError) {
+			return nil, ok()
+		}
+		attachmentRepo := &mockAttachmentRepo{
+			getApplicationAttachments: func(_ context.Context, _ entities.GetApplicationAttachmentsDTO) ([]*entities.Attachment, Error.CodeError) {
+				return nil, internalErr()
+			},
+		}
+
+		svc := newAttachmentTestService(repo, attachmentRepo, &mockAttachmentStorage{}, roleClient("inspector"))
+		_, err := svc.GetApplication(context.Background(), &pb.GetApplicationRequest{
+			InitiatorUuid:   initiatorID,
+			ApplicationUuid: appID,
+		})
+		assertCode(t, err, codes.Internal)
+	})
+
 	t.Run("permission denied (stranger)", func(t *testing.T) {
 		repo := repoWithApp(testApp())
 
@@ -489,6 +604,103 @@ func TestGetApplications(t *testing.T) {
 	})
 }
 
+func TestGetApplicationsSearch(t *testing.T) {
+	int64Ptr := func(n int64) *int64 { return &n }
+
+	t.Run("filters passed to repository", func(t *testing.T) {
+		var got entities.GetApplicationsDTO
+		repo := emptyRepo()
+		repo.getApplications = func(_ context.Context, dto entities.GetApplicationsDTO) ([]*entities.Application, Error.CodeError) {
+			got = dto
+			return nil, ok()
+		}
+
+		svc := newAppTestService(repo, roleClient("analytic"))
+		_, err := svc.GetApplications(context.Background(), &pb.GetApplicationsRequest{
+			InitiatorUuid:    initiatorID,
+			CompanyUuid:      companyID,
+			Count:            10,
+			Search:           "  трещина этаж 3 ",
+			CreatedFrom:      "2024-03-01",
+			ClosedTo:         "2024-06-01T00:00:00Z",
+			ExecutedBy:       targetID,
+			RevisionCountMin: int64Ptr(1),
+			SortBy:           "relevance",
+			LocationUuid:     otherDeptID,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Search != "трещина этаж 3" || got.CreatedFrom != "2024-03-01" || got.ClosedTo != "2024-06-01T00:00:00Z" {
+			t.Errorf("unexpected search filters: %+v", got)
+		}
+		if got.Executor != targetID || got.RevisionCountMin == nil || *got.RevisionCountMin != 1 || got.RevisionCountMax != nil {
+			t.Errorf("unexpected participant filters: %+v", got)
+		}
+		if got.LocationUUID != otherDeptID {
+			t.Errorf("expected location filter %q, got %q", otherDeptID, got.LocationUUID)
+		}
+		if got.SortBy != "relevance" || got.SortOrder != "desc" {
+			t.Errorf("expected relevance desc, got %s %s", got.SortBy, got.SortOrder)
+		}
+	})
+
+	t.Run("role restriction kept", func(t *testing.T) {
+		var got entities.GetApplicationsDTO
+		repo := emptyRepo()
+		repo.getApplications = func(_ context.Context, dto entities.GetApplicationsDTO) ([]*entities.Application, Error.CodeError) {
+			got = dto
+			return nil, ok()
+		}
+
+		svc := newAppTestService(repo, roleClient("engineer"))
+		_, err := svc.GetApplications(context.Background(), &pb.GetApplicationsRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+			Count:         10,
+			ExecutedBy:    otherUserID,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// Инженер видит только свои заявки - фильтр по другому исполнителю лишь сужает выборку до пустой
+		if got.ExecutedBy != initiatorID || got.Executor != otherUserID {
+			t.Errorf("expected own applications filtered by executor, got %+v", got)
+		}
+		if got.SortBy != "created_at" || got.SortOrder != "desc" {
+			t.Errorf("expected default sort, got %s %s", got.SortBy, got.SortOrder)
+		}
+	})
+
+	cases := []struct {
+		name string
+		req  *pb.GetApplicationsRequest
+	}{
+		{"search too long", &pb.GetApplicationsRequest{Search: strings.Repeat("а", 201)}},
+		{"invalid created_from", &pb.GetApplicationsRequest{CreatedFrom: "01.03.2024"}},
+		{"invalid closed_to", &pb.GetApplicationsRequest{ClosedTo: "yesterday"}},
+		{"invalid created_by", &pb.GetApplicationsRequest{CreatedBy: "not-a-uuid"}},
+		{"invalid managed_by", &pb.GetApplicationsRequest{ManagedBy: "not-a-uuid"}},
+		{"invalid location", &pb.GetApplicationsRequest{LocationUuid: "not-a-uuid"}},
+		{"negative revision count", &pb.GetApplicationsRequest{RevisionCountMin: int64Ptr(-1)}},
+		{"revision count range", &pb.GetApplicationsRequest{RevisionCountMin: int64Ptr(3), RevisionCountMax: int64Ptr(1)}},
+		{"unknown sort field", &pb.GetApplicationsRequest{SortBy: "title"}},
+		{"invalid sort order", &pb.GetApplicationsRequest{SortOrder: "up"}},
+		{"relevance without search", &pb.GetApplicationsRequest{SortBy: "relevance"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.req.InitiatorUuid = initiatorID
+			tc.req.CompanyUuid = companyID
+			tc.req.Count = 10
+
+			svc := newAppTestService(emptyRepo(), roleClient("chief"))
+			_, err := svc.GetApplications(context.Background(), tc.req)
+			assertCode(t, err, codes.InvalidArgument)
+		})
+	}
+}
+
 // ─── UpdateApplicationStatus ──────────────────────────────────────────────────
 
 func TestUpdateApplicationStatus(t *testing.T) {
@@ -1285,10 +1497,14 @@ func TestReleaseApplicationVerification(t *testing.T) {
 func TestAddApplicationFixLog(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		repo := repoWithApp(inProgressApp()) // ExecutedBy=initiatorID
-		repo.addApplicationFixLog = func(_ context.Context, _ entities.AddFixLogDTO) Error.CodeError { return ok() }
+		var saved entities.AddFixLogDTO
+		repo.addApplicationFixLog = func(_ context.Context, dto entities.AddFixLogDTO) Error.CodeError {
+			saved = dto
+			return ok()
+		}
 
 		svc := newAppTestService(repo, roleClient("engineer"))
-		_, err := svc.AddApplicationFixLog(context.Background(), &pb.AddApplicationFixLogRequest{
+		res, err := svc.AddApplicationFixLog(context.Background(), &pb.AddApplicationFixLogRequest{
 			InitiatorUuid:   initiatorID,
 			ApplicationUuid: appID,
 			Message:         "Fixed the issue",
@@ -1296,6 +1512,9 @@ func TestAddApplicationFixLog(t *testing.T) {
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
+		if res.GetFixLogUuid() == "" || res.GetFixLogUuid() != saved.FixLogUUID {
+			t.Errorf("expected fix log uuid %q, got %q", saved.FixLogUUID, res.GetFixLogUuid())
+		}
 	})
 
 	t.Run("empty message", func(t *testing.T) {
@@ -1643,8 +1862,9 @@ func TestGetApplicationHistory(t *testing.T) {
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
-		if capturedDTO.Count != 50 {
-			t.Errorf("expected Count=50, got %d", capturedDTO.Count)
+		// Запрашивается на одну версию больше - по ней определяется наличие следующей страницы
+		if capturedDTO.Count != 51 {
+			t.Errorf("expected Count=51, got %d", capturedDTO.Count)
 		}
 		if capturedDTO.Offset != 20 {
 			t.Errorf("expected Offset=20, got %d", capturedDTO.Offset)
@@ -1710,6 +1930,175 @@ func TestGetApplicationHistory(t *testing.T) {
 	})
 }
 
+// ─── Cursor pagination ────────────────────────────────────────────────────────
+
+func TestApplicationsCursorPagination(t *testing.T) {
+	// appsWithUUIDs — заявки с заданными uuid в порядке выдачи репозиторием
+	appsWithUUIDs := func(uuids ...string) []*entities.Application {
+		apps := make([]*entities.Application, 0, len(uuids))
+		for _, id := range uuids {
+			app := testApp()
+			app.ApplicationUUID = id
+			apps = append(apps, app)
+		}
+		return apps
+	}
+
+	t.Run("next cursor and total", func(t *testing.T) {
+		var captured entities.GetApplicationsDTO
+		repo := emptyRepo()
+		repo.getApplications = func(_ context.Context, dto entities.GetApplicationsDTO) ([]*entities.Application, Error.CodeError) {
+			captured = dto
+			return appsWithUUIDs(appID, targetID, otherUserID), ok()
+		}
+		repo.countFilteredApplications = func(_ context.Context, _ entities.GetApplicationsDTO) (int64, Error.CodeError) {
+			return 7, ok()
+		}
+
+		svc := newAppTestService(repo, roleClient("chief"))
+		res, err := svc.GetApplications(context.Background(), &pb.GetApplicationsRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+			Count:         2,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if captured.Count != 3 {
+			t.Errorf("expected lookahead Count=3, got %d", captured.Count)
+		}
+		if len(res.GetApplications()) != 2 || res.GetTotalCount() != 7 {
+			t.Fatalf("expected 2 applications of 7, got %d of %d", len(res.GetApplications()), res.GetTotalCount())
+		}
+
+		// Курсор следующей страницы указывает на последнюю отданную заявку
+		captured = entities.GetApplicationsDTO{}
+		repo.getApplications = func(_ context.Context, dto entities.GetApplicationsDTO) ([]*entities.Application, Error.CodeError) {
+			captured = dto
+			return appsWithUUIDs(otherUserID), ok()
+		}
+		res, err = svc.GetApplications(context.Background(), &pb.GetApplicationsRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+			Count:         2,
+			Cursor:        res.GetNextCursor(),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if captured.AfterUUID != targetID {
+			t.Errorf("expected AfterUUID=%q, got %q", targetID, captured.AfterUUID)
+		}
+		if res.GetNextCursor() != "" {
+			t.Errorf("expected empty next cursor on the last page, got %q", res.GetNextCursor())
+		}
+	})
+
+	t.Run("no cursor for other sorts", func(t *testing.T) {
+		repo := emptyRepo()
+		repo.getApplications = func(_ context.Context, _ entities.GetApplicationsDTO) ([]*entities.Application, Error.CodeError) {
+			return appsWithUUIDs(appID, targetID), ok()
+		}
+
+		svc := newAppTestService(repo, roleClient("chief"))
+		res, err := svc.GetApplications(context.Background(), &pb.GetApplicationsRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+			Count:         1,
+			SortBy:        "priority",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.GetNextCursor() != "" {
+			t.Errorf("expected no cursor for priority sort, got %q", res.GetNextCursor())
+		}
+	})
+
+	cases := []struct {
+		name string
+		req  *pb.GetApplicationsRequest
+	}{
+		{"cursor with offset", &pb.GetApplicationsRequest{Cursor: cursor.Encode(cursor.Cursor{ID: appID}), Offset: 10}},
+		{"malformed cursor", &pb.GetApplicationsRequest{Cursor: "%%%"}},
+		{"cursor without uuid", &pb.GetApplicationsRequest{Cursor: cursor.Encode(cursor.Cursor{Version: 3})}},
+		{"cursor with other sort", &pb.GetApplicationsRequest{Cursor: cursor.Encode(cursor.Cursor{ID: appID}), SortBy: "priority"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.req.InitiatorUuid = initiatorID
+			tc.req.CompanyUuid = companyID
+			tc.req.Count = 10
+
+			svc := newAppTestService(emptyRepo(), roleClient("chief"))
+			_, err := svc.GetApplications(context.Background(), tc.req)
+			assertCode(t, err, codes.InvalidArgument)
+		})
+	}
+
+	t.Run("history cursor", func(t *testing.T) {
+		versions := func(from, to int64) []*entities.Application {
+			apps := make([]*entities.Application, 0)
+			for v := from; v >= to; v-- {
+				app := testApp()
+				app.Version = v
+				apps = append(apps, app)
+			}
+			return apps
+		}
+
+		var captured entities.GetApplicationHistoryDTO
+		repo := repoWithApp(testApp())
+		repo.getApplicationHistory = func(_ context.Context, dto entities.GetApplicationHistoryDTO) ([]*entities.Application, Error.CodeError) {
+			captured = dto
+			if dto.BeforeVersion == 0 {
+				return versions(5, 3), ok()
+			}
+			return versions(dto.BeforeVersion-1, 1), ok()
+		}
+
+		svc := newAppTestService(repo, roleClient("inspector"))
+		res, err := svc.GetApplicationHistory(context.Background(), &pb.GetApplicationHistoryRequest{
+			InitiatorUuid:   initiatorID,
+			ApplicationUuid: appID,
+			Count:           2,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(res.GetHistory()) != 2 || res.GetNextCursor() == "" {
+			t.Fatalf("expected 2 versions and next cursor, got %d %q", len(res.GetHistory()), res.GetNextCursor())
+		}
+
+		res, err = svc.GetApplicationHistory(context.Background(), &pb.GetApplicationHistoryRequest{
+			InitiatorUuid:   initiatorID,
+			ApplicationUuid: appID,
+			Count:           2,
+			Cursor:          res.GetNextCursor(),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if captured.BeforeVersion != 4 {
+			t.Errorf("expected BeforeVersion=4, got %d", captured.BeforeVersion)
+		}
+		if len(res.GetHistory()) != 2 || res.GetNextCursor() == "" {
+			t.Errorf("expected versions 3..2 and next cursor, got %d %q", len(res.GetHistory()), res.GetNextCursor())
+		}
+	})
+
+	t.Run("history cursor without version", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("inspector"))
+		_, err := svc.GetApplicationHistory(context.Background(), &pb.GetApplicationHistoryRequest{
+			InitiatorUuid:   initiatorID,
+			ApplicationUuid: appID,
+			Count:           2,
+			Cursor:          cursor.Encode(cursor.Cursor{ID: appID}),
+		})
+		assertCode(t, err, codes.InvalidArgument)
+	})
+}
+
 // ─── Helpers ──────────────────────────────────────────────────────────────────
 
 func assertCode(t *testing.T, err error, expected codes.Code) {
@@ -1731,3 +2120,43 @@ var _ company_proto.CompanyServiceClient = (*mockCompanyClient)(nil)
 
 // Suppress unused import: grpc is used in mock function signatures in mocks_test.go
 var _ grpc.CallOption
+
+// ─── Кеш ролей сотрудников ────────────────────────────────────────────────────
+
+func TestEmployeeInfoCache(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	client := &mockCompanyClient{
+		getCompanyEmployee: func(_ context.Context, _ *company_proto.GetCompanyEmployeeRequest, _ ...grpc.CallOption) (*company_proto.GetCompanyEmployeeResponse, error) {
+			calls++
+			return &company_proto.GetCompanyEmployeeResponse{Role: "manager", DepartmentUuid: deptID}, nil
+		},
+	}
+	svc := newAppTestService(emptyRepo(), client)
+
+	getEmployee := func(initiatorUUID, targetUUID string, wantCalls int) {
+		t.Helper()
+		employee, err := svc.getEmployeeInfo(ctx, companyID, initiatorUUID, targetUUID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if employee.UUID != targetUUID || employee.Role != "manager" {
+			t.Errorf("unexpected employee: %+v", employee)
+		}
+		if calls != wantCalls {
+			t.Errorf("expected %d company service calls, got %d", wantCalls, calls)
+		}
+	}
+
+	getEmployee(initiatorID, initiatorID, 1)
+	getEmployee(initiatorID, initiatorID, 1)
+	getEmployee(initiatorID, targetID, 2)
+	getEmployee(initiatorID, targetID, 2)
+
+	// Цель в кеше, но инициатор нет - членство инициатора проверяет company сервис
+	getEmployee(otherUserID, targetID, 3)
+
+	// Роль изменилась в company сервисе - следующий запрос идёт мимо кеша
+	svc.members.InvalidateEmployee(companyID, initiatorID)
+	getEmployee(initiatorID, initiatorID, 4)
+}
@@ -0,0 +1,148 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/mtls"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// archivePeer Сервис, которому разрешено архивировать заявки компании (CN клиентского сертификата)
+const archivePeer = "company"
+
+// ArchiveCompanyApplications Шаг удаления компании: выгрузка всех заявок компании (включая удалённые)
+// в архив JSON Lines в хранилище, затем удаление вложений, заявок и настроек заявок компании.
+// Вызывается только company сервисом. Повторный вызов безопасен: если заявки уже удалены,
+// возвращается архив, записанный предыдущим вызовом
+func (s *ApplicationService) ArchiveCompanyApplications(ctx context.Context, req *pb.ArchiveCompanyApplicationsRequest) (*pb.ArchiveCompanyApplicationsResponse, error) {
+	if peerName, _ := mtls.PeerName(ctx); peerName != archivePeer {
+		return nil, status.Errorf(codes.PermissionDenied, "only company service can archive company applications")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+
+	archiveKey := fmt.Sprintf("archives/companies/%s.jsonl", req.GetCompanyUuid())
+	archived, err := s.countCompanyApplications(ctx, req.GetCompanyUuid())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.ArchiveCompanyApplicationsResponse{}
+	if archived > 0 {
+		if err = s.writeCompanyArchive(ctx, req.GetCompanyUuid(), archiveKey, archived); err != nil {
+			return nil, err
+		}
+		res.ArchiveKey, res.Archived = archiveKey, archived
+	} else {
+		archive, getErr := s.storage.Archive.GetArchive(ctx, entities.GetArchiveObjectDTO{ObjectKey: archiveKey})
+		switch getErr.Code {
+		case codes.OK:
+			res.ArchiveKey, res.Archived = archive.ObjectKey, archive.Archived
+		case codes.NotFound:
+			// У компании не было заявок - архив не нужен
+		default:
+			return nil, getErr.GRPCError()
+		}
+	}
+
+	// Содержимое вложений удаляется раньше метаданных: после сбоя ключи объектов найдутся при повторном вызове
+	keys, getErr := s.db.AttachmentRepository.GetCompanyAttachmentKeys(ctx, entities.GetCompanyAttachmentKeysDTO{CompanyUUID: req.GetCompanyUuid()})
+	if err = getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if err = s.storage.Attachment.DeleteAttachment(ctx, entities.DeleteAttachmentObjectDTO{ObjectKey: key}).GRPCError(); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, purgeErr := s.db.ApplicationRepository.PurgeCompanyApplications(ctx, entities.PurgeCompanyApplicationsDTO{
+		CompanyUUID: req.GetCompanyUuid(),
+	}); purgeErr.Code != 0 {
+		return nil, purgeErr.GRPCError()
+	}
+
+	return res, nil
+}
+
+// countCompanyApplications Кол-во заявок компании вместе с удалёнными
+func (s *ApplicationService) countCompanyApplications(ctx context.Context, companyUUID string) (int64, error) {
+	var total int64
+	for _, deleted := range []bool{false, true} {
+		count, dbErr := s.db.ApplicationRepository.CountFilteredApplications(ctx, entities.GetApplicationsDTO{
+			CompanyUUID: companyUUID,
+			IsDeleted:   deleted,
+		})
+		if err := dbErr.GRPCError(); err != nil {
+			return 0, err
+		}
+		total += count
+	}
+	return total, nil
+}
+
+// writeCompanyArchive Запись архива потоком: заявки читаются пачками и сразу передаются в хранилище
+func (s *ApplicationService) writeCompanyArchive(ctx context.Context, companyUUID, archiveKey string, archived int64) error {
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(s.exportCompanyApplications(ctx, companyUUID, writer))
+	}()
+
+	putErr := s.storage.Archive.PutArchive(ctx, entities.PutArchiveObjectDTO{
+		ObjectKey: archiveKey,
+		Content:   reader,
+		Archived:  archived,
+	})
+	// Если хранилище прекратило чтение раньше, выгрузка получит ошибку записи и завершится
+	reader.Close()
+	return putErr.GRPCError()
+}
+
+// exportCompanyApplications Выгрузка заявок компании (сначала действующих, затем удалённых) по одной на строку
+func (s *ApplicationService) exportCompanyApplications(ctx context.Context, companyUUID string, w io.Writer) error {
+	for _, deleted := range []bool{false, true} {
+		filter := entities.GetApplicationsDTO{
+			CompanyUUID: companyUUID,
+			IsDeleted:   deleted,
+			Count:       exportBatchSize,
+		}
+
+		for {
+			applications, dbErr := s.db.ApplicationRepository.GetApplications(ctx, filter)
+			if err := dbErr.GRPCError(); err != nil {
+				return err
+			}
+			if len(applications) == 0 {
+				break
+			}
+
+			exported, err := s.exportBatch(ctx, applications)
+			if err != nil {
+				return err
+			}
+			for _, app := range exported {
+				line, marshalErr := protojson.Marshal(app)
+				if marshalErr != nil {
+					return status.Error(codes.Internal, "internal error")
+				}
+				if _, err = w.Write(append(line, '\n')); err != nil {
+					return err
+				}
+			}
+
+			if len(applications) < exportBatchSize {
+				break
+			}
+			filter.AfterUUID = applications[len(applications)-1].ApplicationUUID
+		}
+	}
+	return nil
+}
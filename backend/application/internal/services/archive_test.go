@@ -0,0 +1,178 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"testing"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// peerContext — контекст входящего mTLS вызова от сервиса с указанным CN сертификата
+func peerContext(commonName string) context.Context {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: commonName}}
+	return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{
+		State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+	}})
+}
+
+// archiveTestService — сервис с total действующими заявками компании и фиксацией удалённых вложений и очистки
+func archiveTestService(total int, storage *mockArchiveStorage, deletedKeys *[]string, purged *bool) *ApplicationService {
+	var batches []entities.GetApplicationsDTO
+	repo := exportRepo(total, &batches)
+	getApplications := repo.getApplications
+	repo.getApplications = func(ctx context.Context, dto entities.GetApplicationsDTO) ([]*entities.Application, Error.CodeError) {
+		if dto.IsDeleted {
+			return nil, ok()
+		}
+		return getApplications(ctx, dto)
+	}
+	repo.countFilteredApplications = func(_ context.Context, dto entities.GetApplicationsDTO) (int64, Error.CodeError) {
+		if dto.IsDeleted || *purged {
+			return 0, ok()
+		}
+		return int64(total), ok()
+	}
+	repo.purgeCompanyApplications = func(_ context.Context, dto entities.PurgeCompanyApplicationsDTO) (int64, Error.CodeError) {
+		*purged = true
+		return int64(total), ok()
+	}
+
+	attachmentRepo := &mockAttachmentRepo{
+		getCompanyAttachmentKeys: func(_ context.Context, _ entities.GetCompanyAttachmentKeysDTO) ([]string, Error.CodeError) {
+			if *purged {
+				return nil, ok()
+			}
+			return []string{"attachments/a", "attachments/b"}, ok()
+		},
+	}
+	attachmentStorage := &mockAttachmentStorage{
+		deleteAttachment: func(_ context.Context, dto entities.DeleteAttachmentObjectDTO) Error.CodeError {
+			*deletedKeys = append(*deletedKeys, dto.ObjectKey)
+			return ok()
+		},
+	}
+
+	svc := newAttachmentTestService(repo, attachmentRepo, attachmentStorage, nil)
+	svc.storage.Archive = storage
+	return svc
+}
+
+func TestArchiveCompanyApplications(t *testing.T) {
+	req := &pb.ArchiveCompanyApplicationsRequest{CompanyUuid: companyID}
+
+	t.Run("only company service", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), nil)
+		_, err := svc.ArchiveCompanyApplications(peerContext("gateway"), req)
+		assertCode(t, err, codes.PermissionDenied)
+
+		_, err = svc.ArchiveCompanyApplications(context.Background(), req)
+		assertCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("invalid company uuid", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), nil)
+		_, err := svc.ArchiveCompanyApplications(peerContext("company"), &pb.ArchiveCompanyApplicationsRequest{CompanyUuid: "bad"})
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("archives, deletes attachments and purges", func(t *testing.T) {
+		var lines int
+		var written *entities.PutArchiveObjectDTO
+		storage := &mockArchiveStorage{
+			putArchive: func(_ context.Context, dto entities.PutArchiveObjectDTO) Error.CodeError {
+				written = &dto
+				scanner := bufio.NewScanner(dto.Content)
+				for scanner.Scan() {
+					lines++
+				}
+				if err := scanner.Err(); err != nil {
+					t.Fatalf("archive read failed: %v", err)
+				}
+				return ok()
+			},
+		}
+		var deletedKeys []string
+		var purged bool
+		svc := archiveTestService(exportBatchSize+3, storage, &deletedKeys, &purged)
+
+		res, err := svc.ArchiveCompanyApplications(peerContext("company"), req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.GetArchiveKey() != "archives/companies/"+companyID+".jsonl" || res.GetArchived() != int64(exportBatchSize+3) {
+			t.Errorf("unexpected response: %v", res)
+		}
+		if written == nil || written.Archived != int64(exportBatchSize+3) || lines != exportBatchSize+3 {
+			t.Errorf("unexpected archive: %+v, %d lines", written, lines)
+		}
+		if len(deletedKeys) != 2 || !purged {
+			t.Errorf("expected attachments deleted and applications purged, got %v / %v", deletedKeys, purged)
+		}
+	})
+
+	t.Run("repeated call returns existing archive", func(t *testing.T) {
+		storage := &mockArchiveStorage{
+			getArchive: func(_ context.Context, dto entities.GetArchiveObjectDTO) (*entities.ArchiveObject, Error.CodeError) {
+				return &entities.ArchiveObject{ObjectKey: dto.ObjectKey, Archived: 7}, ok()
+			},
+		}
+		var deletedKeys []string
+		purged := true
+		svc := archiveTestService(7, storage, &deletedKeys, &purged)
+
+		res, err := svc.ArchiveCompanyApplications(peerContext("company"), req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.GetArchiveKey() != "archives/companies/"+companyID+".jsonl" || res.GetArchived() != 7 || len(deletedKeys) != 0 {
+			t.Errorf("unexpected response: %v, deleted %v", res, deletedKeys)
+		}
+	})
+
+	t.Run("company without applications", func(t *testing.T) {
+		storage := &mockArchiveStorage{
+			getArchive: func(_ context.Context, _ entities.GetArchiveObjectDTO) (*entities.ArchiveObject, Error.CodeError) {
+				return nil, Error.Public(codes.NotFound, "archive not found")
+			},
+		}
+		var deletedKeys []string
+		purged := true
+		svc := archiveTestService(0, storage, &deletedKeys, &purged)
+
+		res, err := svc.ArchiveCompanyApplications(peerContext("company"), req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.GetArchiveKey() != "" || res.GetArchived() != 0 {
+			t.Errorf("expected empty response, got %v", res)
+		}
+	})
+
+	t.Run("storage failure aborts before purge", func(t *testing.T) {
+		storage := &mockArchiveStorage{
+			putArchive: func(_ context.Context, dto entities.PutArchiveObjectDTO) Error.CodeError {
+				_, _ = io.CopyN(io.Discard, dto.Content, 10)
+				return Error.Internal(io.ErrUnexpectedEOF)
+			},
+		}
+		var deletedKeys []string
+		var purged bool
+		svc := archiveTestService(3, storage, &deletedKeys, &purged)
+
+		_, err := svc.ArchiveCompanyApplications(peerContext("company"), req)
+		assertCode(t, err, codes.Internal)
+		if purged || len(deletedKeys) != 0 {
+			t.Errorf("nothing must be deleted after archive failure")
+		}
+	})
+}
@@ -0,0 +1,241 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/helpers"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/interceptors"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// UploadApplicationAttachment Загрузка вложения (фото, PDF, чертёж) к заявке или к записи fix log-а
+func (s *ApplicationService) UploadApplicationAttachment(ctx context.Context, req *pb.UploadApplicationAttachmentRequest) (*pb.UploadApplicationAttachmentResponse, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetApplicationUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid application uuid")
+	}
+	if err := validate.UUID(req.GetFixLogUuid()); err != nil && req.GetFixLogUuid() != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid fix log uuid")
+	}
+	if err := validate.AttachmentFileName(req.GetFileName()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validate.AttachmentContentType(req.GetContentType()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validate.AttachmentSize(int64(len(req.GetContent()))); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	application, getErr := s.db.ApplicationRepository.GetApplication(ctx, entities.GetApplicationDTO{
+		ApplicationUUID: req.GetApplicationUuid(),
+	})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	if application.DeletedAt != "" {
+		return nil, status.Error(codes.FailedPrecondition, "application is deleted")
+	}
+
+	if req.GetFixLogUuid() != "" {
+		// Вложения к fix log-у - те же права, что и на добавление fix log-а
+		if application.ExecutedBy != req.GetInitiatorUuid() {
+			return nil, status.Error(codes.PermissionDenied, "only the responsible engineer can add fix log attachments")
+		}
+
+		fixLogs, getLogsErr := s.db.ApplicationRepository.GetApplicationFixLogs(ctx, entities.GetApplicationFixLogsDTO{
+			ApplicationUUID: req.GetApplicationUuid(),
+		})
+		if err := getLogsErr.GRPCError(); err != nil {
+			return nil, err
+		}
+
+		var fixLog *entities.FixLog
+		for _, fl := range fixLogs {
+			if fl.UUID == req.GetFixLogUuid() {
+				fixLog = fl
+				break
+			}
+		}
+		if fixLog == nil {
+			return nil, status.Error(codes.NotFound, "fix log not found")
+		}
+		if fixLog.CreatedBy != req.GetInitiatorUuid() {
+			return nil, status.Error(codes.PermissionDenied, "only the author of the fix log can add attachments to it")
+		}
+	} else if !canManageAttachments(application, req.GetInitiatorUuid()) {
+		return nil, status.Error(codes.PermissionDenied, "only the responsible engineer or the creator can add attachments")
+	}
+
+	attachmentUUID := uuid.Must(uuid.NewV7()).String()
+	objectKey := fmt.Sprintf("applications/%s/%s", req.GetApplicationUuid(), attachmentUUID)
+
+	if err := s.storage.Attachment.PutAttachment(ctx, entities.PutAttachmentObjectDTO{
+		ObjectKey:   objectKey,
+		ContentType: req.GetContentType(),
+		Content:     req.GetContent(),
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	if createErr := s.db.AttachmentRepository.CreateAttachment(ctx, entities.CreateAttachmentDTO{
+		AttachmentUUID:  attachmentUUID,
+		ApplicationUUID: req.GetApplicationUuid(),
+		FixLogUUID:      req.GetFixLogUuid(),
+		ObjectKey:       objectKey,
+		FileName:        req.GetFileName(),
+		ContentType:     req.GetContentType(),
+		Size:            int64(len(req.GetContent())),
+		CreatedBy:       req.GetInitiatorUuid(),
+	}); createErr.Code != 0 {
+		// Метаданные не сохранились - объект в хранилище больше никому не нужен
+		if delErr := s.storage.Attachment.DeleteAttachment(ctx, entities.DeleteAttachmentObjectDTO{ObjectKey: objectKey}); delErr.Code != 0 {
+			log.Error().Err(delErr).Time("time", time.Now()).Str("id", interceptors.OperationIDFromContext(ctx)).Str("method", "UploadApplicationAttachment").Str("object_key", objectKey).Msg("failed to remove orphaned attachment object")
+		}
+		return nil, createErr.GRPCError()
+	}
+
+	return &pb.UploadApplicationAttachmentResponse{
+		Attachment: &pb.Attachment{
+			AttachmentUuid:  attachmentUUID,
+			ApplicationUuid: req.GetApplicationUuid(),
+			FixLogUuid:      req.GetFixLogUuid(),
+			FileName:        req.GetFileName(),
+			ContentType:     req.GetContentType(),
+			Size:            int64(len(req.GetContent())),
+			CreatedBy:       req.GetInitiatorUuid(),
+		},
+	}, nil
+}
+
+// GetApplicationAttachment Скачивание вложения заявки (доступ как у GetApplication)
+func (s *ApplicationService) GetApplicationAttachment(ctx context.Context, req *pb.GetApplicationAttachmentRequest) (*pb.GetApplicationAttachmentResponse, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetApplicationUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid application uuid")
+	}
+	if err := validate.UUID(req.GetAttachmentUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attachment uuid")
+	}
+
+	application, getErr := s.db.ApplicationRepository.GetApplication(ctx, entities.GetApplicationDTO{
+		ApplicationUUID: req.GetApplicationUuid(),
+	})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	initiator, err := s.getEmployeeInfo(ctx, application.CompanyUUID, req.GetInitiatorUuid(), req.GetInitiatorUuid())
+	if err != nil {
+		return nil, err
+	}
+
+	if !helpers.Contains([]string{"chief", "analytic"}, initiator.Role) &&
+		!helpers.Contains([]string{application.CreatedBy, application.ManagedBy, application.ExecutedBy, application.InspectedBy}, req.GetInitiatorUuid()) {
+		return nil, status.Error(codes.PermissionDenied, "you are not allowed to get application attachments")
+	}
+
+	attachment, getAttachmentErr := s.db.AttachmentRepository.GetAttachment(ctx, entities.GetAttachmentDTO{
+		AttachmentUUID:  req.GetAttachmentUuid(),
+		ApplicationUUID: req.GetApplicationUuid(),
+	})
+	if err := getAttachmentErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	content, getContentErr := s.storage.Attachment.GetAttachment(ctx, entities.GetAttachmentObjectDTO{
+		ObjectKey: attachment.ObjectKey,
+	})
+	if err := getContentErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &pb.GetApplicationAttachmentResponse{
+		Attachment: attachmentToPB(attachment),
+		Content:    content,
+	}, nil
+}
+
+// DeleteApplicationAttachment Удаление вложения заявки (только автор вложения, пока он ответственный)
+func (s *ApplicationService) DeleteApplicationAttachment(ctx context.Context, req *pb.DeleteApplicationAttachmentRequest) (*emptypb.Empty, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetApplicationUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid application uuid")
+	}
+	if err := validate.UUID(req.GetAttachmentUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attachment uuid")
+	}
+
+	application, getErr := s.db.ApplicationRepository.GetApplication(ctx, entities.GetApplicationDTO{
+		ApplicationUUID: req.GetApplicationUuid(),
+	})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	if application.DeletedAt != "" {
+		return nil, status.Error(codes.FailedPrecondition, "application is deleted")
+	}
+
+	attachment, getAttachmentErr := s.db.AttachmentRepository.GetAttachment(ctx, entities.GetAttachmentDTO{
+		AttachmentUUID:  req.GetAttachmentUuid(),
+		ApplicationUUID: req.GetApplicationUuid(),
+	})
+	if err := getAttachmentErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	if attachment.CreatedBy != req.GetInitiatorUuid() || !canManageAttachments(application, req.GetInitiatorUuid()) {
+		return nil, status.Error(codes.PermissionDenied, "only the author can delete attachment")
+	}
+
+	if err := s.db.AttachmentRepository.DeleteAttachment(ctx, entities.DeleteAttachmentDTO{
+		AttachmentUUID:  req.GetAttachmentUuid(),
+		ApplicationUUID: req.GetApplicationUuid(),
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	if delErr := s.storage.Attachment.DeleteAttachment(ctx, entities.DeleteAttachmentObjectDTO{ObjectKey: attachment.ObjectKey}); delErr.Code != 0 {
+		log.Error().Err(delErr).Time("time", time.Now()).Str("id", interceptors.OperationIDFromContext(ctx)).Str("method", "DeleteApplicationAttachment").Str("object_key", attachment.ObjectKey).Msg("failed to remove attachment object")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// canManageAttachments Вложения добавляет ответственный инженер, а создатель - пока заявка не взята в работу
+func canManageAttachments(application *entities.Application, initiatorUUID string) bool {
+	if application.ExecutedBy == initiatorUUID {
+		return true
+	}
+	return application.CreatedBy == initiatorUUID && application.Status == "created"
+}
+
+func attachmentToPB(a *entities.Attachment) *pb.Attachment {
+	return &pb.Attachment{
+		AttachmentUuid:  a.UUID,
+		ApplicationUuid: a.ApplicationUUID,
+		FixLogUuid:      a.FixLogUUID,
+		FileName:        a.FileName,
+		ContentType:     a.ContentType,
+		Size:            a.Size,
+		CreatedAt:       a.CreatedAt,
+		CreatedBy:       a.CreatedBy,
+	}
+}
@@ -0,0 +1,418 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	attachmentID = "22222222-2222-2222-2222-222222222222"
+	fixLogID     = "33333333-3333-3333-3333-333333333333"
+)
+
+func testAttachment() *entities.Attachment {
+	return &entities.Attachment{
+		UUID:            attachmentID,
+		ApplicationUUID: appID,
+		ObjectKey:       "applications/" + appID + "/" + attachmentID,
+		FileName:        "defect.jpg",
+		ContentType:     "image/jpeg",
+		Size:            4,
+		CreatedAt:       "2024-01-01 00:00:00",
+		CreatedBy:       initiatorID,
+	}
+}
+
+func uploadRequest() *pb.UploadApplicationAttachmentRequest {
+	return &pb.UploadApplicationAttachmentRequest{
+		InitiatorUuid:   initiatorID,
+		ApplicationUuid: appID,
+		FileName:        "defect.jpg",
+		ContentType:     "image/jpeg",
+		Content:         []byte("data"),
+	}
+}
+
+// ─── UploadApplicationAttachment ─────────────────────────────────────────────
+
+func TestUploadApplicationAttachment(t *testing.T) {
+	t.Run("success as creator of new application", func(t *testing.T) {
+		var stored entities.PutAttachmentObjectDTO
+		var created entities.CreateAttachmentDTO
+
+		attachmentRepo := &mockAttachmentRepo{
+			createAttachment: func(_ context.Context, dto entities.CreateAttachmentDTO) Error.CodeError {
+				created = dto
+				return ok()
+			},
+		}
+		storage := &mockAttachmentStorage{
+			putAttachment: func(_ context.Context, dto entities.PutAttachmentObjectDTO) Error.CodeError {
+				stored = dto
+				return ok()
+			},
+		}
+
+		svc := newAttachmentTestService(repoWithApp(testApp()), attachmentRepo, storage, roleClient("inspector"))
+		res, err := svc.UploadApplicationAttachment(context.Background(), uploadRequest())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.GetAttachment().GetAttachmentUuid() == "" {
+			t.Error("expected attachment uuid")
+		}
+		if stored.ObjectKey != created.ObjectKey || !strings.HasPrefix(stored.ObjectKey, "applications/"+appID+"/") {
+			t.Errorf("unexpected object key: stored %q, created %q", stored.ObjectKey, created.ObjectKey)
+		}
+		if created.Size != 4 || created.FixLogUUID != "" {
+			t.Errorf("unexpected metadata: %+v", created)
+		}
+	})
+
+	t.Run("success as engineer for fix log", func(t *testing.T) {
+		repo := repoWithApp(inProgressApp()) // ExecutedBy=initiatorID
+		repo.getApplicationFixLogs = func(_ context.Context, _ entities.GetApplicationFixLogsDTO) ([]*entities.FixLog, Error.CodeError) {
+			return []*entities.FixLog{{UUID: fixLogID, CreatedBy: initiatorID}}, ok()
+		}
+		attachmentRepo := &mockAttachmentRepo{
+			createAttachment: func(_ context.Context, dto entities.CreateAttachmentDTO) Error.CodeError {
+				if dto.FixLogUUID != fixLogID {
+					t.Errorf("expected fix log %q, got %q", fixLogID, dto.FixLogUUID)
+				}
+				return ok()
+			},
+		}
+		storage := &mockAttachmentStorage{
+			putAttachment: func(_ context.Context, _ entities.PutAttachmentObjectDTO) Error.CodeError { return ok() },
+		}
+
+		req := uploadRequest()
+		req.FixLogUuid = fixLogID
+		req.ContentType = "application/pdf"
+
+		svc := newAttachmentTestService(repo, attachmentRepo, storage, roleClient("engineer"))
+		if _, err := svc.UploadApplicationAttachment(context.Background(), req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("creator after application was assigned", func(t *testing.T) {
+		svc := newAttachmentTestService(repoWithApp(assignedApp()), &mockAttachmentRepo{}, &mockAttachmentStorage{}, roleClient("inspector"))
+		_, err := svc.UploadApplicationAttachment(context.Background(), uploadRequest())
+		assertCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("stranger", func(t *testing.T) {
+		req := uploadRequest()
+		req.InitiatorUuid = otherUserID
+
+		svc := newAttachmentTestService(repoWithApp(testApp()), &mockAttachmentRepo{}, &mockAttachmentStorage{}, roleClient("engineer"))
+		_, err := svc.UploadApplicationAttachment(context.Background(), req)
+		assertCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("fix log not found", func(t *testing.T) {
+		repo := repoWithApp(inProgressApp())
+		repo.getApplicationFixLogs = func(_ context.Context, _ entities.GetApplicationFixLogsDTO) ([]*entities.FixLog, Error.CodeError) {
+			return []*entities.FixLog{}, ok()
+		}
+
+		req := uploadRequest()
+		req.FixLogUuid = fixLogID
+
+		svc := newAttachmentTestService(repo, &mockAttachmentRepo{}, &mockAttachmentStorage{}, roleClient("engineer"))
+		_, err := svc.UploadApplicationAttachment(context.Background(), req)
+		assertCode(t, err, codes.NotFound)
+	})
+
+	t.Run("fix log of another author", func(t *testing.T) {
+		repo := repoWithApp(inProgressApp())
+		repo.getApplicationFixLogs = func(_ context.Context, _ entities.GetApplicationFixLogsDTO) ([]*entities.FixLog, Error.CodeError) {
+			return []*entities.FixLog{{UUID: fixLogID, CreatedBy: otherUserID}}, ok()
+		}
+
+		req := uploadRequest()
+		req.FixLogUuid = fixLogID
+
+		svc := newAttachmentTestService(repo, &mockAttachmentRepo{}, &mockAttachmentStorage{}, roleClient("engineer"))
+		_, err := svc.UploadApplicationAttachment(context.Background(), req)
+		assertCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("deleted application", func(t *testing.T) {
+		app := testApp()
+		app.DeletedAt = "2024-01-02 00:00:00"
+
+		svc := newAttachmentTestService(repoWithApp(app), &mockAttachmentRepo{}, &mockAttachmentStorage{}, roleClient("inspector"))
+		_, err := svc.UploadApplicationAttachment(context.Background(), uploadRequest())
+		assertCode(t, err, codes.FailedPrecondition)
+	})
+
+	t.Run("metadata db error removes stored object", func(t *testing.T) {
+		removed := false
+		attachmentRepo := &mockAttachmentRepo{
+			createAttachment: func(_ context.Context, _ entities.CreateAttachmentDTO) Error.CodeError { return internalErr() },
+		}
+		storage := &mockAttachmentStorage{
+			putAttachment: func(_ context.Context, _ entities.PutAttachmentObjectDTO) Error.CodeError { return ok() },
+			deleteAttachment: func(_ context.Context, _ entities.DeleteAttachmentObjectDTO) Error.CodeError {
+				removed = true
+				return ok()
+			},
+		}
+
+		svc := newAttachmentTestService(repoWithApp(testApp()), attachmentRepo, storage, roleClient("inspector"))
+		_, err := svc.UploadApplicationAttachment(context.Background(), uploadRequest())
+		assertCode(t, err, codes.Internal)
+		if !removed {
+			t.Error("expected orphaned object to be removed")
+		}
+	})
+
+	t.Run("storage error", func(t *testing.T) {
+		storage := &mockAttachmentStorage{
+			putAttachment: func(_ context.Context, _ entities.PutAttachmentObjectDTO) Error.CodeError { return internalErr() },
+		}
+
+		svc := newAttachmentTestService(repoWithApp(testApp()), &mockAttachmentRepo{}, storage, roleClient("inspector"))
+		_, err := svc.UploadApplicationAttachment(context.Background(), uploadRequest())
+		assertCode(t, err, codes.Internal)
+	})
+
+	t.Run("unsupported content type", func(t *testing.T) {
+		req := uploadRequest()
+		req.ContentType = "application/x-msdownload"
+
+		svc := newAttachmentTestService(emptyRepo(), &mockAttachmentRepo{}, &mockAttachmentStorage{}, roleClient("inspector"))
+		_, err := svc.UploadApplicationAttachment(context.Background(), req)
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("empty file", func(t *testing.T) {
+		req := uploadRequest()
+		req.Content = nil
+
+		svc := newAttachmentTestService(emptyRepo(), &mockAttachmentRepo{}, &mockAttachmentStorage{}, roleClient("inspector"))
+		_, err := svc.UploadApplicationAttachment(context.Background(), req)
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("file too large", func(t *testing.T) {
+		req := uploadRequest()
+		req.Content = bytes.Repeat([]byte{1}, validate.AttachmentMaxSize+1)
+
+		svc := newAttachmentTestService(emptyRepo(), &mockAttachmentRepo{}, &mockAttachmentStorage{}, roleClient("inspector"))
+		_, err := svc.UploadApplicationAttachment(context.Background(), req)
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("invalid file name", func(t *testing.T) {
+		req := uploadRequest()
+		req.FileName = "../../etc/passwd"
+
+		svc := newAttachmentTestService(emptyRepo(), &mockAttachmentRepo{}, &mockAttachmentStorage{}, roleClient("inspector"))
+		_, err := svc.UploadApplicationAttachment(context.Background(), req)
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("invalid_fix_log_uuid", func(t *testing.T) {
+		req := uploadRequest()
+		req.FixLogUuid = "not-a-uuid"
+
+		svc := newAttachmentTestService(emptyRepo(), &mockAttachmentRepo{}, &mockAttachmentStorage{}, roleClient("inspector"))
+		_, err := svc.UploadApplicationAttachment(context.Background(), req)
+		assertCode(t, err, codes.InvalidArgument)
+	})
+}
+
+// ─── GetApplicationAttachment ────────────────────────────────────────────────
+
+func TestGetApplicationAttachment(t *testing.T) {
+	getRequest := func(initiator string) *pb.GetApplicationAttachmentRequest {
+		return &pb.GetApplicationAttachmentRequest{
+			InitiatorUuid:   initiator,
+			ApplicationUuid: appID,
+			AttachmentUuid:  attachmentID,
+		}
+	}
+
+	attachmentRepo := func() *mockAttachmentRepo {
+		return &mockAttachmentRepo{
+			getAttachment: func(_ context.Context, _ entities.GetAttachmentDTO) (*entities.Attachment, Error.CodeError) {
+				return testAttachment(), ok()
+			},
+		}
+	}
+	storage := func() *mockAttachmentStorage {
+		return &mockAttachmentStorage{
+			getAttachment: func(_ context.Context, _ entities.GetAttachmentObjectDTO) ([]byte, Error.CodeError) {
+				return []byte("data"), ok()
+			},
+		}
+	}
+
+	t.Run("success as creator", func(t *testing.T) {
+		svc := newAttachmentTestService(repoWithApp(testApp()), attachmentRepo(), storage(), roleClient("inspector"))
+		res, err := svc.GetApplicationAttachment(context.Background(), getRequest(initiatorID))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(res.GetContent()) != "data" || res.GetAttachment().GetContentType() != "image/jpeg" {
+			t.Errorf("unexpected response: %+v", res)
+		}
+	})
+
+	t.Run("success as analytic", func(t *testing.T) {
+		svc := newAttachmentTestService(repoWithApp(testApp()), attachmentRepo(), storage(), roleClient("analytic"))
+		if _, err := svc.GetApplicationAttachment(context.Background(), getRequest(otherUserID)); err != nil {
+			t.Fatalf("analytic should access any attachment, got: %v", err)
+		}
+	})
+
+	t.Run("permission denied (stranger)", func(t *testing.T) {
+		svc := newAttachmentTestService(repoWithApp(testApp()), &mockAttachmentRepo{}, &mockAttachmentStorage{}, roleClient("engineer"))
+		_, err := svc.GetApplicationAttachment(context.Background(), getRequest(otherUserID))
+		assertCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("attachment not found", func(t *testing.T) {
+		repo := &mockAttachmentRepo{
+			getAttachment: func(_ context.Context, _ entities.GetAttachmentDTO) (*entities.Attachment, Error.CodeError) {
+				return nil, notFound()
+			},
+		}
+
+		svc := newAttachmentTestService(repoWithApp(testApp()), repo, &mockAttachmentStorage{}, roleClient("inspector"))
+		_, err := svc.GetApplicationAttachment(context.Background(), getRequest(initiatorID))
+		assertCode(t, err, codes.NotFound)
+	})
+
+	t.Run("storage error", func(t *testing.T) {
+		failing := &mockAttachmentStorage{
+			getAttachment: func(_ context.Context, _ entities.GetAttachmentObjectDTO) ([]byte, Error.CodeError) {
+				return nil, internalErr()
+			},
+		}
+
+		svc := newAttachmentTestService(repoWithApp(testApp()), attachmentRepo(), failing, roleClient("inspector"))
+		_, err := svc.GetApplicationAttachment(context.Background(), getRequest(initiatorID))
+		assertCode(t, err, codes.Internal)
+	})
+
+	t.Run("invalid_attachment_uuid", func(t *testing.T) {
+		req := getRequest(initiatorID)
+		req.AttachmentUuid = "not-a-uuid"
+
+		svc := newAttachmentTestService(emptyRepo(), &mockAttachmentRepo{}, &mockAttachmentStorage{}, roleClient("inspector"))
+		_, err := svc.GetApplicationAttachment(context.Background(), req)
+		assertCode(t, err, codes.InvalidArgument)
+	})
+}
+
+// ─── DeleteApplicationAttachment ─────────────────────────────────────────────
+
+func TestDeleteApplicationAttachment(t *testing.T) {
+	deleteRequest := func(initiator string) *pb.DeleteApplicationAttachmentRequest {
+		return &pb.DeleteApplicationAttachmentRequest{
+			InitiatorUuid:   initiator,
+			ApplicationUuid: appID,
+			AttachmentUuid:  attachmentID,
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		removed := false
+		attachmentRepo := &mockAttachmentRepo{
+			getAttachment: func(_ context.Context, _ entities.GetAttachmentDTO) (*entities.Attachment, Error.CodeError) {
+				return testAttachment(), ok()
+			},
+			deleteAttachment: func(_ context.Context, _ entities.DeleteAttachmentDTO) Error.CodeError { return ok() },
+		}
+		storage := &mockAttachmentStorage{
+			deleteAttachment: func(_ context.Context, dto entities.DeleteAttachmentObjectDTO) Error.CodeError {
+				removed = dto.ObjectKey == testAttachment().ObjectKey
+				return ok()
+			},
+		}
+
+		svc := newAttachmentTestService(repoWithApp(inProgressApp()), attachmentRepo, storage, roleClient("engineer"))
+		if _, err := svc.DeleteApplicationAttachment(context.Background(), deleteRequest(initiatorID)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !removed {
+			t.Error("expected object to be removed from storage")
+		}
+	})
+
+	t.Run("storage error is not returned", func(t *testing.T) {
+		attachmentRepo := &mockAttachmentRepo{
+			getAttachment: func(_ context.Context, _ entities.GetAttachmentDTO) (*entities.Attachment, Error.CodeError) {
+				return testAttachment(), ok()
+			},
+			deleteAttachment: func(_ context.Context, _ entities.DeleteAttachmentDTO) Error.CodeError { return ok() },
+		}
+		storage := &mockAttachmentStorage{
+			deleteAttachment: func(_ context.Context, _ entities.DeleteAttachmentObjectDTO) Error.CodeError { return internalErr() },
+		}
+
+		svc := newAttachmentTestService(repoWithApp(inProgressApp()), attachmentRepo, storage, roleClient("engineer"))
+		if _, err := svc.DeleteApplicationAttachment(context.Background(), deleteRequest(initiatorID)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("not the author", func(t *testing.T) {
+		attachment := testAttachment()
+		attachment.CreatedBy = otherUserID
+		attachmentRepo := &mockAttachmentRepo{
+			getAttachment: func(_ context.Context, _ entities.GetAttachmentDTO) (*entities.Attachment, Error.CodeError) {
+				return attachment, ok()
+			},
+		}
+
+		svc := newAttachmentTestService(repoWithApp(inProgressApp()), attachmentRepo, &mockAttachmentStorage{}, roleClient("engineer"))
+		_, err := svc.DeleteApplicationAttachment(context.Background(), deleteRequest(initiatorID))
+		assertCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("author is no longer responsible", func(t *testing.T) {
+		attachmentRepo := &mockAttachmentRepo{
+			getAttachment: func(_ context.Context, _ entities.GetAttachmentDTO) (*entities.Attachment, Error.CodeError) {
+				return testAttachment(), ok()
+			},
+		}
+
+		svc := newAttachmentTestService(repoWithApp(assignedApp()), attachmentRepo, &mockAttachmentStorage{}, roleClient("inspector"))
+		_, err := svc.DeleteApplicationAttachment(context.Background(), deleteRequest(initiatorID))
+		assertCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("attachment not found", func(t *testing.T) {
+		attachmentRepo := &mockAttachmentRepo{
+			getAttachment: func(_ context.Context, _ entities.GetAttachmentDTO) (*entities.Attachment, Error.CodeError) {
+				return nil, notFound()
+			},
+		}
+
+		svc := newAttachmentTestService(repoWithApp(inProgressApp()), attachmentRepo, &mockAttachmentStorage{}, roleClient("engineer"))
+		_, err := svc.DeleteApplicationAttachment(context.Background(), deleteRequest(initiatorID))
+		assertCode(t, err, codes.NotFound)
+	})
+
+	t.Run("invalid_attachment_uuid", func(t *testing.T) {
+		req := deleteRequest(initiatorID)
+		req.AttachmentUuid = "not-a-uuid"
+
+		svc := newAttachmentTestService(emptyRepo(), &mockAttachmentRepo{}, &mockAttachmentStorage{}, roleClient("engineer"))
+		_, err := svc.DeleteApplicationAttachment(context.Background(), req)
+		assertCode(t, err, codes.InvalidArgument)
+	})
+}
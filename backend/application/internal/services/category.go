@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// CreateApplicationCategory Создание категории дефектов компании (только chief)
+func (s *ApplicationService) CreateApplicationCategory(ctx context.Context, req *pb.CreateApplicationCategoryRequest) (*pb.CreateApplicationCategoryResponse, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+	if err := validate.CategoryTitle(req.GetTitle()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validate.UUID(req.GetDefaultDepartmentUuid()); err != nil && req.GetDefaultDepartmentUuid() != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid default department uuid")
+	}
+
+	if err := s.checkChief(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), "only chief can configure categories"); err != nil {
+		return nil, err
+	}
+	if err := s.checkCategoryDepartment(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), req.GetDefaultDepartmentUuid()); err != nil {
+		return nil, err
+	}
+
+	categoryUUID := uuid.Must(uuid.NewV7()).String()
+
+	if err := s.db.CategoryRepository.CreateCategory(ctx, entities.CreateCategoryDTO{
+		UUID:                  categoryUUID,
+		CompanyUUID:           req.GetCompanyUuid(),
+		Title:                 req.GetTitle(),
+		DefaultDepartmentUUID: req.GetDefaultDepartmentUuid(),
+		CreatedBy:             req.GetInitiatorUuid(),
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateApplicationCategoryResponse{CategoryUuid: categoryUUID}, nil
+}
+
+// GetApplicationCategories Получение категорий дефектов компании (любой сотрудник компании)
+func (s *ApplicationService) GetApplicationCategories(ctx context.Context, req *pb.GetApplicationCategoriesRequest) (*pb.GetApplicationCategoriesResponse, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+
+	if _, err := s.getEmployeeInfo(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), req.GetInitiatorUuid()); err != nil {
+		return nil, err
+	}
+
+	categories, getErr := s.db.CategoryRepository.GetCompanyCategories(ctx, entities.GetCompanyCategoriesDTO{
+		CompanyUUID: req.GetCompanyUuid(),
+	})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.ApplicationCategory, 0, len(categories))
+	for _, c := range categories {
+		res = append(res, &pb.ApplicationCategory{
+			CategoryUuid:          c.UUID,
+			Title:                 c.Title,
+			DefaultDepartmentUuid: c.DefaultDepartmentUUID,
+			CreatedAt:             c.CreatedAt,
+			CreatedBy:             c.CreatedBy,
+		})
+	}
+
+	return &pb.GetApplicationCategoriesResponse{Categories: res}, nil
+}
+
+// UpdateApplicationCategory Обновление названия и департамента по умолчанию (только chief).
+// Уже созданные заявки остаются в своих департаментах
+func (s *ApplicationService) UpdateApplicationCategory(ctx context.Context, req *pb.UpdateApplicationCategoryRequest) (*emptypb.Empty, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCategoryUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid category uuid")
+	}
+	if err := validate.CategoryTitle(req.GetTitle()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validate.UUID(req.GetDefaultDepartmentUuid()); err != nil && req.GetDefaultDepartmentUuid() != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid default department uuid")
+	}
+
+	category, getErr := s.db.CategoryRepository.GetCategory(ctx, entities.GetCategoryDTO{CategoryUUID: req.GetCategoryUuid()})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkChief(ctx, category.CompanyUUID, req.GetInitiatorUuid(), "only chief can configure categories"); err != nil {
+		return nil, err
+	}
+	if err := s.checkCategoryDepartment(ctx, category.CompanyUUID, req.GetInitiatorUuid(), req.GetDefaultDepartmentUuid()); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.CategoryRepository.UpdateCategory(ctx, entities.UpdateCategoryDTO{
+		CategoryUUID:          req.GetCategoryUuid(),
+		Title:                 req.GetTitle(),
+		DefaultDepartmentUUID: req.GetDefaultDepartmentUuid(),
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteApplicationCategory Удаление категории без заявок (только chief)
+func (s *ApplicationService) DeleteApplicationCategory(ctx context.Context, req *pb.DeleteApplicationCategoryRequest) (*emptypb.Empty, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCategoryUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid category uuid")
+	}
+
+	category, getErr := s.db.CategoryRepository.GetCategory(ctx, entities.GetCategoryDTO{CategoryUUID: req.GetCategoryUuid()})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkChief(ctx, category.CompanyUUID, req.GetInitiatorUuid(), "only chief can configure categories"); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.CategoryRepository.DeleteCategory(ctx, entities.DeleteCategoryDTO{
+		CategoryUUID: req.GetCategoryUuid(),
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// checkCategoryDepartment Департамент по умолчанию должен принадлежать компании категории
+func (s *ApplicationService) checkCategoryDepartment(ctx context.Context, companyUUID, initiatorUUID, departmentUUID string) error {
+	if departmentUUID == "" {
+		return nil
+	}
+
+	department, err := s.getDepartmentInfo(ctx, initiatorUUID, departmentUUID)
+	if err != nil {
+		return err
+	}
+	if department.CompanyUUID != companyUUID {
+		return status.Error(codes.PermissionDenied, "department is from another company")
+	}
+	return nil
+}
+
+// getApplicationCategory Категория новой заявки. Категория чужой компании для инициатора не существует
+func (s *ApplicationService) getApplicationCategory(ctx context.Context, companyUUID, categoryUUID string) (*entities.Category, error) {
+	category, getErr := s.db.CategoryRepository.GetCategory(ctx, entities.GetCategoryDTO{CategoryUUID: categoryUUID})
+	if getErr.Code == codes.NotFound || (getErr.Code == 0 && category.CompanyUUID != companyUUID) {
+		return nil, status.Error(codes.InvalidArgument, "category not found")
+	}
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+	return category, nil
+}
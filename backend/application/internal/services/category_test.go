@@ -0,0 +1,279 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+const categoryID = "77777777-7777-7777-7777-777777777777"
+
+// categoryRepoWith — мок репозитория категорий, возвращающий заданную категорию через GetCategory
+func categoryRepoWith(category *entities.Category) *mockCategoryRepo {
+	return &mockCategoryRepo{
+		getCategory: func(_ context.Context, dto entities.GetCategoryDTO) (*entities.Category, Error.CodeError) {
+			if category == nil || dto.CategoryUUID != category.UUID {
+				return nil, notFound()
+			}
+			return category, ok()
+		},
+	}
+}
+
+func TestCreateApplicationCategory(t *testing.T) {
+	create := func(svc *ApplicationService, title, departmentUUID string) (*pb.CreateApplicationCategoryResponse, error) {
+		return svc.CreateApplicationCategory(context.Background(), &pb.CreateApplicationCategoryRequest{
+			InitiatorUuid:         initiatorID,
+			CompanyUuid:           companyID,
+			Title:                 title,
+			DefaultDepartmentUuid: departmentUUID,
+		})
+	}
+
+	t.Run("success with default department", func(t *testing.T) {
+		var saved entities.CreateCategoryDTO
+		categoryRepo := &mockCategoryRepo{
+			createCategory: func(_ context.Context, dto entities.CreateCategoryDTO) Error.CodeError {
+				saved = dto
+				return ok()
+			},
+		}
+		svc := newCategoryTestService(emptyRepo(), categoryRepo, &mockCustomFieldRepo{}, redirectClient("chief", companyID))
+
+		res, err := create(svc, "Waterproofing", otherDeptID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.GetCategoryUuid() == "" || saved.UUID != res.GetCategoryUuid() {
+			t.Errorf("expected saved category uuid %q, got %q", res.GetCategoryUuid(), saved.UUID)
+		}
+		if saved.CompanyUUID != companyID || saved.Title != "Waterproofing" || saved.DefaultDepartmentUUID != otherDeptID || saved.CreatedBy != initiatorID {
+			t.Errorf("unexpected saved category: %+v", saved)
+		}
+	})
+
+	t.Run("not chief", func(t *testing.T) {
+		svc := newCategoryTestService(emptyRepo(), &mockCategoryRepo{}, &mockCustomFieldRepo{}, roleClient("manager"))
+		_, err := create(svc, "Cracks", "")
+		assertCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("department of another company", func(t *testing.T) {
+		svc := newCategoryTestService(emptyRepo(), &mockCategoryRepo{}, &mockCustomFieldRepo{}, redirectClient("chief", "other-company"))
+		_, err := create(svc, "Cracks", otherDeptID)
+		assertCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("duplicate title", func(t *testing.T) {
+		categoryRepo := &mockCategoryRepo{
+			createCategory: func(_ context.Context, _ entities.CreateCategoryDTO) Error.CodeError {
+				return Error.Public(codes.AlreadyExists, "category with this title already exists")
+			},
+		}
+		svc := newCategoryTestService(emptyRepo(), categoryRepo, &mockCustomFieldRepo{}, roleClient("chief"))
+		_, err := create(svc, "Cracks", "")
+		assertCode(t, err, codes.AlreadyExists)
+	})
+
+	cases := []struct {
+		name       string
+		title      string
+		department string
+	}{
+		{"empty title", "", ""},
+		{"invalid title", "Cracks <script>", ""},
+		{"invalid department", "Cracks", "not-a-uuid"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := newCategoryTestService(emptyRepo(), &mockCategoryRepo{}, &mockCustomFieldRepo{}, roleClient("chief"))
+			_, err := create(svc, tc.title, tc.department)
+			assertCode(t, err, codes.InvalidArgument)
+		})
+	}
+}
+
+func TestGetApplicationCategories(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		categoryRepo := &mockCategoryRepo{
+			getCompanyCategories: func(_ context.Context, dto entities.GetCompanyCategoriesDTO) ([]*entities.Category, Error.CodeError) {
+				if dto.CompanyUUID != companyID {
+					t.Errorf("expected company %q, got %q", companyID, dto.CompanyUUID)
+				}
+				return []*entities.Category{{UUID: categoryID, CompanyUUID: companyID, Title: "Electrical", DefaultDepartmentUUID: otherDeptID}}, ok()
+			},
+		}
+		svc := newCategoryTestService(emptyRepo(), categoryRepo, &mockCustomFieldRepo{}, roleClient("engineer"))
+
+		res, err := svc.GetApplicationCategories(context.Background(), &pb.GetApplicationCategoriesRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(res.GetCategories()) != 1 || res.GetCategories()[0].GetDefaultDepartmentUuid() != otherDeptID {
+			t.Errorf("unexpected categories: %v", res.GetCategories())
+		}
+	})
+
+	t.Run("not an employee", func(t *testing.T) {
+		svc := newCategoryTestService(emptyRepo(), &mockCategoryRepo{}, &mockCustomFieldRepo{}, roleByTargetClient(map[string]string{}))
+		_, err := svc.GetApplicationCategories(context.Background(), &pb.GetApplicationCategoriesRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+		})
+		assertCode(t, err, codes.NotFound)
+	})
+}
+
+func TestUpdateApplicationCategory(t *testing.T) {
+	category := &entities.Category{UUID: categoryID, CompanyUUID: companyID, Title: "Cracks", DefaultDepartmentUUID: deptID}
+	update := func(svc *ApplicationService, departmentUUID string) error {
+		_, err := svc.UpdateApplicationCategory(context.Background(), &pb.UpdateApplicationCategoryRequest{
+			InitiatorUuid:         initiatorID,
+			CategoryUuid:          categoryID,
+			Title:                 "Structural cracks",
+			DefaultDepartmentUuid: departmentUUID,
+		})
+		return err
+	}
+
+	t.Run("clear default department", func(t *testing.T) {
+		var saved entities.UpdateCategoryDTO
+		categoryRepo := categoryRepoWith(category)
+		categoryRepo.updateCategory = func(_ context.Context, dto entities.UpdateCategoryDTO) Error.CodeError {
+			saved = dto
+			return ok()
+		}
+		svc := newCategoryTestService(emptyRepo(), categoryRepo, &mockCustomFieldRepo{}, roleClient("chief"))
+
+		if err := update(svc, ""); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if saved.Title != "Structural cracks" || saved.DefaultDepartmentUUID != "" {
+			t.Errorf("unexpected update: %+v", saved)
+		}
+	})
+
+	t.Run("not chief", func(t *testing.T) {
+		svc := newCategoryTestService(emptyRepo(), categoryRepoWith(category), &mockCustomFieldRepo{}, roleClient("analytic"))
+		assertCode(t, update(svc, ""), codes.PermissionDenied)
+	})
+
+	t.Run("category not found", func(t *testing.T) {
+		svc := newCategoryTestService(emptyRepo(), categoryRepoWith(nil), &mockCustomFieldRepo{}, roleClient("chief"))
+		assertCode(t, update(svc, ""), codes.NotFound)
+	})
+}
+
+func TestDeleteApplicationCategory(t *testing.T) {
+	category := &entities.Category{UUID: categoryID, CompanyUUID: companyID, Title: "Cracks"}
+	remove := func(svc *ApplicationService) error {
+		_, err := svc.DeleteApplicationCategory(context.Background(), &pb.DeleteApplicationCategoryRequest{
+			InitiatorUuid: initiatorID,
+			CategoryUuid:  categoryID,
+		})
+		return err
+	}
+
+	t.Run("success", func(t *testing.T) {
+		var deleted string
+		categoryRepo := categoryRepoWith(category)
+		categoryRepo.deleteCategory = func(_ context.Context, dto entities.DeleteCategoryDTO) Error.CodeError {
+			deleted = dto.CategoryUUID
+			return ok()
+		}
+		svc := newCategoryTestService(emptyRepo(), categoryRepo, &mockCustomFieldRepo{}, roleClient("chief"))
+
+		if err := remove(svc); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if deleted != categoryID {
+			t.Errorf("expected deleted category %q, got %q", categoryID, deleted)
+		}
+	})
+
+	t.Run("used by applications", func(t *testing.T) {
+		categoryRepo := categoryRepoWith(category)
+		categoryRepo.deleteCategory = func(_ context.Context, _ entities.DeleteCategoryDTO) Error.CodeError {
+			return Error.Public(codes.FailedPrecondition, "category is used by applications")
+		}
+		svc := newCategoryTestService(emptyRepo(), categoryRepo, &mockCustomFieldRepo{}, roleClient("chief"))
+		assertCode(t, remove(svc), codes.FailedPrecondition)
+	})
+
+	t.Run("not chief", func(t *testing.T) {
+		svc := newCategoryTestService(emptyRepo(), categoryRepoWith(category), &mockCustomFieldRepo{}, roleClient("inspector"))
+		assertCode(t, remove(svc), codes.PermissionDenied)
+	})
+}
+
+// ─── CreateApplication: категория и серьёзность ──────────────────────────────
+
+func TestCreateApplicationWithCategory(t *testing.T) {
+	create := func(svc *ApplicationService, categoryUUID, severity string) error {
+		_, err := svc.CreateApplication(context.Background(), &pb.CreateApplicationRequest{
+			InitiatorUuid:   initiatorID,
+			CompanyUuid:     companyID,
+			ApplicationData: &pb.ApplicationData{Title: "Valid Title", Description: "Some description"},
+			CategoryUuid:    categoryUUID,
+			Severity:        severity,
+		})
+		return err
+	}
+	capture := func(got *entities.CreateApplicationDTO) *mockApplicationRepo {
+		repo := emptyRepo()
+		repo.createApplication = func(_ context.Context, dto entities.CreateApplicationDTO) Error.CodeError {
+			*got = dto
+			return ok()
+		}
+		return repo
+	}
+
+	t.Run("routed to default department", func(t *testing.T) {
+		var got entities.CreateApplicationDTO
+		categoryRepo := categoryRepoWith(&entities.Category{UUID: categoryID, CompanyUUID: companyID, DefaultDepartmentUUID: otherDeptID})
+		svc := newCategoryTestService(capture(&got), categoryRepo, &mockCustomFieldRepo{}, roleClient("inspector"))
+
+		if err := create(svc, categoryID, "major"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.DepartmentUUID != otherDeptID || got.CategoryUUID != categoryID || got.Severity != "major" {
+			t.Errorf("unexpected application: department %q, category %q, severity %q", got.DepartmentUUID, got.CategoryUUID, got.Severity)
+		}
+	})
+
+	t.Run("without default department stays in inspector department", func(t *testing.T) {
+		var got entities.CreateApplicationDTO
+		categoryRepo := categoryRepoWith(&entities.Category{UUID: categoryID, CompanyUUID: companyID})
+		svc := newCategoryTestService(capture(&got), categoryRepo, &mockCustomFieldRepo{}, roleClient("inspector"))
+
+		if err := create(svc, categoryID, ""); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.DepartmentUUID != deptID || got.Severity != "" {
+			t.Errorf("expected department %q without severity, got %q / %q", deptID, got.DepartmentUUID, got.Severity)
+		}
+	})
+
+	t.Run("category of another company", func(t *testing.T) {
+		categoryRepo := categoryRepoWith(&entities.Category{UUID: categoryID, CompanyUUID: "other-company"})
+		svc := newCategoryTestService(emptyRepo(), categoryRepo, &mockCustomFieldRepo{}, roleClient("inspector"))
+		assertCode(t, create(svc, categoryID, ""), codes.InvalidArgument)
+	})
+
+	t.Run("category not found", func(t *testing.T) {
+		svc := newCategoryTestService(emptyRepo(), categoryRepoWith(nil), &mockCustomFieldRepo{}, roleClient("inspector"))
+		assertCode(t, create(svc, categoryID, ""), codes.InvalidArgument)
+	})
+
+	t.Run("invalid severity", func(t *testing.T) {
+		svc := newCategoryTestService(emptyRepo(), &mockCategoryRepo{}, &mockCustomFieldRepo{}, roleClient("inspector"))
+		assertCode(t, create(svc, categoryID, "catastrophic"), codes.InvalidArgument)
+	})
+}
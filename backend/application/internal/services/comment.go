@@ -0,0 +1,442 @@
+package services
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/cursor"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/helpers"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// CreateApplicationComment Добавление комментария к заявке или ответа в ветке комментария
+func (s *ApplicationService) CreateApplicationComment(ctx context.Context, req *pb.CreateApplicationCommentRequest) (*pb.CreateApplicationCommentResponse, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetApplicationUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid application uuid")
+	}
+	if err := validate.UUID(req.GetParentCommentUuid()); err != nil && req.GetParentCommentUuid() != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent comment uuid")
+	}
+	if err := validate.ApplicationComment(req.GetText()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validate.CommentMentions(req.GetMentionedUuids()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	application, getErr := s.db.ApplicationRepository.GetApplication(ctx, entities.GetApplicationDTO{
+		ApplicationUUID: req.GetApplicationUuid(),
+	})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	if application.DeletedAt != "" {
+		return nil, status.Error(codes.FailedPrecondition, "application is deleted")
+	}
+
+	if _, err := s.checkCommentWriteAccess(ctx, application, req.GetInitiatorUuid()); err != nil {
+		return nil, err
+	}
+
+	if req.GetParentCommentUuid() != "" {
+		parent, getParentErr := s.db.CommentRepository.GetComment(ctx, entities.GetCommentDTO{
+			CommentUUID:     req.GetParentCommentUuid(),
+			ApplicationUUID: req.GetApplicationUuid(),
+		})
+		if err := getParentErr.GRPCError(); err != nil {
+			return nil, err
+		}
+
+		// Ветка одного уровня: отвечать можно только на комментарий верхнего уровня
+		if parent.ParentUUID != "" {
+			return nil, status.Error(codes.InvalidArgument, "replies can be added only to top-level comments")
+		}
+		if parent.DeletedAt != "" {
+			return nil, status.Error(codes.FailedPrecondition, "parent comment is deleted")
+		}
+	}
+
+	if err := s.checkCommentMentions(ctx, application, req.GetInitiatorUuid(), req.GetMentionedUuids()); err != nil {
+		return nil, err
+	}
+
+	comment := &entities.Comment{
+		UUID:            uuid.Must(uuid.NewV7()).String(),
+		ApplicationUUID: req.GetApplicationUuid(),
+		ParentUUID:      req.GetParentCommentUuid(),
+		Text:            strings.TrimSpace(req.GetText()),
+		MentionedUUIDs:  req.GetMentionedUuids(),
+		CreatedBy:       req.GetInitiatorUuid(),
+	}
+
+	if err := s.db.CommentRepository.CreateComment(ctx, entities.CreateCommentDTO{
+		CommentUUID:     comment.UUID,
+		ApplicationUUID: comment.ApplicationUUID,
+		ParentUUID:      comment.ParentUUID,
+		Text:            comment.Text,
+		MentionedUUIDs:  comment.MentionedUUIDs,
+		CreatedBy:       comment.CreatedBy,
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateApplicationCommentResponse{Comment: commentToPB(comment)}, nil
+}
+
+// GetApplicationComments Получение веток комментариев заявки (доступ как у GetApplication)
+func (s *ApplicationService) GetApplicationComments(ctx context.Context, req *pb.GetApplicationCommentsRequest) (*pb.GetApplicationCommentsResponse, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetApplicationUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid application uuid")
+	}
+	if req.GetCount() <= 0 || req.GetCount() > 100 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid count (1..100)")
+	}
+	if req.GetOffset() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid offset")
+	}
+	position, err := cursor.Parse(req.GetCursor(), req.GetOffset())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.GetCursor() != "" && validate.UUID(position.ID) != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cursor")
+	}
+
+	application, getErr := s.db.ApplicationRepository.GetApplication(ctx, entities.GetApplicationDTO{
+		ApplicationUUID: req.GetApplicationUuid(),
+	})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	if _, err = s.checkCommentAccess(ctx, application, req.GetInitiatorUuid()); err != nil {
+		return nil, err
+	}
+
+	threads, dbErr := s.db.CommentRepository.GetCommentThreads(ctx, entities.GetCommentThreadsDTO{
+		ApplicationUUID: req.GetApplicationUuid(),
+		Offset:          req.GetOffset(),
+		Count:           req.GetCount() + 1, // лишняя ветка показывает, есть ли следующая страница
+		AfterUUID:       position.ID,
+	})
+	if err := dbErr.GRPCError(); err != nil {
+		return nil, err
+	}
+	total, dbErr := s.db.CommentRepository.CountCommentThreads(ctx, entities.CountCommentThreadsDTO{
+		ApplicationUUID: req.GetApplicationUuid(),
+	})
+	if err := dbErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	nextCursor := ""
+	if int64(len(threads)) > req.GetCount() {
+		threads = threads[:req.GetCount()]
+		nextCursor = cursor.Encode(cursor.Cursor{ID: threads[len(threads)-1].UUID})
+	}
+
+	pbComments := make([]*pb.Comment, 0, len(threads))
+	if len(threads) == 0 {
+		return &pb.GetApplicationCommentsResponse{Comments: pbComments, TotalCount: total}, nil
+	}
+
+	parentUUIDs := make([]string, 0, len(threads))
+	for _, c := range threads {
+		parentUUIDs = append(parentUUIDs, c.UUID)
+	}
+
+	replies, dbErr := s.db.CommentRepository.GetCommentReplies(ctx, entities.GetCommentRepliesDTO{
+		ParentUUIDs: parentUUIDs,
+	})
+	if err := dbErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	threadReplies := make(map[string][]*pb.Comment)
+	for _, r := range replies {
+		threadReplies[r.ParentUUID] = append(threadReplies[r.ParentUUID], commentToPB(r))
+	}
+
+	for _, c := range threads {
+		pbComment := commentToPB(c)
+		pbComment.Replies = threadReplies[c.UUID]
+		pbComments = append(pbComments, pbComment)
+	}
+
+	return &pb.GetApplicationCommentsResponse{
+		Comments:   pbComments,
+		NextCursor: nextCursor,
+		TotalCount: total,
+	}, nil
+}
+
+// UpdateApplicationComment Изменение комментария (только автор). Предыдущая редакция сохраняется в истории
+func (s *ApplicationService) UpdateApplicationComment(ctx context.Context, req *pb.UpdateApplicationCommentRequest) (*emptypb.Empty, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetApplicationUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid application uuid")
+	}
+	if err := validate.UUID(req.GetCommentUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid comment uuid")
+	}
+	if err := validate.ApplicationComment(req.GetText()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validate.CommentMentions(req.GetMentionedUuids()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	application, getErr := s.db.ApplicationRepository.GetApplication(ctx, entities.GetApplicationDTO{
+		ApplicationUUID: req.GetApplicationUuid(),
+	})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	if application.DeletedAt != "" {
+		return nil, status.Error(codes.FailedPrecondition, "application is deleted")
+	}
+
+	comment, getCommentErr := s.db.CommentRepository.GetComment(ctx, entities.GetCommentDTO{
+		CommentUUID:     req.GetCommentUuid(),
+		ApplicationUUID: req.GetApplicationUuid(),
+	})
+	if err := getCommentErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	if comment.CreatedBy != req.GetInitiatorUuid() {
+		return nil, status.Error(codes.PermissionDenied, "only the author can edit comment")
+	}
+	if comment.DeletedAt != "" {
+		return nil, status.Error(codes.FailedPrecondition, "comment is deleted")
+	}
+
+	// Автор мог потерять доступ к заявке (сменилась роль, заявку передали другому инженеру)
+	if _, err := s.checkCommentWriteAccess(ctx, application, req.GetInitiatorUuid()); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkCommentMentions(ctx, application, req.GetInitiatorUuid(), req.GetMentionedUuids()); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.CommentRepository.UpdateComment(ctx, entities.UpdateCommentDTO{
+		CommentUUID:     req.GetCommentUuid(),
+		ApplicationUUID: req.GetApplicationUuid(),
+		Text:            strings.TrimSpace(req.GetText()),
+		MentionedUUIDs:  req.GetMentionedUuids(),
+		EditedBy:        req.GetInitiatorUuid(),
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteApplicationComment Мягкое удаление комментария (автор или chief)
+func (s *ApplicationService) DeleteApplicationComment(ctx context.Context, req *pb.DeleteApplicationCommentRequest) (*emptypb.Empty, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetApplicationUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid application uuid")
+	}
+	if err := validate.UUID(req.GetCommentUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid comment uuid")
+	}
+
+	application, getErr := s.db.ApplicationRepository.GetApplication(ctx, entities.GetApplicationDTO{
+		ApplicationUUID: req.GetApplicationUuid(),
+	})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	initiator, err := s.checkCommentAccess(ctx, application, req.GetInitiatorUuid())
+	if err != nil {
+		return nil, err
+	}
+
+	comment, getCommentErr := s.db.CommentRepository.GetComment(ctx, entities.GetCommentDTO{
+		CommentUUID:     req.GetCommentUuid(),
+		ApplicationUUID: req.GetApplicationUuid(),
+	})
+	if err := getCommentErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	if comment.CreatedBy != req.GetInitiatorUuid() && initiator.Role != "chief" {
+		return nil, status.Error(codes.PermissionDenied, "only the author or chief can delete comment")
+	}
+	if comment.DeletedAt != "" {
+		return nil, status.Error(codes.FailedPrecondition, "comment is already deleted")
+	}
+
+	if err := s.db.CommentRepository.DeleteComment(ctx, entities.DeleteCommentDTO{
+		CommentUUID:     req.GetCommentUuid(),
+		ApplicationUUID: req.GetApplicationUuid(),
+		DeletedBy:       req.GetInitiatorUuid(),
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetApplicationCommentHistory Получение предыдущих редакций комментария.
+// История удалённого комментария доступна только chief-у.
+func (s *ApplicationService) GetApplicationCommentHistory(ctx context.Context, req *pb.GetApplicationCommentHistoryRequest) (*pb.GetApplicationCommentHistoryResponse, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetApplicationUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid application uuid")
+	}
+	if err := validate.UUID(req.GetCommentUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid comment uuid")
+	}
+
+	application, getErr := s.db.ApplicationRepository.GetApplication(ctx, entities.GetApplicationDTO{
+		ApplicationUUID: req.GetApplicationUuid(),
+	})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	initiator, err := s.checkCommentAccess(ctx, application, req.GetInitiatorUuid())
+	if err != nil {
+		return nil, err
+	}
+
+	comment, getCommentErr := s.db.CommentRepository.GetComment(ctx, entities.GetCommentDTO{
+		CommentUUID:     req.GetCommentUuid(),
+		ApplicationUUID: req.GetApplicationUuid(),
+	})
+	if err := getCommentErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	if comment.DeletedAt != "" && initiator.Role != "chief" {
+		return nil, status.Error(codes.PermissionDenied, "only chief can get history of deleted comment")
+	}
+
+	edits, getEditsErr := s.db.CommentRepository.GetCommentEdits(ctx, entities.GetCommentEditsDTO{
+		CommentUUID: req.GetCommentUuid(),
+	})
+	if err := getEditsErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	pbComment := commentToPB(comment)
+	if comment.DeletedAt != "" {
+		pbComment.Text = comment.Text
+		pbComment.MentionedUuids = comment.MentionedUUIDs
+	}
+
+	pbEdits := make([]*pb.CommentEdit, 0, len(edits))
+	for _, e := range edits {
+		pbEdits = append(pbEdits, &pb.CommentEdit{
+			Text:           e.Text,
+			MentionedUuids: e.MentionedUUIDs,
+			EditedAt:       e.EditedAt,
+			EditedBy:       e.EditedBy,
+		})
+	}
+
+	return &pb.GetApplicationCommentHistoryResponse{
+		Comment: pbComment,
+		Edits:   pbEdits,
+	}, nil
+}
+
+// ─── Вспомогательные функции ──────────────────────────────────────────────────
+
+// checkCommentAccess Комментарии видят те же, кто видит заявку: chief, analytic и участники заявки
+func (s *ApplicationService) checkCommentAccess(ctx context.Context, application *entities.Application, initiatorUUID string) (*entities.Employee, error) {
+	initiator, err := s.getEmployeeInfo(ctx, application.CompanyUUID, initiatorUUID, initiatorUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canSeeComments(application, initiatorUUID, initiator.Role) {
+		return nil, status.Error(codes.PermissionDenied, "you are not allowed to get application comments")
+	}
+
+	return initiator, nil
+}
+
+// checkCommentWriteAccess Писать комментарии могут все, кто их видит, кроме analytic (только чтение)
+func (s *ApplicationService) checkCommentWriteAccess(ctx context.Context, application *entities.Application, initiatorUUID string) (*entities.Employee, error) {
+	initiator, err := s.checkCommentAccess(ctx, application, initiatorUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if initiator.Role == "analytic" {
+		return nil, status.Error(codes.PermissionDenied, "analytics can't write comments")
+	}
+
+	return initiator, nil
+}
+
+// checkCommentMentions Упоминать можно только сотрудников компании, которые видят комментарии заявки -
+// иначе упомянутый получил бы уведомление о ветке, которую не может открыть
+func (s *ApplicationService) checkCommentMentions(ctx context.Context, application *entities.Application, initiatorUUID string, mentions []string) error {
+	for _, mentioned := range mentions {
+		employee, err := s.getEmployeeInfo(ctx, application.CompanyUUID, initiatorUUID, mentioned)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return status.Errorf(codes.InvalidArgument, "mentioned user %s is not a company employee", mentioned)
+			}
+			return err
+		}
+		if !canSeeComments(application, mentioned, employee.Role) {
+			return status.Errorf(codes.InvalidArgument, "mentioned user %s can't see the application", mentioned)
+		}
+	}
+	return nil
+}
+
+// canSeeComments Комментарии заявки видят chief, analytic и участники заявки
+func canSeeComments(application *entities.Application, userUUID, role string) bool {
+	return helpers.Contains([]string{"chief", "analytic"}, role) ||
+		helpers.Contains([]string{application.CreatedBy, application.ManagedBy, application.ExecutedBy, application.InspectedBy}, userUUID)
+}
+
+// commentToPB Текст и упоминания удалённого комментария не отдаются - остаётся только место в ветке
+func commentToPB(c *entities.Comment) *pb.Comment {
+	res := &pb.Comment{
+		CommentUuid:       c.UUID,
+		ApplicationUuid:   c.ApplicationUUID,
+		ParentCommentUuid: c.ParentUUID,
+		Text:              c.Text,
+		MentionedUuids:    c.MentionedUUIDs,
+		CreatedAt:         c.CreatedAt,
+		CreatedBy:         c.CreatedBy,
+		EditedAt:          c.EditedAt,
+		IsDeleted:         c.DeletedAt != "",
+		DeletedAt:         c.DeletedAt,
+		DeletedBy:         c.DeletedBy,
+	}
+	if res.IsDeleted {
+		res.Text = ""
+		res.MentionedUuids = nil
+	}
+	return res
+}
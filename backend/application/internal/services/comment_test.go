@@ -0,0 +1,490 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/cursor"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	commentID = "44444444-4444-4444-4444-444444444444"
+	replyID   = "55555555-5555-5555-5555-555555555555"
+)
+
+func testComment() *entities.Comment {
+	return &entities.Comment{
+		UUID:            commentID,
+		ApplicationUUID: appID,
+		Text:            "Which wall exactly?",
+		MentionedUUIDs:  []string{targetID},
+		CreatedAt:       "2024-01-01 00:00:00",
+		CreatedBy:       initiatorID,
+	}
+}
+
+func deletedComment() *entities.Comment {
+	c := testComment()
+	c.DeletedAt = "2024-01-02 00:00:00"
+	c.DeletedBy = initiatorID
+	return c
+}
+
+func commentRepoWith(comment *entities.Comment) *mockCommentRepo {
+	return &mockCommentRepo{
+		getComment: func(_ context.Context, _ entities.GetCommentDTO) (*entities.Comment, Error.CodeError) {
+			return comment, ok()
+		},
+	}
+}
+
+func createCommentRequest() *pb.CreateApplicationCommentRequest {
+	return &pb.CreateApplicationCommentRequest{
+		InitiatorUuid:   initiatorID,
+		ApplicationUuid: appID,
+		Text:            "  Which wall exactly?  ",
+	}
+}
+
+// ─── CreateApplicationComment ────────────────────────────────────────────────
+
+func TestCreateApplicationComment(t *testing.T) {
+	t.Run("success as creator with mention", func(t *testing.T) {
+		var created entities.CreateCommentDTO
+		commentRepo := &mockCommentRepo{
+			createComment: func(_ context.Context, dto entities.CreateCommentDTO) Error.CodeError {
+				created = dto
+				return ok()
+			},
+		}
+
+		req := createCommentRequest()
+		req.MentionedUuids = []string{targetID}
+		client := roleByTargetClient(map[string]string{initiatorID: "manager", targetID: "engineer"})
+
+		svc := newCommentTestService(repoWithApp(assignedApp()), commentRepo, client)
+		res, err := svc.CreateApplicationComment(context.Background(), req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.GetComment().GetCommentUuid() == "" || res.GetComment().GetCommentUuid() != created.CommentUUID {
+			t.Errorf("unexpected comment uuid: %q, created %q", res.GetComment().GetCommentUuid(), created.CommentUUID)
+		}
+		if created.Text != "Which wall exactly?" {
+			t.Errorf("expected trimmed text, got %q", created.Text)
+		}
+		if created.ParentUUID != "" || !slices.Equal(created.MentionedUUIDs, []string{targetID}) {
+			t.Errorf("unexpected comment: %+v", created)
+		}
+	})
+
+	t.Run("chief not involved in application can comment", func(t *testing.T) {
+		app := assignedApp()
+		app.CreatedBy, app.ManagedBy = otherUserID, otherUserID
+		commentRepo := &mockCommentRepo{
+			createComment: func(_ context.Context, _ entities.CreateCommentDTO) Error.CodeError { return ok() },
+		}
+
+		svc := newCommentTestService(repoWithApp(app), commentRepo, roleClient("chief"))
+		if _, err := svc.CreateApplicationComment(context.Background(), createCommentRequest()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("reply to top-level comment", func(t *testing.T) {
+		var created entities.CreateCommentDTO
+		commentRepo := commentRepoWith(testComment())
+		commentRepo.createComment = func(_ context.Context, dto entities.CreateCommentDTO) Error.CodeError {
+			created = dto
+			return ok()
+		}
+
+		req := createCommentRequest()
+		req.ParentCommentUuid = commentID
+
+		svc := newCommentTestService(repoWithApp(testApp()), commentRepo, roleClient("inspector"))
+		if _, err := svc.CreateApplicationComment(context.Background(), req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if created.ParentUUID != commentID {
+			t.Errorf("expected parent %s, got %q", commentID, created.ParentUUID)
+		}
+	})
+
+	t.Run("nested reply", func(t *testing.T) {
+		reply := testComment()
+		reply.UUID = replyID
+		reply.ParentUUID = commentID
+
+		req := createCommentRequest()
+		req.ParentCommentUuid = replyID
+
+		svc := newCommentTestService(repoWithApp(testApp()), commentRepoWith(reply), roleClient("inspector"))
+		_, err := svc.CreateApplicationComment(context.Background(), req)
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("reply to deleted comment", func(t *testing.T) {
+		req := createCommentRequest()
+		req.ParentCommentUuid = commentID
+
+		svc := newCommentTestService(repoWithApp(testApp()), commentRepoWith(deletedComment()), roleClient("inspector"))
+		_, err := svc.CreateApplicationComment(context.Background(), req)
+		assertCode(t, err, codes.FailedPrecondition)
+	})
+
+	t.Run("mentioned user is not a company employee", func(t *testing.T) {
+		req := createCommentRequest()
+		req.MentionedUuids = []string{otherUserID}
+
+		svc := newCommentTestService(repoWithApp(testApp()), &mockCommentRepo{}, roleByTargetClient(map[string]string{initiatorID: "inspector"}))
+		_, err := svc.CreateApplicationComment(context.Background(), req)
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("mentioned employee can't see application", func(t *testing.T) {
+		req := createCommentRequest()
+		req.MentionedUuids = []string{targetID}
+		client := roleByTargetClient(map[string]string{initiatorID: "inspector", targetID: "engineer"})
+
+		svc := newCommentTestService(repoWithApp(testApp()), &mockCommentRepo{}, client)
+		_, err := svc.CreateApplicationComment(context.Background(), req)
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("mention of analytic not involved in application", func(t *testing.T) {
+		commentRepo := &mockCommentRepo{
+			createComment: func(_ context.Context, _ entities.CreateCommentDTO) Error.CodeError { return ok() },
+		}
+		req := createCommentRequest()
+		req.MentionedUuids = []string{targetID}
+		client := roleByTargetClient(map[string]string{initiatorID: "inspector", targetID: "analytic"})
+
+		svc := newCommentTestService(repoWithApp(testApp()), commentRepo, client)
+		if _, err := svc.CreateApplicationComment(context.Background(), req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("duplicate mention", func(t *testing.T) {
+		req := createCommentRequest()
+		req.MentionedUuids = []string{targetID, targetID}
+
+		svc := newCommentTestService(emptyRepo(), &mockCommentRepo{}, &mockCompanyClient{})
+		_, err := svc.CreateApplicationComment(context.Background(), req)
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("empty text", func(t *testing.T) {
+		req := createCommentRequest()
+		req.Text = "   "
+
+		svc := newCommentTestService(emptyRepo(), &mockCommentRepo{}, &mockCompanyClient{})
+		_, err := svc.CreateApplicationComment(context.Background(), req)
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("not involved employee", func(t *testing.T) {
+		svc := newCommentTestService(repoWithApp(assignedApp()), &mockCommentRepo{}, roleClient("engineer"))
+		req := createCommentRequest()
+		req.InitiatorUuid = otherUserID
+		_, err := svc.CreateApplicationComment(context.Background(), req)
+		assertCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("analytic can't comment", func(t *testing.T) {
+		app := testApp()
+		app.CreatedBy = otherUserID
+
+		svc := newCommentTestService(repoWithApp(app), &mockCommentRepo{}, roleClient("analytic"))
+		_, err := svc.CreateApplicationComment(context.Background(), createCommentRequest())
+		assertCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("deleted application", func(t *testing.T) {
+		app := testApp()
+		app.DeletedAt = "2024-01-02 00:00:00"
+
+		svc := newCommentTestService(repoWithApp(app), &mockCommentRepo{}, roleClient("inspector"))
+		_, err := svc.CreateApplicationComment(context.Background(), createCommentRequest())
+		assertCode(t, err, codes.FailedPrecondition)
+	})
+}
+
+// ─── GetApplicationComments ──────────────────────────────────────────────────
+
+func TestGetApplicationComments(t *testing.T) {
+	req := func() *pb.GetApplicationCommentsRequest {
+		return &pb.GetApplicationCommentsRequest{InitiatorUuid: initiatorID, ApplicationUuid: appID, Count: 1}
+	}
+
+	t.Run("threads with replies and next cursor", func(t *testing.T) {
+		second := testComment()
+		second.UUID = "66666666-6666-6666-6666-666666666666"
+		reply := testComment()
+		reply.UUID = replyID
+		reply.ParentUUID = commentID
+
+		var threadsDTO entities.GetCommentThreadsDTO
+		var repliesDTO entities.GetCommentRepliesDTO
+		commentRepo := &mockCommentRepo{
+			getCommentThreads: func(_ context.Context, dto entities.GetCommentThreadsDTO) ([]*entities.Comment, Error.CodeError) {
+				threadsDTO = dto
+				return []*entities.Comment{testComment(), second}, ok()
+			},
+			countCommentThreads: func(_ context.Context, _ entities.CountCommentThreadsDTO) (int64, Error.CodeError) {
+				return 2, ok()
+			},
+			getCommentReplies: func(_ context.Context, dto entities.GetCommentRepliesDTO) ([]*entities.Comment, Error.CodeError) {
+				repliesDTO = dto
+				return []*entities.Comment{reply}, ok()
+			},
+		}
+
+		svc := newCommentTestService(repoWithApp(testApp()), commentRepo, roleClient("inspector"))
+		res, err := svc.GetApplicationComments(context.Background(), req())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if threadsDTO.Count != 2 {
+			t.Errorf("expected one extra thread requested, got count %d", threadsDTO.Count)
+		}
+		if !slices.Equal(repliesDTO.ParentUUIDs, []string{commentID}) {
+			t.Errorf("replies requested for %v", repliesDTO.ParentUUIDs)
+		}
+		if len(res.GetComments()) != 1 || len(res.GetComments()[0].GetReplies()) != 1 {
+			t.Fatalf("unexpected comments: %v", res.GetComments())
+		}
+		if res.GetTotalCount() != 2 {
+			t.Errorf("expected total 2, got %d", res.GetTotalCount())
+		}
+		position, _ := cursor.Decode(res.GetNextCursor())
+		if position.ID != commentID {
+			t.Errorf("expected cursor after %s, got %q", commentID, position.ID)
+		}
+	})
+
+	t.Run("deleted comment text is hidden", func(t *testing.T) {
+		commentRepo := &mockCommentRepo{
+			getCommentThreads: func(_ context.Context, _ entities.GetCommentThreadsDTO) ([]*entities.Comment, Error.CodeError) {
+				return []*entities.Comment{deletedComment()}, ok()
+			},
+			countCommentThreads: func(_ context.Context, _ entities.CountCommentThreadsDTO) (int64, Error.CodeError) {
+				return 1, ok()
+			},
+			getCommentReplies: func(_ context.Context, _ entities.GetCommentRepliesDTO) ([]*entities.Comment, Error.CodeError) {
+				return nil, ok()
+			},
+		}
+
+		svc := newCommentTestService(repoWithApp(testApp()), commentRepo, roleClient("inspector"))
+		res, err := svc.GetApplicationComments(context.Background(), req())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		c := res.GetComments()[0]
+		if !c.GetIsDeleted() || c.GetText() != "" || len(c.GetMentionedUuids()) != 0 {
+			t.Errorf("deleted comment content exposed: %v", c)
+		}
+	})
+
+	t.Run("analytic can read", func(t *testing.T) {
+		app := testApp()
+		app.CreatedBy = otherUserID
+		commentRepo := &mockCommentRepo{
+			getCommentThreads: func(_ context.Context, _ entities.GetCommentThreadsDTO) ([]*entities.Comment, Error.CodeError) {
+				return []*entities.Comment{}, ok()
+			},
+			countCommentThreads: func(_ context.Context, _ entities.CountCommentThreadsDTO) (int64, Error.CodeError) {
+				return 0, ok()
+			},
+		}
+
+		svc := newCommentTestService(repoWithApp(app), commentRepo, roleClient("analytic"))
+		if _, err := svc.GetApplicationComments(context.Background(), req()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("not involved employee", func(t *testing.T) {
+		r := req()
+		r.InitiatorUuid = otherUserID
+
+		svc := newCommentTestService(repoWithApp(assignedApp()), &mockCommentRepo{}, roleClient("engineer"))
+		_, err := svc.GetApplicationComments(context.Background(), r)
+		assertCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("cursor with offset", func(t *testing.T) {
+		r := req()
+		r.Offset = 1
+		r.Cursor = cursor.Encode(cursor.Cursor{ID: commentID})
+
+		svc := newCommentTestService(emptyRepo(), &mockCommentRepo{}, &mockCompanyClient{})
+		_, err := svc.GetApplicationComments(context.Background(), r)
+		assertCode(t, err, codes.InvalidArgument)
+	})
+}
+
+// ─── UpdateApplicationComment ────────────────────────────────────────────────
+
+func TestUpdateApplicationComment(t *testing.T) {
+	req := func() *pb.UpdateApplicationCommentRequest {
+		return &pb.UpdateApplicationCommentRequest{
+			InitiatorUuid:   initiatorID,
+			ApplicationUuid: appID,
+			CommentUuid:     commentID,
+			Text:            "The north wall",
+		}
+	}
+
+	t.Run("success as author", func(t *testing.T) {
+		var updated entities.UpdateCommentDTO
+		commentRepo := commentRepoWith(testComment())
+		commentRepo.updateComment = func(_ context.Context, dto entities.UpdateCommentDTO) Error.CodeError {
+			updated = dto
+			return ok()
+		}
+
+		svc := newCommentTestService(repoWithApp(testApp()), commentRepo, roleClient("inspector"))
+		if _, err := svc.UpdateApplicationComment(context.Background(), req()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if updated.Text != "The north wall" || updated.EditedBy != initiatorID || len(updated.MentionedUUIDs) != 0 {
+			t.Errorf("unexpected update: %+v", updated)
+		}
+	})
+
+	t.Run("not author", func(t *testing.T) {
+		comment := testComment()
+		comment.CreatedBy = targetID
+
+		svc := newCommentTestService(repoWithApp(testApp()), commentRepoWith(comment), roleClient("chief"))
+		_, err := svc.UpdateApplicationComment(context.Background(), req())
+		assertCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("author lost access to application", func(t *testing.T) {
+		app := assignedApp()
+		app.CreatedBy, app.ManagedBy = otherUserID, otherUserID
+
+		svc := newCommentTestService(repoWithApp(app), commentRepoWith(testComment()), roleClient("engineer"))
+		_, err := svc.UpdateApplicationComment(context.Background(), req())
+		assertCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("deleted comment", func(t *testing.T) {
+		svc := newCommentTestService(repoWithApp(testApp()), commentRepoWith(deletedComment()), roleClient("inspector"))
+		_, err := svc.UpdateApplicationComment(context.Background(), req())
+		assertCode(t, err, codes.FailedPrecondition)
+	})
+
+	t.Run("comment not found", func(t *testing.T) {
+		commentRepo := &mockCommentRepo{
+			getComment: func(_ context.Context, _ entities.GetCommentDTO) (*entities.Comment, Error.CodeError) {
+				return nil, notFound()
+			},
+		}
+
+		svc := newCommentTestService(repoWithApp(testApp()), commentRepo, roleClient("inspector"))
+		_, err := svc.UpdateApplicationComment(context.Background(), req())
+		assertCode(t, err, codes.NotFound)
+	})
+}
+
+// ─── DeleteApplicationComment ────────────────────────────────────────────────
+
+func TestDeleteApplicationComment(t *testing.T) {
+	req := func(initiator string) *pb.DeleteApplicationCommentRequest {
+		return &pb.DeleteApplicationCommentRequest{InitiatorUuid: initiator, ApplicationUuid: appID, CommentUuid: commentID}
+	}
+
+	t.Run("success as author", func(t *testing.T) {
+		var deleted entities.DeleteCommentDTO
+		commentRepo := commentRepoWith(testComment())
+		commentRepo.deleteComment = func(_ context.Context, dto entities.DeleteCommentDTO) Error.CodeError {
+			deleted = dto
+			return ok()
+		}
+
+		svc := newCommentTestService(repoWithApp(testApp()), commentRepo, roleClient("inspector"))
+		if _, err := svc.DeleteApplicationComment(context.Background(), req(initiatorID)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if deleted.CommentUUID != commentID || deleted.DeletedBy != initiatorID {
+			t.Errorf("unexpected delete: %+v", deleted)
+		}
+	})
+
+	t.Run("chief deletes another author's comment", func(t *testing.T) {
+		commentRepo := commentRepoWith(testComment())
+		commentRepo.deleteComment = func(_ context.Context, _ entities.DeleteCommentDTO) Error.CodeError { return ok() }
+
+		svc := newCommentTestService(repoWithApp(testApp()), commentRepo, roleClient("chief"))
+		if _, err := svc.DeleteApplicationComment(context.Background(), req(otherUserID)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("participant can't delete another author's comment", func(t *testing.T) {
+		svc := newCommentTestService(repoWithApp(assignedApp()), commentRepoWith(testComment()), roleClient("engineer"))
+		_, err := svc.DeleteApplicationComment(context.Background(), req(targetID))
+		assertCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("already deleted", func(t *testing.T) {
+		svc := newCommentTestService(repoWithApp(testApp()), commentRepoWith(deletedComment()), roleClient("inspector"))
+		_, err := svc.DeleteApplicationComment(context.Background(), req(initiatorID))
+		assertCode(t, err, codes.FailedPrecondition)
+	})
+}
+
+// ─── GetApplicationCommentHistory ────────────────────────────────────────────
+
+func TestGetApplicationCommentHistory(t *testing.T) {
+	req := func() *pb.GetApplicationCommentHistoryRequest {
+		return &pb.GetApplicationCommentHistoryRequest{InitiatorUuid: initiatorID, ApplicationUuid: appID, CommentUuid: commentID}
+	}
+	edits := func(_ context.Context, _ entities.GetCommentEditsDTO) ([]*entities.CommentEdit, Error.CodeError) {
+		return []*entities.CommentEdit{{Text: "Which wal?", EditedAt: "2024-01-01 00:01:00", EditedBy: initiatorID}}, ok()
+	}
+
+	t.Run("success", func(t *testing.T) {
+		commentRepo := commentRepoWith(testComment())
+		commentRepo.getCommentEdits = edits
+
+		svc := newCommentTestService(repoWithApp(testApp()), commentRepo, roleClient("inspector"))
+		res, err := svc.GetApplicationCommentHistory(context.Background(), req())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.GetComment().GetText() != "Which wall exactly?" || len(res.GetEdits()) != 1 || res.GetEdits()[0].GetText() != "Which wal?" {
+			t.Errorf("unexpected history: %v", res)
+		}
+	})
+
+	t.Run("deleted comment visible to chief", func(t *testing.T) {
+		commentRepo := commentRepoWith(deletedComment())
+		commentRepo.getCommentEdits = edits
+
+		svc := newCommentTestService(repoWithApp(testApp()), commentRepo, roleClient("chief"))
+		res, err := svc.GetApplicationCommentHistory(context.Background(), req())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !res.GetComment().GetIsDeleted() || res.GetComment().GetText() == "" {
+			t.Errorf("expected deleted comment with text, got %v", res.GetComment())
+		}
+	})
+
+	t.Run("deleted comment hidden from participant", func(t *testing.T) {
+		svc := newCommentTestService(repoWithApp(testApp()), commentRepoWith(deletedComment()), roleClient("inspector"))
+		_, err := svc.GetApplicationCommentHistory(context.Background(), req())
+		assertCode(t, err, codes.PermissionDenied)
+	})
+}
@@ -0,0 +1,192 @@
+package services
+
+import (
+	"context"
+	"slices"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GetCompanyCustomFields Получение дополнительных полей заявок компании (любой сотрудник компании)
+func (s *ApplicationService) GetCompanyCustomFields(ctx context.Context, req *pb.GetCompanyCustomFieldsRequest) (*pb.GetCompanyCustomFieldsResponse, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+
+	if _, err := s.getEmployeeInfo(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), req.GetInitiatorUuid()); err != nil {
+		return nil, err
+	}
+
+	fields, getErr := s.db.CustomFieldRepository.GetCompanyCustomFields(ctx, entities.GetCompanyCustomFieldsDTO{
+		CompanyUUID: req.GetCompanyUuid(),
+	})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.CustomField, 0, len(fields))
+	for _, f := range fields {
+		res = append(res, &pb.CustomField{
+			Key:      f.Key,
+			Title:    f.Title,
+			Type:     f.Type,
+			Options:  f.Options,
+			Required: f.Required,
+		})
+	}
+
+	return &pb.GetCompanyCustomFieldsResponse{Fields: res}, nil
+}
+
+// UpdateCompanyCustomField Создание или замена дополнительного поля по ключу (только chief).
+// Новые правила применяются только к заявкам, созданным после изменения
+func (s *ApplicationService) UpdateCompanyCustomField(ctx context.Context, req *pb.UpdateCompanyCustomFieldRequest) (*emptypb.Empty, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+
+	field := req.GetField()
+	if err := validate.CustomFieldKey(field.GetKey()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validate.CustomFieldTitle(field.GetTitle()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validate.CustomFieldType(field.GetType()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validate.CustomFieldOptions(field.GetType(), field.GetOptions()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.checkChief(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), "only chief can configure custom fields"); err != nil {
+		return nil, err
+	}
+
+	fields, getErr := s.db.CustomFieldRepository.GetCompanyCustomFields(ctx, entities.GetCompanyCustomFieldsDTO{
+		CompanyUUID: req.GetCompanyUuid(),
+	})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+	if _, exists := customFieldByKey(fields, field.GetKey()); !exists && len(fields) >= validate.CustomFieldsMax {
+		return nil, status.Errorf(codes.FailedPrecondition, "company can not have more than %d custom fields", validate.CustomFieldsMax)
+	}
+
+	if err := s.db.CustomFieldRepository.SaveCustomField(ctx, entities.SaveCustomFieldDTO{
+		CompanyUUID: req.GetCompanyUuid(),
+		Key:         field.GetKey(),
+		Title:       field.GetTitle(),
+		Type:        field.GetType(),
+		Options:     field.GetOptions(),
+		Required:    field.GetRequired(),
+		UpdatedBy:   req.GetInitiatorUuid(),
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteCompanyCustomField Удаление дополнительного поля (только chief). Сохранённые значения остаются в заявках
+func (s *ApplicationService) DeleteCompanyCustomField(ctx context.Context, req *pb.DeleteCompanyCustomFieldRequest) (*emptypb.Empty, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+	if err := validate.CustomFieldKey(req.GetKey()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.checkChief(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), "only chief can configure custom fields"); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.CustomFieldRepository.DeleteCustomField(ctx, entities.DeleteCustomFieldDTO{
+		CompanyUUID: req.GetCompanyUuid(),
+		Key:         req.GetKey(),
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// applicationCustomFields Проверка значений дополнительных полей новой заявки по определениям компании:
+// ключ должен быть объявлен, значение - соответствовать типу, обязательные поля - заполнены
+func applicationCustomFields(fields []*entities.CustomField, values []*pb.CustomFieldValue) (map[string]string, error) {
+	res := make(map[string]string, len(values))
+	for _, v := range values {
+		field, found := customFieldByKey(fields, v.GetKey())
+		if !found {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown custom field %q", v.GetKey())
+		}
+		if _, duplicate := res[v.GetKey()]; duplicate {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate custom field %q", v.GetKey())
+		}
+		if err := validate.CustomFieldValue(field.Type, field.Options, v.GetValue()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "custom field %q: %s", v.GetKey(), err.Error())
+		}
+		res[v.GetKey()] = v.GetValue()
+	}
+
+	for _, field := range fields {
+		if _, filled := res[field.Key]; field.Required && !filled {
+			return nil, status.Errorf(codes.InvalidArgument, "custom field %q is required", field.Key)
+		}
+	}
+	return res, nil
+}
+
+// customFieldsFilter Фильтр списка заявок по значениям дополнительных полей. Значения не сверяются с
+// текущими определениями - поле могли удалить, а заявки с его значениями остались
+func customFieldsFilter(values []*pb.CustomFieldValue) (map[string]string, error) {
+	res := make(map[string]string, len(values))
+	for _, v := range values {
+		if err := validate.CustomFieldKey(v.GetKey()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if _, duplicate := res[v.GetKey()]; duplicate {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate custom field %q", v.GetKey())
+		}
+		res[v.GetKey()] = v.GetValue()
+	}
+	return res, nil
+}
+
+func customFieldByKey(fields []*entities.CustomField, key string) (*entities.CustomField, bool) {
+	for _, f := range fields {
+		if f.Key == key {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+// customFieldsToPB Значения дополнительных полей заявки в порядке ключей
+func customFieldsToPB(values map[string]string) []*pb.CustomFieldValue {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	res := make([]*pb.CustomFieldValue, 0, len(keys))
+	for _, key := range keys {
+		res = append(res, &pb.CustomFieldValue{Key: key, Value: values[key]})
+	}
+	return res
+}
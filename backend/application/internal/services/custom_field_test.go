@@ -0,0 +1,265 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+// testCustomFields — дополнительные поля компании всех типов, обязательно только crack_width
+func testCustomFields() []*entities.CustomField {
+	return []*entities.CustomField{
+		{CompanyUUID: companyID, Key: "crack_width", Title: "Crack width", Type: "number", Required: true},
+		{CompanyUUID: companyID, Key: "wall", Title: "Wall", Type: "enum", Options: []string{"north", "south"}},
+		{CompanyUUID: companyID, Key: "found_on", Title: "Found on", Type: "date"},
+		{CompanyUUID: companyID, Key: "note", Title: "Note", Type: "text"},
+	}
+}
+
+func customFieldRepoWith(fields []*entities.CustomField) *mockCustomFieldRepo {
+	return &mockCustomFieldRepo{
+		getCompanyCustomFields: func(_ context.Context, _ entities.GetCompanyCustomFieldsDTO) ([]*entities.CustomField, Error.CodeError) {
+			return fields, ok()
+		},
+	}
+}
+
+func TestGetCompanyCustomFields(t *testing.T) {
+	svc := newCategoryTestService(emptyRepo(), &mockCategoryRepo{}, customFieldRepoWith(testCustomFields()), roleClient("inspector"))
+
+	res, err := svc.GetCompanyCustomFields(context.Background(), &pb.GetCompanyCustomFieldsRequest{
+		InitiatorUuid: initiatorID,
+		CompanyUuid:   companyID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(res.GetFields()) != 4 || !res.GetFields()[0].GetRequired() || len(res.GetFields()[1].GetOptions()) != 2 {
+		t.Errorf("unexpected fields: %v", res.GetFields())
+	}
+}
+
+func TestUpdateCompanyCustomField(t *testing.T) {
+	update := func(svc *ApplicationService, field *pb.CustomField) error {
+		_, err := svc.UpdateCompanyCustomField(context.Background(), &pb.UpdateCompanyCustomFieldRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+			Field:         field,
+		})
+		return err
+	}
+
+	t.Run("success", func(t *testing.T) {
+		var saved entities.SaveCustomFieldDTO
+		fieldRepo := customFieldRepoWith(nil)
+		fieldRepo.saveCustomField = func(_ context.Context, dto entities.SaveCustomFieldDTO) Error.CodeError {
+			saved = dto
+			return ok()
+		}
+		svc := newCategoryTestService(emptyRepo(), &mockCategoryRepo{}, fieldRepo, roleClient("chief"))
+
+		err := update(svc, &pb.CustomField{Key: "wall", Title: "Wall", Type: "enum", Options: []string{"north", "south"}, Required: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if saved.Key != "wall" || saved.Type != "enum" || len(saved.Options) != 2 || !saved.Required || saved.UpdatedBy != initiatorID {
+			t.Errorf("unexpected saved field: %+v", saved)
+		}
+	})
+
+	t.Run("type change rejected", func(t *testing.T) {
+		fieldRepo := customFieldRepoWith(testCustomFields())
+		fieldRepo.saveCustomField = func(_ context.Context, _ entities.SaveCustomFieldDTO) Error.CodeError {
+			return Error.Public(codes.FailedPrecondition, "custom field type can not be changed, delete the field instead")
+		}
+		svc := newCategoryTestService(emptyRepo(), &mockCategoryRepo{}, fieldRepo, roleClient("chief"))
+		assertCode(t, update(svc, &pb.CustomField{Key: "note", Title: "Note", Type: "number"}), codes.FailedPrecondition)
+	})
+
+	t.Run("too many fields", func(t *testing.T) {
+		fields := make([]*entities.CustomField, 0, 30)
+		for i := range 30 {
+			fields = append(fields, &entities.CustomField{Key: fmt.Sprintf("field_%d", i), Type: "text"})
+		}
+		svc := newCategoryTestService(emptyRepo(), &mockCategoryRepo{}, customFieldRepoWith(fields), roleClient("chief"))
+		assertCode(t, update(svc, &pb.CustomField{Key: "one_more", Title: "One more", Type: "text"}), codes.FailedPrecondition)
+	})
+
+	t.Run("not chief", func(t *testing.T) {
+		svc := newCategoryTestService(emptyRepo(), &mockCategoryRepo{}, &mockCustomFieldRepo{}, roleClient("manager"))
+		assertCode(t, update(svc, &pb.CustomField{Key: "note", Title: "Note", Type: "text"}), codes.PermissionDenied)
+	})
+
+	cases := []struct {
+		name  string
+		field *pb.CustomField
+	}{
+		{"invalid key", &pb.CustomField{Key: "Crack Width", Title: "Crack width", Type: "number"}},
+		{"empty title", &pb.CustomField{Key: "crack_width", Type: "number"}},
+		{"unknown type", &pb.CustomField{Key: "crack_width", Title: "Crack width", Type: "float"}},
+		{"enum without options", &pb.CustomField{Key: "wall", Title: "Wall", Type: "enum"}},
+		{"duplicate options", &pb.CustomField{Key: "wall", Title: "Wall", Type: "enum", Options: []string{"north", "north"}}},
+		{"options for text", &pb.CustomField{Key: "note", Title: "Note", Type: "text", Options: []string{"a"}}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := newCategoryTestService(emptyRepo(), &mockCategoryRepo{}, &mockCustomFieldRepo{}, roleClient("chief"))
+			assertCode(t, update(svc, tc.field), codes.InvalidArgument)
+		})
+	}
+}
+
+func TestDeleteCompanyCustomField(t *testing.T) {
+	remove := func(svc *ApplicationService) error {
+		_, err := svc.DeleteCompanyCustomField(context.Background(), &pb.DeleteCompanyCustomFieldRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+			Key:           "wall",
+		})
+		return err
+	}
+
+	t.Run("success", func(t *testing.T) {
+		var deleted entities.DeleteCustomFieldDTO
+		fieldRepo := &mockCustomFieldRepo{
+			deleteCustomField: func(_ context.Context, dto entities.DeleteCustomFieldDTO) Error.CodeError {
+				deleted = dto
+				return ok()
+			},
+		}
+		svc := newCategoryTestService(emptyRepo(), &mockCategoryRepo{}, fieldRepo, roleClient("chief"))
+
+		if err := remove(svc); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if deleted.CompanyUUID != companyID || deleted.Key != "wall" {
+			t.Errorf("unexpected deleted field: %+v", deleted)
+		}
+	})
+
+	t.Run("field not found", func(t *testing.T) {
+		fieldRepo := &mockCustomFieldRepo{
+			deleteCustomField: func(_ context.Context, _ entities.DeleteCustomFieldDTO) Error.CodeError {
+				return notFound()
+			},
+		}
+		svc := newCategoryTestService(emptyRepo(), &mockCategoryRepo{}, fieldRepo, roleClient("chief"))
+		assertCode(t, remove(svc), codes.NotFound)
+	})
+}
+
+// ─── CreateApplication: дополнительные поля ──────────────────────────────────
+
+func TestCreateApplicationCustomFields(t *testing.T) {
+	create := func(svc *ApplicationService, values ...*pb.CustomFieldValue) error {
+		_, err := svc.CreateApplication(context.Background(), &pb.CreateApplicationRequest{
+			InitiatorUuid:   initiatorID,
+			CompanyUuid:     companyID,
+			ApplicationData: &pb.ApplicationData{Title: "Valid Title", Description: "Some description"},
+			CustomFields:    values,
+		})
+		return err
+	}
+	value := func(key, v string) *pb.CustomFieldValue { return &pb.CustomFieldValue{Key: key, Value: v} }
+
+	t.Run("values saved", func(t *testing.T) {
+		var got entities.CreateApplicationDTO
+		repo := emptyRepo()
+		repo.createApplication = func(_ context.Context, dto entities.CreateApplicationDTO) Error.CodeError {
+			got = dto
+			return ok()
+		}
+		svc := newCategoryTestService(repo, &mockCategoryRepo{}, customFieldRepoWith(testCustomFields()), roleClient("inspector"))
+
+		err := create(svc, value("crack_width", "0.35"), value("wall", "north"), value("found_on", "2024-05-01"), value("note", "near the stairs"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(got.CustomFields) != 4 || got.CustomFields["crack_width"] != "0.35" || got.CustomFields["wall"] != "north" {
+			t.Errorf("unexpected custom fields: %v", got.CustomFields)
+		}
+	})
+
+	cases := []struct {
+		name   string
+		values []*pb.CustomFieldValue
+	}{
+		{"required field missing", []*pb.CustomFieldValue{value("wall", "north")}},
+		{"unknown field", []*pb.CustomFieldValue{value("crack_width", "1"), value("color", "red")}},
+		{"duplicate field", []*pb.CustomFieldValue{value("crack_width", "1"), value("crack_width", "2")}},
+		{"not a number", []*pb.CustomFieldValue{value("crack_width", "wide")}},
+		{"unknown option", []*pb.CustomFieldValue{value("crack_width", "1"), value("wall", "east")}},
+		{"invalid date", []*pb.CustomFieldValue{value("crack_width", "1"), value("found_on", "01.05.2024")}},
+		{"empty value", []*pb.CustomFieldValue{value("crack_width", "1"), value("note", " ")}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := newCategoryTestService(emptyRepo(), &mockCategoryRepo{}, customFieldRepoWith(testCustomFields()), roleClient("inspector"))
+			assertCode(t, create(svc, tc.values...), codes.InvalidArgument)
+		})
+	}
+}
+
+// ─── GetApplications: категория, серьёзность и дополнительные поля ───────────
+
+func TestGetApplicationsDefectFilters(t *testing.T) {
+	t.Run("filters passed to repository", func(t *testing.T) {
+		var got entities.GetApplicationsDTO
+		repo := emptyRepo()
+		repo.getApplications = func(_ context.Context, dto entities.GetApplicationsDTO) ([]*entities.Application, Error.CodeError) {
+			got = dto
+			return nil, ok()
+		}
+		svc := newAppTestService(repo, roleClient("chief"))
+
+		_, err := svc.GetApplications(context.Background(), &pb.GetApplicationsRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+			Count:         10,
+			CategoryUuid:  categoryID,
+			Severities:    []string{"major", "critical"},
+			CustomFields:  []*pb.CustomFieldValue{{Key: "wall", Value: "north"}},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.CategoryUUID != categoryID || len(got.Severities) != 2 || got.CustomFields["wall"] != "north" {
+			t.Errorf("unexpected defect filters: %+v", got)
+		}
+	})
+
+	cases := []struct {
+		name string
+		req  *pb.GetApplicationsRequest
+	}{
+		{"invalid category", &pb.GetApplicationsRequest{CategoryUuid: "not-a-uuid"}},
+		{"invalid severity", &pb.GetApplicationsRequest{Severities: []string{"catastrophic"}}},
+		{"invalid custom field key", &pb.GetApplicationsRequest{CustomFields: []*pb.CustomFieldValue{{Key: "Wall", Value: "north"}}}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.req.InitiatorUuid, tc.req.CompanyUuid, tc.req.Count = initiatorID, companyID, 10
+			svc := newAppTestService(emptyRepo(), roleClient("chief"))
+			_, err := svc.GetApplications(context.Background(), tc.req)
+			assertCode(t, err, codes.InvalidArgument)
+		})
+	}
+
+	t.Run("matches watch filter", func(t *testing.T) {
+		filter := entities.GetApplicationsDTO{CompanyUUID: companyID, CategoryUUID: categoryID, Severities: []string{"major"}, CustomFields: map[string]string{"wall": "north"}}
+		app := testApp()
+		app.CategoryUUID, app.Severity, app.CustomFields = categoryID, "major", map[string]string{"wall": "north", "note": "x"}
+		if !matchesApplicationsFilter(filter, app) {
+			t.Error("expected application to match filter")
+		}
+		app.CustomFields["wall"] = "south"
+		if matchesApplicationsFilter(filter, app) {
+			t.Error("expected application with other custom field value not to match")
+		}
+	})
+}
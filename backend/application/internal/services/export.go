@@ -0,0 +1,153 @@
+package services
+
+import (
+	"context"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportBatchSize Кол-во заявок, читаемых из БД за один раз при выгрузке
+const exportBatchSize = 100
+
+// ExportApplications Потоковая выгрузка заявок компании с fix log-ами и историей статусов (chief/analytic).
+// Заявки читаются пачками по курсору, поэтому выгрузка не держит в памяти всю выборку.
+func (s *ApplicationService) ExportApplications(req *pb.ExportApplicationsRequest, stream grpc.ServerStreamingServer[pb.ExportedApplication]) error {
+	query := req.GetQuery()
+	if err := validate.UUID(query.GetInitiatorUuid()); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(query.GetCompanyUuid()); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+	if query.GetSortBy() != "" && query.GetSortBy() != "created_at" {
+		return status.Errorf(codes.InvalidArgument, "export is available only for created_at sort")
+	}
+
+	ctx := stream.Context()
+	initiator, err := s.checkAnalyticsAccess(ctx, query.GetCompanyUuid(), query.GetInitiatorUuid())
+	if err != nil {
+		return err
+	}
+
+	filter, err := applicationsFilter(initiator, applicationsFilterParams{
+		CompanyUUID:    query.GetCompanyUuid(),
+		DepartmentUUID: query.GetDepartmentUuid(),
+		Statuses:       query.GetStatuses(),
+		IsDeleted:      query.GetIsDeleted(),
+		IsOverdue:      query.GetIsOverdue(),
+	})
+	if err != nil {
+		return err
+	}
+	if err = applicationsSearchFilter(&filter, query); err != nil {
+		return err
+	}
+	filter.Count = exportBatchSize
+
+	for {
+		applications, dbErr := s.db.ApplicationRepository.GetApplications(ctx, filter)
+		if err = dbErr.GRPCError(); err != nil {
+			return err
+		}
+		if len(applications) == 0 {
+			return nil
+		}
+
+		exported, batchErr := s.exportBatch(ctx, applications)
+		if batchErr != nil {
+			return batchErr
+		}
+		for _, app := range exported {
+			if err = stream.Send(app); err != nil {
+				return err
+			}
+		}
+
+		if len(applications) < exportBatchSize {
+			return nil
+		}
+		filter.AfterUUID = applications[len(applications)-1].ApplicationUUID
+	}
+}
+
+// exportBatch Дополняет пачку заявок fix log-ами и историей статусов двумя запросами на всю пачку
+func (s *ApplicationService) exportBatch(ctx context.Context, applications []*entities.Application) ([]*pb.ExportedApplication, error) {
+	uuids := make([]string, 0, len(applications))
+	for _, app := range applications {
+		uuids = append(uuids, app.ApplicationUUID)
+	}
+
+	fixLogs, dbErr := s.db.ApplicationRepository.GetFixLogsByApplications(ctx, entities.GetFixLogsByApplicationsDTO{ApplicationUUIDs: uuids})
+	if err := dbErr.GRPCError(); err != nil {
+		return nil, err
+	}
+	timeline, dbErr := s.db.ApplicationRepository.GetStatusTimeline(ctx, entities.GetStatusTimelineDTO{ApplicationUUIDs: uuids})
+	if err := dbErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	appFixLogs := make(map[string][]*pb.FixLog, len(applications))
+	for _, fl := range fixLogs {
+		appFixLogs[fl.ApplicationUUID] = append(appFixLogs[fl.ApplicationUUID], &pb.FixLog{
+			Uuid:      fl.UUID,
+			Text:      fl.Text,
+			CreatedAt: fl.CreatedAt,
+			CreatedBy: fl.CreatedBy,
+		})
+	}
+	appTimeline := make(map[string][]*pb.StatusChange, len(applications))
+	for _, change := range timeline {
+		appTimeline[change.ApplicationUUID] = append(appTimeline[change.ApplicationUUID], &pb.StatusChange{
+			Status:    change.Status,
+			ChangedAt: change.ChangedAt,
+			ChangedBy: change.ChangedBy,
+		})
+	}
+
+	exported := make([]*pb.ExportedApplication, 0, len(applications))
+	for _, app := range applications {
+		// История начинается с создания заявки - в снапшотах этого перехода нет
+		created := &pb.StatusChange{Status: "created", ChangedAt: app.CreatedAt, ChangedBy: app.CreatedBy}
+
+		exported = append(exported, &pb.ExportedApplication{
+			Application: &pb.Application{
+				ApplicationUuid: app.ApplicationUUID,
+				CompanyUuid:     app.CompanyUUID,
+				DepartmentUuid:  app.DepartmentUUID,
+				Version:         app.Version,
+				Title:           app.Title,
+				Description:     app.Description,
+				RevisionCount:   app.RevisionCount,
+				Status:          app.Status,
+				CreatedAt:       app.CreatedAt,
+				CreatedBy:       app.CreatedBy,
+				UpdatedAt:       app.UpdatedAt,
+				UpdatedBy:       app.UpdatedBy,
+				ManagedBy:       app.ManagedBy,
+				ExecutedBy:      app.ExecutedBy,
+				InspectedBy:     app.InspectedBy,
+				ClosedAt:        app.ClosedAt,
+				DeletedAt:       app.DeletedAt,
+				DeletedBy:       app.DeletedBy,
+				Priority:        app.Priority,
+				DueAt:           app.DueAt,
+				IsOverdue:       app.SLABreach != "",
+				SlaBreach:       app.SLABreach,
+				OverdueAt:       app.OverdueAt,
+				LocationUuid:    app.LocationUUID,
+				CategoryUuid:    app.CategoryUUID,
+				Severity:        app.Severity,
+				CustomFields:    customFieldsToPB(app.CustomFields),
+				FixLogs:         appFixLogs[app.ApplicationUUID],
+			},
+			Timeline: append([]*pb.StatusChange{created}, appTimeline[app.ApplicationUUID]...),
+		})
+	}
+
+	return exported, nil
+}
@@ -0,0 +1,147 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// mockExportStream — серверный поток ExportApplications, накапливающий отправленные заявки
+type mockExportStream struct {
+	grpc.ServerStream
+	sent []*pb.ExportedApplication
+}
+
+func (m *mockExportStream) Context() context.Context { return context.Background() }
+func (m *mockExportStream) Send(app *pb.ExportedApplication) error {
+	m.sent = append(m.sent, app)
+	return nil
+}
+
+// exportRepo — мок репозитория с total заявками, отдающий их пачками по AfterUUID
+func exportRepo(total int, batches *[]entities.GetApplicationsDTO) *mockApplicationRepo {
+	apps := make([]*entities.Application, 0, total)
+	for i := range total {
+		apps = append(apps, &entities.Application{
+			ApplicationUUID: fmt.Sprintf("00000000-0000-7000-8000-%012d", total-i),
+			CompanyUUID:     companyID,
+			Status:          "completed",
+			CreatedAt:       "2026-01-01 10:00:00+00",
+			CreatedBy:       targetID,
+		})
+	}
+
+	repo := emptyRepo()
+	repo.getApplications = func(_ context.Context, dto entities.GetApplicationsDTO) ([]*entities.Application, Error.CodeError) {
+		*batches = append(*batches, dto)
+		start := 0
+		if dto.AfterUUID != "" {
+			for i, app := range apps {
+				if app.ApplicationUUID == dto.AfterUUID {
+					start = i + 1
+				}
+			}
+		}
+		end := min(start+int(dto.Count), len(apps))
+		return apps[start:end], ok()
+	}
+	repo.getFixLogsByApplications = func(_ context.Context, dto entities.GetFixLogsByApplicationsDTO) ([]*entities.FixLog, Error.CodeError) {
+		return []*entities.FixLog{{UUID: "fl", ApplicationUUID: dto.ApplicationUUIDs[0], Text: "fixed"}}, ok()
+	}
+	repo.getStatusTimeline = func(_ context.Context, dto entities.GetStatusTimelineDTO) ([]*entities.StatusChange, Error.CodeError) {
+		return []*entities.StatusChange{{ApplicationUUID: dto.ApplicationUUIDs[0], Status: "completed", ChangedBy: initiatorID}}, ok()
+	}
+	return repo
+}
+
+func TestExportApplications(t *testing.T) {
+	export := func(svc *ApplicationService, query *pb.GetApplicationsRequest) (*mockExportStream, error) {
+		stream := &mockExportStream{}
+		return stream, svc.ExportApplications(&pb.ExportApplicationsRequest{Query: query}, stream)
+	}
+	query := func() *pb.GetApplicationsRequest {
+		return &pb.GetApplicationsRequest{InitiatorUuid: initiatorID, CompanyUuid: companyID}
+	}
+
+	t.Run("streams all applications in batches", func(t *testing.T) {
+		var batches []entities.GetApplicationsDTO
+		svc := newAppTestService(exportRepo(exportBatchSize+5, &batches), roleClient("analytic"))
+
+		stream, err := export(svc, query())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(stream.sent) != exportBatchSize+5 {
+			t.Fatalf("expected %d applications, got %d", exportBatchSize+5, len(stream.sent))
+		}
+		if len(batches) != 2 || batches[0].AfterUUID != "" || batches[1].AfterUUID != stream.sent[exportBatchSize-1].GetApplication().GetApplicationUuid() {
+			t.Errorf("unexpected batches: %+v", batches)
+		}
+		if batches[0].SortBy != "created_at" || batches[0].Count != exportBatchSize {
+			t.Errorf("unexpected filter: %+v", batches[0])
+		}
+	})
+
+	t.Run("fix logs and timeline are attached to their application", func(t *testing.T) {
+		var batches []entities.GetApplicationsDTO
+		svc := newAppTestService(exportRepo(2, &batches), roleClient("chief"))
+
+		stream, err := export(svc, query())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(batches) != 1 {
+			t.Errorf("expected single batch, got %d", len(batches))
+		}
+		first, second := stream.sent[0], stream.sent[1]
+		if len(first.GetApplication().GetFixLogs()) != 1 || len(second.GetApplication().GetFixLogs()) != 0 {
+			t.Errorf("unexpected fix logs: %v / %v", first.GetApplication().GetFixLogs(), second.GetApplication().GetFixLogs())
+		}
+		if len(first.GetTimeline()) != 2 || first.GetTimeline()[0].GetStatus() != "created" || first.GetTimeline()[0].GetChangedBy() != targetID || first.GetTimeline()[1].GetStatus() != "completed" {
+			t.Errorf("unexpected timeline: %v", first.GetTimeline())
+		}
+		if len(second.GetTimeline()) != 1 {
+			t.Errorf("unexpected timeline: %v", second.GetTimeline())
+		}
+	})
+
+	t.Run("sort other than created_at", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("chief"))
+		q := query()
+		q.SortBy = "priority"
+		_, err := export(svc, q)
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("invalid date filter", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("chief"))
+		q := query()
+		q.CreatedFrom = "yesterday"
+		_, err := export(svc, q)
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		repo := emptyRepo()
+		repo.getApplications = func(_ context.Context, _ entities.GetApplicationsDTO) ([]*entities.Application, Error.CodeError) {
+			return nil, internalErr()
+		}
+		svc := newAppTestService(repo, roleClient("chief"))
+		_, err := export(svc, query())
+		assertCode(t, err, codes.Internal)
+	})
+
+	for _, role := range []string{"manager", "engineer", "inspector"} {
+		t.Run(role+" denied", func(t *testing.T) {
+			svc := newAppTestService(emptyRepo(), roleClient(role))
+			_, err := export(svc, query())
+			assertCode(t, err, codes.PermissionDenied)
+		})
+	}
+}
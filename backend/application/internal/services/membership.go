@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/rs/zerolog/log"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/interceptors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// HandleEmployeeOffboarding Обработчик событий, после которых сотрудник теряет связи с заявками:
+// уход и удаление из компании (employee.left, employee.removed), смена роли (employee.role_changed)
+// и удаление из отдела (employee.department_changed). Изменение уже записано company сервисом,
+// политику chief он проверил до записи (OffboardEmployeeApplications с dry_run): по reassign заявки
+// передаются коллеге, иначе возвращаются в пул отдела, чтобы их могли взять другие сотрудники.
+// Повторная доставка события безопасна - обработанные заявки повторно не выбираются
+func (s *ApplicationService) HandleEmployeeOffboarding(ctx context.Context, body []byte) Error.CodeError {
+	event := &entities.MembershipEvent{}
+	if err := json.Unmarshal(body, event); err != nil {
+		return Error.Public(codes.InvalidArgument, "invalid event body")
+	}
+
+	var comment string
+	switch event.EventType {
+	case entities.EventEmployeeLeft:
+		comment = "employee left the company"
+	case entities.EventEmployeeRemoved:
+		comment = "employee removed from company"
+	case entities.EventEmployeeRoleChanged:
+		comment = "employee role changed to " + event.Role
+	case entities.EventEmployeeDepartmentChanged:
+		comment = "employee removed from department"
+	default:
+		return Error.CodeError{}
+	}
+	// Перевод в отдел публикуется без политики - заявки сотрудника он не затрагивает
+	if event.OpenApplications == "" && (event.EventType == entities.EventEmployeeRoleChanged || event.EventType == entities.EventEmployeeDepartmentChanged) {
+		return Error.CodeError{}
+	}
+	if event.CompanyUUID == "" || event.UserUUID == "" {
+		return Error.Public(codes.InvalidArgument, "invalid event body")
+	}
+
+	// Сотрудник, сам покинувший компанию, - инициатор изменения своих заявок
+	initiatorUUID := event.InitiatorUUID
+	if initiatorUUID == "" {
+		initiatorUUID = event.UserUUID
+	}
+
+	relations := offboardingRelations(event.Role)
+	reassignTo, err := s.offboardingReassignTarget(ctx, event, relations)
+	if err.Code != 0 {
+		return err
+	}
+
+	released, err := s.db.ApplicationRepository.OffboardEmployeeApplications(ctx, entities.OffboardEmployeeApplicationsDTO{
+		CompanyUUID:    event.CompanyUUID,
+		UserUUID:       event.UserUUID,
+		DepartmentUUID: event.DepartmentUUID,
+		Relations:      relations,
+		ReassignTo:     reassignTo,
+		InitiatorUUID:  initiatorUUID,
+		FixLogText:     offboardingFixLogText(comment, reassignTo),
+	})
+	if err.Code != 0 {
+		return err
+	}
+
+	if released > 0 {
+		log.Info().Str("company_uuid", event.CompanyUUID).Str("user_uuid", event.UserUUID).Str("event_type", event.EventType).
+			Int64("released", released).Msg("employee applications released")
+	}
+	return Error.CodeError{}
+}
+
+// offboardingReassignTarget Коллега, которому передаются заявки по политике reassign. Если после проверки company
+// сервисом коллега перестал подходить (ушёл из отдела, сменил роль), заявки возвращаются в пул отдела
+func (s *ApplicationService) offboardingReassignTarget(ctx context.Context, event *entities.MembershipEvent, relations []string) (string, Error.CodeError) {
+	if event.OpenApplications != entities.OffboardingReassign || event.ReassignTo == "" || event.InitiatorUUID == "" {
+		return "", Error.CodeError{}
+	}
+
+	applications, getErr := s.db.ApplicationRepository.GetEmployeeOpenApplications(ctx, entities.GetEmployeeOpenApplicationsDTO{
+		CompanyUUID:    event.CompanyUUID,
+		UserUUID:       event.UserUUID,
+		DepartmentUUID: event.DepartmentUUID,
+		Relations:      relations,
+	})
+	if getErr.Code != 0 {
+		return "", getErr
+	}
+	if len(applications) == 0 {
+		return "", Error.CodeError{}
+	}
+
+	// Событие доставляется без пользователя - коллегу проверяем от имени chief, выполнившего изменение
+	err := s.checkReassignTarget(interceptors.ContextWithUserUUID(ctx, event.InitiatorUUID), &pb.OffboardEmployeeApplicationsRequest{
+		InitiatorUuid:  event.InitiatorUUID,
+		CompanyUuid:    event.CompanyUUID,
+		UserUuid:       event.UserUUID,
+		ReassignToUuid: event.ReassignTo,
+	}, applications, relations)
+	switch status.Code(err) {
+	case codes.OK:
+		return event.ReassignTo, Error.CodeError{}
+	case codes.FailedPrecondition, codes.NotFound, codes.PermissionDenied:
+		log.Warn().Err(err).Str("company_uuid", event.CompanyUUID).Str("user_uuid", event.UserUUID).Str("reassign_to", event.ReassignTo).
+			Msg("reassign target no longer fits, applications returned to department pool")
+		return "", Error.CodeError{}
+	default:
+		return "", Error.Internal(err)
+	}
+}
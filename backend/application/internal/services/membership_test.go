@@ -0,0 +1,176 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+func membershipEventBody(t *testing.T, eventType string) []byte {
+	t.Helper()
+	return offboardingEventBody(t, entities.MembershipEvent{
+		EventType:   eventType,
+		CompanyUUID: companyID,
+		UserUUID:    targetID,
+	})
+}
+
+// offboardingEventBody — событие изменения состава с политикой обработки заявок, выбранной chief
+func offboardingEventBody(t *testing.T, event entities.MembershipEvent) []byte {
+	t.Helper()
+	body, err := json.Marshal(event)
+	if err != nil {
+		t.Fatalf("marshal event: %v", err)
+	}
+	return body
+}
+
+// ─── HandleEmployeeOffboarding ───────────────────────────────────────────────
+
+func TestHandleEmployeeOffboarding(t *testing.T) {
+	for _, eventType := range []string{entities.EventEmployeeLeft, entities.EventEmployeeRemoved} {
+		t.Run(eventType, func(t *testing.T) {
+			var got entities.OffboardEmployeeApplicationsDTO
+			repo := &mockApplicationRepo{
+				offboardEmployeeApplications: func(_ context.Context, dto entities.OffboardEmployeeApplicationsDTO) (int64, Error.CodeError) {
+					got = dto
+					return 2, ok()
+				},
+			}
+			svc := newAppTestService(repo, nil)
+
+			if err := svc.HandleEmployeeOffboarding(context.Background(), membershipEventBody(t, eventType)); err.Code != 0 {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.CompanyUUID != companyID || got.UserUUID != targetID || got.ReassignTo != "" {
+				t.Errorf("unexpected dto: %+v", got)
+			}
+			if len(got.Relations) != len(entities.Relations) {
+				t.Errorf("expected all relations to be released, got %v", got.Relations)
+			}
+		})
+	}
+
+	t.Run("role change keeps relation of new role", func(t *testing.T) {
+		var got entities.OffboardEmployeeApplicationsDTO
+		svc := newAppTestService(offboardingRepo(nil, &got), nil)
+
+		body := offboardingEventBody(t, entities.MembershipEvent{
+			EventType: entities.EventEmployeeRoleChanged, CompanyUUID: companyID, UserUUID: targetID,
+			Role: "manager", InitiatorUUID: initiatorID, OpenApplications: entities.OffboardingPool,
+		})
+		if err := svc.HandleEmployeeOffboarding(context.Background(), body); err.Code != 0 {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if slices.Contains(got.Relations, entities.RelationManager) || len(got.Relations) != len(entities.Relations)-1 {
+			t.Errorf("expected manager relation to be kept, got %v", got.Relations)
+		}
+		if got.InitiatorUUID != initiatorID || got.FixLogText != "employee role changed to manager: returned to department pool" {
+			t.Errorf("unexpected dto: %+v", got)
+		}
+	})
+
+	t.Run("department removal limited to department", func(t *testing.T) {
+		var got entities.OffboardEmployeeApplicationsDTO
+		svc := newAppTestService(offboardingRepo(nil, &got), nil)
+
+		body := offboardingEventBody(t, entities.MembershipEvent{
+			EventType: entities.EventEmployeeDepartmentChanged, CompanyUUID: companyID, UserUUID: targetID,
+			DepartmentUUID: deptID, InitiatorUUID: initiatorID, OpenApplications: entities.OffboardingBlock,
+		})
+		if err := svc.HandleEmployeeOffboarding(context.Background(), body); err.Code != 0 {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.DepartmentUUID != deptID || got.ReassignTo != "" {
+			t.Errorf("unexpected dto: %+v", got)
+		}
+	})
+
+	t.Run("reassign to colleague", func(t *testing.T) {
+		var got entities.OffboardEmployeeApplicationsDTO
+		client := roleByTargetClient(map[string]string{initiatorID: "chief", otherUserID: "engineer"})
+		svc := newAppTestService(offboardingRepo([]*entities.Application{assignedApp()}, &got), client)
+
+		body := offboardingEventBody(t, entities.MembershipEvent{
+			EventType: entities.EventEmployeeRemoved, CompanyUUID: companyID, UserUUID: targetID,
+			InitiatorUUID: initiatorID, OpenApplications: entities.OffboardingReassign, ReassignTo: otherUserID,
+		})
+		if err := svc.HandleEmployeeOffboarding(context.Background(), body); err.Code != 0 {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.ReassignTo != otherUserID {
+			t.Errorf("expected reassign to %s, got %q", otherUserID, got.ReassignTo)
+		}
+	})
+
+	t.Run("reassign target no longer fits returns to pool", func(t *testing.T) {
+		var got entities.OffboardEmployeeApplicationsDTO
+		client := roleByTargetClient(map[string]string{initiatorID: "chief"})
+		svc := newAppTestService(offboardingRepo([]*entities.Application{assignedApp()}, &got), client)
+
+		body := offboardingEventBody(t, entities.MembershipEvent{
+			EventType: entities.EventEmployeeRemoved, CompanyUUID: companyID, UserUUID: targetID,
+			InitiatorUUID: initiatorID, OpenApplications: entities.OffboardingReassign, ReassignTo: otherUserID,
+		})
+		if err := svc.HandleEmployeeOffboarding(context.Background(), body); err.Code != 0 {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.CompanyUUID != companyID || got.ReassignTo != "" {
+			t.Errorf("expected applications returned to pool, got %+v", got)
+		}
+	})
+
+	t.Run("reassign check error is returned for redelivery", func(t *testing.T) {
+		var got entities.OffboardEmployeeApplicationsDTO
+		svc := newAppTestService(offboardingRepo([]*entities.Application{assignedApp()}, &got), errCompanyClient())
+
+		body := offboardingEventBody(t, entities.MembershipEvent{
+			EventType: entities.EventEmployeeRemoved, CompanyUUID: companyID, UserUUID: targetID,
+			InitiatorUUID: initiatorID, OpenApplications: entities.OffboardingReassign, ReassignTo: otherUserID,
+		})
+		if err := svc.HandleEmployeeOffboarding(context.Background(), body); err.Code == 0 {
+			t.Fatal("expected error")
+		}
+		if got.CompanyUUID != "" {
+			t.Errorf("expected no applications processed, got %+v", got)
+		}
+	})
+
+	t.Run("department change without policy ignored", func(t *testing.T) {
+		svc := newAppTestService(&mockApplicationRepo{}, nil)
+		if err := svc.HandleEmployeeOffboarding(context.Background(), membershipEventBody(t, entities.EventEmployeeDepartmentChanged)); err.Code != 0 {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("other event ignored", func(t *testing.T) {
+		svc := newAppTestService(&mockApplicationRepo{}, nil)
+		if err := svc.HandleEmployeeOffboarding(context.Background(), membershipEventBody(t, entities.EventDepartmentDeleted)); err.Code != 0 {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		svc := newAppTestService(&mockApplicationRepo{}, nil)
+		if err := svc.HandleEmployeeOffboarding(context.Background(), []byte("{")); err.Code != codes.InvalidArgument {
+			t.Errorf("expected InvalidArgument, got %v", err.Code)
+		}
+	})
+
+	t.Run("repository error is returned for redelivery", func(t *testing.T) {
+		repo := &mockApplicationRepo{
+			offboardEmployeeApplications: func(context.Context, entities.OffboardEmployeeApplicationsDTO) (int64, Error.CodeError) {
+				return 0, Error.Public(codes.Unavailable, "db unavailable")
+			},
+		}
+		svc := newAppTestService(repo, nil)
+		if err := svc.HandleEmployeeOffboarding(context.Background(), membershipEventBody(t, entities.EventEmployeeLeft)); err.Code != codes.Unavailable {
+			t.Errorf("expected Unavailable, got %v", err.Code)
+		}
+	})
+}
@@ -3,9 +3,12 @@ package services
 import (
 	"context"
 	"fmt"
+	"time"
 
+	minioDB "github.com/unwelcome/FrameWorkTask1/backend/application/internal/database/minio"
 	postgresDB "github.com/unwelcome/FrameWorkTask1/backend/application/internal/database/postgres"
 	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/membership"
 	company_proto "github.com/unwelcome/FrameWorkTask1/backend/contracts/company/generated"
 	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
 	"google.golang.org/grpc"
@@ -21,6 +24,7 @@ type mockApplicationRepo struct {
 	addApplicationFixLog           func(ctx context.Context, dto entities.AddFixLogDTO) Error.CodeError
 	getApplication                 func(ctx context.Context, dto entities.GetApplicationDTO) (*entities.Application, Error.CodeError)
 	getApplicationFixLogs          func(ctx context.Context, dto entities.GetApplicationFixLogsDTO) ([]*entities.FixLog, Error.CodeError)
+	getFixLogsByApplications       func(ctx context.Context, dto entities.GetFixLogsByApplicationsDTO) ([]*entities.FixLog, Error.CodeError)
 	getApplications                func(ctx context.Context, dto entities.GetApplicationsDTO) ([]*entities.Application, Error.CodeError)
 	updateApplicationStatus        func(ctx context.Context, dto entities.UpdateApplicationStatusDTO) Error.CodeError
 	assignApplicationToEmployee    func(ctx context.Context, dto entities.AssignApplicationDTO) Error.CodeError
@@ -28,8 +32,18 @@ type mockApplicationRepo struct {
 	recallApplication              func(ctx context.Context, dto entities.RecallApplicationDTO) Error.CodeError
 	takeApplicationToVerification  func(ctx context.Context, dto entities.TakeApplicationToVerificationDTO) Error.CodeError
 	releaseApplicationVerification func(ctx context.Context, dto entities.ReleaseApplicationVerificationDTO) Error.CodeError
+	getEmployeeOpenApplications    func(ctx context.Context, dto entities.GetEmployeeOpenApplicationsDTO) ([]*entities.Application, Error.CodeError)
+	offboardEmployeeApplications   func(ctx context.Context, dto entities.OffboardEmployeeApplicationsDTO) (int64, Error.CodeError)
+	purgeCompanyApplications       func(ctx context.Context, dto entities.PurgeCompanyApplicationsDTO) (int64, Error.CodeError)
 	deleteApplication              func(ctx context.Context, dto entities.DeleteApplicationDTO) Error.CodeError
 	getApplicationHistory          func(ctx context.Context, dto entities.GetApplicationHistoryDTO) ([]*entities.Application, Error.CodeError)
+	getApplicationVersion          func(ctx context.Context, dto entities.GetApplicationVersionDTO) (*entities.Application, Error.CodeError)
+	countApplications              func(ctx context.Context, dto entities.CountApplicationsDTO) (int64, Error.CodeError)
+	countFilteredApplications      func(ctx context.Context, dto entities.GetApplicationsDTO) (int64, Error.CodeError)
+	countApplicationHistory        func(ctx context.Context, dto entities.GetApplicationHistoryDTO) (int64, Error.CodeError)
+	getSLAChanges                  func(ctx context.Context, dto entities.GetSLAChangesDTO) ([]string, Error.CodeError)
+	updateApplicationSLABreach     func(ctx context.Context, dto entities.UpdateApplicationSLABreachDTO) Error.CodeError
+	getStatusTimeline              func(ctx context.Context, dto entities.GetStatusTimelineDTO) ([]*entities.StatusChange, Error.CodeError)
 }
 
 func (m *mockApplicationRepo) CreateApplication(ctx context.Context, dto entities.CreateApplicationDTO) Error.CodeError {
@@ -44,6 +58,9 @@ func (m *mockApplicationRepo) GetApplication(ctx context.Context, dto entities.G
 func (m *mockApplicationRepo) GetApplicationFixLogs(ctx context.Context, dto entities.GetApplicationFixLogsDTO) ([]*entities.FixLog, Error.CodeError) {
 	return m.getApplicationFixLogs(ctx, dto)
 }
+func (m *mockApplicationRepo) GetFixLogsByApplications(ctx context.Context, dto entities.GetFixLogsByApplicationsDTO) ([]*entities.FixLog, Error.CodeError) {
+	return m.getFixLogsByApplications(ctx, dto)
+}
 func (m *mockApplicationRepo) GetApplications(ctx context.Context, dto entities.GetApplicationsDTO) ([]*entities.Application, Error.CodeError) {
 	return m.getApplications(ctx, dto)
 }
@@ -65,18 +82,296 @@ func (m *mockApplicationRepo) TakeApplicationToVerification(ctx context.Context,
 func (m *mockApplicationRepo) ReleaseApplicationVerification(ctx context.Context, dto entities.ReleaseApplicationVerificationDTO) Error.CodeError {
 	return m.releaseApplicationVerification(ctx, dto)
 }
+func (m *mockApplicationRepo) GetEmployeeOpenApplications(ctx context.Context, dto entities.GetEmployeeOpenApplicationsDTO) ([]*entities.Application, Error.CodeError) {
+	return m.getEmployeeOpenApplications(ctx, dto)
+}
+func (m *mockApplicationRepo) OffboardEmployeeApplications(ctx context.Context, dto entities.OffboardEmployeeApplicationsDTO) (int64, Error.CodeError) {
+	return m.offboardEmployeeApplications(ctx, dto)
+}
+func (m *mockApplicationRepo) PurgeCompanyApplications(ctx context.Context, dto entities.PurgeCompanyApplicationsDTO) (int64, Error.CodeError) {
+	return m.purgeCompanyApplications(ctx, dto)
+}
 func (m *mockApplicationRepo) DeleteApplication(ctx context.Context, dto entities.DeleteApplicationDTO) Error.CodeError {
 	return m.deleteApplication(ctx, dto)
 }
 func (m *mockApplicationRepo) GetApplicationHistory(ctx context.Context, dto entities.GetApplicationHistoryDTO) ([]*entities.Application, Error.CodeError) {
 	return m.getApplicationHistory(ctx, dto)
 }
+func (m *mockApplicationRepo) GetApplicationVersion(ctx context.Context, dto entities.GetApplicationVersionDTO) (*entities.Application, Error.CodeError) {
+	return m.getApplicationVersion(ctx, dto)
+}
+func (m *mockApplicationRepo) CountApplications(ctx context.Context, dto entities.CountApplicationsDTO) (int64, Error.CodeError) {
+	return m.countApplications(ctx, dto)
+}
+
+// CountFilteredApplications / CountApplicationHistory без заданной функции возвращают 0 - total в тестах списков не важен
+func (m *mockApplicationRepo) CountFilteredApplications(ctx context.Context, dto entities.GetApplicationsDTO) (int64, Error.CodeError) {
+	if m.countFilteredApplications == nil {
+		return 0, ok()
+	}
+	return m.countFilteredApplications(ctx, dto)
+}
+func (m *mockApplicationRepo) CountApplicationHistory(ctx context.Context, dto entities.GetApplicationHistoryDTO) (int64, Error.CodeError) {
+	if m.countApplicationHistory == nil {
+		return 0, ok()
+	}
+	return m.countApplicationHistory(ctx, dto)
+}
+func (m *mockApplicationRepo) GetSLAChanges(ctx context.Context, dto entities.GetSLAChangesDTO) ([]string, Error.CodeError) {
+	return m.getSLAChanges(ctx, dto)
+}
+func (m *mockApplicationRepo) UpdateApplicationSLABreach(ctx context.Context, dto entities.UpdateApplicationSLABreachDTO) Error.CodeError {
+	return m.updateApplicationSLABreach(ctx, dto)
+}
+func (m *mockApplicationRepo) GetStatusTimeline(ctx context.Context, dto entities.GetStatusTimelineDTO) ([]*entities.StatusChange, Error.CodeError) {
+	return m.getStatusTimeline(ctx, dto)
+}
+
+// ─── Mock: AttachmentRepository ──────────────────────────────────────────────
+
+type mockAttachmentRepo struct {
+	createAttachment          func(ctx context.Context, dto entities.CreateAttachmentDTO) Error.CodeError
+	getAttachment             func(ctx context.Context, dto entities.GetAttachmentDTO) (*entities.Attachment, Error.CodeError)
+	getApplicationAttachments func(ctx context.Context, dto entities.GetApplicationAttachmentsDTO) ([]*entities.Attachment, Error.CodeError)
+	deleteAttachment          func(ctx context.Context, dto entities.DeleteAttachmentDTO) Error.CodeError
+	getCompanyAttachmentKeys  func(ctx context.Context, dto entities.GetCompanyAttachmentKeysDTO) ([]string, Error.CodeError)
+}
+
+func (m *mockAttachmentRepo) CreateAttachment(ctx context.Context, dto entities.CreateAttachmentDTO) Error.CodeError {
+	return m.createAttachment(ctx, dto)
+}
+func (m *mockAttachmentRepo) GetAttachment(ctx context.Context, dto entities.GetAttachmentDTO) (*entities.Attachment, Error.CodeError) {
+	return m.getAttachment(ctx, dto)
+}
+func (m *mockAttachmentRepo) GetApplicationAttachments(ctx context.Context, dto entities.GetApplicationAttachmentsDTO) ([]*entities.Attachment, Error.CodeError) {
+	return m.getApplicationAttachments(ctx, dto)
+}
+func (m *mockAttachmentRepo) DeleteAttachment(ctx context.Context, dto entities.DeleteAttachmentDTO) Error.CodeError {
+	return m.deleteAttachment(ctx, dto)
+}
+func (m *mockAttachmentRepo) GetCompanyAttachmentKeys(ctx context.Context, dto entities.GetCompanyAttachmentKeysDTO) ([]string, Error.CodeError) {
+	return m.getCompanyAttachmentKeys(ctx, dto)
+}
+
+// ─── Mock: CommentRepository ─────────────────────────────────────────────────
+
+type mockCommentRepo struct {
+	createComment       func(ctx context.Context, dto entities.CreateCommentDTO) Error.CodeError
+	getComment          func(ctx context.Context, dto entities.GetCommentDTO) (*entities.Comment, Error.CodeError)
+	getCommentThreads   func(ctx context.Context, dto entities.GetCommentThreadsDTO) ([]*entities.Comment, Error.CodeError)
+	getCommentReplies   func(ctx context.Context, dto entities.GetCommentRepliesDTO) ([]*entities.Comment, Error.CodeError)
+	countCommentThreads func(ctx context.Context, dto entities.CountCommentThreadsDTO) (int64, Error.CodeError)
+	updateComment       func(ctx context.Context, dto entities.UpdateCommentDTO) Error.CodeError
+	deleteComment       func(ctx context.Context, dto entities.DeleteCommentDTO) Error.CodeError
+	getCommentEdits     func(ctx context.Context, dto entities.GetCommentEditsDTO) ([]*entities.CommentEdit, Error.CodeError)
+}
+
+func (m *mockCommentRepo) CreateComment(ctx context.Context, dto entities.CreateCommentDTO) Error.CodeError {
+	return m.createComment(ctx, dto)
+}
+func (m *mockCommentRepo) GetComment(ctx context.Context, dto entities.GetCommentDTO) (*entities.Comment, Error.CodeError) {
+	return m.getComment(ctx, dto)
+}
+func (m *mockCommentRepo) GetCommentThreads(ctx context.Context, dto entities.GetCommentThreadsDTO) ([]*entities.Comment, Error.CodeError) {
+	return m.getCommentThreads(ctx, dto)
+}
+func (m *mockCommentRepo) GetCommentReplies(ctx context.Context, dto entities.GetCommentRepliesDTO) ([]*entities.Comment, Error.CodeError) {
+	return m.getCommentReplies(ctx, dto)
+}
+func (m *mockCommentRepo) CountCommentThreads(ctx context.Context, dto entities.CountCommentThreadsDTO) (int64, Error.CodeError) {
+	return m.countCommentThreads(ctx, dto)
+}
+func (m *mockCommentRepo) UpdateComment(ctx context.Context, dto entities.UpdateCommentDTO) Error.CodeError {
+	return m.updateComment(ctx, dto)
+}
+func (m *mockCommentRepo) DeleteComment(ctx context.Context, dto entities.DeleteCommentDTO) Error.CodeError {
+	return m.deleteComment(ctx, dto)
+}
+func (m *mockCommentRepo) GetCommentEdits(ctx context.Context, dto entities.GetCommentEditsDTO) ([]*entities.CommentEdit, Error.CodeError) {
+	return m.getCommentEdits(ctx, dto)
+}
+
+// ─── Mock: AttachmentStorage ─────────────────────────────────────────────────
+
+type mockAttachmentStorage struct {
+	putAttachment    func(ctx context.Context, dto entities.PutAttachmentObjectDTO) Error.CodeError
+	getAttachment    func(ctx context.Context, dto entities.GetAttachmentObjectDTO) ([]byte, Error.CodeError)
+	deleteAttachment func(ctx context.Context, dto entities.DeleteAttachmentObjectDTO) Error.CodeError
+}
+
+func (m *mockAttachmentStorage) PutAttachment(ctx context.Context, dto entities.PutAttachmentObjectDTO) Error.CodeError {
+	return m.putAttachment(ctx, dto)
+}
+func (m *mockAttachmentStorage) GetAttachment(ctx context.Context, dto entities.GetAttachmentObjectDTO) ([]byte, Error.CodeError) {
+	return m.getAttachment(ctx, dto)
+}
+func (m *mockAttachmentStorage) DeleteAttachment(ctx context.Context, dto entities.DeleteAttachmentObjectDTO) Error.CodeError {
+	return m.deleteAttachment(ctx, dto)
+}
+
+// ─── Mock: ArchiveStorage ────────────────────────────────────────────────────
+
+type mockArchiveStorage struct {
+	putArchive func(ctx context.Context, dto entities.PutArchiveObjectDTO) Error.CodeError
+	getArchive func(ctx context.Context, dto entities.GetArchiveObjectDTO) (*entities.ArchiveObject, Error.CodeError)
+}
+
+func (m *mockArchiveStorage) PutArchive(ctx context.Context, dto entities.PutArchiveObjectDTO) Error.CodeError {
+	return m.putArchive(ctx, dto)
+}
+func (m *mockArchiveStorage) GetArchive(ctx context.Context, dto entities.GetArchiveObjectDTO) (*entities.ArchiveObject, Error.CodeError) {
+	return m.getArchive(ctx, dto)
+}
+
+// ─── Mock: WorkflowRepository ────────────────────────────────────────────────
+
+// mockWorkflowRepo — без заданного getCompanyWorkflow компания использует workflow по умолчанию
+type mockWorkflowRepo struct {
+	getCompanyWorkflow    func(ctx context.Context, dto entities.GetCompanyWorkflowDTO) (*entities.CompanyWorkflow, Error.CodeError)
+	saveCompanyWorkflow   func(ctx context.Context, dto entities.SaveCompanyWorkflowDTO) Error.CodeError
+	deleteCompanyWorkflow func(ctx context.Context, dto entities.DeleteCompanyWorkflowDTO) Error.CodeError
+}
+
+func (m *mockWorkflowRepo) GetCompanyWorkflow(ctx context.Context, dto entities.GetCompanyWorkflowDTO) (*entities.CompanyWorkflow, Error.CodeError) {
+	if m.getCompanyWorkflow == nil {
+		return nil, notFound()
+	}
+	return m.getCompanyWorkflow(ctx, dto)
+}
+func (m *mockWorkflowRepo) SaveCompanyWorkflow(ctx context.Context, dto entities.SaveCompanyWorkflowDTO) Error.CodeError {
+	return m.saveCompanyWorkflow(ctx, dto)
+}
+func (m *mockWorkflowRepo) DeleteCompanyWorkflow(ctx context.Context, dto entities.DeleteCompanyWorkflowDTO) Error.CodeError {
+	return m.deleteCompanyWorkflow(ctx, dto)
+}
+
+// ─── Mock: SLARepository ─────────────────────────────────────────────────────
+
+type mockSLARepo struct {
+	getCompanySLAPolicies func(ctx context.Context, dto entities.GetCompanySLAPoliciesDTO) ([]*entities.SLAPolicy, Error.CodeError)
+	saveSLAPolicy         func(ctx context.Context, dto entities.SaveSLAPolicyDTO) Error.CodeError
+	deleteSLAPolicy       func(ctx context.Context, dto entities.DeleteSLAPolicyDTO) Error.CodeError
+}
+
+func (m *mockSLARepo) GetCompanySLAPolicies(ctx context.Context, dto entities.GetCompanySLAPoliciesDTO) ([]*entities.SLAPolicy, Error.CodeError) {
+	return m.getCompanySLAPolicies(ctx, dto)
+}
+func (m *mockSLARepo) SaveSLAPolicy(ctx context.Context, dto entities.SaveSLAPolicyDTO) Error.CodeError {
+	return m.saveSLAPolicy(ctx, dto)
+}
+func (m *mockSLARepo) DeleteSLAPolicy(ctx context.Context, dto entities.DeleteSLAPolicyDTO) Error.CodeError {
+	return m.deleteSLAPolicy(ctx, dto)
+}
+
+// ─── Mock: CategoryRepository ────────────────────────────────────────────────
+
+type mockCategoryRepo struct {
+	createCategory       func(ctx context.Context, dto entities.CreateCategoryDTO) Error.CodeError
+	getCategory          func(ctx context.Context, dto entities.GetCategoryDTO) (*entities.Category, Error.CodeError)
+	getCompanyCategories func(ctx context.Context, dto entities.GetCompanyCategoriesDTO) ([]*entities.Category, Error.CodeError)
+	updateCategory       func(ctx context.Context, dto entities.UpdateCategoryDTO) Error.CodeError
+	deleteCategory       func(ctx context.Context, dto entities.DeleteCategoryDTO) Error.CodeError
+}
+
+func (m *mockCategoryRepo) CreateCategory(ctx context.Context, dto entities.CreateCategoryDTO) Error.CodeError {
+	return m.createCategory(ctx, dto)
+}
+func (m *mockCategoryRepo) GetCategory(ctx context.Context, dto entities.GetCategoryDTO) (*entities.Category, Error.CodeError) {
+	return m.getCategory(ctx, dto)
+}
+func (m *mockCategoryRepo) GetCompanyCategories(ctx context.Context, dto entities.GetCompanyCategoriesDTO) ([]*entities.Category, Error.CodeError) {
+	return m.getCompanyCategories(ctx, dto)
+}
+func (m *mockCategoryRepo) UpdateCategory(ctx context.Context, dto entities.UpdateCategoryDTO) Error.CodeError {
+	return m.updateCategory(ctx, dto)
+}
+func (m *mockCategoryRepo) DeleteCategory(ctx context.Context, dto entities.DeleteCategoryDTO) Error.CodeError {
+	return m.deleteCategory(ctx, dto)
+}
+
+// ─── Mock: CustomFieldRepository ─────────────────────────────────────────────
+
+// mockCustomFieldRepo — без заданного getCompanyCustomFields у компании нет дополнительных полей
+type mockCustomFieldRepo struct {
+	getCompanyCustomFields func(ctx context.Context, dto entities.GetCompanyCustomFieldsDTO) ([]*entities.CustomField, Error.CodeError)
+	saveCustomField        func(ctx context.Context, dto entities.SaveCustomFieldDTO) Error.CodeError
+	deleteCustomField      func(ctx context.Context, dto entities.DeleteCustomFieldDTO) Error.CodeError
+}
+
+func (m *mockCustomFieldRepo) GetCompanyCustomFields(ctx context.Context, dto entities.GetCompanyCustomFieldsDTO) ([]*entities.CustomField, Error.CodeError) {
+	if m.getCompanyCustomFields == nil {
+		return []*entities.CustomField{}, ok()
+	}
+	return m.getCompanyCustomFields(ctx, dto)
+}
+func (m *mockCustomFieldRepo) SaveCustomField(ctx context.Context, dto entities.SaveCustomFieldDTO) Error.CodeError {
+	return m.saveCustomField(ctx, dto)
+}
+func (m *mockCustomFieldRepo) DeleteCustomField(ctx context.Context, dto entities.DeleteCustomFieldDTO) Error.CodeError {
+	return m.deleteCustomField(ctx, dto)
+}
+
+// ─── Mock: AnalyticsRepository ───────────────────────────────────────────────
+
+type mockAnalyticsRepo struct {
+	getStatusStats            func(ctx context.Context, dto entities.GetStatusStatsDTO) ([]*entities.StatusStat, Error.CodeError)
+	getLeadTimes              func(ctx context.Context, dto entities.AnalyticsFilterDTO) (*entities.LeadTimes, Error.CodeError)
+	getEngineerRevisionStats  func(ctx context.Context, dto entities.AnalyticsFilterDTO) ([]*entities.EngineerRevisionStat, Error.CodeError)
+	getDepartmentFailureStats func(ctx context.Context, dto entities.AnalyticsFilterDTO) ([]*entities.DepartmentFailureStat, Error.CodeError)
+}
+
+func (m *mockAnalyticsRepo) GetStatusStats(ctx context.Context, dto entities.GetStatusStatsDTO) ([]*entities.StatusStat, Error.CodeError) {
+	return m.getStatusStats(ctx, dto)
+}
+func (m *mockAnalyticsRepo) GetLeadTimes(ctx context.Context, dto entities.AnalyticsFilterDTO) (*entities.LeadTimes, Error.CodeError) {
+	return m.getLeadTimes(ctx, dto)
+}
+func (m *mockAnalyticsRepo) GetEngineerRevisionStats(ctx context.Context, dto entities.AnalyticsFilterDTO) ([]*entities.EngineerRevisionStat, Error.CodeError) {
+	return m.getEngineerRevisionStats(ctx, dto)
+}
+func (m *mockAnalyticsRepo) GetDepartmentFailureStats(ctx context.Context, dto entities.AnalyticsFilterDTO) ([]*entities.DepartmentFailureStat, Error.CodeError) {
+	return m.getDepartmentFailureStats(ctx, dto)
+}
+
+// ─── Mock: OutboxRepository ──────────────────────────────────────────────────
+
+type mockOutboxRepo struct {
+	acquirePublisherLock  func(ctx context.Context) (func(), bool, Error.CodeError)
+	getPendingEvents      func(ctx context.Context, dto entities.GetPendingOutboxEventsDTO) ([]*entities.OutboxEvent, Error.CodeError)
+	markEventsPublished   func(ctx context.Context, dto entities.MarkOutboxEventsPublishedDTO) Error.CodeError
+	deletePublishedEvents func(ctx context.Context, dto entities.DeletePublishedOutboxEventsDTO) (int64, Error.CodeError)
+}
+
+func (m *mockOutboxRepo) AcquirePublisherLock(ctx context.Context) (func(), bool, Error.CodeError) {
+	return m.acquirePublisherLock(ctx)
+}
+func (m *mockOutboxRepo) GetPendingEvents(ctx context.Context, dto entities.GetPendingOutboxEventsDTO) ([]*entities.OutboxEvent, Error.CodeError) {
+	return m.getPendingEvents(ctx, dto)
+}
+func (m *mockOutboxRepo) MarkEventsPublished(ctx context.Context, dto entities.MarkOutboxEventsPublishedDTO) Error.CodeError {
+	return m.markEventsPublished(ctx, dto)
+}
+func (m *mockOutboxRepo) DeletePublishedEvents(ctx context.Context, dto entities.DeletePublishedOutboxEventsDTO) (int64, Error.CodeError) {
+	return m.deletePublishedEvents(ctx, dto)
+}
+
+// ─── Mock: Publisher ─────────────────────────────────────────────────────────
+
+type mockPublisher struct {
+	publishApplicationEvent func(ctx context.Context, event *entities.OutboxEvent) Error.CodeError
+}
+
+func (m *mockPublisher) PublishApplicationEvent(ctx context.Context, event *entities.OutboxEvent) Error.CodeError {
+	return m.publishApplicationEvent(ctx, event)
+}
+func (m *mockPublisher) Close() error { return nil }
 
 // ─── Mock: CompanyServiceClient ───────────────────────────────────────────────
 
 type mockCompanyClient struct {
 	getCompanyEmployee func(ctx context.Context, in *company_proto.GetCompanyEmployeeRequest, opts ...grpc.CallOption) (*company_proto.GetCompanyEmployeeResponse, error)
 	getDepartment      func(ctx context.Context, in *company_proto.GetDepartmentRequest, opts ...grpc.CallOption) (*company_proto.GetDepartmentResponse, error)
+	getLocation        func(ctx context.Context, in *company_proto.GetLocationRequest, opts ...grpc.CallOption) (*company_proto.GetLocationResponse, error)
 }
 
 func (m *mockCompanyClient) GetCompanyEmployee(ctx context.Context, in *company_proto.GetCompanyEmployeeRequest, opts ...grpc.CallOption) (*company_proto.GetCompanyEmployeeResponse, error) {
@@ -112,6 +407,12 @@ func (m *mockCompanyClient) UpdateCompanyStatus(_ context.Context, _ *company_pr
 func (m *mockCompanyClient) DeleteCompany(_ context.Context, _ *company_proto.DeleteCompanyRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
 	panic("unexpected call to DeleteCompany")
 }
+func (m *mockCompanyClient) GetCompanyDeletionStatus(_ context.Context, _ *company_proto.GetCompanyDeletionStatusRequest, _ ...grpc.CallOption) (*company_proto.GetCompanyDeletionStatusResponse, error) {
+	panic("unexpected call to GetCompanyDeletionStatus")
+}
+func (m *mockCompanyClient) RestoreCompany(_ context.Context, _ *company_proto.RestoreCompanyRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	panic("unexpected call to RestoreCompany")
+}
 func (m *mockCompanyClient) CreateCompanyJoinCode(_ context.Context, _ *company_proto.CreateCompanyJoinCodeRequest, _ ...grpc.CallOption) (*company_proto.CreateCompanyJoinCodeResponse, error) {
 	panic("unexpected call to CreateCompanyJoinCode")
 }
@@ -136,6 +437,24 @@ func (m *mockCompanyClient) UpdateEmployeeRole(_ context.Context, _ *company_pro
 func (m *mockCompanyClient) RemoveCompanyEmployee(_ context.Context, _ *company_proto.RemoveCompanyEmployeeRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
 	panic("unexpected call to RemoveCompanyEmployee")
 }
+func (m *mockCompanyClient) LeaveCompany(_ context.Context, _ *company_proto.LeaveCompanyRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	panic("unexpected call to LeaveCompany")
+}
+func (m *mockCompanyClient) TransferCompanyOwnership(_ context.Context, _ *company_proto.TransferCompanyOwnershipRequest, _ ...grpc.CallOption) (*company_proto.TransferCompanyOwnershipResponse, error) {
+	panic("unexpected call to TransferCompanyOwnership")
+}
+func (m *mockCompanyClient) GetCompanyOwnershipTransfer(_ context.Context, _ *company_proto.GetCompanyOwnershipTransferRequest, _ ...grpc.CallOption) (*company_proto.GetCompanyOwnershipTransferResponse, error) {
+	panic("unexpected call to GetCompanyOwnershipTransfer")
+}
+func (m *mockCompanyClient) AcceptCompanyOwnershipTransfer(_ context.Context, _ *company_proto.AcceptCompanyOwnershipTransferRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	panic("unexpected call to AcceptCompanyOwnershipTransfer")
+}
+func (m *mockCompanyClient) DeclineCompanyOwnershipTransfer(_ context.Context, _ *company_proto.DeclineCompanyOwnershipTransferRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	panic("unexpected call to DeclineCompanyOwnershipTransfer")
+}
+func (m *mockCompanyClient) CancelCompanyOwnershipTransfer(_ context.Context, _ *company_proto.CancelCompanyOwnershipTransferRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	panic("unexpected call to CancelCompanyOwnershipTransfer")
+}
 func (m *mockCompanyClient) CreateDepartment(_ context.Context, _ *company_proto.CreateDepartmentRequest, _ ...grpc.CallOption) (*company_proto.CreateDepartmentResponse, error) {
 	panic("unexpected call to CreateDepartment")
 }
@@ -157,16 +476,102 @@ func (m *mockCompanyClient) RemoveEmployeeFromDepartment(_ context.Context, _ *c
 func (m *mockCompanyClient) CheckColleagues(_ context.Context, _ *company_proto.CheckColleaguesRequest, _ ...grpc.CallOption) (*company_proto.CheckColleaguesResponse, error) {
 	panic("unexpected call to CheckColleagues")
 }
+func (m *mockCompanyClient) CreateLocation(_ context.Context, _ *company_proto.CreateLocationRequest, _ ...grpc.CallOption) (*company_proto.CreateLocationResponse, error) {
+	panic("unexpected call to CreateLocation")
+}
+func (m *mockCompanyClient) GetLocation(ctx context.Context, in *company_proto.GetLocationRequest, opts ...grpc.CallOption) (*company_proto.GetLocationResponse, error) {
+	if m.getLocation != nil {
+		return m.getLocation(ctx, in, opts...)
+	}
+	panic("unexpected call to GetLocation")
+}
+func (m *mockCompanyClient) GetCompanyLocations(_ context.Context, _ *company_proto.GetCompanyLocationsRequest, _ ...grpc.CallOption) (*company_proto.GetCompanyLocationsResponse, error) {
+	panic("unexpected call to GetCompanyLocations")
+}
+func (m *mockCompanyClient) UpdateLocation(_ context.Context, _ *company_proto.UpdateLocationRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	panic("unexpected call to UpdateLocation")
+}
+func (m *mockCompanyClient) DeleteLocation(_ context.Context, _ *company_proto.DeleteLocationRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	panic("unexpected call to DeleteLocation")
+}
+func (m *mockCompanyClient) GetCompanyAuditLog(_ context.Context, _ *company_proto.GetCompanyAuditLogRequest, _ ...grpc.CallOption) (*company_proto.GetCompanyAuditLogResponse, error) {
+	panic("unexpected call to GetCompanyAuditLog")
+}
+func (m *mockCompanyClient) UpdateCompanyJoinSettings(_ context.Context, _ *company_proto.UpdateCompanyJoinSettingsRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	panic("unexpected call to UpdateCompanyJoinSettings")
+}
+func (m *mockCompanyClient) GetJoinCodeRedemptions(_ context.Context, _ *company_proto.GetJoinCodeRedemptionsRequest, _ ...grpc.CallOption) (*company_proto.GetJoinCodeRedemptionsResponse, error) {
+	panic("unexpected call to GetJoinCodeRedemptions")
+}
+func (m *mockCompanyClient) GetCompanyJoinRequests(_ context.Context, _ *company_proto.GetCompanyJoinRequestsRequest, _ ...grpc.CallOption) (*company_proto.GetCompanyJoinRequestsResponse, error) {
+	panic("unexpected call to GetCompanyJoinRequests")
+}
+func (m *mockCompanyClient) AcceptCompanyJoinRequest(_ context.Context, _ *company_proto.AcceptCompanyJoinRequestRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	panic("unexpected call to AcceptCompanyJoinRequest")
+}
+func (m *mockCompanyClient) DeclineCompanyJoinRequest(_ context.Context, _ *company_proto.DeclineCompanyJoinRequestRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	panic("unexpected call to DeclineCompanyJoinRequest")
+}
+func (m *mockCompanyClient) CreateCompanyInvitation(_ context.Context, _ *company_proto.CreateCompanyInvitationRequest, _ ...grpc.CallOption) (*company_proto.CreateCompanyInvitationResponse, error) {
+	panic("unexpected call to CreateCompanyInvitation")
+}
+func (m *mockCompanyClient) GetCompanyInvitations(_ context.Context, _ *company_proto.GetCompanyInvitationsRequest, _ ...grpc.CallOption) (*company_proto.GetCompanyInvitationsResponse, error) {
+	panic("unexpected call to GetCompanyInvitations")
+}
+func (m *mockCompanyClient) RevokeCompanyInvitation(_ context.Context, _ *company_proto.RevokeCompanyInvitationRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	panic("unexpected call to RevokeCompanyInvitation")
+}
+func (m *mockCompanyClient) GetUserInvitations(_ context.Context, _ *company_proto.GetUserInvitationsRequest, _ ...grpc.CallOption) (*company_proto.GetUserInvitationsResponse, error) {
+	panic("unexpected call to GetUserInvitations")
+}
+func (m *mockCompanyClient) AcceptCompanyInvitation(_ context.Context, _ *company_proto.AcceptCompanyInvitationRequest, _ ...grpc.CallOption) (*company_proto.AcceptCompanyInvitationResponse, error) {
+	panic("unexpected call to AcceptCompanyInvitation")
+}
+func (m *mockCompanyClient) DeclineCompanyInvitation(_ context.Context, _ *company_proto.DeclineCompanyInvitationRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	panic("unexpected call to DeclineCompanyInvitation")
+}
 
 // ─── Helpers ──────────────────────────────────────────────────────────────────
 
+const testMembershipCacheSize = 1000
+
 // emptyRepo — пустая заглушка репозитория (паника при любом вызове)
 func emptyRepo() *mockApplicationRepo { return &mockApplicationRepo{} }
 
 // newAppTestService создаёт ApplicationService с подменёнными зависимостями
 func newAppTestService(repo postgresDB.ApplicationRepository, client company_proto.CompanyServiceClient) *ApplicationService {
-	db := &postgresDB.DatabaseRepository{ApplicationRepository: repo}
-	return NewApplicationService(db, client)
+	return newAttachmentTestService(repo, &mockAttachmentRepo{}, &mockAttachmentStorage{}, client)
+}
+
+// newAttachmentTestService создаёт ApplicationService с подменёнными репозиторием и хранилищем вложений
+func newAttachmentTestService(repo postgresDB.ApplicationRepository, attachmentRepo postgresDB.AttachmentRepository, attachmentStorage minioDB.AttachmentStorage, client company_proto.CompanyServiceClient) *ApplicationService {
+	db := &postgresDB.DatabaseRepository{ApplicationRepository: repo, AttachmentRepository: attachmentRepo, WorkflowRepository: &mockWorkflowRepo{}, SLARepository: &mockSLARepo{}, CategoryRepository: &mockCategoryRepo{}, CustomFieldRepository: &mockCustomFieldRepo{}, AnalyticsRepository: &mockAnalyticsRepo{}}
+	storage := &minioDB.StorageRepository{Attachment: attachmentStorage}
+	return NewApplicationService(db, storage, client, membership.NewCache(testMembershipCacheSize, time.Minute))
+}
+
+// newCommentTestService создаёт ApplicationService с подменёнными репозиториями заявок и комментариев
+func newCommentTestService(repo postgresDB.ApplicationRepository, commentRepo postgresDB.CommentRepository, client company_proto.CompanyServiceClient) *ApplicationService {
+	svc := newAppTestService(repo, client)
+	svc.db.CommentRepository = commentRepo
+	return svc
+}
+
+// newCategoryTestService создаёт ApplicationService с подменёнными репозиториями категорий и дополнительных полей
+func newCategoryTestService(repo postgresDB.ApplicationRepository, categoryRepo postgresDB.CategoryRepository, customFieldRepo postgresDB.CustomFieldRepository, client company_proto.CompanyServiceClient) *ApplicationService {
+	svc := newAppTestService(repo, client)
+	svc.db.CategoryRepository = categoryRepo
+	svc.db.CustomFieldRepository = customFieldRepo
+	return svc
+}
+
+// attachmentRepoWith — мок репозитория вложений, возвращающий заданные вложения через GetApplicationAttachments
+func attachmentRepoWith(attachments ...*entities.Attachment) *mockAttachmentRepo {
+	return &mockAttachmentRepo{
+		getApplicationAttachments: func(_ context.Context, _ entities.GetApplicationAttachmentsDTO) ([]*entities.Attachment, Error.CodeError) {
+			return attachments, ok()
+		},
+	}
 }
 
 // ok — успешный CodeError (Code == 0 означает «нет ошибки» в HandleError)
@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/workflow"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/helpers"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const offboardingCommentMaxLength = 500
+
+// employeeRoles Роли сотрудника компании, включая сотрудника без роли
+var employeeRoles = append(slices.Clone(workflow.Roles), "unemployed")
+
+// roleRelations Связь с заявкой, которую сохраняет сотрудник с данной ролью
+var roleRelations = map[string]string{
+	"manager":   entities.RelationManager,
+	"engineer":  entities.RelationExecutor,
+	"inspector": entities.RelationInspector,
+}
+
+// GetEmployeeOpenApplications Открытые заявки сотрудника, которые затронет его удаление из компании,
+// перевод из отдела или смена роли (только chief). Используется для отчёта перед изменением
+func (s *ApplicationService) GetEmployeeOpenApplications(ctx context.Context, req *pb.GetEmployeeOpenApplicationsRequest) (*pb.GetEmployeeOpenApplicationsResponse, error) {
+	if err := validateOffboardingScope(req.GetInitiatorUuid(), req.GetCompanyUuid(), req.GetUserUuid(), req.GetDepartmentUuid(), req.GetNewRole()); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkChief(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), "only chief can view employee open applications"); err != nil {
+		return nil, err
+	}
+
+	relations := offboardingRelations(req.GetNewRole())
+	applications, getErr := s.db.ApplicationRepository.GetEmployeeOpenApplications(ctx, entities.GetEmployeeOpenApplicationsDTO{
+		CompanyUUID:    req.GetCompanyUuid(),
+		UserUUID:       req.GetUserUuid(),
+		DepartmentUUID: req.GetDepartmentUuid(),
+		Relations:      relations,
+	})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	res := &pb.GetEmployeeOpenApplicationsResponse{
+		Applications: make([]*pb.EmployeeOpenApplication, 0, len(applications)),
+	}
+	for _, app := range applications {
+		res.Applications = append(res.Applications, &pb.EmployeeOpenApplication{
+			ApplicationUuid: app.ApplicationUUID,
+			DepartmentUuid:  app.DepartmentUUID,
+			Title:           app.Title,
+			Status:          app.Status,
+			Relations:       employeeRelations(app, req.GetUserUuid(), relations),
+		})
+	}
+
+	return res, nil
+}
+
+// OffboardEmployeeApplications Обработка открытых заявок сотрудника перед удалением из компании,
+// переводом из отдела или сменой роли (только chief):
+// block - ошибка, если открытые заявки есть; pool - возврат в пул отдела; reassign - передача коллеге.
+// С dry_run только проверяет политику: так company сервис отклоняет изменение до записи,
+// а заявки обрабатываются по событию уже после него (HandleEmployeeOffboarding).
+// Повторный вызов безопасен: обработанные заявки больше не связаны с сотрудником
+func (s *ApplicationService) OffboardEmployeeApplications(ctx context.Context, req *pb.OffboardEmployeeApplicationsRequest) (*pb.OffboardEmployeeApplicationsResponse, error) {
+	if err := validateOffboardingScope(req.GetInitiatorUuid(), req.GetCompanyUuid(), req.GetUserUuid(), req.GetDepartmentUuid(), req.GetNewRole()); err != nil {
+		return nil, err
+	}
+	if !helpers.Contains([]string{entities.OffboardingBlock, entities.OffboardingPool, entities.OffboardingReassign}, req.GetPolicy()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid policy")
+	}
+	if req.GetPolicy() == entities.OffboardingReassign {
+		if err := validate.UUID(req.GetReassignToUuid()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid reassign_to uuid")
+		}
+		if req.GetReassignToUuid() == req.GetUserUuid() {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot reassign applications to the same employee")
+		}
+	} else if req.GetReassignToUuid() != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "reassign_to is allowed only with reassign policy")
+	}
+	if len([]rune(req.GetComment())) > offboardingCommentMaxLength {
+		return nil, status.Errorf(codes.InvalidArgument, "comment is too long")
+	}
+
+	if err := s.checkChief(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), "only chief can offboard employee applications"); err != nil {
+		return nil, err
+	}
+
+	relations := offboardingRelations(req.GetNewRole())
+	applications, getErr := s.db.ApplicationRepository.GetEmployeeOpenApplications(ctx, entities.GetEmployeeOpenApplicationsDTO{
+		CompanyUUID:    req.GetCompanyUuid(),
+		UserUUID:       req.GetUserUuid(),
+		DepartmentUUID: req.GetDepartmentUuid(),
+		Relations:      relations,
+	})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+	if len(applications) == 0 {
+		return &pb.OffboardEmployeeApplicationsResponse{}, nil
+	}
+
+	switch req.GetPolicy() {
+	case entities.OffboardingBlock:
+		return nil, status.Errorf(codes.FailedPrecondition, "employee has %d open applications", len(applications))
+	case entities.OffboardingReassign:
+		if err := s.checkReassignTarget(ctx, req, applications, relations); err != nil {
+			return nil, err
+		}
+	}
+	if req.GetDryRun() {
+		return &pb.OffboardEmployeeApplicationsResponse{Affected: int64(len(applications))}, nil
+	}
+
+	affected, offboardErr := s.db.ApplicationRepository.OffboardEmployeeApplications(ctx, entities.OffboardEmployeeApplicationsDTO{
+		CompanyUUID:    req.GetCompanyUuid(),
+		UserUUID:       req.GetUserUuid(),
+		DepartmentUUID: req.GetDepartmentUuid(),
+		Relations:      relations,
+		ReassignTo:     req.GetReassignToUuid(),
+		InitiatorUUID:  req.GetInitiatorUuid(),
+		FixLogText:     offboardingFixLogText(req.GetComment(), req.GetReassignToUuid()),
+	})
+	if err := offboardErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &pb.OffboardEmployeeApplicationsResponse{Affected: affected}, nil
+}
+
+// checkReassignTarget Коллега должен работать в отделе каждой заявки и иметь роль, соответствующую передаваемым связям
+func (s *ApplicationService) checkReassignTarget(ctx context.Context, req *pb.OffboardEmployeeApplicationsRequest, applications []*entities.Application, relations []string) error {
+	target, err := s.getEmployeeInfo(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), req.GetReassignToUuid())
+	if err != nil {
+		return err
+	}
+
+	for _, app := range applications {
+		if target.DepartmentUUID != app.DepartmentUUID {
+			return status.Errorf(codes.FailedPrecondition, "reassign target is not from department of application %s", app.ApplicationUUID)
+		}
+		for _, relation := range employeeRelations(app, req.GetUserUuid(), relations) {
+			if roleRelations[target.Role] != relation {
+				return status.Errorf(codes.FailedPrecondition, "reassign target with role %s cannot take %s of application %s", target.Role, relation, app.ApplicationUUID)
+			}
+		}
+	}
+	return nil
+}
+
+// ─── Вспомогательные функции ──────────────────────────────────────────────────
+
+func validateOffboardingScope(initiatorUUID, companyUUID, userUUID, departmentUUID, newRole string) error {
+	if err := validate.UUID(initiatorUUID); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(companyUUID); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+	if err := validate.UUID(userUUID); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid user uuid")
+	}
+	if departmentUUID != "" {
+		if err := validate.UUID(departmentUUID); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid department uuid")
+		}
+	}
+	if newRole != "" && !helpers.Contains(employeeRoles, newRole) {
+		return status.Errorf(codes.InvalidArgument, "invalid new role")
+	}
+	return nil
+}
+
+// offboardingRelations Связи, которые сотрудник теряет: при смене роли сохраняется связь новой роли
+func offboardingRelations(newRole string) []string {
+	kept := roleRelations[newRole]
+	return slices.DeleteFunc(slices.Clone(entities.Relations), func(relation string) bool { return relation == kept })
+}
+
+// employeeRelations Связи сотрудника с открытой заявкой (условия совпадают с выборкой репозитория)
+func employeeRelations(app *entities.Application, userUUID string, relations []string) []string {
+	res := make([]string, 0, len(relations))
+	for _, relation := range relations {
+		switch {
+		case relation == entities.RelationManager && app.ManagedBy == userUUID,
+			relation == entities.RelationExecutor && app.ExecutedBy == userUUID && helpers.Contains([]string{"assigned", "in_progress", "on_hold", "on_revision"}, app.Status),
+			relation == entities.RelationInspector && app.InspectedBy == userUUID && app.Status == "on_verification":
+			res = append(res, relation)
+		}
+	}
+	return res
+}
+
+// offboardingFixLogText Текст fix log-а, оставляемого на каждой обработанной заявке
+func offboardingFixLogText(comment, reassignTo string) string {
+	if comment == "" {
+		comment = "employee offboarded"
+	}
+	if reassignTo != "" {
+		return fmt.Sprintf("%s: reassigned to %s", comment, reassignTo)
+	}
+	return comment + ": returned to department pool"
+}
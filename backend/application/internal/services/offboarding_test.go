@@ -0,0 +1,233 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+// offboardingRepo — мок репозитория с открытыми заявками сотрудника; dto вызова Offboard сохраняется в got
+func offboardingRepo(apps []*entities.Application, got *entities.OffboardEmployeeApplicationsDTO) *mockApplicationRepo {
+	repo := emptyRepo()
+	repo.getEmployeeOpenApplications = func(_ context.Context, _ entities.GetEmployeeOpenApplicationsDTO) ([]*entities.Application, Error.CodeError) {
+		return apps, ok()
+	}
+	repo.offboardEmployeeApplications = func(_ context.Context, dto entities.OffboardEmployeeApplicationsDTO) (int64, Error.CodeError) {
+		*got = dto
+		return int64(len(apps)), ok()
+	}
+	return repo
+}
+
+// ─── GetEmployeeOpenApplications ─────────────────────────────────────────────
+
+func TestGetEmployeeOpenApplications(t *testing.T) {
+	t.Run("relations reported", func(t *testing.T) {
+		app := assignedApp()
+		app.ManagedBy = targetID
+		var gotDTO entities.GetEmployeeOpenApplicationsDTO
+		repo := emptyRepo()
+		repo.getEmployeeOpenApplications = func(_ context.Context, dto entities.GetEmployeeOpenApplicationsDTO) ([]*entities.Application, Error.CodeError) {
+			gotDTO = dto
+			return []*entities.Application{app}, ok()
+		}
+		svc := newAppTestService(repo, roleClient("chief"))
+
+		res, err := svc.GetEmployeeOpenApplications(context.Background(), &pb.GetEmployeeOpenApplicationsRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+			UserUuid:      targetID,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(res.GetApplications()) != 1 {
+			t.Fatalf("expected 1 application, got %d", len(res.GetApplications()))
+		}
+		want := []string{entities.RelationManager, entities.RelationExecutor}
+		if got := res.GetApplications()[0].GetRelations(); !slices.Equal(got, want) {
+			t.Errorf("expected relations %v, got %v", want, got)
+		}
+		if !slices.Equal(gotDTO.Relations, entities.Relations) {
+			t.Errorf("expected all relations, got %v", gotDTO.Relations)
+		}
+	})
+
+	t.Run("new role keeps its relation", func(t *testing.T) {
+		var gotDTO entities.GetEmployeeOpenApplicationsDTO
+		repo := emptyRepo()
+		repo.getEmployeeOpenApplications = func(_ context.Context, dto entities.GetEmployeeOpenApplicationsDTO) ([]*entities.Application, Error.CodeError) {
+			gotDTO = dto
+			return nil, ok()
+		}
+		svc := newAppTestService(repo, roleClient("chief"))
+
+		_, err := svc.GetEmployeeOpenApplications(context.Background(), &pb.GetEmployeeOpenApplicationsRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+			UserUuid:      targetID,
+			NewRole:       "engineer",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if slices.Contains(gotDTO.Relations, entities.RelationExecutor) {
+			t.Errorf("engineer must keep executor relation, got %v", gotDTO.Relations)
+		}
+	})
+
+	t.Run("not chief", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("manager"))
+		_, err := svc.GetEmployeeOpenApplications(context.Background(), &pb.GetEmployeeOpenApplicationsRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+			UserUuid:      targetID,
+		})
+		assertCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("invalid new role", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("chief"))
+		_, err := svc.GetEmployeeOpenApplications(context.Background(), &pb.GetEmployeeOpenApplicationsRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+			UserUuid:      targetID,
+			NewRole:       "owner",
+		})
+		assertCode(t, err, codes.InvalidArgument)
+	})
+}
+
+// ─── OffboardEmployeeApplications ────────────────────────────────────────────
+
+func TestOffboardEmployeeApplications(t *testing.T) {
+	req := func(policy, reassignTo string) *pb.OffboardEmployeeApplicationsRequest {
+		return &pb.OffboardEmployeeApplicationsRequest{
+			InitiatorUuid:  initiatorID,
+			CompanyUuid:    companyID,
+			UserUuid:       targetID,
+			Policy:         policy,
+			ReassignToUuid: reassignTo,
+			Comment:        "employee removed from company",
+		}
+	}
+
+	t.Run("block with open applications", func(t *testing.T) {
+		var got entities.OffboardEmployeeApplicationsDTO
+		svc := newAppTestService(offboardingRepo([]*entities.Application{assignedApp()}, &got), roleClient("chief"))
+		_, err := svc.OffboardEmployeeApplications(context.Background(), req(entities.OffboardingBlock, ""))
+		assertCode(t, err, codes.FailedPrecondition)
+	})
+
+	t.Run("block without open applications", func(t *testing.T) {
+		var got entities.OffboardEmployeeApplicationsDTO
+		svc := newAppTestService(offboardingRepo(nil, &got), roleClient("chief"))
+		res, err := svc.OffboardEmployeeApplications(context.Background(), req(entities.OffboardingBlock, ""))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.GetAffected() != 0 {
+			t.Errorf("expected 0 affected, got %d", res.GetAffected())
+		}
+	})
+
+	t.Run("dry run reports without changes", func(t *testing.T) {
+		var got entities.OffboardEmployeeApplicationsDTO
+		client := roleByTargetClient(map[string]string{initiatorID: "chief", otherUserID: "engineer"})
+		svc := newAppTestService(offboardingRepo([]*entities.Application{assignedApp()}, &got), client)
+
+		dryRun := req(entities.OffboardingReassign, otherUserID)
+		dryRun.DryRun = true
+		res, err := svc.OffboardEmployeeApplications(context.Background(), dryRun)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.GetAffected() != 1 {
+			t.Errorf("expected 1 affected, got %d", res.GetAffected())
+		}
+		if got.UserUUID != "" {
+			t.Errorf("expected no changes in dry run, got %+v", got)
+		}
+	})
+
+	t.Run("dry run with block policy", func(t *testing.T) {
+		var got entities.OffboardEmployeeApplicationsDTO
+		svc := newAppTestService(offboardingRepo([]*entities.Application{assignedApp()}, &got), roleClient("chief"))
+
+		dryRun := req(entities.OffboardingBlock, "")
+		dryRun.DryRun = true
+		_, err := svc.OffboardEmployeeApplications(context.Background(), dryRun)
+		assertCode(t, err, codes.FailedPrecondition)
+	})
+
+	t.Run("pool", func(t *testing.T) {
+		var got entities.OffboardEmployeeApplicationsDTO
+		svc := newAppTestService(offboardingRepo([]*entities.Application{assignedApp()}, &got), roleClient("chief"))
+		res, err := svc.OffboardEmployeeApplications(context.Background(), req(entities.OffboardingPool, ""))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.GetAffected() != 1 {
+			t.Errorf("expected 1 affected, got %d", res.GetAffected())
+		}
+		if got.ReassignTo != "" || got.InitiatorUUID != initiatorID {
+			t.Errorf("unexpected dto: %+v", got)
+		}
+		if got.FixLogText != "employee removed from company: returned to department pool" {
+			t.Errorf("unexpected fix log text %q", got.FixLogText)
+		}
+	})
+
+	t.Run("reassign to engineer", func(t *testing.T) {
+		var got entities.OffboardEmployeeApplicationsDTO
+		client := roleByTargetClient(map[string]string{initiatorID: "chief", otherUserID: "engineer"})
+		svc := newAppTestService(offboardingRepo([]*entities.Application{assignedApp()}, &got), client)
+		if _, err := svc.OffboardEmployeeApplications(context.Background(), req(entities.OffboardingReassign, otherUserID)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.ReassignTo != otherUserID {
+			t.Errorf("expected reassign to %s, got %q", otherUserID, got.ReassignTo)
+		}
+	})
+
+	t.Run("reassign target with wrong role", func(t *testing.T) {
+		var got entities.OffboardEmployeeApplicationsDTO
+		client := roleByTargetClient(map[string]string{initiatorID: "chief", otherUserID: "inspector"})
+		svc := newAppTestService(offboardingRepo([]*entities.Application{assignedApp()}, &got), client)
+		_, err := svc.OffboardEmployeeApplications(context.Background(), req(entities.OffboardingReassign, otherUserID))
+		assertCode(t, err, codes.FailedPrecondition)
+	})
+
+	t.Run("reassign target from other department", func(t *testing.T) {
+		var got entities.OffboardEmployeeApplicationsDTO
+		app := assignedApp()
+		app.DepartmentUUID = otherDeptID
+		client := roleByTargetClient(map[string]string{initiatorID: "chief", otherUserID: "engineer"})
+		svc := newAppTestService(offboardingRepo([]*entities.Application{app}, &got), client)
+		_, err := svc.OffboardEmployeeApplications(context.Background(), req(entities.OffboardingReassign, otherUserID))
+		assertCode(t, err, codes.FailedPrecondition)
+	})
+
+	t.Run("reassign requires target", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("chief"))
+		_, err := svc.OffboardEmployeeApplications(context.Background(), req(entities.OffboardingReassign, ""))
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("invalid policy", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("chief"))
+		_, err := svc.OffboardEmployeeApplications(context.Background(), req("archive", ""))
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("not chief", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("manager"))
+		_, err := svc.OffboardEmployeeApplications(context.Background(), req(entities.OffboardingPool, ""))
+		assertCode(t, err, codes.PermissionDenied)
+	})
+}
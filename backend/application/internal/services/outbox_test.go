@@ -0,0 +1,253 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	postgresDB "github.com/unwelcome/FrameWorkTask1/backend/application/internal/database/postgres"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+)
+
+// outboxEvents — n событий с последовательными uuid
+func outboxEvents(n int) []*entities.OutboxEvent {
+	events := make([]*entities.OutboxEvent, 0, n)
+	for i := 0; i < n; i++ {
+		events = append(events, &entities.OutboxEvent{
+			UUID:      fmt.Sprintf("event-%d", i),
+			EventType: entities.EventApplicationStatusChanged,
+		})
+	}
+	return events
+}
+
+// publisherLockAcquired — блокировка публикации свободна и достаётся worker-у
+func publisherLockAcquired(_ context.Context) (func(), bool, Error.CodeError) {
+	return func() {}, true, ok()
+}
+
+// ─── publishPendingEvents ────────────────────────────────────────────────────
+
+func TestPublishPendingEvents(t *testing.T) {
+	t.Run("no events", func(t *testing.T) {
+		repo := &mockOutboxRepo{
+			acquirePublisherLock: publisherLockAcquired,
+			getPendingEvents: func(_ context.Context, _ entities.GetPendingOutboxEventsDTO) ([]*entities.OutboxEvent, Error.CodeError) {
+				return nil, ok()
+			},
+		}
+		db := &postgresDB.DatabaseRepository{OutboxRepository: repo}
+
+		if got := publishPendingEvents(context.Background(), db, &mockPublisher{}); got != 0 {
+			t.Errorf("expected 0 published events, got %d", got)
+		}
+	})
+
+	t.Run("publishes and marks batch", func(t *testing.T) {
+		var marked []string
+		repo := &mockOutboxRepo{
+			acquirePublisherLock: publisherLockAcquired,
+			getPendingEvents: func(_ context.Context, dto entities.GetPendingOutboxEventsDTO) ([]*entities.OutboxEvent, Error.CodeError) {
+				if dto.Count != outboxBatchSize {
+					t.Errorf("expected batch size %d, got %d", outboxBatchSize, dto.Count)
+				}
+				return outboxEvents(3), ok()
+			},
+			markEventsPublished: func(_ context.Context, dto entities.MarkOutboxEventsPublishedDTO) Error.CodeError {
+				marked = dto.EventUUIDs
+				return ok()
+			},
+		}
+		var published []string
+		pub := &mockPublisher{
+			publishApplicationEvent: func(_ context.Context, event *entities.OutboxEvent) Error.CodeError {
+				published = append(published, event.UUID)
+				return ok()
+			},
+		}
+		db := &postgresDB.DatabaseRepository{OutboxRepository: repo}
+
+		if got := publishPendingEvents(context.Background(), db, pub); got != 3 {
+			t.Errorf("expected 3 published events, got %d", got)
+		}
+		if !slices.Equal(published, []string{"event-0", "event-1", "event-2"}) {
+			t.Errorf("events published out of order: %v", published)
+		}
+		if !slices.Equal(marked, published) {
+			t.Errorf("expected marked %v, got %v", published, marked)
+		}
+	})
+
+	t.Run("full batch fetches next", func(t *testing.T) {
+		calls := 0
+		repo := &mockOutboxRepo{
+			acquirePublisherLock: publisherLockAcquired,
+			getPendingEvents: func(_ context.Context, _ entities.GetPendingOutboxEventsDTO) ([]*entities.OutboxEvent, Error.CodeError) {
+				calls++
+				if calls == 1 {
+					return outboxEvents(outboxBatchSize), ok()
+				}
+				return outboxEvents(1), ok()
+			},
+			markEventsPublished: func(_ context.Context, _ entities.MarkOutboxEventsPublishedDTO) Error.CodeError {
+				return ok()
+			},
+		}
+		pub := &mockPublisher{
+			publishApplicationEvent: func(_ context.Context, _ *entities.OutboxEvent) Error.CodeError {
+				return ok()
+			},
+		}
+		db := &postgresDB.DatabaseRepository{OutboxRepository: repo}
+
+		if got := publishPendingEvents(context.Background(), db, pub); got != outboxBatchSize+1 {
+			t.Errorf("expected %d published events, got %d", outboxBatchSize+1, got)
+		}
+		if calls != 2 {
+			t.Errorf("expected 2 fetches, got %d", calls)
+		}
+	})
+
+	t.Run("broker error stops batch", func(t *testing.T) {
+		var marked []string
+		repo := &mockOutboxRepo{
+			acquirePublisherLock: publisherLockAcquired,
+			getPendingEvents: func(_ context.Context, _ entities.GetPendingOutboxEventsDTO) ([]*entities.OutboxEvent, Error.CodeError) {
+				return outboxEvents(outboxBatchSize), ok()
+			},
+			markEventsPublished: func(_ context.Context, dto entities.MarkOutboxEventsPublishedDTO) Error.CodeError {
+				marked = dto.EventUUIDs
+				return ok()
+			},
+		}
+		pub := &mockPublisher{
+			publishApplicationEvent: func(_ context.Context, event *entities.OutboxEvent) Error.CodeError {
+				if event.UUID == "event-2" {
+					return internalErr()
+				}
+				return ok()
+			},
+		}
+		db := &postgresDB.DatabaseRepository{OutboxRepository: repo}
+
+		// Событие event-2 и последующие остаются в outbox-е до следующего тика
+		if got := publishPendingEvents(context.Background(), db, pub); got != 2 {
+			t.Errorf("expected 2 published events, got %d", got)
+		}
+		if !slices.Equal(marked, []string{"event-0", "event-1"}) {
+			t.Errorf("expected only events before failure to be marked, got %v", marked)
+		}
+	})
+
+	t.Run("first event fails", func(t *testing.T) {
+		repo := &mockOutboxRepo{
+			acquirePublisherLock: publisherLockAcquired,
+			getPendingEvents: func(_ context.Context, _ entities.GetPendingOutboxEventsDTO) ([]*entities.OutboxEvent, Error.CodeError) {
+				return outboxEvents(1), ok()
+			},
+		}
+		pub := &mockPublisher{
+			publishApplicationEvent: func(_ context.Context, _ *entities.OutboxEvent) Error.CodeError {
+				return internalErr()
+			},
+		}
+		db := &postgresDB.DatabaseRepository{OutboxRepository: repo}
+
+		// markEventsPublished не задан - вызов упал бы с nil func
+		if got := publishPendingEvents(context.Background(), db, pub); got != 0 {
+			t.Errorf("expected 0 published events, got %d", got)
+		}
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		repo := &mockOutboxRepo{
+			acquirePublisherLock: publisherLockAcquired,
+			getPendingEvents: func(_ context.Context, _ entities.GetPendingOutboxEventsDTO) ([]*entities.OutboxEvent, Error.CodeError) {
+				return nil, internalErr()
+			},
+		}
+		db := &postgresDB.DatabaseRepository{OutboxRepository: repo}
+
+		if got := publishPendingEvents(context.Background(), db, &mockPublisher{}); got != 0 {
+			t.Errorf("expected 0 published events, got %d", got)
+		}
+	})
+
+	t.Run("lock held by another instance", func(t *testing.T) {
+		repo := &mockOutboxRepo{
+			acquirePublisherLock: func(_ context.Context) (func(), bool, Error.CodeError) {
+				return nil, false, ok()
+			},
+		}
+		db := &postgresDB.DatabaseRepository{OutboxRepository: repo}
+
+		// getPendingEvents не задан - чтение outbox-а без блокировки упало бы с nil func
+		if got := publishPendingEvents(context.Background(), db, &mockPublisher{}); got != 0 {
+			t.Errorf("expected 0 published events, got %d", got)
+		}
+	})
+
+	t.Run("lock released after publishing", func(t *testing.T) {
+		released := false
+		repo := &mockOutboxRepo{
+			acquirePublisherLock: func(_ context.Context) (func(), bool, Error.CodeError) {
+				return func() { released = true }, true, ok()
+			},
+			getPendingEvents: func(_ context.Context, _ entities.GetPendingOutboxEventsDTO) ([]*entities.OutboxEvent, Error.CodeError) {
+				return nil, ok()
+			},
+		}
+		db := &postgresDB.DatabaseRepository{OutboxRepository: repo}
+
+		publishPendingEvents(context.Background(), db, &mockPublisher{})
+		if !released {
+			t.Error("expected publisher lock to be released")
+		}
+	})
+
+	t.Run("mark error", func(t *testing.T) {
+		calls := 0
+		repo := &mockOutboxRepo{
+			acquirePublisherLock: publisherLockAcquired,
+			getPendingEvents: func(_ context.Context, _ entities.GetPendingOutboxEventsDTO) ([]*entities.OutboxEvent, Error.CodeError) {
+				calls++
+				return outboxEvents(outboxBatchSize), ok()
+			},
+			markEventsPublished: func(_ context.Context, _ entities.MarkOutboxEventsPublishedDTO) Error.CodeError {
+				return internalErr()
+			},
+		}
+		pub := &mockPublisher{
+			publishApplicationEvent: func(_ context.Context, _ *entities.OutboxEvent) Error.CodeError {
+				return ok()
+			},
+		}
+		db := &postgresDB.DatabaseRepository{OutboxRepository: repo}
+
+		if got := publishPendingEvents(context.Background(), db, pub); got != 0 {
+			t.Errorf("expected 0 published events, got %d", got)
+		}
+		if calls != 1 {
+			t.Errorf("worker must not loop when marking fails, got %d fetches", calls)
+		}
+	})
+}
+
+// ─── runOutboxCleanup ────────────────────────────────────────────────────────
+
+func TestRunOutboxCleanup(t *testing.T) {
+	var got entities.DeletePublishedOutboxEventsDTO
+	repo := &mockOutboxRepo{
+		deletePublishedEvents: func(_ context.Context, dto entities.DeletePublishedOutboxEventsDTO) (int64, Error.CodeError) {
+			got = dto
+			return 5, ok()
+		},
+	}
+
+	runOutboxCleanup(context.Background(), &postgresDB.DatabaseRepository{OutboxRepository: repo})
+	if got.PublishedBefore.IsZero() {
+		t.Error("expected retention boundary to be set")
+	}
+}
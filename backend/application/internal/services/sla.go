@@ -0,0 +1,176 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	postgresDB "github.com/unwelcome/FrameWorkTask1/backend/application/internal/database/postgres"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	slaCheckInterval = time.Minute
+	slaBatchSize     = 100
+	slaMaxBatches    = 50 // за один тик пересчитываем не больше slaBatchSize * slaMaxBatches заявок, остальные - на следующем
+)
+
+// StartSLAWorker запускает фоновую горутину, которая каждые slaCheckInterval пересчитывает нарушения SLA:
+// отмечает просроченные заявки (событие application.overdue) и снимает отметку с заявок,
+// дошедших до нужного статуса (application.overdue_resolved).
+// Останавливается при отмене ctx (graceful shutdown).
+func StartSLAWorker(ctx context.Context, db *postgresDB.DatabaseRepository) {
+	log.Info().Dur("interval", slaCheckInterval).Msg("sla worker started")
+
+	ticker := time.NewTicker(slaCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			runSLACheck(ctx, db)
+		case <-ctx.Done():
+			log.Info().Msg("sla worker stopped")
+			return
+		}
+	}
+}
+
+// runSLACheck Пересчёт нарушений SLA пачками по slaBatchSize. Останавливается на первой ошибке -
+// оставшиеся заявки будут обработаны на следующем тике. Возвращает число обработанных заявок.
+func runSLACheck(ctx context.Context, db *postgresDB.DatabaseRepository) int {
+	total := 0
+
+	for range slaMaxBatches {
+		uuids, getErr := db.ApplicationRepository.GetSLAChanges(ctx, entities.GetSLAChangesDTO{
+			Count: slaBatchSize,
+		})
+		if getErr.Code != 0 {
+			log.Error().Err(getErr).Msg("sla: failed to get applications with changed sla breach")
+			break
+		}
+
+		for _, applicationUUID := range uuids {
+			if err := db.ApplicationRepository.UpdateApplicationSLABreach(ctx, entities.UpdateApplicationSLABreachDTO{
+				ApplicationUUID: applicationUUID,
+			}); err.Code != 0 {
+				log.Error().Err(err).Str("application_uuid", applicationUUID).Msg("sla: failed to update application sla breach")
+				return total
+			}
+			total++
+		}
+
+		if len(uuids) < slaBatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		log.Info().Int("updated", total).Msg("sla check")
+	}
+	return total
+}
+
+// GetCompanySLAPolicies Получение политик SLA компании (любой сотрудник компании)
+func (s *ApplicationService) GetCompanySLAPolicies(ctx context.Context, req *pb.GetCompanySLAPoliciesRequest) (*pb.GetCompanySLAPoliciesResponse, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+
+	if _, err := s.getEmployeeInfo(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), req.GetInitiatorUuid()); err != nil {
+		return nil, err
+	}
+
+	policies, getErr := s.db.SLARepository.GetCompanySLAPolicies(ctx, entities.GetCompanySLAPoliciesDTO{
+		CompanyUUID: req.GetCompanyUuid(),
+	})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.SLAPolicy, 0, len(policies))
+	for _, p := range policies {
+		res = append(res, &pb.SLAPolicy{
+			Priority:                p.Priority,
+			AssignWithinHours:       p.AssignWithinHours,
+			VerificationWithinHours: p.VerificationWithinHours,
+		})
+	}
+
+	return &pb.GetCompanySLAPoliciesResponse{Policies: res}, nil
+}
+
+// UpdateCompanySLAPolicy Создание или замена политики SLA приоритета (только chief).
+// Новые сроки применяются и к уже открытым заявкам при следующей проверке SLA.
+func (s *ApplicationService) UpdateCompanySLAPolicy(ctx context.Context, req *pb.UpdateCompanySLAPolicyRequest) (*emptypb.Empty, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+
+	policy := req.GetPolicy()
+	if err := validate.ApplicationPriority(policy.GetPriority()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid priority")
+	}
+	if err := validate.SLAHours(int(policy.GetAssignWithinHours()), "assign_within_hours"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validate.SLAHours(int(policy.GetVerificationWithinHours()), "verification_within_hours"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if policy.GetAssignWithinHours() == 0 && policy.GetVerificationWithinHours() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "policy without deadlines, delete it instead")
+	}
+
+	if err := s.checkChief(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), "only chief can configure sla"); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.SLARepository.SaveSLAPolicy(ctx, entities.SaveSLAPolicyDTO{
+		CompanyUUID:             req.GetCompanyUuid(),
+		Priority:                policy.GetPriority(),
+		AssignWithinHours:       policy.GetAssignWithinHours(),
+		VerificationWithinHours: policy.GetVerificationWithinHours(),
+		UpdatedBy:               req.GetInitiatorUuid(),
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteCompanySLAPolicy Удаление политики SLA приоритета (только chief)
+func (s *ApplicationService) DeleteCompanySLAPolicy(ctx context.Context, req *pb.DeleteCompanySLAPolicyRequest) (*emptypb.Empty, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+	if err := validate.ApplicationPriority(req.GetPriority()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid priority")
+	}
+
+	if err := s.checkChief(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), "only chief can configure sla"); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.SLARepository.DeleteSLAPolicy(ctx, entities.DeleteSLAPolicyDTO{
+		CompanyUUID: req.GetCompanyUuid(),
+		Priority:    req.GetPriority(),
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,306 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	postgresDB "github.com/unwelcome/FrameWorkTask1/backend/application/internal/database/postgres"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+// slaChangedUUIDs — список из n uuid заявок с изменившимся нарушением SLA
+func slaChangedUUIDs(n int) []string {
+	uuids := make([]string, 0, n)
+	for i := range n {
+		uuids = append(uuids, fmt.Sprintf("app-%d", i))
+	}
+	return uuids
+}
+
+// ─── runSLACheck ─────────────────────────────────────────────────────────────
+
+func TestRunSLACheck(t *testing.T) {
+	t.Run("no changes", func(t *testing.T) {
+		repo := emptyRepo()
+		repo.getSLAChanges = func(_ context.Context, _ entities.GetSLAChangesDTO) ([]string, Error.CodeError) {
+			return nil, ok()
+		}
+		db := &postgresDB.DatabaseRepository{ApplicationRepository: repo}
+
+		if got := runSLACheck(context.Background(), db); got != 0 {
+			t.Errorf("expected 0 updated applications, got %d", got)
+		}
+	})
+
+	t.Run("updates all batches", func(t *testing.T) {
+		calls := 0
+		var updated []string
+		repo := emptyRepo()
+		repo.getSLAChanges = func(_ context.Context, _ entities.GetSLAChangesDTO) ([]string, Error.CodeError) {
+			calls++
+			if calls == 1 {
+				return slaChangedUUIDs(slaBatchSize), ok()
+			}
+			return slaChangedUUIDs(1), ok()
+		}
+		repo.updateApplicationSLABreach = func(_ context.Context, dto entities.UpdateApplicationSLABreachDTO) Error.CodeError {
+			updated = append(updated, dto.ApplicationUUID)
+			return ok()
+		}
+		db := &postgresDB.DatabaseRepository{ApplicationRepository: repo}
+
+		if got := runSLACheck(context.Background(), db); got != slaBatchSize+1 {
+			t.Errorf("expected %d updated applications, got %d", slaBatchSize+1, got)
+		}
+		if calls != 2 {
+			t.Errorf("expected 2 fetches, got %d", calls)
+		}
+		if len(updated) != slaBatchSize+1 {
+			t.Errorf("expected %d update calls, got %d", slaBatchSize+1, len(updated))
+		}
+	})
+
+	t.Run("update error stops check", func(t *testing.T) {
+		var updated []string
+		repo := emptyRepo()
+		repo.getSLAChanges = func(_ context.Context, _ entities.GetSLAChangesDTO) ([]string, Error.CodeError) {
+			return slaChangedUUIDs(slaBatchSize), ok()
+		}
+		repo.updateApplicationSLABreach = func(_ context.Context, dto entities.UpdateApplicationSLABreachDTO) Error.CodeError {
+			if dto.ApplicationUUID == "app-2" {
+				return internalErr()
+			}
+			updated = append(updated, dto.ApplicationUUID)
+			return ok()
+		}
+		db := &postgresDB.DatabaseRepository{ApplicationRepository: repo}
+
+		// Заявка app-2 и последующие будут пересчитаны на следующем тике
+		if got := runSLACheck(context.Background(), db); got != 2 {
+			t.Errorf("expected 2 updated applications, got %d", got)
+		}
+		if !slices.Equal(updated, []string{"app-0", "app-1"}) {
+			t.Errorf("expected only applications before failure to be updated, got %v", updated)
+		}
+	})
+
+	t.Run("fetch error", func(t *testing.T) {
+		repo := emptyRepo()
+		repo.getSLAChanges = func(_ context.Context, _ entities.GetSLAChangesDTO) ([]string, Error.CodeError) {
+			return nil, internalErr()
+		}
+		db := &postgresDB.DatabaseRepository{ApplicationRepository: repo}
+
+		if got := runSLACheck(context.Background(), db); got != 0 {
+			t.Errorf("expected 0 updated applications, got %d", got)
+		}
+	})
+}
+
+// ─── CreateApplication: приоритет и срок ─────────────────────────────────────
+
+func TestCreateApplicationSLA(t *testing.T) {
+	create := func(svc *ApplicationService, priority, dueAt string) error {
+		_, err := svc.CreateApplication(context.Background(), &pb.CreateApplicationRequest{
+			InitiatorUuid:   initiatorID,
+			CompanyUuid:     companyID,
+			ApplicationData: &pb.ApplicationData{Title: "Valid Title", Description: "Some description"},
+			Priority:        priority,
+			DueAt:           dueAt,
+		})
+		return err
+	}
+
+	t.Run("default priority", func(t *testing.T) {
+		var got entities.CreateApplicationDTO
+		repo := emptyRepo()
+		repo.createApplication = func(_ context.Context, dto entities.CreateApplicationDTO) Error.CodeError {
+			got = dto
+			return ok()
+		}
+
+		if err := create(newAppTestService(repo, roleClient("inspector")), "", ""); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Priority != "normal" {
+			t.Errorf("expected priority normal, got %q", got.Priority)
+		}
+		if got.DueAt != "" {
+			t.Errorf("expected empty due_at, got %q", got.DueAt)
+		}
+	})
+
+	t.Run("priority and due_at", func(t *testing.T) {
+		dueAt := time.Now().Add(48 * time.Hour).UTC().Format(time.RFC3339)
+		var got entities.CreateApplicationDTO
+		repo := emptyRepo()
+		repo.createApplication = func(_ context.Context, dto entities.CreateApplicationDTO) Error.CodeError {
+			got = dto
+			return ok()
+		}
+
+		if err := create(newAppTestService(repo, roleClient("inspector")), "critical", dueAt); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Priority != "critical" || got.DueAt != dueAt {
+			t.Errorf("expected critical/%s, got %s/%s", dueAt, got.Priority, got.DueAt)
+		}
+	})
+
+	t.Run("invalid priority", func(t *testing.T) {
+		err := create(newAppTestService(emptyRepo(), roleClient("inspector")), "urgent", "")
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("invalid due_at format", func(t *testing.T) {
+		err := create(newAppTestService(emptyRepo(), roleClient("inspector")), "high", "tomorrow")
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("due_at in the past", func(t *testing.T) {
+		dueAt := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+		err := create(newAppTestService(emptyRepo(), roleClient("inspector")), "high", dueAt)
+		assertCode(t, err, codes.InvalidArgument)
+	})
+}
+
+// ─── SLA-политики компании ───────────────────────────────────────────────────
+
+func TestGetCompanySLAPolicies(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("engineer"))
+		svc.db.SLARepository = &mockSLARepo{
+			getCompanySLAPolicies: func(_ context.Context, _ entities.GetCompanySLAPoliciesDTO) ([]*entities.SLAPolicy, Error.CodeError) {
+				return []*entities.SLAPolicy{{CompanyUUID: companyID, Priority: "critical", AssignWithinHours: 24, VerificationWithinHours: 72}}, ok()
+			},
+		}
+
+		res, err := svc.GetCompanySLAPolicies(context.Background(), &pb.GetCompanySLAPoliciesRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(res.GetPolicies()) != 1 || res.GetPolicies()[0].GetAssignWithinHours() != 24 {
+			t.Errorf("unexpected policies: %v", res.GetPolicies())
+		}
+	})
+
+	t.Run("not an employee", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleByTargetClient(map[string]string{}))
+		_, err := svc.GetCompanySLAPolicies(context.Background(), &pb.GetCompanySLAPoliciesRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+		})
+		assertCode(t, err, codes.NotFound)
+	})
+}
+
+func TestUpdateCompanySLAPolicy(t *testing.T) {
+	update := func(svc *ApplicationService, policy *pb.SLAPolicy) error {
+		_, err := svc.UpdateCompanySLAPolicy(context.Background(), &pb.UpdateCompanySLAPolicyRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+			Policy:        policy,
+		})
+		return err
+	}
+
+	t.Run("success", func(t *testing.T) {
+		var saved entities.SaveSLAPolicyDTO
+		svc := newAppTestService(emptyRepo(), roleClient("chief"))
+		svc.db.SLARepository = &mockSLARepo{
+			saveSLAPolicy: func(_ context.Context, dto entities.SaveSLAPolicyDTO) Error.CodeError {
+				saved = dto
+				return ok()
+			},
+		}
+
+		if err := update(svc, &pb.SLAPolicy{Priority: "critical", AssignWithinHours: 24, VerificationWithinHours: 72}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if saved.Priority != "critical" || saved.AssignWithinHours != 24 || saved.VerificationWithinHours != 72 || saved.UpdatedBy != initiatorID {
+			t.Errorf("unexpected saved policy: %+v", saved)
+		}
+	})
+
+	t.Run("not chief", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("manager"))
+		err := update(svc, &pb.SLAPolicy{Priority: "critical", AssignWithinHours: 24})
+		assertCode(t, err, codes.PermissionDenied)
+	})
+
+	cases := []struct {
+		name   string
+		policy *pb.SLAPolicy
+	}{
+		{"invalid priority", &pb.SLAPolicy{Priority: "urgent", AssignWithinHours: 24}},
+		{"negative hours", &pb.SLAPolicy{Priority: "high", AssignWithinHours: -1}},
+		{"hours too large", &pb.SLAPolicy{Priority: "high", VerificationWithinHours: 24*365 + 1}},
+		{"without deadlines", &pb.SLAPolicy{Priority: "high"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := newAppTestService(emptyRepo(), roleClient("chief"))
+			assertCode(t, update(svc, tc.policy), codes.InvalidArgument)
+		})
+	}
+}
+
+func TestDeleteCompanySLAPolicy(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var deleted entities.DeleteSLAPolicyDTO
+		svc := newAppTestService(emptyRepo(), roleClient("chief"))
+		svc.db.SLARepository = &mockSLARepo{
+			deleteSLAPolicy: func(_ context.Context, dto entities.DeleteSLAPolicyDTO) Error.CodeError {
+				deleted = dto
+				return ok()
+			},
+		}
+
+		_, err := svc.DeleteCompanySLAPolicy(context.Background(), &pb.DeleteCompanySLAPolicyRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+			Priority:      "high",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if deleted.CompanyUUID != companyID || deleted.Priority != "high" {
+			t.Errorf("unexpected deleted policy: %+v", deleted)
+		}
+	})
+
+	t.Run("not chief", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("engineer"))
+		_, err := svc.DeleteCompanySLAPolicy(context.Background(), &pb.DeleteCompanySLAPolicyRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+			Priority:      "high",
+		})
+		assertCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("policy not found", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("chief"))
+		svc.db.SLARepository = &mockSLARepo{
+			deleteSLAPolicy: func(_ context.Context, _ entities.DeleteSLAPolicyDTO) Error.CodeError {
+				return notFound()
+			},
+		}
+
+		_, err := svc.DeleteCompanySLAPolicy(context.Background(), &pb.DeleteCompanySLAPolicyRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+			Priority:      "high",
+		})
+		assertCode(t, err, codes.NotFound)
+	})
+}
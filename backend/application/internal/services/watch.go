@@ -0,0 +1,268 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/interceptors"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	watchBufferSize      = 64          // неотправленные изменения одного подписчика, при переполнении поток закрывается
+	watchRecheckInterval = time.Minute // период перепроверки роли и отдела подписчика в company сервисе
+)
+
+// watcher Подписчик потока изменений заявок одной компании
+type watcher struct {
+	companyUUID string
+
+	mu     sync.Mutex
+	filter entities.GetApplicationsDTO
+
+	updates      chan *pb.ApplicationUpdate
+	overflow     chan struct{} // закрывается, если подписчик не успевает читать изменения
+	overflowOnce sync.Once
+}
+
+func (w *watcher) getFilter() entities.GetApplicationsDTO {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.filter
+}
+
+func (w *watcher) setFilter(filter entities.GetApplicationsDTO) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.filter = filter
+}
+
+// push Неблокирующая отправка изменения подписчику
+func (w *watcher) push(update *pb.ApplicationUpdate) {
+	select {
+	case w.updates <- update:
+	default:
+		w.overflowOnce.Do(func() { close(w.overflow) })
+	}
+}
+
+// watchHub Реестр подписчиков WatchApplications текущего экземпляра сервиса
+type watchHub struct {
+	mu       sync.RWMutex
+	watchers map[string]map[*watcher]struct{} // company_uuid → подписчики
+
+	closing   chan struct{} // закрывается при остановке сервиса, все потоки завершаются
+	closeOnce sync.Once
+}
+
+func newWatchHub() *watchHub {
+	return &watchHub{
+		watchers: make(map[string]map[*watcher]struct{}),
+		closing:  make(chan struct{}),
+	}
+}
+
+func (h *watchHub) close() {
+	h.closeOnce.Do(func() { close(h.closing) })
+}
+
+func (h *watchHub) subscribe(companyUUID string, filter entities.GetApplicationsDTO) *watcher {
+	w := &watcher{
+		companyUUID: companyUUID,
+		filter:      filter,
+		updates:     make(chan *pb.ApplicationUpdate, watchBufferSize),
+		overflow:    make(chan struct{}),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.watchers[companyUUID] == nil {
+		h.watchers[companyUUID] = make(map[*watcher]struct{})
+	}
+	h.watchers[companyUUID][w] = struct{}{}
+
+	return w
+}
+
+func (h *watchHub) unsubscribe(w *watcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.watchers[w.companyUUID], w)
+	if len(h.watchers[w.companyUUID]) == 0 {
+		delete(h.watchers, w.companyUUID)
+	}
+}
+
+// companyWatchers Снимок списка подписчиков компании
+func (h *watchHub) companyWatchers(companyUUID string) []*watcher {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	watchers := make([]*watcher, 0, len(h.watchers[companyUUID]))
+	for w := range h.watchers[companyUUID] {
+		watchers = append(watchers, w)
+	}
+	return watchers
+}
+
+// WatchApplications Поток изменений заявок, видимых инициатору.
+// Правила видимости совпадают с GetApplications; если заявка перестала попадать под фильтр,
+// отправляется изменение с removed = true.
+func (s *ApplicationService) WatchApplications(req *pb.WatchApplicationsRequest, stream grpc.ServerStreamingServer[pb.ApplicationUpdate]) error {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+
+	ctx := stream.Context()
+	params := applicationsFilterParams{
+		CompanyUUID:    req.GetCompanyUuid(),
+		DepartmentUUID: req.GetDepartmentUuid(),
+		Statuses:       req.GetStatuses(),
+		IsDeleted:      req.GetIsDeleted(),
+		FromPool:       req.GetFromPool(),
+		IsOverdue:      req.GetIsOverdue(),
+	}
+
+	filter, err := s.watchFilter(ctx, req.GetInitiatorUuid(), params)
+	if err != nil {
+		return err
+	}
+
+	w := s.watchers.subscribe(req.GetCompanyUuid(), filter)
+	defer s.watchers.unsubscribe(w)
+
+	// Заголовки отправляются после проверки прав - клиент может дождаться их, чтобы отличить отказ от пустого потока
+	if err = stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	log.Info().Time("time", time.Now()).Str("id", interceptors.OperationIDFromContext(ctx)).Str("method", "WatchApplications").
+		Str("initiator_uuid", req.GetInitiatorUuid()).Str("company_uuid", req.GetCompanyUuid()).Msg("watcher subscribed")
+
+	recheck := time.NewTicker(watchRecheckInterval)
+	defer recheck.Stop()
+
+	for {
+		select {
+		case update := <-w.updates:
+			if err = stream.Send(update); err != nil {
+				return err
+			}
+
+		case <-recheck.C:
+			// Сотрудника могли уволить или перевести в другой отдел - пересчитываем фильтр
+			filter, err = s.watchFilter(ctx, req.GetInitiatorUuid(), params)
+			if err != nil {
+				return err
+			}
+			w.setFilter(filter)
+
+		case <-w.overflow:
+			return status.Error(codes.ResourceExhausted, "client is too slow, reconnect to continue watching")
+
+		case <-s.watchers.closing:
+			return status.Error(codes.Unavailable, "service is shutting down, reconnect to continue watching")
+
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// StopWatching Завершает все потоки WatchApplications с codes.Unavailable, чтобы клиенты переподключились
+// к другому экземпляру. Вызывается при остановке сервиса: GracefulStop не дожидается бесконечных потоков.
+func (s *ApplicationService) StopWatching() {
+	s.watchers.close()
+}
+
+// watchFilter Фильтр подписчика по его текущей роли в компании
+func (s *ApplicationService) watchFilter(ctx context.Context, initiatorUUID string, params applicationsFilterParams) (entities.GetApplicationsDTO, error) {
+	initiator, err := s.getEmployeeInfo(ctx, params.CompanyUUID, initiatorUUID, initiatorUUID)
+	if err != nil {
+		return entities.GetApplicationsDTO{}, err
+	}
+	return applicationsFilter(initiator, params)
+}
+
+// HandleApplicationEvent Рассылка доменного события заявки подписчикам WatchApplications.
+// Состояния заявки до и после изменения берутся по версии из события, поэтому
+// результат не зависит от того, насколько событие отстало от текущего состояния заявки.
+func (s *ApplicationService) HandleApplicationEvent(ctx context.Context, body []byte) Error.CodeError {
+	event := &entities.ApplicationEvent{}
+	if err := json.Unmarshal(body, event); err != nil {
+		return Error.Public(codes.InvalidArgument, "invalid event body")
+	}
+
+	watchers := s.watchers.companyWatchers(event.CompanyUUID)
+	if len(watchers) == 0 {
+		return Error.CodeError{}
+	}
+
+	current, err := s.db.ApplicationRepository.GetApplicationVersion(ctx, entities.GetApplicationVersionDTO{
+		ApplicationUUID: event.ApplicationUUID,
+		Version:         event.Version,
+	})
+	if err.Code != 0 {
+		return err
+	}
+
+	var previous *entities.Application
+	if event.Version > 1 {
+		previous, err = s.db.ApplicationRepository.GetApplicationVersion(ctx, entities.GetApplicationVersionDTO{
+			ApplicationUUID: event.ApplicationUUID,
+			Version:         event.Version - 1,
+		})
+		if err.Code != 0 && err.Code != codes.NotFound {
+			return err
+		}
+	}
+
+	for _, w := range watchers {
+		filter := w.getFilter()
+
+		wasVisible := previous != nil && matchesApplicationsFilter(filter, previous)
+		isVisible := matchesApplicationsFilter(filter, current)
+		if !wasVisible && !isVisible {
+			continue
+		}
+
+		w.push(&pb.ApplicationUpdate{
+			EventType: event.EventType,
+			Application: &pb.Application{
+				ApplicationUuid: current.ApplicationUUID,
+				DepartmentUuid:  current.DepartmentUUID,
+				Version:         current.Version,
+				Title:           current.Title,
+				Status:          current.Status,
+				CreatedAt:       current.CreatedAt,
+				UpdatedAt:       current.UpdatedAt,
+				Priority:        current.Priority,
+				DueAt:           current.DueAt,
+				IsOverdue:       current.SLABreach != "",
+				SlaBreach:       current.SLABreach,
+				LocationUuid:    current.LocationUUID,
+				CategoryUuid:    current.CategoryUUID,
+				Severity:        current.Severity,
+				CustomFields:    customFieldsToPB(current.CustomFields),
+			},
+			Removed: !isVisible,
+		})
+	}
+
+	return Error.CodeError{}
+}
@@ -0,0 +1,309 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// mockWatchStream — серверный поток WatchApplications, складывающий отправленные изменения в канал
+type mockWatchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pb.ApplicationUpdate
+}
+
+func (m *mockWatchStream) Context() context.Context       { return m.ctx }
+func (m *mockWatchStream) SendHeader(_ metadata.MD) error { return nil }
+func (m *mockWatchStream) Send(update *pb.ApplicationUpdate) error {
+	m.sent <- update
+	return nil
+}
+
+// eventBody — тело события заявки testApp() с заданной версией
+func eventBody(t *testing.T, eventType string, version int64) []byte {
+	t.Helper()
+	body, err := json.Marshal(entities.ApplicationEvent{
+		EventType:       eventType,
+		ApplicationUUID: appID,
+		CompanyUUID:     companyID,
+		Version:         version,
+	})
+	if err != nil {
+		t.Fatalf("marshal event: %v", err)
+	}
+	return body
+}
+
+// versionsRepo — мок репозитория, отдающий состояния заявки по версиям
+func versionsRepo(versions map[int64]*entities.Application) *mockApplicationRepo {
+	repo := emptyRepo()
+	repo.getApplicationVersion = func(_ context.Context, dto entities.GetApplicationVersionDTO) (*entities.Application, Error.CodeError) {
+		app, found := versions[dto.Version]
+		if !found {
+			return nil, notFound()
+		}
+		return app, ok()
+	}
+	return repo
+}
+
+// ─── matchesApplicationsFilter ───────────────────────────────────────────────
+
+func TestMatchesApplicationsFilter(t *testing.T) {
+	deleted := testApp()
+	deleted.DeletedAt = "2024-01-02 00:00:00"
+	overdue := testApp()
+	overdue.SLABreach = entities.SLABreachAssign
+
+	cases := []struct {
+		name   string
+		filter entities.GetApplicationsDTO
+		app    *entities.Application
+		want   bool
+	}{
+		{"company only", entities.GetApplicationsDTO{CompanyUUID: companyID}, testApp(), true},
+		{"other company", entities.GetApplicationsDTO{CompanyUUID: "other"}, testApp(), false},
+		{"status match", entities.GetApplicationsDTO{CompanyUUID: companyID, Statuses: []string{"created", "assigned"}}, testApp(), true},
+		{"status mismatch", entities.GetApplicationsDTO{CompanyUUID: companyID, Statuses: []string{"assigned"}}, testApp(), false},
+		{"created by", entities.GetApplicationsDTO{CompanyUUID: companyID, CreatedBy: initiatorID}, testApp(), true},
+		{"created by other", entities.GetApplicationsDTO{CompanyUUID: companyID, CreatedBy: otherUserID}, testApp(), false},
+		{"executed by", entities.GetApplicationsDTO{CompanyUUID: companyID, ExecutedBy: targetID}, assignedApp(), true},
+		{"executed by is null", entities.GetApplicationsDTO{CompanyUUID: companyID, ExecutedByIsNull: true}, assignedApp(), false},
+		{"department mismatch", entities.GetApplicationsDTO{CompanyUUID: companyID, DepartmentUUID: otherDeptID}, testApp(), false},
+		{"deleted hidden", entities.GetApplicationsDTO{CompanyUUID: companyID}, deleted, false},
+		{"deleted requested", entities.GetApplicationsDTO{CompanyUUID: companyID, IsDeleted: true}, deleted, true},
+		{"not deleted when deleted requested", entities.GetApplicationsDTO{CompanyUUID: companyID, IsDeleted: true}, testApp(), false},
+		{"overdue requested", entities.GetApplicationsDTO{CompanyUUID: companyID, IsOverdue: true}, overdue, true},
+		{"not overdue when overdue requested", entities.GetApplicationsDTO{CompanyUUID: companyID, IsOverdue: true}, testApp(), false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := matchesApplicationsFilter(tc.filter, tc.app); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+// ─── HandleApplicationEvent ──────────────────────────────────────────────────
+
+func TestHandleApplicationEvent(t *testing.T) {
+	// Менеджерский пул: created → assigned убирает заявку из пула
+	poolFilter := entities.GetApplicationsDTO{
+		CompanyUUID:      companyID,
+		DepartmentUUID:   deptID,
+		Statuses:         []string{"created", "redirected", "recalled", "on_revision"},
+		ExecutedByIsNull: true,
+	}
+
+	created := testApp()
+	created.Version = 1
+	assigned := assignedApp()
+	assigned.Version = 2
+
+	t.Run("new application visible", func(t *testing.T) {
+		svc := newAppTestService(versionsRepo(map[int64]*entities.Application{1: created}), roleClient("manager"))
+		w := svc.watchers.subscribe(companyID, poolFilter)
+
+		if err := svc.HandleApplicationEvent(context.Background(), eventBody(t, entities.EventApplicationCreated, 1)); err.Code != 0 {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		select {
+		case update := <-w.updates:
+			if update.GetRemoved() {
+				t.Error("expected removed=false")
+			}
+			if update.GetEventType() != entities.EventApplicationCreated {
+				t.Errorf("expected event type %q, got %q", entities.EventApplicationCreated, update.GetEventType())
+			}
+			if update.GetApplication().GetApplicationUuid() != appID {
+				t.Errorf("expected application %q, got %q", appID, update.GetApplication().GetApplicationUuid())
+			}
+		default:
+			t.Fatal("expected update")
+		}
+	})
+
+	t.Run("application left pool", func(t *testing.T) {
+		svc := newAppTestService(versionsRepo(map[int64]*entities.Application{1: created, 2: assigned}), roleClient("manager"))
+		w := svc.watchers.subscribe(companyID, poolFilter)
+
+		if err := svc.HandleApplicationEvent(context.Background(), eventBody(t, entities.EventApplicationAssigned, 2)); err.Code != 0 {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		select {
+		case update := <-w.updates:
+			if !update.GetRemoved() {
+				t.Error("expected removed=true")
+			}
+			if update.GetApplication().GetStatus() != "assigned" {
+				t.Errorf("expected current status, got %q", update.GetApplication().GetStatus())
+			}
+		default:
+			t.Fatal("expected update")
+		}
+	})
+
+	t.Run("invisible application skipped", func(t *testing.T) {
+		svc := newAppTestService(versionsRepo(map[int64]*entities.Application{1: created, 2: assigned}), roleClient("manager"))
+		otherDept := poolFilter
+		otherDept.DepartmentUUID = otherDeptID
+		w := svc.watchers.subscribe(companyID, otherDept)
+
+		if err := svc.HandleApplicationEvent(context.Background(), eventBody(t, entities.EventApplicationAssigned, 2)); err.Code != 0 {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(w.updates) != 0 {
+			t.Errorf("expected no updates, got %d", len(w.updates))
+		}
+	})
+
+	t.Run("no watchers skips db", func(t *testing.T) {
+		// getApplicationVersion не задан - вызов упал бы с nil func
+		svc := newAppTestService(emptyRepo(), roleClient("manager"))
+		if err := svc.HandleApplicationEvent(context.Background(), eventBody(t, entities.EventApplicationCreated, 1)); err.Code != 0 {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("manager"))
+		if err := svc.HandleApplicationEvent(context.Background(), []byte("{")); err.Code != codes.InvalidArgument {
+			t.Errorf("expected InvalidArgument, got %v", err.Code)
+		}
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		repo := emptyRepo()
+		repo.getApplicationVersion = func(_ context.Context, _ entities.GetApplicationVersionDTO) (*entities.Application, Error.CodeError) {
+			return nil, internalErr()
+		}
+		svc := newAppTestService(repo, roleClient("manager"))
+		svc.watchers.subscribe(companyID, poolFilter)
+
+		if err := svc.HandleApplicationEvent(context.Background(), eventBody(t, entities.EventApplicationCreated, 1)); err.Code != codes.Internal {
+			t.Errorf("expected Internal, got %v", err.Code)
+		}
+	})
+
+	t.Run("slow watcher overflow", func(t *testing.T) {
+		svc := newAppTestService(versionsRepo(map[int64]*entities.Application{1: created}), roleClient("manager"))
+		w := svc.watchers.subscribe(companyID, poolFilter)
+
+		for i := 0; i <= watchBufferSize; i++ {
+			svc.HandleApplicationEvent(context.Background(), eventBody(t, entities.EventApplicationCreated, 1))
+		}
+
+		select {
+		case <-w.overflow:
+		default:
+			t.Fatal("expected overflow to be signalled")
+		}
+	})
+}
+
+// ─── WatchApplications ───────────────────────────────────────────────────────
+
+func TestWatchApplications(t *testing.T) {
+	t.Run("invalid initiator uuid", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("manager"))
+		err := svc.WatchApplications(&pb.WatchApplicationsRequest{
+			InitiatorUuid: "not-a-uuid",
+			CompanyUuid:   companyID,
+		}, &mockWatchStream{ctx: context.Background()})
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("not employee", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), errCompanyClient())
+		err := svc.WatchApplications(&pb.WatchApplicationsRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+		}, &mockWatchStream{ctx: context.Background()})
+		assertCode(t, err, codes.Internal)
+	})
+
+	t.Run("engineer invalid statuses", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("engineer"))
+		err := svc.WatchApplications(&pb.WatchApplicationsRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+			Statuses:      []string{"created"},
+		}, &mockWatchStream{ctx: context.Background()})
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("unknown role", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("unemployed"))
+		err := svc.WatchApplications(&pb.WatchApplicationsRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+		}, &mockWatchStream{ctx: context.Background()})
+		assertCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("streams updates until cancelled", func(t *testing.T) {
+		created := testApp()
+		created.Version = 1
+		svc := newAppTestService(versionsRepo(map[int64]*entities.Application{1: created}), roleClient("manager"))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		stream := &mockWatchStream{ctx: ctx, sent: make(chan *pb.ApplicationUpdate, 1)}
+
+		done := make(chan error, 1)
+		go func() {
+			done <- svc.WatchApplications(&pb.WatchApplicationsRequest{
+				InitiatorUuid: initiatorID,
+				CompanyUuid:   companyID,
+				FromPool:      true,
+			}, stream)
+		}()
+
+		// Дожидаемся регистрации подписчика
+		deadline := time.After(time.Second)
+		for len(svc.watchers.companyWatchers(companyID)) == 0 {
+			select {
+			case <-deadline:
+				t.Fatal("watcher was not subscribed")
+			case <-time.After(time.Millisecond):
+			}
+		}
+
+		svc.HandleApplicationEvent(context.Background(), eventBody(t, entities.EventApplicationCreated, 1))
+
+		select {
+		case update := <-stream.sent:
+			if update.GetApplication().GetApplicationUuid() != appID {
+				t.Errorf("expected application %q, got %q", appID, update.GetApplication().GetApplicationUuid())
+			}
+		case <-time.After(time.Second):
+			t.Fatal("expected update to be sent")
+		}
+
+		cancel()
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Errorf("expected nil error after cancel, got %v", err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("stream did not stop after cancel")
+		}
+
+		if len(svc.watchers.companyWatchers(companyID)) != 0 {
+			t.Error("expected watcher to be unsubscribed")
+		}
+	})
+}
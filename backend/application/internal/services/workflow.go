@@ -0,0 +1,274 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/workflow"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	sharedErrors "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GetApplicationActions Переходы workflow, доступные инициатору для заявки в её текущем статусе
+func (s *ApplicationService) GetApplicationActions(ctx context.Context, req *pb.GetApplicationActionsRequest) (*pb.GetApplicationActionsResponse, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetApplicationUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid application uuid")
+	}
+
+	application, getErr := s.db.ApplicationRepository.GetApplication(ctx, entities.GetApplicationDTO{
+		ApplicationUUID: req.GetApplicationUuid(),
+	})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	initiator, err := s.getEmployeeInfo(ctx, application.CompanyUUID, req.GetInitiatorUuid(), req.GetInitiatorUuid())
+	if err != nil {
+		return nil, err
+	}
+
+	// У удалённой заявки действий нет
+	if application.DeletedAt != "" {
+		return &pb.GetApplicationActionsResponse{Actions: []*pb.WorkflowTransition{}}, nil
+	}
+
+	machine, _, err := s.getWorkflow(ctx, application.CompanyUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := machine.Allowed(application, initiator)
+	actions := make([]*pb.WorkflowTransition, 0, len(allowed))
+	for _, t := range allowed {
+		actions = append(actions, transitionToPb(t))
+	}
+
+	return &pb.GetApplicationActionsResponse{Actions: actions}, nil
+}
+
+// GetCompanyWorkflow Получение workflow заявок компании (любой сотрудник компании)
+func (s *ApplicationService) GetCompanyWorkflow(ctx context.Context, req *pb.GetCompanyWorkflowRequest) (*pb.GetCompanyWorkflowResponse, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+
+	if _, err := s.getEmployeeInfo(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), req.GetInitiatorUuid()); err != nil {
+		return nil, err
+	}
+
+	machine, stored, err := s.getWorkflow(ctx, req.GetCompanyUuid())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.GetCompanyWorkflowResponse{
+		Workflow:  workflowToPb(machine),
+		IsDefault: stored == nil,
+	}
+	if stored != nil {
+		res.UpdatedAt = stored.UpdatedAt
+		res.UpdatedBy = stored.UpdatedBy
+	}
+
+	return res, nil
+}
+
+// UpdateCompanyWorkflow Замена workflow заявок компании (только chief).
+// Статус нельзя убрать из workflow, пока в нём есть заявки компании.
+func (s *ApplicationService) UpdateCompanyWorkflow(ctx context.Context, req *pb.UpdateCompanyWorkflowRequest) (*emptypb.Empty, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+
+	next := workflowFromPb(req.GetWorkflow())
+	if err := next.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid workflow: %s", err.Error())
+	}
+
+	if err := s.checkChief(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), "only chief can configure workflow"); err != nil {
+		return nil, err
+	}
+
+	current, _, err := s.getWorkflow(ctx, req.GetCompanyUuid())
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkRemovedStates(ctx, req.GetCompanyUuid(), current.RemovedStates(next)); err != nil {
+		return nil, err
+	}
+
+	definition, marshalErr := json.Marshal(next)
+	if marshalErr != nil {
+		return nil, sharedErrors.Internal(marshalErr).GRPCError()
+	}
+
+	if err := s.db.WorkflowRepository.SaveCompanyWorkflow(ctx, entities.SaveCompanyWorkflowDTO{
+		CompanyUUID: req.GetCompanyUuid(),
+		Definition:  definition,
+		UpdatedBy:   req.GetInitiatorUuid(),
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ResetCompanyWorkflow Возврат компании к workflow по умолчанию (только chief)
+func (s *ApplicationService) ResetCompanyWorkflow(ctx context.Context, req *pb.ResetCompanyWorkflowRequest) (*emptypb.Empty, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+
+	if err := s.checkChief(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), "only chief can configure workflow"); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.WorkflowRepository.DeleteCompanyWorkflow(ctx, entities.DeleteCompanyWorkflowDTO{
+		CompanyUUID: req.GetCompanyUuid(),
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ─── Вспомогательные функции ──────────────────────────────────────────────────
+
+// getWorkflow Workflow компании; если компания его не настраивала - workflow по умолчанию (stored = nil)
+func (s *ApplicationService) getWorkflow(ctx context.Context, companyUUID string) (*workflow.Machine, *entities.CompanyWorkflow, error) {
+	stored, getErr := s.db.WorkflowRepository.GetCompanyWorkflow(ctx, entities.GetCompanyWorkflowDTO{
+		CompanyUUID: companyUUID,
+	})
+	if getErr.Code == codes.NotFound {
+		return workflow.Default(), nil, nil
+	}
+	if err := getErr.GRPCError(); err != nil {
+		return nil, nil, err
+	}
+
+	machine := &workflow.Machine{}
+	if err := json.Unmarshal(stored.Definition, machine); err != nil {
+		return nil, nil, sharedErrors.Internal(err).GRPCError()
+	}
+
+	return machine, stored, nil
+}
+
+// checkTransition Проверка действия над заявкой по workflow компании.
+// statusCode - код ошибки при недопустимом текущем статусе заявки: исторически он различается
+// между методами API, и клиенты на него опираются.
+func (s *ApplicationService) checkTransition(ctx context.Context, application *entities.Application, initiator *entities.Employee, action, to, message string, statusCode codes.Code) error {
+	machine, _, err := s.getWorkflow(ctx, application.CompanyUUID)
+	if err != nil {
+		return err
+	}
+
+	if _, err = machine.Check(application, initiator, action, to, message); err != nil {
+		var wfErr *workflow.Error
+		if !errors.As(err, &wfErr) {
+			return sharedErrors.Internal(err).GRPCError()
+		}
+
+		switch wfErr.Reason {
+		case workflow.ReasonDisabled:
+			return status.Error(codes.FailedPrecondition, wfErr.Message)
+		case workflow.ReasonStatus:
+			return status.Error(statusCode, wfErr.Message)
+		case workflow.ReasonMessage:
+			return status.Error(codes.InvalidArgument, wfErr.Message)
+		default:
+			return status.Error(codes.PermissionDenied, wfErr.Message)
+		}
+	}
+
+	return nil
+}
+
+// checkChief Настройки компании (workflow, SLA) может менять только chief
+func (s *ApplicationService) checkChief(ctx context.Context, companyUUID, initiatorUUID, message string) error {
+	initiator, err := s.getEmployeeInfo(ctx, companyUUID, initiatorUUID, initiatorUUID)
+	if err != nil {
+		return err
+	}
+	if initiator.Role != "chief" {
+		return status.Error(codes.PermissionDenied, message)
+	}
+	return nil
+}
+
+// checkRemovedStates Убираемые из workflow статусы не должны содержать заявок, иначе они застрянут
+func (s *ApplicationService) checkRemovedStates(ctx context.Context, companyUUID string, removed []string) error {
+	if len(removed) == 0 {
+		return nil
+	}
+
+	count, countErr := s.db.ApplicationRepository.CountApplications(ctx, entities.CountApplicationsDTO{
+		CompanyUUID: companyUUID,
+		Statuses:    removed,
+	})
+	if err := countErr.GRPCError(); err != nil {
+		return err
+	}
+	if count > 0 {
+		return status.Errorf(codes.FailedPrecondition, "%d applications are in removed states %v", count, removed)
+	}
+
+	return nil
+}
+
+func transitionToPb(t workflow.Transition) *pb.WorkflowTransition {
+	return &pb.WorkflowTransition{
+		Name:           t.Name,
+		Action:         t.Action,
+		From:           t.From,
+		To:             t.To,
+		Roles:          t.Roles,
+		Guard:          t.Guard,
+		RequireMessage: t.RequireMessage,
+	}
+}
+
+func workflowToPb(m *workflow.Machine) *pb.Workflow {
+	transitions := make([]*pb.WorkflowTransition, 0, len(m.Transitions))
+	for _, t := range m.Transitions {
+		transitions = append(transitions, transitionToPb(t))
+	}
+	return &pb.Workflow{States: m.States, Transitions: transitions}
+}
+
+func workflowFromPb(wf *pb.Workflow) *workflow.Machine {
+	machine := &workflow.Machine{
+		States:      wf.GetStates(),
+		Transitions: make([]workflow.Transition, 0, len(wf.GetTransitions())),
+	}
+	for _, t := range wf.GetTransitions() {
+		machine.Transitions = append(machine.Transitions, workflow.Transition{
+			Name:           t.GetName(),
+			Action:         t.GetAction(),
+			From:           t.GetFrom(),
+			To:             t.GetTo(),
+			Roles:          t.GetRoles(),
+			Guard:          t.GetGuard(),
+			RequireMessage: t.GetRequireMessage(),
+		})
+	}
+	return machine
+}
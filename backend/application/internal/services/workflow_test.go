@@ -0,0 +1,334 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/workflow"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+// workflowWithoutOnHold — workflow по умолчанию, в котором chief отключил шаг on_hold
+func workflowWithoutOnHold() *workflow.Machine {
+	m := workflow.Default()
+	m.States = slices.DeleteFunc(m.States, func(s string) bool { return s == "on_hold" })
+	m.Transitions = slices.DeleteFunc(m.Transitions, func(t workflow.Transition) bool { return t.To == "on_hold" })
+	for i := range m.Transitions {
+		m.Transitions[i].From = slices.DeleteFunc(slices.Clone(m.Transitions[i].From), func(s string) bool { return s == "on_hold" })
+	}
+	return m
+}
+
+// storedWorkflowRepo — мок репозитория workflow, возвращающий сохранённый workflow компании
+func storedWorkflowRepo(t *testing.T, m *workflow.Machine) *mockWorkflowRepo {
+	t.Helper()
+	definition, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("marshal workflow: %v", err)
+	}
+	return &mockWorkflowRepo{
+		getCompanyWorkflow: func(_ context.Context, _ entities.GetCompanyWorkflowDTO) (*entities.CompanyWorkflow, Error.CodeError) {
+			return &entities.CompanyWorkflow{
+				CompanyUUID: companyID,
+				Definition:  definition,
+				UpdatedAt:   "2024-01-01 00:00:00",
+				UpdatedBy:   initiatorID,
+			}, ok()
+		},
+	}
+}
+
+func actionNames(actions []*pb.WorkflowTransition) []string {
+	names := make([]string, 0, len(actions))
+	for _, a := range actions {
+		names = append(names, a.GetName())
+	}
+	return names
+}
+
+// ─── GetApplicationActions ───────────────────────────────────────────────────
+
+func TestGetApplicationActions(t *testing.T) {
+	t.Run("invalid application uuid", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("engineer"))
+		_, err := svc.GetApplicationActions(context.Background(), &pb.GetApplicationActionsRequest{
+			InitiatorUuid:   initiatorID,
+			ApplicationUuid: "not-a-uuid",
+		})
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("executor actions", func(t *testing.T) {
+		svc := newAppTestService(repoWithApp(inProgressApp()), roleClient("engineer"))
+		res, err := svc.GetApplicationActions(context.Background(), &pb.GetApplicationActionsRequest{
+			InitiatorUuid:   initiatorID,
+			ApplicationUuid: appID,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := []string{"start_work", "hold", "submit_verification"}
+		if got := actionNames(res.GetActions()); !slices.Equal(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("company workflow applied", func(t *testing.T) {
+		svc := newAppTestService(repoWithApp(inProgressApp()), roleClient("engineer"))
+		svc.db.WorkflowRepository = storedWorkflowRepo(t, workflowWithoutOnHold())
+
+		res, err := svc.GetApplicationActions(context.Background(), &pb.GetApplicationActionsRequest{
+			InitiatorUuid:   initiatorID,
+			ApplicationUuid: appID,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := []string{"start_work", "submit_verification"}
+		if got := actionNames(res.GetActions()); !slices.Equal(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("deleted application has no actions", func(t *testing.T) {
+		app := testApp()
+		app.DeletedAt = "2024-01-02 00:00:00"
+		svc := newAppTestService(repoWithApp(app), roleClient("inspector"))
+
+		res, err := svc.GetApplicationActions(context.Background(), &pb.GetApplicationActionsRequest{
+			InitiatorUuid:   initiatorID,
+			ApplicationUuid: appID,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(res.GetActions()) != 0 {
+			t.Errorf("expected no actions, got %v", actionNames(res.GetActions()))
+		}
+	})
+
+	t.Run("not employee", func(t *testing.T) {
+		svc := newAppTestService(repoWithApp(testApp()), errCompanyClient())
+		_, err := svc.GetApplicationActions(context.Background(), &pb.GetApplicationActionsRequest{
+			InitiatorUuid:   initiatorID,
+			ApplicationUuid: appID,
+		})
+		assertCode(t, err, codes.Internal)
+	})
+}
+
+// ─── UpdateApplicationStatus (workflow компании) ─────────────────────────────
+
+func TestUpdateApplicationStatusCompanyWorkflow(t *testing.T) {
+	t.Run("disabled step", func(t *testing.T) {
+		svc := newAppTestService(repoWithApp(inProgressApp()), roleClient("engineer"))
+		svc.db.WorkflowRepository = storedWorkflowRepo(t, workflowWithoutOnHold())
+
+		_, err := svc.UpdateApplicationStatus(context.Background(), &pb.UpdateApplicationStatusRequest{
+			InitiatorUuid:   initiatorID,
+			ApplicationUuid: appID,
+			Status:          "on_hold",
+		})
+		assertCode(t, err, codes.FailedPrecondition)
+	})
+
+	t.Run("required message saved to fix log", func(t *testing.T) {
+		m := workflow.Default()
+		for i := range m.Transitions {
+			if m.Transitions[i].Name == "hold" {
+				m.Transitions[i].RequireMessage = true
+			}
+		}
+
+		repo := repoWithApp(inProgressApp())
+		var saved entities.UpdateApplicationStatusDTO
+		repo.updateApplicationStatus = func(_ context.Context, dto entities.UpdateApplicationStatusDTO) Error.CodeError {
+			saved = dto
+			return ok()
+		}
+		svc := newAppTestService(repo, roleClient("engineer"))
+		svc.db.WorkflowRepository = storedWorkflowRepo(t, m)
+
+		req := &pb.UpdateApplicationStatusRequest{
+			InitiatorUuid:   initiatorID,
+			ApplicationUuid: appID,
+			Status:          "on_hold",
+		}
+		_, err := svc.UpdateApplicationStatus(context.Background(), req)
+		assertCode(t, err, codes.InvalidArgument)
+
+		req.Message = "  waiting for parts  "
+		if _, err = svc.UpdateApplicationStatus(context.Background(), req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if saved.FixLogText != "waiting for parts" {
+			t.Errorf("expected trimmed fix log text, got %q", saved.FixLogText)
+		}
+	})
+}
+
+// ─── GetCompanyWorkflow ──────────────────────────────────────────────────────
+
+func TestGetCompanyWorkflow(t *testing.T) {
+	t.Run("default workflow", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("engineer"))
+		res, err := svc.GetCompanyWorkflow(context.Background(), &pb.GetCompanyWorkflowRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !res.GetIsDefault() {
+			t.Error("expected is_default=true")
+		}
+		if len(res.GetWorkflow().GetTransitions()) != len(workflow.Default().Transitions) {
+			t.Errorf("expected default transitions, got %d", len(res.GetWorkflow().GetTransitions()))
+		}
+	})
+
+	t.Run("stored workflow", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("engineer"))
+		svc.db.WorkflowRepository = storedWorkflowRepo(t, workflowWithoutOnHold())
+
+		res, err := svc.GetCompanyWorkflow(context.Background(), &pb.GetCompanyWorkflowRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.GetIsDefault() {
+			t.Error("expected is_default=false")
+		}
+		if slices.Contains(res.GetWorkflow().GetStates(), "on_hold") {
+			t.Error("expected on_hold to be absent")
+		}
+		if res.GetUpdatedBy() != initiatorID {
+			t.Errorf("expected updated_by %q, got %q", initiatorID, res.GetUpdatedBy())
+		}
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("engineer"))
+		svc.db.WorkflowRepository = &mockWorkflowRepo{
+			getCompanyWorkflow: func(_ context.Context, _ entities.GetCompanyWorkflowDTO) (*entities.CompanyWorkflow, Error.CodeError) {
+				return nil, internalErr()
+			},
+		}
+		_, err := svc.GetCompanyWorkflow(context.Background(), &pb.GetCompanyWorkflowRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+		})
+		assertCode(t, err, codes.Internal)
+	})
+}
+
+// ─── UpdateCompanyWorkflow ───────────────────────────────────────────────────
+
+func TestUpdateCompanyWorkflow(t *testing.T) {
+	request := func(m *workflow.Machine) *pb.UpdateCompanyWorkflowRequest {
+		return &pb.UpdateCompanyWorkflowRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+			Workflow:      workflowToPb(m),
+		}
+	}
+
+	t.Run("invalid workflow", func(t *testing.T) {
+		m := workflow.Default()
+		m.States = append(m.States, "archived")
+
+		svc := newAppTestService(emptyRepo(), roleClient("chief"))
+		_, err := svc.UpdateCompanyWorkflow(context.Background(), request(m))
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("not chief", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("manager"))
+		_, err := svc.UpdateCompanyWorkflow(context.Background(), request(workflowWithoutOnHold()))
+		assertCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("applications in removed state", func(t *testing.T) {
+		repo := emptyRepo()
+		repo.countApplications = func(_ context.Context, dto entities.CountApplicationsDTO) (int64, Error.CodeError) {
+			if !slices.Equal(dto.Statuses, []string{"on_hold"}) {
+				t.Errorf("expected removed states [on_hold], got %v", dto.Statuses)
+			}
+			return 2, ok()
+		}
+
+		svc := newAppTestService(repo, roleClient("chief"))
+		_, err := svc.UpdateCompanyWorkflow(context.Background(), request(workflowWithoutOnHold()))
+		assertCode(t, err, codes.FailedPrecondition)
+	})
+
+	t.Run("saved", func(t *testing.T) {
+		repo := emptyRepo()
+		repo.countApplications = func(_ context.Context, _ entities.CountApplicationsDTO) (int64, Error.CodeError) { return 0, ok() }
+
+		var saved entities.SaveCompanyWorkflowDTO
+		svc := newAppTestService(repo, roleClient("chief"))
+		svc.db.WorkflowRepository = &mockWorkflowRepo{
+			saveCompanyWorkflow: func(_ context.Context, dto entities.SaveCompanyWorkflowDTO) Error.CodeError {
+				saved = dto
+				return ok()
+			},
+		}
+
+		if _, err := svc.UpdateCompanyWorkflow(context.Background(), request(workflowWithoutOnHold())); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if saved.UpdatedBy != initiatorID {
+			t.Errorf("expected updated_by %q, got %q", initiatorID, saved.UpdatedBy)
+		}
+
+		stored := &workflow.Machine{}
+		if err := json.Unmarshal(saved.Definition, stored); err != nil {
+			t.Fatalf("unmarshal saved definition: %v", err)
+		}
+		if slices.Contains(stored.States, "on_hold") {
+			t.Error("expected on_hold to be removed from saved workflow")
+		}
+	})
+}
+
+// ─── ResetCompanyWorkflow ────────────────────────────────────────────────────
+
+func TestResetCompanyWorkflow(t *testing.T) {
+	t.Run("not chief", func(t *testing.T) {
+		svc := newAppTestService(emptyRepo(), roleClient("inspector"))
+		_, err := svc.ResetCompanyWorkflow(context.Background(), &pb.ResetCompanyWorkflowRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+		})
+		assertCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		deleted := false
+		svc := newAppTestService(emptyRepo(), roleClient("chief"))
+		svc.db.WorkflowRepository = &mockWorkflowRepo{
+			deleteCompanyWorkflow: func(_ context.Context, _ entities.DeleteCompanyWorkflowDTO) Error.CodeError {
+				deleted = true
+				return ok()
+			},
+		}
+
+		if _, err := svc.ResetCompanyWorkflow(context.Background(), &pb.ResetCompanyWorkflowRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   companyID,
+		}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !deleted {
+			t.Error("expected company workflow to be deleted")
+		}
+	})
+}
@@ -0,0 +1,23 @@
+package workflow
+
+// Reason Причина отказа в переходе
+type Reason int
+
+const (
+	ReasonDisabled Reason = iota + 1 // действие или целевой статус отключены в workflow компании
+	ReasonRole                       // роль сотрудника не может выполнять действие
+	ReasonGuard                      // сотрудник не отвечает за заявку
+	ReasonTarget                     // роль сотрудника не может установить целевой статус
+	ReasonStatus                     // действие недоступно из текущего статуса заявки
+	ReasonMessage                    // не указано обязательное сообщение
+)
+
+// Error Отказ в переходе. Код gRPC ошибки выбирает вызывающий метод по Reason
+type Error struct {
+	Reason  Reason
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
@@ -0,0 +1,290 @@
+package workflow
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+)
+
+// Statuses Все статусы заявки (значения enum application_status)
+var Statuses = []string{"created", "assigned", "in_progress", "on_hold", "completed", "failed", "redirected", "rejected", "recalled", "pending_verification", "on_verification", "on_revision"}
+
+// SettableStatuses Статусы, которые можно установить действием set_status (UpdateApplicationStatus).
+// Остальные статусы устанавливаются только своими действиями (assign, redirect, ...)
+var SettableStatuses = []string{"rejected", "in_progress", "on_hold", "pending_verification", "completed", "failed", "on_revision"}
+
+// Roles Роли сотрудников компании
+var Roles = []string{"chief", "analytic", "inspector", "manager", "engineer"}
+
+// Действия - методы API, которыми выполняются переходы
+const (
+	ActionSetStatus           = "set_status"
+	ActionAssign              = "assign"
+	ActionRedirect            = "redirect"
+	ActionRecall              = "recall"
+	ActionTakeVerification    = "take_verification"
+	ActionReleaseVerification = "release_verification"
+	ActionDelete              = "delete"
+)
+
+// actionTargets Целевой статус действий, выставляемый репозиторием (для set_status задаётся переходом).
+// Удаление статус не меняет.
+var actionTargets = map[string]string{
+	ActionAssign:              "assigned",
+	ActionRedirect:            "redirected",
+	ActionRecall:              "recalled",
+	ActionTakeVerification:    "on_verification",
+	ActionReleaseVerification: "pending_verification",
+	ActionDelete:              "",
+}
+
+// messageActions Действия, сообщение которых сохраняется в fix log - сообщение для них обязательно
+var messageActions = []string{ActionRedirect, ActionRecall, ActionReleaseVerification, ActionDelete}
+
+// Условия на сотрудника, выполняющего переход
+const (
+	GuardDepartment = "department" // сотрудник отдела, ответственного за заявку
+	GuardManager    = "manager"    // ответственный менеджер заявки (managed_by)
+	GuardExecutor   = "executor"   // назначенный инженер (executed_by)
+	GuardInspector  = "inspector"  // инспектор, взявший заявку на проверку (inspected_by)
+	GuardCreator    = "creator"    // создатель заявки (created_by)
+)
+
+var guards = []string{"", GuardDepartment, GuardManager, GuardExecutor, GuardInspector, GuardCreator}
+
+const maxTransitions = 100
+
+// Transition Переход между статусами заявки
+type Transition struct {
+	Name           string   `json:"name"`   // уникальное имя перехода, например start_work
+	Action         string   `json:"action"` // метод API, которым выполняется переход
+	From           []string `json:"from"`
+	To             string   `json:"to"`
+	Roles          []string `json:"roles"`
+	Guard          string   `json:"guard,omitempty"`
+	RequireMessage bool     `json:"require_message,omitempty"`
+}
+
+// Machine Декларативный workflow заявок: статусы и разрешённые переходы
+type Machine struct {
+	States      []string     `json:"states"`
+	Transitions []Transition `json:"transitions"`
+}
+
+// Default Workflow по умолчанию - используется компаниями без собственной настройки
+func Default() *Machine {
+	pool := []string{"created", "redirected", "recalled", "on_revision"}
+	work := []string{"assigned", "in_progress", "on_hold", "on_revision"}
+
+	return &Machine{
+		States: slices.Clone(Statuses),
+		Transitions: []Transition{
+			{Name: "assign", Action: ActionAssign, From: pool, To: "assigned", Roles: []string{"manager"}, Guard: GuardDepartment},
+			{Name: "redirect", Action: ActionRedirect, From: pool, To: "redirected", Roles: []string{"manager"}, Guard: GuardDepartment, RequireMessage: true},
+			{Name: "reject", Action: ActionSetStatus, From: pool, To: "rejected", Roles: []string{"manager"}, Guard: GuardDepartment},
+			{Name: "recall", Action: ActionRecall, From: work, To: "recalled", Roles: []string{"manager"}, Guard: GuardManager, RequireMessage: true},
+			{Name: "start_work", Action: ActionSetStatus, From: work, To: "in_progress", Roles: []string{"engineer"}, Guard: GuardExecutor},
+			{Name: "hold", Action: ActionSetStatus, From: work, To: "on_hold", Roles: []string{"engineer"}, Guard: GuardExecutor},
+			{Name: "submit_verification", Action: ActionSetStatus, From: work, To: "pending_verification", Roles: []string{"engineer"}, Guard: GuardExecutor},
+			{Name: "take_verification", Action: ActionTakeVerification, From: []string{"pending_verification"}, To: "on_verification", Roles: []string{"inspector"}, Guard: GuardDepartment},
+			{Name: "release_verification", Action: ActionReleaseVerification, From: []string{"on_verification"}, To: "pending_verification", Roles: []string{"inspector"}, Guard: GuardInspector, RequireMessage: true},
+			{Name: "complete", Action: ActionSetStatus, From: []string{"on_verification"}, To: "completed", Roles: []string{"inspector"}, Guard: GuardInspector},
+			{Name: "fail", Action: ActionSetStatus, From: []string{"on_verification"}, To: "failed", Roles: []string{"inspector"}, Guard: GuardInspector},
+			{Name: "send_to_revision", Action: ActionSetStatus, From: []string{"on_verification"}, To: "on_revision", Roles: []string{"inspector"}, Guard: GuardInspector},
+			{Name: "delete", Action: ActionDelete, From: []string{"created"}, Roles: []string{"inspector"}, Guard: GuardCreator, RequireMessage: true},
+		},
+	}
+}
+
+// Validate Проверка workflow перед сохранением
+func (m *Machine) Validate() error {
+	if len(m.States) == 0 {
+		return fmt.Errorf("states are empty")
+	}
+	for i, state := range m.States {
+		if !slices.Contains(Statuses, state) {
+			return fmt.Errorf("unknown state %q", state)
+		}
+		if slices.Contains(m.States[:i], state) {
+			return fmt.Errorf("duplicate state %q", state)
+		}
+	}
+	// Заявка всегда создаётся в статусе created
+	if !slices.Contains(m.States, "created") {
+		return fmt.Errorf("state \"created\" is required")
+	}
+
+	if len(m.Transitions) > maxTransitions {
+		return fmt.Errorf("too many transitions (max %d)", maxTransitions)
+	}
+
+	names := make(map[string]struct{}, len(m.Transitions))
+	for _, t := range m.Transitions {
+		if t.Name == "" {
+			return fmt.Errorf("transition name is empty")
+		}
+		if _, found := names[t.Name]; found {
+			return fmt.Errorf("duplicate transition %q", t.Name)
+		}
+		names[t.Name] = struct{}{}
+
+		if err := m.validateTransition(t); err != nil {
+			return fmt.Errorf("transition %q: %w", t.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (m *Machine) validateTransition(t Transition) error {
+	if t.Action == ActionSetStatus {
+		if !slices.Contains(SettableStatuses, t.To) {
+			return fmt.Errorf("status %q can't be set by %s", t.To, ActionSetStatus)
+		}
+	} else {
+		target, found := actionTargets[t.Action]
+		if !found {
+			return fmt.Errorf("unknown action %q", t.Action)
+		}
+		if t.To != target {
+			return fmt.Errorf("action %s always leads to %q", t.Action, target)
+		}
+	}
+	if t.To != "" && !slices.Contains(m.States, t.To) {
+		return fmt.Errorf("target state %q is not in states", t.To)
+	}
+
+	if len(t.From) == 0 {
+		return fmt.Errorf("from is empty")
+	}
+	for _, from := range t.From {
+		if !slices.Contains(m.States, from) {
+			return fmt.Errorf("source state %q is not in states", from)
+		}
+	}
+
+	if len(t.Roles) == 0 {
+		return fmt.Errorf("roles are empty")
+	}
+	for _, role := range t.Roles {
+		if !slices.Contains(Roles, role) {
+			return fmt.Errorf("unknown role %q", role)
+		}
+	}
+
+	if !slices.Contains(guards, t.Guard) {
+		return fmt.Errorf("unknown guard %q", t.Guard)
+	}
+	if slices.Contains(messageActions, t.Action) && !t.RequireMessage {
+		return fmt.Errorf("action %s always requires a message", t.Action)
+	}
+
+	return nil
+}
+
+// RemovedStates Статусы текущего workflow, отсутствующие в новом
+func (m *Machine) RemovedStates(next *Machine) []string {
+	removed := make([]string, 0)
+	for _, state := range m.States {
+		if !slices.Contains(next.States, state) {
+			removed = append(removed, state)
+		}
+	}
+	return removed
+}
+
+// Allowed Переходы, доступные сотруднику для заявки в её текущем статусе
+func (m *Machine) Allowed(app *entities.Application, actor *entities.Employee) []Transition {
+	allowed := make([]Transition, 0)
+	for _, t := range m.Transitions {
+		if slices.Contains(t.Roles, actor.Role) && guardAllows(t.Guard, app, actor) && slices.Contains(t.From, app.Status) {
+			allowed = append(allowed, t)
+		}
+	}
+	return allowed
+}
+
+// Check Поиск перехода для действия action сотрудника actor из текущего статуса заявки.
+// to учитывается только для set_status. Проверки выполняются в порядке:
+// действие включено → роль → условие на сотрудника → целевой статус → текущий статус → сообщение
+func (m *Machine) Check(app *entities.Application, actor *entities.Employee, action, to, message string) (*Transition, error) {
+	candidates := m.filter(func(t Transition) bool {
+		return t.Action == action && (action != ActionSetStatus || t.To == to)
+	})
+	if len(candidates) == 0 {
+		return nil, &Error{Reason: ReasonDisabled, Message: fmt.Sprintf("%s is disabled in company workflow", actionTitle(action, to))}
+	}
+
+	candidates = m.filter(func(t Transition) bool {
+		return t.Action == action && slices.Contains(t.Roles, actor.Role)
+	})
+	if len(candidates) == 0 {
+		return nil, &Error{Reason: ReasonRole, Message: fmt.Sprintf("role %q is not allowed to %s", actor.Role, action)}
+	}
+
+	candidates = filter(candidates, func(t Transition) bool { return guardAllows(t.Guard, app, actor) })
+	if len(candidates) == 0 {
+		return nil, &Error{Reason: ReasonGuard, Message: "you are not responsible for this application"}
+	}
+
+	candidates = filter(candidates, func(t Transition) bool { return action != ActionSetStatus || t.To == to })
+	if len(candidates) == 0 {
+		return nil, &Error{Reason: ReasonTarget, Message: fmt.Sprintf("role %q is not allowed to set status %q", actor.Role, to)}
+	}
+
+	candidates = filter(candidates, func(t Transition) bool { return slices.Contains(t.From, app.Status) })
+	if len(candidates) == 0 {
+		return nil, &Error{Reason: ReasonStatus, Message: fmt.Sprintf("%s is not allowed from status %q", actionTitle(action, to), app.Status)}
+	}
+
+	transition := candidates[0]
+	if transition.RequireMessage && message == "" {
+		return nil, &Error{Reason: ReasonMessage, Message: "message is required"}
+	}
+
+	return &transition, nil
+}
+
+func (m *Machine) filter(match func(t Transition) bool) []Transition {
+	return filter(m.Transitions, match)
+}
+
+func filter(transitions []Transition, match func(t Transition) bool) []Transition {
+	res := make([]Transition, 0, len(transitions))
+	for _, t := range transitions {
+		if match(t) {
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
+// guardAllows Проверка условия перехода на сотрудника
+func guardAllows(guard string, app *entities.Application, actor *entities.Employee) bool {
+	switch guard {
+	case GuardDepartment:
+		return actor.DepartmentUUID == app.DepartmentUUID
+	case GuardManager:
+		// Ответственный менеджер покинул компанию - заявку может отозвать любой менеджер отдела
+		if app.ManagedBy == "" {
+			return actor.DepartmentUUID == app.DepartmentUUID
+		}
+		return app.ManagedBy == actor.UUID
+	case GuardExecutor:
+		return app.ExecutedBy == actor.UUID
+	case GuardInspector:
+		return app.InspectedBy == actor.UUID
+	case GuardCreator:
+		return app.CreatedBy == actor.UUID
+	default:
+		return true
+	}
+}
+
+func actionTitle(action, to string) string {
+	if action == ActionSetStatus {
+		return fmt.Sprintf("transition to %q", to)
+	}
+	return action
+}
@@ -0,0 +1,211 @@
+package workflow
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/application/internal/entities"
+)
+
+const (
+	deptID     = "dept"
+	managerID  = "manager"
+	engineerID = "engineer"
+)
+
+func assignedApp() *entities.Application {
+	return &entities.Application{
+		DepartmentUUID: deptID,
+		Status:         "assigned",
+		CreatedBy:      "creator",
+		ManagedBy:      managerID,
+		ExecutedBy:     engineerID,
+	}
+}
+
+func assertReason(t *testing.T, err error, want Reason) {
+	t.Helper()
+	var wfErr *Error
+	if !errors.As(err, &wfErr) {
+		t.Fatalf("expected workflow error with reason %d, got %v", want, err)
+	}
+	if wfErr.Reason != want {
+		t.Errorf("expected reason %d, got %d (%s)", want, wfErr.Reason, wfErr.Message)
+	}
+}
+
+// withoutOnHold — workflow по умолчанию без статуса on_hold
+func withoutOnHold() *Machine {
+	m := Default()
+	m.States = slices.DeleteFunc(m.States, func(s string) bool { return s == "on_hold" })
+	m.Transitions = slices.DeleteFunc(m.Transitions, func(t Transition) bool { return t.To == "on_hold" })
+	for i := range m.Transitions {
+		m.Transitions[i].From = slices.DeleteFunc(slices.Clone(m.Transitions[i].From), func(s string) bool { return s == "on_hold" })
+	}
+	return m
+}
+
+// ─── Validate ────────────────────────────────────────────────────────────────
+
+func TestValidate(t *testing.T) {
+	t.Run("default is valid", func(t *testing.T) {
+		if err := Default().Validate(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("without on_hold is valid", func(t *testing.T) {
+		if err := withoutOnHold().Validate(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	cases := []struct {
+		name   string
+		modify func(m *Machine)
+	}{
+		{"empty states", func(m *Machine) { m.States = nil }},
+		{"unknown state", func(m *Machine) { m.States = append(m.States, "archived") }},
+		{"duplicate state", func(m *Machine) { m.States = append(m.States, "created") }},
+		{"created is required", func(m *Machine) {
+			m.States = slices.DeleteFunc(m.States, func(s string) bool { return s == "created" })
+		}},
+		{"duplicate transition", func(m *Machine) { m.Transitions = append(m.Transitions, m.Transitions[0]) }},
+		{"empty transition name", func(m *Machine) { m.Transitions[0].Name = "" }},
+		{"unknown action", func(m *Machine) { m.Transitions[0].Action = "archive" }},
+		{"action target mismatch", func(m *Machine) { m.Transitions[0].To = "in_progress" }},
+		{"status not settable", func(m *Machine) { m.Transitions[2].To = "assigned" }},
+		{"source not in states", func(m *Machine) {
+			m.States = slices.DeleteFunc(m.States, func(s string) bool { return s == "on_hold" })
+		}},
+		{"empty roles", func(m *Machine) { m.Transitions[0].Roles = nil }},
+		{"unknown role", func(m *Machine) { m.Transitions[0].Roles = []string{"director"} }},
+		{"unknown guard", func(m *Machine) { m.Transitions[0].Guard = "owner" }},
+		{"message action without require_message", func(m *Machine) { m.Transitions[1].RequireMessage = false }},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := Default()
+			tc.modify(m)
+			if err := m.Validate(); err == nil {
+				t.Error("expected validation error")
+			}
+		})
+	}
+}
+
+// ─── Check ───────────────────────────────────────────────────────────────────
+
+func TestCheck(t *testing.T) {
+	engineer := &entities.Employee{UUID: engineerID, Role: "engineer", DepartmentUUID: deptID}
+	manager := &entities.Employee{UUID: managerID, Role: "manager", DepartmentUUID: deptID}
+
+	t.Run("allowed transition", func(t *testing.T) {
+		transition, err := Default().Check(assignedApp(), engineer, ActionSetStatus, "in_progress", "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if transition.Name != "start_work" {
+			t.Errorf("expected start_work, got %q", transition.Name)
+		}
+	})
+
+	t.Run("disabled target", func(t *testing.T) {
+		_, err := withoutOnHold().Check(assignedApp(), engineer, ActionSetStatus, "on_hold", "")
+		assertReason(t, err, ReasonDisabled)
+	})
+
+	t.Run("role not allowed", func(t *testing.T) {
+		_, err := Default().Check(assignedApp(), engineer, ActionRecall, "", "reason")
+		assertReason(t, err, ReasonRole)
+	})
+
+	t.Run("guard failed", func(t *testing.T) {
+		other := &entities.Employee{UUID: "other", Role: "engineer", DepartmentUUID: deptID}
+		_, err := Default().Check(assignedApp(), other, ActionSetStatus, "in_progress", "")
+		assertReason(t, err, ReasonGuard)
+	})
+
+	t.Run("manager guard without responsible manager", func(t *testing.T) {
+		app := assignedApp()
+		app.ManagedBy = ""
+		other := &entities.Employee{UUID: "other", Role: "manager", DepartmentUUID: deptID}
+		if _, err := Default().Check(app, other, ActionRecall, "", "reason"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		foreign := &entities.Employee{UUID: "foreign", Role: "manager", DepartmentUUID: "other-dept"}
+		_, err := Default().Check(app, foreign, ActionRecall, "", "reason")
+		assertReason(t, err, ReasonGuard)
+	})
+
+	t.Run("target not allowed for role", func(t *testing.T) {
+		app := assignedApp()
+		app.Status = "created"
+		_, err := Default().Check(app, manager, ActionSetStatus, "completed", "")
+		assertReason(t, err, ReasonTarget)
+	})
+
+	t.Run("invalid current status", func(t *testing.T) {
+		_, err := Default().Check(assignedApp(), manager, ActionAssign, "", "")
+		assertReason(t, err, ReasonStatus)
+	})
+
+	t.Run("message required", func(t *testing.T) {
+		_, err := Default().Check(assignedApp(), manager, ActionRecall, "", "")
+		assertReason(t, err, ReasonMessage)
+	})
+
+	t.Run("message provided", func(t *testing.T) {
+		if _, err := Default().Check(assignedApp(), manager, ActionRecall, "", "reason"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+// ─── Allowed ─────────────────────────────────────────────────────────────────
+
+func TestAllowed(t *testing.T) {
+	names := func(transitions []Transition) []string {
+		res := make([]string, 0, len(transitions))
+		for _, t := range transitions {
+			res = append(res, t.Name)
+		}
+		return res
+	}
+
+	cases := []struct {
+		name    string
+		machine *Machine
+		actor   *entities.Employee
+		want    []string
+	}{
+		{"executor", Default(), &entities.Employee{UUID: engineerID, Role: "engineer", DepartmentUUID: deptID}, []string{"start_work", "hold", "submit_verification"}},
+		{"executor without on_hold", withoutOnHold(), &entities.Employee{UUID: engineerID, Role: "engineer", DepartmentUUID: deptID}, []string{"start_work", "submit_verification"}},
+		{"responsible manager", Default(), &entities.Employee{UUID: managerID, Role: "manager", DepartmentUUID: deptID}, []string{"recall"}},
+		{"other engineer", Default(), &entities.Employee{UUID: "other", Role: "engineer", DepartmentUUID: deptID}, []string{}},
+		{"chief", Default(), &entities.Employee{UUID: "chief", Role: "chief"}, []string{}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := names(tc.machine.Allowed(assignedApp(), tc.actor))
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+// ─── RemovedStates ───────────────────────────────────────────────────────────
+
+func TestRemovedStates(t *testing.T) {
+	if got := Default().RemovedStates(withoutOnHold()); !slices.Equal(got, []string{"on_hold"}) {
+		t.Errorf("expected [on_hold], got %v", got)
+	}
+	if got := withoutOnHold().RemovedStates(Default()); len(got) != 0 {
+		t.Errorf("expected no removed states, got %v", got)
+	}
+}
@@ -2,10 +2,11 @@ package main
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"fmt"
 	"net"
-	"os/signal"
-	"syscall"
+	"strings"
+	"time"
 
 	grpcprom "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/rs/zerolog/log"
@@ -14,12 +15,15 @@ import (
 	redisDB "github.com/unwelcome/FrameWorkTask1/backend/auth/internal/database/redis"
 	"github.com/unwelcome/FrameWorkTask1/backend/auth/internal/messaging"
 	"github.com/unwelcome/FrameWorkTask1/backend/auth/internal/services"
+	"github.com/unwelcome/FrameWorkTask1/backend/auth/pkg/keyset"
 	"github.com/unwelcome/FrameWorkTask1/backend/auth/pkg/password"
 	"github.com/unwelcome/FrameWorkTask1/backend/auth/pkg/utils"
 	auth_proto "github.com/unwelcome/FrameWorkTask1/backend/contracts/auth/generated"
 	"github.com/unwelcome/FrameWorkTask1/backend/shared/interceptors"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/lifecycle"
 	"github.com/unwelcome/FrameWorkTask1/backend/shared/logger"
-	"github.com/unwelcome/FrameWorkTask1/backend/shared/metrics"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/mtls"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/tracing"
 	"google.golang.org/grpc"
 )
 
@@ -29,25 +33,37 @@ func main() {
 	loggerConf, httpLogger := logger.Setup(cfg.Log.Path, cfg.Log.ConsoleOut)
 	log.Logger = *loggerConf
 
-	// Ограничиваем одновременные вычисления Argon2 (защита от resource-exhaustion DoS)
-	password.Setup(cfg.Password.MaxConcurrentHashes, cfg.Password.AcquireTimeout)
+	// Остановка по SIGINT / SIGTERM: серверы, затем воркеры, затем соединения (см. lifecycle)
+	lc := lifecycle.New(cfg.ShutdownTimeout)
 
-	// Загружаем приватный ключ из PEM-файла
-	privateKey, err := utils.LoadPrivateKey(cfg.JWT.PrivateKeyPath)
+	// Трассировка: спаны экспортируются в OTLP коллектор, если задан OTEL_EXPORTER_OTLP_ENDPOINT
+	shutdownTracing, err := tracing.Setup(context.Background(), "auth_service", cfg.Tracing)
 	if err != nil {
-		log.Fatal().Err(err).Str("path", cfg.JWT.PrivateKeyPath).Msg("failed to load JWT private key")
+		log.Fatal().Err(err).Msg("failed to setup tracing")
 	}
+	lc.OnClose("tracing", shutdownTracing)
+
+	// Ограничиваем одновременные вычисления Argon2 (защита от resource-exhaustion DoS)
+	password.Setup(cfg.Password.MaxConcurrentHashes, cfg.Password.AcquireTimeout)
+
+	// Загружаем ключи подписи из PEM-файлов
+	keys := loadKeySet(cfg.JWT)
 
 	db := postgresDB.NewDatabaseInstance(cfg.Postgres.ConnectionString())
-	cache := redisDB.NewCacheInstance(cfg.Redis.Options(), cfg.JWT.RefreshTokenLifetime, cfg.Redis.Prefix)
-	rabbitMQ := messaging.NewPublisher(cfg.RabbitMQ.ConnectionString())
+	lc.OnClose("postgres", lifecycle.Closer(db.Close))
+	lc.ReadinessCheck("postgres", db.Ping)
 
-	// Контекст для graceful shutdown, отменяется по SIGINT / SIGTERM
-	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
-	defer stop()
+	cache := redisDB.NewCacheInstance(cfg.Redis.Options(), cfg.JWT.AccessTokenLifetime, cfg.JWT.RefreshTokenLifetime, cfg.Redis.Prefix)
+	lc.OnClose("redis", lifecycle.Closer(cache.Close))
+	lc.ReadinessCheck("redis", cache.Ping)
+
+	rabbitMQ := messaging.NewPublisher(cfg.RabbitMQ.ConnectionString())
+	lc.OnClose("rabbitMQ", lifecycle.Closer(rabbitMQ.Close))
 
 	// Фоновая горутина анонимизации удалённых аккаунтов
-	go services.StartCleanupWorker(ctx, db)
+	lc.Go("cleanup", func(ctx context.Context) {
+		services.StartCleanupWorker(ctx, db)
+	})
 
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Port))
 	if err != nil {
@@ -57,28 +73,88 @@ func main() {
 	// Включаем гистограмму latency (выключена по умолчанию из-за кардинальности)
 	grpcprom.EnableHandlingTimeHistogram()
 
+	// Auth сервис вызывает только gateway
+	creds, err := mtls.ServerCredentials(cfg.TLS)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to load tls credentials")
+	}
+
 	grpcServer := grpc.NewServer(
+		grpc.Creds(creds),
+		tracing.ServerOption(),
 		grpc.ChainUnaryInterceptor(
 			grpcprom.UnaryServerInterceptor,
 			interceptors.NewLoggingInterceptor(*httpLogger),
+			interceptors.NewIdentityInterceptor("gateway"),
+		),
+		grpc.ChainStreamInterceptor(
+			grpcprom.StreamServerInterceptor,
+			interceptors.NewIdentityStreamInterceptor("gateway"),
 		),
-		grpc.StreamInterceptor(grpcprom.StreamServerInterceptor),
 	)
 	auth_proto.RegisterAuthServiceServer(grpcServer, services.NewAuthService(
 		db, cache, rabbitMQ,
-		privateKey,
+		keys,
 		cfg.JWT.AccessTokenLifetime,
 		cfg.JWT.RefreshTokenLifetime,
 		cfg.AppEnv,
+		cfg.TOTPIssuer,
 	))
 
 	// Инициализируем метрики для всех зарегистрированных методов
 	grpcprom.Register(grpcServer)
 
-	metrics.StartServer(cfg.MetricsPort)
+	// /metrics, /livez и /readyz
+	lc.StartProbeServer(cfg.MetricsPort)
+
+	lc.Serve("grpc", func() error { return grpcServer.Serve(listener) }, lifecycle.GRPCShutdown(grpcServer))
+	lc.Ready()
 
 	log.Info().Int("port", cfg.Port).Msg("auth service started")
-	if err := grpcServer.Serve(listener); err != nil {
-		log.Fatal().Err(err).Msg("failed to serve grpc")
+	if err := lc.Wait(); err != nil {
+		log.Fatal().Err(err).Msg("auth service stopped with error")
+	}
+}
+
+// loadKeySet Собирает набор ключей подписи: активный, следующий и выведенные
+func loadKeySet(cfg config.JWTConfig) *keyset.KeySet {
+	active, err := utils.LoadPrivateKey(cfg.PrivateKeyPath)
+	if err != nil {
+		log.Fatal().Err(err).Str("path", cfg.PrivateKeyPath).Msg("failed to load JWT private key")
+	}
+
+	var next *ecdsa.PrivateKey
+	if cfg.NextKeyPath != "" {
+		if next, err = utils.LoadPrivateKey(cfg.NextKeyPath); err != nil {
+			log.Fatal().Err(err).Str("path", cfg.NextKeyPath).Msg("failed to load next JWT key")
+		}
 	}
+
+	// Выведенный ключ задаётся как <путь>@<RFC 3339 время вывода>: от этого времени gateway отсчитывает grace-период
+	retired := make([]keyset.Retired, 0, len(cfg.RetiredKeyPaths))
+	for _, entry := range cfg.RetiredKeyPaths {
+		sep := strings.LastIndex(entry, "@")
+		if sep < 0 {
+			log.Fatal().Str("entry", entry).Msg("retired JWT key must be set as <path>@<retired at, RFC 3339>")
+		}
+		path := entry[:sep]
+		retiredAt, parseErr := time.Parse(time.RFC3339, entry[sep+1:])
+		if parseErr != nil {
+			log.Fatal().Err(parseErr).Str("entry", entry).Msg("invalid retirement time of JWT key")
+		}
+		key, loadErr := utils.LoadPublicKey(path)
+		if loadErr != nil {
+			log.Fatal().Err(loadErr).Str("path", path).Msg("failed to load retired JWT key")
+		}
+		retired = append(retired, keyset.Retired{Public: key, At: retiredAt})
+	}
+
+	keys, err := keyset.New(active, next, retired)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid JWT key set")
+	}
+
+	kid, _ := keys.Active()
+	log.Info().Str("kid", kid).Int("retired", len(retired)).Bool("next", next != nil).Msg("JWT key set loaded")
+	return keys
 }
@@ -7,15 +7,19 @@ import (
 )
 
 type Config struct {
-	Port        int
-	MetricsPort int
-	AppEnv      string
-	Log         LogConfig
-	Postgres    sharedConfig.PostgresConfig
-	Redis       sharedConfig.RedisConfig
-	RabbitMQ    sharedConfig.RabbitMQConfig
-	JWT         JWTConfig
-	Password    PasswordConfig
+	Port            int
+	MetricsPort     int
+	ShutdownTimeout time.Duration // время на graceful shutdown, должно быть меньше stop_grace_period в docker-compose
+	AppEnv          string
+	Log             LogConfig
+	Postgres        sharedConfig.PostgresConfig
+	Redis           sharedConfig.RedisConfig
+	RabbitMQ        sharedConfig.RabbitMQConfig
+	TLS             sharedConfig.TLSConfig
+	Tracing         sharedConfig.TracingConfig
+	JWT             JWTConfig
+	Password        PasswordConfig
+	TOTPIssuer      string // Название сервиса в приложении-аутентификаторе
 }
 
 // PasswordConfig ограничивает одновременные вычисления Argon2 (защита от resource-exhaustion DoS).
@@ -30,17 +34,23 @@ type LogConfig struct {
 	ConsoleOut bool
 }
 
+// JWTConfig Ключи подписи токенов.
+// PrivateKeyPath — активный ключ; NextKeyPath — следующий (публикуется в JWKS до ротации);
+// RetiredKeyPaths — выведенные ключи, токены которых ещё проверяются, в виде <путь>@<время вывода RFC 3339>.
 type JWTConfig struct {
 	PrivateKeyPath       string
+	NextKeyPath          string
+	RetiredKeyPaths      []string
 	AccessTokenLifetime  time.Duration
 	RefreshTokenLifetime time.Duration
 }
 
 func NewConfig() *Config {
 	return &Config{
-		Port:        sharedConfig.MustParseInt("AUTH_SERVICE_PORT"),
-		MetricsPort: sharedConfig.ParseIntOrDefault("METRICS_PORT", 2112),
-		AppEnv:      sharedConfig.GetEnvOrDefault("APP_ENV", "production"),
+		Port:            sharedConfig.MustParseInt("AUTH_SERVICE_PORT"),
+		MetricsPort:     sharedConfig.ParseIntOrDefault("METRICS_PORT", 2112),
+		ShutdownTimeout: sharedConfig.ParseDurationOrDefault("SHUTDOWN_TIMEOUT", 25*time.Second),
+		AppEnv:          sharedConfig.GetEnvOrDefault("APP_ENV", "production"),
 		Log: LogConfig{
 			Path:       sharedConfig.MustGetEnv("LOG_PATH"),
 			ConsoleOut: sharedConfig.MustParseBool("LOG_CONSOLE_OUT"),
@@ -48,8 +58,12 @@ func NewConfig() *Config {
 		Postgres: sharedConfig.NewPostgresConfig(),
 		Redis:    sharedConfig.NewRedisConfig(),
 		RabbitMQ: sharedConfig.NewRabbitMQConfig(),
+		TLS:      sharedConfig.NewTLSConfig(),
+		Tracing:  sharedConfig.NewTracingConfig(),
 		JWT: JWTConfig{
 			PrivateKeyPath:       sharedConfig.MustGetEnv("JWT_PRIVATE_KEY_PATH"),
+			NextKeyPath:          sharedConfig.GetEnvOrDefault("JWT_NEXT_KEY_PATH", ""),
+			RetiredKeyPaths:      sharedConfig.ParseStringSliceOrDefault("JWT_RETIRED_KEY_PATHS", nil),
 			AccessTokenLifetime:  sharedConfig.MustParseDuration("ACCESS_TOKEN_LIFETIME"),
 			RefreshTokenLifetime: sharedConfig.MustParseDuration("REFRESH_TOKEN_LIFETIME"),
 		},
@@ -57,5 +71,6 @@ func NewConfig() *Config {
 			MaxConcurrentHashes: sharedConfig.ParseIntOrDefault("MAX_CONCURRENT_HASHES", 8),
 			AcquireTimeout:      sharedConfig.ParseDurationOrDefault("HASH_ACQUIRE_TIMEOUT", 3*time.Second),
 		},
+		TOTPIssuer: sharedConfig.GetEnvOrDefault("TOTP_ISSUER", "FrameWork"),
 	}
 }
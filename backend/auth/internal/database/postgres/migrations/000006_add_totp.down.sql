@@ -0,0 +1,5 @@
+DROP TABLE two_factor_recovery_codes;
+
+ALTER TABLE users
+    DROP COLUMN totp_secret,
+    DROP COLUMN two_factor_method;
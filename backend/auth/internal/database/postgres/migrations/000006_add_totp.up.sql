@@ -0,0 +1,13 @@
+ALTER TABLE users
+    ADD COLUMN two_factor_method VARCHAR(10) NOT NULL DEFAULT 'email',
+    ADD COLUMN totp_secret       VARCHAR(64);
+
+CREATE TABLE two_factor_recovery_codes (
+    uuid       UUID         PRIMARY KEY,
+    user_uuid  UUID         NOT NULL REFERENCES users (uuid) ON DELETE CASCADE,
+    code_hash  VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    used_at    TIMESTAMPTZ
+);
+
+CREATE INDEX two_factor_recovery_codes_user_uuid_idx ON two_factor_recovery_codes (user_uuid) WHERE used_at IS NULL;
@@ -17,14 +17,20 @@ import (
 var migrationsFS embed.FS
 
 type DatabaseRepository struct {
-	User UserRepository
-	db   *sql.DB
+	User      UserRepository
+	TwoFactor TwoFactorRepository
+	db        *sql.DB
 }
 
 func (r *DatabaseRepository) Ping(ctx context.Context) error {
 	return r.db.PingContext(ctx)
 }
 
+// Close Закрывает пул соединений. Новые запросы отклоняются, начатые запросы и транзакции дожидаются завершения.
+func (r *DatabaseRepository) Close() error {
+	return r.db.Close()
+}
+
 func NewDatabaseInstance(connectString string) *DatabaseRepository {
 	db := sharedPostgres.Connect(connectString)
 
@@ -50,7 +56,8 @@ func NewDatabaseInstance(connectString string) *DatabaseRepository {
 	log.Info().Msg("migrations applied successfully")
 
 	return &DatabaseRepository{
-		User: NewUserRepository(db),
-		db:   db,
+		User:      NewUserRepository(db),
+		TwoFactor: NewTwoFactorRepository(db),
+		db:        db,
 	}
 }
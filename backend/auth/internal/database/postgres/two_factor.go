@@ -0,0 +1,116 @@
+package postgresDB
+
+import (
+	"database/sql"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/auth/internal/entities"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+type TwoFactorRepository interface {
+	EnableTOTP(ctx context.Context, dto entities.EnableTOTPDTO) Error.CodeError
+	GetRecoveryCodes(ctx context.Context, dto entities.GetRecoveryCodesDTO) ([]*entities.RecoveryCode, Error.CodeError)
+	UseRecoveryCode(ctx context.Context, dto entities.UseRecoveryCodeDTO) Error.CodeError
+}
+
+type twoFactorRepository struct {
+	db *sql.DB
+}
+
+func NewTwoFactorRepository(db *sql.DB) TwoFactorRepository {
+	return &twoFactorRepository{db: db}
+}
+
+// EnableTOTP Сохраняет подтверждённый TOTP секрет, делает TOTP основным способом 2FA и заменяет коды восстановления
+func (r *twoFactorRepository) EnableTOTP(ctx context.Context, dto entities.EnableTOTPDTO) Error.CodeError {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	defer tx.Rollback()
+
+	// Включаем TOTP
+	res, err := tx.ExecContext(ctx,
+		`UPDATE users SET totp_secret = $2, two_factor_method = 'totp', two_factor_enabled = true WHERE uuid = $1 AND deleted_at IS NULL`,
+		dto.UserUUID, dto.Secret,
+	)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return Error.Internal(err)
+	}
+	if rowsAffected == 0 {
+		return Error.Public(codes.NotFound, "user not found")
+	}
+
+	// Старые коды восстановления теряют силу вместе со старым секретом
+	if _, err = tx.ExecContext(ctx, `DELETE FROM two_factor_recovery_codes WHERE user_uuid = $1`, dto.UserUUID); err != nil {
+		return Error.Internal(err)
+	}
+
+	for _, code := range dto.RecoveryCodes {
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO two_factor_recovery_codes (uuid, user_uuid, code_hash) VALUES ($1, $2, $3)`,
+			code.UUID, dto.UserUUID, code.CodeHash,
+		)
+		if err != nil {
+			return Error.Internal(err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return Error.Internal(err)
+	}
+
+	return Error.CodeError{}
+}
+
+// GetRecoveryCodes Возвращает неиспользованные коды восстановления пользователя
+func (r *twoFactorRepository) GetRecoveryCodes(ctx context.Context, dto entities.GetRecoveryCodesDTO) ([]*entities.RecoveryCode, Error.CodeError) {
+	query := `SELECT uuid, code_hash FROM two_factor_recovery_codes WHERE user_uuid = $1 AND used_at IS NULL;`
+
+	rows, err := r.db.QueryContext(ctx, query, dto.UserUUID)
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	defer rows.Close()
+
+	recoveryCodes := make([]*entities.RecoveryCode, 0)
+	for rows.Next() {
+		code := &entities.RecoveryCode{}
+		if err = rows.Scan(&code.UUID, &code.CodeHash); err != nil {
+			return nil, Error.Internal(err)
+		}
+		recoveryCodes = append(recoveryCodes, code)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, Error.Internal(err)
+	}
+
+	return recoveryCodes, Error.CodeError{}
+}
+
+// UseRecoveryCode Помечает код восстановления использованным. Повторно код не принимается.
+func (r *twoFactorRepository) UseRecoveryCode(ctx context.Context, dto entities.UseRecoveryCodeDTO) Error.CodeError {
+	query := `UPDATE two_factor_recovery_codes SET used_at = NOW() WHERE uuid = $1 AND used_at IS NULL;`
+
+	res, err := r.db.ExecContext(ctx, query, dto.UUID)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	if rowsAffected == 0 {
+		return Error.Public(codes.NotFound, "recovery code not found")
+	}
+	return Error.CodeError{}
+}
@@ -52,7 +52,7 @@ func (r *userRepository) CreateUser(ctx context.Context, dto entities.User) Erro
 
 // GetUserByEmail Возвращает частичные данные пользователя по его email
 func (r *userRepository) GetUserByEmail(ctx context.Context, dto entities.GetUserByEmailDTO) (*entities.UserGetByEmail, Error.CodeError) {
-	query := `SELECT uuid, password_hash, first_name, is_verified, two_factor_enabled, deleted_at FROM users WHERE email = $1;`
+	query := `SELECT uuid, password_hash, first_name, is_verified, two_factor_enabled, two_factor_method, deleted_at FROM users WHERE email = $1;`
 
 	userGetByEmail := &entities.UserGetByEmail{Email: dto.Email}
 	var passwordHash, firstName sql.NullString
@@ -61,7 +61,7 @@ func (r *userRepository) GetUserByEmail(ctx context.Context, dto entities.GetUse
 	err := r.db.QueryRowContext(ctx, query, dto.Email).Scan(
 		&userGetByEmail.UserUUID,
 		&passwordHash, &firstName,
-		&userGetByEmail.IsVerified, &userGetByEmail.Enabled2FA,
+		&userGetByEmail.IsVerified, &userGetByEmail.Enabled2FA, &userGetByEmail.TwoFAMethod,
 		&deletedAt,
 	)
 	if err != nil {
@@ -82,15 +82,15 @@ func (r *userRepository) GetUserByEmail(ctx context.Context, dto entities.GetUse
 
 // GetUser Возвращает данные пользователя по его uuid
 func (r *userRepository) GetUser(ctx context.Context, dto entities.GetUserDTO) (*entities.UserGet, Error.CodeError) {
-	query := `SELECT email, password_hash, first_name, last_name, patronymic, description, created_at, is_verified, two_factor_enabled, deleted_at FROM users WHERE uuid = $1;`
+	query := `SELECT email, password_hash, first_name, last_name, patronymic, description, created_at, is_verified, two_factor_enabled, two_factor_method, totp_secret, deleted_at FROM users WHERE uuid = $1;`
 
 	userGet := &entities.UserGet{UserUUID: dto.UserUUID}
-	var email, passwordHash, firstName, lastName, patronymic, description sql.NullString
+	var email, passwordHash, firstName, lastName, patronymic, description, totpSecret sql.NullString
 	var deletedAt sql.NullTime
 
 	err := r.db.QueryRowContext(ctx, query, dto.UserUUID).Scan(
 		&email, &passwordHash, &firstName, &lastName, &patronymic, &description,
-		&userGet.CreatedAt, &userGet.IsVerified, &userGet.Enabled2FA,
+		&userGet.CreatedAt, &userGet.IsVerified, &userGet.Enabled2FA, &userGet.TwoFAMethod, &totpSecret,
 		&deletedAt,
 	)
 	if err != nil {
@@ -106,6 +106,7 @@ func (r *userRepository) GetUser(ctx context.Context, dto entities.GetUserDTO) (
 	userGet.LastName = lastName.String
 	userGet.Patronymic = patronymic.String
 	userGet.Description = description.String
+	userGet.TOTPSecret = totpSecret.String
 	if deletedAt.Valid {
 		t := deletedAt.Time
 		userGet.DeletedAt = &t
@@ -153,11 +154,11 @@ func (r *userRepository) UpdateUserBio(ctx context.Context, dto entities.UserUpd
 	return Error.CodeError{}
 }
 
-// UpdateUser2FA Обновляет двухфакторную авторизацию пользователя
+// UpdateUser2FA Обновляет двухфакторную авторизацию пользователя. Пустой способ оставляет текущий.
 func (r *userRepository) UpdateUser2FA(ctx context.Context, dto entities.UpdateUser2FADTO) Error.CodeError {
-	query := `UPDATE users SET two_factor_enabled = $2 WHERE uuid = $1;`
+	query := `UPDATE users SET two_factor_enabled = $2, two_factor_method = COALESCE(NULLIF($3, ''), two_factor_method) WHERE uuid = $1;`
 
-	res, err := r.db.ExecContext(ctx, query, dto.UserUUID, dto.TwoFAEnabled)
+	res, err := r.db.ExecContext(ctx, query, dto.UserUUID, dto.TwoFAEnabled, dto.TwoFAMethod)
 	if err != nil {
 		return Error.Internal(err)
 	}
@@ -242,7 +243,9 @@ func (r *userRepository) AnonymizeExpiredUsers(ctx context.Context, before time.
 			first_name         = NULL,
 			last_name          = NULL,
 			patronymic         = NULL,
-			two_factor_enabled = false
+			two_factor_enabled = false,
+			two_factor_method  = 'email',
+			totp_secret        = NULL
 		WHERE deleted_at IS NOT NULL
 		  AND deleted_at < $1
 		  AND email IS NOT NULL;`
@@ -15,17 +15,30 @@ import (
 
 const (
 	twoFACodeTTL = 15 * time.Minute
+
+	// pendingTOTPTTL — время на подтверждение подключения аутентификатора
+	pendingTOTPTTL = 15 * time.Minute
+
+	// totpStepTTL — время жизни отметки об использованном TOTP коде: код валиден в пределах ±1 шага
+	totpStepTTL = 2 * time.Minute
 )
 
 type TwoFARepository interface {
 	Save2FAData(ctx context.Context, dto entities.Save2FADataDTO) Error.CodeError
 	Get2FAData(ctx context.Context, dto entities.Get2FADataDTO) (*entities.TwoFAData, Error.CodeError)
 	Delete2FAData(ctx context.Context, dto entities.Delete2FADataDTO) Error.CodeError
+	// Update2FACode заменяет email код в данных сессии, не продлевая её и не сбрасывая счётчик попыток.
+	Update2FACode(ctx context.Context, dto entities.Update2FACodeDTO) Error.CodeError
 	Incr2FAAttempts(ctx context.Context, dto entities.Incr2FAAttemptsDTO) (int64, Error.CodeError)
 	// Acquire2FAEmailCooldown устанавливает cooldown-ключ (SetNX). Возвращает true, если разрешено отправить письмо.
 	Acquire2FAEmailCooldown(ctx context.Context, dto entities.Acquire2FAEmailCooldownDTO) (bool, Error.CodeError)
 	// Incr2FAEmailDailyCount увеличивает суточный счётчик отправок 2FA писем и возвращает новое значение.
 	Incr2FAEmailDailyCount(ctx context.Context, dto entities.Incr2FAEmailDailyCountDTO) (int64, Error.CodeError)
+	SavePendingTOTP(ctx context.Context, dto entities.SavePendingTOTPDTO) Error.CodeError
+	GetPendingTOTP(ctx context.Context, dto entities.GetPendingTOTPDTO) (string, Error.CodeError)
+	DeletePendingTOTP(ctx context.Context, dto entities.DeletePendingTOTPDTO) Error.CodeError
+	// AcquireTOTPStep помечает шаг TOTP использованным (SetNX). Возвращает false, если код этого шага уже принимался.
+	AcquireTOTPStep(ctx context.Context, dto entities.AcquireTOTPStepDTO) (bool, Error.CodeError)
 }
 
 type twoFARepository struct {
@@ -48,6 +61,7 @@ func (r *twoFARepository) Save2FAData(ctx context.Context, dto entities.Save2FAD
 		UserUUID:  dto.UserUUID,
 		Email:     dto.Email,
 		FirstName: dto.FirstName,
+		Method:    dto.Method,
 		Code:      dto.Code,
 	})
 	if err != nil {
@@ -94,6 +108,30 @@ func (r *twoFARepository) Delete2FAData(ctx context.Context, dto entities.Delete
 	return Error.CodeError{}
 }
 
+// Update2FACode Заменяет email код в данных сессии авторизации, сохраняя её TTL
+func (r *twoFARepository) Update2FACode(ctx context.Context, dto entities.Update2FACodeDTO) Error.CodeError {
+	data, getErr := r.Get2FAData(ctx, entities.Get2FADataDTO{SessionUUID: dto.SessionUUID})
+	if getErr.Code != 0 {
+		return getErr
+	}
+	data.Code = dto.Code
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	// XX: сессия могла истечь между чтением и записью - не воскрешаем её
+	updated, err := r.redis.SetArgs(ctx, r.get2FADataKey(dto.SessionUUID), body, redis.SetArgs{KeepTTL: true, Mode: "XX"}).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return Error.Internal(err)
+	}
+	if updated != "OK" {
+		return Error.Public(codes.NotFound, "2FA code not found")
+	}
+	return Error.CodeError{}
+}
+
 // Incr2FAAttempts Увеличивает счетчик попыток 2FA авторизации и возвращает текущее значение
 func (r *twoFARepository) Incr2FAAttempts(ctx context.Context, dto entities.Incr2FAAttemptsDTO) (int64, Error.CodeError) {
 	count, err := r.redis.Incr(ctx, r.get2FAAttemptsKey(dto.SessionUUID)).Result()
@@ -114,6 +152,43 @@ func (r *twoFARepository) Incr2FAEmailDailyCount(ctx context.Context, dto entiti
 	return r.emailLimiter.incrDailyCount(ctx, dto.UserUUID)
 }
 
+// SavePendingTOTP Сохраняет секрет аутентификатора до подтверждения подключения
+func (r *twoFARepository) SavePendingTOTP(ctx context.Context, dto entities.SavePendingTOTPDTO) Error.CodeError {
+	if err := r.redis.Set(ctx, r.getPendingTOTPKey(dto.UserUUID), dto.Secret, pendingTOTPTTL).Err(); err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// GetPendingTOTP Возвращает неподтверждённый секрет аутентификатора
+func (r *twoFARepository) GetPendingTOTP(ctx context.Context, dto entities.GetPendingTOTPDTO) (string, Error.CodeError) {
+	secret, err := r.redis.Get(ctx, r.getPendingTOTPKey(dto.UserUUID)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", Error.Public(codes.NotFound, "TOTP enrollment not found or expired")
+		}
+		return "", Error.Internal(err)
+	}
+	return secret, Error.CodeError{}
+}
+
+// DeletePendingTOTP Удаляет неподтверждённый секрет аутентификатора
+func (r *twoFARepository) DeletePendingTOTP(ctx context.Context, dto entities.DeletePendingTOTPDTO) Error.CodeError {
+	if err := r.redis.Del(ctx, r.getPendingTOTPKey(dto.UserUUID)).Err(); err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// AcquireTOTPStep Помечает шаг TOTP пользователя использованным. Возвращает true, если код этого шага ещё не принимался.
+func (r *twoFARepository) AcquireTOTPStep(ctx context.Context, dto entities.AcquireTOTPStepDTO) (bool, Error.CodeError) {
+	ok, err := r.redis.SetNX(ctx, r.getTOTPStepKey(dto.UserUUID, dto.Step), 1, totpStepTTL).Result()
+	if err != nil {
+		return false, Error.Internal(err)
+	}
+	return ok, Error.CodeError{}
+}
+
 // ─── Вспомогательные функции ──────────────────────────────────────────────────
 
 func (r *twoFARepository) get2FADataKey(sessionUUID string) string {
@@ -124,3 +199,10 @@ func (r *twoFARepository) get2FAAttemptsKey(sessionUUID string) string {
 	return fmt.Sprintf("%s:2fa:%s:attempts", r.prefix, sessionUUID)
 }
 
+func (r *twoFARepository) getPendingTOTPKey(userUUID string) string {
+	return fmt.Sprintf("%s:totp:%s:pending", r.prefix, userUUID)
+}
+
+func (r *twoFARepository) getTOTPStepKey(userUUID string, step int64) string {
+	return fmt.Sprintf("%s:totp:%s:step:%d", r.prefix, userUUID, step)
+}
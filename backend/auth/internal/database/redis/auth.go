@@ -10,6 +10,7 @@ import (
 	"github.com/redis/go-redis/v9"
 	"github.com/unwelcome/FrameWorkTask1/backend/auth/internal/entities"
 	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/revocation"
 	"google.golang.org/grpc/codes"
 )
 
@@ -23,7 +24,7 @@ var errSessionNotFound = errors.New("session not found")
 type AuthRepository interface {
 	SaveSession(ctx context.Context, dto entities.SaveSessionDTO) Error.CodeError
 	GetAllSessions(ctx context.Context, dto entities.GetAllSessionsDTO) ([]entities.SessionEntry, Error.CodeError)
-	CheckSessionExists(ctx context.Context, dto entities.CheckSessionExistsDTO) Error.CodeError
+	CheckSessionExists(ctx context.Context, dto entities.CheckSessionExistsDTO) (string, Error.CodeError)
 	RevokeSession(ctx context.Context, dto entities.RevokeSessionDTO) Error.CodeError
 	RevokeAllSessions(ctx context.Context, dto entities.RevokeAllSessionsDTO) Error.CodeError
 	RefreshToken(ctx context.Context, dto entities.RefreshTokenDTO) Error.CodeError
@@ -31,12 +32,13 @@ type AuthRepository interface {
 
 type authRepository struct {
 	redis           *redis.Client
+	accessTokenTTL  time.Duration
 	refreshTokenTTL time.Duration
 	prefix          string
 }
 
-func NewAuthRepository(redis *redis.Client, refreshTokenTTL time.Duration, prefix string) AuthRepository {
-	return &authRepository{redis: redis, refreshTokenTTL: refreshTokenTTL, prefix: prefix}
+func NewAuthRepository(redis *redis.Client, accessTokenTTL, refreshTokenTTL time.Duration, prefix string) AuthRepository {
+	return &authRepository{redis: redis, accessTokenTTL: accessTokenTTL, refreshTokenTTL: refreshTokenTTL, prefix: prefix}
 }
 
 // SaveSession сохраняет новую сессию, индексированную по sessionUUID.
@@ -110,29 +112,29 @@ func (r *authRepository) GetAllSessions(ctx context.Context, dto entities.GetAll
 	return activeEntries, Error.CodeError{}
 }
 
-// CheckSessionExists проверяет наличие сессии
-func (r *authRepository) CheckSessionExists(ctx context.Context, dto entities.CheckSessionExistsDTO) Error.CodeError {
+// CheckSessionExists проверяет наличие сессии и возвращает её UUID
+func (r *authRepository) CheckSessionExists(ctx context.Context, dto entities.CheckSessionExistsDTO) (string, Error.CodeError) {
 	tokenIndexKey := r.getTokenIndexKey(dto.HashedToken)
 
 	sessionUUID, err := r.redis.Get(ctx, tokenIndexKey).Result()
 	if errors.Is(err, redis.Nil) || sessionUUID == "" {
-		return Error.Public(codes.NotFound, "session not found")
+		return "", Error.Public(codes.NotFound, "session not found")
 	}
 	if err != nil {
-		return Error.Internal(err)
+		return "", Error.Internal(err)
 	}
 
 	// Verify the session HSET still exists (could have been revoked concurrently)
 	exists, err := r.redis.Exists(ctx, r.getSessionKey(sessionUUID)).Result()
 	if err != nil {
-		return Error.Internal(err)
+		return "", Error.Internal(err)
 	}
 	if exists == 0 {
 		_ = r.redis.Del(ctx, tokenIndexKey).Err()
-		return Error.Public(codes.NotFound, "session not found")
+		return "", Error.Public(codes.NotFound, "session not found")
 	}
 
-	return Error.CodeError{}
+	return sessionUUID, Error.CodeError{}
 }
 
 // RevokeSession отзывает конкретную сессию по SessionUUID, проверяя принадлежность пользователю
@@ -165,6 +167,8 @@ func (r *authRepository) RevokeSession(ctx context.Context, dto entities.RevokeS
 
 	pipeline := r.redis.Pipeline()
 	pipeline.SRem(ctx, userSessionsKey, dto.SessionUUID)
+	// Access токены сессии перестают приниматься gateway сразу
+	pipeline.Set(ctx, revocation.SessionKey(r.prefix, dto.SessionUUID), 1, r.accessTokenTTL)
 	if currentHash != "" {
 		pipeline.Del(ctx, r.getTokenIndexKey(currentHash))
 	}
@@ -175,10 +179,15 @@ func (r *authRepository) RevokeSession(ctx context.Context, dto entities.RevokeS
 	return Error.CodeError{}
 }
 
-// RevokeAllSessions отзывает все сессии пользователя
+// RevokeAllSessions отзывает все сессии пользователя.
+// Эпоха пользователя сдвигается даже при отсутствии сессий - выпущенные ранее access токены перестают приниматься.
 func (r *authRepository) RevokeAllSessions(ctx context.Context, dto entities.RevokeAllSessionsDTO) Error.CodeError {
 	userSessionsKey := r.getUserSessionsKey(dto.UserUUID)
 
+	if err := r.redis.Set(ctx, revocation.UserKey(r.prefix, dto.UserUUID), time.Now().Unix(), r.accessTokenTTL).Err(); err != nil {
+		return Error.Internal(err)
+	}
+
 	sessionUUIDs, err := r.redis.SMembers(ctx, userSessionsKey).Result()
 	if err != nil {
 		return Error.Internal(err)
@@ -191,6 +200,8 @@ func (r *authRepository) RevokeAllSessions(ctx context.Context, dto entities.Rev
 	pipeline := r.redis.Pipeline()
 	for _, sid := range sessionUUIDs {
 		pipeline.Del(ctx, r.getSessionKey(sid))
+		// Эпоха хранится с точностью до секунды - токены, выпущенные в ту же секунду, отзываем по сессии
+		pipeline.Set(ctx, revocation.SessionKey(r.prefix, sid), 1, r.accessTokenTTL)
 	}
 	pipeline.Del(ctx, userSessionsKey)
 	if _, err := pipeline.Exec(ctx); err != nil {
@@ -230,11 +241,12 @@ func (r *authRepository) RefreshToken(ctx context.Context, dto entities.RefreshT
 		// Детектируем повторное использование токена
 		if fields["current_hash"] != dto.OldHashToken {
 			// Токен уже был ротирован — атакующий пытается использовать старый токен.
-			// Отзываем скомпрометированную сессию.
+			// Отзываем скомпрометированную сессию вместе с её access токенами.
 			_, _ = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
 				pipe.Del(ctx, sessionKey)
 				pipe.SRem(ctx, userSessionsKey, sessionUUID)
 				pipe.Del(ctx, tokenIndexKey)
+				pipe.Set(ctx, revocation.SessionKey(r.prefix, sessionUUID), 1, r.accessTokenTTL)
 				return nil
 			})
 			return entities.ErrTokenReuse
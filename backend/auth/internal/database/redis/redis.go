@@ -20,11 +20,15 @@ func (r *CacheRepository) Ping(ctx context.Context) error {
 	return r.rdb.Ping(ctx).Err()
 }
 
-func NewCacheInstance(connectOptions *redis.Options, refreshTokenTTL time.Duration, prefix string) *CacheRepository {
+func (r *CacheRepository) Close() error {
+	return r.rdb.Close()
+}
+
+func NewCacheInstance(connectOptions *redis.Options, accessTokenTTL, refreshTokenTTL time.Duration, prefix string) *CacheRepository {
 	rdb := sharedRedis.Connect(connectOptions)
 
 	return &CacheRepository{
-		Auth:         NewAuthRepository(rdb, refreshTokenTTL, prefix),
+		Auth:         NewAuthRepository(rdb, accessTokenTTL, refreshTokenTTL, prefix),
 		Verification: NewVerificationRepository(rdb, prefix),
 		Recovery:     NewRecoveryRepository(rdb, prefix),
 		TwoFA:        NewTwoFARepository(rdb, prefix),
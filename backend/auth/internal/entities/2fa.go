@@ -1,10 +1,23 @@
 package entities
 
+// Способы второго фактора
+const (
+	TwoFAMethodEmail    = "email"
+	TwoFAMethodTOTP     = "totp"
+	TwoFAMethodRecovery = "recovery"
+)
+
 type Save2FADataDTO struct {
 	SessionUUID string
 	UserUUID    string
 	Email       string
 	FirstName   string
+	Method      string
+	Code        string // Пустая строка, если письмо с кодом ещё не отправлялось
+}
+
+type Update2FACodeDTO struct {
+	SessionUUID string
 	Code        string
 }
 
@@ -28,9 +41,48 @@ type Incr2FAEmailDailyCountDTO struct {
 	UserUUID string
 }
 
+type SavePendingTOTPDTO struct {
+	UserUUID string
+	Secret   string
+}
+
+type GetPendingTOTPDTO struct {
+	UserUUID string
+}
+
+type DeletePendingTOTPDTO struct {
+	UserUUID string
+}
+
+type AcquireTOTPStepDTO struct {
+	UserUUID string
+	Step     int64
+}
+
+type EnableTOTPDTO struct {
+	UserUUID      string
+	Secret        string
+	RecoveryCodes []RecoveryCode
+}
+
+type GetRecoveryCodesDTO struct {
+	UserUUID string
+}
+
+type UseRecoveryCodeDTO struct {
+	UUID string
+}
+
 type TwoFAData struct {
 	UserUUID  string `json:"user_uuid"`
 	Email     string `json:"email"`
 	FirstName string `json:"first_name"`
+	Method    string `json:"method"`
 	Code      string `json:"code"`
 }
+
+// RecoveryCode Одноразовый код восстановления 2FA, хранится только хеш
+type RecoveryCode struct {
+	UUID     string `db:"uuid"`
+	CodeHash string `db:"code_hash"`
+}
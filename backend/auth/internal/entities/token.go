@@ -17,8 +17,9 @@ type TokenPair struct {
 }
 
 type TokenClaims struct {
-	UserUUID  string `json:"user_uuid"`
-	TokenType string `json:"token_type"`
+	UserUUID    string `json:"user_uuid"`
+	SessionUUID string `json:"sid"` // сессия, к которой привязан токен, - по ней gateway проверяет отзыв
+	TokenType   string `json:"token_type"`
 	jwt.RegisteredClaims
 }
 
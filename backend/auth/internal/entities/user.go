@@ -23,6 +23,8 @@ type UserGet struct {
 	Description  string     `db:"description"`
 	CreatedAt    string     `db:"created_at"`
 	Enabled2FA   bool       `db:"two_factor_enabled"`
+	TwoFAMethod  string     `db:"two_factor_method"`
+	TOTPSecret   string     `db:"totp_secret"` // Пустая строка если TOTP не подключён
 	IsVerified   bool       `db:"is_verified"`
 	DeletedAt    *time.Time `db:"deleted_at"` // nil если аккаунт активен
 }
@@ -33,6 +35,7 @@ type UserGetByEmail struct {
 	PasswordHash string     `db:"password_hash"`
 	FirstName    string     `db:"first_name"`
 	Enabled2FA   bool       `db:"two_factor_enabled"`
+	TwoFAMethod  string     `db:"two_factor_method"`
 	IsVerified   bool       `db:"is_verified"`
 	DeletedAt    *time.Time `db:"deleted_at"` // nil если аккаунт активен
 }
@@ -73,4 +76,5 @@ type SetUserVerifiedDTO struct {
 type UpdateUser2FADTO struct {
 	UserUUID     string
 	TwoFAEnabled bool
+	TwoFAMethod  string // Пустая строка - способ не меняется
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/unwelcome/FrameWorkTask1/backend/auth/internal/entities"
 	"github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
 	"github.com/unwelcome/FrameWorkTask1/backend/shared/rabbitMQ"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/tracing"
 )
 
 type Publisher interface {
@@ -20,9 +21,11 @@ type Publisher interface {
 	SendRegistrationAttemptEmail(ctx context.Context, dto entities.RegistrationAttemptEmailMsg) errors.CodeError
 	SendLoginNotificationEmail(ctx context.Context, dto entities.LoginNotificationEmailMsg) errors.CodeError
 	SendTokenReuseAlertEmail(ctx context.Context, dto entities.TokenReuseAlertEmailMsg) errors.CodeError
+	Close() error
 }
 
 type publisher struct {
+	conn                          *amqp.Connection
 	ch                            *amqp.Channel
 	emailVerificationQueue        amqp.Queue
 	emailRecoveryQueue            amqp.Queue
@@ -36,7 +39,7 @@ type publisher struct {
 
 func NewPublisher(connectString string) Publisher {
 	// Подключение к rabbitMQ
-	ch := rabbitMQ.Connect(connectString)
+	conn, ch := rabbitMQ.Connect(connectString)
 
 	// Создание очереди для email верификации (идемпотентно)
 	emailVerificationQueue, err := ch.QueueDeclare(
@@ -159,6 +162,7 @@ func NewPublisher(connectString string) Publisher {
 	}
 
 	return &publisher{
+		conn:                          conn,
 		ch:                            ch,
 		emailVerificationQueue:        emailVerificationQueue,
 		emailRecoveryQueue:            emailRecoveryQueue,
@@ -178,16 +182,7 @@ func (p *publisher) SendVerificationEmail(ctx context.Context, dto entities.Veri
 		return errors.Internal(err)
 	}
 
-	err = p.ch.PublishWithContext(ctx,
-		"",                            // exchange
-		p.emailVerificationQueue.Name, // routing key
-		false,                         // mandatory
-		false,                         // immediate
-		amqp.Publishing{
-			ContentType:  "application/json",
-			DeliveryMode: amqp.Persistent,
-			Body:         body,
-		})
+	err = p.publish(ctx, p.emailVerificationQueue.Name, body)
 	if err != nil {
 		return errors.Internal(err)
 	}
@@ -202,16 +197,7 @@ func (p *publisher) SendRecoveryEmail(ctx context.Context, dto entities.Recovery
 		return errors.Internal(err)
 	}
 
-	err = p.ch.PublishWithContext(ctx,
-		"",                        // exchange
-		p.emailRecoveryQueue.Name, // routing key
-		false,                     // mandatory
-		false,                     // immediate
-		amqp.Publishing{
-			ContentType:  "application/json",
-			DeliveryMode: amqp.Persistent,
-			Body:         body,
-		})
+	err = p.publish(ctx, p.emailRecoveryQueue.Name, body)
 	if err != nil {
 		return errors.Internal(err)
 	}
@@ -226,16 +212,7 @@ func (p *publisher) SendPasswordChangedEmail(ctx context.Context, dto entities.P
 		return errors.Internal(err)
 	}
 
-	err = p.ch.PublishWithContext(ctx,
-		"",
-		p.emailPasswordChangedQueue.Name,
-		false,
-		false,
-		amqp.Publishing{
-			ContentType:  "application/json",
-			DeliveryMode: amqp.Persistent,
-			Body:         body,
-		})
+	err = p.publish(ctx, p.emailPasswordChangedQueue.Name, body)
 	if err != nil {
 		return errors.Internal(err)
 	}
@@ -249,16 +226,7 @@ func (p *publisher) SendPasswordResetEmail(ctx context.Context, dto entities.Pas
 		return errors.Internal(err)
 	}
 
-	err = p.ch.PublishWithContext(ctx,
-		"",
-		p.emailPasswordResetQueue.Name,
-		false,
-		false,
-		amqp.Publishing{
-			ContentType:  "application/json",
-			DeliveryMode: amqp.Persistent,
-			Body:         body,
-		})
+	err = p.publish(ctx, p.emailPasswordResetQueue.Name, body)
 	if err != nil {
 		return errors.Internal(err)
 	}
@@ -272,16 +240,7 @@ func (p *publisher) SendRegistrationAttemptEmail(ctx context.Context, dto entiti
 		return errors.Internal(err)
 	}
 
-	err = p.ch.PublishWithContext(ctx,
-		"",
-		p.emailRegistrationAttemptQueue.Name,
-		false,
-		false,
-		amqp.Publishing{
-			ContentType:  "application/json",
-			DeliveryMode: amqp.Persistent,
-			Body:         body,
-		})
+	err = p.publish(ctx, p.emailRegistrationAttemptQueue.Name, body)
 	if err != nil {
 		return errors.Internal(err)
 	}
@@ -295,16 +254,7 @@ func (p *publisher) SendLoginNotificationEmail(ctx context.Context, dto entities
 		return errors.Internal(err)
 	}
 
-	err = p.ch.PublishWithContext(ctx,
-		"",
-		p.emailLoginNotificationQueue.Name,
-		false,
-		false,
-		amqp.Publishing{
-			ContentType:  "application/json",
-			DeliveryMode: amqp.Persistent,
-			Body:         body,
-		})
+	err = p.publish(ctx, p.emailLoginNotificationQueue.Name, body)
 	if err != nil {
 		return errors.Internal(err)
 	}
@@ -318,16 +268,7 @@ func (p *publisher) SendTokenReuseAlertEmail(ctx context.Context, dto entities.T
 		return errors.Internal(err)
 	}
 
-	err = p.ch.PublishWithContext(ctx,
-		"",
-		p.emailTokenReuseAlertQueue.Name,
-		false,
-		false,
-		amqp.Publishing{
-			ContentType:  "application/json",
-			DeliveryMode: amqp.Persistent,
-			Body:         body,
-		})
+	err = p.publish(ctx, p.emailTokenReuseAlertQueue.Name, body)
 	if err != nil {
 		return errors.Internal(err)
 	}
@@ -341,18 +282,44 @@ func (p *publisher) Send2FAEmail(ctx context.Context, dto entities.TwoFAEmailMsg
 		return errors.Internal(err)
 	}
 
-	err = p.ch.PublishWithContext(ctx,
-		"",
-		p.email2FAQueue.Name,
-		false,
-		false,
-		amqp.Publishing{
-			ContentType:  "application/json",
-			DeliveryMode: amqp.Persistent,
-			Body:         body,
-		})
+	err = p.publish(ctx, p.email2FAQueue.Name, body)
 	if err != nil {
 		return errors.Internal(err)
 	}
 	return errors.CodeError{}
 }
+
+// publish Публикует JSON сообщение в очередь. Контекст трассы передаётся в заголовках сообщения,
+// письмо попадает в ту же трассу, что и запрос, который его отправил.
+func (p *publisher) publish(ctx context.Context, queue string, body []byte) error {
+	msg := amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		Body:         body,
+	}
+
+	ctx, span := tracing.StartPublish(ctx, "", queue, &msg)
+	err := p.ch.PublishWithContext(ctx,
+		"",    // exchange
+		queue, // routing key
+		false, // mandatory
+		false, // immediate
+		msg)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+// Close Закрывает канал и соединение с rabbitMQ. Вызывается после остановки gRPC сервера,
+// когда новых публикаций уже нет: закрытие канала дожидается от брокера channel.close-ok,
+// поэтому все отправленные ранее сообщения к этому моменту переданы брокеру.
+func (p *publisher) Close() error {
+	if p.conn.IsClosed() {
+		return nil
+	}
+
+	chErr := p.ch.Close()
+	if err := p.conn.Close(); err != nil {
+		return err
+	}
+	return chErr
+}
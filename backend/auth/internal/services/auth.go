@@ -2,8 +2,6 @@ package services
 
 import (
 	"context"
-	"crypto/ecdsa"
-	"crypto/subtle"
 	"errors"
 	"time"
 
@@ -13,6 +11,7 @@ import (
 	redisDB "github.com/unwelcome/FrameWorkTask1/backend/auth/internal/database/redis"
 	"github.com/unwelcome/FrameWorkTask1/backend/auth/internal/entities"
 	"github.com/unwelcome/FrameWorkTask1/backend/auth/internal/messaging"
+	"github.com/unwelcome/FrameWorkTask1/backend/auth/pkg/keyset"
 	"github.com/unwelcome/FrameWorkTask1/backend/auth/pkg/password"
 	"github.com/unwelcome/FrameWorkTask1/backend/auth/pkg/utils"
 	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/auth/generated"
@@ -29,6 +28,9 @@ const (
 	max2FAAttempts        = 5
 	max2FAEmailDailyCount = 10
 
+	// recoveryCodesCount — кол-во одноразовых кодов восстановления, выдаваемых при подключении TOTP
+	recoveryCodesCount = 10
+
 	maxVerificationEmailDailyCount = 5
 	maxRecoveryEmailDailyCount     = 3
 
@@ -54,22 +56,24 @@ type AuthService struct {
 	db              *postgresDB.DatabaseRepository
 	cache           *redisDB.CacheRepository
 	publisher       messaging.Publisher
-	jwtPrivateKey   *ecdsa.PrivateKey
+	jwtKeys         *keyset.KeySet
 	accessTokenTTL  time.Duration
 	refreshTokenTTL time.Duration
 	appEnv          string
+	totpIssuer      string
 	pb.UnimplementedAuthServiceServer
 }
 
-func NewAuthService(db *postgresDB.DatabaseRepository, cache *redisDB.CacheRepository, publisher messaging.Publisher, jwtPrivateKey *ecdsa.PrivateKey, accessTokenTTL, refreshTokenTTL time.Duration, appEnv string) *AuthService {
+func NewAuthService(db *postgresDB.DatabaseRepository, cache *redisDB.CacheRepository, publisher messaging.Publisher, jwtKeys *keyset.KeySet, accessTokenTTL, refreshTokenTTL time.Duration, appEnv, totpIssuer string) *AuthService {
 	return &AuthService{
 		db:              db,
 		cache:           cache,
 		publisher:       publisher,
-		jwtPrivateKey:   jwtPrivateKey,
+		jwtKeys:         jwtKeys,
 		accessTokenTTL:  accessTokenTTL,
 		refreshTokenTTL: refreshTokenTTL,
 		appEnv:          appEnv,
+		totpIssuer:      totpIssuer,
 	}
 }
 
@@ -84,6 +88,26 @@ func (s *AuthService) Health(ctx context.Context, _ *emptypb.Empty) (*pb.HealthR
 	}, nil
 }
 
+// GetJWKS Публичные ключи подписи токенов. Gateway кеширует их и проверяет access токены по kid.
+func (s *AuthService) GetJWKS(_ context.Context, _ *emptypb.Empty) (*pb.GetJWKSResponse, error) {
+	jwks := s.jwtKeys.JWKS()
+	keys := make([]*pb.JWK, 0, len(jwks))
+	for _, key := range jwks {
+		keys = append(keys, &pb.JWK{
+			Kid:       key.Kid,
+			Kty:       key.Kty,
+			Crv:       key.Crv,
+			X:         key.X,
+			Y:         key.Y,
+			Alg:       key.Alg,
+			Use:       key.Use,
+			Status:    key.Status,
+			RetiredAt: key.RetiredAt,
+		})
+	}
+	return &pb.GetJWKSResponse{Keys: keys}, nil
+}
+
 // Register Создание нового пользователя с отправкой кода верификации на почту
 func (s *AuthService) Register(ctx context.Context, req *pb.RegisterRequest) (*emptypb.Empty, error) {
 	if err := validate.Email(req.GetEmail()); err != nil {
@@ -141,7 +165,7 @@ func (s *AuthService) Register(ctx context.Context, req *pb.RegisterRequest) (*e
 			})
 		} else {
 			// Email занят неверифицированным аккаунтом — отправляем новый токен верификации
-			verificationToken, tokenErr := utils.CreateVerificationToken(existing.Email, s.jwtPrivateKey, verificationTokenTTL)
+			verificationToken, tokenErr := utils.CreateVerificationToken(existing.Email, s.jwtKeys, verificationTokenTTL)
 			if tokenErr != nil {
 				return nil, status.Errorf(codes.Internal, "internal error")
 			}
@@ -159,7 +183,7 @@ func (s *AuthService) Register(ctx context.Context, req *pb.RegisterRequest) (*e
 	}
 
 	// Генерируем JWT токен верификации
-	verificationToken, err := utils.CreateVerificationToken(req.GetEmail(), s.jwtPrivateKey, verificationTokenTTL)
+	verificationToken, err := utils.CreateVerificationToken(req.GetEmail(), s.jwtKeys, verificationTokenTTL)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
@@ -214,62 +238,60 @@ func (s *AuthService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Logi
 
 	// Если у пользователя включена 2FA
 	if user.Enabled2FA {
-		// Rate limiting: cooldown между отправками 2FA писем
-		allowed, rateLimitErr := s.cache.TwoFA.Acquire2FAEmailCooldown(ctx, entities.Acquire2FAEmailCooldownDTO{UserUUID: user.UserUUID})
-		if err := rateLimitErr.GRPCError(); err != nil {
-			return nil, err
-		}
-		if !allowed {
-			return nil, status.Errorf(codes.ResourceExhausted, "please wait before requesting a new 2FA code")
+		method := user.TwoFAMethod
+		if method == "" {
+			method = entities.TwoFAMethodEmail
 		}
 
-		// Rate limiting: суточный лимит отправок 2FA писем
-		count, countErr := s.cache.TwoFA.Incr2FAEmailDailyCount(ctx, entities.Incr2FAEmailDailyCountDTO{UserUUID: user.UserUUID})
-		if err := countErr.GRPCError(); err != nil {
-			return nil, err
-		}
-		if count > max2FAEmailDailyCount {
-			return nil, status.Errorf(codes.ResourceExhausted, "daily 2FA email limit reached")
+		data := entities.Save2FADataDTO{
+			SessionUUID: uuid.Must(uuid.NewV7()).String(),
+			UserUUID:    user.UserUUID,
+			Email:       user.Email,
+			FirstName:   user.FirstName,
+			Method:      method,
 		}
 
-		sessionUUID := uuid.Must(uuid.NewV7()).String()
-		code, codeErr := utils.GenerateTwoFACode()
-		if codeErr != nil {
-			return nil, status.Errorf(codes.Internal, "internal error")
+		// При TOTP письмо не отправляется - код берётся из аутентификатора, почта остаётся запасным способом (Send2FAEmailCode)
+		if method == entities.TwoFAMethodEmail {
+			if err := s.check2FAEmailLimits(ctx, user.UserUUID); err != nil {
+				return nil, err
+			}
+
+			code, codeErr := utils.GenerateTwoFACode()
+			if codeErr != nil {
+				return nil, status.Errorf(codes.Internal, "internal error")
+			}
+			data.Code = code
 		}
 
 		// Сохраняем данные для 2FA авторизации
-		if err := s.cache.TwoFA.Save2FAData(ctx, entities.Save2FADataDTO{
-			SessionUUID: sessionUUID,
-			UserUUID:    user.UserUUID,
-			Email:       user.Email,
-			FirstName:   user.FirstName,
-			Code:        code,
-		}).GRPCError(); err != nil {
+		if err := s.cache.TwoFA.Save2FAData(ctx, data).GRPCError(); err != nil {
 			return nil, err
 		}
 
-		// Отправляем сообщение в message broker
-		_ = s.publisher.Send2FAEmail(ctx, entities.TwoFAEmailMsg{
-			UserUUID:  user.UserUUID,
-			Email:     req.GetEmail(),
-			FirstName: user.FirstName,
-			Code:      code,
-		})
+		if data.Code != "" {
+			// Отправляем сообщение в message broker
+			_ = s.publisher.Send2FAEmail(ctx, entities.TwoFAEmailMsg{
+				UserUUID:  user.UserUUID,
+				Email:     req.GetEmail(),
+				FirstName: user.FirstName,
+				Code:      data.Code,
+			})
+		}
 
 		// Возвращаем ответ для включенной 2FA
-		return &pb.LoginResponse{SessionUuid: sessionUUID}, nil
+		return &pb.LoginResponse{SessionUuid: data.SessionUUID, TwoFactorMethod: method}, nil
 	}
 
 	// Если 2FA выключена, создаем токены
-	tokenPair, err := utils.CreateTokens(user.UserUUID, s.jwtPrivateKey, s.accessTokenTTL, s.refreshTokenTTL)
+	sessionUUID := uuid.Must(uuid.NewV7()).String()
+	tokenPair, err := utils.CreateTokens(user.UserUUID, sessionUUID, s.jwtKeys, s.accessTokenTTL, s.refreshTokenTTL)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
 
 	session := &entities.SessionInfo{}
 	session.FromProto(req.GetSession())
-	sessionUUID := uuid.Must(uuid.NewV7()).String()
 
 	if err := s.cache.Auth.SaveSession(ctx, entities.SaveSessionDTO{
 		UserUUID:    user.UserUUID,
@@ -471,7 +493,7 @@ func (s *AuthService) GetAllActiveSessions(ctx context.Context, req *pb.GetAllAc
 
 // RefreshToken Обновление токенов
 func (s *AuthService) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
-	tokenClaims, err := utils.ParseToken(req.GetRefreshToken(), s.jwtPrivateKey)
+	tokenClaims, err := utils.ParseToken(req.GetRefreshToken(), s.jwtKeys)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -480,10 +502,12 @@ func (s *AuthService) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequ
 		return nil, status.Errorf(codes.InvalidArgument, "wrong token type")
 	}
 
-	if err = s.cache.Auth.CheckSessionExists(ctx, entities.CheckSessionExistsDTO{
+	// UUID сессии берём из индекса токенов, а не из claims - refresh токены, выпущенные до привязки к сессии, его не содержат
+	sessionUUID, checkErr := s.cache.Auth.CheckSessionExists(ctx, entities.CheckSessionExistsDTO{
 		UserUUID:    tokenClaims.UserUUID,
 		HashedToken: utils.HashToken(req.GetRefreshToken()),
-	}).GRPCError(); err != nil {
+	})
+	if err = checkErr.GRPCError(); err != nil {
 		return nil, err
 	}
 
@@ -498,7 +522,7 @@ func (s *AuthService) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequ
 		return nil, status.Error(codes.PermissionDenied, "account not verified")
 	}
 
-	tokenPair, err := utils.CreateTokens(tokenClaims.UserUUID, s.jwtPrivateKey, s.accessTokenTTL, s.refreshTokenTTL)
+	tokenPair, err := utils.CreateTokens(tokenClaims.UserUUID, sessionUUID, s.jwtKeys, s.accessTokenTTL, s.refreshTokenTTL)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "internal error")
 	}
@@ -566,7 +590,7 @@ func (s *AuthService) RevokeAllSessions(ctx context.Context, req *pb.RevokeAllSe
 
 // VerifyAccount Подтверждение аккаунта по JWT токену из письма (magic link)
 func (s *AuthService) VerifyAccount(ctx context.Context, req *pb.VerifyAccountRequest) (*emptypb.Empty, error) {
-	claims, err := utils.ParseVerificationToken(req.GetVerificationToken(), s.jwtPrivateKey)
+	claims, err := utils.ParseVerificationToken(req.GetVerificationToken(), s.jwtKeys)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid or expired verification token")
 	}
@@ -643,7 +667,7 @@ func (s *AuthService) ResendVerificationCode(ctx context.Context, req *pb.Resend
 	}
 
 	// Генерируем новый JWT токен верификации
-	verificationToken, err := utils.CreateVerificationToken(user.Email, s.jwtPrivateKey, verificationTokenTTL)
+	verificationToken, err := utils.CreateVerificationToken(user.Email, s.jwtKeys, verificationTokenTTL)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
@@ -702,7 +726,7 @@ func (s *AuthService) ForgotPassword(ctx context.Context, req *pb.ForgotPassword
 		return &emptypb.Empty{}, nil
 	}
 
-	resetToken, err := utils.CreateResetPasswordToken(user.Email, s.jwtPrivateKey, resetPasswordTokenTTL)
+	resetToken, err := utils.CreateResetPasswordToken(user.Email, s.jwtKeys, resetPasswordTokenTTL)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
@@ -725,7 +749,7 @@ func (s *AuthService) ResetPassword(ctx context.Context, req *pb.ResetPasswordRe
 	}
 
 	// Верифицируем JWT токен (подпись + срок действия)
-	claims, err := utils.ParseResetPasswordToken(req.GetResetToken(), s.jwtPrivateKey)
+	claims, err := utils.ParseResetPasswordToken(req.GetResetToken(), s.jwtKeys)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid or expired reset token")
 	}
@@ -788,14 +812,24 @@ func (s *AuthService) ResetPassword(ctx context.Context, req *pb.ResetPasswordRe
 	return &emptypb.Empty{}, nil
 }
 
-// Verify2FA Подтверждение 2FA авторизации
+// Verify2FA Подтверждение 2FA авторизации кодом из письма, аутентификатора или кодом восстановления
 func (s *AuthService) Verify2FA(ctx context.Context, req *pb.Verify2FARequest) (*pb.Verify2FAResponse, error) {
 	// Валидации
 	if err := validate.UUID(req.SessionUuid); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid session uuid")
 	}
-	if err := validate.User2FACCode(req.GetCode()); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid code format")
+	method := req.GetMethod()
+	switch method {
+	case entities.TwoFAMethodRecovery:
+		if err := validate.User2FARecoveryCode(req.GetCode()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid code format")
+		}
+	case "", entities.TwoFAMethodEmail, entities.TwoFAMethodTOTP:
+		if err := validate.User2FACCode(req.GetCode()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid code format")
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid 2FA method")
 	}
 
 	// Получаем данные по sessionUUID
@@ -804,7 +838,15 @@ func (s *AuthService) Verify2FA(ctx context.Context, req *pb.Verify2FARequest) (
 		return nil, err
 	}
 
-	// Увеличиваем счётчик попыток
+	// По умолчанию проверяем способ, выбранный пользователем
+	if method == "" {
+		method = data.Method
+	}
+	if method == "" {
+		method = entities.TwoFAMethodEmail
+	}
+
+	// Увеличиваем счётчик попыток - общий для всех способов
 	attempts, attemptsErr := s.cache.TwoFA.Incr2FAAttempts(ctx, entities.Incr2FAAttemptsDTO{SessionUUID: req.GetSessionUuid()})
 	if err := attemptsErr.GRPCError(); err != nil {
 		return nil, err
@@ -814,8 +856,11 @@ func (s *AuthService) Verify2FA(ctx context.Context, req *pb.Verify2FARequest) (
 		return nil, status.Errorf(codes.ResourceExhausted, "too many attempts, please try login again")
 	}
 
-	// Сравниваем code в constant-time, чтобы не утекало число совпавших символов через тайминг
-	if subtle.ConstantTimeCompare([]byte(data.Code), []byte(req.GetCode())) != 1 {
+	ok, err := s.check2FACode(ctx, data, method, req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid or expired code")
 	}
 
@@ -823,14 +868,14 @@ func (s *AuthService) Verify2FA(ctx context.Context, req *pb.Verify2FARequest) (
 	_ = s.cache.TwoFA.Delete2FAData(ctx, entities.Delete2FADataDTO{SessionUUID: req.GetSessionUuid()})
 
 	// Генерируем пару токенов
-	tokenPair, err := utils.CreateTokens(data.UserUUID, s.jwtPrivateKey, s.accessTokenTTL, s.refreshTokenTTL)
+	verify2FASessionUUID := uuid.Must(uuid.NewV7()).String()
+	tokenPair, err := utils.CreateTokens(data.UserUUID, verify2FASessionUUID, s.jwtKeys, s.accessTokenTTL, s.refreshTokenTTL)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
 
 	session := &entities.SessionInfo{}
 	session.FromProto(req.GetSession())
-	verify2FASessionUUID := uuid.Must(uuid.NewV7()).String()
 
 	// Сохраняем новую сессию
 	if err := s.cache.Auth.SaveSession(ctx, entities.SaveSessionDTO{
@@ -861,12 +906,15 @@ func (s *AuthService) Verify2FA(ctx context.Context, req *pb.Verify2FARequest) (
 	}, nil
 }
 
-// UpdateUser2FA Включение / выключение 2FA авторизации
+// UpdateUser2FA Включение / выключение 2FA авторизации и выбор основного способа
 func (s *AuthService) UpdateUser2FA(ctx context.Context, req *pb.UpdateUser2FARequest) (*emptypb.Empty, error) {
 	// Валидации
 	if err := validate.UUID(req.GetUserUuid()); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid user uuid")
 	}
+	if err := validate.TwoFAMethod(req.GetMethod()); err != nil && req.GetMethod() != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid 2FA method")
+	}
 
 	user, getErr := s.db.User.GetUser(ctx, entities.GetUserDTO{UserUUID: req.GetUserUuid()})
 	if err := getErr.GRPCError(); err != nil {
@@ -876,10 +924,16 @@ func (s *AuthService) UpdateUser2FA(ctx context.Context, req *pb.UpdateUser2FARe
 		return nil, status.Error(codes.PermissionDenied, deletedAccountMessage(*user.DeletedAt))
 	}
 
+	// TOTP можно выбрать только после подтверждения аутентификатора (EnrollTOTP + ConfirmTOTP)
+	if req.GetMethod() == entities.TwoFAMethodTOTP && user.TOTPSecret == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "TOTP is not enrolled")
+	}
+
 	// Обновляем данные пользователя
 	if err := s.db.User.UpdateUser2FA(ctx, entities.UpdateUser2FADTO{
 		UserUUID:     req.GetUserUuid(),
 		TwoFAEnabled: req.GetEnable_2Fa(),
+		TwoFAMethod:  req.GetMethod(),
 	}).GRPCError(); err != nil {
 		return nil, err
 	}
@@ -947,7 +1001,7 @@ func (s *AuthService) GetVerificationToken(ctx context.Context, req *pb.GetVerif
 		return nil, err
 	}
 
-	token, err := utils.CreateVerificationToken(user.Email, s.jwtPrivateKey, verificationTokenTTL)
+	token, err := utils.CreateVerificationToken(user.Email, s.jwtKeys, verificationTokenTTL)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
@@ -971,7 +1025,7 @@ func (s *AuthService) GetResetPasswordToken(ctx context.Context, req *pb.GetRese
 		return nil, err
 	}
 
-	token, err := utils.CreateResetPasswordToken(user.Email, s.jwtPrivateKey, resetPasswordTokenTTL)
+	token, err := utils.CreateResetPasswordToken(user.Email, s.jwtKeys, resetPasswordTokenTTL)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
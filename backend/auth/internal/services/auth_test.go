@@ -2,6 +2,9 @@ package services
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"fmt"
 	"strings"
 	"testing"
@@ -9,8 +12,11 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/unwelcome/FrameWorkTask1/backend/auth/internal/entities"
+	"github.com/unwelcome/FrameWorkTask1/backend/auth/pkg/keyset"
 	"github.com/unwelcome/FrameWorkTask1/backend/auth/pkg/password"
 	"github.com/unwelcome/FrameWorkTask1/backend/auth/pkg/utils"
 	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/auth/generated"
@@ -51,7 +57,7 @@ func hashPassword(t *testing.T, pwd string) string {
 
 func validRefreshToken(t *testing.T) string {
 	t.Helper()
-	tokens, err := utils.CreateTokens(testUUID1, testPrivateKey, testAccessTTL, testRefreshTTL)
+	tokens, err := utils.CreateTokens(testUUID1, testUUID2, testKeys, testAccessTTL, testRefreshTTL)
 	if err != nil {
 		t.Fatalf("failed to create tokens: %v", err)
 	}
@@ -60,7 +66,7 @@ func validRefreshToken(t *testing.T) string {
 
 func validAccessToken(t *testing.T) string {
 	t.Helper()
-	tokens, err := utils.CreateTokens(testUUID1, testPrivateKey, testAccessTTL, testRefreshTTL)
+	tokens, err := utils.CreateTokens(testUUID1, testUUID2, testKeys, testAccessTTL, testRefreshTTL)
 	if err != nil {
 		t.Fatalf("failed to create tokens: %v", err)
 	}
@@ -69,7 +75,7 @@ func validAccessToken(t *testing.T) string {
 
 func validResetPasswordToken(t *testing.T, email string) string {
 	t.Helper()
-	token, err := utils.CreateResetPasswordToken(email, testPrivateKey, time.Minute)
+	token, err := utils.CreateResetPasswordToken(email, testKeys, time.Minute)
 	if err != nil {
 		t.Fatalf("failed to create reset password token: %v", err)
 	}
@@ -282,8 +288,12 @@ func TestLogin(t *testing.T) {
 				}, ok()
 			},
 		}
+		var savedSessionUUID string
 		authRepo := &mockAuthRepo{
-			saveSession: func(_ context.Context, _ entities.SaveSessionDTO) Error.CodeError { return ok() },
+			saveSession: func(_ context.Context, dto entities.SaveSessionDTO) Error.CodeError {
+				savedSessionUUID = dto.SessionUUID
+				return ok()
+			},
 		}
 		pub := &mockPublisher{
 			sendVerificationEmail:        func(_ context.Context, _ entities.VerificationEmailMsg) Error.CodeError { return ok() },
@@ -314,6 +324,11 @@ func TestLogin(t *testing.T) {
 		if resp.GetUserUuid() != testUUID1 {
 			t.Errorf("expected user UUID %q, got %q", testUUID1, resp.GetUserUuid())
 		}
+		claims, err := utils.ParseToken(resp.GetAccessToken(), testKeys)
+		assertNoError(t, err)
+		if claims.SessionUUID == "" || claims.SessionUUID != savedSessionUUID {
+			t.Errorf("expected access token bound to saved session %q, got %q", savedSessionUUID, claims.SessionUUID)
+		}
 		if !notified {
 			t.Error("expected login notification to be sent")
 		}
@@ -905,7 +920,7 @@ func TestRefreshToken(t *testing.T) {
 			},
 		}
 		authRepo := &mockAuthRepo{
-			checkSessionExists: func(_ context.Context, _ entities.CheckSessionExistsDTO) Error.CodeError { return ok() },
+			checkSessionExists: func(_ context.Context, _ entities.CheckSessionExistsDTO) (string, Error.CodeError) { return testUUID2, ok() },
 			refreshToken: func(_ context.Context, _ entities.RefreshTokenDTO) Error.CodeError { return ok() },
 		}
 		svc := newTestService(userRepo, authRepo)
@@ -920,6 +935,38 @@ func TestRefreshToken(t *testing.T) {
 		}
 	})
 
+	t.Run("tokens_bound_to_session", func(t *testing.T) {
+		// Сессия берётся из индекса токенов, даже если в claims старого токена её нет
+		legacyTokens, err := utils.CreateTokens(testUUID1, "", testKeys, testAccessTTL, testRefreshTTL)
+		if err != nil {
+			t.Fatalf("failed to create tokens: %v", err)
+		}
+		userRepo := &mockUserRepo{
+			getUser: func(_ context.Context, _ entities.GetUserDTO) (*entities.UserGet, Error.CodeError) {
+				return &entities.UserGet{UserUUID: testUUID1, IsVerified: true}, ok()
+			},
+		}
+		authRepo := &mockAuthRepo{
+			checkSessionExists: func(_ context.Context, _ entities.CheckSessionExistsDTO) (string, Error.CodeError) { return testUUID2, ok() },
+			refreshToken: func(_ context.Context, _ entities.RefreshTokenDTO) Error.CodeError { return ok() },
+		}
+		svc := newTestService(userRepo, authRepo)
+
+		resp, err := svc.RefreshToken(context.Background(), &pb.RefreshTokenRequest{
+			RefreshToken: legacyTokens.RefreshToken,
+		})
+
+		assertNoError(t, err)
+		claims, err := utils.ParseToken(resp.GetAccessToken(), testKeys)
+		assertNoError(t, err)
+		if claims.SessionUUID != testUUID2 {
+			t.Errorf("expected access token bound to session %s, got %q", testUUID2, claims.SessionUUID)
+		}
+		if claims.IssuedAt == nil {
+			t.Error("expected iat claim in access token")
+		}
+	})
+
 	t.Run("invalid_token", func(t *testing.T) {
 		svc := newTestService(emptyUserRepo(), emptyAuthRepo())
 
@@ -944,8 +991,8 @@ func TestRefreshToken(t *testing.T) {
 	t.Run("token_not_in_cache", func(t *testing.T) {
 		refreshToken := validRefreshToken(t)
 		authRepo := &mockAuthRepo{
-			checkSessionExists: func(_ context.Context, _ entities.CheckSessionExistsDTO) Error.CodeError {
-				return Error.Public(codes.NotFound, "token not found")
+			checkSessionExists: func(_ context.Context, _ entities.CheckSessionExistsDTO) (string, Error.CodeError) {
+				return "", Error.Public(codes.NotFound, "token not found")
 			},
 		}
 		svc := newTestService(emptyUserRepo(), authRepo)
@@ -965,7 +1012,7 @@ func TestRefreshToken(t *testing.T) {
 			},
 		}
 		authRepo := &mockAuthRepo{
-			checkSessionExists: func(_ context.Context, _ entities.CheckSessionExistsDTO) Error.CodeError { return ok() },
+			checkSessionExists: func(_ context.Context, _ entities.CheckSessionExistsDTO) (string, Error.CodeError) { return testUUID2, ok() },
 		}
 		svc := newTestService(userRepo, authRepo)
 
@@ -985,7 +1032,7 @@ func TestRefreshToken(t *testing.T) {
 			},
 		}
 		authRepo := &mockAuthRepo{
-			checkSessionExists: func(_ context.Context, _ entities.CheckSessionExistsDTO) Error.CodeError { return ok() },
+			checkSessionExists: func(_ context.Context, _ entities.CheckSessionExistsDTO) (string, Error.CodeError) { return testUUID2, ok() },
 		}
 		svc := newTestService(userRepo, authRepo)
 
@@ -1004,7 +1051,7 @@ func TestRefreshToken(t *testing.T) {
 			},
 		}
 		authRepo := &mockAuthRepo{
-			checkSessionExists: func(_ context.Context, _ entities.CheckSessionExistsDTO) Error.CodeError { return ok() },
+			checkSessionExists: func(_ context.Context, _ entities.CheckSessionExistsDTO) (string, Error.CodeError) { return testUUID2, ok() },
 		}
 		svc := newTestService(userRepo, authRepo)
 
@@ -1032,7 +1079,7 @@ func TestRefreshToken(t *testing.T) {
 			},
 		}
 		authRepo := &mockAuthRepo{
-			checkSessionExists: func(_ context.Context, _ entities.CheckSessionExistsDTO) Error.CodeError { return ok() },
+			checkSessionExists: func(_ context.Context, _ entities.CheckSessionExistsDTO) (string, Error.CodeError) { return testUUID2, ok() },
 			refreshToken: func(_ context.Context, _ entities.RefreshTokenDTO) Error.CodeError {
 				return Error.CodeError{Code: codes.Unauthenticated, Err: entities.ErrTokenReuse}
 			},
@@ -1069,7 +1116,7 @@ func TestRefreshToken(t *testing.T) {
 			},
 		}
 		authRepo := &mockAuthRepo{
-			checkSessionExists: func(_ context.Context, _ entities.CheckSessionExistsDTO) Error.CodeError { return ok() },
+			checkSessionExists: func(_ context.Context, _ entities.CheckSessionExistsDTO) (string, Error.CodeError) { return testUUID2, ok() },
 			refreshToken: func(_ context.Context, _ entities.RefreshTokenDTO) Error.CodeError {
 				return Error.Internal(fmt.Errorf("redis error"))
 			},
@@ -1084,6 +1131,111 @@ func TestRefreshToken(t *testing.T) {
 	})
 }
 
+// ─── Key rotation ────────────────────────────────────────────────────────────
+
+// rotatedKeys Набор после ротации: новый активный ключ, тестовый ключ выведен
+func rotatedKeys(t *testing.T) *keyset.KeySet {
+	t.Helper()
+	_, oldKey := testKeys.Active()
+	newKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	keys, err := keyset.New(newKey, nil, []keyset.Retired{{Public: &oldKey.PublicKey, At: time.Now()}})
+	if err != nil {
+		t.Fatalf("failed to build key set: %v", err)
+	}
+	return keys
+}
+
+func TestKeyRotation(t *testing.T) {
+	refreshDeps := func() (*mockUserRepo, *mockAuthRepo) {
+		userRepo := &mockUserRepo{
+			getUser: func(_ context.Context, _ entities.GetUserDTO) (*entities.UserGet, Error.CodeError) {
+				return &entities.UserGet{UserUUID: testUUID1, IsVerified: true}, ok()
+			},
+		}
+		authRepo := &mockAuthRepo{
+			checkSessionExists: func(_ context.Context, _ entities.CheckSessionExistsDTO) (string, Error.CodeError) { return testUUID2, ok() },
+			refreshToken: func(_ context.Context, _ entities.RefreshTokenDTO) Error.CodeError { return ok() },
+		}
+		return userRepo, authRepo
+	}
+
+	t.Run("refresh_token_signed_by_retired_key", func(t *testing.T) {
+		// Токен выпущен до ротации - сессия не должна теряться
+		refreshToken := validRefreshToken(t)
+		keys := rotatedKeys(t)
+		userRepo, authRepo := refreshDeps()
+		svc := newTestService(userRepo, authRepo)
+		svc.jwtKeys = keys
+
+		resp, err := svc.RefreshToken(context.Background(), &pb.RefreshTokenRequest{RefreshToken: refreshToken})
+
+		assertNoError(t, err)
+		// Новая пара подписана уже активным ключом
+		token, _, parseErr := jwt.NewParser().ParseUnverified(resp.GetAccessToken(), &entities.TokenClaims{})
+		assertNoError(t, parseErr)
+		activeKID, _ := keys.Active()
+		if token.Header["kid"] != activeKID {
+			t.Errorf("expected kid %q, got %v", activeKID, token.Header["kid"])
+		}
+	})
+
+	t.Run("token_signed_by_unknown_key", func(t *testing.T) {
+		// Ключ удалён из набора (например, скомпрометирован) - его токены не принимаются
+		refreshToken, err := utils.CreateTokens(testUUID1, testUUID2, rotatedKeys(t), testAccessTTL, testRefreshTTL)
+		if err != nil {
+			t.Fatalf("failed to create tokens: %v", err)
+		}
+		svc := newTestService(emptyUserRepo(), emptyAuthRepo())
+
+		_, err = svc.RefreshToken(context.Background(), &pb.RefreshTokenRequest{RefreshToken: refreshToken.RefreshToken})
+
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("jwks_lists_all_keys", func(t *testing.T) {
+		keys := rotatedKeys(t)
+		svc := newTestService(emptyUserRepo(), emptyAuthRepo())
+		svc.jwtKeys = keys
+
+		resp, err := svc.GetJWKS(context.Background(), &emptypb.Empty{})
+
+		assertNoError(t, err)
+		if len(resp.GetKeys()) != 2 {
+			t.Fatalf("expected 2 keys, got %d", len(resp.GetKeys()))
+		}
+		activeKID, _ := keys.Active()
+		oldKID, _ := testKeys.Active()
+		statuses := map[string]string{}
+		for _, key := range resp.GetKeys() {
+			if key.GetKty() != "EC" || key.GetCrv() != "P-256" || key.GetAlg() != "ES256" || key.GetX() == "" || key.GetY() == "" {
+				t.Errorf("unexpected JWK %+v", key)
+			}
+			statuses[key.GetKid()] = key.GetStatus()
+			if (key.GetKid() == oldKID) != (key.GetRetiredAt() != 0) {
+				t.Errorf("retired_at must be set only for the retired key: %+v", key)
+			}
+		}
+		if statuses[activeKID] != keyset.StatusActive || statuses[oldKID] != keyset.StatusRetired {
+			t.Errorf("unexpected key statuses: %v", statuses)
+		}
+	})
+
+	t.Run("retired_key_requires_retirement_time", func(t *testing.T) {
+		_, oldKey := testKeys.Active()
+		newKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatalf("failed to generate key: %v", err)
+		}
+
+		if _, err = keyset.New(newKey, nil, []keyset.Retired{{Public: &oldKey.PublicKey}}); err == nil {
+			t.Error("expected error for retired key without retirement time")
+		}
+	})
+}
+
 // ─── RevokeSession ───────────────────────────────────────────────────────────
 
 func TestRevokeSession(t *testing.T) {
@@ -1219,6 +1371,47 @@ func TestLogin_Extra(t *testing.T) {
 		}
 	})
 
+	t.Run("two_fa_totp_skips_email", func(t *testing.T) {
+		hashedPwd := hashPassword(t, testPassword)
+		userRepo := &mockUserRepo{
+			getUserByEmail: func(_ context.Context, _ entities.GetUserByEmailDTO) (*entities.UserGetByEmail, Error.CodeError) {
+				return &entities.UserGetByEmail{
+					UserUUID:     testUUID1,
+					PasswordHash: hashedPwd,
+					IsVerified:   true,
+					Enabled2FA:   true,
+					TwoFAMethod:  "totp",
+				}, ok()
+			},
+		}
+		var saved entities.Save2FADataDTO
+		twoFARepo := &mockTwoFARepo{
+			save2FAData: func(_ context.Context, dto entities.Save2FADataDTO) Error.CodeError {
+				saved = dto
+				return ok()
+			},
+		}
+		pub := emptyPublisher().(*mockPublisher)
+		pub.send2FAEmail = func(_ context.Context, _ entities.TwoFAEmailMsg) Error.CodeError {
+			t.Error("2FA email must not be sent for TOTP")
+			return ok()
+		}
+		svc := buildSvc(svcDeps{user: userRepo, twoFA: twoFARepo, publisher: pub})
+
+		resp, err := svc.Login(context.Background(), &pb.LoginRequest{
+			Email:    "test@example.com",
+			Password: testPassword,
+		})
+
+		assertNoError(t, err)
+		if resp.GetTwoFactorMethod() != "totp" || resp.GetSessionUuid() != saved.SessionUUID {
+			t.Errorf("unexpected response: %+v", resp)
+		}
+		if saved.Method != "totp" || saved.Code != "" {
+			t.Errorf("unexpected 2FA data: %+v", saved)
+		}
+	})
+
 	t.Run("two_fa_save_error", func(t *testing.T) {
 		hashedPwd := hashPassword(t, testPassword)
 		userRepo := &mockUserRepo{
@@ -1418,7 +1611,7 @@ func TestRevokeAllSessions(t *testing.T) {
 // validVerificationToken создаёт валидный JWT токен верификации для указанного email.
 func validVerificationToken(t *testing.T, email string) string {
 	t.Helper()
-	token, err := utils.CreateVerificationToken(email, testPrivateKey, verificationTokenTTL)
+	token, err := utils.CreateVerificationToken(email, testKeys, verificationTokenTTL)
 	if err != nil {
 		t.Fatalf("failed to create verification token: %v", err)
 	}
@@ -2180,6 +2373,54 @@ func TestUpdateUser2FA(t *testing.T) {
 		assertNoError(t, err)
 	})
 
+	t.Run("select_totp", func(t *testing.T) {
+		userRepo := &mockUserRepo{
+			getUser: func(_ context.Context, _ entities.GetUserDTO) (*entities.UserGet, Error.CodeError) {
+				return &entities.UserGet{UserUUID: testUUID1, TOTPSecret: "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"}, ok()
+			},
+			updateUser2FA: func(_ context.Context, dto entities.UpdateUser2FADTO) Error.CodeError {
+				if dto.TwoFAMethod != "totp" {
+					return Error.Internal(fmt.Errorf("expected method=totp"))
+				}
+				return ok()
+			},
+		}
+		svc := buildSvc(svcDeps{user: userRepo})
+
+		_, err := svc.UpdateUser2FA(context.Background(), &pb.UpdateUser2FARequest{
+			UserUuid:   testUUID1,
+			Enable_2Fa: true,
+			Method:     "totp",
+		})
+
+		assertNoError(t, err)
+	})
+
+	t.Run("totp_not_enrolled", func(t *testing.T) {
+		userRepo := &mockUserRepo{getUser: activeUser}
+		svc := buildSvc(svcDeps{user: userRepo})
+
+		_, err := svc.UpdateUser2FA(context.Background(), &pb.UpdateUser2FARequest{
+			UserUuid:   testUUID1,
+			Enable_2Fa: true,
+			Method:     "totp",
+		})
+
+		assertCode(t, err, codes.FailedPrecondition)
+	})
+
+	t.Run("invalid_method", func(t *testing.T) {
+		svc := buildSvc(svcDeps{})
+
+		_, err := svc.UpdateUser2FA(context.Background(), &pb.UpdateUser2FARequest{
+			UserUuid:   testUUID1,
+			Enable_2Fa: true,
+			Method:     "sms",
+		})
+
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
 	t.Run("invalid_uuid", func(t *testing.T) {
 		svc := buildSvc(svcDeps{})
 
@@ -2303,7 +2544,7 @@ func TestGetVerificationToken(t *testing.T) {
 			t.Error("expected non-empty verification token")
 		}
 		// Проверяем, что токен парсится и содержит правильный email
-		claims, parseErr := utils.ParseVerificationToken(resp.GetToken(), testPrivateKey)
+		claims, parseErr := utils.ParseVerificationToken(resp.GetToken(), testKeys)
 		if parseErr != nil {
 			t.Fatalf("returned token is not a valid verification JWT: %v", parseErr)
 		}
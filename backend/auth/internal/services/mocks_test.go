@@ -2,13 +2,13 @@ package services
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"time"
 
 	postgresDB "github.com/unwelcome/FrameWorkTask1/backend/auth/internal/database/postgres"
 	redisDB "github.com/unwelcome/FrameWorkTask1/backend/auth/internal/database/redis"
 	"github.com/unwelcome/FrameWorkTask1/backend/auth/internal/entities"
 	"github.com/unwelcome/FrameWorkTask1/backend/auth/internal/messaging"
+	"github.com/unwelcome/FrameWorkTask1/backend/auth/pkg/keyset"
 	"github.com/unwelcome/FrameWorkTask1/backend/auth/pkg/utils"
 	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
 )
@@ -64,7 +64,7 @@ func (m *mockUserRepo) SetUserVerified(ctx context.Context, dto entities.SetUser
 type mockAuthRepo struct {
 	saveSession        func(ctx context.Context, dto entities.SaveSessionDTO) Error.CodeError
 	getAllSessions      func(ctx context.Context, dto entities.GetAllSessionsDTO) ([]entities.SessionEntry, Error.CodeError)
-	checkSessionExists func(ctx context.Context, dto entities.CheckSessionExistsDTO) Error.CodeError
+	checkSessionExists func(ctx context.Context, dto entities.CheckSessionExistsDTO) (string, Error.CodeError)
 	revokeSession      func(ctx context.Context, dto entities.RevokeSessionDTO) Error.CodeError
 	revokeAllSessions  func(ctx context.Context, dto entities.RevokeAllSessionsDTO) Error.CodeError
 	refreshToken      func(ctx context.Context, dto entities.RefreshTokenDTO) Error.CodeError
@@ -76,7 +76,7 @@ func (m *mockAuthRepo) SaveSession(ctx context.Context, dto entities.SaveSession
 func (m *mockAuthRepo) GetAllSessions(ctx context.Context, dto entities.GetAllSessionsDTO) ([]entities.SessionEntry, Error.CodeError) {
 	return m.getAllSessions(ctx, dto)
 }
-func (m *mockAuthRepo) CheckSessionExists(ctx context.Context, dto entities.CheckSessionExistsDTO) Error.CodeError {
+func (m *mockAuthRepo) CheckSessionExists(ctx context.Context, dto entities.CheckSessionExistsDTO) (string, Error.CodeError) {
 	return m.checkSessionExists(ctx, dto)
 }
 func (m *mockAuthRepo) RevokeSession(ctx context.Context, dto entities.RevokeSessionDTO) Error.CodeError {
@@ -149,6 +149,11 @@ type mockTwoFARepo struct {
 	incr2FAAttempts        func(ctx context.Context, dto entities.Incr2FAAttemptsDTO) (int64, Error.CodeError)
 	acquire2FAEmailCooldown func(ctx context.Context, dto entities.Acquire2FAEmailCooldownDTO) (bool, Error.CodeError)
 	incr2FAEmailDailyCount  func(ctx context.Context, dto entities.Incr2FAEmailDailyCountDTO) (int64, Error.CodeError)
+	update2FACode           func(ctx context.Context, dto entities.Update2FACodeDTO) Error.CodeError
+	savePendingTOTP         func(ctx context.Context, dto entities.SavePendingTOTPDTO) Error.CodeError
+	getPendingTOTP          func(ctx context.Context, dto entities.GetPendingTOTPDTO) (string, Error.CodeError)
+	deletePendingTOTP       func(ctx context.Context, dto entities.DeletePendingTOTPDTO) Error.CodeError
+	acquireTOTPStep         func(ctx context.Context, dto entities.AcquireTOTPStepDTO) (bool, Error.CodeError)
 }
 
 func (m *mockTwoFARepo) Save2FAData(ctx context.Context, dto entities.Save2FADataDTO) Error.CodeError {
@@ -169,6 +174,21 @@ func (m *mockTwoFARepo) Acquire2FAEmailCooldown(ctx context.Context, dto entitie
 func (m *mockTwoFARepo) Incr2FAEmailDailyCount(ctx context.Context, dto entities.Incr2FAEmailDailyCountDTO) (int64, Error.CodeError) {
 	return m.incr2FAEmailDailyCount(ctx, dto)
 }
+func (m *mockTwoFARepo) Update2FACode(ctx context.Context, dto entities.Update2FACodeDTO) Error.CodeError {
+	return m.update2FACode(ctx, dto)
+}
+func (m *mockTwoFARepo) SavePendingTOTP(ctx context.Context, dto entities.SavePendingTOTPDTO) Error.CodeError {
+	return m.savePendingTOTP(ctx, dto)
+}
+func (m *mockTwoFARepo) GetPendingTOTP(ctx context.Context, dto entities.GetPendingTOTPDTO) (string, Error.CodeError) {
+	return m.getPendingTOTP(ctx, dto)
+}
+func (m *mockTwoFARepo) DeletePendingTOTP(ctx context.Context, dto entities.DeletePendingTOTPDTO) Error.CodeError {
+	return m.deletePendingTOTP(ctx, dto)
+}
+func (m *mockTwoFARepo) AcquireTOTPStep(ctx context.Context, dto entities.AcquireTOTPStepDTO) (bool, Error.CodeError) {
+	return m.acquireTOTPStep(ctx, dto)
+}
 
 // emptyTwoFARepo — заглушка для тестов, где TwoFARepository не должен вызываться.
 func emptyTwoFARepo() *mockTwoFARepo {
@@ -179,9 +199,35 @@ func emptyTwoFARepo() *mockTwoFARepo {
 		incr2FAAttempts:         func(_ context.Context, _ entities.Incr2FAAttemptsDTO) (int64, Error.CodeError) { return 0, Error.CodeError{} },
 		acquire2FAEmailCooldown: func(_ context.Context, _ entities.Acquire2FAEmailCooldownDTO) (bool, Error.CodeError) { return true, Error.CodeError{} },
 		incr2FAEmailDailyCount:  func(_ context.Context, _ entities.Incr2FAEmailDailyCountDTO) (int64, Error.CodeError) { return 1, Error.CodeError{} },
+		update2FACode:           func(_ context.Context, _ entities.Update2FACodeDTO) Error.CodeError { return Error.CodeError{} },
+		savePendingTOTP:         func(_ context.Context, _ entities.SavePendingTOTPDTO) Error.CodeError { return Error.CodeError{} },
+		getPendingTOTP:          func(_ context.Context, _ entities.GetPendingTOTPDTO) (string, Error.CodeError) { return "", Error.CodeError{} },
+		deletePendingTOTP:       func(_ context.Context, _ entities.DeletePendingTOTPDTO) Error.CodeError { return Error.CodeError{} },
+		acquireTOTPStep:         func(_ context.Context, _ entities.AcquireTOTPStepDTO) (bool, Error.CodeError) { return true, Error.CodeError{} },
 	}
 }
 
+// ─── Mock: TwoFactorRepository ───────────────────────────────────────────────
+
+type mockTwoFactorRepo struct {
+	enableTOTP       func(ctx context.Context, dto entities.EnableTOTPDTO) Error.CodeError
+	getRecoveryCodes func(ctx context.Context, dto entities.GetRecoveryCodesDTO) ([]*entities.RecoveryCode, Error.CodeError)
+	useRecoveryCode  func(ctx context.Context, dto entities.UseRecoveryCodeDTO) Error.CodeError
+}
+
+func (m *mockTwoFactorRepo) EnableTOTP(ctx context.Context, dto entities.EnableTOTPDTO) Error.CodeError {
+	return m.enableTOTP(ctx, dto)
+}
+func (m *mockTwoFactorRepo) GetRecoveryCodes(ctx context.Context, dto entities.GetRecoveryCodesDTO) ([]*entities.RecoveryCode, Error.CodeError) {
+	return m.getRecoveryCodes(ctx, dto)
+}
+func (m *mockTwoFactorRepo) UseRecoveryCode(ctx context.Context, dto entities.UseRecoveryCodeDTO) Error.CodeError {
+	return m.useRecoveryCode(ctx, dto)
+}
+
+// emptyTwoFactorRepo — заглушка для тестов, где TwoFactorRepository не должен вызываться.
+func emptyTwoFactorRepo() *mockTwoFactorRepo { return &mockTwoFactorRepo{} }
+
 // ─── Mock: Publisher ─────────────────────────────────────────────────────────
 
 type mockPublisher struct {
@@ -219,6 +265,7 @@ func (m *mockPublisher) SendLoginNotificationEmail(ctx context.Context, dto enti
 func (m *mockPublisher) SendTokenReuseAlertEmail(ctx context.Context, dto entities.TokenReuseAlertEmailMsg) Error.CodeError {
 	return m.sendTokenReuseAlertEmail(ctx, dto)
 }
+func (m *mockPublisher) Close() error { return nil }
 
 // emptyPublisher — заглушка для тестов, где Publisher не должен вызываться.
 func emptyPublisher() messaging.Publisher {
@@ -236,20 +283,24 @@ func emptyPublisher() messaging.Publisher {
 
 // ─── Helpers ─────────────────────────────────────────────────────────────────
 
-// testPrivateKey — ECDSA ключ из /keys/test/private.pem для unit-тестов.
-var testPrivateKey *ecdsa.PrivateKey
+// testKeys — набор ключей с активным ключом из /keys/test/private.pem для unit-тестов.
+var testKeys *keyset.KeySet
 
 func init() {
-	var err error
-	testPrivateKey, err = utils.LoadPrivateKey("../../../keys/test/private.pem")
+	testPrivateKey, err := utils.LoadPrivateKey("../../../keys/test/private.pem")
 	if err != nil {
 		panic("failed to load test private key: " + err.Error())
 	}
+	testKeys, err = keyset.New(testPrivateKey, nil, nil)
+	if err != nil {
+		panic("failed to build test key set: " + err.Error())
+	}
 }
 
 const (
 	testAccessTTL  = time.Minute
 	testRefreshTTL = time.Hour
+	testTOTPIssuer = "FrameWork"
 
 	// Валидные UUID для использования в тестах
 	testUUID1 = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
@@ -261,14 +312,14 @@ const (
 
 // newTestService создаёт AuthService с подменёнными зависимостями
 func newTestService(userRepo postgresDB.UserRepository, authRepo redisDB.AuthRepository) *AuthService {
-	db := &postgresDB.DatabaseRepository{User: userRepo}
+	db := &postgresDB.DatabaseRepository{User: userRepo, TwoFactor: emptyTwoFactorRepo()}
 	cache := &redisDB.CacheRepository{
 		Auth:         authRepo,
 		Verification: emptyVerificationRepo(),
 		Recovery:     emptyRecoveryRepo(),
 		TwoFA:        emptyTwoFARepo(),
 	}
-	return NewAuthService(db, cache, emptyPublisher(), testPrivateKey, testAccessTTL, testRefreshTTL, "test")
+	return NewAuthService(db, cache, emptyPublisher(), testKeys, testAccessTTL, testRefreshTTL, "test", testTOTPIssuer)
 }
 
 // emptyUserRepo — заглушка для тестов, где UserRepository не должен вызываться
@@ -305,6 +356,7 @@ type svcDeps struct {
 	verification redisDB.VerificationRepository
 	recovery     redisDB.RecoveryRepository
 	twoFA        redisDB.TwoFARepository
+	twoFactor    postgresDB.TwoFactorRepository
 	publisher    messaging.Publisher
 	appEnv       string
 }
@@ -326,18 +378,21 @@ func buildSvc(d svcDeps) *AuthService {
 	if d.twoFA == nil {
 		d.twoFA = emptyTwoFARepo()
 	}
+	if d.twoFactor == nil {
+		d.twoFactor = emptyTwoFactorRepo()
+	}
 	if d.publisher == nil {
 		d.publisher = emptyPublisher()
 	}
 	if d.appEnv == "" {
 		d.appEnv = "test"
 	}
-	db := &postgresDB.DatabaseRepository{User: d.user}
+	db := &postgresDB.DatabaseRepository{User: d.user, TwoFactor: d.twoFactor}
 	cache := &redisDB.CacheRepository{
 		Auth:         d.auth,
 		Verification: d.verification,
 		Recovery:     d.recovery,
 		TwoFA:        d.twoFA,
 	}
-	return NewAuthService(db, cache, d.publisher, testPrivateKey, testAccessTTL, testRefreshTTL, d.appEnv)
+	return NewAuthService(db, cache, d.publisher, testKeys, testAccessTTL, testRefreshTTL, d.appEnv, testTOTPIssuer)
 }
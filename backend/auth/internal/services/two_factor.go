@@ -0,0 +1,232 @@
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/unwelcome/FrameWorkTask1/backend/auth/internal/entities"
+	"github.com/unwelcome/FrameWorkTask1/backend/auth/pkg/password"
+	"github.com/unwelcome/FrameWorkTask1/backend/auth/pkg/totp"
+	"github.com/unwelcome/FrameWorkTask1/backend/auth/pkg/utils"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/auth/generated"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// EnrollTOTP Начало подключения приложения-аутентификатора: генерирует секрет и otpauth URI для QR-кода.
+// Секрет вступает в силу только после ConfirmTOTP.
+func (s *AuthService) EnrollTOTP(ctx context.Context, req *pb.EnrollTOTPRequest) (*pb.EnrollTOTPResponse, error) {
+	if err := validate.UUID(req.GetUserUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user uuid")
+	}
+
+	user, getErr := s.db.User.GetUser(ctx, entities.GetUserDTO{UserUUID: req.GetUserUuid()})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+	if user.DeletedAt != nil {
+		return nil, status.Error(codes.PermissionDenied, deletedAccountMessage(*user.DeletedAt))
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	if err = s.cache.TwoFA.SavePendingTOTP(ctx, entities.SavePendingTOTPDTO{
+		UserUUID: user.UserUUID,
+		Secret:   secret,
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &pb.EnrollTOTPResponse{
+		Secret:     secret,
+		OtpauthUri: totp.URI(s.totpIssuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmTOTP Подтверждение подключения аутентификатора кодом из приложения.
+// Включает 2FA с TOTP основным способом и возвращает одноразовые коды восстановления - они показываются один раз.
+func (s *AuthService) ConfirmTOTP(ctx context.Context, req *pb.ConfirmTOTPRequest) (*pb.ConfirmTOTPResponse, error) {
+	if err := validate.UUID(req.GetUserUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user uuid")
+	}
+	if err := validate.User2FACCode(req.GetCode()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid code format")
+	}
+
+	secret, getErr := s.cache.TwoFA.GetPendingTOTP(ctx, entities.GetPendingTOTPDTO{UserUUID: req.GetUserUuid()})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	step, ok := totp.Validate(secret, req.GetCode(), time.Now())
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid or expired code")
+	}
+
+	recoveryCodes, err := utils.GenerateRecoveryCodes(recoveryCodesCount)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	hashed := make([]entities.RecoveryCode, 0, len(recoveryCodes))
+	for _, code := range recoveryCodes {
+		codeHash, hashErr := password.Hash(ctx, code)
+		if hashErr != nil {
+			if errors.Is(hashErr, password.ErrOverloaded) {
+				return nil, status.Errorf(codes.ResourceExhausted, "server is busy, please retry")
+			}
+			return nil, status.Errorf(codes.Internal, "internal error")
+		}
+		hashed = append(hashed, entities.RecoveryCode{
+			UUID:     uuid.Must(uuid.NewV7()).String(),
+			CodeHash: codeHash,
+		})
+	}
+
+	if err = s.db.TwoFactor.EnableTOTP(ctx, entities.EnableTOTPDTO{
+		UserUUID:      req.GetUserUuid(),
+		Secret:        secret,
+		RecoveryCodes: hashed,
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	_ = s.cache.TwoFA.DeletePendingTOTP(ctx, entities.DeletePendingTOTPDTO{UserUUID: req.GetUserUuid()})
+
+	// Код подтверждения не должен повторно пройти как второй фактор при входе
+	_, _ = s.cache.TwoFA.AcquireTOTPStep(ctx, entities.AcquireTOTPStepDTO{UserUUID: req.GetUserUuid(), Step: step})
+
+	return &pb.ConfirmTOTPResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+// Send2FAEmailCode Запасной способ: отправка кода 2FA на почту для начатой авторизации.
+// Нужен пользователям с TOTP, у которых нет доступа к аутентификатору, и для повторной отправки письма.
+func (s *AuthService) Send2FAEmailCode(ctx context.Context, req *pb.Send2FAEmailCodeRequest) (*emptypb.Empty, error) {
+	if err := validate.UUID(req.GetSessionUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid session uuid")
+	}
+
+	data, getErr := s.cache.TwoFA.Get2FAData(ctx, entities.Get2FADataDTO{SessionUUID: req.GetSessionUuid()})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	if err := s.check2FAEmailLimits(ctx, data.UserUUID); err != nil {
+		return nil, err
+	}
+
+	code, err := utils.GenerateTwoFACode()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	// Счётчик попыток и время жизни сессии не сбрасываются - иначе повторная отправка снимала бы лимит попыток
+	if err = s.cache.TwoFA.Update2FACode(ctx, entities.Update2FACodeDTO{
+		SessionUUID: req.GetSessionUuid(),
+		Code:        code,
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	_ = s.publisher.Send2FAEmail(ctx, entities.TwoFAEmailMsg{
+		UserUUID:  data.UserUUID,
+		Email:     data.Email,
+		FirstName: data.FirstName,
+		Code:      code,
+	})
+
+	return &emptypb.Empty{}, nil
+}
+
+// ─── Вспомогательные функции ──────────────────────────────────────────────────
+
+// check2FAEmailLimits Cooldown и суточный лимит отправок писем с кодом 2FA
+func (s *AuthService) check2FAEmailLimits(ctx context.Context, userUUID string) error {
+	// Rate limiting: cooldown между отправками 2FA писем
+	allowed, rateLimitErr := s.cache.TwoFA.Acquire2FAEmailCooldown(ctx, entities.Acquire2FAEmailCooldownDTO{UserUUID: userUUID})
+	if err := rateLimitErr.GRPCError(); err != nil {
+		return err
+	}
+	if !allowed {
+		return status.Errorf(codes.ResourceExhausted, "please wait before requesting a new 2FA code")
+	}
+
+	// Rate limiting: суточный лимит отправок 2FA писем
+	count, countErr := s.cache.TwoFA.Incr2FAEmailDailyCount(ctx, entities.Incr2FAEmailDailyCountDTO{UserUUID: userUUID})
+	if err := countErr.GRPCError(); err != nil {
+		return err
+	}
+	if count > max2FAEmailDailyCount {
+		return status.Errorf(codes.ResourceExhausted, "daily 2FA email limit reached")
+	}
+
+	return nil
+}
+
+// check2FACode Проверяет код выбранного способа 2FA. Возвращает false, если код не подошёл.
+func (s *AuthService) check2FACode(ctx context.Context, data *entities.TwoFAData, method, code string) (bool, error) {
+	switch method {
+	case entities.TwoFAMethodEmail:
+		// Сравниваем code в constant-time, чтобы не утекало число совпавших символов через тайминг.
+		// Пустой код - письмо в этой сессии не отправлялось.
+		return data.Code != "" && subtle.ConstantTimeCompare([]byte(data.Code), []byte(code)) == 1, nil
+
+	case entities.TwoFAMethodTOTP:
+		user, getErr := s.db.User.GetUser(ctx, entities.GetUserDTO{UserUUID: data.UserUUID})
+		if err := getErr.GRPCError(); err != nil {
+			return false, err
+		}
+		if user.TOTPSecret == "" {
+			return false, nil
+		}
+
+		step, ok := totp.Validate(user.TOTPSecret, code, time.Now())
+		if !ok {
+			return false, nil
+		}
+
+		// Один код принимается один раз - перехваченный код нельзя использовать повторно
+		acquired, acquireErr := s.cache.TwoFA.AcquireTOTPStep(ctx, entities.AcquireTOTPStepDTO{UserUUID: data.UserUUID, Step: step})
+		if err := acquireErr.GRPCError(); err != nil {
+			return false, err
+		}
+		return acquired, nil
+
+	case entities.TwoFAMethodRecovery:
+		recoveryCodes, getErr := s.db.TwoFactor.GetRecoveryCodes(ctx, entities.GetRecoveryCodesDTO{UserUUID: data.UserUUID})
+		if err := getErr.GRPCError(); err != nil {
+			return false, err
+		}
+
+		for _, recoveryCode := range recoveryCodes {
+			ok, err := password.Verify(ctx, recoveryCode.CodeHash, code)
+			if errors.Is(err, password.ErrOverloaded) {
+				return false, status.Errorf(codes.ResourceExhausted, "server is busy, please retry")
+			}
+			if err != nil || !ok {
+				continue
+			}
+
+			// Код мог быть использован параллельным запросом
+			useErr := s.db.TwoFactor.UseRecoveryCode(ctx, entities.UseRecoveryCodeDTO{UUID: recoveryCode.UUID})
+			if useErr.Code == codes.NotFound {
+				return false, nil
+			}
+			if err = useErr.GRPCError(); err != nil {
+				return false, err
+			}
+			return true, nil
+		}
+		return false, nil
+	}
+
+	return false, nil
+}
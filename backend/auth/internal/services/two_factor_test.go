@@ -0,0 +1,394 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/auth/internal/entities"
+	"github.com/unwelcome/FrameWorkTask1/backend/auth/pkg/password"
+	"github.com/unwelcome/FrameWorkTask1/backend/auth/pkg/totp"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/auth/generated"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
+)
+
+// ─── Helpers ─────────────────────────────────────────────────────────────────
+
+// testTOTPSecret — секрет аутентификатора для тестов (RFC 6238, "12345678901234567890" в base32)
+const testTOTPSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func currentTOTPCode(t *testing.T) string {
+	t.Helper()
+	code, err := totp.Code(testTOTPSecret, time.Now())
+	if err != nil {
+		t.Fatalf("failed to compute TOTP code: %v", err)
+	}
+	return code
+}
+
+// totpSession — мок TwoFARepository с активной сессией авторизации с указанным способом 2FA
+func totpSession(method string) *mockTwoFARepo {
+	repo := emptyTwoFARepo()
+	repo.get2FAData = func(_ context.Context, _ entities.Get2FADataDTO) (*entities.TwoFAData, Error.CodeError) {
+		return &entities.TwoFAData{UserUUID: testUUID1, Email: "test@example.com", FirstName: "Ivan", Method: method}, ok()
+	}
+	repo.incr2FAAttempts = func(_ context.Context, _ entities.Incr2FAAttemptsDTO) (int64, Error.CodeError) {
+		return 1, ok()
+	}
+	return repo
+}
+
+// totpUser — мок UserRepository с подключённым аутентификатором
+func totpUser() *mockUserRepo {
+	return &mockUserRepo{
+		getUser: func(_ context.Context, _ entities.GetUserDTO) (*entities.UserGet, Error.CodeError) {
+			return &entities.UserGet{UserUUID: testUUID1, Email: "test@example.com", TwoFAMethod: "totp", TOTPSecret: testTOTPSecret}, ok()
+		},
+	}
+}
+
+func sessionAuthRepo() *mockAuthRepo {
+	return &mockAuthRepo{
+		saveSession: func(_ context.Context, _ entities.SaveSessionDTO) Error.CodeError { return ok() },
+	}
+}
+
+// ─── EnrollTOTP ──────────────────────────────────────────────────────────────
+
+func TestEnrollTOTP(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var pending entities.SavePendingTOTPDTO
+		twoFARepo := emptyTwoFARepo()
+		twoFARepo.savePendingTOTP = func(_ context.Context, dto entities.SavePendingTOTPDTO) Error.CodeError {
+			pending = dto
+			return ok()
+		}
+		svc := buildSvc(svcDeps{user: totpUser(), twoFA: twoFARepo})
+
+		resp, err := svc.EnrollTOTP(context.Background(), &pb.EnrollTOTPRequest{UserUuid: testUUID1})
+
+		assertNoError(t, err)
+		if resp.GetSecret() == "" || pending.Secret != resp.GetSecret() || pending.UserUUID != testUUID1 {
+			t.Errorf("pending secret not saved: %+v", pending)
+		}
+		if !strings.HasPrefix(resp.GetOtpauthUri(), "otpauth://totp/"+testTOTPIssuer+":test@example.com?") ||
+			!strings.Contains(resp.GetOtpauthUri(), "secret="+resp.GetSecret()) {
+			t.Errorf("unexpected otpauth uri: %q", resp.GetOtpauthUri())
+		}
+	})
+
+	t.Run("invalid_uuid", func(t *testing.T) {
+		svc := buildSvc(svcDeps{})
+
+		_, err := svc.EnrollTOTP(context.Background(), &pb.EnrollTOTPRequest{UserUuid: "not-a-uuid"})
+
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("account_deleted", func(t *testing.T) {
+		deletedAt := time.Now().Add(-time.Hour)
+		userRepo := &mockUserRepo{
+			getUser: func(_ context.Context, _ entities.GetUserDTO) (*entities.UserGet, Error.CodeError) {
+				return &entities.UserGet{UserUUID: testUUID1, DeletedAt: &deletedAt}, ok()
+			},
+		}
+		svc := buildSvc(svcDeps{user: userRepo})
+
+		_, err := svc.EnrollTOTP(context.Background(), &pb.EnrollTOTPRequest{UserUuid: testUUID1})
+
+		assertCode(t, err, codes.PermissionDenied)
+	})
+}
+
+// ─── ConfirmTOTP ─────────────────────────────────────────────────────────────
+
+func TestConfirmTOTP(t *testing.T) {
+	pendingRepo := func() *mockTwoFARepo {
+		repo := emptyTwoFARepo()
+		repo.getPendingTOTP = func(_ context.Context, _ entities.GetPendingTOTPDTO) (string, Error.CodeError) {
+			return testTOTPSecret, ok()
+		}
+		return repo
+	}
+
+	t.Run("success", func(t *testing.T) {
+		var enabled entities.EnableTOTPDTO
+		pendingDeleted := false
+		twoFARepo := pendingRepo()
+		twoFARepo.deletePendingTOTP = func(_ context.Context, _ entities.DeletePendingTOTPDTO) Error.CodeError {
+			pendingDeleted = true
+			return ok()
+		}
+		twoFactorRepo := &mockTwoFactorRepo{
+			enableTOTP: func(_ context.Context, dto entities.EnableTOTPDTO) Error.CodeError {
+				enabled = dto
+				return ok()
+			},
+		}
+		svc := buildSvc(svcDeps{twoFA: twoFARepo, twoFactor: twoFactorRepo})
+
+		resp, err := svc.ConfirmTOTP(context.Background(), &pb.ConfirmTOTPRequest{UserUuid: testUUID1, Code: currentTOTPCode(t)})
+
+		assertNoError(t, err)
+		if len(resp.GetRecoveryCodes()) != recoveryCodesCount || len(enabled.RecoveryCodes) != recoveryCodesCount {
+			t.Fatalf("expected %d recovery codes, got %d (stored %d)", recoveryCodesCount, len(resp.GetRecoveryCodes()), len(enabled.RecoveryCodes))
+		}
+		if enabled.UserUUID != testUUID1 || enabled.Secret != testTOTPSecret {
+			t.Errorf("unexpected enable dto: %+v", enabled)
+		}
+		for i, code := range resp.GetRecoveryCodes() {
+			if err := validate.User2FARecoveryCode(code); err != nil {
+				t.Errorf("recovery code %q has invalid format", code)
+			}
+			if enabled.RecoveryCodes[i].CodeHash == code {
+				t.Fatal("recovery code stored in plain text")
+			}
+			if match, _ := password.Verify(context.Background(), enabled.RecoveryCodes[i].CodeHash, code); !match {
+				t.Errorf("recovery code %q does not match its hash", code)
+			}
+		}
+		if !pendingDeleted {
+			t.Error("expected pending secret to be deleted")
+		}
+	})
+
+	t.Run("wrong_code", func(t *testing.T) {
+		code := "000000"
+		if currentTOTPCode(t) == code {
+			code = "111111"
+		}
+		svc := buildSvc(svcDeps{twoFA: pendingRepo()})
+
+		_, err := svc.ConfirmTOTP(context.Background(), &pb.ConfirmTOTPRequest{UserUuid: testUUID1, Code: code})
+
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("invalid_code_format", func(t *testing.T) {
+		svc := buildSvc(svcDeps{})
+
+		_, err := svc.ConfirmTOTP(context.Background(), &pb.ConfirmTOTPRequest{UserUuid: testUUID1, Code: "12ab"})
+
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("enrollment_not_found", func(t *testing.T) {
+		twoFARepo := emptyTwoFARepo()
+		twoFARepo.getPendingTOTP = func(_ context.Context, _ entities.GetPendingTOTPDTO) (string, Error.CodeError) {
+			return "", Error.Public(codes.NotFound, "TOTP enrollment not found or expired")
+		}
+		svc := buildSvc(svcDeps{twoFA: twoFARepo})
+
+		_, err := svc.ConfirmTOTP(context.Background(), &pb.ConfirmTOTPRequest{UserUuid: testUUID1, Code: "123456"})
+
+		assertCode(t, err, codes.NotFound)
+	})
+
+	t.Run("db_error", func(t *testing.T) {
+		twoFactorRepo := &mockTwoFactorRepo{
+			enableTOTP: func(_ context.Context, _ entities.EnableTOTPDTO) Error.CodeError {
+				return Error.Internal(fmt.Errorf("db error"))
+			},
+		}
+		svc := buildSvc(svcDeps{twoFA: pendingRepo(), twoFactor: twoFactorRepo})
+
+		_, err := svc.ConfirmTOTP(context.Background(), &pb.ConfirmTOTPRequest{UserUuid: testUUID1, Code: currentTOTPCode(t)})
+
+		assertCode(t, err, codes.Internal)
+	})
+}
+
+// ─── Send2FAEmailCode ────────────────────────────────────────────────────────
+
+func TestSend2FAEmailCode(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var updated entities.Update2FACodeDTO
+		var sent entities.TwoFAEmailMsg
+		twoFARepo := totpSession("totp")
+		twoFARepo.update2FACode = func(_ context.Context, dto entities.Update2FACodeDTO) Error.CodeError {
+			updated = dto
+			return ok()
+		}
+		pub := emptyPublisher().(*mockPublisher)
+		pub.send2FAEmail = func(_ context.Context, dto entities.TwoFAEmailMsg) Error.CodeError {
+			sent = dto
+			return ok()
+		}
+		svc := buildSvc(svcDeps{twoFA: twoFARepo, publisher: pub})
+
+		_, err := svc.Send2FAEmailCode(context.Background(), &pb.Send2FAEmailCodeRequest{SessionUuid: testUUID2})
+
+		assertNoError(t, err)
+		if updated.SessionUUID != testUUID2 || updated.Code == "" {
+			t.Errorf("unexpected code update: %+v", updated)
+		}
+		if sent.Code != updated.Code || sent.Email != "test@example.com" {
+			t.Errorf("unexpected email: %+v", sent)
+		}
+	})
+
+	t.Run("cooldown_active", func(t *testing.T) {
+		twoFARepo := totpSession("totp")
+		twoFARepo.acquire2FAEmailCooldown = func(_ context.Context, _ entities.Acquire2FAEmailCooldownDTO) (bool, Error.CodeError) {
+			return false, ok()
+		}
+		svc := buildSvc(svcDeps{twoFA: twoFARepo})
+
+		_, err := svc.Send2FAEmailCode(context.Background(), &pb.Send2FAEmailCodeRequest{SessionUuid: testUUID2})
+
+		assertCode(t, err, codes.ResourceExhausted)
+	})
+
+	t.Run("daily_limit_reached", func(t *testing.T) {
+		twoFARepo := totpSession("totp")
+		twoFARepo.incr2FAEmailDailyCount = func(_ context.Context, _ entities.Incr2FAEmailDailyCountDTO) (int64, Error.CodeError) {
+			return max2FAEmailDailyCount + 1, ok()
+		}
+		svc := buildSvc(svcDeps{twoFA: twoFARepo})
+
+		_, err := svc.Send2FAEmailCode(context.Background(), &pb.Send2FAEmailCodeRequest{SessionUuid: testUUID2})
+
+		assertCode(t, err, codes.ResourceExhausted)
+	})
+
+	t.Run("session_not_found", func(t *testing.T) {
+		twoFARepo := emptyTwoFARepo()
+		twoFARepo.get2FAData = func(_ context.Context, _ entities.Get2FADataDTO) (*entities.TwoFAData, Error.CodeError) {
+			return nil, Error.Public(codes.NotFound, "2FA code not found")
+		}
+		svc := buildSvc(svcDeps{twoFA: twoFARepo})
+
+		_, err := svc.Send2FAEmailCode(context.Background(), &pb.Send2FAEmailCodeRequest{SessionUuid: testUUID2})
+
+		assertCode(t, err, codes.NotFound)
+	})
+
+	t.Run("invalid_session_uuid", func(t *testing.T) {
+		svc := buildSvc(svcDeps{})
+
+		_, err := svc.Send2FAEmailCode(context.Background(), &pb.Send2FAEmailCodeRequest{SessionUuid: "not-a-uuid"})
+
+		assertCode(t, err, codes.InvalidArgument)
+	})
+}
+
+// ─── Verify2FA (TOTP и коды восстановления) ──────────────────────────────────
+
+func TestVerify2FA_Methods(t *testing.T) {
+	t.Run("totp_by_session_method", func(t *testing.T) {
+		svc := buildSvc(svcDeps{user: totpUser(), auth: sessionAuthRepo(), twoFA: totpSession("totp")})
+
+		resp, err := svc.Verify2FA(context.Background(), &pb.Verify2FARequest{SessionUuid: testUUID2, Code: currentTOTPCode(t)})
+
+		assertNoError(t, err)
+		if resp.GetAccessToken() == "" {
+			t.Error("expected non-empty tokens")
+		}
+	})
+
+	t.Run("totp_code_replay", func(t *testing.T) {
+		twoFARepo := totpSession("totp")
+		twoFARepo.acquireTOTPStep = func(_ context.Context, _ entities.AcquireTOTPStepDTO) (bool, Error.CodeError) {
+			return false, ok()
+		}
+		svc := buildSvc(svcDeps{user: totpUser(), twoFA: twoFARepo})
+
+		_, err := svc.Verify2FA(context.Background(), &pb.Verify2FARequest{SessionUuid: testUUID2, Code: currentTOTPCode(t)})
+
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("totp_not_enrolled", func(t *testing.T) {
+		userRepo := &mockUserRepo{
+			getUser: func(_ context.Context, _ entities.GetUserDTO) (*entities.UserGet, Error.CodeError) {
+				return &entities.UserGet{UserUUID: testUUID1}, ok()
+			},
+		}
+		svc := buildSvc(svcDeps{user: userRepo, twoFA: totpSession("email")})
+
+		_, err := svc.Verify2FA(context.Background(), &pb.Verify2FARequest{SessionUuid: testUUID2, Code: currentTOTPCode(t), Method: "totp"})
+
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("email_fallback_for_totp_session", func(t *testing.T) {
+		twoFARepo := totpSession("totp")
+		twoFARepo.get2FAData = func(_ context.Context, _ entities.Get2FADataDTO) (*entities.TwoFAData, Error.CodeError) {
+			return &entities.TwoFAData{UserUUID: testUUID1, Method: "totp", Code: "123456"}, ok()
+		}
+		svc := buildSvc(svcDeps{auth: sessionAuthRepo(), twoFA: twoFARepo})
+
+		_, err := svc.Verify2FA(context.Background(), &pb.Verify2FARequest{SessionUuid: testUUID2, Code: "123456", Method: "email"})
+
+		assertNoError(t, err)
+	})
+
+	t.Run("email_code_not_sent", func(t *testing.T) {
+		svc := buildSvc(svcDeps{twoFA: totpSession("totp")})
+
+		_, err := svc.Verify2FA(context.Background(), &pb.Verify2FARequest{SessionUuid: testUUID2, Code: "123456", Method: "email"})
+
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("recovery_code", func(t *testing.T) {
+		usedUUID := ""
+		twoFactorRepo := &mockTwoFactorRepo{
+			getRecoveryCodes: func(_ context.Context, _ entities.GetRecoveryCodesDTO) ([]*entities.RecoveryCode, Error.CodeError) {
+				return []*entities.RecoveryCode{
+					{UUID: "first", CodeHash: hashPassword(t, "aaaaa-bbbbb")},
+					{UUID: "second", CodeHash: hashPassword(t, "ccccc-ddddd")},
+				}, ok()
+			},
+			useRecoveryCode: func(_ context.Context, dto entities.UseRecoveryCodeDTO) Error.CodeError {
+				usedUUID = dto.UUID
+				return ok()
+			},
+		}
+		svc := buildSvc(svcDeps{auth: sessionAuthRepo(), twoFA: totpSession("totp"), twoFactor: twoFactorRepo})
+
+		_, err := svc.Verify2FA(context.Background(), &pb.Verify2FARequest{SessionUuid: testUUID2, Code: "ccccc-ddddd", Method: "recovery"})
+
+		assertNoError(t, err)
+		if usedUUID != "second" {
+			t.Errorf("expected recovery code %q to be used, got %q", "second", usedUUID)
+		}
+	})
+
+	t.Run("recovery_code_already_used", func(t *testing.T) {
+		twoFactorRepo := &mockTwoFactorRepo{
+			getRecoveryCodes: func(_ context.Context, _ entities.GetRecoveryCodesDTO) ([]*entities.RecoveryCode, Error.CodeError) {
+				return []*entities.RecoveryCode{{UUID: "first", CodeHash: hashPassword(t, "aaaaa-bbbbb")}}, ok()
+			},
+			useRecoveryCode: func(_ context.Context, _ entities.UseRecoveryCodeDTO) Error.CodeError {
+				return Error.Public(codes.NotFound, "recovery code not found")
+			},
+		}
+		svc := buildSvc(svcDeps{twoFA: totpSession("totp"), twoFactor: twoFactorRepo})
+
+		_, err := svc.Verify2FA(context.Background(), &pb.Verify2FARequest{SessionUuid: testUUID2, Code: "aaaaa-bbbbb", Method: "recovery"})
+
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("invalid_recovery_code_format", func(t *testing.T) {
+		svc := buildSvc(svcDeps{})
+
+		_, err := svc.Verify2FA(context.Background(), &pb.Verify2FARequest{SessionUuid: testUUID2, Code: "123456", Method: "recovery"})
+
+		assertCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("unknown_method", func(t *testing.T) {
+		svc := buildSvc(svcDeps{})
+
+		_, err := svc.Verify2FA(context.Background(), &pb.Verify2FARequest{SessionUuid: testUUID2, Code: "123456", Method: "sms"})
+
+		assertCode(t, err, codes.InvalidArgument)
+	})
+}
@@ -0,0 +1,163 @@
+package keyset
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"time"
+)
+
+// Статусы ключей подписи.
+// active  — текущий ключ: им подписываются новые токены;
+// next    — следующий ключ: уже опубликован в JWKS, чтобы gateway загрузил его до ротации;
+// retired — выведенный ключ: не подписывает, но токены, подписанные им, ещё проверяются
+// (refresh токены живут дольше access токенов - иначе ротация разлогинила бы всех).
+const (
+	StatusActive  = "active"
+	StatusNext    = "next"
+	StatusRetired = "retired"
+)
+
+// Key Ключ подписи с идентификатором kid
+type Key struct {
+	ID        string
+	Status    string
+	Public    *ecdsa.PublicKey
+	RetiredAt time.Time
+}
+
+// Retired Выведенный ключ и момент его вывода из подписи. Момент задаётся в конфигурации auth и публикуется
+// в JWKS: gateway отсчитывает от него grace-период, одинаковый на всех репликах и после перезапусков.
+type Retired struct {
+	Public *ecdsa.PublicKey
+	At     time.Time
+}
+
+// JWK Публичный ключ в формате RFC 7517
+type JWK struct {
+	Kid    string
+	Kty    string
+	Crv    string
+	X      string
+	Y      string
+	Alg    string
+	Use    string
+	Status string
+	// RetiredAt Unix время вывода ключа, только для retired
+	RetiredAt int64
+}
+
+// KeySet Набор ключей подписи JWT: один активный, необязательный следующий и выведенные
+type KeySet struct {
+	activeID  string
+	activeKey *ecdsa.PrivateKey
+	keys      []Key
+	byID      map[string]Key
+}
+
+// New Собирает набор ключей. kid каждого ключа — RFC 7638 thumbprint, одинаковый на всех репликах без настройки.
+func New(active, next *ecdsa.PrivateKey, retired []Retired) (*KeySet, error) {
+	if active == nil {
+		return nil, fmt.Errorf("active key is required")
+	}
+
+	s := &KeySet{
+		activeKey: active,
+		byID:      make(map[string]Key),
+	}
+
+	s.activeID = Thumbprint(&active.PublicKey)
+	if err := s.add(Key{ID: s.activeID, Status: StatusActive, Public: &active.PublicKey}); err != nil {
+		return nil, err
+	}
+	if next != nil {
+		if err := s.add(Key{ID: Thumbprint(&next.PublicKey), Status: StatusNext, Public: &next.PublicKey}); err != nil {
+			return nil, err
+		}
+	}
+	for _, key := range retired {
+		if key.At.IsZero() {
+			return nil, fmt.Errorf("retired key %s: retirement time is required", Thumbprint(key.Public))
+		}
+		if err := s.add(Key{ID: Thumbprint(key.Public), Status: StatusRetired, Public: key.Public, RetiredAt: key.At}); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// Active Возвращает kid и приватный ключ для подписи новых токенов
+func (s *KeySet) Active() (string, *ecdsa.PrivateKey) {
+	return s.activeID, s.activeKey
+}
+
+// PublicKey Ключ проверки подписи по kid. Токены без kid выпущены до ротации ключей - проверяются активным ключом.
+func (s *KeySet) PublicKey(kid string) (*ecdsa.PublicKey, error) {
+	if kid == "" {
+		return &s.activeKey.PublicKey, nil
+	}
+	key, ok := s.byID[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id: %s", kid)
+	}
+	return key.Public, nil
+}
+
+// JWKS Публичные ключи набора для /.well-known/jwks.json
+func (s *KeySet) JWKS() []JWK {
+	jwks := make([]JWK, 0, len(s.keys))
+	for _, key := range s.keys {
+		x, y := coordinates(key.Public)
+		jwk := JWK{
+			Kid:    key.ID,
+			Kty:    "EC",
+			Crv:    "P-256",
+			X:      x,
+			Y:      y,
+			Alg:    "ES256",
+			Use:    "sig",
+			Status: key.Status,
+		}
+		if !key.RetiredAt.IsZero() {
+			jwk.RetiredAt = key.RetiredAt.Unix()
+		}
+		jwks = append(jwks, jwk)
+	}
+	return jwks
+}
+
+// Thumbprint RFC 7638 thumbprint EC ключа: base64url(SHA-256) канонического JWK
+func Thumbprint(pub *ecdsa.PublicKey) string {
+	x, y := coordinates(pub)
+	canonical := fmt.Sprintf(`{"crv":"P-256","kty":"EC","x":"%s","y":"%s"}`, x, y)
+	sum := sha256.Sum256([]byte(canonical))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// ─── Вспомогательные функции ──────────────────────────────────────────────────
+
+func (s *KeySet) add(key Key) error {
+	if key.Public.Curve != elliptic.P256() {
+		return fmt.Errorf("key %s: only P-256 keys are supported", key.ID)
+	}
+	if _, exists := s.byID[key.ID]; exists {
+		return fmt.Errorf("key %s is configured twice", key.ID)
+	}
+	s.keys = append(s.keys, key)
+	s.byID[key.ID] = key
+	return nil
+}
+
+// coordinates Координаты точки ключа в base64url, по 32 байта (RFC 7518 §6.2.1.2).
+// Несжатая точка P-256: 0x04 || X || Y.
+func coordinates(pub *ecdsa.PublicKey) (string, string) {
+	point, err := pub.ECDH()
+	if err != nil {
+		return "", ""
+	}
+	raw := point.Bytes()
+	return base64.RawURLEncoding.EncodeToString(raw[1:33]), base64.RawURLEncoding.EncodeToString(raw[33:])
+}
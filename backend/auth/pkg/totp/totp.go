@@ -0,0 +1,98 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Параметры RFC 6238 — значения по умолчанию, которые понимают все приложения-аутентификаторы
+// (Google Authenticator, Яндекс Ключ, Aegis и т.д.)
+const (
+	Period = 30 * time.Second
+	Digits = 6
+
+	secretLen = 20 // 160-bit секрет, рекомендованный RFC 4226 для HMAC-SHA1
+	skew      = 1  // допустимое расхождение часов клиента и сервера, в шагах
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret Генерирует случайный секрет в base32 (без паддинга), как его ожидают аутентификаторы
+func GenerateSecret() (string, error) {
+	secret := make([]byte, secretLen)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("generate totp secret: %w", err)
+	}
+	return encoding.EncodeToString(secret), nil
+}
+
+// URI Формирует otpauth:// URI для QR-кода. account — логин пользователя (email), issuer — название сервиса.
+func URI(issuer, account, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(Digits))
+	query.Set("period", fmt.Sprint(int(Period.Seconds())))
+
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(account)
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+// Code Вычисляет код для момента t
+func Code(secret string, t time.Time) (string, error) {
+	key, err := encoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", fmt.Errorf("decode totp secret: %w", err)
+	}
+	return hotp(key, step(t)), nil
+}
+
+// Validate Проверяет код с учётом расхождения часов на ±1 шаг.
+// Возвращает номер шага, которому соответствует код, — по нему вызывающий защищается от повторного использования кода.
+func Validate(secret, code string, t time.Time) (int64, bool) {
+	key, err := encoding.DecodeString(strings.ToUpper(secret))
+	if err != nil || len(code) != Digits {
+		return 0, false
+	}
+
+	current := step(t)
+	for i := current - skew; i <= current+skew; i++ {
+		if subtle.ConstantTimeCompare([]byte(hotp(key, i)), []byte(code)) == 1 {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// step Номер 30-секундного интервала с начала эпохи Unix
+func step(t time.Time) int64 {
+	return t.Unix() / int64(Period.Seconds())
+}
+
+// hotp Код HOTP по RFC 4226 (HMAC-SHA1 + dynamic truncation)
+func hotp(key []byte, counter int64) string {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for range Digits {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", Digits, value%mod)
+}
@@ -14,3 +14,28 @@ func GenerateTwoFACode() (string, error) {
 	}
 	return fmt.Sprintf("%06d", n.Int64()), nil
 }
+
+// recoveryCodeAlphabet Алфавит кодов восстановления 2FA без похожих символов (0/o, 1/l/i)
+const recoveryCodeAlphabet = "23456789abcdefghjkmnpqrstuvwxyz"
+
+// GenerateRecoveryCodes Генерирует count одноразовых кодов восстановления 2FA вида xxxxx-xxxxx
+func GenerateRecoveryCodes(count int) ([]string, error) {
+	max := big.NewInt(int64(len(recoveryCodeAlphabet)))
+	codes := make([]string, 0, count)
+	for range count {
+		code := make([]byte, 11)
+		for i := range code {
+			if i == 5 {
+				code[i] = '-'
+				continue
+			}
+			n, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				return nil, fmt.Errorf("generate recovery code: %w", err)
+			}
+			code[i] = recoveryCodeAlphabet[n.Int64()]
+		}
+		codes = append(codes, string(code))
+	}
+	return codes, nil
+}
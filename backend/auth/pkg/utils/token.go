@@ -1,7 +1,6 @@
 package utils
 
 import (
-	"crypto/ecdsa"
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
@@ -11,17 +10,18 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/unwelcome/FrameWorkTask1/backend/auth/internal/entities"
+	"github.com/unwelcome/FrameWorkTask1/backend/auth/pkg/keyset"
 )
 
-// CreateTokens Генерация пары access и refresh токенов
-func CreateTokens(userUUID string, privateKey *ecdsa.PrivateKey, accessTokenLifetime, refreshTokenLifetime time.Duration) (*entities.TokenPair, error) {
+// CreateTokens Генерация пары access и refresh токенов, привязанных к сессии sessionUUID
+func CreateTokens(userUUID, sessionUUID string, keys *keyset.KeySet, accessTokenLifetime, refreshTokenLifetime time.Duration) (*entities.TokenPair, error) {
 
-	accessToken, err := generateToken(userUUID, privateKey, entities.AccessTokenType, accessTokenLifetime)
+	accessToken, err := generateToken(userUUID, sessionUUID, keys, entities.AccessTokenType, accessTokenLifetime)
 	if err != nil {
 		return nil, err
 	}
 
-	refreshToken, err := generateToken(userUUID, privateKey, entities.RefreshTokenType, refreshTokenLifetime)
+	refreshToken, err := generateToken(userUUID, sessionUUID, keys, entities.RefreshTokenType, refreshTokenLifetime)
 	if err != nil {
 		return nil, err
 	}
@@ -29,19 +29,9 @@ func CreateTokens(userUUID string, privateKey *ecdsa.PrivateKey, accessTokenLife
 	return &entities.TokenPair{AccessToken: accessToken, RefreshToken: refreshToken}, nil
 }
 
-// ParseToken Парсинг jwt токена (верификация публичным ключом, извлечённым из приватного)
-func ParseToken(tokenString string, privateKey *ecdsa.PrivateKey) (*entities.TokenClaims, error) {
-	return parseTokenWithPublicKey(tokenString, &privateKey.PublicKey)
-}
-
-// parseTokenWithPublicKey Парсинг токена с помощью открытого ключа
-func parseTokenWithPublicKey(tokenString string, publicKey *ecdsa.PublicKey) (*entities.TokenClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &entities.TokenClaims{}, func(token *jwt.Token) (any, error) {
-		if token.Method != jwt.SigningMethodES256 {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return publicKey, nil
-	})
+// ParseToken Парсинг jwt токена (верификация публичным ключом из набора по kid)
+func ParseToken(tokenString string, keys *keyset.KeySet) (*entities.TokenClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &entities.TokenClaims{}, keyFunc(keys))
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
 			return nil, fmt.Errorf("token expired")
@@ -57,18 +47,20 @@ func parseTokenWithPublicKey(tokenString string, publicKey *ecdsa.PublicKey) (*e
 }
 
 // generateToken Создание JWT токена с ES256
-func generateToken(userUUID string, privateKey *ecdsa.PrivateKey, tokenType string, tokenLifetime time.Duration) (string, error) {
+func generateToken(userUUID, sessionUUID string, keys *keyset.KeySet, tokenType string, tokenLifetime time.Duration) (string, error) {
+	now := time.Now()
 	claims := &entities.TokenClaims{
-		UserUUID:  userUUID,
-		TokenType: tokenType,
+		UserUUID:    userUUID,
+		SessionUUID: sessionUUID,
+		TokenType:   tokenType,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ID:        uuid.Must(uuid.NewV7()).String(),
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(tokenLifetime)),
+			IssuedAt:  jwt.NewNumericDate(now), // сравнивается с эпохой отзыва всех сессий пользователя
+			ExpiresAt: jwt.NewNumericDate(now.Add(tokenLifetime)),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
-	tokenString, err := token.SignedString(privateKey)
+	tokenString, err := sign(claims, keys)
 	if err != nil {
 		return "", fmt.Errorf("generate token error: %w", err)
 	}
@@ -77,7 +69,7 @@ func generateToken(userUUID string, privateKey *ecdsa.PrivateKey, tokenType stri
 }
 
 // CreateResetPasswordToken Генерация JWT токена для сброса пароля
-func CreateResetPasswordToken(email string, privateKey *ecdsa.PrivateKey, ttl time.Duration) (string, error) {
+func CreateResetPasswordToken(email string, keys *keyset.KeySet, ttl time.Duration) (string, error) {
 	claims := &entities.ResetPasswordTokenClaims{
 		Email:     email,
 		TokenType: entities.ResetPasswordTokenType,
@@ -87,8 +79,7 @@ func CreateResetPasswordToken(email string, privateKey *ecdsa.PrivateKey, ttl ti
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
-	tokenString, err := token.SignedString(privateKey)
+	tokenString, err := sign(claims, keys)
 	if err != nil {
 		return "", fmt.Errorf("generate reset password token error: %w", err)
 	}
@@ -96,13 +87,8 @@ func CreateResetPasswordToken(email string, privateKey *ecdsa.PrivateKey, ttl ti
 }
 
 // ParseResetPasswordToken Парсинг JWT токена для сброса пароля
-func ParseResetPasswordToken(tokenString string, privateKey *ecdsa.PrivateKey) (*entities.ResetPasswordTokenClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &entities.ResetPasswordTokenClaims{}, func(token *jwt.Token) (any, error) {
-		if token.Method != jwt.SigningMethodES256 {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return &privateKey.PublicKey, nil
-	})
+func ParseResetPasswordToken(tokenString string, keys *keyset.KeySet) (*entities.ResetPasswordTokenClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &entities.ResetPasswordTokenClaims{}, keyFunc(keys))
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
 			return nil, fmt.Errorf("token expired")
@@ -117,7 +103,7 @@ func ParseResetPasswordToken(tokenString string, privateKey *ecdsa.PrivateKey) (
 }
 
 // CreateVerificationToken Генерация JWT токена для верификации аккаунта
-func CreateVerificationToken(email string, privateKey *ecdsa.PrivateKey, ttl time.Duration) (string, error) {
+func CreateVerificationToken(email string, keys *keyset.KeySet, ttl time.Duration) (string, error) {
 	claims := &entities.VerificationTokenClaims{
 		Email:     email,
 		TokenType: entities.VerificationTokenType,
@@ -126,8 +112,7 @@ func CreateVerificationToken(email string, privateKey *ecdsa.PrivateKey, ttl tim
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
 		},
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
-	tokenString, err := token.SignedString(privateKey)
+	tokenString, err := sign(claims, keys)
 	if err != nil {
 		return "", fmt.Errorf("generate verification token error: %w", err)
 	}
@@ -135,13 +120,8 @@ func CreateVerificationToken(email string, privateKey *ecdsa.PrivateKey, ttl tim
 }
 
 // ParseVerificationToken Парсинг JWT токена для верификации аккаунта
-func ParseVerificationToken(tokenString string, privateKey *ecdsa.PrivateKey) (*entities.VerificationTokenClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &entities.VerificationTokenClaims{}, func(token *jwt.Token) (any, error) {
-		if token.Method != jwt.SigningMethodES256 {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return &privateKey.PublicKey, nil
-	})
+func ParseVerificationToken(tokenString string, keys *keyset.KeySet) (*entities.VerificationTokenClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &entities.VerificationTokenClaims{}, keyFunc(keys))
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
 			return nil, fmt.Errorf("token expired")
@@ -154,6 +134,26 @@ func ParseVerificationToken(tokenString string, privateKey *ecdsa.PrivateKey) (*
 	return nil, fmt.Errorf("invalid token")
 }
 
+// sign Подписывает claims активным ключом набора, kid пишется в заголовок токена
+func sign(claims jwt.Claims, keys *keyset.KeySet) (string, error) {
+	kid, privateKey := keys.Active()
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["kid"] = kid
+	return token.SignedString(privateKey)
+}
+
+// keyFunc Выбирает ключ проверки подписи по kid из заголовка токена.
+// Принимаются все ключи набора, включая выведенные: refresh токены и ссылки из писем переживают ротацию.
+func keyFunc(keys *keyset.KeySet) jwt.Keyfunc {
+	return func(token *jwt.Token) (any, error) {
+		if token.Method != jwt.SigningMethodES256 {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		kid, _ := token.Header["kid"].(string)
+		return keys.PublicKey(kid)
+	}
+}
+
 // HashToken Хеширует refresh токен
 func HashToken(rawToken string) string {
 	hash := sha256.Sum256([]byte(rawToken))
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net"
 
@@ -9,11 +10,15 @@ import (
 	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/config"
 	postgresDB "github.com/unwelcome/FrameWorkTask1/backend/company/internal/database/postgres"
 	redisDB "github.com/unwelcome/FrameWorkTask1/backend/company/internal/database/redis"
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/messaging"
 	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/services"
+	application_proto "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
 	company_proto "github.com/unwelcome/FrameWorkTask1/backend/contracts/company/generated"
 	"github.com/unwelcome/FrameWorkTask1/backend/shared/interceptors"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/lifecycle"
 	"github.com/unwelcome/FrameWorkTask1/backend/shared/logger"
-	"github.com/unwelcome/FrameWorkTask1/backend/shared/metrics"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/mtls"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/tracing"
 	"google.golang.org/grpc"
 )
 
@@ -23,8 +28,55 @@ func main() {
 	loggerConf, httpLogger := logger.Setup(cfg.Log.Path, cfg.Log.ConsoleOut)
 	log.Logger = *loggerConf
 
+	// Остановка по SIGINT / SIGTERM: серверы, затем воркеры, затем соединения (см. lifecycle)
+	lc := lifecycle.New(cfg.ShutdownTimeout)
+
+	// Трассировка: спаны экспортируются в OTLP коллектор, если задан OTEL_EXPORTER_OTLP_ENDPOINT
+	shutdownTracing, err := tracing.Setup(context.Background(), "company_service", cfg.Tracing)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to setup tracing")
+	}
+	lc.OnClose("tracing", shutdownTracing)
+
 	db := postgresDB.NewDatabaseInstance(cfg.Postgres.ConnectionString())
+	lc.OnClose("postgres", lifecycle.Closer(db.Close))
+	lc.ReadinessCheck("postgres", db.Ping)
+
 	cache := redisDB.NewCacheInstance(cfg.Redis.Options(), cfg.Redis.Prefix)
+	lc.OnClose("redis", lifecycle.Closer(cache.Close))
+	lc.ReadinessCheck("redis", cache.Ping)
+
+	// События изменения состава компаний - по ним application сервис сбрасывает кеш ролей и отделов
+	publisher := messaging.NewPublisher(cfg.RabbitMQ.ConnectionString())
+	lc.OnClose("rabbitMQ", lifecycle.Closer(publisher.Close))
+
+	// Фоновая горутина публикации событий из membership_outbox
+	lc.Go("outbox", func(ctx context.Context) {
+		services.StartOutboxWorker(ctx, db, publisher)
+	})
+
+	// Заявки удаляемой компании архивирует application сервис - запросы идут по mTLS от имени company сервиса.
+	// Открытые заявки сотрудника перед изменением состава проверяются от имени пользователя, выполняющего изменение
+	clientCreds, err := mtls.ClientCredentials(cfg.TLS, cfg.ApplicationService.Host)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to load tls credentials")
+	}
+
+	applicationConn, err := grpc.NewClient(cfg.ApplicationService.Addr(),
+		grpc.WithTransportCredentials(clientCreds),
+		tracing.DialOption(),
+		grpc.WithUnaryInterceptor(interceptors.NewIdentityForwardingInterceptor()),
+	)
+	if err != nil {
+		log.Fatal().Err(err).Str("addr", cfg.ApplicationService.Addr()).Msg("failed to connect to application service")
+	}
+	lc.OnClose("application service connection", lifecycle.Closer(applicationConn.Close))
+
+	// Фоновая горутина удаления компаний с истёкшим сроком восстановления
+	applicationClient := application_proto.NewApplicationServiceClient(applicationConn)
+	lc.Go("company deletion", func(ctx context.Context) {
+		services.StartCompanyDeletionWorker(ctx, db, cache, applicationClient, cfg.Deletion.Interval)
+	})
 
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Port))
 	if err != nil {
@@ -33,21 +85,37 @@ func main() {
 
 	grpcprom.EnableHandlingTimeHistogram()
 
+	// Company сервис вызывают gateway и application сервис (от имени того же пользователя)
+	creds, err := mtls.ServerCredentials(cfg.TLS)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to load tls credentials")
+	}
+
 	grpcServer := grpc.NewServer(
+		grpc.Creds(creds),
+		tracing.ServerOption(),
 		grpc.ChainUnaryInterceptor(
 			grpcprom.UnaryServerInterceptor,
 			interceptors.NewLoggingInterceptor(*httpLogger),
+			interceptors.NewIdentityInterceptor("gateway", "application"),
+		),
+		grpc.ChainStreamInterceptor(
+			grpcprom.StreamServerInterceptor,
+			interceptors.NewIdentityStreamInterceptor("gateway", "application"),
 		),
-		grpc.StreamInterceptor(grpcprom.StreamServerInterceptor),
 	)
-	company_proto.RegisterCompanyServiceServer(grpcServer, services.NewCompanyService(db, cache))
+	company_proto.RegisterCompanyServiceServer(grpcServer, services.NewCompanyService(db, cache, publisher, applicationClient, cfg.Deletion.Retention))
 
 	grpcprom.Register(grpcServer)
 
-	metrics.StartServer(cfg.MetricsPort)
+	// /metrics, /livez и /readyz
+	lc.StartProbeServer(cfg.MetricsPort)
+
+	lc.Serve("grpc", func() error { return grpcServer.Serve(listener) }, lifecycle.GRPCShutdown(grpcServer))
+	lc.Ready()
 
 	log.Info().Int("port", cfg.Port).Msg("company service started")
-	if err := grpcServer.Serve(listener); err != nil {
-		log.Fatal().Err(err).Msg("failed to serve grpc")
+	if err := lc.Wait(); err != nil {
+		log.Fatal().Err(err).Msg("company service stopped with error")
 	}
 }
@@ -1,15 +1,31 @@
 package config
 
 import (
+	"fmt"
+	"time"
+
 	sharedConfig "github.com/unwelcome/FrameWorkTask1/backend/shared/config"
 )
 
 type Config struct {
-	Port        int
-	MetricsPort int
-	Log         LogConfig
-	Postgres    sharedConfig.PostgresConfig
-	Redis       sharedConfig.RedisConfig
+	Port               int
+	MetricsPort        int
+	ShutdownTimeout    time.Duration // время на graceful shutdown, должно быть меньше stop_grace_period в docker-compose
+	Log                LogConfig
+	Postgres           sharedConfig.PostgresConfig
+	Redis              sharedConfig.RedisConfig
+	RabbitMQ           sharedConfig.RabbitMQConfig
+	TLS                sharedConfig.TLSConfig
+	Tracing            sharedConfig.TracingConfig
+	ApplicationService ServiceAddress
+	Deletion           DeletionConfig
+}
+
+// DeletionConfig Удаление компаний: срок, в течение которого компанию можно восстановить,
+// и период проверки удалений, которые пора продолжить
+type DeletionConfig struct {
+	Retention time.Duration
+	Interval  time.Duration
 }
 
 type LogConfig struct {
@@ -17,15 +33,36 @@ type LogConfig struct {
 	ConsoleOut bool
 }
 
+type ServiceAddress struct {
+	Host string
+	Port int
+}
+
+func (s ServiceAddress) Addr() string {
+	return fmt.Sprintf("%s:%d", s.Host, s.Port)
+}
+
 func NewConfig() *Config {
 	return &Config{
-		Port:        sharedConfig.MustParseInt("COMPANY_SERVICE_PORT"),
-		MetricsPort: sharedConfig.ParseIntOrDefault("METRICS_PORT", 2112),
+		Port:            sharedConfig.MustParseInt("COMPANY_SERVICE_PORT"),
+		MetricsPort:     sharedConfig.ParseIntOrDefault("METRICS_PORT", 2112),
+		ShutdownTimeout: sharedConfig.ParseDurationOrDefault("SHUTDOWN_TIMEOUT", 25*time.Second),
 		Log: LogConfig{
 			Path:       sharedConfig.MustGetEnv("LOG_PATH"),
 			ConsoleOut: sharedConfig.MustParseBool("LOG_CONSOLE_OUT"),
 		},
 		Postgres: sharedConfig.NewPostgresConfig(),
 		Redis:    sharedConfig.NewRedisConfig(),
+		RabbitMQ: sharedConfig.NewRabbitMQConfig(),
+		TLS:      sharedConfig.NewTLSConfig(),
+		Tracing:  sharedConfig.NewTracingConfig(),
+		ApplicationService: ServiceAddress{
+			Host: sharedConfig.MustGetEnv("APPLICATION_SERVICE_HOST"),
+			Port: sharedConfig.MustParseInt("APPLICATION_SERVICE_PORT"),
+		},
+		Deletion: DeletionConfig{
+			Retention: sharedConfig.ParseDurationOrDefault("COMPANY_DELETION_RETENTION", 7*24*time.Hour),
+			Interval:  sharedConfig.ParseDurationOrDefault("COMPANY_DELETION_INTERVAL", time.Minute),
+		},
 	}
 }
@@ -0,0 +1,145 @@
+package postgresDB
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+)
+
+type AuditRepository interface {
+	CreateAuditEntry(ctx context.Context, dto entities.CreateAuditEntryDTO) Error.CodeError
+	GetCompanyAuditLog(ctx context.Context, dto entities.GetCompanyAuditLogDTO) ([]*entities.AuditEntry, Error.CodeError)
+	CountCompanyAuditLog(ctx context.Context, dto entities.GetCompanyAuditLogDTO) (int64, Error.CodeError)
+}
+
+type auditRepository struct {
+	db *sql.DB
+}
+
+func NewAuditRepository(db *sql.DB) AuditRepository {
+	return &auditRepository{db: db}
+}
+
+const auditEntryColumns = `
+			uuid,
+			company_uuid,
+			actor_uuid,
+			action,
+			target_type,
+			target_id,
+			before_value,
+			after_value,
+			operation_id,
+			created_at::text`
+
+// auditLogFilterWhere Фильтры журнала аудита ($1 - компания, $2 - автор, $3 - действие, $4/$5 - период создания)
+const auditLogFilterWhere = `company_uuid = $1
+		AND ($2 = '' OR actor_uuid::text = $2)
+		AND ($3 = '' OR action::text = $3)
+		AND (NULLIF($4, '') IS NULL OR created_at >= NULLIF($4, '')::timestamptz)
+		AND (NULLIF($5, '') IS NULL OR created_at < NULLIF($5, '')::timestamptz)`
+
+const insertAuditEntryQuery = `INSERT INTO company_audit_log
+		(uuid, company_uuid, actor_uuid, action, target_type, target_id, before_value, after_value, operation_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);`
+
+// CreateAuditEntry Добавление записи в журнал аудита о действии вне Postgres (коды для вступления в redis).
+// Изменения в Postgres пишут запись в своей транзакции (insertAuditEntry)
+func (r *auditRepository) CreateAuditEntry(ctx context.Context, dto entities.CreateAuditEntryDTO) Error.CodeError {
+	_, err := r.db.ExecContext(ctx, insertAuditEntryQuery,
+		dto.UUID, dto.CompanyUUID, dto.ActorUUID, dto.Action, dto.TargetType, dto.TargetID, dto.BeforeValue, dto.AfterValue, dto.OperationID,
+	)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// GetCompanyAuditLog Получение журнала аудита компании (новые записи первыми) с учетом фильтров
+func (r *auditRepository) GetCompanyAuditLog(ctx context.Context, dto entities.GetCompanyAuditLogDTO) ([]*entities.AuditEntry, Error.CodeError) {
+	query := `SELECT` + auditEntryColumns + `
+		FROM company_audit_log
+		WHERE ` + auditLogFilterWhere + `
+			AND ($6 = '' OR uuid < NULLIF($6, '')::uuid)
+		ORDER BY uuid DESC
+		OFFSET $7 LIMIT $8;`
+
+	rows, err := r.db.QueryContext(ctx, query,
+		dto.CompanyUUID, dto.ActorUUID, dto.Action, dto.CreatedFrom, dto.CreatedTo, dto.AfterUUID, dto.Offset, dto.Count,
+	)
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	defer rows.Close()
+
+	entries := make([]*entities.AuditEntry, 0)
+	for rows.Next() {
+		entry, scanErr := scanAuditEntry(rows)
+		if scanErr != nil {
+			return nil, Error.Internal(scanErr)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, Error.Internal(err)
+	}
+	return entries, Error.CodeError{}
+}
+
+// CountCompanyAuditLog Общее кол-во записей журнала аудита компании с учетом фильтров
+func (r *auditRepository) CountCompanyAuditLog(ctx context.Context, dto entities.GetCompanyAuditLogDTO) (int64, Error.CodeError) {
+	query := `SELECT COUNT(*) FROM company_audit_log WHERE ` + auditLogFilterWhere + `;`
+
+	var total int64
+	err := r.db.QueryRowContext(ctx, query, dto.CompanyUUID, dto.ActorUUID, dto.Action, dto.CreatedFrom, dto.CreatedTo).Scan(&total)
+	if err != nil {
+		return 0, Error.Internal(err)
+	}
+	return total, Error.CodeError{}
+}
+
+// ─── Вспомогательные функции ──────────────────────────────────────────────────
+
+type auditEntryScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanAuditEntry Чтение строки с колонками auditEntryColumns
+func scanAuditEntry(row auditEntryScanner) (*entities.AuditEntry, error) {
+	entry := &entities.AuditEntry{}
+	err := row.Scan(
+		&entry.UUID,
+		&entry.CompanyUUID,
+		&entry.ActorUUID,
+		&entry.Action,
+		&entry.TargetType,
+		&entry.TargetID,
+		&entry.BeforeValue,
+		&entry.AfterValue,
+		&entry.OperationID,
+		&entry.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// insertAuditEntry Добавление записи в журнал аудита в транзакции действия: запись фиксируется вместе с ним.
+// nil - действие без записи в журнал
+func insertAuditEntry(ctx context.Context, tx *sql.Tx, entry *entities.CreateAuditEntryDTO) Error.CodeError {
+	if entry == nil {
+		return Error.CodeError{}
+	}
+
+	_, err := tx.ExecContext(ctx, insertAuditEntryQuery,
+		entry.UUID, entry.CompanyUUID, entry.ActorUUID, entry.Action, entry.TargetType, entry.TargetID, entry.BeforeValue, entry.AfterValue, entry.OperationID,
+	)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
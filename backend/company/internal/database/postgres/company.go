@@ -16,13 +16,15 @@ type CompanyRepository interface {
 	CreateCompany(ctx context.Context, dto entities.CreateCompany) Error.CodeError
 	GetCompany(ctx context.Context, dto entities.GetCompanyDTO) (*entities.Company, Error.CodeError)
 	GetCompanies(ctx context.Context, dto entities.GetCompaniesDTO) ([]*entities.GetCompanies, Error.CodeError)
+	CountCompanies(ctx context.Context) (int64, Error.CodeError)
 	GetUserCompanies(ctx context.Context, dto entities.GetUserCompaniesDTO) ([]*entities.GetCompanies, Error.CodeError)
 	UpdateCompanyTitle(ctx context.Context, dto entities.UpdateCompanyTitleDTO) Error.CodeError
 	UpdateCompanyStatus(ctx context.Context, dto entities.UpdateCompanyStatusDTO) Error.CodeError
-	DeleteCompany(ctx context.Context, dto entities.DeleteCompanyDTO) Error.CodeError
+	UpdateCompanyJoinSettings(ctx context.Context, dto entities.UpdateCompanyJoinSettingsDTO) Error.CodeError
 	JoinCompany(ctx context.Context, dto entities.JoinCompanyDTO) Error.CodeError
 	GetCompanyEmployee(ctx context.Context, dto entities.GetCompanyEmployeeDTO) (*entities.Employee, Error.CodeError)
 	GetCompanyEmployees(ctx context.Context, dto entities.GetCompanyEmployeesDTO) ([]*entities.Employee, Error.CodeError)
+	CountCompanyEmployees(ctx context.Context, dto entities.GetCompanyEmployeesDTO) (int64, Error.CodeError)
 	GetCompanyEmployeesSummary(ctx context.Context, dto entities.GetCompanyEmployeesSummaryDTO) (*entities.EmployeesSummary, Error.CodeError)
 	SetCompanyEmployeeRole(ctx context.Context, dto entities.SetCompanyEmployeeRoleDTO) Error.CodeError
 	RemoveCompanyEmployee(ctx context.Context, dto entities.RemoveCompanyEmployeeDTO) Error.CodeError
@@ -52,9 +54,9 @@ func (r *companyRepository) CreateCompany(ctx context.Context, dto entities.Crea
 	}
 	defer tx.Rollback()
 
-	// Создаём компанию
+	// Создаём компанию, основатель - её владелец
 	_, err = tx.ExecContext(ctx,
-		`INSERT INTO companies (uuid, title, created_by) VALUES ($1, $2, $3)`,
+		`INSERT INTO companies (uuid, title, created_by, owner_uuid) VALUES ($1, $2, $3, $3)`,
 		dto.CompanyUUID, dto.Title, dto.CreatedBy,
 	)
 	if err != nil {
@@ -86,15 +88,15 @@ func (r *companyRepository) CreateCompany(ctx context.Context, dto entities.Crea
 	return Error.CodeError{}
 }
 
-// GetCompany Получение данных о компании по uuid
+// GetCompany Получение данных о компании по uuid (удалённая компания не найдётся)
 func (r *companyRepository) GetCompany(ctx context.Context, dto entities.GetCompanyDTO) (*entities.Company, Error.CodeError) {
-	query := `SELECT title, status, created_by, created_at FROM companies WHERE uuid = $1;`
+	query := `SELECT title, status, join_approval_required, owner_uuid, created_by, created_at FROM companies WHERE uuid = $1 AND deleted_at IS NULL;`
 
 	company := &entities.Company{
 		CompanyUUID: dto.CompanyUUID,
 	}
 
-	err := r.db.QueryRowContext(ctx, query, dto.CompanyUUID).Scan(&company.Title, &company.Status, &company.CreatedBy, &company.CreatedAt)
+	err := r.db.QueryRowContext(ctx, query, dto.CompanyUUID).Scan(&company.Title, &company.Status, &company.JoinApprovalRequired, &company.OwnerUUID, &company.CreatedBy, &company.CreatedAt)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, Error.Public(codes.NotFound, "company not found")
@@ -104,11 +106,15 @@ func (r *companyRepository) GetCompany(ctx context.Context, dto entities.GetComp
 	return company, Error.CodeError{}
 }
 
-// GetCompanies Получение списка компаний размера Count со сдвигом Offset
+// GetCompanies Получение списка компаний размера Count со сдвигом Offset или после компании AfterUUID.
+// uuid компаний - UUIDv7, поэтому сортировка по нему совпадает с сортировкой по дате создания.
 func (r *companyRepository) GetCompanies(ctx context.Context, dto entities.GetCompaniesDTO) ([]*entities.GetCompanies, Error.CodeError) {
-	query := `SELECT uuid, title, status FROM companies ORDER BY created_at DESC, uuid OFFSET $1 LIMIT $2;`
+	query := `SELECT uuid, title, status FROM companies
+	WHERE deleted_at IS NULL AND ($3 = '' OR uuid < NULLIF($3, '')::uuid)
+	ORDER BY uuid DESC
+	OFFSET $1 LIMIT $2;`
 
-	res, err := r.db.QueryContext(ctx, query, dto.Offset, dto.Count)
+	res, err := r.db.QueryContext(ctx, query, dto.Offset, dto.Count, dto.AfterUUID)
 	if err != nil {
 		return nil, Error.Internal(err)
 	}
@@ -132,9 +138,20 @@ func (r *companyRepository) GetCompanies(ctx context.Context, dto entities.GetCo
 	return companies, Error.CodeError{}
 }
 
+// CountCompanies Общее кол-во компаний
+func (r *companyRepository) CountCompanies(ctx context.Context) (int64, Error.CodeError) {
+	query := `SELECT COUNT(*) FROM companies WHERE deleted_at IS NULL;`
+
+	var total int64
+	if err := r.db.QueryRowContext(ctx, query).Scan(&total); err != nil {
+		return 0, Error.Internal(err)
+	}
+	return total, Error.CodeError{}
+}
+
 // GetUserCompanies Получение списка компаний, в которых состоит пользователь
 func (r *companyRepository) GetUserCompanies(ctx context.Context, dto entities.GetUserCompaniesDTO) ([]*entities.GetCompanies, Error.CodeError) {
-	query := `SELECT c.uuid, c.title, c.status FROM companies c JOIN employees e ON c.uuid = e.company_uuid WHERE e.user_uuid = $1 ORDER BY c.created_at DESC;`
+	query := `SELECT c.uuid, c.title, c.status FROM companies c JOIN employees e ON c.uuid = e.company_uuid WHERE e.user_uuid = $1 AND c.deleted_at IS NULL ORDER BY c.created_at DESC;`
 
 	res, err := r.db.QueryContext(ctx, query, dto.UserUUID)
 	if err != nil {
@@ -159,22 +176,36 @@ func (r *companyRepository) GetUserCompanies(ctx context.Context, dto entities.G
 	return companies, Error.CodeError{}
 }
 
-// UpdateCompanyTitle Обновление названия компании
+// UpdateCompanyTitle Обновление названия компании и запись в журнал аудита с прежним названием
 func (r *companyRepository) UpdateCompanyTitle(ctx context.Context, dto entities.UpdateCompanyTitleDTO) Error.CodeError {
-	query := `UPDATE companies SET title = $2 WHERE uuid = $1;`
-
-	res, err := r.db.ExecContext(ctx, query, dto.CompanyUUID, dto.Title)
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return Error.Internal(err)
 	}
+	defer tx.Rollback()
 
-	rowsAffected, err := res.RowsAffected()
+	var title string
+	err = tx.QueryRowContext(ctx, `SELECT title FROM companies WHERE uuid = $1 FOR NO KEY UPDATE;`, dto.CompanyUUID).Scan(&title)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Error.Public(codes.NotFound, "company not found")
+		}
 		return Error.Internal(err)
 	}
 
-	if rowsAffected == 0 {
-		return Error.Public(codes.NotFound, "company not found")
+	if _, err = tx.ExecContext(ctx, `UPDATE companies SET title = $2 WHERE uuid = $1;`, dto.CompanyUUID, dto.Title); err != nil {
+		return Error.Internal(err)
+	}
+
+	if dto.Audit != nil {
+		dto.Audit.BeforeValue = title
+	}
+	if auditErr := insertAuditEntry(ctx, tx, dto.Audit); auditErr.Code != 0 {
+		return auditErr
+	}
+
+	if err = tx.Commit(); err != nil {
+		return Error.Internal(err)
 	}
 	return Error.CodeError{}
 }
@@ -206,11 +237,11 @@ func (r *companyRepository) UpdateCompanyStatus(ctx context.Context, dto entitie
 	return Error.CodeError{}
 }
 
-// DeleteCompany Удаление компании
-func (r *companyRepository) DeleteCompany(ctx context.Context, dto entities.DeleteCompanyDTO) Error.CodeError {
-	query := `DELETE FROM companies WHERE uuid = $1;`
+// UpdateCompanyJoinSettings Включение или отключение одобрения заявок на вступление по коду
+func (r *companyRepository) UpdateCompanyJoinSettings(ctx context.Context, dto entities.UpdateCompanyJoinSettingsDTO) Error.CodeError {
+	query := `UPDATE companies SET join_approval_required = $2 WHERE uuid = $1;`
 
-	res, err := r.db.ExecContext(ctx, query, dto.CompanyUUID)
+	res, err := r.db.ExecContext(ctx, query, dto.CompanyUUID, dto.JoinApprovalRequired)
 	if err != nil {
 		return Error.Internal(err)
 	}
@@ -228,9 +259,37 @@ func (r *companyRepository) DeleteCompany(ctx context.Context, dto entities.Dele
 
 // JoinCompany Добавление пользователя в список сотрудников компании
 func (r *companyRepository) JoinCompany(ctx context.Context, dto entities.JoinCompanyDTO) Error.CodeError {
-	query := `INSERT INTO employees (company_uuid, user_uuid) VALUES ($1, $2);`
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	defer tx.Rollback()
+
+	if joinErr := insertEmployee(ctx, tx, dto.CompanyUUID, dto.UserUUID, "unemployed", ""); joinErr.Code != 0 {
+		return joinErr
+	}
 
-	_, err := r.db.ExecContext(ctx, query, dto.CompanyUUID, dto.UserUUID)
+	// Запоминаем, кто и когда воспользовался кодом
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO join_code_redemptions (company_uuid, join_code, user_uuid) VALUES ($1, $2, $3);`,
+		dto.CompanyUUID, dto.JoinCode, dto.UserUUID,
+	)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// insertEmployee Добавление сотрудника с ролью и департаментом в рамках транзакции
+func insertEmployee(ctx context.Context, tx *sql.Tx, companyUUID, userUUID, role, departmentUUID string) Error.CodeError {
+	query := `INSERT INTO employees (company_uuid, user_uuid, role, department_uuid)
+	VALUES ($1, $2, $3::employee_role, NULLIF($4, '')::uuid);`
+
+	_, err := tx.ExecContext(ctx, query, companyUUID, userUUID, role, departmentUUID)
 	if err != nil {
 		var pqErr *pq.Error
 		if errors.As(err, &pqErr) {
@@ -265,7 +324,8 @@ func (r *companyRepository) GetCompanyEmployee(ctx context.Context, dto entities
 	return employee, Error.CodeError{}
 }
 
-// GetCompanyEmployees Возвращает сотрудников компании (сортировка по role, departmentUUID и ограничения через offset и count)
+// GetCompanyEmployees Возвращает сотрудников компании (сортировка по role, departmentUUID и ограничения через offset и count).
+// Вместо offset можно передать позицию последнего сотрудника предыдущей страницы (AfterJoinedAt, AfterUserUUID).
 func (r *companyRepository) GetCompanyEmployees(ctx context.Context, dto entities.GetCompanyEmployeesDTO) ([]*entities.Employee, Error.CodeError) {
 	query := `SELECT
 		user_uuid,
@@ -273,11 +333,12 @@ func (r *companyRepository) GetCompanyEmployees(ctx context.Context, dto entitie
 		COALESCE(department_uuid::text, ''),
 		joined_at
 	FROM employees
-	WHERE company_uuid = $1 AND ($2 = '' OR role::text = $2) AND ($3 = '' OR department_uuid::text = $3)
-	ORDER BY joined_at DESC
+	WHERE ` + employeesFilterWhere + `
+		AND ($6 = '' OR (joined_at, user_uuid) < (NULLIF($6, '')::timestamptz, NULLIF($7, '')::uuid))
+	ORDER BY joined_at DESC, user_uuid DESC
 	OFFSET $4 LIMIT $5;`
 
-	rows, err := r.db.QueryContext(ctx, query, dto.CompanyUUID, dto.Role, dto.DepartmentUUID, dto.Offset, dto.Count)
+	rows, err := r.db.QueryContext(ctx, query, dto.CompanyUUID, dto.Role, dto.DepartmentUUID, dto.Offset, dto.Count, dto.AfterJoinedAt, dto.AfterUserUUID)
 	if err != nil {
 		return nil, Error.Internal(err)
 	}
@@ -301,6 +362,20 @@ func (r *companyRepository) GetCompanyEmployees(ctx context.Context, dto entitie
 	return employees, Error.CodeError{}
 }
 
+// employeesFilterWhere Фильтры списка сотрудников компании ($1 - компания, $2 - роль, $3 - отдел)
+const employeesFilterWhere = `company_uuid = $1 AND ($2 = '' OR role::text = $2) AND ($3 = '' OR department_uuid::text = $3)`
+
+// CountCompanyEmployees Общее кол-во сотрудников компании с учетом фильтров
+func (r *companyRepository) CountCompanyEmployees(ctx context.Context, dto entities.GetCompanyEmployeesDTO) (int64, Error.CodeError) {
+	query := `SELECT COUNT(*) FROM employees WHERE ` + employeesFilterWhere + `;`
+
+	var total int64
+	if err := r.db.QueryRowContext(ctx, query, dto.CompanyUUID, dto.Role, dto.DepartmentUUID).Scan(&total); err != nil {
+		return 0, Error.Internal(err)
+	}
+	return total, Error.CodeError{}
+}
+
 // GetCompanyEmployeesSummary Получение кол-ва сотрудников по ролям в компании
 func (r *companyRepository) GetCompanyEmployeesSummary(ctx context.Context, dto entities.GetCompanyEmployeesSummaryDTO) (*entities.EmployeesSummary, Error.CodeError) {
 	query := `SELECT
@@ -334,9 +409,36 @@ func (r *companyRepository) GetCompanyEmployeesSummary(ctx context.Context, dto
 
 // SetCompanyEmployeeRole Устанавливает новую роль сотруднику компании
 func (r *companyRepository) SetCompanyEmployeeRole(ctx context.Context, dto entities.SetCompanyEmployeeRoleDTO) Error.CodeError {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	defer tx.Rollback()
+
+	ownerUUID, lockErr := lockCompanyOwner(ctx, tx, dto.CompanyUUID)
+	if lockErr.Code != 0 {
+		return lockErr
+	}
+	if ownerUUID == dto.UserUUID && dto.Role != "chief" {
+		return Error.Public(codes.FailedPrecondition, "company owner must stay chief")
+	}
+
+	// Прежняя роль для журнала аудита читается под блокировкой строки сотрудника
+	var role string
+	err = tx.QueryRowContext(ctx,
+		`SELECT role FROM employees WHERE company_uuid = $1 AND user_uuid = $2 FOR NO KEY UPDATE;`,
+		dto.CompanyUUID, dto.UserUUID,
+	).Scan(&role)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Error.Public(codes.NotFound, "employee not found")
+		}
+		return Error.Internal(err)
+	}
+
 	query := `UPDATE employees SET role = $3 WHERE company_uuid = $1 AND user_uuid = $2;`
 
-	res, err := r.db.ExecContext(ctx, query, dto.CompanyUUID, dto.UserUUID, dto.Role)
+	_, err = tx.ExecContext(ctx, query, dto.CompanyUUID, dto.UserUUID, dto.Role)
 	if err != nil {
 		var pqErr *pq.Error
 		if errors.As(err, &pqErr) {
@@ -348,54 +450,148 @@ func (r *companyRepository) SetCompanyEmployeeRole(ctx context.Context, dto enti
 		return Error.Internal(err)
 	}
 
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		return Error.Internal(err)
+	if chiefErr := ensureChiefRemains(ctx, tx, dto.CompanyUUID); chiefErr.Code != 0 {
+		return chiefErr
 	}
 
-	if rowsAffected == 0 {
-		return Error.Public(codes.NotFound, "employee not found")
+	if dto.Audit != nil {
+		dto.Audit.BeforeValue = role
+	}
+	if auditErr := insertAuditEntry(ctx, tx, dto.Audit); auditErr.Code != 0 {
+		return auditErr
+	}
+	if eventErr := insertMembershipEvent(ctx, tx, dto.Event); eventErr.Code != 0 {
+		return eventErr
 	}
 
+	if err = tx.Commit(); err != nil {
+		return Error.Internal(err)
+	}
 	return Error.CodeError{}
 }
 
-// RemoveCompanyEmployee Удаление пользователя из списка сотрудников компании
+// RemoveCompanyEmployee Удаление пользователя из списка сотрудников компании (исключение chief-ом или выход).
+// Владельца удалить нельзя, ожидающая передача владения этому пользователю отменяется.
 func (r *companyRepository) RemoveCompanyEmployee(ctx context.Context, dto entities.RemoveCompanyEmployeeDTO) Error.CodeError {
-	query := `DELETE FROM employees WHERE company_uuid = $1 AND user_uuid = $2;`
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	defer tx.Rollback()
+
+	ownerUUID, lockErr := lockCompanyOwner(ctx, tx, dto.CompanyUUID)
+	if lockErr.Code != 0 {
+		return lockErr
+	}
+	if ownerUUID == dto.UserUUID {
+		return Error.Public(codes.FailedPrecondition, "company owner cannot leave company, transfer ownership first")
+	}
 
-	res, err := r.db.ExecContext(ctx, query, dto.CompanyUUID, dto.UserUUID)
+	var role string
+	err = tx.QueryRowContext(ctx,
+		`DELETE FROM employees WHERE company_uuid = $1 AND user_uuid = $2 RETURNING role;`,
+		dto.CompanyUUID, dto.UserUUID,
+	).Scan(&role)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Error.Public(codes.NotFound, "user not in company")
+		}
 		return Error.Internal(err)
 	}
 
-	rowsAffected, err := res.RowsAffected()
+	if chiefErr := ensureChiefRemains(ctx, tx, dto.CompanyUUID); chiefErr.Code != 0 {
+		return chiefErr
+	}
+
+	if dto.Audit != nil {
+		dto.Audit.BeforeValue = role
+	}
+	if auditErr := insertAuditEntry(ctx, tx, dto.Audit); auditErr.Code != 0 {
+		return auditErr
+	}
+	if eventErr := insertMembershipEvent(ctx, tx, dto.Event); eventErr.Code != 0 {
+		return eventErr
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`UPDATE ownership_transfers SET status = 'cancelled', decided_at = NOW() WHERE company_uuid = $1 AND to_uuid = $2 AND status = 'pending';`,
+		dto.CompanyUUID, dto.UserUUID,
+	)
 	if err != nil {
 		return Error.Internal(err)
 	}
 
-	if rowsAffected == 0 {
-		return Error.Public(codes.NotFound, "user not in company")
+	if err = tx.Commit(); err != nil {
+		return Error.Internal(err)
 	}
 	return Error.CodeError{}
 }
 
-// CreateDepartment Создание департамента
+// lockCompanyOwner Блокирует строку компании до конца транзакции и возвращает владельца.
+// Изменения ролей, состава и владельца компании выполняются последовательно - проверка
+// "в компании остаётся chief" не гоняется с параллельными изменениями.
+// FOR NO KEY UPDATE не конфликтует с FOR KEY SHARE, которую берут вставки сотрудников по внешнему ключу.
+func lockCompanyOwner(ctx context.Context, tx *sql.Tx, companyUUID string) (string, Error.CodeError) {
+	var ownerUUID string
+	err := tx.QueryRowContext(ctx, `SELECT owner_uuid FROM companies WHERE uuid = $1 FOR NO KEY UPDATE;`, companyUUID).Scan(&ownerUUID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", Error.Public(codes.NotFound, "company not found")
+		}
+		return "", Error.Internal(err)
+	}
+	return ownerUUID, Error.CodeError{}
+}
+
+// ensureChiefRemains Проверка, что после изменения в компании остался хотя бы один chief
+func ensureChiefRemains(ctx context.Context, tx *sql.Tx, companyUUID string) Error.CodeError {
+	var chiefs int64
+	err := tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM employees WHERE company_uuid = $1 AND role = 'chief';`, companyUUID).Scan(&chiefs)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	if chiefs == 0 {
+		return Error.Public(codes.FailedPrecondition, "company must keep at least one chief")
+	}
+	return Error.CodeError{}
+}
+
+// CreateDepartment Создание департамента и запись в журнал аудита
 func (r *companyRepository) CreateDepartment(ctx context.Context, dto entities.CreateDepartment) Error.CodeError {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	defer tx.Rollback()
+
 	query := `INSERT INTO departments (uuid, company_uuid, title, created_by) VALUES ($1, $2, $3, $4);`
 
-	_, err := r.db.ExecContext(ctx, query, dto.UUID, dto.CompanyUUID, dto.Title, dto.CreatedBy)
+	_, err = tx.ExecContext(ctx, query, dto.UUID, dto.CompanyUUID, dto.Title, dto.CreatedBy)
 	if err != nil {
 		return Error.Internal(err)
 	}
+
+	if auditErr := insertAuditEntry(ctx, tx, dto.Audit); auditErr.Code != 0 {
+		return auditErr
+	}
+
+	if err = tx.Commit(); err != nil {
+		return Error.Internal(err)
+	}
 	return Error.CodeError{}
 }
 
-// AddEmployeeToDepartment Добавление сотрудника в департамент
+// AddEmployeeToDepartment Добавление сотрудника в департамент и запись события изменения состава в outbox
 func (r *companyRepository) AddEmployeeToDepartment(ctx context.Context, dto entities.AddEmployeeToDepartmentDTO) Error.CodeError {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	defer tx.Rollback()
+
 	query := `UPDATE employees SET department_uuid = $3 WHERE company_uuid = $1 AND user_uuid = $2;`
 
-	res, err := r.db.ExecContext(ctx, query, dto.CompanyUUID, dto.TargetUUID, dto.DepartmentUUID)
+	res, err := tx.ExecContext(ctx, query, dto.CompanyUUID, dto.TargetUUID, dto.DepartmentUUID)
 	if err != nil {
 		return Error.Internal(err)
 	}
@@ -409,6 +605,13 @@ func (r *companyRepository) AddEmployeeToDepartment(ctx context.Context, dto ent
 		return Error.Public(codes.NotFound, "employee not found")
 	}
 
+	if eventErr := insertMembershipEvent(ctx, tx, dto.Event); eventErr.Code != 0 {
+		return eventErr
+	}
+
+	if err = tx.Commit(); err != nil {
+		return Error.Internal(err)
+	}
 	return Error.CodeError{}
 }
 
@@ -458,45 +661,70 @@ func (r *companyRepository) GetCompanyDepartments(ctx context.Context, dto entit
 	return departments, Error.CodeError{}
 }
 
-// UpdateDepartmentTitle Обновление названия департамента
+// UpdateDepartmentTitle Обновление названия департамента и запись в журнал аудита с прежним названием
 func (r *companyRepository) UpdateDepartmentTitle(ctx context.Context, dto *entities.UpdateDepartment) Error.CodeError {
-	query := `UPDATE departments SET title = $1 WHERE uuid = $2;`
-
-	res, err := r.db.ExecContext(ctx, query, dto.Title, dto.UUID)
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return Error.Internal(err)
 	}
+	defer tx.Rollback()
 
-	rowsAffected, err := res.RowsAffected()
+	var title string
+	err = tx.QueryRowContext(ctx, `SELECT title FROM departments WHERE uuid = $1 FOR NO KEY UPDATE;`, dto.UUID).Scan(&title)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Error.Public(codes.NotFound, "department not found")
+		}
 		return Error.Internal(err)
 	}
 
-	if rowsAffected == 0 {
-		return Error.Public(codes.NotFound, "department not found")
+	if _, err = tx.ExecContext(ctx, `UPDATE departments SET title = $1 WHERE uuid = $2;`, dto.Title, dto.UUID); err != nil {
+		return Error.Internal(err)
 	}
 
+	if dto.Audit != nil {
+		dto.Audit.BeforeValue = title
+	}
+	if auditErr := insertAuditEntry(ctx, tx, dto.Audit); auditErr.Code != 0 {
+		return auditErr
+	}
+
+	if err = tx.Commit(); err != nil {
+		return Error.Internal(err)
+	}
 	return Error.CodeError{}
 }
 
-// DeleteDepartment Удаление департамента
+// DeleteDepartment Удаление департамента, запись в журнал аудита с его названием и события в outbox
 func (r *companyRepository) DeleteDepartment(ctx context.Context, dto entities.DeleteDepartmentDTO) Error.CodeError {
-	query := `DELETE FROM departments WHERE uuid = $1;`
-
-	res, err := r.db.ExecContext(ctx, query, dto.DepartmentUUID)
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return Error.Internal(err)
 	}
+	defer tx.Rollback()
 
-	rowsAffected, err := res.RowsAffected()
+	var title string
+	err = tx.QueryRowContext(ctx, `DELETE FROM departments WHERE uuid = $1 RETURNING title;`, dto.DepartmentUUID).Scan(&title)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Error.Public(codes.NotFound, "department not found")
+		}
 		return Error.Internal(err)
 	}
 
-	if rowsAffected == 0 {
-		return Error.Public(codes.NotFound, "department not found")
+	if dto.Audit != nil {
+		dto.Audit.BeforeValue = title
+	}
+	if auditErr := insertAuditEntry(ctx, tx, dto.Audit); auditErr.Code != 0 {
+		return auditErr
+	}
+	if eventErr := insertMembershipEvent(ctx, tx, dto.Event); eventErr.Code != 0 {
+		return eventErr
 	}
 
+	if err = tx.Commit(); err != nil {
+		return Error.Internal(err)
+	}
 	return Error.CodeError{}
 }
 
@@ -505,7 +733,8 @@ func (r *companyRepository) CheckColleagues(ctx context.Context, dto entities.Ch
 	query := `SELECT EXISTS (
 		SELECT 1 FROM employees e1
 		JOIN employees e2 ON e1.company_uuid = e2.company_uuid
-		WHERE e1.user_uuid = $1 AND e2.user_uuid = $2
+		JOIN companies c ON c.uuid = e1.company_uuid
+		WHERE e1.user_uuid = $1 AND e2.user_uuid = $2 AND c.deleted_at IS NULL
 	)`
 
 	var exists bool
@@ -516,11 +745,17 @@ func (r *companyRepository) CheckColleagues(ctx context.Context, dto entities.Ch
 	return exists, Error.CodeError{}
 }
 
-// RemoveEmployeeFromDepartment Удаление сотрудника из департамента
+// RemoveEmployeeFromDepartment Удаление сотрудника из департамента и запись события изменения состава в outbox
 func (r *companyRepository) RemoveEmployeeFromDepartment(ctx context.Context, dto entities.RemoveEmployeeFromDepartmentDTO) Error.CodeError {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	defer tx.Rollback()
+
 	query := `UPDATE employees SET department_uuid = NULL WHERE company_uuid = $1 AND user_uuid = $2;`
 
-	res, err := r.db.ExecContext(ctx, query, dto.CompanyUUID, dto.TargetUUID)
+	res, err := tx.ExecContext(ctx, query, dto.CompanyUUID, dto.TargetUUID)
 	if err != nil {
 		return Error.Internal(err)
 	}
@@ -534,5 +769,12 @@ func (r *companyRepository) RemoveEmployeeFromDepartment(ctx context.Context, dt
 		return Error.Public(codes.NotFound, "employee not found")
 	}
 
+	if eventErr := insertMembershipEvent(ctx, tx, dto.Event); eventErr.Code != 0 {
+		return eventErr
+	}
+
+	if err = tx.Commit(); err != nil {
+		return Error.Internal(err)
+	}
 	return Error.CodeError{}
 }
@@ -0,0 +1,284 @@
+package postgresDB
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+type DeletionRepository interface {
+	ScheduleCompanyDeletion(ctx context.Context, dto entities.ScheduleCompanyDeletionDTO) Error.CodeError
+	GetCompanyDeletion(ctx context.Context, dto entities.GetCompanyDeletionDTO) (*entities.CompanyDeletion, Error.CodeError)
+	RestoreCompany(ctx context.Context, dto entities.RestoreCompanyDTO) Error.CodeError
+	GetDueCompanyDeletions(ctx context.Context, dto entities.GetDueCompanyDeletionsDTO) ([]*entities.CompanyDeletion, Error.CodeError)
+	AdvanceCompanyDeletion(ctx context.Context, dto entities.AdvanceCompanyDeletionDTO) Error.CodeError
+	FailCompanyDeletionStep(ctx context.Context, dto entities.FailCompanyDeletionStepDTO) Error.CodeError
+	CompleteCompanyDeletion(ctx context.Context, dto entities.CompleteCompanyDeletionDTO) Error.CodeError
+}
+
+type deletionRepository struct {
+	db *sql.DB
+}
+
+func NewDeletionRepository(db *sql.DB) DeletionRepository {
+	return &deletionRepository{db: db}
+}
+
+const companyDeletionColumns = `
+			company_uuid,
+			requested_by,
+			step,
+			purge_after::text,
+			archive_key,
+			archived_applications,
+			attempts,
+			last_error,
+			created_at::text,
+			COALESCE(completed_at::text, '')`
+
+// ScheduleCompanyDeletion Мягкое удаление компании: компания скрывается и ставится в очередь на окончательное удаление
+func (r *deletionRepository) ScheduleCompanyDeletion(ctx context.Context, dto entities.ScheduleCompanyDeletionDTO) Error.CodeError {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx,
+		`UPDATE companies SET deleted_at = NOW() WHERE uuid = $1 AND deleted_at IS NULL;`,
+		dto.CompanyUUID,
+	)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	affectedRows, err := res.RowsAffected()
+	if err != nil {
+		return Error.Internal(err)
+	}
+	if affectedRows == 0 {
+		return Error.Public(codes.NotFound, "company not found")
+	}
+
+	// Запись могла остаться от удаления, отменённого восстановлением
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO company_deletions (company_uuid, requested_by, purge_after) VALUES ($1, $2, $3)
+		ON CONFLICT (company_uuid) DO UPDATE SET
+			requested_by = EXCLUDED.requested_by,
+			step = 'soft_deleted',
+			purge_after = EXCLUDED.purge_after,
+			archive_key = '',
+			archived_applications = 0,
+			attempts = 0,
+			last_error = '',
+			next_attempt_at = NOW(),
+			created_at = NOW(),
+			updated_at = NOW(),
+			completed_at = NULL;`,
+		dto.CompanyUUID, dto.RequestedBy, dto.PurgeAfter,
+	)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	if eventErr := insertMembershipEvent(ctx, tx, dto.Event); eventErr.Code != 0 {
+		return eventErr
+	}
+
+	if err = tx.Commit(); err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// GetCompanyDeletion Получение хода удаления компании
+func (r *deletionRepository) GetCompanyDeletion(ctx context.Context, dto entities.GetCompanyDeletionDTO) (*entities.CompanyDeletion, Error.CodeError) {
+	query := `SELECT` + companyDeletionColumns + `
+		FROM company_deletions
+		WHERE company_uuid = $1;`
+
+	deletion, err := scanCompanyDeletion(r.db.QueryRowContext(ctx, query, dto.CompanyUUID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, Error.Public(codes.NotFound, "company deletion not found")
+		}
+		return nil, Error.Internal(err)
+	}
+	return deletion, Error.CodeError{}
+}
+
+// RestoreCompany Отмена удаления компании. Возможна только до начала удаления данных
+func (r *deletionRepository) RestoreCompany(ctx context.Context, dto entities.RestoreCompanyDTO) Error.CodeError {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	defer tx.Rollback()
+
+	// Блокировка записи: воркер не начнёт удаление данных, пока компания восстанавливается
+	var restorable bool
+	err = tx.QueryRowContext(ctx,
+		`SELECT step = 'soft_deleted' AND purge_after > NOW() FROM company_deletions WHERE company_uuid = $1 FOR UPDATE;`,
+		dto.CompanyUUID,
+	).Scan(&restorable)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Error.Public(codes.NotFound, "company deletion not found")
+		}
+		return Error.Internal(err)
+	}
+	if !restorable {
+		return Error.Public(codes.FailedPrecondition, "company deletion already started")
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM company_deletions WHERE company_uuid = $1;`, dto.CompanyUUID); err != nil {
+		return Error.Internal(err)
+	}
+	if _, err = tx.ExecContext(ctx, `UPDATE companies SET deleted_at = NULL WHERE uuid = $1;`, dto.CompanyUUID); err != nil {
+		return Error.Internal(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// GetDueCompanyDeletions Незавершённые удаления, у которых истёк срок восстановления и подошло время следующей попытки
+func (r *deletionRepository) GetDueCompanyDeletions(ctx context.Context, dto entities.GetDueCompanyDeletionsDTO) ([]*entities.CompanyDeletion, Error.CodeError) {
+	query := `SELECT` + companyDeletionColumns + `
+		FROM company_deletions
+		WHERE step <> 'completed' AND purge_after <= NOW() AND next_attempt_at <= NOW()
+		ORDER BY purge_after
+		LIMIT $1;`
+
+	res, err := r.db.QueryContext(ctx, query, dto.Count)
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	defer res.Close()
+
+	deletions := make([]*entities.CompanyDeletion, 0)
+	for res.Next() {
+		deletion, scanErr := scanCompanyDeletion(res)
+		if scanErr != nil {
+			return nil, Error.Internal(scanErr)
+		}
+		deletions = append(deletions, deletion)
+	}
+
+	if err = res.Err(); err != nil {
+		return nil, Error.Internal(err)
+	}
+	return deletions, Error.CodeError{}
+}
+
+// AdvanceCompanyDeletion Переход к следующему шагу удаления. Условие на текущий шаг защищает от повторного
+// перехода, если шаг параллельно выполнил другой экземпляр сервиса или компанию восстановили
+func (r *deletionRepository) AdvanceCompanyDeletion(ctx context.Context, dto entities.AdvanceCompanyDeletionDTO) Error.CodeError {
+	query := `UPDATE company_deletions SET
+			step = $3,
+			archive_key = CASE WHEN $4 = '' THEN archive_key ELSE $4 END,
+			archived_applications = CASE WHEN $4 = '' THEN archived_applications ELSE $5 END,
+			attempts = 0,
+			last_error = '',
+			next_attempt_at = NOW(),
+			updated_at = NOW()
+		WHERE company_uuid = $1 AND step = $2;`
+
+	res, err := r.db.ExecContext(ctx, query, dto.CompanyUUID, dto.From, dto.To, dto.ArchiveKey, dto.ArchivedApplications)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	affectedRows, err := res.RowsAffected()
+	if err != nil {
+		return Error.Internal(err)
+	}
+	if affectedRows == 0 {
+		return Error.Public(codes.FailedPrecondition, "company deletion step changed")
+	}
+	return Error.CodeError{}
+}
+
+// FailCompanyDeletionStep Запись неудачной попытки шага и времени следующей попытки
+func (r *deletionRepository) FailCompanyDeletionStep(ctx context.Context, dto entities.FailCompanyDeletionStepDTO) Error.CodeError {
+	query := `UPDATE company_deletions SET
+			attempts = attempts + 1,
+			last_error = $2,
+			next_attempt_at = $3,
+			updated_at = NOW()
+		WHERE company_uuid = $1 AND step <> 'completed';`
+
+	if _, err := r.db.ExecContext(ctx, query, dto.CompanyUUID, dto.Error, dto.NextAttemptAt); err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// CompleteCompanyDeletion Окончательное удаление компании (сотрудники, отделы и площадки удаляются каскадно)
+// и завершение удаления. Повторный вызов ничего не удаляет
+func (r *deletionRepository) CompleteCompanyDeletion(ctx context.Context, dto entities.CompleteCompanyDeletionDTO) Error.CodeError {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx,
+		`UPDATE company_deletions SET step = 'completed', attempts = 0, last_error = '', updated_at = NOW(), completed_at = NOW()
+		WHERE company_uuid = $1 AND step = 'deleting_company';`,
+		dto.CompanyUUID,
+	)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	affectedRows, err := res.RowsAffected()
+	if err != nil {
+		return Error.Internal(err)
+	}
+	if affectedRows == 0 {
+		return Error.Public(codes.FailedPrecondition, "company deletion step changed")
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM companies WHERE uuid = $1 AND deleted_at IS NOT NULL;`, dto.CompanyUUID); err != nil {
+		return Error.Internal(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// ─── Вспомогательные функции ──────────────────────────────────────────────────
+
+type deletionScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanCompanyDeletion Чтение строки с колонками companyDeletionColumns
+func scanCompanyDeletion(row deletionScanner) (*entities.CompanyDeletion, error) {
+	deletion := &entities.CompanyDeletion{}
+	err := row.Scan(
+		&deletion.CompanyUUID,
+		&deletion.RequestedBy,
+		&deletion.Step,
+		&deletion.PurgeAfter,
+		&deletion.ArchiveKey,
+		&deletion.ArchivedApplications,
+		&deletion.Attempts,
+		&deletion.LastError,
+		&deletion.CreatedAt,
+		&deletion.CompletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return deletion, nil
+}
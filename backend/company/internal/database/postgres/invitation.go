@@ -0,0 +1,249 @@
+package postgresDB
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+type InvitationRepository interface {
+	CreateInvitation(ctx context.Context, dto entities.CreateInvitation) Error.CodeError
+	GetInvitation(ctx context.Context, dto entities.GetInvitationDTO) (*entities.Invitation, Error.CodeError)
+	GetCompanyInvitations(ctx context.Context, dto entities.GetCompanyInvitationsDTO) ([]*entities.Invitation, Error.CodeError)
+	GetEmailInvitations(ctx context.Context, dto entities.GetEmailInvitationsDTO) ([]*entities.Invitation, Error.CodeError)
+	RevokeInvitation(ctx context.Context, dto entities.RevokeInvitationDTO) Error.CodeError
+	AcceptInvitation(ctx context.Context, dto entities.AcceptInvitationDTO) Error.CodeError
+	DeclineInvitation(ctx context.Context, dto entities.DeclineInvitationDTO) Error.CodeError
+}
+
+type invitationRepository struct {
+	db *sql.DB
+}
+
+func NewInvitationRepository(db *sql.DB) InvitationRepository {
+	return &invitationRepository{db: db}
+}
+
+// invitationStatus Ожидающее приглашение с истёкшим сроком считается просроченным
+const invitationStatus = `CASE WHEN i.status = 'pending' AND i.expires_at <= NOW() THEN 'expired' ELSE i.status::text END`
+
+const invitationColumns = `
+			i.uuid,
+			i.company_uuid,
+			c.title,
+			i.email,
+			i.role,
+			COALESCE(i.department_uuid::text, ''),
+			` + invitationStatus + `,
+			i.expires_at::text,
+			i.created_at::text,
+			i.created_by,
+			COALESCE(i.decided_at::text, ''),
+			COALESCE(i.accepted_by::text, '')`
+
+// CreateInvitation Создание приглашения. Просроченное приглашение на тот же адрес закрывается, действующее - конфликт
+func (r *invitationRepository) CreateInvitation(ctx context.Context, dto entities.CreateInvitation) Error.CodeError {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx,
+		`UPDATE invitations SET status = 'expired'
+		WHERE company_uuid = $1 AND lower(email) = lower($2) AND status = 'pending' AND expires_at <= NOW();`,
+		dto.CompanyUUID, dto.Email,
+	)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO invitations (uuid, company_uuid, email, role, department_uuid, expires_at, created_by)
+		VALUES ($1, $2, $3, $4::employee_role, NULLIF($5, '')::uuid, $6, $7);`,
+		dto.UUID, dto.CompanyUUID, dto.Email, dto.Role, dto.DepartmentUUID, dto.ExpiresAt, dto.CreatedBy,
+	)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) {
+			if pqErr.Code == "23505" { // unique_violation: на адрес уже есть действующее приглашение
+				return Error.Public(codes.AlreadyExists, "invitation for this email already pending")
+			}
+			if pqErr.Code == "23503" { // foreign_key_violation
+				return Error.Public(codes.NotFound, "company or department not found")
+			}
+		}
+		return Error.Internal(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// GetInvitation Получение приглашения
+func (r *invitationRepository) GetInvitation(ctx context.Context, dto entities.GetInvitationDTO) (*entities.Invitation, Error.CodeError) {
+	query := `SELECT` + invitationColumns + `
+		FROM invitations i
+		JOIN companies c ON c.uuid = i.company_uuid
+		WHERE i.uuid = $1;`
+
+	invitation, err := scanInvitation(r.db.QueryRowContext(ctx, query, dto.InvitationUUID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, Error.Public(codes.NotFound, "invitation not found")
+		}
+		return nil, Error.Internal(err)
+	}
+	return invitation, Error.CodeError{}
+}
+
+// GetCompanyInvitations Получение приглашений компании (новые первыми), с фильтром по статусу
+func (r *invitationRepository) GetCompanyInvitations(ctx context.Context, dto entities.GetCompanyInvitationsDTO) ([]*entities.Invitation, Error.CodeError) {
+	query := `SELECT` + invitationColumns + `
+		FROM invitations i
+		JOIN companies c ON c.uuid = i.company_uuid
+		WHERE i.company_uuid = $1 AND ($2 = '' OR ` + invitationStatus + ` = $2)
+		ORDER BY i.created_at DESC;`
+
+	rows, err := r.db.QueryContext(ctx, query, dto.CompanyUUID, dto.Status)
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	return scanInvitations(rows)
+}
+
+// GetEmailInvitations Получение действующих приглашений на адрес во все компании
+func (r *invitationRepository) GetEmailInvitations(ctx context.Context, dto entities.GetEmailInvitationsDTO) ([]*entities.Invitation, Error.CodeError) {
+	query := `SELECT` + invitationColumns + `
+		FROM invitations i
+		JOIN companies c ON c.uuid = i.company_uuid
+		WHERE lower(i.email) = lower($1) AND i.status = 'pending' AND i.expires_at > NOW()
+		ORDER BY i.created_at DESC;`
+
+	rows, err := r.db.QueryContext(ctx, query, dto.Email)
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	return scanInvitations(rows)
+}
+
+// RevokeInvitation Отзыв ожидающего приглашения
+func (r *invitationRepository) RevokeInvitation(ctx context.Context, dto entities.RevokeInvitationDTO) Error.CodeError {
+	query := `UPDATE invitations SET status = 'revoked', decided_at = NOW()
+	WHERE uuid = $1 AND status = 'pending';`
+
+	return r.closeInvitation(ctx, query, dto.InvitationUUID)
+}
+
+// AcceptInvitation Принятие приглашения: пользователь добавляется в компанию с ролью и департаментом из приглашения
+func (r *invitationRepository) AcceptInvitation(ctx context.Context, dto entities.AcceptInvitationDTO) Error.CodeError {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	defer tx.Rollback()
+
+	var companyUUID, role, departmentUUID string
+	err = tx.QueryRowContext(ctx,
+		`UPDATE invitations SET status = 'accepted', decided_at = NOW(), accepted_by = $2
+		WHERE uuid = $1 AND status = 'pending' AND expires_at > NOW()
+		RETURNING company_uuid, role, COALESCE(department_uuid::text, '');`,
+		dto.InvitationUUID, dto.UserUUID,
+	).Scan(&companyUUID, &role, &departmentUUID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Error.Public(codes.FailedPrecondition, "invitation is not pending")
+		}
+		return Error.Internal(err)
+	}
+
+	if joinErr := insertEmployee(ctx, tx, companyUUID, dto.UserUUID, role, departmentUUID); joinErr.Code != 0 {
+		return joinErr
+	}
+
+	if err = tx.Commit(); err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// DeclineInvitation Отказ от ожидающего приглашения
+func (r *invitationRepository) DeclineInvitation(ctx context.Context, dto entities.DeclineInvitationDTO) Error.CodeError {
+	query := `UPDATE invitations SET status = 'declined', decided_at = NOW()
+	WHERE uuid = $1 AND status = 'pending' AND expires_at > NOW();`
+
+	return r.closeInvitation(ctx, query, dto.InvitationUUID)
+}
+
+// closeInvitation Перевод ожидающего приглашения в конечный статус
+func (r *invitationRepository) closeInvitation(ctx context.Context, query, invitationUUID string) Error.CodeError {
+	res, err := r.db.ExecContext(ctx, query, invitationUUID)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	affectedRows, err := res.RowsAffected()
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	if affectedRows == 0 {
+		return Error.Public(codes.FailedPrecondition, "invitation is not pending")
+	}
+	return Error.CodeError{}
+}
+
+// ─── Вспомогательные функции ──────────────────────────────────────────────────
+
+type invitationScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanInvitation Чтение строки с колонками invitationColumns
+func scanInvitation(row invitationScanner) (*entities.Invitation, error) {
+	invitation := &entities.Invitation{}
+	err := row.Scan(
+		&invitation.UUID,
+		&invitation.CompanyUUID,
+		&invitation.CompanyTitle,
+		&invitation.Email,
+		&invitation.Role,
+		&invitation.DepartmentUUID,
+		&invitation.Status,
+		&invitation.ExpiresAt,
+		&invitation.CreatedAt,
+		&invitation.CreatedBy,
+		&invitation.DecidedAt,
+		&invitation.AcceptedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return invitation, nil
+}
+
+func scanInvitations(rows *sql.Rows) ([]*entities.Invitation, Error.CodeError) {
+	defer rows.Close()
+
+	invitations := make([]*entities.Invitation, 0)
+	for rows.Next() {
+		invitation, err := scanInvitation(rows)
+		if err != nil {
+			return nil, Error.Internal(err)
+		}
+		invitations = append(invitations, invitation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, Error.Internal(err)
+	}
+
+	return invitations, Error.CodeError{}
+}
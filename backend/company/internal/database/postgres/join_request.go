@@ -0,0 +1,228 @@
+package postgresDB
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+type JoinRequestRepository interface {
+	CreateJoinRequest(ctx context.Context, dto entities.CreateJoinRequestDTO) Error.CodeError
+	GetJoinRequest(ctx context.Context, dto entities.GetJoinRequestDTO) (*entities.JoinRequest, Error.CodeError)
+	GetCompanyJoinRequests(ctx context.Context, dto entities.GetCompanyJoinRequestsDTO) ([]*entities.JoinRequest, Error.CodeError)
+	AcceptJoinRequest(ctx context.Context, dto entities.DecideJoinRequestDTO) Error.CodeError
+	DeclineJoinRequest(ctx context.Context, dto entities.DecideJoinRequestDTO) Error.CodeError
+	GetJoinCodeRedemptions(ctx context.Context, dto entities.GetJoinCodeRedemptionsDTO) ([]*entities.JoinCodeRedemption, Error.CodeError)
+}
+
+type joinRequestRepository struct {
+	db *sql.DB
+}
+
+func NewJoinRequestRepository(db *sql.DB) JoinRequestRepository {
+	return &joinRequestRepository{db: db}
+}
+
+const joinRequestColumns = `
+			uuid,
+			company_uuid,
+			user_uuid,
+			join_code,
+			status,
+			created_at::text,
+			COALESCE(decided_at::text, ''),
+			COALESCE(decided_by::text, '')`
+
+// CreateJoinRequest Создание заявки на вступление вместе с записью об использовании кода
+func (r *joinRequestRepository) CreateJoinRequest(ctx context.Context, dto entities.CreateJoinRequestDTO) Error.CodeError {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO join_requests (uuid, company_uuid, user_uuid, join_code) VALUES ($1, $2, $3, $4);`,
+		dto.UUID, dto.CompanyUUID, dto.UserUUID, dto.JoinCode,
+	)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) {
+			if pqErr.Code == "23505" { // unique_violation: уже есть ожидающая заявка
+				return Error.Public(codes.AlreadyExists, "join request already pending")
+			}
+			if pqErr.Code == "23503" { // foreign_key_violation
+				return Error.Public(codes.NotFound, "company not found")
+			}
+		}
+		return Error.Internal(err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO join_code_redemptions (company_uuid, join_code, user_uuid, join_request_uuid) VALUES ($1, $2, $3, $4);`,
+		dto.CompanyUUID, dto.JoinCode, dto.UserUUID, dto.UUID,
+	)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// GetJoinRequest Получение заявки на вступление
+func (r *joinRequestRepository) GetJoinRequest(ctx context.Context, dto entities.GetJoinRequestDTO) (*entities.JoinRequest, Error.CodeError) {
+	query := `SELECT` + joinRequestColumns + `
+		FROM join_requests
+		WHERE uuid = $1;`
+
+	request, err := scanJoinRequest(r.db.QueryRowContext(ctx, query, dto.JoinRequestUUID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, Error.Public(codes.NotFound, "join request not found")
+		}
+		return nil, Error.Internal(err)
+	}
+	return request, Error.CodeError{}
+}
+
+// GetCompanyJoinRequests Получение заявок на вступление в компанию (новые первыми), с фильтром по статусу
+func (r *joinRequestRepository) GetCompanyJoinRequests(ctx context.Context, dto entities.GetCompanyJoinRequestsDTO) ([]*entities.JoinRequest, Error.CodeError) {
+	query := `SELECT` + joinRequestColumns + `
+		FROM join_requests
+		WHERE company_uuid = $1 AND ($2 = '' OR status::text = $2)
+		ORDER BY created_at DESC;`
+
+	rows, err := r.db.QueryContext(ctx, query, dto.CompanyUUID, dto.Status)
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	defer rows.Close()
+
+	requests := make([]*entities.JoinRequest, 0)
+	for rows.Next() {
+		request, scanErr := scanJoinRequest(rows)
+		if scanErr != nil {
+			return nil, Error.Internal(scanErr)
+		}
+		requests = append(requests, request)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, Error.Internal(err)
+	}
+
+	return requests, Error.CodeError{}
+}
+
+// AcceptJoinRequest Одобрение заявки: пользователь добавляется в компанию без роли (unemployed)
+func (r *joinRequestRepository) AcceptJoinRequest(ctx context.Context, dto entities.DecideJoinRequestDTO) Error.CodeError {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	defer tx.Rollback()
+
+	var companyUUID, userUUID string
+	err = tx.QueryRowContext(ctx,
+		`UPDATE join_requests SET status = 'accepted', decided_at = NOW(), decided_by = $2
+		WHERE uuid = $1 AND status = 'pending'
+		RETURNING company_uuid, user_uuid;`,
+		dto.JoinRequestUUID, dto.DecidedBy,
+	).Scan(&companyUUID, &userUUID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Error.Public(codes.FailedPrecondition, "join request is not pending")
+		}
+		return Error.Internal(err)
+	}
+
+	if joinErr := insertEmployee(ctx, tx, companyUUID, userUUID, "unemployed", ""); joinErr.Code != 0 {
+		return joinErr
+	}
+
+	if err = tx.Commit(); err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// DeclineJoinRequest Отклонение заявки на вступление
+func (r *joinRequestRepository) DeclineJoinRequest(ctx context.Context, dto entities.DecideJoinRequestDTO) Error.CodeError {
+	query := `UPDATE join_requests SET status = 'declined', decided_at = NOW(), decided_by = $2
+	WHERE uuid = $1 AND status = 'pending';`
+
+	res, err := r.db.ExecContext(ctx, query, dto.JoinRequestUUID, dto.DecidedBy)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	affectedRows, err := res.RowsAffected()
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	if affectedRows == 0 {
+		return Error.Public(codes.FailedPrecondition, "join request is not pending")
+	}
+	return Error.CodeError{}
+}
+
+// GetJoinCodeRedemptions Получение истории использования кода для вступления (последние первыми)
+func (r *joinRequestRepository) GetJoinCodeRedemptions(ctx context.Context, dto entities.GetJoinCodeRedemptionsDTO) ([]*entities.JoinCodeRedemption, Error.CodeError) {
+	query := `SELECT user_uuid, redeemed_at::text, COALESCE(join_request_uuid::text, '')
+		FROM join_code_redemptions
+		WHERE company_uuid = $1 AND join_code = $2
+		ORDER BY redeemed_at DESC;`
+
+	rows, err := r.db.QueryContext(ctx, query, dto.CompanyUUID, dto.Code)
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	defer rows.Close()
+
+	redemptions := make([]*entities.JoinCodeRedemption, 0)
+	for rows.Next() {
+		redemption := &entities.JoinCodeRedemption{}
+		if err = rows.Scan(&redemption.UserUUID, &redemption.RedeemedAt, &redemption.JoinRequestUUID); err != nil {
+			return nil, Error.Internal(err)
+		}
+		redemptions = append(redemptions, redemption)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, Error.Internal(err)
+	}
+	return redemptions, Error.CodeError{}
+}
+
+// ─── Вспомогательные функции ──────────────────────────────────────────────────
+
+type joinRequestScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanJoinRequest Чтение строки с колонками joinRequestColumns
+func scanJoinRequest(row joinRequestScanner) (*entities.JoinRequest, error) {
+	request := &entities.JoinRequest{}
+	err := row.Scan(
+		&request.UUID,
+		&request.CompanyUUID,
+		&request.UserUUID,
+		&request.JoinCode,
+		&request.Status,
+		&request.CreatedAt,
+		&request.DecidedAt,
+		&request.DecidedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return request, nil
+}
@@ -0,0 +1,224 @@
+package postgresDB
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+type LocationRepository interface {
+	CreateLocation(ctx context.Context, dto entities.CreateLocation) Error.CodeError
+	GetLocation(ctx context.Context, dto entities.GetLocationDTO) (*entities.Location, Error.CodeError)
+	GetLocationPath(ctx context.Context, dto entities.GetLocationPathDTO) ([]*entities.Location, Error.CodeError)
+	GetCompanyLocations(ctx context.Context, dto entities.GetCompanyLocationsDTO) ([]*entities.Location, Error.CodeError)
+	UpdateLocation(ctx context.Context, dto entities.UpdateLocation) Error.CodeError
+	DeleteLocation(ctx context.Context, dto entities.DeleteLocationDTO) Error.CodeError
+}
+
+type locationRepository struct {
+	db *sql.DB
+}
+
+func NewLocationRepository(db *sql.DB) LocationRepository {
+	return &locationRepository{db: db}
+}
+
+const locationColumns = `
+			uuid,
+			company_uuid,
+			COALESCE(parent_uuid::text, ''),
+			kind,
+			title,
+			latitude,
+			longitude,
+			created_at::text,
+			created_by`
+
+// CreateLocation Создание места (объекта, здания, этажа или зоны)
+func (r *locationRepository) CreateLocation(ctx context.Context, dto entities.CreateLocation) Error.CodeError {
+	query := `INSERT INTO locations
+	(uuid, company_uuid, parent_uuid, kind, title, latitude, longitude, created_by) VALUES
+	($1, $2, NULLIF($3, '')::uuid, $4::location_kind, $5, $6, $7, $8);`
+
+	_, err := r.db.ExecContext(ctx, query,
+		dto.UUID,
+		dto.CompanyUUID,
+		dto.ParentUUID,
+		dto.Kind,
+		dto.Title,
+		dto.Latitude,
+		dto.Longitude,
+		dto.CreatedBy,
+	)
+	if err != nil {
+		var pgErr *pq.Error
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23503" { // foreign_key_violation
+				return Error.Public(codes.NotFound, "parent location not found")
+			}
+		}
+
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// GetLocation Получение места
+func (r *locationRepository) GetLocation(ctx context.Context, dto entities.GetLocationDTO) (*entities.Location, Error.CodeError) {
+	query := `SELECT` + locationColumns + `
+		FROM locations
+		WHERE uuid = $1;`
+
+	location, err := scanLocation(r.db.QueryRowContext(ctx, query, dto.LocationUUID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, Error.Public(codes.NotFound, "location not found")
+		}
+		return nil, Error.Internal(err)
+	}
+	return location, Error.CodeError{}
+}
+
+// GetLocationPath Получение цепочки мест от объекта (site) до заданного места включительно
+func (r *locationRepository) GetLocationPath(ctx context.Context, dto entities.GetLocationPathDTO) ([]*entities.Location, Error.CodeError) {
+	query := `WITH RECURSIVE path (step_uuid, step_parent_uuid, depth) AS (
+			SELECT uuid, parent_uuid, 0 FROM locations WHERE uuid = $1
+			UNION ALL
+			SELECT l.uuid, l.parent_uuid, p.depth + 1
+			FROM locations l
+			JOIN path p ON l.uuid = p.step_parent_uuid
+		)
+		SELECT` + locationColumns + `
+		FROM locations
+		JOIN path ON uuid = step_uuid
+		ORDER BY depth DESC;`
+
+	rows, err := r.db.QueryContext(ctx, query, dto.LocationUUID)
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+
+	path, scanErr := scanLocations(rows)
+	if scanErr.Code != 0 {
+		return nil, scanErr
+	}
+
+	if len(path) == 0 {
+		return nil, Error.Public(codes.NotFound, "location not found")
+	}
+	return path, Error.CodeError{}
+}
+
+// GetCompanyLocations Получение списка мест компании с фильтрацией по родителю и уровню (offset и count)
+func (r *locationRepository) GetCompanyLocations(ctx context.Context, dto entities.GetCompanyLocationsDTO) ([]*entities.Location, Error.CodeError) {
+	query := `SELECT` + locationColumns + `
+		FROM locations
+		WHERE company_uuid = $1
+			AND (NULLIF($2, '') IS NULL OR parent_uuid = NULLIF($2, '')::uuid)
+			AND ($3 = '' OR kind::text = $3)
+		ORDER BY created_at, uuid
+		OFFSET $4 LIMIT $5;`
+
+	rows, err := r.db.QueryContext(ctx, query, dto.CompanyUUID, dto.ParentUUID, dto.Kind, dto.Offset, dto.Count)
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+	return scanLocations(rows)
+}
+
+// UpdateLocation Обновление названия и координат места
+func (r *locationRepository) UpdateLocation(ctx context.Context, dto entities.UpdateLocation) Error.CodeError {
+	query := `UPDATE locations SET title = $2, latitude = $3, longitude = $4 WHERE uuid = $1;`
+
+	res, err := r.db.ExecContext(ctx, query, dto.UUID, dto.Title, dto.Latitude, dto.Longitude)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	if rowsAffected == 0 {
+		return Error.Public(codes.NotFound, "location not found")
+	}
+
+	return Error.CodeError{}
+}
+
+// DeleteLocation Удаление места. Место с вложенными местами не удаляется
+func (r *locationRepository) DeleteLocation(ctx context.Context, dto entities.DeleteLocationDTO) Error.CodeError {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM locations WHERE uuid = $1;`, dto.LocationUUID)
+	if err != nil {
+		var pgErr *pq.Error
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23503" { // foreign_key_violation
+				return Error.Public(codes.FailedPrecondition, "location has nested locations")
+			}
+		}
+
+		return Error.Internal(err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	if rowsAffected == 0 {
+		return Error.Public(codes.NotFound, "location not found")
+	}
+
+	return Error.CodeError{}
+}
+
+// ─── Вспомогательные функции ──────────────────────────────────────────────────
+
+type locationScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanLocation Чтение строки с колонками locationColumns
+func scanLocation(row locationScanner) (*entities.Location, error) {
+	location := &entities.Location{}
+	err := row.Scan(
+		&location.UUID,
+		&location.CompanyUUID,
+		&location.ParentUUID,
+		&location.Kind,
+		&location.Title,
+		&location.Latitude,
+		&location.Longitude,
+		&location.CreatedAt,
+		&location.CreatedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return location, nil
+}
+
+func scanLocations(rows *sql.Rows) ([]*entities.Location, Error.CodeError) {
+	defer rows.Close()
+
+	locations := make([]*entities.Location, 0)
+	for rows.Next() {
+		location, err := scanLocation(rows)
+		if err != nil {
+			return nil, Error.Internal(err)
+		}
+		locations = append(locations, location)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, Error.Internal(err)
+	}
+
+	return locations, Error.CodeError{}
+}
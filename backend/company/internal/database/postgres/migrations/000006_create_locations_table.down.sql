@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS locations;
+DROP TYPE IF EXISTS location_kind;
@@ -0,0 +1,21 @@
+CREATE TYPE location_kind AS ENUM (
+    'site',
+    'building',
+    'floor',
+    'zone'
+);
+
+CREATE TABLE locations (
+    uuid         UUID             PRIMARY KEY,
+    company_uuid UUID             NOT NULL REFERENCES companies(uuid) ON DELETE CASCADE,
+    parent_uuid  UUID             REFERENCES locations(uuid),
+    kind         location_kind    NOT NULL,
+    title        VARCHAR(255)     NOT NULL,
+    latitude     DOUBLE PRECISION,
+    longitude    DOUBLE PRECISION,
+    created_at   TIMESTAMPTZ      NOT NULL DEFAULT NOW(),
+    created_by   UUID             NOT NULL,
+    CHECK ((latitude IS NULL) = (longitude IS NULL))
+);
+
+CREATE INDEX idx_locations_company_parent ON locations (company_uuid, parent_uuid);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS invitations;
+DROP TYPE IF EXISTS invitation_status;
+DROP TABLE IF EXISTS join_code_redemptions;
+DROP TABLE IF EXISTS join_requests;
+DROP TYPE IF EXISTS join_request_status;
+ALTER TABLE companies DROP COLUMN IF EXISTS join_approval_required;
@@ -0,0 +1,58 @@
+ALTER TABLE companies ADD COLUMN join_approval_required BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TYPE join_request_status AS ENUM (
+    'pending',
+    'accepted',
+    'declined'
+);
+
+CREATE TABLE join_requests (
+    uuid         UUID                PRIMARY KEY,
+    company_uuid UUID                NOT NULL REFERENCES companies(uuid) ON DELETE CASCADE,
+    user_uuid    UUID                NOT NULL,
+    join_code    VARCHAR(6)          NOT NULL,
+    status       join_request_status NOT NULL DEFAULT 'pending',
+    created_at   TIMESTAMPTZ         NOT NULL DEFAULT NOW(),
+    decided_at   TIMESTAMPTZ,
+    decided_by   UUID
+);
+
+-- Не больше одной ожидающей заявки пользователя в компанию
+CREATE UNIQUE INDEX idx_join_requests_pending ON join_requests (company_uuid, user_uuid) WHERE status = 'pending';
+CREATE INDEX idx_join_requests_company ON join_requests (company_uuid, created_at DESC);
+
+CREATE TABLE join_code_redemptions (
+    company_uuid      UUID        NOT NULL REFERENCES companies(uuid) ON DELETE CASCADE,
+    join_code         VARCHAR(6)  NOT NULL,
+    user_uuid         UUID        NOT NULL,
+    join_request_uuid UUID        REFERENCES join_requests(uuid) ON DELETE SET NULL,
+    redeemed_at       TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_join_code_redemptions_code ON join_code_redemptions (company_uuid, join_code, redeemed_at DESC);
+
+CREATE TYPE invitation_status AS ENUM (
+    'pending',
+    'accepted',
+    'declined',
+    'revoked',
+    'expired'
+);
+
+CREATE TABLE invitations (
+    uuid            UUID              PRIMARY KEY,
+    company_uuid    UUID              NOT NULL REFERENCES companies(uuid) ON DELETE CASCADE,
+    email           VARCHAR(255)      NOT NULL,
+    role            employee_role     NOT NULL,
+    department_uuid UUID              REFERENCES departments(uuid) ON DELETE SET NULL,
+    status          invitation_status NOT NULL DEFAULT 'pending',
+    expires_at      TIMESTAMPTZ       NOT NULL,
+    created_at      TIMESTAMPTZ       NOT NULL DEFAULT NOW(),
+    created_by      UUID              NOT NULL,
+    decided_at      TIMESTAMPTZ,
+    accepted_by     UUID
+);
+
+-- Не больше одного ожидающего приглашения на адрес в компании
+CREATE UNIQUE INDEX idx_invitations_pending ON invitations (company_uuid, lower(email)) WHERE status = 'pending';
+CREATE INDEX idx_invitations_email ON invitations (lower(email)) WHERE status = 'pending';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS ownership_transfers;
+DROP TYPE IF EXISTS ownership_transfer_status;
+ALTER TABLE companies DROP COLUMN IF EXISTS owner_uuid;
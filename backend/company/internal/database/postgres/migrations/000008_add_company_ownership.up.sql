@@ -0,0 +1,32 @@
+ALTER TABLE companies ADD COLUMN owner_uuid UUID;
+
+-- Владелец - основатель, если он ещё chief, иначе chief с наибольшим стажем
+UPDATE companies c SET owner_uuid = COALESCE(
+    (SELECT e.user_uuid FROM employees e WHERE e.company_uuid = c.uuid AND e.user_uuid = c.created_by AND e.role = 'chief'),
+    (SELECT e.user_uuid FROM employees e WHERE e.company_uuid = c.uuid AND e.role = 'chief' ORDER BY e.joined_at LIMIT 1),
+    c.created_by
+);
+
+ALTER TABLE companies ALTER COLUMN owner_uuid SET NOT NULL;
+
+CREATE TYPE ownership_transfer_status AS ENUM (
+    'pending',
+    'accepted',
+    'declined',
+    'cancelled',
+    'expired'
+);
+
+CREATE TABLE ownership_transfers (
+    uuid         UUID                      PRIMARY KEY,
+    company_uuid UUID                      NOT NULL REFERENCES companies(uuid) ON DELETE CASCADE,
+    from_uuid    UUID                      NOT NULL,
+    to_uuid      UUID                      NOT NULL,
+    status       ownership_transfer_status NOT NULL DEFAULT 'pending',
+    expires_at   TIMESTAMPTZ               NOT NULL,
+    created_at   TIMESTAMPTZ               NOT NULL DEFAULT NOW(),
+    decided_at   TIMESTAMPTZ
+);
+
+-- Не больше одной ожидающей передачи владения в компании
+CREATE UNIQUE INDEX idx_ownership_transfers_pending ON ownership_transfers (company_uuid) WHERE status = 'pending';
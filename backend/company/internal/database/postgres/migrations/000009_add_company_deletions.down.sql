@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS company_deletions;
+DROP TYPE IF EXISTS company_deletion_step;
+ALTER TABLE companies DROP COLUMN IF EXISTS deleted_at;
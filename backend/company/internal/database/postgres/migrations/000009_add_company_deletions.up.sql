@@ -0,0 +1,29 @@
+-- Удалённая компания скрыта сразу, а данные удаляются по шагам после окончания срока восстановления
+ALTER TABLE companies ADD COLUMN deleted_at TIMESTAMPTZ;
+
+CREATE TYPE company_deletion_step AS ENUM (
+    'soft_deleted',
+    'archiving_applications',
+    'purging_join_codes',
+    'deleting_company',
+    'completed'
+);
+
+-- Без внешнего ключа на companies: запись о ходе удаления остаётся после удаления компании
+CREATE TABLE company_deletions (
+    company_uuid          UUID                  PRIMARY KEY,
+    requested_by          UUID                  NOT NULL,
+    step                  company_deletion_step NOT NULL DEFAULT 'soft_deleted',
+    purge_after           TIMESTAMPTZ           NOT NULL,
+    archive_key           TEXT                  NOT NULL DEFAULT '',
+    archived_applications BIGINT                NOT NULL DEFAULT 0,
+    attempts              INT                   NOT NULL DEFAULT 0,
+    last_error            TEXT                  NOT NULL DEFAULT '',
+    next_attempt_at       TIMESTAMPTZ           NOT NULL DEFAULT NOW(),
+    created_at            TIMESTAMPTZ           NOT NULL DEFAULT NOW(),
+    updated_at            TIMESTAMPTZ           NOT NULL DEFAULT NOW(),
+    completed_at          TIMESTAMPTZ
+);
+
+-- Незавершённые удаления, которые пора продолжить
+CREATE INDEX idx_company_deletions_due ON company_deletions (purge_after) WHERE step <> 'completed';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS company_audit_log;
+DROP FUNCTION IF EXISTS forbid_company_audit_log_update();
+DROP TYPE IF EXISTS company_audit_action;
@@ -0,0 +1,36 @@
+CREATE TYPE company_audit_action AS ENUM (
+    'employee_role_changed',
+    'employee_removed',
+    'department_created',
+    'department_deleted',
+    'company_title_changed',
+    'department_title_changed',
+    'join_code_created'
+);
+
+-- Журнал административных действий: записи только добавляются, удаляются вместе с компанией
+CREATE TABLE company_audit_log (
+    uuid         UUID                 PRIMARY KEY,
+    company_uuid UUID                 NOT NULL REFERENCES companies(uuid) ON DELETE CASCADE,
+    actor_uuid   UUID                 NOT NULL,
+    action       company_audit_action NOT NULL,
+    target_type  TEXT                 NOT NULL,
+    target_id    TEXT                 NOT NULL,
+    before_value TEXT                 NOT NULL DEFAULT '',
+    after_value  TEXT                 NOT NULL DEFAULT '',
+    operation_id TEXT                 NOT NULL DEFAULT '',
+    created_at   TIMESTAMPTZ          NOT NULL DEFAULT NOW()
+);
+
+-- uuid (UUIDv7) растёт со временем создания записи
+CREATE INDEX idx_company_audit_log_company ON company_audit_log (company_uuid, uuid DESC);
+
+CREATE FUNCTION forbid_company_audit_log_update() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'company_audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER company_audit_log_append_only
+    BEFORE UPDATE ON company_audit_log
+    FOR EACH ROW EXECUTE FUNCTION forbid_company_audit_log_update();
@@ -0,0 +1,13 @@
+-- События изменения состава компании записываются в транзакции изменения и публикуются outbox worker-ом.
+-- Без внешнего ключа на companies: событие удаления компании публикуется и после её окончательного удаления
+CREATE TABLE membership_outbox (
+    uuid         UUID        PRIMARY KEY,
+    event_type   TEXT        NOT NULL,
+    company_uuid UUID        NOT NULL,
+    payload      JSONB       NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_membership_outbox_unpublished ON membership_outbox(created_at, uuid) WHERE published_at IS NULL;
+CREATE INDEX idx_membership_outbox_published_at ON membership_outbox(published_at) WHERE published_at IS NOT NULL;
@@ -0,0 +1,216 @@
+package postgresDB
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+type OwnershipRepository interface {
+	CreateOwnershipTransfer(ctx context.Context, dto entities.CreateOwnershipTransferDTO) Error.CodeError
+	GetPendingOwnershipTransfer(ctx context.Context, dto entities.GetOwnershipTransferDTO) (*entities.OwnershipTransfer, Error.CodeError)
+	AcceptOwnershipTransfer(ctx context.Context, dto entities.DecideOwnershipTransferDTO) Error.CodeError
+	DeclineOwnershipTransfer(ctx context.Context, dto entities.DecideOwnershipTransferDTO) Error.CodeError
+	CancelOwnershipTransfer(ctx context.Context, dto entities.DecideOwnershipTransferDTO) Error.CodeError
+}
+
+type ownershipRepository struct {
+	db *sql.DB
+}
+
+func NewOwnershipRepository(db *sql.DB) OwnershipRepository {
+	return &ownershipRepository{db: db}
+}
+
+const ownershipTransferColumns = `
+			uuid,
+			company_uuid,
+			from_uuid,
+			to_uuid,
+			status,
+			expires_at::text,
+			created_at::text,
+			COALESCE(decided_at::text, '')`
+
+// CreateOwnershipTransfer Создание передачи владения. Владелец проверяется под блокировкой компании,
+// получатель должен быть сотрудником. Просроченная передача закрывается, действующая - конфликт
+func (r *ownershipRepository) CreateOwnershipTransfer(ctx context.Context, dto entities.CreateOwnershipTransferDTO) Error.CodeError {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	defer tx.Rollback()
+
+	ownerUUID, lockErr := lockCompanyOwner(ctx, tx, dto.CompanyUUID)
+	if lockErr.Code != 0 {
+		return lockErr
+	}
+	if ownerUUID != dto.FromUUID {
+		return Error.Public(codes.PermissionDenied, "only company owner can transfer ownership")
+	}
+
+	var isEmployee bool
+	err = tx.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM employees WHERE company_uuid = $1 AND user_uuid = $2);`,
+		dto.CompanyUUID, dto.ToUUID,
+	).Scan(&isEmployee)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	if !isEmployee {
+		return Error.Public(codes.NotFound, "employee not found")
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`UPDATE ownership_transfers SET status = 'expired'
+		WHERE company_uuid = $1 AND status = 'pending' AND expires_at <= NOW();`,
+		dto.CompanyUUID,
+	)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO ownership_transfers (uuid, company_uuid, from_uuid, to_uuid, expires_at) VALUES ($1, $2, $3, $4, $5);`,
+		dto.UUID, dto.CompanyUUID, dto.FromUUID, dto.ToUUID, dto.ExpiresAt,
+	)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" { // unique_violation: передача уже ожидает подтверждения
+			return Error.Public(codes.AlreadyExists, "ownership transfer already pending")
+		}
+		return Error.Internal(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// GetPendingOwnershipTransfer Получение действующей передачи владения компании
+func (r *ownershipRepository) GetPendingOwnershipTransfer(ctx context.Context, dto entities.GetOwnershipTransferDTO) (*entities.OwnershipTransfer, Error.CodeError) {
+	query := `SELECT` + ownershipTransferColumns + `
+		FROM ownership_transfers
+		WHERE company_uuid = $1 AND status = 'pending' AND expires_at > NOW();`
+
+	transfer := &entities.OwnershipTransfer{}
+	err := r.db.QueryRowContext(ctx, query, dto.CompanyUUID).Scan(
+		&transfer.UUID,
+		&transfer.CompanyUUID,
+		&transfer.FromUUID,
+		&transfer.ToUUID,
+		&transfer.Status,
+		&transfer.ExpiresAt,
+		&transfer.CreatedAt,
+		&transfer.DecidedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, Error.Public(codes.NotFound, "ownership transfer not found")
+		}
+		return nil, Error.Internal(err)
+	}
+	return transfer, Error.CodeError{}
+}
+
+// AcceptOwnershipTransfer Подтверждение передачи получателем: он становится владельцем и chief.
+// Прежний владелец остаётся chief и после этого может покинуть компанию
+func (r *ownershipRepository) AcceptOwnershipTransfer(ctx context.Context, dto entities.DecideOwnershipTransferDTO) Error.CodeError {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	defer tx.Rollback()
+
+	ownerUUID, lockErr := lockCompanyOwner(ctx, tx, dto.CompanyUUID)
+	if lockErr.Code != 0 {
+		return lockErr
+	}
+
+	var fromUUID string
+	err = tx.QueryRowContext(ctx,
+		`UPDATE ownership_transfers SET status = 'accepted', decided_at = NOW()
+		WHERE company_uuid = $1 AND to_uuid = $2 AND status = 'pending' AND expires_at > NOW()
+		RETURNING from_uuid;`,
+		dto.CompanyUUID, dto.UserUUID,
+	).Scan(&fromUUID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Error.Public(codes.NotFound, "ownership transfer not found")
+		}
+		return Error.Internal(err)
+	}
+
+	// Передачи создаёт только владелец, а смена владельца закрывает их - расхождение означает гонку
+	if fromUUID != ownerUUID {
+		return Error.Public(codes.FailedPrecondition, "company owner has changed")
+	}
+
+	res, err := tx.ExecContext(ctx,
+		`UPDATE employees SET role = 'chief' WHERE company_uuid = $1 AND user_uuid = $2;`,
+		dto.CompanyUUID, dto.UserUUID,
+	)
+	if err != nil {
+		return Error.Internal(err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return Error.Internal(err)
+	}
+	if rowsAffected == 0 {
+		return Error.Public(codes.NotFound, "employee not found")
+	}
+
+	if _, err = tx.ExecContext(ctx, `UPDATE companies SET owner_uuid = $2 WHERE uuid = $1;`, dto.CompanyUUID, dto.UserUUID); err != nil {
+		return Error.Internal(err)
+	}
+
+	if eventErr := insertMembershipEvent(ctx, tx, dto.Event); eventErr.Code != 0 {
+		return eventErr
+	}
+
+	if err = tx.Commit(); err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// DeclineOwnershipTransfer Отказ получателя от передачи владения
+func (r *ownershipRepository) DeclineOwnershipTransfer(ctx context.Context, dto entities.DecideOwnershipTransferDTO) Error.CodeError {
+	query := `UPDATE ownership_transfers SET status = 'declined', decided_at = NOW()
+	WHERE company_uuid = $1 AND to_uuid = $2 AND status = 'pending' AND expires_at > NOW();`
+
+	return r.closeOwnershipTransfer(ctx, query, dto)
+}
+
+// CancelOwnershipTransfer Отмена передачи владения владельцем
+func (r *ownershipRepository) CancelOwnershipTransfer(ctx context.Context, dto entities.DecideOwnershipTransferDTO) Error.CodeError {
+	query := `UPDATE ownership_transfers SET status = 'cancelled', decided_at = NOW()
+	WHERE company_uuid = $1 AND from_uuid = $2 AND status = 'pending' AND expires_at > NOW();`
+
+	return r.closeOwnershipTransfer(ctx, query, dto)
+}
+
+// closeOwnershipTransfer Перевод действующей передачи владения в конечный статус
+func (r *ownershipRepository) closeOwnershipTransfer(ctx context.Context, query string, dto entities.DecideOwnershipTransferDTO) Error.CodeError {
+	res, err := r.db.ExecContext(ctx, query, dto.CompanyUUID, dto.UserUUID)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	affectedRows, err := res.RowsAffected()
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	if affectedRows == 0 {
+		return Error.Public(codes.NotFound, "ownership transfer not found")
+	}
+	return Error.CodeError{}
+}
@@ -17,14 +17,26 @@ import (
 var migrationsFS embed.FS
 
 type DatabaseRepository struct {
-	Company CompanyRepository
-	db      *sql.DB
+	Company     CompanyRepository
+	Location    LocationRepository
+	JoinRequest JoinRequestRepository
+	Invitation  InvitationRepository
+	Ownership   OwnershipRepository
+	Deletion    DeletionRepository
+	Audit       AuditRepository
+	Outbox      OutboxRepository
+	db          *sql.DB
 }
 
 func (r *DatabaseRepository) Ping(ctx context.Context) error {
 	return r.db.PingContext(ctx)
 }
 
+// Close Закрывает пул соединений. Новые запросы отклоняются, начатые запросы и транзакции дожидаются завершения.
+func (r *DatabaseRepository) Close() error {
+	return r.db.Close()
+}
+
 func NewDatabaseInstance(connectString string) *DatabaseRepository {
 	db := sharedPostgres.Connect(connectString)
 
@@ -50,7 +62,14 @@ func NewDatabaseInstance(connectString string) *DatabaseRepository {
 	log.Info().Msg("migrations applied successfully")
 
 	return &DatabaseRepository{
-		Company: NewCompanyRepository(db),
-		db:      db,
+		Company:     NewCompanyRepository(db),
+		Location:    NewLocationRepository(db),
+		JoinRequest: NewJoinRequestRepository(db),
+		Invitation:  NewInvitationRepository(db),
+		Ownership:   NewOwnershipRepository(db),
+		Deletion:    NewDeletionRepository(db),
+		Audit:       NewAuditRepository(db),
+		Outbox:      NewOutboxRepository(db),
+		db:          db,
 	}
 }
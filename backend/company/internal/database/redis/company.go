@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/redis/go-redis/v9"
 	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
@@ -18,8 +19,27 @@ type CompanyRepository interface {
 	GetCompanyJoinCodes(ctx context.Context, dto entities.GetCompanyJoinCodesDTO) ([]string, Error.CodeError)
 	GetCompanyByJoinCode(ctx context.Context, dto entities.GetCompanyByJoinCodeDTO) (string, Error.CodeError)
 	DeleteCompanyJoinCode(ctx context.Context, dto entities.DeleteCompanyJoinCodeDTO) Error.CodeError
+	GetJoinCodeUsage(ctx context.Context, dto entities.GetJoinCodeUsageDTO) (*entities.JoinCodeUsage, Error.CodeError)
+	RedeemJoinCode(ctx context.Context, dto entities.RedeemJoinCodeDTO) Error.CodeError
+	ReleaseJoinCode(ctx context.Context, dto entities.ReleaseJoinCodeDTO) Error.CodeError
+	PurgeCompanyJoinCodes(ctx context.Context, dto entities.PurgeCompanyJoinCodesDTO) Error.CodeError
 }
 
+// redeemJoinCodeScript Атомарно засчитывает использование кода с учётом ограничения.
+// Возвращает 0 - кода нет, -1 - лимит исчерпан, 1 - использование засчитано
+var redeemJoinCodeScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+local maxUses = tonumber(redis.call('HGET', KEYS[2], 'max_uses') or '0')
+local uses = tonumber(redis.call('HGET', KEYS[2], 'uses') or '0')
+if maxUses > 0 and uses >= maxUses then
+	return -1
+end
+redis.call('HINCRBY', KEYS[2], 'uses', 1)
+return 1
+`)
+
 type companyRepository struct {
 	redis  *redis.Client
 	prefix string
@@ -34,6 +54,8 @@ func (r *companyRepository) CreateCompanyJoinCode(ctx context.Context, dto entit
 	pipeline := r.redis.Pipeline()
 
 	pipeline.Set(ctx, r.getCodeKey(dto.Code), dto.CompanyUUID, dto.TTL)
+	pipeline.HSet(ctx, r.getCodeUsageKey(dto.Code), "max_uses", dto.MaxUses, "uses", 0)
+	pipeline.Expire(ctx, r.getCodeUsageKey(dto.Code), dto.TTL)
 	pipeline.SAdd(ctx, r.getCompanyCodesKey(dto.CompanyUUID), dto.Code)
 
 	_, err := pipeline.Exec(ctx)
@@ -125,10 +147,80 @@ func (r *companyRepository) DeleteCompanyJoinCode(ctx context.Context, dto entit
 		return Error.Public(codes.PermissionDenied, "code not belong to company")
 	}
 
-	err = r.redis.Del(ctx, r.getCodeKey(dto.Code)).Err()
+	err = r.redis.Del(ctx, r.getCodeKey(dto.Code), r.getCodeUsageKey(dto.Code)).Err()
+	if err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// GetJoinCodeUsage Возвращает ограничение и число использований кода для вступления
+func (r *companyRepository) GetJoinCodeUsage(ctx context.Context, dto entities.GetJoinCodeUsageDTO) (*entities.JoinCodeUsage, Error.CodeError) {
+	values, err := r.redis.HMGet(ctx, r.getCodeUsageKey(dto.Code), "max_uses", "uses").Result()
+	if err != nil {
+		return nil, Error.Internal(err)
+	}
+
+	// У кодов, созданных до появления ограничений, счётчика нет - такие коды без ограничения
+	usage := &entities.JoinCodeUsage{}
+	if v, ok := values[0].(string); ok {
+		usage.MaxUses, _ = strconv.ParseInt(v, 10, 64)
+	}
+	if v, ok := values[1].(string); ok {
+		usage.Uses, _ = strconv.ParseInt(v, 10, 64)
+	}
+	return usage, Error.CodeError{}
+}
+
+// RedeemJoinCode Засчитывает использование кода для вступления, если лимит ещё не исчерпан
+func (r *companyRepository) RedeemJoinCode(ctx context.Context, dto entities.RedeemJoinCodeDTO) Error.CodeError {
+	res, err := redeemJoinCodeScript.Run(ctx, r.redis, []string{r.getCodeKey(dto.Code), r.getCodeUsageKey(dto.Code)}).Int()
 	if err != nil {
 		return Error.Internal(err)
 	}
+
+	switch res {
+	case 0:
+		return Error.Public(codes.NotFound, "join code not found")
+	case -1:
+		return Error.Public(codes.ResourceExhausted, "join code usage limit reached")
+	}
+	return Error.CodeError{}
+}
+
+// ReleaseJoinCode Возвращает использование кода, если вступление не состоялось после RedeemJoinCode
+func (r *companyRepository) ReleaseJoinCode(ctx context.Context, dto entities.ReleaseJoinCodeDTO) Error.CodeError {
+	exist, err := r.redis.Exists(ctx, r.getCodeUsageKey(dto.Code)).Result()
+	if err != nil {
+		return Error.Internal(err)
+	}
+	if exist == 0 {
+		return Error.CodeError{}
+	}
+
+	if err = r.redis.HIncrBy(ctx, r.getCodeUsageKey(dto.Code), "uses", -1).Err(); err != nil {
+		return Error.Internal(err)
+	}
+	return Error.CodeError{}
+}
+
+// PurgeCompanyJoinCodes Удаляет все коды для вступления в компанию вместе со счётчиками использований
+func (r *companyRepository) PurgeCompanyJoinCodes(ctx context.Context, dto entities.PurgeCompanyJoinCodesDTO) Error.CodeError {
+	companyCodes, err := r.redis.SMembers(ctx, r.getCompanyCodesKey(dto.CompanyUUID)).Result()
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	keys := make([]string, 0, len(companyCodes)*2+1)
+	for _, code := range companyCodes {
+		keys = append(keys, r.getCodeKey(code), r.getCodeUsageKey(code))
+	}
+	// Множество кодов удаляется последним вместе с кодами - после сбоя повторный вызов найдёт оставшиеся коды
+	keys = append(keys, r.getCompanyCodesKey(dto.CompanyUUID))
+
+	if err = r.redis.Del(ctx, keys...).Err(); err != nil {
+		return Error.Internal(err)
+	}
 	return Error.CodeError{}
 }
 
@@ -137,6 +229,11 @@ func (r *companyRepository) getCompanyCodesKey(companyUUID string) string {
 	return fmt.Sprintf("%s:company:%s:codes", r.prefix, companyUUID)
 }
 
+// getCodeUsageKey Возвращает ключ счётчика использований кода для вступления в компанию
+func (r *companyRepository) getCodeUsageKey(code string) string {
+	return fmt.Sprintf("%s:code:%s:usage", r.prefix, code)
+}
+
 // getCodeKey Возвращает ключ для получения информации о коде для вступления в компанию
 func (r *companyRepository) getCodeKey(code string) string {
 	return fmt.Sprintf("%s:code:%s", r.prefix, code)
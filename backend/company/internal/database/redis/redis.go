@@ -16,6 +16,10 @@ func (r *CacheRepository) Ping(ctx context.Context) error {
 	return r.rdb.Ping(ctx).Err()
 }
 
+func (r *CacheRepository) Close() error {
+	return r.rdb.Close()
+}
+
 func NewCacheInstance(connectOptions *redis.Options, prefix string) *CacheRepository {
 	rdb := sharedRedis.Connect(connectOptions)
 
@@ -0,0 +1,61 @@
+package entities
+
+// Административные действия, попадающие в журнал аудита компании
+const (
+	AuditEmployeeRoleChanged    = "employee_role_changed"
+	AuditEmployeeRemoved        = "employee_removed"
+	AuditDepartmentCreated      = "department_created"
+	AuditDepartmentDeleted      = "department_deleted"
+	AuditCompanyTitleChanged    = "company_title_changed"
+	AuditDepartmentTitleChanged = "department_title_changed"
+	AuditJoinCodeCreated        = "join_code_created"
+)
+
+// Объекты административных действий
+const (
+	AuditTargetEmployee   = "employee"
+	AuditTargetDepartment = "department"
+	AuditTargetCompany    = "company"
+	AuditTargetJoinCode   = "join_code"
+)
+
+// AuditEntry Запись журнала аудита компании
+type AuditEntry struct {
+	UUID        string `db:"uuid"`
+	CompanyUUID string `db:"company_uuid"`
+	ActorUUID   string `db:"actor_uuid"`
+	Action      string `db:"action"`
+	TargetType  string `db:"target_type"`
+	TargetID    string `db:"target_id"`
+	BeforeValue string `db:"before_value"`
+	AfterValue  string `db:"after_value"`
+	OperationID string `db:"operation_id"`
+	CreatedAt   string `db:"created_at"`
+}
+
+// CreateAuditEntryDTO Запись журнала аудита. Передаётся в DTO действия и добавляется репозиторием в той же
+// транзакции; BeforeValue репозиторий заполняет там же, прочитав прежнее значение под блокировкой строки
+type CreateAuditEntryDTO struct {
+	UUID        string
+	CompanyUUID string
+	ActorUUID   string
+	Action      string
+	TargetType  string
+	TargetID    string
+	BeforeValue string
+	AfterValue  string
+	OperationID string
+}
+
+// GetCompanyAuditLogDTO Фильтры журнала аудита. Пустые значения не фильтруют,
+// CreatedTo не включительно, AfterUUID - последняя запись предыдущей страницы
+type GetCompanyAuditLogDTO struct {
+	CompanyUUID string
+	ActorUUID   string
+	Action      string
+	CreatedFrom string
+	CreatedTo   string
+	Offset      int64
+	Count       int64
+	AfterUUID   string
+}
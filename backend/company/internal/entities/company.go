@@ -1,11 +1,13 @@
 package entities
 
 type Company struct {
-	CompanyUUID string `db:"uuid"`
-	Title       string `db:"title"`
-	Status      string `db:"status"`
-	CreatedAt   string `db:"created_at"`
-	CreatedBy   string `db:"created_by"`
+	CompanyUUID          string `db:"uuid"`
+	Title                string `db:"title"`
+	Status               string `db:"status"`
+	JoinApprovalRequired bool   `db:"join_approval_required"`
+	OwnerUUID            string `db:"owner_uuid"`
+	CreatedAt            string `db:"created_at"`
+	CreatedBy            string `db:"created_by"`
 }
 
 type CreateCompany struct {
@@ -25,8 +27,9 @@ type GetCompanyDTO struct {
 }
 
 type GetCompaniesDTO struct {
-	Offset int64
-	Count  int64
+	Offset    int64
+	Count     int64
+	AfterUUID string // keyset-пагинация: uuid последней компании предыдущей страницы
 }
 
 type GetUserCompaniesDTO struct {
@@ -36,6 +39,7 @@ type GetUserCompaniesDTO struct {
 type UpdateCompanyTitleDTO struct {
 	CompanyUUID string
 	Title       string
+	Audit       *CreateAuditEntryDTO // nil - без записи в журнал аудита
 }
 
 type UpdateCompanyStatusDTO struct {
@@ -43,6 +47,7 @@ type UpdateCompanyStatusDTO struct {
 	Status      string
 }
 
-type DeleteCompanyDTO struct {
-	CompanyUUID string
+type UpdateCompanyJoinSettingsDTO struct {
+	CompanyUUID          string
+	JoinApprovalRequired bool
 }
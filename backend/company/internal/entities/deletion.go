@@ -0,0 +1,68 @@
+package entities
+
+import "time"
+
+// Шаги удаления компании. Шаги выполняются по порядку, каждый можно безопасно повторить
+const (
+	DeletionStepSoftDeleted           = "soft_deleted"
+	DeletionStepArchivingApplications = "archiving_applications"
+	DeletionStepPurgingJoinCodes      = "purging_join_codes"
+	DeletionStepDeletingCompany       = "deleting_company"
+	DeletionStepCompleted             = "completed"
+)
+
+// CompanyDeletion Ход удаления компании
+type CompanyDeletion struct {
+	CompanyUUID          string `db:"company_uuid"`
+	RequestedBy          string `db:"requested_by"`
+	Step                 string `db:"step"`
+	PurgeAfter           string `db:"purge_after"`
+	ArchiveKey           string `db:"archive_key"`
+	ArchivedApplications int64  `db:"archived_applications"`
+	Attempts             int32  `db:"attempts"`
+	LastError            string `db:"last_error"`
+	CreatedAt            string `db:"created_at"`
+	CompletedAt          string `db:"completed_at"`
+}
+
+type ScheduleCompanyDeletionDTO struct {
+	CompanyUUID string
+	RequestedBy string
+	PurgeAfter  time.Time
+	Event       *MembershipEvent // nil - без события изменения состава
+}
+
+type GetCompanyDeletionDTO struct {
+	CompanyUUID string
+}
+
+type RestoreCompanyDTO struct {
+	CompanyUUID string
+}
+
+type GetDueCompanyDeletionsDTO struct {
+	Count int
+}
+
+// AdvanceCompanyDeletionDTO Переход удаления со шага From на шаг To. Архив записывается, если задан ArchiveKey
+type AdvanceCompanyDeletionDTO struct {
+	CompanyUUID          string
+	From                 string
+	To                   string
+	ArchiveKey           string
+	ArchivedApplications int64
+}
+
+type FailCompanyDeletionStepDTO struct {
+	CompanyUUID   string
+	Error         string
+	NextAttemptAt time.Time
+}
+
+type CompleteCompanyDeletionDTO struct {
+	CompanyUUID string
+}
+
+type PurgeCompanyJoinCodesDTO struct {
+	CompanyUUID string
+}
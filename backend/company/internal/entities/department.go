@@ -9,21 +9,24 @@ type Department struct {
 }
 
 type CreateDepartment struct {
-	UUID        string `db:"uuid"`
-	CompanyUUID string `db:"company_uuid"`
-	Title       string `db:"title"`
-	CreatedBy   string `db:"created_by"`
+	UUID        string               `db:"uuid"`
+	CompanyUUID string               `db:"company_uuid"`
+	Title       string               `db:"title"`
+	CreatedBy   string               `db:"created_by"`
+	Audit       *CreateAuditEntryDTO // nil - без записи в журнал аудита
 }
 
 type UpdateDepartment struct {
-	UUID  string `db:"uuid"`
-	Title string `db:"title"`
+	UUID  string               `db:"uuid"`
+	Title string               `db:"title"`
+	Audit *CreateAuditEntryDTO // nil - без записи в журнал аудита
 }
 
 type AddEmployeeToDepartmentDTO struct {
 	DepartmentUUID string
 	CompanyUUID    string
 	TargetUUID     string
+	Event          *MembershipEvent // nil - без события изменения состава
 }
 
 type GetDepartmentDTO struct {
@@ -38,9 +41,12 @@ type GetCompanyDepartmentsDTO struct {
 
 type DeleteDepartmentDTO struct {
 	DepartmentUUID string
+	Audit          *CreateAuditEntryDTO // nil - без записи в журнал аудита
+	Event          *MembershipEvent     // nil - без события изменения состава
 }
 
 type RemoveEmployeeFromDepartmentDTO struct {
 	CompanyUUID string
 	TargetUUID  string
+	Event       *MembershipEvent // nil - без события изменения состава
 }
@@ -21,6 +21,7 @@ type EmployeesSummary struct {
 type JoinCompanyDTO struct {
 	CompanyUUID string
 	UserUUID    string
+	JoinCode    string
 }
 
 type GetCompanyEmployeeDTO struct {
@@ -34,6 +35,8 @@ type GetCompanyEmployeesDTO struct {
 	Role           string
 	Offset         int64
 	Count          int64
+	AfterJoinedAt  string // keyset-пагинация: позиция последнего сотрудника предыдущей страницы
+	AfterUserUUID  string
 }
 
 type GetCompanyEmployeesSummaryDTO struct {
@@ -45,11 +48,15 @@ type SetCompanyEmployeeRoleDTO struct {
 	CompanyUUID string
 	UserUUID    string
 	Role        string
+	Audit       *CreateAuditEntryDTO // nil - без записи в журнал аудита
+	Event       *MembershipEvent     // nil - без события изменения состава
 }
 
 type RemoveCompanyEmployeeDTO struct {
 	CompanyUUID string
 	UserUUID    string
+	Audit       *CreateAuditEntryDTO // nil - без записи в журнал аудита
+	Event       *MembershipEvent     // nil - без события изменения состава
 }
 
 type CheckColleaguesDTO struct {
@@ -0,0 +1,55 @@
+package entities
+
+import "time"
+
+// Invitation Приглашение в компанию на конкретный email с заранее заданной ролью и департаментом
+type Invitation struct {
+	UUID           string `db:"uuid"`
+	CompanyUUID    string `db:"company_uuid"`
+	CompanyTitle   string `db:"company_title"`
+	Email          string `db:"email"`
+	Role           string `db:"role"`
+	DepartmentUUID string `db:"department_uuid"`
+	Status         string `db:"status"` // просроченное ожидающее приглашение возвращается со статусом expired
+	ExpiresAt      string `db:"expires_at"`
+	CreatedAt      string `db:"created_at"`
+	CreatedBy      string `db:"created_by"`
+	DecidedAt      string `db:"decided_at"`
+	AcceptedBy     string `db:"accepted_by"`
+}
+
+type CreateInvitation struct {
+	UUID           string
+	CompanyUUID    string
+	Email          string
+	Role           string
+	DepartmentUUID string
+	ExpiresAt      time.Time
+	CreatedBy      string
+}
+
+type GetInvitationDTO struct {
+	InvitationUUID string
+}
+
+type GetCompanyInvitationsDTO struct {
+	CompanyUUID string
+	Status      string
+}
+
+type GetEmailInvitationsDTO struct {
+	Email string
+}
+
+type RevokeInvitationDTO struct {
+	InvitationUUID string
+}
+
+type AcceptInvitationDTO struct {
+	InvitationUUID string
+	UserUUID       string
+}
+
+type DeclineInvitationDTO struct {
+	InvitationUUID string
+}
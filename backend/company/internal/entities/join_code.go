@@ -2,10 +2,24 @@ package entities
 
 import "time"
 
+// JoinCodeUsage Ограничение и число использований кода для вступления
+type JoinCodeUsage struct {
+	MaxUses int64 // 0 - без ограничения
+	Uses    int64
+}
+
+// JoinCodeRedemption Запись об использовании кода для вступления
+type JoinCodeRedemption struct {
+	UserUUID        string `db:"user_uuid"`
+	RedeemedAt      string `db:"redeemed_at"`
+	JoinRequestUUID string `db:"join_request_uuid"`
+}
+
 type CreateCompanyJoinCodeDTO struct {
 	CompanyUUID string
 	Code        string
 	TTL         time.Duration
+	MaxUses     int64
 }
 
 type CheckJoinCodeExistsDTO struct {
@@ -29,3 +43,20 @@ type DeleteCompanyJoinCodeDTO struct {
 	CompanyUUID string
 	Code        string
 }
+
+type GetJoinCodeUsageDTO struct {
+	Code string
+}
+
+type RedeemJoinCodeDTO struct {
+	Code string
+}
+
+type ReleaseJoinCodeDTO struct {
+	Code string
+}
+
+type GetJoinCodeRedemptionsDTO struct {
+	CompanyUUID string
+	Code        string
+}
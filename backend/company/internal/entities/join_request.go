@@ -0,0 +1,34 @@
+package entities
+
+// JoinRequest Заявка на вступление по коду в компанию с одобрением
+type JoinRequest struct {
+	UUID        string `db:"uuid"`
+	CompanyUUID string `db:"company_uuid"`
+	UserUUID    string `db:"user_uuid"`
+	JoinCode    string `db:"join_code"`
+	Status      string `db:"status"`
+	CreatedAt   string `db:"created_at"`
+	DecidedAt   string `db:"decided_at"`
+	DecidedBy   string `db:"decided_by"`
+}
+
+type CreateJoinRequestDTO struct {
+	UUID        string
+	CompanyUUID string
+	UserUUID    string
+	JoinCode    string
+}
+
+type GetJoinRequestDTO struct {
+	JoinRequestUUID string
+}
+
+type GetCompanyJoinRequestsDTO struct {
+	CompanyUUID string
+	Status      string
+}
+
+type DecideJoinRequestDTO struct {
+	JoinRequestUUID string
+	DecidedBy       string
+}
@@ -0,0 +1,51 @@
+package entities
+
+type Location struct {
+	UUID        string   `db:"uuid"`
+	CompanyUUID string   `db:"company_uuid"`
+	ParentUUID  string   `db:"parent_uuid"`
+	Kind        string   `db:"kind"`
+	Title       string   `db:"title"`
+	Latitude    *float64 `db:"latitude"`
+	Longitude   *float64 `db:"longitude"`
+	CreatedAt   string   `db:"created_at"`
+	CreatedBy   string   `db:"created_by"`
+}
+
+type CreateLocation struct {
+	UUID        string   `db:"uuid"`
+	CompanyUUID string   `db:"company_uuid"`
+	ParentUUID  string   `db:"parent_uuid"`
+	Kind        string   `db:"kind"`
+	Title       string   `db:"title"`
+	Latitude    *float64 `db:"latitude"`
+	Longitude   *float64 `db:"longitude"`
+	CreatedBy   string   `db:"created_by"`
+}
+
+type UpdateLocation struct {
+	UUID      string   `db:"uuid"`
+	Title     string   `db:"title"`
+	Latitude  *float64 `db:"latitude"`
+	Longitude *float64 `db:"longitude"`
+}
+
+type GetLocationDTO struct {
+	LocationUUID string
+}
+
+type GetLocationPathDTO struct {
+	LocationUUID string
+}
+
+type GetCompanyLocationsDTO struct {
+	CompanyUUID string
+	ParentUUID  string // Если указано - только вложенные места
+	Kind        string // Если указано - только места этого уровня
+	Offset      int64
+	Count       int64
+}
+
+type DeleteLocationDTO struct {
+	LocationUUID string
+}
@@ -0,0 +1,57 @@
+package entities
+
+import "time"
+
+// Типы событий изменения состава компании (используются как routing key в exchange company.membership).
+// По ним другие сервисы сбрасывают закешированные роли и отделы сотрудников.
+const (
+	EventEmployeeRoleChanged       = "employee.role_changed"
+	EventEmployeeRemoved           = "employee.removed"
+	EventEmployeeLeft              = "employee.left" // сотрудник сам покинул компанию
+	EventEmployeeDepartmentChanged = "employee.department_changed"
+	EventDepartmentDeleted         = "department.deleted"
+	EventCompanyDeleted            = "company.deleted"
+)
+
+// Политики обработки открытых заявок сотрудника, теряющего связь с ними при смене роли,
+// удалении из компании или из отдела. Заявки обрабатывает application сервис по событию изменения
+const (
+	OffboardingBlock    = "block"    // изменение запрещено, пока у сотрудника есть открытые заявки
+	OffboardingPool     = "pool"     // заявки возвращаются в пул отдела
+	OffboardingReassign = "reassign" // заявки передаются указанному коллеге
+)
+
+// MembershipEvent Событие изменения роли или отдела сотрудника, удаления отдела или компании
+type MembershipEvent struct {
+	EventType      string `json:"event_type"`
+	CompanyUUID    string `json:"company_uuid"`
+	UserUUID       string `json:"user_uuid,omitempty"`
+	DepartmentUUID string `json:"department_uuid,omitempty"` // новый отдел сотрудника или отдел, из которого он удалён
+	Role           string `json:"role,omitempty"`            // новая роль (employee.role_changed)
+
+	// Обработка открытых заявок сотрудника, выбранная chief при изменении
+	InitiatorUUID    string `json:"initiator_uuid,omitempty"`
+	OpenApplications string `json:"open_applications,omitempty"`
+	ReassignTo       string `json:"reassign_to,omitempty"`
+}
+
+// OutboxEvent Запись outbox-а событий изменения состава компании, ожидающая публикации в брокер
+type OutboxEvent struct {
+	UUID        string
+	EventType   string
+	CompanyUUID string
+	Payload     []byte
+	CreatedAt   string
+}
+
+type GetPendingOutboxEventsDTO struct {
+	Count int64
+}
+
+type MarkOutboxEventsPublishedDTO struct {
+	EventUUIDs []string
+}
+
+type DeletePublishedOutboxEventsDTO struct {
+	PublishedBefore time.Time
+}
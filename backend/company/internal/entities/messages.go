@@ -0,0 +1,11 @@
+package entities
+
+// InvitationEmailMsg Письмо с приглашением в компанию (очередь company-invitation.email).
+// Адресат может ещё не иметь аккаунта, поэтому имени в письме нет.
+type InvitationEmailMsg struct {
+	InvitationUUID string `json:"invitation_uuid"`
+	Email          string `json:"email"`
+	CompanyTitle   string `json:"company_title"`
+	Role           string `json:"role"`
+	ExpiresAt      int64  `json:"expires_at"`
+}
@@ -0,0 +1,34 @@
+package entities
+
+import "time"
+
+// OwnershipTransfer Передача владения компанией, ожидающая подтверждения будущим владельцем
+type OwnershipTransfer struct {
+	UUID        string `db:"uuid"`
+	CompanyUUID string `db:"company_uuid"`
+	FromUUID    string `db:"from_uuid"`
+	ToUUID      string `db:"to_uuid"`
+	Status      string `db:"status"`
+	ExpiresAt   string `db:"expires_at"`
+	CreatedAt   string `db:"created_at"`
+	DecidedAt   string `db:"decided_at"`
+}
+
+type CreateOwnershipTransferDTO struct {
+	UUID        string
+	CompanyUUID string
+	FromUUID    string
+	ToUUID      string
+	ExpiresAt   time.Time
+}
+
+type GetOwnershipTransferDTO struct {
+	CompanyUUID string
+}
+
+// DecideOwnershipTransferDTO Решение по ожидающей передаче: UserUUID - получатель (accept, decline) или владелец (cancel)
+type DecideOwnershipTransferDTO struct {
+	CompanyUUID string
+	UserUUID    string
+	Event       *MembershipEvent // событие смены роли нового владельца (только при подтверждении)
+}
@@ -0,0 +1,203 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/rs/zerolog/log"
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/rabbitMQ"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/tracing"
+)
+
+// MembershipExchange Topic exchange событий изменения состава компаний.
+// Routing key совпадает с типом события (employee.role_changed, department.deleted, ...)
+const MembershipExchange = "company.membership"
+
+// InvitationEmailQueue Очередь писем с приглашениями в компанию, читается сервисом notification
+const InvitationEmailQueue = "company-invitation.email"
+
+type Publisher interface {
+	PublishMembershipEvent(ctx context.Context, event *entities.OutboxEvent) Error.CodeError
+	SendInvitationEmail(ctx context.Context, dto entities.InvitationEmailMsg) Error.CodeError
+	Close() error
+}
+
+type publisher struct {
+	mu            sync.Mutex
+	connectString string
+	conn          *amqp.Connection
+	ch            *amqp.Channel
+}
+
+func NewPublisher(connectString string) Publisher {
+	// Подключение к rabbitMQ
+	conn, ch := rabbitMQ.Connect(connectString)
+
+	if err := setupChannel(ch); err != nil {
+		log.Fatal().Err(err).Msg("failed to setup rabbitMQ channel")
+	}
+
+	return &publisher{
+		connectString: connectString,
+		conn:          conn,
+		ch:            ch,
+	}
+}
+
+// setupChannel Создание exchange для событий и очереди писем (идемпотентно) и включение publisher confirms
+func setupChannel(ch *amqp.Channel) error {
+	err := ch.ExchangeDeclare(
+		MembershipExchange, // name
+		amqp.ExchangeTopic, // type
+		true,               // durable
+		false,              // auto-deleted
+		false,              // internal
+		false,              // no-wait
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ch.QueueDeclare(
+		InvitationEmailQueue, // name
+		true,                 // durable
+		false,                // delete when unused
+		false,                // exclusive
+		false,                // no-wait
+		amqp.Table{
+			amqp.QueueTypeArg: amqp.QueueTypeQuorum,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return ch.Confirm(false)
+}
+
+// PublishMembershipEvent Публикует событие из outbox-а в exchange company.membership
+// и дожидается подтверждения брокера. При потере канала переподключается.
+func (p *publisher) PublishMembershipEvent(ctx context.Context, event *entities.OutboxEvent) Error.CodeError {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.reconnect(); err != nil {
+		return Error.Internal(err)
+	}
+
+	msg := amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		MessageId:    event.UUID,
+		Type:         event.EventType,
+		Timestamp:    time.Now(),
+		Body:         event.Payload,
+	}
+
+	// Событие публикуется воркером outbox-а - спан публикации начинает собственную трассу,
+	// подписчики продолжают её по заголовкам сообщения
+	ctx, span := tracing.StartPublish(ctx, MembershipExchange, event.EventType, &msg)
+	err := p.publishConfirmed(ctx, MembershipExchange, event.EventType, msg)
+	tracing.EndSpan(span, err)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	return Error.CodeError{}
+}
+
+// SendInvitationEmail Отправляет в очередь company-invitation.email письмо с приглашением в компанию
+func (p *publisher) SendInvitationEmail(ctx context.Context, dto entities.InvitationEmailMsg) Error.CodeError {
+	body, err := json.Marshal(dto)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err = p.reconnect(); err != nil {
+		return Error.Internal(err)
+	}
+
+	msg := amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		Timestamp:    time.Now(),
+		Body:         body,
+	}
+
+	// Письма публикуются через default exchange, routing key - имя очереди
+	ctx, span := tracing.StartPublish(ctx, "", InvitationEmailQueue, &msg)
+	err = p.publishConfirmed(ctx, "", InvitationEmailQueue, msg)
+	tracing.EndSpan(span, err)
+	if err != nil {
+		return Error.Internal(err)
+	}
+
+	return Error.CodeError{}
+}
+
+// Close Закрывает канал и соединение с rabbitMQ
+func (p *publisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.conn.IsClosed() {
+		return nil
+	}
+
+	chErr := p.ch.Close()
+	if err := p.conn.Close(); err != nil {
+		return err
+	}
+	return chErr
+}
+
+// reconnect Переподключается к rabbitMQ, если канал закрыт. Вызывается под p.mu
+func (p *publisher) reconnect() error {
+	if !p.ch.IsClosed() {
+		return nil
+	}
+
+	conn, ch, err := rabbitMQ.Dial(p.connectString)
+	if err != nil {
+		return err
+	}
+	if err = setupChannel(ch); err != nil {
+		_ = conn.Close()
+		return err
+	}
+	_ = p.conn.Close()
+	p.conn, p.ch = conn, ch
+	return nil
+}
+
+// publishConfirmed Публикует сообщение и дожидается подтверждения брокера
+func (p *publisher) publishConfirmed(ctx context.Context, exchange, routingKey string, msg amqp.Publishing) error {
+	confirmation, err := p.ch.PublishWithDeferredConfirmWithContext(ctx,
+		exchange,   // exchange
+		routingKey, // routing key
+		false,      // mandatory
+		false,      // immediate
+		msg)
+	if err != nil {
+		return err
+	}
+
+	acked, err := confirmation.WaitContext(ctx)
+	if err != nil {
+		return err
+	}
+	if !acked {
+		return fmt.Errorf("%s message was not confirmed by broker", routingKey)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/company/generated"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/cursor"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/helpers"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/interceptors"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var AuditActions = []string{
+	entities.AuditEmployeeRoleChanged,
+	entities.AuditEmployeeRemoved,
+	entities.AuditDepartmentCreated,
+	entities.AuditDepartmentDeleted,
+	entities.AuditCompanyTitleChanged,
+	entities.AuditDepartmentTitleChanged,
+	entities.AuditJoinCodeCreated,
+}
+
+// GetCompanyAuditLog Возвращает журнал административных действий в компании (count, offset или cursor).
+// Доступен только chief, фильтруется по автору, типу действия и периоду
+func (s *CompanyService) GetCompanyAuditLog(ctx context.Context, req *pb.GetCompanyAuditLogRequest) (*pb.GetCompanyAuditLogResponse, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+	if err := validate.UUID(req.GetActorUuid()); err != nil && req.GetActorUuid() != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid actor uuid")
+	}
+	if req.GetAction() != "" && !helpers.Contains(AuditActions, req.GetAction()) {
+		return nil, status.Error(codes.InvalidArgument, "incorrect action")
+	}
+	if err := validate.DateFilter(req.GetCreatedFrom(), "created_from"); err != nil && req.GetCreatedFrom() != "" {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validate.DateFilter(req.GetCreatedTo(), "created_to"); err != nil && req.GetCreatedTo() != "" {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.GetCount() <= 0 || req.GetCount() > 100 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid count (1..100)")
+	}
+	if req.GetOffset() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid offset")
+	}
+	position, err := cursor.Parse(req.GetCursor(), req.GetOffset())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.GetCursor() != "" && validate.UUID(position.ID) != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cursor")
+	}
+
+	if err := s.checkEmployeeRole(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), []string{"chief"}); err != nil {
+		return nil, err
+	}
+
+	filter := entities.GetCompanyAuditLogDTO{
+		CompanyUUID: req.GetCompanyUuid(),
+		ActorUUID:   req.GetActorUuid(),
+		Action:      req.GetAction(),
+		CreatedFrom: req.GetCreatedFrom(),
+		CreatedTo:   req.GetCreatedTo(),
+		Offset:      req.GetOffset(),
+		Count:       req.GetCount() + 1, // лишняя запись показывает, есть ли следующая страница
+		AfterUUID:   position.ID,
+	}
+	entries, getErr := s.db.Audit.GetCompanyAuditLog(ctx, filter)
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+	total, getErr := s.db.Audit.CountCompanyAuditLog(ctx, filter)
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	nextCursor := ""
+	if int64(len(entries)) > req.GetCount() {
+		entries = entries[:req.GetCount()]
+		nextCursor = cursor.Encode(cursor.Cursor{ID: entries[len(entries)-1].UUID})
+	}
+
+	resEntries := make([]*pb.AuditEntry, 0, len(entries))
+	for _, entry := range entries {
+		resEntries = append(resEntries, &pb.AuditEntry{
+			EntryUuid:   entry.UUID,
+			ActorUuid:   entry.ActorUUID,
+			Action:      entry.Action,
+			TargetType:  entry.TargetType,
+			TargetId:    entry.TargetID,
+			BeforeValue: entry.BeforeValue,
+			AfterValue:  entry.AfterValue,
+			OperationId: entry.OperationID,
+			CreatedAt:   entry.CreatedAt,
+		})
+	}
+
+	return &pb.GetCompanyAuditLogResponse{
+		Entries:    resEntries,
+		NextCursor: nextCursor,
+		TotalCount: total,
+	}, nil
+}
+
+// newAuditEntry Подготавливает запись журнала аудита для действия. Репозиторий добавляет её в транзакции
+// действия, поэтому запись не теряется: без неё действие откатывается
+func newAuditEntry(ctx context.Context, entry entities.CreateAuditEntryDTO) *entities.CreateAuditEntryDTO {
+	entry.UUID = uuid.Must(uuid.NewV7()).String()
+	entry.OperationID = interceptors.OperationIDFromContext(ctx)
+	return &entry
+}
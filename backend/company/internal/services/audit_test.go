@@ -0,0 +1,312 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/company/generated"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/cursor"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/interceptors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	testOperationID = "op-1"
+	entryID         = "eeeeeeee-eeee-eeee-eeee-eeeeeeeeeeee"
+)
+
+// pgRepoWithChiefAndTarget — initiator всегда chief, остальные сотрудники - engineer
+func pgRepoWithChiefAndTarget() *mockPGCompanyRepo {
+	pg := pgRepoWithChiefAndDept()
+	pg.getCompanyEmployee = func(_ context.Context, dto entities.GetCompanyEmployeeDTO) (*entities.Employee, Error.CodeError) {
+		if dto.UserUUID == initiatorID {
+			return chiefEmployee(), ok()
+		}
+		return &entities.Employee{Role: "engineer"}, ok()
+	}
+	return pg
+}
+
+// ─── Запись в журнал аудита ───────────────────────────────────────────────────
+
+// assertAuditEntry Проверка записи журнала, переданной репозиторию вместе с действием
+func assertAuditEntry(t *testing.T, entry *entities.CreateAuditEntryDTO, action, targetID, afterValue string) {
+	t.Helper()
+	if entry == nil {
+		t.Fatal("expected audit entry to be passed with the change")
+	}
+	if entry.Action != action || entry.ActorUUID != initiatorID || entry.TargetID != targetID || entry.AfterValue != afterValue {
+		t.Errorf("unexpected audit entry: %+v", entry)
+	}
+	if entry.OperationID != testOperationID {
+		t.Errorf("expected operation id %q, got %q", testOperationID, entry.OperationID)
+	}
+	if entry.UUID == "" {
+		t.Error("expected audit entry uuid")
+	}
+}
+
+func TestRecordAudit(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(interceptors.OperationIDMetaKey, testOperationID))
+
+	t.Run("role change passes entry to repository", func(t *testing.T) {
+		var entry *entities.CreateAuditEntryDTO
+		pg := pgRepoWithChiefAndTarget()
+		pg.setCompanyEmployeeRole = func(_ context.Context, dto entities.SetCompanyEmployeeRoleDTO) Error.CodeError {
+			entry = dto.Audit
+			return ok()
+		}
+
+		var entries []entities.CreateAuditEntryDTO
+		svc := newAuditTestService(pg, emptyRedisRepo(), recordingAuditRepo(&entries))
+		_, err := svc.UpdateEmployeeRole(ctx, &pb.UpdateEmployeeRoleRequest{
+			CompanyUuid: companyID, InitiatorUuid: initiatorID, TargetUuid: targetID, Role: "manager",
+		})
+		assertNoError(t, err)
+
+		assertAuditEntry(t, entry, entities.AuditEmployeeRoleChanged, targetID, "manager")
+		if entry.CompanyUUID != companyID || entry.TargetType != entities.AuditTargetEmployee {
+			t.Errorf("unexpected audit entry: %+v", entry)
+		}
+		// Запись добавляется в транзакции изменения роли, отдельной записи быть не должно
+		if len(entries) != 0 {
+			t.Errorf("expected no separate audit entries, got %+v", entries)
+		}
+	})
+
+	t.Run("employee removal passes entry to repository", func(t *testing.T) {
+		var entry *entities.CreateAuditEntryDTO
+		pg := pgRepoWithChiefAndTarget()
+		pg.removeCompanyEmployee = func(_ context.Context, dto entities.RemoveCompanyEmployeeDTO) Error.CodeError {
+			entry = dto.Audit
+			return ok()
+		}
+
+		svc := newAuditTestService(pg, emptyRedisRepo(), emptyAuditRepo())
+		_, err := svc.RemoveCompanyEmployee(ctx, &pb.RemoveCompanyEmployeeRequest{
+			CompanyUuid: companyID, InitiatorUuid: initiatorID, TargetUuid: targetID,
+		})
+		assertNoError(t, err)
+
+		assertAuditEntry(t, entry, entities.AuditEmployeeRemoved, targetID, "")
+	})
+
+	t.Run("leaving company passes entry to repository", func(t *testing.T) {
+		var entry *entities.CreateAuditEntryDTO
+		pg := pgRepoWithOwner(targetID)
+		pg.removeCompanyEmployee = func(_ context.Context, dto entities.RemoveCompanyEmployeeDTO) Error.CodeError {
+			entry = dto.Audit
+			return ok()
+		}
+
+		svc := newAuditTestService(pg, emptyRedisRepo(), emptyAuditRepo())
+		_, err := svc.LeaveCompany(ctx, &pb.LeaveCompanyRequest{CompanyUuid: companyID, InitiatorUuid: initiatorID})
+		assertNoError(t, err)
+
+		// Ушедший сотрудник - и автор, и объект записи
+		assertAuditEntry(t, entry, entities.AuditEmployeeRemoved, initiatorID, "")
+		if entry.ActorUUID != entry.TargetID || entry.TargetType != entities.AuditTargetEmployee {
+			t.Errorf("expected employee to be both actor and target, got %+v", entry)
+		}
+	})
+
+	t.Run("department title change passes entry to repository", func(t *testing.T) {
+		var entry *entities.CreateAuditEntryDTO
+		pg := pgRepoWithChiefAndDept()
+		pg.updateDepartmentTitle = func(_ context.Context, dto *entities.UpdateDepartment) Error.CodeError {
+			entry = dto.Audit
+			return ok()
+		}
+
+		svc := newAuditTestService(pg, emptyRedisRepo(), emptyAuditRepo())
+		_, err := svc.UpdateDepartmentTitle(ctx, &pb.UpdateDepartmentTitleRequest{
+			InitiatorUuid: initiatorID, DepartmentUuid: deptID, Title: "New Dept",
+		})
+		assertNoError(t, err)
+
+		assertAuditEntry(t, entry, entities.AuditDepartmentTitleChanged, deptID, "New Dept")
+		if entry.CompanyUUID != companyID || entry.TargetType != entities.AuditTargetDepartment {
+			t.Errorf("unexpected audit entry: %+v", entry)
+		}
+	})
+
+	t.Run("company title change passes entry to repository", func(t *testing.T) {
+		var entry *entities.CreateAuditEntryDTO
+		pg := pgRepoWithChief()
+		pg.updateCompanyTitle = func(_ context.Context, dto entities.UpdateCompanyTitleDTO) Error.CodeError {
+			entry = dto.Audit
+			return ok()
+		}
+
+		svc := newAuditTestService(pg, emptyRedisRepo(), emptyAuditRepo())
+		_, err := svc.UpdateCompanyTitle(ctx, &pb.UpdateCompanyTitleRequest{
+			CompanyUuid: companyID, InitiatorUuid: initiatorID, Title: "New Title",
+		})
+		assertNoError(t, err)
+
+		assertAuditEntry(t, entry, entities.AuditCompanyTitleChanged, companyID, "New Title")
+	})
+
+	t.Run("join code creation is recorded", func(t *testing.T) {
+		rdb := emptyRedisRepo()
+		rdb.checkJoinCodeExists = func(_ context.Context, _ entities.CheckJoinCodeExistsDTO) Error.CodeError { return notFound() }
+		rdb.createCompanyJoinCode = func(_ context.Context, _ entities.CreateCompanyJoinCodeDTO) Error.CodeError { return ok() }
+
+		var entries []entities.CreateAuditEntryDTO
+		svc := newAuditTestService(pgRepoWithChief(), rdb, recordingAuditRepo(&entries))
+		res, err := svc.CreateCompanyJoinCode(ctx, &pb.CreateCompanyJoinCodeRequest{
+			CompanyUuid: companyID, InitiatorUuid: initiatorID, CodeTtl: 3600, MaxUses: 5,
+		})
+		assertNoError(t, err)
+
+		if len(entries) != 1 {
+			t.Fatalf("expected 1 audit entry, got %d", len(entries))
+		}
+		assertAuditEntry(t, &entries[0], entities.AuditJoinCodeCreated, res.GetJoinCode(), "ttl=3600s max_uses=5")
+	})
+
+	t.Run("join code audit error discards code", func(t *testing.T) {
+		var created, deleted string
+		rdb := emptyRedisRepo()
+		rdb.checkJoinCodeExists = func(_ context.Context, _ entities.CheckJoinCodeExistsDTO) Error.CodeError { return notFound() }
+		rdb.createCompanyJoinCode = func(_ context.Context, dto entities.CreateCompanyJoinCodeDTO) Error.CodeError {
+			created = dto.Code
+			return ok()
+		}
+		rdb.deleteCompanyJoinCode = func(_ context.Context, dto entities.DeleteCompanyJoinCodeDTO) Error.CodeError {
+			deleted = dto.Code
+			return ok()
+		}
+
+		audit := &mockAuditRepo{
+			createAuditEntry: func(_ context.Context, _ entities.CreateAuditEntryDTO) Error.CodeError { return internalErr() },
+		}
+		svc := newAuditTestService(pgRepoWithChief(), rdb, audit)
+		_, err := svc.CreateCompanyJoinCode(ctx, &pb.CreateCompanyJoinCodeRequest{
+			CompanyUuid: companyID, InitiatorUuid: initiatorID, CodeTtl: 3600,
+		})
+		assertGRPCCode(t, err, codes.Internal)
+
+		if created == "" || deleted != created {
+			t.Errorf("expected created code %q to be deleted, got %q", created, deleted)
+		}
+	})
+}
+
+// ─── GetCompanyAuditLog ───────────────────────────────────────────────────────
+
+func TestGetCompanyAuditLog(t *testing.T) {
+	ctx := context.Background()
+
+	makeReq := func() *pb.GetCompanyAuditLogRequest {
+		return &pb.GetCompanyAuditLogRequest{
+			CompanyUuid:   companyID,
+			InitiatorUuid: initiatorID,
+			Count:         1,
+		}
+	}
+
+	auditLog := func(filters *entities.GetCompanyAuditLogDTO) *mockAuditRepo {
+		return &mockAuditRepo{
+			getCompanyAuditLog: func(_ context.Context, dto entities.GetCompanyAuditLogDTO) ([]*entities.AuditEntry, Error.CodeError) {
+				*filters = dto
+				return []*entities.AuditEntry{
+					{UUID: entryID, ActorUUID: initiatorID, Action: entities.AuditEmployeeRoleChanged, BeforeValue: "engineer", AfterValue: "manager"},
+					{UUID: targetID, ActorUUID: initiatorID, Action: entities.AuditDepartmentCreated},
+				}, ok()
+			},
+			countCompanyAuditLog: func(_ context.Context, _ entities.GetCompanyAuditLogDTO) (int64, Error.CodeError) {
+				return 2, ok()
+			},
+		}
+	}
+
+	t.Run("success with next page", func(t *testing.T) {
+		var filters entities.GetCompanyAuditLogDTO
+		svc := newAuditTestService(pgRepoWithChief(), emptyRedisRepo(), auditLog(&filters))
+
+		req := makeReq()
+		req.ActorUuid = initiatorID
+		req.Action = entities.AuditEmployeeRoleChanged
+		req.CreatedFrom = "2026-01-01"
+		res, err := svc.GetCompanyAuditLog(ctx, req)
+		assertNoError(t, err)
+
+		if len(res.GetEntries()) != 1 || res.GetEntries()[0].GetBeforeValue() != "engineer" {
+			t.Fatalf("unexpected entries: %+v", res.GetEntries())
+		}
+		if res.GetTotalCount() != 2 {
+			t.Errorf("expected total 2, got %d", res.GetTotalCount())
+		}
+		if res.GetNextCursor() != cursor.Encode(cursor.Cursor{ID: entryID}) {
+			t.Errorf("expected cursor after %s, got %q", entryID, res.GetNextCursor())
+		}
+		if filters.ActorUUID != initiatorID || filters.Action != entities.AuditEmployeeRoleChanged || filters.CreatedFrom != "2026-01-01" {
+			t.Errorf("filters not passed to repository: %+v", filters)
+		}
+		if filters.Count != 2 {
+			t.Errorf("expected one extra entry requested, got count %d", filters.Count)
+		}
+	})
+
+	t.Run("cursor continues after entry", func(t *testing.T) {
+		var filters entities.GetCompanyAuditLogDTO
+		svc := newAuditTestService(pgRepoWithChief(), emptyRedisRepo(), auditLog(&filters))
+
+		req := makeReq()
+		req.Cursor = cursor.Encode(cursor.Cursor{ID: entryID})
+		_, err := svc.GetCompanyAuditLog(ctx, req)
+		assertNoError(t, err)
+
+		if filters.AfterUUID != entryID {
+			t.Errorf("expected after uuid %s, got %q", entryID, filters.AfterUUID)
+		}
+	})
+
+	t.Run("not chief", func(t *testing.T) {
+		pg := pgRepoWithChief()
+		pg.getCompanyEmployee = func(_ context.Context, _ entities.GetCompanyEmployeeDTO) (*entities.Employee, Error.CodeError) {
+			return &entities.Employee{Role: "manager"}, ok()
+		}
+
+		svc := newAuditTestService(pg, emptyRedisRepo(), emptyAuditRepo())
+		_, err := svc.GetCompanyAuditLog(ctx, makeReq())
+		assertGRPCCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("invalid_arguments", func(t *testing.T) {
+		cases := map[string]func(req *pb.GetCompanyAuditLogRequest){
+			"actor":        func(req *pb.GetCompanyAuditLogRequest) { req.ActorUuid = "not-a-uuid" },
+			"action":       func(req *pb.GetCompanyAuditLogRequest) { req.Action = "company_deleted" },
+			"created_from": func(req *pb.GetCompanyAuditLogRequest) { req.CreatedFrom = "yesterday" },
+			"created_to":   func(req *pb.GetCompanyAuditLogRequest) { req.CreatedTo = "2026-13-01" },
+			"count":        func(req *pb.GetCompanyAuditLogRequest) { req.Count = 101 },
+			"cursor":       func(req *pb.GetCompanyAuditLogRequest) { req.Cursor = "not-a-cursor" },
+		}
+		for name, mutate := range cases {
+			t.Run(name, func(t *testing.T) {
+				req := makeReq()
+				mutate(req)
+
+				svc := newAuditTestService(emptyPGRepo(), emptyRedisRepo(), emptyAuditRepo())
+				_, err := svc.GetCompanyAuditLog(ctx, req)
+				assertGRPCCode(t, err, codes.InvalidArgument)
+			})
+		}
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		audit := &mockAuditRepo{
+			getCompanyAuditLog: func(_ context.Context, _ entities.GetCompanyAuditLogDTO) ([]*entities.AuditEntry, Error.CodeError) {
+				return nil, internalErr()
+			},
+		}
+
+		svc := newAuditTestService(pgRepoWithChief(), emptyRedisRepo(), audit)
+		_, err := svc.GetCompanyAuditLog(ctx, makeReq())
+		assertGRPCCode(t, err, codes.Internal)
+	})
+}
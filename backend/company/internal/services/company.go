@@ -2,15 +2,21 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
 	postgresDB "github.com/unwelcome/FrameWorkTask1/backend/company/internal/database/postgres"
 	redisDB "github.com/unwelcome/FrameWorkTask1/backend/company/internal/database/redis"
 	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/messaging"
 	"github.com/unwelcome/FrameWorkTask1/backend/company/pkg/utils"
+	application_proto "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
 	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/company/generated"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/cursor"
 	"github.com/unwelcome/FrameWorkTask1/backend/shared/helpers"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/interceptors"
 	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -20,19 +26,37 @@ import (
 const JoinCodeLength = 6
 const JoinCodeCreateTries = 10
 
+// JoinCodeMaxUses Верхняя граница ограничения числа использований одного кода
+const JoinCodeMaxUses = 1000
+
+// Результат вступления по коду: сразу в компанию или заявка на одобрение
+const (
+	JoinStatusJoined  = "joined"
+	JoinStatusPending = "pending"
+)
+
+// invitationEmailTimeout Время на отправку письма с приглашением после записи в БД
+const invitationEmailTimeout = 5 * time.Second
+
 var AllStatuses = []string{"open", "close"}
 var AllRoles = []string{"chief", "analytic", "manager", "engineer", "inspector", "unemployed"}
 
 type CompanyService struct {
-	db    *postgresDB.DatabaseRepository
-	cache *redisDB.CacheRepository
+	db                *postgresDB.DatabaseRepository
+	cache             *redisDB.CacheRepository
+	publisher         messaging.Publisher
+	applications      ApplicationOffboarder
+	deletionRetention time.Duration // срок, в течение которого удалённую компанию можно восстановить
 	pb.UnimplementedCompanyServiceServer
 }
 
-func NewCompanyService(db *postgresDB.DatabaseRepository, cache *redisDB.CacheRepository) *CompanyService {
+func NewCompanyService(db *postgresDB.DatabaseRepository, cache *redisDB.CacheRepository, publisher messaging.Publisher, applications ApplicationOffboarder, deletionRetention time.Duration) *CompanyService {
 	return &CompanyService{
-		db:    db,
-		cache: cache,
+		db:                db,
+		cache:             cache,
+		publisher:         publisher,
+		applications:      applications,
+		deletionRetention: deletionRetention,
 	}
 }
 
@@ -84,13 +108,15 @@ func (s *CompanyService) GetCompany(ctx context.Context, req *pb.GetCompanyReque
 	}
 
 	return &pb.GetCompanyResponse{
-		CompanyUuid: companyInfo.CompanyUUID,
-		Title:       companyInfo.Title,
-		Status:      companyInfo.Status,
+		CompanyUuid:          companyInfo.CompanyUUID,
+		Title:                companyInfo.Title,
+		Status:               companyInfo.Status,
+		JoinApprovalRequired: companyInfo.JoinApprovalRequired,
+		OwnerUuid:            companyInfo.OwnerUUID,
 	}, nil
 }
 
-// GetCompanies Возвращает список всех компаний (count, offset)
+// GetCompanies Возвращает список всех компаний (count, offset или cursor)
 func (s *CompanyService) GetCompanies(ctx context.Context, req *pb.GetCompaniesRequest) (*pb.GetCompaniesResponse, error) {
 	offset := req.GetOffset()
 	if offset < 0 {
@@ -100,12 +126,30 @@ func (s *CompanyService) GetCompanies(ctx context.Context, req *pb.GetCompaniesR
 	if count <= 0 || count > 100 {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid count (1..100)")
 	}
+	position, err := cursor.Parse(req.GetCursor(), offset)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.GetCursor() != "" && validate.UUID(position.ID) != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cursor")
+	}
 
-	companies, getErr := s.db.Company.GetCompanies(ctx, entities.GetCompaniesDTO{Offset: offset, Count: count})
+	// Лишняя компания показывает, есть ли следующая страница
+	companies, getErr := s.db.Company.GetCompanies(ctx, entities.GetCompaniesDTO{Offset: offset, Count: count + 1, AfterUUID: position.ID})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+	total, getErr := s.db.Company.CountCompanies(ctx)
 	if err := getErr.GRPCError(); err != nil {
 		return nil, err
 	}
 
+	nextCursor := ""
+	if int64(len(companies)) > count {
+		companies = companies[:count]
+		nextCursor = cursor.Encode(cursor.Cursor{ID: companies[len(companies)-1].CompanyUUID})
+	}
+
 	resCompanies := make([]*pb.Company, 0)
 	for _, company := range companies {
 		resCompanies = append(resCompanies, &pb.Company{
@@ -115,7 +159,11 @@ func (s *CompanyService) GetCompanies(ctx context.Context, req *pb.GetCompaniesR
 		})
 	}
 
-	return &pb.GetCompaniesResponse{Companies: resCompanies}, nil
+	return &pb.GetCompaniesResponse{
+		Companies:  resCompanies,
+		NextCursor: nextCursor,
+		TotalCount: total,
+	}, nil
 }
 
 // GetUserCompanies Возвращает список компаний, в которых состоит пользователь
@@ -160,6 +208,14 @@ func (s *CompanyService) UpdateCompanyTitle(ctx context.Context, req *pb.UpdateC
 	if err := s.db.Company.UpdateCompanyTitle(ctx, entities.UpdateCompanyTitleDTO{
 		CompanyUUID: req.GetCompanyUuid(),
 		Title:       req.GetTitle(),
+		Audit: newAuditEntry(ctx, entities.CreateAuditEntryDTO{
+			CompanyUUID: req.GetCompanyUuid(),
+			ActorUUID:   req.GetInitiatorUuid(),
+			Action:      entities.AuditCompanyTitleChanged,
+			TargetType:  entities.AuditTargetCompany,
+			TargetID:    req.GetCompanyUuid(),
+			AfterValue:  req.GetTitle(),
+		}),
 	}).GRPCError(); err != nil {
 		return nil, err
 	}
@@ -193,8 +249,8 @@ func (s *CompanyService) UpdateCompanyStatus(ctx context.Context, req *pb.Update
 	return &emptypb.Empty{}, nil
 }
 
-// DeleteCompany Удаляет компанию
-func (s *CompanyService) DeleteCompany(ctx context.Context, req *pb.DeleteCompanyRequest) (*emptypb.Empty, error) {
+// UpdateCompanyJoinSettings Включает или отключает одобрение chief при вступлении по коду
+func (s *CompanyService) UpdateCompanyJoinSettings(ctx context.Context, req *pb.UpdateCompanyJoinSettingsRequest) (*emptypb.Empty, error) {
 	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
 	}
@@ -206,8 +262,9 @@ func (s *CompanyService) DeleteCompany(ctx context.Context, req *pb.DeleteCompan
 		return nil, err
 	}
 
-	if err := s.db.Company.DeleteCompany(ctx, entities.DeleteCompanyDTO{
-		CompanyUUID: req.GetCompanyUuid(),
+	if err := s.db.Company.UpdateCompanyJoinSettings(ctx, entities.UpdateCompanyJoinSettingsDTO{
+		CompanyUUID:          req.GetCompanyUuid(),
+		JoinApprovalRequired: req.GetJoinApprovalRequired(),
 	}).GRPCError(); err != nil {
 		return nil, err
 	}
@@ -232,6 +289,10 @@ func (s *CompanyService) CreateCompanyJoinCode(ctx context.Context, req *pb.Crea
 		return nil, status.Errorf(codes.InvalidArgument, "invalid code ttl (max 7 days)")
 	}
 	joinCodeTTL := time.Second * time.Duration(ttl)
+	// Ограничение числа использований: 0 - без ограничения
+	if req.GetMaxUses() < 0 || req.GetMaxUses() > JoinCodeMaxUses {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid code max uses (0-%d)", JoinCodeMaxUses)
+	}
 
 	if err := s.checkEmployeeRole(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), []string{"chief"}); err != nil {
 		return nil, err
@@ -263,10 +324,24 @@ func (s *CompanyService) CreateCompanyJoinCode(ctx context.Context, req *pb.Crea
 		CompanyUUID: req.GetCompanyUuid(),
 		Code:        joinCode,
 		TTL:         joinCodeTTL,
+		MaxUses:     req.GetMaxUses(),
 	}).GRPCError(); err != nil {
 		return nil, err
 	}
 
+	// Код хранится в redis, поэтому общей транзакции с журналом нет: без записи в журнал код удаляется
+	if err := s.db.Audit.CreateAuditEntry(ctx, *newAuditEntry(ctx, entities.CreateAuditEntryDTO{
+		CompanyUUID: req.GetCompanyUuid(),
+		ActorUUID:   req.GetInitiatorUuid(),
+		Action:      entities.AuditJoinCodeCreated,
+		TargetType:  entities.AuditTargetJoinCode,
+		TargetID:    joinCode,
+		AfterValue:  fmt.Sprintf("ttl=%ds max_uses=%d", ttl, req.GetMaxUses()),
+	})).GRPCError(); err != nil {
+		s.discardJoinCode(ctx, req.GetCompanyUuid(), joinCode)
+		return nil, err
+	}
+
 	return &pb.CreateCompanyJoinCodeResponse{JoinCode: joinCode}, nil
 }
 
@@ -288,7 +363,53 @@ func (s *CompanyService) GetCompanyJoinCodes(ctx context.Context, req *pb.GetCom
 		return nil, err
 	}
 
-	return &pb.GetCompanyJoinCodesResponse{Codes: companyCodes}, nil
+	joinCodes := make([]*pb.JoinCode, 0, len(companyCodes))
+	for _, code := range companyCodes {
+		usage, usageErr := s.cache.Company.GetJoinCodeUsage(ctx, entities.GetJoinCodeUsageDTO{Code: code})
+		if err := usageErr.GRPCError(); err != nil {
+			return nil, err
+		}
+		joinCodes = append(joinCodes, &pb.JoinCode{Code: code, MaxUses: usage.MaxUses, Uses: usage.Uses})
+	}
+
+	return &pb.GetCompanyJoinCodesResponse{Codes: companyCodes, JoinCodes: joinCodes}, nil
+}
+
+// GetJoinCodeRedemptions Возвращает историю использования кода для вступления (кто и когда)
+func (s *CompanyService) GetJoinCodeRedemptions(ctx context.Context, req *pb.GetJoinCodeRedemptionsRequest) (*pb.GetJoinCodeRedemptionsResponse, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+	if err := validate.CompanyJoinCode(req.GetCode()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company join code")
+	}
+
+	if err := s.checkEmployeeRole(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), []string{"chief"}); err != nil {
+		return nil, err
+	}
+
+	// История хранится в БД и доступна и после истечения кода
+	redemptions, getErr := s.db.JoinRequest.GetJoinCodeRedemptions(ctx, entities.GetJoinCodeRedemptionsDTO{
+		CompanyUUID: req.GetCompanyUuid(),
+		Code:        req.GetCode(),
+	})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.JoinCodeRedemption, 0, len(redemptions))
+	for _, redemption := range redemptions {
+		res = append(res, &pb.JoinCodeRedemption{
+			UserUuid:        redemption.UserUUID,
+			RedeemedAt:      redemption.RedeemedAt,
+			JoinRequestUuid: redemption.JoinRequestUUID,
+		})
+	}
+
+	return &pb.GetJoinCodeRedemptionsResponse{Redemptions: res}, nil
 }
 
 // DeleteCompanyJoinCode Удаляет код добавления в компанию
@@ -330,7 +451,7 @@ func (s *CompanyService) DeleteCompanyJoinCode(ctx context.Context, req *pb.Dele
 	return &emptypb.Empty{}, nil
 }
 
-// JoinCompany Добавляет пользователя в компанию
+// JoinCompany Добавляет пользователя в компанию, а если компания требует одобрения - создает заявку на вступление
 func (s *CompanyService) JoinCompany(ctx context.Context, req *pb.JoinCompanyRequest) (*pb.JoinCompanyResponse, error) {
 	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
@@ -374,14 +495,37 @@ func (s *CompanyService) JoinCompany(ctx context.Context, req *pb.JoinCompanyReq
 		return nil, status.Error(codes.PermissionDenied, "company is closed")
 	}
 
+	// Засчитываем использование кода до записи в БД, чтобы лимит не превысили параллельные запросы
+	if err := s.cache.Company.RedeemJoinCode(ctx, entities.RedeemJoinCodeDTO{Code: req.GetJoinCode()}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	if companyInfo.JoinApprovalRequired {
+		joinRequestUUID := uuid.Must(uuid.NewV7()).String()
+
+		if err := s.db.JoinRequest.CreateJoinRequest(ctx, entities.CreateJoinRequestDTO{
+			UUID:        joinRequestUUID,
+			CompanyUUID: companyUUID,
+			UserUUID:    req.GetInitiatorUuid(),
+			JoinCode:    req.GetJoinCode(),
+		}).GRPCError(); err != nil {
+			s.releaseJoinCode(ctx, req.GetJoinCode())
+			return nil, err
+		}
+
+		return &pb.JoinCompanyResponse{CompanyUuid: companyUUID, Status: JoinStatusPending, JoinRequestUuid: joinRequestUUID}, nil
+	}
+
 	if err := s.db.Company.JoinCompany(ctx, entities.JoinCompanyDTO{
 		CompanyUUID: companyUUID,
 		UserUUID:    req.GetInitiatorUuid(),
+		JoinCode:    req.GetJoinCode(),
 	}).GRPCError(); err != nil {
+		s.releaseJoinCode(ctx, req.GetJoinCode())
 		return nil, err
 	}
 
-	return &pb.JoinCompanyResponse{CompanyUuid: companyUUID, Role: "unemployed"}, nil
+	return &pb.JoinCompanyResponse{CompanyUuid: companyUUID, Role: "unemployed", Status: JoinStatusJoined}, nil
 }
 
 // GetCompanyEmployee Возвращает роль сотрудника в компании, иначе возвращает ошибку
@@ -415,7 +559,7 @@ func (s *CompanyService) GetCompanyEmployee(ctx context.Context, req *pb.GetComp
 	}, nil
 }
 
-// GetCompanyEmployees Возвращает список сотрудников компании с фильтрацией (count, offset, role)
+// GetCompanyEmployees Возвращает список сотрудников компании с фильтрацией (count, offset или cursor, role)
 func (s *CompanyService) GetCompanyEmployees(ctx context.Context, req *pb.GetCompanyEmployeesRequest) (*pb.GetCompanyEmployeesResponse, error) {
 	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
@@ -435,22 +579,45 @@ func (s *CompanyService) GetCompanyEmployees(ctx context.Context, req *pb.GetCom
 	if req.GetOffset() < 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid offset")
 	}
+	position, err := cursor.Parse(req.GetCursor(), req.GetOffset())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.GetCursor() != "" {
+		if _, err = time.Parse(time.RFC3339Nano, position.Time); err != nil || validate.UUID(position.ID) != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor")
+		}
+	}
 
 	if err := s.checkEmployeeRole(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), AllRoles); err != nil {
 		return nil, err
 	}
 
-	employees, getErr := s.db.Company.GetCompanyEmployees(ctx, entities.GetCompanyEmployeesDTO{
+	filter := entities.GetCompanyEmployeesDTO{
 		CompanyUUID:    req.GetCompanyUuid(),
 		DepartmentUUID: req.GetDepartmentUuid(),
 		Role:           req.GetRole(),
 		Offset:         req.GetOffset(),
-		Count:          req.GetCount(),
-	})
+		Count:          req.GetCount() + 1, // лишний сотрудник показывает, есть ли следующая страница
+		AfterJoinedAt:  position.Time,
+		AfterUserUUID:  position.ID,
+	}
+	employees, getErr := s.db.Company.GetCompanyEmployees(ctx, filter)
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+	total, getErr := s.db.Company.CountCompanyEmployees(ctx, filter)
 	if err := getErr.GRPCError(); err != nil {
 		return nil, err
 	}
 
+	nextCursor := ""
+	if int64(len(employees)) > req.GetCount() {
+		employees = employees[:req.GetCount()]
+		last := employees[len(employees)-1]
+		nextCursor = cursor.Encode(cursor.Cursor{ID: last.UserUUID, Time: last.JoinedAt})
+	}
+
 	resEmployees := make([]*pb.Employee, 0)
 	for _, employee := range employees {
 		resEmployees = append(resEmployees, &pb.Employee{
@@ -461,7 +628,11 @@ func (s *CompanyService) GetCompanyEmployees(ctx context.Context, req *pb.GetCom
 		})
 	}
 
-	return &pb.GetCompanyEmployeesResponse{Employees: resEmployees}, nil
+	return &pb.GetCompanyEmployeesResponse{
+		Employees:  resEmployees,
+		NextCursor: nextCursor,
+		TotalCount: total,
+	}, nil
 }
 
 // GetCompanyEmployeesSummary Возвращает кол-во сотрудников компании по ролям
@@ -498,7 +669,8 @@ func (s *CompanyService) GetCompanyEmployeesSummary(ctx context.Context, req *pb
 	}, nil
 }
 
-// UpdateEmployeeRole Обновляет роль сотрудника компании
+// UpdateEmployeeRole Обновляет роль сотрудника компании. Открытые заявки, связь с которыми сотрудник теряет,
+// application сервис обрабатывает по событию employee.role_changed согласно выбранной политике
 func (s *CompanyService) UpdateEmployeeRole(ctx context.Context, req *pb.UpdateEmployeeRoleRequest) (*emptypb.Empty, error) {
 	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
@@ -509,28 +681,62 @@ func (s *CompanyService) UpdateEmployeeRole(ctx context.Context, req *pb.UpdateE
 	if err := validate.UUID(req.GetTargetUuid()); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid target uuid")
 	}
+	if !helpers.Contains(AllRoles, req.GetRole()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role")
+	}
 	if req.GetInitiatorUuid() == req.GetTargetUuid() {
 		return nil, status.Error(codes.InvalidArgument, "cannot change your own role")
 	}
+	policy, err := validateOffboarding(req.GetOpenApplications(), req.GetReassignTo(), req.GetTargetUuid())
+	if err != nil {
+		return nil, err
+	}
 
 	if err := s.checkEmployeeRole(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), []string{"chief"}); err != nil {
 		return nil, err
 	}
 
 	// Проверяем наличие сотрудника
-	if _, checkErr := s.db.Company.GetCompanyEmployee(ctx, entities.GetCompanyEmployeeDTO{
+	_, checkErr := s.db.Company.GetCompanyEmployee(ctx, entities.GetCompanyEmployeeDTO{
 		CompanyUUID: req.GetCompanyUuid(),
 		UserUUID:    req.GetTargetUuid(),
-	}); checkErr.Code != 0 {
-		if err := checkErr.GRPCError(); err != nil {
-			return nil, err
-		}
+	})
+	if err := checkErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkOpenApplications(ctx, &application_proto.OffboardEmployeeApplicationsRequest{
+		InitiatorUuid:  req.GetInitiatorUuid(),
+		CompanyUuid:    req.GetCompanyUuid(),
+		UserUuid:       req.GetTargetUuid(),
+		NewRole:        req.GetRole(),
+		Policy:         policy,
+		ReassignToUuid: req.GetReassignTo(),
+	}); err != nil {
+		return nil, err
 	}
 
 	if err := s.db.Company.SetCompanyEmployeeRole(ctx, entities.SetCompanyEmployeeRoleDTO{
 		CompanyUUID: req.GetCompanyUuid(),
 		UserUUID:    req.GetTargetUuid(),
 		Role:        req.GetRole(),
+		Audit: newAuditEntry(ctx, entities.CreateAuditEntryDTO{
+			CompanyUUID: req.GetCompanyUuid(),
+			ActorUUID:   req.GetInitiatorUuid(),
+			Action:      entities.AuditEmployeeRoleChanged,
+			TargetType:  entities.AuditTargetEmployee,
+			TargetID:    req.GetTargetUuid(),
+			AfterValue:  req.GetRole(),
+		}),
+		Event: &entities.MembershipEvent{
+			EventType:        entities.EventEmployeeRoleChanged,
+			CompanyUUID:      req.GetCompanyUuid(),
+			UserUUID:         req.GetTargetUuid(),
+			Role:             req.GetRole(),
+			InitiatorUUID:    req.GetInitiatorUuid(),
+			OpenApplications: policy,
+			ReassignTo:       req.GetReassignTo(),
+		},
 	}).GRPCError(); err != nil {
 		return nil, err
 	}
@@ -538,7 +744,8 @@ func (s *CompanyService) UpdateEmployeeRole(ctx context.Context, req *pb.UpdateE
 	return &emptypb.Empty{}, nil
 }
 
-// RemoveCompanyEmployee Удаляет сотрудника из компании
+// RemoveCompanyEmployee Удаляет сотрудника из компании. Его открытые заявки application сервис
+// обрабатывает по событию employee.removed согласно выбранной политике
 func (s *CompanyService) RemoveCompanyEmployee(ctx context.Context, req *pb.RemoveCompanyEmployeeRequest) (*emptypb.Empty, error) {
 	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
@@ -552,14 +759,93 @@ func (s *CompanyService) RemoveCompanyEmployee(ctx context.Context, req *pb.Remo
 	if req.GetInitiatorUuid() == req.GetTargetUuid() {
 		return nil, status.Error(codes.InvalidArgument, "cannot remove yourself from company")
 	}
+	policy, err := validateOffboarding(req.GetOpenApplications(), req.GetReassignTo(), req.GetTargetUuid())
+	if err != nil {
+		return nil, err
+	}
 
 	if err := s.checkEmployeeRole(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), []string{"chief"}); err != nil {
 		return nil, err
 	}
 
+	// Проверяем наличие сотрудника
+	_, checkErr := s.db.Company.GetCompanyEmployee(ctx, entities.GetCompanyEmployeeDTO{
+		CompanyUUID: req.GetCompanyUuid(),
+		UserUUID:    req.GetTargetUuid(),
+	})
+	if err := checkErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkOpenApplications(ctx, &application_proto.OffboardEmployeeApplicationsRequest{
+		InitiatorUuid:  req.GetInitiatorUuid(),
+		CompanyUuid:    req.GetCompanyUuid(),
+		UserUuid:       req.GetTargetUuid(),
+		Policy:         policy,
+		ReassignToUuid: req.GetReassignTo(),
+	}); err != nil {
+		return nil, err
+	}
+
 	if err := s.db.Company.RemoveCompanyEmployee(ctx, entities.RemoveCompanyEmployeeDTO{
 		CompanyUUID: req.GetCompanyUuid(),
 		UserUUID:    req.GetTargetUuid(),
+		Audit: newAuditEntry(ctx, entities.CreateAuditEntryDTO{
+			CompanyUUID: req.GetCompanyUuid(),
+			ActorUUID:   req.GetInitiatorUuid(),
+			Action:      entities.AuditEmployeeRemoved,
+			TargetType:  entities.AuditTargetEmployee,
+			TargetID:    req.GetTargetUuid(),
+		}),
+		Event: &entities.MembershipEvent{
+			EventType:        entities.EventEmployeeRemoved,
+			CompanyUUID:      req.GetCompanyUuid(),
+			UserUUID:         req.GetTargetUuid(),
+			InitiatorUUID:    req.GetInitiatorUuid(),
+			OpenApplications: policy,
+			ReassignTo:       req.GetReassignTo(),
+		},
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// LeaveCompany Выход сотрудника из компании. Владелец должен сначала передать владение.
+// Открытые заявки сотрудника освобождает application сервис по событию employee.left
+func (s *CompanyService) LeaveCompany(ctx context.Context, req *pb.LeaveCompanyRequest) (*emptypb.Empty, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+
+	companyInfo, getErr := s.db.Company.GetCompany(ctx, entities.GetCompanyDTO{CompanyUUID: req.GetCompanyUuid()})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+	if companyInfo.OwnerUUID == req.GetInitiatorUuid() {
+		return nil, status.Error(codes.FailedPrecondition, "company owner cannot leave company, transfer ownership first")
+	}
+
+	// Сотрудник удаляет себя сам - он и автор, и объект записи в журнале аудита
+	if err := s.db.Company.RemoveCompanyEmployee(ctx, entities.RemoveCompanyEmployeeDTO{
+		CompanyUUID: req.GetCompanyUuid(),
+		UserUUID:    req.GetInitiatorUuid(),
+		Audit: newAuditEntry(ctx, entities.CreateAuditEntryDTO{
+			CompanyUUID: req.GetCompanyUuid(),
+			ActorUUID:   req.GetInitiatorUuid(),
+			Action:      entities.AuditEmployeeRemoved,
+			TargetType:  entities.AuditTargetEmployee,
+			TargetID:    req.GetInitiatorUuid(),
+		}),
+		Event: &entities.MembershipEvent{
+			EventType:   entities.EventEmployeeLeft,
+			CompanyUUID: req.GetCompanyUuid(),
+			UserUUID:    req.GetInitiatorUuid(),
+		},
 	}).GRPCError(); err != nil {
 		return nil, err
 	}
@@ -590,6 +876,14 @@ func (s *CompanyService) CreateDepartment(ctx context.Context, req *pb.CreateDep
 		CompanyUUID: req.GetCompanyUuid(),
 		Title:       req.GetTitle(),
 		CreatedBy:   req.GetInitiatorUuid(),
+		Audit: newAuditEntry(ctx, entities.CreateAuditEntryDTO{
+			CompanyUUID: req.GetCompanyUuid(),
+			ActorUUID:   req.GetInitiatorUuid(),
+			Action:      entities.AuditDepartmentCreated,
+			TargetType:  entities.AuditTargetDepartment,
+			TargetID:    departmentUUID,
+			AfterValue:  req.GetTitle(),
+		}),
 	}).GRPCError(); err != nil {
 		return nil, err
 	}
@@ -638,6 +932,12 @@ func (s *CompanyService) AddEmployeeToDepartment(ctx context.Context, req *pb.Ad
 		DepartmentUUID: req.GetDepartmentUuid(),
 		CompanyUUID:    department.CompanyUUID,
 		TargetUUID:     req.GetTargetUuid(),
+		Event: &entities.MembershipEvent{
+			EventType:      entities.EventEmployeeDepartmentChanged,
+			CompanyUUID:    department.CompanyUUID,
+			UserUUID:       req.GetTargetUuid(),
+			DepartmentUUID: req.GetDepartmentUuid(),
+		},
 	}).GRPCError(); err != nil {
 		return nil, err
 	}
@@ -735,6 +1035,14 @@ func (s *CompanyService) UpdateDepartmentTitle(ctx context.Context, req *pb.Upda
 	if err := s.db.Company.UpdateDepartmentTitle(ctx, &entities.UpdateDepartment{
 		UUID:  req.GetDepartmentUuid(),
 		Title: req.GetTitle(),
+		Audit: newAuditEntry(ctx, entities.CreateAuditEntryDTO{
+			CompanyUUID: department.CompanyUUID,
+			ActorUUID:   req.GetInitiatorUuid(),
+			Action:      entities.AuditDepartmentTitleChanged,
+			TargetType:  entities.AuditTargetDepartment,
+			TargetID:    req.GetDepartmentUuid(),
+			AfterValue:  req.GetTitle(),
+		}),
 	}).GRPCError(); err != nil {
 		return nil, err
 	}
@@ -762,6 +1070,18 @@ func (s *CompanyService) DeleteDepartment(ctx context.Context, req *pb.DeleteDep
 
 	if err := s.db.Company.DeleteDepartment(ctx, entities.DeleteDepartmentDTO{
 		DepartmentUUID: req.GetDepartmentUuid(),
+		Audit: newAuditEntry(ctx, entities.CreateAuditEntryDTO{
+			CompanyUUID: department.CompanyUUID,
+			ActorUUID:   req.GetInitiatorUuid(),
+			Action:      entities.AuditDepartmentDeleted,
+			TargetType:  entities.AuditTargetDepartment,
+			TargetID:    req.GetDepartmentUuid(),
+		}),
+		Event: &entities.MembershipEvent{
+			EventType:      entities.EventDepartmentDeleted,
+			CompanyUUID:    department.CompanyUUID,
+			DepartmentUUID: req.GetDepartmentUuid(),
+		},
 	}).GRPCError(); err != nil {
 		return nil, err
 	}
@@ -769,7 +1089,8 @@ func (s *CompanyService) DeleteDepartment(ctx context.Context, req *pb.DeleteDep
 	return &emptypb.Empty{}, nil
 }
 
-// RemoveEmployeeFromDepartment Удаление сотрудника из департамента
+// RemoveEmployeeFromDepartment Удаление сотрудника из департамента. Его открытые заявки отдела application сервис
+// обрабатывает по событию employee.department_changed согласно выбранной политике
 func (s *CompanyService) RemoveEmployeeFromDepartment(ctx context.Context, req *pb.RemoveEmployeeFromDepartmentRequest) (*emptypb.Empty, error) {
 	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
@@ -780,6 +1101,10 @@ func (s *CompanyService) RemoveEmployeeFromDepartment(ctx context.Context, req *
 	if err := validate.UUID(req.GetTargetUuid()); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid target uuid")
 	}
+	policy, err := validateOffboarding(req.GetOpenApplications(), req.GetReassignTo(), req.GetTargetUuid())
+	if err != nil {
+		return nil, err
+	}
 
 	department, getErr := s.db.Company.GetDepartment(ctx, entities.GetDepartmentDTO{DepartmentUUID: req.GetDepartmentUuid()})
 	if err := getErr.GRPCError(); err != nil {
@@ -802,9 +1127,29 @@ func (s *CompanyService) RemoveEmployeeFromDepartment(ctx context.Context, req *
 		return nil, status.Errorf(codes.InvalidArgument, "user not in this department")
 	}
 
+	if err := s.checkOpenApplications(ctx, &application_proto.OffboardEmployeeApplicationsRequest{
+		InitiatorUuid:  req.GetInitiatorUuid(),
+		CompanyUuid:    department.CompanyUUID,
+		UserUuid:       req.GetTargetUuid(),
+		DepartmentUuid: req.GetDepartmentUuid(),
+		Policy:         policy,
+		ReassignToUuid: req.GetReassignTo(),
+	}); err != nil {
+		return nil, err
+	}
+
 	if err := s.db.Company.RemoveEmployeeFromDepartment(ctx, entities.RemoveEmployeeFromDepartmentDTO{
 		CompanyUUID: department.CompanyUUID,
 		TargetUUID:  req.GetTargetUuid(),
+		Event: &entities.MembershipEvent{
+			EventType:        entities.EventEmployeeDepartmentChanged,
+			CompanyUUID:      department.CompanyUUID,
+			UserUUID:         req.GetTargetUuid(),
+			DepartmentUUID:   req.GetDepartmentUuid(),
+			InitiatorUUID:    req.GetInitiatorUuid(),
+			OpenApplications: policy,
+			ReassignTo:       req.GetReassignTo(),
+		},
 	}).GRPCError(); err != nil {
 		return nil, err
 	}
@@ -834,6 +1179,26 @@ func (s *CompanyService) CheckColleagues(ctx context.Context, req *pb.CheckColle
 
 // ─── Вспомогательные функции ──────────────────────────────────────────────────
 
+// releaseJoinCode Возвращает использование кода, если вступление не состоялось. Ошибка только логируется
+func (s *CompanyService) releaseJoinCode(ctx context.Context, code string) {
+	// Вступление могло сорваться из-за отмены запроса - возврат использования от неё не зависит
+	if err := s.cache.Company.ReleaseJoinCode(context.WithoutCancel(ctx), entities.ReleaseJoinCodeDTO{Code: code}); err.Code != 0 {
+		log.Error().Err(err).Time("time", time.Now()).Str("id", interceptors.OperationIDFromContext(ctx)).Str("method", "JoinCompany").
+			Str("join_code", code).Msg("failed to release join code")
+	}
+}
+
+// discardJoinCode Удаляет созданный код, если его создание не удалось записать в журнал аудита
+func (s *CompanyService) discardJoinCode(ctx context.Context, companyUUID, code string) {
+	if err := s.cache.Company.DeleteCompanyJoinCode(context.WithoutCancel(ctx), entities.DeleteCompanyJoinCodeDTO{
+		CompanyUUID: companyUUID,
+		Code:        code,
+	}); err.Code != 0 {
+		log.Error().Err(err).Time("time", time.Now()).Str("id", interceptors.OperationIDFromContext(ctx)).Str("method", "CreateCompanyJoinCode").
+			Str("join_code", code).Msg("failed to discard join code")
+	}
+}
+
 // checkEmployeeRole Проверяет роль пользователя в компании
 func (s *CompanyService) checkEmployeeRole(ctx context.Context, companyUUID, userUUID string, requiredRoles []string) error {
 	// Проверяем существование компании
@@ -6,6 +6,7 @@ import (
 
 	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
 	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/company/generated"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/cursor"
 	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -356,60 +357,6 @@ func TestUpdateCompanyStatus(t *testing.T) {
 	})
 }
 
-// ─── DeleteCompany ────────────────────────────────────────────────────────────
-
-func TestDeleteCompany(t *testing.T) {
-	ctx := context.Background()
-	req := &pb.DeleteCompanyRequest{CompanyUuid: companyID, InitiatorUuid: initiatorID}
-
-	t.Run("success", func(t *testing.T) {
-		pg := pgRepoWithChief()
-		pg.deleteCompany = func(_ context.Context, _ entities.DeleteCompanyDTO) Error.CodeError { return ok() }
-
-		svc := newTestService(pg, emptyRedisRepo())
-		_, err := svc.DeleteCompany(ctx, req)
-		assertNoError(t, err)
-	})
-
-	t.Run("invalid_initiator_uuid", func(t *testing.T) {
-		svc := newTestService(emptyPGRepo(), emptyRedisRepo())
-		_, err := svc.DeleteCompany(ctx, &pb.DeleteCompanyRequest{
-			InitiatorUuid: "not-a-uuid",
-			CompanyUuid:   companyID,
-		})
-		assertGRPCCode(t, err, codes.InvalidArgument)
-	})
-
-	t.Run("invalid_company_uuid", func(t *testing.T) {
-		svc := newTestService(emptyPGRepo(), emptyRedisRepo())
-		_, err := svc.DeleteCompany(ctx, &pb.DeleteCompanyRequest{
-			InitiatorUuid: initiatorID,
-			CompanyUuid:   "not-a-uuid",
-		})
-		assertGRPCCode(t, err, codes.InvalidArgument)
-	})
-
-	t.Run("check role fails", func(t *testing.T) {
-		pg := emptyPGRepo()
-		pg.getCompany = func(_ context.Context, _ entities.GetCompanyDTO) (*entities.Company, Error.CodeError) {
-			return nil, notFound()
-		}
-
-		svc := newTestService(pg, emptyRedisRepo())
-		_, err := svc.DeleteCompany(ctx, req)
-		assertGRPCCode(t, err, codes.NotFound)
-	})
-
-	t.Run("db delete error", func(t *testing.T) {
-		pg := pgRepoWithChief()
-		pg.deleteCompany = func(_ context.Context, _ entities.DeleteCompanyDTO) Error.CodeError { return internalErr() }
-
-		svc := newTestService(pg, emptyRedisRepo())
-		_, err := svc.DeleteCompany(ctx, req)
-		assertGRPCCode(t, err, codes.Internal)
-	})
-}
-
 // ─── CreateCompanyJoinCode ────────────────────────────────────────────────────
 
 func TestCreateCompanyJoinCode(t *testing.T) {
@@ -470,6 +417,35 @@ func TestCreateCompanyJoinCode(t *testing.T) {
 		assertGRPCCode(t, err, codes.InvalidArgument)
 	})
 
+	t.Run("max uses saved", func(t *testing.T) {
+		var saved entities.CreateCompanyJoinCodeDTO
+		rdb := emptyRedisRepo()
+		rdb.checkJoinCodeExists = func(_ context.Context, _ entities.CheckJoinCodeExistsDTO) Error.CodeError { return notFound() }
+		rdb.createCompanyJoinCode = func(_ context.Context, dto entities.CreateCompanyJoinCodeDTO) Error.CodeError {
+			saved = dto
+			return ok()
+		}
+
+		svc := newTestService(pgRepoWithChief(), rdb)
+		_, err := svc.CreateCompanyJoinCode(ctx, &pb.CreateCompanyJoinCodeRequest{
+			CompanyUuid: companyID, InitiatorUuid: initiatorID, CodeTtl: 3600, MaxUses: 5,
+		})
+		assertNoError(t, err)
+		if saved.MaxUses != 5 {
+			t.Errorf("expected max uses 5, got %d", saved.MaxUses)
+		}
+	})
+
+	t.Run("invalid max uses", func(t *testing.T) {
+		svc := newTestService(pgRepoWithChief(), emptyRedisRepo())
+		for _, maxUses := range []int64{-1, JoinCodeMaxUses + 1} {
+			_, err := svc.CreateCompanyJoinCode(ctx, &pb.CreateCompanyJoinCodeRequest{
+				CompanyUuid: companyID, InitiatorUuid: initiatorID, CodeTtl: 3600, MaxUses: maxUses,
+			})
+			assertGRPCCode(t, err, codes.InvalidArgument)
+		}
+	})
+
 	t.Run("check role fails", func(t *testing.T) {
 		pg := emptyPGRepo()
 		pg.getCompany = func(_ context.Context, _ entities.GetCompanyDTO) (*entities.Company, Error.CodeError) {
@@ -535,6 +511,27 @@ func TestGetCompanyJoinCodes(t *testing.T) {
 		}
 	})
 
+	t.Run("success — codes with usage", func(t *testing.T) {
+		rdb := emptyRedisRepo()
+		rdb.getCompanyJoinCodes = func(_ context.Context, _ entities.GetCompanyJoinCodesDTO) ([]string, Error.CodeError) {
+			return []string{testCode}, ok()
+		}
+		rdb.getJoinCodeUsage = func(_ context.Context, _ entities.GetJoinCodeUsageDTO) (*entities.JoinCodeUsage, Error.CodeError) {
+			return &entities.JoinCodeUsage{MaxUses: 3, Uses: 1}, ok()
+		}
+
+		svc := newTestService(pgRepoWithChief(), rdb)
+		res, err := svc.GetCompanyJoinCodes(ctx, req)
+		assertNoError(t, err)
+		if len(res.GetJoinCodes()) != 1 {
+			t.Fatalf("expected 1 join code, got %d", len(res.GetJoinCodes()))
+		}
+		code := res.GetJoinCodes()[0]
+		if code.GetCode() != testCode || code.GetMaxUses() != 3 || code.GetUses() != 1 {
+			t.Errorf("unexpected join code usage: %v", code)
+		}
+	})
+
 	t.Run("check role fails", func(t *testing.T) {
 		pg := emptyPGRepo()
 		pg.getCompany = func(_ context.Context, _ entities.GetCompanyDTO) (*entities.Company, Error.CodeError) {
@@ -768,9 +765,42 @@ func TestJoinCompany(t *testing.T) {
 		}
 		pg.joinCompany = func(_ context.Context, _ entities.JoinCompanyDTO) Error.CodeError { return internalErr() }
 
-		svc := newTestService(pg, joinRdb())
+		released := false
+		rdb := joinRdb()
+		rdb.releaseJoinCode = func(_ context.Context, _ entities.ReleaseJoinCodeDTO) Error.CodeError {
+			released = true
+			return ok()
+		}
+
+		svc := newTestService(pg, rdb)
 		_, err := svc.JoinCompany(ctx, req)
 		assertGRPCCode(t, err, codes.Internal)
+		if !released {
+			t.Error("expected join code use to be released after db error")
+		}
+	})
+
+	t.Run("code usage limit reached", func(t *testing.T) {
+		pg := emptyPGRepo()
+		pg.getCompanyEmployee = func(_ context.Context, _ entities.GetCompanyEmployeeDTO) (*entities.Employee, Error.CodeError) {
+			return nil, notFound()
+		}
+		pg.getCompany = func(_ context.Context, _ entities.GetCompanyDTO) (*entities.Company, Error.CodeError) {
+			return &entities.Company{Status: "open"}, ok()
+		}
+		pg.joinCompany = func(_ context.Context, _ entities.JoinCompanyDTO) Error.CodeError {
+			t.Error("join company should not be called when limit reached")
+			return ok()
+		}
+
+		rdb := joinRdb()
+		rdb.redeemJoinCode = func(_ context.Context, _ entities.RedeemJoinCodeDTO) Error.CodeError {
+			return Error.Public(codes.ResourceExhausted, "join code usage limit reached")
+		}
+
+		svc := newTestService(pg, rdb)
+		_, err := svc.JoinCompany(ctx, req)
+		assertGRPCCode(t, err, codes.ResourceExhausted)
 	})
 }
 
@@ -979,6 +1009,112 @@ func TestGetCompanyEmployees(t *testing.T) {
 	})
 }
 
+// ─── Cursor-пагинация списков ─────────────────────────────────────────────────
+
+func TestCompanyListsCursorPagination(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("companies — next cursor and total", func(t *testing.T) {
+		pg := emptyPGRepo()
+		pg.getCompanies = func(_ context.Context, dto entities.GetCompaniesDTO) ([]*entities.GetCompanies, Error.CodeError) {
+			if dto.Count != 3 {
+				t.Errorf("expected lookahead count 3, got %d", dto.Count)
+			}
+			if dto.AfterUUID != companyID {
+				t.Errorf("expected AfterUUID %s, got %q", companyID, dto.AfterUUID)
+			}
+			return []*entities.GetCompanies{
+				{CompanyUUID: "c1"}, {CompanyUUID: "c2"}, {CompanyUUID: "c3"},
+			}, ok()
+		}
+		pg.countCompanies = func(_ context.Context) (int64, Error.CodeError) { return 7, ok() }
+
+		svc := newTestService(pg, emptyRedisRepo())
+		res, err := svc.GetCompanies(ctx, &pb.GetCompaniesRequest{Count: 2, Cursor: cursor.Encode(cursor.Cursor{ID: companyID})})
+		assertNoError(t, err)
+		if len(res.GetCompanies()) != 2 {
+			t.Fatalf("expected 2 companies, got %d", len(res.GetCompanies()))
+		}
+		if res.GetTotalCount() != 7 {
+			t.Errorf("expected total 7, got %d", res.GetTotalCount())
+		}
+		next, _ := cursor.Decode(res.GetNextCursor())
+		if next.ID != "c2" {
+			t.Errorf("expected next cursor at c2, got %q", next.ID)
+		}
+	})
+
+	t.Run("companies — last page has no cursor", func(t *testing.T) {
+		pg := emptyPGRepo()
+		pg.getCompanies = func(_ context.Context, _ entities.GetCompaniesDTO) ([]*entities.GetCompanies, Error.CodeError) {
+			return []*entities.GetCompanies{{CompanyUUID: "c1"}}, ok()
+		}
+
+		svc := newTestService(pg, emptyRedisRepo())
+		res, err := svc.GetCompanies(ctx, &pb.GetCompaniesRequest{Count: 2})
+		assertNoError(t, err)
+		if res.GetNextCursor() != "" {
+			t.Errorf("expected empty next cursor, got %q", res.GetNextCursor())
+		}
+	})
+
+	t.Run("companies — cursor with offset", func(t *testing.T) {
+		svc := newTestService(emptyPGRepo(), emptyRedisRepo())
+		_, err := svc.GetCompanies(ctx, &pb.GetCompaniesRequest{Count: 2, Offset: 2, Cursor: cursor.Encode(cursor.Cursor{ID: companyID})})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("companies — malformed cursor", func(t *testing.T) {
+		svc := newTestService(emptyPGRepo(), emptyRedisRepo())
+		_, err := svc.GetCompanies(ctx, &pb.GetCompaniesRequest{Count: 2, Cursor: "%%%"})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("employees — keyset position from cursor", func(t *testing.T) {
+		joinedAt := "2026-01-02T03:04:05.123456Z"
+		pg := pgRepoWithChief()
+		pg.getCompanyEmployees = func(_ context.Context, dto entities.GetCompanyEmployeesDTO) ([]*entities.Employee, Error.CodeError) {
+			if dto.AfterJoinedAt != joinedAt || dto.AfterUserUUID != targetID {
+				t.Errorf("unexpected keyset position %q %q", dto.AfterJoinedAt, dto.AfterUserUUID)
+			}
+			return []*entities.Employee{
+				{UserUUID: "u1", JoinedAt: "2026-01-01T00:00:00Z"},
+				{UserUUID: "u2", JoinedAt: "2026-01-01T00:00:00Z"},
+			}, ok()
+		}
+		pg.countCompanyEmployees = func(_ context.Context, _ entities.GetCompanyEmployeesDTO) (int64, Error.CodeError) {
+			return 5, ok()
+		}
+
+		svc := newTestService(pg, emptyRedisRepo())
+		res, err := svc.GetCompanyEmployees(ctx, &pb.GetCompanyEmployeesRequest{
+			CompanyUuid:   companyID,
+			InitiatorUuid: initiatorID,
+			Count:         1,
+			Cursor:        cursor.Encode(cursor.Cursor{ID: targetID, Time: joinedAt}),
+		})
+		assertNoError(t, err)
+		if len(res.GetEmployees()) != 1 || res.GetTotalCount() != 5 {
+			t.Fatalf("expected 1 employee of 5, got %d of %d", len(res.GetEmployees()), res.GetTotalCount())
+		}
+		next, _ := cursor.Decode(res.GetNextCursor())
+		if next.ID != "u1" || next.Time != "2026-01-01T00:00:00Z" {
+			t.Errorf("unexpected next cursor %+v", next)
+		}
+	})
+
+	t.Run("employees — cursor without joined_at", func(t *testing.T) {
+		svc := newTestService(pgRepoWithChief(), emptyRedisRepo())
+		_, err := svc.GetCompanyEmployees(ctx, &pb.GetCompanyEmployeesRequest{
+			CompanyUuid:   companyID,
+			InitiatorUuid: initiatorID,
+			Count:         1,
+			Cursor:        cursor.Encode(cursor.Cursor{ID: targetID}),
+		})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+}
+
 // ─── GetCompanyEmployeesSummary ───────────────────────────────────────────────
 
 func TestGetCompanyEmployeesSummary(t *testing.T) {
@@ -1099,11 +1235,21 @@ func TestUpdateEmployeeRole(t *testing.T) {
 			}
 			return &entities.Employee{Role: "engineer"}, ok()
 		}
-		pg.setCompanyEmployeeRole = func(_ context.Context, _ entities.SetCompanyEmployeeRoleDTO) Error.CodeError { return ok() }
+		var events []entities.MembershipEvent
+		pg.setCompanyEmployeeRole = func(_ context.Context, dto entities.SetCompanyEmployeeRoleDTO) Error.CodeError {
+			recordEvent(&events, dto.Event)
+			return ok()
+		}
 
 		svc := newTestService(pg, emptyRedisRepo())
 		_, err := svc.UpdateEmployeeRole(ctx, req)
 		assertNoError(t, err)
+
+		// Сервисы с закешированной ролью сотрудника должны узнать об изменении - событие пишется в транзакции изменения
+		if len(events) != 1 || events[0].EventType != entities.EventEmployeeRoleChanged ||
+			events[0].CompanyUUID != companyID || events[0].UserUUID != targetID {
+			t.Errorf("unexpected membership events: %+v", events)
+		}
 	})
 
 	t.Run("update self role", func(t *testing.T) {
@@ -1702,11 +1848,19 @@ func TestDeleteDepartment(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		pg := pgRepoWithChiefAndDept()
-		pg.deleteDepartment = func(_ context.Context, _ entities.DeleteDepartmentDTO) Error.CodeError { return ok() }
+		var events []entities.MembershipEvent
+		pg.deleteDepartment = func(_ context.Context, dto entities.DeleteDepartmentDTO) Error.CodeError {
+			recordEvent(&events, dto.Event)
+			return ok()
+		}
 
 		svc := newTestService(pg, emptyRedisRepo())
 		_, err := svc.DeleteDepartment(ctx, req)
 		assertNoError(t, err)
+
+		if len(events) != 1 || events[0].EventType != entities.EventDepartmentDeleted || events[0].DepartmentUUID != deptID {
+			t.Errorf("unexpected membership events: %+v", events)
+		}
 	})
 
 	t.Run("department not found", func(t *testing.T) {
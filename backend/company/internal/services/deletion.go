@@ -0,0 +1,277 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	postgresDB "github.com/unwelcome/FrameWorkTask1/backend/company/internal/database/postgres"
+	redisDB "github.com/unwelcome/FrameWorkTask1/backend/company/internal/database/redis"
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
+	application_proto "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/company/generated"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	companyDeletionBatchSize   = 10
+	companyDeletionStepTimeout = 10 * time.Minute // архивация заявок крупной компании может идти долго
+	companyDeletionRetryDelay  = 30 * time.Second // задержка после первой неудачи, дальше удваивается
+	companyDeletionMaxDelay    = time.Hour
+)
+
+// ApplicationArchiver Архивация заявок компании в application сервисе (шаг удаления компании)
+type ApplicationArchiver interface {
+	ArchiveCompanyApplications(ctx context.Context, in *application_proto.ArchiveCompanyApplicationsRequest, opts ...grpc.CallOption) (*application_proto.ArchiveCompanyApplicationsResponse, error)
+}
+
+// DeleteCompany Удаляет компанию: компания сразу скрывается, а её данные удаляются воркером
+// после окончания срока восстановления (см. StartCompanyDeletionWorker)
+func (s *CompanyService) DeleteCompany(ctx context.Context, req *pb.DeleteCompanyRequest) (*emptypb.Empty, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+
+	if err := s.checkEmployeeRole(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), []string{"chief"}); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.Deletion.ScheduleCompanyDeletion(ctx, entities.ScheduleCompanyDeletionDTO{
+		CompanyUUID: req.GetCompanyUuid(),
+		RequestedBy: req.GetInitiatorUuid(),
+		PurgeAfter:  time.Now().Add(s.deletionRetention),
+		// Скрытая компания недоступна сотрудникам - подписчики сбрасывают закешированные роли
+		Event: &entities.MembershipEvent{
+			EventType:   entities.EventCompanyDeleted,
+			CompanyUUID: req.GetCompanyUuid(),
+		},
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetCompanyDeletionStatus Возвращает ход удаления компании (для инициатора удаления и руководителей компании)
+func (s *CompanyService) GetCompanyDeletionStatus(ctx context.Context, req *pb.GetCompanyDeletionStatusRequest) (*pb.GetCompanyDeletionStatusResponse, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+
+	deletion, getErr := s.db.Deletion.GetCompanyDeletion(ctx, entities.GetCompanyDeletionDTO{CompanyUUID: req.GetCompanyUuid()})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	// После окончательного удаления сотрудников нет - ход удаления доступен только инициатору
+	if deletion.RequestedBy != req.GetInitiatorUuid() {
+		if err := s.checkDeletedCompanyChief(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid()); err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.GetCompanyDeletionStatusResponse{
+		Step:                 deletion.Step,
+		RequestedBy:          deletion.RequestedBy,
+		RequestedAt:          deletion.CreatedAt,
+		PurgeAfter:           deletion.PurgeAfter,
+		ArchiveKey:           deletion.ArchiveKey,
+		ArchivedApplications: deletion.ArchivedApplications,
+		Attempts:             deletion.Attempts,
+		LastError:            deletion.LastError,
+		CompletedAt:          deletion.CompletedAt,
+	}, nil
+}
+
+// RestoreCompany Отменяет удаление компании до окончания срока восстановления
+func (s *CompanyService) RestoreCompany(ctx context.Context, req *pb.RestoreCompanyRequest) (*emptypb.Empty, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+
+	if err := s.checkDeletedCompanyChief(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid()); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.Deletion.RestoreCompany(ctx, entities.RestoreCompanyDTO{
+		CompanyUUID: req.GetCompanyUuid(),
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// checkDeletedCompanyChief Проверяет, что пользователь - руководитель компании. В отличие от checkEmployeeRole
+// не требует, чтобы компания была видна: сотрудники удалённой компании хранятся до окончательного удаления
+func (s *CompanyService) checkDeletedCompanyChief(ctx context.Context, companyUUID, userUUID string) error {
+	employee, getErr := s.db.Company.GetCompanyEmployee(ctx, entities.GetCompanyEmployeeDTO{
+		CompanyUUID: companyUUID,
+		UserUUID:    userUUID,
+	})
+	if getErr.Code == codes.NotFound {
+		return status.Errorf(codes.PermissionDenied, "access denied")
+	}
+	if err := getErr.GRPCError(); err != nil {
+		return err
+	}
+
+	if employee.Role != "chief" {
+		return status.Errorf(codes.PermissionDenied, "not enough rights")
+	}
+	return nil
+}
+
+// StartCompanyDeletionWorker запускает фоновую горутину, которая каждые interval продолжает удаление компаний
+// с истёкшим сроком восстановления: архивирует заявки в application сервисе, удаляет коды для вступления
+// и окончательно удаляет компанию. Каждый переход между шагами сохраняется, поэтому после перезапуска
+// удаление продолжается с прерванного шага. Неудачный шаг повторяется с растущей задержкой.
+// Останавливается при отмене ctx (graceful shutdown).
+func StartCompanyDeletionWorker(ctx context.Context, db *postgresDB.DatabaseRepository, cache *redisDB.CacheRepository, archiver ApplicationArchiver, interval time.Duration) {
+	log.Info().Dur("interval", interval).Msg("company deletion worker started")
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			runCompanyDeletions(ctx, db, cache, archiver)
+		case <-ctx.Done():
+			log.Info().Msg("company deletion worker stopped")
+			return
+		}
+	}
+}
+
+// runCompanyDeletions Продолжение удалений, которым подошло время. Возвращает число завершённых удалений
+func runCompanyDeletions(ctx context.Context, db *postgresDB.DatabaseRepository, cache *redisDB.CacheRepository, archiver ApplicationArchiver) int {
+	deletions, getErr := db.Deletion.GetDueCompanyDeletions(ctx, entities.GetDueCompanyDeletionsDTO{
+		Count: companyDeletionBatchSize,
+	})
+	if getErr.Code != 0 {
+		log.Error().Err(getErr).Msg("company deletion: failed to get due deletions")
+		return 0
+	}
+
+	completed := 0
+	for _, deletion := range deletions {
+		err := runCompanyDeletionSteps(ctx, db, cache, archiver, deletion)
+		if err == nil {
+			if deletion.Step == entities.DeletionStepCompleted {
+				completed++
+			}
+			continue
+		}
+		if ctx.Err() != nil {
+			return completed
+		}
+
+		log.Error().Err(err).Str("company_uuid", deletion.CompanyUUID).Str("step", deletion.Step).
+			Int32("attempts", deletion.Attempts+1).Msg("company deletion: step failed")
+
+		if failErr := db.Deletion.FailCompanyDeletionStep(ctx, entities.FailCompanyDeletionStepDTO{
+			CompanyUUID:   deletion.CompanyUUID,
+			Error:         publicErrorMessage(err),
+			NextAttemptAt: time.Now().Add(companyDeletionBackoff(deletion.Attempts + 1)),
+		}); failErr.Code != 0 {
+			log.Error().Err(failErr).Str("company_uuid", deletion.CompanyUUID).Msg("company deletion: failed to save step failure")
+		}
+	}
+
+	if completed > 0 {
+		log.Info().Int("completed", completed).Msg("company deletion")
+	}
+	return completed
+}
+
+// runCompanyDeletionSteps Выполняет оставшиеся шаги удаления по порядку. deletion.Step обновляется после
+// каждого сохранённого перехода. Если шаг сменился параллельно (другой экземпляр сервиса), удаление пропускается
+func runCompanyDeletionSteps(ctx context.Context, db *postgresDB.DatabaseRepository, cache *redisDB.CacheRepository, archiver ApplicationArchiver, deletion *entities.CompanyDeletion) error {
+	for deletion.Step != entities.DeletionStepCompleted {
+		advance := entities.AdvanceCompanyDeletionDTO{CompanyUUID: deletion.CompanyUUID, From: deletion.Step}
+
+		switch deletion.Step {
+		case entities.DeletionStepSoftDeleted:
+			advance.To = entities.DeletionStepArchivingApplications
+
+		case entities.DeletionStepArchivingApplications:
+			stepCtx, cancel := context.WithTimeout(ctx, companyDeletionStepTimeout)
+			res, err := archiver.ArchiveCompanyApplications(stepCtx, &application_proto.ArchiveCompanyApplicationsRequest{
+				CompanyUuid: deletion.CompanyUUID,
+			})
+			cancel()
+			if err != nil {
+				return err
+			}
+			advance.To = entities.DeletionStepPurgingJoinCodes
+			advance.ArchiveKey, advance.ArchivedApplications = res.GetArchiveKey(), res.GetArchived()
+
+		case entities.DeletionStepPurgingJoinCodes:
+			if err := cache.Company.PurgeCompanyJoinCodes(ctx, entities.PurgeCompanyJoinCodesDTO{
+				CompanyUUID: deletion.CompanyUUID,
+			}); err.Code != 0 {
+				return err
+			}
+			advance.To = entities.DeletionStepDeletingCompany
+
+		case entities.DeletionStepDeletingCompany:
+			if err := db.Deletion.CompleteCompanyDeletion(ctx, entities.CompleteCompanyDeletionDTO{
+				CompanyUUID: deletion.CompanyUUID,
+			}); err.Code != 0 {
+				if err.Code == codes.FailedPrecondition {
+					return nil
+				}
+				return err
+			}
+			deletion.Step = entities.DeletionStepCompleted
+			continue
+
+		default:
+			return status.Errorf(codes.Internal, "unknown company deletion step %q", deletion.Step)
+		}
+
+		if err := db.Deletion.AdvanceCompanyDeletion(ctx, advance); err.Code != 0 {
+			if err.Code == codes.FailedPrecondition {
+				return nil
+			}
+			return err
+		}
+		deletion.Step = advance.To
+		deletion.Attempts = 0
+	}
+	return nil
+}
+
+// companyDeletionBackoff Задержка перед попыткой номер attempts + 1
+func companyDeletionBackoff(attempts int32) time.Duration {
+	delay := companyDeletionRetryDelay
+	for i := int32(1); i < attempts && delay < companyDeletionMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, companyDeletionMaxDelay)
+}
+
+// publicErrorMessage Сообщение об ошибке шага, которое можно показать пользователю (без внутренних деталей)
+func publicErrorMessage(err error) string {
+	var codeErr Error.CodeError
+	if errors.As(err, &codeErr) {
+		err = codeErr.GRPCError()
+	}
+	return status.Convert(err).Message()
+}
@@ -0,0 +1,395 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	postgresDB "github.com/unwelcome/FrameWorkTask1/backend/company/internal/database/postgres"
+	redisDB "github.com/unwelcome/FrameWorkTask1/backend/company/internal/database/redis"
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
+	application_proto "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/company/generated"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mockArchiver — application сервис, архивирующий заявки компании
+type mockArchiver struct {
+	archive func(ctx context.Context, in *application_proto.ArchiveCompanyApplicationsRequest) (*application_proto.ArchiveCompanyApplicationsResponse, error)
+}
+
+func (m *mockArchiver) ArchiveCompanyApplications(ctx context.Context, in *application_proto.ArchiveCompanyApplicationsRequest, _ ...grpc.CallOption) (*application_proto.ArchiveCompanyApplicationsResponse, error) {
+	return m.archive(ctx, in)
+}
+
+// deletionWorkerRepos — моки хранилищ для воркера удаления: переходы между шагами записываются в steps
+func deletionWorkerRepos(steps *[]string, purged *bool) (*postgresDB.DatabaseRepository, *redisDB.CacheRepository) {
+	deletion := &mockDeletionRepo{
+		advanceCompanyDeletion: func(_ context.Context, dto entities.AdvanceCompanyDeletionDTO) Error.CodeError {
+			*steps = append(*steps, dto.To)
+			return ok()
+		},
+		completeCompanyDeletion: func(_ context.Context, _ entities.CompleteCompanyDeletionDTO) Error.CodeError {
+			*steps = append(*steps, entities.DeletionStepCompleted)
+			return ok()
+		},
+	}
+	redis := emptyRedisRepo()
+	redis.purgeCompanyJoinCodes = func(_ context.Context, dto entities.PurgeCompanyJoinCodesDTO) Error.CodeError {
+		*purged = dto.CompanyUUID == companyID
+		return ok()
+	}
+	return &postgresDB.DatabaseRepository{Company: emptyPGRepo(), Deletion: deletion}, &redisDB.CacheRepository{Company: redis}
+}
+
+// ─── DeleteCompany ────────────────────────────────────────────────────────────
+
+func TestDeleteCompany(t *testing.T) {
+	ctx := context.Background()
+	req := &pb.DeleteCompanyRequest{CompanyUuid: companyID, InitiatorUuid: initiatorID}
+
+	t.Run("success — deletion scheduled after retention", func(t *testing.T) {
+		var scheduled entities.ScheduleCompanyDeletionDTO
+		deletion := &mockDeletionRepo{
+			scheduleCompanyDeletion: func(_ context.Context, dto entities.ScheduleCompanyDeletionDTO) Error.CodeError {
+				scheduled = dto
+				return ok()
+			},
+		}
+		svc := newDeletionTestService(pgRepoWithChief(), deletion, emptyPublisher())
+		_, err := svc.DeleteCompany(ctx, req)
+		assertNoError(t, err)
+
+		if scheduled.CompanyUUID != companyID || scheduled.RequestedBy != initiatorID {
+			t.Errorf("unexpected deletion scheduled: %+v", scheduled)
+		}
+		if d := time.Until(scheduled.PurgeAfter); d <= 0 || d > testDeletionRetention {
+			t.Errorf("unexpected purge_after: %v", scheduled.PurgeAfter)
+		}
+		if scheduled.Event == nil || scheduled.Event.EventType != entities.EventCompanyDeleted || scheduled.Event.CompanyUUID != companyID {
+			t.Errorf("expected company deleted event, got %+v", scheduled.Event)
+		}
+	})
+
+	t.Run("invalid_initiator_uuid", func(t *testing.T) {
+		svc := newDeletionTestService(emptyPGRepo(), &mockDeletionRepo{}, emptyPublisher())
+		_, err := svc.DeleteCompany(ctx, &pb.DeleteCompanyRequest{
+			InitiatorUuid: "not-a-uuid",
+			CompanyUuid:   companyID,
+		})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("invalid_company_uuid", func(t *testing.T) {
+		svc := newDeletionTestService(emptyPGRepo(), &mockDeletionRepo{}, emptyPublisher())
+		_, err := svc.DeleteCompany(ctx, &pb.DeleteCompanyRequest{
+			InitiatorUuid: initiatorID,
+			CompanyUuid:   "not-a-uuid",
+		})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("check role fails", func(t *testing.T) {
+		pg := emptyPGRepo()
+		pg.getCompany = func(_ context.Context, _ entities.GetCompanyDTO) (*entities.Company, Error.CodeError) {
+			return nil, notFound()
+		}
+
+		svc := newDeletionTestService(pg, &mockDeletionRepo{}, emptyPublisher())
+		_, err := svc.DeleteCompany(ctx, req)
+		assertGRPCCode(t, err, codes.NotFound)
+	})
+
+	t.Run("db schedule error", func(t *testing.T) {
+		deletion := &mockDeletionRepo{
+			scheduleCompanyDeletion: func(_ context.Context, _ entities.ScheduleCompanyDeletionDTO) Error.CodeError { return internalErr() },
+		}
+
+		svc := newDeletionTestService(pgRepoWithChief(), deletion, emptyPublisher())
+		_, err := svc.DeleteCompany(ctx, req)
+		assertGRPCCode(t, err, codes.Internal)
+	})
+}
+
+// ─── GetCompanyDeletionStatus ─────────────────────────────────────────────────
+
+func TestGetCompanyDeletionStatus(t *testing.T) {
+	ctx := context.Background()
+	req := &pb.GetCompanyDeletionStatusRequest{CompanyUuid: companyID, InitiatorUuid: initiatorID}
+
+	deletionRequestedBy := func(requestedBy string) *mockDeletionRepo {
+		return &mockDeletionRepo{
+			getCompanyDeletion: func(_ context.Context, _ entities.GetCompanyDeletionDTO) (*entities.CompanyDeletion, Error.CodeError) {
+				return &entities.CompanyDeletion{
+					CompanyUUID:          companyID,
+					RequestedBy:          requestedBy,
+					Step:                 entities.DeletionStepCompleted,
+					ArchiveKey:           "archives/companies/" + companyID + ".jsonl",
+					ArchivedApplications: 3,
+				}, ok()
+			},
+		}
+	}
+
+	t.Run("requester after company purge", func(t *testing.T) {
+		// Сотрудников уже нет - проверка роли не должна выполняться
+		svc := newDeletionTestService(emptyPGRepo(), deletionRequestedBy(initiatorID), emptyPublisher())
+		res, err := svc.GetCompanyDeletionStatus(ctx, req)
+		assertNoError(t, err)
+
+		if res.GetStep() != entities.DeletionStepCompleted || res.GetArchivedApplications() != 3 || res.GetRequestedBy() != initiatorID {
+			t.Errorf("unexpected status: %v", res)
+		}
+	})
+
+	t.Run("other chief of soft deleted company", func(t *testing.T) {
+		svc := newDeletionTestService(pgRepoWithChief(), deletionRequestedBy(targetID), emptyPublisher())
+		_, err := svc.GetCompanyDeletionStatus(ctx, req)
+		assertNoError(t, err)
+	})
+
+	t.Run("not chief", func(t *testing.T) {
+		pg := emptyPGRepo()
+		pg.getCompanyEmployee = func(_ context.Context, _ entities.GetCompanyEmployeeDTO) (*entities.Employee, Error.CodeError) {
+			return &entities.Employee{Role: "manager"}, ok()
+		}
+
+		svc := newDeletionTestService(pg, deletionRequestedBy(targetID), emptyPublisher())
+		_, err := svc.GetCompanyDeletionStatus(ctx, req)
+		assertGRPCCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("not employee", func(t *testing.T) {
+		pg := emptyPGRepo()
+		pg.getCompanyEmployee = func(_ context.Context, _ entities.GetCompanyEmployeeDTO) (*entities.Employee, Error.CodeError) {
+			return nil, notFound()
+		}
+
+		svc := newDeletionTestService(pg, deletionRequestedBy(targetID), emptyPublisher())
+		_, err := svc.GetCompanyDeletionStatus(ctx, req)
+		assertGRPCCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("company not deleted", func(t *testing.T) {
+		deletion := &mockDeletionRepo{
+			getCompanyDeletion: func(_ context.Context, _ entities.GetCompanyDeletionDTO) (*entities.CompanyDeletion, Error.CodeError) {
+				return nil, notFound()
+			},
+		}
+
+		svc := newDeletionTestService(emptyPGRepo(), deletion, emptyPublisher())
+		_, err := svc.GetCompanyDeletionStatus(ctx, req)
+		assertGRPCCode(t, err, codes.NotFound)
+	})
+}
+
+// ─── RestoreCompany ───────────────────────────────────────────────────────────
+
+func TestRestoreCompany(t *testing.T) {
+	ctx := context.Background()
+	req := &pb.RestoreCompanyRequest{CompanyUuid: companyID, InitiatorUuid: initiatorID}
+
+	t.Run("success", func(t *testing.T) {
+		restored := false
+		deletion := &mockDeletionRepo{
+			restoreCompany: func(_ context.Context, dto entities.RestoreCompanyDTO) Error.CodeError {
+				restored = dto.CompanyUUID == companyID
+				return ok()
+			},
+		}
+
+		svc := newDeletionTestService(pgRepoWithChief(), deletion, emptyPublisher())
+		_, err := svc.RestoreCompany(ctx, req)
+		assertNoError(t, err)
+		if !restored {
+			t.Error("expected company restored")
+		}
+	})
+
+	t.Run("not chief", func(t *testing.T) {
+		pg := emptyPGRepo()
+		pg.getCompanyEmployee = func(_ context.Context, _ entities.GetCompanyEmployeeDTO) (*entities.Employee, Error.CodeError) {
+			return &entities.Employee{Role: "engineer"}, ok()
+		}
+
+		svc := newDeletionTestService(pg, &mockDeletionRepo{}, emptyPublisher())
+		_, err := svc.RestoreCompany(ctx, req)
+		assertGRPCCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("purge already started", func(t *testing.T) {
+		deletion := &mockDeletionRepo{
+			restoreCompany: func(_ context.Context, _ entities.RestoreCompanyDTO) Error.CodeError {
+				return Error.Public(codes.FailedPrecondition, "company deletion already started")
+			},
+		}
+
+		svc := newDeletionTestService(pgRepoWithChief(), deletion, emptyPublisher())
+		_, err := svc.RestoreCompany(ctx, req)
+		assertGRPCCode(t, err, codes.FailedPrecondition)
+	})
+
+	t.Run("invalid company uuid", func(t *testing.T) {
+		svc := newDeletionTestService(emptyPGRepo(), &mockDeletionRepo{}, emptyPublisher())
+		_, err := svc.RestoreCompany(ctx, &pb.RestoreCompanyRequest{CompanyUuid: "bad", InitiatorUuid: initiatorID})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+}
+
+// ─── Company deletion worker ──────────────────────────────────────────────────
+
+func TestRunCompanyDeletionSteps(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("all steps from soft deleted", func(t *testing.T) {
+		var steps []string
+		var purged bool
+		db, cache := deletionWorkerRepos(&steps, &purged)
+		var archived *entities.AdvanceCompanyDeletionDTO
+		advance := db.Deletion.(*mockDeletionRepo).advanceCompanyDeletion
+		db.Deletion.(*mockDeletionRepo).advanceCompanyDeletion = func(ctx context.Context, dto entities.AdvanceCompanyDeletionDTO) Error.CodeError {
+			if dto.From == entities.DeletionStepArchivingApplications {
+				archived = &dto
+			}
+			return advance(ctx, dto)
+		}
+		archiver := &mockArchiver{archive: func(_ context.Context, in *application_proto.ArchiveCompanyApplicationsRequest) (*application_proto.ArchiveCompanyApplicationsResponse, error) {
+			return &application_proto.ArchiveCompanyApplicationsResponse{ArchiveKey: "archives/companies/" + in.GetCompanyUuid() + ".jsonl", Archived: 5}, nil
+		}}
+
+		deletion := &entities.CompanyDeletion{CompanyUUID: companyID, Step: entities.DeletionStepSoftDeleted}
+		err := runCompanyDeletionSteps(ctx, db, cache, archiver, deletion)
+		assertNoError(t, err)
+
+		expected := []string{
+			entities.DeletionStepArchivingApplications,
+			entities.DeletionStepPurgingJoinCodes,
+			entities.DeletionStepDeletingCompany,
+			entities.DeletionStepCompleted,
+		}
+		if len(steps) != len(expected) {
+			t.Fatalf("expected steps %v, got %v", expected, steps)
+		}
+		for i := range expected {
+			if steps[i] != expected[i] {
+				t.Fatalf("expected steps %v, got %v", expected, steps)
+			}
+		}
+		if deletion.Step != entities.DeletionStepCompleted || !purged {
+			t.Errorf("expected completed deletion with purged join codes, got %q / %v", deletion.Step, purged)
+		}
+		if archived == nil || archived.ArchivedApplications != 5 || archived.ArchiveKey == "" {
+			t.Errorf("expected archive saved, got %+v", archived)
+		}
+	})
+
+	t.Run("resumes from interrupted step", func(t *testing.T) {
+		var steps []string
+		var purged bool
+		db, cache := deletionWorkerRepos(&steps, &purged)
+
+		// Архивация уже выполнена до сбоя - application сервис повторно не вызывается
+		deletion := &entities.CompanyDeletion{CompanyUUID: companyID, Step: entities.DeletionStepPurgingJoinCodes}
+		err := runCompanyDeletionSteps(ctx, db, cache, &mockArchiver{}, deletion)
+		assertNoError(t, err)
+
+		if len(steps) != 2 || !purged || deletion.Step != entities.DeletionStepCompleted {
+			t.Errorf("unexpected steps %v", steps)
+		}
+	})
+
+	t.Run("archive failure stops on current step", func(t *testing.T) {
+		var steps []string
+		var purged bool
+		db, cache := deletionWorkerRepos(&steps, &purged)
+		archiver := &mockArchiver{archive: func(_ context.Context, _ *application_proto.ArchiveCompanyApplicationsRequest) (*application_proto.ArchiveCompanyApplicationsResponse, error) {
+			return nil, status.Error(codes.Unavailable, "application service unavailable")
+		}}
+
+		deletion := &entities.CompanyDeletion{CompanyUUID: companyID, Step: entities.DeletionStepArchivingApplications}
+		err := runCompanyDeletionSteps(ctx, db, cache, archiver, deletion)
+		assertGRPCCode(t, err, codes.Unavailable)
+
+		if len(steps) != 0 || purged || deletion.Step != entities.DeletionStepArchivingApplications {
+			t.Errorf("expected no progress, got steps %v", steps)
+		}
+	})
+
+	t.Run("step changed concurrently", func(t *testing.T) {
+		var steps []string
+		var purged bool
+		db, cache := deletionWorkerRepos(&steps, &purged)
+		db.Deletion.(*mockDeletionRepo).advanceCompanyDeletion = func(_ context.Context, _ entities.AdvanceCompanyDeletionDTO) Error.CodeError {
+			return Error.Public(codes.FailedPrecondition, "company deletion step changed")
+		}
+
+		deletion := &entities.CompanyDeletion{CompanyUUID: companyID, Step: entities.DeletionStepSoftDeleted}
+		err := runCompanyDeletionSteps(ctx, db, cache, &mockArchiver{}, deletion)
+		assertNoError(t, err)
+		if deletion.Step != entities.DeletionStepSoftDeleted {
+			t.Errorf("expected deletion skipped, got step %q", deletion.Step)
+		}
+	})
+}
+
+func TestRunCompanyDeletions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("failed step recorded with backoff", func(t *testing.T) {
+		var steps []string
+		var purged bool
+		db, cache := deletionWorkerRepos(&steps, &purged)
+		mock := db.Deletion.(*mockDeletionRepo)
+		mock.getDueCompanyDeletions = func(_ context.Context, _ entities.GetDueCompanyDeletionsDTO) ([]*entities.CompanyDeletion, Error.CodeError) {
+			return []*entities.CompanyDeletion{{CompanyUUID: companyID, Step: entities.DeletionStepPurgingJoinCodes, Attempts: 2}}, ok()
+		}
+		cache.Company.(*mockRedisCompanyRepo).purgeCompanyJoinCodes = func(_ context.Context, _ entities.PurgeCompanyJoinCodesDTO) Error.CodeError {
+			return internalErr()
+		}
+		var failed entities.FailCompanyDeletionStepDTO
+		mock.failCompanyDeletionStep = func(_ context.Context, dto entities.FailCompanyDeletionStepDTO) Error.CodeError {
+			failed = dto
+			return ok()
+		}
+
+		completed := runCompanyDeletions(ctx, db, cache, &mockArchiver{})
+		if completed != 0 {
+			t.Errorf("expected no completed deletions, got %d", completed)
+		}
+		// Внутренние детали ошибки не сохраняются - ход удаления видят пользователи
+		if failed.CompanyUUID != companyID || failed.Error != "internal error" {
+			t.Errorf("unexpected failure saved: %+v", failed)
+		}
+		if d := time.Until(failed.NextAttemptAt); d <= 3*companyDeletionRetryDelay || d > 4*companyDeletionRetryDelay {
+			t.Errorf("unexpected next attempt: %v", d)
+		}
+	})
+
+	t.Run("completed deletions counted", func(t *testing.T) {
+		var steps []string
+		var purged bool
+		db, cache := deletionWorkerRepos(&steps, &purged)
+		db.Deletion.(*mockDeletionRepo).getDueCompanyDeletions = func(_ context.Context, _ entities.GetDueCompanyDeletionsDTO) ([]*entities.CompanyDeletion, Error.CodeError) {
+			return []*entities.CompanyDeletion{{CompanyUUID: companyID, Step: entities.DeletionStepDeletingCompany}}, ok()
+		}
+
+		if completed := runCompanyDeletions(ctx, db, cache, &mockArchiver{}); completed != 1 {
+			t.Errorf("expected 1 completed deletion, got %d", completed)
+		}
+	})
+}
+
+func TestCompanyDeletionBackoff(t *testing.T) {
+	if d := companyDeletionBackoff(1); d != companyDeletionRetryDelay {
+		t.Errorf("expected %v for first retry, got %v", companyDeletionRetryDelay, d)
+	}
+	if d := companyDeletionBackoff(3); d != 4*companyDeletionRetryDelay {
+		t.Errorf("expected %v for third retry, got %v", 4*companyDeletionRetryDelay, d)
+	}
+	if d := companyDeletionBackoff(100); d != companyDeletionMaxDelay {
+		t.Errorf("expected backoff capped at %v, got %v", companyDeletionMaxDelay, d)
+	}
+}
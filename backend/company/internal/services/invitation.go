@@ -0,0 +1,268 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/company/generated"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/helpers"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/interceptors"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var AllInvitationStatuses = []string{"pending", "accepted", "declined", "revoked", "expired"}
+
+// CreateCompanyInvitation Создает приглашение на email с заданной ролью и департаментом и отправляет письмо адресату
+func (s *CompanyService) CreateCompanyInvitation(ctx context.Context, req *pb.CreateCompanyInvitationRequest) (*pb.CreateCompanyInvitationResponse, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+	if err := validate.Email(req.GetEmail()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid email")
+	}
+	if !helpers.Contains(AllRoles, req.GetRole()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role")
+	}
+	if req.GetDepartmentUuid() != "" {
+		if err := validate.UUID(req.GetDepartmentUuid()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid department uuid")
+		}
+	}
+	// Валидация времени жизни приглашения: мин - 60 сек / макс - 7 дней
+	ttl := req.GetTtl()
+	if ttl < 60 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid invitation ttl (min 60s)")
+	}
+	if ttl > 60*60*24*7 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid invitation ttl (max 7 days)")
+	}
+
+	if err := s.checkEmployeeRole(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), []string{"chief"}); err != nil {
+		return nil, err
+	}
+
+	// Департамент должен принадлежать компании
+	if req.GetDepartmentUuid() != "" {
+		department, getErr := s.db.Company.GetDepartment(ctx, entities.GetDepartmentDTO{DepartmentUUID: req.GetDepartmentUuid()})
+		if err := getErr.GRPCError(); err != nil {
+			return nil, err
+		}
+		if department.CompanyUUID != req.GetCompanyUuid() {
+			return nil, status.Error(codes.NotFound, "department not found")
+		}
+	}
+
+	companyInfo, getCompanyErr := s.db.Company.GetCompany(ctx, entities.GetCompanyDTO{CompanyUUID: req.GetCompanyUuid()})
+	if err := getCompanyErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	invitationUUID := uuid.Must(uuid.NewV7()).String()
+	expiresAt := time.Now().Add(time.Second * time.Duration(ttl))
+
+	if err := s.db.Invitation.CreateInvitation(ctx, entities.CreateInvitation{
+		UUID:           invitationUUID,
+		CompanyUUID:    req.GetCompanyUuid(),
+		Email:          req.GetEmail(),
+		Role:           req.GetRole(),
+		DepartmentUUID: req.GetDepartmentUuid(),
+		ExpiresAt:      expiresAt,
+		CreatedBy:      req.GetInitiatorUuid(),
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	// Приглашение уже создано и видно адресату в списке, поэтому ошибка отправки письма только логируется
+	publishCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), invitationEmailTimeout)
+	defer cancel()
+
+	if err := s.publisher.SendInvitationEmail(publishCtx, entities.InvitationEmailMsg{
+		InvitationUUID: invitationUUID,
+		Email:          req.GetEmail(),
+		CompanyTitle:   companyInfo.Title,
+		Role:           req.GetRole(),
+		ExpiresAt:      expiresAt.Unix(),
+	}); err.Code != 0 {
+		log.Error().Err(err).Time("time", time.Now()).Str("id", interceptors.OperationIDFromContext(ctx)).Str("method", "CreateCompanyInvitation").
+			Str("invitation_uuid", invitationUUID).Msg("failed to send invitation email")
+	}
+
+	return &pb.CreateCompanyInvitationResponse{InvitationUuid: invitationUUID}, nil
+}
+
+// GetCompanyInvitations Возвращает приглашения компании, с фильтром по статусу
+func (s *CompanyService) GetCompanyInvitations(ctx context.Context, req *pb.GetCompanyInvitationsRequest) (*pb.GetCompanyInvitationsResponse, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+	if req.GetStatus() != "" && !helpers.Contains(AllInvitationStatuses, req.GetStatus()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid invitation status")
+	}
+
+	if err := s.checkEmployeeRole(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), []string{"chief"}); err != nil {
+		return nil, err
+	}
+
+	invitations, getErr := s.db.Invitation.GetCompanyInvitations(ctx, entities.GetCompanyInvitationsDTO{
+		CompanyUUID: req.GetCompanyUuid(),
+		Status:      req.GetStatus(),
+	})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &pb.GetCompanyInvitationsResponse{Invitations: invitationsToPB(invitations)}, nil
+}
+
+// RevokeCompanyInvitation Отзывает ожидающее приглашение
+func (s *CompanyService) RevokeCompanyInvitation(ctx context.Context, req *pb.RevokeCompanyInvitationRequest) (*emptypb.Empty, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+	if err := validate.UUID(req.GetInvitationUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid invitation uuid")
+	}
+
+	if err := s.checkEmployeeRole(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), []string{"chief"}); err != nil {
+		return nil, err
+	}
+
+	invitation, getErr := s.db.Invitation.GetInvitation(ctx, entities.GetInvitationDTO{InvitationUUID: req.GetInvitationUuid()})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	// Приглашение другой компании для инициатора не существует
+	if invitation.CompanyUUID != req.GetCompanyUuid() {
+		return nil, status.Error(codes.NotFound, "invitation not found")
+	}
+
+	if err := s.db.Invitation.RevokeInvitation(ctx, entities.RevokeInvitationDTO{
+		InvitationUUID: req.GetInvitationUuid(),
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetUserInvitations Возвращает действующие приглашения на email пользователя во все компании
+func (s *CompanyService) GetUserInvitations(ctx context.Context, req *pb.GetUserInvitationsRequest) (*pb.GetUserInvitationsResponse, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.Email(req.GetEmail()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid email")
+	}
+
+	invitations, getErr := s.db.Invitation.GetEmailInvitations(ctx, entities.GetEmailInvitationsDTO{Email: req.GetEmail()})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &pb.GetUserInvitationsResponse{Invitations: invitationsToPB(invitations)}, nil
+}
+
+// AcceptCompanyInvitation Принимает приглашение: пользователь добавляется в компанию с ролью и департаментом из приглашения
+func (s *CompanyService) AcceptCompanyInvitation(ctx context.Context, req *pb.AcceptCompanyInvitationRequest) (*pb.AcceptCompanyInvitationResponse, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.Email(req.GetEmail()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid email")
+	}
+	if err := validate.UUID(req.GetInvitationUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid invitation uuid")
+	}
+
+	invitation, err := s.getUserInvitation(ctx, req.GetInvitationUuid(), req.GetEmail())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.db.Invitation.AcceptInvitation(ctx, entities.AcceptInvitationDTO{
+		InvitationUUID: req.GetInvitationUuid(),
+		UserUUID:       req.GetInitiatorUuid(),
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &pb.AcceptCompanyInvitationResponse{CompanyUuid: invitation.CompanyUUID, Role: invitation.Role}, nil
+}
+
+// DeclineCompanyInvitation Отказ от приглашения
+func (s *CompanyService) DeclineCompanyInvitation(ctx context.Context, req *pb.DeclineCompanyInvitationRequest) (*emptypb.Empty, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.Email(req.GetEmail()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid email")
+	}
+	if err := validate.UUID(req.GetInvitationUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid invitation uuid")
+	}
+
+	if _, err := s.getUserInvitation(ctx, req.GetInvitationUuid(), req.GetEmail()); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.Invitation.DeclineInvitation(ctx, entities.DeclineInvitationDTO{
+		InvitationUUID: req.GetInvitationUuid(),
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// getUserInvitation Возвращает приглашение, если оно адресовано на email пользователя
+func (s *CompanyService) getUserInvitation(ctx context.Context, invitationUUID, email string) (*entities.Invitation, error) {
+	invitation, getErr := s.db.Invitation.GetInvitation(ctx, entities.GetInvitationDTO{InvitationUUID: invitationUUID})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	// Чужое приглашение для пользователя не существует
+	if !strings.EqualFold(invitation.Email, email) {
+		return nil, status.Error(codes.NotFound, "invitation not found")
+	}
+	return invitation, nil
+}
+
+// invitationsToPB Преобразование приглашений в proto сообщения
+func invitationsToPB(invitations []*entities.Invitation) []*pb.Invitation {
+	res := make([]*pb.Invitation, 0, len(invitations))
+	for _, invitation := range invitations {
+		res = append(res, &pb.Invitation{
+			InvitationUuid: invitation.UUID,
+			CompanyUuid:    invitation.CompanyUUID,
+			CompanyTitle:   invitation.CompanyTitle,
+			Email:          invitation.Email,
+			Role:           invitation.Role,
+			DepartmentUuid: invitation.DepartmentUUID,
+			Status:         invitation.Status,
+			ExpiresAt:      invitation.ExpiresAt,
+			CreatedAt:      invitation.CreatedAt,
+			CreatedBy:      invitation.CreatedBy,
+			DecidedAt:      invitation.DecidedAt,
+			AcceptedBy:     invitation.AcceptedBy,
+		})
+	}
+	return res
+}
@@ -0,0 +1,293 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/company/generated"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	invitationID    = "55555555-5555-5555-5555-555555555555"
+	invitationEmail = "ivan@example.com"
+)
+
+func invitationEntity(companyUUID string) *entities.Invitation {
+	return &entities.Invitation{
+		UUID: invitationID, CompanyUUID: companyUUID, CompanyTitle: "Test Co", Email: invitationEmail,
+		Role: "engineer", DepartmentUUID: deptID, Status: "pending",
+	}
+}
+
+// ─── CreateCompanyInvitation ──────────────────────────────────────────────────
+
+func TestCreateCompanyInvitation(t *testing.T) {
+	ctx := context.Background()
+	validReq := &pb.CreateCompanyInvitationRequest{
+		InitiatorUuid: initiatorID, CompanyUuid: companyID, Email: invitationEmail,
+		Role: "engineer", DepartmentUuid: deptID, Ttl: 3600,
+	}
+
+	t.Run("success — invitation saved and email sent", func(t *testing.T) {
+		var saved entities.CreateInvitation
+		inv := &mockInvitationRepo{
+			createInvitation: func(_ context.Context, dto entities.CreateInvitation) Error.CodeError {
+				saved = dto
+				return ok()
+			},
+		}
+		var sent []entities.InvitationEmailMsg
+		publisher := emptyPublisher()
+		publisher.sendInvitationEmail = func(_ context.Context, dto entities.InvitationEmailMsg) Error.CodeError {
+			sent = append(sent, dto)
+			return ok()
+		}
+
+		svc := newInvitationTestService(pgRepoWithChiefAndDept(), inv, publisher)
+		res, err := svc.CreateCompanyInvitation(ctx, validReq)
+		assertNoError(t, err)
+
+		if res.GetInvitationUuid() == "" || saved.UUID != res.GetInvitationUuid() {
+			t.Errorf("expected saved invitation uuid %q, got %q", res.GetInvitationUuid(), saved.UUID)
+		}
+		if saved.Role != "engineer" || saved.DepartmentUUID != deptID || saved.CreatedBy != initiatorID {
+			t.Errorf("unexpected invitation saved: %+v", saved)
+		}
+		if d := time.Until(saved.ExpiresAt); d <= 0 || d > time.Hour {
+			t.Errorf("expected invitation to expire in an hour, got %v", d)
+		}
+		if len(sent) != 1 || sent[0].Email != invitationEmail || sent[0].CompanyTitle != "Test Co" || sent[0].InvitationUUID != res.GetInvitationUuid() {
+			t.Errorf("unexpected invitation emails: %+v", sent)
+		}
+	})
+
+	t.Run("email send error does not fail request", func(t *testing.T) {
+		inv := &mockInvitationRepo{
+			createInvitation: func(_ context.Context, _ entities.CreateInvitation) Error.CodeError { return ok() },
+		}
+		publisher := emptyPublisher()
+		publisher.sendInvitationEmail = func(_ context.Context, _ entities.InvitationEmailMsg) Error.CodeError { return internalErr() }
+
+		svc := newInvitationTestService(pgRepoWithChiefAndDept(), inv, publisher)
+		_, err := svc.CreateCompanyInvitation(ctx, validReq)
+		assertNoError(t, err)
+	})
+
+	t.Run("invalid email", func(t *testing.T) {
+		svc := newInvitationTestService(emptyPGRepo(), &mockInvitationRepo{}, emptyPublisher())
+		_, err := svc.CreateCompanyInvitation(ctx, &pb.CreateCompanyInvitationRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, Email: "not-an-email", Role: "engineer", Ttl: 3600,
+		})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("invalid role", func(t *testing.T) {
+		svc := newInvitationTestService(emptyPGRepo(), &mockInvitationRepo{}, emptyPublisher())
+		_, err := svc.CreateCompanyInvitation(ctx, &pb.CreateCompanyInvitationRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, Email: invitationEmail, Role: "director", Ttl: 3600,
+		})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("ttl too short", func(t *testing.T) {
+		svc := newInvitationTestService(emptyPGRepo(), &mockInvitationRepo{}, emptyPublisher())
+		_, err := svc.CreateCompanyInvitation(ctx, &pb.CreateCompanyInvitationRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, Email: invitationEmail, Role: "engineer", Ttl: 59,
+		})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("department of another company", func(t *testing.T) {
+		pg := pgRepoWithChief()
+		pg.getDepartment = func(_ context.Context, _ entities.GetDepartmentDTO) (*entities.Department, Error.CodeError) {
+			return &entities.Department{UUID: deptID, CompanyUUID: otherCompanyID}, ok()
+		}
+
+		svc := newInvitationTestService(pg, &mockInvitationRepo{}, emptyPublisher())
+		_, err := svc.CreateCompanyInvitation(ctx, validReq)
+		assertGRPCCode(t, err, codes.NotFound)
+	})
+
+	t.Run("already pending", func(t *testing.T) {
+		inv := &mockInvitationRepo{
+			createInvitation: func(_ context.Context, _ entities.CreateInvitation) Error.CodeError {
+				return Error.Public(codes.AlreadyExists, "invitation for this email already pending")
+			},
+		}
+
+		svc := newInvitationTestService(pgRepoWithChiefAndDept(), inv, emptyPublisher())
+		_, err := svc.CreateCompanyInvitation(ctx, validReq)
+		assertGRPCCode(t, err, codes.AlreadyExists)
+	})
+}
+
+// ─── GetCompanyInvitations / RevokeCompanyInvitation ──────────────────────────
+
+func TestCompanyInvitations(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("list with status filter", func(t *testing.T) {
+		inv := &mockInvitationRepo{
+			getCompanyInvitations: func(_ context.Context, dto entities.GetCompanyInvitationsDTO) ([]*entities.Invitation, Error.CodeError) {
+				if dto.Status != "expired" {
+					t.Errorf("expected status filter %q, got %q", "expired", dto.Status)
+				}
+				return []*entities.Invitation{invitationEntity(companyID)}, ok()
+			},
+		}
+
+		svc := newInvitationTestService(pgRepoWithChief(), inv, emptyPublisher())
+		res, err := svc.GetCompanyInvitations(ctx, &pb.GetCompanyInvitationsRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, Status: "expired",
+		})
+		assertNoError(t, err)
+		if len(res.GetInvitations()) != 1 || res.GetInvitations()[0].GetEmail() != invitationEmail {
+			t.Errorf("unexpected invitations: %v", res.GetInvitations())
+		}
+	})
+
+	t.Run("list invalid status", func(t *testing.T) {
+		svc := newInvitationTestService(emptyPGRepo(), &mockInvitationRepo{}, emptyPublisher())
+		_, err := svc.GetCompanyInvitations(ctx, &pb.GetCompanyInvitationsRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, Status: "sent",
+		})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("revoke invitation of another company", func(t *testing.T) {
+		inv := &mockInvitationRepo{
+			getInvitation: func(_ context.Context, _ entities.GetInvitationDTO) (*entities.Invitation, Error.CodeError) {
+				return invitationEntity(otherCompanyID), ok()
+			},
+		}
+
+		svc := newInvitationTestService(pgRepoWithChief(), inv, emptyPublisher())
+		_, err := svc.RevokeCompanyInvitation(ctx, &pb.RevokeCompanyInvitationRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, InvitationUuid: invitationID,
+		})
+		assertGRPCCode(t, err, codes.NotFound)
+	})
+
+	t.Run("revoke success", func(t *testing.T) {
+		revoked := false
+		inv := &mockInvitationRepo{
+			getInvitation: func(_ context.Context, _ entities.GetInvitationDTO) (*entities.Invitation, Error.CodeError) {
+				return invitationEntity(companyID), ok()
+			},
+			revokeInvitation: func(_ context.Context, _ entities.RevokeInvitationDTO) Error.CodeError {
+				revoked = true
+				return ok()
+			},
+		}
+
+		svc := newInvitationTestService(pgRepoWithChief(), inv, emptyPublisher())
+		_, err := svc.RevokeCompanyInvitation(ctx, &pb.RevokeCompanyInvitationRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, InvitationUuid: invitationID,
+		})
+		assertNoError(t, err)
+		if !revoked {
+			t.Error("expected invitation to be revoked")
+		}
+	})
+}
+
+// ─── GetUserInvitations / AcceptCompanyInvitation / DeclineCompanyInvitation ──
+
+func TestUserInvitations(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("list by email", func(t *testing.T) {
+		inv := &mockInvitationRepo{
+			getEmailInvitations: func(_ context.Context, dto entities.GetEmailInvitationsDTO) ([]*entities.Invitation, Error.CodeError) {
+				if dto.Email != invitationEmail {
+					t.Errorf("expected email %q, got %q", invitationEmail, dto.Email)
+				}
+				return []*entities.Invitation{invitationEntity(companyID)}, ok()
+			},
+		}
+
+		svc := newInvitationTestService(emptyPGRepo(), inv, emptyPublisher())
+		res, err := svc.GetUserInvitations(ctx, &pb.GetUserInvitationsRequest{InitiatorUuid: targetID, Email: invitationEmail})
+		assertNoError(t, err)
+		if len(res.GetInvitations()) != 1 || res.GetInvitations()[0].GetCompanyTitle() != "Test Co" {
+			t.Errorf("unexpected invitations: %v", res.GetInvitations())
+		}
+	})
+
+	t.Run("accept success — email compared case-insensitively", func(t *testing.T) {
+		var accepted entities.AcceptInvitationDTO
+		inv := &mockInvitationRepo{
+			getInvitation: func(_ context.Context, _ entities.GetInvitationDTO) (*entities.Invitation, Error.CodeError) {
+				return invitationEntity(companyID), ok()
+			},
+			acceptInvitation: func(_ context.Context, dto entities.AcceptInvitationDTO) Error.CodeError {
+				accepted = dto
+				return ok()
+			},
+		}
+
+		svc := newInvitationTestService(emptyPGRepo(), inv, emptyPublisher())
+		res, err := svc.AcceptCompanyInvitation(ctx, &pb.AcceptCompanyInvitationRequest{
+			InitiatorUuid: targetID, Email: "Ivan@Example.com", InvitationUuid: invitationID,
+		})
+		assertNoError(t, err)
+		if res.GetCompanyUuid() != companyID || res.GetRole() != "engineer" {
+			t.Errorf("unexpected response: %v", res)
+		}
+		if accepted.InvitationUUID != invitationID || accepted.UserUUID != targetID {
+			t.Errorf("unexpected acceptance: %+v", accepted)
+		}
+	})
+
+	t.Run("accept invitation addressed to another email", func(t *testing.T) {
+		inv := &mockInvitationRepo{
+			getInvitation: func(_ context.Context, _ entities.GetInvitationDTO) (*entities.Invitation, Error.CodeError) {
+				return invitationEntity(companyID), ok()
+			},
+		}
+
+		svc := newInvitationTestService(emptyPGRepo(), inv, emptyPublisher())
+		_, err := svc.AcceptCompanyInvitation(ctx, &pb.AcceptCompanyInvitationRequest{
+			InitiatorUuid: targetID, Email: "petr@example.com", InvitationUuid: invitationID,
+		})
+		assertGRPCCode(t, err, codes.NotFound)
+	})
+
+	t.Run("accept user already in company", func(t *testing.T) {
+		inv := &mockInvitationRepo{
+			getInvitation: func(_ context.Context, _ entities.GetInvitationDTO) (*entities.Invitation, Error.CodeError) {
+				return invitationEntity(companyID), ok()
+			},
+			acceptInvitation: func(_ context.Context, _ entities.AcceptInvitationDTO) Error.CodeError {
+				return Error.Public(codes.AlreadyExists, "user already in company")
+			},
+		}
+
+		svc := newInvitationTestService(emptyPGRepo(), inv, emptyPublisher())
+		_, err := svc.AcceptCompanyInvitation(ctx, &pb.AcceptCompanyInvitationRequest{
+			InitiatorUuid: targetID, Email: invitationEmail, InvitationUuid: invitationID,
+		})
+		assertGRPCCode(t, err, codes.AlreadyExists)
+	})
+
+	t.Run("decline not pending", func(t *testing.T) {
+		inv := &mockInvitationRepo{
+			getInvitation: func(_ context.Context, _ entities.GetInvitationDTO) (*entities.Invitation, Error.CodeError) {
+				return invitationEntity(companyID), ok()
+			},
+			declineInvitation: func(_ context.Context, _ entities.DeclineInvitationDTO) Error.CodeError {
+				return Error.Public(codes.FailedPrecondition, "invitation is not pending")
+			},
+		}
+
+		svc := newInvitationTestService(emptyPGRepo(), inv, emptyPublisher())
+		_, err := svc.DeclineCompanyInvitation(ctx, &pb.DeclineCompanyInvitationRequest{
+			InitiatorUuid: targetID, Email: invitationEmail, InvitationUuid: invitationID,
+		})
+		assertGRPCCode(t, err, codes.FailedPrecondition)
+	})
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/company/generated"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/helpers"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var AllJoinRequestStatuses = []string{"pending", "accepted", "declined"}
+
+// GetCompanyJoinRequests Возвращает заявки на вступление в компанию, с фильтром по статусу
+func (s *CompanyService) GetCompanyJoinRequests(ctx context.Context, req *pb.GetCompanyJoinRequestsRequest) (*pb.GetCompanyJoinRequestsResponse, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+	if req.GetStatus() != "" && !helpers.Contains(AllJoinRequestStatuses, req.GetStatus()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid join request status")
+	}
+
+	if err := s.checkEmployeeRole(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), []string{"chief"}); err != nil {
+		return nil, err
+	}
+
+	joinRequests, getErr := s.db.JoinRequest.GetCompanyJoinRequests(ctx, entities.GetCompanyJoinRequestsDTO{
+		CompanyUUID: req.GetCompanyUuid(),
+		Status:      req.GetStatus(),
+	})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.JoinRequest, 0, len(joinRequests))
+	for _, joinRequest := range joinRequests {
+		res = append(res, joinRequestToPB(joinRequest))
+	}
+
+	return &pb.GetCompanyJoinRequestsResponse{JoinRequests: res}, nil
+}
+
+// AcceptCompanyJoinRequest Одобряет заявку: пользователь добавляется в компанию без роли
+func (s *CompanyService) AcceptCompanyJoinRequest(ctx context.Context, req *pb.AcceptCompanyJoinRequestRequest) (*emptypb.Empty, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+	if err := validate.UUID(req.GetJoinRequestUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid join request uuid")
+	}
+
+	if err := s.checkJoinRequestAccess(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), req.GetJoinRequestUuid()); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.JoinRequest.AcceptJoinRequest(ctx, entities.DecideJoinRequestDTO{
+		JoinRequestUUID: req.GetJoinRequestUuid(),
+		DecidedBy:       req.GetInitiatorUuid(),
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// DeclineCompanyJoinRequest Отклоняет заявку на вступление. Использование кода при этом не возвращается
+func (s *CompanyService) DeclineCompanyJoinRequest(ctx context.Context, req *pb.DeclineCompanyJoinRequestRequest) (*emptypb.Empty, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+	if err := validate.UUID(req.GetJoinRequestUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid join request uuid")
+	}
+
+	if err := s.checkJoinRequestAccess(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), req.GetJoinRequestUuid()); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.JoinRequest.DeclineJoinRequest(ctx, entities.DecideJoinRequestDTO{
+		JoinRequestUUID: req.GetJoinRequestUuid(),
+		DecidedBy:       req.GetInitiatorUuid(),
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// checkJoinRequestAccess Проверяет, что инициатор - chief компании, а заявка подана в эту компанию
+func (s *CompanyService) checkJoinRequestAccess(ctx context.Context, companyUUID, initiatorUUID, joinRequestUUID string) error {
+	if err := s.checkEmployeeRole(ctx, companyUUID, initiatorUUID, []string{"chief"}); err != nil {
+		return err
+	}
+
+	joinRequest, getErr := s.db.JoinRequest.GetJoinRequest(ctx, entities.GetJoinRequestDTO{JoinRequestUUID: joinRequestUUID})
+	if err := getErr.GRPCError(); err != nil {
+		return err
+	}
+
+	// Заявка в другую компанию для инициатора не существует
+	if joinRequest.CompanyUUID != companyUUID {
+		return status.Error(codes.NotFound, "join request not found")
+	}
+	return nil
+}
+
+// joinRequestToPB Преобразование заявки на вступление в proto сообщение
+func joinRequestToPB(joinRequest *entities.JoinRequest) *pb.JoinRequest {
+	return &pb.JoinRequest{
+		JoinRequestUuid: joinRequest.UUID,
+		UserUuid:        joinRequest.UserUUID,
+		JoinCode:        joinRequest.JoinCode,
+		Status:          joinRequest.Status,
+		CreatedAt:       joinRequest.CreatedAt,
+		DecidedAt:       joinRequest.DecidedAt,
+		DecidedBy:       joinRequest.DecidedBy,
+	}
+}
@@ -0,0 +1,269 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/company/generated"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	joinRequestID  = "44444444-4444-4444-4444-444444444444"
+	otherCompanyID = "eeeeeeee-eeee-eeee-eeee-eeeeeeeeeeee"
+)
+
+func joinRequestEntity(companyUUID string) *entities.JoinRequest {
+	return &entities.JoinRequest{UUID: joinRequestID, CompanyUUID: companyUUID, UserUUID: targetID, JoinCode: testCode, Status: "pending"}
+}
+
+// ─── UpdateCompanyJoinSettings ────────────────────────────────────────────────
+
+func TestUpdateCompanyJoinSettings(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		var saved entities.UpdateCompanyJoinSettingsDTO
+		pg := pgRepoWithChief()
+		pg.updateCompanyJoinSettings = func(_ context.Context, dto entities.UpdateCompanyJoinSettingsDTO) Error.CodeError {
+			saved = dto
+			return ok()
+		}
+
+		svc := newTestService(pg, emptyRedisRepo())
+		_, err := svc.UpdateCompanyJoinSettings(ctx, &pb.UpdateCompanyJoinSettingsRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, JoinApprovalRequired: true,
+		})
+		assertNoError(t, err)
+		if saved.CompanyUUID != companyID || !saved.JoinApprovalRequired {
+			t.Errorf("unexpected settings saved: %+v", saved)
+		}
+	})
+
+	t.Run("not chief", func(t *testing.T) {
+		pg := pgRepoWithChief()
+		pg.getCompanyEmployee = func(_ context.Context, _ entities.GetCompanyEmployeeDTO) (*entities.Employee, Error.CodeError) {
+			return &entities.Employee{Role: "engineer"}, ok()
+		}
+
+		svc := newTestService(pg, emptyRedisRepo())
+		_, err := svc.UpdateCompanyJoinSettings(ctx, &pb.UpdateCompanyJoinSettingsRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, JoinApprovalRequired: true,
+		})
+		assertGRPCCode(t, err, codes.PermissionDenied)
+	})
+}
+
+// ─── JoinCompany (с одобрением) ───────────────────────────────────────────────
+
+func TestJoinCompanyWithApproval(t *testing.T) {
+	ctx := context.Background()
+	req := &pb.JoinCompanyRequest{JoinCode: testCode, InitiatorUuid: targetID}
+
+	approvalPG := func() *mockPGCompanyRepo {
+		pg := emptyPGRepo()
+		pg.getCompanyEmployee = func(_ context.Context, _ entities.GetCompanyEmployeeDTO) (*entities.Employee, Error.CodeError) {
+			return nil, notFound()
+		}
+		pg.getCompany = func(_ context.Context, _ entities.GetCompanyDTO) (*entities.Company, Error.CodeError) {
+			return &entities.Company{Status: "open", JoinApprovalRequired: true}, ok()
+		}
+		pg.joinCompany = func(_ context.Context, _ entities.JoinCompanyDTO) Error.CodeError {
+			t.Error("user must not be added before approval")
+			return ok()
+		}
+		return pg
+	}
+	joinRdb := func() *mockRedisCompanyRepo {
+		rdb := emptyRedisRepo()
+		rdb.checkJoinCodeExists = func(_ context.Context, _ entities.CheckJoinCodeExistsDTO) Error.CodeError { return ok() }
+		rdb.getCompanyByJoinCode = func(_ context.Context, _ entities.GetCompanyByJoinCodeDTO) (string, Error.CodeError) {
+			return companyID, ok()
+		}
+		return rdb
+	}
+
+	t.Run("creates pending join request", func(t *testing.T) {
+		var saved entities.CreateJoinRequestDTO
+		jr := &mockJoinRequestRepo{
+			createJoinRequest: func(_ context.Context, dto entities.CreateJoinRequestDTO) Error.CodeError {
+				saved = dto
+				return ok()
+			},
+		}
+
+		svc := newJoinRequestTestService(approvalPG(), joinRdb(), jr)
+		res, err := svc.JoinCompany(ctx, req)
+		assertNoError(t, err)
+		if res.GetStatus() != JoinStatusPending {
+			t.Errorf("expected status %q, got %q", JoinStatusPending, res.GetStatus())
+		}
+		if res.GetRole() != "" {
+			t.Errorf("expected empty role while pending, got %q", res.GetRole())
+		}
+		if res.GetJoinRequestUuid() == "" || saved.UUID != res.GetJoinRequestUuid() {
+			t.Errorf("expected saved join request uuid %q, got %q", res.GetJoinRequestUuid(), saved.UUID)
+		}
+		if saved.CompanyUUID != companyID || saved.UserUUID != targetID || saved.JoinCode != testCode {
+			t.Errorf("unexpected join request saved: %+v", saved)
+		}
+	})
+
+	t.Run("already pending releases code use", func(t *testing.T) {
+		released := false
+		rdb := joinRdb()
+		rdb.releaseJoinCode = func(_ context.Context, _ entities.ReleaseJoinCodeDTO) Error.CodeError {
+			released = true
+			return ok()
+		}
+		jr := &mockJoinRequestRepo{
+			createJoinRequest: func(_ context.Context, _ entities.CreateJoinRequestDTO) Error.CodeError {
+				return Error.Public(codes.AlreadyExists, "join request already pending")
+			},
+		}
+
+		svc := newJoinRequestTestService(approvalPG(), rdb, jr)
+		_, err := svc.JoinCompany(ctx, req)
+		assertGRPCCode(t, err, codes.AlreadyExists)
+		if !released {
+			t.Error("expected join code use to be released")
+		}
+	})
+}
+
+// ─── GetCompanyJoinRequests ───────────────────────────────────────────────────
+
+func TestGetCompanyJoinRequests(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success with status filter", func(t *testing.T) {
+		jr := &mockJoinRequestRepo{
+			getCompanyJoinRequests: func(_ context.Context, dto entities.GetCompanyJoinRequestsDTO) ([]*entities.JoinRequest, Error.CodeError) {
+				if dto.Status != "pending" {
+					t.Errorf("expected status filter %q, got %q", "pending", dto.Status)
+				}
+				return []*entities.JoinRequest{joinRequestEntity(companyID)}, ok()
+			},
+		}
+
+		svc := newJoinRequestTestService(pgRepoWithChief(), emptyRedisRepo(), jr)
+		res, err := svc.GetCompanyJoinRequests(ctx, &pb.GetCompanyJoinRequestsRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, Status: "pending",
+		})
+		assertNoError(t, err)
+		if len(res.GetJoinRequests()) != 1 || res.GetJoinRequests()[0].GetUserUuid() != targetID {
+			t.Errorf("unexpected join requests: %v", res.GetJoinRequests())
+		}
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		svc := newJoinRequestTestService(emptyPGRepo(), emptyRedisRepo(), &mockJoinRequestRepo{})
+		_, err := svc.GetCompanyJoinRequests(ctx, &pb.GetCompanyJoinRequestsRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, Status: "approved",
+		})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+}
+
+// ─── AcceptCompanyJoinRequest / DeclineCompanyJoinRequest ─────────────────────
+
+func TestDecideCompanyJoinRequest(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("accept success", func(t *testing.T) {
+		var decided entities.DecideJoinRequestDTO
+		jr := &mockJoinRequestRepo{
+			getJoinRequest: func(_ context.Context, _ entities.GetJoinRequestDTO) (*entities.JoinRequest, Error.CodeError) {
+				return joinRequestEntity(companyID), ok()
+			},
+			acceptJoinRequest: func(_ context.Context, dto entities.DecideJoinRequestDTO) Error.CodeError {
+				decided = dto
+				return ok()
+			},
+		}
+
+		svc := newJoinRequestTestService(pgRepoWithChief(), emptyRedisRepo(), jr)
+		_, err := svc.AcceptCompanyJoinRequest(ctx, &pb.AcceptCompanyJoinRequestRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, JoinRequestUuid: joinRequestID,
+		})
+		assertNoError(t, err)
+		if decided.JoinRequestUUID != joinRequestID || decided.DecidedBy != initiatorID {
+			t.Errorf("unexpected decision: %+v", decided)
+		}
+	})
+
+	t.Run("accept request of another company", func(t *testing.T) {
+		jr := &mockJoinRequestRepo{
+			getJoinRequest: func(_ context.Context, _ entities.GetJoinRequestDTO) (*entities.JoinRequest, Error.CodeError) {
+				return joinRequestEntity(otherCompanyID), ok()
+			},
+		}
+
+		svc := newJoinRequestTestService(pgRepoWithChief(), emptyRedisRepo(), jr)
+		_, err := svc.AcceptCompanyJoinRequest(ctx, &pb.AcceptCompanyJoinRequestRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, JoinRequestUuid: joinRequestID,
+		})
+		assertGRPCCode(t, err, codes.NotFound)
+	})
+
+	t.Run("decline already decided", func(t *testing.T) {
+		jr := &mockJoinRequestRepo{
+			getJoinRequest: func(_ context.Context, _ entities.GetJoinRequestDTO) (*entities.JoinRequest, Error.CodeError) {
+				return joinRequestEntity(companyID), ok()
+			},
+			declineJoinRequest: func(_ context.Context, _ entities.DecideJoinRequestDTO) Error.CodeError {
+				return Error.Public(codes.FailedPrecondition, "join request is not pending")
+			},
+		}
+
+		svc := newJoinRequestTestService(pgRepoWithChief(), emptyRedisRepo(), jr)
+		_, err := svc.DeclineCompanyJoinRequest(ctx, &pb.DeclineCompanyJoinRequestRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, JoinRequestUuid: joinRequestID,
+		})
+		assertGRPCCode(t, err, codes.FailedPrecondition)
+	})
+
+	t.Run("invalid join request uuid", func(t *testing.T) {
+		svc := newJoinRequestTestService(emptyPGRepo(), emptyRedisRepo(), &mockJoinRequestRepo{})
+		_, err := svc.DeclineCompanyJoinRequest(ctx, &pb.DeclineCompanyJoinRequestRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, JoinRequestUuid: "not-a-uuid",
+		})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+}
+
+// ─── GetJoinCodeRedemptions ───────────────────────────────────────────────────
+
+func TestGetJoinCodeRedemptions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		jr := &mockJoinRequestRepo{
+			getJoinCodeRedemptions: func(_ context.Context, dto entities.GetJoinCodeRedemptionsDTO) ([]*entities.JoinCodeRedemption, Error.CodeError) {
+				if dto.CompanyUUID != companyID || dto.Code != testCode {
+					t.Errorf("unexpected dto: %+v", dto)
+				}
+				return []*entities.JoinCodeRedemption{{UserUUID: targetID, RedeemedAt: "2026-01-01 10:00:00+00"}}, ok()
+			},
+		}
+
+		svc := newJoinRequestTestService(pgRepoWithChief(), emptyRedisRepo(), jr)
+		res, err := svc.GetJoinCodeRedemptions(ctx, &pb.GetJoinCodeRedemptionsRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, Code: testCode,
+		})
+		assertNoError(t, err)
+		if len(res.GetRedemptions()) != 1 || res.GetRedemptions()[0].GetUserUuid() != targetID {
+			t.Errorf("unexpected redemptions: %v", res.GetRedemptions())
+		}
+	})
+
+	t.Run("invalid code", func(t *testing.T) {
+		svc := newJoinRequestTestService(emptyPGRepo(), emptyRedisRepo(), &mockJoinRequestRepo{})
+		_, err := svc.GetJoinCodeRedemptions(ctx, &pb.GetJoinCodeRedemptionsRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, Code: "abc",
+		})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+}
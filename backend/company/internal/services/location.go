@@ -0,0 +1,238 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/company/generated"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// locationParentKinds Уровень родителя для каждого уровня иерархии мест. Объект (site) - корень иерархии
+var locationParentKinds = map[string]string{
+	"building": "site",
+	"floor":    "building",
+	"zone":     "floor",
+}
+
+// CreateLocation Создание места дефектов: объекта, здания, этажа или зоны
+func (s *CompanyService) CreateLocation(ctx context.Context, req *pb.CreateLocationRequest) (*pb.CreateLocationResponse, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+	if err := validate.LocationKind(req.GetKind()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validate.LocationTitle(req.GetTitle()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid location title")
+	}
+	if err := validate.Coordinates(req.Latitude, req.Longitude); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	parentKind, nested := locationParentKinds[req.GetKind()]
+	if !nested && req.GetParentUuid() != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "site can not have parent location")
+	}
+	if nested {
+		if err := validate.UUID(req.GetParentUuid()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent uuid")
+		}
+	}
+
+	if err := s.checkEmployeeRole(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), []string{"chief"}); err != nil {
+		return nil, err
+	}
+
+	if nested {
+		parent, getErr := s.db.Location.GetLocation(ctx, entities.GetLocationDTO{LocationUUID: req.GetParentUuid()})
+		if err := getErr.GRPCError(); err != nil {
+			return nil, err
+		}
+
+		// Место другой компании для инициатора не существует
+		if parent.CompanyUUID != req.GetCompanyUuid() {
+			return nil, status.Error(codes.NotFound, "parent location not found")
+		}
+		if parent.Kind != parentKind {
+			return nil, status.Errorf(codes.InvalidArgument, "%s must be placed in %s", req.GetKind(), parentKind)
+		}
+	}
+
+	locationUUID := uuid.Must(uuid.NewV7()).String()
+
+	if err := s.db.Location.CreateLocation(ctx, entities.CreateLocation{
+		UUID:        locationUUID,
+		CompanyUUID: req.GetCompanyUuid(),
+		ParentUUID:  req.GetParentUuid(),
+		Kind:        req.GetKind(),
+		Title:       req.GetTitle(),
+		Latitude:    req.Latitude,
+		Longitude:   req.Longitude,
+		CreatedBy:   req.GetInitiatorUuid(),
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateLocationResponse{LocationUuid: locationUUID}, nil
+}
+
+// GetLocation Получение места вместе с цепочкой вышестоящих мест
+func (s *CompanyService) GetLocation(ctx context.Context, req *pb.GetLocationRequest) (*pb.GetLocationResponse, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetLocationUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid location uuid")
+	}
+
+	path, getErr := s.db.Location.GetLocationPath(ctx, entities.GetLocationPathDTO{LocationUUID: req.GetLocationUuid()})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+	location := path[len(path)-1]
+
+	if err := s.checkEmployeeRole(ctx, location.CompanyUUID, req.GetInitiatorUuid(), AllRoles); err != nil {
+		return nil, err
+	}
+
+	pbPath := make([]*pb.Location, 0, len(path))
+	for _, item := range path {
+		pbPath = append(pbPath, locationToPB(item))
+	}
+
+	return &pb.GetLocationResponse{
+		Location:    locationToPB(location),
+		CompanyUuid: location.CompanyUUID,
+		CreatedAt:   location.CreatedAt,
+		CreatedBy:   location.CreatedBy,
+		Path:        pbPath,
+	}, nil
+}
+
+// GetCompanyLocations Получение списка мест компании с фильтрацией по родителю и уровню (offset, count)
+func (s *CompanyService) GetCompanyLocations(ctx context.Context, req *pb.GetCompanyLocationsRequest) (*pb.GetCompanyLocationsResponse, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+	if err := validate.UUID(req.GetParentUuid()); err != nil && req.GetParentUuid() != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent uuid")
+	}
+	if err := validate.LocationKind(req.GetKind()); err != nil && req.GetKind() != "" {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.GetOffset() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid offset")
+	}
+	if req.GetCount() <= 0 || req.GetCount() > 100 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid count (1..100)")
+	}
+
+	if err := s.checkEmployeeRole(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), AllRoles); err != nil {
+		return nil, err
+	}
+
+	locations, getErr := s.db.Location.GetCompanyLocations(ctx, entities.GetCompanyLocationsDTO{
+		CompanyUUID: req.GetCompanyUuid(),
+		ParentUUID:  req.GetParentUuid(),
+		Kind:        req.GetKind(),
+		Offset:      req.GetOffset(),
+		Count:       req.GetCount(),
+	})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.Location, 0, len(locations))
+	for _, location := range locations {
+		res = append(res, locationToPB(location))
+	}
+
+	return &pb.GetCompanyLocationsResponse{Locations: res}, nil
+}
+
+// UpdateLocation Обновление названия и координат места. Уровень и родитель места не меняются:
+// цепочка мест уже сохранена в заявках
+func (s *CompanyService) UpdateLocation(ctx context.Context, req *pb.UpdateLocationRequest) (*emptypb.Empty, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetLocationUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid location uuid")
+	}
+	if err := validate.LocationTitle(req.GetTitle()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid location title")
+	}
+	if err := validate.Coordinates(req.Latitude, req.Longitude); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	location, getErr := s.db.Location.GetLocation(ctx, entities.GetLocationDTO{LocationUUID: req.GetLocationUuid()})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkEmployeeRole(ctx, location.CompanyUUID, req.GetInitiatorUuid(), []string{"chief"}); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.Location.UpdateLocation(ctx, entities.UpdateLocation{
+		UUID:      req.GetLocationUuid(),
+		Title:     req.GetTitle(),
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteLocation Удаление места без вложенных мест. Заявки сохраняют ссылку на удалённое место
+func (s *CompanyService) DeleteLocation(ctx context.Context, req *pb.DeleteLocationRequest) (*emptypb.Empty, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetLocationUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid location uuid")
+	}
+
+	location, getErr := s.db.Location.GetLocation(ctx, entities.GetLocationDTO{LocationUUID: req.GetLocationUuid()})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkEmployeeRole(ctx, location.CompanyUUID, req.GetInitiatorUuid(), []string{"chief"}); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.Location.DeleteLocation(ctx, entities.DeleteLocationDTO{
+		LocationUUID: req.GetLocationUuid(),
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// locationToPB Преобразование места в proto сообщение
+func locationToPB(location *entities.Location) *pb.Location {
+	return &pb.Location{
+		LocationUuid: location.UUID,
+		ParentUuid:   location.ParentUUID,
+		Kind:         location.Kind,
+		Title:        location.Title,
+		Latitude:     location.Latitude,
+		Longitude:    location.Longitude,
+	}
+}
@@ -0,0 +1,358 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/company/generated"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	siteID     = "11111111-1111-1111-1111-111111111111"
+	buildingID = "22222222-2222-2222-2222-222222222222"
+	floorID    = "33333333-3333-3333-3333-333333333333"
+)
+
+func locationEntity(id, parentID, kind string) *entities.Location {
+	return &entities.Location{UUID: id, CompanyUUID: companyID, ParentUUID: parentID, Kind: kind, Title: "Test " + kind}
+}
+
+func float64Ptr(v float64) *float64 { return &v }
+
+// ─── CreateLocation ───────────────────────────────────────────────────────────
+
+func TestCreateLocation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success — site with coordinates", func(t *testing.T) {
+		var saved entities.CreateLocation
+		loc := &mockLocationRepo{
+			createLocation: func(_ context.Context, dto entities.CreateLocation) Error.CodeError {
+				saved = dto
+				return ok()
+			},
+		}
+
+		svc := newLocationTestService(pgRepoWithChief(), loc)
+		res, err := svc.CreateLocation(ctx, &pb.CreateLocationRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, Kind: "site", Title: "ЖК Северный",
+			Latitude: float64Ptr(55.75), Longitude: float64Ptr(37.62),
+		})
+		assertNoError(t, err)
+		if res.GetLocationUuid() == "" || saved.UUID != res.GetLocationUuid() {
+			t.Errorf("expected saved location uuid %q, got %q", res.GetLocationUuid(), saved.UUID)
+		}
+		if saved.Latitude == nil || *saved.Latitude != 55.75 {
+			t.Errorf("expected latitude to be saved, got %v", saved.Latitude)
+		}
+	})
+
+	t.Run("success — building in site", func(t *testing.T) {
+		loc := &mockLocationRepo{
+			getLocation: func(_ context.Context, _ entities.GetLocationDTO) (*entities.Location, Error.CodeError) {
+				return locationEntity(siteID, "", "site"), ok()
+			},
+			createLocation: func(_ context.Context, dto entities.CreateLocation) Error.CodeError {
+				if dto.ParentUUID != siteID {
+					t.Errorf("expected parent %q, got %q", siteID, dto.ParentUUID)
+				}
+				return ok()
+			},
+		}
+
+		svc := newLocationTestService(pgRepoWithChief(), loc)
+		_, err := svc.CreateLocation(ctx, &pb.CreateLocationRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, ParentUuid: siteID, Kind: "building", Title: "Корпус 1",
+		})
+		assertNoError(t, err)
+	})
+
+	t.Run("invalid kind", func(t *testing.T) {
+		svc := newLocationTestService(emptyPGRepo(), &mockLocationRepo{})
+		_, err := svc.CreateLocation(ctx, &pb.CreateLocationRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, Kind: "room", Title: "Room",
+		})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("only latitude", func(t *testing.T) {
+		svc := newLocationTestService(emptyPGRepo(), &mockLocationRepo{})
+		_, err := svc.CreateLocation(ctx, &pb.CreateLocationRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, Kind: "site", Title: "Site", Latitude: float64Ptr(10),
+		})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("latitude out of range", func(t *testing.T) {
+		svc := newLocationTestService(emptyPGRepo(), &mockLocationRepo{})
+		_, err := svc.CreateLocation(ctx, &pb.CreateLocationRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, Kind: "site", Title: "Site",
+			Latitude: float64Ptr(91), Longitude: float64Ptr(0),
+		})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("site with parent", func(t *testing.T) {
+		svc := newLocationTestService(emptyPGRepo(), &mockLocationRepo{})
+		_, err := svc.CreateLocation(ctx, &pb.CreateLocationRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, ParentUuid: siteID, Kind: "site", Title: "Site",
+		})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("floor without parent", func(t *testing.T) {
+		svc := newLocationTestService(emptyPGRepo(), &mockLocationRepo{})
+		_, err := svc.CreateLocation(ctx, &pb.CreateLocationRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, Kind: "floor", Title: "Floor 1",
+		})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("floor in site — wrong level", func(t *testing.T) {
+		loc := &mockLocationRepo{
+			getLocation: func(_ context.Context, _ entities.GetLocationDTO) (*entities.Location, Error.CodeError) {
+				return locationEntity(siteID, "", "site"), ok()
+			},
+		}
+
+		svc := newLocationTestService(pgRepoWithChief(), loc)
+		_, err := svc.CreateLocation(ctx, &pb.CreateLocationRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, ParentUuid: siteID, Kind: "floor", Title: "Floor 1",
+		})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("parent of another company", func(t *testing.T) {
+		loc := &mockLocationRepo{
+			getLocation: func(_ context.Context, _ entities.GetLocationDTO) (*entities.Location, Error.CodeError) {
+				site := locationEntity(siteID, "", "site")
+				site.CompanyUUID = targetID
+				return site, ok()
+			},
+		}
+
+		svc := newLocationTestService(pgRepoWithChief(), loc)
+		_, err := svc.CreateLocation(ctx, &pb.CreateLocationRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, ParentUuid: siteID, Kind: "building", Title: "Корпус 1",
+		})
+		assertGRPCCode(t, err, codes.NotFound)
+	})
+
+	t.Run("not chief", func(t *testing.T) {
+		pg := emptyPGRepo()
+		pg.getCompany = func(_ context.Context, _ entities.GetCompanyDTO) (*entities.Company, Error.CodeError) {
+			return companyEntity(), ok()
+		}
+		pg.getCompanyEmployee = func(_ context.Context, _ entities.GetCompanyEmployeeDTO) (*entities.Employee, Error.CodeError) {
+			return &entities.Employee{Role: "inspector"}, ok()
+		}
+
+		svc := newLocationTestService(pg, &mockLocationRepo{})
+		_, err := svc.CreateLocation(ctx, &pb.CreateLocationRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, Kind: "site", Title: "Site",
+		})
+		assertGRPCCode(t, err, codes.PermissionDenied)
+	})
+}
+
+// ─── GetLocation ──────────────────────────────────────────────────────────────
+
+func TestGetLocation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success — path from site", func(t *testing.T) {
+		pg := pgRepoWithChief()
+		pg.getCompanyEmployee = func(_ context.Context, _ entities.GetCompanyEmployeeDTO) (*entities.Employee, Error.CodeError) {
+			return &entities.Employee{Role: "inspector"}, ok()
+		}
+		loc := &mockLocationRepo{
+			getLocationPath: func(_ context.Context, _ entities.GetLocationPathDTO) ([]*entities.Location, Error.CodeError) {
+				return []*entities.Location{
+					locationEntity(siteID, "", "site"),
+					locationEntity(buildingID, siteID, "building"),
+					locationEntity(floorID, buildingID, "floor"),
+				}, ok()
+			},
+		}
+
+		svc := newLocationTestService(pg, loc)
+		res, err := svc.GetLocation(ctx, &pb.GetLocationRequest{InitiatorUuid: initiatorID, LocationUuid: floorID})
+		assertNoError(t, err)
+		if res.GetLocation().GetLocationUuid() != floorID || res.GetCompanyUuid() != companyID {
+			t.Errorf("unexpected location %q of company %q", res.GetLocation().GetLocationUuid(), res.GetCompanyUuid())
+		}
+		if len(res.GetPath()) != 3 || res.GetPath()[0].GetLocationUuid() != siteID {
+			t.Errorf("expected path from site, got %v", res.GetPath())
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		loc := &mockLocationRepo{
+			getLocationPath: func(_ context.Context, _ entities.GetLocationPathDTO) ([]*entities.Location, Error.CodeError) {
+				return nil, notFound()
+			},
+		}
+
+		svc := newLocationTestService(emptyPGRepo(), loc)
+		_, err := svc.GetLocation(ctx, &pb.GetLocationRequest{InitiatorUuid: initiatorID, LocationUuid: floorID})
+		assertGRPCCode(t, err, codes.NotFound)
+	})
+
+	t.Run("not an employee", func(t *testing.T) {
+		pg := emptyPGRepo()
+		pg.getCompany = func(_ context.Context, _ entities.GetCompanyDTO) (*entities.Company, Error.CodeError) {
+			return companyEntity(), ok()
+		}
+		pg.getCompanyEmployee = func(_ context.Context, _ entities.GetCompanyEmployeeDTO) (*entities.Employee, Error.CodeError) {
+			return nil, notFound()
+		}
+		loc := &mockLocationRepo{
+			getLocationPath: func(_ context.Context, _ entities.GetLocationPathDTO) ([]*entities.Location, Error.CodeError) {
+				return []*entities.Location{locationEntity(siteID, "", "site")}, ok()
+			},
+		}
+
+		svc := newLocationTestService(pg, loc)
+		_, err := svc.GetLocation(ctx, &pb.GetLocationRequest{InitiatorUuid: initiatorID, LocationUuid: siteID})
+		assertGRPCCode(t, err, codes.PermissionDenied)
+	})
+}
+
+// ─── GetCompanyLocations ──────────────────────────────────────────────────────
+
+func TestGetCompanyLocations(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success — filter by parent", func(t *testing.T) {
+		loc := &mockLocationRepo{
+			getCompanyLocations: func(_ context.Context, dto entities.GetCompanyLocationsDTO) ([]*entities.Location, Error.CodeError) {
+				if dto.ParentUUID != siteID {
+					t.Errorf("expected parent filter %q, got %q", siteID, dto.ParentUUID)
+				}
+				return []*entities.Location{locationEntity(buildingID, siteID, "building")}, ok()
+			},
+		}
+
+		svc := newLocationTestService(pgRepoWithChief(), loc)
+		res, err := svc.GetCompanyLocations(ctx, &pb.GetCompanyLocationsRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, ParentUuid: siteID, Count: 10,
+		})
+		assertNoError(t, err)
+		if len(res.GetLocations()) != 1 || res.GetLocations()[0].GetKind() != "building" {
+			t.Errorf("unexpected locations %v", res.GetLocations())
+		}
+	})
+
+	t.Run("invalid kind", func(t *testing.T) {
+		svc := newLocationTestService(emptyPGRepo(), &mockLocationRepo{})
+		_, err := svc.GetCompanyLocations(ctx, &pb.GetCompanyLocationsRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, Kind: "room", Count: 10,
+		})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("invalid count", func(t *testing.T) {
+		svc := newLocationTestService(emptyPGRepo(), &mockLocationRepo{})
+		_, err := svc.GetCompanyLocations(ctx, &pb.GetCompanyLocationsRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, Count: 0,
+		})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+}
+
+// ─── UpdateLocation ───────────────────────────────────────────────────────────
+
+func TestUpdateLocation(t *testing.T) {
+	ctx := context.Background()
+	req := &pb.UpdateLocationRequest{InitiatorUuid: initiatorID, LocationUuid: buildingID, Title: "Корпус 2"}
+
+	t.Run("success — coordinates cleared", func(t *testing.T) {
+		loc := &mockLocationRepo{
+			getLocation: func(_ context.Context, _ entities.GetLocationDTO) (*entities.Location, Error.CodeError) {
+				return locationEntity(buildingID, siteID, "building"), ok()
+			},
+			updateLocation: func(_ context.Context, dto entities.UpdateLocation) Error.CodeError {
+				if dto.Title != "Корпус 2" || dto.Latitude != nil || dto.Longitude != nil {
+					t.Errorf("unexpected update %+v", dto)
+				}
+				return ok()
+			},
+		}
+
+		svc := newLocationTestService(pgRepoWithChief(), loc)
+		_, err := svc.UpdateLocation(ctx, req)
+		assertNoError(t, err)
+	})
+
+	t.Run("invalid title", func(t *testing.T) {
+		svc := newLocationTestService(emptyPGRepo(), &mockLocationRepo{})
+		_, err := svc.UpdateLocation(ctx, &pb.UpdateLocationRequest{InitiatorUuid: initiatorID, LocationUuid: buildingID})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("not chief", func(t *testing.T) {
+		pg := pgRepoWithChief()
+		pg.getCompanyEmployee = func(_ context.Context, _ entities.GetCompanyEmployeeDTO) (*entities.Employee, Error.CodeError) {
+			return &entities.Employee{Role: "manager"}, ok()
+		}
+		loc := &mockLocationRepo{
+			getLocation: func(_ context.Context, _ entities.GetLocationDTO) (*entities.Location, Error.CodeError) {
+				return locationEntity(buildingID, siteID, "building"), ok()
+			},
+		}
+
+		svc := newLocationTestService(pg, loc)
+		_, err := svc.UpdateLocation(ctx, req)
+		assertGRPCCode(t, err, codes.PermissionDenied)
+	})
+}
+
+// ─── DeleteLocation ───────────────────────────────────────────────────────────
+
+func TestDeleteLocation(t *testing.T) {
+	ctx := context.Background()
+	req := &pb.DeleteLocationRequest{InitiatorUuid: initiatorID, LocationUuid: siteID}
+
+	getSite := func(_ context.Context, _ entities.GetLocationDTO) (*entities.Location, Error.CodeError) {
+		return locationEntity(siteID, "", "site"), ok()
+	}
+
+	t.Run("success", func(t *testing.T) {
+		loc := &mockLocationRepo{
+			getLocation:    getSite,
+			deleteLocation: func(_ context.Context, _ entities.DeleteLocationDTO) Error.CodeError { return ok() },
+		}
+
+		svc := newLocationTestService(pgRepoWithChief(), loc)
+		_, err := svc.DeleteLocation(ctx, req)
+		assertNoError(t, err)
+	})
+
+	t.Run("has nested locations", func(t *testing.T) {
+		loc := &mockLocationRepo{
+			getLocation: getSite,
+			deleteLocation: func(_ context.Context, _ entities.DeleteLocationDTO) Error.CodeError {
+				return Error.Public(codes.FailedPrecondition, "location has nested locations")
+			},
+		}
+
+		svc := newLocationTestService(pgRepoWithChief(), loc)
+		_, err := svc.DeleteLocation(ctx, req)
+		assertGRPCCode(t, err, codes.FailedPrecondition)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		loc := &mockLocationRepo{
+			getLocation: func(_ context.Context, _ entities.GetLocationDTO) (*entities.Location, Error.CodeError) {
+				return nil, notFound()
+			},
+		}
+
+		svc := newLocationTestService(emptyPGRepo(), loc)
+		_, err := svc.DeleteLocation(ctx, req)
+		assertGRPCCode(t, err, codes.NotFound)
+	})
+}
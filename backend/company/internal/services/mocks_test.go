@@ -3,11 +3,15 @@ package services
 import (
 	"context"
 	"fmt"
+	"time"
 
 	postgresDB "github.com/unwelcome/FrameWorkTask1/backend/company/internal/database/postgres"
 	redisDB "github.com/unwelcome/FrameWorkTask1/backend/company/internal/database/redis"
 	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/messaging"
+	application_proto "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
 	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 )
 
@@ -19,7 +23,7 @@ type mockPGCompanyRepo struct {
 	getCompanies               func(ctx context.Context, dto entities.GetCompaniesDTO) ([]*entities.GetCompanies, Error.CodeError)
 	updateCompanyTitle         func(ctx context.Context, dto entities.UpdateCompanyTitleDTO) Error.CodeError
 	updateCompanyStatus        func(ctx context.Context, dto entities.UpdateCompanyStatusDTO) Error.CodeError
-	deleteCompany              func(ctx context.Context, dto entities.DeleteCompanyDTO) Error.CodeError
+	updateCompanyJoinSettings  func(ctx context.Context, dto entities.UpdateCompanyJoinSettingsDTO) Error.CodeError
 	joinCompany                func(ctx context.Context, dto entities.JoinCompanyDTO) Error.CodeError
 	getCompanyEmployee         func(ctx context.Context, dto entities.GetCompanyEmployeeDTO) (*entities.Employee, Error.CodeError)
 	getCompanyEmployees        func(ctx context.Context, dto entities.GetCompanyEmployeesDTO) ([]*entities.Employee, Error.CodeError)
@@ -35,6 +39,8 @@ type mockPGCompanyRepo struct {
 	removeEmployeeFromDepartment func(ctx context.Context, dto entities.RemoveEmployeeFromDepartmentDTO) Error.CodeError
 	getUserCompanies             func(ctx context.Context, dto entities.GetUserCompaniesDTO) ([]*entities.GetCompanies, Error.CodeError)
 	checkColleagues              func(ctx context.Context, dto entities.CheckColleaguesDTO) (bool, Error.CodeError)
+	countCompanies               func(ctx context.Context) (int64, Error.CodeError)
+	countCompanyEmployees        func(ctx context.Context, dto entities.GetCompanyEmployeesDTO) (int64, Error.CodeError)
 }
 
 func (m *mockPGCompanyRepo) CreateCompany(ctx context.Context, dto entities.CreateCompany) Error.CodeError {
@@ -52,8 +58,8 @@ func (m *mockPGCompanyRepo) UpdateCompanyTitle(ctx context.Context, dto entities
 func (m *mockPGCompanyRepo) UpdateCompanyStatus(ctx context.Context, dto entities.UpdateCompanyStatusDTO) Error.CodeError {
 	return m.updateCompanyStatus(ctx, dto)
 }
-func (m *mockPGCompanyRepo) DeleteCompany(ctx context.Context, dto entities.DeleteCompanyDTO) Error.CodeError {
-	return m.deleteCompany(ctx, dto)
+func (m *mockPGCompanyRepo) UpdateCompanyJoinSettings(ctx context.Context, dto entities.UpdateCompanyJoinSettingsDTO) Error.CodeError {
+	return m.updateCompanyJoinSettings(ctx, dto)
 }
 func (m *mockPGCompanyRepo) JoinCompany(ctx context.Context, dto entities.JoinCompanyDTO) Error.CodeError {
 	return m.joinCompany(ctx, dto)
@@ -100,6 +106,18 @@ func (m *mockPGCompanyRepo) GetUserCompanies(ctx context.Context, dto entities.G
 func (m *mockPGCompanyRepo) CheckColleagues(ctx context.Context, dto entities.CheckColleaguesDTO) (bool, Error.CodeError) {
 	return m.checkColleagues(ctx, dto)
 }
+func (m *mockPGCompanyRepo) CountCompanies(ctx context.Context) (int64, Error.CodeError) {
+	if m.countCompanies == nil {
+		return 0, ok()
+	}
+	return m.countCompanies(ctx)
+}
+func (m *mockPGCompanyRepo) CountCompanyEmployees(ctx context.Context, dto entities.GetCompanyEmployeesDTO) (int64, Error.CodeError) {
+	if m.countCompanyEmployees == nil {
+		return 0, ok()
+	}
+	return m.countCompanyEmployees(ctx, dto)
+}
 
 // ─── Mock: Redis CompanyRepository ───────────────────────────────────────────
 
@@ -110,6 +128,10 @@ type mockRedisCompanyRepo struct {
 	getCompanyJoinCodes          func(ctx context.Context, dto entities.GetCompanyJoinCodesDTO) ([]string, Error.CodeError)
 	getCompanyByJoinCode         func(ctx context.Context, dto entities.GetCompanyByJoinCodeDTO) (string, Error.CodeError)
 	deleteCompanyJoinCode        func(ctx context.Context, dto entities.DeleteCompanyJoinCodeDTO) Error.CodeError
+	getJoinCodeUsage             func(ctx context.Context, dto entities.GetJoinCodeUsageDTO) (*entities.JoinCodeUsage, Error.CodeError)
+	redeemJoinCode               func(ctx context.Context, dto entities.RedeemJoinCodeDTO) Error.CodeError
+	releaseJoinCode              func(ctx context.Context, dto entities.ReleaseJoinCodeDTO) Error.CodeError
+	purgeCompanyJoinCodes        func(ctx context.Context, dto entities.PurgeCompanyJoinCodesDTO) Error.CodeError
 }
 
 func (m *mockRedisCompanyRepo) CreateCompanyJoinCode(ctx context.Context, dto entities.CreateCompanyJoinCodeDTO) Error.CodeError {
@@ -130,13 +152,319 @@ func (m *mockRedisCompanyRepo) GetCompanyByJoinCode(ctx context.Context, dto ent
 func (m *mockRedisCompanyRepo) DeleteCompanyJoinCode(ctx context.Context, dto entities.DeleteCompanyJoinCodeDTO) Error.CodeError {
 	return m.deleteCompanyJoinCode(ctx, dto)
 }
+func (m *mockRedisCompanyRepo) GetJoinCodeUsage(ctx context.Context, dto entities.GetJoinCodeUsageDTO) (*entities.JoinCodeUsage, Error.CodeError) {
+	if m.getJoinCodeUsage == nil {
+		return &entities.JoinCodeUsage{}, ok()
+	}
+	return m.getJoinCodeUsage(ctx, dto)
+}
+func (m *mockRedisCompanyRepo) RedeemJoinCode(ctx context.Context, dto entities.RedeemJoinCodeDTO) Error.CodeError {
+	if m.redeemJoinCode == nil {
+		return ok()
+	}
+	return m.redeemJoinCode(ctx, dto)
+}
+func (m *mockRedisCompanyRepo) ReleaseJoinCode(ctx context.Context, dto entities.ReleaseJoinCodeDTO) Error.CodeError {
+	if m.releaseJoinCode == nil {
+		return ok()
+	}
+	return m.releaseJoinCode(ctx, dto)
+}
+func (m *mockRedisCompanyRepo) PurgeCompanyJoinCodes(ctx context.Context, dto entities.PurgeCompanyJoinCodesDTO) Error.CodeError {
+	return m.purgeCompanyJoinCodes(ctx, dto)
+}
+
+// ─── Mock: Publisher ─────────────────────────────────────────────────────────
+
+type mockPublisher struct {
+	publishMembershipEvent func(ctx context.Context, event *entities.OutboxEvent) Error.CodeError
+	sendInvitationEmail    func(ctx context.Context, dto entities.InvitationEmailMsg) Error.CodeError
+}
+
+func (m *mockPublisher) PublishMembershipEvent(ctx context.Context, event *entities.OutboxEvent) Error.CodeError {
+	return m.publishMembershipEvent(ctx, event)
+}
+func (m *mockPublisher) SendInvitationEmail(ctx context.Context, dto entities.InvitationEmailMsg) Error.CodeError {
+	if m.sendInvitationEmail == nil {
+		return ok()
+	}
+	return m.sendInvitationEmail(ctx, dto)
+}
+func (m *mockPublisher) Close() error { return nil }
+
+// emptyPublisher — заглушка для тестов, где письма не проверяются.
+// События изменения состава сервис пишет в outbox (поле Event DTO репозитория), а не публикует сам.
+func emptyPublisher() *mockPublisher {
+	return &mockPublisher{
+		publishMembershipEvent: func(_ context.Context, _ *entities.OutboxEvent) Error.CodeError { return Error.CodeError{} },
+	}
+}
+
+// recordEvent — запоминает событие, переданное репозиторию для записи в outbox (nil - без события).
+func recordEvent(events *[]entities.MembershipEvent, event *entities.MembershipEvent) {
+	if event != nil {
+		*events = append(*events, *event)
+	}
+}
+
+// ─── Mock: Postgres OutboxRepository ─────────────────────────────────────────
+
+type mockOutboxRepo struct {
+	acquirePublisherLock  func(ctx context.Context) (func(), bool, Error.CodeError)
+	getPendingEvents      func(ctx context.Context, dto entities.GetPendingOutboxEventsDTO) ([]*entities.OutboxEvent, Error.CodeError)
+	markEventsPublished   func(ctx context.Context, dto entities.MarkOutboxEventsPublishedDTO) Error.CodeError
+	deletePublishedEvents func(ctx context.Context, dto entities.DeletePublishedOutboxEventsDTO) (int64, Error.CodeError)
+}
+
+func (m *mockOutboxRepo) AcquirePublisherLock(ctx context.Context) (func(), bool, Error.CodeError) {
+	return m.acquirePublisherLock(ctx)
+}
+func (m *mockOutboxRepo) GetPendingEvents(ctx context.Context, dto entities.GetPendingOutboxEventsDTO) ([]*entities.OutboxEvent, Error.CodeError) {
+	return m.getPendingEvents(ctx, dto)
+}
+func (m *mockOutboxRepo) MarkEventsPublished(ctx context.Context, dto entities.MarkOutboxEventsPublishedDTO) Error.CodeError {
+	return m.markEventsPublished(ctx, dto)
+}
+func (m *mockOutboxRepo) DeletePublishedEvents(ctx context.Context, dto entities.DeletePublishedOutboxEventsDTO) (int64, Error.CodeError) {
+	return m.deletePublishedEvents(ctx, dto)
+}
+
+// ─── Mock: application сервис ────────────────────────────────────────────────
+
+// mockOffboarder — проверка открытых заявок сотрудника в application сервисе; без offboard заявок нет
+type mockOffboarder struct {
+	offboard func(ctx context.Context, in *application_proto.OffboardEmployeeApplicationsRequest) (*application_proto.OffboardEmployeeApplicationsResponse, error)
+}
+
+func (m *mockOffboarder) OffboardEmployeeApplications(ctx context.Context, in *application_proto.OffboardEmployeeApplicationsRequest, _ ...grpc.CallOption) (*application_proto.OffboardEmployeeApplicationsResponse, error) {
+	if m.offboard == nil {
+		return &application_proto.OffboardEmployeeApplicationsResponse{}, nil
+	}
+	return m.offboard(ctx, in)
+}
+
+// ─── Mock: Postgres LocationRepository ───────────────────────────────────────
+
+type mockLocationRepo struct {
+	createLocation      func(ctx context.Context, dto entities.CreateLocation) Error.CodeError
+	getLocation         func(ctx context.Context, dto entities.GetLocationDTO) (*entities.Location, Error.CodeError)
+	getLocationPath     func(ctx context.Context, dto entities.GetLocationPathDTO) ([]*entities.Location, Error.CodeError)
+	getCompanyLocations func(ctx context.Context, dto entities.GetCompanyLocationsDTO) ([]*entities.Location, Error.CodeError)
+	updateLocation      func(ctx context.Context, dto entities.UpdateLocation) Error.CodeError
+	deleteLocation      func(ctx context.Context, dto entities.DeleteLocationDTO) Error.CodeError
+}
+
+func (m *mockLocationRepo) CreateLocation(ctx context.Context, dto entities.CreateLocation) Error.CodeError {
+	return m.createLocation(ctx, dto)
+}
+func (m *mockLocationRepo) GetLocation(ctx context.Context, dto entities.GetLocationDTO) (*entities.Location, Error.CodeError) {
+	return m.getLocation(ctx, dto)
+}
+func (m *mockLocationRepo) GetLocationPath(ctx context.Context, dto entities.GetLocationPathDTO) ([]*entities.Location, Error.CodeError) {
+	return m.getLocationPath(ctx, dto)
+}
+func (m *mockLocationRepo) GetCompanyLocations(ctx context.Context, dto entities.GetCompanyLocationsDTO) ([]*entities.Location, Error.CodeError) {
+	return m.getCompanyLocations(ctx, dto)
+}
+func (m *mockLocationRepo) UpdateLocation(ctx context.Context, dto entities.UpdateLocation) Error.CodeError {
+	return m.updateLocation(ctx, dto)
+}
+func (m *mockLocationRepo) DeleteLocation(ctx context.Context, dto entities.DeleteLocationDTO) Error.CodeError {
+	return m.deleteLocation(ctx, dto)
+}
+
+// ─── Mock: Postgres JoinRequestRepository ────────────────────────────────────
+
+type mockJoinRequestRepo struct {
+	createJoinRequest      func(ctx context.Context, dto entities.CreateJoinRequestDTO) Error.CodeError
+	getJoinRequest         func(ctx context.Context, dto entities.GetJoinRequestDTO) (*entities.JoinRequest, Error.CodeError)
+	getCompanyJoinRequests func(ctx context.Context, dto entities.GetCompanyJoinRequestsDTO) ([]*entities.JoinRequest, Error.CodeError)
+	acceptJoinRequest      func(ctx context.Context, dto entities.DecideJoinRequestDTO) Error.CodeError
+	declineJoinRequest     func(ctx context.Context, dto entities.DecideJoinRequestDTO) Error.CodeError
+	getJoinCodeRedemptions func(ctx context.Context, dto entities.GetJoinCodeRedemptionsDTO) ([]*entities.JoinCodeRedemption, Error.CodeError)
+}
+
+func (m *mockJoinRequestRepo) CreateJoinRequest(ctx context.Context, dto entities.CreateJoinRequestDTO) Error.CodeError {
+	return m.createJoinRequest(ctx, dto)
+}
+func (m *mockJoinRequestRepo) GetJoinRequest(ctx context.Context, dto entities.GetJoinRequestDTO) (*entities.JoinRequest, Error.CodeError) {
+	return m.getJoinRequest(ctx, dto)
+}
+func (m *mockJoinRequestRepo) GetCompanyJoinRequests(ctx context.Context, dto entities.GetCompanyJoinRequestsDTO) ([]*entities.JoinRequest, Error.CodeError) {
+	return m.getCompanyJoinRequests(ctx, dto)
+}
+func (m *mockJoinRequestRepo) AcceptJoinRequest(ctx context.Context, dto entities.DecideJoinRequestDTO) Error.CodeError {
+	return m.acceptJoinRequest(ctx, dto)
+}
+func (m *mockJoinRequestRepo) DeclineJoinRequest(ctx context.Context, dto entities.DecideJoinRequestDTO) Error.CodeError {
+	return m.declineJoinRequest(ctx, dto)
+}
+func (m *mockJoinRequestRepo) GetJoinCodeRedemptions(ctx context.Context, dto entities.GetJoinCodeRedemptionsDTO) ([]*entities.JoinCodeRedemption, Error.CodeError) {
+	return m.getJoinCodeRedemptions(ctx, dto)
+}
+
+// ─── Mock: Postgres InvitationRepository ─────────────────────────────────────
+
+type mockInvitationRepo struct {
+	createInvitation      func(ctx context.Context, dto entities.CreateInvitation) Error.CodeError
+	getInvitation         func(ctx context.Context, dto entities.GetInvitationDTO) (*entities.Invitation, Error.CodeError)
+	getCompanyInvitations func(ctx context.Context, dto entities.GetCompanyInvitationsDTO) ([]*entities.Invitation, Error.CodeError)
+	getEmailInvitations   func(ctx context.Context, dto entities.GetEmailInvitationsDTO) ([]*entities.Invitation, Error.CodeError)
+	revokeInvitation      func(ctx context.Context, dto entities.RevokeInvitationDTO) Error.CodeError
+	acceptInvitation      func(ctx context.Context, dto entities.AcceptInvitationDTO) Error.CodeError
+	declineInvitation     func(ctx context.Context, dto entities.DeclineInvitationDTO) Error.CodeError
+}
+
+func (m *mockInvitationRepo) CreateInvitation(ctx context.Context, dto entities.CreateInvitation) Error.CodeError {
+	return m.createInvitation(ctx, dto)
+}
+func (m *mockInvitationRepo) GetInvitation(ctx context.Context, dto entities.GetInvitationDTO) (*entities.Invitation, Error.CodeError) {
+	return m.getInvitation(ctx, dto)
+}
+func (m *mockInvitationRepo) GetCompanyInvitations(ctx context.Context, dto entities.GetCompanyInvitationsDTO) ([]*entities.Invitation, Error.CodeError) {
+	return m.getCompanyInvitations(ctx, dto)
+}
+func (m *mockInvitationRepo) GetEmailInvitations(ctx context.Context, dto entities.GetEmailInvitationsDTO) ([]*entities.Invitation, Error.CodeError) {
+	return m.getEmailInvitations(ctx, dto)
+}
+func (m *mockInvitationRepo) RevokeInvitation(ctx context.Context, dto entities.RevokeInvitationDTO) Error.CodeError {
+	return m.revokeInvitation(ctx, dto)
+}
+func (m *mockInvitationRepo) AcceptInvitation(ctx context.Context, dto entities.AcceptInvitationDTO) Error.CodeError {
+	return m.acceptInvitation(ctx, dto)
+}
+func (m *mockInvitationRepo) DeclineInvitation(ctx context.Context, dto entities.DeclineInvitationDTO) Error.CodeError {
+	return m.declineInvitation(ctx, dto)
+}
+
+// ─── Mock: Postgres OwnershipRepository ──────────────────────────────────────
+
+type mockOwnershipRepo struct {
+	createOwnershipTransfer     func(ctx context.Context, dto entities.CreateOwnershipTransferDTO) Error.CodeError
+	getPendingOwnershipTransfer func(ctx context.Context, dto entities.GetOwnershipTransferDTO) (*entities.OwnershipTransfer, Error.CodeError)
+	acceptOwnershipTransfer     func(ctx context.Context, dto entities.DecideOwnershipTransferDTO) Error.CodeError
+	declineOwnershipTransfer    func(ctx context.Context, dto entities.DecideOwnershipTransferDTO) Error.CodeError
+	cancelOwnershipTransfer     func(ctx context.Context, dto entities.DecideOwnershipTransferDTO) Error.CodeError
+}
+
+func (m *mockOwnershipRepo) CreateOwnershipTransfer(ctx context.Context, dto entities.CreateOwnershipTransferDTO) Error.CodeError {
+	return m.createOwnershipTransfer(ctx, dto)
+}
+func (m *mockOwnershipRepo) GetPendingOwnershipTransfer(ctx context.Context, dto entities.GetOwnershipTransferDTO) (*entities.OwnershipTransfer, Error.CodeError) {
+	return m.getPendingOwnershipTransfer(ctx, dto)
+}
+func (m *mockOwnershipRepo) AcceptOwnershipTransfer(ctx context.Context, dto entities.DecideOwnershipTransferDTO) Error.CodeError {
+	return m.acceptOwnershipTransfer(ctx, dto)
+}
+func (m *mockOwnershipRepo) DeclineOwnershipTransfer(ctx context.Context, dto entities.DecideOwnershipTransferDTO) Error.CodeError {
+	return m.declineOwnershipTransfer(ctx, dto)
+}
+func (m *mockOwnershipRepo) CancelOwnershipTransfer(ctx context.Context, dto entities.DecideOwnershipTransferDTO) Error.CodeError {
+	return m.cancelOwnershipTransfer(ctx, dto)
+}
+
+// ─── Mock: Postgres DeletionRepository ───────────────────────────────────────
+
+type mockDeletionRepo struct {
+	scheduleCompanyDeletion func(ctx context.Context, dto entities.ScheduleCompanyDeletionDTO) Error.CodeError
+	getCompanyDeletion      func(ctx context.Context, dto entities.GetCompanyDeletionDTO) (*entities.CompanyDeletion, Error.CodeError)
+	restoreCompany          func(ctx context.Context, dto entities.RestoreCompanyDTO) Error.CodeError
+	getDueCompanyDeletions  func(ctx context.Context, dto entities.GetDueCompanyDeletionsDTO) ([]*entities.CompanyDeletion, Error.CodeError)
+	advanceCompanyDeletion  func(ctx context.Context, dto entities.AdvanceCompanyDeletionDTO) Error.CodeError
+	failCompanyDeletionStep func(ctx context.Context, dto entities.FailCompanyDeletionStepDTO) Error.CodeError
+	completeCompanyDeletion func(ctx context.Context, dto entities.CompleteCompanyDeletionDTO) Error.CodeError
+}
+
+func (m *mockDeletionRepo) ScheduleCompanyDeletion(ctx context.Context, dto entities.ScheduleCompanyDeletionDTO) Error.CodeError {
+	return m.scheduleCompanyDeletion(ctx, dto)
+}
+func (m *mockDeletionRepo) GetCompanyDeletion(ctx context.Context, dto entities.GetCompanyDeletionDTO) (*entities.CompanyDeletion, Error.CodeError) {
+	return m.getCompanyDeletion(ctx, dto)
+}
+func (m *mockDeletionRepo) RestoreCompany(ctx context.Context, dto entities.RestoreCompanyDTO) Error.CodeError {
+	return m.restoreCompany(ctx, dto)
+}
+func (m *mockDeletionRepo) GetDueCompanyDeletions(ctx context.Context, dto entities.GetDueCompanyDeletionsDTO) ([]*entities.CompanyDeletion, Error.CodeError) {
+	return m.getDueCompanyDeletions(ctx, dto)
+}
+func (m *mockDeletionRepo) AdvanceCompanyDeletion(ctx context.Context, dto entities.AdvanceCompanyDeletionDTO) Error.CodeError {
+	return m.advanceCompanyDeletion(ctx, dto)
+}
+func (m *mockDeletionRepo) FailCompanyDeletionStep(ctx context.Context, dto entities.FailCompanyDeletionStepDTO) Error.CodeError {
+	return m.failCompanyDeletionStep(ctx, dto)
+}
+func (m *mockDeletionRepo) CompleteCompanyDeletion(ctx context.Context, dto entities.CompleteCompanyDeletionDTO) Error.CodeError {
+	return m.completeCompanyDeletion(ctx, dto)
+}
+
+// ─── Mock: Postgres AuditRepository ──────────────────────────────────────────
+
+type mockAuditRepo struct {
+	createAuditEntry     func(ctx context.Context, dto entities.CreateAuditEntryDTO) Error.CodeError
+	getCompanyAuditLog   func(ctx context.Context, dto entities.GetCompanyAuditLogDTO) ([]*entities.AuditEntry, Error.CodeError)
+	countCompanyAuditLog func(ctx context.Context, dto entities.GetCompanyAuditLogDTO) (int64, Error.CodeError)
+}
+
+func (m *mockAuditRepo) CreateAuditEntry(ctx context.Context, dto entities.CreateAuditEntryDTO) Error.CodeError {
+	return m.createAuditEntry(ctx, dto)
+}
+func (m *mockAuditRepo) GetCompanyAuditLog(ctx context.Context, dto entities.GetCompanyAuditLogDTO) ([]*entities.AuditEntry, Error.CodeError) {
+	return m.getCompanyAuditLog(ctx, dto)
+}
+func (m *mockAuditRepo) CountCompanyAuditLog(ctx context.Context, dto entities.GetCompanyAuditLogDTO) (int64, Error.CodeError) {
+	return m.countCompanyAuditLog(ctx, dto)
+}
 
 // ─── Helpers ─────────────────────────────────────────────────────────────────
 
+const testDeletionRetention = 7 * 24 * time.Hour
+
 func newTestService(pgRepo postgresDB.CompanyRepository, redisRepo redisDB.CompanyRepository) *CompanyService {
-	db := &postgresDB.DatabaseRepository{Company: pgRepo}
+	db := &postgresDB.DatabaseRepository{Company: pgRepo, Audit: emptyAuditRepo()}
+	cache := &redisDB.CacheRepository{Company: redisRepo}
+	return NewCompanyService(db, cache, emptyPublisher(), &mockOffboarder{}, testDeletionRetention)
+}
+
+func newOffboardingTestService(pgRepo postgresDB.CompanyRepository, offboarder ApplicationOffboarder, publisher messaging.Publisher) *CompanyService {
+	db := &postgresDB.DatabaseRepository{Company: pgRepo, Audit: emptyAuditRepo()}
+	cache := &redisDB.CacheRepository{Company: emptyRedisRepo()}
+	return NewCompanyService(db, cache, publisher, offboarder, testDeletionRetention)
+}
+
+func newLocationTestService(pgRepo postgresDB.CompanyRepository, locationRepo postgresDB.LocationRepository) *CompanyService {
+	db := &postgresDB.DatabaseRepository{Company: pgRepo, Location: locationRepo, Audit: emptyAuditRepo()}
+	cache := &redisDB.CacheRepository{Company: emptyRedisRepo()}
+	return NewCompanyService(db, cache, emptyPublisher(), &mockOffboarder{}, testDeletionRetention)
+}
+
+func newJoinRequestTestService(pgRepo postgresDB.CompanyRepository, redisRepo redisDB.CompanyRepository, joinRequestRepo postgresDB.JoinRequestRepository) *CompanyService {
+	db := &postgresDB.DatabaseRepository{Company: pgRepo, JoinRequest: joinRequestRepo, Audit: emptyAuditRepo()}
+	cache := &redisDB.CacheRepository{Company: redisRepo}
+	return NewCompanyService(db, cache, emptyPublisher(), &mockOffboarder{}, testDeletionRetention)
+}
+
+func newInvitationTestService(pgRepo postgresDB.CompanyRepository, invitationRepo postgresDB.InvitationRepository, publisher messaging.Publisher) *CompanyService {
+	db := &postgresDB.DatabaseRepository{Company: pgRepo, Invitation: invitationRepo, Audit: emptyAuditRepo()}
+	cache := &redisDB.CacheRepository{Company: emptyRedisRepo()}
+	return NewCompanyService(db, cache, publisher, &mockOffboarder{}, testDeletionRetention)
+}
+
+func newOwnershipTestService(pgRepo postgresDB.CompanyRepository, ownershipRepo postgresDB.OwnershipRepository, publisher messaging.Publisher) *CompanyService {
+	db := &postgresDB.DatabaseRepository{Company: pgRepo, Ownership: ownershipRepo, Audit: emptyAuditRepo()}
+	cache := &redisDB.CacheRepository{Company: emptyRedisRepo()}
+	return NewCompanyService(db, cache, publisher, &mockOffboarder{}, testDeletionRetention)
+}
+
+func newAuditTestService(pgRepo postgresDB.CompanyRepository, redisRepo redisDB.CompanyRepository, auditRepo postgresDB.AuditRepository) *CompanyService {
+	db := &postgresDB.DatabaseRepository{Company: pgRepo, Audit: auditRepo}
 	cache := &redisDB.CacheRepository{Company: redisRepo}
-	return NewCompanyService(db, cache)
+	return NewCompanyService(db, cache, emptyPublisher(), &mockOffboarder{}, testDeletionRetention)
+}
+
+func newDeletionTestService(pgRepo postgresDB.CompanyRepository, deletionRepo postgresDB.DeletionRepository, publisher messaging.Publisher) *CompanyService {
+	db := &postgresDB.DatabaseRepository{Company: pgRepo, Deletion: deletionRepo, Audit: emptyAuditRepo()}
+	cache := &redisDB.CacheRepository{Company: emptyRedisRepo()}
+	return NewCompanyService(db, cache, publisher, &mockOffboarder{}, testDeletionRetention)
 }
 
 func emptyPGRepo() *mockPGCompanyRepo {
@@ -149,6 +477,23 @@ func emptyPGRepo() *mockPGCompanyRepo {
 
 func emptyRedisRepo() *mockRedisCompanyRepo { return &mockRedisCompanyRepo{} }
 
+// emptyAuditRepo — журнал аудита, принимающий любые записи.
+func emptyAuditRepo() *mockAuditRepo {
+	return &mockAuditRepo{
+		createAuditEntry: func(_ context.Context, _ entities.CreateAuditEntryDTO) Error.CodeError { return ok() },
+	}
+}
+
+// recordingAuditRepo — журнал аудита, запоминающий добавленные записи.
+func recordingAuditRepo(entries *[]entities.CreateAuditEntryDTO) *mockAuditRepo {
+	return &mockAuditRepo{
+		createAuditEntry: func(_ context.Context, dto entities.CreateAuditEntryDTO) Error.CodeError {
+			*entries = append(*entries, dto)
+			return ok()
+		},
+	}
+}
+
 func ok() Error.CodeError { return Error.CodeError{} }
 
 func notFound() Error.CodeError {
@@ -0,0 +1,61 @@
+package services
+
+import (
+	"context"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
+	application_proto "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/helpers"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var OffboardingPolicies = []string{entities.OffboardingBlock, entities.OffboardingPool, entities.OffboardingReassign}
+
+// ApplicationOffboarder Проверка открытых заявок сотрудника в application сервисе перед сменой роли,
+// удалением из компании или из отдела
+type ApplicationOffboarder interface {
+	OffboardEmployeeApplications(ctx context.Context, in *application_proto.OffboardEmployeeApplicationsRequest, opts ...grpc.CallOption) (*application_proto.OffboardEmployeeApplicationsResponse, error)
+}
+
+// checkOpenApplications Проверяет в application сервисе, что политика применима к открытым заявкам сотрудника:
+// для block их нет, для reassign коллега может их принять. Вызывается до записи изменения состава -
+// сами заявки application сервис обрабатывает по событию изменения, только если оно записано
+func (s *CompanyService) checkOpenApplications(ctx context.Context, req *application_proto.OffboardEmployeeApplicationsRequest) error {
+	// Вернуть заявки в пул отдела можно всегда
+	if req.GetPolicy() == entities.OffboardingPool {
+		return nil
+	}
+
+	req.DryRun = true
+	_, err := s.applications.OffboardEmployeeApplications(ctx, req)
+	return err
+}
+
+// ─── Вспомогательные функции ──────────────────────────────────────────────────
+
+// validateOffboarding Проверяет политику обработки открытых заявок сотрудника (по умолчанию block)
+func validateOffboarding(policy, reassignTo, targetUUID string) (string, error) {
+	if policy == "" {
+		policy = entities.OffboardingBlock
+	}
+	if !helpers.Contains(OffboardingPolicies, policy) {
+		return "", status.Errorf(codes.InvalidArgument, "invalid open applications policy")
+	}
+
+	if policy != entities.OffboardingReassign {
+		if reassignTo != "" {
+			return "", status.Errorf(codes.InvalidArgument, "reassign_to is allowed only with reassign policy")
+		}
+		return policy, nil
+	}
+	if err := validate.UUID(reassignTo); err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid reassign_to uuid")
+	}
+	if reassignTo == targetUUID {
+		return "", status.Errorf(codes.InvalidArgument, "cannot reassign applications to the same employee")
+	}
+	return policy, nil
+}
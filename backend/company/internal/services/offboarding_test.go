@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
+	application_proto "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/company/generated"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const colleagueID = "ffffffff-ffff-ffff-ffff-ffffffffffff"
+
+// recordingOffboarder — application сервис, запоминающий запросы проверки открытых заявок и отвечающий err
+func recordingOffboarder(requests *[]*application_proto.OffboardEmployeeApplicationsRequest, err error) *mockOffboarder {
+	return &mockOffboarder{
+		offboard: func(_ context.Context, in *application_proto.OffboardEmployeeApplicationsRequest) (*application_proto.OffboardEmployeeApplicationsResponse, error) {
+			*requests = append(*requests, in)
+			return &application_proto.OffboardEmployeeApplicationsResponse{}, err
+		},
+	}
+}
+
+// ─── Обработка открытых заявок сотрудника ─────────────────────────────────────
+
+func TestEmployeeOffboarding(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("role change checks policy before change", func(t *testing.T) {
+		var changed bool
+		var events []entities.MembershipEvent
+		pg := pgRepoWithChiefAndTarget()
+		pg.setCompanyEmployeeRole = func(_ context.Context, dto entities.SetCompanyEmployeeRoleDTO) Error.CodeError {
+			changed = true
+			recordEvent(&events, dto.Event)
+			return ok()
+		}
+
+		var requests []*application_proto.OffboardEmployeeApplicationsRequest
+		svc := newOffboardingTestService(pg, recordingOffboarder(&requests, nil), emptyPublisher())
+		_, err := svc.UpdateEmployeeRole(ctx, &pb.UpdateEmployeeRoleRequest{
+			CompanyUuid: companyID, InitiatorUuid: initiatorID, TargetUuid: targetID, Role: "manager",
+			OpenApplications: entities.OffboardingReassign, ReassignTo: colleagueID,
+		})
+		assertNoError(t, err)
+
+		if len(requests) != 1 || !requests[0].GetDryRun() {
+			t.Fatalf("expected one dry run check, got %+v", requests)
+		}
+		if requests[0].GetNewRole() != "manager" || requests[0].GetReassignToUuid() != colleagueID {
+			t.Errorf("unexpected check request: %+v", requests[0])
+		}
+		if !changed {
+			t.Error("expected role to be changed")
+		}
+		if len(events) != 1 {
+			t.Fatalf("expected 1 event, got %d", len(events))
+		}
+		event := events[0]
+		if event.Role != "manager" || event.InitiatorUUID != initiatorID || event.OpenApplications != entities.OffboardingReassign || event.ReassignTo != colleagueID {
+			t.Errorf("unexpected event: %+v", event)
+		}
+	})
+
+	t.Run("blocked by open applications", func(t *testing.T) {
+		pg := pgRepoWithChiefAndTarget()
+		pg.removeCompanyEmployee = func(_ context.Context, _ entities.RemoveCompanyEmployeeDTO) Error.CodeError {
+			t.Fatal("employee must not be removed")
+			return ok()
+		}
+
+		var requests []*application_proto.OffboardEmployeeApplicationsRequest
+		blocked := status.Error(codes.FailedPrecondition, "employee has 1 open applications")
+		svc := newOffboardingTestService(pg, recordingOffboarder(&requests, blocked), emptyPublisher())
+		_, err := svc.RemoveCompanyEmployee(ctx, &pb.RemoveCompanyEmployeeRequest{
+			CompanyUuid: companyID, InitiatorUuid: initiatorID, TargetUuid: targetID,
+		})
+		assertGRPCCode(t, err, codes.FailedPrecondition)
+
+		if len(requests) != 1 || requests[0].GetPolicy() != entities.OffboardingBlock {
+			t.Errorf("expected block policy by default, got %+v", requests)
+		}
+	})
+
+	t.Run("pool skips check", func(t *testing.T) {
+		pg := pgRepoWithChiefAndTarget()
+		var events []entities.MembershipEvent
+		pg.removeCompanyEmployee = func(_ context.Context, dto entities.RemoveCompanyEmployeeDTO) Error.CodeError {
+			recordEvent(&events, dto.Event)
+			return ok()
+		}
+
+		var requests []*application_proto.OffboardEmployeeApplicationsRequest
+		svc := newOffboardingTestService(pg, recordingOffboarder(&requests, nil), emptyPublisher())
+		_, err := svc.RemoveCompanyEmployee(ctx, &pb.RemoveCompanyEmployeeRequest{
+			CompanyUuid: companyID, InitiatorUuid: initiatorID, TargetUuid: targetID, OpenApplications: entities.OffboardingPool,
+		})
+		assertNoError(t, err)
+
+		if len(requests) != 0 {
+			t.Errorf("expected no check for pool policy, got %+v", requests)
+		}
+		if len(events) != 1 || events[0].OpenApplications != entities.OffboardingPool {
+			t.Errorf("unexpected events: %+v", events)
+		}
+	})
+
+	t.Run("department removal scoped to department", func(t *testing.T) {
+		pg := pgRepoWithChiefAndDept()
+		pg.getCompanyEmployee = func(_ context.Context, dto entities.GetCompanyEmployeeDTO) (*entities.Employee, Error.CodeError) {
+			if dto.UserUUID == initiatorID {
+				return chiefEmployee(), ok()
+			}
+			return &entities.Employee{Role: "engineer", DepartmentUUID: deptID}, ok()
+		}
+		var events []entities.MembershipEvent
+		pg.removeEmployeeFromDepartment = func(_ context.Context, dto entities.RemoveEmployeeFromDepartmentDTO) Error.CodeError {
+			recordEvent(&events, dto.Event)
+			return ok()
+		}
+
+		var requests []*application_proto.OffboardEmployeeApplicationsRequest
+		svc := newOffboardingTestService(pg, recordingOffboarder(&requests, nil), emptyPublisher())
+		_, err := svc.RemoveEmployeeFromDepartment(ctx, &pb.RemoveEmployeeFromDepartmentRequest{
+			InitiatorUuid: initiatorID, DepartmentUuid: deptID, TargetUuid: targetID,
+		})
+		assertNoError(t, err)
+
+		if len(requests) != 1 || requests[0].GetDepartmentUuid() != deptID || requests[0].GetCompanyUuid() != companyID {
+			t.Errorf("unexpected check requests: %+v", requests)
+		}
+		if len(events) != 1 || events[0].DepartmentUUID != deptID || events[0].OpenApplications != entities.OffboardingBlock {
+			t.Errorf("unexpected events: %+v", events)
+		}
+	})
+
+	t.Run("invalid_arguments", func(t *testing.T) {
+		cases := map[string]*pb.RemoveCompanyEmployeeRequest{
+			"policy":                {OpenApplications: "archive"},
+			"reassign without uuid": {OpenApplications: entities.OffboardingReassign},
+			"reassign to target":    {OpenApplications: entities.OffboardingReassign, ReassignTo: targetID},
+			"reassign_to with pool": {OpenApplications: entities.OffboardingPool, ReassignTo: colleagueID},
+		}
+		for name, req := range cases {
+			t.Run(name, func(t *testing.T) {
+				req.CompanyUuid, req.InitiatorUuid, req.TargetUuid = companyID, initiatorID, targetID
+
+				svc := newOffboardingTestService(emptyPGRepo(), &mockOffboarder{}, emptyPublisher())
+				_, err := svc.RemoveCompanyEmployee(ctx, req)
+				assertGRPCCode(t, err, codes.InvalidArgument)
+			})
+		}
+	})
+}
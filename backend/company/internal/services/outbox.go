@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	postgresDB "github.com/unwelcome/FrameWorkTask1/backend/company/internal/database/postgres"
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/messaging"
+)
+
+const (
+	outboxPollInterval    = time.Second
+	outboxBatchSize       = 100
+	outboxCleanupInterval = time.Hour
+	outboxRetention       = 7 * 24 * time.Hour // опубликованные события храним неделю для разбора инцидентов
+)
+
+// StartOutboxWorker запускает фоновую горутину, которая каждые outboxPollInterval публикует
+// неотправленные события изменения состава компаний из membership_outbox в rabbitMQ, а раз в
+// outboxCleanupInterval удаляет опубликованные события старше outboxRetention.
+// Доставка at-least-once: подписчики должны быть идемпотентны по message id.
+// При нескольких экземплярах сервиса события публикует только владелец блокировки публикации.
+// Останавливается при отмене ctx (graceful shutdown).
+func StartOutboxWorker(ctx context.Context, db *postgresDB.DatabaseRepository, publisher messaging.Publisher) {
+	log.Info().Dur("interval", outboxPollInterval).Msg("outbox worker started")
+
+	poll := time.NewTicker(outboxPollInterval)
+	defer poll.Stop()
+
+	cleanup := time.NewTicker(outboxCleanupInterval)
+	defer cleanup.Stop()
+
+	for {
+		select {
+		case <-poll.C:
+			publishPendingEvents(ctx, db, publisher)
+		case <-cleanup.C:
+			runOutboxCleanup(ctx, db)
+		case <-ctx.Done():
+			log.Info().Msg("outbox worker stopped")
+			return
+		}
+	}
+}
+
+// publishPendingEvents Публикация накопившихся событий пачками по outboxBatchSize.
+// Публикация останавливается на первой ошибке, чтобы не нарушать порядок изменений состава -
+// оставшиеся события будут отправлены на следующем тике. Возвращает число опубликованных событий.
+func publishPendingEvents(ctx context.Context, db *postgresDB.DatabaseRepository, publisher messaging.Publisher) int {
+	release, acquired, lockErr := db.Outbox.AcquirePublisherLock(ctx)
+	if lockErr.Code != 0 {
+		log.Error().Err(lockErr).Msg("outbox: failed to acquire publisher lock")
+		return 0
+	}
+	if !acquired {
+		// События публикует другой экземпляр сервиса
+		return 0
+	}
+	defer release()
+
+	total := 0
+
+	for {
+		events, getErr := db.Outbox.GetPendingEvents(ctx, entities.GetPendingOutboxEventsDTO{
+			Count: outboxBatchSize,
+		})
+		if getErr.Code != 0 {
+			log.Error().Err(getErr).Msg("outbox: failed to get pending events")
+			return total
+		}
+
+		published := make([]string, 0, len(events))
+		var publishFailed bool
+		for _, event := range events {
+			if err := publisher.PublishMembershipEvent(ctx, event); err.Code != 0 {
+				log.Warn().Err(err).Str("event_uuid", event.UUID).Str("event_type", event.EventType).
+					Str("company_uuid", event.CompanyUUID).Msg("outbox: failed to publish event")
+				publishFailed = true
+				break
+			}
+			published = append(published, event.UUID)
+		}
+
+		if len(published) > 0 {
+			if err := db.Outbox.MarkEventsPublished(ctx, entities.MarkOutboxEventsPublishedDTO{
+				EventUUIDs: published,
+			}); err.Code != 0 {
+				// События уже в брокере - при следующем тике они уйдут повторно (at-least-once)
+				log.Error().Err(err).Int("count", len(published)).Msg("outbox: failed to mark events as published")
+				return total
+			}
+			total += len(published)
+		}
+
+		if publishFailed || len(events) < outboxBatchSize {
+			return total
+		}
+	}
+}
+
+// runOutboxCleanup Удаление старых опубликованных событий
+func runOutboxCleanup(ctx context.Context, db *postgresDB.DatabaseRepository) {
+	count, err := db.Outbox.DeletePublishedEvents(ctx, entities.DeletePublishedOutboxEventsDTO{
+		PublishedBefore: time.Now().Add(-outboxRetention),
+	})
+	if err.Code != 0 {
+		log.Error().Err(err).Msg("outbox: failed to delete published events")
+		return
+	}
+	log.Info().Int64("deleted", count).Msg("outbox cleanup")
+}
@@ -0,0 +1,153 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/company/generated"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// OwnershipTransferTTL Время, за которое получатель должен подтвердить передачу владения
+const OwnershipTransferTTL = 7 * 24 * time.Hour
+
+// TransferCompanyOwnership Предлагает сотруднику стать владельцем компании. Владение переходит после подтверждения получателем
+func (s *CompanyService) TransferCompanyOwnership(ctx context.Context, req *pb.TransferCompanyOwnershipRequest) (*pb.TransferCompanyOwnershipResponse, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+	if err := validate.UUID(req.GetTargetUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid target uuid")
+	}
+	if req.GetInitiatorUuid() == req.GetTargetUuid() {
+		return nil, status.Error(codes.InvalidArgument, "cannot transfer ownership to yourself")
+	}
+
+	companyInfo, getErr := s.db.Company.GetCompany(ctx, entities.GetCompanyDTO{CompanyUUID: req.GetCompanyUuid()})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+	if companyInfo.OwnerUUID != req.GetInitiatorUuid() {
+		return nil, status.Error(codes.PermissionDenied, "only company owner can transfer ownership")
+	}
+
+	transferUUID := uuid.Must(uuid.NewV7()).String()
+	expiresAt := time.Now().Add(OwnershipTransferTTL)
+
+	if err := s.db.Ownership.CreateOwnershipTransfer(ctx, entities.CreateOwnershipTransferDTO{
+		UUID:        transferUUID,
+		CompanyUUID: req.GetCompanyUuid(),
+		FromUUID:    req.GetInitiatorUuid(),
+		ToUUID:      req.GetTargetUuid(),
+		ExpiresAt:   expiresAt,
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &pb.TransferCompanyOwnershipResponse{
+		TransferUuid: transferUUID,
+		ExpiresAt:    expiresAt.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+// GetCompanyOwnershipTransfer Возвращает ожидающую передачу владения компании (для любого сотрудника)
+func (s *CompanyService) GetCompanyOwnershipTransfer(ctx context.Context, req *pb.GetCompanyOwnershipTransferRequest) (*pb.GetCompanyOwnershipTransferResponse, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+
+	if err := s.checkEmployeeRole(ctx, req.GetCompanyUuid(), req.GetInitiatorUuid(), AllRoles); err != nil {
+		return nil, err
+	}
+
+	transfer, getErr := s.db.Ownership.GetPendingOwnershipTransfer(ctx, entities.GetOwnershipTransferDTO{CompanyUUID: req.GetCompanyUuid()})
+	if err := getErr.GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &pb.GetCompanyOwnershipTransferResponse{
+		Transfer: &pb.OwnershipTransfer{
+			TransferUuid: transfer.UUID,
+			CompanyUuid:  transfer.CompanyUUID,
+			FromUuid:     transfer.FromUUID,
+			ToUuid:       transfer.ToUUID,
+			Status:       transfer.Status,
+			ExpiresAt:    transfer.ExpiresAt,
+			CreatedAt:    transfer.CreatedAt,
+			DecidedAt:    transfer.DecidedAt,
+		},
+	}, nil
+}
+
+// AcceptCompanyOwnershipTransfer Подтверждение передачи получателем: он становится владельцем с ролью chief
+func (s *CompanyService) AcceptCompanyOwnershipTransfer(ctx context.Context, req *pb.AcceptCompanyOwnershipTransferRequest) (*emptypb.Empty, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+
+	if err := s.db.Ownership.AcceptOwnershipTransfer(ctx, entities.DecideOwnershipTransferDTO{
+		CompanyUUID: req.GetCompanyUuid(),
+		UserUUID:    req.GetInitiatorUuid(),
+		Event: &entities.MembershipEvent{
+			EventType:   entities.EventEmployeeRoleChanged,
+			CompanyUUID: req.GetCompanyUuid(),
+			UserUUID:    req.GetInitiatorUuid(),
+		},
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// DeclineCompanyOwnershipTransfer Отказ получателя от передачи владения
+func (s *CompanyService) DeclineCompanyOwnershipTransfer(ctx context.Context, req *pb.DeclineCompanyOwnershipTransferRequest) (*emptypb.Empty, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+
+	if err := s.db.Ownership.DeclineOwnershipTransfer(ctx, entities.DecideOwnershipTransferDTO{
+		CompanyUUID: req.GetCompanyUuid(),
+		UserUUID:    req.GetInitiatorUuid(),
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// CancelCompanyOwnershipTransfer Отмена передачи владения владельцем
+func (s *CompanyService) CancelCompanyOwnershipTransfer(ctx context.Context, req *pb.CancelCompanyOwnershipTransferRequest) (*emptypb.Empty, error) {
+	if err := validate.UUID(req.GetInitiatorUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initiator uuid")
+	}
+	if err := validate.UUID(req.GetCompanyUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid company uuid")
+	}
+
+	if err := s.db.Ownership.CancelOwnershipTransfer(ctx, entities.DecideOwnershipTransferDTO{
+		CompanyUUID: req.GetCompanyUuid(),
+		UserUUID:    req.GetInitiatorUuid(),
+	}).GRPCError(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,283 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/company/internal/entities"
+	pb "github.com/unwelcome/FrameWorkTask1/backend/contracts/company/generated"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+// pgRepoWithOwner настраивает pg-мок: компания принадлежит owner, initiator - chief
+func pgRepoWithOwner(owner string) *mockPGCompanyRepo {
+	pg := pgRepoWithChief()
+	pg.getCompany = func(_ context.Context, _ entities.GetCompanyDTO) (*entities.Company, Error.CodeError) {
+		company := companyEntity()
+		company.OwnerUUID = owner
+		return company, ok()
+	}
+	return pg
+}
+
+// ─── TransferCompanyOwnership ─────────────────────────────────────────────────
+
+func TestTransferCompanyOwnership(t *testing.T) {
+	ctx := context.Background()
+	validReq := &pb.TransferCompanyOwnershipRequest{InitiatorUuid: initiatorID, CompanyUuid: companyID, TargetUuid: targetID}
+
+	t.Run("success — transfer saved", func(t *testing.T) {
+		var saved entities.CreateOwnershipTransferDTO
+		ownership := &mockOwnershipRepo{
+			createOwnershipTransfer: func(_ context.Context, dto entities.CreateOwnershipTransferDTO) Error.CodeError {
+				saved = dto
+				return ok()
+			},
+		}
+
+		svc := newOwnershipTestService(pgRepoWithOwner(initiatorID), ownership, emptyPublisher())
+		res, err := svc.TransferCompanyOwnership(ctx, validReq)
+		assertNoError(t, err)
+
+		if res.GetTransferUuid() == "" || saved.UUID != res.GetTransferUuid() {
+			t.Errorf("expected saved transfer uuid %q, got %q", res.GetTransferUuid(), saved.UUID)
+		}
+		if saved.FromUUID != initiatorID || saved.ToUUID != targetID || saved.CompanyUUID != companyID {
+			t.Errorf("unexpected transfer saved: %+v", saved)
+		}
+		if d := time.Until(saved.ExpiresAt); d <= 0 || d > OwnershipTransferTTL {
+			t.Errorf("unexpected expires_at: %v", saved.ExpiresAt)
+		}
+	})
+
+	t.Run("transfer to yourself", func(t *testing.T) {
+		svc := newOwnershipTestService(pgRepoWithOwner(initiatorID), &mockOwnershipRepo{}, emptyPublisher())
+		_, err := svc.TransferCompanyOwnership(ctx, &pb.TransferCompanyOwnershipRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, TargetUuid: initiatorID,
+		})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("invalid target uuid", func(t *testing.T) {
+		svc := newOwnershipTestService(pgRepoWithOwner(initiatorID), &mockOwnershipRepo{}, emptyPublisher())
+		_, err := svc.TransferCompanyOwnership(ctx, &pb.TransferCompanyOwnershipRequest{
+			InitiatorUuid: initiatorID, CompanyUuid: companyID, TargetUuid: "bad",
+		})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("chief but not owner", func(t *testing.T) {
+		svc := newOwnershipTestService(pgRepoWithOwner(targetID), &mockOwnershipRepo{}, emptyPublisher())
+		_, err := svc.TransferCompanyOwnership(ctx, validReq)
+		assertGRPCCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("company not found", func(t *testing.T) {
+		pg := emptyPGRepo()
+		pg.getCompany = func(_ context.Context, _ entities.GetCompanyDTO) (*entities.Company, Error.CodeError) {
+			return nil, notFound()
+		}
+
+		svc := newOwnershipTestService(pg, &mockOwnershipRepo{}, emptyPublisher())
+		_, err := svc.TransferCompanyOwnership(ctx, validReq)
+		assertGRPCCode(t, err, codes.NotFound)
+	})
+
+	t.Run("transfer already pending", func(t *testing.T) {
+		ownership := &mockOwnershipRepo{
+			createOwnershipTransfer: func(_ context.Context, _ entities.CreateOwnershipTransferDTO) Error.CodeError {
+				return Error.Public(codes.AlreadyExists, "ownership transfer already pending")
+			},
+		}
+
+		svc := newOwnershipTestService(pgRepoWithOwner(initiatorID), ownership, emptyPublisher())
+		_, err := svc.TransferCompanyOwnership(ctx, validReq)
+		assertGRPCCode(t, err, codes.AlreadyExists)
+	})
+}
+
+// ─── GetCompanyOwnershipTransfer ──────────────────────────────────────────────
+
+func TestGetCompanyOwnershipTransfer(t *testing.T) {
+	ctx := context.Background()
+	req := &pb.GetCompanyOwnershipTransferRequest{InitiatorUuid: initiatorID, CompanyUuid: companyID}
+
+	t.Run("success", func(t *testing.T) {
+		ownership := &mockOwnershipRepo{
+			getPendingOwnershipTransfer: func(_ context.Context, _ entities.GetOwnershipTransferDTO) (*entities.OwnershipTransfer, Error.CodeError) {
+				return &entities.OwnershipTransfer{CompanyUUID: companyID, FromUUID: initiatorID, ToUUID: targetID, Status: "pending"}, ok()
+			},
+		}
+
+		svc := newOwnershipTestService(pgRepoWithChief(), ownership, emptyPublisher())
+		res, err := svc.GetCompanyOwnershipTransfer(ctx, req)
+		assertNoError(t, err)
+		if res.GetTransfer().GetToUuid() != targetID || res.GetTransfer().GetStatus() != "pending" {
+			t.Errorf("unexpected transfer: %+v", res.GetTransfer())
+		}
+	})
+
+	t.Run("not an employee", func(t *testing.T) {
+		pg := pgRepoWithChief()
+		pg.getCompanyEmployee = func(_ context.Context, _ entities.GetCompanyEmployeeDTO) (*entities.Employee, Error.CodeError) {
+			return nil, notFound()
+		}
+
+		svc := newOwnershipTestService(pg, &mockOwnershipRepo{}, emptyPublisher())
+		_, err := svc.GetCompanyOwnershipTransfer(ctx, req)
+		assertGRPCCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("no pending transfer", func(t *testing.T) {
+		ownership := &mockOwnershipRepo{
+			getPendingOwnershipTransfer: func(_ context.Context, _ entities.GetOwnershipTransferDTO) (*entities.OwnershipTransfer, Error.CodeError) {
+				return nil, notFound()
+			},
+		}
+
+		svc := newOwnershipTestService(pgRepoWithChief(), ownership, emptyPublisher())
+		_, err := svc.GetCompanyOwnershipTransfer(ctx, req)
+		assertGRPCCode(t, err, codes.NotFound)
+	})
+}
+
+// ─── Accept / Decline / CancelCompanyOwnershipTransfer ────────────────────────
+
+func TestDecideCompanyOwnershipTransfer(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("accept — new owner role event published", func(t *testing.T) {
+		var decided entities.DecideOwnershipTransferDTO
+		ownership := &mockOwnershipRepo{
+			acceptOwnershipTransfer: func(_ context.Context, dto entities.DecideOwnershipTransferDTO) Error.CodeError {
+				decided = dto
+				return ok()
+			},
+		}
+		svc := newOwnershipTestService(emptyPGRepo(), ownership, emptyPublisher())
+		_, err := svc.AcceptCompanyOwnershipTransfer(ctx, &pb.AcceptCompanyOwnershipTransferRequest{InitiatorUuid: targetID, CompanyUuid: companyID})
+		assertNoError(t, err)
+
+		if decided.UserUUID != targetID || decided.CompanyUUID != companyID {
+			t.Errorf("unexpected decision: %+v", decided)
+		}
+		if decided.Event == nil || decided.Event.EventType != entities.EventEmployeeRoleChanged || decided.Event.UserUUID != targetID {
+			t.Errorf("expected role_changed event for new owner, got %+v", decided.Event)
+		}
+	})
+
+	t.Run("accept — no transfer", func(t *testing.T) {
+		ownership := &mockOwnershipRepo{
+			acceptOwnershipTransfer: func(_ context.Context, _ entities.DecideOwnershipTransferDTO) Error.CodeError { return notFound() },
+		}
+
+		// Событие пишется в транзакции подтверждения и откатывается вместе с ней
+		svc := newOwnershipTestService(emptyPGRepo(), ownership, emptyPublisher())
+		_, err := svc.AcceptCompanyOwnershipTransfer(ctx, &pb.AcceptCompanyOwnershipTransferRequest{InitiatorUuid: targetID, CompanyUuid: companyID})
+		assertGRPCCode(t, err, codes.NotFound)
+	})
+
+	t.Run("decline by recipient", func(t *testing.T) {
+		var decided entities.DecideOwnershipTransferDTO
+		ownership := &mockOwnershipRepo{
+			declineOwnershipTransfer: func(_ context.Context, dto entities.DecideOwnershipTransferDTO) Error.CodeError {
+				decided = dto
+				return ok()
+			},
+		}
+
+		svc := newOwnershipTestService(emptyPGRepo(), ownership, emptyPublisher())
+		_, err := svc.DeclineCompanyOwnershipTransfer(ctx, &pb.DeclineCompanyOwnershipTransferRequest{InitiatorUuid: targetID, CompanyUuid: companyID})
+		assertNoError(t, err)
+		if decided.UserUUID != targetID {
+			t.Errorf("expected decline by %s, got %+v", targetID, decided)
+		}
+		if decided.Event != nil {
+			t.Errorf("expected no event on decline, got %+v", decided.Event)
+		}
+	})
+
+	t.Run("cancel by owner", func(t *testing.T) {
+		var decided entities.DecideOwnershipTransferDTO
+		ownership := &mockOwnershipRepo{
+			cancelOwnershipTransfer: func(_ context.Context, dto entities.DecideOwnershipTransferDTO) Error.CodeError {
+				decided = dto
+				return ok()
+			},
+		}
+
+		svc := newOwnershipTestService(emptyPGRepo(), ownership, emptyPublisher())
+		_, err := svc.CancelCompanyOwnershipTransfer(ctx, &pb.CancelCompanyOwnershipTransferRequest{InitiatorUuid: initiatorID, CompanyUuid: companyID})
+		assertNoError(t, err)
+		if decided.UserUUID != initiatorID {
+			t.Errorf("expected cancel by %s, got %+v", initiatorID, decided)
+		}
+	})
+
+	t.Run("invalid company uuid", func(t *testing.T) {
+		svc := newOwnershipTestService(emptyPGRepo(), &mockOwnershipRepo{}, emptyPublisher())
+		_, err := svc.CancelCompanyOwnershipTransfer(ctx, &pb.CancelCompanyOwnershipTransferRequest{InitiatorUuid: initiatorID, CompanyUuid: "bad"})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+}
+
+// ─── LeaveCompany ─────────────────────────────────────────────────────────────
+
+func TestLeaveCompany(t *testing.T) {
+	ctx := context.Background()
+	req := &pb.LeaveCompanyRequest{InitiatorUuid: initiatorID, CompanyUuid: companyID}
+
+	t.Run("success — employee removed and left event published", func(t *testing.T) {
+		pg := pgRepoWithOwner(targetID)
+		var removed entities.RemoveCompanyEmployeeDTO
+		pg.removeCompanyEmployee = func(_ context.Context, dto entities.RemoveCompanyEmployeeDTO) Error.CodeError {
+			removed = dto
+			return ok()
+		}
+		svc := newOwnershipTestService(pg, &mockOwnershipRepo{}, emptyPublisher())
+		_, err := svc.LeaveCompany(ctx, req)
+		assertNoError(t, err)
+
+		if removed.UserUUID != initiatorID || removed.CompanyUUID != companyID {
+			t.Errorf("unexpected removal: %+v", removed)
+		}
+		if removed.Event == nil || removed.Event.EventType != entities.EventEmployeeLeft || removed.Event.UserUUID != initiatorID {
+			t.Errorf("expected employee.left event, got %+v", removed.Event)
+		}
+	})
+
+	t.Run("owner cannot leave", func(t *testing.T) {
+		// removeCompanyEmployee не задан - удаление владельца упало бы с nil func
+		svc := newOwnershipTestService(pgRepoWithOwner(initiatorID), &mockOwnershipRepo{}, emptyPublisher())
+		_, err := svc.LeaveCompany(ctx, req)
+		assertGRPCCode(t, err, codes.FailedPrecondition)
+	})
+
+	t.Run("not a member", func(t *testing.T) {
+		pg := pgRepoWithOwner(targetID)
+		pg.removeCompanyEmployee = func(_ context.Context, _ entities.RemoveCompanyEmployeeDTO) Error.CodeError { return notFound() }
+
+		svc := newOwnershipTestService(pg, &mockOwnershipRepo{}, emptyPublisher())
+		_, err := svc.LeaveCompany(ctx, req)
+		assertGRPCCode(t, err, codes.NotFound)
+	})
+
+	t.Run("last chief cannot leave", func(t *testing.T) {
+		pg := pgRepoWithOwner(targetID)
+		pg.removeCompanyEmployee = func(_ context.Context, _ entities.RemoveCompanyEmployeeDTO) Error.CodeError {
+			return Error.Public(codes.FailedPrecondition, "company must keep at least one chief")
+		}
+
+		svc := newOwnershipTestService(pg, &mockOwnershipRepo{}, emptyPublisher())
+		_, err := svc.LeaveCompany(ctx, req)
+		assertGRPCCode(t, err, codes.FailedPrecondition)
+	})
+
+	t.Run("invalid initiator uuid", func(t *testing.T) {
+		svc := newOwnershipTestService(emptyPGRepo(), &mockOwnershipRepo{}, emptyPublisher())
+		_, err := svc.LeaveCompany(ctx, &pb.LeaveCompanyRequest{InitiatorUuid: "bad", CompanyUuid: companyID})
+		assertGRPCCode(t, err, codes.InvalidArgument)
+	})
+}
@@ -43,6 +43,16 @@ type Application struct {
 	DeletedAt       string                 `protobuf:"bytes,17,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
 	DeletedBy       string                 `protobuf:"bytes,18,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
 	FixLogs         []*FixLog              `protobuf:"bytes,19,rep,name=fix_logs,json=fixLogs,proto3" json:"fix_logs,omitempty"`
+	Attachments     []*Attachment          `protobuf:"bytes,20,rep,name=attachments,proto3" json:"attachments,omitempty"`  // вложения, не привязанные к fix log-ам
+	Priority        string                 `protobuf:"bytes,21,opt,name=priority,proto3" json:"priority,omitempty"`        // low | normal | high | critical
+	DueAt           string                 `protobuf:"bytes,22,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"` // срок устранения по договору (необязательно)
+	IsOverdue       bool                   `protobuf:"varint,23,opt,name=is_overdue,json=isOverdue,proto3" json:"is_overdue,omitempty"`
+	SlaBreach       string                 `protobuf:"bytes,24,opt,name=sla_breach,json=slaBreach,proto3" json:"sla_breach,omitempty"` // assign | fix - нарушенный срок, если is_overdue
+	OverdueAt       string                 `protobuf:"bytes,25,opt,name=overdue_at,json=overdueAt,proto3" json:"overdue_at,omitempty"`
+	LocationUuid    string                 `protobuf:"bytes,26,opt,name=location_uuid,json=locationUuid,proto3" json:"location_uuid,omitempty"` // место дефекта (site, building, floor или zone), необязательно
+	CategoryUuid    string                 `protobuf:"bytes,27,opt,name=category_uuid,json=categoryUuid,proto3" json:"category_uuid,omitempty"` // категория дефекта, необязательно
+	Severity        string                 `protobuf:"bytes,28,opt,name=severity,proto3" json:"severity,omitempty"`                             // minor | moderate | major | critical, пусто - не указана
+	CustomFields    []*CustomFieldValue    `protobuf:"bytes,29,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"` // значения дополнительных полей компании
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -210,12 +220,83 @@ func (x *Application) GetFixLogs() []*FixLog {
 	return nil
 }
 
+func (x *Application) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Application) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *Application) GetDueAt() string {
+	if x != nil {
+		return x.DueAt
+	}
+	return ""
+}
+
+func (x *Application) GetIsOverdue() bool {
+	if x != nil {
+		return x.IsOverdue
+	}
+	return false
+}
+
+func (x *Application) GetSlaBreach() string {
+	if x != nil {
+		return x.SlaBreach
+	}
+	return ""
+}
+
+func (x *Application) GetOverdueAt() string {
+	if x != nil {
+		return x.OverdueAt
+	}
+	return ""
+}
+
+func (x *Application) GetLocationUuid() string {
+	if x != nil {
+		return x.LocationUuid
+	}
+	return ""
+}
+
+func (x *Application) GetCategoryUuid() string {
+	if x != nil {
+		return x.CategoryUuid
+	}
+	return ""
+}
+
+func (x *Application) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *Application) GetCustomFields() []*CustomFieldValue {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
 type FixLog struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	CreatedBy     string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Attachments   []*Attachment          `protobuf:"bytes,5,rep,name=attachments,proto3" json:"attachments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -278,28 +359,41 @@ func (x *FixLog) GetCreatedBy() string {
 	return ""
 }
 
-type ApplicationData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *FixLog) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
 }
 
-func (x *ApplicationData) Reset() {
-	*x = ApplicationData{}
+type Attachment struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentUuid  string                 `protobuf:"bytes,1,opt,name=attachment_uuid,json=attachmentUuid,proto3" json:"attachment_uuid,omitempty"`
+	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
+	FixLogUuid      string                 `protobuf:"bytes,3,opt,name=fix_log_uuid,json=fixLogUuid,proto3" json:"fix_log_uuid,omitempty"`
+	FileName        string                 `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType     string                 `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size            int64                  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy       string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
 	mi := &file_application_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplicationData) String() string {
+func (x *Attachment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplicationData) ProtoMessage() {}
+func (*Attachment) ProtoMessage() {}
 
-func (x *ApplicationData) ProtoReflect() protoreflect.Message {
+func (x *Attachment) ProtoReflect() protoreflect.Message {
 	mi := &file_application_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -311,52 +405,94 @@ func (x *ApplicationData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplicationData.ProtoReflect.Descriptor instead.
-func (*ApplicationData) Descriptor() ([]byte, []int) {
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
 	return file_application_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ApplicationData) GetTitle() string {
+func (x *Attachment) GetAttachmentUuid() string {
 	if x != nil {
-		return x.Title
+		return x.AttachmentUuid
 	}
 	return ""
 }
 
-func (x *ApplicationData) GetDescription() string {
+func (x *Attachment) GetApplicationUuid() string {
 	if x != nil {
-		return x.Description
+		return x.ApplicationUuid
 	}
 	return ""
 }
 
-// Health
-// Empty request
-type HealthResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Service       string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
-	Postgres      string                 `protobuf:"bytes,2,opt,name=postgres,proto3" json:"postgres,omitempty"`
-	Redis         string                 `protobuf:"bytes,3,opt,name=redis,proto3" json:"redis,omitempty"`
-	Minio         string                 `protobuf:"bytes,4,opt,name=minio,proto3" json:"minio,omitempty"`
-	Mongo         string                 `protobuf:"bytes,5,opt,name=mongo,proto3" json:"mongo,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Attachment) GetFixLogUuid() string {
+	if x != nil {
+		return x.FixLogUuid
+	}
+	return ""
 }
 
-func (x *HealthResponse) Reset() {
-	*x = HealthResponse{}
+func (x *Attachment) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Attachment) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type WorkflowTransition struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Action         string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // set_status | assign | redirect | recall | take_verification | release_verification | delete
+	From           []string               `protobuf:"bytes,3,rep,name=from,proto3" json:"from,omitempty"`
+	To             string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Roles          []string               `protobuf:"bytes,5,rep,name=roles,proto3" json:"roles,omitempty"`
+	Guard          string                 `protobuf:"bytes,6,opt,name=guard,proto3" json:"guard,omitempty"` // department | manager | executor | inspector | creator
+	RequireMessage bool                   `protobuf:"varint,7,opt,name=require_message,json=requireMessage,proto3" json:"require_message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WorkflowTransition) Reset() {
+	*x = WorkflowTransition{}
 	mi := &file_application_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HealthResponse) String() string {
+func (x *WorkflowTransition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthResponse) ProtoMessage() {}
+func (*WorkflowTransition) ProtoMessage() {}
 
-func (x *HealthResponse) ProtoReflect() protoreflect.Message {
+func (x *WorkflowTransition) ProtoReflect() protoreflect.Message {
 	mi := &file_application_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -368,70 +504,82 @@ func (x *HealthResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
-func (*HealthResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WorkflowTransition.ProtoReflect.Descriptor instead.
+func (*WorkflowTransition) Descriptor() ([]byte, []int) {
 	return file_application_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *HealthResponse) GetService() string {
+func (x *WorkflowTransition) GetName() string {
 	if x != nil {
-		return x.Service
+		return x.Name
 	}
 	return ""
 }
 
-func (x *HealthResponse) GetPostgres() string {
+func (x *WorkflowTransition) GetAction() string {
 	if x != nil {
-		return x.Postgres
+		return x.Action
 	}
 	return ""
 }
 
-func (x *HealthResponse) GetRedis() string {
+func (x *WorkflowTransition) GetFrom() []string {
 	if x != nil {
-		return x.Redis
+		return x.From
 	}
-	return ""
+	return nil
 }
 
-func (x *HealthResponse) GetMinio() string {
+func (x *WorkflowTransition) GetTo() string {
 	if x != nil {
-		return x.Minio
+		return x.To
 	}
 	return ""
 }
 
-func (x *HealthResponse) GetMongo() string {
+func (x *WorkflowTransition) GetRoles() []string {
 	if x != nil {
-		return x.Mongo
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *WorkflowTransition) GetGuard() string {
+	if x != nil {
+		return x.Guard
 	}
 	return ""
 }
 
-// CreateApplication
-type CreateApplicationRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	CompanyUuid     string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
-	ApplicationData *ApplicationData       `protobuf:"bytes,3,opt,name=application_data,json=applicationData,proto3" json:"application_data,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *WorkflowTransition) GetRequireMessage() bool {
+	if x != nil {
+		return x.RequireMessage
+	}
+	return false
 }
 
-func (x *CreateApplicationRequest) Reset() {
-	*x = CreateApplicationRequest{}
+type Workflow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	States        []string               `protobuf:"bytes,1,rep,name=states,proto3" json:"states,omitempty"`
+	Transitions   []*WorkflowTransition  `protobuf:"bytes,2,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Workflow) Reset() {
+	*x = Workflow{}
 	mi := &file_application_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateApplicationRequest) String() string {
+func (x *Workflow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateApplicationRequest) ProtoMessage() {}
+func (*Workflow) ProtoMessage() {}
 
-func (x *CreateApplicationRequest) ProtoReflect() protoreflect.Message {
+func (x *Workflow) ProtoReflect() protoreflect.Message {
 	mi := &file_application_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -443,53 +591,50 @@ func (x *CreateApplicationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateApplicationRequest.ProtoReflect.Descriptor instead.
-func (*CreateApplicationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Workflow.ProtoReflect.Descriptor instead.
+func (*Workflow) Descriptor() ([]byte, []int) {
 	return file_application_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreateApplicationRequest) GetInitiatorUuid() string {
-	if x != nil {
-		return x.InitiatorUuid
-	}
-	return ""
-}
-
-func (x *CreateApplicationRequest) GetCompanyUuid() string {
+func (x *Workflow) GetStates() []string {
 	if x != nil {
-		return x.CompanyUuid
+		return x.States
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateApplicationRequest) GetApplicationData() *ApplicationData {
+func (x *Workflow) GetTransitions() []*WorkflowTransition {
 	if x != nil {
-		return x.ApplicationData
+		return x.Transitions
 	}
 	return nil
 }
 
-type CreateApplicationResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ApplicationUuid string                 `protobuf:"bytes,1,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type ApplicationCategory struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	CategoryUuid          string                 `protobuf:"bytes,1,opt,name=category_uuid,json=categoryUuid,proto3" json:"category_uuid,omitempty"`
+	Title                 string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	DefaultDepartmentUuid string                 `protobuf:"bytes,3,opt,name=default_department_uuid,json=defaultDepartmentUuid,proto3" json:"default_department_uuid,omitempty"` // департамент, в который попадают новые заявки категории, пусто - департамент инспектора
+	CreatedAt             string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy             string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *CreateApplicationResponse) Reset() {
-	*x = CreateApplicationResponse{}
+func (x *ApplicationCategory) Reset() {
+	*x = ApplicationCategory{}
 	mi := &file_application_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateApplicationResponse) String() string {
+func (x *ApplicationCategory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateApplicationResponse) ProtoMessage() {}
+func (*ApplicationCategory) ProtoMessage() {}
 
-func (x *CreateApplicationResponse) ProtoReflect() protoreflect.Message {
+func (x *ApplicationCategory) ProtoReflect() protoreflect.Message {
 	mi := &file_application_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -501,93 +646,72 @@ func (x *CreateApplicationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateApplicationResponse.ProtoReflect.Descriptor instead.
-func (*CreateApplicationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApplicationCategory.ProtoReflect.Descriptor instead.
+func (*ApplicationCategory) Descriptor() ([]byte, []int) {
 	return file_application_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateApplicationResponse) GetApplicationUuid() string {
+func (x *ApplicationCategory) GetCategoryUuid() string {
 	if x != nil {
-		return x.ApplicationUuid
+		return x.CategoryUuid
 	}
 	return ""
 }
 
-// GetApplication
-type GetApplicationRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
-}
-
-func (x *GetApplicationRequest) Reset() {
-	*x = GetApplicationRequest{}
-	mi := &file_application_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetApplicationRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetApplicationRequest) ProtoMessage() {}
-
-func (x *GetApplicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_application_proto_msgTypes[6]
+func (x *ApplicationCategory) GetTitle() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Title
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetApplicationRequest.ProtoReflect.Descriptor instead.
-func (*GetApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_application_proto_rawDescGZIP(), []int{6}
+func (x *ApplicationCategory) GetDefaultDepartmentUuid() string {
+	if x != nil {
+		return x.DefaultDepartmentUuid
+	}
+	return ""
 }
 
-func (x *GetApplicationRequest) GetInitiatorUuid() string {
+func (x *ApplicationCategory) GetCreatedAt() string {
 	if x != nil {
-		return x.InitiatorUuid
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *GetApplicationRequest) GetApplicationUuid() string {
+func (x *ApplicationCategory) GetCreatedBy() string {
 	if x != nil {
-		return x.ApplicationUuid
+		return x.CreatedBy
 	}
 	return ""
 }
 
-type GetApplicationResponse struct {
+type CustomField struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Application   *Application           `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // идентификатор поля в заявках: a-z, 0-9, _
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`          // text | number | enum | date
+	Options       []string               `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`    // варианты значения, только для enum
+	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"` // обязательно при создании заявки
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetApplicationResponse) Reset() {
-	*x = GetApplicationResponse{}
-	mi := &file_application_proto_msgTypes[7]
+func (x *CustomField) Reset() {
+	*x = CustomField{}
+	mi := &file_application_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetApplicationResponse) String() string {
+func (x *CustomField) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetApplicationResponse) ProtoMessage() {}
+func (*CustomField) ProtoMessage() {}
 
-func (x *GetApplicationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_application_proto_msgTypes[7]
+func (x *CustomField) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -598,48 +722,4411 @@ func (x *GetApplicationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetApplicationResponse.ProtoReflect.Descriptor instead.
-func (*GetApplicationResponse) Descriptor() ([]byte, []int) {
-	return file_application_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CustomField.ProtoReflect.Descriptor instead.
+func (*CustomField) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetApplicationResponse) GetApplication() *Application {
+func (x *CustomField) GetKey() string {
 	if x != nil {
-		return x.Application
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CustomField) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CustomField) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CustomField) GetOptions() []string {
+	if x != nil {
+		return x.Options
 	}
 	return nil
 }
 
-// GetApplications
-type GetApplicationsRequest struct {
+func (x *CustomField) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type CustomFieldValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // number - десятичное число, date - YYYY-MM-DD, enum - один из options
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomFieldValue) Reset() {
+	*x = CustomFieldValue{}
+	mi := &file_application_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomFieldValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomFieldValue) ProtoMessage() {}
+
+func (x *CustomFieldValue) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomFieldValue.ProtoReflect.Descriptor instead.
+func (*CustomFieldValue) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CustomFieldValue) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CustomFieldValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SLAPolicy struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Priority                string                 `protobuf:"bytes,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	AssignWithinHours       int32                  `protobuf:"varint,2,opt,name=assign_within_hours,json=assignWithinHours,proto3" json:"assign_within_hours,omitempty"`                   // 0 - без ограничения
+	VerificationWithinHours int32                  `protobuf:"varint,3,opt,name=verification_within_hours,json=verificationWithinHours,proto3" json:"verification_within_hours,omitempty"` // срок до pending_verification, 0 - без ограничения
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *SLAPolicy) Reset() {
+	*x = SLAPolicy{}
+	mi := &file_application_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SLAPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLAPolicy) ProtoMessage() {}
+
+func (x *SLAPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLAPolicy.ProtoReflect.Descriptor instead.
+func (*SLAPolicy) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SLAPolicy) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *SLAPolicy) GetAssignWithinHours() int32 {
+	if x != nil {
+		return x.AssignWithinHours
+	}
+	return 0
+}
+
+func (x *SLAPolicy) GetVerificationWithinHours() int32 {
+	if x != nil {
+		return x.VerificationWithinHours
+	}
+	return 0
+}
+
+// Аналитика: выборка ограничена заявками, созданными в [created_from, created_to)
+type AnalyticsFilter struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid  string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	CompanyUuid    string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
-	DepartmentUuid string                 `protobuf:"bytes,3,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
-	Statuses       []string               `protobuf:"bytes,4,rep,name=statuses,proto3" json:"statuses,omitempty"`
-	Count          int64                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
-	Offset         int64                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
-	IsDeleted      bool                   `protobuf:"varint,7,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
-	FromPool       bool                   `protobuf:"varint,8,opt,name=from_pool,json=fromPool,proto3" json:"from_pool,omitempty"` // true - заявки из пула (для инспекторов, менеджеров) false - (личные заявки)
+	DepartmentUuid string                 `protobuf:"bytes,1,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"` // пусто - вся компания
+	CreatedFrom    string                 `protobuf:"bytes,2,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`          // RFC3339 или YYYY-MM-DD
+	CreatedTo      string                 `protobuf:"bytes,3,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetApplicationsRequest) Reset() {
-	*x = GetApplicationsRequest{}
-	mi := &file_application_proto_msgTypes[8]
+func (x *AnalyticsFilter) Reset() {
+	*x = AnalyticsFilter{}
+	mi := &file_application_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetApplicationsRequest) String() string {
+func (x *AnalyticsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyticsFilter) ProtoMessage() {}
+
+func (x *AnalyticsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyticsFilter.ProtoReflect.Descriptor instead.
+func (*AnalyticsFilter) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AnalyticsFilter) GetDepartmentUuid() string {
+	if x != nil {
+		return x.DepartmentUuid
+	}
+	return ""
+}
+
+func (x *AnalyticsFilter) GetCreatedFrom() string {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return ""
+}
+
+func (x *AnalyticsFilter) GetCreatedTo() string {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return ""
+}
+
+type StatusStat struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Period         string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"` // начало периода (RFC3339)
+	DepartmentUuid string                 `protobuf:"bytes,2,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Count          int64                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StatusStat) Reset() {
+	*x = StatusStat{}
+	mi := &file_application_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusStat) ProtoMessage() {}
+
+func (x *StatusStat) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusStat.ProtoReflect.Descriptor instead.
+func (*StatusStat) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StatusStat) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *StatusStat) GetDepartmentUuid() string {
+	if x != nil {
+		return x.DepartmentUuid
+	}
+	return ""
+}
+
+func (x *StatusStat) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StatusStat) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type LeadTime struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MeanSeconds   float64                `protobuf:"fixed64,1,opt,name=mean_seconds,json=meanSeconds,proto3" json:"mean_seconds,omitempty"`
+	Samples       int64                  `protobuf:"varint,2,opt,name=samples,proto3" json:"samples,omitempty"` // кол-во заявок, по которым посчитано среднее
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeadTime) Reset() {
+	*x = LeadTime{}
+	mi := &file_application_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeadTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeadTime) ProtoMessage() {}
+
+func (x *LeadTime) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeadTime.ProtoReflect.Descriptor instead.
+func (*LeadTime) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LeadTime) GetMeanSeconds() float64 {
+	if x != nil {
+		return x.MeanSeconds
+	}
+	return 0
+}
+
+func (x *LeadTime) GetSamples() int64 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+type EngineerRevisionStat struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	EngineerUuid        string                 `protobuf:"bytes,1,opt,name=engineer_uuid,json=engineerUuid,proto3" json:"engineer_uuid,omitempty"`
+	Applications        int64                  `protobuf:"varint,2,opt,name=applications,proto3" json:"applications,omitempty"`
+	RevisedApplications int64                  `protobuf:"varint,3,opt,name=revised_applications,json=revisedApplications,proto3" json:"revised_applications,omitempty"` // заявки, хотя бы раз отправленные на доработку
+	Revisions           int64                  `protobuf:"varint,4,opt,name=revisions,proto3" json:"revisions,omitempty"`
+	RevisionRate        float64                `protobuf:"fixed64,5,opt,name=revision_rate,json=revisionRate,proto3" json:"revision_rate,omitempty"` // revisions / applications
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *EngineerRevisionStat) Reset() {
+	*x = EngineerRevisionStat{}
+	mi := &file_application_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EngineerRevisionStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngineerRevisionStat) ProtoMessage() {}
+
+func (x *EngineerRevisionStat) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngineerRevisionStat.ProtoReflect.Descriptor instead.
+func (*EngineerRevisionStat) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EngineerRevisionStat) GetEngineerUuid() string {
+	if x != nil {
+		return x.EngineerUuid
+	}
+	return ""
+}
+
+func (x *EngineerRevisionStat) GetApplications() int64 {
+	if x != nil {
+		return x.Applications
+	}
+	return 0
+}
+
+func (x *EngineerRevisionStat) GetRevisedApplications() int64 {
+	if x != nil {
+		return x.RevisedApplications
+	}
+	return 0
+}
+
+func (x *EngineerRevisionStat) GetRevisions() int64 {
+	if x != nil {
+		return x.Revisions
+	}
+	return 0
+}
+
+func (x *EngineerRevisionStat) GetRevisionRate() float64 {
+	if x != nil {
+		return x.RevisionRate
+	}
+	return 0
+}
+
+type DepartmentFailureStat struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DepartmentUuid string                 `protobuf:"bytes,1,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
+	Closed         int64                  `protobuf:"varint,2,opt,name=closed,proto3" json:"closed,omitempty"`
+	Completed      int64                  `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	Failed         int64                  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	FailureRate    float64                `protobuf:"fixed64,5,opt,name=failure_rate,json=failureRate,proto3" json:"failure_rate,omitempty"` // failed / closed
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DepartmentFailureStat) Reset() {
+	*x = DepartmentFailureStat{}
+	mi := &file_application_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepartmentFailureStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepartmentFailureStat) ProtoMessage() {}
+
+func (x *DepartmentFailureStat) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepartmentFailureStat.ProtoReflect.Descriptor instead.
+func (*DepartmentFailureStat) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DepartmentFailureStat) GetDepartmentUuid() string {
+	if x != nil {
+		return x.DepartmentUuid
+	}
+	return ""
+}
+
+func (x *DepartmentFailureStat) GetClosed() int64 {
+	if x != nil {
+		return x.Closed
+	}
+	return 0
+}
+
+func (x *DepartmentFailureStat) GetCompleted() int64 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *DepartmentFailureStat) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *DepartmentFailureStat) GetFailureRate() float64 {
+	if x != nil {
+		return x.FailureRate
+	}
+	return 0
+}
+
+// Переход заявки в статус (восстанавливается по application_versions)
+type StatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ChangedAt     string                 `protobuf:"bytes,2,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	ChangedBy     string                 `protobuf:"bytes,3,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusChange) Reset() {
+	*x = StatusChange{}
+	mi := &file_application_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusChange) ProtoMessage() {}
+
+func (x *StatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusChange.ProtoReflect.Descriptor instead.
+func (*StatusChange) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StatusChange) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StatusChange) GetChangedAt() string {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return ""
+}
+
+func (x *StatusChange) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+// Комментарий к заявке. Ответы образуют ветку под комментарием верхнего уровня (один уровень вложенности)
+type Comment struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CommentUuid       string                 `protobuf:"bytes,1,opt,name=comment_uuid,json=commentUuid,proto3" json:"comment_uuid,omitempty"`
+	ApplicationUuid   string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
+	ParentCommentUuid string                 `protobuf:"bytes,3,opt,name=parent_comment_uuid,json=parentCommentUuid,proto3" json:"parent_comment_uuid,omitempty"` // пусто у комментария верхнего уровня
+	Text              string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`                                                      // пусто у удалённого комментария
+	MentionedUuids    []string               `protobuf:"bytes,5,rep,name=mentioned_uuids,json=mentionedUuids,proto3" json:"mentioned_uuids,omitempty"`            // упомянутые сотрудники компании
+	CreatedAt         string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy         string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	EditedAt          string                 `protobuf:"bytes,8,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"` // время последнего изменения, если комментарий редактировался
+	IsDeleted         bool                   `protobuf:"varint,9,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
+	DeletedAt         string                 `protobuf:"bytes,10,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy         string                 `protobuf:"bytes,11,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	Replies           []*Comment             `protobuf:"bytes,12,rep,name=replies,proto3" json:"replies,omitempty"` // только у комментариев верхнего уровня
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_application_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Comment) GetCommentUuid() string {
+	if x != nil {
+		return x.CommentUuid
+	}
+	return ""
+}
+
+func (x *Comment) GetApplicationUuid() string {
+	if x != nil {
+		return x.ApplicationUuid
+	}
+	return ""
+}
+
+func (x *Comment) GetParentCommentUuid() string {
+	if x != nil {
+		return x.ParentCommentUuid
+	}
+	return ""
+}
+
+func (x *Comment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Comment) GetMentionedUuids() []string {
+	if x != nil {
+		return x.MentionedUuids
+	}
+	return nil
+}
+
+func (x *Comment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Comment) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Comment) GetEditedAt() string {
+	if x != nil {
+		return x.EditedAt
+	}
+	return ""
+}
+
+func (x *Comment) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *Comment) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Comment) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *Comment) GetReplies() []*Comment {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+// Предыдущая редакция комментария
+type CommentEdit struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Text           string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	MentionedUuids []string               `protobuf:"bytes,2,rep,name=mentioned_uuids,json=mentionedUuids,proto3" json:"mentioned_uuids,omitempty"`
+	EditedAt       string                 `protobuf:"bytes,3,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"` // когда редакцию заменили
+	EditedBy       string                 `protobuf:"bytes,4,opt,name=edited_by,json=editedBy,proto3" json:"edited_by,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CommentEdit) Reset() {
+	*x = CommentEdit{}
+	mi := &file_application_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentEdit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentEdit) ProtoMessage() {}
+
+func (x *CommentEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentEdit.ProtoReflect.Descriptor instead.
+func (*CommentEdit) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CommentEdit) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *CommentEdit) GetMentionedUuids() []string {
+	if x != nil {
+		return x.MentionedUuids
+	}
+	return nil
+}
+
+func (x *CommentEdit) GetEditedAt() string {
+	if x != nil {
+		return x.EditedAt
+	}
+	return ""
+}
+
+func (x *CommentEdit) GetEditedBy() string {
+	if x != nil {
+		return x.EditedBy
+	}
+	return ""
+}
+
+type ApplicationData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicationData) Reset() {
+	*x = ApplicationData{}
+	mi := &file_application_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationData) ProtoMessage() {}
+
+func (x *ApplicationData) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationData.ProtoReflect.Descriptor instead.
+func (*ApplicationData) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ApplicationData) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ApplicationData) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Health
+// Empty request
+type HealthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Postgres      string                 `protobuf:"bytes,2,opt,name=postgres,proto3" json:"postgres,omitempty"`
+	Redis         string                 `protobuf:"bytes,3,opt,name=redis,proto3" json:"redis,omitempty"`
+	Minio         string                 `protobuf:"bytes,4,opt,name=minio,proto3" json:"minio,omitempty"`
+	Mongo         string                 `protobuf:"bytes,5,opt,name=mongo,proto3" json:"mongo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthResponse) Reset() {
+	*x = HealthResponse{}
+	mi := &file_application_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthResponse) ProtoMessage() {}
+
+func (x *HealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
+func (*HealthResponse) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *HealthResponse) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *HealthResponse) GetPostgres() string {
+	if x != nil {
+		return x.Postgres
+	}
+	return ""
+}
+
+func (x *HealthResponse) GetRedis() string {
+	if x != nil {
+		return x.Redis
+	}
+	return ""
+}
+
+func (x *HealthResponse) GetMinio() string {
+	if x != nil {
+		return x.Minio
+	}
+	return ""
+}
+
+func (x *HealthResponse) GetMongo() string {
+	if x != nil {
+		return x.Mongo
+	}
+	return ""
+}
+
+// CreateApplication
+type CreateApplicationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid     string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	ApplicationData *ApplicationData       `protobuf:"bytes,3,opt,name=application_data,json=applicationData,proto3" json:"application_data,omitempty"`
+	Priority        string                 `protobuf:"bytes,4,opt,name=priority,proto3" json:"priority,omitempty"`                             // по умолчанию normal
+	DueAt           string                 `protobuf:"bytes,5,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`                      // RFC3339, необязательно
+	LocationUuid    string                 `protobuf:"bytes,6,opt,name=location_uuid,json=locationUuid,proto3" json:"location_uuid,omitempty"` // место дефекта из company сервиса, необязательно
+	CategoryUuid    string                 `protobuf:"bytes,7,opt,name=category_uuid,json=categoryUuid,proto3" json:"category_uuid,omitempty"` // необязательно, заявка попадает в департамент по умолчанию категории
+	Severity        string                 `protobuf:"bytes,8,opt,name=severity,proto3" json:"severity,omitempty"`                             // необязательно
+	CustomFields    []*CustomFieldValue    `protobuf:"bytes,9,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateApplicationRequest) Reset() {
+	*x = CreateApplicationRequest{}
+	mi := &file_application_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApplicationRequest) ProtoMessage() {}
+
+func (x *CreateApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApplicationRequest.ProtoReflect.Descriptor instead.
+func (*CreateApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateApplicationRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *CreateApplicationRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *CreateApplicationRequest) GetApplicationData() *ApplicationData {
+	if x != nil {
+		return x.ApplicationData
+	}
+	return nil
+}
+
+func (x *CreateApplicationRequest) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *CreateApplicationRequest) GetDueAt() string {
+	if x != nil {
+		return x.DueAt
+	}
+	return ""
+}
+
+func (x *CreateApplicationRequest) GetLocationUuid() string {
+	if x != nil {
+		return x.LocationUuid
+	}
+	return ""
+}
+
+func (x *CreateApplicationRequest) GetCategoryUuid() string {
+	if x != nil {
+		return x.CategoryUuid
+	}
+	return ""
+}
+
+func (x *CreateApplicationRequest) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *CreateApplicationRequest) GetCustomFields() []*CustomFieldValue {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+type CreateApplicationResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationUuid string                 `protobuf:"bytes,1,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateApplicationResponse) Reset() {
+	*x = CreateApplicationResponse{}
+	mi := &file_application_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApplicationResponse) ProtoMessage() {}
+
+func (x *CreateApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApplicationResponse.ProtoReflect.Descriptor instead.
+func (*CreateApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateApplicationResponse) GetApplicationUuid() string {
+	if x != nil {
+		return x.ApplicationUuid
+	}
+	return ""
+}
+
+// GetApplication
+type GetApplicationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetApplicationRequest) Reset() {
+	*x = GetApplicationRequest{}
+	mi := &file_application_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationRequest) ProtoMessage() {}
+
+func (x *GetApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetApplicationRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *GetApplicationRequest) GetApplicationUuid() string {
+	if x != nil {
+		return x.ApplicationUuid
+	}
+	return ""
+}
+
+type GetApplicationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Application   *Application           `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApplicationResponse) Reset() {
+	*x = GetApplicationResponse{}
+	mi := &file_application_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationResponse) ProtoMessage() {}
+
+func (x *GetApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationResponse.ProtoReflect.Descriptor instead.
+func (*GetApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetApplicationResponse) GetApplication() *Application {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+// GetApplications
+type GetApplicationsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid    string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid      string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	DepartmentUuid   string                 `protobuf:"bytes,3,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
+	Statuses         []string               `protobuf:"bytes,4,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	Count            int64                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Offset           int64                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	IsDeleted        bool                   `protobuf:"varint,7,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
+	FromPool         bool                   `protobuf:"varint,8,opt,name=from_pool,json=fromPool,proto3" json:"from_pool,omitempty"`          // true - заявки из пула (для инспекторов, менеджеров) false - (личные заявки)
+	IsOverdue        bool                   `protobuf:"varint,9,opt,name=is_overdue,json=isOverdue,proto3" json:"is_overdue,omitempty"`       // только заявки с нарушенным SLA
+	Search           string                 `protobuf:"bytes,10,opt,name=search,proto3" json:"search,omitempty"`                              // полнотекстовый поиск по названию, описанию и fix log-ам
+	CreatedFrom      string                 `protobuf:"bytes,11,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"` // RFC3339 или YYYY-MM-DD, включительно
+	CreatedTo        string                 `protobuf:"bytes,12,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`       // RFC3339 или YYYY-MM-DD, не включительно
+	ClosedFrom       string                 `protobuf:"bytes,13,opt,name=closed_from,json=closedFrom,proto3" json:"closed_from,omitempty"`
+	ClosedTo         string                 `protobuf:"bytes,14,opt,name=closed_to,json=closedTo,proto3" json:"closed_to,omitempty"`
+	CreatedBy        string                 `protobuf:"bytes,15,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	ExecutedBy       string                 `protobuf:"bytes,16,opt,name=executed_by,json=executedBy,proto3" json:"executed_by,omitempty"`
+	ManagedBy        string                 `protobuf:"bytes,17,opt,name=managed_by,json=managedBy,proto3" json:"managed_by,omitempty"`
+	RevisionCountMin *int64                 `protobuf:"varint,18,opt,name=revision_count_min,json=revisionCountMin,proto3,oneof" json:"revision_count_min,omitempty"`
+	RevisionCountMax *int64                 `protobuf:"varint,19,opt,name=revision_count_max,json=revisionCountMax,proto3,oneof" json:"revision_count_max,omitempty"`
+	SortBy           string                 `protobuf:"bytes,20,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`                   // created_at (по умолчанию), updated_at, closed_at, due_at, priority, revision_count, relevance
+	SortOrder        string                 `protobuf:"bytes,21,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`          // asc, desc (по умолчанию)
+	Cursor           string                 `protobuf:"bytes,22,opt,name=cursor,proto3" json:"cursor,omitempty"`                                 // next_cursor предыдущей страницы (только для сортировки по created_at, вместо offset)
+	LocationUuid     string                 `protobuf:"bytes,23,opt,name=location_uuid,json=locationUuid,proto3" json:"location_uuid,omitempty"` // заявки в этом месте и во всех вложенных (например, все этажи здания)
+	CategoryUuid     string                 `protobuf:"bytes,24,opt,name=category_uuid,json=categoryUuid,proto3" json:"category_uuid,omitempty"`
+	Severities       []string               `protobuf:"bytes,25,rep,name=severities,proto3" json:"severities,omitempty"`
+	CustomFields     []*CustomFieldValue    `protobuf:"bytes,26,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"` // точное совпадение значений всех перечисленных полей
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetApplicationsRequest) Reset() {
+	*x = GetApplicationsRequest{}
+	mi := &file_application_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationsRequest) ProtoMessage() {}
+
+func (x *GetApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetApplicationsRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *GetApplicationsRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *GetApplicationsRequest) GetDepartmentUuid() string {
+	if x != nil {
+		return x.DepartmentUuid
+	}
+	return ""
+}
+
+func (x *GetApplicationsRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *GetApplicationsRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetApplicationsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetApplicationsRequest) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *GetApplicationsRequest) GetFromPool() bool {
+	if x != nil {
+		return x.FromPool
+	}
+	return false
+}
+
+func (x *GetApplicationsRequest) GetIsOverdue() bool {
+	if x != nil {
+		return x.IsOverdue
+	}
+	return false
+}
+
+func (x *GetApplicationsRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *GetApplicationsRequest) GetCreatedFrom() string {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return ""
+}
+
+func (x *GetApplicationsRequest) GetCreatedTo() string {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return ""
+}
+
+func (x *GetApplicationsRequest) GetClosedFrom() string {
+	if x != nil {
+		return x.ClosedFrom
+	}
+	return ""
+}
+
+func (x *GetApplicationsRequest) GetClosedTo() string {
+	if x != nil {
+		return x.ClosedTo
+	}
+	return ""
+}
+
+func (x *GetApplicationsRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *GetApplicationsRequest) GetExecutedBy() string {
+	if x != nil {
+		return x.ExecutedBy
+	}
+	return ""
+}
+
+func (x *GetApplicationsRequest) GetManagedBy() string {
+	if x != nil {
+		return x.ManagedBy
+	}
+	return ""
+}
+
+func (x *GetApplicationsRequest) GetRevisionCountMin() int64 {
+	if x != nil && x.RevisionCountMin != nil {
+		return *x.RevisionCountMin
+	}
+	return 0
+}
+
+func (x *GetApplicationsRequest) GetRevisionCountMax() int64 {
+	if x != nil && x.RevisionCountMax != nil {
+		return *x.RevisionCountMax
+	}
+	return 0
+}
+
+func (x *GetApplicationsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *GetApplicationsRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *GetApplicationsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetApplicationsRequest) GetLocationUuid() string {
+	if x != nil {
+		return x.LocationUuid
+	}
+	return ""
+}
+
+func (x *GetApplicationsRequest) GetCategoryUuid() string {
+	if x != nil {
+		return x.CategoryUuid
+	}
+	return ""
+}
+
+func (x *GetApplicationsRequest) GetSeverities() []string {
+	if x != nil {
+		return x.Severities
+	}
+	return nil
+}
+
+func (x *GetApplicationsRequest) GetCustomFields() []*CustomFieldValue {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+type GetApplicationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applications  []*Application         `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // пусто - страниц больше нет
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApplicationsResponse) Reset() {
+	*x = GetApplicationsResponse{}
+	mi := &file_application_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationsResponse) ProtoMessage() {}
+
+func (x *GetApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*GetApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetApplicationsResponse) GetApplications() []*Application {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
+func (x *GetApplicationsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetApplicationsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// UpdateApplicationStatus
+type UpdateApplicationStatusRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
+	Status          string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Message         string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"` // сохраняется в fix log, обязательно, если этого требует переход workflow
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateApplicationStatusRequest) Reset() {
+	*x = UpdateApplicationStatusRequest{}
+	mi := &file_application_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateApplicationStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateApplicationStatusRequest) ProtoMessage() {}
+
+func (x *UpdateApplicationStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateApplicationStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateApplicationStatusRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateApplicationStatusRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *UpdateApplicationStatusRequest) GetApplicationUuid() string {
+	if x != nil {
+		return x.ApplicationUuid
+	}
+	return ""
+}
+
+func (x *UpdateApplicationStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateApplicationStatusRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// AssignApplication
+type AssignApplicationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
+	TargetUuid      string                 `protobuf:"bytes,3,opt,name=target_uuid,json=targetUuid,proto3" json:"target_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AssignApplicationRequest) Reset() {
+	*x = AssignApplicationRequest{}
+	mi := &file_application_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignApplicationRequest) ProtoMessage() {}
+
+func (x *AssignApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignApplicationRequest.ProtoReflect.Descriptor instead.
+func (*AssignApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AssignApplicationRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *AssignApplicationRequest) GetApplicationUuid() string {
+	if x != nil {
+		return x.ApplicationUuid
+	}
+	return ""
+}
+
+func (x *AssignApplicationRequest) GetTargetUuid() string {
+	if x != nil {
+		return x.TargetUuid
+	}
+	return ""
+}
+
+// RedirectApplication
+type RedirectApplicationRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid        string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	ApplicationUuid      string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
+	TargetDepartmentUuid string                 `protobuf:"bytes,3,opt,name=target_department_uuid,json=targetDepartmentUuid,proto3" json:"target_department_uuid,omitempty"`
+	Message              string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RedirectApplicationRequest) Reset() {
+	*x = RedirectApplicationRequest{}
+	mi := &file_application_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedirectApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedirectApplicationRequest) ProtoMessage() {}
+
+func (x *RedirectApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedirectApplicationRequest.ProtoReflect.Descriptor instead.
+func (*RedirectApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RedirectApplicationRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *RedirectApplicationRequest) GetApplicationUuid() string {
+	if x != nil {
+		return x.ApplicationUuid
+	}
+	return ""
+}
+
+func (x *RedirectApplicationRequest) GetTargetDepartmentUuid() string {
+	if x != nil {
+		return x.TargetDepartmentUuid
+	}
+	return ""
+}
+
+func (x *RedirectApplicationRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// RecallApplication
+type RecallApplicationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
+	Message         string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RecallApplicationRequest) Reset() {
+	*x = RecallApplicationRequest{}
+	mi := &file_application_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecallApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecallApplicationRequest) ProtoMessage() {}
+
+func (x *RecallApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecallApplicationRequest.ProtoReflect.Descriptor instead.
+func (*RecallApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RecallApplicationRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *RecallApplicationRequest) GetApplicationUuid() string {
+	if x != nil {
+		return x.ApplicationUuid
+	}
+	return ""
+}
+
+func (x *RecallApplicationRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// TakeApplicationToVerification
+type TakeApplicationToVerificationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TakeApplicationToVerificationRequest) Reset() {
+	*x = TakeApplicationToVerificationRequest{}
+	mi := &file_application_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TakeApplicationToVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakeApplicationToVerificationRequest) ProtoMessage() {}
+
+func (x *TakeApplicationToVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakeApplicationToVerificationRequest.ProtoReflect.Descriptor instead.
+func (*TakeApplicationToVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TakeApplicationToVerificationRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *TakeApplicationToVerificationRequest) GetApplicationUuid() string {
+	if x != nil {
+		return x.ApplicationUuid
+	}
+	return ""
+}
+
+// ReleaseApplicationVerification
+type ReleaseApplicationVerificationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
+	Message         string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReleaseApplicationVerificationRequest) Reset() {
+	*x = ReleaseApplicationVerificationRequest{}
+	mi := &file_application_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseApplicationVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseApplicationVerificationRequest) ProtoMessage() {}
+
+func (x *ReleaseApplicationVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseApplicationVerificationRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseApplicationVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReleaseApplicationVerificationRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *ReleaseApplicationVerificationRequest) GetApplicationUuid() string {
+	if x != nil {
+		return x.ApplicationUuid
+	}
+	return ""
+}
+
+func (x *ReleaseApplicationVerificationRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// AddApplicationFixLog
+type AddApplicationFixLogRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
+	Message         string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AddApplicationFixLogRequest) Reset() {
+	*x = AddApplicationFixLogRequest{}
+	mi := &file_application_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddApplicationFixLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddApplicationFixLogRequest) ProtoMessage() {}
+
+func (x *AddApplicationFixLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddApplicationFixLogRequest.ProtoReflect.Descriptor instead.
+func (*AddApplicationFixLogRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AddApplicationFixLogRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *AddApplicationFixLogRequest) GetApplicationUuid() string {
+	if x != nil {
+		return x.ApplicationUuid
+	}
+	return ""
+}
+
+func (x *AddApplicationFixLogRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type AddApplicationFixLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FixLogUuid    string                 `protobuf:"bytes,1,opt,name=fix_log_uuid,json=fixLogUuid,proto3" json:"fix_log_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddApplicationFixLogResponse) Reset() {
+	*x = AddApplicationFixLogResponse{}
+	mi := &file_application_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddApplicationFixLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddApplicationFixLogResponse) ProtoMessage() {}
+
+func (x *AddApplicationFixLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddApplicationFixLogResponse.ProtoReflect.Descriptor instead.
+func (*AddApplicationFixLogResponse) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AddApplicationFixLogResponse) GetFixLogUuid() string {
+	if x != nil {
+		return x.FixLogUuid
+	}
+	return ""
+}
+
+// DeleteApplication
+type DeleteApplicationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
+	Message         string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteApplicationRequest) Reset() {
+	*x = DeleteApplicationRequest{}
+	mi := &file_application_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteApplicationRequest) ProtoMessage() {}
+
+func (x *DeleteApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteApplicationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteApplicationRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *DeleteApplicationRequest) GetApplicationUuid() string {
+	if x != nil {
+		return x.ApplicationUuid
+	}
+	return ""
+}
+
+func (x *DeleteApplicationRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GetApplicationHistory
+type GetApplicationHistoryRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
+	Offset          int64                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Count           int64                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Cursor          string                 `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"` // next_cursor предыдущей страницы (вместо offset)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetApplicationHistoryRequest) Reset() {
+	*x = GetApplicationHistoryRequest{}
+	mi := &file_application_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationHistoryRequest) ProtoMessage() {}
+
+func (x *GetApplicationHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetApplicationHistoryRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *GetApplicationHistoryRequest) GetApplicationUuid() string {
+	if x != nil {
+		return x.ApplicationUuid
+	}
+	return ""
+}
+
+func (x *GetApplicationHistoryRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetApplicationHistoryRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetApplicationHistoryRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetApplicationHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	History       []*Application         `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // пусто - страниц больше нет
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApplicationHistoryResponse) Reset() {
+	*x = GetApplicationHistoryResponse{}
+	mi := &file_application_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationHistoryResponse) ProtoMessage() {}
+
+func (x *GetApplicationHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetApplicationHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetApplicationHistoryResponse) GetHistory() []*Application {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *GetApplicationHistoryResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetApplicationHistoryResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// UploadApplicationAttachment
+type UploadApplicationAttachmentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
+	FixLogUuid      string                 `protobuf:"bytes,3,opt,name=fix_log_uuid,json=fixLogUuid,proto3" json:"fix_log_uuid,omitempty"` // необязательно - привязка вложения к записи fix log-а
+	FileName        string                 `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType     string                 `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content         []byte                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UploadApplicationAttachmentRequest) Reset() {
+	*x = UploadApplicationAttachmentRequest{}
+	mi := &file_application_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadApplicationAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadApplicationAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadApplicationAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadApplicationAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadApplicationAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UploadApplicationAttachmentRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *UploadApplicationAttachmentRequest) GetApplicationUuid() string {
+	if x != nil {
+		return x.ApplicationUuid
+	}
+	return ""
+}
+
+func (x *UploadApplicationAttachmentRequest) GetFixLogUuid() string {
+	if x != nil {
+		return x.FixLogUuid
+	}
+	return ""
+}
+
+func (x *UploadApplicationAttachmentRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *UploadApplicationAttachmentRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *UploadApplicationAttachmentRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type UploadApplicationAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadApplicationAttachmentResponse) Reset() {
+	*x = UploadApplicationAttachmentResponse{}
+	mi := &file_application_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadApplicationAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadApplicationAttachmentResponse) ProtoMessage() {}
+
+func (x *UploadApplicationAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadApplicationAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadApplicationAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UploadApplicationAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+// GetApplicationAttachment
+type GetApplicationAttachmentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
+	AttachmentUuid  string                 `protobuf:"bytes,3,opt,name=attachment_uuid,json=attachmentUuid,proto3" json:"attachment_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetApplicationAttachmentRequest) Reset() {
+	*x = GetApplicationAttachmentRequest{}
+	mi := &file_application_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationAttachmentRequest) ProtoMessage() {}
+
+func (x *GetApplicationAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetApplicationAttachmentRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *GetApplicationAttachmentRequest) GetApplicationUuid() string {
+	if x != nil {
+		return x.ApplicationUuid
+	}
+	return ""
+}
+
+func (x *GetApplicationAttachmentRequest) GetAttachmentUuid() string {
+	if x != nil {
+		return x.AttachmentUuid
+	}
+	return ""
+}
+
+type GetApplicationAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApplicationAttachmentResponse) Reset() {
+	*x = GetApplicationAttachmentResponse{}
+	mi := &file_application_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationAttachmentResponse) ProtoMessage() {}
+
+func (x *GetApplicationAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*GetApplicationAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetApplicationAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *GetApplicationAttachmentResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// DeleteApplicationAttachment
+type DeleteApplicationAttachmentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
+	AttachmentUuid  string                 `protobuf:"bytes,3,opt,name=attachment_uuid,json=attachmentUuid,proto3" json:"attachment_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteApplicationAttachmentRequest) Reset() {
+	*x = DeleteApplicationAttachmentRequest{}
+	mi := &file_application_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteApplicationAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteApplicationAttachmentRequest) ProtoMessage() {}
+
+func (x *DeleteApplicationAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteApplicationAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteApplicationAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteApplicationAttachmentRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *DeleteApplicationAttachmentRequest) GetApplicationUuid() string {
+	if x != nil {
+		return x.ApplicationUuid
+	}
+	return ""
+}
+
+func (x *DeleteApplicationAttachmentRequest) GetAttachmentUuid() string {
+	if x != nil {
+		return x.AttachmentUuid
+	}
+	return ""
+}
+
+// WatchApplications
+// Фильтр совпадает с GetApplicationsRequest (без пагинации)
+type WatchApplicationsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid  string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid    string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	DepartmentUuid string                 `protobuf:"bytes,3,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
+	Statuses       []string               `protobuf:"bytes,4,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	IsDeleted      bool                   `protobuf:"varint,5,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
+	FromPool       bool                   `protobuf:"varint,6,opt,name=from_pool,json=fromPool,proto3" json:"from_pool,omitempty"`
+	IsOverdue      bool                   `protobuf:"varint,7,opt,name=is_overdue,json=isOverdue,proto3" json:"is_overdue,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WatchApplicationsRequest) Reset() {
+	*x = WatchApplicationsRequest{}
+	mi := &file_application_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchApplicationsRequest) ProtoMessage() {}
+
+func (x *WatchApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*WatchApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *WatchApplicationsRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *WatchApplicationsRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *WatchApplicationsRequest) GetDepartmentUuid() string {
+	if x != nil {
+		return x.DepartmentUuid
+	}
+	return ""
+}
+
+func (x *WatchApplicationsRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *WatchApplicationsRequest) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *WatchApplicationsRequest) GetFromPool() bool {
+	if x != nil {
+		return x.FromPool
+	}
+	return false
+}
+
+func (x *WatchApplicationsRequest) GetIsOverdue() bool {
+	if x != nil {
+		return x.IsOverdue
+	}
+	return false
+}
+
+type ApplicationUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventType     string                 `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Application   *Application           `protobuf:"bytes,2,opt,name=application,proto3" json:"application,omitempty"` // краткие данные заявки (как в GetApplicationsResponse) + department_uuid, version
+	Removed       bool                   `protobuf:"varint,3,opt,name=removed,proto3" json:"removed,omitempty"`        // заявка перестала попадать под фильтр (взята из пула, перенаправлена, удалена, ...)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicationUpdate) Reset() {
+	*x = ApplicationUpdate{}
+	mi := &file_application_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationUpdate) ProtoMessage() {}
+
+func (x *ApplicationUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationUpdate.ProtoReflect.Descriptor instead.
+func (*ApplicationUpdate) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ApplicationUpdate) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *ApplicationUpdate) GetApplication() *Application {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+func (x *ApplicationUpdate) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+// GetApplicationActions
+type GetApplicationActionsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetApplicationActionsRequest) Reset() {
+	*x = GetApplicationActionsRequest{}
+	mi := &file_application_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationActionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationActionsRequest) ProtoMessage() {}
+
+func (x *GetApplicationActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationActionsRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationActionsRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetApplicationActionsRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *GetApplicationActionsRequest) GetApplicationUuid() string {
+	if x != nil {
+		return x.ApplicationUuid
+	}
+	return ""
+}
+
+type GetApplicationActionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actions       []*WorkflowTransition  `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"` // переходы, доступные инициатору из текущего статуса заявки
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApplicationActionsResponse) Reset() {
+	*x = GetApplicationActionsResponse{}
+	mi := &file_application_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationActionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationActionsResponse) ProtoMessage() {}
+
+func (x *GetApplicationActionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationActionsResponse.ProtoReflect.Descriptor instead.
+func (*GetApplicationActionsResponse) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetApplicationActionsResponse) GetActions() []*WorkflowTransition {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+// GetCompanyWorkflow
+type GetCompanyWorkflowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyWorkflowRequest) Reset() {
+	*x = GetCompanyWorkflowRequest{}
+	mi := &file_application_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyWorkflowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyWorkflowRequest) ProtoMessage() {}
+
+func (x *GetCompanyWorkflowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyWorkflowRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetCompanyWorkflowRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *GetCompanyWorkflowRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+type GetCompanyWorkflowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workflow      *Workflow              `protobuf:"bytes,1,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,2,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"` // компания не настраивала workflow
+	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,4,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyWorkflowResponse) Reset() {
+	*x = GetCompanyWorkflowResponse{}
+	mi := &file_application_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyWorkflowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyWorkflowResponse) ProtoMessage() {}
+
+func (x *GetCompanyWorkflowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyWorkflowResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanyWorkflowResponse) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetCompanyWorkflowResponse) GetWorkflow() *Workflow {
+	if x != nil {
+		return x.Workflow
+	}
+	return nil
+}
+
+func (x *GetCompanyWorkflowResponse) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *GetCompanyWorkflowResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *GetCompanyWorkflowResponse) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+// UpdateCompanyWorkflow
+type UpdateCompanyWorkflowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	Workflow      *Workflow              `protobuf:"bytes,3,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCompanyWorkflowRequest) Reset() {
+	*x = UpdateCompanyWorkflowRequest{}
+	mi := &file_application_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCompanyWorkflowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompanyWorkflowRequest) ProtoMessage() {}
+
+func (x *UpdateCompanyWorkflowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompanyWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCompanyWorkflowRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UpdateCompanyWorkflowRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *UpdateCompanyWorkflowRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *UpdateCompanyWorkflowRequest) GetWorkflow() *Workflow {
+	if x != nil {
+		return x.Workflow
+	}
+	return nil
+}
+
+// ResetCompanyWorkflow
+type ResetCompanyWorkflowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetCompanyWorkflowRequest) Reset() {
+	*x = ResetCompanyWorkflowRequest{}
+	mi := &file_application_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetCompanyWorkflowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetCompanyWorkflowRequest) ProtoMessage() {}
+
+func (x *ResetCompanyWorkflowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetCompanyWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*ResetCompanyWorkflowRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ResetCompanyWorkflowRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *ResetCompanyWorkflowRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+// GetCompanySLAPolicies
+type GetCompanySLAPoliciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanySLAPoliciesRequest) Reset() {
+	*x = GetCompanySLAPoliciesRequest{}
+	mi := &file_application_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanySLAPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanySLAPoliciesRequest) ProtoMessage() {}
+
+func (x *GetCompanySLAPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanySLAPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanySLAPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetCompanySLAPoliciesRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *GetCompanySLAPoliciesRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+type GetCompanySLAPoliciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policies      []*SLAPolicy           `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanySLAPoliciesResponse) Reset() {
+	*x = GetCompanySLAPoliciesResponse{}
+	mi := &file_application_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanySLAPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanySLAPoliciesResponse) ProtoMessage() {}
+
+func (x *GetCompanySLAPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanySLAPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanySLAPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetCompanySLAPoliciesResponse) GetPolicies() []*SLAPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+// UpdateCompanySLAPolicy
+type UpdateCompanySLAPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	Policy        *SLAPolicy             `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCompanySLAPolicyRequest) Reset() {
+	*x = UpdateCompanySLAPolicyRequest{}
+	mi := &file_application_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCompanySLAPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompanySLAPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateCompanySLAPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompanySLAPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCompanySLAPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateCompanySLAPolicyRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *UpdateCompanySLAPolicyRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *UpdateCompanySLAPolicyRequest) GetPolicy() *SLAPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// DeleteCompanySLAPolicy
+type DeleteCompanySLAPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	Priority      string                 `protobuf:"bytes,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCompanySLAPolicyRequest) Reset() {
+	*x = DeleteCompanySLAPolicyRequest{}
+	mi := &file_application_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCompanySLAPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCompanySLAPolicyRequest) ProtoMessage() {}
+
+func (x *DeleteCompanySLAPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCompanySLAPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCompanySLAPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteCompanySLAPolicyRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *DeleteCompanySLAPolicyRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *DeleteCompanySLAPolicyRequest) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+// GetApplicationStatusStats
+type GetApplicationStatusStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	Filter        *AnalyticsFilter       `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Interval      string                 `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"` // day, week, month
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApplicationStatusStatsRequest) Reset() {
+	*x = GetApplicationStatusStatsRequest{}
+	mi := &file_application_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationStatusStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationStatusStatsRequest) ProtoMessage() {}
+
+func (x *GetApplicationStatusStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationStatusStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationStatusStatsRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetApplicationStatusStatsRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *GetApplicationStatusStatsRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *GetApplicationStatusStatsRequest) GetFilter() *AnalyticsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetApplicationStatusStatsRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+type GetApplicationStatusStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         []*StatusStat          `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApplicationStatusStatsResponse) Reset() {
+	*x = GetApplicationStatusStatsResponse{}
+	mi := &file_application_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationStatusStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationStatusStatsResponse) ProtoMessage() {}
+
+func (x *GetApplicationStatusStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationStatusStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetApplicationStatusStatsResponse) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetApplicationStatusStatsResponse) GetStats() []*StatusStat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// GetApplicationLeadTimes
+type GetApplicationLeadTimesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	Filter        *AnalyticsFilter       `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApplicationLeadTimesRequest) Reset() {
+	*x = GetApplicationLeadTimesRequest{}
+	mi := &file_application_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationLeadTimesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationLeadTimesRequest) ProtoMessage() {}
+
+func (x *GetApplicationLeadTimesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationLeadTimesRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationLeadTimesRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetApplicationLeadTimesRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *GetApplicationLeadTimesRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *GetApplicationLeadTimesRequest) GetFilter() *AnalyticsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetApplicationLeadTimesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assign        *LeadTime              `protobuf:"bytes,1,opt,name=assign,proto3" json:"assign,omitempty"` // создание -> первое назначение инженера
+	Fix           *LeadTime              `protobuf:"bytes,2,opt,name=fix,proto3" json:"fix,omitempty"`       // первое назначение -> первая отправка на проверку
+	Verify        *LeadTime              `protobuf:"bytes,3,opt,name=verify,proto3" json:"verify,omitempty"` // последняя отправка на проверку -> закрытие
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApplicationLeadTimesResponse) Reset() {
+	*x = GetApplicationLeadTimesResponse{}
+	mi := &file_application_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationLeadTimesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationLeadTimesResponse) ProtoMessage() {}
+
+func (x *GetApplicationLeadTimesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationLeadTimesResponse.ProtoReflect.Descriptor instead.
+func (*GetApplicationLeadTimesResponse) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetApplicationLeadTimesResponse) GetAssign() *LeadTime {
+	if x != nil {
+		return x.Assign
+	}
+	return nil
+}
+
+func (x *GetApplicationLeadTimesResponse) GetFix() *LeadTime {
+	if x != nil {
+		return x.Fix
+	}
+	return nil
+}
+
+func (x *GetApplicationLeadTimesResponse) GetVerify() *LeadTime {
+	if x != nil {
+		return x.Verify
+	}
+	return nil
+}
+
+// GetEngineerRevisionStats
+type GetEngineerRevisionStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	Filter        *AnalyticsFilter       `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEngineerRevisionStatsRequest) Reset() {
+	*x = GetEngineerRevisionStatsRequest{}
+	mi := &file_application_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEngineerRevisionStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEngineerRevisionStatsRequest) ProtoMessage() {}
+
+func (x *GetEngineerRevisionStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEngineerRevisionStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetEngineerRevisionStatsRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetEngineerRevisionStatsRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *GetEngineerRevisionStatsRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *GetEngineerRevisionStatsRequest) GetFilter() *AnalyticsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetEngineerRevisionStatsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Stats         []*EngineerRevisionStat `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEngineerRevisionStatsResponse) Reset() {
+	*x = GetEngineerRevisionStatsResponse{}
+	mi := &file_application_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEngineerRevisionStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEngineerRevisionStatsResponse) ProtoMessage() {}
+
+func (x *GetEngineerRevisionStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEngineerRevisionStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetEngineerRevisionStatsResponse) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetEngineerRevisionStatsResponse) GetStats() []*EngineerRevisionStat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// GetDepartmentFailureStats
+type GetDepartmentFailureStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	Filter        *AnalyticsFilter       `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDepartmentFailureStatsRequest) Reset() {
+	*x = GetDepartmentFailureStatsRequest{}
+	mi := &file_application_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDepartmentFailureStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepartmentFailureStatsRequest) ProtoMessage() {}
+
+func (x *GetDepartmentFailureStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepartmentFailureStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetDepartmentFailureStatsRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetDepartmentFailureStatsRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *GetDepartmentFailureStatsRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *GetDepartmentFailureStatsRequest) GetFilter() *AnalyticsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetDepartmentFailureStatsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Stats         []*DepartmentFailureStat `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDepartmentFailureStatsResponse) Reset() {
+	*x = GetDepartmentFailureStatsResponse{}
+	mi := &file_application_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDepartmentFailureStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepartmentFailureStatsResponse) ProtoMessage() {}
+
+func (x *GetDepartmentFailureStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepartmentFailureStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetDepartmentFailureStatsResponse) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetDepartmentFailureStatsResponse) GetStats() []*DepartmentFailureStat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// ExportApplications
+type ExportApplicationsRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Query         *GetApplicationsRequest `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"` // фильтры списка заявок; count, offset и cursor не используются, сортировка только по created_at
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportApplicationsRequest) Reset() {
+	*x = ExportApplicationsRequest{}
+	mi := &file_application_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportApplicationsRequest) ProtoMessage() {}
+
+func (x *ExportApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ExportApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ExportApplicationsRequest) GetQuery() *GetApplicationsRequest {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+type ExportedApplication struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Application   *Application           `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"` // вместе с fix_logs
+	Timeline      []*StatusChange        `protobuf:"bytes,2,rep,name=timeline,proto3" json:"timeline,omitempty"`       // начиная с создания заявки
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportedApplication) Reset() {
+	*x = ExportedApplication{}
+	mi := &file_application_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportedApplication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportedApplication) ProtoMessage() {}
+
+func (x *ExportedApplication) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportedApplication.ProtoReflect.Descriptor instead.
+func (*ExportedApplication) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ExportedApplication) GetApplication() *Application {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+func (x *ExportedApplication) GetTimeline() []*StatusChange {
+	if x != nil {
+		return x.Timeline
+	}
+	return nil
+}
+
+// CreateApplicationComment
+type CreateApplicationCommentRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid     string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	ApplicationUuid   string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
+	ParentCommentUuid string                 `protobuf:"bytes,3,opt,name=parent_comment_uuid,json=parentCommentUuid,proto3" json:"parent_comment_uuid,omitempty"` // необязательно - ответ в ветке комментария верхнего уровня
+	Text              string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	MentionedUuids    []string               `protobuf:"bytes,5,rep,name=mentioned_uuids,json=mentionedUuids,proto3" json:"mentioned_uuids,omitempty"` // @упоминания, только сотрудники компании, видящие комментарии заявки
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateApplicationCommentRequest) Reset() {
+	*x = CreateApplicationCommentRequest{}
+	mi := &file_application_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApplicationCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApplicationCommentRequest) ProtoMessage() {}
+
+func (x *CreateApplicationCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApplicationCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateApplicationCommentRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CreateApplicationCommentRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *CreateApplicationCommentRequest) GetApplicationUuid() string {
+	if x != nil {
+		return x.ApplicationUuid
+	}
+	return ""
+}
+
+func (x *CreateApplicationCommentRequest) GetParentCommentUuid() string {
+	if x != nil {
+		return x.ParentCommentUuid
+	}
+	return ""
+}
+
+func (x *CreateApplicationCommentRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *CreateApplicationCommentRequest) GetMentionedUuids() []string {
+	if x != nil {
+		return x.MentionedUuids
+	}
+	return nil
+}
+
+type CreateApplicationCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApplicationCommentResponse) Reset() {
+	*x = CreateApplicationCommentResponse{}
+	mi := &file_application_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApplicationCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApplicationCommentResponse) ProtoMessage() {}
+
+func (x *CreateApplicationCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApplicationCommentResponse.ProtoReflect.Descriptor instead.
+func (*CreateApplicationCommentResponse) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CreateApplicationCommentResponse) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+// GetApplicationComments
+// Пагинация по веткам: комментарии верхнего уровня от старых к новым, каждый со всеми ответами
+type GetApplicationCommentsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
+	Count           int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Offset          int64                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Cursor          string                 `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"` // next_cursor предыдущей страницы, заменяет offset
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetApplicationCommentsRequest) Reset() {
+	*x = GetApplicationCommentsRequest{}
+	mi := &file_application_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationCommentsRequest) ProtoMessage() {}
+
+func (x *GetApplicationCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationCommentsRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetApplicationCommentsRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *GetApplicationCommentsRequest) GetApplicationUuid() string {
+	if x != nil {
+		return x.ApplicationUuid
+	}
+	return ""
+}
+
+func (x *GetApplicationCommentsRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetApplicationCommentsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetApplicationCommentsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetApplicationCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // количество веток
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApplicationCommentsResponse) Reset() {
+	*x = GetApplicationCommentsResponse{}
+	mi := &file_application_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationCommentsResponse) ProtoMessage() {}
+
+func (x *GetApplicationCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationCommentsResponse.ProtoReflect.Descriptor instead.
+func (*GetApplicationCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetApplicationCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *GetApplicationCommentsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetApplicationCommentsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// UpdateApplicationComment
+type UpdateApplicationCommentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
+	CommentUuid     string                 `protobuf:"bytes,3,opt,name=comment_uuid,json=commentUuid,proto3" json:"comment_uuid,omitempty"`
+	Text            string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	MentionedUuids  []string               `protobuf:"bytes,5,rep,name=mentioned_uuids,json=mentionedUuids,proto3" json:"mentioned_uuids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateApplicationCommentRequest) Reset() {
+	*x = UpdateApplicationCommentRequest{}
+	mi := &file_application_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateApplicationCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateApplicationCommentRequest) ProtoMessage() {}
+
+func (x *UpdateApplicationCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateApplicationCommentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateApplicationCommentRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UpdateApplicationCommentRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *UpdateApplicationCommentRequest) GetApplicationUuid() string {
+	if x != nil {
+		return x.ApplicationUuid
+	}
+	return ""
+}
+
+func (x *UpdateApplicationCommentRequest) GetCommentUuid() string {
+	if x != nil {
+		return x.CommentUuid
+	}
+	return ""
+}
+
+func (x *UpdateApplicationCommentRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *UpdateApplicationCommentRequest) GetMentionedUuids() []string {
+	if x != nil {
+		return x.MentionedUuids
+	}
+	return nil
+}
+
+// DeleteApplicationComment
+type DeleteApplicationCommentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
+	CommentUuid     string                 `protobuf:"bytes,3,opt,name=comment_uuid,json=commentUuid,proto3" json:"comment_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteApplicationCommentRequest) Reset() {
+	*x = DeleteApplicationCommentRequest{}
+	mi := &file_application_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteApplicationCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteApplicationCommentRequest) ProtoMessage() {}
+
+func (x *DeleteApplicationCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteApplicationCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteApplicationCommentRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DeleteApplicationCommentRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *DeleteApplicationCommentRequest) GetApplicationUuid() string {
+	if x != nil {
+		return x.ApplicationUuid
+	}
+	return ""
+}
+
+func (x *DeleteApplicationCommentRequest) GetCommentUuid() string {
+	if x != nil {
+		return x.CommentUuid
+	}
+	return ""
+}
+
+// GetApplicationCommentHistory
+type GetApplicationCommentHistoryRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
+	CommentUuid     string                 `protobuf:"bytes,3,opt,name=comment_uuid,json=commentUuid,proto3" json:"comment_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetApplicationCommentHistoryRequest) Reset() {
+	*x = GetApplicationCommentHistoryRequest{}
+	mi := &file_application_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationCommentHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationCommentHistoryRequest) ProtoMessage() {}
+
+func (x *GetApplicationCommentHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationCommentHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationCommentHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetApplicationCommentHistoryRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *GetApplicationCommentHistoryRequest) GetApplicationUuid() string {
+	if x != nil {
+		return x.ApplicationUuid
+	}
+	return ""
+}
+
+func (x *GetApplicationCommentHistoryRequest) GetCommentUuid() string {
+	if x != nil {
+		return x.CommentUuid
+	}
+	return ""
+}
+
+type GetApplicationCommentHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"` // текущая редакция
+	Edits         []*CommentEdit         `protobuf:"bytes,2,rep,name=edits,proto3" json:"edits,omitempty"`     // предыдущие редакции, от новых к старым
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApplicationCommentHistoryResponse) Reset() {
+	*x = GetApplicationCommentHistoryResponse{}
+	mi := &file_application_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationCommentHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationCommentHistoryResponse) ProtoMessage() {}
+
+func (x *GetApplicationCommentHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationCommentHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetApplicationCommentHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetApplicationCommentHistoryResponse) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *GetApplicationCommentHistoryResponse) GetEdits() []*CommentEdit {
+	if x != nil {
+		return x.Edits
+	}
+	return nil
+}
+
+// CreateApplicationCategory
+type CreateApplicationCategoryRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid         string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid           string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	Title                 string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	DefaultDepartmentUuid string                 `protobuf:"bytes,4,opt,name=default_department_uuid,json=defaultDepartmentUuid,proto3" json:"default_department_uuid,omitempty"` // необязательно
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CreateApplicationCategoryRequest) Reset() {
+	*x = CreateApplicationCategoryRequest{}
+	mi := &file_application_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApplicationCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApplicationCategoryRequest) ProtoMessage() {}
+
+func (x *CreateApplicationCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApplicationCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateApplicationCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateApplicationCategoryRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *CreateApplicationCategoryRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *CreateApplicationCategoryRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateApplicationCategoryRequest) GetDefaultDepartmentUuid() string {
+	if x != nil {
+		return x.DefaultDepartmentUuid
+	}
+	return ""
+}
+
+type CreateApplicationCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryUuid  string                 `protobuf:"bytes,1,opt,name=category_uuid,json=categoryUuid,proto3" json:"category_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApplicationCategoryResponse) Reset() {
+	*x = CreateApplicationCategoryResponse{}
+	mi := &file_application_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApplicationCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApplicationCategoryResponse) ProtoMessage() {}
+
+func (x *CreateApplicationCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApplicationCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateApplicationCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CreateApplicationCategoryResponse) GetCategoryUuid() string {
+	if x != nil {
+		return x.CategoryUuid
+	}
+	return ""
+}
+
+// GetApplicationCategories
+type GetApplicationCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApplicationCategoriesRequest) Reset() {
+	*x = GetApplicationCategoriesRequest{}
+	mi := &file_application_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationCategoriesRequest) ProtoMessage() {}
+
+func (x *GetApplicationCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetApplicationCategoriesRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *GetApplicationCategoriesRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+type GetApplicationCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*ApplicationCategory `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApplicationCategoriesResponse) Reset() {
+	*x = GetApplicationCategoriesResponse{}
+	mi := &file_application_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationCategoriesResponse) ProtoMessage() {}
+
+func (x *GetApplicationCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*GetApplicationCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetApplicationCategoriesResponse) GetCategories() []*ApplicationCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// UpdateApplicationCategory
+type UpdateApplicationCategoryRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid         string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CategoryUuid          string                 `protobuf:"bytes,2,opt,name=category_uuid,json=categoryUuid,proto3" json:"category_uuid,omitempty"`
+	Title                 string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	DefaultDepartmentUuid string                 `protobuf:"bytes,4,opt,name=default_department_uuid,json=defaultDepartmentUuid,proto3" json:"default_department_uuid,omitempty"` // пусто - снять департамент по умолчанию
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpdateApplicationCategoryRequest) Reset() {
+	*x = UpdateApplicationCategoryRequest{}
+	mi := &file_application_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateApplicationCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetApplicationsRequest) ProtoMessage() {}
+func (*UpdateApplicationCategoryRequest) ProtoMessage() {}
 
-func (x *GetApplicationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_application_proto_msgTypes[8]
+func (x *UpdateApplicationCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -650,89 +5137,116 @@ func (x *GetApplicationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetApplicationsRequest.ProtoReflect.Descriptor instead.
-func (*GetApplicationsRequest) Descriptor() ([]byte, []int) {
-	return file_application_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use UpdateApplicationCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateApplicationCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *GetApplicationsRequest) GetInitiatorUuid() string {
+func (x *UpdateApplicationCategoryRequest) GetInitiatorUuid() string {
 	if x != nil {
 		return x.InitiatorUuid
 	}
 	return ""
 }
 
-func (x *GetApplicationsRequest) GetCompanyUuid() string {
+func (x *UpdateApplicationCategoryRequest) GetCategoryUuid() string {
 	if x != nil {
-		return x.CompanyUuid
+		return x.CategoryUuid
 	}
 	return ""
 }
 
-func (x *GetApplicationsRequest) GetDepartmentUuid() string {
+func (x *UpdateApplicationCategoryRequest) GetTitle() string {
 	if x != nil {
-		return x.DepartmentUuid
+		return x.Title
 	}
 	return ""
 }
 
-func (x *GetApplicationsRequest) GetStatuses() []string {
+func (x *UpdateApplicationCategoryRequest) GetDefaultDepartmentUuid() string {
 	if x != nil {
-		return x.Statuses
+		return x.DefaultDepartmentUuid
 	}
-	return nil
+	return ""
 }
 
-func (x *GetApplicationsRequest) GetCount() int64 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
+// DeleteApplicationCategory
+type DeleteApplicationCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CategoryUuid  string                 `protobuf:"bytes,2,opt,name=category_uuid,json=categoryUuid,proto3" json:"category_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetApplicationsRequest) GetOffset() int64 {
+func (x *DeleteApplicationCategoryRequest) Reset() {
+	*x = DeleteApplicationCategoryRequest{}
+	mi := &file_application_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteApplicationCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteApplicationCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteApplicationCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[76]
 	if x != nil {
-		return x.Offset
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetApplicationsRequest) GetIsDeleted() bool {
+// Deprecated: Use DeleteApplicationCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteApplicationCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DeleteApplicationCategoryRequest) GetInitiatorUuid() string {
 	if x != nil {
-		return x.IsDeleted
+		return x.InitiatorUuid
 	}
-	return false
+	return ""
 }
 
-func (x *GetApplicationsRequest) GetFromPool() bool {
+func (x *DeleteApplicationCategoryRequest) GetCategoryUuid() string {
 	if x != nil {
-		return x.FromPool
+		return x.CategoryUuid
 	}
-	return false
+	return ""
 }
 
-type GetApplicationsResponse struct {
+// GetCompanyCustomFields
+type GetCompanyCustomFieldsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Applications  []*Application         `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetApplicationsResponse) Reset() {
-	*x = GetApplicationsResponse{}
-	mi := &file_application_proto_msgTypes[9]
+func (x *GetCompanyCustomFieldsRequest) Reset() {
+	*x = GetCompanyCustomFieldsRequest{}
+	mi := &file_application_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetApplicationsResponse) String() string {
+func (x *GetCompanyCustomFieldsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetApplicationsResponse) ProtoMessage() {}
+func (*GetCompanyCustomFieldsRequest) ProtoMessage() {}
 
-func (x *GetApplicationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_application_proto_msgTypes[9]
+func (x *GetCompanyCustomFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -743,43 +5257,47 @@ func (x *GetApplicationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetApplicationsResponse.ProtoReflect.Descriptor instead.
-func (*GetApplicationsResponse) Descriptor() ([]byte, []int) {
-	return file_application_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetCompanyCustomFieldsRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyCustomFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *GetApplicationsResponse) GetApplications() []*Application {
+func (x *GetCompanyCustomFieldsRequest) GetInitiatorUuid() string {
 	if x != nil {
-		return x.Applications
+		return x.InitiatorUuid
 	}
-	return nil
+	return ""
 }
 
-// UpdateApplicationStatus
-type UpdateApplicationStatusRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
-	Status          string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *GetCompanyCustomFieldsRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
 }
 
-func (x *UpdateApplicationStatusRequest) Reset() {
-	*x = UpdateApplicationStatusRequest{}
-	mi := &file_application_proto_msgTypes[10]
+type GetCompanyCustomFieldsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fields        []*CustomField         `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyCustomFieldsResponse) Reset() {
+	*x = GetCompanyCustomFieldsResponse{}
+	mi := &file_application_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateApplicationStatusRequest) String() string {
+func (x *GetCompanyCustomFieldsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateApplicationStatusRequest) ProtoMessage() {}
+func (*GetCompanyCustomFieldsResponse) ProtoMessage() {}
 
-func (x *UpdateApplicationStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_application_proto_msgTypes[10]
+func (x *GetCompanyCustomFieldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -790,57 +5308,43 @@ func (x *UpdateApplicationStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateApplicationStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateApplicationStatusRequest) Descriptor() ([]byte, []int) {
-	return file_application_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *UpdateApplicationStatusRequest) GetInitiatorUuid() string {
-	if x != nil {
-		return x.InitiatorUuid
-	}
-	return ""
-}
-
-func (x *UpdateApplicationStatusRequest) GetApplicationUuid() string {
-	if x != nil {
-		return x.ApplicationUuid
-	}
-	return ""
+// Deprecated: Use GetCompanyCustomFieldsResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanyCustomFieldsResponse) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *UpdateApplicationStatusRequest) GetStatus() string {
+func (x *GetCompanyCustomFieldsResponse) GetFields() []*CustomField {
 	if x != nil {
-		return x.Status
+		return x.Fields
 	}
-	return ""
+	return nil
 }
 
-// AssignApplication
-type AssignApplicationRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
-	TargetUuid      string                 `protobuf:"bytes,3,opt,name=target_uuid,json=targetUuid,proto3" json:"target_uuid,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// UpdateCompanyCustomField
+type UpdateCompanyCustomFieldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	Field         *CustomField           `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"` // создание или замена поля по key, тип существующего поля не меняется
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AssignApplicationRequest) Reset() {
-	*x = AssignApplicationRequest{}
-	mi := &file_application_proto_msgTypes[11]
+func (x *UpdateCompanyCustomFieldRequest) Reset() {
+	*x = UpdateCompanyCustomFieldRequest{}
+	mi := &file_application_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssignApplicationRequest) String() string {
+func (x *UpdateCompanyCustomFieldRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignApplicationRequest) ProtoMessage() {}
+func (*UpdateCompanyCustomFieldRequest) ProtoMessage() {}
 
-func (x *AssignApplicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_application_proto_msgTypes[11]
+func (x *UpdateCompanyCustomFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -851,58 +5355,57 @@ func (x *AssignApplicationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignApplicationRequest.ProtoReflect.Descriptor instead.
-func (*AssignApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_application_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use UpdateCompanyCustomFieldRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCompanyCustomFieldRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *AssignApplicationRequest) GetInitiatorUuid() string {
+func (x *UpdateCompanyCustomFieldRequest) GetInitiatorUuid() string {
 	if x != nil {
 		return x.InitiatorUuid
 	}
 	return ""
 }
 
-func (x *AssignApplicationRequest) GetApplicationUuid() string {
+func (x *UpdateCompanyCustomFieldRequest) GetCompanyUuid() string {
 	if x != nil {
-		return x.ApplicationUuid
+		return x.CompanyUuid
 	}
 	return ""
 }
 
-func (x *AssignApplicationRequest) GetTargetUuid() string {
+func (x *UpdateCompanyCustomFieldRequest) GetField() *CustomField {
 	if x != nil {
-		return x.TargetUuid
+		return x.Field
 	}
-	return ""
+	return nil
 }
 
-// RedirectApplication
-type RedirectApplicationRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid        string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	ApplicationUuid      string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
-	TargetDepartmentUuid string                 `protobuf:"bytes,3,opt,name=target_department_uuid,json=targetDepartmentUuid,proto3" json:"target_department_uuid,omitempty"`
-	Message              string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+// DeleteCompanyCustomField
+type DeleteCompanyCustomFieldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	Key           string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RedirectApplicationRequest) Reset() {
-	*x = RedirectApplicationRequest{}
-	mi := &file_application_proto_msgTypes[12]
+func (x *DeleteCompanyCustomFieldRequest) Reset() {
+	*x = DeleteCompanyCustomFieldRequest{}
+	mi := &file_application_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RedirectApplicationRequest) String() string {
+func (x *DeleteCompanyCustomFieldRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RedirectApplicationRequest) ProtoMessage() {}
+func (*DeleteCompanyCustomFieldRequest) ProtoMessage() {}
 
-func (x *RedirectApplicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_application_proto_msgTypes[12]
+func (x *DeleteCompanyCustomFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -913,64 +5416,61 @@ func (x *RedirectApplicationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RedirectApplicationRequest.ProtoReflect.Descriptor instead.
-func (*RedirectApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_application_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use DeleteCompanyCustomFieldRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCompanyCustomFieldRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *RedirectApplicationRequest) GetInitiatorUuid() string {
+func (x *DeleteCompanyCustomFieldRequest) GetInitiatorUuid() string {
 	if x != nil {
 		return x.InitiatorUuid
 	}
 	return ""
 }
 
-func (x *RedirectApplicationRequest) GetApplicationUuid() string {
-	if x != nil {
-		return x.ApplicationUuid
-	}
-	return ""
-}
-
-func (x *RedirectApplicationRequest) GetTargetDepartmentUuid() string {
+func (x *DeleteCompanyCustomFieldRequest) GetCompanyUuid() string {
 	if x != nil {
-		return x.TargetDepartmentUuid
+		return x.CompanyUuid
 	}
 	return ""
 }
 
-func (x *RedirectApplicationRequest) GetMessage() string {
+func (x *DeleteCompanyCustomFieldRequest) GetKey() string {
 	if x != nil {
-		return x.Message
+		return x.Key
 	}
 	return ""
 }
 
-// RecallApplication
-type RecallApplicationRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
-	Message         string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// GetEmployeeOpenApplications
+// Открытые заявки, которые затронет удаление сотрудника, перевод из отдела или смена роли.
+// department_uuid ограничивает заявки отделом, new_role исключает связи, доступные новой роли
+type GetEmployeeOpenApplicationsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid  string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid    string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	UserUuid       string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	DepartmentUuid string                 `protobuf:"bytes,4,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"` // необязательно
+	NewRole        string                 `protobuf:"bytes,5,opt,name=new_role,json=newRole,proto3" json:"new_role,omitempty"`                      // необязательно
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *RecallApplicationRequest) Reset() {
-	*x = RecallApplicationRequest{}
-	mi := &file_application_proto_msgTypes[13]
+func (x *GetEmployeeOpenApplicationsRequest) Reset() {
+	*x = GetEmployeeOpenApplicationsRequest{}
+	mi := &file_application_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecallApplicationRequest) String() string {
+func (x *GetEmployeeOpenApplicationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecallApplicationRequest) ProtoMessage() {}
+func (*GetEmployeeOpenApplicationsRequest) ProtoMessage() {}
 
-func (x *RecallApplicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_application_proto_msgTypes[13]
+func (x *GetEmployeeOpenApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -981,56 +5481,72 @@ func (x *RecallApplicationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecallApplicationRequest.ProtoReflect.Descriptor instead.
-func (*RecallApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_application_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetEmployeeOpenApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*GetEmployeeOpenApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *RecallApplicationRequest) GetInitiatorUuid() string {
+func (x *GetEmployeeOpenApplicationsRequest) GetInitiatorUuid() string {
 	if x != nil {
 		return x.InitiatorUuid
 	}
 	return ""
 }
 
-func (x *RecallApplicationRequest) GetApplicationUuid() string {
+func (x *GetEmployeeOpenApplicationsRequest) GetCompanyUuid() string {
 	if x != nil {
-		return x.ApplicationUuid
+		return x.CompanyUuid
 	}
 	return ""
 }
 
-func (x *RecallApplicationRequest) GetMessage() string {
+func (x *GetEmployeeOpenApplicationsRequest) GetUserUuid() string {
 	if x != nil {
-		return x.Message
+		return x.UserUuid
 	}
 	return ""
 }
 
-// TakeApplicationToVerification
-type TakeApplicationToVerificationRequest struct {
+func (x *GetEmployeeOpenApplicationsRequest) GetDepartmentUuid() string {
+	if x != nil {
+		return x.DepartmentUuid
+	}
+	return ""
+}
+
+func (x *GetEmployeeOpenApplicationsRequest) GetNewRole() string {
+	if x != nil {
+		return x.NewRole
+	}
+	return ""
+}
+
+type EmployeeOpenApplication struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
+	ApplicationUuid string                 `protobuf:"bytes,1,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
+	DepartmentUuid  string                 `protobuf:"bytes,2,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
+	Title           string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Status          string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Relations       []string               `protobuf:"bytes,5,rep,name=relations,proto3" json:"relations,omitempty"` // manager | executor | inspector
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *TakeApplicationToVerificationRequest) Reset() {
-	*x = TakeApplicationToVerificationRequest{}
-	mi := &file_application_proto_msgTypes[14]
+func (x *EmployeeOpenApplication) Reset() {
+	*x = EmployeeOpenApplication{}
+	mi := &file_application_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TakeApplicationToVerificationRequest) String() string {
+func (x *EmployeeOpenApplication) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TakeApplicationToVerificationRequest) ProtoMessage() {}
+func (*EmployeeOpenApplication) ProtoMessage() {}
 
-func (x *TakeApplicationToVerificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_application_proto_msgTypes[14]
+func (x *EmployeeOpenApplication) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1041,50 +5557,68 @@ func (x *TakeApplicationToVerificationRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TakeApplicationToVerificationRequest.ProtoReflect.Descriptor instead.
-func (*TakeApplicationToVerificationRequest) Descriptor() ([]byte, []int) {
-	return file_application_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use EmployeeOpenApplication.ProtoReflect.Descriptor instead.
+func (*EmployeeOpenApplication) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *TakeApplicationToVerificationRequest) GetInitiatorUuid() string {
+func (x *EmployeeOpenApplication) GetApplicationUuid() string {
 	if x != nil {
-		return x.InitiatorUuid
+		return x.ApplicationUuid
 	}
 	return ""
 }
 
-func (x *TakeApplicationToVerificationRequest) GetApplicationUuid() string {
+func (x *EmployeeOpenApplication) GetDepartmentUuid() string {
 	if x != nil {
-		return x.ApplicationUuid
+		return x.DepartmentUuid
+	}
+	return ""
+}
+
+func (x *EmployeeOpenApplication) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *EmployeeOpenApplication) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-// ReleaseApplicationVerification
-type ReleaseApplicationVerificationRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
-	Message         string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *EmployeeOpenApplication) GetRelations() []string {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+type GetEmployeeOpenApplicationsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Applications  []*EmployeeOpenApplication `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReleaseApplicationVerificationRequest) Reset() {
-	*x = ReleaseApplicationVerificationRequest{}
-	mi := &file_application_proto_msgTypes[15]
+func (x *GetEmployeeOpenApplicationsResponse) Reset() {
+	*x = GetEmployeeOpenApplicationsResponse{}
+	mi := &file_application_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseApplicationVerificationRequest) String() string {
+func (x *GetEmployeeOpenApplicationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseApplicationVerificationRequest) ProtoMessage() {}
+func (*GetEmployeeOpenApplicationsResponse) ProtoMessage() {}
 
-func (x *ReleaseApplicationVerificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_application_proto_msgTypes[15]
+func (x *GetEmployeeOpenApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1095,57 +5629,52 @@ func (x *ReleaseApplicationVerificationRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseApplicationVerificationRequest.ProtoReflect.Descriptor instead.
-func (*ReleaseApplicationVerificationRequest) Descriptor() ([]byte, []int) {
-	return file_application_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *ReleaseApplicationVerificationRequest) GetInitiatorUuid() string {
-	if x != nil {
-		return x.InitiatorUuid
-	}
-	return ""
-}
-
-func (x *ReleaseApplicationVerificationRequest) GetApplicationUuid() string {
-	if x != nil {
-		return x.ApplicationUuid
-	}
-	return ""
+// Deprecated: Use GetEmployeeOpenApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*GetEmployeeOpenApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *ReleaseApplicationVerificationRequest) GetMessage() string {
+func (x *GetEmployeeOpenApplicationsResponse) GetApplications() []*EmployeeOpenApplication {
 	if x != nil {
-		return x.Message
+		return x.Applications
 	}
-	return ""
+	return nil
 }
 
-// AddApplicationFixLog
-type AddApplicationFixLogRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
-	Message         string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// OffboardEmployeeApplications
+// Обработка открытых заявок сотрудника перед удалением, переводом или сменой роли.
+// На каждую изменённую заявку пишется fix log. Company сервис вызывает с dry_run до изменения состава:
+// политика проверяется без изменения заявок, а сами заявки обрабатываются по событию после изменения
+type OffboardEmployeeApplicationsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid  string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid    string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	UserUuid       string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	DepartmentUuid string                 `protobuf:"bytes,4,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`   // необязательно
+	NewRole        string                 `protobuf:"bytes,5,opt,name=new_role,json=newRole,proto3" json:"new_role,omitempty"`                        // необязательно
+	Policy         string                 `protobuf:"bytes,6,opt,name=policy,proto3" json:"policy,omitempty"`                                         // block | pool | reassign
+	ReassignToUuid string                 `protobuf:"bytes,7,opt,name=reassign_to_uuid,json=reassignToUuid,proto3" json:"reassign_to_uuid,omitempty"` // только для reassign
+	Comment        string                 `protobuf:"bytes,8,opt,name=comment,proto3" json:"comment,omitempty"`                                       // причина, попадает в fix log
+	DryRun         bool                   `protobuf:"varint,9,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                          // только проверка политики, affected - число затрагиваемых заявок
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *AddApplicationFixLogRequest) Reset() {
-	*x = AddApplicationFixLogRequest{}
-	mi := &file_application_proto_msgTypes[16]
+func (x *OffboardEmployeeApplicationsRequest) Reset() {
+	*x = OffboardEmployeeApplicationsRequest{}
+	mi := &file_application_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddApplicationFixLogRequest) String() string {
+func (x *OffboardEmployeeApplicationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddApplicationFixLogRequest) ProtoMessage() {}
+func (*OffboardEmployeeApplicationsRequest) ProtoMessage() {}
 
-func (x *AddApplicationFixLogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_application_proto_msgTypes[16]
+func (x *OffboardEmployeeApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1156,119 +5685,96 @@ func (x *AddApplicationFixLogRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddApplicationFixLogRequest.ProtoReflect.Descriptor instead.
-func (*AddApplicationFixLogRequest) Descriptor() ([]byte, []int) {
-	return file_application_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use OffboardEmployeeApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*OffboardEmployeeApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *AddApplicationFixLogRequest) GetInitiatorUuid() string {
+func (x *OffboardEmployeeApplicationsRequest) GetInitiatorUuid() string {
 	if x != nil {
 		return x.InitiatorUuid
 	}
 	return ""
 }
 
-func (x *AddApplicationFixLogRequest) GetApplicationUuid() string {
+func (x *OffboardEmployeeApplicationsRequest) GetCompanyUuid() string {
 	if x != nil {
-		return x.ApplicationUuid
+		return x.CompanyUuid
 	}
 	return ""
 }
 
-func (x *AddApplicationFixLogRequest) GetMessage() string {
+func (x *OffboardEmployeeApplicationsRequest) GetUserUuid() string {
 	if x != nil {
-		return x.Message
+		return x.UserUuid
 	}
 	return ""
 }
 
-// DeleteApplication
-type DeleteApplicationRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
-	Message         string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
-}
-
-func (x *DeleteApplicationRequest) Reset() {
-	*x = DeleteApplicationRequest{}
-	mi := &file_application_proto_msgTypes[17]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeleteApplicationRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OffboardEmployeeApplicationsRequest) GetDepartmentUuid() string {
+	if x != nil {
+		return x.DepartmentUuid
+	}
+	return ""
 }
 
-func (*DeleteApplicationRequest) ProtoMessage() {}
-
-func (x *DeleteApplicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_application_proto_msgTypes[17]
+func (x *OffboardEmployeeApplicationsRequest) GetNewRole() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.NewRole
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteApplicationRequest.ProtoReflect.Descriptor instead.
-func (*DeleteApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_application_proto_rawDescGZIP(), []int{17}
+func (x *OffboardEmployeeApplicationsRequest) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
 }
 
-func (x *DeleteApplicationRequest) GetInitiatorUuid() string {
+func (x *OffboardEmployeeApplicationsRequest) GetReassignToUuid() string {
 	if x != nil {
-		return x.InitiatorUuid
+		return x.ReassignToUuid
 	}
 	return ""
 }
 
-func (x *DeleteApplicationRequest) GetApplicationUuid() string {
+func (x *OffboardEmployeeApplicationsRequest) GetComment() string {
 	if x != nil {
-		return x.ApplicationUuid
+		return x.Comment
 	}
 	return ""
 }
 
-func (x *DeleteApplicationRequest) GetMessage() string {
+func (x *OffboardEmployeeApplicationsRequest) GetDryRun() bool {
 	if x != nil {
-		return x.Message
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-// GetApplicationHistory
-type GetApplicationHistoryRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	ApplicationUuid string                 `protobuf:"bytes,2,opt,name=application_uuid,json=applicationUuid,proto3" json:"application_uuid,omitempty"`
-	Offset          int64                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	Count           int64                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type OffboardEmployeeApplicationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Affected      int64                  `protobuf:"varint,1,opt,name=affected,proto3" json:"affected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetApplicationHistoryRequest) Reset() {
-	*x = GetApplicationHistoryRequest{}
-	mi := &file_application_proto_msgTypes[18]
+func (x *OffboardEmployeeApplicationsResponse) Reset() {
+	*x = OffboardEmployeeApplicationsResponse{}
+	mi := &file_application_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetApplicationHistoryRequest) String() string {
+func (x *OffboardEmployeeApplicationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetApplicationHistoryRequest) ProtoMessage() {}
+func (*OffboardEmployeeApplicationsResponse) ProtoMessage() {}
 
-func (x *GetApplicationHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_application_proto_msgTypes[18]
+func (x *OffboardEmployeeApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1279,61 +5785,88 @@ func (x *GetApplicationHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetApplicationHistoryRequest.ProtoReflect.Descriptor instead.
-func (*GetApplicationHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_application_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use OffboardEmployeeApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*OffboardEmployeeApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *GetApplicationHistoryRequest) GetInitiatorUuid() string {
+func (x *OffboardEmployeeApplicationsResponse) GetAffected() int64 {
 	if x != nil {
-		return x.InitiatorUuid
+		return x.Affected
 	}
-	return ""
+	return 0
 }
 
-func (x *GetApplicationHistoryRequest) GetApplicationUuid() string {
-	if x != nil {
-		return x.ApplicationUuid
-	}
-	return ""
+// ArchiveCompanyApplications
+// Шаг удаления компании: все заявки компании выгружаются в архив в хранилище, затем их данные удаляются.
+// Вызывается только company сервисом; повторный вызов безопасен и возвращает уже записанный архив
+type ArchiveCompanyApplicationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyUuid   string                 `protobuf:"bytes,1,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetApplicationHistoryRequest) GetOffset() int64 {
+func (x *ArchiveCompanyApplicationsRequest) Reset() {
+	*x = ArchiveCompanyApplicationsRequest{}
+	mi := &file_application_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveCompanyApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveCompanyApplicationsRequest) ProtoMessage() {}
+
+func (x *ArchiveCompanyApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[86]
 	if x != nil {
-		return x.Offset
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetApplicationHistoryRequest) GetCount() int64 {
+// Deprecated: Use ArchiveCompanyApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveCompanyApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ArchiveCompanyApplicationsRequest) GetCompanyUuid() string {
 	if x != nil {
-		return x.Count
+		return x.CompanyUuid
 	}
-	return 0
+	return ""
 }
 
-type GetApplicationHistoryResponse struct {
+type ArchiveCompanyApplicationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	History       []*Application         `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+	ArchiveKey    string                 `protobuf:"bytes,1,opt,name=archive_key,json=archiveKey,proto3" json:"archive_key,omitempty"` // пусто, если у компании не было заявок
+	Archived      int64                  `protobuf:"varint,2,opt,name=archived,proto3" json:"archived,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetApplicationHistoryResponse) Reset() {
-	*x = GetApplicationHistoryResponse{}
-	mi := &file_application_proto_msgTypes[19]
+func (x *ArchiveCompanyApplicationsResponse) Reset() {
+	*x = ArchiveCompanyApplicationsResponse{}
+	mi := &file_application_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetApplicationHistoryResponse) String() string {
+func (x *ArchiveCompanyApplicationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetApplicationHistoryResponse) ProtoMessage() {}
+func (*ArchiveCompanyApplicationsResponse) ProtoMessage() {}
 
-func (x *GetApplicationHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_application_proto_msgTypes[19]
+func (x *ArchiveCompanyApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1344,23 +5877,30 @@ func (x *GetApplicationHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetApplicationHistoryResponse.ProtoReflect.Descriptor instead.
-func (*GetApplicationHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_application_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ArchiveCompanyApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveCompanyApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_application_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *GetApplicationHistoryResponse) GetHistory() []*Application {
+func (x *ArchiveCompanyApplicationsResponse) GetArchiveKey() string {
 	if x != nil {
-		return x.History
+		return x.ArchiveKey
 	}
-	return nil
+	return ""
+}
+
+func (x *ArchiveCompanyApplicationsResponse) GetArchived() int64 {
+	if x != nil {
+		return x.Archived
+	}
+	return 0
 }
 
 var File_application_proto protoreflect.FileDescriptor
 
 const file_application_proto_rawDesc = "" +
 	"\n" +
-	"\x11application.proto\x12\vapplication\x1a\x1bgoogle/protobuf/empty.proto\"\xff\x04\n" +
+	"\x11application.proto\x12\vapplication\x1a\x1bgoogle/protobuf/empty.proto\"\xf4\a\n" +
 	"\vApplication\x12)\n" +
 	"\x10application_uuid\x18\x01 \x01(\tR\x0fapplicationUuid\x12!\n" +
 	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12'\n" +
@@ -1389,14 +5929,129 @@ const file_application_proto_rawDesc = "" +
 	"deleted_at\x18\x11 \x01(\tR\tdeletedAt\x12\x1d\n" +
 	"\n" +
 	"deleted_by\x18\x12 \x01(\tR\tdeletedBy\x12.\n" +
-	"\bfix_logs\x18\x13 \x03(\v2\x13.application.FixLogR\afixLogs\"n\n" +
+	"\bfix_logs\x18\x13 \x03(\v2\x13.application.FixLogR\afixLogs\x129\n" +
+	"\vattachments\x18\x14 \x03(\v2\x17.application.AttachmentR\vattachments\x12\x1a\n" +
+	"\bpriority\x18\x15 \x01(\tR\bpriority\x12\x15\n" +
+	"\x06due_at\x18\x16 \x01(\tR\x05dueAt\x12\x1d\n" +
+	"\n" +
+	"is_overdue\x18\x17 \x01(\bR\tisOverdue\x12\x1d\n" +
+	"\n" +
+	"sla_breach\x18\x18 \x01(\tR\tslaBreach\x12\x1d\n" +
+	"\n" +
+	"overdue_at\x18\x19 \x01(\tR\toverdueAt\x12#\n" +
+	"\rlocation_uuid\x18\x1a \x01(\tR\flocationUuid\x12#\n" +
+	"\rcategory_uuid\x18\x1b \x01(\tR\fcategoryUuid\x12\x1a\n" +
+	"\bseverity\x18\x1c \x01(\tR\bseverity\x12B\n" +
+	"\rcustom_fields\x18\x1d \x03(\v2\x1d.application.CustomFieldValueR\fcustomFields\"\xa9\x01\n" +
 	"\x06FixLog\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
 	"\x04text\x18\x02 \x01(\tR\x04text\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"created_by\x18\x04 \x01(\tR\tcreatedBy\"I\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\x129\n" +
+	"\vattachments\x18\x05 \x03(\v2\x17.application.AttachmentR\vattachments\"\x94\x02\n" +
+	"\n" +
+	"Attachment\x12'\n" +
+	"\x0fattachment_uuid\x18\x01 \x01(\tR\x0eattachmentUuid\x12)\n" +
+	"\x10application_uuid\x18\x02 \x01(\tR\x0fapplicationUuid\x12 \n" +
+	"\ffix_log_uuid\x18\x03 \x01(\tR\n" +
+	"fixLogUuid\x12\x1b\n" +
+	"\tfile_name\x18\x04 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x03R\x04size\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\b \x01(\tR\tcreatedBy\"\xb9\x01\n" +
+	"\x12WorkflowTransition\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x12\n" +
+	"\x04from\x18\x03 \x03(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\x12\x14\n" +
+	"\x05roles\x18\x05 \x03(\tR\x05roles\x12\x14\n" +
+	"\x05guard\x18\x06 \x01(\tR\x05guard\x12'\n" +
+	"\x0frequire_message\x18\a \x01(\bR\x0erequireMessage\"e\n" +
+	"\bWorkflow\x12\x16\n" +
+	"\x06states\x18\x01 \x03(\tR\x06states\x12A\n" +
+	"\vtransitions\x18\x02 \x03(\v2\x1f.application.WorkflowTransitionR\vtransitions\"\xc6\x01\n" +
+	"\x13ApplicationCategory\x12#\n" +
+	"\rcategory_uuid\x18\x01 \x01(\tR\fcategoryUuid\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x126\n" +
+	"\x17default_department_uuid\x18\x03 \x01(\tR\x15defaultDepartmentUuid\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\"\x7f\n" +
+	"\vCustomField\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x18\n" +
+	"\aoptions\x18\x04 \x03(\tR\aoptions\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\":\n" +
+	"\x10CustomFieldValue\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\x93\x01\n" +
+	"\tSLAPolicy\x12\x1a\n" +
+	"\bpriority\x18\x01 \x01(\tR\bpriority\x12.\n" +
+	"\x13assign_within_hours\x18\x02 \x01(\x05R\x11assignWithinHours\x12:\n" +
+	"\x19verification_within_hours\x18\x03 \x01(\x05R\x17verificationWithinHours\"|\n" +
+	"\x0fAnalyticsFilter\x12'\n" +
+	"\x0fdepartment_uuid\x18\x01 \x01(\tR\x0edepartmentUuid\x12!\n" +
+	"\fcreated_from\x18\x02 \x01(\tR\vcreatedFrom\x12\x1d\n" +
+	"\n" +
+	"created_to\x18\x03 \x01(\tR\tcreatedTo\"{\n" +
+	"\n" +
+	"StatusStat\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12'\n" +
+	"\x0fdepartment_uuid\x18\x02 \x01(\tR\x0edepartmentUuid\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x03R\x05count\"G\n" +
+	"\bLeadTime\x12!\n" +
+	"\fmean_seconds\x18\x01 \x01(\x01R\vmeanSeconds\x12\x18\n" +
+	"\asamples\x18\x02 \x01(\x03R\asamples\"\xd5\x01\n" +
+	"\x14EngineerRevisionStat\x12#\n" +
+	"\rengineer_uuid\x18\x01 \x01(\tR\fengineerUuid\x12\"\n" +
+	"\fapplications\x18\x02 \x01(\x03R\fapplications\x121\n" +
+	"\x14revised_applications\x18\x03 \x01(\x03R\x13revisedApplications\x12\x1c\n" +
+	"\trevisions\x18\x04 \x01(\x03R\trevisions\x12#\n" +
+	"\rrevision_rate\x18\x05 \x01(\x01R\frevisionRate\"\xb1\x01\n" +
+	"\x15DepartmentFailureStat\x12'\n" +
+	"\x0fdepartment_uuid\x18\x01 \x01(\tR\x0edepartmentUuid\x12\x16\n" +
+	"\x06closed\x18\x02 \x01(\x03R\x06closed\x12\x1c\n" +
+	"\tcompleted\x18\x03 \x01(\x03R\tcompleted\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x03R\x06failed\x12!\n" +
+	"\ffailure_rate\x18\x05 \x01(\x01R\vfailureRate\"d\n" +
+	"\fStatusChange\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"changed_at\x18\x02 \x01(\tR\tchangedAt\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x03 \x01(\tR\tchangedBy\"\xac\x03\n" +
+	"\aComment\x12!\n" +
+	"\fcomment_uuid\x18\x01 \x01(\tR\vcommentUuid\x12)\n" +
+	"\x10application_uuid\x18\x02 \x01(\tR\x0fapplicationUuid\x12.\n" +
+	"\x13parent_comment_uuid\x18\x03 \x01(\tR\x11parentCommentUuid\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x12'\n" +
+	"\x0fmentioned_uuids\x18\x05 \x03(\tR\x0ementionedUuids\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\x12\x1b\n" +
+	"\tedited_at\x18\b \x01(\tR\beditedAt\x12\x1d\n" +
+	"\n" +
+	"is_deleted\x18\t \x01(\bR\tisDeleted\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\n" +
+	" \x01(\tR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\v \x01(\tR\tdeletedBy\x12.\n" +
+	"\areplies\x18\f \x03(\v2\x14.application.CommentR\areplies\"\x84\x01\n" +
+	"\vCommentEdit\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12'\n" +
+	"\x0fmentioned_uuids\x18\x02 \x03(\tR\x0ementionedUuids\x12\x1b\n" +
+	"\tedited_at\x18\x03 \x01(\tR\beditedAt\x12\x1b\n" +
+	"\tedited_by\x18\x04 \x01(\tR\beditedBy\"I\n" +
 	"\x0fApplicationData\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\"\x88\x01\n" +
@@ -1405,18 +6060,24 @@ const file_application_proto_rawDesc = "" +
 	"\bpostgres\x18\x02 \x01(\tR\bpostgres\x12\x14\n" +
 	"\x05redis\x18\x03 \x01(\tR\x05redis\x12\x14\n" +
 	"\x05minio\x18\x04 \x01(\tR\x05minio\x12\x14\n" +
-	"\x05mongo\x18\x05 \x01(\tR\x05mongo\"\xad\x01\n" +
+	"\x05mongo\x18\x05 \x01(\tR\x05mongo\"\x8a\x03\n" +
 	"\x18CreateApplicationRequest\x12%\n" +
 	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
 	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12G\n" +
-	"\x10application_data\x18\x03 \x01(\v2\x1c.application.ApplicationDataR\x0fapplicationData\"F\n" +
+	"\x10application_data\x18\x03 \x01(\v2\x1c.application.ApplicationDataR\x0fapplicationData\x12\x1a\n" +
+	"\bpriority\x18\x04 \x01(\tR\bpriority\x12\x15\n" +
+	"\x06due_at\x18\x05 \x01(\tR\x05dueAt\x12#\n" +
+	"\rlocation_uuid\x18\x06 \x01(\tR\flocationUuid\x12#\n" +
+	"\rcategory_uuid\x18\a \x01(\tR\fcategoryUuid\x12\x1a\n" +
+	"\bseverity\x18\b \x01(\tR\bseverity\x12B\n" +
+	"\rcustom_fields\x18\t \x03(\v2\x1d.application.CustomFieldValueR\fcustomFields\"F\n" +
 	"\x19CreateApplicationResponse\x12)\n" +
 	"\x10application_uuid\x18\x01 \x01(\tR\x0fapplicationUuid\"i\n" +
 	"\x15GetApplicationRequest\x12%\n" +
 	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12)\n" +
 	"\x10application_uuid\x18\x02 \x01(\tR\x0fapplicationUuid\"T\n" +
 	"\x16GetApplicationResponse\x12:\n" +
-	"\vapplication\x18\x01 \x01(\v2\x18.application.ApplicationR\vapplication\"\x91\x02\n" +
+	"\vapplication\x18\x01 \x01(\v2\x18.application.ApplicationR\vapplication\"\xb9\a\n" +
 	"\x16GetApplicationsRequest\x12%\n" +
 	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
 	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12'\n" +
@@ -1426,13 +6087,48 @@ const file_application_proto_rawDesc = "" +
 	"\x06offset\x18\x06 \x01(\x03R\x06offset\x12\x1d\n" +
 	"\n" +
 	"is_deleted\x18\a \x01(\bR\tisDeleted\x12\x1b\n" +
-	"\tfrom_pool\x18\b \x01(\bR\bfromPool\"W\n" +
+	"\tfrom_pool\x18\b \x01(\bR\bfromPool\x12\x1d\n" +
+	"\n" +
+	"is_overdue\x18\t \x01(\bR\tisOverdue\x12\x16\n" +
+	"\x06search\x18\n" +
+	" \x01(\tR\x06search\x12!\n" +
+	"\fcreated_from\x18\v \x01(\tR\vcreatedFrom\x12\x1d\n" +
+	"\n" +
+	"created_to\x18\f \x01(\tR\tcreatedTo\x12\x1f\n" +
+	"\vclosed_from\x18\r \x01(\tR\n" +
+	"closedFrom\x12\x1b\n" +
+	"\tclosed_to\x18\x0e \x01(\tR\bclosedTo\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x0f \x01(\tR\tcreatedBy\x12\x1f\n" +
+	"\vexecuted_by\x18\x10 \x01(\tR\n" +
+	"executedBy\x12\x1d\n" +
+	"\n" +
+	"managed_by\x18\x11 \x01(\tR\tmanagedBy\x121\n" +
+	"\x12revision_count_min\x18\x12 \x01(\x03H\x00R\x10revisionCountMin\x88\x01\x01\x121\n" +
+	"\x12revision_count_max\x18\x13 \x01(\x03H\x01R\x10revisionCountMax\x88\x01\x01\x12\x17\n" +
+	"\asort_by\x18\x14 \x01(\tR\x06sortBy\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x15 \x01(\tR\tsortOrder\x12\x16\n" +
+	"\x06cursor\x18\x16 \x01(\tR\x06cursor\x12#\n" +
+	"\rlocation_uuid\x18\x17 \x01(\tR\flocationUuid\x12#\n" +
+	"\rcategory_uuid\x18\x18 \x01(\tR\fcategoryUuid\x12\x1e\n" +
+	"\n" +
+	"severities\x18\x19 \x03(\tR\n" +
+	"severities\x12B\n" +
+	"\rcustom_fields\x18\x1a \x03(\v2\x1d.application.CustomFieldValueR\fcustomFieldsB\x15\n" +
+	"\x13_revision_count_minB\x15\n" +
+	"\x13_revision_count_max\"\x99\x01\n" +
 	"\x17GetApplicationsResponse\x12<\n" +
-	"\fapplications\x18\x01 \x03(\v2\x18.application.ApplicationR\fapplications\"\x8a\x01\n" +
+	"\fapplications\x18\x01 \x03(\v2\x18.application.ApplicationR\fapplications\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x03R\n" +
+	"totalCount\"\xa4\x01\n" +
 	"\x1eUpdateApplicationStatusRequest\x12%\n" +
 	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12)\n" +
 	"\x10application_uuid\x18\x02 \x01(\tR\x0fapplicationUuid\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\"\x8d\x01\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x8d\x01\n" +
 	"\x18AssignApplicationRequest\x12%\n" +
 	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12)\n" +
 	"\x10application_uuid\x18\x02 \x01(\tR\x0fapplicationUuid\x12\x1f\n" +
@@ -1457,18 +6153,238 @@ const file_application_proto_rawDesc = "" +
 	"\x1bAddApplicationFixLogRequest\x12%\n" +
 	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12)\n" +
 	"\x10application_uuid\x18\x02 \x01(\tR\x0fapplicationUuid\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"\x86\x01\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"@\n" +
+	"\x1cAddApplicationFixLogResponse\x12 \n" +
+	"\ffix_log_uuid\x18\x01 \x01(\tR\n" +
+	"fixLogUuid\"\x86\x01\n" +
 	"\x18DeleteApplicationRequest\x12%\n" +
 	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12)\n" +
 	"\x10application_uuid\x18\x02 \x01(\tR\x0fapplicationUuid\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"\x9e\x01\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xb6\x01\n" +
 	"\x1cGetApplicationHistoryRequest\x12%\n" +
 	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12)\n" +
 	"\x10application_uuid\x18\x02 \x01(\tR\x0fapplicationUuid\x12\x16\n" +
 	"\x06offset\x18\x03 \x01(\x03R\x06offset\x12\x14\n" +
-	"\x05count\x18\x04 \x01(\x03R\x05count\"S\n" +
+	"\x05count\x18\x04 \x01(\x03R\x05count\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\"\x95\x01\n" +
 	"\x1dGetApplicationHistoryResponse\x122\n" +
-	"\ahistory\x18\x01 \x03(\v2\x18.application.ApplicationR\ahistory2\xc8\t\n" +
+	"\ahistory\x18\x01 \x03(\v2\x18.application.ApplicationR\ahistory\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x03R\n" +
+	"totalCount\"\xf2\x01\n" +
+	"\"UploadApplicationAttachmentRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12)\n" +
+	"\x10application_uuid\x18\x02 \x01(\tR\x0fapplicationUuid\x12 \n" +
+	"\ffix_log_uuid\x18\x03 \x01(\tR\n" +
+	"fixLogUuid\x12\x1b\n" +
+	"\tfile_name\x18\x04 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x06 \x01(\fR\acontent\"^\n" +
+	"#UploadApplicationAttachmentResponse\x127\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x17.application.AttachmentR\n" +
+	"attachment\"\x9c\x01\n" +
+	"\x1fGetApplicationAttachmentRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12)\n" +
+	"\x10application_uuid\x18\x02 \x01(\tR\x0fapplicationUuid\x12'\n" +
+	"\x0fattachment_uuid\x18\x03 \x01(\tR\x0eattachmentUuid\"u\n" +
+	" GetApplicationAttachmentResponse\x127\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x17.application.AttachmentR\n" +
+	"attachment\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\"\x9f\x01\n" +
+	"\"DeleteApplicationAttachmentRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12)\n" +
+	"\x10application_uuid\x18\x02 \x01(\tR\x0fapplicationUuid\x12'\n" +
+	"\x0fattachment_uuid\x18\x03 \x01(\tR\x0eattachmentUuid\"\x84\x02\n" +
+	"\x18WatchApplicationsRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12'\n" +
+	"\x0fdepartment_uuid\x18\x03 \x01(\tR\x0edepartmentUuid\x12\x1a\n" +
+	"\bstatuses\x18\x04 \x03(\tR\bstatuses\x12\x1d\n" +
+	"\n" +
+	"is_deleted\x18\x05 \x01(\bR\tisDeleted\x12\x1b\n" +
+	"\tfrom_pool\x18\x06 \x01(\bR\bfromPool\x12\x1d\n" +
+	"\n" +
+	"is_overdue\x18\a \x01(\bR\tisOverdue\"\x88\x01\n" +
+	"\x11ApplicationUpdate\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\tR\teventType\x12:\n" +
+	"\vapplication\x18\x02 \x01(\v2\x18.application.ApplicationR\vapplication\x12\x18\n" +
+	"\aremoved\x18\x03 \x01(\bR\aremoved\"p\n" +
+	"\x1cGetApplicationActionsRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12)\n" +
+	"\x10application_uuid\x18\x02 \x01(\tR\x0fapplicationUuid\"Z\n" +
+	"\x1dGetApplicationActionsResponse\x129\n" +
+	"\aactions\x18\x01 \x03(\v2\x1f.application.WorkflowTransitionR\aactions\"e\n" +
+	"\x19GetCompanyWorkflowRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\"\xac\x01\n" +
+	"\x1aGetCompanyWorkflowResponse\x121\n" +
+	"\bworkflow\x18\x01 \x01(\v2\x15.application.WorkflowR\bworkflow\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x02 \x01(\bR\tisDefault\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x04 \x01(\tR\tupdatedBy\"\x9b\x01\n" +
+	"\x1cUpdateCompanyWorkflowRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x121\n" +
+	"\bworkflow\x18\x03 \x01(\v2\x15.application.WorkflowR\bworkflow\"g\n" +
+	"\x1bResetCompanyWorkflowRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\"h\n" +
+	"\x1cGetCompanySLAPoliciesRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\"S\n" +
+	"\x1dGetCompanySLAPoliciesResponse\x122\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x16.application.SLAPolicyR\bpolicies\"\x99\x01\n" +
+	"\x1dUpdateCompanySLAPolicyRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12.\n" +
+	"\x06policy\x18\x03 \x01(\v2\x16.application.SLAPolicyR\x06policy\"\x85\x01\n" +
+	"\x1dDeleteCompanySLAPolicyRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\tR\bpriority\"\xbe\x01\n" +
+	" GetApplicationStatusStatsRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x124\n" +
+	"\x06filter\x18\x03 \x01(\v2\x1c.application.AnalyticsFilterR\x06filter\x12\x1a\n" +
+	"\binterval\x18\x04 \x01(\tR\binterval\"R\n" +
+	"!GetApplicationStatusStatsResponse\x12-\n" +
+	"\x05stats\x18\x01 \x03(\v2\x17.application.StatusStatR\x05stats\"\xa0\x01\n" +
+	"\x1eGetApplicationLeadTimesRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x124\n" +
+	"\x06filter\x18\x03 \x01(\v2\x1c.application.AnalyticsFilterR\x06filter\"\xa8\x01\n" +
+	"\x1fGetApplicationLeadTimesResponse\x12-\n" +
+	"\x06assign\x18\x01 \x01(\v2\x15.application.LeadTimeR\x06assign\x12'\n" +
+	"\x03fix\x18\x02 \x01(\v2\x15.application.LeadTimeR\x03fix\x12-\n" +
+	"\x06verify\x18\x03 \x01(\v2\x15.application.LeadTimeR\x06verify\"\xa1\x01\n" +
+	"\x1fGetEngineerRevisionStatsRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x124\n" +
+	"\x06filter\x18\x03 \x01(\v2\x1c.application.AnalyticsFilterR\x06filter\"[\n" +
+	" GetEngineerRevisionStatsResponse\x127\n" +
+	"\x05stats\x18\x01 \x03(\v2!.application.EngineerRevisionStatR\x05stats\"\xa2\x01\n" +
+	" GetDepartmentFailureStatsRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x124\n" +
+	"\x06filter\x18\x03 \x01(\v2\x1c.application.AnalyticsFilterR\x06filter\"]\n" +
+	"!GetDepartmentFailureStatsResponse\x128\n" +
+	"\x05stats\x18\x01 \x03(\v2\".application.DepartmentFailureStatR\x05stats\"V\n" +
+	"\x19ExportApplicationsRequest\x129\n" +
+	"\x05query\x18\x01 \x01(\v2#.application.GetApplicationsRequestR\x05query\"\x88\x01\n" +
+	"\x13ExportedApplication\x12:\n" +
+	"\vapplication\x18\x01 \x01(\v2\x18.application.ApplicationR\vapplication\x125\n" +
+	"\btimeline\x18\x02 \x03(\v2\x19.application.StatusChangeR\btimeline\"\xe0\x01\n" +
+	"\x1fCreateApplicationCommentRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12)\n" +
+	"\x10application_uuid\x18\x02 \x01(\tR\x0fapplicationUuid\x12.\n" +
+	"\x13parent_comment_uuid\x18\x03 \x01(\tR\x11parentCommentUuid\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x12'\n" +
+	"\x0fmentioned_uuids\x18\x05 \x03(\tR\x0ementionedUuids\"R\n" +
+	" CreateApplicationCommentResponse\x12.\n" +
+	"\acomment\x18\x01 \x01(\v2\x14.application.CommentR\acomment\"\xb7\x01\n" +
+	"\x1dGetApplicationCommentsRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12)\n" +
+	"\x10application_uuid\x18\x02 \x01(\tR\x0fapplicationUuid\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x03R\x06offset\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\"\x94\x01\n" +
+	"\x1eGetApplicationCommentsResponse\x120\n" +
+	"\bcomments\x18\x01 \x03(\v2\x14.application.CommentR\bcomments\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x03R\n" +
+	"totalCount\"\xd3\x01\n" +
+	"\x1fUpdateApplicationCommentRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12)\n" +
+	"\x10application_uuid\x18\x02 \x01(\tR\x0fapplicationUuid\x12!\n" +
+	"\fcomment_uuid\x18\x03 \x01(\tR\vcommentUuid\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x12'\n" +
+	"\x0fmentioned_uuids\x18\x05 \x03(\tR\x0ementionedUuids\"\x96\x01\n" +
+	"\x1fDeleteApplicationCommentRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12)\n" +
+	"\x10application_uuid\x18\x02 \x01(\tR\x0fapplicationUuid\x12!\n" +
+	"\fcomment_uuid\x18\x03 \x01(\tR\vcommentUuid\"\x9a\x01\n" +
+	"#GetApplicationCommentHistoryRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12)\n" +
+	"\x10application_uuid\x18\x02 \x01(\tR\x0fapplicationUuid\x12!\n" +
+	"\fcomment_uuid\x18\x03 \x01(\tR\vcommentUuid\"\x86\x01\n" +
+	"$GetApplicationCommentHistoryResponse\x12.\n" +
+	"\acomment\x18\x01 \x01(\v2\x14.application.CommentR\acomment\x12.\n" +
+	"\x05edits\x18\x02 \x03(\v2\x18.application.CommentEditR\x05edits\"\xba\x01\n" +
+	" CreateApplicationCategoryRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x126\n" +
+	"\x17default_department_uuid\x18\x04 \x01(\tR\x15defaultDepartmentUuid\"H\n" +
+	"!CreateApplicationCategoryResponse\x12#\n" +
+	"\rcategory_uuid\x18\x01 \x01(\tR\fcategoryUuid\"k\n" +
+	"\x1fGetApplicationCategoriesRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\"d\n" +
+	" GetApplicationCategoriesResponse\x12@\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2 .application.ApplicationCategoryR\n" +
+	"categories\"\xbc\x01\n" +
+	" UpdateApplicationCategoryRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12#\n" +
+	"\rcategory_uuid\x18\x02 \x01(\tR\fcategoryUuid\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x126\n" +
+	"\x17default_department_uuid\x18\x04 \x01(\tR\x15defaultDepartmentUuid\"n\n" +
+	" DeleteApplicationCategoryRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12#\n" +
+	"\rcategory_uuid\x18\x02 \x01(\tR\fcategoryUuid\"i\n" +
+	"\x1dGetCompanyCustomFieldsRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\"R\n" +
+	"\x1eGetCompanyCustomFieldsResponse\x120\n" +
+	"\x06fields\x18\x01 \x03(\v2\x18.application.CustomFieldR\x06fields\"\x9b\x01\n" +
+	"\x1fUpdateCompanyCustomFieldRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12.\n" +
+	"\x05field\x18\x03 \x01(\v2\x18.application.CustomFieldR\x05field\"}\n" +
+	"\x1fDeleteCompanyCustomFieldRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\"\xcf\x01\n" +
+	"\"GetEmployeeOpenApplicationsRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12'\n" +
+	"\x0fdepartment_uuid\x18\x04 \x01(\tR\x0edepartmentUuid\x12\x19\n" +
+	"\bnew_role\x18\x05 \x01(\tR\anewRole\"\xb9\x01\n" +
+	"\x17EmployeeOpenApplication\x12)\n" +
+	"\x10application_uuid\x18\x01 \x01(\tR\x0fapplicationUuid\x12'\n" +
+	"\x0fdepartment_uuid\x18\x02 \x01(\tR\x0edepartmentUuid\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1c\n" +
+	"\trelations\x18\x05 \x03(\tR\trelations\"o\n" +
+	"#GetEmployeeOpenApplicationsResponse\x12H\n" +
+	"\fapplications\x18\x01 \x03(\v2$.application.EmployeeOpenApplicationR\fapplications\"\xc5\x02\n" +
+	"#OffboardEmployeeApplicationsRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12'\n" +
+	"\x0fdepartment_uuid\x18\x04 \x01(\tR\x0edepartmentUuid\x12\x19\n" +
+	"\bnew_role\x18\x05 \x01(\tR\anewRole\x12\x16\n" +
+	"\x06policy\x18\x06 \x01(\tR\x06policy\x12(\n" +
+	"\x10reassign_to_uuid\x18\a \x01(\tR\x0ereassignToUuid\x12\x18\n" +
+	"\acomment\x18\b \x01(\tR\acomment\x12\x17\n" +
+	"\adry_run\x18\t \x01(\bR\x06dryRun\"B\n" +
+	"$OffboardEmployeeApplicationsResponse\x12\x1a\n" +
+	"\baffected\x18\x01 \x01(\x03R\baffected\"F\n" +
+	"!ArchiveCompanyApplicationsRequest\x12!\n" +
+	"\fcompany_uuid\x18\x01 \x01(\tR\vcompanyUuid\"a\n" +
+	"\"ArchiveCompanyApplicationsResponse\x12\x1f\n" +
+	"\varchive_key\x18\x01 \x01(\tR\n" +
+	"archiveKey\x12\x1a\n" +
+	"\barchived\x18\x02 \x01(\x03R\barchived2\xd1$\n" +
 	"\x12ApplicationService\x12=\n" +
 	"\x06Health\x12\x16.google.protobuf.Empty\x1a\x1b.application.HealthResponse\x12b\n" +
 	"\x11CreateApplication\x12%.application.CreateApplicationRequest\x1a&.application.CreateApplicationResponse\x12Y\n" +
@@ -1479,10 +6395,41 @@ const file_application_proto_rawDesc = "" +
 	"\x13RedirectApplication\x12'.application.RedirectApplicationRequest\x1a\x16.google.protobuf.Empty\x12R\n" +
 	"\x11RecallApplication\x12%.application.RecallApplicationRequest\x1a\x16.google.protobuf.Empty\x12j\n" +
 	"\x1dTakeApplicationToVerification\x121.application.TakeApplicationToVerificationRequest\x1a\x16.google.protobuf.Empty\x12l\n" +
-	"\x1eReleaseApplicationVerification\x122.application.ReleaseApplicationVerificationRequest\x1a\x16.google.protobuf.Empty\x12X\n" +
-	"\x14AddApplicationFixLog\x12(.application.AddApplicationFixLogRequest\x1a\x16.google.protobuf.Empty\x12R\n" +
+	"\x1eReleaseApplicationVerification\x122.application.ReleaseApplicationVerificationRequest\x1a\x16.google.protobuf.Empty\x12k\n" +
+	"\x14AddApplicationFixLog\x12(.application.AddApplicationFixLogRequest\x1a).application.AddApplicationFixLogResponse\x12R\n" +
 	"\x11DeleteApplication\x12%.application.DeleteApplicationRequest\x1a\x16.google.protobuf.Empty\x12n\n" +
-	"\x15GetApplicationHistory\x12).application.GetApplicationHistoryRequest\x1a*.application.GetApplicationHistoryResponseB_Z]github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated;application_protob\x06proto3"
+	"\x15GetApplicationHistory\x12).application.GetApplicationHistoryRequest\x1a*.application.GetApplicationHistoryResponse\x12\x80\x01\n" +
+	"\x1bUploadApplicationAttachment\x12/.application.UploadApplicationAttachmentRequest\x1a0.application.UploadApplicationAttachmentResponse\x12w\n" +
+	"\x18GetApplicationAttachment\x12,.application.GetApplicationAttachmentRequest\x1a-.application.GetApplicationAttachmentResponse\x12f\n" +
+	"\x1bDeleteApplicationAttachment\x12/.application.DeleteApplicationAttachmentRequest\x1a\x16.google.protobuf.Empty\x12\\\n" +
+	"\x11WatchApplications\x12%.application.WatchApplicationsRequest\x1a\x1e.application.ApplicationUpdate0\x01\x12n\n" +
+	"\x15GetApplicationActions\x12).application.GetApplicationActionsRequest\x1a*.application.GetApplicationActionsResponse\x12e\n" +
+	"\x12GetCompanyWorkflow\x12&.application.GetCompanyWorkflowRequest\x1a'.application.GetCompanyWorkflowResponse\x12Z\n" +
+	"\x15UpdateCompanyWorkflow\x12).application.UpdateCompanyWorkflowRequest\x1a\x16.google.protobuf.Empty\x12X\n" +
+	"\x14ResetCompanyWorkflow\x12(.application.ResetCompanyWorkflowRequest\x1a\x16.google.protobuf.Empty\x12n\n" +
+	"\x15GetCompanySLAPolicies\x12).application.GetCompanySLAPoliciesRequest\x1a*.application.GetCompanySLAPoliciesResponse\x12\\\n" +
+	"\x16UpdateCompanySLAPolicy\x12*.application.UpdateCompanySLAPolicyRequest\x1a\x16.google.protobuf.Empty\x12\\\n" +
+	"\x16DeleteCompanySLAPolicy\x12*.application.DeleteCompanySLAPolicyRequest\x1a\x16.google.protobuf.Empty\x12z\n" +
+	"\x19GetApplicationStatusStats\x12-.application.GetApplicationStatusStatsRequest\x1a..application.GetApplicationStatusStatsResponse\x12t\n" +
+	"\x17GetApplicationLeadTimes\x12+.application.GetApplicationLeadTimesRequest\x1a,.application.GetApplicationLeadTimesResponse\x12w\n" +
+	"\x18GetEngineerRevisionStats\x12,.application.GetEngineerRevisionStatsRequest\x1a-.application.GetEngineerRevisionStatsResponse\x12z\n" +
+	"\x19GetDepartmentFailureStats\x12-.application.GetDepartmentFailureStatsRequest\x1a..application.GetDepartmentFailureStatsResponse\x12`\n" +
+	"\x12ExportApplications\x12&.application.ExportApplicationsRequest\x1a .application.ExportedApplication0\x01\x12w\n" +
+	"\x18CreateApplicationComment\x12,.application.CreateApplicationCommentRequest\x1a-.application.CreateApplicationCommentResponse\x12q\n" +
+	"\x16GetApplicationComments\x12*.application.GetApplicationCommentsRequest\x1a+.application.GetApplicationCommentsResponse\x12`\n" +
+	"\x18UpdateApplicationComment\x12,.application.UpdateApplicationCommentRequest\x1a\x16.google.protobuf.Empty\x12`\n" +
+	"\x18DeleteApplicationComment\x12,.application.DeleteApplicationCommentRequest\x1a\x16.google.protobuf.Empty\x12\x83\x01\n" +
+	"\x1cGetApplicationCommentHistory\x120.application.GetApplicationCommentHistoryRequest\x1a1.application.GetApplicationCommentHistoryResponse\x12z\n" +
+	"\x19CreateApplicationCategory\x12-.application.CreateApplicationCategoryRequest\x1a..application.CreateApplicationCategoryResponse\x12w\n" +
+	"\x18GetApplicationCategories\x12,.application.GetApplicationCategoriesRequest\x1a-.application.GetApplicationCategoriesResponse\x12b\n" +
+	"\x19UpdateApplicationCategory\x12-.application.UpdateApplicationCategoryRequest\x1a\x16.google.protobuf.Empty\x12b\n" +
+	"\x19DeleteApplicationCategory\x12-.application.DeleteApplicationCategoryRequest\x1a\x16.google.protobuf.Empty\x12q\n" +
+	"\x16GetCompanyCustomFields\x12*.application.GetCompanyCustomFieldsRequest\x1a+.application.GetCompanyCustomFieldsResponse\x12`\n" +
+	"\x18UpdateCompanyCustomField\x12,.application.UpdateCompanyCustomFieldRequest\x1a\x16.google.protobuf.Empty\x12`\n" +
+	"\x18DeleteCompanyCustomField\x12,.application.DeleteCompanyCustomFieldRequest\x1a\x16.google.protobuf.Empty\x12\x80\x01\n" +
+	"\x1bGetEmployeeOpenApplications\x12/.application.GetEmployeeOpenApplicationsRequest\x1a0.application.GetEmployeeOpenApplicationsResponse\x12\x83\x01\n" +
+	"\x1cOffboardEmployeeApplications\x120.application.OffboardEmployeeApplicationsRequest\x1a1.application.OffboardEmployeeApplicationsResponse\x12}\n" +
+	"\x1aArchiveCompanyApplications\x12..application.ArchiveCompanyApplicationsRequest\x1a/.application.ArchiveCompanyApplicationsResponseB_Z]github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated;application_protob\x06proto3"
 
 var (
 	file_application_proto_rawDescOnce sync.Once
@@ -1496,67 +6443,233 @@ func file_application_proto_rawDescGZIP() []byte {
 	return file_application_proto_rawDescData
 }
 
-var file_application_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_application_proto_msgTypes = make([]protoimpl.MessageInfo, 88)
 var file_application_proto_goTypes = []any{
 	(*Application)(nil),                           // 0: application.Application
 	(*FixLog)(nil),                                // 1: application.FixLog
-	(*ApplicationData)(nil),                       // 2: application.ApplicationData
-	(*HealthResponse)(nil),                        // 3: application.HealthResponse
-	(*CreateApplicationRequest)(nil),              // 4: application.CreateApplicationRequest
-	(*CreateApplicationResponse)(nil),             // 5: application.CreateApplicationResponse
-	(*GetApplicationRequest)(nil),                 // 6: application.GetApplicationRequest
-	(*GetApplicationResponse)(nil),                // 7: application.GetApplicationResponse
-	(*GetApplicationsRequest)(nil),                // 8: application.GetApplicationsRequest
-	(*GetApplicationsResponse)(nil),               // 9: application.GetApplicationsResponse
-	(*UpdateApplicationStatusRequest)(nil),        // 10: application.UpdateApplicationStatusRequest
-	(*AssignApplicationRequest)(nil),              // 11: application.AssignApplicationRequest
-	(*RedirectApplicationRequest)(nil),            // 12: application.RedirectApplicationRequest
-	(*RecallApplicationRequest)(nil),              // 13: application.RecallApplicationRequest
-	(*TakeApplicationToVerificationRequest)(nil),  // 14: application.TakeApplicationToVerificationRequest
-	(*ReleaseApplicationVerificationRequest)(nil), // 15: application.ReleaseApplicationVerificationRequest
-	(*AddApplicationFixLogRequest)(nil),           // 16: application.AddApplicationFixLogRequest
-	(*DeleteApplicationRequest)(nil),              // 17: application.DeleteApplicationRequest
-	(*GetApplicationHistoryRequest)(nil),          // 18: application.GetApplicationHistoryRequest
-	(*GetApplicationHistoryResponse)(nil),         // 19: application.GetApplicationHistoryResponse
-	(*emptypb.Empty)(nil),                         // 20: google.protobuf.Empty
+	(*Attachment)(nil),                            // 2: application.Attachment
+	(*WorkflowTransition)(nil),                    // 3: application.WorkflowTransition
+	(*Workflow)(nil),                              // 4: application.Workflow
+	(*ApplicationCategory)(nil),                   // 5: application.ApplicationCategory
+	(*CustomField)(nil),                           // 6: application.CustomField
+	(*CustomFieldValue)(nil),                      // 7: application.CustomFieldValue
+	(*SLAPolicy)(nil),                             // 8: application.SLAPolicy
+	(*AnalyticsFilter)(nil),                       // 9: application.AnalyticsFilter
+	(*StatusStat)(nil),                            // 10: application.StatusStat
+	(*LeadTime)(nil),                              // 11: application.LeadTime
+	(*EngineerRevisionStat)(nil),                  // 12: application.EngineerRevisionStat
+	(*DepartmentFailureStat)(nil),                 // 13: application.DepartmentFailureStat
+	(*StatusChange)(nil),                          // 14: application.StatusChange
+	(*Comment)(nil),                               // 15: application.Comment
+	(*CommentEdit)(nil),                           // 16: application.CommentEdit
+	(*ApplicationData)(nil),                       // 17: application.ApplicationData
+	(*HealthResponse)(nil),                        // 18: application.HealthResponse
+	(*CreateApplicationRequest)(nil),              // 19: application.CreateApplicationRequest
+	(*CreateApplicationResponse)(nil),             // 20: application.CreateApplicationResponse
+	(*GetApplicationRequest)(nil),                 // 21: application.GetApplicationRequest
+	(*GetApplicationResponse)(nil),                // 22: application.GetApplicationResponse
+	(*GetApplicationsRequest)(nil),                // 23: application.GetApplicationsRequest
+	(*GetApplicationsResponse)(nil),               // 24: application.GetApplicationsResponse
+	(*UpdateApplicationStatusRequest)(nil),        // 25: application.UpdateApplicationStatusRequest
+	(*AssignApplicationRequest)(nil),              // 26: application.AssignApplicationRequest
+	(*RedirectApplicationRequest)(nil),            // 27: application.RedirectApplicationRequest
+	(*RecallApplicationRequest)(nil),              // 28: application.RecallApplicationRequest
+	(*TakeApplicationToVerificationRequest)(nil),  // 29: application.TakeApplicationToVerificationRequest
+	(*ReleaseApplicationVerificationRequest)(nil), // 30: application.ReleaseApplicationVerificationRequest
+	(*AddApplicationFixLogRequest)(nil),           // 31: application.AddApplicationFixLogRequest
+	(*AddApplicationFixLogResponse)(nil),          // 32: application.AddApplicationFixLogResponse
+	(*DeleteApplicationRequest)(nil),              // 33: application.DeleteApplicationRequest
+	(*GetApplicationHistoryRequest)(nil),          // 34: application.GetApplicationHistoryRequest
+	(*GetApplicationHistoryResponse)(nil),         // 35: application.GetApplicationHistoryResponse
+	(*UploadApplicationAttachmentRequest)(nil),    // 36: application.UploadApplicationAttachmentRequest
+	(*UploadApplicationAttachmentResponse)(nil),   // 37: application.UploadApplicationAttachmentResponse
+	(*GetApplicationAttachmentRequest)(nil),       // 38: application.GetApplicationAttachmentRequest
+	(*GetApplicationAttachmentResponse)(nil),      // 39: application.GetApplicationAttachmentResponse
+	(*DeleteApplicationAttachmentRequest)(nil),    // 40: application.DeleteApplicationAttachmentRequest
+	(*WatchApplicationsRequest)(nil),              // 41: application.WatchApplicationsRequest
+	(*ApplicationUpdate)(nil),                     // 42: application.ApplicationUpdate
+	(*GetApplicationActionsRequest)(nil),          // 43: application.GetApplicationActionsRequest
+	(*GetApplicationActionsResponse)(nil),         // 44: application.GetApplicationActionsResponse
+	(*GetCompanyWorkflowRequest)(nil),             // 45: application.GetCompanyWorkflowRequest
+	(*GetCompanyWorkflowResponse)(nil),            // 46: application.GetCompanyWorkflowResponse
+	(*UpdateCompanyWorkflowRequest)(nil),          // 47: application.UpdateCompanyWorkflowRequest
+	(*ResetCompanyWorkflowRequest)(nil),           // 48: application.ResetCompanyWorkflowRequest
+	(*GetCompanySLAPoliciesRequest)(nil),          // 49: application.GetCompanySLAPoliciesRequest
+	(*GetCompanySLAPoliciesResponse)(nil),         // 50: application.GetCompanySLAPoliciesResponse
+	(*UpdateCompanySLAPolicyRequest)(nil),         // 51: application.UpdateCompanySLAPolicyRequest
+	(*DeleteCompanySLAPolicyRequest)(nil),         // 52: application.DeleteCompanySLAPolicyRequest
+	(*GetApplicationStatusStatsRequest)(nil),      // 53: application.GetApplicationStatusStatsRequest
+	(*GetApplicationStatusStatsResponse)(nil),     // 54: application.GetApplicationStatusStatsResponse
+	(*GetApplicationLeadTimesRequest)(nil),        // 55: application.GetApplicationLeadTimesRequest
+	(*GetApplicationLeadTimesResponse)(nil),       // 56: application.GetApplicationLeadTimesResponse
+	(*GetEngineerRevisionStatsRequest)(nil),       // 57: application.GetEngineerRevisionStatsRequest
+	(*GetEngineerRevisionStatsResponse)(nil),      // 58: application.GetEngineerRevisionStatsResponse
+	(*GetDepartmentFailureStatsRequest)(nil),      // 59: application.GetDepartmentFailureStatsRequest
+	(*GetDepartmentFailureStatsResponse)(nil),     // 60: application.GetDepartmentFailureStatsResponse
+	(*ExportApplicationsRequest)(nil),             // 61: application.ExportApplicationsRequest
+	(*ExportedApplication)(nil),                   // 62: application.ExportedApplication
+	(*CreateApplicationCommentRequest)(nil),       // 63: application.CreateApplicationCommentRequest
+	(*CreateApplicationCommentResponse)(nil),      // 64: application.CreateApplicationCommentResponse
+	(*GetApplicationCommentsRequest)(nil),         // 65: application.GetApplicationCommentsRequest
+	(*GetApplicationCommentsResponse)(nil),        // 66: application.GetApplicationCommentsResponse
+	(*UpdateApplicationCommentRequest)(nil),       // 67: application.UpdateApplicationCommentRequest
+	(*DeleteApplicationCommentRequest)(nil),       // 68: application.DeleteApplicationCommentRequest
+	(*GetApplicationCommentHistoryRequest)(nil),   // 69: application.GetApplicationCommentHistoryRequest
+	(*GetApplicationCommentHistoryResponse)(nil),  // 70: application.GetApplicationCommentHistoryResponse
+	(*CreateApplicationCategoryRequest)(nil),      // 71: application.CreateApplicationCategoryRequest
+	(*CreateApplicationCategoryResponse)(nil),     // 72: application.CreateApplicationCategoryResponse
+	(*GetApplicationCategoriesRequest)(nil),       // 73: application.GetApplicationCategoriesRequest
+	(*GetApplicationCategoriesResponse)(nil),      // 74: application.GetApplicationCategoriesResponse
+	(*UpdateApplicationCategoryRequest)(nil),      // 75: application.UpdateApplicationCategoryRequest
+	(*DeleteApplicationCategoryRequest)(nil),      // 76: application.DeleteApplicationCategoryRequest
+	(*GetCompanyCustomFieldsRequest)(nil),         // 77: application.GetCompanyCustomFieldsRequest
+	(*GetCompanyCustomFieldsResponse)(nil),        // 78: application.GetCompanyCustomFieldsResponse
+	(*UpdateCompanyCustomFieldRequest)(nil),       // 79: application.UpdateCompanyCustomFieldRequest
+	(*DeleteCompanyCustomFieldRequest)(nil),       // 80: application.DeleteCompanyCustomFieldRequest
+	(*GetEmployeeOpenApplicationsRequest)(nil),    // 81: application.GetEmployeeOpenApplicationsRequest
+	(*EmployeeOpenApplication)(nil),               // 82: application.EmployeeOpenApplication
+	(*GetEmployeeOpenApplicationsResponse)(nil),   // 83: application.GetEmployeeOpenApplicationsResponse
+	(*OffboardEmployeeApplicationsRequest)(nil),   // 84: application.OffboardEmployeeApplicationsRequest
+	(*OffboardEmployeeApplicationsResponse)(nil),  // 85: application.OffboardEmployeeApplicationsResponse
+	(*ArchiveCompanyApplicationsRequest)(nil),     // 86: application.ArchiveCompanyApplicationsRequest
+	(*ArchiveCompanyApplicationsResponse)(nil),    // 87: application.ArchiveCompanyApplicationsResponse
+	(*emptypb.Empty)(nil),                         // 88: google.protobuf.Empty
 }
 var file_application_proto_depIdxs = []int32{
 	1,  // 0: application.Application.fix_logs:type_name -> application.FixLog
-	2,  // 1: application.CreateApplicationRequest.application_data:type_name -> application.ApplicationData
-	0,  // 2: application.GetApplicationResponse.application:type_name -> application.Application
-	0,  // 3: application.GetApplicationsResponse.applications:type_name -> application.Application
-	0,  // 4: application.GetApplicationHistoryResponse.history:type_name -> application.Application
-	20, // 5: application.ApplicationService.Health:input_type -> google.protobuf.Empty
-	4,  // 6: application.ApplicationService.CreateApplication:input_type -> application.CreateApplicationRequest
-	6,  // 7: application.ApplicationService.GetApplication:input_type -> application.GetApplicationRequest
-	8,  // 8: application.ApplicationService.GetApplications:input_type -> application.GetApplicationsRequest
-	10, // 9: application.ApplicationService.UpdateApplicationStatus:input_type -> application.UpdateApplicationStatusRequest
-	11, // 10: application.ApplicationService.AssignApplication:input_type -> application.AssignApplicationRequest
-	12, // 11: application.ApplicationService.RedirectApplication:input_type -> application.RedirectApplicationRequest
-	13, // 12: application.ApplicationService.RecallApplication:input_type -> application.RecallApplicationRequest
-	14, // 13: application.ApplicationService.TakeApplicationToVerification:input_type -> application.TakeApplicationToVerificationRequest
-	15, // 14: application.ApplicationService.ReleaseApplicationVerification:input_type -> application.ReleaseApplicationVerificationRequest
-	16, // 15: application.ApplicationService.AddApplicationFixLog:input_type -> application.AddApplicationFixLogRequest
-	17, // 16: application.ApplicationService.DeleteApplication:input_type -> application.DeleteApplicationRequest
-	18, // 17: application.ApplicationService.GetApplicationHistory:input_type -> application.GetApplicationHistoryRequest
-	3,  // 18: application.ApplicationService.Health:output_type -> application.HealthResponse
-	5,  // 19: application.ApplicationService.CreateApplication:output_type -> application.CreateApplicationResponse
-	7,  // 20: application.ApplicationService.GetApplication:output_type -> application.GetApplicationResponse
-	9,  // 21: application.ApplicationService.GetApplications:output_type -> application.GetApplicationsResponse
-	20, // 22: application.ApplicationService.UpdateApplicationStatus:output_type -> google.protobuf.Empty
-	20, // 23: application.ApplicationService.AssignApplication:output_type -> google.protobuf.Empty
-	20, // 24: application.ApplicationService.RedirectApplication:output_type -> google.protobuf.Empty
-	20, // 25: application.ApplicationService.RecallApplication:output_type -> google.protobuf.Empty
-	20, // 26: application.ApplicationService.TakeApplicationToVerification:output_type -> google.protobuf.Empty
-	20, // 27: application.ApplicationService.ReleaseApplicationVerification:output_type -> google.protobuf.Empty
-	20, // 28: application.ApplicationService.AddApplicationFixLog:output_type -> google.protobuf.Empty
-	20, // 29: application.ApplicationService.DeleteApplication:output_type -> google.protobuf.Empty
-	19, // 30: application.ApplicationService.GetApplicationHistory:output_type -> application.GetApplicationHistoryResponse
-	18, // [18:31] is the sub-list for method output_type
-	5,  // [5:18] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	2,  // 1: application.Application.attachments:type_name -> application.Attachment
+	7,  // 2: application.Application.custom_fields:type_name -> application.CustomFieldValue
+	2,  // 3: application.FixLog.attachments:type_name -> application.Attachment
+	3,  // 4: application.Workflow.transitions:type_name -> application.WorkflowTransition
+	15, // 5: application.Comment.replies:type_name -> application.Comment
+	17, // 6: application.CreateApplicationRequest.application_data:type_name -> application.ApplicationData
+	7,  // 7: application.CreateApplicationRequest.custom_fields:type_name -> application.CustomFieldValue
+	0,  // 8: application.GetApplicationResponse.application:type_name -> application.Application
+	7,  // 9: application.GetApplicationsRequest.custom_fields:type_name -> application.CustomFieldValue
+	0,  // 10: application.GetApplicationsResponse.applications:type_name -> application.Application
+	0,  // 11: application.GetApplicationHistoryResponse.history:type_name -> application.Application
+	2,  // 12: application.UploadApplicationAttachmentResponse.attachment:type_name -> application.Attachment
+	2,  // 13: application.GetApplicationAttachmentResponse.attachment:type_name -> application.Attachment
+	0,  // 14: application.ApplicationUpdate.application:type_name -> application.Application
+	3,  // 15: application.GetApplicationActionsResponse.actions:type_name -> application.WorkflowTransition
+	4,  // 16: application.GetCompanyWorkflowResponse.workflow:type_name -> application.Workflow
+	4,  // 17: application.UpdateCompanyWorkflowRequest.workflow:type_name -> application.Workflow
+	8,  // 18: application.GetCompanySLAPoliciesResponse.policies:type_name -> application.SLAPolicy
+	8,  // 19: application.UpdateCompanySLAPolicyRequest.policy:type_name -> application.SLAPolicy
+	9,  // 20: application.GetApplicationStatusStatsRequest.filter:type_name -> application.AnalyticsFilter
+	10, // 21: application.GetApplicationStatusStatsResponse.stats:type_name -> application.StatusStat
+	9,  // 22: application.GetApplicationLeadTimesRequest.filter:type_name -> application.AnalyticsFilter
+	11, // 23: application.GetApplicationLeadTimesResponse.assign:type_name -> application.LeadTime
+	11, // 24: application.GetApplicationLeadTimesResponse.fix:type_name -> application.LeadTime
+	11, // 25: application.GetApplicationLeadTimesResponse.verify:type_name -> application.LeadTime
+	9,  // 26: application.GetEngineerRevisionStatsRequest.filter:type_name -> application.AnalyticsFilter
+	12, // 27: application.GetEngineerRevisionStatsResponse.stats:type_name -> application.EngineerRevisionStat
+	9,  // 28: application.GetDepartmentFailureStatsRequest.filter:type_name -> application.AnalyticsFilter
+	13, // 29: application.GetDepartmentFailureStatsResponse.stats:type_name -> application.DepartmentFailureStat
+	23, // 30: application.ExportApplicationsRequest.query:type_name -> application.GetApplicationsRequest
+	0,  // 31: application.ExportedApplication.application:type_name -> application.Application
+	14, // 32: application.ExportedApplication.timeline:type_name -> application.StatusChange
+	15, // 33: application.CreateApplicationCommentResponse.comment:type_name -> application.Comment
+	15, // 34: application.GetApplicationCommentsResponse.comments:type_name -> application.Comment
+	15, // 35: application.GetApplicationCommentHistoryResponse.comment:type_name -> application.Comment
+	16, // 36: application.GetApplicationCommentHistoryResponse.edits:type_name -> application.CommentEdit
+	5,  // 37: application.GetApplicationCategoriesResponse.categories:type_name -> application.ApplicationCategory
+	6,  // 38: application.GetCompanyCustomFieldsResponse.fields:type_name -> application.CustomField
+	6,  // 39: application.UpdateCompanyCustomFieldRequest.field:type_name -> application.CustomField
+	82, // 40: application.GetEmployeeOpenApplicationsResponse.applications:type_name -> application.EmployeeOpenApplication
+	88, // 41: application.ApplicationService.Health:input_type -> google.protobuf.Empty
+	19, // 42: application.ApplicationService.CreateApplication:input_type -> application.CreateApplicationRequest
+	21, // 43: application.ApplicationService.GetApplication:input_type -> application.GetApplicationRequest
+	23, // 44: application.ApplicationService.GetApplications:input_type -> application.GetApplicationsRequest
+	25, // 45: application.ApplicationService.UpdateApplicationStatus:input_type -> application.UpdateApplicationStatusRequest
+	26, // 46: application.ApplicationService.AssignApplication:input_type -> application.AssignApplicationRequest
+	27, // 47: application.ApplicationService.RedirectApplication:input_type -> application.RedirectApplicationRequest
+	28, // 48: application.ApplicationService.RecallApplication:input_type -> application.RecallApplicationRequest
+	29, // 49: application.ApplicationService.TakeApplicationToVerification:input_type -> application.TakeApplicationToVerificationRequest
+	30, // 50: application.ApplicationService.ReleaseApplicationVerification:input_type -> application.ReleaseApplicationVerificationRequest
+	31, // 51: application.ApplicationService.AddApplicationFixLog:input_type -> application.AddApplicationFixLogRequest
+	33, // 52: application.ApplicationService.DeleteApplication:input_type -> application.DeleteApplicationRequest
+	34, // 53: application.ApplicationService.GetApplicationHistory:input_type -> application.GetApplicationHistoryRequest
+	36, // 54: application.ApplicationService.UploadApplicationAttachment:input_type -> application.UploadApplicationAttachmentRequest
+	38, // 55: application.ApplicationService.GetApplicationAttachment:input_type -> application.GetApplicationAttachmentRequest
+	40, // 56: application.ApplicationService.DeleteApplicationAttachment:input_type -> application.DeleteApplicationAttachmentRequest
+	41, // 57: application.ApplicationService.WatchApplications:input_type -> application.WatchApplicationsRequest
+	43, // 58: application.ApplicationService.GetApplicationActions:input_type -> application.GetApplicationActionsRequest
+	45, // 59: application.ApplicationService.GetCompanyWorkflow:input_type -> application.GetCompanyWorkflowRequest
+	47, // 60: application.ApplicationService.UpdateCompanyWorkflow:input_type -> application.UpdateCompanyWorkflowRequest
+	48, // 61: application.ApplicationService.ResetCompanyWorkflow:input_type -> application.ResetCompanyWorkflowRequest
+	49, // 62: application.ApplicationService.GetCompanySLAPolicies:input_type -> application.GetCompanySLAPoliciesRequest
+	51, // 63: application.ApplicationService.UpdateCompanySLAPolicy:input_type -> application.UpdateCompanySLAPolicyRequest
+	52, // 64: application.ApplicationService.DeleteCompanySLAPolicy:input_type -> application.DeleteCompanySLAPolicyRequest
+	53, // 65: application.ApplicationService.GetApplicationStatusStats:input_type -> application.GetApplicationStatusStatsRequest
+	55, // 66: application.ApplicationService.GetApplicationLeadTimes:input_type -> application.GetApplicationLeadTimesRequest
+	57, // 67: application.ApplicationService.GetEngineerRevisionStats:input_type -> application.GetEngineerRevisionStatsRequest
+	59, // 68: application.ApplicationService.GetDepartmentFailureStats:input_type -> application.GetDepartmentFailureStatsRequest
+	61, // 69: application.ApplicationService.ExportApplications:input_type -> application.ExportApplicationsRequest
+	63, // 70: application.ApplicationService.CreateApplicationComment:input_type -> application.CreateApplicationCommentRequest
+	65, // 71: application.ApplicationService.GetApplicationComments:input_type -> application.GetApplicationCommentsRequest
+	67, // 72: application.ApplicationService.UpdateApplicationComment:input_type -> application.UpdateApplicationCommentRequest
+	68, // 73: application.ApplicationService.DeleteApplicationComment:input_type -> application.DeleteApplicationCommentRequest
+	69, // 74: application.ApplicationService.GetApplicationCommentHistory:input_type -> application.GetApplicationCommentHistoryRequest
+	71, // 75: application.ApplicationService.CreateApplicationCategory:input_type -> application.CreateApplicationCategoryRequest
+	73, // 76: application.ApplicationService.GetApplicationCategories:input_type -> application.GetApplicationCategoriesRequest
+	75, // 77: application.ApplicationService.UpdateApplicationCategory:input_type -> application.UpdateApplicationCategoryRequest
+	76, // 78: application.ApplicationService.DeleteApplicationCategory:input_type -> application.DeleteApplicationCategoryRequest
+	77, // 79: application.ApplicationService.GetCompanyCustomFields:input_type -> application.GetCompanyCustomFieldsRequest
+	79, // 80: application.ApplicationService.UpdateCompanyCustomField:input_type -> application.UpdateCompanyCustomFieldRequest
+	80, // 81: application.ApplicationService.DeleteCompanyCustomField:input_type -> application.DeleteCompanyCustomFieldRequest
+	81, // 82: application.ApplicationService.GetEmployeeOpenApplications:input_type -> application.GetEmployeeOpenApplicationsRequest
+	84, // 83: application.ApplicationService.OffboardEmployeeApplications:input_type -> application.OffboardEmployeeApplicationsRequest
+	86, // 84: application.ApplicationService.ArchiveCompanyApplications:input_type -> application.ArchiveCompanyApplicationsRequest
+	18, // 85: application.ApplicationService.Health:output_type -> application.HealthResponse
+	20, // 86: application.ApplicationService.CreateApplication:output_type -> application.CreateApplicationResponse
+	22, // 87: application.ApplicationService.GetApplication:output_type -> application.GetApplicationResponse
+	24, // 88: application.ApplicationService.GetApplications:output_type -> application.GetApplicationsResponse
+	88, // 89: application.ApplicationService.UpdateApplicationStatus:output_type -> google.protobuf.Empty
+	88, // 90: application.ApplicationService.AssignApplication:output_type -> google.protobuf.Empty
+	88, // 91: application.ApplicationService.RedirectApplication:output_type -> google.protobuf.Empty
+	88, // 92: application.ApplicationService.RecallApplication:output_type -> google.protobuf.Empty
+	88, // 93: application.ApplicationService.TakeApplicationToVerification:output_type -> google.protobuf.Empty
+	88, // 94: application.ApplicationService.ReleaseApplicationVerification:output_type -> google.protobuf.Empty
+	32, // 95: application.ApplicationService.AddApplicationFixLog:output_type -> application.AddApplicationFixLogResponse
+	88, // 96: application.ApplicationService.DeleteApplication:output_type -> google.protobuf.Empty
+	35, // 97: application.ApplicationService.GetApplicationHistory:output_type -> application.GetApplicationHistoryResponse
+	37, // 98: application.ApplicationService.UploadApplicationAttachment:output_type -> application.UploadApplicationAttachmentResponse
+	39, // 99: application.ApplicationService.GetApplicationAttachment:output_type -> application.GetApplicationAttachmentResponse
+	88, // 100: application.ApplicationService.DeleteApplicationAttachment:output_type -> google.protobuf.Empty
+	42, // 101: application.ApplicationService.WatchApplications:output_type -> application.ApplicationUpdate
+	44, // 102: application.ApplicationService.GetApplicationActions:output_type -> application.GetApplicationActionsResponse
+	46, // 103: application.ApplicationService.GetCompanyWorkflow:output_type -> application.GetCompanyWorkflowResponse
+	88, // 104: application.ApplicationService.UpdateCompanyWorkflow:output_type -> google.protobuf.Empty
+	88, // 105: application.ApplicationService.ResetCompanyWorkflow:output_type -> google.protobuf.Empty
+	50, // 106: application.ApplicationService.GetCompanySLAPolicies:output_type -> application.GetCompanySLAPoliciesResponse
+	88, // 107: application.ApplicationService.UpdateCompanySLAPolicy:output_type -> google.protobuf.Empty
+	88, // 108: application.ApplicationService.DeleteCompanySLAPolicy:output_type -> google.protobuf.Empty
+	54, // 109: application.ApplicationService.GetApplicationStatusStats:output_type -> application.GetApplicationStatusStatsResponse
+	56, // 110: application.ApplicationService.GetApplicationLeadTimes:output_type -> application.GetApplicationLeadTimesResponse
+	58, // 111: application.ApplicationService.GetEngineerRevisionStats:output_type -> application.GetEngineerRevisionStatsResponse
+	60, // 112: application.ApplicationService.GetDepartmentFailureStats:output_type -> application.GetDepartmentFailureStatsResponse
+	62, // 113: application.ApplicationService.ExportApplications:output_type -> application.ExportedApplication
+	64, // 114: application.ApplicationService.CreateApplicationComment:output_type -> application.CreateApplicationCommentResponse
+	66, // 115: application.ApplicationService.GetApplicationComments:output_type -> application.GetApplicationCommentsResponse
+	88, // 116: application.ApplicationService.UpdateApplicationComment:output_type -> google.protobuf.Empty
+	88, // 117: application.ApplicationService.DeleteApplicationComment:output_type -> google.protobuf.Empty
+	70, // 118: application.ApplicationService.GetApplicationCommentHistory:output_type -> application.GetApplicationCommentHistoryResponse
+	72, // 119: application.ApplicationService.CreateApplicationCategory:output_type -> application.CreateApplicationCategoryResponse
+	74, // 120: application.ApplicationService.GetApplicationCategories:output_type -> application.GetApplicationCategoriesResponse
+	88, // 121: application.ApplicationService.UpdateApplicationCategory:output_type -> google.protobuf.Empty
+	88, // 122: application.ApplicationService.DeleteApplicationCategory:output_type -> google.protobuf.Empty
+	78, // 123: application.ApplicationService.GetCompanyCustomFields:output_type -> application.GetCompanyCustomFieldsResponse
+	88, // 124: application.ApplicationService.UpdateCompanyCustomField:output_type -> google.protobuf.Empty
+	88, // 125: application.ApplicationService.DeleteCompanyCustomField:output_type -> google.protobuf.Empty
+	83, // 126: application.ApplicationService.GetEmployeeOpenApplications:output_type -> application.GetEmployeeOpenApplicationsResponse
+	85, // 127: application.ApplicationService.OffboardEmployeeApplications:output_type -> application.OffboardEmployeeApplicationsResponse
+	87, // 128: application.ApplicationService.ArchiveCompanyApplications:output_type -> application.ArchiveCompanyApplicationsResponse
+	85, // [85:129] is the sub-list for method output_type
+	41, // [41:85] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_application_proto_init() }
@@ -1564,13 +6677,14 @@ func file_application_proto_init() {
 	if File_application_proto != nil {
 		return
 	}
+	file_application_proto_msgTypes[23].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_application_proto_rawDesc), len(file_application_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   88,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -33,6 +33,37 @@ const (
 	ApplicationService_AddApplicationFixLog_FullMethodName           = "/application.ApplicationService/AddApplicationFixLog"
 	ApplicationService_DeleteApplication_FullMethodName              = "/application.ApplicationService/DeleteApplication"
 	ApplicationService_GetApplicationHistory_FullMethodName          = "/application.ApplicationService/GetApplicationHistory"
+	ApplicationService_UploadApplicationAttachment_FullMethodName    = "/application.ApplicationService/UploadApplicationAttachment"
+	ApplicationService_GetApplicationAttachment_FullMethodName       = "/application.ApplicationService/GetApplicationAttachment"
+	ApplicationService_DeleteApplicationAttachment_FullMethodName    = "/application.ApplicationService/DeleteApplicationAttachment"
+	ApplicationService_WatchApplications_FullMethodName              = "/application.ApplicationService/WatchApplications"
+	ApplicationService_GetApplicationActions_FullMethodName          = "/application.ApplicationService/GetApplicationActions"
+	ApplicationService_GetCompanyWorkflow_FullMethodName             = "/application.ApplicationService/GetCompanyWorkflow"
+	ApplicationService_UpdateCompanyWorkflow_FullMethodName          = "/application.ApplicationService/UpdateCompanyWorkflow"
+	ApplicationService_ResetCompanyWorkflow_FullMethodName           = "/application.ApplicationService/ResetCompanyWorkflow"
+	ApplicationService_GetCompanySLAPolicies_FullMethodName          = "/application.ApplicationService/GetCompanySLAPolicies"
+	ApplicationService_UpdateCompanySLAPolicy_FullMethodName         = "/application.ApplicationService/UpdateCompanySLAPolicy"
+	ApplicationService_DeleteCompanySLAPolicy_FullMethodName         = "/application.ApplicationService/DeleteCompanySLAPolicy"
+	ApplicationService_GetApplicationStatusStats_FullMethodName      = "/application.ApplicationService/GetApplicationStatusStats"
+	ApplicationService_GetApplicationLeadTimes_FullMethodName        = "/application.ApplicationService/GetApplicationLeadTimes"
+	ApplicationService_GetEngineerRevisionStats_FullMethodName       = "/application.ApplicationService/GetEngineerRevisionStats"
+	ApplicationService_GetDepartmentFailureStats_FullMethodName      = "/application.ApplicationService/GetDepartmentFailureStats"
+	ApplicationService_ExportApplications_FullMethodName             = "/application.ApplicationService/ExportApplications"
+	ApplicationService_CreateApplicationComment_FullMethodName       = "/application.ApplicationService/CreateApplicationComment"
+	ApplicationService_GetApplicationComments_FullMethodName         = "/application.ApplicationService/GetApplicationComments"
+	ApplicationService_UpdateApplicationComment_FullMethodName       = "/application.ApplicationService/UpdateApplicationComment"
+	ApplicationService_DeleteApplicationComment_FullMethodName       = "/application.ApplicationService/DeleteApplicationComment"
+	ApplicationService_GetApplicationCommentHistory_FullMethodName   = "/application.ApplicationService/GetApplicationCommentHistory"
+	ApplicationService_CreateApplicationCategory_FullMethodName      = "/application.ApplicationService/CreateApplicationCategory"
+	ApplicationService_GetApplicationCategories_FullMethodName       = "/application.ApplicationService/GetApplicationCategories"
+	ApplicationService_UpdateApplicationCategory_FullMethodName      = "/application.ApplicationService/UpdateApplicationCategory"
+	ApplicationService_DeleteApplicationCategory_FullMethodName      = "/application.ApplicationService/DeleteApplicationCategory"
+	ApplicationService_GetCompanyCustomFields_FullMethodName         = "/application.ApplicationService/GetCompanyCustomFields"
+	ApplicationService_UpdateCompanyCustomField_FullMethodName       = "/application.ApplicationService/UpdateCompanyCustomField"
+	ApplicationService_DeleteCompanyCustomField_FullMethodName       = "/application.ApplicationService/DeleteCompanyCustomField"
+	ApplicationService_GetEmployeeOpenApplications_FullMethodName    = "/application.ApplicationService/GetEmployeeOpenApplications"
+	ApplicationService_OffboardEmployeeApplications_FullMethodName   = "/application.ApplicationService/OffboardEmployeeApplications"
+	ApplicationService_ArchiveCompanyApplications_FullMethodName     = "/application.ApplicationService/ArchiveCompanyApplications"
 )
 
 // ApplicationServiceClient is the client API for ApplicationService service.
@@ -49,9 +80,40 @@ type ApplicationServiceClient interface {
 	RecallApplication(ctx context.Context, in *RecallApplicationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	TakeApplicationToVerification(ctx context.Context, in *TakeApplicationToVerificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ReleaseApplicationVerification(ctx context.Context, in *ReleaseApplicationVerificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	AddApplicationFixLog(ctx context.Context, in *AddApplicationFixLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddApplicationFixLog(ctx context.Context, in *AddApplicationFixLogRequest, opts ...grpc.CallOption) (*AddApplicationFixLogResponse, error)
 	DeleteApplication(ctx context.Context, in *DeleteApplicationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetApplicationHistory(ctx context.Context, in *GetApplicationHistoryRequest, opts ...grpc.CallOption) (*GetApplicationHistoryResponse, error)
+	UploadApplicationAttachment(ctx context.Context, in *UploadApplicationAttachmentRequest, opts ...grpc.CallOption) (*UploadApplicationAttachmentResponse, error)
+	GetApplicationAttachment(ctx context.Context, in *GetApplicationAttachmentRequest, opts ...grpc.CallOption) (*GetApplicationAttachmentResponse, error)
+	DeleteApplicationAttachment(ctx context.Context, in *DeleteApplicationAttachmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	WatchApplications(ctx context.Context, in *WatchApplicationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ApplicationUpdate], error)
+	GetApplicationActions(ctx context.Context, in *GetApplicationActionsRequest, opts ...grpc.CallOption) (*GetApplicationActionsResponse, error)
+	GetCompanyWorkflow(ctx context.Context, in *GetCompanyWorkflowRequest, opts ...grpc.CallOption) (*GetCompanyWorkflowResponse, error)
+	UpdateCompanyWorkflow(ctx context.Context, in *UpdateCompanyWorkflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ResetCompanyWorkflow(ctx context.Context, in *ResetCompanyWorkflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetCompanySLAPolicies(ctx context.Context, in *GetCompanySLAPoliciesRequest, opts ...grpc.CallOption) (*GetCompanySLAPoliciesResponse, error)
+	UpdateCompanySLAPolicy(ctx context.Context, in *UpdateCompanySLAPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteCompanySLAPolicy(ctx context.Context, in *DeleteCompanySLAPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetApplicationStatusStats(ctx context.Context, in *GetApplicationStatusStatsRequest, opts ...grpc.CallOption) (*GetApplicationStatusStatsResponse, error)
+	GetApplicationLeadTimes(ctx context.Context, in *GetApplicationLeadTimesRequest, opts ...grpc.CallOption) (*GetApplicationLeadTimesResponse, error)
+	GetEngineerRevisionStats(ctx context.Context, in *GetEngineerRevisionStatsRequest, opts ...grpc.CallOption) (*GetEngineerRevisionStatsResponse, error)
+	GetDepartmentFailureStats(ctx context.Context, in *GetDepartmentFailureStatsRequest, opts ...grpc.CallOption) (*GetDepartmentFailureStatsResponse, error)
+	ExportApplications(ctx context.Context, in *ExportApplicationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportedApplication], error)
+	CreateApplicationComment(ctx context.Context, in *CreateApplicationCommentRequest, opts ...grpc.CallOption) (*CreateApplicationCommentResponse, error)
+	GetApplicationComments(ctx context.Context, in *GetApplicationCommentsRequest, opts ...grpc.CallOption) (*GetApplicationCommentsResponse, error)
+	UpdateApplicationComment(ctx context.Context, in *UpdateApplicationCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteApplicationComment(ctx context.Context, in *DeleteApplicationCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetApplicationCommentHistory(ctx context.Context, in *GetApplicationCommentHistoryRequest, opts ...grpc.CallOption) (*GetApplicationCommentHistoryResponse, error)
+	CreateApplicationCategory(ctx context.Context, in *CreateApplicationCategoryRequest, opts ...grpc.CallOption) (*CreateApplicationCategoryResponse, error)
+	GetApplicationCategories(ctx context.Context, in *GetApplicationCategoriesRequest, opts ...grpc.CallOption) (*GetApplicationCategoriesResponse, error)
+	UpdateApplicationCategory(ctx context.Context, in *UpdateApplicationCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteApplicationCategory(ctx context.Context, in *DeleteApplicationCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetCompanyCustomFields(ctx context.Context, in *GetCompanyCustomFieldsRequest, opts ...grpc.CallOption) (*GetCompanyCustomFieldsResponse, error)
+	UpdateCompanyCustomField(ctx context.Context, in *UpdateCompanyCustomFieldRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteCompanyCustomField(ctx context.Context, in *DeleteCompanyCustomFieldRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetEmployeeOpenApplications(ctx context.Context, in *GetEmployeeOpenApplicationsRequest, opts ...grpc.CallOption) (*GetEmployeeOpenApplicationsResponse, error)
+	OffboardEmployeeApplications(ctx context.Context, in *OffboardEmployeeApplicationsRequest, opts ...grpc.CallOption) (*OffboardEmployeeApplicationsResponse, error)
+	ArchiveCompanyApplications(ctx context.Context, in *ArchiveCompanyApplicationsRequest, opts ...grpc.CallOption) (*ArchiveCompanyApplicationsResponse, error)
 }
 
 type applicationServiceClient struct {
@@ -162,9 +224,9 @@ func (c *applicationServiceClient) ReleaseApplicationVerification(ctx context.Co
 	return out, nil
 }
 
-func (c *applicationServiceClient) AddApplicationFixLog(ctx context.Context, in *AddApplicationFixLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *applicationServiceClient) AddApplicationFixLog(ctx context.Context, in *AddApplicationFixLogRequest, opts ...grpc.CallOption) (*AddApplicationFixLogResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
+	out := new(AddApplicationFixLogResponse)
 	err := c.cc.Invoke(ctx, ApplicationService_AddApplicationFixLog_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -179,336 +241,1332 @@ func (c *applicationServiceClient) DeleteApplication(ctx context.Context, in *De
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetApplicationHistory(ctx context.Context, in *GetApplicationHistoryRequest, opts ...grpc.CallOption) (*GetApplicationHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetApplicationHistoryResponse)
+	err := c.cc.Invoke(ctx, ApplicationService_GetApplicationHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) UploadApplicationAttachment(ctx context.Context, in *UploadApplicationAttachmentRequest, opts ...grpc.CallOption) (*UploadApplicationAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadApplicationAttachmentResponse)
+	err := c.cc.Invoke(ctx, ApplicationService_UploadApplicationAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetApplicationAttachment(ctx context.Context, in *GetApplicationAttachmentRequest, opts ...grpc.CallOption) (*GetApplicationAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetApplicationAttachmentResponse)
+	err := c.cc.Invoke(ctx, ApplicationService_GetApplicationAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) DeleteApplicationAttachment(ctx context.Context, in *DeleteApplicationAttachmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApplicationService_DeleteApplicationAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) WatchApplications(ctx context.Context, in *WatchApplicationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ApplicationUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ApplicationService_ServiceDesc.Streams[0], ApplicationService_WatchApplications_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchApplicationsRequest, ApplicationUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ApplicationService_WatchApplicationsClient = grpc.ServerStreamingClient[ApplicationUpdate]
+
+func (c *applicationServiceClient) GetApplicationActions(ctx context.Context, in *GetApplicationActionsRequest, opts ...grpc.CallOption) (*GetApplicationActionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetApplicationActionsResponse)
+	err := c.cc.Invoke(ctx, ApplicationService_GetApplicationActions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetCompanyWorkflow(ctx context.Context, in *GetCompanyWorkflowRequest, opts ...grpc.CallOption) (*GetCompanyWorkflowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCompanyWorkflowResponse)
+	err := c.cc.Invoke(ctx, ApplicationService_GetCompanyWorkflow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) UpdateCompanyWorkflow(ctx context.Context, in *UpdateCompanyWorkflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApplicationService_UpdateCompanyWorkflow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) ResetCompanyWorkflow(ctx context.Context, in *ResetCompanyWorkflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApplicationService_ResetCompanyWorkflow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetCompanySLAPolicies(ctx context.Context, in *GetCompanySLAPoliciesRequest, opts ...grpc.CallOption) (*GetCompanySLAPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCompanySLAPoliciesResponse)
+	err := c.cc.Invoke(ctx, ApplicationService_GetCompanySLAPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) UpdateCompanySLAPolicy(ctx context.Context, in *UpdateCompanySLAPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApplicationService_UpdateCompanySLAPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) DeleteCompanySLAPolicy(ctx context.Context, in *DeleteCompanySLAPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApplicationService_DeleteCompanySLAPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetApplicationStatusStats(ctx context.Context, in *GetApplicationStatusStatsRequest, opts ...grpc.CallOption) (*GetApplicationStatusStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetApplicationStatusStatsResponse)
+	err := c.cc.Invoke(ctx, ApplicationService_GetApplicationStatusStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetApplicationLeadTimes(ctx context.Context, in *GetApplicationLeadTimesRequest, opts ...grpc.CallOption) (*GetApplicationLeadTimesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetApplicationLeadTimesResponse)
+	err := c.cc.Invoke(ctx, ApplicationService_GetApplicationLeadTimes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetEngineerRevisionStats(ctx context.Context, in *GetEngineerRevisionStatsRequest, opts ...grpc.CallOption) (*GetEngineerRevisionStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEngineerRevisionStatsResponse)
+	err := c.cc.Invoke(ctx, ApplicationService_GetEngineerRevisionStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetDepartmentFailureStats(ctx context.Context, in *GetDepartmentFailureStatsRequest, opts ...grpc.CallOption) (*GetDepartmentFailureStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDepartmentFailureStatsResponse)
+	err := c.cc.Invoke(ctx, ApplicationService_GetDepartmentFailureStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) ExportApplications(ctx context.Context, in *ExportApplicationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportedApplication], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ApplicationService_ServiceDesc.Streams[1], ApplicationService_ExportApplications_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportApplicationsRequest, ExportedApplication]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ApplicationService_ExportApplicationsClient = grpc.ServerStreamingClient[ExportedApplication]
+
+func (c *applicationServiceClient) CreateApplicationComment(ctx context.Context, in *CreateApplicationCommentRequest, opts ...grpc.CallOption) (*CreateApplicationCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApplicationCommentResponse)
+	err := c.cc.Invoke(ctx, ApplicationService_CreateApplicationComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetApplicationComments(ctx context.Context, in *GetApplicationCommentsRequest, opts ...grpc.CallOption) (*GetApplicationCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetApplicationCommentsResponse)
+	err := c.cc.Invoke(ctx, ApplicationService_GetApplicationComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) UpdateApplicationComment(ctx context.Context, in *UpdateApplicationCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApplicationService_UpdateApplicationComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) DeleteApplicationComment(ctx context.Context, in *DeleteApplicationCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApplicationService_DeleteApplicationComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetApplicationCommentHistory(ctx context.Context, in *GetApplicationCommentHistoryRequest, opts ...grpc.CallOption) (*GetApplicationCommentHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetApplicationCommentHistoryResponse)
+	err := c.cc.Invoke(ctx, ApplicationService_GetApplicationCommentHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) CreateApplicationCategory(ctx context.Context, in *CreateApplicationCategoryRequest, opts ...grpc.CallOption) (*CreateApplicationCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApplicationCategoryResponse)
+	err := c.cc.Invoke(ctx, ApplicationService_CreateApplicationCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetApplicationCategories(ctx context.Context, in *GetApplicationCategoriesRequest, opts ...grpc.CallOption) (*GetApplicationCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetApplicationCategoriesResponse)
+	err := c.cc.Invoke(ctx, ApplicationService_GetApplicationCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) UpdateApplicationCategory(ctx context.Context, in *UpdateApplicationCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApplicationService_UpdateApplicationCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) DeleteApplicationCategory(ctx context.Context, in *DeleteApplicationCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApplicationService_DeleteApplicationCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetCompanyCustomFields(ctx context.Context, in *GetCompanyCustomFieldsRequest, opts ...grpc.CallOption) (*GetCompanyCustomFieldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCompanyCustomFieldsResponse)
+	err := c.cc.Invoke(ctx, ApplicationService_GetCompanyCustomFields_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) UpdateCompanyCustomField(ctx context.Context, in *UpdateCompanyCustomFieldRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApplicationService_UpdateCompanyCustomField_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) DeleteCompanyCustomField(ctx context.Context, in *DeleteCompanyCustomFieldRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApplicationService_DeleteCompanyCustomField_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetEmployeeOpenApplications(ctx context.Context, in *GetEmployeeOpenApplicationsRequest, opts ...grpc.CallOption) (*GetEmployeeOpenApplicationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEmployeeOpenApplicationsResponse)
+	err := c.cc.Invoke(ctx, ApplicationService_GetEmployeeOpenApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) OffboardEmployeeApplications(ctx context.Context, in *OffboardEmployeeApplicationsRequest, opts ...grpc.CallOption) (*OffboardEmployeeApplicationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OffboardEmployeeApplicationsResponse)
+	err := c.cc.Invoke(ctx, ApplicationService_OffboardEmployeeApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) ArchiveCompanyApplications(ctx context.Context, in *ArchiveCompanyApplicationsRequest, opts ...grpc.CallOption) (*ArchiveCompanyApplicationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveCompanyApplicationsResponse)
+	err := c.cc.Invoke(ctx, ApplicationService_ArchiveCompanyApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApplicationServiceServer is the server API for ApplicationService service.
+// All implementations must embed UnimplementedApplicationServiceServer
+// for forward compatibility.
+type ApplicationServiceServer interface {
+	Health(context.Context, *emptypb.Empty) (*HealthResponse, error)
+	CreateApplication(context.Context, *CreateApplicationRequest) (*CreateApplicationResponse, error)
+	GetApplication(context.Context, *GetApplicationRequest) (*GetApplicationResponse, error)
+	GetApplications(context.Context, *GetApplicationsRequest) (*GetApplicationsResponse, error)
+	UpdateApplicationStatus(context.Context, *UpdateApplicationStatusRequest) (*emptypb.Empty, error)
+	AssignApplication(context.Context, *AssignApplicationRequest) (*emptypb.Empty, error)
+	RedirectApplication(context.Context, *RedirectApplicationRequest) (*emptypb.Empty, error)
+	RecallApplication(context.Context, *RecallApplicationRequest) (*emptypb.Empty, error)
+	TakeApplicationToVerification(context.Context, *TakeApplicationToVerificationRequest) (*emptypb.Empty, error)
+	ReleaseApplicationVerification(context.Context, *ReleaseApplicationVerificationRequest) (*emptypb.Empty, error)
+	AddApplicationFixLog(context.Context, *AddApplicationFixLogRequest) (*AddApplicationFixLogResponse, error)
+	DeleteApplication(context.Context, *DeleteApplicationRequest) (*emptypb.Empty, error)
+	GetApplicationHistory(context.Context, *GetApplicationHistoryRequest) (*GetApplicationHistoryResponse, error)
+	UploadApplicationAttachment(context.Context, *UploadApplicationAttachmentRequest) (*UploadApplicationAttachmentResponse, error)
+	GetApplicationAttachment(context.Context, *GetApplicationAttachmentRequest) (*GetApplicationAttachmentResponse, error)
+	DeleteApplicationAttachment(context.Context, *DeleteApplicationAttachmentRequest) (*emptypb.Empty, error)
+	WatchApplications(*WatchApplicationsRequest, grpc.ServerStreamingServer[ApplicationUpdate]) error
+	GetApplicationActions(context.Context, *GetApplicationActionsRequest) (*GetApplicationActionsResponse, error)
+	GetCompanyWorkflow(context.Context, *GetCompanyWorkflowRequest) (*GetCompanyWorkflowResponse, error)
+	UpdateCompanyWorkflow(context.Context, *UpdateCompanyWorkflowRequest) (*emptypb.Empty, error)
+	ResetCompanyWorkflow(context.Context, *ResetCompanyWorkflowRequest) (*emptypb.Empty, error)
+	GetCompanySLAPolicies(context.Context, *GetCompanySLAPoliciesRequest) (*GetCompanySLAPoliciesResponse, error)
+	UpdateCompanySLAPolicy(context.Context, *UpdateCompanySLAPolicyRequest) (*emptypb.Empty, error)
+	DeleteCompanySLAPolicy(context.Context, *DeleteCompanySLAPolicyRequest) (*emptypb.Empty, error)
+	GetApplicationStatusStats(context.Context, *GetApplicationStatusStatsRequest) (*GetApplicationStatusStatsResponse, error)
+	GetApplicationLeadTimes(context.Context, *GetApplicationLeadTimesRequest) (*GetApplicationLeadTimesResponse, error)
+	GetEngineerRevisionStats(context.Context, *GetEngineerRevisionStatsRequest) (*GetEngineerRevisionStatsResponse, error)
+	GetDepartmentFailureStats(context.Context, *GetDepartmentFailureStatsRequest) (*GetDepartmentFailureStatsResponse, error)
+	ExportApplications(*ExportApplicationsRequest, grpc.ServerStreamingServer[ExportedApplication]) error
+	CreateApplicationComment(context.Context, *CreateApplicationCommentRequest) (*CreateApplicationCommentResponse, error)
+	GetApplicationComments(context.Context, *GetApplicationCommentsRequest) (*GetApplicationCommentsResponse, error)
+	UpdateApplicationComment(context.Context, *UpdateApplicationCommentRequest) (*emptypb.Empty, error)
+	DeleteApplicationComment(context.Context, *DeleteApplicationCommentRequest) (*emptypb.Empty, error)
+	GetApplicationCommentHistory(context.Context, *GetApplicationCommentHistoryRequest) (*GetApplicationCommentHistoryResponse, error)
+	CreateApplicationCategory(context.Context, *CreateApplicationCategoryRequest) (*CreateApplicationCategoryResponse, error)
+	GetApplicationCategories(context.Context, *GetApplicationCategoriesRequest) (*GetApplicationCategoriesResponse, error)
+	UpdateApplicationCategory(context.Context, *UpdateApplicationCategoryRequest) (*emptypb.Empty, error)
+	DeleteApplicationCategory(context.Context, *DeleteApplicationCategoryRequest) (*emptypb.Empty, error)
+	GetCompanyCustomFields(context.Context, *GetCompanyCustomFieldsRequest) (*GetCompanyCustomFieldsResponse, error)
+	UpdateCompanyCustomField(context.Context, *UpdateCompanyCustomFieldRequest) (*emptypb.Empty, error)
+	DeleteCompanyCustomField(context.Context, *DeleteCompanyCustomFieldRequest) (*emptypb.Empty, error)
+	GetEmployeeOpenApplications(context.Context, *GetEmployeeOpenApplicationsRequest) (*GetEmployeeOpenApplicationsResponse, error)
+	OffboardEmployeeApplications(context.Context, *OffboardEmployeeApplicationsRequest) (*OffboardEmployeeApplicationsResponse, error)
+	ArchiveCompanyApplications(context.Context, *ArchiveCompanyApplicationsRequest) (*ArchiveCompanyApplicationsResponse, error)
+	mustEmbedUnimplementedApplicationServiceServer()
+}
+
+// UnimplementedApplicationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedApplicationServiceServer struct{}
+
+func (UnimplementedApplicationServiceServer) Health(context.Context, *emptypb.Empty) (*HealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
+func (UnimplementedApplicationServiceServer) CreateApplication(context.Context, *CreateApplicationRequest) (*CreateApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApplication not implemented")
+}
+func (UnimplementedApplicationServiceServer) GetApplication(context.Context, *GetApplicationRequest) (*GetApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplication not implemented")
+}
+func (UnimplementedApplicationServiceServer) GetApplications(context.Context, *GetApplicationsRequest) (*GetApplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplications not implemented")
+}
+func (UnimplementedApplicationServiceServer) UpdateApplicationStatus(context.Context, *UpdateApplicationStatusRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateApplicationStatus not implemented")
+}
+func (UnimplementedApplicationServiceServer) AssignApplication(context.Context, *AssignApplicationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignApplication not implemented")
+}
+func (UnimplementedApplicationServiceServer) RedirectApplication(context.Context, *RedirectApplicationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedirectApplication not implemented")
+}
+func (UnimplementedApplicationServiceServer) RecallApplication(context.Context, *RecallApplicationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecallApplication not implemented")
+}
+func (UnimplementedApplicationServiceServer) TakeApplicationToVerification(context.Context, *TakeApplicationToVerificationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TakeApplicationToVerification not implemented")
+}
+func (UnimplementedApplicationServiceServer) ReleaseApplicationVerification(context.Context, *ReleaseApplicationVerificationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseApplicationVerification not implemented")
+}
+func (UnimplementedApplicationServiceServer) AddApplicationFixLog(context.Context, *AddApplicationFixLogRequest) (*AddApplicationFixLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddApplicationFixLog not implemented")
+}
+func (UnimplementedApplicationServiceServer) DeleteApplication(context.Context, *DeleteApplicationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteApplication not implemented")
+}
+func (UnimplementedApplicationServiceServer) GetApplicationHistory(context.Context, *GetApplicationHistoryRequest) (*GetApplicationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplicationHistory not implemented")
+}
+func (UnimplementedApplicationServiceServer) UploadApplicationAttachment(context.Context, *UploadApplicationAttachmentRequest) (*UploadApplicationAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadApplicationAttachment not implemented")
+}
+func (UnimplementedApplicationServiceServer) GetApplicationAttachment(context.Context, *GetApplicationAttachmentRequest) (*GetApplicationAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplicationAttachment not implemented")
+}
+func (UnimplementedApplicationServiceServer) DeleteApplicationAttachment(context.Context, *DeleteApplicationAttachmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteApplicationAttachment not implemented")
+}
+func (UnimplementedApplicationServiceServer) WatchApplications(*WatchApplicationsRequest, grpc.ServerStreamingServer[ApplicationUpdate]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchApplications not implemented")
+}
+func (UnimplementedApplicationServiceServer) GetApplicationActions(context.Context, *GetApplicationActionsRequest) (*GetApplicationActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplicationActions not implemented")
+}
+func (UnimplementedApplicationServiceServer) GetCompanyWorkflow(context.Context, *GetCompanyWorkflowRequest) (*GetCompanyWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompanyWorkflow not implemented")
+}
+func (UnimplementedApplicationServiceServer) UpdateCompanyWorkflow(context.Context, *UpdateCompanyWorkflowRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCompanyWorkflow not implemented")
+}
+func (UnimplementedApplicationServiceServer) ResetCompanyWorkflow(context.Context, *ResetCompanyWorkflowRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCompanyWorkflow not implemented")
+}
+func (UnimplementedApplicationServiceServer) GetCompanySLAPolicies(context.Context, *GetCompanySLAPoliciesRequest) (*GetCompanySLAPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompanySLAPolicies not implemented")
+}
+func (UnimplementedApplicationServiceServer) UpdateCompanySLAPolicy(context.Context, *UpdateCompanySLAPolicyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCompanySLAPolicy not implemented")
+}
+func (UnimplementedApplicationServiceServer) DeleteCompanySLAPolicy(context.Context, *DeleteCompanySLAPolicyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCompanySLAPolicy not implemented")
+}
+func (UnimplementedApplicationServiceServer) GetApplicationStatusStats(context.Context, *GetApplicationStatusStatsRequest) (*GetApplicationStatusStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplicationStatusStats not implemented")
+}
+func (UnimplementedApplicationServiceServer) GetApplicationLeadTimes(context.Context, *GetApplicationLeadTimesRequest) (*GetApplicationLeadTimesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplicationLeadTimes not implemented")
+}
+func (UnimplementedApplicationServiceServer) GetEngineerRevisionStats(context.Context, *GetEngineerRevisionStatsRequest) (*GetEngineerRevisionStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEngineerRevisionStats not implemented")
+}
+func (UnimplementedApplicationServiceServer) GetDepartmentFailureStats(context.Context, *GetDepartmentFailureStatsRequest) (*GetDepartmentFailureStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepartmentFailureStats not implemented")
+}
+func (UnimplementedApplicationServiceServer) ExportApplications(*ExportApplicationsRequest, grpc.ServerStreamingServer[ExportedApplication]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportApplications not implemented")
+}
+func (UnimplementedApplicationServiceServer) CreateApplicationComment(context.Context, *CreateApplicationCommentRequest) (*CreateApplicationCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApplicationComment not implemented")
+}
+func (UnimplementedApplicationServiceServer) GetApplicationComments(context.Context, *GetApplicationCommentsRequest) (*GetApplicationCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplicationComments not implemented")
+}
+func (UnimplementedApplicationServiceServer) UpdateApplicationComment(context.Context, *UpdateApplicationCommentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateApplicationComment not implemented")
+}
+func (UnimplementedApplicationServiceServer) DeleteApplicationComment(context.Context, *DeleteApplicationCommentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteApplicationComment not implemented")
+}
+func (UnimplementedApplicationServiceServer) GetApplicationCommentHistory(context.Context, *GetApplicationCommentHistoryRequest) (*GetApplicationCommentHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplicationCommentHistory not implemented")
+}
+func (UnimplementedApplicationServiceServer) CreateApplicationCategory(context.Context, *CreateApplicationCategoryRequest) (*CreateApplicationCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApplicationCategory not implemented")
+}
+func (UnimplementedApplicationServiceServer) GetApplicationCategories(context.Context, *GetApplicationCategoriesRequest) (*GetApplicationCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplicationCategories not implemented")
+}
+func (UnimplementedApplicationServiceServer) UpdateApplicationCategory(context.Context, *UpdateApplicationCategoryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateApplicationCategory not implemented")
+}
+func (UnimplementedApplicationServiceServer) DeleteApplicationCategory(context.Context, *DeleteApplicationCategoryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteApplicationCategory not implemented")
+}
+func (UnimplementedApplicationServiceServer) GetCompanyCustomFields(context.Context, *GetCompanyCustomFieldsRequest) (*GetCompanyCustomFieldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompanyCustomFields not implemented")
+}
+func (UnimplementedApplicationServiceServer) UpdateCompanyCustomField(context.Context, *UpdateCompanyCustomFieldRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCompanyCustomField not implemented")
+}
+func (UnimplementedApplicationServiceServer) DeleteCompanyCustomField(context.Context, *DeleteCompanyCustomFieldRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCompanyCustomField not implemented")
+}
+func (UnimplementedApplicationServiceServer) GetEmployeeOpenApplications(context.Context, *GetEmployeeOpenApplicationsRequest) (*GetEmployeeOpenApplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmployeeOpenApplications not implemented")
+}
+func (UnimplementedApplicationServiceServer) OffboardEmployeeApplications(context.Context, *OffboardEmployeeApplicationsRequest) (*OffboardEmployeeApplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OffboardEmployeeApplications not implemented")
+}
+func (UnimplementedApplicationServiceServer) ArchiveCompanyApplications(context.Context, *ArchiveCompanyApplicationsRequest) (*ArchiveCompanyApplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveCompanyApplications not implemented")
+}
+func (UnimplementedApplicationServiceServer) mustEmbedUnimplementedApplicationServiceServer() {}
+func (UnimplementedApplicationServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeApplicationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ApplicationServiceServer will
+// result in compilation errors.
+type UnsafeApplicationServiceServer interface {
+	mustEmbedUnimplementedApplicationServiceServer()
+}
+
+func RegisterApplicationServiceServer(s grpc.ServiceRegistrar, srv ApplicationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedApplicationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ApplicationService_ServiceDesc, srv)
+}
+
+func _ApplicationService_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_Health_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).Health(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_CreateApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).CreateApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_CreateApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).CreateApplication(ctx, req.(*CreateApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_GetApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_GetApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetApplication(ctx, req.(*GetApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_GetApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_GetApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetApplications(ctx, req.(*GetApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_UpdateApplicationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateApplicationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).UpdateApplicationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_UpdateApplicationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).UpdateApplicationStatus(ctx, req.(*UpdateApplicationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_AssignApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).AssignApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_AssignApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).AssignApplication(ctx, req.(*AssignApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_RedirectApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedirectApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).RedirectApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_RedirectApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).RedirectApplication(ctx, req.(*RedirectApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_RecallApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecallApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).RecallApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_RecallApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).RecallApplication(ctx, req.(*RecallApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_TakeApplicationToVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TakeApplicationToVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).TakeApplicationToVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_TakeApplicationToVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).TakeApplicationToVerification(ctx, req.(*TakeApplicationToVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_ReleaseApplicationVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseApplicationVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ReleaseApplicationVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_ReleaseApplicationVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ReleaseApplicationVerification(ctx, req.(*ReleaseApplicationVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_AddApplicationFixLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddApplicationFixLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).AddApplicationFixLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_AddApplicationFixLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).AddApplicationFixLog(ctx, req.(*AddApplicationFixLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_DeleteApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).DeleteApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_DeleteApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).DeleteApplication(ctx, req.(*DeleteApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_GetApplicationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetApplicationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_GetApplicationHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetApplicationHistory(ctx, req.(*GetApplicationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_UploadApplicationAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadApplicationAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).UploadApplicationAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_UploadApplicationAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).UploadApplicationAttachment(ctx, req.(*UploadApplicationAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_GetApplicationAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetApplicationAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_GetApplicationAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetApplicationAttachment(ctx, req.(*GetApplicationAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_DeleteApplicationAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApplicationAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).DeleteApplicationAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_DeleteApplicationAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).DeleteApplicationAttachment(ctx, req.(*DeleteApplicationAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_WatchApplications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchApplicationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApplicationServiceServer).WatchApplications(m, &grpc.GenericServerStream[WatchApplicationsRequest, ApplicationUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ApplicationService_WatchApplicationsServer = grpc.ServerStreamingServer[ApplicationUpdate]
+
+func _ApplicationService_GetApplicationActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetApplicationActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_GetApplicationActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetApplicationActions(ctx, req.(*GetApplicationActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_GetCompanyWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanyWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetCompanyWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_GetCompanyWorkflow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetCompanyWorkflow(ctx, req.(*GetCompanyWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_UpdateCompanyWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCompanyWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).UpdateCompanyWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_UpdateCompanyWorkflow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).UpdateCompanyWorkflow(ctx, req.(*UpdateCompanyWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_ResetCompanyWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetCompanyWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ResetCompanyWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_ResetCompanyWorkflow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ResetCompanyWorkflow(ctx, req.(*ResetCompanyWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_GetCompanySLAPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanySLAPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetCompanySLAPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_GetCompanySLAPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetCompanySLAPolicies(ctx, req.(*GetCompanySLAPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_UpdateCompanySLAPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCompanySLAPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).UpdateCompanySLAPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_UpdateCompanySLAPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).UpdateCompanySLAPolicy(ctx, req.(*UpdateCompanySLAPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_DeleteCompanySLAPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCompanySLAPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).DeleteCompanySLAPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_DeleteCompanySLAPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).DeleteCompanySLAPolicy(ctx, req.(*DeleteCompanySLAPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_GetApplicationStatusStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationStatusStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetApplicationStatusStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_GetApplicationStatusStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetApplicationStatusStats(ctx, req.(*GetApplicationStatusStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_GetApplicationLeadTimes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationLeadTimesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetApplicationLeadTimes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_GetApplicationLeadTimes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetApplicationLeadTimes(ctx, req.(*GetApplicationLeadTimesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *applicationServiceClient) GetApplicationHistory(ctx context.Context, in *GetApplicationHistoryRequest, opts ...grpc.CallOption) (*GetApplicationHistoryResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetApplicationHistoryResponse)
-	err := c.cc.Invoke(ctx, ApplicationService_GetApplicationHistory_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ApplicationService_GetEngineerRevisionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEngineerRevisionStatsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetEngineerRevisionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_GetEngineerRevisionStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetEngineerRevisionStats(ctx, req.(*GetEngineerRevisionStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// ApplicationServiceServer is the server API for ApplicationService service.
-// All implementations must embed UnimplementedApplicationServiceServer
-// for forward compatibility.
-type ApplicationServiceServer interface {
-	Health(context.Context, *emptypb.Empty) (*HealthResponse, error)
-	CreateApplication(context.Context, *CreateApplicationRequest) (*CreateApplicationResponse, error)
-	GetApplication(context.Context, *GetApplicationRequest) (*GetApplicationResponse, error)
-	GetApplications(context.Context, *GetApplicationsRequest) (*GetApplicationsResponse, error)
-	UpdateApplicationStatus(context.Context, *UpdateApplicationStatusRequest) (*emptypb.Empty, error)
-	AssignApplication(context.Context, *AssignApplicationRequest) (*emptypb.Empty, error)
-	RedirectApplication(context.Context, *RedirectApplicationRequest) (*emptypb.Empty, error)
-	RecallApplication(context.Context, *RecallApplicationRequest) (*emptypb.Empty, error)
-	TakeApplicationToVerification(context.Context, *TakeApplicationToVerificationRequest) (*emptypb.Empty, error)
-	ReleaseApplicationVerification(context.Context, *ReleaseApplicationVerificationRequest) (*emptypb.Empty, error)
-	AddApplicationFixLog(context.Context, *AddApplicationFixLogRequest) (*emptypb.Empty, error)
-	DeleteApplication(context.Context, *DeleteApplicationRequest) (*emptypb.Empty, error)
-	GetApplicationHistory(context.Context, *GetApplicationHistoryRequest) (*GetApplicationHistoryResponse, error)
-	mustEmbedUnimplementedApplicationServiceServer()
+func _ApplicationService_GetDepartmentFailureStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDepartmentFailureStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetDepartmentFailureStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_GetDepartmentFailureStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetDepartmentFailureStats(ctx, req.(*GetDepartmentFailureStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedApplicationServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedApplicationServiceServer struct{}
-
-func (UnimplementedApplicationServiceServer) Health(context.Context, *emptypb.Empty) (*HealthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
-}
-func (UnimplementedApplicationServiceServer) CreateApplication(context.Context, *CreateApplicationRequest) (*CreateApplicationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateApplication not implemented")
-}
-func (UnimplementedApplicationServiceServer) GetApplication(context.Context, *GetApplicationRequest) (*GetApplicationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetApplication not implemented")
-}
-func (UnimplementedApplicationServiceServer) GetApplications(context.Context, *GetApplicationsRequest) (*GetApplicationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetApplications not implemented")
-}
-func (UnimplementedApplicationServiceServer) UpdateApplicationStatus(context.Context, *UpdateApplicationStatusRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateApplicationStatus not implemented")
-}
-func (UnimplementedApplicationServiceServer) AssignApplication(context.Context, *AssignApplicationRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssignApplication not implemented")
-}
-func (UnimplementedApplicationServiceServer) RedirectApplication(context.Context, *RedirectApplicationRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RedirectApplication not implemented")
-}
-func (UnimplementedApplicationServiceServer) RecallApplication(context.Context, *RecallApplicationRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecallApplication not implemented")
-}
-func (UnimplementedApplicationServiceServer) TakeApplicationToVerification(context.Context, *TakeApplicationToVerificationRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TakeApplicationToVerification not implemented")
-}
-func (UnimplementedApplicationServiceServer) ReleaseApplicationVerification(context.Context, *ReleaseApplicationVerificationRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReleaseApplicationVerification not implemented")
-}
-func (UnimplementedApplicationServiceServer) AddApplicationFixLog(context.Context, *AddApplicationFixLogRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddApplicationFixLog not implemented")
-}
-func (UnimplementedApplicationServiceServer) DeleteApplication(context.Context, *DeleteApplicationRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteApplication not implemented")
-}
-func (UnimplementedApplicationServiceServer) GetApplicationHistory(context.Context, *GetApplicationHistoryRequest) (*GetApplicationHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetApplicationHistory not implemented")
+func _ApplicationService_ExportApplications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportApplicationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApplicationServiceServer).ExportApplications(m, &grpc.GenericServerStream[ExportApplicationsRequest, ExportedApplication]{ServerStream: stream})
 }
-func (UnimplementedApplicationServiceServer) mustEmbedUnimplementedApplicationServiceServer() {}
-func (UnimplementedApplicationServiceServer) testEmbeddedByValue()                            {}
 
-// UnsafeApplicationServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ApplicationServiceServer will
-// result in compilation errors.
-type UnsafeApplicationServiceServer interface {
-	mustEmbedUnimplementedApplicationServiceServer()
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ApplicationService_ExportApplicationsServer = grpc.ServerStreamingServer[ExportedApplication]
+
+func _ApplicationService_CreateApplicationComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApplicationCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).CreateApplicationComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_CreateApplicationComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).CreateApplicationComment(ctx, req.(*CreateApplicationCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterApplicationServiceServer(s grpc.ServiceRegistrar, srv ApplicationServiceServer) {
-	// If the following call pancis, it indicates UnimplementedApplicationServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ApplicationService_GetApplicationComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ApplicationService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetApplicationComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_GetApplicationComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetApplicationComments(ctx, req.(*GetApplicationCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ApplicationService_UpdateApplicationComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateApplicationCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).Health(ctx, in)
+		return srv.(ApplicationServiceServer).UpdateApplicationComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ApplicationService_Health_FullMethodName,
+		FullMethod: ApplicationService_UpdateApplicationComment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).Health(ctx, req.(*emptypb.Empty))
+		return srv.(ApplicationServiceServer).UpdateApplicationComment(ctx, req.(*UpdateApplicationCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_CreateApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateApplicationRequest)
+func _ApplicationService_DeleteApplicationComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApplicationCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).CreateApplication(ctx, in)
+		return srv.(ApplicationServiceServer).DeleteApplicationComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ApplicationService_CreateApplication_FullMethodName,
+		FullMethod: ApplicationService_DeleteApplicationComment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).CreateApplication(ctx, req.(*CreateApplicationRequest))
+		return srv.(ApplicationServiceServer).DeleteApplicationComment(ctx, req.(*DeleteApplicationCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_GetApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetApplicationRequest)
+func _ApplicationService_GetApplicationCommentHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationCommentHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).GetApplication(ctx, in)
+		return srv.(ApplicationServiceServer).GetApplicationCommentHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ApplicationService_GetApplication_FullMethodName,
+		FullMethod: ApplicationService_GetApplicationCommentHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).GetApplication(ctx, req.(*GetApplicationRequest))
+		return srv.(ApplicationServiceServer).GetApplicationCommentHistory(ctx, req.(*GetApplicationCommentHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_GetApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetApplicationsRequest)
+func _ApplicationService_CreateApplicationCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApplicationCategoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).GetApplications(ctx, in)
+		return srv.(ApplicationServiceServer).CreateApplicationCategory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ApplicationService_GetApplications_FullMethodName,
+		FullMethod: ApplicationService_CreateApplicationCategory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).GetApplications(ctx, req.(*GetApplicationsRequest))
+		return srv.(ApplicationServiceServer).CreateApplicationCategory(ctx, req.(*CreateApplicationCategoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_UpdateApplicationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateApplicationStatusRequest)
+func _ApplicationService_GetApplicationCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationCategoriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).UpdateApplicationStatus(ctx, in)
+		return srv.(ApplicationServiceServer).GetApplicationCategories(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ApplicationService_UpdateApplicationStatus_FullMethodName,
+		FullMethod: ApplicationService_GetApplicationCategories_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).UpdateApplicationStatus(ctx, req.(*UpdateApplicationStatusRequest))
+		return srv.(ApplicationServiceServer).GetApplicationCategories(ctx, req.(*GetApplicationCategoriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_AssignApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignApplicationRequest)
+func _ApplicationService_UpdateApplicationCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateApplicationCategoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).AssignApplication(ctx, in)
+		return srv.(ApplicationServiceServer).UpdateApplicationCategory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ApplicationService_AssignApplication_FullMethodName,
+		FullMethod: ApplicationService_UpdateApplicationCategory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).AssignApplication(ctx, req.(*AssignApplicationRequest))
+		return srv.(ApplicationServiceServer).UpdateApplicationCategory(ctx, req.(*UpdateApplicationCategoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_RedirectApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RedirectApplicationRequest)
+func _ApplicationService_DeleteApplicationCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApplicationCategoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).RedirectApplication(ctx, in)
+		return srv.(ApplicationServiceServer).DeleteApplicationCategory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ApplicationService_RedirectApplication_FullMethodName,
+		FullMethod: ApplicationService_DeleteApplicationCategory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).RedirectApplication(ctx, req.(*RedirectApplicationRequest))
+		return srv.(ApplicationServiceServer).DeleteApplicationCategory(ctx, req.(*DeleteApplicationCategoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_RecallApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecallApplicationRequest)
+func _ApplicationService_GetCompanyCustomFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanyCustomFieldsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).RecallApplication(ctx, in)
+		return srv.(ApplicationServiceServer).GetCompanyCustomFields(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ApplicationService_RecallApplication_FullMethodName,
+		FullMethod: ApplicationService_GetCompanyCustomFields_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).RecallApplication(ctx, req.(*RecallApplicationRequest))
+		return srv.(ApplicationServiceServer).GetCompanyCustomFields(ctx, req.(*GetCompanyCustomFieldsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_TakeApplicationToVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TakeApplicationToVerificationRequest)
+func _ApplicationService_UpdateCompanyCustomField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCompanyCustomFieldRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).TakeApplicationToVerification(ctx, in)
+		return srv.(ApplicationServiceServer).UpdateCompanyCustomField(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ApplicationService_TakeApplicationToVerification_FullMethodName,
+		FullMethod: ApplicationService_UpdateCompanyCustomField_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).TakeApplicationToVerification(ctx, req.(*TakeApplicationToVerificationRequest))
+		return srv.(ApplicationServiceServer).UpdateCompanyCustomField(ctx, req.(*UpdateCompanyCustomFieldRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_ReleaseApplicationVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReleaseApplicationVerificationRequest)
+func _ApplicationService_DeleteCompanyCustomField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCompanyCustomFieldRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).ReleaseApplicationVerification(ctx, in)
+		return srv.(ApplicationServiceServer).DeleteCompanyCustomField(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ApplicationService_ReleaseApplicationVerification_FullMethodName,
+		FullMethod: ApplicationService_DeleteCompanyCustomField_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).ReleaseApplicationVerification(ctx, req.(*ReleaseApplicationVerificationRequest))
+		return srv.(ApplicationServiceServer).DeleteCompanyCustomField(ctx, req.(*DeleteCompanyCustomFieldRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_AddApplicationFixLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddApplicationFixLogRequest)
+func _ApplicationService_GetEmployeeOpenApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmployeeOpenApplicationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).AddApplicationFixLog(ctx, in)
+		return srv.(ApplicationServiceServer).GetEmployeeOpenApplications(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ApplicationService_AddApplicationFixLog_FullMethodName,
+		FullMethod: ApplicationService_GetEmployeeOpenApplications_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).AddApplicationFixLog(ctx, req.(*AddApplicationFixLogRequest))
+		return srv.(ApplicationServiceServer).GetEmployeeOpenApplications(ctx, req.(*GetEmployeeOpenApplicationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_DeleteApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteApplicationRequest)
+func _ApplicationService_OffboardEmployeeApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OffboardEmployeeApplicationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).DeleteApplication(ctx, in)
+		return srv.(ApplicationServiceServer).OffboardEmployeeApplications(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ApplicationService_DeleteApplication_FullMethodName,
+		FullMethod: ApplicationService_OffboardEmployeeApplications_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).DeleteApplication(ctx, req.(*DeleteApplicationRequest))
+		return srv.(ApplicationServiceServer).OffboardEmployeeApplications(ctx, req.(*OffboardEmployeeApplicationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_GetApplicationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetApplicationHistoryRequest)
+func _ApplicationService_ArchiveCompanyApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveCompanyApplicationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).GetApplicationHistory(ctx, in)
+		return srv.(ApplicationServiceServer).ArchiveCompanyApplications(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ApplicationService_GetApplicationHistory_FullMethodName,
+		FullMethod: ApplicationService_ArchiveCompanyApplications_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).GetApplicationHistory(ctx, req.(*GetApplicationHistoryRequest))
+		return srv.(ApplicationServiceServer).ArchiveCompanyApplications(ctx, req.(*ArchiveCompanyApplicationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -572,7 +1630,134 @@ var ApplicationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetApplicationHistory",
 			Handler:    _ApplicationService_GetApplicationHistory_Handler,
 		},
+		{
+			MethodName: "UploadApplicationAttachment",
+			Handler:    _ApplicationService_UploadApplicationAttachment_Handler,
+		},
+		{
+			MethodName: "GetApplicationAttachment",
+			Handler:    _ApplicationService_GetApplicationAttachment_Handler,
+		},
+		{
+			MethodName: "DeleteApplicationAttachment",
+			Handler:    _ApplicationService_DeleteApplicationAttachment_Handler,
+		},
+		{
+			MethodName: "GetApplicationActions",
+			Handler:    _ApplicationService_GetApplicationActions_Handler,
+		},
+		{
+			MethodName: "GetCompanyWorkflow",
+			Handler:    _ApplicationService_GetCompanyWorkflow_Handler,
+		},
+		{
+			MethodName: "UpdateCompanyWorkflow",
+			Handler:    _ApplicationService_UpdateCompanyWorkflow_Handler,
+		},
+		{
+			MethodName: "ResetCompanyWorkflow",
+			Handler:    _ApplicationService_ResetCompanyWorkflow_Handler,
+		},
+		{
+			MethodName: "GetCompanySLAPolicies",
+			Handler:    _ApplicationService_GetCompanySLAPolicies_Handler,
+		},
+		{
+			MethodName: "UpdateCompanySLAPolicy",
+			Handler:    _ApplicationService_UpdateCompanySLAPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteCompanySLAPolicy",
+			Handler:    _ApplicationService_DeleteCompanySLAPolicy_Handler,
+		},
+		{
+			MethodName: "GetApplicationStatusStats",
+			Handler:    _ApplicationService_GetApplicationStatusStats_Handler,
+		},
+		{
+			MethodName: "GetApplicationLeadTimes",
+			Handler:    _ApplicationService_GetApplicationLeadTimes_Handler,
+		},
+		{
+			MethodName: "GetEngineerRevisionStats",
+			Handler:    _ApplicationService_GetEngineerRevisionStats_Handler,
+		},
+		{
+			MethodName: "GetDepartmentFailureStats",
+			Handler:    _ApplicationService_GetDepartmentFailureStats_Handler,
+		},
+		{
+			MethodName: "CreateApplicationComment",
+			Handler:    _ApplicationService_CreateApplicationComment_Handler,
+		},
+		{
+			MethodName: "GetApplicationComments",
+			Handler:    _ApplicationService_GetApplicationComments_Handler,
+		},
+		{
+			MethodName: "UpdateApplicationComment",
+			Handler:    _ApplicationService_UpdateApplicationComment_Handler,
+		},
+		{
+			MethodName: "DeleteApplicationComment",
+			Handler:    _ApplicationService_DeleteApplicationComment_Handler,
+		},
+		{
+			MethodName: "GetApplicationCommentHistory",
+			Handler:    _ApplicationService_GetApplicationCommentHistory_Handler,
+		},
+		{
+			MethodName: "CreateApplicationCategory",
+			Handler:    _ApplicationService_CreateApplicationCategory_Handler,
+		},
+		{
+			MethodName: "GetApplicationCategories",
+			Handler:    _ApplicationService_GetApplicationCategories_Handler,
+		},
+		{
+			MethodName: "UpdateApplicationCategory",
+			Handler:    _ApplicationService_UpdateApplicationCategory_Handler,
+		},
+		{
+			MethodName: "DeleteApplicationCategory",
+			Handler:    _ApplicationService_DeleteApplicationCategory_Handler,
+		},
+		{
+			MethodName: "GetCompanyCustomFields",
+			Handler:    _ApplicationService_GetCompanyCustomFields_Handler,
+		},
+		{
+			MethodName: "UpdateCompanyCustomField",
+			Handler:    _ApplicationService_UpdateCompanyCustomField_Handler,
+		},
+		{
+			MethodName: "DeleteCompanyCustomField",
+			Handler:    _ApplicationService_DeleteCompanyCustomField_Handler,
+		},
+		{
+			MethodName: "GetEmployeeOpenApplications",
+			Handler:    _ApplicationService_GetEmployeeOpenApplications_Handler,
+		},
+		{
+			MethodName: "OffboardEmployeeApplications",
+			Handler:    _ApplicationService_OffboardEmployeeApplications_Handler,
+		},
+		{
+			MethodName: "ArchiveCompanyApplications",
+			Handler:    _ApplicationService_ArchiveCompanyApplications_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchApplications",
+			Handler:       _ApplicationService_WatchApplications_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportApplications",
+			Handler:       _ApplicationService_ExportApplications_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "application.proto",
 }
@@ -447,13 +447,14 @@ func (x *LoginRequest) GetSession() *SessionInfo {
 }
 
 type LoginResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserUuid      string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
-	AccessToken   string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	RefreshToken  string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	SessionUuid   string                 `protobuf:"bytes,4,opt,name=session_uuid,json=sessionUuid,proto3" json:"session_uuid,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid        string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	AccessToken     string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken    string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	SessionUuid     string                 `protobuf:"bytes,4,opt,name=session_uuid,json=sessionUuid,proto3" json:"session_uuid,omitempty"`
+	TwoFactorMethod string                 `protobuf:"bytes,5,opt,name=two_factor_method,json=twoFactorMethod,proto3" json:"two_factor_method,omitempty"` // email / totp, заполняется при включённой 2FA
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *LoginResponse) Reset() {
@@ -514,6 +515,13 @@ func (x *LoginResponse) GetSessionUuid() string {
 	return ""
 }
 
+func (x *LoginResponse) GetTwoFactorMethod() string {
+	if x != nil {
+		return x.TwoFactorMethod
+	}
+	return ""
+}
+
 // Get user
 type GetUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1595,6 +1603,7 @@ type Verify2FARequest struct {
 	SessionUuid   string                 `protobuf:"bytes,1,opt,name=session_uuid,json=sessionUuid,proto3" json:"session_uuid,omitempty"`
 	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 	Session       *SessionInfo           `protobuf:"bytes,3,opt,name=session,proto3" json:"session,omitempty"`
+	Method        string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"` // email / totp / recovery, по умолчанию - способ, выбранный пользователем
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1650,6 +1659,13 @@ func (x *Verify2FARequest) GetSession() *SessionInfo {
 	return nil
 }
 
+func (x *Verify2FARequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
 type Verify2FAResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserUuid      string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
@@ -1715,6 +1731,7 @@ type UpdateUser2FARequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserUuid      string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
 	Enable_2Fa    bool                   `protobuf:"varint,2,opt,name=enable_2fa,json=enable2fa,proto3" json:"enable_2fa,omitempty"`
+	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"` // email / totp, пустое значение - оставить текущий способ
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1763,6 +1780,252 @@ func (x *UpdateUser2FARequest) GetEnable_2Fa() bool {
 	return false
 }
 
+func (x *UpdateUser2FARequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+// EnrollTOTP
+type EnrollTOTPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid      string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTOTPRequest) Reset() {
+	*x = EnrollTOTPRequest{}
+	mi := &file_auth_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTOTPRequest) ProtoMessage() {}
+
+func (x *EnrollTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTOTPRequest.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *EnrollTOTPRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+type EnrollTOTPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	OtpauthUri    string                 `protobuf:"bytes,2,opt,name=otpauth_uri,json=otpauthUri,proto3" json:"otpauth_uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTOTPResponse) Reset() {
+	*x = EnrollTOTPResponse{}
+	mi := &file_auth_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTOTPResponse) ProtoMessage() {}
+
+func (x *EnrollTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTOTPResponse.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *EnrollTOTPResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollTOTPResponse) GetOtpauthUri() string {
+	if x != nil {
+		return x.OtpauthUri
+	}
+	return ""
+}
+
+// ConfirmTOTP
+type ConfirmTOTPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid      string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTOTPRequest) Reset() {
+	*x = ConfirmTOTPRequest{}
+	mi := &file_auth_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPRequest) ProtoMessage() {}
+
+func (x *ConfirmTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ConfirmTOTPRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *ConfirmTOTPRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmTOTPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTOTPResponse) Reset() {
+	*x = ConfirmTOTPResponse{}
+	mi := &file_auth_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPResponse) ProtoMessage() {}
+
+func (x *ConfirmTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ConfirmTOTPResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+// Send2FAEmailCode
+type Send2FAEmailCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionUuid   string                 `protobuf:"bytes,1,opt,name=session_uuid,json=sessionUuid,proto3" json:"session_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Send2FAEmailCodeRequest) Reset() {
+	*x = Send2FAEmailCodeRequest{}
+	mi := &file_auth_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Send2FAEmailCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Send2FAEmailCodeRequest) ProtoMessage() {}
+
+func (x *Send2FAEmailCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Send2FAEmailCodeRequest.ProtoReflect.Descriptor instead.
+func (*Send2FAEmailCodeRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Send2FAEmailCodeRequest) GetSessionUuid() string {
+	if x != nil {
+		return x.SessionUuid
+	}
+	return ""
+}
+
 // RestoreAccount
 type RestoreAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1774,7 +2037,7 @@ type RestoreAccountRequest struct {
 
 func (x *RestoreAccountRequest) Reset() {
 	*x = RestoreAccountRequest{}
-	mi := &file_auth_proto_msgTypes[30]
+	mi := &file_auth_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1786,7 +2049,7 @@ func (x *RestoreAccountRequest) String() string {
 func (*RestoreAccountRequest) ProtoMessage() {}
 
 func (x *RestoreAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[30]
+	mi := &file_auth_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1799,7 +2062,7 @@ func (x *RestoreAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestoreAccountRequest.ProtoReflect.Descriptor instead.
 func (*RestoreAccountRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{30}
+	return file_auth_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *RestoreAccountRequest) GetEmail() string {
@@ -1816,6 +2079,159 @@ func (x *RestoreAccountRequest) GetPassword() string {
 	return ""
 }
 
+// GetJWKS - публичные ключи подписи токенов (RFC 7517)
+type JWK struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kid           string                 `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	Kty           string                 `protobuf:"bytes,2,opt,name=kty,proto3" json:"kty,omitempty"`
+	Crv           string                 `protobuf:"bytes,3,opt,name=crv,proto3" json:"crv,omitempty"`
+	X             string                 `protobuf:"bytes,4,opt,name=x,proto3" json:"x,omitempty"`
+	Y             string                 `protobuf:"bytes,5,opt,name=y,proto3" json:"y,omitempty"`
+	Alg           string                 `protobuf:"bytes,6,opt,name=alg,proto3" json:"alg,omitempty"`
+	Use           string                 `protobuf:"bytes,7,opt,name=use,proto3" json:"use,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`                         // active | next | retired
+	RetiredAt     int64                  `protobuf:"varint,9,opt,name=retired_at,json=retiredAt,proto3" json:"retired_at,omitempty"` // unix время вывода ключа, только для retired
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JWK) Reset() {
+	*x = JWK{}
+	mi := &file_auth_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JWK) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JWK) ProtoMessage() {}
+
+func (x *JWK) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JWK.ProtoReflect.Descriptor instead.
+func (*JWK) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *JWK) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *JWK) GetKty() string {
+	if x != nil {
+		return x.Kty
+	}
+	return ""
+}
+
+func (x *JWK) GetCrv() string {
+	if x != nil {
+		return x.Crv
+	}
+	return ""
+}
+
+func (x *JWK) GetX() string {
+	if x != nil {
+		return x.X
+	}
+	return ""
+}
+
+func (x *JWK) GetY() string {
+	if x != nil {
+		return x.Y
+	}
+	return ""
+}
+
+func (x *JWK) GetAlg() string {
+	if x != nil {
+		return x.Alg
+	}
+	return ""
+}
+
+func (x *JWK) GetUse() string {
+	if x != nil {
+		return x.Use
+	}
+	return ""
+}
+
+func (x *JWK) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JWK) GetRetiredAt() int64 {
+	if x != nil {
+		return x.RetiredAt
+	}
+	return 0
+}
+
+type GetJWKSResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*JWK                 `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJWKSResponse) Reset() {
+	*x = GetJWKSResponse{}
+	mi := &file_auth_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJWKSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJWKSResponse) ProtoMessage() {}
+
+func (x *GetJWKSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJWKSResponse.ProtoReflect.Descriptor instead.
+func (*GetJWKSResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetJWKSResponse) GetKeys() []*JWK {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
 var File_auth_proto protoreflect.FileDescriptor
 
 const file_auth_proto_rawDesc = "" +
@@ -1863,12 +2279,13 @@ const file_auth_proto_rawDesc = "" +
 	"\fLoginRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12+\n" +
-	"\asession\x18\x03 \x01(\v2\x11.auth.SessionInfoR\asession\"\x97\x01\n" +
+	"\asession\x18\x03 \x01(\v2\x11.auth.SessionInfoR\asession\"\xc3\x01\n" +
 	"\rLoginResponse\x12\x1b\n" +
 	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\x12!\n" +
 	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12#\n" +
 	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x12!\n" +
-	"\fsession_uuid\x18\x04 \x01(\tR\vsessionUuid\"-\n" +
+	"\fsession_uuid\x18\x04 \x01(\tR\vsessionUuid\x12*\n" +
+	"\x11two_factor_method\x18\x05 \x01(\tR\x0ftwoFactorMethod\"-\n" +
 	"\x0eGetUserRequest\x12\x1b\n" +
 	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\"\x80\x02\n" +
 	"\x0fGetUserResponse\x12\x1b\n" +
@@ -1936,22 +2353,50 @@ const file_auth_proto_rawDesc = "" +
 	"\x14ResetPasswordRequest\x12\x1f\n" +
 	"\vreset_token\x18\x01 \x01(\tR\n" +
 	"resetToken\x12!\n" +
-	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"v\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"\x8e\x01\n" +
 	"\x10Verify2FARequest\x12!\n" +
 	"\fsession_uuid\x18\x01 \x01(\tR\vsessionUuid\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\tR\x04code\x12+\n" +
-	"\asession\x18\x03 \x01(\v2\x11.auth.SessionInfoR\asession\"x\n" +
+	"\asession\x18\x03 \x01(\v2\x11.auth.SessionInfoR\asession\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\"x\n" +
 	"\x11Verify2FAResponse\x12\x1b\n" +
 	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\x12!\n" +
 	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\"R\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\"j\n" +
 	"\x14UpdateUser2FARequest\x12\x1b\n" +
 	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\x12\x1d\n" +
 	"\n" +
-	"enable_2fa\x18\x02 \x01(\bR\tenable2fa\"I\n" +
+	"enable_2fa\x18\x02 \x01(\bR\tenable2fa\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\"0\n" +
+	"\x11EnrollTOTPRequest\x12\x1b\n" +
+	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\"M\n" +
+	"\x12EnrollTOTPResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x1f\n" +
+	"\votpauth_uri\x18\x02 \x01(\tR\n" +
+	"otpauthUri\"E\n" +
+	"\x12ConfirmTOTPRequest\x12\x1b\n" +
+	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"<\n" +
+	"\x13ConfirmTOTPResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\"<\n" +
+	"\x17Send2FAEmailCodeRequest\x12!\n" +
+	"\fsession_uuid\x18\x01 \x01(\tR\vsessionUuid\"I\n" +
 	"\x15RestoreAccountRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword2\xe1\v\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xb2\x01\n" +
+	"\x03JWK\x12\x10\n" +
+	"\x03kid\x18\x01 \x01(\tR\x03kid\x12\x10\n" +
+	"\x03kty\x18\x02 \x01(\tR\x03kty\x12\x10\n" +
+	"\x03crv\x18\x03 \x01(\tR\x03crv\x12\f\n" +
+	"\x01x\x18\x04 \x01(\tR\x01x\x12\f\n" +
+	"\x01y\x18\x05 \x01(\tR\x01y\x12\x10\n" +
+	"\x03alg\x18\x06 \x01(\tR\x03alg\x12\x10\n" +
+	"\x03use\x18\a \x01(\tR\x03use\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"retired_at\x18\t \x01(\x03R\tretiredAt\"0\n" +
+	"\x0fGetJWKSResponse\x12\x1d\n" +
+	"\x04keys\x18\x01 \x03(\v2\t.auth.JWKR\x04keys2\xeb\r\n" +
 	"\vAuthService\x126\n" +
 	"\x06Health\x12\x16.google.protobuf.Empty\x1a\x14.auth.HealthResponse\x129\n" +
 	"\bRegister\x12\x15.auth.RegisterRequest\x1a\x16.google.protobuf.Empty\x120\n" +
@@ -1974,8 +2419,13 @@ const file_auth_proto_rawDesc = "" +
 	"\x0eForgotPassword\x12\x1b.auth.ForgotPasswordRequest\x1a\x16.google.protobuf.Empty\x12C\n" +
 	"\rResetPassword\x12\x1a.auth.ResetPasswordRequest\x1a\x16.google.protobuf.Empty\x12<\n" +
 	"\tVerify2FA\x12\x16.auth.Verify2FARequest\x1a\x17.auth.Verify2FAResponse\x12C\n" +
-	"\rUpdateUser2FA\x12\x1a.auth.UpdateUser2FARequest\x1a\x16.google.protobuf.Empty\x12E\n" +
-	"\x0eRestoreAccount\x12\x1b.auth.RestoreAccountRequest\x1a\x16.google.protobuf.EmptyBQZOgithub.com/unwelcome/FrameWorkTask1/backend/contracts/auth/generated;auth_protob\x06proto3"
+	"\rUpdateUser2FA\x12\x1a.auth.UpdateUser2FARequest\x1a\x16.google.protobuf.Empty\x12?\n" +
+	"\n" +
+	"EnrollTOTP\x12\x17.auth.EnrollTOTPRequest\x1a\x18.auth.EnrollTOTPResponse\x12B\n" +
+	"\vConfirmTOTP\x12\x18.auth.ConfirmTOTPRequest\x1a\x19.auth.ConfirmTOTPResponse\x12I\n" +
+	"\x10Send2FAEmailCode\x12\x1d.auth.Send2FAEmailCodeRequest\x1a\x16.google.protobuf.Empty\x12E\n" +
+	"\x0eRestoreAccount\x12\x1b.auth.RestoreAccountRequest\x1a\x16.google.protobuf.Empty\x128\n" +
+	"\aGetJWKS\x12\x16.google.protobuf.Empty\x1a\x15.auth.GetJWKSResponseBQZOgithub.com/unwelcome/FrameWorkTask1/backend/contracts/auth/generated;auth_protob\x06proto3"
 
 var (
 	file_auth_proto_rawDescOnce sync.Once
@@ -1989,7 +2439,7 @@ func file_auth_proto_rawDescGZIP() []byte {
 	return file_auth_proto_rawDescData
 }
 
-var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_auth_proto_goTypes = []any{
 	(*Token)(nil),                         // 0: auth.Token
 	(*SessionInfo)(nil),                   // 1: auth.SessionInfo
@@ -2021,61 +2471,77 @@ var file_auth_proto_goTypes = []any{
 	(*Verify2FARequest)(nil),              // 27: auth.Verify2FARequest
 	(*Verify2FAResponse)(nil),             // 28: auth.Verify2FAResponse
 	(*UpdateUser2FARequest)(nil),          // 29: auth.UpdateUser2FARequest
-	(*RestoreAccountRequest)(nil),         // 30: auth.RestoreAccountRequest
-	(*emptypb.Empty)(nil),                 // 31: google.protobuf.Empty
+	(*EnrollTOTPRequest)(nil),             // 30: auth.EnrollTOTPRequest
+	(*EnrollTOTPResponse)(nil),            // 31: auth.EnrollTOTPResponse
+	(*ConfirmTOTPRequest)(nil),            // 32: auth.ConfirmTOTPRequest
+	(*ConfirmTOTPResponse)(nil),           // 33: auth.ConfirmTOTPResponse
+	(*Send2FAEmailCodeRequest)(nil),       // 34: auth.Send2FAEmailCodeRequest
+	(*RestoreAccountRequest)(nil),         // 35: auth.RestoreAccountRequest
+	(*JWK)(nil),                           // 36: auth.JWK
+	(*GetJWKSResponse)(nil),               // 37: auth.GetJWKSResponse
+	(*emptypb.Empty)(nil),                 // 38: google.protobuf.Empty
 }
 var file_auth_proto_depIdxs = []int32{
 	1,  // 0: auth.Token.session:type_name -> auth.SessionInfo
 	1,  // 1: auth.LoginRequest.session:type_name -> auth.SessionInfo
 	0,  // 2: auth.GetAllActiveSessionsResponse.tokens:type_name -> auth.Token
 	1,  // 3: auth.Verify2FARequest.session:type_name -> auth.SessionInfo
-	31, // 4: auth.AuthService.Health:input_type -> google.protobuf.Empty
-	3,  // 5: auth.AuthService.Register:input_type -> auth.RegisterRequest
-	4,  // 6: auth.AuthService.Login:input_type -> auth.LoginRequest
-	6,  // 7: auth.AuthService.GetUser:input_type -> auth.GetUserRequest
-	8,  // 8: auth.AuthService.ChangePassword:input_type -> auth.ChangePasswordRequest
-	9,  // 9: auth.AuthService.UpdateUserBio:input_type -> auth.UpdateUserBioRequest
-	10, // 10: auth.AuthService.DeleteUser:input_type -> auth.DeleteUserRequest
-	11, // 11: auth.AuthService.RefreshToken:input_type -> auth.RefreshTokenRequest
-	13, // 12: auth.AuthService.GetAllActiveSessions:input_type -> auth.GetAllActiveSessionsRequest
-	15, // 13: auth.AuthService.RevokeSession:input_type -> auth.RevokeSessionRequest
-	16, // 14: auth.AuthService.RevokeAllSessions:input_type -> auth.RevokeAllSessionsRequest
-	17, // 15: auth.AuthService.VerifyAccount:input_type -> auth.VerifyAccountRequest
-	18, // 16: auth.AuthService.ResendVerificationCode:input_type -> auth.ResendVerificationCodeRequest
-	19, // 17: auth.AuthService.GetVerificationToken:input_type -> auth.GetVerificationTokenRequest
-	21, // 18: auth.AuthService.GetResetPasswordToken:input_type -> auth.GetResetPasswordTokenRequest
-	23, // 19: auth.AuthService.Get2FACode:input_type -> auth.Get2FACodeRequest
-	25, // 20: auth.AuthService.ForgotPassword:input_type -> auth.ForgotPasswordRequest
-	26, // 21: auth.AuthService.ResetPassword:input_type -> auth.ResetPasswordRequest
-	27, // 22: auth.AuthService.Verify2FA:input_type -> auth.Verify2FARequest
-	29, // 23: auth.AuthService.UpdateUser2FA:input_type -> auth.UpdateUser2FARequest
-	30, // 24: auth.AuthService.RestoreAccount:input_type -> auth.RestoreAccountRequest
-	2,  // 25: auth.AuthService.Health:output_type -> auth.HealthResponse
-	31, // 26: auth.AuthService.Register:output_type -> google.protobuf.Empty
-	5,  // 27: auth.AuthService.Login:output_type -> auth.LoginResponse
-	7,  // 28: auth.AuthService.GetUser:output_type -> auth.GetUserResponse
-	31, // 29: auth.AuthService.ChangePassword:output_type -> google.protobuf.Empty
-	31, // 30: auth.AuthService.UpdateUserBio:output_type -> google.protobuf.Empty
-	31, // 31: auth.AuthService.DeleteUser:output_type -> google.protobuf.Empty
-	12, // 32: auth.AuthService.RefreshToken:output_type -> auth.RefreshTokenResponse
-	14, // 33: auth.AuthService.GetAllActiveSessions:output_type -> auth.GetAllActiveSessionsResponse
-	31, // 34: auth.AuthService.RevokeSession:output_type -> google.protobuf.Empty
-	31, // 35: auth.AuthService.RevokeAllSessions:output_type -> google.protobuf.Empty
-	31, // 36: auth.AuthService.VerifyAccount:output_type -> google.protobuf.Empty
-	31, // 37: auth.AuthService.ResendVerificationCode:output_type -> google.protobuf.Empty
-	20, // 38: auth.AuthService.GetVerificationToken:output_type -> auth.GetVerificationTokenResponse
-	22, // 39: auth.AuthService.GetResetPasswordToken:output_type -> auth.GetResetPasswordTokenResponse
-	24, // 40: auth.AuthService.Get2FACode:output_type -> auth.Get2FACodeResponse
-	31, // 41: auth.AuthService.ForgotPassword:output_type -> google.protobuf.Empty
-	31, // 42: auth.AuthService.ResetPassword:output_type -> google.protobuf.Empty
-	28, // 43: auth.AuthService.Verify2FA:output_type -> auth.Verify2FAResponse
-	31, // 44: auth.AuthService.UpdateUser2FA:output_type -> google.protobuf.Empty
-	31, // 45: auth.AuthService.RestoreAccount:output_type -> google.protobuf.Empty
-	25, // [25:46] is the sub-list for method output_type
-	4,  // [4:25] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	36, // 4: auth.GetJWKSResponse.keys:type_name -> auth.JWK
+	38, // 5: auth.AuthService.Health:input_type -> google.protobuf.Empty
+	3,  // 6: auth.AuthService.Register:input_type -> auth.RegisterRequest
+	4,  // 7: auth.AuthService.Login:input_type -> auth.LoginRequest
+	6,  // 8: auth.AuthService.GetUser:input_type -> auth.GetUserRequest
+	8,  // 9: auth.AuthService.ChangePassword:input_type -> auth.ChangePasswordRequest
+	9,  // 10: auth.AuthService.UpdateUserBio:input_type -> auth.UpdateUserBioRequest
+	10, // 11: auth.AuthService.DeleteUser:input_type -> auth.DeleteUserRequest
+	11, // 12: auth.AuthService.RefreshToken:input_type -> auth.RefreshTokenRequest
+	13, // 13: auth.AuthService.GetAllActiveSessions:input_type -> auth.GetAllActiveSessionsRequest
+	15, // 14: auth.AuthService.RevokeSession:input_type -> auth.RevokeSessionRequest
+	16, // 15: auth.AuthService.RevokeAllSessions:input_type -> auth.RevokeAllSessionsRequest
+	17, // 16: auth.AuthService.VerifyAccount:input_type -> auth.VerifyAccountRequest
+	18, // 17: auth.AuthService.ResendVerificationCode:input_type -> auth.ResendVerificationCodeRequest
+	19, // 18: auth.AuthService.GetVerificationToken:input_type -> auth.GetVerificationTokenRequest
+	21, // 19: auth.AuthService.GetResetPasswordToken:input_type -> auth.GetResetPasswordTokenRequest
+	23, // 20: auth.AuthService.Get2FACode:input_type -> auth.Get2FACodeRequest
+	25, // 21: auth.AuthService.ForgotPassword:input_type -> auth.ForgotPasswordRequest
+	26, // 22: auth.AuthService.ResetPassword:input_type -> auth.ResetPasswordRequest
+	27, // 23: auth.AuthService.Verify2FA:input_type -> auth.Verify2FARequest
+	29, // 24: auth.AuthService.UpdateUser2FA:input_type -> auth.UpdateUser2FARequest
+	30, // 25: auth.AuthService.EnrollTOTP:input_type -> auth.EnrollTOTPRequest
+	32, // 26: auth.AuthService.ConfirmTOTP:input_type -> auth.ConfirmTOTPRequest
+	34, // 27: auth.AuthService.Send2FAEmailCode:input_type -> auth.Send2FAEmailCodeRequest
+	35, // 28: auth.AuthService.RestoreAccount:input_type -> auth.RestoreAccountRequest
+	38, // 29: auth.AuthService.GetJWKS:input_type -> google.protobuf.Empty
+	2,  // 30: auth.AuthService.Health:output_type -> auth.HealthResponse
+	38, // 31: auth.AuthService.Register:output_type -> google.protobuf.Empty
+	5,  // 32: auth.AuthService.Login:output_type -> auth.LoginResponse
+	7,  // 33: auth.AuthService.GetUser:output_type -> auth.GetUserResponse
+	38, // 34: auth.AuthService.ChangePassword:output_type -> google.protobuf.Empty
+	38, // 35: auth.AuthService.UpdateUserBio:output_type -> google.protobuf.Empty
+	38, // 36: auth.AuthService.DeleteUser:output_type -> google.protobuf.Empty
+	12, // 37: auth.AuthService.RefreshToken:output_type -> auth.RefreshTokenResponse
+	14, // 38: auth.AuthService.GetAllActiveSessions:output_type -> auth.GetAllActiveSessionsResponse
+	38, // 39: auth.AuthService.RevokeSession:output_type -> google.protobuf.Empty
+	38, // 40: auth.AuthService.RevokeAllSessions:output_type -> google.protobuf.Empty
+	38, // 41: auth.AuthService.VerifyAccount:output_type -> google.protobuf.Empty
+	38, // 42: auth.AuthService.ResendVerificationCode:output_type -> google.protobuf.Empty
+	20, // 43: auth.AuthService.GetVerificationToken:output_type -> auth.GetVerificationTokenResponse
+	22, // 44: auth.AuthService.GetResetPasswordToken:output_type -> auth.GetResetPasswordTokenResponse
+	24, // 45: auth.AuthService.Get2FACode:output_type -> auth.Get2FACodeResponse
+	38, // 46: auth.AuthService.ForgotPassword:output_type -> google.protobuf.Empty
+	38, // 47: auth.AuthService.ResetPassword:output_type -> google.protobuf.Empty
+	28, // 48: auth.AuthService.Verify2FA:output_type -> auth.Verify2FAResponse
+	38, // 49: auth.AuthService.UpdateUser2FA:output_type -> google.protobuf.Empty
+	31, // 50: auth.AuthService.EnrollTOTP:output_type -> auth.EnrollTOTPResponse
+	33, // 51: auth.AuthService.ConfirmTOTP:output_type -> auth.ConfirmTOTPResponse
+	38, // 52: auth.AuthService.Send2FAEmailCode:output_type -> google.protobuf.Empty
+	38, // 53: auth.AuthService.RestoreAccount:output_type -> google.protobuf.Empty
+	37, // 54: auth.AuthService.GetJWKS:output_type -> auth.GetJWKSResponse
+	30, // [30:55] is the sub-list for method output_type
+	5,  // [5:30] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_auth_proto_init() }
@@ -2089,7 +2555,7 @@ func file_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_proto_rawDesc), len(file_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   31,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
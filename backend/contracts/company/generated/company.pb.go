@@ -202,33 +202,29 @@ func (x *Department) GetTitle() string {
 	return ""
 }
 
-// Health
-// Empty request
-type HealthResponse struct {
+type JoinCode struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Service       string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
-	Postgres      string                 `protobuf:"bytes,2,opt,name=postgres,proto3" json:"postgres,omitempty"`
-	Redis         string                 `protobuf:"bytes,3,opt,name=redis,proto3" json:"redis,omitempty"`
-	Minio         string                 `protobuf:"bytes,4,opt,name=minio,proto3" json:"minio,omitempty"`
-	Mongo         string                 `protobuf:"bytes,5,opt,name=mongo,proto3" json:"mongo,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	MaxUses       int64                  `protobuf:"varint,2,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"` // 0 - без ограничения
+	Uses          int64                  `protobuf:"varint,3,opt,name=uses,proto3" json:"uses,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HealthResponse) Reset() {
-	*x = HealthResponse{}
+func (x *JoinCode) Reset() {
+	*x = JoinCode{}
 	mi := &file_company_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HealthResponse) String() string {
+func (x *JoinCode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthResponse) ProtoMessage() {}
+func (*JoinCode) ProtoMessage() {}
 
-func (x *HealthResponse) ProtoReflect() protoreflect.Message {
+func (x *JoinCode) ProtoReflect() protoreflect.Message {
 	mi := &file_company_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -240,69 +236,55 @@ func (x *HealthResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
-func (*HealthResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinCode.ProtoReflect.Descriptor instead.
+func (*JoinCode) Descriptor() ([]byte, []int) {
 	return file_company_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *HealthResponse) GetService() string {
-	if x != nil {
-		return x.Service
-	}
-	return ""
-}
-
-func (x *HealthResponse) GetPostgres() string {
-	if x != nil {
-		return x.Postgres
-	}
-	return ""
-}
-
-func (x *HealthResponse) GetRedis() string {
+func (x *JoinCode) GetCode() string {
 	if x != nil {
-		return x.Redis
+		return x.Code
 	}
 	return ""
 }
 
-func (x *HealthResponse) GetMinio() string {
+func (x *JoinCode) GetMaxUses() int64 {
 	if x != nil {
-		return x.Minio
+		return x.MaxUses
 	}
-	return ""
+	return 0
 }
 
-func (x *HealthResponse) GetMongo() string {
+func (x *JoinCode) GetUses() int64 {
 	if x != nil {
-		return x.Mongo
+		return x.Uses
 	}
-	return ""
+	return 0
 }
 
-// CreateCompany
-type CreateCompanyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type JoinCodeRedemption struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid        string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	RedeemedAt      string                 `protobuf:"bytes,2,opt,name=redeemed_at,json=redeemedAt,proto3" json:"redeemed_at,omitempty"`
+	JoinRequestUuid string                 `protobuf:"bytes,3,opt,name=join_request_uuid,json=joinRequestUuid,proto3" json:"join_request_uuid,omitempty"` // пусто - пользователь добавлен сразу, без заявки
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CreateCompanyRequest) Reset() {
-	*x = CreateCompanyRequest{}
+func (x *JoinCodeRedemption) Reset() {
+	*x = JoinCodeRedemption{}
 	mi := &file_company_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCompanyRequest) String() string {
+func (x *JoinCodeRedemption) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCompanyRequest) ProtoMessage() {}
+func (*JoinCodeRedemption) ProtoMessage() {}
 
-func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
+func (x *JoinCodeRedemption) ProtoReflect() protoreflect.Message {
 	mi := &file_company_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -314,46 +296,60 @@ func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCompanyRequest.ProtoReflect.Descriptor instead.
-func (*CreateCompanyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinCodeRedemption.ProtoReflect.Descriptor instead.
+func (*JoinCodeRedemption) Descriptor() ([]byte, []int) {
 	return file_company_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreateCompanyRequest) GetInitiatorUuid() string {
+func (x *JoinCodeRedemption) GetUserUuid() string {
 	if x != nil {
-		return x.InitiatorUuid
+		return x.UserUuid
 	}
 	return ""
 }
 
-func (x *CreateCompanyRequest) GetTitle() string {
+func (x *JoinCodeRedemption) GetRedeemedAt() string {
 	if x != nil {
-		return x.Title
+		return x.RedeemedAt
 	}
 	return ""
 }
 
-type CreateCompanyResponse struct {
+func (x *JoinCodeRedemption) GetJoinRequestUuid() string {
+	if x != nil {
+		return x.JoinRequestUuid
+	}
+	return ""
+}
+
+type OwnershipTransfer struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CompanyUuid   string                 `protobuf:"bytes,1,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	TransferUuid  string                 `protobuf:"bytes,1,opt,name=transfer_uuid,json=transferUuid,proto3" json:"transfer_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	FromUuid      string                 `protobuf:"bytes,3,opt,name=from_uuid,json=fromUuid,proto3" json:"from_uuid,omitempty"` // владелец, предложивший передачу
+	ToUuid        string                 `protobuf:"bytes,4,opt,name=to_uuid,json=toUuid,proto3" json:"to_uuid,omitempty"`       // будущий владелец, должен подтвердить передачу
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                     // pending | accepted | declined | cancelled | expired
+	ExpiresAt     string                 `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DecidedAt     string                 `protobuf:"bytes,8,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCompanyResponse) Reset() {
-	*x = CreateCompanyResponse{}
+func (x *OwnershipTransfer) Reset() {
+	*x = OwnershipTransfer{}
 	mi := &file_company_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCompanyResponse) String() string {
+func (x *OwnershipTransfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCompanyResponse) ProtoMessage() {}
+func (*OwnershipTransfer) ProtoMessage() {}
 
-func (x *CreateCompanyResponse) ProtoReflect() protoreflect.Message {
+func (x *OwnershipTransfer) ProtoReflect() protoreflect.Message {
 	mi := &file_company_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -365,41 +361,94 @@ func (x *CreateCompanyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCompanyResponse.ProtoReflect.Descriptor instead.
-func (*CreateCompanyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OwnershipTransfer.ProtoReflect.Descriptor instead.
+func (*OwnershipTransfer) Descriptor() ([]byte, []int) {
 	return file_company_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateCompanyResponse) GetCompanyUuid() string {
+func (x *OwnershipTransfer) GetTransferUuid() string {
+	if x != nil {
+		return x.TransferUuid
+	}
+	return ""
+}
+
+func (x *OwnershipTransfer) GetCompanyUuid() string {
 	if x != nil {
 		return x.CompanyUuid
 	}
 	return ""
 }
 
-// GetCompany
-type GetCompanyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *OwnershipTransfer) GetFromUuid() string {
+	if x != nil {
+		return x.FromUuid
+	}
+	return ""
 }
 
-func (x *GetCompanyRequest) Reset() {
-	*x = GetCompanyRequest{}
+func (x *OwnershipTransfer) GetToUuid() string {
+	if x != nil {
+		return x.ToUuid
+	}
+	return ""
+}
+
+func (x *OwnershipTransfer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OwnershipTransfer) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *OwnershipTransfer) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *OwnershipTransfer) GetDecidedAt() string {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return ""
+}
+
+type JoinRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	JoinRequestUuid string                 `protobuf:"bytes,1,opt,name=join_request_uuid,json=joinRequestUuid,proto3" json:"join_request_uuid,omitempty"`
+	UserUuid        string                 `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	JoinCode        string                 `protobuf:"bytes,3,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"`
+	Status          string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // pending | accepted | declined
+	CreatedAt       string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DecidedAt       string                 `protobuf:"bytes,6,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	DecidedBy       string                 `protobuf:"bytes,7,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *JoinRequest) Reset() {
+	*x = JoinRequest{}
 	mi := &file_company_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanyRequest) String() string {
+func (x *JoinRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanyRequest) ProtoMessage() {}
+func (*JoinRequest) ProtoMessage() {}
 
-func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
+func (x *JoinRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_company_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -411,48 +460,92 @@ func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanyRequest.ProtoReflect.Descriptor instead.
-func (*GetCompanyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
+func (*JoinRequest) Descriptor() ([]byte, []int) {
 	return file_company_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetCompanyRequest) GetInitiatorUuid() string {
+func (x *JoinRequest) GetJoinRequestUuid() string {
 	if x != nil {
-		return x.InitiatorUuid
+		return x.JoinRequestUuid
 	}
 	return ""
 }
 
-func (x *GetCompanyRequest) GetCompanyUuid() string {
+func (x *JoinRequest) GetUserUuid() string {
 	if x != nil {
-		return x.CompanyUuid
+		return x.UserUuid
 	}
 	return ""
 }
 
-type GetCompanyResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CompanyUuid   string                 `protobuf:"bytes,1,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *JoinRequest) GetJoinCode() string {
+	if x != nil {
+		return x.JoinCode
+	}
+	return ""
 }
 
-func (x *GetCompanyResponse) Reset() {
-	*x = GetCompanyResponse{}
+func (x *JoinRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetDecidedAt() string {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+type Invitation struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InvitationUuid string                 `protobuf:"bytes,1,opt,name=invitation_uuid,json=invitationUuid,proto3" json:"invitation_uuid,omitempty"`
+	CompanyUuid    string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	CompanyTitle   string                 `protobuf:"bytes,3,opt,name=company_title,json=companyTitle,proto3" json:"company_title,omitempty"`
+	Email          string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Role           string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	DepartmentUuid string                 `protobuf:"bytes,6,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
+	Status         string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // pending | accepted | declined | revoked | expired
+	ExpiresAt      string                 `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy      string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	DecidedAt      string                 `protobuf:"bytes,11,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	AcceptedBy     string                 `protobuf:"bytes,12,opt,name=accepted_by,json=acceptedBy,proto3" json:"accepted_by,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Invitation) Reset() {
+	*x = Invitation{}
 	mi := &file_company_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanyResponse) String() string {
+func (x *Invitation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanyResponse) ProtoMessage() {}
+func (*Invitation) ProtoMessage() {}
 
-func (x *GetCompanyResponse) ProtoReflect() protoreflect.Message {
+func (x *Invitation) ProtoReflect() protoreflect.Message {
 	mi := &file_company_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -464,55 +557,121 @@ func (x *GetCompanyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanyResponse.ProtoReflect.Descriptor instead.
-func (*GetCompanyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
 	return file_company_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetCompanyResponse) GetCompanyUuid() string {
+func (x *Invitation) GetInvitationUuid() string {
+	if x != nil {
+		return x.InvitationUuid
+	}
+	return ""
+}
+
+func (x *Invitation) GetCompanyUuid() string {
 	if x != nil {
 		return x.CompanyUuid
 	}
 	return ""
 }
 
-func (x *GetCompanyResponse) GetTitle() string {
+func (x *Invitation) GetCompanyTitle() string {
 	if x != nil {
-		return x.Title
+		return x.CompanyTitle
 	}
 	return ""
 }
 
-func (x *GetCompanyResponse) GetStatus() string {
+func (x *Invitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Invitation) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Invitation) GetDepartmentUuid() string {
+	if x != nil {
+		return x.DepartmentUuid
+	}
+	return ""
+}
+
+func (x *Invitation) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-// GetCompanies
-type GetCompaniesRequest struct {
+func (x *Invitation) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *Invitation) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Invitation) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Invitation) GetDecidedAt() string {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return ""
+}
+
+func (x *Invitation) GetAcceptedBy() string {
+	if x != nil {
+		return x.AcceptedBy
+	}
+	return ""
+}
+
+type Location struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Offset        int64                  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
-	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	LocationUuid  string                 `protobuf:"bytes,1,opt,name=location_uuid,json=locationUuid,proto3" json:"location_uuid,omitempty"`
+	ParentUuid    string                 `protobuf:"bytes,2,opt,name=parent_uuid,json=parentUuid,proto3" json:"parent_uuid,omitempty"` // пусто у объекта (site)
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`                               // site | building | floor | zone
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Latitude      *float64               `protobuf:"fixed64,5,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude     *float64               `protobuf:"fixed64,6,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCompaniesRequest) Reset() {
-	*x = GetCompaniesRequest{}
+func (x *Location) Reset() {
+	*x = Location{}
 	mi := &file_company_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompaniesRequest) String() string {
+func (x *Location) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompaniesRequest) ProtoMessage() {}
+func (*Location) ProtoMessage() {}
 
-func (x *GetCompaniesRequest) ProtoReflect() protoreflect.Message {
+func (x *Location) ProtoReflect() protoreflect.Message {
 	mi := &file_company_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -524,46 +683,82 @@ func (x *GetCompaniesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompaniesRequest.ProtoReflect.Descriptor instead.
-func (*GetCompaniesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
 	return file_company_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetCompaniesRequest) GetOffset() int64 {
+func (x *Location) GetLocationUuid() string {
 	if x != nil {
-		return x.Offset
+		return x.LocationUuid
 	}
-	return 0
+	return ""
 }
 
-func (x *GetCompaniesRequest) GetCount() int64 {
+func (x *Location) GetParentUuid() string {
 	if x != nil {
-		return x.Count
+		return x.ParentUuid
 	}
-	return 0
+	return ""
 }
 
-type GetCompaniesResponse struct {
+func (x *Location) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Location) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Location) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *Location) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+type AuditEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Companies     []*Company             `protobuf:"bytes,1,rep,name=companies,proto3" json:"companies,omitempty"`
+	EntryUuid     string                 `protobuf:"bytes,1,opt,name=entry_uuid,json=entryUuid,proto3" json:"entry_uuid,omitempty"`
+	ActorUuid     string                 `protobuf:"bytes,2,opt,name=actor_uuid,json=actorUuid,proto3" json:"actor_uuid,omitempty"`       // кто выполнил действие
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`                              // employee_role_changed | employee_removed | department_created | department_deleted | company_title_changed | department_title_changed | join_code_created
+	TargetType    string                 `protobuf:"bytes,4,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`    // employee | department | company | join_code
+	TargetId      string                 `protobuf:"bytes,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`          // uuid сотрудника, отдела или компании либо код для вступления
+	BeforeValue   string                 `protobuf:"bytes,6,opt,name=before_value,json=beforeValue,proto3" json:"before_value,omitempty"` // пусто - значения до действия не было
+	AfterValue    string                 `protobuf:"bytes,7,opt,name=after_value,json=afterValue,proto3" json:"after_value,omitempty"`    // пусто - значения после действия нет
+	OperationId   string                 `protobuf:"bytes,8,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCompaniesResponse) Reset() {
-	*x = GetCompaniesResponse{}
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
 	mi := &file_company_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompaniesResponse) String() string {
+func (x *AuditEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompaniesResponse) ProtoMessage() {}
+func (*AuditEntry) ProtoMessage() {}
 
-func (x *GetCompaniesResponse) ProtoReflect() protoreflect.Message {
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_company_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -575,85 +770,2992 @@ func (x *GetCompaniesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompaniesResponse.ProtoReflect.Descriptor instead.
-func (*GetCompaniesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
 	return file_company_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetCompaniesResponse) GetCompanies() []*Company {
+func (x *AuditEntry) GetEntryUuid() string {
 	if x != nil {
-		return x.Companies
+		return x.EntryUuid
 	}
-	return nil
+	return ""
 }
 
-// GetUserCompanies
-type GetUserCompaniesRequest struct {
+func (x *AuditEntry) GetActorUuid() string {
+	if x != nil {
+		return x.ActorUuid
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetBeforeValue() string {
+	if x != nil {
+		return x.BeforeValue
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAfterValue() string {
+	if x != nil {
+		return x.AfterValue
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// Health
+// Empty request
+type HealthResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	Service       string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Postgres      string                 `protobuf:"bytes,2,opt,name=postgres,proto3" json:"postgres,omitempty"`
+	Redis         string                 `protobuf:"bytes,3,opt,name=redis,proto3" json:"redis,omitempty"`
+	Minio         string                 `protobuf:"bytes,4,opt,name=minio,proto3" json:"minio,omitempty"`
+	Mongo         string                 `protobuf:"bytes,5,opt,name=mongo,proto3" json:"mongo,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserCompaniesRequest) Reset() {
-	*x = GetUserCompaniesRequest{}
+func (x *HealthResponse) Reset() {
+	*x = HealthResponse{}
+	mi := &file_company_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthResponse) ProtoMessage() {}
+
+func (x *HealthResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_company_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
+func (*HealthResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *HealthResponse) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *HealthResponse) GetPostgres() string {
+	if x != nil {
+		return x.Postgres
+	}
+	return ""
+}
+
+func (x *HealthResponse) GetRedis() string {
+	if x != nil {
+		return x.Redis
+	}
+	return ""
+}
+
+func (x *HealthResponse) GetMinio() string {
+	if x != nil {
+		return x.Minio
+	}
+	return ""
+}
+
+func (x *HealthResponse) GetMongo() string {
+	if x != nil {
+		return x.Mongo
+	}
+	return ""
+}
+
+// CreateCompany
+type CreateCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCompanyRequest) Reset() {
+	*x = CreateCompanyRequest{}
+	mi := &file_company_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompanyRequest) ProtoMessage() {}
+
+func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompanyRequest.ProtoReflect.Descriptor instead.
+func (*CreateCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateCompanyRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *CreateCompanyRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type CreateCompanyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyUuid   string                 `protobuf:"bytes,1,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCompanyResponse) Reset() {
+	*x = CreateCompanyResponse{}
+	mi := &file_company_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserCompaniesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateCompanyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompanyResponse) ProtoMessage() {}
+
+func (x *CreateCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompanyResponse.ProtoReflect.Descriptor instead.
+func (*CreateCompanyResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateCompanyResponse) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+// GetCompany
+type GetCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyRequest) Reset() {
+	*x = GetCompanyRequest{}
+	mi := &file_company_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyRequest) ProtoMessage() {}
+
+func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetCompanyRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *GetCompanyRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+type GetCompanyResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	CompanyUuid          string                 `protobuf:"bytes,1,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	Title                string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Status               string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	JoinApprovalRequired bool                   `protobuf:"varint,4,opt,name=join_approval_required,json=joinApprovalRequired,proto3" json:"join_approval_required,omitempty"` // вступление по коду только после одобрения chief
+	OwnerUuid            string                 `protobuf:"bytes,5,opt,name=owner_uuid,json=ownerUuid,proto3" json:"owner_uuid,omitempty"`                                     // владелец компании, всегда chief
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetCompanyResponse) Reset() {
+	*x = GetCompanyResponse{}
+	mi := &file_company_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyResponse) ProtoMessage() {}
+
+func (x *GetCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanyResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetCompanyResponse) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *GetCompanyResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GetCompanyResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetCompanyResponse) GetJoinApprovalRequired() bool {
+	if x != nil {
+		return x.JoinApprovalRequired
+	}
+	return false
+}
+
+func (x *GetCompanyResponse) GetOwnerUuid() string {
+	if x != nil {
+		return x.OwnerUuid
+	}
+	return ""
+}
+
+// GetCompanies
+type GetCompaniesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offset        int64                  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"` // next_cursor предыдущей страницы (вместо offset)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompaniesRequest) Reset() {
+	*x = GetCompaniesRequest{}
+	mi := &file_company_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompaniesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompaniesRequest) ProtoMessage() {}
+
+func (x *GetCompaniesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompaniesRequest.ProtoReflect.Descriptor instead.
+func (*GetCompaniesRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetCompaniesRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetCompaniesRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetCompaniesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetCompaniesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Companies     []*Company             `protobuf:"bytes,1,rep,name=companies,proto3" json:"companies,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // пусто - страниц больше нет
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompaniesResponse) Reset() {
+	*x = GetCompaniesResponse{}
+	mi := &file_company_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompaniesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompaniesResponse) ProtoMessage() {}
+
+func (x *GetCompaniesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompaniesResponse.ProtoReflect.Descriptor instead.
+func (*GetCompaniesResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetCompaniesResponse) GetCompanies() []*Company {
+	if x != nil {
+		return x.Companies
+	}
+	return nil
+}
+
+func (x *GetCompaniesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetCompaniesResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// GetUserCompanies
+type GetUserCompaniesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserCompaniesRequest) Reset() {
+	*x = GetUserCompaniesRequest{}
+	mi := &file_company_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserCompaniesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserCompaniesRequest) ProtoMessage() {}
+
+func (x *GetUserCompaniesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserCompaniesRequest.ProtoReflect.Descriptor instead.
+func (*GetUserCompaniesRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetUserCompaniesRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+type GetUserCompaniesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Companies     []*Company             `protobuf:"bytes,1,rep,name=companies,proto3" json:"companies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserCompaniesResponse) Reset() {
+	*x = GetUserCompaniesResponse{}
+	mi := &file_company_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserCompaniesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserCompaniesResponse) ProtoMessage() {}
+
+func (x *GetUserCompaniesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserCompaniesResponse.ProtoReflect.Descriptor instead.
+func (*GetUserCompaniesResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetUserCompaniesResponse) GetCompanies() []*Company {
+	if x != nil {
+		return x.Companies
+	}
+	return nil
+}
+
+// UpdateCompanyTitle
+type UpdateCompanyTitleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCompanyTitleRequest) Reset() {
+	*x = UpdateCompanyTitleRequest{}
+	mi := &file_company_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCompanyTitleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompanyTitleRequest) ProtoMessage() {}
+
+func (x *UpdateCompanyTitleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompanyTitleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCompanyTitleRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateCompanyTitleRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *UpdateCompanyTitleRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *UpdateCompanyTitleRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+// UpdateCompanyStatus
+type UpdateCompanyStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCompanyStatusRequest) Reset() {
+	*x = UpdateCompanyStatusRequest{}
+	mi := &file_company_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCompanyStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompanyStatusRequest) ProtoMessage() {}
+
+func (x *UpdateCompanyStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompanyStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCompanyStatusRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateCompanyStatusRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *UpdateCompanyStatusRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *UpdateCompanyStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// DeleteCompany
+type DeleteCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCompanyRequest) Reset() {
+	*x = DeleteCompanyRequest{}
+	mi := &file_company_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCompanyRequest) ProtoMessage() {}
+
+func (x *DeleteCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCompanyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteCompanyRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *DeleteCompanyRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+// GetCompanyDeletionStatus
+type GetCompanyDeletionStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyDeletionStatusRequest) Reset() {
+	*x = GetCompanyDeletionStatusRequest{}
+	mi := &file_company_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyDeletionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyDeletionStatusRequest) ProtoMessage() {}
+
+func (x *GetCompanyDeletionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyDeletionStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyDeletionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetCompanyDeletionStatusRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *GetCompanyDeletionStatusRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+type GetCompanyDeletionStatusResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Step                 string                 `protobuf:"bytes,1,opt,name=step,proto3" json:"step,omitempty"` // soft_deleted | archiving_applications | purging_join_codes | deleting_company | completed
+	RequestedBy          string                 `protobuf:"bytes,2,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	RequestedAt          string                 `protobuf:"bytes,3,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	PurgeAfter           string                 `protobuf:"bytes,4,opt,name=purge_after,json=purgeAfter,proto3" json:"purge_after,omitempty"` // до этого времени компанию можно восстановить
+	ArchiveKey           string                 `protobuf:"bytes,5,opt,name=archive_key,json=archiveKey,proto3" json:"archive_key,omitempty"` // ключ архива заявок в хранилище application сервиса
+	ArchivedApplications int64                  `protobuf:"varint,6,opt,name=archived_applications,json=archivedApplications,proto3" json:"archived_applications,omitempty"`
+	Attempts             int32                  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"` // кол-во неудачных попыток текущего шага
+	LastError            string                 `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CompletedAt          string                 `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetCompanyDeletionStatusResponse) Reset() {
+	*x = GetCompanyDeletionStatusResponse{}
+	mi := &file_company_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyDeletionStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyDeletionStatusResponse) ProtoMessage() {}
+
+func (x *GetCompanyDeletionStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyDeletionStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanyDeletionStatusResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetCompanyDeletionStatusResponse) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *GetCompanyDeletionStatusResponse) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *GetCompanyDeletionStatusResponse) GetRequestedAt() string {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return ""
+}
+
+func (x *GetCompanyDeletionStatusResponse) GetPurgeAfter() string {
+	if x != nil {
+		return x.PurgeAfter
+	}
+	return ""
+}
+
+func (x *GetCompanyDeletionStatusResponse) GetArchiveKey() string {
+	if x != nil {
+		return x.ArchiveKey
+	}
+	return ""
+}
+
+func (x *GetCompanyDeletionStatusResponse) GetArchivedApplications() int64 {
+	if x != nil {
+		return x.ArchivedApplications
+	}
+	return 0
+}
+
+func (x *GetCompanyDeletionStatusResponse) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *GetCompanyDeletionStatusResponse) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *GetCompanyDeletionStatusResponse) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+// RestoreCompany
+type RestoreCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCompanyRequest) Reset() {
+	*x = RestoreCompanyRequest{}
+	mi := &file_company_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCompanyRequest) ProtoMessage() {}
+
+func (x *RestoreCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCompanyRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RestoreCompanyRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *RestoreCompanyRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+// UpdateCompanyJoinSettings
+type UpdateCompanyJoinSettingsRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid        string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid          string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	JoinApprovalRequired bool                   `protobuf:"varint,3,opt,name=join_approval_required,json=joinApprovalRequired,proto3" json:"join_approval_required,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateCompanyJoinSettingsRequest) Reset() {
+	*x = UpdateCompanyJoinSettingsRequest{}
+	mi := &file_company_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCompanyJoinSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompanyJoinSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateCompanyJoinSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompanyJoinSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCompanyJoinSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateCompanyJoinSettingsRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *UpdateCompanyJoinSettingsRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *UpdateCompanyJoinSettingsRequest) GetJoinApprovalRequired() bool {
+	if x != nil {
+		return x.JoinApprovalRequired
+	}
+	return false
+}
+
+// CreateCompanyJoinCode
+type CreateCompanyJoinCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	CodeTtl       int64                  `protobuf:"varint,3,opt,name=code_ttl,json=codeTtl,proto3" json:"code_ttl,omitempty"`
+	MaxUses       int64                  `protobuf:"varint,4,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"` // 0 - без ограничения
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCompanyJoinCodeRequest) Reset() {
+	*x = CreateCompanyJoinCodeRequest{}
+	mi := &file_company_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCompanyJoinCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompanyJoinCodeRequest) ProtoMessage() {}
+
+func (x *CreateCompanyJoinCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompanyJoinCodeRequest.ProtoReflect.Descriptor instead.
+func (*CreateCompanyJoinCodeRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateCompanyJoinCodeRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *CreateCompanyJoinCodeRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *CreateCompanyJoinCodeRequest) GetCodeTtl() int64 {
+	if x != nil {
+		return x.CodeTtl
+	}
+	return 0
+}
+
+func (x *CreateCompanyJoinCodeRequest) GetMaxUses() int64 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+type CreateCompanyJoinCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JoinCode      string                 `protobuf:"bytes,1,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCompanyJoinCodeResponse) Reset() {
+	*x = CreateCompanyJoinCodeResponse{}
+	mi := &file_company_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCompanyJoinCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompanyJoinCodeResponse) ProtoMessage() {}
+
+func (x *CreateCompanyJoinCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompanyJoinCodeResponse.ProtoReflect.Descriptor instead.
+func (*CreateCompanyJoinCodeResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateCompanyJoinCodeResponse) GetJoinCode() string {
+	if x != nil {
+		return x.JoinCode
+	}
+	return ""
+}
+
+// GetCompanyJoinCodes
+type GetCompanyJoinCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyJoinCodesRequest) Reset() {
+	*x = GetCompanyJoinCodesRequest{}
+	mi := &file_company_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyJoinCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyJoinCodesRequest) ProtoMessage() {}
+
+func (x *GetCompanyJoinCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyJoinCodesRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyJoinCodesRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetCompanyJoinCodesRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *GetCompanyJoinCodesRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+type GetCompanyJoinCodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Codes         []string               `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes,omitempty"`
+	JoinCodes     []*JoinCode            `protobuf:"bytes,2,rep,name=join_codes,json=joinCodes,proto3" json:"join_codes,omitempty"` // коды вместе с числом использований
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyJoinCodesResponse) Reset() {
+	*x = GetCompanyJoinCodesResponse{}
+	mi := &file_company_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyJoinCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyJoinCodesResponse) ProtoMessage() {}
+
+func (x *GetCompanyJoinCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyJoinCodesResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanyJoinCodesResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetCompanyJoinCodesResponse) GetCodes() []string {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+func (x *GetCompanyJoinCodesResponse) GetJoinCodes() []*JoinCode {
+	if x != nil {
+		return x.JoinCodes
+	}
+	return nil
+}
+
+// DeleteCompanyJoinCodes
+type DeleteCompanyJoinCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCompanyJoinCodeRequest) Reset() {
+	*x = DeleteCompanyJoinCodeRequest{}
+	mi := &file_company_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCompanyJoinCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCompanyJoinCodeRequest) ProtoMessage() {}
+
+func (x *DeleteCompanyJoinCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCompanyJoinCodeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCompanyJoinCodeRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteCompanyJoinCodeRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *DeleteCompanyJoinCodeRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *DeleteCompanyJoinCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// GetJoinCodeRedemptions
+type GetJoinCodeRedemptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJoinCodeRedemptionsRequest) Reset() {
+	*x = GetJoinCodeRedemptionsRequest{}
+	mi := &file_company_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJoinCodeRedemptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJoinCodeRedemptionsRequest) ProtoMessage() {}
+
+func (x *GetJoinCodeRedemptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJoinCodeRedemptionsRequest.ProtoReflect.Descriptor instead.
+func (*GetJoinCodeRedemptionsRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetJoinCodeRedemptionsRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *GetJoinCodeRedemptionsRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *GetJoinCodeRedemptionsRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type GetJoinCodeRedemptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Redemptions   []*JoinCodeRedemption  `protobuf:"bytes,1,rep,name=redemptions,proto3" json:"redemptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJoinCodeRedemptionsResponse) Reset() {
+	*x = GetJoinCodeRedemptionsResponse{}
+	mi := &file_company_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJoinCodeRedemptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJoinCodeRedemptionsResponse) ProtoMessage() {}
+
+func (x *GetJoinCodeRedemptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJoinCodeRedemptionsResponse.ProtoReflect.Descriptor instead.
+func (*GetJoinCodeRedemptionsResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetJoinCodeRedemptionsResponse) GetRedemptions() []*JoinCodeRedemption {
+	if x != nil {
+		return x.Redemptions
+	}
+	return nil
+}
+
+// JoinCompany
+type JoinCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	JoinCode      string                 `protobuf:"bytes,2,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinCompanyRequest) Reset() {
+	*x = JoinCompanyRequest{}
+	mi := &file_company_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinCompanyRequest) ProtoMessage() {}
+
+func (x *JoinCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinCompanyRequest.ProtoReflect.Descriptor instead.
+func (*JoinCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *JoinCompanyRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *JoinCompanyRequest) GetJoinCode() string {
+	if x != nil {
+		return x.JoinCode
+	}
+	return ""
+}
+
+type JoinCompanyResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CompanyUuid     string                 `protobuf:"bytes,1,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	Role            string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`                                                // пусто, пока заявка не одобрена
+	Status          string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                                            // joined | pending
+	JoinRequestUuid string                 `protobuf:"bytes,4,opt,name=join_request_uuid,json=joinRequestUuid,proto3" json:"join_request_uuid,omitempty"` // только для pending
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *JoinCompanyResponse) Reset() {
+	*x = JoinCompanyResponse{}
+	mi := &file_company_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinCompanyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinCompanyResponse) ProtoMessage() {}
+
+func (x *JoinCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinCompanyResponse.ProtoReflect.Descriptor instead.
+func (*JoinCompanyResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *JoinCompanyResponse) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *JoinCompanyResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *JoinCompanyResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JoinCompanyResponse) GetJoinRequestUuid() string {
+	if x != nil {
+		return x.JoinRequestUuid
+	}
+	return ""
+}
+
+// GetCompanyEmployee
+type GetCompanyEmployeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	TargetUuid    string                 `protobuf:"bytes,2,opt,name=target_uuid,json=targetUuid,proto3" json:"target_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,3,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyEmployeeRequest) Reset() {
+	*x = GetCompanyEmployeeRequest{}
+	mi := &file_company_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyEmployeeRequest) ProtoMessage() {}
+
+func (x *GetCompanyEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetCompanyEmployeeRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *GetCompanyEmployeeRequest) GetTargetUuid() string {
+	if x != nil {
+		return x.TargetUuid
+	}
+	return ""
+}
+
+func (x *GetCompanyEmployeeRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+type GetCompanyEmployeeResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Role           string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	DepartmentUuid string                 `protobuf:"bytes,2,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
+	JoinedAt       string                 `protobuf:"bytes,3,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCompanyEmployeeResponse) Reset() {
+	*x = GetCompanyEmployeeResponse{}
+	mi := &file_company_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyEmployeeResponse) ProtoMessage() {}
+
+func (x *GetCompanyEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanyEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetCompanyEmployeeResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *GetCompanyEmployeeResponse) GetDepartmentUuid() string {
+	if x != nil {
+		return x.DepartmentUuid
+	}
+	return ""
+}
+
+func (x *GetCompanyEmployeeResponse) GetJoinedAt() string {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return ""
+}
+
+// GetCompanyEmployees
+type GetCompanyEmployeesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid  string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid    string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	Role           string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	DepartmentUuid string                 `protobuf:"bytes,4,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
+	Count          int64                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Offset         int64                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	Cursor         string                 `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"` // next_cursor предыдущей страницы (вместо offset)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCompanyEmployeesRequest) Reset() {
+	*x = GetCompanyEmployeesRequest{}
+	mi := &file_company_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyEmployeesRequest) ProtoMessage() {}
+
+func (x *GetCompanyEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetCompanyEmployeesRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *GetCompanyEmployeesRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *GetCompanyEmployeesRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *GetCompanyEmployeesRequest) GetDepartmentUuid() string {
+	if x != nil {
+		return x.DepartmentUuid
+	}
+	return ""
+}
+
+func (x *GetCompanyEmployeesRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetCompanyEmployeesRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetCompanyEmployeesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetCompanyEmployeesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employees     []*Employee            `protobuf:"bytes,1,rep,name=employees,proto3" json:"employees,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // пусто - страниц больше нет
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyEmployeesResponse) Reset() {
+	*x = GetCompanyEmployeesResponse{}
+	mi := &file_company_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyEmployeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyEmployeesResponse) ProtoMessage() {}
+
+func (x *GetCompanyEmployeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyEmployeesResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanyEmployeesResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetCompanyEmployeesResponse) GetEmployees() []*Employee {
+	if x != nil {
+		return x.Employees
+	}
+	return nil
+}
+
+func (x *GetCompanyEmployeesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetCompanyEmployeesResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// GetCompanyEmployeesSummary
+type GetCompanyEmployeesSummaryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid  string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid    string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	DepartmentUuid string                 `protobuf:"bytes,3,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCompanyEmployeesSummaryRequest) Reset() {
+	*x = GetCompanyEmployeesSummaryRequest{}
+	mi := &file_company_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyEmployeesSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyEmployeesSummaryRequest) ProtoMessage() {}
+
+func (x *GetCompanyEmployeesSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyEmployeesSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyEmployeesSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetCompanyEmployeesSummaryRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *GetCompanyEmployeesSummaryRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *GetCompanyEmployeesSummaryRequest) GetDepartmentUuid() string {
+	if x != nil {
+		return x.DepartmentUuid
+	}
+	return ""
+}
+
+type GetCompanyEmployeesSummaryResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChiefCount      int64                  `protobuf:"varint,1,opt,name=chief_count,json=chiefCount,proto3" json:"chief_count,omitempty"`
+	AnalyticsCount  int64                  `protobuf:"varint,2,opt,name=analytics_count,json=analyticsCount,proto3" json:"analytics_count,omitempty"`
+	ManagerCount    int64                  `protobuf:"varint,3,opt,name=manager_count,json=managerCount,proto3" json:"manager_count,omitempty"`
+	EngineerCount   int64                  `protobuf:"varint,4,opt,name=engineer_count,json=engineerCount,proto3" json:"engineer_count,omitempty"`
+	InspectorCount  int64                  `protobuf:"varint,5,opt,name=inspector_count,json=inspectorCount,proto3" json:"inspector_count,omitempty"`
+	UnemployedCount int64                  `protobuf:"varint,6,opt,name=unemployed_count,json=unemployedCount,proto3" json:"unemployed_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetCompanyEmployeesSummaryResponse) Reset() {
+	*x = GetCompanyEmployeesSummaryResponse{}
+	mi := &file_company_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyEmployeesSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyEmployeesSummaryResponse) ProtoMessage() {}
+
+func (x *GetCompanyEmployeesSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyEmployeesSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanyEmployeesSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetCompanyEmployeesSummaryResponse) GetChiefCount() int64 {
+	if x != nil {
+		return x.ChiefCount
+	}
+	return 0
+}
+
+func (x *GetCompanyEmployeesSummaryResponse) GetAnalyticsCount() int64 {
+	if x != nil {
+		return x.AnalyticsCount
+	}
+	return 0
+}
+
+func (x *GetCompanyEmployeesSummaryResponse) GetManagerCount() int64 {
+	if x != nil {
+		return x.ManagerCount
+	}
+	return 0
+}
+
+func (x *GetCompanyEmployeesSummaryResponse) GetEngineerCount() int64 {
+	if x != nil {
+		return x.EngineerCount
+	}
+	return 0
+}
+
+func (x *GetCompanyEmployeesSummaryResponse) GetInspectorCount() int64 {
+	if x != nil {
+		return x.InspectorCount
+	}
+	return 0
+}
+
+func (x *GetCompanyEmployeesSummaryResponse) GetUnemployedCount() int64 {
+	if x != nil {
+		return x.UnemployedCount
+	}
+	return 0
+}
+
+// UpdateEmployeeRole
+// Открытые заявки, связь с которыми сотрудник теряет, обрабатываются application сервисом
+// по событию employee.role_changed после смены роли
+type UpdateEmployeeRoleRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid    string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	TargetUuid       string                 `protobuf:"bytes,2,opt,name=target_uuid,json=targetUuid,proto3" json:"target_uuid,omitempty"`
+	CompanyUuid      string                 `protobuf:"bytes,3,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	Role             string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	OpenApplications string                 `protobuf:"bytes,5,opt,name=open_applications,json=openApplications,proto3" json:"open_applications,omitempty"` // block (по умолчанию) | pool | reassign
+	ReassignTo       string                 `protobuf:"bytes,6,opt,name=reassign_to,json=reassignTo,proto3" json:"reassign_to,omitempty"`                   // только для reassign
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateEmployeeRoleRequest) Reset() {
+	*x = UpdateEmployeeRoleRequest{}
+	mi := &file_company_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEmployeeRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEmployeeRoleRequest) ProtoMessage() {}
+
+func (x *UpdateEmployeeRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEmployeeRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEmployeeRoleRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateEmployeeRoleRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRoleRequest) GetTargetUuid() string {
+	if x != nil {
+		return x.TargetUuid
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRoleRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRoleRequest) GetOpenApplications() string {
+	if x != nil {
+		return x.OpenApplications
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRoleRequest) GetReassignTo() string {
+	if x != nil {
+		return x.ReassignTo
+	}
+	return ""
+}
+
+// RemoveCompanyEmployee
+// Открытые заявки сотрудника обрабатываются application сервисом по событию employee.removed после удаления
+type RemoveCompanyEmployeeRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid    string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid      string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	TargetUuid       string                 `protobuf:"bytes,3,opt,name=target_uuid,json=targetUuid,proto3" json:"target_uuid,omitempty"`
+	OpenApplications string                 `protobuf:"bytes,4,opt,name=open_applications,json=openApplications,proto3" json:"open_applications,omitempty"` // block (по умолчанию) | pool | reassign
+	ReassignTo       string                 `protobuf:"bytes,5,opt,name=reassign_to,json=reassignTo,proto3" json:"reassign_to,omitempty"`                   // только для reassign
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RemoveCompanyEmployeeRequest) Reset() {
+	*x = RemoveCompanyEmployeeRequest{}
+	mi := &file_company_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCompanyEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCompanyEmployeeRequest) ProtoMessage() {}
+
+func (x *RemoveCompanyEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCompanyEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCompanyEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RemoveCompanyEmployeeRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *RemoveCompanyEmployeeRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *RemoveCompanyEmployeeRequest) GetTargetUuid() string {
+	if x != nil {
+		return x.TargetUuid
+	}
+	return ""
+}
+
+func (x *RemoveCompanyEmployeeRequest) GetOpenApplications() string {
+	if x != nil {
+		return x.OpenApplications
+	}
+	return ""
+}
+
+func (x *RemoveCompanyEmployeeRequest) GetReassignTo() string {
+	if x != nil {
+		return x.ReassignTo
+	}
+	return ""
+}
+
+// LeaveCompany
+type LeaveCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveCompanyRequest) Reset() {
+	*x = LeaveCompanyRequest{}
+	mi := &file_company_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveCompanyRequest) ProtoMessage() {}
+
+func (x *LeaveCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveCompanyRequest.ProtoReflect.Descriptor instead.
+func (*LeaveCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *LeaveCompanyRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *LeaveCompanyRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+// CreateDepartment
+type CreateDepartmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDepartmentRequest) Reset() {
+	*x = CreateDepartmentRequest{}
+	mi := &file_company_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDepartmentRequest) ProtoMessage() {}
+
+func (x *CreateDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateDepartmentRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *CreateDepartmentRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *CreateDepartmentRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type CreateDepartmentResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DepartmentUuid string                 `protobuf:"bytes,1,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateDepartmentResponse) Reset() {
+	*x = CreateDepartmentResponse{}
+	mi := &file_company_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDepartmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDepartmentResponse) ProtoMessage() {}
+
+func (x *CreateDepartmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDepartmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateDepartmentResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateDepartmentResponse) GetDepartmentUuid() string {
+	if x != nil {
+		return x.DepartmentUuid
+	}
+	return ""
+}
+
+// AddEmployeeToDepartment
+type AddEmployeeToDepartmentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid  string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	DepartmentUuid string                 `protobuf:"bytes,2,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
+	TargetUuid     string                 `protobuf:"bytes,3,opt,name=target_uuid,json=targetUuid,proto3" json:"target_uuid,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddEmployeeToDepartmentRequest) Reset() {
+	*x = AddEmployeeToDepartmentRequest{}
+	mi := &file_company_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddEmployeeToDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEmployeeToDepartmentRequest) ProtoMessage() {}
+
+func (x *AddEmployeeToDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEmployeeToDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*AddEmployeeToDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AddEmployeeToDepartmentRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *AddEmployeeToDepartmentRequest) GetDepartmentUuid() string {
+	if x != nil {
+		return x.DepartmentUuid
+	}
+	return ""
+}
+
+func (x *AddEmployeeToDepartmentRequest) GetTargetUuid() string {
+	if x != nil {
+		return x.TargetUuid
+	}
+	return ""
+}
+
+// GetDepartment
+type GetDepartmentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid  string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	DepartmentUuid string                 `protobuf:"bytes,2,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetDepartmentRequest) Reset() {
+	*x = GetDepartmentRequest{}
+	mi := &file_company_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepartmentRequest) ProtoMessage() {}
+
+func (x *GetDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*GetDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetDepartmentRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *GetDepartmentRequest) GetDepartmentUuid() string {
+	if x != nil {
+		return x.DepartmentUuid
+	}
+	return ""
+}
+
+type GetDepartmentResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DepartmentUuid string                 `protobuf:"bytes,1,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
+	CompanyUuid    string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy      string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetDepartmentResponse) Reset() {
+	*x = GetDepartmentResponse{}
+	mi := &file_company_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDepartmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepartmentResponse) ProtoMessage() {}
+
+func (x *GetDepartmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepartmentResponse.ProtoReflect.Descriptor instead.
+func (*GetDepartmentResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetDepartmentResponse) GetDepartmentUuid() string {
+	if x != nil {
+		return x.DepartmentUuid
+	}
+	return ""
+}
+
+func (x *GetDepartmentResponse) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *GetDepartmentResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GetDepartmentResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *GetDepartmentResponse) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+// GetCompanyDepartments
+type GetCompanyDepartmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	Offset        int64                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Count         int64                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyDepartmentsRequest) Reset() {
+	*x = GetCompanyDepartmentsRequest{}
+	mi := &file_company_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyDepartmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyDepartmentsRequest) ProtoMessage() {}
+
+func (x *GetCompanyDepartmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyDepartmentsRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyDepartmentsRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetCompanyDepartmentsRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *GetCompanyDepartmentsRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *GetCompanyDepartmentsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetCompanyDepartmentsRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetCompanyDepartmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Departments   []*Department          `protobuf:"bytes,1,rep,name=departments,proto3" json:"departments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyDepartmentsResponse) Reset() {
+	*x = GetCompanyDepartmentsResponse{}
+	mi := &file_company_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyDepartmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyDepartmentsResponse) ProtoMessage() {}
+
+func (x *GetCompanyDepartmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyDepartmentsResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanyDepartmentsResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetCompanyDepartmentsResponse) GetDepartments() []*Department {
+	if x != nil {
+		return x.Departments
+	}
+	return nil
+}
+
+// UpdateDepartmentTitle
+type UpdateDepartmentTitleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid  string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	DepartmentUuid string                 `protobuf:"bytes,2,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
+	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateDepartmentTitleRequest) Reset() {
+	*x = UpdateDepartmentTitleRequest{}
+	mi := &file_company_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDepartmentTitleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDepartmentTitleRequest) ProtoMessage() {}
+
+func (x *UpdateDepartmentTitleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDepartmentTitleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDepartmentTitleRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateDepartmentTitleRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *UpdateDepartmentTitleRequest) GetDepartmentUuid() string {
+	if x != nil {
+		return x.DepartmentUuid
+	}
+	return ""
+}
+
+func (x *UpdateDepartmentTitleRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+// DeleteDepartment
+type DeleteDepartmentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid  string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	DepartmentUuid string                 `protobuf:"bytes,2,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteDepartmentRequest) Reset() {
+	*x = DeleteDepartmentRequest{}
+	mi := &file_company_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDepartmentRequest) ProtoMessage() {}
+
+func (x *DeleteDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteDepartmentRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *DeleteDepartmentRequest) GetDepartmentUuid() string {
+	if x != nil {
+		return x.DepartmentUuid
+	}
+	return ""
+}
+
+// RemoveEmployeeFromDepartment
+// Открытые заявки отдела обрабатываются application сервисом по событию employee.department_changed после удаления
+type RemoveEmployeeFromDepartmentRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid    string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	DepartmentUuid   string                 `protobuf:"bytes,2,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
+	TargetUuid       string                 `protobuf:"bytes,3,opt,name=target_uuid,json=targetUuid,proto3" json:"target_uuid,omitempty"`
+	OpenApplications string                 `protobuf:"bytes,4,opt,name=open_applications,json=openApplications,proto3" json:"open_applications,omitempty"` // block (по умолчанию) | pool | reassign
+	ReassignTo       string                 `protobuf:"bytes,5,opt,name=reassign_to,json=reassignTo,proto3" json:"reassign_to,omitempty"`                   // только для reassign
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RemoveEmployeeFromDepartmentRequest) Reset() {
+	*x = RemoveEmployeeFromDepartmentRequest{}
+	mi := &file_company_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveEmployeeFromDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveEmployeeFromDepartmentRequest) ProtoMessage() {}
+
+func (x *RemoveEmployeeFromDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveEmployeeFromDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*RemoveEmployeeFromDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RemoveEmployeeFromDepartmentRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *RemoveEmployeeFromDepartmentRequest) GetDepartmentUuid() string {
+	if x != nil {
+		return x.DepartmentUuid
+	}
+	return ""
+}
+
+func (x *RemoveEmployeeFromDepartmentRequest) GetTargetUuid() string {
+	if x != nil {
+		return x.TargetUuid
+	}
+	return ""
+}
+
+func (x *RemoveEmployeeFromDepartmentRequest) GetOpenApplications() string {
+	if x != nil {
+		return x.OpenApplications
+	}
+	return ""
+}
+
+func (x *RemoveEmployeeFromDepartmentRequest) GetReassignTo() string {
+	if x != nil {
+		return x.ReassignTo
+	}
+	return ""
+}
+
+// CheckColleagues
+type CheckColleaguesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	TargetUuid    string                 `protobuf:"bytes,2,opt,name=target_uuid,json=targetUuid,proto3" json:"target_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckColleaguesRequest) Reset() {
+	*x = CheckColleaguesRequest{}
+	mi := &file_company_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckColleaguesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckColleaguesRequest) ProtoMessage() {}
+
+func (x *CheckColleaguesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckColleaguesRequest.ProtoReflect.Descriptor instead.
+func (*CheckColleaguesRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CheckColleaguesRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *CheckColleaguesRequest) GetTargetUuid() string {
+	if x != nil {
+		return x.TargetUuid
+	}
+	return ""
+}
+
+type CheckColleaguesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AreColleagues bool                   `protobuf:"varint,1,opt,name=are_colleagues,json=areColleagues,proto3" json:"are_colleagues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckColleaguesResponse) Reset() {
+	*x = CheckColleaguesResponse{}
+	mi := &file_company_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckColleaguesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckColleaguesResponse) ProtoMessage() {}
+
+func (x *CheckColleaguesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckColleaguesResponse.ProtoReflect.Descriptor instead.
+func (*CheckColleaguesResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CheckColleaguesResponse) GetAreColleagues() bool {
+	if x != nil {
+		return x.AreColleagues
+	}
+	return false
+}
+
+// CreateLocation
+type CreateLocationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	ParentUuid    string                 `protobuf:"bytes,3,opt,name=parent_uuid,json=parentUuid,proto3" json:"parent_uuid,omitempty"` // building - в site, floor - в building, zone - в floor; у site не указывается
+	Kind          string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	Title         string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Latitude      *float64               `protobuf:"fixed64,6,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude     *float64               `protobuf:"fixed64,7,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLocationRequest) Reset() {
+	*x = CreateLocationRequest{}
+	mi := &file_company_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLocationRequest) ProtoMessage() {}
+
+func (x *CreateLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLocationRequest.ProtoReflect.Descriptor instead.
+func (*CreateLocationRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateLocationRequest) GetInitiatorUuid() string {
+	if x != nil {
+		return x.InitiatorUuid
+	}
+	return ""
+}
+
+func (x *CreateLocationRequest) GetCompanyUuid() string {
+	if x != nil {
+		return x.CompanyUuid
+	}
+	return ""
+}
+
+func (x *CreateLocationRequest) GetParentUuid() string {
+	if x != nil {
+		return x.ParentUuid
+	}
+	return ""
+}
+
+func (x *CreateLocationRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
 }
 
-func (*GetUserCompaniesRequest) ProtoMessage() {}
-
-func (x *GetUserCompaniesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[10]
+func (x *CreateLocationRequest) GetTitle() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Title
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetUserCompaniesRequest.ProtoReflect.Descriptor instead.
-func (*GetUserCompaniesRequest) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{10}
+func (x *CreateLocationRequest) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
 }
 
-func (x *GetUserCompaniesRequest) GetInitiatorUuid() string {
-	if x != nil {
-		return x.InitiatorUuid
+func (x *CreateLocationRequest) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
 	}
-	return ""
+	return 0
 }
 
-type GetUserCompaniesResponse struct {
+type CreateLocationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Companies     []*Company             `protobuf:"bytes,1,rep,name=companies,proto3" json:"companies,omitempty"`
+	LocationUuid  string                 `protobuf:"bytes,1,opt,name=location_uuid,json=locationUuid,proto3" json:"location_uuid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserCompaniesResponse) Reset() {
-	*x = GetUserCompaniesResponse{}
-	mi := &file_company_proto_msgTypes[11]
+func (x *CreateLocationResponse) Reset() {
+	*x = CreateLocationResponse{}
+	mi := &file_company_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserCompaniesResponse) String() string {
+func (x *CreateLocationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserCompaniesResponse) ProtoMessage() {}
+func (*CreateLocationResponse) ProtoMessage() {}
 
-func (x *GetUserCompaniesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[11]
+func (x *CreateLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -664,43 +3766,42 @@ func (x *GetUserCompaniesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserCompaniesResponse.ProtoReflect.Descriptor instead.
-func (*GetUserCompaniesResponse) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use CreateLocationResponse.ProtoReflect.Descriptor instead.
+func (*CreateLocationResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetUserCompaniesResponse) GetCompanies() []*Company {
+func (x *CreateLocationResponse) GetLocationUuid() string {
 	if x != nil {
-		return x.Companies
+		return x.LocationUuid
 	}
-	return nil
+	return ""
 }
 
-// UpdateCompanyTitle
-type UpdateCompanyTitleRequest struct {
+// GetLocation
+type GetLocationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	LocationUuid  string                 `protobuf:"bytes,2,opt,name=location_uuid,json=locationUuid,proto3" json:"location_uuid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCompanyTitleRequest) Reset() {
-	*x = UpdateCompanyTitleRequest{}
-	mi := &file_company_proto_msgTypes[12]
+func (x *GetLocationRequest) Reset() {
+	*x = GetLocationRequest{}
+	mi := &file_company_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCompanyTitleRequest) String() string {
+func (x *GetLocationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCompanyTitleRequest) ProtoMessage() {}
+func (*GetLocationRequest) ProtoMessage() {}
 
-func (x *UpdateCompanyTitleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[12]
+func (x *GetLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -711,57 +3812,51 @@ func (x *UpdateCompanyTitleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCompanyTitleRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCompanyTitleRequest) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetLocationRequest.ProtoReflect.Descriptor instead.
+func (*GetLocationRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *UpdateCompanyTitleRequest) GetInitiatorUuid() string {
+func (x *GetLocationRequest) GetInitiatorUuid() string {
 	if x != nil {
 		return x.InitiatorUuid
 	}
 	return ""
 }
 
-func (x *UpdateCompanyTitleRequest) GetCompanyUuid() string {
-	if x != nil {
-		return x.CompanyUuid
-	}
-	return ""
-}
-
-func (x *UpdateCompanyTitleRequest) GetTitle() string {
+func (x *GetLocationRequest) GetLocationUuid() string {
 	if x != nil {
-		return x.Title
+		return x.LocationUuid
 	}
 	return ""
 }
 
-// UpdateCompanyStatus
-type UpdateCompanyStatusRequest struct {
+type GetLocationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	Location      *Location              `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
 	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Path          []*Location            `protobuf:"bytes,5,rep,name=path,proto3" json:"path,omitempty"` // цепочка от объекта (site) до самого места включительно
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCompanyStatusRequest) Reset() {
-	*x = UpdateCompanyStatusRequest{}
-	mi := &file_company_proto_msgTypes[13]
+func (x *GetLocationResponse) Reset() {
+	*x = GetLocationResponse{}
+	mi := &file_company_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCompanyStatusRequest) String() string {
+func (x *GetLocationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCompanyStatusRequest) ProtoMessage() {}
+func (*GetLocationResponse) ProtoMessage() {}
 
-func (x *UpdateCompanyStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[13]
+func (x *GetLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -772,56 +3867,74 @@ func (x *UpdateCompanyStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCompanyStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCompanyStatusRequest) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetLocationResponse.ProtoReflect.Descriptor instead.
+func (*GetLocationResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *UpdateCompanyStatusRequest) GetInitiatorUuid() string {
+func (x *GetLocationResponse) GetLocation() *Location {
 	if x != nil {
-		return x.InitiatorUuid
+		return x.Location
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateCompanyStatusRequest) GetCompanyUuid() string {
+func (x *GetLocationResponse) GetCompanyUuid() string {
 	if x != nil {
 		return x.CompanyUuid
 	}
 	return ""
 }
 
-func (x *UpdateCompanyStatusRequest) GetStatus() string {
+func (x *GetLocationResponse) GetCreatedAt() string {
 	if x != nil {
-		return x.Status
+		return x.CreatedAt
 	}
 	return ""
 }
 
-// DeleteCompany
-type DeleteCompanyRequest struct {
+func (x *GetLocationResponse) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *GetLocationResponse) GetPath() []*Location {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+// GetCompanyLocations
+type GetCompanyLocationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
 	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	ParentUuid    string                 `protobuf:"bytes,3,opt,name=parent_uuid,json=parentUuid,proto3" json:"parent_uuid,omitempty"` // если указано - только вложенные в него места
+	Kind          string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`                               // если указано - только места этого уровня
+	Offset        int64                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	Count         int64                  `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCompanyRequest) Reset() {
-	*x = DeleteCompanyRequest{}
-	mi := &file_company_proto_msgTypes[14]
+func (x *GetCompanyLocationsRequest) Reset() {
+	*x = GetCompanyLocationsRequest{}
+	mi := &file_company_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCompanyRequest) String() string {
+func (x *GetCompanyLocationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCompanyRequest) ProtoMessage() {}
+func (*GetCompanyLocationsRequest) ProtoMessage() {}
 
-func (x *DeleteCompanyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[14]
+func (x *GetCompanyLocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -832,108 +3945,75 @@ func (x *DeleteCompanyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCompanyRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCompanyRequest) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetCompanyLocationsRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyLocationsRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *DeleteCompanyRequest) GetInitiatorUuid() string {
+func (x *GetCompanyLocationsRequest) GetInitiatorUuid() string {
 	if x != nil {
 		return x.InitiatorUuid
 	}
 	return ""
 }
 
-func (x *DeleteCompanyRequest) GetCompanyUuid() string {
+func (x *GetCompanyLocationsRequest) GetCompanyUuid() string {
 	if x != nil {
 		return x.CompanyUuid
 	}
 	return ""
 }
 
-// CreateCompanyJoinCode
-type CreateCompanyJoinCodeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
-	CodeTtl       int64                  `protobuf:"varint,3,opt,name=code_ttl,json=codeTtl,proto3" json:"code_ttl,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateCompanyJoinCodeRequest) Reset() {
-	*x = CreateCompanyJoinCodeRequest{}
-	mi := &file_company_proto_msgTypes[15]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateCompanyJoinCodeRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateCompanyJoinCodeRequest) ProtoMessage() {}
-
-func (x *CreateCompanyJoinCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[15]
+func (x *GetCompanyLocationsRequest) GetParentUuid() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ParentUuid
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateCompanyJoinCodeRequest.ProtoReflect.Descriptor instead.
-func (*CreateCompanyJoinCodeRequest) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{15}
+	return ""
 }
 
-func (x *CreateCompanyJoinCodeRequest) GetInitiatorUuid() string {
+func (x *GetCompanyLocationsRequest) GetKind() string {
 	if x != nil {
-		return x.InitiatorUuid
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *CreateCompanyJoinCodeRequest) GetCompanyUuid() string {
+func (x *GetCompanyLocationsRequest) GetOffset() int64 {
 	if x != nil {
-		return x.CompanyUuid
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateCompanyJoinCodeRequest) GetCodeTtl() int64 {
+func (x *GetCompanyLocationsRequest) GetCount() int64 {
 	if x != nil {
-		return x.CodeTtl
+		return x.Count
 	}
 	return 0
 }
 
-type CreateCompanyJoinCodeResponse struct {
+type GetCompanyLocationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	JoinCode      string                 `protobuf:"bytes,1,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"`
+	Locations     []*Location            `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCompanyJoinCodeResponse) Reset() {
-	*x = CreateCompanyJoinCodeResponse{}
-	mi := &file_company_proto_msgTypes[16]
+func (x *GetCompanyLocationsResponse) Reset() {
+	*x = GetCompanyLocationsResponse{}
+	mi := &file_company_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCompanyJoinCodeResponse) String() string {
+func (x *GetCompanyLocationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCompanyJoinCodeResponse) ProtoMessage() {}
+func (*GetCompanyLocationsResponse) ProtoMessage() {}
 
-func (x *CreateCompanyJoinCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[16]
+func (x *GetCompanyLocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -944,42 +4024,45 @@ func (x *CreateCompanyJoinCodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCompanyJoinCodeResponse.ProtoReflect.Descriptor instead.
-func (*CreateCompanyJoinCodeResponse) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetCompanyLocationsResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanyLocationsResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *CreateCompanyJoinCodeResponse) GetJoinCode() string {
+func (x *GetCompanyLocationsResponse) GetLocations() []*Location {
 	if x != nil {
-		return x.JoinCode
+		return x.Locations
 	}
-	return ""
+	return nil
 }
 
-// GetCompanyJoinCodes
-type GetCompanyJoinCodesRequest struct {
+// UpdateLocation
+type UpdateLocationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	LocationUuid  string                 `protobuf:"bytes,2,opt,name=location_uuid,json=locationUuid,proto3" json:"location_uuid,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Latitude      *float64               `protobuf:"fixed64,4,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"` // координаты заменяются целиком, без них - удаляются
+	Longitude     *float64               `protobuf:"fixed64,5,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCompanyJoinCodesRequest) Reset() {
-	*x = GetCompanyJoinCodesRequest{}
-	mi := &file_company_proto_msgTypes[17]
+func (x *UpdateLocationRequest) Reset() {
+	*x = UpdateLocationRequest{}
+	mi := &file_company_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanyJoinCodesRequest) String() string {
+func (x *UpdateLocationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanyJoinCodesRequest) ProtoMessage() {}
+func (*UpdateLocationRequest) ProtoMessage() {}
 
-func (x *GetCompanyJoinCodesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[17]
+func (x *UpdateLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -990,94 +4073,70 @@ func (x *GetCompanyJoinCodesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanyJoinCodesRequest.ProtoReflect.Descriptor instead.
-func (*GetCompanyJoinCodesRequest) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use UpdateLocationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLocationRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetCompanyJoinCodesRequest) GetInitiatorUuid() string {
+func (x *UpdateLocationRequest) GetInitiatorUuid() string {
 	if x != nil {
 		return x.InitiatorUuid
 	}
 	return ""
 }
 
-func (x *GetCompanyJoinCodesRequest) GetCompanyUuid() string {
+func (x *UpdateLocationRequest) GetLocationUuid() string {
 	if x != nil {
-		return x.CompanyUuid
+		return x.LocationUuid
 	}
 	return ""
 }
 
-type GetCompanyJoinCodesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Codes         []string               `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetCompanyJoinCodesResponse) Reset() {
-	*x = GetCompanyJoinCodesResponse{}
-	mi := &file_company_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetCompanyJoinCodesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetCompanyJoinCodesResponse) ProtoMessage() {}
-
-func (x *GetCompanyJoinCodesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[18]
+func (x *UpdateLocationRequest) GetTitle() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Title
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetCompanyJoinCodesResponse.ProtoReflect.Descriptor instead.
-func (*GetCompanyJoinCodesResponse) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{18}
+func (x *UpdateLocationRequest) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
 }
 
-func (x *GetCompanyJoinCodesResponse) GetCodes() []string {
-	if x != nil {
-		return x.Codes
+func (x *UpdateLocationRequest) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
 	}
-	return nil
+	return 0
 }
 
-// DeleteCompanyJoinCodes
-type DeleteCompanyJoinCodeRequest struct {
+// DeleteLocation
+type DeleteLocationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
-	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	LocationUuid  string                 `protobuf:"bytes,2,opt,name=location_uuid,json=locationUuid,proto3" json:"location_uuid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCompanyJoinCodeRequest) Reset() {
-	*x = DeleteCompanyJoinCodeRequest{}
-	mi := &file_company_proto_msgTypes[19]
+func (x *DeleteLocationRequest) Reset() {
+	*x = DeleteLocationRequest{}
+	mi := &file_company_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCompanyJoinCodeRequest) String() string {
+func (x *DeleteLocationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCompanyJoinCodeRequest) ProtoMessage() {}
+func (*DeleteLocationRequest) ProtoMessage() {}
 
-func (x *DeleteCompanyJoinCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[19]
+func (x *DeleteLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1088,56 +4147,50 @@ func (x *DeleteCompanyJoinCodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCompanyJoinCodeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCompanyJoinCodeRequest) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use DeleteLocationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLocationRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *DeleteCompanyJoinCodeRequest) GetInitiatorUuid() string {
+func (x *DeleteLocationRequest) GetInitiatorUuid() string {
 	if x != nil {
 		return x.InitiatorUuid
 	}
 	return ""
 }
 
-func (x *DeleteCompanyJoinCodeRequest) GetCompanyUuid() string {
-	if x != nil {
-		return x.CompanyUuid
-	}
-	return ""
-}
-
-func (x *DeleteCompanyJoinCodeRequest) GetCode() string {
+func (x *DeleteLocationRequest) GetLocationUuid() string {
 	if x != nil {
-		return x.Code
+		return x.LocationUuid
 	}
 	return ""
 }
 
-// JoinCompany
-type JoinCompanyRequest struct {
+// GetCompanyJoinRequests
+type GetCompanyJoinRequestsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	JoinCode      string                 `protobuf:"bytes,2,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // если указано - только заявки с этим статусом
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JoinCompanyRequest) Reset() {
-	*x = JoinCompanyRequest{}
-	mi := &file_company_proto_msgTypes[20]
+func (x *GetCompanyJoinRequestsRequest) Reset() {
+	*x = GetCompanyJoinRequestsRequest{}
+	mi := &file_company_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JoinCompanyRequest) String() string {
+func (x *GetCompanyJoinRequestsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinCompanyRequest) ProtoMessage() {}
+func (*GetCompanyJoinRequestsRequest) ProtoMessage() {}
 
-func (x *JoinCompanyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[20]
+func (x *GetCompanyJoinRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1148,48 +4201,54 @@ func (x *JoinCompanyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinCompanyRequest.ProtoReflect.Descriptor instead.
-func (*JoinCompanyRequest) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetCompanyJoinRequestsRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyJoinRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *JoinCompanyRequest) GetInitiatorUuid() string {
+func (x *GetCompanyJoinRequestsRequest) GetInitiatorUuid() string {
 	if x != nil {
 		return x.InitiatorUuid
 	}
 	return ""
 }
 
-func (x *JoinCompanyRequest) GetJoinCode() string {
+func (x *GetCompanyJoinRequestsRequest) GetCompanyUuid() string {
 	if x != nil {
-		return x.JoinCode
+		return x.CompanyUuid
 	}
 	return ""
 }
 
-type JoinCompanyResponse struct {
+func (x *GetCompanyJoinRequestsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetCompanyJoinRequestsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CompanyUuid   string                 `protobuf:"bytes,1,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
-	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	JoinRequests  []*JoinRequest         `protobuf:"bytes,1,rep,name=join_requests,json=joinRequests,proto3" json:"join_requests,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JoinCompanyResponse) Reset() {
-	*x = JoinCompanyResponse{}
-	mi := &file_company_proto_msgTypes[21]
+func (x *GetCompanyJoinRequestsResponse) Reset() {
+	*x = GetCompanyJoinRequestsResponse{}
+	mi := &file_company_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JoinCompanyResponse) String() string {
+func (x *GetCompanyJoinRequestsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinCompanyResponse) ProtoMessage() {}
+func (*GetCompanyJoinRequestsResponse) ProtoMessage() {}
 
-func (x *JoinCompanyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[21]
+func (x *GetCompanyJoinRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1200,50 +4259,43 @@ func (x *JoinCompanyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinCompanyResponse.ProtoReflect.Descriptor instead.
-func (*JoinCompanyResponse) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *JoinCompanyResponse) GetCompanyUuid() string {
-	if x != nil {
-		return x.CompanyUuid
-	}
-	return ""
+// Deprecated: Use GetCompanyJoinRequestsResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanyJoinRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *JoinCompanyResponse) GetRole() string {
+func (x *GetCompanyJoinRequestsResponse) GetJoinRequests() []*JoinRequest {
 	if x != nil {
-		return x.Role
+		return x.JoinRequests
 	}
-	return ""
+	return nil
 }
 
-// GetCompanyEmployee
-type GetCompanyEmployeeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	TargetUuid    string                 `protobuf:"bytes,2,opt,name=target_uuid,json=targetUuid,proto3" json:"target_uuid,omitempty"`
-	CompanyUuid   string                 `protobuf:"bytes,3,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// AcceptCompanyJoinRequest
+type AcceptCompanyJoinRequestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid     string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	JoinRequestUuid string                 `protobuf:"bytes,3,opt,name=join_request_uuid,json=joinRequestUuid,proto3" json:"join_request_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetCompanyEmployeeRequest) Reset() {
-	*x = GetCompanyEmployeeRequest{}
-	mi := &file_company_proto_msgTypes[22]
+func (x *AcceptCompanyJoinRequestRequest) Reset() {
+	*x = AcceptCompanyJoinRequestRequest{}
+	mi := &file_company_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanyEmployeeRequest) String() string {
+func (x *AcceptCompanyJoinRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanyEmployeeRequest) ProtoMessage() {}
+func (*AcceptCompanyJoinRequestRequest) ProtoMessage() {}
 
-func (x *GetCompanyEmployeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[22]
+func (x *AcceptCompanyJoinRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1254,56 +4306,57 @@ func (x *GetCompanyEmployeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanyEmployeeRequest.ProtoReflect.Descriptor instead.
-func (*GetCompanyEmployeeRequest) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use AcceptCompanyJoinRequestRequest.ProtoReflect.Descriptor instead.
+func (*AcceptCompanyJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *GetCompanyEmployeeRequest) GetInitiatorUuid() string {
+func (x *AcceptCompanyJoinRequestRequest) GetInitiatorUuid() string {
 	if x != nil {
 		return x.InitiatorUuid
 	}
 	return ""
 }
 
-func (x *GetCompanyEmployeeRequest) GetTargetUuid() string {
+func (x *AcceptCompanyJoinRequestRequest) GetCompanyUuid() string {
 	if x != nil {
-		return x.TargetUuid
+		return x.CompanyUuid
 	}
 	return ""
 }
 
-func (x *GetCompanyEmployeeRequest) GetCompanyUuid() string {
+func (x *AcceptCompanyJoinRequestRequest) GetJoinRequestUuid() string {
 	if x != nil {
-		return x.CompanyUuid
+		return x.JoinRequestUuid
 	}
 	return ""
 }
 
-type GetCompanyEmployeeResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Role           string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
-	DepartmentUuid string                 `protobuf:"bytes,2,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
-	JoinedAt       string                 `protobuf:"bytes,3,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// DeclineCompanyJoinRequest
+type DeclineCompanyJoinRequestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid   string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid     string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	JoinRequestUuid string                 `protobuf:"bytes,3,opt,name=join_request_uuid,json=joinRequestUuid,proto3" json:"join_request_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetCompanyEmployeeResponse) Reset() {
-	*x = GetCompanyEmployeeResponse{}
-	mi := &file_company_proto_msgTypes[23]
+func (x *DeclineCompanyJoinRequestRequest) Reset() {
+	*x = DeclineCompanyJoinRequestRequest{}
+	mi := &file_company_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanyEmployeeResponse) String() string {
+func (x *DeclineCompanyJoinRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanyEmployeeResponse) ProtoMessage() {}
+func (*DeclineCompanyJoinRequestRequest) ProtoMessage() {}
 
-func (x *GetCompanyEmployeeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[23]
+func (x *DeclineCompanyJoinRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1314,60 +4367,60 @@ func (x *GetCompanyEmployeeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanyEmployeeResponse.ProtoReflect.Descriptor instead.
-func (*GetCompanyEmployeeResponse) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use DeclineCompanyJoinRequestRequest.ProtoReflect.Descriptor instead.
+func (*DeclineCompanyJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *GetCompanyEmployeeResponse) GetRole() string {
+func (x *DeclineCompanyJoinRequestRequest) GetInitiatorUuid() string {
 	if x != nil {
-		return x.Role
+		return x.InitiatorUuid
 	}
 	return ""
 }
 
-func (x *GetCompanyEmployeeResponse) GetDepartmentUuid() string {
+func (x *DeclineCompanyJoinRequestRequest) GetCompanyUuid() string {
 	if x != nil {
-		return x.DepartmentUuid
+		return x.CompanyUuid
 	}
 	return ""
 }
 
-func (x *GetCompanyEmployeeResponse) GetJoinedAt() string {
+func (x *DeclineCompanyJoinRequestRequest) GetJoinRequestUuid() string {
 	if x != nil {
-		return x.JoinedAt
+		return x.JoinRequestUuid
 	}
 	return ""
 }
 
-// GetCompanyEmployees
-type GetCompanyEmployeesRequest struct {
+// CreateCompanyInvitation
+type CreateCompanyInvitationRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	InitiatorUuid  string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
 	CompanyUuid    string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
-	Role           string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
-	DepartmentUuid string                 `protobuf:"bytes,4,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
-	Count          int64                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
-	Offset         int64                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	Email          string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role           string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`                                           // роль после принятия приглашения
+	DepartmentUuid string                 `protobuf:"bytes,5,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"` // необязательно
+	Ttl            int64                  `protobuf:"varint,6,opt,name=ttl,proto3" json:"ttl,omitempty"`                                            // время жизни приглашения в секундах
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetCompanyEmployeesRequest) Reset() {
-	*x = GetCompanyEmployeesRequest{}
-	mi := &file_company_proto_msgTypes[24]
+func (x *CreateCompanyInvitationRequest) Reset() {
+	*x = CreateCompanyInvitationRequest{}
+	mi := &file_company_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanyEmployeesRequest) String() string {
+func (x *CreateCompanyInvitationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanyEmployeesRequest) ProtoMessage() {}
+func (*CreateCompanyInvitationRequest) ProtoMessage() {}
 
-func (x *GetCompanyEmployeesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[24]
+func (x *CreateCompanyInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1378,75 +4431,75 @@ func (x *GetCompanyEmployeesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanyEmployeesRequest.ProtoReflect.Descriptor instead.
-func (*GetCompanyEmployeesRequest) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use CreateCompanyInvitationRequest.ProtoReflect.Descriptor instead.
+func (*CreateCompanyInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *GetCompanyEmployeesRequest) GetInitiatorUuid() string {
+func (x *CreateCompanyInvitationRequest) GetInitiatorUuid() string {
 	if x != nil {
 		return x.InitiatorUuid
 	}
 	return ""
 }
 
-func (x *GetCompanyEmployeesRequest) GetCompanyUuid() string {
+func (x *CreateCompanyInvitationRequest) GetCompanyUuid() string {
 	if x != nil {
 		return x.CompanyUuid
 	}
 	return ""
 }
 
-func (x *GetCompanyEmployeesRequest) GetRole() string {
+func (x *CreateCompanyInvitationRequest) GetEmail() string {
 	if x != nil {
-		return x.Role
+		return x.Email
 	}
 	return ""
 }
 
-func (x *GetCompanyEmployeesRequest) GetDepartmentUuid() string {
+func (x *CreateCompanyInvitationRequest) GetRole() string {
 	if x != nil {
-		return x.DepartmentUuid
+		return x.Role
 	}
 	return ""
 }
 
-func (x *GetCompanyEmployeesRequest) GetCount() int64 {
+func (x *CreateCompanyInvitationRequest) GetDepartmentUuid() string {
 	if x != nil {
-		return x.Count
+		return x.DepartmentUuid
 	}
-	return 0
+	return ""
 }
 
-func (x *GetCompanyEmployeesRequest) GetOffset() int64 {
+func (x *CreateCompanyInvitationRequest) GetTtl() int64 {
 	if x != nil {
-		return x.Offset
+		return x.Ttl
 	}
 	return 0
 }
 
-type GetCompanyEmployeesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Employees     []*Employee            `protobuf:"bytes,1,rep,name=employees,proto3" json:"employees,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CreateCompanyInvitationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InvitationUuid string                 `protobuf:"bytes,1,opt,name=invitation_uuid,json=invitationUuid,proto3" json:"invitation_uuid,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetCompanyEmployeesResponse) Reset() {
-	*x = GetCompanyEmployeesResponse{}
-	mi := &file_company_proto_msgTypes[25]
+func (x *CreateCompanyInvitationResponse) Reset() {
+	*x = CreateCompanyInvitationResponse{}
+	mi := &file_company_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanyEmployeesResponse) String() string {
+func (x *CreateCompanyInvitationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanyEmployeesResponse) ProtoMessage() {}
+func (*CreateCompanyInvitationResponse) ProtoMessage() {}
 
-func (x *GetCompanyEmployeesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[25]
+func (x *CreateCompanyInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1457,43 +4510,43 @@ func (x *GetCompanyEmployeesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanyEmployeesResponse.ProtoReflect.Descriptor instead.
-func (*GetCompanyEmployeesResponse) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use CreateCompanyInvitationResponse.ProtoReflect.Descriptor instead.
+func (*CreateCompanyInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *GetCompanyEmployeesResponse) GetEmployees() []*Employee {
+func (x *CreateCompanyInvitationResponse) GetInvitationUuid() string {
 	if x != nil {
-		return x.Employees
+		return x.InvitationUuid
 	}
-	return nil
+	return ""
 }
 
-// GetCompanyEmployeesSummary
-type GetCompanyEmployeesSummaryRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid  string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	CompanyUuid    string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
-	DepartmentUuid string                 `protobuf:"bytes,3,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// GetCompanyInvitations
+type GetCompanyInvitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // если указано - только приглашения с этим статусом
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCompanyEmployeesSummaryRequest) Reset() {
-	*x = GetCompanyEmployeesSummaryRequest{}
-	mi := &file_company_proto_msgTypes[26]
+func (x *GetCompanyInvitationsRequest) Reset() {
+	*x = GetCompanyInvitationsRequest{}
+	mi := &file_company_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanyEmployeesSummaryRequest) String() string {
+func (x *GetCompanyInvitationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanyEmployeesSummaryRequest) ProtoMessage() {}
+func (*GetCompanyInvitationsRequest) ProtoMessage() {}
 
-func (x *GetCompanyEmployeesSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[26]
+func (x *GetCompanyInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1504,59 +4557,54 @@ func (x *GetCompanyEmployeesSummaryRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanyEmployeesSummaryRequest.ProtoReflect.Descriptor instead.
-func (*GetCompanyEmployeesSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use GetCompanyInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *GetCompanyEmployeesSummaryRequest) GetInitiatorUuid() string {
+func (x *GetCompanyInvitationsRequest) GetInitiatorUuid() string {
 	if x != nil {
 		return x.InitiatorUuid
 	}
 	return ""
 }
 
-func (x *GetCompanyEmployeesSummaryRequest) GetCompanyUuid() string {
+func (x *GetCompanyInvitationsRequest) GetCompanyUuid() string {
 	if x != nil {
 		return x.CompanyUuid
 	}
 	return ""
 }
 
-func (x *GetCompanyEmployeesSummaryRequest) GetDepartmentUuid() string {
+func (x *GetCompanyInvitationsRequest) GetStatus() string {
 	if x != nil {
-		return x.DepartmentUuid
+		return x.Status
 	}
 	return ""
 }
 
-type GetCompanyEmployeesSummaryResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ChiefCount      int64                  `protobuf:"varint,1,opt,name=chief_count,json=chiefCount,proto3" json:"chief_count,omitempty"`
-	AnalyticsCount  int64                  `protobuf:"varint,2,opt,name=analytics_count,json=analyticsCount,proto3" json:"analytics_count,omitempty"`
-	ManagerCount    int64                  `protobuf:"varint,3,opt,name=manager_count,json=managerCount,proto3" json:"manager_count,omitempty"`
-	EngineerCount   int64                  `protobuf:"varint,4,opt,name=engineer_count,json=engineerCount,proto3" json:"engineer_count,omitempty"`
-	InspectorCount  int64                  `protobuf:"varint,5,opt,name=inspector_count,json=inspectorCount,proto3" json:"inspector_count,omitempty"`
-	UnemployedCount int64                  `protobuf:"varint,6,opt,name=unemployed_count,json=unemployedCount,proto3" json:"unemployed_count,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetCompanyInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*Invitation          `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCompanyEmployeesSummaryResponse) Reset() {
-	*x = GetCompanyEmployeesSummaryResponse{}
-	mi := &file_company_proto_msgTypes[27]
+func (x *GetCompanyInvitationsResponse) Reset() {
+	*x = GetCompanyInvitationsResponse{}
+	mi := &file_company_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanyEmployeesSummaryResponse) String() string {
+func (x *GetCompanyInvitationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanyEmployeesSummaryResponse) ProtoMessage() {}
+func (*GetCompanyInvitationsResponse) ProtoMessage() {}
 
-func (x *GetCompanyEmployeesSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[27]
+func (x *GetCompanyInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1567,79 +4615,43 @@ func (x *GetCompanyEmployeesSummaryResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanyEmployeesSummaryResponse.ProtoReflect.Descriptor instead.
-func (*GetCompanyEmployeesSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *GetCompanyEmployeesSummaryResponse) GetChiefCount() int64 {
-	if x != nil {
-		return x.ChiefCount
-	}
-	return 0
-}
-
-func (x *GetCompanyEmployeesSummaryResponse) GetAnalyticsCount() int64 {
-	if x != nil {
-		return x.AnalyticsCount
-	}
-	return 0
-}
-
-func (x *GetCompanyEmployeesSummaryResponse) GetManagerCount() int64 {
-	if x != nil {
-		return x.ManagerCount
-	}
-	return 0
-}
-
-func (x *GetCompanyEmployeesSummaryResponse) GetEngineerCount() int64 {
-	if x != nil {
-		return x.EngineerCount
-	}
-	return 0
-}
-
-func (x *GetCompanyEmployeesSummaryResponse) GetInspectorCount() int64 {
-	if x != nil {
-		return x.InspectorCount
-	}
-	return 0
+// Deprecated: Use GetCompanyInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanyInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *GetCompanyEmployeesSummaryResponse) GetUnemployedCount() int64 {
+func (x *GetCompanyInvitationsResponse) GetInvitations() []*Invitation {
 	if x != nil {
-		return x.UnemployedCount
+		return x.Invitations
 	}
-	return 0
+	return nil
 }
-
-// UpdateEmployeeRole
-type UpdateEmployeeRoleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	TargetUuid    string                 `protobuf:"bytes,2,opt,name=target_uuid,json=targetUuid,proto3" json:"target_uuid,omitempty"`
-	CompanyUuid   string                 `protobuf:"bytes,3,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
-	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+
+// RevokeCompanyInvitation
+type RevokeCompanyInvitationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid  string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid    string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	InvitationUuid string                 `protobuf:"bytes,3,opt,name=invitation_uuid,json=invitationUuid,proto3" json:"invitation_uuid,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateEmployeeRoleRequest) Reset() {
-	*x = UpdateEmployeeRoleRequest{}
-	mi := &file_company_proto_msgTypes[28]
+func (x *RevokeCompanyInvitationRequest) Reset() {
+	*x = RevokeCompanyInvitationRequest{}
+	mi := &file_company_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEmployeeRoleRequest) String() string {
+func (x *RevokeCompanyInvitationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEmployeeRoleRequest) ProtoMessage() {}
+func (*RevokeCompanyInvitationRequest) ProtoMessage() {}
 
-func (x *UpdateEmployeeRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[28]
+func (x *RevokeCompanyInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1650,64 +4662,56 @@ func (x *UpdateEmployeeRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEmployeeRoleRequest.ProtoReflect.Descriptor instead.
-func (*UpdateEmployeeRoleRequest) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use RevokeCompanyInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeCompanyInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *UpdateEmployeeRoleRequest) GetInitiatorUuid() string {
+func (x *RevokeCompanyInvitationRequest) GetInitiatorUuid() string {
 	if x != nil {
 		return x.InitiatorUuid
 	}
 	return ""
 }
 
-func (x *UpdateEmployeeRoleRequest) GetTargetUuid() string {
-	if x != nil {
-		return x.TargetUuid
-	}
-	return ""
-}
-
-func (x *UpdateEmployeeRoleRequest) GetCompanyUuid() string {
+func (x *RevokeCompanyInvitationRequest) GetCompanyUuid() string {
 	if x != nil {
 		return x.CompanyUuid
 	}
 	return ""
 }
 
-func (x *UpdateEmployeeRoleRequest) GetRole() string {
+func (x *RevokeCompanyInvitationRequest) GetInvitationUuid() string {
 	if x != nil {
-		return x.Role
+		return x.InvitationUuid
 	}
 	return ""
 }
 
-// RemoveCompanyEmployee
-type RemoveCompanyEmployeeRequest struct {
+// GetUserInvitations
+type GetUserInvitationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
-	TargetUuid    string                 `protobuf:"bytes,3,opt,name=target_uuid,json=targetUuid,proto3" json:"target_uuid,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"` // email инициатора (gateway берёт его из auth сервиса)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveCompanyEmployeeRequest) Reset() {
-	*x = RemoveCompanyEmployeeRequest{}
-	mi := &file_company_proto_msgTypes[29]
+func (x *GetUserInvitationsRequest) Reset() {
+	*x = GetUserInvitationsRequest{}
+	mi := &file_company_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveCompanyEmployeeRequest) String() string {
+func (x *GetUserInvitationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveCompanyEmployeeRequest) ProtoMessage() {}
+func (*GetUserInvitationsRequest) ProtoMessage() {}
 
-func (x *RemoveCompanyEmployeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[29]
+func (x *GetUserInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1718,57 +4722,47 @@ func (x *RemoveCompanyEmployeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveCompanyEmployeeRequest.ProtoReflect.Descriptor instead.
-func (*RemoveCompanyEmployeeRequest) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use GetUserInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *RemoveCompanyEmployeeRequest) GetInitiatorUuid() string {
+func (x *GetUserInvitationsRequest) GetInitiatorUuid() string {
 	if x != nil {
 		return x.InitiatorUuid
 	}
 	return ""
 }
 
-func (x *RemoveCompanyEmployeeRequest) GetCompanyUuid() string {
-	if x != nil {
-		return x.CompanyUuid
-	}
-	return ""
-}
-
-func (x *RemoveCompanyEmployeeRequest) GetTargetUuid() string {
+func (x *GetUserInvitationsRequest) GetEmail() string {
 	if x != nil {
-		return x.TargetUuid
+		return x.Email
 	}
 	return ""
 }
 
-// CreateDepartment
-type CreateDepartmentRequest struct {
+type GetUserInvitationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Invitations   []*Invitation          `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"` // действующие приглашения
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateDepartmentRequest) Reset() {
-	*x = CreateDepartmentRequest{}
-	mi := &file_company_proto_msgTypes[30]
+func (x *GetUserInvitationsResponse) Reset() {
+	*x = GetUserInvitationsResponse{}
+	mi := &file_company_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDepartmentRequest) String() string {
+func (x *GetUserInvitationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDepartmentRequest) ProtoMessage() {}
+func (*GetUserInvitationsResponse) ProtoMessage() {}
 
-func (x *CreateDepartmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[30]
+func (x *GetUserInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1779,54 +4773,43 @@ func (x *CreateDepartmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDepartmentRequest.ProtoReflect.Descriptor instead.
-func (*CreateDepartmentRequest) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *CreateDepartmentRequest) GetInitiatorUuid() string {
-	if x != nil {
-		return x.InitiatorUuid
-	}
-	return ""
-}
-
-func (x *CreateDepartmentRequest) GetCompanyUuid() string {
-	if x != nil {
-		return x.CompanyUuid
-	}
-	return ""
+// Deprecated: Use GetUserInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *CreateDepartmentRequest) GetTitle() string {
+func (x *GetUserInvitationsResponse) GetInvitations() []*Invitation {
 	if x != nil {
-		return x.Title
+		return x.Invitations
 	}
-	return ""
+	return nil
 }
 
-type CreateDepartmentResponse struct {
+// AcceptCompanyInvitation
+type AcceptCompanyInvitationRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	DepartmentUuid string                 `protobuf:"bytes,1,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
+	InitiatorUuid  string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	Email          string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"` // email инициатора, должен совпадать с адресом приглашения
+	InvitationUuid string                 `protobuf:"bytes,3,opt,name=invitation_uuid,json=invitationUuid,proto3" json:"invitation_uuid,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateDepartmentResponse) Reset() {
-	*x = CreateDepartmentResponse{}
-	mi := &file_company_proto_msgTypes[31]
+func (x *AcceptCompanyInvitationRequest) Reset() {
+	*x = AcceptCompanyInvitationRequest{}
+	mi := &file_company_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDepartmentResponse) String() string {
+func (x *AcceptCompanyInvitationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDepartmentResponse) ProtoMessage() {}
+func (*AcceptCompanyInvitationRequest) ProtoMessage() {}
 
-func (x *CreateDepartmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[31]
+func (x *AcceptCompanyInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1837,43 +4820,55 @@ func (x *CreateDepartmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDepartmentResponse.ProtoReflect.Descriptor instead.
-func (*CreateDepartmentResponse) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use AcceptCompanyInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptCompanyInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *CreateDepartmentResponse) GetDepartmentUuid() string {
+func (x *AcceptCompanyInvitationRequest) GetInitiatorUuid() string {
 	if x != nil {
-		return x.DepartmentUuid
+		return x.InitiatorUuid
 	}
 	return ""
 }
 
-// AddEmployeeToDepartment
-type AddEmployeeToDepartmentRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid  string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	DepartmentUuid string                 `protobuf:"bytes,2,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
-	TargetUuid     string                 `protobuf:"bytes,3,opt,name=target_uuid,json=targetUuid,proto3" json:"target_uuid,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *AcceptCompanyInvitationRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
 }
 
-func (x *AddEmployeeToDepartmentRequest) Reset() {
-	*x = AddEmployeeToDepartmentRequest{}
-	mi := &file_company_proto_msgTypes[32]
+func (x *AcceptCompanyInvitationRequest) GetInvitationUuid() string {
+	if x != nil {
+		return x.InvitationUuid
+	}
+	return ""
+}
+
+type AcceptCompanyInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyUuid   string                 `protobuf:"bytes,1,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptCompanyInvitationResponse) Reset() {
+	*x = AcceptCompanyInvitationResponse{}
+	mi := &file_company_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddEmployeeToDepartmentRequest) String() string {
+func (x *AcceptCompanyInvitationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddEmployeeToDepartmentRequest) ProtoMessage() {}
+func (*AcceptCompanyInvitationResponse) ProtoMessage() {}
 
-func (x *AddEmployeeToDepartmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[32]
+func (x *AcceptCompanyInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1884,56 +4879,50 @@ func (x *AddEmployeeToDepartmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddEmployeeToDepartmentRequest.ProtoReflect.Descriptor instead.
-func (*AddEmployeeToDepartmentRequest) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *AddEmployeeToDepartmentRequest) GetInitiatorUuid() string {
-	if x != nil {
-		return x.InitiatorUuid
-	}
-	return ""
+// Deprecated: Use AcceptCompanyInvitationResponse.ProtoReflect.Descriptor instead.
+func (*AcceptCompanyInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *AddEmployeeToDepartmentRequest) GetDepartmentUuid() string {
+func (x *AcceptCompanyInvitationResponse) GetCompanyUuid() string {
 	if x != nil {
-		return x.DepartmentUuid
+		return x.CompanyUuid
 	}
 	return ""
 }
 
-func (x *AddEmployeeToDepartmentRequest) GetTargetUuid() string {
+func (x *AcceptCompanyInvitationResponse) GetRole() string {
 	if x != nil {
-		return x.TargetUuid
+		return x.Role
 	}
 	return ""
 }
 
-// GetDepartment
-type GetDepartmentRequest struct {
+// DeclineCompanyInvitation
+type DeclineCompanyInvitationRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	InitiatorUuid  string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	DepartmentUuid string                 `protobuf:"bytes,2,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
+	Email          string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	InvitationUuid string                 `protobuf:"bytes,3,opt,name=invitation_uuid,json=invitationUuid,proto3" json:"invitation_uuid,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetDepartmentRequest) Reset() {
-	*x = GetDepartmentRequest{}
-	mi := &file_company_proto_msgTypes[33]
+func (x *DeclineCompanyInvitationRequest) Reset() {
+	*x = DeclineCompanyInvitationRequest{}
+	mi := &file_company_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDepartmentRequest) String() string {
+func (x *DeclineCompanyInvitationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDepartmentRequest) ProtoMessage() {}
+func (*DeclineCompanyInvitationRequest) ProtoMessage() {}
 
-func (x *GetDepartmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[33]
+func (x *DeclineCompanyInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1944,51 +4933,57 @@ func (x *GetDepartmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDepartmentRequest.ProtoReflect.Descriptor instead.
-func (*GetDepartmentRequest) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use DeclineCompanyInvitationRequest.ProtoReflect.Descriptor instead.
+func (*DeclineCompanyInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *GetDepartmentRequest) GetInitiatorUuid() string {
+func (x *DeclineCompanyInvitationRequest) GetInitiatorUuid() string {
 	if x != nil {
 		return x.InitiatorUuid
 	}
 	return ""
 }
 
-func (x *GetDepartmentRequest) GetDepartmentUuid() string {
+func (x *DeclineCompanyInvitationRequest) GetEmail() string {
 	if x != nil {
-		return x.DepartmentUuid
+		return x.Email
 	}
 	return ""
 }
 
-type GetDepartmentResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	DepartmentUuid string                 `protobuf:"bytes,1,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
-	CompanyUuid    string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
-	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	CreatedAt      string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	CreatedBy      string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *DeclineCompanyInvitationRequest) GetInvitationUuid() string {
+	if x != nil {
+		return x.InvitationUuid
+	}
+	return ""
 }
 
-func (x *GetDepartmentResponse) Reset() {
-	*x = GetDepartmentResponse{}
-	mi := &file_company_proto_msgTypes[34]
+// TransferCompanyOwnership
+type TransferCompanyOwnershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	TargetUuid    string                 `protobuf:"bytes,3,opt,name=target_uuid,json=targetUuid,proto3" json:"target_uuid,omitempty"` // сотрудник компании, которому передаётся владение
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferCompanyOwnershipRequest) Reset() {
+	*x = TransferCompanyOwnershipRequest{}
+	mi := &file_company_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDepartmentResponse) String() string {
+func (x *TransferCompanyOwnershipRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDepartmentResponse) ProtoMessage() {}
+func (*TransferCompanyOwnershipRequest) ProtoMessage() {}
 
-func (x *GetDepartmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[34]
+func (x *TransferCompanyOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1999,72 +4994,108 @@ func (x *GetDepartmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDepartmentResponse.ProtoReflect.Descriptor instead.
-func (*GetDepartmentResponse) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use TransferCompanyOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferCompanyOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *GetDepartmentResponse) GetDepartmentUuid() string {
+func (x *TransferCompanyOwnershipRequest) GetInitiatorUuid() string {
 	if x != nil {
-		return x.DepartmentUuid
+		return x.InitiatorUuid
 	}
 	return ""
 }
 
-func (x *GetDepartmentResponse) GetCompanyUuid() string {
+func (x *TransferCompanyOwnershipRequest) GetCompanyUuid() string {
 	if x != nil {
 		return x.CompanyUuid
 	}
 	return ""
 }
 
-func (x *GetDepartmentResponse) GetTitle() string {
+func (x *TransferCompanyOwnershipRequest) GetTargetUuid() string {
 	if x != nil {
-		return x.Title
+		return x.TargetUuid
 	}
 	return ""
 }
 
-func (x *GetDepartmentResponse) GetCreatedAt() string {
+type TransferCompanyOwnershipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferUuid  string                 `protobuf:"bytes,1,opt,name=transfer_uuid,json=transferUuid,proto3" json:"transfer_uuid,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferCompanyOwnershipResponse) Reset() {
+	*x = TransferCompanyOwnershipResponse{}
+	mi := &file_company_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferCompanyOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferCompanyOwnershipResponse) ProtoMessage() {}
+
+func (x *TransferCompanyOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[79]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferCompanyOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferCompanyOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *TransferCompanyOwnershipResponse) GetTransferUuid() string {
+	if x != nil {
+		return x.TransferUuid
 	}
 	return ""
 }
 
-func (x *GetDepartmentResponse) GetCreatedBy() string {
+func (x *TransferCompanyOwnershipResponse) GetExpiresAt() string {
 	if x != nil {
-		return x.CreatedBy
+		return x.ExpiresAt
 	}
 	return ""
 }
 
-// GetCompanyDepartments
-type GetCompanyDepartmentsRequest struct {
+// GetCompanyOwnershipTransfer
+type GetCompanyOwnershipTransferRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
 	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
-	Offset        int64                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	Count         int64                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCompanyDepartmentsRequest) Reset() {
-	*x = GetCompanyDepartmentsRequest{}
-	mi := &file_company_proto_msgTypes[35]
+func (x *GetCompanyOwnershipTransferRequest) Reset() {
+	*x = GetCompanyOwnershipTransferRequest{}
+	mi := &file_company_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanyDepartmentsRequest) String() string {
+func (x *GetCompanyOwnershipTransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanyDepartmentsRequest) ProtoMessage() {}
+func (*GetCompanyOwnershipTransferRequest) ProtoMessage() {}
 
-func (x *GetCompanyDepartmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[35]
+func (x *GetCompanyOwnershipTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2075,61 +5106,47 @@ func (x *GetCompanyDepartmentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanyDepartmentsRequest.ProtoReflect.Descriptor instead.
-func (*GetCompanyDepartmentsRequest) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use GetCompanyOwnershipTransferRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyOwnershipTransferRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *GetCompanyDepartmentsRequest) GetInitiatorUuid() string {
+func (x *GetCompanyOwnershipTransferRequest) GetInitiatorUuid() string {
 	if x != nil {
 		return x.InitiatorUuid
 	}
-	return ""
-}
-
-func (x *GetCompanyDepartmentsRequest) GetCompanyUuid() string {
-	if x != nil {
-		return x.CompanyUuid
-	}
-	return ""
-}
-
-func (x *GetCompanyDepartmentsRequest) GetOffset() int64 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
+	return ""
 }
 
-func (x *GetCompanyDepartmentsRequest) GetCount() int64 {
+func (x *GetCompanyOwnershipTransferRequest) GetCompanyUuid() string {
 	if x != nil {
-		return x.Count
+		return x.CompanyUuid
 	}
-	return 0
+	return ""
 }
 
-type GetCompanyDepartmentsResponse struct {
+type GetCompanyOwnershipTransferResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Departments   []*Department          `protobuf:"bytes,1,rep,name=departments,proto3" json:"departments,omitempty"`
+	Transfer      *OwnershipTransfer     `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"` // ожидающая передача владения
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCompanyDepartmentsResponse) Reset() {
-	*x = GetCompanyDepartmentsResponse{}
-	mi := &file_company_proto_msgTypes[36]
+func (x *GetCompanyOwnershipTransferResponse) Reset() {
+	*x = GetCompanyOwnershipTransferResponse{}
+	mi := &file_company_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanyDepartmentsResponse) String() string {
+func (x *GetCompanyOwnershipTransferResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanyDepartmentsResponse) ProtoMessage() {}
+func (*GetCompanyOwnershipTransferResponse) ProtoMessage() {}
 
-func (x *GetCompanyDepartmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[36]
+func (x *GetCompanyOwnershipTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2140,43 +5157,42 @@ func (x *GetCompanyDepartmentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanyDepartmentsResponse.ProtoReflect.Descriptor instead.
-func (*GetCompanyDepartmentsResponse) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use GetCompanyOwnershipTransferResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanyOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *GetCompanyDepartmentsResponse) GetDepartments() []*Department {
+func (x *GetCompanyOwnershipTransferResponse) GetTransfer() *OwnershipTransfer {
 	if x != nil {
-		return x.Departments
+		return x.Transfer
 	}
 	return nil
 }
 
-// UpdateDepartmentTitle
-type UpdateDepartmentTitleRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid  string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	DepartmentUuid string                 `protobuf:"bytes,2,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
-	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// AcceptCompanyOwnershipTransfer
+type AcceptCompanyOwnershipTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateDepartmentTitleRequest) Reset() {
-	*x = UpdateDepartmentTitleRequest{}
-	mi := &file_company_proto_msgTypes[37]
+func (x *AcceptCompanyOwnershipTransferRequest) Reset() {
+	*x = AcceptCompanyOwnershipTransferRequest{}
+	mi := &file_company_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDepartmentTitleRequest) String() string {
+func (x *AcceptCompanyOwnershipTransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDepartmentTitleRequest) ProtoMessage() {}
+func (*AcceptCompanyOwnershipTransferRequest) ProtoMessage() {}
 
-func (x *UpdateDepartmentTitleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[37]
+func (x *AcceptCompanyOwnershipTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2187,56 +5203,49 @@ func (x *UpdateDepartmentTitleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDepartmentTitleRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDepartmentTitleRequest) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use AcceptCompanyOwnershipTransferRequest.ProtoReflect.Descriptor instead.
+func (*AcceptCompanyOwnershipTransferRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *UpdateDepartmentTitleRequest) GetInitiatorUuid() string {
+func (x *AcceptCompanyOwnershipTransferRequest) GetInitiatorUuid() string {
 	if x != nil {
 		return x.InitiatorUuid
 	}
 	return ""
 }
 
-func (x *UpdateDepartmentTitleRequest) GetDepartmentUuid() string {
-	if x != nil {
-		return x.DepartmentUuid
-	}
-	return ""
-}
-
-func (x *UpdateDepartmentTitleRequest) GetTitle() string {
+func (x *AcceptCompanyOwnershipTransferRequest) GetCompanyUuid() string {
 	if x != nil {
-		return x.Title
+		return x.CompanyUuid
 	}
 	return ""
 }
 
-// DeleteDepartment
-type DeleteDepartmentRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid  string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	DepartmentUuid string                 `protobuf:"bytes,2,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// DeclineCompanyOwnershipTransfer
+type DeclineCompanyOwnershipTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteDepartmentRequest) Reset() {
-	*x = DeleteDepartmentRequest{}
-	mi := &file_company_proto_msgTypes[38]
+func (x *DeclineCompanyOwnershipTransferRequest) Reset() {
+	*x = DeclineCompanyOwnershipTransferRequest{}
+	mi := &file_company_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteDepartmentRequest) String() string {
+func (x *DeclineCompanyOwnershipTransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteDepartmentRequest) ProtoMessage() {}
+func (*DeclineCompanyOwnershipTransferRequest) ProtoMessage() {}
 
-func (x *DeleteDepartmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[38]
+func (x *DeclineCompanyOwnershipTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2247,50 +5256,49 @@ func (x *DeleteDepartmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteDepartmentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteDepartmentRequest) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use DeclineCompanyOwnershipTransferRequest.ProtoReflect.Descriptor instead.
+func (*DeclineCompanyOwnershipTransferRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *DeleteDepartmentRequest) GetInitiatorUuid() string {
+func (x *DeclineCompanyOwnershipTransferRequest) GetInitiatorUuid() string {
 	if x != nil {
 		return x.InitiatorUuid
 	}
 	return ""
 }
 
-func (x *DeleteDepartmentRequest) GetDepartmentUuid() string {
+func (x *DeclineCompanyOwnershipTransferRequest) GetCompanyUuid() string {
 	if x != nil {
-		return x.DepartmentUuid
+		return x.CompanyUuid
 	}
 	return ""
 }
 
-// RemoveEmployeeFromDepartment
-type RemoveEmployeeFromDepartmentRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	InitiatorUuid  string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	DepartmentUuid string                 `protobuf:"bytes,2,opt,name=department_uuid,json=departmentUuid,proto3" json:"department_uuid,omitempty"`
-	TargetUuid     string                 `protobuf:"bytes,3,opt,name=target_uuid,json=targetUuid,proto3" json:"target_uuid,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// CancelCompanyOwnershipTransfer
+type CancelCompanyOwnershipTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveEmployeeFromDepartmentRequest) Reset() {
-	*x = RemoveEmployeeFromDepartmentRequest{}
-	mi := &file_company_proto_msgTypes[39]
+func (x *CancelCompanyOwnershipTransferRequest) Reset() {
+	*x = CancelCompanyOwnershipTransferRequest{}
+	mi := &file_company_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveEmployeeFromDepartmentRequest) String() string {
+func (x *CancelCompanyOwnershipTransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveEmployeeFromDepartmentRequest) ProtoMessage() {}
+func (*CancelCompanyOwnershipTransferRequest) ProtoMessage() {}
 
-func (x *RemoveEmployeeFromDepartmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[39]
+func (x *CancelCompanyOwnershipTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2301,56 +5309,56 @@ func (x *RemoveEmployeeFromDepartmentRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveEmployeeFromDepartmentRequest.ProtoReflect.Descriptor instead.
-func (*RemoveEmployeeFromDepartmentRequest) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use CancelCompanyOwnershipTransferRequest.ProtoReflect.Descriptor instead.
+func (*CancelCompanyOwnershipTransferRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *RemoveEmployeeFromDepartmentRequest) GetInitiatorUuid() string {
+func (x *CancelCompanyOwnershipTransferRequest) GetInitiatorUuid() string {
 	if x != nil {
 		return x.InitiatorUuid
 	}
 	return ""
 }
 
-func (x *RemoveEmployeeFromDepartmentRequest) GetDepartmentUuid() string {
-	if x != nil {
-		return x.DepartmentUuid
-	}
-	return ""
-}
-
-func (x *RemoveEmployeeFromDepartmentRequest) GetTargetUuid() string {
+func (x *CancelCompanyOwnershipTransferRequest) GetCompanyUuid() string {
 	if x != nil {
-		return x.TargetUuid
+		return x.CompanyUuid
 	}
 	return ""
 }
 
-// CheckColleagues
-type CheckColleaguesRequest struct {
+// GetCompanyAuditLog
+type GetCompanyAuditLogRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	InitiatorUuid string                 `protobuf:"bytes,1,opt,name=initiator_uuid,json=initiatorUuid,proto3" json:"initiator_uuid,omitempty"`
-	TargetUuid    string                 `protobuf:"bytes,2,opt,name=target_uuid,json=targetUuid,proto3" json:"target_uuid,omitempty"`
+	CompanyUuid   string                 `protobuf:"bytes,2,opt,name=company_uuid,json=companyUuid,proto3" json:"company_uuid,omitempty"`
+	ActorUuid     string                 `protobuf:"bytes,3,opt,name=actor_uuid,json=actorUuid,proto3" json:"actor_uuid,omitempty"`       // если указано - только действия этого пользователя
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`                              // если указано - только действия этого типа
+	CreatedFrom   string                 `protobuf:"bytes,5,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"` // RFC3339 или YYYY-MM-DD, включительно
+	CreatedTo     string                 `protobuf:"bytes,6,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`       // RFC3339 или YYYY-MM-DD, не включительно
+	Count         int64                  `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`
+	Offset        int64                  `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`
+	Cursor        string                 `protobuf:"bytes,9,opt,name=cursor,proto3" json:"cursor,omitempty"` // next_cursor предыдущей страницы (вместо offset)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckColleaguesRequest) Reset() {
-	*x = CheckColleaguesRequest{}
-	mi := &file_company_proto_msgTypes[40]
+func (x *GetCompanyAuditLogRequest) Reset() {
+	*x = GetCompanyAuditLogRequest{}
+	mi := &file_company_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckColleaguesRequest) String() string {
+func (x *GetCompanyAuditLogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckColleaguesRequest) ProtoMessage() {}
+func (*GetCompanyAuditLogRequest) ProtoMessage() {}
 
-func (x *CheckColleaguesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[40]
+func (x *GetCompanyAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2361,47 +5369,98 @@ func (x *CheckColleaguesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckColleaguesRequest.ProtoReflect.Descriptor instead.
-func (*CheckColleaguesRequest) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use GetCompanyAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *CheckColleaguesRequest) GetInitiatorUuid() string {
+func (x *GetCompanyAuditLogRequest) GetInitiatorUuid() string {
 	if x != nil {
 		return x.InitiatorUuid
 	}
 	return ""
 }
 
-func (x *CheckColleaguesRequest) GetTargetUuid() string {
+func (x *GetCompanyAuditLogRequest) GetCompanyUuid() string {
 	if x != nil {
-		return x.TargetUuid
+		return x.CompanyUuid
 	}
 	return ""
 }
 
-type CheckColleaguesResponse struct {
+func (x *GetCompanyAuditLogRequest) GetActorUuid() string {
+	if x != nil {
+		return x.ActorUuid
+	}
+	return ""
+}
+
+func (x *GetCompanyAuditLogRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *GetCompanyAuditLogRequest) GetCreatedFrom() string {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return ""
+}
+
+func (x *GetCompanyAuditLogRequest) GetCreatedTo() string {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return ""
+}
+
+func (x *GetCompanyAuditLogRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetCompanyAuditLogRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetCompanyAuditLogRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetCompanyAuditLogResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AreColleagues bool                   `protobuf:"varint,1,opt,name=are_colleagues,json=areColleagues,proto3" json:"are_colleagues,omitempty"`
+	Entries       []*AuditEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`                         // новые первыми
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // пусто - страниц больше нет
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckColleaguesResponse) Reset() {
-	*x = CheckColleaguesResponse{}
-	mi := &file_company_proto_msgTypes[41]
+func (x *GetCompanyAuditLogResponse) Reset() {
+	*x = GetCompanyAuditLogResponse{}
+	mi := &file_company_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckColleaguesResponse) String() string {
+func (x *GetCompanyAuditLogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckColleaguesResponse) ProtoMessage() {}
+func (*GetCompanyAuditLogResponse) ProtoMessage() {}
 
-func (x *CheckColleaguesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_company_proto_msgTypes[41]
+func (x *GetCompanyAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2412,16 +5471,30 @@ func (x *CheckColleaguesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckColleaguesResponse.ProtoReflect.Descriptor instead.
-func (*CheckColleaguesResponse) Descriptor() ([]byte, []int) {
-	return file_company_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use GetCompanyAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanyAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_company_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *CheckColleaguesResponse) GetAreColleagues() bool {
+func (x *GetCompanyAuditLogResponse) GetEntries() []*AuditEntry {
 	if x != nil {
-		return x.AreColleagues
+		return x.Entries
 	}
-	return false
+	return nil
+}
+
+func (x *GetCompanyAuditLogResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetCompanyAuditLogResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
 }
 
 var File_company_proto protoreflect.FileDescriptor
@@ -2441,7 +5514,86 @@ const file_company_proto_rawDesc = "" +
 	"\n" +
 	"Department\x12'\n" +
 	"\x0fdepartment_uuid\x18\x01 \x01(\tR\x0edepartmentUuid\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\"\x88\x01\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\"M\n" +
+	"\bJoinCode\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x19\n" +
+	"\bmax_uses\x18\x02 \x01(\x03R\amaxUses\x12\x12\n" +
+	"\x04uses\x18\x03 \x01(\x03R\x04uses\"~\n" +
+	"\x12JoinCodeRedemption\x12\x1b\n" +
+	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\x12\x1f\n" +
+	"\vredeemed_at\x18\x02 \x01(\tR\n" +
+	"redeemedAt\x12*\n" +
+	"\x11join_request_uuid\x18\x03 \x01(\tR\x0fjoinRequestUuid\"\x86\x02\n" +
+	"\x11OwnershipTransfer\x12#\n" +
+	"\rtransfer_uuid\x18\x01 \x01(\tR\ftransferUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12\x1b\n" +
+	"\tfrom_uuid\x18\x03 \x01(\tR\bfromUuid\x12\x17\n" +
+	"\ato_uuid\x18\x04 \x01(\tR\x06toUuid\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\tR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"decided_at\x18\b \x01(\tR\tdecidedAt\"\xe8\x01\n" +
+	"\vJoinRequest\x12*\n" +
+	"\x11join_request_uuid\x18\x01 \x01(\tR\x0fjoinRequestUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x02 \x01(\tR\buserUuid\x12\x1b\n" +
+	"\tjoin_code\x18\x03 \x01(\tR\bjoinCode\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"decided_at\x18\x06 \x01(\tR\tdecidedAt\x12\x1d\n" +
+	"\n" +
+	"decided_by\x18\a \x01(\tR\tdecidedBy\"\x85\x03\n" +
+	"\n" +
+	"Invitation\x12'\n" +
+	"\x0finvitation_uuid\x18\x01 \x01(\tR\x0einvitationUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12#\n" +
+	"\rcompany_title\x18\x03 \x01(\tR\fcompanyTitle\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\x12'\n" +
+	"\x0fdepartment_uuid\x18\x06 \x01(\tR\x0edepartmentUuid\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\tR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"decided_at\x18\v \x01(\tR\tdecidedAt\x12\x1f\n" +
+	"\vaccepted_by\x18\f \x01(\tR\n" +
+	"acceptedBy\"\xd9\x01\n" +
+	"\bLocation\x12#\n" +
+	"\rlocation_uuid\x18\x01 \x01(\tR\flocationUuid\x12\x1f\n" +
+	"\vparent_uuid\x18\x02 \x01(\tR\n" +
+	"parentUuid\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x1f\n" +
+	"\blatitude\x18\x05 \x01(\x01H\x00R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\x06 \x01(\x01H\x01R\tlongitude\x88\x01\x01B\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"\xa6\x02\n" +
+	"\n" +
+	"AuditEntry\x12\x1d\n" +
+	"\n" +
+	"entry_uuid\x18\x01 \x01(\tR\tentryUuid\x12\x1d\n" +
+	"\n" +
+	"actor_uuid\x18\x02 \x01(\tR\tactorUuid\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x1f\n" +
+	"\vtarget_type\x18\x04 \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x05 \x01(\tR\btargetId\x12!\n" +
+	"\fbefore_value\x18\x06 \x01(\tR\vbeforeValue\x12\x1f\n" +
+	"\vafter_value\x18\a \x01(\tR\n" +
+	"afterValue\x12!\n" +
+	"\foperation_id\x18\b \x01(\tR\voperationId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\"\x88\x01\n" +
 	"\x0eHealthResponse\x12\x18\n" +
 	"\aservice\x18\x01 \x01(\tR\aservice\x12\x1a\n" +
 	"\bpostgres\x18\x02 \x01(\tR\bpostgres\x12\x14\n" +
@@ -2455,16 +5607,24 @@ const file_company_proto_rawDesc = "" +
 	"\fcompany_uuid\x18\x01 \x01(\tR\vcompanyUuid\"]\n" +
 	"\x11GetCompanyRequest\x12%\n" +
 	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
-	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\"e\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\"\xba\x01\n" +
 	"\x12GetCompanyResponse\x12!\n" +
 	"\fcompany_uuid\x18\x01 \x01(\tR\vcompanyUuid\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\"C\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x124\n" +
+	"\x16join_approval_required\x18\x04 \x01(\bR\x14joinApprovalRequired\x12\x1d\n" +
+	"\n" +
+	"owner_uuid\x18\x05 \x01(\tR\townerUuid\"[\n" +
 	"\x13GetCompaniesRequest\x12\x16\n" +
 	"\x06offset\x18\x01 \x01(\x03R\x06offset\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x03R\x05count\"F\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"\x88\x01\n" +
 	"\x14GetCompaniesResponse\x12.\n" +
-	"\tcompanies\x18\x01 \x03(\v2\x10.company.CompanyR\tcompanies\"@\n" +
+	"\tcompanies\x18\x01 \x03(\v2\x10.company.CompanyR\tcompanies\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x03R\n" +
+	"totalCount\"@\n" +
 	"\x17GetUserCompaniesRequest\x12%\n" +
 	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\"J\n" +
 	"\x18GetUserCompaniesResponse\x12.\n" +
@@ -2479,28 +5639,62 @@ const file_company_proto_rawDesc = "" +
 	"\x06status\x18\x03 \x01(\tR\x06status\"`\n" +
 	"\x14DeleteCompanyRequest\x12%\n" +
 	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
-	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\"\x83\x01\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\"k\n" +
+	"\x1fGetCompanyDeletionStatusRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\"\xd1\x02\n" +
+	" GetCompanyDeletionStatusResponse\x12\x12\n" +
+	"\x04step\x18\x01 \x01(\tR\x04step\x12!\n" +
+	"\frequested_by\x18\x02 \x01(\tR\vrequestedBy\x12!\n" +
+	"\frequested_at\x18\x03 \x01(\tR\vrequestedAt\x12\x1f\n" +
+	"\vpurge_after\x18\x04 \x01(\tR\n" +
+	"purgeAfter\x12\x1f\n" +
+	"\varchive_key\x18\x05 \x01(\tR\n" +
+	"archiveKey\x123\n" +
+	"\x15archived_applications\x18\x06 \x01(\x03R\x14archivedApplications\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tR\tlastError\x12!\n" +
+	"\fcompleted_at\x18\t \x01(\tR\vcompletedAt\"a\n" +
+	"\x15RestoreCompanyRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\"\xa2\x01\n" +
+	" UpdateCompanyJoinSettingsRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x124\n" +
+	"\x16join_approval_required\x18\x03 \x01(\bR\x14joinApprovalRequired\"\x9e\x01\n" +
 	"\x1cCreateCompanyJoinCodeRequest\x12%\n" +
 	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
 	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12\x19\n" +
-	"\bcode_ttl\x18\x03 \x01(\x03R\acodeTtl\"<\n" +
+	"\bcode_ttl\x18\x03 \x01(\x03R\acodeTtl\x12\x19\n" +
+	"\bmax_uses\x18\x04 \x01(\x03R\amaxUses\"<\n" +
 	"\x1dCreateCompanyJoinCodeResponse\x12\x1b\n" +
 	"\tjoin_code\x18\x01 \x01(\tR\bjoinCode\"f\n" +
 	"\x1aGetCompanyJoinCodesRequest\x12%\n" +
 	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
-	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\"3\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\"e\n" +
 	"\x1bGetCompanyJoinCodesResponse\x12\x14\n" +
-	"\x05codes\x18\x01 \x03(\tR\x05codes\"|\n" +
+	"\x05codes\x18\x01 \x03(\tR\x05codes\x120\n" +
+	"\n" +
+	"join_codes\x18\x02 \x03(\v2\x11.company.JoinCodeR\tjoinCodes\"|\n" +
 	"\x1cDeleteCompanyJoinCodeRequest\x12%\n" +
 	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
 	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12\x12\n" +
-	"\x04code\x18\x03 \x01(\tR\x04code\"X\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\"}\n" +
+	"\x1dGetJoinCodeRedemptionsRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\"_\n" +
+	"\x1eGetJoinCodeRedemptionsResponse\x12=\n" +
+	"\vredemptions\x18\x01 \x03(\v2\x1b.company.JoinCodeRedemptionR\vredemptions\"X\n" +
 	"\x12JoinCompanyRequest\x12%\n" +
 	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12\x1b\n" +
-	"\tjoin_code\x18\x02 \x01(\tR\bjoinCode\"L\n" +
+	"\tjoin_code\x18\x02 \x01(\tR\bjoinCode\"\x90\x01\n" +
 	"\x13JoinCompanyResponse\x12!\n" +
 	"\fcompany_uuid\x18\x01 \x01(\tR\vcompanyUuid\x12\x12\n" +
-	"\x04role\x18\x02 \x01(\tR\x04role\"\x86\x01\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12*\n" +
+	"\x11join_request_uuid\x18\x04 \x01(\tR\x0fjoinRequestUuid\"\x86\x01\n" +
 	"\x19GetCompanyEmployeeRequest\x12%\n" +
 	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12\x1f\n" +
 	"\vtarget_uuid\x18\x02 \x01(\tR\n" +
@@ -2509,16 +5703,21 @@ const file_company_proto_rawDesc = "" +
 	"\x1aGetCompanyEmployeeResponse\x12\x12\n" +
 	"\x04role\x18\x01 \x01(\tR\x04role\x12'\n" +
 	"\x0fdepartment_uuid\x18\x02 \x01(\tR\x0edepartmentUuid\x12\x1b\n" +
-	"\tjoined_at\x18\x03 \x01(\tR\bjoinedAt\"\xd1\x01\n" +
+	"\tjoined_at\x18\x03 \x01(\tR\bjoinedAt\"\xe9\x01\n" +
 	"\x1aGetCompanyEmployeesRequest\x12%\n" +
 	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
 	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12\x12\n" +
 	"\x04role\x18\x03 \x01(\tR\x04role\x12'\n" +
 	"\x0fdepartment_uuid\x18\x04 \x01(\tR\x0edepartmentUuid\x12\x14\n" +
 	"\x05count\x18\x05 \x01(\x03R\x05count\x12\x16\n" +
-	"\x06offset\x18\x06 \x01(\x03R\x06offset\"N\n" +
+	"\x06offset\x18\x06 \x01(\x03R\x06offset\x12\x16\n" +
+	"\x06cursor\x18\a \x01(\tR\x06cursor\"\x90\x01\n" +
 	"\x1bGetCompanyEmployeesResponse\x12/\n" +
-	"\temployees\x18\x01 \x03(\v2\x11.company.EmployeeR\temployees\"\x96\x01\n" +
+	"\temployees\x18\x01 \x03(\v2\x11.company.EmployeeR\temployees\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x03R\n" +
+	"totalCount\"\x96\x01\n" +
 	"!GetCompanyEmployeesSummaryRequest\x12%\n" +
 	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
 	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12'\n" +
@@ -2530,18 +5729,27 @@ const file_company_proto_rawDesc = "" +
 	"\rmanager_count\x18\x03 \x01(\x03R\fmanagerCount\x12%\n" +
 	"\x0eengineer_count\x18\x04 \x01(\x03R\rengineerCount\x12'\n" +
 	"\x0finspector_count\x18\x05 \x01(\x03R\x0einspectorCount\x12)\n" +
-	"\x10unemployed_count\x18\x06 \x01(\x03R\x0funemployedCount\"\x9a\x01\n" +
+	"\x10unemployed_count\x18\x06 \x01(\x03R\x0funemployedCount\"\xe8\x01\n" +
 	"\x19UpdateEmployeeRoleRequest\x12%\n" +
 	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12\x1f\n" +
 	"\vtarget_uuid\x18\x02 \x01(\tR\n" +
 	"targetUuid\x12!\n" +
 	"\fcompany_uuid\x18\x03 \x01(\tR\vcompanyUuid\x12\x12\n" +
-	"\x04role\x18\x04 \x01(\tR\x04role\"\x89\x01\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12+\n" +
+	"\x11open_applications\x18\x05 \x01(\tR\x10openApplications\x12\x1f\n" +
+	"\vreassign_to\x18\x06 \x01(\tR\n" +
+	"reassignTo\"\xd7\x01\n" +
 	"\x1cRemoveCompanyEmployeeRequest\x12%\n" +
 	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
 	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12\x1f\n" +
 	"\vtarget_uuid\x18\x03 \x01(\tR\n" +
-	"targetUuid\"y\n" +
+	"targetUuid\x12+\n" +
+	"\x11open_applications\x18\x04 \x01(\tR\x10openApplications\x12\x1f\n" +
+	"\vreassign_to\x18\x05 \x01(\tR\n" +
+	"reassignTo\"_\n" +
+	"\x13LeaveCompanyRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\"y\n" +
 	"\x17CreateDepartmentRequest\x12%\n" +
 	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
 	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12\x14\n" +
@@ -2577,18 +5785,158 @@ const file_company_proto_rawDesc = "" +
 	"\x05title\x18\x03 \x01(\tR\x05title\"i\n" +
 	"\x17DeleteDepartmentRequest\x12%\n" +
 	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12'\n" +
-	"\x0fdepartment_uuid\x18\x02 \x01(\tR\x0edepartmentUuid\"\x96\x01\n" +
+	"\x0fdepartment_uuid\x18\x02 \x01(\tR\x0edepartmentUuid\"\xe4\x01\n" +
 	"#RemoveEmployeeFromDepartmentRequest\x12%\n" +
 	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12'\n" +
 	"\x0fdepartment_uuid\x18\x02 \x01(\tR\x0edepartmentUuid\x12\x1f\n" +
 	"\vtarget_uuid\x18\x03 \x01(\tR\n" +
-	"targetUuid\"`\n" +
+	"targetUuid\x12+\n" +
+	"\x11open_applications\x18\x04 \x01(\tR\x10openApplications\x12\x1f\n" +
+	"\vreassign_to\x18\x05 \x01(\tR\n" +
+	"reassignTo\"`\n" +
 	"\x16CheckColleaguesRequest\x12%\n" +
 	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12\x1f\n" +
 	"\vtarget_uuid\x18\x02 \x01(\tR\n" +
 	"targetUuid\"@\n" +
 	"\x17CheckColleaguesResponse\x12%\n" +
-	"\x0eare_colleagues\x18\x01 \x01(\bR\rareColleagues2\x93\x11\n" +
+	"\x0eare_colleagues\x18\x01 \x01(\bR\rareColleagues\"\x8b\x02\n" +
+	"\x15CreateLocationRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12\x1f\n" +
+	"\vparent_uuid\x18\x03 \x01(\tR\n" +
+	"parentUuid\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12\x1f\n" +
+	"\blatitude\x18\x06 \x01(\x01H\x00R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\a \x01(\x01H\x01R\tlongitude\x88\x01\x01B\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"=\n" +
+	"\x16CreateLocationResponse\x12#\n" +
+	"\rlocation_uuid\x18\x01 \x01(\tR\flocationUuid\"`\n" +
+	"\x12GetLocationRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12#\n" +
+	"\rlocation_uuid\x18\x02 \x01(\tR\flocationUuid\"\xcc\x01\n" +
+	"\x13GetLocationResponse\x12-\n" +
+	"\blocation\x18\x01 \x01(\v2\x11.company.LocationR\blocation\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\x12%\n" +
+	"\x04path\x18\x05 \x03(\v2\x11.company.LocationR\x04path\"\xc9\x01\n" +
+	"\x1aGetCompanyLocationsRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12\x1f\n" +
+	"\vparent_uuid\x18\x03 \x01(\tR\n" +
+	"parentUuid\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x03R\x06offset\x12\x14\n" +
+	"\x05count\x18\x06 \x01(\x03R\x05count\"N\n" +
+	"\x1bGetCompanyLocationsResponse\x12/\n" +
+	"\tlocations\x18\x01 \x03(\v2\x11.company.LocationR\tlocations\"\xd8\x01\n" +
+	"\x15UpdateLocationRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12#\n" +
+	"\rlocation_uuid\x18\x02 \x01(\tR\flocationUuid\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1f\n" +
+	"\blatitude\x18\x04 \x01(\x01H\x00R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\x05 \x01(\x01H\x01R\tlongitude\x88\x01\x01B\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"c\n" +
+	"\x15DeleteLocationRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12#\n" +
+	"\rlocation_uuid\x18\x02 \x01(\tR\flocationUuid\"\x81\x01\n" +
+	"\x1dGetCompanyJoinRequestsRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"[\n" +
+	"\x1eGetCompanyJoinRequestsResponse\x129\n" +
+	"\rjoin_requests\x18\x01 \x03(\v2\x14.company.JoinRequestR\fjoinRequests\"\x97\x01\n" +
+	"\x1fAcceptCompanyJoinRequestRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12*\n" +
+	"\x11join_request_uuid\x18\x03 \x01(\tR\x0fjoinRequestUuid\"\x98\x01\n" +
+	" DeclineCompanyJoinRequestRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12*\n" +
+	"\x11join_request_uuid\x18\x03 \x01(\tR\x0fjoinRequestUuid\"\xcf\x01\n" +
+	"\x1eCreateCompanyInvitationRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12'\n" +
+	"\x0fdepartment_uuid\x18\x05 \x01(\tR\x0edepartmentUuid\x12\x10\n" +
+	"\x03ttl\x18\x06 \x01(\x03R\x03ttl\"J\n" +
+	"\x1fCreateCompanyInvitationResponse\x12'\n" +
+	"\x0finvitation_uuid\x18\x01 \x01(\tR\x0einvitationUuid\"\x80\x01\n" +
+	"\x1cGetCompanyInvitationsRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"V\n" +
+	"\x1dGetCompanyInvitationsResponse\x125\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x13.company.InvitationR\vinvitations\"\x93\x01\n" +
+	"\x1eRevokeCompanyInvitationRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12'\n" +
+	"\x0finvitation_uuid\x18\x03 \x01(\tR\x0einvitationUuid\"X\n" +
+	"\x19GetUserInvitationsRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\"S\n" +
+	"\x1aGetUserInvitationsResponse\x125\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x13.company.InvitationR\vinvitations\"\x86\x01\n" +
+	"\x1eAcceptCompanyInvitationRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12'\n" +
+	"\x0finvitation_uuid\x18\x03 \x01(\tR\x0einvitationUuid\"X\n" +
+	"\x1fAcceptCompanyInvitationResponse\x12!\n" +
+	"\fcompany_uuid\x18\x01 \x01(\tR\vcompanyUuid\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"\x87\x01\n" +
+	"\x1fDeclineCompanyInvitationRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12'\n" +
+	"\x0finvitation_uuid\x18\x03 \x01(\tR\x0einvitationUuid\"\x8c\x01\n" +
+	"\x1fTransferCompanyOwnershipRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12\x1f\n" +
+	"\vtarget_uuid\x18\x03 \x01(\tR\n" +
+	"targetUuid\"f\n" +
+	" TransferCompanyOwnershipResponse\x12#\n" +
+	"\rtransfer_uuid\x18\x01 \x01(\tR\ftransferUuid\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\tR\texpiresAt\"n\n" +
+	"\"GetCompanyOwnershipTransferRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\"]\n" +
+	"#GetCompanyOwnershipTransferResponse\x126\n" +
+	"\btransfer\x18\x01 \x01(\v2\x1a.company.OwnershipTransferR\btransfer\"q\n" +
+	"%AcceptCompanyOwnershipTransferRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\"r\n" +
+	"&DeclineCompanyOwnershipTransferRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\"q\n" +
+	"%CancelCompanyOwnershipTransferRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\"\xa4\x02\n" +
+	"\x19GetCompanyAuditLogRequest\x12%\n" +
+	"\x0einitiator_uuid\x18\x01 \x01(\tR\rinitiatorUuid\x12!\n" +
+	"\fcompany_uuid\x18\x02 \x01(\tR\vcompanyUuid\x12\x1d\n" +
+	"\n" +
+	"actor_uuid\x18\x03 \x01(\tR\tactorUuid\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12!\n" +
+	"\fcreated_from\x18\x05 \x01(\tR\vcreatedFrom\x12\x1d\n" +
+	"\n" +
+	"created_to\x18\x06 \x01(\tR\tcreatedTo\x12\x14\n" +
+	"\x05count\x18\a \x01(\x03R\x05count\x12\x16\n" +
+	"\x06offset\x18\b \x01(\x03R\x06offset\x12\x16\n" +
+	"\x06cursor\x18\t \x01(\tR\x06cursor\"\x8d\x01\n" +
+	"\x1aGetCompanyAuditLogResponse\x12-\n" +
+	"\aentries\x18\x01 \x03(\v2\x13.company.AuditEntryR\aentries\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x03R\n" +
+	"totalCount2\x82$\n" +
 	"\x0eCompanyService\x129\n" +
 	"\x06Health\x12\x16.google.protobuf.Empty\x1a\x17.company.HealthResponse\x12N\n" +
 	"\rCreateCompany\x12\x1d.company.CreateCompanyRequest\x1a\x1e.company.CreateCompanyResponse\x12E\n" +
@@ -2598,16 +5946,35 @@ const file_company_proto_rawDesc = "" +
 	"\x10GetUserCompanies\x12 .company.GetUserCompaniesRequest\x1a!.company.GetUserCompaniesResponse\x12P\n" +
 	"\x12UpdateCompanyTitle\x12\".company.UpdateCompanyTitleRequest\x1a\x16.google.protobuf.Empty\x12R\n" +
 	"\x13UpdateCompanyStatus\x12#.company.UpdateCompanyStatusRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
-	"\rDeleteCompany\x12\x1d.company.DeleteCompanyRequest\x1a\x16.google.protobuf.Empty\x12f\n" +
+	"\rDeleteCompany\x12\x1d.company.DeleteCompanyRequest\x1a\x16.google.protobuf.Empty\x12o\n" +
+	"\x18GetCompanyDeletionStatus\x12(.company.GetCompanyDeletionStatusRequest\x1a).company.GetCompanyDeletionStatusResponse\x12H\n" +
+	"\x0eRestoreCompany\x12\x1e.company.RestoreCompanyRequest\x1a\x16.google.protobuf.Empty\x12^\n" +
+	"\x19UpdateCompanyJoinSettings\x12).company.UpdateCompanyJoinSettingsRequest\x1a\x16.google.protobuf.Empty\x12f\n" +
 	"\x15CreateCompanyJoinCode\x12%.company.CreateCompanyJoinCodeRequest\x1a&.company.CreateCompanyJoinCodeResponse\x12`\n" +
 	"\x13GetCompanyJoinCodes\x12#.company.GetCompanyJoinCodesRequest\x1a$.company.GetCompanyJoinCodesResponse\x12V\n" +
-	"\x15DeleteCompanyJoinCode\x12%.company.DeleteCompanyJoinCodeRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
+	"\x15DeleteCompanyJoinCode\x12%.company.DeleteCompanyJoinCodeRequest\x1a\x16.google.protobuf.Empty\x12i\n" +
+	"\x16GetJoinCodeRedemptions\x12&.company.GetJoinCodeRedemptionsRequest\x1a'.company.GetJoinCodeRedemptionsResponse\x12i\n" +
+	"\x16GetCompanyJoinRequests\x12&.company.GetCompanyJoinRequestsRequest\x1a'.company.GetCompanyJoinRequestsResponse\x12\\\n" +
+	"\x18AcceptCompanyJoinRequest\x12(.company.AcceptCompanyJoinRequestRequest\x1a\x16.google.protobuf.Empty\x12^\n" +
+	"\x19DeclineCompanyJoinRequest\x12).company.DeclineCompanyJoinRequestRequest\x1a\x16.google.protobuf.Empty\x12l\n" +
+	"\x17CreateCompanyInvitation\x12'.company.CreateCompanyInvitationRequest\x1a(.company.CreateCompanyInvitationResponse\x12f\n" +
+	"\x15GetCompanyInvitations\x12%.company.GetCompanyInvitationsRequest\x1a&.company.GetCompanyInvitationsResponse\x12Z\n" +
+	"\x17RevokeCompanyInvitation\x12'.company.RevokeCompanyInvitationRequest\x1a\x16.google.protobuf.Empty\x12]\n" +
+	"\x12GetUserInvitations\x12\".company.GetUserInvitationsRequest\x1a#.company.GetUserInvitationsResponse\x12l\n" +
+	"\x17AcceptCompanyInvitation\x12'.company.AcceptCompanyInvitationRequest\x1a(.company.AcceptCompanyInvitationResponse\x12\\\n" +
+	"\x18DeclineCompanyInvitation\x12(.company.DeclineCompanyInvitationRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
 	"\vJoinCompany\x12\x1b.company.JoinCompanyRequest\x1a\x1c.company.JoinCompanyResponse\x12]\n" +
 	"\x12GetCompanyEmployee\x12\".company.GetCompanyEmployeeRequest\x1a#.company.GetCompanyEmployeeResponse\x12`\n" +
 	"\x13GetCompanyEmployees\x12#.company.GetCompanyEmployeesRequest\x1a$.company.GetCompanyEmployeesResponse\x12u\n" +
 	"\x1aGetCompanyEmployeesSummary\x12*.company.GetCompanyEmployeesSummaryRequest\x1a+.company.GetCompanyEmployeesSummaryResponse\x12P\n" +
 	"\x12UpdateEmployeeRole\x12\".company.UpdateEmployeeRoleRequest\x1a\x16.google.protobuf.Empty\x12V\n" +
-	"\x15RemoveCompanyEmployee\x12%.company.RemoveCompanyEmployeeRequest\x1a\x16.google.protobuf.Empty\x12T\n" +
+	"\x15RemoveCompanyEmployee\x12%.company.RemoveCompanyEmployeeRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
+	"\fLeaveCompany\x12\x1c.company.LeaveCompanyRequest\x1a\x16.google.protobuf.Empty\x12o\n" +
+	"\x18TransferCompanyOwnership\x12(.company.TransferCompanyOwnershipRequest\x1a).company.TransferCompanyOwnershipResponse\x12x\n" +
+	"\x1bGetCompanyOwnershipTransfer\x12+.company.GetCompanyOwnershipTransferRequest\x1a,.company.GetCompanyOwnershipTransferResponse\x12h\n" +
+	"\x1eAcceptCompanyOwnershipTransfer\x12..company.AcceptCompanyOwnershipTransferRequest\x1a\x16.google.protobuf.Empty\x12j\n" +
+	"\x1fDeclineCompanyOwnershipTransfer\x12/.company.DeclineCompanyOwnershipTransferRequest\x1a\x16.google.protobuf.Empty\x12h\n" +
+	"\x1eCancelCompanyOwnershipTransfer\x12..company.CancelCompanyOwnershipTransferRequest\x1a\x16.google.protobuf.Empty\x12T\n" +
 	"\x0fCheckColleagues\x12\x1f.company.CheckColleaguesRequest\x1a .company.CheckColleaguesResponse\x12W\n" +
 	"\x10CreateDepartment\x12 .company.CreateDepartmentRequest\x1a!.company.CreateDepartmentResponse\x12Z\n" +
 	"\x17AddEmployeeToDepartment\x12'.company.AddEmployeeToDepartmentRequest\x1a\x16.google.protobuf.Empty\x12N\n" +
@@ -2615,7 +5982,13 @@ const file_company_proto_rawDesc = "" +
 	"\x15GetCompanyDepartments\x12%.company.GetCompanyDepartmentsRequest\x1a&.company.GetCompanyDepartmentsResponse\x12V\n" +
 	"\x15UpdateDepartmentTitle\x12%.company.UpdateDepartmentTitleRequest\x1a\x16.google.protobuf.Empty\x12L\n" +
 	"\x10DeleteDepartment\x12 .company.DeleteDepartmentRequest\x1a\x16.google.protobuf.Empty\x12d\n" +
-	"\x1cRemoveEmployeeFromDepartment\x12,.company.RemoveEmployeeFromDepartmentRequest\x1a\x16.google.protobuf.EmptyBWZUgithub.com/unwelcome/FrameWorkTask1/backend/contracts/company/generated;company_protob\x06proto3"
+	"\x1cRemoveEmployeeFromDepartment\x12,.company.RemoveEmployeeFromDepartmentRequest\x1a\x16.google.protobuf.Empty\x12Q\n" +
+	"\x0eCreateLocation\x12\x1e.company.CreateLocationRequest\x1a\x1f.company.CreateLocationResponse\x12H\n" +
+	"\vGetLocation\x12\x1b.company.GetLocationRequest\x1a\x1c.company.GetLocationResponse\x12`\n" +
+	"\x13GetCompanyLocations\x12#.company.GetCompanyLocationsRequest\x1a$.company.GetCompanyLocationsResponse\x12H\n" +
+	"\x0eUpdateLocation\x12\x1e.company.UpdateLocationRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
+	"\x0eDeleteLocation\x12\x1e.company.DeleteLocationRequest\x1a\x16.google.protobuf.Empty\x12]\n" +
+	"\x12GetCompanyAuditLog\x12\".company.GetCompanyAuditLogRequest\x1a#.company.GetCompanyAuditLogResponseBWZUgithub.com/unwelcome/FrameWorkTask1/backend/contracts/company/generated;company_protob\x06proto3"
 
 var (
 	file_company_proto_rawDescOnce sync.Once
@@ -2629,112 +6002,217 @@ func file_company_proto_rawDescGZIP() []byte {
 	return file_company_proto_rawDescData
 }
 
-var file_company_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
+var file_company_proto_msgTypes = make([]protoimpl.MessageInfo, 87)
 var file_company_proto_goTypes = []any{
-	(*Company)(nil),                             // 0: company.Company
-	(*Employee)(nil),                            // 1: company.Employee
-	(*Department)(nil),                          // 2: company.Department
-	(*HealthResponse)(nil),                      // 3: company.HealthResponse
-	(*CreateCompanyRequest)(nil),                // 4: company.CreateCompanyRequest
-	(*CreateCompanyResponse)(nil),               // 5: company.CreateCompanyResponse
-	(*GetCompanyRequest)(nil),                   // 6: company.GetCompanyRequest
-	(*GetCompanyResponse)(nil),                  // 7: company.GetCompanyResponse
-	(*GetCompaniesRequest)(nil),                 // 8: company.GetCompaniesRequest
-	(*GetCompaniesResponse)(nil),                // 9: company.GetCompaniesResponse
-	(*GetUserCompaniesRequest)(nil),             // 10: company.GetUserCompaniesRequest
-	(*GetUserCompaniesResponse)(nil),            // 11: company.GetUserCompaniesResponse
-	(*UpdateCompanyTitleRequest)(nil),           // 12: company.UpdateCompanyTitleRequest
-	(*UpdateCompanyStatusRequest)(nil),          // 13: company.UpdateCompanyStatusRequest
-	(*DeleteCompanyRequest)(nil),                // 14: company.DeleteCompanyRequest
-	(*CreateCompanyJoinCodeRequest)(nil),        // 15: company.CreateCompanyJoinCodeRequest
-	(*CreateCompanyJoinCodeResponse)(nil),       // 16: company.CreateCompanyJoinCodeResponse
-	(*GetCompanyJoinCodesRequest)(nil),          // 17: company.GetCompanyJoinCodesRequest
-	(*GetCompanyJoinCodesResponse)(nil),         // 18: company.GetCompanyJoinCodesResponse
-	(*DeleteCompanyJoinCodeRequest)(nil),        // 19: company.DeleteCompanyJoinCodeRequest
-	(*JoinCompanyRequest)(nil),                  // 20: company.JoinCompanyRequest
-	(*JoinCompanyResponse)(nil),                 // 21: company.JoinCompanyResponse
-	(*GetCompanyEmployeeRequest)(nil),           // 22: company.GetCompanyEmployeeRequest
-	(*GetCompanyEmployeeResponse)(nil),          // 23: company.GetCompanyEmployeeResponse
-	(*GetCompanyEmployeesRequest)(nil),          // 24: company.GetCompanyEmployeesRequest
-	(*GetCompanyEmployeesResponse)(nil),         // 25: company.GetCompanyEmployeesResponse
-	(*GetCompanyEmployeesSummaryRequest)(nil),   // 26: company.GetCompanyEmployeesSummaryRequest
-	(*GetCompanyEmployeesSummaryResponse)(nil),  // 27: company.GetCompanyEmployeesSummaryResponse
-	(*UpdateEmployeeRoleRequest)(nil),           // 28: company.UpdateEmployeeRoleRequest
-	(*RemoveCompanyEmployeeRequest)(nil),        // 29: company.RemoveCompanyEmployeeRequest
-	(*CreateDepartmentRequest)(nil),             // 30: company.CreateDepartmentRequest
-	(*CreateDepartmentResponse)(nil),            // 31: company.CreateDepartmentResponse
-	(*AddEmployeeToDepartmentRequest)(nil),      // 32: company.AddEmployeeToDepartmentRequest
-	(*GetDepartmentRequest)(nil),                // 33: company.GetDepartmentRequest
-	(*GetDepartmentResponse)(nil),               // 34: company.GetDepartmentResponse
-	(*GetCompanyDepartmentsRequest)(nil),        // 35: company.GetCompanyDepartmentsRequest
-	(*GetCompanyDepartmentsResponse)(nil),       // 36: company.GetCompanyDepartmentsResponse
-	(*UpdateDepartmentTitleRequest)(nil),        // 37: company.UpdateDepartmentTitleRequest
-	(*DeleteDepartmentRequest)(nil),             // 38: company.DeleteDepartmentRequest
-	(*RemoveEmployeeFromDepartmentRequest)(nil), // 39: company.RemoveEmployeeFromDepartmentRequest
-	(*CheckColleaguesRequest)(nil),              // 40: company.CheckColleaguesRequest
-	(*CheckColleaguesResponse)(nil),             // 41: company.CheckColleaguesResponse
-	(*emptypb.Empty)(nil),                       // 42: google.protobuf.Empty
+	(*Company)(nil),                                // 0: company.Company
+	(*Employee)(nil),                               // 1: company.Employee
+	(*Department)(nil),                             // 2: company.Department
+	(*JoinCode)(nil),                               // 3: company.JoinCode
+	(*JoinCodeRedemption)(nil),                     // 4: company.JoinCodeRedemption
+	(*OwnershipTransfer)(nil),                      // 5: company.OwnershipTransfer
+	(*JoinRequest)(nil),                            // 6: company.JoinRequest
+	(*Invitation)(nil),                             // 7: company.Invitation
+	(*Location)(nil),                               // 8: company.Location
+	(*AuditEntry)(nil),                             // 9: company.AuditEntry
+	(*HealthResponse)(nil),                         // 10: company.HealthResponse
+	(*CreateCompanyRequest)(nil),                   // 11: company.CreateCompanyRequest
+	(*CreateCompanyResponse)(nil),                  // 12: company.CreateCompanyResponse
+	(*GetCompanyRequest)(nil),                      // 13: company.GetCompanyRequest
+	(*GetCompanyResponse)(nil),                     // 14: company.GetCompanyResponse
+	(*GetCompaniesRequest)(nil),                    // 15: company.GetCompaniesRequest
+	(*GetCompaniesResponse)(nil),                   // 16: company.GetCompaniesResponse
+	(*GetUserCompaniesRequest)(nil),                // 17: company.GetUserCompaniesRequest
+	(*GetUserCompaniesResponse)(nil),               // 18: company.GetUserCompaniesResponse
+	(*UpdateCompanyTitleRequest)(nil),              // 19: company.UpdateCompanyTitleRequest
+	(*UpdateCompanyStatusRequest)(nil),             // 20: company.UpdateCompanyStatusRequest
+	(*DeleteCompanyRequest)(nil),                   // 21: company.DeleteCompanyRequest
+	(*GetCompanyDeletionStatusRequest)(nil),        // 22: company.GetCompanyDeletionStatusRequest
+	(*GetCompanyDeletionStatusResponse)(nil),       // 23: company.GetCompanyDeletionStatusResponse
+	(*RestoreCompanyRequest)(nil),                  // 24: company.RestoreCompanyRequest
+	(*UpdateCompanyJoinSettingsRequest)(nil),       // 25: company.UpdateCompanyJoinSettingsRequest
+	(*CreateCompanyJoinCodeRequest)(nil),           // 26: company.CreateCompanyJoinCodeRequest
+	(*CreateCompanyJoinCodeResponse)(nil),          // 27: company.CreateCompanyJoinCodeResponse
+	(*GetCompanyJoinCodesRequest)(nil),             // 28: company.GetCompanyJoinCodesRequest
+	(*GetCompanyJoinCodesResponse)(nil),            // 29: company.GetCompanyJoinCodesResponse
+	(*DeleteCompanyJoinCodeRequest)(nil),           // 30: company.DeleteCompanyJoinCodeRequest
+	(*GetJoinCodeRedemptionsRequest)(nil),          // 31: company.GetJoinCodeRedemptionsRequest
+	(*GetJoinCodeRedemptionsResponse)(nil),         // 32: company.GetJoinCodeRedemptionsResponse
+	(*JoinCompanyRequest)(nil),                     // 33: company.JoinCompanyRequest
+	(*JoinCompanyResponse)(nil),                    // 34: company.JoinCompanyResponse
+	(*GetCompanyEmployeeRequest)(nil),              // 35: company.GetCompanyEmployeeRequest
+	(*GetCompanyEmployeeResponse)(nil),             // 36: company.GetCompanyEmployeeResponse
+	(*GetCompanyEmployeesRequest)(nil),             // 37: company.GetCompanyEmployeesRequest
+	(*GetCompanyEmployeesResponse)(nil),            // 38: company.GetCompanyEmployeesResponse
+	(*GetCompanyEmployeesSummaryRequest)(nil),      // 39: company.GetCompanyEmployeesSummaryRequest
+	(*GetCompanyEmployeesSummaryResponse)(nil),     // 40: company.GetCompanyEmployeesSummaryResponse
+	(*UpdateEmployeeRoleRequest)(nil),              // 41: company.UpdateEmployeeRoleRequest
+	(*RemoveCompanyEmployeeRequest)(nil),           // 42: company.RemoveCompanyEmployeeRequest
+	(*LeaveCompanyRequest)(nil),                    // 43: company.LeaveCompanyRequest
+	(*CreateDepartmentRequest)(nil),                // 44: company.CreateDepartmentRequest
+	(*CreateDepartmentResponse)(nil),               // 45: company.CreateDepartmentResponse
+	(*AddEmployeeToDepartmentRequest)(nil),         // 46: company.AddEmployeeToDepartmentRequest
+	(*GetDepartmentRequest)(nil),                   // 47: company.GetDepartmentRequest
+	(*GetDepartmentResponse)(nil),                  // 48: company.GetDepartmentResponse
+	(*GetCompanyDepartmentsRequest)(nil),           // 49: company.GetCompanyDepartmentsRequest
+	(*GetCompanyDepartmentsResponse)(nil),          // 50: company.GetCompanyDepartmentsResponse
+	(*UpdateDepartmentTitleRequest)(nil),           // 51: company.UpdateDepartmentTitleRequest
+	(*DeleteDepartmentRequest)(nil),                // 52: company.DeleteDepartmentRequest
+	(*RemoveEmployeeFromDepartmentRequest)(nil),    // 53: company.RemoveEmployeeFromDepartmentRequest
+	(*CheckColleaguesRequest)(nil),                 // 54: company.CheckColleaguesRequest
+	(*CheckColleaguesResponse)(nil),                // 55: company.CheckColleaguesResponse
+	(*CreateLocationRequest)(nil),                  // 56: company.CreateLocationRequest
+	(*CreateLocationResponse)(nil),                 // 57: company.CreateLocationResponse
+	(*GetLocationRequest)(nil),                     // 58: company.GetLocationRequest
+	(*GetLocationResponse)(nil),                    // 59: company.GetLocationResponse
+	(*GetCompanyLocationsRequest)(nil),             // 60: company.GetCompanyLocationsRequest
+	(*GetCompanyLocationsResponse)(nil),            // 61: company.GetCompanyLocationsResponse
+	(*UpdateLocationRequest)(nil),                  // 62: company.UpdateLocationRequest
+	(*DeleteLocationRequest)(nil),                  // 63: company.DeleteLocationRequest
+	(*GetCompanyJoinRequestsRequest)(nil),          // 64: company.GetCompanyJoinRequestsRequest
+	(*GetCompanyJoinRequestsResponse)(nil),         // 65: company.GetCompanyJoinRequestsResponse
+	(*AcceptCompanyJoinRequestRequest)(nil),        // 66: company.AcceptCompanyJoinRequestRequest
+	(*DeclineCompanyJoinRequestRequest)(nil),       // 67: company.DeclineCompanyJoinRequestRequest
+	(*CreateCompanyInvitationRequest)(nil),         // 68: company.CreateCompanyInvitationRequest
+	(*CreateCompanyInvitationResponse)(nil),        // 69: company.CreateCompanyInvitationResponse
+	(*GetCompanyInvitationsRequest)(nil),           // 70: company.GetCompanyInvitationsRequest
+	(*GetCompanyInvitationsResponse)(nil),          // 71: company.GetCompanyInvitationsResponse
+	(*RevokeCompanyInvitationRequest)(nil),         // 72: company.RevokeCompanyInvitationRequest
+	(*GetUserInvitationsRequest)(nil),              // 73: company.GetUserInvitationsRequest
+	(*GetUserInvitationsResponse)(nil),             // 74: company.GetUserInvitationsResponse
+	(*AcceptCompanyInvitationRequest)(nil),         // 75: company.AcceptCompanyInvitationRequest
+	(*AcceptCompanyInvitationResponse)(nil),        // 76: company.AcceptCompanyInvitationResponse
+	(*DeclineCompanyInvitationRequest)(nil),        // 77: company.DeclineCompanyInvitationRequest
+	(*TransferCompanyOwnershipRequest)(nil),        // 78: company.TransferCompanyOwnershipRequest
+	(*TransferCompanyOwnershipResponse)(nil),       // 79: company.TransferCompanyOwnershipResponse
+	(*GetCompanyOwnershipTransferRequest)(nil),     // 80: company.GetCompanyOwnershipTransferRequest
+	(*GetCompanyOwnershipTransferResponse)(nil),    // 81: company.GetCompanyOwnershipTransferResponse
+	(*AcceptCompanyOwnershipTransferRequest)(nil),  // 82: company.AcceptCompanyOwnershipTransferRequest
+	(*DeclineCompanyOwnershipTransferRequest)(nil), // 83: company.DeclineCompanyOwnershipTransferRequest
+	(*CancelCompanyOwnershipTransferRequest)(nil),  // 84: company.CancelCompanyOwnershipTransferRequest
+	(*GetCompanyAuditLogRequest)(nil),              // 85: company.GetCompanyAuditLogRequest
+	(*GetCompanyAuditLogResponse)(nil),             // 86: company.GetCompanyAuditLogResponse
+	(*emptypb.Empty)(nil),                          // 87: google.protobuf.Empty
 }
 var file_company_proto_depIdxs = []int32{
 	0,  // 0: company.GetCompaniesResponse.companies:type_name -> company.Company
 	0,  // 1: company.GetUserCompaniesResponse.companies:type_name -> company.Company
-	1,  // 2: company.GetCompanyEmployeesResponse.employees:type_name -> company.Employee
-	2,  // 3: company.GetCompanyDepartmentsResponse.departments:type_name -> company.Department
-	42, // 4: company.CompanyService.Health:input_type -> google.protobuf.Empty
-	4,  // 5: company.CompanyService.CreateCompany:input_type -> company.CreateCompanyRequest
-	6,  // 6: company.CompanyService.GetCompany:input_type -> company.GetCompanyRequest
-	8,  // 7: company.CompanyService.GetCompanies:input_type -> company.GetCompaniesRequest
-	10, // 8: company.CompanyService.GetUserCompanies:input_type -> company.GetUserCompaniesRequest
-	12, // 9: company.CompanyService.UpdateCompanyTitle:input_type -> company.UpdateCompanyTitleRequest
-	13, // 10: company.CompanyService.UpdateCompanyStatus:input_type -> company.UpdateCompanyStatusRequest
-	14, // 11: company.CompanyService.DeleteCompany:input_type -> company.DeleteCompanyRequest
-	15, // 12: company.CompanyService.CreateCompanyJoinCode:input_type -> company.CreateCompanyJoinCodeRequest
-	17, // 13: company.CompanyService.GetCompanyJoinCodes:input_type -> company.GetCompanyJoinCodesRequest
-	19, // 14: company.CompanyService.DeleteCompanyJoinCode:input_type -> company.DeleteCompanyJoinCodeRequest
-	20, // 15: company.CompanyService.JoinCompany:input_type -> company.JoinCompanyRequest
-	22, // 16: company.CompanyService.GetCompanyEmployee:input_type -> company.GetCompanyEmployeeRequest
-	24, // 17: company.CompanyService.GetCompanyEmployees:input_type -> company.GetCompanyEmployeesRequest
-	26, // 18: company.CompanyService.GetCompanyEmployeesSummary:input_type -> company.GetCompanyEmployeesSummaryRequest
-	28, // 19: company.CompanyService.UpdateEmployeeRole:input_type -> company.UpdateEmployeeRoleRequest
-	29, // 20: company.CompanyService.RemoveCompanyEmployee:input_type -> company.RemoveCompanyEmployeeRequest
-	40, // 21: company.CompanyService.CheckColleagues:input_type -> company.CheckColleaguesRequest
-	30, // 22: company.CompanyService.CreateDepartment:input_type -> company.CreateDepartmentRequest
-	32, // 23: company.CompanyService.AddEmployeeToDepartment:input_type -> company.AddEmployeeToDepartmentRequest
-	33, // 24: company.CompanyService.GetDepartment:input_type -> company.GetDepartmentRequest
-	35, // 25: company.CompanyService.GetCompanyDepartments:input_type -> company.GetCompanyDepartmentsRequest
-	37, // 26: company.CompanyService.UpdateDepartmentTitle:input_type -> company.UpdateDepartmentTitleRequest
-	38, // 27: company.CompanyService.DeleteDepartment:input_type -> company.DeleteDepartmentRequest
-	39, // 28: company.CompanyService.RemoveEmployeeFromDepartment:input_type -> company.RemoveEmployeeFromDepartmentRequest
-	3,  // 29: company.CompanyService.Health:output_type -> company.HealthResponse
-	5,  // 30: company.CompanyService.CreateCompany:output_type -> company.CreateCompanyResponse
-	7,  // 31: company.CompanyService.GetCompany:output_type -> company.GetCompanyResponse
-	9,  // 32: company.CompanyService.GetCompanies:output_type -> company.GetCompaniesResponse
-	11, // 33: company.CompanyService.GetUserCompanies:output_type -> company.GetUserCompaniesResponse
-	42, // 34: company.CompanyService.UpdateCompanyTitle:output_type -> google.protobuf.Empty
-	42, // 35: company.CompanyService.UpdateCompanyStatus:output_type -> google.protobuf.Empty
-	42, // 36: company.CompanyService.DeleteCompany:output_type -> google.protobuf.Empty
-	16, // 37: company.CompanyService.CreateCompanyJoinCode:output_type -> company.CreateCompanyJoinCodeResponse
-	18, // 38: company.CompanyService.GetCompanyJoinCodes:output_type -> company.GetCompanyJoinCodesResponse
-	42, // 39: company.CompanyService.DeleteCompanyJoinCode:output_type -> google.protobuf.Empty
-	21, // 40: company.CompanyService.JoinCompany:output_type -> company.JoinCompanyResponse
-	23, // 41: company.CompanyService.GetCompanyEmployee:output_type -> company.GetCompanyEmployeeResponse
-	25, // 42: company.CompanyService.GetCompanyEmployees:output_type -> company.GetCompanyEmployeesResponse
-	27, // 43: company.CompanyService.GetCompanyEmployeesSummary:output_type -> company.GetCompanyEmployeesSummaryResponse
-	42, // 44: company.CompanyService.UpdateEmployeeRole:output_type -> google.protobuf.Empty
-	42, // 45: company.CompanyService.RemoveCompanyEmployee:output_type -> google.protobuf.Empty
-	41, // 46: company.CompanyService.CheckColleagues:output_type -> company.CheckColleaguesResponse
-	31, // 47: company.CompanyService.CreateDepartment:output_type -> company.CreateDepartmentResponse
-	42, // 48: company.CompanyService.AddEmployeeToDepartment:output_type -> google.protobuf.Empty
-	34, // 49: company.CompanyService.GetDepartment:output_type -> company.GetDepartmentResponse
-	36, // 50: company.CompanyService.GetCompanyDepartments:output_type -> company.GetCompanyDepartmentsResponse
-	42, // 51: company.CompanyService.UpdateDepartmentTitle:output_type -> google.protobuf.Empty
-	42, // 52: company.CompanyService.DeleteDepartment:output_type -> google.protobuf.Empty
-	42, // 53: company.CompanyService.RemoveEmployeeFromDepartment:output_type -> google.protobuf.Empty
-	29, // [29:54] is the sub-list for method output_type
-	4,  // [4:29] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	3,  // 2: company.GetCompanyJoinCodesResponse.join_codes:type_name -> company.JoinCode
+	4,  // 3: company.GetJoinCodeRedemptionsResponse.redemptions:type_name -> company.JoinCodeRedemption
+	1,  // 4: company.GetCompanyEmployeesResponse.employees:type_name -> company.Employee
+	2,  // 5: company.GetCompanyDepartmentsResponse.departments:type_name -> company.Department
+	8,  // 6: company.GetLocationResponse.location:type_name -> company.Location
+	8,  // 7: company.GetLocationResponse.path:type_name -> company.Location
+	8,  // 8: company.GetCompanyLocationsResponse.locations:type_name -> company.Location
+	6,  // 9: company.GetCompanyJoinRequestsResponse.join_requests:type_name -> company.JoinRequest
+	7,  // 10: company.GetCompanyInvitationsResponse.invitations:type_name -> company.Invitation
+	7,  // 11: company.GetUserInvitationsResponse.invitations:type_name -> company.Invitation
+	5,  // 12: company.GetCompanyOwnershipTransferResponse.transfer:type_name -> company.OwnershipTransfer
+	9,  // 13: company.GetCompanyAuditLogResponse.entries:type_name -> company.AuditEntry
+	87, // 14: company.CompanyService.Health:input_type -> google.protobuf.Empty
+	11, // 15: company.CompanyService.CreateCompany:input_type -> company.CreateCompanyRequest
+	13, // 16: company.CompanyService.GetCompany:input_type -> company.GetCompanyRequest
+	15, // 17: company.CompanyService.GetCompanies:input_type -> company.GetCompaniesRequest
+	17, // 18: company.CompanyService.GetUserCompanies:input_type -> company.GetUserCompaniesRequest
+	19, // 19: company.CompanyService.UpdateCompanyTitle:input_type -> company.UpdateCompanyTitleRequest
+	20, // 20: company.CompanyService.UpdateCompanyStatus:input_type -> company.UpdateCompanyStatusRequest
+	21, // 21: company.CompanyService.DeleteCompany:input_type -> company.DeleteCompanyRequest
+	22, // 22: company.CompanyService.GetCompanyDeletionStatus:input_type -> company.GetCompanyDeletionStatusRequest
+	24, // 23: company.CompanyService.RestoreCompany:input_type -> company.RestoreCompanyRequest
+	25, // 24: company.CompanyService.UpdateCompanyJoinSettings:input_type -> company.UpdateCompanyJoinSettingsRequest
+	26, // 25: company.CompanyService.CreateCompanyJoinCode:input_type -> company.CreateCompanyJoinCodeRequest
+	28, // 26: company.CompanyService.GetCompanyJoinCodes:input_type -> company.GetCompanyJoinCodesRequest
+	30, // 27: company.CompanyService.DeleteCompanyJoinCode:input_type -> company.DeleteCompanyJoinCodeRequest
+	31, // 28: company.CompanyService.GetJoinCodeRedemptions:input_type -> company.GetJoinCodeRedemptionsRequest
+	64, // 29: company.CompanyService.GetCompanyJoinRequests:input_type -> company.GetCompanyJoinRequestsRequest
+	66, // 30: company.CompanyService.AcceptCompanyJoinRequest:input_type -> company.AcceptCompanyJoinRequestRequest
+	67, // 31: company.CompanyService.DeclineCompanyJoinRequest:input_type -> company.DeclineCompanyJoinRequestRequest
+	68, // 32: company.CompanyService.CreateCompanyInvitation:input_type -> company.CreateCompanyInvitationRequest
+	70, // 33: company.CompanyService.GetCompanyInvitations:input_type -> company.GetCompanyInvitationsRequest
+	72, // 34: company.CompanyService.RevokeCompanyInvitation:input_type -> company.RevokeCompanyInvitationRequest
+	73, // 35: company.CompanyService.GetUserInvitations:input_type -> company.GetUserInvitationsRequest
+	75, // 36: company.CompanyService.AcceptCompanyInvitation:input_type -> company.AcceptCompanyInvitationRequest
+	77, // 37: company.CompanyService.DeclineCompanyInvitation:input_type -> company.DeclineCompanyInvitationRequest
+	33, // 38: company.CompanyService.JoinCompany:input_type -> company.JoinCompanyRequest
+	35, // 39: company.CompanyService.GetCompanyEmployee:input_type -> company.GetCompanyEmployeeRequest
+	37, // 40: company.CompanyService.GetCompanyEmployees:input_type -> company.GetCompanyEmployeesRequest
+	39, // 41: company.CompanyService.GetCompanyEmployeesSummary:input_type -> company.GetCompanyEmployeesSummaryRequest
+	41, // 42: company.CompanyService.UpdateEmployeeRole:input_type -> company.UpdateEmployeeRoleRequest
+	42, // 43: company.CompanyService.RemoveCompanyEmployee:input_type -> company.RemoveCompanyEmployeeRequest
+	43, // 44: company.CompanyService.LeaveCompany:input_type -> company.LeaveCompanyRequest
+	78, // 45: company.CompanyService.TransferCompanyOwnership:input_type -> company.TransferCompanyOwnershipRequest
+	80, // 46: company.CompanyService.GetCompanyOwnershipTransfer:input_type -> company.GetCompanyOwnershipTransferRequest
+	82, // 47: company.CompanyService.AcceptCompanyOwnershipTransfer:input_type -> company.AcceptCompanyOwnershipTransferRequest
+	83, // 48: company.CompanyService.DeclineCompanyOwnershipTransfer:input_type -> company.DeclineCompanyOwnershipTransferRequest
+	84, // 49: company.CompanyService.CancelCompanyOwnershipTransfer:input_type -> company.CancelCompanyOwnershipTransferRequest
+	54, // 50: company.CompanyService.CheckColleagues:input_type -> company.CheckColleaguesRequest
+	44, // 51: company.CompanyService.CreateDepartment:input_type -> company.CreateDepartmentRequest
+	46, // 52: company.CompanyService.AddEmployeeToDepartment:input_type -> company.AddEmployeeToDepartmentRequest
+	47, // 53: company.CompanyService.GetDepartment:input_type -> company.GetDepartmentRequest
+	49, // 54: company.CompanyService.GetCompanyDepartments:input_type -> company.GetCompanyDepartmentsRequest
+	51, // 55: company.CompanyService.UpdateDepartmentTitle:input_type -> company.UpdateDepartmentTitleRequest
+	52, // 56: company.CompanyService.DeleteDepartment:input_type -> company.DeleteDepartmentRequest
+	53, // 57: company.CompanyService.RemoveEmployeeFromDepartment:input_type -> company.RemoveEmployeeFromDepartmentRequest
+	56, // 58: company.CompanyService.CreateLocation:input_type -> company.CreateLocationRequest
+	58, // 59: company.CompanyService.GetLocation:input_type -> company.GetLocationRequest
+	60, // 60: company.CompanyService.GetCompanyLocations:input_type -> company.GetCompanyLocationsRequest
+	62, // 61: company.CompanyService.UpdateLocation:input_type -> company.UpdateLocationRequest
+	63, // 62: company.CompanyService.DeleteLocation:input_type -> company.DeleteLocationRequest
+	85, // 63: company.CompanyService.GetCompanyAuditLog:input_type -> company.GetCompanyAuditLogRequest
+	10, // 64: company.CompanyService.Health:output_type -> company.HealthResponse
+	12, // 65: company.CompanyService.CreateCompany:output_type -> company.CreateCompanyResponse
+	14, // 66: company.CompanyService.GetCompany:output_type -> company.GetCompanyResponse
+	16, // 67: company.CompanyService.GetCompanies:output_type -> company.GetCompaniesResponse
+	18, // 68: company.CompanyService.GetUserCompanies:output_type -> company.GetUserCompaniesResponse
+	87, // 69: company.CompanyService.UpdateCompanyTitle:output_type -> google.protobuf.Empty
+	87, // 70: company.CompanyService.UpdateCompanyStatus:output_type -> google.protobuf.Empty
+	87, // 71: company.CompanyService.DeleteCompany:output_type -> google.protobuf.Empty
+	23, // 72: company.CompanyService.GetCompanyDeletionStatus:output_type -> company.GetCompanyDeletionStatusResponse
+	87, // 73: company.CompanyService.RestoreCompany:output_type -> google.protobuf.Empty
+	87, // 74: company.CompanyService.UpdateCompanyJoinSettings:output_type -> google.protobuf.Empty
+	27, // 75: company.CompanyService.CreateCompanyJoinCode:output_type -> company.CreateCompanyJoinCodeResponse
+	29, // 76: company.CompanyService.GetCompanyJoinCodes:output_type -> company.GetCompanyJoinCodesResponse
+	87, // 77: company.CompanyService.DeleteCompanyJoinCode:output_type -> google.protobuf.Empty
+	32, // 78: company.CompanyService.GetJoinCodeRedemptions:output_type -> company.GetJoinCodeRedemptionsResponse
+	65, // 79: company.CompanyService.GetCompanyJoinRequests:output_type -> company.GetCompanyJoinRequestsResponse
+	87, // 80: company.CompanyService.AcceptCompanyJoinRequest:output_type -> google.protobuf.Empty
+	87, // 81: company.CompanyService.DeclineCompanyJoinRequest:output_type -> google.protobuf.Empty
+	69, // 82: company.CompanyService.CreateCompanyInvitation:output_type -> company.CreateCompanyInvitationResponse
+	71, // 83: company.CompanyService.GetCompanyInvitations:output_type -> company.GetCompanyInvitationsResponse
+	87, // 84: company.CompanyService.RevokeCompanyInvitation:output_type -> google.protobuf.Empty
+	74, // 85: company.CompanyService.GetUserInvitations:output_type -> company.GetUserInvitationsResponse
+	76, // 86: company.CompanyService.AcceptCompanyInvitation:output_type -> company.AcceptCompanyInvitationResponse
+	87, // 87: company.CompanyService.DeclineCompanyInvitation:output_type -> google.protobuf.Empty
+	34, // 88: company.CompanyService.JoinCompany:output_type -> company.JoinCompanyResponse
+	36, // 89: company.CompanyService.GetCompanyEmployee:output_type -> company.GetCompanyEmployeeResponse
+	38, // 90: company.CompanyService.GetCompanyEmployees:output_type -> company.GetCompanyEmployeesResponse
+	40, // 91: company.CompanyService.GetCompanyEmployeesSummary:output_type -> company.GetCompanyEmployeesSummaryResponse
+	87, // 92: company.CompanyService.UpdateEmployeeRole:output_type -> google.protobuf.Empty
+	87, // 93: company.CompanyService.RemoveCompanyEmployee:output_type -> google.protobuf.Empty
+	87, // 94: company.CompanyService.LeaveCompany:output_type -> google.protobuf.Empty
+	79, // 95: company.CompanyService.TransferCompanyOwnership:output_type -> company.TransferCompanyOwnershipResponse
+	81, // 96: company.CompanyService.GetCompanyOwnershipTransfer:output_type -> company.GetCompanyOwnershipTransferResponse
+	87, // 97: company.CompanyService.AcceptCompanyOwnershipTransfer:output_type -> google.protobuf.Empty
+	87, // 98: company.CompanyService.DeclineCompanyOwnershipTransfer:output_type -> google.protobuf.Empty
+	87, // 99: company.CompanyService.CancelCompanyOwnershipTransfer:output_type -> google.protobuf.Empty
+	55, // 100: company.CompanyService.CheckColleagues:output_type -> company.CheckColleaguesResponse
+	45, // 101: company.CompanyService.CreateDepartment:output_type -> company.CreateDepartmentResponse
+	87, // 102: company.CompanyService.AddEmployeeToDepartment:output_type -> google.protobuf.Empty
+	48, // 103: company.CompanyService.GetDepartment:output_type -> company.GetDepartmentResponse
+	50, // 104: company.CompanyService.GetCompanyDepartments:output_type -> company.GetCompanyDepartmentsResponse
+	87, // 105: company.CompanyService.UpdateDepartmentTitle:output_type -> google.protobuf.Empty
+	87, // 106: company.CompanyService.DeleteDepartment:output_type -> google.protobuf.Empty
+	87, // 107: company.CompanyService.RemoveEmployeeFromDepartment:output_type -> google.protobuf.Empty
+	57, // 108: company.CompanyService.CreateLocation:output_type -> company.CreateLocationResponse
+	59, // 109: company.CompanyService.GetLocation:output_type -> company.GetLocationResponse
+	61, // 110: company.CompanyService.GetCompanyLocations:output_type -> company.GetCompanyLocationsResponse
+	87, // 111: company.CompanyService.UpdateLocation:output_type -> google.protobuf.Empty
+	87, // 112: company.CompanyService.DeleteLocation:output_type -> google.protobuf.Empty
+	86, // 113: company.CompanyService.GetCompanyAuditLog:output_type -> company.GetCompanyAuditLogResponse
+	64, // [64:114] is the sub-list for method output_type
+	14, // [14:64] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_company_proto_init() }
@@ -2742,13 +6220,16 @@ func file_company_proto_init() {
 	if File_company_proto != nil {
 		return
 	}
+	file_company_proto_msgTypes[8].OneofWrappers = []any{}
+	file_company_proto_msgTypes[56].OneofWrappers = []any{}
+	file_company_proto_msgTypes[62].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_company_proto_rawDesc), len(file_company_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   42,
+			NumMessages:   87,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,31 +20,56 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CompanyService_Health_FullMethodName                       = "/company.CompanyService/Health"
-	CompanyService_CreateCompany_FullMethodName                = "/company.CompanyService/CreateCompany"
-	CompanyService_GetCompany_FullMethodName                   = "/company.CompanyService/GetCompany"
-	CompanyService_GetCompanies_FullMethodName                 = "/company.CompanyService/GetCompanies"
-	CompanyService_GetUserCompanies_FullMethodName             = "/company.CompanyService/GetUserCompanies"
-	CompanyService_UpdateCompanyTitle_FullMethodName           = "/company.CompanyService/UpdateCompanyTitle"
-	CompanyService_UpdateCompanyStatus_FullMethodName          = "/company.CompanyService/UpdateCompanyStatus"
-	CompanyService_DeleteCompany_FullMethodName                = "/company.CompanyService/DeleteCompany"
-	CompanyService_CreateCompanyJoinCode_FullMethodName        = "/company.CompanyService/CreateCompanyJoinCode"
-	CompanyService_GetCompanyJoinCodes_FullMethodName          = "/company.CompanyService/GetCompanyJoinCodes"
-	CompanyService_DeleteCompanyJoinCode_FullMethodName        = "/company.CompanyService/DeleteCompanyJoinCode"
-	CompanyService_JoinCompany_FullMethodName                  = "/company.CompanyService/JoinCompany"
-	CompanyService_GetCompanyEmployee_FullMethodName           = "/company.CompanyService/GetCompanyEmployee"
-	CompanyService_GetCompanyEmployees_FullMethodName          = "/company.CompanyService/GetCompanyEmployees"
-	CompanyService_GetCompanyEmployeesSummary_FullMethodName   = "/company.CompanyService/GetCompanyEmployeesSummary"
-	CompanyService_UpdateEmployeeRole_FullMethodName           = "/company.CompanyService/UpdateEmployeeRole"
-	CompanyService_RemoveCompanyEmployee_FullMethodName        = "/company.CompanyService/RemoveCompanyEmployee"
-	CompanyService_CheckColleagues_FullMethodName              = "/company.CompanyService/CheckColleagues"
-	CompanyService_CreateDepartment_FullMethodName             = "/company.CompanyService/CreateDepartment"
-	CompanyService_AddEmployeeToDepartment_FullMethodName      = "/company.CompanyService/AddEmployeeToDepartment"
-	CompanyService_GetDepartment_FullMethodName                = "/company.CompanyService/GetDepartment"
-	CompanyService_GetCompanyDepartments_FullMethodName        = "/company.CompanyService/GetCompanyDepartments"
-	CompanyService_UpdateDepartmentTitle_FullMethodName        = "/company.CompanyService/UpdateDepartmentTitle"
-	CompanyService_DeleteDepartment_FullMethodName             = "/company.CompanyService/DeleteDepartment"
-	CompanyService_RemoveEmployeeFromDepartment_FullMethodName = "/company.CompanyService/RemoveEmployeeFromDepartment"
+	CompanyService_Health_FullMethodName                          = "/company.CompanyService/Health"
+	CompanyService_CreateCompany_FullMethodName                   = "/company.CompanyService/CreateCompany"
+	CompanyService_GetCompany_FullMethodName                      = "/company.CompanyService/GetCompany"
+	CompanyService_GetCompanies_FullMethodName                    = "/company.CompanyService/GetCompanies"
+	CompanyService_GetUserCompanies_FullMethodName                = "/company.CompanyService/GetUserCompanies"
+	CompanyService_UpdateCompanyTitle_FullMethodName              = "/company.CompanyService/UpdateCompanyTitle"
+	CompanyService_UpdateCompanyStatus_FullMethodName             = "/company.CompanyService/UpdateCompanyStatus"
+	CompanyService_DeleteCompany_FullMethodName                   = "/company.CompanyService/DeleteCompany"
+	CompanyService_GetCompanyDeletionStatus_FullMethodName        = "/company.CompanyService/GetCompanyDeletionStatus"
+	CompanyService_RestoreCompany_FullMethodName                  = "/company.CompanyService/RestoreCompany"
+	CompanyService_UpdateCompanyJoinSettings_FullMethodName       = "/company.CompanyService/UpdateCompanyJoinSettings"
+	CompanyService_CreateCompanyJoinCode_FullMethodName           = "/company.CompanyService/CreateCompanyJoinCode"
+	CompanyService_GetCompanyJoinCodes_FullMethodName             = "/company.CompanyService/GetCompanyJoinCodes"
+	CompanyService_DeleteCompanyJoinCode_FullMethodName           = "/company.CompanyService/DeleteCompanyJoinCode"
+	CompanyService_GetJoinCodeRedemptions_FullMethodName          = "/company.CompanyService/GetJoinCodeRedemptions"
+	CompanyService_GetCompanyJoinRequests_FullMethodName          = "/company.CompanyService/GetCompanyJoinRequests"
+	CompanyService_AcceptCompanyJoinRequest_FullMethodName        = "/company.CompanyService/AcceptCompanyJoinRequest"
+	CompanyService_DeclineCompanyJoinRequest_FullMethodName       = "/company.CompanyService/DeclineCompanyJoinRequest"
+	CompanyService_CreateCompanyInvitation_FullMethodName         = "/company.CompanyService/CreateCompanyInvitation"
+	CompanyService_GetCompanyInvitations_FullMethodName           = "/company.CompanyService/GetCompanyInvitations"
+	CompanyService_RevokeCompanyInvitation_FullMethodName         = "/company.CompanyService/RevokeCompanyInvitation"
+	CompanyService_GetUserInvitations_FullMethodName              = "/company.CompanyService/GetUserInvitations"
+	CompanyService_AcceptCompanyInvitation_FullMethodName         = "/company.CompanyService/AcceptCompanyInvitation"
+	CompanyService_DeclineCompanyInvitation_FullMethodName        = "/company.CompanyService/DeclineCompanyInvitation"
+	CompanyService_JoinCompany_FullMethodName                     = "/company.CompanyService/JoinCompany"
+	CompanyService_GetCompanyEmployee_FullMethodName              = "/company.CompanyService/GetCompanyEmployee"
+	CompanyService_GetCompanyEmployees_FullMethodName             = "/company.CompanyService/GetCompanyEmployees"
+	CompanyService_GetCompanyEmployeesSummary_FullMethodName      = "/company.CompanyService/GetCompanyEmployeesSummary"
+	CompanyService_UpdateEmployeeRole_FullMethodName              = "/company.CompanyService/UpdateEmployeeRole"
+	CompanyService_RemoveCompanyEmployee_FullMethodName           = "/company.CompanyService/RemoveCompanyEmployee"
+	CompanyService_LeaveCompany_FullMethodName                    = "/company.CompanyService/LeaveCompany"
+	CompanyService_TransferCompanyOwnership_FullMethodName        = "/company.CompanyService/TransferCompanyOwnership"
+	CompanyService_GetCompanyOwnershipTransfer_FullMethodName     = "/company.CompanyService/GetCompanyOwnershipTransfer"
+	CompanyService_AcceptCompanyOwnershipTransfer_FullMethodName  = "/company.CompanyService/AcceptCompanyOwnershipTransfer"
+	CompanyService_DeclineCompanyOwnershipTransfer_FullMethodName = "/company.CompanyService/DeclineCompanyOwnershipTransfer"
+	CompanyService_CancelCompanyOwnershipTransfer_FullMethodName  = "/company.CompanyService/CancelCompanyOwnershipTransfer"
+	CompanyService_CheckColleagues_FullMethodName                 = "/company.CompanyService/CheckColleagues"
+	CompanyService_CreateDepartment_FullMethodName                = "/company.CompanyService/CreateDepartment"
+	CompanyService_AddEmployeeToDepartment_FullMethodName         = "/company.CompanyService/AddEmployeeToDepartment"
+	CompanyService_GetDepartment_FullMethodName                   = "/company.CompanyService/GetDepartment"
+	CompanyService_GetCompanyDepartments_FullMethodName           = "/company.CompanyService/GetCompanyDepartments"
+	CompanyService_UpdateDepartmentTitle_FullMethodName           = "/company.CompanyService/UpdateDepartmentTitle"
+	CompanyService_DeleteDepartment_FullMethodName                = "/company.CompanyService/DeleteDepartment"
+	CompanyService_RemoveEmployeeFromDepartment_FullMethodName    = "/company.CompanyService/RemoveEmployeeFromDepartment"
+	CompanyService_CreateLocation_FullMethodName                  = "/company.CompanyService/CreateLocation"
+	CompanyService_GetLocation_FullMethodName                     = "/company.CompanyService/GetLocation"
+	CompanyService_GetCompanyLocations_FullMethodName             = "/company.CompanyService/GetCompanyLocations"
+	CompanyService_UpdateLocation_FullMethodName                  = "/company.CompanyService/UpdateLocation"
+	CompanyService_DeleteLocation_FullMethodName                  = "/company.CompanyService/DeleteLocation"
+	CompanyService_GetCompanyAuditLog_FullMethodName              = "/company.CompanyService/GetCompanyAuditLog"
 )
 
 // CompanyServiceClient is the client API for CompanyService service.
@@ -60,10 +85,25 @@ type CompanyServiceClient interface {
 	UpdateCompanyTitle(ctx context.Context, in *UpdateCompanyTitleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateCompanyStatus(ctx context.Context, in *UpdateCompanyStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteCompany(ctx context.Context, in *DeleteCompanyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetCompanyDeletionStatus(ctx context.Context, in *GetCompanyDeletionStatusRequest, opts ...grpc.CallOption) (*GetCompanyDeletionStatusResponse, error)
+	RestoreCompany(ctx context.Context, in *RestoreCompanyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateCompanyJoinSettings(ctx context.Context, in *UpdateCompanyJoinSettingsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Join code
 	CreateCompanyJoinCode(ctx context.Context, in *CreateCompanyJoinCodeRequest, opts ...grpc.CallOption) (*CreateCompanyJoinCodeResponse, error)
 	GetCompanyJoinCodes(ctx context.Context, in *GetCompanyJoinCodesRequest, opts ...grpc.CallOption) (*GetCompanyJoinCodesResponse, error)
 	DeleteCompanyJoinCode(ctx context.Context, in *DeleteCompanyJoinCodeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetJoinCodeRedemptions(ctx context.Context, in *GetJoinCodeRedemptionsRequest, opts ...grpc.CallOption) (*GetJoinCodeRedemptionsResponse, error)
+	// Join requests
+	GetCompanyJoinRequests(ctx context.Context, in *GetCompanyJoinRequestsRequest, opts ...grpc.CallOption) (*GetCompanyJoinRequestsResponse, error)
+	AcceptCompanyJoinRequest(ctx context.Context, in *AcceptCompanyJoinRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeclineCompanyJoinRequest(ctx context.Context, in *DeclineCompanyJoinRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Invitations
+	CreateCompanyInvitation(ctx context.Context, in *CreateCompanyInvitationRequest, opts ...grpc.CallOption) (*CreateCompanyInvitationResponse, error)
+	GetCompanyInvitations(ctx context.Context, in *GetCompanyInvitationsRequest, opts ...grpc.CallOption) (*GetCompanyInvitationsResponse, error)
+	RevokeCompanyInvitation(ctx context.Context, in *RevokeCompanyInvitationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetUserInvitations(ctx context.Context, in *GetUserInvitationsRequest, opts ...grpc.CallOption) (*GetUserInvitationsResponse, error)
+	AcceptCompanyInvitation(ctx context.Context, in *AcceptCompanyInvitationRequest, opts ...grpc.CallOption) (*AcceptCompanyInvitationResponse, error)
+	DeclineCompanyInvitation(ctx context.Context, in *DeclineCompanyInvitationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Employee
 	JoinCompany(ctx context.Context, in *JoinCompanyRequest, opts ...grpc.CallOption) (*JoinCompanyResponse, error)
 	GetCompanyEmployee(ctx context.Context, in *GetCompanyEmployeeRequest, opts ...grpc.CallOption) (*GetCompanyEmployeeResponse, error)
@@ -71,6 +111,13 @@ type CompanyServiceClient interface {
 	GetCompanyEmployeesSummary(ctx context.Context, in *GetCompanyEmployeesSummaryRequest, opts ...grpc.CallOption) (*GetCompanyEmployeesSummaryResponse, error)
 	UpdateEmployeeRole(ctx context.Context, in *UpdateEmployeeRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveCompanyEmployee(ctx context.Context, in *RemoveCompanyEmployeeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	LeaveCompany(ctx context.Context, in *LeaveCompanyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Ownership
+	TransferCompanyOwnership(ctx context.Context, in *TransferCompanyOwnershipRequest, opts ...grpc.CallOption) (*TransferCompanyOwnershipResponse, error)
+	GetCompanyOwnershipTransfer(ctx context.Context, in *GetCompanyOwnershipTransferRequest, opts ...grpc.CallOption) (*GetCompanyOwnershipTransferResponse, error)
+	AcceptCompanyOwnershipTransfer(ctx context.Context, in *AcceptCompanyOwnershipTransferRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeclineCompanyOwnershipTransfer(ctx context.Context, in *DeclineCompanyOwnershipTransferRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CancelCompanyOwnershipTransfer(ctx context.Context, in *CancelCompanyOwnershipTransferRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// CheckColleagues
 	CheckColleagues(ctx context.Context, in *CheckColleaguesRequest, opts ...grpc.CallOption) (*CheckColleaguesResponse, error)
 	// Departments
@@ -81,6 +128,14 @@ type CompanyServiceClient interface {
 	UpdateDepartmentTitle(ctx context.Context, in *UpdateDepartmentTitleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteDepartment(ctx context.Context, in *DeleteDepartmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveEmployeeFromDepartment(ctx context.Context, in *RemoveEmployeeFromDepartmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Locations
+	CreateLocation(ctx context.Context, in *CreateLocationRequest, opts ...grpc.CallOption) (*CreateLocationResponse, error)
+	GetLocation(ctx context.Context, in *GetLocationRequest, opts ...grpc.CallOption) (*GetLocationResponse, error)
+	GetCompanyLocations(ctx context.Context, in *GetCompanyLocationsRequest, opts ...grpc.CallOption) (*GetCompanyLocationsResponse, error)
+	UpdateLocation(ctx context.Context, in *UpdateLocationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteLocation(ctx context.Context, in *DeleteLocationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Audit log
+	GetCompanyAuditLog(ctx context.Context, in *GetCompanyAuditLogRequest, opts ...grpc.CallOption) (*GetCompanyAuditLogResponse, error)
 }
 
 type companyServiceClient struct {
@@ -171,6 +226,36 @@ func (c *companyServiceClient) DeleteCompany(ctx context.Context, in *DeleteComp
 	return out, nil
 }
 
+func (c *companyServiceClient) GetCompanyDeletionStatus(ctx context.Context, in *GetCompanyDeletionStatusRequest, opts ...grpc.CallOption) (*GetCompanyDeletionStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCompanyDeletionStatusResponse)
+	err := c.cc.Invoke(ctx, CompanyService_GetCompanyDeletionStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) RestoreCompany(ctx context.Context, in *RestoreCompanyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CompanyService_RestoreCompany_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) UpdateCompanyJoinSettings(ctx context.Context, in *UpdateCompanyJoinSettingsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CompanyService_UpdateCompanyJoinSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *companyServiceClient) CreateCompanyJoinCode(ctx context.Context, in *CreateCompanyJoinCodeRequest, opts ...grpc.CallOption) (*CreateCompanyJoinCodeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateCompanyJoinCodeResponse)
@@ -201,6 +286,106 @@ func (c *companyServiceClient) DeleteCompanyJoinCode(ctx context.Context, in *De
 	return out, nil
 }
 
+func (c *companyServiceClient) GetJoinCodeRedemptions(ctx context.Context, in *GetJoinCodeRedemptionsRequest, opts ...grpc.CallOption) (*GetJoinCodeRedemptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJoinCodeRedemptionsResponse)
+	err := c.cc.Invoke(ctx, CompanyService_GetJoinCodeRedemptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) GetCompanyJoinRequests(ctx context.Context, in *GetCompanyJoinRequestsRequest, opts ...grpc.CallOption) (*GetCompanyJoinRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCompanyJoinRequestsResponse)
+	err := c.cc.Invoke(ctx, CompanyService_GetCompanyJoinRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) AcceptCompanyJoinRequest(ctx context.Context, in *AcceptCompanyJoinRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CompanyService_AcceptCompanyJoinRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) DeclineCompanyJoinRequest(ctx context.Context, in *DeclineCompanyJoinRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CompanyService_DeclineCompanyJoinRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) CreateCompanyInvitation(ctx context.Context, in *CreateCompanyInvitationRequest, opts ...grpc.CallOption) (*CreateCompanyInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCompanyInvitationResponse)
+	err := c.cc.Invoke(ctx, CompanyService_CreateCompanyInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) GetCompanyInvitations(ctx context.Context, in *GetCompanyInvitationsRequest, opts ...grpc.CallOption) (*GetCompanyInvitationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCompanyInvitationsResponse)
+	err := c.cc.Invoke(ctx, CompanyService_GetCompanyInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) RevokeCompanyInvitation(ctx context.Context, in *RevokeCompanyInvitationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CompanyService_RevokeCompanyInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) GetUserInvitations(ctx context.Context, in *GetUserInvitationsRequest, opts ...grpc.CallOption) (*GetUserInvitationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserInvitationsResponse)
+	err := c.cc.Invoke(ctx, CompanyService_GetUserInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) AcceptCompanyInvitation(ctx context.Context, in *AcceptCompanyInvitationRequest, opts ...grpc.CallOption) (*AcceptCompanyInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptCompanyInvitationResponse)
+	err := c.cc.Invoke(ctx, CompanyService_AcceptCompanyInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) DeclineCompanyInvitation(ctx context.Context, in *DeclineCompanyInvitationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CompanyService_DeclineCompanyInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *companyServiceClient) JoinCompany(ctx context.Context, in *JoinCompanyRequest, opts ...grpc.CallOption) (*JoinCompanyResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(JoinCompanyResponse)
@@ -261,6 +446,66 @@ func (c *companyServiceClient) RemoveCompanyEmployee(ctx context.Context, in *Re
 	return out, nil
 }
 
+func (c *companyServiceClient) LeaveCompany(ctx context.Context, in *LeaveCompanyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CompanyService_LeaveCompany_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) TransferCompanyOwnership(ctx context.Context, in *TransferCompanyOwnershipRequest, opts ...grpc.CallOption) (*TransferCompanyOwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferCompanyOwnershipResponse)
+	err := c.cc.Invoke(ctx, CompanyService_TransferCompanyOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) GetCompanyOwnershipTransfer(ctx context.Context, in *GetCompanyOwnershipTransferRequest, opts ...grpc.CallOption) (*GetCompanyOwnershipTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCompanyOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, CompanyService_GetCompanyOwnershipTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) AcceptCompanyOwnershipTransfer(ctx context.Context, in *AcceptCompanyOwnershipTransferRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CompanyService_AcceptCompanyOwnershipTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) DeclineCompanyOwnershipTransfer(ctx context.Context, in *DeclineCompanyOwnershipTransferRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CompanyService_DeclineCompanyOwnershipTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) CancelCompanyOwnershipTransfer(ctx context.Context, in *CancelCompanyOwnershipTransferRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CompanyService_CancelCompanyOwnershipTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *companyServiceClient) CheckColleagues(ctx context.Context, in *CheckColleaguesRequest, opts ...grpc.CallOption) (*CheckColleaguesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CheckColleaguesResponse)
@@ -341,6 +586,66 @@ func (c *companyServiceClient) RemoveEmployeeFromDepartment(ctx context.Context,
 	return out, nil
 }
 
+func (c *companyServiceClient) CreateLocation(ctx context.Context, in *CreateLocationRequest, opts ...grpc.CallOption) (*CreateLocationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLocationResponse)
+	err := c.cc.Invoke(ctx, CompanyService_CreateLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) GetLocation(ctx context.Context, in *GetLocationRequest, opts ...grpc.CallOption) (*GetLocationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLocationResponse)
+	err := c.cc.Invoke(ctx, CompanyService_GetLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) GetCompanyLocations(ctx context.Context, in *GetCompanyLocationsRequest, opts ...grpc.CallOption) (*GetCompanyLocationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCompanyLocationsResponse)
+	err := c.cc.Invoke(ctx, CompanyService_GetCompanyLocations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) UpdateLocation(ctx context.Context, in *UpdateLocationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CompanyService_UpdateLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) DeleteLocation(ctx context.Context, in *DeleteLocationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CompanyService_DeleteLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) GetCompanyAuditLog(ctx context.Context, in *GetCompanyAuditLogRequest, opts ...grpc.CallOption) (*GetCompanyAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCompanyAuditLogResponse)
+	err := c.cc.Invoke(ctx, CompanyService_GetCompanyAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CompanyServiceServer is the server API for CompanyService service.
 // All implementations must embed UnimplementedCompanyServiceServer
 // for forward compatibility.
@@ -354,10 +659,25 @@ type CompanyServiceServer interface {
 	UpdateCompanyTitle(context.Context, *UpdateCompanyTitleRequest) (*emptypb.Empty, error)
 	UpdateCompanyStatus(context.Context, *UpdateCompanyStatusRequest) (*emptypb.Empty, error)
 	DeleteCompany(context.Context, *DeleteCompanyRequest) (*emptypb.Empty, error)
+	GetCompanyDeletionStatus(context.Context, *GetCompanyDeletionStatusRequest) (*GetCompanyDeletionStatusResponse, error)
+	RestoreCompany(context.Context, *RestoreCompanyRequest) (*emptypb.Empty, error)
+	UpdateCompanyJoinSettings(context.Context, *UpdateCompanyJoinSettingsRequest) (*emptypb.Empty, error)
 	// Join code
 	CreateCompanyJoinCode(context.Context, *CreateCompanyJoinCodeRequest) (*CreateCompanyJoinCodeResponse, error)
 	GetCompanyJoinCodes(context.Context, *GetCompanyJoinCodesRequest) (*GetCompanyJoinCodesResponse, error)
 	DeleteCompanyJoinCode(context.Context, *DeleteCompanyJoinCodeRequest) (*emptypb.Empty, error)
+	GetJoinCodeRedemptions(context.Context, *GetJoinCodeRedemptionsRequest) (*GetJoinCodeRedemptionsResponse, error)
+	// Join requests
+	GetCompanyJoinRequests(context.Context, *GetCompanyJoinRequestsRequest) (*GetCompanyJoinRequestsResponse, error)
+	AcceptCompanyJoinRequest(context.Context, *AcceptCompanyJoinRequestRequest) (*emptypb.Empty, error)
+	DeclineCompanyJoinRequest(context.Context, *DeclineCompanyJoinRequestRequest) (*emptypb.Empty, error)
+	// Invitations
+	CreateCompanyInvitation(context.Context, *CreateCompanyInvitationRequest) (*CreateCompanyInvitationResponse, error)
+	GetCompanyInvitations(context.Context, *GetCompanyInvitationsRequest) (*GetCompanyInvitationsResponse, error)
+	RevokeCompanyInvitation(context.Context, *RevokeCompanyInvitationRequest) (*emptypb.Empty, error)
+	GetUserInvitations(context.Context, *GetUserInvitationsRequest) (*GetUserInvitationsResponse, error)
+	AcceptCompanyInvitation(context.Context, *AcceptCompanyInvitationRequest) (*AcceptCompanyInvitationResponse, error)
+	DeclineCompanyInvitation(context.Context, *DeclineCompanyInvitationRequest) (*emptypb.Empty, error)
 	// Employee
 	JoinCompany(context.Context, *JoinCompanyRequest) (*JoinCompanyResponse, error)
 	GetCompanyEmployee(context.Context, *GetCompanyEmployeeRequest) (*GetCompanyEmployeeResponse, error)
@@ -365,6 +685,13 @@ type CompanyServiceServer interface {
 	GetCompanyEmployeesSummary(context.Context, *GetCompanyEmployeesSummaryRequest) (*GetCompanyEmployeesSummaryResponse, error)
 	UpdateEmployeeRole(context.Context, *UpdateEmployeeRoleRequest) (*emptypb.Empty, error)
 	RemoveCompanyEmployee(context.Context, *RemoveCompanyEmployeeRequest) (*emptypb.Empty, error)
+	LeaveCompany(context.Context, *LeaveCompanyRequest) (*emptypb.Empty, error)
+	// Ownership
+	TransferCompanyOwnership(context.Context, *TransferCompanyOwnershipRequest) (*TransferCompanyOwnershipResponse, error)
+	GetCompanyOwnershipTransfer(context.Context, *GetCompanyOwnershipTransferRequest) (*GetCompanyOwnershipTransferResponse, error)
+	AcceptCompanyOwnershipTransfer(context.Context, *AcceptCompanyOwnershipTransferRequest) (*emptypb.Empty, error)
+	DeclineCompanyOwnershipTransfer(context.Context, *DeclineCompanyOwnershipTransferRequest) (*emptypb.Empty, error)
+	CancelCompanyOwnershipTransfer(context.Context, *CancelCompanyOwnershipTransferRequest) (*emptypb.Empty, error)
 	// CheckColleagues
 	CheckColleagues(context.Context, *CheckColleaguesRequest) (*CheckColleaguesResponse, error)
 	// Departments
@@ -375,6 +702,14 @@ type CompanyServiceServer interface {
 	UpdateDepartmentTitle(context.Context, *UpdateDepartmentTitleRequest) (*emptypb.Empty, error)
 	DeleteDepartment(context.Context, *DeleteDepartmentRequest) (*emptypb.Empty, error)
 	RemoveEmployeeFromDepartment(context.Context, *RemoveEmployeeFromDepartmentRequest) (*emptypb.Empty, error)
+	// Locations
+	CreateLocation(context.Context, *CreateLocationRequest) (*CreateLocationResponse, error)
+	GetLocation(context.Context, *GetLocationRequest) (*GetLocationResponse, error)
+	GetCompanyLocations(context.Context, *GetCompanyLocationsRequest) (*GetCompanyLocationsResponse, error)
+	UpdateLocation(context.Context, *UpdateLocationRequest) (*emptypb.Empty, error)
+	DeleteLocation(context.Context, *DeleteLocationRequest) (*emptypb.Empty, error)
+	// Audit log
+	GetCompanyAuditLog(context.Context, *GetCompanyAuditLogRequest) (*GetCompanyAuditLogResponse, error)
 	mustEmbedUnimplementedCompanyServiceServer()
 }
 
@@ -409,6 +744,15 @@ func (UnimplementedCompanyServiceServer) UpdateCompanyStatus(context.Context, *U
 func (UnimplementedCompanyServiceServer) DeleteCompany(context.Context, *DeleteCompanyRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCompany not implemented")
 }
+func (UnimplementedCompanyServiceServer) GetCompanyDeletionStatus(context.Context, *GetCompanyDeletionStatusRequest) (*GetCompanyDeletionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompanyDeletionStatus not implemented")
+}
+func (UnimplementedCompanyServiceServer) RestoreCompany(context.Context, *RestoreCompanyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCompany not implemented")
+}
+func (UnimplementedCompanyServiceServer) UpdateCompanyJoinSettings(context.Context, *UpdateCompanyJoinSettingsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCompanyJoinSettings not implemented")
+}
 func (UnimplementedCompanyServiceServer) CreateCompanyJoinCode(context.Context, *CreateCompanyJoinCodeRequest) (*CreateCompanyJoinCodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCompanyJoinCode not implemented")
 }
@@ -418,6 +762,36 @@ func (UnimplementedCompanyServiceServer) GetCompanyJoinCodes(context.Context, *G
 func (UnimplementedCompanyServiceServer) DeleteCompanyJoinCode(context.Context, *DeleteCompanyJoinCodeRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCompanyJoinCode not implemented")
 }
+func (UnimplementedCompanyServiceServer) GetJoinCodeRedemptions(context.Context, *GetJoinCodeRedemptionsRequest) (*GetJoinCodeRedemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJoinCodeRedemptions not implemented")
+}
+func (UnimplementedCompanyServiceServer) GetCompanyJoinRequests(context.Context, *GetCompanyJoinRequestsRequest) (*GetCompanyJoinRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompanyJoinRequests not implemented")
+}
+func (UnimplementedCompanyServiceServer) AcceptCompanyJoinRequest(context.Context, *AcceptCompanyJoinRequestRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptCompanyJoinRequest not implemented")
+}
+func (UnimplementedCompanyServiceServer) DeclineCompanyJoinRequest(context.Context, *DeclineCompanyJoinRequestRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineCompanyJoinRequest not implemented")
+}
+func (UnimplementedCompanyServiceServer) CreateCompanyInvitation(context.Context, *CreateCompanyInvitationRequest) (*CreateCompanyInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCompanyInvitation not implemented")
+}
+func (UnimplementedCompanyServiceServer) GetCompanyInvitations(context.Context, *GetCompanyInvitationsRequest) (*GetCompanyInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompanyInvitations not implemented")
+}
+func (UnimplementedCompanyServiceServer) RevokeCompanyInvitation(context.Context, *RevokeCompanyInvitationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCompanyInvitation not implemented")
+}
+func (UnimplementedCompanyServiceServer) GetUserInvitations(context.Context, *GetUserInvitationsRequest) (*GetUserInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserInvitations not implemented")
+}
+func (UnimplementedCompanyServiceServer) AcceptCompanyInvitation(context.Context, *AcceptCompanyInvitationRequest) (*AcceptCompanyInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptCompanyInvitation not implemented")
+}
+func (UnimplementedCompanyServiceServer) DeclineCompanyInvitation(context.Context, *DeclineCompanyInvitationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineCompanyInvitation not implemented")
+}
 func (UnimplementedCompanyServiceServer) JoinCompany(context.Context, *JoinCompanyRequest) (*JoinCompanyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method JoinCompany not implemented")
 }
@@ -436,6 +810,24 @@ func (UnimplementedCompanyServiceServer) UpdateEmployeeRole(context.Context, *Up
 func (UnimplementedCompanyServiceServer) RemoveCompanyEmployee(context.Context, *RemoveCompanyEmployeeRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveCompanyEmployee not implemented")
 }
+func (UnimplementedCompanyServiceServer) LeaveCompany(context.Context, *LeaveCompanyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveCompany not implemented")
+}
+func (UnimplementedCompanyServiceServer) TransferCompanyOwnership(context.Context, *TransferCompanyOwnershipRequest) (*TransferCompanyOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferCompanyOwnership not implemented")
+}
+func (UnimplementedCompanyServiceServer) GetCompanyOwnershipTransfer(context.Context, *GetCompanyOwnershipTransferRequest) (*GetCompanyOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompanyOwnershipTransfer not implemented")
+}
+func (UnimplementedCompanyServiceServer) AcceptCompanyOwnershipTransfer(context.Context, *AcceptCompanyOwnershipTransferRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptCompanyOwnershipTransfer not implemented")
+}
+func (UnimplementedCompanyServiceServer) DeclineCompanyOwnershipTransfer(context.Context, *DeclineCompanyOwnershipTransferRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineCompanyOwnershipTransfer not implemented")
+}
+func (UnimplementedCompanyServiceServer) CancelCompanyOwnershipTransfer(context.Context, *CancelCompanyOwnershipTransferRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCompanyOwnershipTransfer not implemented")
+}
 func (UnimplementedCompanyServiceServer) CheckColleagues(context.Context, *CheckColleaguesRequest) (*CheckColleaguesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckColleagues not implemented")
 }
@@ -460,6 +852,24 @@ func (UnimplementedCompanyServiceServer) DeleteDepartment(context.Context, *Dele
 func (UnimplementedCompanyServiceServer) RemoveEmployeeFromDepartment(context.Context, *RemoveEmployeeFromDepartmentRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveEmployeeFromDepartment not implemented")
 }
+func (UnimplementedCompanyServiceServer) CreateLocation(context.Context, *CreateLocationRequest) (*CreateLocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLocation not implemented")
+}
+func (UnimplementedCompanyServiceServer) GetLocation(context.Context, *GetLocationRequest) (*GetLocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLocation not implemented")
+}
+func (UnimplementedCompanyServiceServer) GetCompanyLocations(context.Context, *GetCompanyLocationsRequest) (*GetCompanyLocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompanyLocations not implemented")
+}
+func (UnimplementedCompanyServiceServer) UpdateLocation(context.Context, *UpdateLocationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLocation not implemented")
+}
+func (UnimplementedCompanyServiceServer) DeleteLocation(context.Context, *DeleteLocationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLocation not implemented")
+}
+func (UnimplementedCompanyServiceServer) GetCompanyAuditLog(context.Context, *GetCompanyAuditLogRequest) (*GetCompanyAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompanyAuditLog not implemented")
+}
 func (UnimplementedCompanyServiceServer) mustEmbedUnimplementedCompanyServiceServer() {}
 func (UnimplementedCompanyServiceServer) testEmbeddedByValue()                        {}
 
@@ -625,110 +1035,344 @@ func _CompanyService_DeleteCompany_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompanyService_CreateCompanyJoinCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCompanyJoinCodeRequest)
+func _CompanyService_GetCompanyDeletionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanyDeletionStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompanyServiceServer).CreateCompanyJoinCode(ctx, in)
+		return srv.(CompanyServiceServer).GetCompanyDeletionStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompanyService_CreateCompanyJoinCode_FullMethodName,
+		FullMethod: CompanyService_GetCompanyDeletionStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompanyServiceServer).CreateCompanyJoinCode(ctx, req.(*CreateCompanyJoinCodeRequest))
+		return srv.(CompanyServiceServer).GetCompanyDeletionStatus(ctx, req.(*GetCompanyDeletionStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompanyService_GetCompanyJoinCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCompanyJoinCodesRequest)
+func _CompanyService_RestoreCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCompanyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompanyServiceServer).GetCompanyJoinCodes(ctx, in)
+		return srv.(CompanyServiceServer).RestoreCompany(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompanyService_GetCompanyJoinCodes_FullMethodName,
+		FullMethod: CompanyService_RestoreCompany_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompanyServiceServer).GetCompanyJoinCodes(ctx, req.(*GetCompanyJoinCodesRequest))
+		return srv.(CompanyServiceServer).RestoreCompany(ctx, req.(*RestoreCompanyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompanyService_DeleteCompanyJoinCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCompanyJoinCodeRequest)
+func _CompanyService_UpdateCompanyJoinSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCompanyJoinSettingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompanyServiceServer).DeleteCompanyJoinCode(ctx, in)
+		return srv.(CompanyServiceServer).UpdateCompanyJoinSettings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompanyService_DeleteCompanyJoinCode_FullMethodName,
+		FullMethod: CompanyService_UpdateCompanyJoinSettings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompanyServiceServer).DeleteCompanyJoinCode(ctx, req.(*DeleteCompanyJoinCodeRequest))
+		return srv.(CompanyServiceServer).UpdateCompanyJoinSettings(ctx, req.(*UpdateCompanyJoinSettingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompanyService_JoinCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JoinCompanyRequest)
+func _CompanyService_CreateCompanyJoinCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCompanyJoinCodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompanyServiceServer).JoinCompany(ctx, in)
+		return srv.(CompanyServiceServer).CreateCompanyJoinCode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompanyService_JoinCompany_FullMethodName,
+		FullMethod: CompanyService_CreateCompanyJoinCode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompanyServiceServer).JoinCompany(ctx, req.(*JoinCompanyRequest))
+		return srv.(CompanyServiceServer).CreateCompanyJoinCode(ctx, req.(*CreateCompanyJoinCodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompanyService_GetCompanyEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCompanyEmployeeRequest)
+func _CompanyService_GetCompanyJoinCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanyJoinCodesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompanyServiceServer).GetCompanyEmployee(ctx, in)
+		return srv.(CompanyServiceServer).GetCompanyJoinCodes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompanyService_GetCompanyEmployee_FullMethodName,
+		FullMethod: CompanyService_GetCompanyJoinCodes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompanyServiceServer).GetCompanyEmployee(ctx, req.(*GetCompanyEmployeeRequest))
+		return srv.(CompanyServiceServer).GetCompanyJoinCodes(ctx, req.(*GetCompanyJoinCodesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompanyService_GetCompanyEmployees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCompanyEmployeesRequest)
+func _CompanyService_DeleteCompanyJoinCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCompanyJoinCodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompanyServiceServer).GetCompanyEmployees(ctx, in)
+		return srv.(CompanyServiceServer).DeleteCompanyJoinCode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompanyService_GetCompanyEmployees_FullMethodName,
+		FullMethod: CompanyService_DeleteCompanyJoinCode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompanyServiceServer).GetCompanyEmployees(ctx, req.(*GetCompanyEmployeesRequest))
+		return srv.(CompanyServiceServer).DeleteCompanyJoinCode(ctx, req.(*DeleteCompanyJoinCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_GetJoinCodeRedemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJoinCodeRedemptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).GetJoinCodeRedemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_GetJoinCodeRedemptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).GetJoinCodeRedemptions(ctx, req.(*GetJoinCodeRedemptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_GetCompanyJoinRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanyJoinRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).GetCompanyJoinRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_GetCompanyJoinRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).GetCompanyJoinRequests(ctx, req.(*GetCompanyJoinRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_AcceptCompanyJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptCompanyJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).AcceptCompanyJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_AcceptCompanyJoinRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).AcceptCompanyJoinRequest(ctx, req.(*AcceptCompanyJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_DeclineCompanyJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineCompanyJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).DeclineCompanyJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_DeclineCompanyJoinRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).DeclineCompanyJoinRequest(ctx, req.(*DeclineCompanyJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_CreateCompanyInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCompanyInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).CreateCompanyInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_CreateCompanyInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).CreateCompanyInvitation(ctx, req.(*CreateCompanyInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_GetCompanyInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanyInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).GetCompanyInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_GetCompanyInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).GetCompanyInvitations(ctx, req.(*GetCompanyInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_RevokeCompanyInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCompanyInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).RevokeCompanyInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_RevokeCompanyInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).RevokeCompanyInvitation(ctx, req.(*RevokeCompanyInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_GetUserInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).GetUserInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_GetUserInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).GetUserInvitations(ctx, req.(*GetUserInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_AcceptCompanyInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptCompanyInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).AcceptCompanyInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_AcceptCompanyInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).AcceptCompanyInvitation(ctx, req.(*AcceptCompanyInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_DeclineCompanyInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineCompanyInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).DeclineCompanyInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_DeclineCompanyInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).DeclineCompanyInvitation(ctx, req.(*DeclineCompanyInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_JoinCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).JoinCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_JoinCompany_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).JoinCompany(ctx, req.(*JoinCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_GetCompanyEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanyEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).GetCompanyEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_GetCompanyEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).GetCompanyEmployee(ctx, req.(*GetCompanyEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_GetCompanyEmployees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanyEmployeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).GetCompanyEmployees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_GetCompanyEmployees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).GetCompanyEmployees(ctx, req.(*GetCompanyEmployeesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -787,6 +1431,114 @@ func _CompanyService_RemoveCompanyEmployee_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CompanyService_LeaveCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).LeaveCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_LeaveCompany_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).LeaveCompany(ctx, req.(*LeaveCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_TransferCompanyOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferCompanyOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).TransferCompanyOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_TransferCompanyOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).TransferCompanyOwnership(ctx, req.(*TransferCompanyOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_GetCompanyOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanyOwnershipTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).GetCompanyOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_GetCompanyOwnershipTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).GetCompanyOwnershipTransfer(ctx, req.(*GetCompanyOwnershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_AcceptCompanyOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptCompanyOwnershipTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).AcceptCompanyOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_AcceptCompanyOwnershipTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).AcceptCompanyOwnershipTransfer(ctx, req.(*AcceptCompanyOwnershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_DeclineCompanyOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineCompanyOwnershipTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).DeclineCompanyOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_DeclineCompanyOwnershipTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).DeclineCompanyOwnershipTransfer(ctx, req.(*DeclineCompanyOwnershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_CancelCompanyOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCompanyOwnershipTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).CancelCompanyOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_CancelCompanyOwnershipTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).CancelCompanyOwnershipTransfer(ctx, req.(*CancelCompanyOwnershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CompanyService_CheckColleagues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckColleaguesRequest)
 	if err := dec(in); err != nil {
@@ -931,6 +1683,114 @@ func _CompanyService_RemoveEmployeeFromDepartment_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CompanyService_CreateLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).CreateLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_CreateLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).CreateLocation(ctx, req.(*CreateLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_GetLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).GetLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_GetLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).GetLocation(ctx, req.(*GetLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_GetCompanyLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanyLocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).GetCompanyLocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_GetCompanyLocations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).GetCompanyLocations(ctx, req.(*GetCompanyLocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_UpdateLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).UpdateLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_UpdateLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).UpdateLocation(ctx, req.(*UpdateLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_DeleteLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).DeleteLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_DeleteLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).DeleteLocation(ctx, req.(*DeleteLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_GetCompanyAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanyAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).GetCompanyAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_GetCompanyAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).GetCompanyAuditLog(ctx, req.(*GetCompanyAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CompanyService_ServiceDesc is the grpc.ServiceDesc for CompanyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -970,6 +1830,18 @@ var CompanyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCompany",
 			Handler:    _CompanyService_DeleteCompany_Handler,
 		},
+		{
+			MethodName: "GetCompanyDeletionStatus",
+			Handler:    _CompanyService_GetCompanyDeletionStatus_Handler,
+		},
+		{
+			MethodName: "RestoreCompany",
+			Handler:    _CompanyService_RestoreCompany_Handler,
+		},
+		{
+			MethodName: "UpdateCompanyJoinSettings",
+			Handler:    _CompanyService_UpdateCompanyJoinSettings_Handler,
+		},
 		{
 			MethodName: "CreateCompanyJoinCode",
 			Handler:    _CompanyService_CreateCompanyJoinCode_Handler,
@@ -982,6 +1854,46 @@ var CompanyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCompanyJoinCode",
 			Handler:    _CompanyService_DeleteCompanyJoinCode_Handler,
 		},
+		{
+			MethodName: "GetJoinCodeRedemptions",
+			Handler:    _CompanyService_GetJoinCodeRedemptions_Handler,
+		},
+		{
+			MethodName: "GetCompanyJoinRequests",
+			Handler:    _CompanyService_GetCompanyJoinRequests_Handler,
+		},
+		{
+			MethodName: "AcceptCompanyJoinRequest",
+			Handler:    _CompanyService_AcceptCompanyJoinRequest_Handler,
+		},
+		{
+			MethodName: "DeclineCompanyJoinRequest",
+			Handler:    _CompanyService_DeclineCompanyJoinRequest_Handler,
+		},
+		{
+			MethodName: "CreateCompanyInvitation",
+			Handler:    _CompanyService_CreateCompanyInvitation_Handler,
+		},
+		{
+			MethodName: "GetCompanyInvitations",
+			Handler:    _CompanyService_GetCompanyInvitations_Handler,
+		},
+		{
+			MethodName: "RevokeCompanyInvitation",
+			Handler:    _CompanyService_RevokeCompanyInvitation_Handler,
+		},
+		{
+			MethodName: "GetUserInvitations",
+			Handler:    _CompanyService_GetUserInvitations_Handler,
+		},
+		{
+			MethodName: "AcceptCompanyInvitation",
+			Handler:    _CompanyService_AcceptCompanyInvitation_Handler,
+		},
+		{
+			MethodName: "DeclineCompanyInvitation",
+			Handler:    _CompanyService_DeclineCompanyInvitation_Handler,
+		},
 		{
 			MethodName: "JoinCompany",
 			Handler:    _CompanyService_JoinCompany_Handler,
@@ -1006,6 +1918,30 @@ var CompanyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveCompanyEmployee",
 			Handler:    _CompanyService_RemoveCompanyEmployee_Handler,
 		},
+		{
+			MethodName: "LeaveCompany",
+			Handler:    _CompanyService_LeaveCompany_Handler,
+		},
+		{
+			MethodName: "TransferCompanyOwnership",
+			Handler:    _CompanyService_TransferCompanyOwnership_Handler,
+		},
+		{
+			MethodName: "GetCompanyOwnershipTransfer",
+			Handler:    _CompanyService_GetCompanyOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "AcceptCompanyOwnershipTransfer",
+			Handler:    _CompanyService_AcceptCompanyOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "DeclineCompanyOwnershipTransfer",
+			Handler:    _CompanyService_DeclineCompanyOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "CancelCompanyOwnershipTransfer",
+			Handler:    _CompanyService_CancelCompanyOwnershipTransfer_Handler,
+		},
 		{
 			MethodName: "CheckColleagues",
 			Handler:    _CompanyService_CheckColleagues_Handler,
@@ -1038,6 +1974,30 @@ var CompanyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveEmployeeFromDepartment",
 			Handler:    _CompanyService_RemoveEmployeeFromDepartment_Handler,
 		},
+		{
+			MethodName: "CreateLocation",
+			Handler:    _CompanyService_CreateLocation_Handler,
+		},
+		{
+			MethodName: "GetLocation",
+			Handler:    _CompanyService_GetLocation_Handler,
+		},
+		{
+			MethodName: "GetCompanyLocations",
+			Handler:    _CompanyService_GetCompanyLocations_Handler,
+		},
+		{
+			MethodName: "UpdateLocation",
+			Handler:    _CompanyService_UpdateLocation_Handler,
+		},
+		{
+			MethodName: "DeleteLocation",
+			Handler:    _CompanyService_DeleteLocation_Handler,
+		},
+		{
+			MethodName: "GetCompanyAuditLog",
+			Handler:    _CompanyService_GetCompanyAuditLog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "company.proto",
@@ -1,10 +1,18 @@
 package e2e
 
 import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -1571,3 +1579,1032 @@ func TestGetApplicationHistory(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, code)
 	})
 }
+
+// ─── TestApplicationAttachments ───────────────────────────────────────────────
+
+func TestApplicationAttachments(t *testing.T) {
+	c := newClient()
+	env := mustSetupAppEnv(t, c) // создаём окружение один раз на все подтесты
+
+	photo := []byte("\xff\xd8\xff\xe0 fake jpeg body")
+
+	// creator_uploads_on_create — инспектор прикладывает фото дефекта к новой заявке.
+	t.Run("creator_uploads_on_create", func(t *testing.T) {
+		appUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID,
+			"Cracked wall", "Crack in the wall on floor 4.")
+
+		attachment := mustUploadAttachment(t, env.Inspector, appUUID, "", "crack.jpg", "image/jpeg", photo)
+		assert.Equal(t, "crack.jpg", attachment.FileName)
+		assert.Equal(t, int64(len(photo)), attachment.Size)
+
+		app := mustGetApplicationDetail(t, env.Inspector, appUUID)
+		require.Len(t, app.Attachments, 1)
+		assert.Equal(t, attachment.AttachmentUUID, app.Attachments[0].AttachmentUUID)
+	})
+
+	// engineer_fix_log_photo — инженер прикладывает фото "после" к своей записи fix log-а.
+	t.Run("engineer_fix_log_photo", func(t *testing.T) {
+		appUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID,
+			"Broken window", "Window glass is broken.")
+		mustAssignApplication(t, env.Manager, appUUID, env.EngineerUUID)
+		fixLogUUID := mustAddFixLog(t, env.Engineer, appUUID, "Replaced the glass.")
+
+		attachment := mustUploadAttachment(t, env.Engineer, appUUID, fixLogUUID, "after.pdf", "application/pdf", []byte("%PDF-1.4"))
+		assert.Equal(t, fixLogUUID, attachment.FixLogUUID)
+
+		app := mustGetApplicationDetail(t, env.Manager, appUUID)
+		require.Len(t, app.FixLogs, 1)
+		require.Len(t, app.FixLogs[0].Attachments, 1)
+		assert.Equal(t, attachment.AttachmentUUID, app.FixLogs[0].Attachments[0].AttachmentUUID)
+		assert.Empty(t, app.Attachments, "fix log attachments must not be listed on the application itself")
+	})
+
+	// download — участник скачивает вложение с исходным типом и содержимым.
+	t.Run("download", func(t *testing.T) {
+		appUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID,
+			"Download attachment", "Desc.")
+		attachment := mustUploadAttachment(t, env.Inspector, appUUID, "", "defect.jpg", "image/jpeg", photo)
+
+		code, body := env.Chief.get("/api/auth/application/" + appUUID + "/attachments/" + attachment.AttachmentUUID)
+		require.Equal(t, http.StatusOK, code, "chief should download any attachment (body: %s)", body)
+		assert.Equal(t, photo, body)
+	})
+
+	// download_denied — сотрудник другого отдела не видит вложения чужой заявки.
+	t.Run("download_denied", func(t *testing.T) {
+		appUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID,
+			"Download denied", "Desc.")
+		attachment := mustUploadAttachment(t, env.Inspector, appUUID, "", "defect.jpg", "image/jpeg", photo)
+
+		code, _ := env.Engineer2.get("/api/auth/application/" + appUUID + "/attachments/" + attachment.AttachmentUUID)
+		assert.Equal(t, http.StatusForbidden, code)
+	})
+
+	// creator_after_assign_denied — после назначения создатель больше не добавляет вложения.
+	t.Run("creator_after_assign_denied", func(t *testing.T) {
+		appUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID,
+			"Upload after assign", "Desc.")
+		mustAssignApplication(t, env.Manager, appUUID, env.EngineerUUID)
+
+		code, body := env.Inspector.upload("/api/auth/application/"+appUUID+"/attachments", "late.jpg", "image/jpeg", photo, nil)
+		assert.Equal(t, http.StatusForbidden, code, "body: %s", body)
+	})
+
+	// unsupported_type — исполняемые файлы не принимаются.
+	t.Run("unsupported_type", func(t *testing.T) {
+		appUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID,
+			"Unsupported attachment", "Desc.")
+
+		code, _ := env.Inspector.upload("/api/auth/application/"+appUUID+"/attachments", "tool.exe", "application/x-msdownload", []byte("MZ"), nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	// delete — автор удаляет вложение, после чего оно недоступно.
+	t.Run("delete", func(t *testing.T) {
+		appUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID,
+			"Delete attachment", "Desc.")
+		attachment := mustUploadAttachment(t, env.Inspector, appUUID, "", "defect.jpg", "image/jpeg", photo)
+		path := "/api/auth/application/" + appUUID + "/attachments/" + attachment.AttachmentUUID
+
+		code, body := env.Inspector2.delete(path, nil)
+		assert.Equal(t, http.StatusForbidden, code, "only the author can delete attachment (body: %s)", body)
+
+		code, body = env.Inspector.delete(path, nil)
+		require.Equal(t, http.StatusOK, code, "body: %s", body)
+
+		code, _ = env.Inspector.get(path)
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+}
+
+// ─── TestWatchApplications ────────────────────────────────────────────────────
+
+func TestWatchApplications(t *testing.T) {
+	c := newClient()
+	env := mustSetupAppEnv(t, c) // создаём окружение один раз на все подтесты
+
+	// manager_pool_updates — менеджер видит новую заявку в пуле и её уход из пула после назначения.
+	t.Run("manager_pool_updates", func(t *testing.T) {
+		code, stream, body := openApplicationStream(t, env.Manager, env.CompanyUUID, "from_pool=true")
+		require.Equalf(t, http.StatusOK, code, "body: %s", body)
+
+		appUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID,
+			"Stream pool app", "Application to verify pool stream.")
+
+		update := stream.mustNextApplicationUpdate(t, appUUID)
+		assert.Equal(t, "application.created", update.EventType)
+		assert.Equal(t, "created", update.Status)
+		assert.False(t, update.Removed)
+
+		mustAssignApplication(t, env.Manager, appUUID, env.EngineerUUID)
+
+		update = stream.mustNextApplicationUpdate(t, appUUID)
+		assert.Equal(t, "application.assigned", update.EventType)
+		assert.Equal(t, "assigned", update.Status)
+		assert.True(t, update.Removed, "assigned application should leave manager pool")
+	})
+
+	// inspector_pending_verification — инспектор получает заявку, перешедшую в pending_verification.
+	t.Run("inspector_pending_verification", func(t *testing.T) {
+		appUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID,
+			"Stream verification app", "Application advanced to pending_verification.")
+		mustAssignApplication(t, env.Manager, appUUID, env.EngineerUUID)
+		mustSetAppStatus(t, env.Engineer, appUUID, "in_progress")
+
+		code, stream, body := openApplicationStream(t, env.Inspector2, env.CompanyUUID, "from_pool=true")
+		require.Equalf(t, http.StatusOK, code, "body: %s", body)
+
+		mustSetAppStatus(t, env.Engineer, appUUID, "pending_verification")
+
+		update := stream.mustNextApplicationUpdate(t, appUUID)
+		assert.Equal(t, "pending_verification", update.Status)
+		assert.False(t, update.Removed)
+	})
+
+	// engineer_invalid_statuses — фильтр проверяется так же, как для списка заявок.
+	t.Run("engineer_invalid_statuses", func(t *testing.T) {
+		code, _, _ := openApplicationStream(t, env.Engineer, env.CompanyUUID, "statuses=created")
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	// outsider_forbidden — пользователь не из компании не может подписаться.
+	t.Run("outsider_forbidden", func(t *testing.T) {
+		_, login := mustRegisterAndLogin(t, c)
+		code, _, _ := openApplicationStream(t, c.withToken(login.AccessToken), env.CompanyUUID, "")
+		assert.NotEqual(t, http.StatusOK, code)
+	})
+
+	// no_token — без токена поток недоступен.
+	t.Run("no_token", func(t *testing.T) {
+		code, _, _ := openApplicationStream(t, c, env.CompanyUUID, "")
+		assert.Equal(t, http.StatusUnauthorized, code)
+	})
+}
+
+// ─── Workflow ─────────────────────────────────────────────────────────────────
+
+func TestApplicationWorkflow(t *testing.T) {
+	c := newClient()
+	env := mustSetupAppEnv(t, c)
+
+	// withoutStatus возвращает workflow без статуса и ведущих в него переходов.
+	withoutStatus := func(wf workflowDefinition, state string) workflowDefinition {
+		res := workflowDefinition{}
+		for _, s := range wf.States {
+			if s != state {
+				res.States = append(res.States, s)
+			}
+		}
+		for _, tr := range wf.Transitions {
+			if tr.To == state {
+				continue
+			}
+			from := make([]string, 0, len(tr.From))
+			for _, s := range tr.From {
+				if s != state {
+					from = append(from, s)
+				}
+			}
+			tr.From = from
+			res.Transitions = append(res.Transitions, tr)
+		}
+		return res
+	}
+
+	// default_workflow — компания без настройки использует workflow по умолчанию.
+	t.Run("default_workflow", func(t *testing.T) {
+		wf := mustGetCompanyWorkflow(t, env.Engineer, env.CompanyUUID)
+		assert.True(t, wf.IsDefault)
+		assert.Contains(t, wf.Workflow.States, "on_hold")
+		assert.NotEmpty(t, wf.Workflow.Transitions)
+	})
+
+	// actions_by_role — доступные действия зависят от роли и ответственности за заявку.
+	t.Run("actions_by_role", func(t *testing.T) {
+		appUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID,
+			"Workflow actions app", "Application to verify available actions.")
+
+		assert.ElementsMatch(t, []string{"assign", "redirect", "reject"}, mustGetApplicationActions(t, env.Manager, appUUID))
+		assert.ElementsMatch(t, []string{"delete"}, mustGetApplicationActions(t, env.Inspector, appUUID))
+		assert.Empty(t, mustGetApplicationActions(t, env.Engineer, appUUID))
+
+		mustAssignApplication(t, env.Manager, appUUID, env.EngineerUUID)
+		assert.ElementsMatch(t, []string{"start_work", "hold", "submit_verification"}, mustGetApplicationActions(t, env.Engineer, appUUID))
+		assert.ElementsMatch(t, []string{"recall"}, mustGetApplicationActions(t, env.Manager, appUUID))
+		assert.Empty(t, mustGetApplicationActions(t, env.Engineer2, appUUID))
+	})
+
+	// non_chief_forbidden — настраивать workflow может только chief.
+	t.Run("non_chief_forbidden", func(t *testing.T) {
+		wf := mustGetCompanyWorkflow(t, env.Manager, env.CompanyUUID)
+		code, _ := env.Manager.put("/api/auth/company/"+env.CompanyUUID+"/workflow",
+			map[string]any{"workflow": withoutStatus(wf.Workflow, "on_hold")})
+		assert.Equal(t, http.StatusForbidden, code)
+
+		code, _ = env.Manager.delete("/api/auth/company/"+env.CompanyUUID+"/workflow", nil)
+		assert.Equal(t, http.StatusForbidden, code)
+	})
+
+	// invalid_workflow — workflow с неизвестным статусом отклоняется.
+	t.Run("invalid_workflow", func(t *testing.T) {
+		wf := mustGetCompanyWorkflow(t, env.Chief, env.CompanyUUID).Workflow
+		wf.States = append(wf.States, "archived")
+		code, _ := env.Chief.put("/api/auth/company/"+env.CompanyUUID+"/workflow", map[string]any{"workflow": wf})
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	// disable_on_hold — chief отключает шаг on_hold, инженер больше не может его выбрать.
+	t.Run("disable_on_hold", func(t *testing.T) {
+		appUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID,
+			"Workflow on_hold app", "Application to verify disabled step.")
+		mustAssignApplication(t, env.Manager, appUUID, env.EngineerUUID)
+
+		wf := mustGetCompanyWorkflow(t, env.Chief, env.CompanyUUID)
+		code, body := env.Chief.put("/api/auth/company/"+env.CompanyUUID+"/workflow",
+			map[string]any{"workflow": withoutStatus(wf.Workflow, "on_hold")})
+		require.Equalf(t, http.StatusOK, code, "body: %s", body)
+
+		updated := mustGetCompanyWorkflow(t, env.Engineer, env.CompanyUUID)
+		assert.False(t, updated.IsDefault)
+		assert.NotContains(t, updated.Workflow.States, "on_hold")
+
+		assert.ElementsMatch(t, []string{"start_work", "submit_verification"}, mustGetApplicationActions(t, env.Engineer, appUUID))
+
+		code, _ = env.Engineer.patch("/api/auth/application/"+appUUID+"/status", map[string]string{"status": "on_hold"})
+		assert.Equal(t, http.StatusPreconditionFailed, code)
+
+		mustSetAppStatus(t, env.Engineer, appUUID, "in_progress")
+	})
+
+	// remove_state_with_applications — статус нельзя убрать, пока в нём есть заявки.
+	t.Run("remove_state_with_applications", func(t *testing.T) {
+		code, body := env.Chief.delete("/api/auth/company/"+env.CompanyUUID+"/workflow", nil)
+		require.Equalf(t, http.StatusOK, code, "body: %s", body)
+
+		appUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID,
+			"Workflow held app", "Application kept on hold.")
+		mustAssignApplication(t, env.Manager, appUUID, env.EngineerUUID)
+		mustSetAppStatus(t, env.Engineer, appUUID, "on_hold")
+
+		wf := mustGetCompanyWorkflow(t, env.Chief, env.CompanyUUID)
+		assert.True(t, wf.IsDefault)
+		code, _ = env.Chief.put("/api/auth/company/"+env.CompanyUUID+"/workflow",
+			map[string]any{"workflow": withoutStatus(wf.Workflow, "on_hold")})
+		assert.Equal(t, http.StatusPreconditionFailed, code)
+	})
+}
+
+// ─── SLA ──────────────────────────────────────────────────────────────────────
+
+func TestApplicationSLA(t *testing.T) {
+	c := newClient()
+	env := mustSetupAppEnv(t, c)
+
+	// create_with_priority — приоритет и срок сохраняются, по умолчанию приоритет normal.
+	t.Run("create_with_priority", func(t *testing.T) {
+		dueAt := time.Now().Add(72 * time.Hour).UTC().Format(time.RFC3339)
+		code, body := env.Inspector.post("/api/auth/application/create", map[string]string{
+			"company_uuid": env.CompanyUUID,
+			"title":        "SLA critical app",
+			"description":  "Application with priority and due date.",
+			"priority":     "critical",
+			"due_at":       dueAt,
+		})
+		require.Equalf(t, http.StatusCreated, code, "body: %s", body)
+		var resp createApplicationResp
+		require.NoError(t, json.Unmarshal(body, &resp))
+
+		app := mustGetApplicationDetail(t, env.Inspector, resp.ApplicationUUID)
+		assert.Equal(t, "critical", app.Priority)
+		assert.NotEmpty(t, app.DueAt)
+		assert.False(t, app.IsOverdue)
+
+		defaultUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID,
+			"SLA default app", "Application with default priority.")
+		assert.Equal(t, "normal", mustGetApplicationDetail(t, env.Inspector, defaultUUID).Priority)
+	})
+
+	// create_invalid — неизвестный приоритет и срок в прошлом отклоняются.
+	t.Run("create_invalid", func(t *testing.T) {
+		code, _ := env.Inspector.post("/api/auth/application/create", map[string]string{
+			"company_uuid": env.CompanyUUID,
+			"title":        "SLA invalid app",
+			"description":  "Application with unknown priority.",
+			"priority":     "urgent",
+		})
+		assert.Equal(t, http.StatusBadRequest, code)
+
+		code, _ = env.Inspector.post("/api/auth/application/create", map[string]string{
+			"company_uuid": env.CompanyUUID,
+			"title":        "SLA past app",
+			"description":  "Application with due date in the past.",
+			"due_at":       time.Now().Add(-time.Hour).UTC().Format(time.RFC3339),
+		})
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	// policies — chief настраивает политику, сотрудники её видят, остальным изменять нельзя.
+	t.Run("policies", func(t *testing.T) {
+		code, body := env.Chief.put("/api/auth/company/"+env.CompanyUUID+"/sla/critical", map[string]int{
+			"assign_within_hours":       24,
+			"verification_within_hours": 72,
+		})
+		require.Equalf(t, http.StatusOK, code, "body: %s", body)
+
+		policies := mustGetCompanySLAPolicies(t, env.Engineer, env.CompanyUUID)
+		require.Len(t, policies, 1)
+		assert.Equal(t, slaPolicy{Priority: "critical", AssignWithinHours: 24, VerificationWithinHours: 72}, policies[0])
+
+		code, _ = env.Manager.put("/api/auth/company/"+env.CompanyUUID+"/sla/high", map[string]int{
+			"assign_within_hours": 48,
+		})
+		assert.Equal(t, http.StatusForbidden, code)
+
+		code, _ = env.Chief.put("/api/auth/company/"+env.CompanyUUID+"/sla/high", map[string]int{})
+		assert.Equal(t, http.StatusBadRequest, code)
+
+		code, _ = env.Manager.delete("/api/auth/company/"+env.CompanyUUID+"/sla/critical", nil)
+		assert.Equal(t, http.StatusForbidden, code)
+
+		code, body = env.Chief.delete("/api/auth/company/"+env.CompanyUUID+"/sla/critical", nil)
+		require.Equalf(t, http.StatusOK, code, "body: %s", body)
+		assert.Empty(t, mustGetCompanySLAPolicies(t, env.Chief, env.CompanyUUID))
+
+		code, _ = env.Chief.delete("/api/auth/company/"+env.CompanyUUID+"/sla/critical", nil)
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+
+	// overdue_filter — свежие заявки не просрочены и не попадают в фильтр is_overdue.
+	t.Run("overdue_filter", func(t *testing.T) {
+		appUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID,
+			"SLA fresh app", "Application within deadlines.")
+
+		code, body := env.Chief.get("/api/auth/company/" + env.CompanyUUID + "/applications/list?count=100&is_overdue=true")
+		require.Equalf(t, http.StatusOK, code, "body: %s", body)
+		var resp applicationListResp
+		require.NoError(t, json.Unmarshal(body, &resp))
+		for _, app := range resp.Applications {
+			assert.True(t, app.IsOverdue)
+			assert.NotEqual(t, appUUID, app.ApplicationUUID)
+		}
+	})
+}
+
+// ─── Search ───────────────────────────────────────────────────────────────────
+
+func TestSearchApplications(t *testing.T) {
+	c := newClient()
+	env := mustSetupAppEnv(t, c)
+
+	crackUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID,
+		"Трещина в стене на третьем этаже", "Вертикальная трещина возле лестницы.")
+	leakUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID,
+		"Протечка в подвале", "Вода на полу технического помещения.")
+	mustAssignApplication(t, env.Manager, leakUUID, env.EngineerUUID)
+	mustSetAppStatus(t, env.Engineer, leakUUID, "in_progress")
+	mustAddFixLog(t, env.Engineer, leakUUID, "Заменили уплотнитель на задвижке.")
+
+	// by_title — поиск учитывает морфологию русского языка.
+	t.Run("by_title", func(t *testing.T) {
+		found := applicationUUIDs(mustListApplications(t, env.Chief, env.CompanyUUID, "count=100&search="+url.QueryEscape("трещины")))
+		assert.Contains(t, found, crackUUID)
+		assert.NotContains(t, found, leakUUID)
+	})
+
+	// by_fix_log — текст fix log-ов тоже участвует в поиске.
+	t.Run("by_fix_log", func(t *testing.T) {
+		found := applicationUUIDs(mustListApplications(t, env.Chief, env.CompanyUUID, "count=100&search="+url.QueryEscape("уплотнитель")))
+		assert.Equal(t, []string{leakUUID}, found)
+	})
+
+	// relevance — сортировка по релевантности доступна вместе с поиском.
+	t.Run("relevance", func(t *testing.T) {
+		found := applicationUUIDs(mustListApplications(t, env.Chief, env.CompanyUUID, "count=100&sort_by=relevance&search="+url.QueryEscape("трещина")))
+		require.NotEmpty(t, found)
+		assert.Equal(t, crackUUID, found[0])
+
+		code, _ := env.Chief.get("/api/auth/company/" + env.CompanyUUID + "/applications/list?count=10&sort_by=relevance")
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	// participants_and_dates — фильтры по исполнителю и датам создания.
+	t.Run("participants_and_dates", func(t *testing.T) {
+		found := applicationUUIDs(mustListApplications(t, env.Chief, env.CompanyUUID, "count=100&executed_by="+env.EngineerUUID))
+		assert.Contains(t, found, leakUUID)
+		assert.NotContains(t, found, crackUUID)
+
+		from := time.Now().Add(-time.Hour).UTC().Format(time.DateOnly)
+		found = applicationUUIDs(mustListApplications(t, env.Chief, env.CompanyUUID, "count=100&created_from="+from))
+		assert.Contains(t, found, crackUUID)
+
+		found = applicationUUIDs(mustListApplications(t, env.Chief, env.CompanyUUID, "count=100&created_to=2000-01-01"))
+		assert.Empty(t, found)
+
+		found = applicationUUIDs(mustListApplications(t, env.Chief, env.CompanyUUID, "count=100&revision_count_min=1"))
+		assert.Empty(t, found)
+	})
+
+	// sort_order — сортировка по дате создания по возрастанию.
+	t.Run("sort_order", func(t *testing.T) {
+		found := applicationUUIDs(mustListApplications(t, env.Chief, env.CompanyUUID, "count=100&sort_by=created_at&sort_order=asc"))
+		require.GreaterOrEqual(t, len(found), 2)
+		assert.Less(t, slices.Index(found, crackUUID), slices.Index(found, leakUUID))
+	})
+
+	// invalid_params — некорректные параметры отклоняются.
+	t.Run("invalid_params", func(t *testing.T) {
+		for _, query := range []string{
+			"count=10&created_from=01.03.2024",
+			"count=10&executed_by=not-a-uuid",
+			"count=10&sort_by=title",
+			"count=10&sort_order=up",
+			"count=10&revision_count_min=-1",
+		} {
+			code, _ := env.Chief.get("/api/auth/company/" + env.CompanyUUID + "/applications/list?" + query)
+			assert.Equalf(t, http.StatusBadRequest, code, "query: %s", query)
+		}
+	})
+}
+
+// ─── TestApplicationsCursorPagination ─────────────────────────────────────────
+
+func TestApplicationsCursorPagination(t *testing.T) {
+	c := newClient()
+	env := mustSetupAppEnv(t, c)
+
+	created := make([]string, 0, 5)
+	for i := 0; i < 5; i++ {
+		created = append(created, mustCreateApplication(t, env.Inspector, env.CompanyUUID,
+			fmt.Sprintf("Cursor page %d", i), "Cursor pagination."))
+	}
+
+	// walk — проход по всем страницам через next_cursor совпадает с выдачей одной страницей.
+	t.Run("walk", func(t *testing.T) {
+		all := mustListApplicationsPage(t, env.Chief, env.CompanyUUID, "count=100")
+		assert.Equal(t, int64(len(all.Applications)), all.TotalCount)
+		assert.Empty(t, all.NextCursor)
+
+		walked := make([]string, 0, len(all.Applications))
+		query := "count=2"
+		for page := 0; page < 100; page++ {
+			resp := mustListApplicationsPage(t, env.Chief, env.CompanyUUID, query)
+			assert.Equal(t, all.TotalCount, resp.TotalCount)
+			walked = append(walked, applicationUUIDs(resp.Applications)...)
+			if resp.NextCursor == "" {
+				break
+			}
+			query = "count=2&cursor=" + url.QueryEscape(resp.NextCursor)
+		}
+		assert.Equal(t, applicationUUIDs(all.Applications), walked)
+		for _, appUUID := range created {
+			assert.Contains(t, walked, appUUID)
+		}
+	})
+
+	// history — курсор истории изменений идёт по версиям.
+	t.Run("history", func(t *testing.T) {
+		appUUID := mustAdvanceToOnVerification(t, env, "Cursor history")
+
+		code, first := getApplicationHistory(t, env.Inspector, appUUID, 3, 0)
+		require.Equal(t, http.StatusOK, code)
+		require.Len(t, first.History, 3)
+		assert.Equal(t, int64(4), first.TotalCount)
+		require.NotEmpty(t, first.NextCursor)
+
+		code, body := env.Inspector.get(fmt.Sprintf("/api/auth/application/%s/history?count=3&cursor=%s", appUUID, url.QueryEscape(first.NextCursor)))
+		require.Equalf(t, http.StatusOK, code, "body: %s", body)
+		var second applicationHistoryResp
+		require.NoError(t, json.Unmarshal(body, &second))
+		require.Len(t, second.History, 1)
+		assert.Equal(t, int64(1), second.History[0].Version)
+		assert.Empty(t, second.NextCursor)
+	})
+
+	// invalid — битый курсор, курсор вместе с offset и курсор при сортировке не по created_at.
+	t.Run("invalid", func(t *testing.T) {
+		page := mustListApplicationsPage(t, env.Chief, env.CompanyUUID, "count=1")
+		require.NotEmpty(t, page.NextCursor)
+		next := url.QueryEscape(page.NextCursor)
+
+		for _, query := range []string{
+			"count=1&cursor=not-a-cursor",
+			"count=1&offset=1&cursor=" + next,
+			"count=1&sort_by=priority&cursor=" + next,
+		} {
+			code, _ := env.Chief.get("/api/auth/company/" + env.CompanyUUID + "/applications/list?" + query)
+			assert.Equalf(t, http.StatusBadRequest, code, "query: %s", query)
+		}
+	})
+}
+
+// ─── TestApplicationAnalytics ─────────────────────────────────────────────────
+
+func TestApplicationAnalytics(t *testing.T) {
+	c := newClient()
+	env := mustSetupAppEnv(t, c)
+
+	mustCreateApplication(t, env.Inspector, env.CompanyUUID, "Analytics created", "Stays in created.")
+	completedUUID := mustAdvanceToOnVerification(t, env, "Analytics completed")
+	mustSetAppStatus(t, env.Inspector2, completedUUID, "completed")
+	failedUUID := mustAdvanceToOnVerification(t, env, "Analytics failed")
+	mustSetAppStatus(t, env.Inspector2, failedUUID, "failed")
+	mustAdvanceToOnRevision(t, env, "Analytics revision")
+
+	base := "/api/auth/company/" + env.CompanyUUID + "/analytics/"
+
+	// statuses — заявки компании по статусам за текущий месяц.
+	t.Run("statuses", func(t *testing.T) {
+		code, body := env.Analytic.get(base + "statuses?interval=month")
+		require.Equalf(t, http.StatusOK, code, "body: %s", body)
+		var resp struct {
+			Stats []struct {
+				Status string `json:"status"`
+				Count  int64  `json:"count"`
+			} `json:"stats"`
+		}
+		require.NoError(t, json.Unmarshal(body, &resp))
+
+		byStatus := map[string]int64{}
+		for _, s := range resp.Stats {
+			byStatus[s.Status] += s.Count
+		}
+		assert.Equal(t, map[string]int64{"created": 1, "completed": 1, "failed": 1, "on_revision": 1}, byStatus)
+	})
+
+	// lead_times — время этапов восстанавливается по истории заявок.
+	t.Run("lead_times", func(t *testing.T) {
+		code, body := env.Chief.get(base + "lead-times")
+		require.Equalf(t, http.StatusOK, code, "body: %s", body)
+		var resp struct {
+			Assign struct{ Samples int64 } `json:"assign"`
+			Fix    struct{ Samples int64 } `json:"fix"`
+			Verify struct{ Samples int64 } `json:"verify"`
+		}
+		require.NoError(t, json.Unmarshal(body, &resp))
+		assert.Equal(t, int64(3), resp.Assign.Samples)
+		assert.Equal(t, int64(3), resp.Fix.Samples)
+		assert.Equal(t, int64(2), resp.Verify.Samples)
+	})
+
+	// revisions — доработки считаются по инженеру заявки.
+	t.Run("revisions", func(t *testing.T) {
+		code, body := env.Analytic.get(base + "revisions")
+		require.Equalf(t, http.StatusOK, code, "body: %s", body)
+		var resp struct {
+			Stats []struct {
+				EngineerUUID string `json:"engineer_uuid"`
+				Applications int64  `json:"applications"`
+				Revisions    int64  `json:"revisions"`
+			} `json:"stats"`
+		}
+		require.NoError(t, json.Unmarshal(body, &resp))
+		require.Len(t, resp.Stats, 1)
+		assert.Equal(t, env.EngineerUUID, resp.Stats[0].EngineerUUID)
+		assert.Equal(t, int64(3), resp.Stats[0].Applications)
+		assert.Equal(t, int64(1), resp.Stats[0].Revisions)
+	})
+
+	// failures — доля проваленных среди закрытых заявок отдела.
+	t.Run("failures", func(t *testing.T) {
+		code, body := env.Analytic.get(base + "failures?department_uuid=" + env.DeptUUID)
+		require.Equalf(t, http.StatusOK, code, "body: %s", body)
+		var resp struct {
+			Stats []struct {
+				DepartmentUUID string  `json:"department_uuid"`
+				Closed         int64   `json:"closed"`
+				Failed         int64   `json:"failed"`
+				FailureRate    float64 `json:"failure_rate"`
+			} `json:"stats"`
+		}
+		require.NoError(t, json.Unmarshal(body, &resp))
+		require.Len(t, resp.Stats, 1)
+		assert.Equal(t, int64(2), resp.Stats[0].Closed)
+		assert.Equal(t, int64(1), resp.Stats[0].Failed)
+		assert.InDelta(t, 0.5, resp.Stats[0].FailureRate, 1e-9)
+	})
+
+	// forbidden — аналитика недоступна остальным ролям.
+	t.Run("forbidden", func(t *testing.T) {
+		for _, client := range []*apiClient{env.Manager, env.Engineer, env.Inspector} {
+			code, _ := client.get(base + "failures")
+			assert.Equal(t, http.StatusForbidden, code)
+		}
+	})
+
+	// invalid_params — неизвестный период группировки и некорректная дата.
+	t.Run("invalid_params", func(t *testing.T) {
+		code, _ := env.Chief.get(base + "statuses?interval=year")
+		assert.Equal(t, http.StatusBadRequest, code)
+		code, _ = env.Chief.get(base + "lead-times?created_from=01.01.2026")
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+}
+
+// ─── TestApplicationsExport ───────────────────────────────────────────────────
+
+func TestApplicationsExport(t *testing.T) {
+	c := newClient()
+	env := mustSetupAppEnv(t, c)
+
+	appUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID, "Export completed", "Exported with history.")
+	mustAssignApplication(t, env.Manager, appUUID, env.EngineerUUID)
+	mustSetAppStatus(t, env.Engineer, appUUID, "in_progress")
+	mustAddFixLog(t, env.Engineer, appUUID, "Replaced the valve")
+	mustSetAppStatus(t, env.Engineer, appUUID, "pending_verification")
+	mustTakeToVerification(t, env.Inspector2, appUUID)
+	mustSetAppStatus(t, env.Inspector2, appUUID, "completed")
+	createdUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID, "Export created", "Stays in created.")
+
+	base := "/api/auth/company/" + env.CompanyUUID + "/applications/export"
+
+	// csv — заявки с fix log-ами и историей статусов, uuid пользователей заменены ФИО.
+	t.Run("csv", func(t *testing.T) {
+		code, header, body := env.Analytic.download(base)
+		require.Equalf(t, http.StatusOK, code, "body: %s", body)
+		assert.Contains(t, header.Get("Content-Type"), "text/csv")
+		assert.Contains(t, header.Get("Content-Disposition"), "attachment")
+
+		records, err := csv.NewReader(bytes.NewReader(body)).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3, "header and two applications")
+
+		columns := map[string]int{}
+		for i, name := range records[0] {
+			columns[name] = i
+		}
+		// Выгрузка по умолчанию отсортирована по дате создания, новые заявки первыми
+		assert.Equal(t, createdUUID, records[1][columns["application_uuid"]])
+		row := records[2]
+		assert.Equal(t, appUUID, row[columns["application_uuid"]])
+		assert.Equal(t, "completed", row[columns["status"]])
+		assert.Equal(t, "Ivanov Ivan Ivanovich", row[columns["created_by"]])
+		assert.Equal(t, "Ivanov Ivan Ivanovich", row[columns["executed_by"]])
+		assert.Contains(t, row[columns["fix_logs"]], "Replaced the valve")
+
+		timeline := strings.Split(row[columns["status_timeline"]], "\n")
+		expected := []string{"created", "assigned", "in_progress", "pending_verification", "on_verification", "completed"}
+		require.Len(t, timeline, len(expected), "timeline: %v", timeline)
+		for i, status := range expected {
+			assert.Contains(t, timeline[i], " "+status+" (Ivanov Ivan Ivanovich)")
+		}
+	})
+
+	// filters — фильтры те же, что у списка заявок.
+	t.Run("filters", func(t *testing.T) {
+		code, _, body := env.Chief.download(base + "?statuses=created&sort_order=asc")
+		require.Equalf(t, http.StatusOK, code, "body: %s", body)
+		records, err := csv.NewReader(bytes.NewReader(body)).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, createdUUID, records[1][0])
+	})
+
+	// xlsx — книга Excel (zip архив).
+	t.Run("xlsx", func(t *testing.T) {
+		code, header, body := env.Chief.download(base + "?format=xlsx")
+		require.Equalf(t, http.StatusOK, code, "body: %s", body)
+		assert.Equal(t, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", header.Get("Content-Type"))
+
+		archive, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+		require.NoError(t, err)
+		var sheet []byte
+		for _, f := range archive.File {
+			if f.Name == "xl/worksheets/sheet1.xml" {
+				r, err := f.Open()
+				require.NoError(t, err)
+				sheet, err = io.ReadAll(r)
+				require.NoError(t, err)
+			}
+		}
+		assert.Contains(t, string(sheet), appUUID)
+		assert.Contains(t, string(sheet), "Replaced the valve")
+	})
+
+	// forbidden — выгрузка доступна только chief и analytic.
+	t.Run("forbidden", func(t *testing.T) {
+		for _, client := range []*apiClient{env.Manager, env.Engineer, env.Inspector} {
+			code, _, _ := client.download(base)
+			assert.Equal(t, http.StatusForbidden, code)
+		}
+	})
+
+	// invalid_params — неизвестный формат и сортировка не по дате создания.
+	t.Run("invalid_params", func(t *testing.T) {
+		code, _, _ := env.Chief.download(base + "?format=pdf")
+		assert.Equal(t, http.StatusBadRequest, code)
+		code, _, _ = env.Chief.download(base + "?sort_by=priority")
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+}
+
+// ─── Comments ─────────────────────────────────────────────────────────────────
+
+func TestApplicationComments(t *testing.T) {
+	c := newClient()
+	env := mustSetupAppEnv(t, c) // создаём окружение один раз на все подтесты
+
+	// thread_with_mention — менеджер спрашивает, инженер отвечает в ветке с упоминанием инспектора.
+	t.Run("thread_with_mention", func(t *testing.T) {
+		appUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID,
+			"Damp wall", "Damp spot on the wall.")
+		mustAssignApplication(t, env.Manager, appUUID, env.EngineerUUID)
+
+		question := mustCreateComment(t, env.Engineer, appUUID, "", "Which wall exactly?", env.InspectorUUID)
+		assert.Equal(t, []string{env.InspectorUUID}, question.MentionedUUIDs)
+		reply := mustCreateComment(t, env.Inspector, appUUID, question.CommentUUID, "The north one, near the window.")
+		assert.Equal(t, question.CommentUUID, reply.ParentCommentUUID)
+
+		list := mustListComments(t, env.Chief, appUUID, "count=10")
+		assert.Equal(t, int64(1), list.TotalCount)
+		require.Len(t, list.Comments, 1)
+		require.Len(t, list.Comments[0].Replies, 1)
+		assert.Equal(t, reply.CommentUUID, list.Comments[0].Replies[0].CommentUUID)
+
+		// в ветке один уровень вложенности
+		code, _ := env.Engineer.post("/api/auth/application/"+appUUID+"/comments", map[string]any{
+			"parent_comment_uuid": reply.CommentUUID,
+			"text":                "Nested",
+		})
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	// mention_outsider_rejected — упомянуть можно только сотрудника компании.
+	t.Run("mention_outsider_rejected", func(t *testing.T) {
+		appUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID,
+			"Mention outsider", "Desc.")
+		_, outsider := mustRegisterAndLogin(t, c)
+
+		code, _ := env.Inspector.post("/api/auth/application/"+appUUID+"/comments", map[string]any{
+			"text":            "Hey @stranger",
+			"mentioned_uuids": []string{outsider.UserUUID},
+		})
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	// mention_non_participant_rejected — упомянуть можно только того, кто видит комментарии заявки.
+	t.Run("mention_non_participant_rejected", func(t *testing.T) {
+		appUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID,
+			"Mention non-participant", "Desc.")
+
+		code, _ := env.Inspector.post("/api/auth/application/"+appUUID+"/comments", map[string]any{
+			"text":            "Take a look",
+			"mentioned_uuids": []string{env.Engineer2UUID},
+		})
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	// visibility — комментарии видят те же, кто видит заявку; analytic только читает.
+	t.Run("visibility", func(t *testing.T) {
+		appUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID,
+			"Comment visibility", "Desc.")
+		mustCreateComment(t, env.Inspector, appUUID, "", "Visible to participants.")
+
+		code, _ := env.Engineer2.get("/api/auth/application/" + appUUID + "/comments?count=10")
+		assert.Equal(t, http.StatusForbidden, code)
+
+		list := mustListComments(t, env.Analytic, appUUID, "count=10")
+		assert.Len(t, list.Comments, 1)
+
+		code, _ = env.Analytic.post("/api/auth/application/"+appUUID+"/comments", map[string]any{"text": "Analytic note"})
+		assert.Equal(t, http.StatusForbidden, code)
+	})
+
+	// edit_and_delete — автор правит комментарий, история хранит прошлую редакцию, удаление скрывает текст.
+	t.Run("edit_and_delete", func(t *testing.T) {
+		appUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID,
+			"Comment edit", "Desc.")
+		comment := mustCreateComment(t, env.Inspector, appUUID, "", "Which wal?")
+		path := "/api/auth/application/" + appUUID + "/comments/" + comment.CommentUUID
+
+		code, _ := env.Chief.patch(path, map[string]any{"text": "Not mine"})
+		assert.Equal(t, http.StatusForbidden, code, "only the author edits a comment")
+
+		code, body := env.Inspector.patch(path, map[string]any{"text": "Which wall?"})
+		require.Equalf(t, http.StatusOK, code, "edit comment failed (body: %s)", body)
+
+		code, body = env.Inspector.get(path + "/history")
+		require.Equalf(t, http.StatusOK, code, "comment history failed (body: %s)", body)
+		var history commentHistoryResp
+		require.NoError(t, json.Unmarshal(body, &history))
+		assert.Equal(t, "Which wall?", history.Comment.Text)
+		assert.NotEmpty(t, history.Comment.EditedAt)
+		require.Len(t, history.Edits, 1)
+		assert.Equal(t, "Which wal?", history.Edits[0].Text)
+
+		code, _ = env.Inspector.delete(path, nil)
+		require.Equal(t, http.StatusOK, code)
+
+		list := mustListComments(t, env.Inspector, appUUID, "count=10")
+		require.Len(t, list.Comments, 1)
+		assert.True(t, list.Comments[0].IsDeleted)
+		assert.Empty(t, list.Comments[0].Text)
+
+		code, _ = env.Inspector.get(path + "/history")
+		assert.Equal(t, http.StatusForbidden, code, "history of a deleted comment is for chief only")
+		code, _ = env.Chief.get(path + "/history")
+		assert.Equal(t, http.StatusOK, code)
+	})
+
+	// pagination — ветки отдаются по курсору от старых к новым.
+	t.Run("pagination", func(t *testing.T) {
+		appUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID,
+			"Comment pages", "Desc.")
+		first := mustCreateComment(t, env.Inspector, appUUID, "", "First")
+		second := mustCreateComment(t, env.Inspector, appUUID, "", "Second")
+
+		page := mustListComments(t, env.Inspector, appUUID, "count=1")
+		require.Len(t, page.Comments, 1)
+		assert.Equal(t, first.CommentUUID, page.Comments[0].CommentUUID)
+		assert.Equal(t, int64(2), page.TotalCount)
+		require.NotEmpty(t, page.NextCursor)
+
+		page = mustListComments(t, env.Inspector, appUUID, "count=1&cursor="+page.NextCursor)
+		require.Len(t, page.Comments, 1)
+		assert.Equal(t, second.CommentUUID, page.Comments[0].CommentUUID)
+		assert.Empty(t, page.NextCursor)
+	})
+}
+
+// ─── TestApplicationLocations ─────────────────────────────────────────────────
+
+func TestApplicationLocations(t *testing.T) {
+	c := newClient()
+	env := mustSetupAppEnv(t, c)
+
+	siteUUID := mustCreateLocation(t, env.Chief, env.CompanyUUID, "", "site", "North plant")
+	buildingUUID := mustCreateLocation(t, env.Chief, env.CompanyUUID, siteUUID, "building", "Building A")
+	floorUUID := mustCreateLocation(t, env.Chief, env.CompanyUUID, buildingUUID, "floor", "Floor 3")
+	otherSiteUUID := mustCreateLocation(t, env.Chief, env.CompanyUUID, "", "site", "South plant")
+
+	t.Run("location_path", func(t *testing.T) {
+		code, body := env.Inspector.get("/api/auth/company/" + env.CompanyUUID + "/location/" + floorUUID)
+		require.Equalf(t, http.StatusOK, code, "body: %s", body)
+
+		var resp locationResp
+		require.NoError(t, json.Unmarshal(body, &resp))
+		assert.Equal(t, "floor", resp.Kind)
+		assert.Equal(t, buildingUUID, resp.ParentUUID)
+		require.Len(t, resp.Path, 3)
+		assert.Equal(t, siteUUID, resp.Path[0].LocationUUID)
+		assert.Equal(t, buildingUUID, resp.Path[1].LocationUUID)
+		assert.Equal(t, floorUUID, resp.Path[2].LocationUUID)
+	})
+
+	t.Run("wrong_parent_kind", func(t *testing.T) {
+		code, body := env.Chief.post("/api/auth/company/"+env.CompanyUUID+"/location", map[string]string{
+			"parent_uuid": siteUUID,
+			"kind":        "floor",
+			"title":       "Floor without building",
+		})
+		assert.Equal(t, http.StatusBadRequest, code, "floor must be placed in a building (body: %s)", body)
+	})
+
+	t.Run("inspector_cannot_create", func(t *testing.T) {
+		code, body := env.Inspector.post("/api/auth/company/"+env.CompanyUUID+"/location", map[string]string{
+			"kind":  "site",
+			"title": "Inspector site",
+		})
+		assert.Equal(t, http.StatusForbidden, code, "body: %s", body)
+	})
+
+	t.Run("list_children", func(t *testing.T) {
+		code, body := env.Inspector.get("/api/auth/company/" + env.CompanyUUID + "/locations/list?parent_uuid=" + siteUUID + "&count=10")
+		require.Equalf(t, http.StatusOK, code, "body: %s", body)
+
+		var resp locationsListResp
+		require.NoError(t, json.Unmarshal(body, &resp))
+		require.Len(t, resp.Locations, 1)
+		assert.Equal(t, buildingUUID, resp.Locations[0].LocationUUID)
+	})
+
+	t.Run("filter_includes_nested_locations", func(t *testing.T) {
+		code, body := env.Inspector.post("/api/auth/application/create", map[string]string{
+			"company_uuid":  env.CompanyUUID,
+			"title":         "Leaking pipe",
+			"description":   "Water is leaking near the stairs.",
+			"location_uuid": floorUUID,
+		})
+		require.Equalf(t, http.StatusCreated, code, "body: %s", body)
+		var created createApplicationResp
+		require.NoError(t, json.Unmarshal(body, &created))
+
+		detail := mustGetApplicationDetail(t, env.Inspector, created.ApplicationUUID)
+		assert.Equal(t, floorUUID, detail.LocationUUID)
+
+		items := mustListApplications(t, env.Chief, env.CompanyUUID, "location_uuid="+siteUUID)
+		assert.Contains(t, applicationUUIDs(items), created.ApplicationUUID, "site filter must include applications on nested floors")
+
+		items = mustListApplications(t, env.Chief, env.CompanyUUID, "location_uuid="+otherSiteUUID)
+		assert.NotContains(t, applicationUUIDs(items), created.ApplicationUUID)
+	})
+
+	t.Run("unknown_location", func(t *testing.T) {
+		code, body := env.Inspector.post("/api/auth/application/create", map[string]string{
+			"company_uuid":  env.CompanyUUID,
+			"title":         "Broken window",
+			"description":   "Window glass is cracked.",
+			"location_uuid": "00000000-0000-7000-8000-000000000000",
+		})
+		assert.Equal(t, http.StatusBadRequest, code, "body: %s", body)
+	})
+
+	t.Run("delete_with_nested_locations", func(t *testing.T) {
+		code, body := env.Chief.delete("/api/auth/company/"+env.CompanyUUID+"/location/"+siteUUID, nil)
+		assert.Equal(t, http.StatusPreconditionFailed, code, "body: %s", body)
+
+		code, body = env.Chief.delete("/api/auth/company/"+env.CompanyUUID+"/location/"+otherSiteUUID, nil)
+		assert.Equal(t, http.StatusOK, code, "body: %s", body)
+	})
+}
+
+func TestApplicationCategoriesAndCustomFields(t *testing.T) {
+	c := newClient()
+	env := mustSetupAppEnv(t, c)
+
+	categoryUUID := mustCreateCategory(t, env.Chief, env.CompanyUUID, "Cracks", env.Dept2UUID)
+
+	code, body := env.Chief.put("/api/auth/company/"+env.CompanyUUID+"/custom-fields/wall", map[string]any{
+		"title":    "Wall",
+		"type":     "enum",
+		"options":  []string{"north", "south"},
+		"required": true,
+	})
+	require.Equalf(t, http.StatusOK, code, "body: %s", body)
+	code, body = env.Chief.put("/api/auth/company/"+env.CompanyUUID+"/custom-fields/crack_width", map[string]any{
+		"title": "Crack width, mm",
+		"type":  "number",
+	})
+	require.Equalf(t, http.StatusOK, code, "body: %s", body)
+
+	t.Run("duplicate_category", func(t *testing.T) {
+		code, body := env.Chief.post("/api/auth/company/"+env.CompanyUUID+"/categories", map[string]string{"title": "cracks"})
+		assert.Equal(t, http.StatusConflict, code, "body: %s", body)
+	})
+
+	t.Run("inspector_cannot_manage_fields", func(t *testing.T) {
+		code, body := env.Inspector.put("/api/auth/company/"+env.CompanyUUID+"/custom-fields/floor", map[string]any{
+			"title": "Floor",
+			"type":  "text",
+		})
+		assert.Equal(t, http.StatusForbidden, code, "body: %s", body)
+	})
+
+	t.Run("field_type_is_immutable", func(t *testing.T) {
+		code, body := env.Chief.put("/api/auth/company/"+env.CompanyUUID+"/custom-fields/crack_width", map[string]any{
+			"title": "Crack width",
+			"type":  "text",
+		})
+		assert.Equal(t, http.StatusPreconditionFailed, code, "body: %s", body)
+	})
+
+	t.Run("missing_required_field", func(t *testing.T) {
+		code, body := env.Inspector.post("/api/auth/application/create", map[string]any{
+			"company_uuid":  env.CompanyUUID,
+			"title":         "Crack in the wall",
+			"description":   "Diagonal crack above the door.",
+			"category_uuid": categoryUUID,
+			"custom_fields": map[string]string{"crack_width": "2.5"},
+		})
+		assert.Equal(t, http.StatusBadRequest, code, "body: %s", body)
+	})
+
+	var appUUID string
+	t.Run("create_routes_to_category_department", func(t *testing.T) {
+		code, body := env.Inspector.post("/api/auth/application/create", map[string]any{
+			"company_uuid":  env.CompanyUUID,
+			"title":         "Crack in the wall",
+			"description":   "Diagonal crack above the door.",
+			"category_uuid": categoryUUID,
+			"severity":      "major",
+			"custom_fields": map[string]string{"wall": "north", "crack_width": "2.5"},
+		})
+		require.Equalf(t, http.StatusCreated, code, "body: %s", body)
+		var created createApplicationResp
+		require.NoError(t, json.Unmarshal(body, &created))
+		appUUID = created.ApplicationUUID
+
+		detail := mustGetApplicationDetail(t, env.Chief, appUUID)
+		assert.Equal(t, env.Dept2UUID, detail.DepartmentUUID, "application must be routed to the category default department")
+		assert.Equal(t, categoryUUID, detail.CategoryUUID)
+		assert.Equal(t, "major", detail.Severity)
+		assert.Equal(t, map[string]string{"wall": "north", "crack_width": "2.5"}, detail.CustomFields)
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		require.NotEmpty(t, appUUID)
+
+		items := mustListApplications(t, env.Chief, env.CompanyUUID, "category_uuid="+categoryUUID+"&severities=major,critical&custom_fields=wall:north")
+		assert.Contains(t, applicationUUIDs(items), appUUID)
+
+		items = mustListApplications(t, env.Chief, env.CompanyUUID, "custom_fields=wall:south")
+		assert.NotContains(t, applicationUUIDs(items), appUUID)
+
+		items = mustListApplications(t, env.Chief, env.CompanyUUID, "severities=minor")
+		assert.NotContains(t, applicationUUIDs(items), appUUID)
+	})
+
+	t.Run("delete_category_in_use", func(t *testing.T) {
+		code, body := env.Chief.delete("/api/auth/company/"+env.CompanyUUID+"/categories/"+categoryUUID, nil)
+		assert.Equal(t, http.StatusPreconditionFailed, code, "body: %s", body)
+	})
+}
@@ -1,10 +1,12 @@
 package e2e
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -499,6 +501,129 @@ func TestRevokeAllSessions(t *testing.T) {
 	})
 }
 
+// ─── Access token revocation ──────────────────────────────────────────────────
+
+func TestAccessTokenRevocation(t *testing.T) {
+	// assertRevoked проверяет, что access токен сразу перестал приниматься gateway
+	assertRevoked := func(t *testing.T, c *apiClient, accessToken, userUUID string) {
+		t.Helper()
+		code, body := c.withToken(accessToken).get("/api/auth/user/" + userUUID + "/info")
+		assert.Equal(t, http.StatusUnauthorized, code, "revoked access token should return 401 (body: %s)", body)
+	}
+
+	t.Run("revoke_session", func(t *testing.T) {
+		c := newClient()
+		email, login := mustRegisterAndLogin(t, c)
+		login2 := mustLogin(t, c, email, "Password123")
+		auth := c.withToken(login.AccessToken)
+
+		code, body := auth.get("/api/auth/user/sessions")
+		require.Equal(t, http.StatusOK, code)
+		var tokens tokensResp
+		require.NoError(t, json.Unmarshal(body, &tokens))
+		require.Len(t, tokens.Tokens, 2)
+
+		code, body = auth.delete("/api/auth/user/session", map[string]string{"session_uuid": tokens.Tokens[0].SessionUUID})
+		require.Equal(t, http.StatusOK, code, "revoke session failed (body: %s)", body)
+
+		// Отозвана ровно одна сессия: её access токен отклоняется, токен другой сессии работает
+		code1, _ := auth.get("/api/auth/user/" + login.UserUUID + "/info")
+		code2, _ := c.withToken(login2.AccessToken).get("/api/auth/user/" + login.UserUUID + "/info")
+		assert.ElementsMatch(t, []int{http.StatusOK, http.StatusUnauthorized}, []int{code1, code2},
+			"exactly one access token should be revoked")
+	})
+
+	t.Run("revoke_all_sessions", func(t *testing.T) {
+		c := newClient()
+		email, login := mustRegisterAndLogin(t, c)
+		login2 := mustLogin(t, c, email, "Password123")
+
+		code, body := c.withToken(login.AccessToken).delete("/api/auth/user/sessions", nil)
+		require.Equal(t, http.StatusOK, code, "revoke all failed (body: %s)", body)
+
+		assertRevoked(t, c, login.AccessToken, login.UserUUID)
+		assertRevoked(t, c, login2.AccessToken, login.UserUUID)
+
+		// Новый вход сразу после отзыва работает
+		fresh := mustLogin(t, c, email, "Password123")
+		code, body = c.withToken(fresh.AccessToken).get("/api/auth/user/" + login.UserUUID + "/info")
+		assert.Equal(t, http.StatusOK, code, "token of a new login should work (body: %s)", body)
+	})
+
+	t.Run("change_password", func(t *testing.T) {
+		c := newClient()
+		_, login := mustRegisterAndLogin(t, c)
+
+		mustChangePassword(t, c.withToken(login.AccessToken), "Password123", "NewPassword456")
+		assertRevoked(t, c, login.AccessToken, login.UserUUID)
+	})
+
+	t.Run("refresh_token_reuse", func(t *testing.T) {
+		c := newClient()
+		_, login := mustRegisterAndLogin(t, c)
+
+		code, body := c.post("/api/refresh", map[string]string{"refresh_token": login.RefreshToken})
+		require.Equal(t, http.StatusOK, code, "refresh failed (body: %s)", body)
+		var refreshed refreshTokenResp
+		require.NoError(t, json.Unmarshal(body, &refreshed))
+
+		// Повторное использование старого refresh токена отзывает всю сессию
+		code, _ = c.post("/api/refresh", map[string]string{"refresh_token": login.RefreshToken})
+		require.Equal(t, http.StatusUnauthorized, code, "refresh token reuse should return 401")
+
+		assertRevoked(t, c, login.AccessToken, login.UserUUID)
+		assertRevoked(t, c, refreshed.AccessToken, login.UserUUID)
+	})
+}
+
+// ─── JWKS ─────────────────────────────────────────────────────────────────────
+
+func TestJWKS(t *testing.T) {
+	t.Run("token_kid_is_published", func(t *testing.T) {
+		c := newClient()
+		_, login := mustRegisterAndLogin(t, c)
+
+		code, body := c.get("/.well-known/jwks.json")
+		require.Equal(t, http.StatusOK, code, "jwks failed (body: %s)", body)
+		var jwks struct {
+			Keys []struct {
+				Kid    string `json:"kid"`
+				Kty    string `json:"kty"`
+				Crv    string `json:"crv"`
+				Alg    string `json:"alg"`
+				X      string `json:"x"`
+				Y      string `json:"y"`
+				Status string `json:"status"`
+			} `json:"keys"`
+		}
+		require.NoError(t, json.Unmarshal(body, &jwks))
+		require.NotEmpty(t, jwks.Keys, "jwks must contain at least the active key")
+
+		// kid из заголовка access токена должен совпадать с активным ключом
+		header, err := base64.RawURLEncoding.DecodeString(strings.Split(login.AccessToken, ".")[0])
+		require.NoError(t, err)
+		var jwtHeader struct {
+			Kid string `json:"kid"`
+		}
+		require.NoError(t, json.Unmarshal(header, &jwtHeader))
+		require.NotEmpty(t, jwtHeader.Kid, "access token must carry kid")
+
+		found := false
+		for _, key := range jwks.Keys {
+			assert.Equal(t, "EC", key.Kty)
+			assert.Equal(t, "P-256", key.Crv)
+			assert.Equal(t, "ES256", key.Alg)
+			assert.NotEmpty(t, key.X)
+			assert.NotEmpty(t, key.Y)
+			if key.Kid == jwtHeader.Kid {
+				found = true
+				assert.Equal(t, "active", key.Status)
+			}
+		}
+		assert.True(t, found, "kid %s not found in jwks", jwtHeader.Kid)
+	})
+}
+
 // ─── DeleteUser ───────────────────────────────────────────────────────────────
 
 func TestDeleteUser(t *testing.T) {
@@ -511,8 +636,13 @@ func TestDeleteUser(t *testing.T) {
 		code, body := auth.delete("/api/auth/user/account", nil)
 		require.Equal(t, http.StatusOK, code, "delete user failed (body: %s)", body)
 
-		// Soft delete: пользователь всё ещё существует в БД, GetUser на свой UUID возвращает 200 с deleted_at
-		code, body = auth.get("/api/auth/user/" + login.UserUUID + "/info")
+		// Access токен удалённого аккаунта отозван сразу
+		code, _ = auth.get("/api/auth/user/" + login.UserUUID + "/info")
+		assert.Equal(t, http.StatusUnauthorized, code, "access token should be revoked after deletion, got %d", code)
+
+		// Soft delete: пользователь всё ещё существует в БД, GetUser на его UUID возвращает 200 с deleted_at
+		_, other := mustRegisterAndLogin(t, c)
+		code, body = c.withToken(other.AccessToken).get("/api/auth/user/" + login.UserUUID + "/info")
 		require.Equal(t, http.StatusOK, code, "soft-deleted user should still be visible via GetUser (body: %s)", body)
 		var user getUserResp
 		require.NoError(t, json.Unmarshal(body, &user))
@@ -872,6 +1002,105 @@ func TestUpdateUser2FA(t *testing.T) {
 	})
 }
 
+// ─── TOTP 2FA ─────────────────────────────────────────────────────────────────
+
+func TestTOTP2FA(t *testing.T) {
+	t.Run("enroll_confirm_and_login", func(t *testing.T) {
+		c := newClient()
+		email, login := mustRegisterAndLogin(t, c)
+		auth := c.withToken(login.AccessToken)
+
+		secret := mustEnrollTOTP(t, auth)
+		recoveryCodes := mustConfirmTOTP(t, auth, secret)
+		assert.Len(t, recoveryCodes, 10)
+
+		// Логин с TOTP не отправляет письмо - код берётся из аутентификатора
+		httpCode, body := c.post("/api/login", map[string]string{"email": email, "password": "Password123"})
+		require.Equalf(t, http.StatusOK, httpCode, "login failed (body: %s)", body)
+		var resp loginResp
+		require.NoError(t, json.Unmarshal(body, &resp))
+		require.NotEmpty(t, resp.SessionUUID)
+		assert.Equal(t, "totp", resp.TwoFactorMethod)
+
+		// Код шага подтверждения уже использован - берём код следующего шага (допустимое расхождение ±1)
+		tokens := mustVerify2FA(t, c, resp.SessionUUID, totpCode(t, secret, time.Now().Add(30*time.Second)))
+		code, body := c.withToken(tokens.AccessToken).get("/api/auth/user/" + login.UserUUID + "/info")
+		assert.Equal(t, http.StatusOK, code, "token after TOTP should work (body: %s)", body)
+	})
+
+	t.Run("totp_code_cannot_be_reused", func(t *testing.T) {
+		c := newClient()
+		email, login := mustRegisterAndLogin(t, c)
+		auth := c.withToken(login.AccessToken)
+
+		secret := mustEnrollTOTP(t, auth)
+		confirmCode := totpCode(t, secret, time.Now())
+		code, body := auth.post("/api/auth/user/2fa/totp/confirm", map[string]string{"code": confirmCode})
+		require.Equalf(t, http.StatusOK, code, "confirm TOTP failed (body: %s)", body)
+
+		sessionUUID := mustLoginWith2FA(t, c, email, "Password123")
+		code, body = verify2FA(c, sessionUUID, confirmCode, "")
+		assert.Equal(t, http.StatusBadRequest, code, "reused TOTP code should return 400 (body: %s)", body)
+	})
+
+	t.Run("recovery_code_is_one_time", func(t *testing.T) {
+		c := newClient()
+		email, login := mustRegisterAndLogin(t, c)
+		auth := c.withToken(login.AccessToken)
+
+		secret := mustEnrollTOTP(t, auth)
+		recoveryCodes := mustConfirmTOTP(t, auth, secret)
+
+		sessionUUID := mustLoginWith2FA(t, c, email, "Password123")
+		code, body := verify2FA(c, sessionUUID, recoveryCodes[0], "recovery")
+		require.Equal(t, http.StatusOK, code, "recovery code should be accepted (body: %s)", body)
+
+		sessionUUID = mustLoginWith2FA(t, c, email, "Password123")
+		code, body = verify2FA(c, sessionUUID, recoveryCodes[0], "recovery")
+		assert.Equal(t, http.StatusBadRequest, code, "used recovery code should return 400 (body: %s)", body)
+	})
+
+	t.Run("email_fallback", func(t *testing.T) {
+		c := newClient()
+		email, login := mustRegisterAndLogin(t, c)
+		auth := c.withToken(login.AccessToken)
+
+		secret := mustEnrollTOTP(t, auth)
+		mustConfirmTOTP(t, auth, secret)
+
+		sessionUUID := mustLoginWith2FA(t, c, email, "Password123")
+		code, body := c.post("/api/2fa/email-code", map[string]string{"session_uuid": sessionUUID})
+		require.Equal(t, http.StatusOK, code, "email fallback failed (body: %s)", body)
+
+		emailCode := mustGet2FACode(t, c, sessionUUID)
+		code, body = verify2FA(c, sessionUUID, emailCode, "email")
+		assert.Equal(t, http.StatusOK, code, "email code should be accepted (body: %s)", body)
+	})
+
+	t.Run("confirm_wrong_code", func(t *testing.T) {
+		c := newClient()
+		_, login := mustRegisterAndLogin(t, c)
+		auth := c.withToken(login.AccessToken)
+
+		secret := mustEnrollTOTP(t, auth)
+		wrong := "000000"
+		if totpCode(t, secret, time.Now()) == wrong {
+			wrong = "111111"
+		}
+		code, body := auth.post("/api/auth/user/2fa/totp/confirm", map[string]string{"code": wrong})
+		assert.Equal(t, http.StatusBadRequest, code, "wrong TOTP code should return 400 (body: %s)", body)
+	})
+
+	t.Run("select_totp_without_enrollment", func(t *testing.T) {
+		c := newClient()
+		_, login := mustRegisterAndLogin(t, c)
+		auth := c.withToken(login.AccessToken)
+
+		code, body := auth.patch("/api/auth/user/2fa", map[string]any{"enable_2fa": true, "method": "totp"})
+		assert.Equal(t, http.StatusPreconditionFailed, code, "TOTP without enrollment should return 412 (body: %s)", body)
+	})
+}
+
 // ─── Full auth flow ───────────────────────────────────────────────────────────
 
 func TestAuthFullFlow(t *testing.T) {
@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -163,6 +165,32 @@ func TestGetCompaniesList(t *testing.T) {
 		assert.LessOrEqual(t, len(resp.Companies), 2)
 	})
 
+	t.Run("cursor_pagination", func(t *testing.T) {
+		c := newClient()
+		_, login := mustRegisterAndLogin(t, c)
+		auth := c.withToken(login.AccessToken)
+		mustCreateCompany(t, auth, randomTitle())
+		mustCreateCompany(t, auth, randomTitle())
+
+		status, body := auth.get("/api/auth/company/list?count=1")
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+		var first companiesResp
+		require.NoError(t, json.Unmarshal(body, &first))
+		require.Len(t, first.Companies, 1)
+		assert.GreaterOrEqual(t, first.TotalCount, int64(2))
+		require.NotEmpty(t, first.NextCursor)
+
+		status, body = auth.get("/api/auth/company/list?count=1&cursor=" + url.QueryEscape(first.NextCursor))
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+		var second companiesResp
+		require.NoError(t, json.Unmarshal(body, &second))
+		require.Len(t, second.Companies, 1)
+		assert.NotEqual(t, first.Companies[0].CompanyUUID, second.Companies[0].CompanyUUID)
+
+		status, body = auth.get("/api/auth/company/list?count=1&offset=1&cursor=" + url.QueryEscape(first.NextCursor))
+		assert.Equal(t, http.StatusBadRequest, status, "cursor with offset should return 400 (body: %s)", body)
+	})
+
 	t.Run("count_below_minimum", func(t *testing.T) {
 		c := newClient()
 		_, login := mustRegisterAndLogin(t, c)
@@ -461,6 +489,86 @@ func TestDeleteCompany(t *testing.T) {
 	})
 }
 
+// ─── Company deletion ─────────────────────────────────────────────────────────
+
+// В тестовом окружении срок восстановления - 3 секунды, воркер удаления запускается каждую секунду
+func TestCompanyDeletion(t *testing.T) {
+	t.Run("restore_within_retention", func(t *testing.T) {
+		c := newClient()
+		_, login := mustRegisterAndLogin(t, c)
+		chief := c.withToken(login.AccessToken)
+		companyUUID := mustCreateCompany(t, chief, randomTitle())
+
+		status, body := chief.delete("/api/auth/company/"+companyUUID, nil)
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+
+		deletion := mustGetCompanyDeletion(t, chief, companyUUID)
+		assert.Equal(t, "soft_deleted", deletion.Step)
+		assert.Equal(t, login.UserUUID, deletion.RequestedBy)
+		assert.NotEmpty(t, deletion.PurgeAfter)
+
+		status, body = chief.get("/api/auth/company/" + companyUUID)
+		require.Equal(t, http.StatusNotFound, status, "deleted company should be hidden (body: %s)", body)
+
+		status, body = chief.post("/api/auth/company/"+companyUUID+"/restore", nil)
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+
+		status, body = chief.get("/api/auth/company/" + companyUUID)
+		assert.Equal(t, http.StatusOK, status, "restored company should be available (body: %s)", body)
+
+		status, body = chief.get("/api/auth/company/" + companyUUID + "/deletion")
+		assert.Equal(t, http.StatusNotFound, status, "restored company has no deletion (body: %s)", body)
+	})
+
+	t.Run("non_chief_forbidden", func(t *testing.T) {
+		c := newClient()
+		_, chiefLogin := mustRegisterAndLogin(t, c)
+		chief := c.withToken(chiefLogin.AccessToken)
+		companyUUID := mustCreateCompany(t, chief, randomTitle())
+
+		_, memberLogin := mustRegisterAndLogin(t, c)
+		member := c.withToken(memberLogin.AccessToken)
+		mustAddMember(t, chief, member, companyUUID)
+
+		status, body := chief.delete("/api/auth/company/"+companyUUID, nil)
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+
+		status, body = member.get("/api/auth/company/" + companyUUID + "/deletion")
+		assert.Equal(t, http.StatusForbidden, status, "body: %s", body)
+
+		status, body = member.post("/api/auth/company/"+companyUUID+"/restore", nil)
+		assert.Equal(t, http.StatusForbidden, status, "body: %s", body)
+	})
+
+	t.Run("purged_after_retention", func(t *testing.T) {
+		c := newClient()
+		env := mustSetupAppEnv(t, c)
+		mustCreateApplication(t, env.Inspector, env.CompanyUUID, "Archived", "Application of deleted company")
+		code := mustCreateCode(t, env.Chief, env.CompanyUUID, 3600)
+
+		status, body := env.Chief.delete("/api/auth/company/"+env.CompanyUUID, nil)
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+
+		var deletion companyDeletionResp
+		require.Eventually(t, func() bool {
+			deletion = mustGetCompanyDeletion(t, env.Chief, env.CompanyUUID)
+			return deletion.Step == "completed"
+		}, 20*time.Second, 500*time.Millisecond, "company deletion should complete after retention")
+
+		assert.Equal(t, int64(1), deletion.ArchivedApplications)
+		assert.NotEmpty(t, deletion.ArchiveKey)
+		assert.NotEmpty(t, deletion.CompletedAt)
+
+		// Данные удалены - восстановить компанию нельзя, коды для вступления больше не действуют
+		status, body = env.Chief.post("/api/auth/company/"+env.CompanyUUID+"/restore", nil)
+		assert.Equal(t, http.StatusForbidden, status, "chief of purged company has no employee record (body: %s)", body)
+
+		_, guestLogin := mustRegisterAndLogin(t, c)
+		status, body = c.withToken(guestLogin.AccessToken).post("/api/auth/company/join", map[string]string{"code": code})
+		assert.Equal(t, http.StatusNotFound, status, "join code of deleted company should be removed (body: %s)", body)
+	})
+}
+
 // ─── CreateJoinCode ───────────────────────────────────────────────────────────
 
 func TestCreateJoinCode(t *testing.T) {
@@ -860,3 +968,377 @@ func TestCompanyFullWorkflow(t *testing.T) {
 	getStatus, _ := chief.get("/api/auth/company/" + companyUUID)
 	assert.Equal(t, http.StatusNotFound, getStatus, "deleted company should return 404")
 }
+
+// ─── Join code limits ─────────────────────────────────────────────────────────
+
+func TestJoinCodeMaxUses(t *testing.T) {
+	c := newClient()
+	_, chiefLogin := mustRegisterAndLogin(t, c)
+	chief := c.withToken(chiefLogin.AccessToken)
+	companyUUID := mustCreateCompany(t, chief, randomTitle())
+	mustOpenCompany(t, chief, companyUUID)
+	code := mustCreateLimitedCode(t, chief, companyUUID, 3600, 1)
+
+	_, firstLogin := mustRegisterAndLogin(t, c)
+	mustJoinCompany(t, c.withToken(firstLogin.AccessToken), code)
+
+	// Второй пользователь упирается в лимит
+	_, secondLogin := mustRegisterAndLogin(t, c)
+	status, body := c.withToken(secondLogin.AccessToken).post("/api/auth/company/join", map[string]string{"code": code})
+	assert.Equal(t, http.StatusTooManyRequests, status, "exhausted code should return 429 (body: %s)", body)
+
+	t.Run("usage_listed", func(t *testing.T) {
+		status, body := chief.get("/api/auth/company/" + companyUUID + "/codes")
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+		var resp joinCodesResp
+		require.NoError(t, json.Unmarshal(body, &resp))
+		require.Len(t, resp.JoinCodes, 1)
+		assert.Equal(t, int64(1), resp.JoinCodes[0].MaxUses)
+		assert.Equal(t, int64(1), resp.JoinCodes[0].Uses)
+	})
+
+	t.Run("redemptions", func(t *testing.T) {
+		status, body := chief.get("/api/auth/company/" + companyUUID + "/code/" + code + "/redemptions")
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+		var resp joinCodeRedemptionsResp
+		require.NoError(t, json.Unmarshal(body, &resp))
+		require.Len(t, resp.Redemptions, 1)
+		assert.Equal(t, firstLogin.UserUUID, resp.Redemptions[0].UserUUID)
+		assert.NotEmpty(t, resp.Redemptions[0].RedeemedAt)
+	})
+
+	t.Run("invalid_max_uses", func(t *testing.T) {
+		status, body := chief.post("/api/auth/company/"+companyUUID+"/code", map[string]int{"code_ttl": 3600, "max_uses": 1001})
+		assert.Equal(t, http.StatusBadRequest, status, "body: %s", body)
+	})
+}
+
+// ─── Join requests ────────────────────────────────────────────────────────────
+
+func TestJoinCompanyWithApproval(t *testing.T) {
+	c := newClient()
+	_, chiefLogin := mustRegisterAndLogin(t, c)
+	chief := c.withToken(chiefLogin.AccessToken)
+	companyUUID := mustCreateCompany(t, chief, randomTitle())
+	mustOpenCompany(t, chief, companyUUID)
+
+	status, body := chief.patch("/api/auth/company/"+companyUUID+"/join-settings", map[string]bool{"join_approval_required": true})
+	require.Equal(t, http.StatusOK, status, "body: %s", body)
+	code := mustCreateCode(t, chief, companyUUID, 3600)
+
+	_, guestLogin := mustRegisterAndLogin(t, c)
+	guest := c.withToken(guestLogin.AccessToken)
+	joined := mustJoinCompany(t, guest, code)
+	assert.Equal(t, "pending", joined.Status)
+	require.NotEmpty(t, joined.JoinRequestUUID)
+
+	// Пока заявка не одобрена, пользователь не сотрудник
+	status, _ = chief.get(fmt.Sprintf("/api/auth/company/%s/employee/%s/info", companyUUID, guestLogin.UserUUID))
+	assert.Equal(t, http.StatusNotFound, status)
+
+	status, body = chief.get("/api/auth/company/" + companyUUID + "/join-requests?status=pending")
+	require.Equal(t, http.StatusOK, status, "body: %s", body)
+	var requests joinRequestsResp
+	require.NoError(t, json.Unmarshal(body, &requests))
+	require.Len(t, requests.JoinRequests, 1)
+	assert.Equal(t, guestLogin.UserUUID, requests.JoinRequests[0].UserUUID)
+
+	// Обычный участник не может решать заявки
+	status, _ = guest.post(fmt.Sprintf("/api/auth/company/%s/join-requests/%s/accept", companyUUID, joined.JoinRequestUUID), nil)
+	assert.Equal(t, http.StatusForbidden, status)
+
+	status, body = chief.post(fmt.Sprintf("/api/auth/company/%s/join-requests/%s/accept", companyUUID, joined.JoinRequestUUID), nil)
+	require.Equal(t, http.StatusOK, status, "body: %s", body)
+
+	status, body = chief.get(fmt.Sprintf("/api/auth/company/%s/employee/%s/info", companyUUID, guestLogin.UserUUID))
+	require.Equal(t, http.StatusOK, status, "body: %s", body)
+	var employee employeeInfoResp
+	require.NoError(t, json.Unmarshal(body, &employee))
+	assert.Equal(t, "unemployed", employee.Role)
+
+	// Повторное решение по той же заявке невозможно
+	status, _ = chief.post(fmt.Sprintf("/api/auth/company/%s/join-requests/%s/decline", companyUUID, joined.JoinRequestUUID), nil)
+	assert.Equal(t, http.StatusPreconditionFailed, status)
+}
+
+// ─── Invitations ──────────────────────────────────────────────────────────────
+
+func TestCompanyInvitations(t *testing.T) {
+	t.Run("accept_applies_role_and_department", func(t *testing.T) {
+		c := newClient()
+		_, chiefLogin := mustRegisterAndLogin(t, c)
+		chief := c.withToken(chiefLogin.AccessToken)
+		title := randomTitle()
+		companyUUID := mustCreateCompany(t, chief, title)
+		deptUUID := mustCreateDepartment(t, chief, companyUUID, "Invited")
+
+		email, inviteeLogin := mustRegisterAndLogin(t, c)
+		invitee := c.withToken(inviteeLogin.AccessToken)
+		invitationUUID := mustCreateInvitation(t, chief, companyUUID, email, "engineer", deptUUID)
+
+		status, body := invitee.get("/api/auth/company/invitations/my")
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+		var mine invitationsResp
+		require.NoError(t, json.Unmarshal(body, &mine))
+		require.Len(t, mine.Invitations, 1)
+		assert.Equal(t, invitationUUID, mine.Invitations[0].InvitationUUID)
+		assert.Equal(t, title, mine.Invitations[0].CompanyTitle)
+
+		status, body = invitee.post("/api/auth/company/invitations/"+invitationUUID+"/accept", nil)
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+		var accepted joinCompanyResp
+		require.NoError(t, json.Unmarshal(body, &accepted))
+		assert.Equal(t, companyUUID, accepted.CompanyUUID)
+		assert.Equal(t, "engineer", accepted.Role)
+
+		status, body = chief.get(fmt.Sprintf("/api/auth/company/%s/employee/%s/info", companyUUID, inviteeLogin.UserUUID))
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+		var employee employeeInfoResp
+		require.NoError(t, json.Unmarshal(body, &employee))
+		assert.Equal(t, "engineer", employee.Role)
+		assert.Equal(t, deptUUID, employee.DepartmentUUID)
+
+		status, body = chief.get("/api/auth/company/" + companyUUID + "/invitations?status=accepted")
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+		var sent invitationsResp
+		require.NoError(t, json.Unmarshal(body, &sent))
+		require.Len(t, sent.Invitations, 1)
+		assert.Equal(t, "accepted", sent.Invitations[0].Status)
+	})
+
+	t.Run("other_user_cannot_accept", func(t *testing.T) {
+		c := newClient()
+		_, chiefLogin := mustRegisterAndLogin(t, c)
+		chief := c.withToken(chiefLogin.AccessToken)
+		companyUUID := mustCreateCompany(t, chief, randomTitle())
+		invitationUUID := mustCreateInvitation(t, chief, companyUUID, randomEmail(), "inspector", "")
+
+		_, strangerLogin := mustRegisterAndLogin(t, c)
+		status, body := c.withToken(strangerLogin.AccessToken).post("/api/auth/company/invitations/"+invitationUUID+"/accept", nil)
+		assert.Equal(t, http.StatusNotFound, status, "body: %s", body)
+	})
+
+	t.Run("revoked_cannot_be_accepted", func(t *testing.T) {
+		c := newClient()
+		_, chiefLogin := mustRegisterAndLogin(t, c)
+		chief := c.withToken(chiefLogin.AccessToken)
+		companyUUID := mustCreateCompany(t, chief, randomTitle())
+
+		email, inviteeLogin := mustRegisterAndLogin(t, c)
+		invitationUUID := mustCreateInvitation(t, chief, companyUUID, email, "manager", "")
+
+		status, body := chief.delete("/api/auth/company/"+companyUUID+"/invitations/"+invitationUUID, nil)
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+
+		status, body = c.withToken(inviteeLogin.AccessToken).post("/api/auth/company/invitations/"+invitationUUID+"/accept", nil)
+		assert.Equal(t, http.StatusPreconditionFailed, status, "body: %s", body)
+	})
+
+	t.Run("not_chief", func(t *testing.T) {
+		c := newClient()
+		_, chiefLogin := mustRegisterAndLogin(t, c)
+		chief := c.withToken(chiefLogin.AccessToken)
+		companyUUID := mustCreateCompany(t, chief, randomTitle())
+
+		_, memberLogin := mustRegisterAndLogin(t, c)
+		member := c.withToken(memberLogin.AccessToken)
+		mustAddMember(t, chief, member, companyUUID)
+
+		status, body := member.post("/api/auth/company/"+companyUUID+"/invitations", map[string]any{
+			"email": randomEmail(),
+			"role":  "engineer",
+			"ttl":   3600,
+		})
+		assert.Equal(t, http.StatusForbidden, status, "body: %s", body)
+	})
+}
+
+// ─── Company ownership ────────────────────────────────────────────────────────
+
+func TestCompanyOwnership(t *testing.T) {
+	t.Run("transfer_accept", func(t *testing.T) {
+		c := newClient()
+		_, ownerLogin := mustRegisterAndLogin(t, c)
+		owner := c.withToken(ownerLogin.AccessToken)
+		companyUUID := mustCreateCompany(t, owner, randomTitle())
+
+		_, targetLogin := mustRegisterAndLogin(t, c)
+		target := c.withToken(targetLogin.AccessToken)
+		mustAddMember(t, owner, target, companyUUID)
+
+		status, body := owner.get("/api/auth/company/" + companyUUID)
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+		var company companyResp
+		require.NoError(t, json.Unmarshal(body, &company))
+		assert.Equal(t, ownerLogin.UserUUID, company.OwnerUUID)
+
+		status, body = owner.post("/api/auth/company/"+companyUUID+"/ownership/transfer", map[string]string{"target_uuid": targetLogin.UserUUID})
+		require.Equal(t, http.StatusCreated, status, "body: %s", body)
+
+		status, body = target.get("/api/auth/company/" + companyUUID + "/ownership/transfer")
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+		var transfer ownershipTransferResp
+		require.NoError(t, json.Unmarshal(body, &transfer))
+		assert.Equal(t, ownerLogin.UserUUID, transfer.FromUUID)
+		assert.Equal(t, targetLogin.UserUUID, transfer.ToUUID)
+		assert.Equal(t, "pending", transfer.Status)
+
+		// Вторая передача при действующей - конфликт
+		status, body = owner.post("/api/auth/company/"+companyUUID+"/ownership/transfer", map[string]string{"target_uuid": targetLogin.UserUUID})
+		assert.Equal(t, http.StatusConflict, status, "body: %s", body)
+
+		status, body = target.post("/api/auth/company/"+companyUUID+"/ownership/transfer/accept", nil)
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+
+		status, body = owner.get("/api/auth/company/" + companyUUID)
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+		require.NoError(t, json.Unmarshal(body, &company))
+		assert.Equal(t, targetLogin.UserUUID, company.OwnerUUID)
+
+		status, body = owner.get(fmt.Sprintf("/api/auth/company/%s/employee/%s/info", companyUUID, targetLogin.UserUUID))
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+		var employee employeeInfoResp
+		require.NoError(t, json.Unmarshal(body, &employee))
+		assert.Equal(t, "chief", employee.Role)
+
+		// Прежний владелец больше не может передавать компанию
+		status, body = owner.post("/api/auth/company/"+companyUUID+"/ownership/transfer", map[string]string{"target_uuid": targetLogin.UserUUID})
+		assert.Equal(t, http.StatusForbidden, status, "body: %s", body)
+	})
+
+	t.Run("decline_and_cancel", func(t *testing.T) {
+		c := newClient()
+		_, ownerLogin := mustRegisterAndLogin(t, c)
+		owner := c.withToken(ownerLogin.AccessToken)
+		companyUUID := mustCreateCompany(t, owner, randomTitle())
+
+		_, targetLogin := mustRegisterAndLogin(t, c)
+		target := c.withToken(targetLogin.AccessToken)
+		mustAddMember(t, owner, target, companyUUID)
+
+		status, body := owner.post("/api/auth/company/"+companyUUID+"/ownership/transfer", map[string]string{"target_uuid": targetLogin.UserUUID})
+		require.Equal(t, http.StatusCreated, status, "body: %s", body)
+		status, body = target.post("/api/auth/company/"+companyUUID+"/ownership/transfer/decline", nil)
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+
+		status, body = owner.get("/api/auth/company/" + companyUUID + "/ownership/transfer")
+		assert.Equal(t, http.StatusNotFound, status, "body: %s", body)
+
+		status, body = owner.post("/api/auth/company/"+companyUUID+"/ownership/transfer", map[string]string{"target_uuid": targetLogin.UserUUID})
+		require.Equal(t, http.StatusCreated, status, "body: %s", body)
+		status, body = owner.delete("/api/auth/company/"+companyUUID+"/ownership/transfer", nil)
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+
+		status, body = target.post("/api/auth/company/"+companyUUID+"/ownership/transfer/accept", nil)
+		assert.Equal(t, http.StatusNotFound, status, "body: %s", body)
+	})
+
+	t.Run("target_not_employee", func(t *testing.T) {
+		c := newClient()
+		_, ownerLogin := mustRegisterAndLogin(t, c)
+		owner := c.withToken(ownerLogin.AccessToken)
+		companyUUID := mustCreateCompany(t, owner, randomTitle())
+
+		_, strangerLogin := mustRegisterAndLogin(t, c)
+		status, body := owner.post("/api/auth/company/"+companyUUID+"/ownership/transfer", map[string]string{"target_uuid": strangerLogin.UserUUID})
+		assert.Equal(t, http.StatusNotFound, status, "body: %s", body)
+	})
+
+	t.Run("owner_must_stay_chief", func(t *testing.T) {
+		c := newClient()
+		_, ownerLogin := mustRegisterAndLogin(t, c)
+		owner := c.withToken(ownerLogin.AccessToken)
+		companyUUID := mustCreateCompany(t, owner, randomTitle())
+
+		_, chiefLogin := mustRegisterAndLogin(t, c)
+		chief := c.withToken(chiefLogin.AccessToken)
+		mustAddMember(t, owner, chief, companyUUID)
+		mustSetEmployeeRole(t, owner, companyUUID, chiefLogin.UserUUID, "chief")
+
+		status, body := chief.patch(fmt.Sprintf("/api/auth/company/%s/employee/%s/role", companyUUID, ownerLogin.UserUUID), map[string]string{"role": "manager"})
+		assert.Equal(t, http.StatusPreconditionFailed, status, "body: %s", body)
+
+		status, body = chief.delete(fmt.Sprintf("/api/auth/company/%s/employee/%s", companyUUID, ownerLogin.UserUUID), nil)
+		assert.Equal(t, http.StatusPreconditionFailed, status, "body: %s", body)
+	})
+}
+
+// ─── CompanyAuditLog ──────────────────────────────────────────────────────────
+
+func TestCompanyAuditLog(t *testing.T) {
+	c := newClient()
+	_, chiefLogin := mustRegisterAndLogin(t, c)
+	chief := c.withToken(chiefLogin.AccessToken)
+	companyUUID := mustCreateCompany(t, chief, "Audit Co")
+
+	_, memberLogin := mustRegisterAndLogin(t, c)
+	member := c.withToken(memberLogin.AccessToken)
+	mustAddMember(t, chief, member, companyUUID) // создаёт код для вступления
+	mustSetEmployeeRole(t, chief, companyUUID, memberLogin.UserUUID, "engineer")
+	deptUUID := mustCreateDepartment(t, chief, companyUUID, "Audit Dept")
+	status, body := chief.patch("/api/auth/company/"+companyUUID+"/title", map[string]string{"title": "Audit Co Renamed"})
+	require.Equal(t, http.StatusOK, status, "body: %s", body)
+
+	t.Run("records_actions", func(t *testing.T) {
+		log := mustGetCompanyAuditLog(t, chief, companyUUID, "count=100")
+		assert.Equal(t, int64(4), log.TotalCount)
+		require.Len(t, log.Entries, 4)
+
+		// Новые записи первыми
+		actions := make([]string, 0, len(log.Entries))
+		for _, entry := range log.Entries {
+			actions = append(actions, entry.Action)
+			assert.Equal(t, chiefLogin.UserUUID, entry.ActorUUID)
+			assert.NotEmpty(t, entry.OperationID)
+		}
+		assert.Equal(t, []string{"company_title_changed", "department_created", "employee_role_changed", "join_code_created"}, actions)
+
+		assert.Equal(t, "Audit Co", log.Entries[0].BeforeValue)
+		assert.Equal(t, "Audit Co Renamed", log.Entries[0].AfterValue)
+		assert.Equal(t, deptUUID, log.Entries[1].TargetID)
+		assert.Equal(t, "Audit Dept", log.Entries[1].AfterValue)
+	})
+
+	t.Run("filter_by_action", func(t *testing.T) {
+		log := mustGetCompanyAuditLog(t, chief, companyUUID, "count=10&action=employee_role_changed")
+		require.Len(t, log.Entries, 1)
+		entry := log.Entries[0]
+		assert.Equal(t, "employee", entry.TargetType)
+		assert.Equal(t, memberLogin.UserUUID, entry.TargetID)
+		assert.Equal(t, "unemployed", entry.BeforeValue)
+		assert.Equal(t, "engineer", entry.AfterValue)
+	})
+
+	t.Run("filter_by_actor_and_date", func(t *testing.T) {
+		log := mustGetCompanyAuditLog(t, chief, companyUUID, "count=10&actor_uuid="+memberLogin.UserUUID)
+		assert.Empty(t, log.Entries)
+		assert.Equal(t, int64(0), log.TotalCount)
+
+		log = mustGetCompanyAuditLog(t, chief, companyUUID, "count=10&created_to=2000-01-01")
+		assert.Empty(t, log.Entries)
+
+		log = mustGetCompanyAuditLog(t, chief, companyUUID, "count=10&created_from="+url.QueryEscape(time.Now().Add(-time.Hour).Format(time.RFC3339)))
+		assert.Len(t, log.Entries, 4)
+	})
+
+	t.Run("cursor_pagination", func(t *testing.T) {
+		first := mustGetCompanyAuditLog(t, chief, companyUUID, "count=3")
+		require.Len(t, first.Entries, 3)
+		require.NotEmpty(t, first.NextCursor)
+
+		second := mustGetCompanyAuditLog(t, chief, companyUUID, "count=3&cursor="+url.QueryEscape(first.NextCursor))
+		require.Len(t, second.Entries, 1)
+		assert.Equal(t, "join_code_created", second.Entries[0].Action)
+		assert.Empty(t, second.NextCursor)
+	})
+
+	t.Run("chief_only", func(t *testing.T) {
+		status, body := member.get("/api/auth/company/" + companyUUID + "/audit-log?count=10")
+		assert.Equal(t, http.StatusForbidden, status, "body: %s", body)
+	})
+
+	t.Run("invalid_action", func(t *testing.T) {
+		status, body := chief.get("/api/auth/company/" + companyUUID + "/audit-log?count=10&action=company_deleted")
+		assert.Equal(t, http.StatusBadRequest, status, "body: %s", body)
+	})
+}
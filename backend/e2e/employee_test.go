@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -109,6 +111,38 @@ func TestGetCompanyEmployees(t *testing.T) {
 		assert.Contains(t, uuids, memberLogin.UserUUID)
 	})
 
+	t.Run("cursor_pagination", func(t *testing.T) {
+		c := newClient()
+		_, chiefLogin := mustRegisterAndLogin(t, c)
+		chief := c.withToken(chiefLogin.AccessToken)
+		companyUUID := mustCreateCompany(t, chief, randomTitle())
+
+		for i := 0; i < 2; i++ {
+			_, memberLogin := mustRegisterAndLogin(t, c)
+			mustAddMember(t, chief, c.withToken(memberLogin.AccessToken), companyUUID)
+		}
+
+		// chief + 2 участника, по одному на страницу
+		seen := make([]string, 0, 3)
+		query := "count=1"
+		for page := 0; page < 10; page++ {
+			status, body := chief.get("/api/auth/company/" + companyUUID + "/employees/list?" + query)
+			require.Equal(t, http.StatusOK, status, "body: %s", body)
+			var resp employeesListResp
+			require.NoError(t, json.Unmarshal(body, &resp))
+			assert.Equal(t, int64(3), resp.TotalCount)
+			for _, e := range resp.Employees {
+				seen = append(seen, e.UserUUID)
+			}
+			if resp.NextCursor == "" {
+				break
+			}
+			query = "count=1&cursor=" + url.QueryEscape(resp.NextCursor)
+		}
+		assert.Len(t, seen, 3)
+		assert.Contains(t, seen, chiefLogin.UserUUID)
+	})
+
 	t.Run("filter_by_role", func(t *testing.T) {
 		c := newClient()
 		_, chiefLogin := mustRegisterAndLogin(t, c)
@@ -439,6 +473,198 @@ func TestRemoveCompanyEmployee(t *testing.T) {
 	})
 }
 
+// ─── LeaveCompany ─────────────────────────────────────────────────────────────
+
+func TestLeaveCompany(t *testing.T) {
+	t.Run("member_leaves", func(t *testing.T) {
+		c := newClient()
+		_, chiefLogin := mustRegisterAndLogin(t, c)
+		chief := c.withToken(chiefLogin.AccessToken)
+		companyUUID := mustCreateCompany(t, chief, randomTitle())
+
+		_, memberLogin := mustRegisterAndLogin(t, c)
+		member := c.withToken(memberLogin.AccessToken)
+		mustAddMember(t, chief, member, companyUUID)
+
+		status, body := member.post("/api/auth/company/"+companyUUID+"/leave", nil)
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+
+		status, body = chief.get(fmt.Sprintf("/api/auth/company/%s/employee/%s/info", companyUUID, memberLogin.UserUUID))
+		assert.Equal(t, http.StatusNotFound, status, "body: %s", body)
+	})
+
+	t.Run("owner_cannot_leave", func(t *testing.T) {
+		c := newClient()
+		_, ownerLogin := mustRegisterAndLogin(t, c)
+		owner := c.withToken(ownerLogin.AccessToken)
+		companyUUID := mustCreateCompany(t, owner, randomTitle())
+
+		status, body := owner.post("/api/auth/company/"+companyUUID+"/leave", nil)
+		assert.Equal(t, http.StatusPreconditionFailed, status, "body: %s", body)
+	})
+
+	t.Run("former_owner_leaves_after_transfer", func(t *testing.T) {
+		c := newClient()
+		_, ownerLogin := mustRegisterAndLogin(t, c)
+		owner := c.withToken(ownerLogin.AccessToken)
+		companyUUID := mustCreateCompany(t, owner, randomTitle())
+
+		_, successorLogin := mustRegisterAndLogin(t, c)
+		successor := c.withToken(successorLogin.AccessToken)
+		mustAddMember(t, owner, successor, companyUUID)
+		mustTransferOwnership(t, owner, successor, companyUUID, successorLogin.UserUUID)
+
+		status, body := owner.post("/api/auth/company/"+companyUUID+"/leave", nil)
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+
+		status, body = successor.get(fmt.Sprintf("/api/auth/company/%s/employee/%s/info", companyUUID, ownerLogin.UserUUID))
+		assert.Equal(t, http.StatusNotFound, status, "body: %s", body)
+	})
+
+	t.Run("open_applications_released", func(t *testing.T) {
+		env := mustSetupAppEnv(t, newClient())
+
+		appUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID, "Leave", "Executor leaves the company")
+		mustAssignApplication(t, env.Manager, appUUID, env.EngineerUUID)
+		mustSetAppStatus(t, env.Engineer, appUUID, "in_progress")
+
+		status, body := env.Engineer.post("/api/auth/company/"+env.CompanyUUID+"/leave", nil)
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+
+		// Заявки освобождаются асинхронно по событию employee.left
+		require.Eventually(t, func() bool {
+			app := mustGetApplicationDetail(t, env.Manager, appUUID)
+			return app.Status == "recalled" && app.ExecutedBy == ""
+		}, 10*time.Second, 200*time.Millisecond, "application of left engineer should be recalled")
+	})
+}
+
+// ─── Employee offboarding ─────────────────────────────────────────────────────
+
+func TestEmployeeOffboarding(t *testing.T) {
+	// setup создаёт заявку, назначенную инженеру первого отдела
+	setup := func(t *testing.T) (appEnv, string) {
+		env := mustSetupAppEnv(t, newClient())
+		appUUID := mustCreateApplication(t, env.Inspector, env.CompanyUUID, "Offboarding", "Executor is offboarded")
+		mustAssignApplication(t, env.Manager, appUUID, env.EngineerUUID)
+		return env, appUUID
+	}
+	openApplications := func(t *testing.T, env appEnv, employeeUUID, query string) employeeOpenApplicationsResp {
+		t.Helper()
+		status, body := env.Chief.get(fmt.Sprintf("/api/auth/company/%s/employee/%s/open-applications%s", env.CompanyUUID, employeeUUID, query))
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+		var resp employeeOpenApplicationsResp
+		require.NoError(t, json.Unmarshal(body, &resp))
+		return resp
+	}
+
+	t.Run("report", func(t *testing.T) {
+		env, appUUID := setup(t)
+
+		report := openApplications(t, env, env.EngineerUUID, "")
+		require.Len(t, report.Applications, 1)
+		assert.Equal(t, appUUID, report.Applications[0].ApplicationUUID)
+		assert.Equal(t, []string{"executor"}, report.Applications[0].Relations)
+
+		report = openApplications(t, env, env.ManagerUUID, "")
+		require.Len(t, report.Applications, 1)
+		assert.Equal(t, []string{"manager"}, report.Applications[0].Relations)
+
+		// Новая роль сохраняет связь с заявкой
+		report = openApplications(t, env, env.EngineerUUID, "?new_role=engineer")
+		assert.Empty(t, report.Applications)
+
+		// Заявки другого отдела не затрагиваются
+		report = openApplications(t, env, env.EngineerUUID, "?department_uuid="+env.Dept2UUID)
+		assert.Empty(t, report.Applications)
+	})
+
+	t.Run("report_non_chief_forbidden", func(t *testing.T) {
+		env, _ := setup(t)
+		status, body := env.Manager.get(fmt.Sprintf("/api/auth/company/%s/employee/%s/open-applications", env.CompanyUUID, env.EngineerUUID))
+		assert.Equal(t, http.StatusForbidden, status, "body: %s", body)
+	})
+
+	t.Run("block_by_default", func(t *testing.T) {
+		env, appUUID := setup(t)
+
+		status, body := env.Chief.delete(fmt.Sprintf("/api/auth/company/%s/employee/%s", env.CompanyUUID, env.EngineerUUID), nil)
+		assert.Equal(t, http.StatusPreconditionFailed, status, "body: %s", body)
+
+		status, body = env.Chief.patch(fmt.Sprintf("/api/auth/company/%s/employee/%s/role", env.CompanyUUID, env.EngineerUUID), map[string]string{"role": "inspector"})
+		assert.Equal(t, http.StatusPreconditionFailed, status, "body: %s", body)
+
+		app := mustGetApplicationDetail(t, env.Manager, appUUID)
+		assert.Equal(t, env.EngineerUUID, app.ExecutedBy, "blocked change should not touch the application")
+	})
+
+	t.Run("pool_on_department_removal", func(t *testing.T) {
+		env, appUUID := setup(t)
+
+		status, body := env.Chief.delete(fmt.Sprintf("/api/auth/company/%s/department/%s/employee/%s?open_applications=pool", env.CompanyUUID, env.DeptUUID, env.EngineerUUID), nil)
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+
+		// Заявки обрабатываются асинхронно по событию employee.department_changed
+		var app applicationDetail
+		require.Eventually(t, func() bool {
+			app = mustGetApplicationDetail(t, env.Manager, appUUID)
+			return app.Status == "recalled" && app.ExecutedBy == ""
+		}, 10*time.Second, 200*time.Millisecond, "application should be returned to department pool")
+		require.NotEmpty(t, app.FixLogs)
+		last := app.FixLogs[len(app.FixLogs)-1]
+		assert.Equal(t, "employee removed from department: returned to department pool", last.Text)
+		assert.Equal(t, env.ChiefUUID, last.CreatedBy)
+	})
+
+	t.Run("pool_on_role_change", func(t *testing.T) {
+		env, appUUID := setup(t)
+
+		status, body := env.Chief.patch(fmt.Sprintf("/api/auth/company/%s/employee/%s/role?open_applications=pool", env.CompanyUUID, env.EngineerUUID), map[string]string{"role": "inspector"})
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+
+		require.Eventually(t, func() bool {
+			app := mustGetApplicationDetail(t, env.Manager, appUUID)
+			return app.Status == "recalled" && app.ExecutedBy == ""
+		}, 10*time.Second, 200*time.Millisecond, "application should be returned to department pool")
+	})
+
+	t.Run("reassign_on_removal", func(t *testing.T) {
+		env, appUUID := setup(t)
+
+		_, colleagueLogin := mustRegisterAndLogin(t, newClient())
+		colleague := newClient().withToken(colleagueLogin.AccessToken)
+		mustAddMember(t, env.Chief, colleague, env.CompanyUUID)
+		mustSetEmployeeRole(t, env.Chief, env.CompanyUUID, colleagueLogin.UserUUID, "engineer")
+		mustAddEmployeeToDepartment(t, env.Chief, env.CompanyUUID, env.DeptUUID, colleagueLogin.UserUUID)
+
+		// Коллега из другого отдела не подходит
+		status, body := env.Chief.delete(fmt.Sprintf("/api/auth/company/%s/employee/%s?open_applications=reassign&reassign_to=%s", env.CompanyUUID, env.EngineerUUID, env.Engineer2UUID), nil)
+		assert.Equal(t, http.StatusPreconditionFailed, status, "body: %s", body)
+
+		status, body = env.Chief.delete(fmt.Sprintf("/api/auth/company/%s/employee/%s?open_applications=reassign&reassign_to=%s", env.CompanyUUID, env.EngineerUUID, colleagueLogin.UserUUID), nil)
+		require.Equal(t, http.StatusOK, status, "body: %s", body)
+
+		var app applicationDetail
+		require.Eventually(t, func() bool {
+			app = mustGetApplicationDetail(t, env.Manager, appUUID)
+			return app.ExecutedBy == colleagueLogin.UserUUID
+		}, 10*time.Second, 200*time.Millisecond, "application should be reassigned to colleague")
+		assert.Equal(t, "assigned", app.Status)
+		require.NotEmpty(t, app.FixLogs)
+		assert.Equal(t, "employee removed from company: reassigned to "+colleagueLogin.UserUUID, app.FixLogs[len(app.FixLogs)-1].Text)
+	})
+
+	t.Run("invalid_policy", func(t *testing.T) {
+		env, _ := setup(t)
+
+		status, body := env.Chief.delete(fmt.Sprintf("/api/auth/company/%s/employee/%s?open_applications=drop", env.CompanyUUID, env.EngineerUUID), nil)
+		assert.Equal(t, http.StatusBadRequest, status, "body: %s", body)
+
+		status, body = env.Chief.delete(fmt.Sprintf("/api/auth/company/%s/employee/%s?open_applications=pool&reassign_to=%s", env.CompanyUUID, env.EngineerUUID, env.ManagerUUID), nil)
+		assert.Equal(t, http.StatusBadRequest, status, "body: %s", body)
+	})
+}
+
 // ─── Full employee workflow ───────────────────────────────────────────────────
 
 func TestEmployeeFullWorkflow(t *testing.T) {
@@ -1,12 +1,20 @@
 package e2e
 
 import (
+	"bufio"
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"io"
 	"math/rand"
+	"mime/multipart"
 	"net/http"
+	"net/textproto"
+	"strings"
 	"testing"
 	"time"
 
@@ -67,18 +75,70 @@ func (c *apiClient) patch(path string, body any) (int, []byte) {
 	return c.do(http.MethodPatch, path, body)
 }
 
+func (c *apiClient) put(path string, body any) (int, []byte) {
+	return c.do(http.MethodPut, path, body)
+}
+
 func (c *apiClient) delete(path string, body any) (int, []byte) {
 	return c.do(http.MethodDelete, path, body)
 }
 
-// ─── Response types ───────────────────────────────────────────────────────────
+// upload отправляет multipart/form-data с одним файлом в поле "file" и дополнительными полями формы.
+func (c *apiClient) upload(path, fileName, contentType string, content []byte, fields map[string]string) (int, []byte) {
+	buf := &bytes.Buffer{}
+	w := multipart.NewWriter(buf)
+	for k, v := range fields {
+		_ = w.WriteField(k, v)
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, fileName))
+	header.Set("Content-Type", contentType)
+	part, _ := w.CreatePart(header)
+	_, _ = part.Write(content)
+	_ = w.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, c.base+path, buf)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	if c.accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, nil
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, respBody
+}
+
+// download выполняет GET и возвращает код, заголовки и тело ответа (выгрузки файлов).
+func (c *apiClient) download(path string) (int, http.Header, []byte) {
+	req, _ := http.NewRequest(http.MethodGet, c.base+path, nil)
+	if c.accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, nil, nil
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, resp.Header, respBody
+}
 
+// ─── Response types ───────────────────────────────────────────────────────────
 
 type loginResp struct {
-	UserUUID     string `json:"user_uuid"`
-	AccessToken  string `json:"access_token"`
-	RefreshToken string `json:"refresh_token"`
-	SessionUUID  string `json:"session_uuid"`
+	UserUUID        string `json:"user_uuid"`
+	AccessToken     string `json:"access_token"`
+	RefreshToken    string `json:"refresh_token"`
+	SessionUUID     string `json:"session_uuid"`
+	TwoFactorMethod string `json:"two_factor_method"`
 }
 
 type getUserResp struct {
@@ -239,7 +299,9 @@ type employeeInfoResp struct {
 }
 
 type employeesListResp struct {
-	Employees []employeeInfoResp `json:"employees"`
+	Employees  []employeeInfoResp `json:"employees"`
+	NextCursor string             `json:"next_cursor"`
+	TotalCount int64              `json:"total_count"`
 }
 
 type employeesSummaryResp struct {
@@ -261,10 +323,13 @@ type companyResp struct {
 	CompanyUUID string `json:"company_uuid"`
 	Title       string `json:"title"`
 	Status      string `json:"status"`
+	OwnerUUID   string `json:"owner_uuid"`
 }
 
 type companiesResp struct {
-	Companies []companyResp `json:"companies"`
+	Companies  []companyResp `json:"companies"`
+	NextCursor string        `json:"next_cursor"`
+	TotalCount int64         `json:"total_count"`
 }
 
 type createCodeResp struct {
@@ -276,8 +341,122 @@ type codesResp struct {
 }
 
 type joinCompanyResp struct {
-	CompanyUUID string `json:"company_uuid"`
-	Role        string `json:"role"`
+	CompanyUUID     string `json:"company_uuid"`
+	Role            string `json:"role"`
+	Status          string `json:"status"`
+	JoinRequestUUID string `json:"join_request_uuid"`
+}
+
+type joinCodeItem struct {
+	Code    string `json:"code"`
+	MaxUses int64  `json:"max_uses"`
+	Uses    int64  `json:"uses"`
+}
+
+type joinCodesResp struct {
+	JoinCodes []joinCodeItem `json:"join_codes"`
+}
+
+type joinCodeRedemptionsResp struct {
+	Redemptions []struct {
+		UserUUID        string `json:"user_uuid"`
+		RedeemedAt      string `json:"redeemed_at"`
+		JoinRequestUUID string `json:"join_request_uuid"`
+	} `json:"redemptions"`
+}
+
+type joinRequestItem struct {
+	JoinRequestUUID string `json:"join_request_uuid"`
+	UserUUID        string `json:"user_uuid"`
+	Status          string `json:"status"`
+}
+
+type joinRequestsResp struct {
+	JoinRequests []joinRequestItem `json:"join_requests"`
+}
+
+type ownershipTransferResp struct {
+	TransferUUID string `json:"transfer_uuid"`
+	FromUUID     string `json:"from_uuid"`
+	ToUUID       string `json:"to_uuid"`
+	Status       string `json:"status"`
+	ExpiresAt    string `json:"expires_at"`
+}
+
+type companyDeletionResp struct {
+	Step                 string `json:"step"`
+	RequestedBy          string `json:"requested_by"`
+	PurgeAfter           string `json:"purge_after"`
+	ArchiveKey           string `json:"archive_key"`
+	ArchivedApplications int64  `json:"archived_applications"`
+	CompletedAt          string `json:"completed_at"`
+}
+
+type auditEntry struct {
+	EntryUUID   string `json:"entry_uuid"`
+	ActorUUID   string `json:"actor_uuid"`
+	Action      string `json:"action"`
+	TargetType  string `json:"target_type"`
+	TargetID    string `json:"target_id"`
+	BeforeValue string `json:"before_value"`
+	AfterValue  string `json:"after_value"`
+	OperationID string `json:"operation_id"`
+	CreatedAt   string `json:"created_at"`
+}
+
+type auditLogResp struct {
+	Entries    []auditEntry `json:"entries"`
+	NextCursor string       `json:"next_cursor"`
+	TotalCount int64        `json:"total_count"`
+}
+
+type createInvitationResp struct {
+	InvitationUUID string `json:"invitation_uuid"`
+}
+
+type invitationItem struct {
+	InvitationUUID string `json:"invitation_uuid"`
+	CompanyUUID    string `json:"company_uuid"`
+	CompanyTitle   string `json:"company_title"`
+	Email          string `json:"email"`
+	Role           string `json:"role"`
+	DepartmentUUID string `json:"department_uuid"`
+	Status         string `json:"status"`
+}
+
+type invitationsResp struct {
+	Invitations []invitationItem `json:"invitations"`
+}
+
+// ─── Location response types ──────────────────────────────────────────────────
+
+type createLocationResp struct {
+	LocationUUID string `json:"location_uuid"`
+}
+
+type locationItem struct {
+	LocationUUID string   `json:"location_uuid"`
+	ParentUUID   string   `json:"parent_uuid"`
+	Kind         string   `json:"kind"`
+	Title        string   `json:"title"`
+	Latitude     *float64 `json:"latitude"`
+	Longitude    *float64 `json:"longitude"`
+}
+
+type locationResp struct {
+	locationItem
+	CompanyUUID string         `json:"company_uuid"`
+	Path        []locationItem `json:"path"`
+}
+
+type locationsListResp struct {
+	Locations []locationItem `json:"locations"`
+}
+
+// ─── Category response types ──────────────────────────────────────────────────
+
+type createCategoryResp struct {
+	CategoryUUID string `json:"category_uuid"`
 }
 
 // ─── Company data helpers ─────────────────────────────────────────────────────
@@ -310,6 +489,33 @@ func mustCreateCode(t *testing.T, c *apiClient, companyUUID string, ttl int) str
 	return resp.Code
 }
 
+// mustCreateLimitedCode creates a join code that can be redeemed at most maxUses times.
+func mustCreateLimitedCode(t *testing.T, c *apiClient, companyUUID string, ttl, maxUses int) string {
+	t.Helper()
+	status, body := c.post("/api/auth/company/"+companyUUID+"/code", map[string]int{"code_ttl": ttl, "max_uses": maxUses})
+	require.Equalf(t, http.StatusCreated, status, "create join code failed (body: %s)", body)
+	var resp createCodeResp
+	require.NoError(t, json.Unmarshal(body, &resp))
+	require.NotEmpty(t, resp.Code, "create join code returned empty code")
+	return resp.Code
+}
+
+// mustCreateInvitation invites the email into the company and returns the invitation UUID.
+func mustCreateInvitation(t *testing.T, chief *apiClient, companyUUID, email, role, deptUUID string) string {
+	t.Helper()
+	status, body := chief.post("/api/auth/company/"+companyUUID+"/invitations", map[string]any{
+		"email":           email,
+		"role":            role,
+		"department_uuid": deptUUID,
+		"ttl":             3600,
+	})
+	require.Equalf(t, http.StatusCreated, status, "create invitation failed (body: %s)", body)
+	var resp createInvitationResp
+	require.NoError(t, json.Unmarshal(body, &resp))
+	require.NotEmpty(t, resp.InvitationUUID, "create invitation returned empty invitation_uuid")
+	return resp.InvitationUUID
+}
+
 // mustJoinCompany joins a company using the given 6-digit code.
 func mustJoinCompany(t *testing.T, c *apiClient, code string) joinCompanyResp {
 	t.Helper()
@@ -332,6 +538,35 @@ func mustCreateDepartment(t *testing.T, chief *apiClient, companyUUID, title str
 	return resp.DepartmentUUID
 }
 
+// mustCreateLocation создаёт место (site, building, floor или zone) и возвращает его UUID.
+func mustCreateLocation(t *testing.T, chief *apiClient, companyUUID, parentUUID, kind, title string) string {
+	t.Helper()
+	status, body := chief.post("/api/auth/company/"+companyUUID+"/location", map[string]string{
+		"parent_uuid": parentUUID,
+		"kind":        kind,
+		"title":       title,
+	})
+	require.Equalf(t, http.StatusCreated, status, "create location failed (body: %s)", body)
+	var resp createLocationResp
+	require.NoError(t, json.Unmarshal(body, &resp))
+	require.NotEmpty(t, resp.LocationUUID, "create location returned empty location_uuid")
+	return resp.LocationUUID
+}
+
+// mustCreateCategory создаёт категорию дефектов и возвращает её UUID.
+func mustCreateCategory(t *testing.T, chief *apiClient, companyUUID, title, defaultDepartmentUUID string) string {
+	t.Helper()
+	status, body := chief.post("/api/auth/company/"+companyUUID+"/categories", map[string]string{
+		"title":                   title,
+		"default_department_uuid": defaultDepartmentUUID,
+	})
+	require.Equalf(t, http.StatusCreated, status, "create category failed (body: %s)", body)
+	var resp createCategoryResp
+	require.NoError(t, json.Unmarshal(body, &resp))
+	require.NotEmpty(t, resp.CategoryUUID, "create category returned empty category_uuid")
+	return resp.CategoryUUID
+}
+
 // mustSetEmployeeRole устанавливает роль сотрудника в компании.
 func mustSetEmployeeRole(t *testing.T, chief *apiClient, companyUUID, targetUUID, role string) {
 	t.Helper()
@@ -352,6 +587,35 @@ func mustAddEmployeeToDepartment(t *testing.T, chief *apiClient, companyUUID, de
 	require.Equalf(t, http.StatusOK, status, "add employee to department failed (body: %s)", body)
 }
 
+// mustTransferOwnership offers the company to the target employee and has the target accept the transfer.
+func mustTransferOwnership(t *testing.T, owner, target *apiClient, companyUUID, targetUUID string) {
+	t.Helper()
+	status, body := owner.post("/api/auth/company/"+companyUUID+"/ownership/transfer", map[string]string{"target_uuid": targetUUID})
+	require.Equalf(t, http.StatusCreated, status, "transfer ownership failed (body: %s)", body)
+	status, body = target.post("/api/auth/company/"+companyUUID+"/ownership/transfer/accept", nil)
+	require.Equalf(t, http.StatusOK, status, "accept ownership transfer failed (body: %s)", body)
+}
+
+// mustGetCompanyDeletion возвращает ход удаления компании.
+func mustGetCompanyDeletion(t *testing.T, c *apiClient, companyUUID string) companyDeletionResp {
+	t.Helper()
+	status, body := c.get("/api/auth/company/" + companyUUID + "/deletion")
+	require.Equal(t, http.StatusOK, status, "get deletion status failed (body: %s)", body)
+	var resp companyDeletionResp
+	require.NoError(t, json.Unmarshal(body, &resp))
+	return resp
+}
+
+// mustGetCompanyAuditLog возвращает страницу журнала аудита компании (query - параметры фильтрации и пагинации).
+func mustGetCompanyAuditLog(t *testing.T, chief *apiClient, companyUUID, query string) auditLogResp {
+	t.Helper()
+	status, body := chief.get("/api/auth/company/" + companyUUID + "/audit-log?" + query)
+	require.Equal(t, http.StatusOK, status, "get audit log failed (body: %s)", body)
+	var resp auditLogResp
+	require.NoError(t, json.Unmarshal(body, &resp))
+	return resp
+}
+
 // mustOpenCompany открывает компанию (устанавливает статус "open") от имени chief.
 func mustOpenCompany(t *testing.T, chief *apiClient, companyUUID string) {
 	t.Helper()
@@ -378,32 +642,104 @@ type applicationDetailResp struct {
 }
 
 type applicationDetail struct {
-	ApplicationUUID string           `json:"application_uuid"`
-	DepartmentUUID  string           `json:"department_uuid"`
-	Status          string           `json:"status"`
-	RevisionCount   int64            `json:"revision_count"`
-	ManagedBy       string           `json:"managed_by"`
-	ExecutedBy      string           `json:"executed_by"`
-	InspectedBy     string           `json:"inspected_by"`
-	ClosedAt        string           `json:"closed_at"`
-	DeletedAt       string           `json:"deleted_at"`
-	DeletedBy       string           `json:"deleted_by"`
-	FixLogs         []fixLogRespItem `json:"fix_logs"`
+	ApplicationUUID string            `json:"application_uuid"`
+	DepartmentUUID  string            `json:"department_uuid"`
+	LocationUUID    string            `json:"location_uuid"`
+	CategoryUUID    string            `json:"category_uuid"`
+	Severity        string            `json:"severity"`
+	CustomFields    map[string]string `json:"custom_fields"`
+	Status          string            `json:"status"`
+	Priority        string            `json:"priority"`
+	DueAt           string            `json:"due_at"`
+	IsOverdue       bool              `json:"is_overdue"`
+	RevisionCount   int64             `json:"revision_count"`
+	ManagedBy       string            `json:"managed_by"`
+	ExecutedBy      string            `json:"executed_by"`
+	InspectedBy     string            `json:"inspected_by"`
+	ClosedAt        string            `json:"closed_at"`
+	DeletedAt       string            `json:"deleted_at"`
+	DeletedBy       string            `json:"deleted_by"`
+	FixLogs         []fixLogRespItem  `json:"fix_logs"`
+	Attachments     []attachmentItem  `json:"attachments"`
 }
 
 type fixLogRespItem struct {
-	UUID      string `json:"uuid"`
-	Text      string `json:"text"`
-	CreatedBy string `json:"created_by"`
+	UUID        string           `json:"uuid"`
+	Text        string           `json:"text"`
+	CreatedBy   string           `json:"created_by"`
+	Attachments []attachmentItem `json:"attachments"`
+}
+
+type attachmentItem struct {
+	AttachmentUUID string `json:"attachment_uuid"`
+	FixLogUUID     string `json:"fix_log_uuid"`
+	FileName       string `json:"file_name"`
+	ContentType    string `json:"content_type"`
+	Size           int64  `json:"size"`
+	CreatedBy      string `json:"created_by"`
+}
+
+type uploadAttachmentResp struct {
+	Attachment attachmentItem `json:"attachment"`
+}
+
+type commentItem struct {
+	CommentUUID       string        `json:"comment_uuid"`
+	ParentCommentUUID string        `json:"parent_comment_uuid"`
+	Text              string        `json:"text"`
+	MentionedUUIDs    []string      `json:"mentioned_uuids"`
+	CreatedBy         string        `json:"created_by"`
+	EditedAt          string        `json:"edited_at"`
+	IsDeleted         bool          `json:"is_deleted"`
+	Replies           []commentItem `json:"replies"`
+}
+
+type createCommentResp struct {
+	Comment commentItem `json:"comment"`
+}
+
+type commentListResp struct {
+	Comments   []commentItem `json:"comments"`
+	NextCursor string        `json:"next_cursor"`
+	TotalCount int64         `json:"total_count"`
+}
+
+type commentHistoryResp struct {
+	Comment commentItem `json:"comment"`
+	Edits   []struct {
+		Text     string `json:"text"`
+		EditedBy string `json:"edited_by"`
+	} `json:"edits"`
+}
+
+type addFixLogResp struct {
+	FixLogUUID string `json:"fix_log_uuid"`
 }
 
 type applicationListResp struct {
 	Applications []applicationListItem `json:"applications"`
+	NextCursor   string                `json:"next_cursor"`
+	TotalCount   int64                 `json:"total_count"`
 }
 
 type applicationListItem struct {
 	ApplicationUUID string `json:"application_uuid"`
 	Status          string `json:"status"`
+	Priority        string `json:"priority"`
+	IsOverdue       bool   `json:"is_overdue"`
+	RevisionCount   int64  `json:"revision_count"`
+	LocationUUID    string `json:"location_uuid"`
+	CategoryUUID    string `json:"category_uuid"`
+	Severity        string `json:"severity"`
+}
+
+type employeeOpenApplicationsResp struct {
+	Applications []struct {
+		ApplicationUUID string   `json:"application_uuid"`
+		DepartmentUUID  string   `json:"department_uuid"`
+		Status          string   `json:"status"`
+		Relations       []string `json:"relations"`
+	} `json:"applications"`
 }
 
 // ─── Application environment ──────────────────────────────────────────────────
@@ -416,6 +752,7 @@ type appEnv struct {
 	DeptUUID string
 
 	Chief        *apiClient
+	ChiefUUID    string
 	Analytic     *apiClient
 	AnalyticUUID string
 
@@ -490,6 +827,7 @@ func mustSetupAppEnv(t *testing.T, c *apiClient) appEnv {
 		CompanyUUID:    companyUUID,
 		DeptUUID:       deptUUID,
 		Chief:          chief,
+		ChiefUUID:      chiefLogin.UserUUID,
 		Analytic:       analytic,
 		AnalyticUUID:   analyticUUID,
 		Inspector:      inspector,
@@ -562,12 +900,57 @@ func mustRecallApplication(t *testing.T, manager *apiClient, appUUID string) {
 }
 
 // mustAddFixLog adds a fix log entry to an application on behalf of the responsible engineer.
-func mustAddFixLog(t *testing.T, engineer *apiClient, appUUID, message string) {
+// Returns the fix log UUID.
+func mustAddFixLog(t *testing.T, engineer *apiClient, appUUID, message string) string {
 	t.Helper()
 	code, body := engineer.post("/api/auth/application/"+appUUID+"/fix-log", map[string]string{
 		"message": message,
 	})
 	require.Equalf(t, http.StatusCreated, code, "add fix log failed (body: %s)", body)
+	var resp addFixLogResp
+	require.NoError(t, json.Unmarshal(body, &resp))
+	require.NotEmpty(t, resp.FixLogUUID, "add fix log returned empty uuid")
+	return resp.FixLogUUID
+}
+
+// mustUploadAttachment uploads a file to an application (optionally bound to a fix log) and returns the attachment.
+func mustUploadAttachment(t *testing.T, client *apiClient, appUUID, fixLogUUID, fileName, contentType string, content []byte) attachmentItem {
+	t.Helper()
+	fields := map[string]string{}
+	if fixLogUUID != "" {
+		fields["fix_log_uuid"] = fixLogUUID
+	}
+	code, body := client.upload("/api/auth/application/"+appUUID+"/attachments", fileName, contentType, content, fields)
+	require.Equalf(t, http.StatusCreated, code, "upload attachment failed (body: %s)", body)
+	var resp uploadAttachmentResp
+	require.NoError(t, json.Unmarshal(body, &resp))
+	require.NotEmpty(t, resp.Attachment.AttachmentUUID, "upload attachment returned empty uuid")
+	return resp.Attachment
+}
+
+// mustCreateComment adds a comment (or a reply when parentUUID is set) to an application and returns it.
+func mustCreateComment(t *testing.T, client *apiClient, appUUID, parentUUID, text string, mentions ...string) commentItem {
+	t.Helper()
+	code, body := client.post("/api/auth/application/"+appUUID+"/comments", map[string]any{
+		"parent_comment_uuid": parentUUID,
+		"text":                text,
+		"mentioned_uuids":     mentions,
+	})
+	require.Equalf(t, http.StatusCreated, code, "create comment failed (body: %s)", body)
+	var resp createCommentResp
+	require.NoError(t, json.Unmarshal(body, &resp))
+	require.NotEmpty(t, resp.Comment.CommentUUID, "create comment returned empty uuid")
+	return resp.Comment
+}
+
+// mustListComments returns a page of comment threads of an application.
+func mustListComments(t *testing.T, client *apiClient, appUUID, query string) commentListResp {
+	t.Helper()
+	code, body := client.get("/api/auth/application/" + appUUID + "/comments?" + query)
+	require.Equalf(t, http.StatusOK, code, "list comments failed (body: %s)", body)
+	var resp commentListResp
+	require.NoError(t, json.Unmarshal(body, &resp))
+	return resp
 }
 
 // mustReleaseApplicationVerification releases an application from on_verification back to pending_verification.
@@ -622,7 +1005,9 @@ type applicationHistoryItem struct {
 }
 
 type applicationHistoryResp struct {
-	History []applicationHistoryItem `json:"history"`
+	History    []applicationHistoryItem `json:"history"`
+	NextCursor string                   `json:"next_cursor"`
+	TotalCount int64                    `json:"total_count"`
 }
 
 // getApplicationHistory fetches application history and returns the raw status code plus parsed response.
@@ -648,6 +1033,189 @@ func mustGetApplicationDetail(t *testing.T, client *apiClient, appUUID string) a
 	return resp.Application
 }
 
+// ─── Workflow helpers ────────────────────────────────────────────────────────
+
+type workflowTransition struct {
+	Name           string   `json:"name"`
+	Action         string   `json:"action"`
+	From           []string `json:"from"`
+	To             string   `json:"to,omitempty"`
+	Roles          []string `json:"roles"`
+	Guard          string   `json:"guard,omitempty"`
+	RequireMessage bool     `json:"require_message"`
+}
+
+type workflowDefinition struct {
+	States      []string             `json:"states"`
+	Transitions []workflowTransition `json:"transitions"`
+}
+
+type companyWorkflowResp struct {
+	Workflow  workflowDefinition `json:"workflow"`
+	IsDefault bool               `json:"is_default"`
+	UpdatedBy string             `json:"updated_by"`
+}
+
+type applicationActionsResp struct {
+	Actions []workflowTransition `json:"actions"`
+}
+
+// mustGetApplicationActions returns names of the workflow transitions available to the client.
+func mustGetApplicationActions(t *testing.T, client *apiClient, appUUID string) []string {
+	t.Helper()
+	code, body := client.get("/api/auth/application/" + appUUID + "/actions")
+	require.Equalf(t, http.StatusOK, code, "get application actions failed (body: %s)", body)
+	var resp applicationActionsResp
+	require.NoError(t, json.Unmarshal(body, &resp))
+
+	names := make([]string, 0, len(resp.Actions))
+	for _, a := range resp.Actions {
+		names = append(names, a.Name)
+	}
+	return names
+}
+
+// mustGetCompanyWorkflow fetches the company workflow.
+func mustGetCompanyWorkflow(t *testing.T, client *apiClient, companyUUID string) companyWorkflowResp {
+	t.Helper()
+	code, body := client.get("/api/auth/company/" + companyUUID + "/workflow")
+	require.Equalf(t, http.StatusOK, code, "get company workflow failed (body: %s)", body)
+	var resp companyWorkflowResp
+	require.NoError(t, json.Unmarshal(body, &resp))
+	return resp
+}
+
+// mustListApplications fetches the application list with the given query string (without leading "?").
+func mustListApplications(t *testing.T, client *apiClient, companyUUID, query string) []applicationListItem {
+	t.Helper()
+	code, body := client.get("/api/auth/company/" + companyUUID + "/applications/list?" + query)
+	require.Equalf(t, http.StatusOK, code, "get applications failed (body: %s)", body)
+	var resp applicationListResp
+	require.NoError(t, json.Unmarshal(body, &resp))
+	return resp.Applications
+}
+
+// mustListApplicationsPage fetches one page of the application list together with next_cursor and total_count.
+func mustListApplicationsPage(t *testing.T, client *apiClient, companyUUID, query string) applicationListResp {
+	t.Helper()
+	code, body := client.get("/api/auth/company/" + companyUUID + "/applications/list?" + query)
+	require.Equalf(t, http.StatusOK, code, "get applications failed (body: %s)", body)
+	var resp applicationListResp
+	require.NoError(t, json.Unmarshal(body, &resp))
+	return resp
+}
+
+func applicationUUIDs(items []applicationListItem) []string {
+	uuids := make([]string, 0, len(items))
+	for _, item := range items {
+		uuids = append(uuids, item.ApplicationUUID)
+	}
+	return uuids
+}
+
+// ─── SLA helpers ─────────────────────────────────────────────────────────────
+
+type slaPolicy struct {
+	Priority                string `json:"priority"`
+	AssignWithinHours       int32  `json:"assign_within_hours"`
+	VerificationWithinHours int32  `json:"verification_within_hours"`
+}
+
+type slaPoliciesResp struct {
+	Policies []slaPolicy `json:"policies"`
+}
+
+// mustGetCompanySLAPolicies fetches SLA policies of the company.
+func mustGetCompanySLAPolicies(t *testing.T, client *apiClient, companyUUID string) []slaPolicy {
+	t.Helper()
+	code, body := client.get("/api/auth/company/" + companyUUID + "/sla")
+	require.Equalf(t, http.StatusOK, code, "get company sla policies failed (body: %s)", body)
+	var resp slaPoliciesResp
+	require.NoError(t, json.Unmarshal(body, &resp))
+	return resp.Policies
+}
+
+// ─── Application stream helpers ──────────────────────────────────────────────
+
+type applicationStreamEvent struct {
+	Event string
+	Data  applicationUpdateEvent
+}
+
+type applicationUpdateEvent struct {
+	EventType       string `json:"event_type"`
+	ApplicationUUID string `json:"application_uuid"`
+	Status          string `json:"status"`
+	Removed         bool   `json:"removed"`
+}
+
+// applicationStream SSE поток /applications/stream; события читаются в фоне.
+type applicationStream struct {
+	resp   *http.Response
+	events chan applicationStreamEvent
+}
+
+// openApplicationStream opens the SSE stream and returns the raw status code.
+// On non-200 the stream is nil and the body is returned for assertions.
+func openApplicationStream(t *testing.T, client *apiClient, companyUUID, query string) (int, *applicationStream, []byte) {
+	t.Helper()
+	req, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/api/auth/company/%s/applications/stream?%s", client.base, companyUUID, query), nil)
+	if client.accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+client.accessToken)
+	}
+
+	// Без таймаута клиента - поток закрывается в t.Cleanup
+	resp, err := (&http.Client{}).Do(req)
+	require.NoError(t, err)
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, nil, body
+	}
+
+	stream := &applicationStream{resp: resp, events: make(chan applicationStreamEvent, 16)}
+	t.Cleanup(func() { _ = resp.Body.Close() })
+
+	go func() {
+		defer close(stream.events)
+		scanner := bufio.NewScanner(resp.Body)
+		event := applicationStreamEvent{}
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case strings.HasPrefix(line, "event: "):
+				event.Event = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				_ = json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event.Data)
+			case line == "" && event.Event != "":
+				stream.events <- event
+				event = applicationStreamEvent{}
+			}
+		}
+	}()
+
+	return resp.StatusCode, stream, nil
+}
+
+// mustNextApplicationUpdate waits for the next update of the given application, skipping other applications.
+func (s *applicationStream) mustNextApplicationUpdate(t *testing.T, appUUID string) applicationUpdateEvent {
+	t.Helper()
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case event, ok := <-s.events:
+			require.True(t, ok, "application stream closed")
+			require.Equal(t, "application", event.Event)
+			if event.Data.ApplicationUUID == appUUID {
+				return event.Data
+			}
+		case <-timeout:
+			require.FailNow(t, "no update received for application "+appUUID)
+		}
+	}
+}
+
 // ─── Recovery / password-reset helpers ───────────────────────────────────────
 
 // mustGetResetPasswordToken fetches a reset-password JWT token for the given email via debug endpoint.
@@ -755,6 +1323,61 @@ func mustLoginWith2FA(t *testing.T, c *apiClient, email, password string) string
 	return resp.SessionUUID
 }
 
+// mustEnrollTOTP starts authenticator enrollment and returns the TOTP secret.
+func mustEnrollTOTP(t *testing.T, auth *apiClient) string {
+	t.Helper()
+	code, body := auth.post("/api/auth/user/2fa/totp", nil)
+	require.Equalf(t, http.StatusOK, code, "enroll TOTP failed (body: %s)", body)
+	var resp struct {
+		Secret     string `json:"secret"`
+		OtpauthURI string `json:"otpauth_uri"`
+	}
+	require.NoError(t, json.Unmarshal(body, &resp))
+	require.NotEmpty(t, resp.Secret, "TOTP secret is empty")
+	require.Contains(t, resp.OtpauthURI, "secret="+resp.Secret)
+	return resp.Secret
+}
+
+// mustConfirmTOTP confirms enrollment with the current code and returns the recovery codes.
+// The confirmation code is consumed, so the next login must use a code of a later step (see totpCode).
+func mustConfirmTOTP(t *testing.T, auth *apiClient, secret string) []string {
+	t.Helper()
+	code, body := auth.post("/api/auth/user/2fa/totp/confirm", map[string]string{"code": totpCode(t, secret, time.Now())})
+	require.Equalf(t, http.StatusOK, code, "confirm TOTP failed (body: %s)", body)
+	var resp struct {
+		RecoveryCodes []string `json:"recovery_codes"`
+	}
+	require.NoError(t, json.Unmarshal(body, &resp))
+	require.NotEmpty(t, resp.RecoveryCodes, "expected recovery codes")
+	return resp.RecoveryCodes
+}
+
+// totpCode computes the RFC 6238 code (HMAC-SHA1, 30s, 6 digits) for moment at.
+func totpCode(t *testing.T, secret string, at time.Time) string {
+	t.Helper()
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	require.NoError(t, err)
+
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(at.Unix()/30))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%06d", value%1_000_000)
+}
+
+// verify2FA submits a second factor with an explicit method and returns the raw response.
+func verify2FA(c *apiClient, sessionUUID, code, method string) (int, []byte) {
+	return c.post("/api/verify-2fa", map[string]string{
+		"session_uuid": sessionUUID,
+		"code":         code,
+		"method":       method,
+	})
+}
+
 // mustVerify2FA completes step 2 of the 2FA login and returns the resulting token pair.
 func mustVerify2FA(t *testing.T, c *apiClient, sessionUUID, code string) loginResp {
 	t.Helper()
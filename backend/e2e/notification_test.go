@@ -0,0 +1,104 @@
+package e2e
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ─── Mailpit helpers ──────────────────────────────────────────────────────────
+
+type mailpitMessage struct {
+	ID      string `json:"ID"`
+	Subject string `json:"Subject"`
+	Text    string `json:"Text"`
+}
+
+// waitForEmail ждёт, пока в mailpit появится письмо на адрес to с указанной темой, и возвращает его целиком.
+func waitForEmail(t *testing.T, to, subject string) mailpitMessage {
+	t.Helper()
+	httpClient := &http.Client{Timeout: 5 * time.Second}
+	searchURL := mailpitBaseURL + "/api/v1/search?query=" + url.QueryEscape(`to:"`+to+`"`)
+
+	deadline := time.Now().Add(30 * time.Second)
+	for time.Now().Before(deadline) {
+		resp, err := httpClient.Get(searchURL)
+		if err == nil {
+			var search struct {
+				Messages []mailpitMessage `json:"messages"`
+			}
+			_ = json.NewDecoder(resp.Body).Decode(&search)
+			resp.Body.Close()
+
+			for _, m := range search.Messages {
+				if m.Subject != subject {
+					continue
+				}
+
+				resp, err = httpClient.Get(mailpitBaseURL + "/api/v1/message/" + m.ID)
+				require.NoError(t, err)
+				defer resp.Body.Close()
+
+				var message mailpitMessage
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&message))
+				return message
+			}
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+
+	t.Fatalf("email %q to %s was not delivered", subject, to)
+	return mailpitMessage{}
+}
+
+// lineAfter возвращает первую непустую строку текста после строки prefix.
+func lineAfter(text, prefix string) string {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) != prefix {
+			continue
+		}
+		for _, next := range lines[i+1:] {
+			if next = strings.TrimSpace(next); next != "" {
+				return next
+			}
+		}
+	}
+	return ""
+}
+
+// ─── TestNotificationEmails ───────────────────────────────────────────────────
+
+func TestNotificationEmails(t *testing.T) {
+	c := newClient()
+
+	// verification_email — после регистрации приходит письмо, токеном из которого подтверждается аккаунт.
+	t.Run("verification_email", func(t *testing.T) {
+		email := randomEmail()
+		mustRegister(t, c, email, "Password123")
+
+		message := waitForEmail(t, email, "Подтверждение регистрации")
+		assert.Contains(t, message.Text, "Ivan")
+
+		token := lineAfter(message.Text, "Для подтверждения аккаунта используйте токен:")
+		require.NotEmpty(t, token, "verification token not found in email (text: %s)", message.Text)
+		mustVerifyAccount(t, c, token)
+		mustLogin(t, c, email, "Password123")
+	})
+
+	// registration_attempt_email — повторная регистрация на занятый email уведомляет владельца.
+	t.Run("registration_attempt_email", func(t *testing.T) {
+		email, _ := mustRegisterVerifyAndLogin(t, c)
+
+		code, _ := c.post("/api/register", defaultUserPayload(email, "Password123"))
+		require.Equal(t, http.StatusCreated, code, "registration must not reveal that the email is taken")
+
+		waitForEmail(t, email, "Попытка регистрации")
+	})
+}
@@ -15,6 +15,9 @@ const (
 	gatewayPort    = "18080"
 	gatewayBaseURL = "http://localhost:" + gatewayPort
 
+	// mailpit принимает письма notification сервиса, его API используется для проверки доставки
+	mailpitBaseURL = "http://localhost:18025"
+
 	composeFile = "../../docker-compose.test.yml"
 
 	startupTimeout = 5 * time.Minute
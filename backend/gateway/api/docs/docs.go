@@ -15,6 +15,64 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/2fa/email-code": {
+            "post": {
+                "description": "Email fallback for 2FA: sends a code to the account email for the login session started by Login. Verify it with method=email",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Send2FAEmailCode",
+                "parameters": [
+                    {
+                        "description": "SessionUUID from Login",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.Send2FAEmailCodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Send2FAEmailCodeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
         "/auth/application/create": {
             "post": {
                 "security": [
@@ -215,24 +273,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/application/{application_uuid}/assign": {
-            "patch": {
+        "/auth/application/{application_uuid}/actions": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Assign application to an engineer (manager only)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get workflow transitions the current user can perform on the application in its current status",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Application"
                 ],
-                "summary": "Assign application to employee",
+                "summary": "Get available application actions",
                 "parameters": [
                     {
                         "type": "string",
@@ -240,22 +295,13 @@ const docTemplate = `{
                         "name": "application_uuid",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "UUID инженера",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entities.AssignApplicationRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.AssignApplicationResponse"
+                            "$ref": "#/definitions/entities.GetApplicationActionsResponse"
                         }
                     },
                     "400": {
@@ -291,14 +337,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/application/{application_uuid}/fix-log": {
-            "post": {
+        "/auth/application/{application_uuid}/assign": {
+            "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Add a fix log entry to the application (responsible engineer only)",
+                "description": "Assign application to an engineer (manager only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -308,7 +354,7 @@ const docTemplate = `{
                 "tags": [
                     "Application"
                 ],
-                "summary": "Add application fix log",
+                "summary": "Assign application to employee",
                 "parameters": [
                     {
                         "type": "string",
@@ -318,20 +364,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Текст записи",
+                        "description": "UUID инженера",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/entities.AddApplicationFixLogRequest"
+                            "$ref": "#/definitions/entities.AssignApplicationRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.AddApplicationFixLogResponse"
+                            "$ref": "#/definitions/entities.AssignApplicationResponse"
                         }
                     },
                     "400": {
@@ -367,21 +413,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/application/{application_uuid}/history": {
-            "get": {
+        "/auth/application/{application_uuid}/attachments": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get paginated list of application state snapshots (participants and chief/analytic only)",
+                "description": "Upload a photo, PDF or drawing to an application (creator while status is \"created\", responsible engineer afterwards). With fix_log_uuid the file is attached to the engineer's fix log entry",
+                "consumes": [
+                    "multipart/form-data"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Application"
                 ],
-                "summary": "Get application history",
+                "summary": "Upload application attachment",
                 "parameters": [
                     {
                         "type": "string",
@@ -391,25 +440,24 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Count",
-                        "name": "count",
-                        "in": "query"
+                        "type": "file",
+                        "description": "Файл вложения (jpeg, png, webp, heic, pdf, dwg, dxf)",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Offset",
-                        "name": "offset",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Fix log UUID",
+                        "name": "fix_log_uuid",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/entities.GetApplicationHistoryResponse"
+                            "$ref": "#/definitions/entities.UploadApplicationAttachmentResponse"
                         }
                     },
                     "400": {
@@ -436,6 +484,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -445,24 +499,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/application/{application_uuid}/recall": {
-            "patch": {
+        "/auth/application/{application_uuid}/attachments/{attachment_uuid}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Return application from engineer back to the pool (responsible manager only)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Download application attachment content (same access rules as getting the application)",
                 "produces": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
                     "Application"
                 ],
-                "summary": "Recall application from engineer",
+                "summary": "Download application attachment",
                 "parameters": [
                     {
                         "type": "string",
@@ -472,20 +523,18 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Причина отзыва",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entities.RecallApplicationRequest"
-                        }
+                        "type": "string",
+                        "description": "Attachment UUID",
+                        "name": "attachment_uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.RecallApplicationResponse"
+                            "type": "file"
                         }
                     },
                     "400": {
@@ -519,26 +568,21 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/auth/application/{application_uuid}/redirect": {
-            "patch": {
+            },
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Transfer application to a different department (manager only)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Delete application attachment (only its author while still responsible for the application)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Application"
                 ],
-                "summary": "Redirect application to another department",
+                "summary": "Delete application attachment",
                 "parameters": [
                     {
                         "type": "string",
@@ -548,20 +592,18 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "UUID целевого департамента и причина",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entities.RedirectApplicationRequest"
-                        }
+                        "type": "string",
+                        "description": "Attachment UUID",
+                        "name": "attachment_uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.RedirectApplicationResponse"
+                            "$ref": "#/definitions/entities.DeleteApplicationAttachmentResponse"
                         }
                     },
                     "400": {
@@ -597,24 +639,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/application/{application_uuid}/release-verification": {
-            "patch": {
+        "/auth/application/{application_uuid}/comments": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Inspector releases a previously taken application back to pending_verification",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get paginated comment threads of the application, oldest first, each with all its replies (same access rules as getting the application). Deleted comments keep their place in the thread without text",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Application"
                 ],
-                "summary": "Release application verification",
+                "summary": "Get application comments",
                 "parameters": [
                     {
                         "type": "string",
@@ -624,20 +663,31 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Причина снятия",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entities.ReleaseApplicationVerificationRequest"
-                        }
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Count",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from next_cursor of the previous page (instead of offset)",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.ReleaseApplicationVerificationResponse"
+                            "$ref": "#/definitions/entities.GetApplicationCommentsResponse"
                         }
                     },
                     "400": {
@@ -671,16 +721,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/auth/application/{application_uuid}/status": {
-            "patch": {
+            },
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update application status. Inspector: completed, failed, on_revision. Manager: rejected. Engineer: in_progress, on_hold, pending_verification.",
+                "description": "Add a comment to the application or a reply to a top-level comment. Participants and chief can comment, mentioned users must be employees of the application's company who can see its comments",
                 "consumes": [
                     "application/json"
                 ],
@@ -690,7 +738,7 @@ const docTemplate = `{
                 "tags": [
                     "Application"
                 ],
-                "summary": "Update application status",
+                "summary": "Create application comment",
                 "parameters": [
                     {
                         "type": "string",
@@ -700,20 +748,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Новый статус",
+                        "description": "Текст комментария и упоминания",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/entities.UpdateApplicationStatusRequest"
+                            "$ref": "#/definitions/entities.CreateApplicationCommentRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/entities.UpdateApplicationStatusResponse"
+                            "$ref": "#/definitions/entities.CreateApplicationCommentResponse"
                         }
                     },
                     "400": {
@@ -740,6 +788,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -749,21 +803,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/application/{application_uuid}/take-verification": {
-            "patch": {
+        "/auth/application/{application_uuid}/comments/{comment_uuid}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Inspector takes a pending_verification application for review",
+                "description": "Soft-delete a comment (author or chief). Replies and edit history are kept",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Application"
                 ],
-                "summary": "Take application to verification",
+                "summary": "Delete application comment",
                 "parameters": [
                     {
                         "type": "string",
@@ -771,13 +825,20 @@ const docTemplate = `{
                         "name": "application_uuid",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comment UUID",
+                        "name": "comment_uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.TakeApplicationToVerificationResponse"
+                            "$ref": "#/definitions/entities.DeleteApplicationCommentResponse"
                         }
                     },
                     "400": {
@@ -804,6 +865,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -811,16 +878,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/auth/company/create": {
-            "post": {
+            },
+            "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create new company (user that created company became \"chief\" automatically)",
+                "description": "Edit comment text and mentions (author only). The previous version is kept in the comment history",
                 "consumes": [
                     "application/json"
                 ],
@@ -828,25 +893,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Company"
+                    "Application"
                 ],
-                "summary": "Create company",
+                "summary": "Update application comment",
                 "parameters": [
                     {
-                        "description": "Данные компании",
+                        "type": "string",
+                        "description": "Application UUID",
+                        "name": "application_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comment UUID",
+                        "name": "comment_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Новый текст комментария и упоминания",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/entities.CreateCompanyRequest"
+                            "$ref": "#/definitions/entities.UpdateApplicationCommentRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.CreateCompanyResponse"
+                            "$ref": "#/definitions/entities.UpdateApplicationCommentResponse"
                         }
                     },
                     "400": {
@@ -861,65 +940,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
-                    }
-                }
-            }
-        },
-        "/auth/company/join": {
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Join company by join code",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Employee"
-                ],
-                "summary": "Join company",
-                "parameters": [
-                    {
-                        "description": "Параметры запроса",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entities.JoinCompanyRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/entities.JoinCompanyResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "412": {
+                        "description": "Precondition Failed",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
@@ -933,42 +967,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/company/list": {
+        "/auth/application/{application_uuid}/comments/{comment_uuid}/history": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get all companies list",
+                "description": "Get the current version of a comment and its previous versions, newest first (same access rules as getting the application; history of a deleted comment is available to chief only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Company"
+                    "Application"
                 ],
-                "summary": "Get companies list",
+                "summary": "Get application comment history",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Offset",
-                        "name": "offset",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Application UUID",
+                        "name": "application_uuid",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Count",
-                        "name": "count",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Comment UUID",
+                        "name": "comment_uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.GetCompaniesResponse"
+                            "$ref": "#/definitions/entities.GetApplicationCommentHistoryResponse"
                         }
                     },
                     "400": {
@@ -983,39 +1017,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
-                    }
-                }
-            }
-        },
-        "/auth/company/my": {
-            "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Get list of companies where the current user is an employee",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Company"
-                ],
-                "summary": "Get user companies",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/entities.GetUserCompaniesResponse"
-                        }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
@@ -1029,91 +1038,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/company/{company_uuid}": {
-            "get": {
+        "/auth/application/{application_uuid}/fix-log": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get company info by company uuid",
+                "description": "Add a fix log entry to the application (responsible engineer only)",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Company"
+                    "Application"
                 ],
-                "summary": "Get company info",
+                "summary": "Add application fix log",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Company UUID",
-                        "name": "company_uuid",
+                        "description": "Application UUID",
+                        "name": "application_uuid",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/entities.GetCompanyResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
+                    {
+                        "description": "Текст записи",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
+                            "$ref": "#/definitions/entities.AddApplicationFixLogRequest"
                         }
                     }
-                }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Delete company by company uuid (chief only)",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Company"
-                ],
-                "summary": "Delete company",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Company UUID",
-                        "name": "company_uuid",
-                        "in": "path",
-                        "required": true
-                    }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/entities.DeleteCompanyResponse"
+                            "$ref": "#/definitions/entities.AddApplicationFixLogResponse"
                         }
                     },
                     "400": {
@@ -1149,45 +1114,29 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/company/{company_uuid}/applications/list": {
+        "/auth/application/{application_uuid}/history": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get paginated list of company applications (unemployed employees are not allowed)",
+                "description": "Get paginated list of application state snapshots (participants and chief/analytic only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Application"
                 ],
-                "summary": "Get applications list",
+                "summary": "Get application history",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Company UUID",
-                        "name": "company_uuid",
+                        "description": "Application UUID",
+                        "name": "application_uuid",
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "string",
-                        "description": "Department UUID (chief/analytic only)",
-                        "name": "department_uuid",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Filter by statuses",
-                        "name": "statuses",
-                        "in": "query"
-                    },
                     {
                         "type": "integer",
                         "default": 10,
@@ -1203,15 +1152,9 @@ const docTemplate = `{
                         "in": "query"
                     },
                     {
-                        "type": "boolean",
-                        "description": "Include deleted",
-                        "name": "is_deleted",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Pool view (inspector/manager)",
-                        "name": "from_pool",
+                        "type": "string",
+                        "description": "Cursor from next_cursor of the previous page (instead of offset)",
+                        "name": "cursor",
                         "in": "query"
                     }
                 ],
@@ -1219,7 +1162,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.GetApplicationsResponse"
+                            "$ref": "#/definitions/entities.GetApplicationHistoryResponse"
                         }
                     },
                     "400": {
@@ -1240,6 +1183,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1249,14 +1198,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/company/{company_uuid}/code": {
-            "post": {
+        "/auth/application/{application_uuid}/recall": {
+            "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create code for users to join company (chief only). Min: 60s, Max: 604800s (1 week)",
+                "description": "Return application from engineer back to the pool (responsible manager only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1264,32 +1213,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Company"
+                    "Application"
                 ],
-                "summary": "Create join company code",
+                "summary": "Recall application from engineer",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Company UUID",
-                        "name": "company_uuid",
+                        "description": "Application UUID",
+                        "name": "application_uuid",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Параметры запроса",
+                        "description": "Причина отзыва",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/entities.CreateCompanyJoinCodeRequest"
+                            "$ref": "#/definitions/entities.RecallApplicationRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.CreateCompanyJoinCodeResponse"
+                            "$ref": "#/definitions/entities.RecallApplicationResponse"
                         }
                     },
                     "400": {
@@ -1323,14 +1272,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/auth/application/{application_uuid}/redirect": {
+            "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete company join code (chief only)",
+                "description": "Transfer application to a different department (manager only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1338,24 +1289,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Company"
+                    "Application"
                 ],
-                "summary": "Delete company join code",
+                "summary": "Redirect application to another department",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Company UUID",
-                        "name": "company_uuid",
+                        "description": "Application UUID",
+                        "name": "application_uuid",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Параметры запроса",
+                        "description": "UUID целевого департамента и причина",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/entities.DeleteCompanyJoinCodeRequest"
+                            "$ref": "#/definitions/entities.RedirectApplicationRequest"
                         }
                     }
                 ],
@@ -1363,7 +1314,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.DeleteCompanyJoinCodeResponse"
+                            "$ref": "#/definitions/entities.RedirectApplicationResponse"
                         }
                     },
                     "400": {
@@ -1399,35 +1350,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/company/{company_uuid}/codes": {
-            "get": {
+        "/auth/application/{application_uuid}/release-verification": {
+            "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get all company join codes (chief only)",
+                "description": "Inspector releases a previously taken application back to pending_verification",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Company"
+                    "Application"
                 ],
-                "summary": "Get all company join codes",
+                "summary": "Release application verification",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Company UUID",
-                        "name": "company_uuid",
+                        "description": "Application UUID",
+                        "name": "application_uuid",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Причина снятия",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.ReleaseApplicationVerificationRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.GetCompanyJoinCodesResponse"
+                            "$ref": "#/definitions/entities.ReleaseApplicationVerificationResponse"
                         }
                     },
                     "400": {
@@ -1463,14 +1426,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/company/{company_uuid}/department": {
-            "post": {
+        "/auth/application/{application_uuid}/status": {
+            "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create new department in a company (chief only)",
+                "description": "Update application status. Allowed transitions are defined by company workflow (see /auth/application/{application_uuid}/actions). By default inspector: completed, failed, on_revision. Manager: rejected. Engineer: in_progress, on_hold, pending_verification.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1478,32 +1441,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Department"
+                    "Application"
                 ],
-                "summary": "Create department",
+                "summary": "Update application status",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Company UUID",
-                        "name": "company_uuid",
+                        "description": "Application UUID",
+                        "name": "application_uuid",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Параметры запроса",
+                        "description": "Новый статус",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/entities.CreateDepartmentRequest"
+                            "$ref": "#/definitions/entities.UpdateApplicationStatusRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.CreateDepartmentResponse"
+                            "$ref": "#/definitions/entities.UpdateApplicationStatusResponse"
                         }
                     },
                     "400": {
@@ -1530,6 +1493,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1539,33 +1508,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/company/{company_uuid}/department/{department_uuid}": {
-            "get": {
+        "/auth/application/{application_uuid}/take-verification": {
+            "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get department info by uuid",
+                "description": "Inspector takes a pending_verification application for review",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Department"
+                    "Application"
                 ],
-                "summary": "Get department info",
+                "summary": "Take application to verification",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Company UUID",
-                        "name": "company_uuid",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Department UUID",
-                        "name": "department_uuid",
+                        "description": "Application UUID",
+                        "name": "application_uuid",
                         "in": "path",
                         "required": true
                     }
@@ -1574,7 +1536,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.GetDepartmentResponse"
+                            "$ref": "#/definitions/entities.TakeApplicationToVerificationResponse"
                         }
                     },
                     "400": {
@@ -1608,42 +1570,42 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/auth/company/create": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete department by uuid (chief only)",
+                "description": "Create new company (user that created company became \"chief\" automatically)",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Department"
+                    "Company"
                 ],
-                "summary": "Delete department",
+                "summary": "Create company",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Company UUID",
-                        "name": "company_uuid",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Department UUID",
-                        "name": "department_uuid",
-                        "in": "path",
-                        "required": true
+                        "description": "Данные компании",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.CreateCompanyRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/entities.DeleteDepartmentResponse"
+                            "$ref": "#/definitions/entities.CreateCompanyResponse"
                         }
                     },
                     "400": {
@@ -1658,8 +1620,39 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/invitations/my": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get pending invitations addressed to the current user's email",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employee"
+                ],
+                "summary": "Get my invitations",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.GetUserInvitationsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
@@ -1679,40 +1672,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/company/{company_uuid}/department/{department_uuid}/employee/{employee_uuid}": {
+        "/auth/company/invitations/{invitation_uuid}/accept": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Add an employee to a department",
+                "description": "Accept invitation addressed to the current user's email, the user joins the company with the role and department from the invitation",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Department"
+                    "Employee"
                 ],
-                "summary": "Add employee to department",
+                "summary": "Accept invitation",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Company UUID",
-                        "name": "company_uuid",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Department UUID",
-                        "name": "department_uuid",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Employee UUID",
-                        "name": "employee_uuid",
+                        "description": "Invitation UUID",
+                        "name": "invitation_uuid",
                         "in": "path",
                         "required": true
                     }
@@ -1721,7 +1700,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.AddEmployeeToDepartmentResponse"
+                            "$ref": "#/definitions/entities.AcceptCompanyInvitationResponse"
                         }
                     },
                     "400": {
@@ -1736,14 +1715,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
@@ -1755,40 +1740,28 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/auth/company/invitations/{invitation_uuid}/decline": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Remove an employee from a department",
+                "description": "Decline invitation addressed to the current user's email",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Department"
+                    "Employee"
                 ],
-                "summary": "Remove employee from department",
+                "summary": "Decline invitation",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Company UUID",
-                        "name": "company_uuid",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Department UUID",
-                        "name": "department_uuid",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Employee UUID",
-                        "name": "employee_uuid",
+                        "description": "Invitation UUID",
+                        "name": "invitation_uuid",
                         "in": "path",
                         "required": true
                     }
@@ -1797,7 +1770,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.RemoveEmployeeFromDepartmentResponse"
+                            "$ref": "#/definitions/entities.DeclineCompanyInvitationResponse"
                         }
                     },
                     "400": {
@@ -1812,14 +1785,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "412": {
+                        "description": "Precondition Failed",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
@@ -1833,14 +1806,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/company/{company_uuid}/department/{department_uuid}/title": {
-            "patch": {
+        "/auth/company/join": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update department title (chief only)",
+                "description": "Join company by join code. If the company requires approval, a pending join request is created instead (status \"pending\")",
                 "consumes": [
                     "application/json"
                 ],
@@ -1848,31 +1821,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Department"
+                    "Employee"
                 ],
-                "summary": "Update department title",
+                "summary": "Join company",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Company UUID",
-                        "name": "company_uuid",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Department UUID",
-                        "name": "department_uuid",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "description": "Параметры запроса",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/entities.UpdateDepartmentTitleRequest"
+                            "$ref": "#/definitions/entities.JoinCompanyRequest"
                         }
                     }
                 ],
@@ -1880,7 +1839,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.UpdateDepartmentTitleResponse"
+                            "$ref": "#/definitions/entities.JoinCompanyResponse"
                         }
                     },
                     "400": {
@@ -1907,6 +1866,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1916,29 +1887,22 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/company/{company_uuid}/departments/list": {
+        "/auth/company/list": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get list of all departments in a company",
+                "description": "Get all companies list",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Department"
+                    "Company"
                 ],
-                "summary": "Get company departments list",
+                "summary": "Get companies list",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Company UUID",
-                        "name": "company_uuid",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "integer",
                         "default": 0,
@@ -1952,13 +1916,19 @@ const docTemplate = `{
                         "description": "Count",
                         "name": "count",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from next_cursor of the previous page (instead of offset)",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.GetCompanyDepartmentsResponse"
+                            "$ref": "#/definitions/entities.GetCompaniesResponse"
                         }
                     },
                     "400": {
@@ -1973,14 +1943,39 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
+                    }
+                }
+            }
+        },
+        "/auth/company/my": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get list of companies where the current user is an employee",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Company"
+                ],
+                "summary": "Get user companies",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.GetUserCompaniesResponse"
+                        }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
@@ -1994,21 +1989,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/company/{company_uuid}/employee/{employee_uuid}": {
-            "delete": {
+        "/auth/company/{company_uuid}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Remove company employee by his uuid",
+                "description": "Get company info by company uuid",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Employee"
+                    "Company"
                 ],
-                "summary": "Remove company employee",
+                "summary": "Get company info",
                 "parameters": [
                     {
                         "type": "string",
@@ -2016,20 +2011,13 @@ const docTemplate = `{
                         "name": "company_uuid",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Employee UUID",
-                        "name": "employee_uuid",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.RemoveCompanyEmployeeResponse"
+                            "$ref": "#/definitions/entities.GetCompanyResponse"
                         }
                     },
                     "400": {
@@ -2044,12 +2032,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -2063,23 +2045,21 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/auth/company/{company_uuid}/employee/{employee_uuid}/info": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get employee info by his uuid",
+                "description": "Delete company by company uuid (chief only). Company is hidden immediately and can be restored until purge_after, then its applications are archived, join codes removed and company data deleted in background",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Employee"
+                    "Company"
                 ],
-                "summary": "Get employee info",
+                "summary": "Delete company",
                 "parameters": [
                     {
                         "type": "string",
@@ -2087,20 +2067,13 @@ const docTemplate = `{
                         "name": "company_uuid",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Employee UUID",
-                        "name": "employee_uuid",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.GetCompanyEmployeeResponse"
+                            "$ref": "#/definitions/entities.DeleteCompanyResponse"
                         }
                     },
                     "400": {
@@ -2136,24 +2109,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/company/{company_uuid}/employee/{employee_uuid}/role": {
-            "patch": {
+        "/auth/company/{company_uuid}/analytics/failures": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update employee role (chief only). Available roles: \"unemployed\", \"engineer\", \"manager\", \"analytic\", \"inspector\", \"chief\"",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Completed and failed applications per department and failure rate among closed ones (chief/analytic only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Employee"
+                    "Analytics"
                 ],
-                "summary": "Update employee role",
+                "summary": "Get department failure stats",
                 "parameters": [
                     {
                         "type": "string",
@@ -2164,26 +2134,28 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Employee UUID",
-                        "name": "employee_uuid",
-                        "in": "path",
-                        "required": true
+                        "description": "Department UUID",
+                        "name": "department_uuid",
+                        "in": "query"
                     },
                     {
-                        "description": "Параметры запроса",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entities.UpdateEmployeeRoleRequest"
-                        }
+                        "type": "string",
+                        "description": "Created at or after (RFC3339 or YYYY-MM-DD)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created before (RFC3339 or YYYY-MM-DD)",
+                        "name": "created_to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.UpdateEmployeeRoleResponse"
+                            "$ref": "#/definitions/entities.GetDepartmentFailureStatsResponse"
                         }
                     },
                     "400": {
@@ -2204,12 +2176,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2219,21 +2185,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/company/{company_uuid}/employees/list": {
+        "/auth/company/{company_uuid}/analytics/lead-times": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get company employees filtered by role and/or department",
+                "description": "Mean time to assign, fix and verify applications in seconds, restored from application history (chief/analytic only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Employee"
+                    "Analytics"
                 ],
-                "summary": "Get company employees",
+                "summary": "Get application lead times",
                 "parameters": [
                     {
                         "type": "string",
@@ -2250,22 +2216,14 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Role",
-                        "name": "role",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Offset",
-                        "name": "offset",
+                        "description": "Created at or after (RFC3339 or YYYY-MM-DD)",
+                        "name": "created_from",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Count",
-                        "name": "count",
+                        "type": "string",
+                        "description": "Created before (RFC3339 or YYYY-MM-DD)",
+                        "name": "created_to",
                         "in": "query"
                     }
                 ],
@@ -2273,7 +2231,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.GetCompanyEmployeesResponse"
+                            "$ref": "#/definitions/entities.GetApplicationLeadTimesResponse"
                         }
                     },
                     "400": {
@@ -2294,12 +2252,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2309,21 +2261,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/company/{company_uuid}/employees/summary": {
+        "/auth/company/{company_uuid}/analytics/revisions": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get company employees count by each role",
+                "description": "Revision count and rate per engineer (chief/analytic only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Employee"
+                    "Analytics"
                 ],
-                "summary": "Get company employees summary",
+                "summary": "Get engineer revision stats",
                 "parameters": [
                     {
                         "type": "string",
@@ -2337,13 +2289,25 @@ const docTemplate = `{
                         "description": "Department UUID",
                         "name": "department_uuid",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after (RFC3339 or YYYY-MM-DD)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created before (RFC3339 or YYYY-MM-DD)",
+                        "name": "created_to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.GetCompanyEmployeesSummaryResponse"
+                            "$ref": "#/definitions/entities.GetEngineerRevisionStatsResponse"
                         }
                     },
                     "400": {
@@ -2364,12 +2328,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2379,24 +2337,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/company/{company_uuid}/status": {
-            "patch": {
+        "/auth/company/{company_uuid}/analytics/statuses": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update company status by company uuid (chief only). Available statuses: \"open\", \"close\"",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Count applications by status and department, grouped by creation period (chief/analytic only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Company"
+                    "Analytics"
                 ],
-                "summary": "Update company status",
+                "summary": "Get application status stats",
                 "parameters": [
                     {
                         "type": "string",
@@ -2406,20 +2361,41 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Параметры запроса",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entities.UpdateCompanyStatusRequest"
-                        }
+                        "type": "string",
+                        "description": "Department UUID",
+                        "name": "department_uuid",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after (RFC3339 or YYYY-MM-DD)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created before (RFC3339 or YYYY-MM-DD)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "day",
+                            "week",
+                            "month"
+                        ],
+                        "type": "string",
+                        "default": "day",
+                        "description": "Group period",
+                        "name": "interval",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.UpdateCompanyStatusResponse"
+                            "$ref": "#/definitions/entities.GetApplicationStatusStatsResponse"
                         }
                     },
                     "400": {
@@ -2440,12 +2416,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2455,24 +2425,22 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/company/{company_uuid}/title": {
-            "patch": {
+        "/auth/company/{company_uuid}/applications/export": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update company title by company uuid (chief only)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Stream CSV or XLSX file with company applications, their fix logs and status timeline (only chief/analytic).\nFilters are the same as for the applications list; user UUIDs are replaced with full names.",
                 "produces": [
-                    "application/json"
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
                 ],
                 "tags": [
-                    "Company"
+                    "Application"
                 ],
-                "summary": "Update company title",
+                "summary": "Export applications",
                 "parameters": [
                     {
                         "type": "string",
@@ -2482,196 +2450,153 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Параметры запроса",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entities.UpdateCompanyTitleRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/entities.UpdateCompanyTitleResponse"
-                        }
+                        "enum": [
+                            "csv",
+                            "xlsx"
+                        ],
+                        "type": "string",
+                        "default": "csv",
+                        "description": "File format",
+                        "name": "format",
+                        "in": "query"
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
+                    {
+                        "type": "string",
+                        "description": "Department UUID",
+                        "name": "department_uuid",
+                        "in": "query"
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by statuses",
+                        "name": "statuses",
+                        "in": "query"
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
+                    {
+                        "type": "boolean",
+                        "description": "Include deleted",
+                        "name": "is_deleted",
+                        "in": "query"
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
+                    {
+                        "type": "boolean",
+                        "description": "Only applications with breached SLA",
+                        "name": "is_overdue",
+                        "in": "query"
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
-                    }
-                }
-            }
-        },
-        "/auth/user/2fa": {
-            "patch": {
-                "description": "Enable / disable 2FA",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "User"
-                ],
-                "summary": "UpdateUser2FA",
-                "parameters": [
                     {
-                        "description": "Body",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entities.UpdateUser2FARequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/entities.UpdateUser2FAResponse"
-                        }
+                        "type": "string",
+                        "description": "Full-text search by title, description and fix logs",
+                        "name": "search",
+                        "in": "query"
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
+                    {
+                        "type": "string",
+                        "description": "Created at or after (RFC3339 or YYYY-MM-DD)",
+                        "name": "created_from",
+                        "in": "query"
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
+                    {
+                        "type": "string",
+                        "description": "Created before (RFC3339 or YYYY-MM-DD)",
+                        "name": "created_to",
+                        "in": "query"
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
+                    {
+                        "type": "string",
+                        "description": "Closed at or after (RFC3339 or YYYY-MM-DD)",
+                        "name": "closed_from",
+                        "in": "query"
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
+                    {
+                        "type": "string",
+                        "description": "Closed before (RFC3339 or YYYY-MM-DD)",
+                        "name": "closed_to",
+                        "in": "query"
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
-                    }
-                }
-            }
-        },
-        "/auth/user/account": {
-            "delete": {
-                "security": [
                     {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Delete user",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Auth"
-                ],
-                "summary": "DeleteUser",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/entities.DeleteUserResponse"
-                        }
+                        "type": "string",
+                        "description": "Creator UUID",
+                        "name": "created_by",
+                        "in": "query"
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
+                    {
+                        "type": "string",
+                        "description": "Executor UUID",
+                        "name": "executed_by",
+                        "in": "query"
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
+                    {
+                        "type": "string",
+                        "description": "Manager UUID",
+                        "name": "managed_by",
+                        "in": "query"
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
-                    }
-                }
-            }
-        },
-        "/auth/user/bio": {
-            "patch": {
-                "security": [
                     {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Update user firstName, lastName, patronymic",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "User"
-                ],
-                "summary": "UpdateUserBio",
-                "parameters": [
+                        "type": "integer",
+                        "description": "Min revision count",
+                        "name": "revision_count_min",
+                        "in": "query"
+                    },
                     {
-                        "description": "ФИО пользователя",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entities.UpdateUserBioRequest"
-                        }
+                        "type": "integer",
+                        "description": "Max revision count",
+                        "name": "revision_count_max",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Location UUID (applications in it and in all nested locations)",
+                        "name": "location_uuid",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Defect category UUID",
+                        "name": "category_uuid",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by severities (minor, moderate, major, critical)",
+                        "name": "severities",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by custom field values in key:value format (exact match)",
+                        "name": "custom_fields",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Sort order by creation date",
+                        "name": "sort_order",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.UpdateUserBioResponse"
+                            "type": "file"
                         }
                     },
                     "400": {
@@ -2692,12 +2617,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2707,40 +2626,208 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/user/password": {
-            "patch": {
+        "/auth/company/{company_uuid}/applications/list": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Change user password (requires current password verification)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get paginated list of company applications (unemployed employees are not allowed)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Application"
                 ],
-                "summary": "ChangePassword",
+                "summary": "Get applications list",
                 "parameters": [
                     {
-                        "description": "Новый пароль",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entities.ChangePasswordRequest"
-                        }
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Department UUID (chief/analytic only)",
+                        "name": "department_uuid",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by statuses",
+                        "name": "statuses",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Count",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include deleted",
+                        "name": "is_deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Pool view (inspector/manager)",
+                        "name": "from_pool",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only applications with breached SLA",
+                        "name": "is_overdue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Full-text search by title, description and fix logs",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after (RFC3339 or YYYY-MM-DD)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created before (RFC3339 or YYYY-MM-DD)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Closed at or after (RFC3339 or YYYY-MM-DD)",
+                        "name": "closed_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Closed before (RFC3339 or YYYY-MM-DD)",
+                        "name": "closed_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Creator UUID",
+                        "name": "created_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Executor UUID",
+                        "name": "executed_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Manager UUID",
+                        "name": "managed_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Min revision count",
+                        "name": "revision_count_min",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Max revision count",
+                        "name": "revision_count_max",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Location UUID (applications in it and in all nested locations)",
+                        "name": "location_uuid",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Defect category UUID",
+                        "name": "category_uuid",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by severities (minor, moderate, major, critical)",
+                        "name": "severities",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by custom field values in key:value format (exact match)",
+                        "name": "custom_fields",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "updated_at",
+                            "closed_at",
+                            "due_at",
+                            "priority",
+                            "revision_count",
+                            "relevance"
+                        ],
+                        "type": "string",
+                        "default": "created_at",
+                        "description": "Sort field",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Sort order",
+                        "name": "sort_order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from next_cursor of the previous page (created_at sort only, instead of offset)",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.ChangePasswordResponse"
+                            "$ref": "#/definitions/entities.GetApplicationsResponse"
                         }
                     },
                     "400": {
@@ -2761,18 +2848,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
-                    },
-                    "429": {
-                        "description": "Too Many Requests",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2782,40 +2857,69 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/user/session": {
-            "delete": {
+        "/auth/company/{company_uuid}/applications/stream": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Revoke session by session UUID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Server-Sent Events stream of application changes. Visibility rules and filters are the same as for the applications list.\nEach change is sent as event \"application\" with entities.ApplicationUpdateEvent data; removed=true means the application no longer matches the filter.\nIf the client reads too slowly, the stream ends with event \"error\" and the client should reconnect.",
                 "produces": [
-                    "application/json"
+                    "text/event-stream"
                 ],
                 "tags": [
-                    "Auth"
+                    "Application"
                 ],
-                "summary": "RevokeSession",
+                "summary": "Watch application updates",
                 "parameters": [
                     {
-                        "description": "Refresh token hash to revoke",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entities.RevokeSessionRequest"
-                        }
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Department UUID (chief/analytic only)",
+                        "name": "department_uuid",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filter by statuses",
+                        "name": "statuses",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include deleted",
+                        "name": "is_deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Pool view (inspector/manager)",
+                        "name": "from_pool",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only applications with breached SLA",
+                        "name": "is_overdue",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.RevokeSessionResponse"
+                            "$ref": "#/definitions/entities.ApplicationUpdateEvent"
                         }
                     },
                     "400": {
@@ -2830,8 +2934,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
@@ -2845,26 +2949,79 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/user/sessions": {
+        "/auth/company/{company_uuid}/audit-log": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get all active sessions",
+                "description": "Get log of administrative actions in company (chief only), newest first. Available actions: \"employee_role_changed\", \"employee_removed\", \"department_created\", \"department_deleted\", \"company_title_changed\", \"department_title_changed\", \"join_code_created\"",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Company"
+                ],
+                "summary": "Get company audit log",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "UUID of user who performed the action",
+                        "name": "actor_uuid",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Action",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created from (RFC3339 or YYYY-MM-DD, inclusive)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created to (RFC3339 or YYYY-MM-DD, exclusive)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Count",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from next_cursor of the previous page (instead of offset)",
+                        "name": "cursor",
+                        "in": "query"
+                    }
                 ],
-                "summary": "GetAllActiveSessions",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.GetAllActiveSessionsResponse"
+                            "$ref": "#/definitions/entities.GetCompanyAuditLogResponse"
                         }
                     },
                     "400": {
@@ -2879,37 +3036,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
-                    }
-                }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Revoke all user sessions",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Auth"
-                ],
-                "summary": "RevokeAllSessions",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/entities.RevokeAllSessionsResponse"
+                            "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
@@ -2923,26 +3057,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/user/{user_uuid}/info": {
+        "/auth/company/{company_uuid}/categories": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get colleague's public profile. Only accessible if the requester and target share at least one company.",
+                "description": "Get defect categories of the company (any company employee)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Application"
                 ],
-                "summary": "GetUser",
+                "summary": "Get application categories",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User UUID",
-                        "name": "user_uuid",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
                         "in": "path",
                         "required": true
                     }
@@ -2951,7 +3085,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.GetUserResponse"
+                            "$ref": "#/definitions/entities.GetApplicationCategoriesResponse"
                         }
                     },
                     "400": {
@@ -2972,12 +3106,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/Error.HttpError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2985,35 +3113,47 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/debug/2fa/{session_uuid}/code": {
-            "get": {
-                "description": "Debug endpoint: returns the active 2FA code by session UUID. Available only when APP_ENV=test.",
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create defect category (chief only). New applications of the category are routed to its default department if it is set.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Debug"
+                    "Application"
                 ],
-                "summary": "Get2FACode",
+                "summary": "Create application category",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Session UUID",
-                        "name": "session_uuid",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Category",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.CreateApplicationCategoryRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/entities.CreateApplicationCategoryResponse"
                         }
                     },
                     "400": {
@@ -3022,14 +3162,26 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "501": {
-                        "description": "Not Implemented",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
@@ -3037,21 +3189,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/debug/user/email/{email}/reset-password-token": {
-            "get": {
-                "description": "Debug endpoint: generates and returns a reset password token by email. Available only when APP_ENV=test.",
+        "/auth/company/{company_uuid}/categories/{category_uuid}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete defect category that is not used by any application (chief only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Debug"
+                    "Application"
                 ],
-                "summary": "GetResetPasswordToken",
+                "summary": "Delete application category",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Email",
-                        "name": "email",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category UUID",
+                        "name": "category_uuid",
                         "in": "path",
                         "required": true
                     }
@@ -3060,10 +3224,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/entities.DeleteApplicationCategoryResponse"
                         }
                     },
                     "400": {
@@ -3072,48 +3233,85 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "501": {
-                        "description": "Not Implemented",
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     }
                 }
-            }
-        },
-        "/debug/user/email/{email}/verification-token": {
-            "get": {
-                "description": "Debug endpoint: generates and returns a verification token by email. Available only when APP_ENV=test.",
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Rename defect category or change its default department (chief only). Existing applications are not moved.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Debug"
+                    "Application"
                 ],
-                "summary": "GetVerificationToken",
+                "summary": "Update application category",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Email",
-                        "name": "email",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category UUID",
+                        "name": "category_uuid",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Category",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.UpdateApplicationCategoryRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/entities.UpdateApplicationCategoryResponse"
                         }
                     },
                     "400": {
@@ -3122,14 +3320,32 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "501": {
-                        "description": "Not Implemented",
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
@@ -3137,9 +3353,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/forgot-password": {
+        "/auth/company/{company_uuid}/code": {
             "post": {
-                "description": "Request password recovery — sends a one-time code to the user's email",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create code for users to join company (chief only). Min: 60s, Max: 604800s (1 week). max_uses limits how many users can redeem the code (0 - unlimited, max 1000)",
                 "consumes": [
                     "application/json"
                 ],
@@ -3147,25 +3368,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Company"
                 ],
-                "summary": "ForgotPassword",
+                "summary": "Create join company code",
                 "parameters": [
                     {
-                        "description": "Email пользователя",
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Параметры запроса",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/entities.ForgotPasswordRequest"
+                            "$ref": "#/definitions/entities.CreateCompanyJoinCodeRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/entities.ForgotPasswordResponse"
+                            "$ref": "#/definitions/entities.CreateCompanyJoinCodeResponse"
                         }
                     },
                     "400": {
@@ -3174,6 +3402,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3181,31 +3427,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/health": {
-            "get": {
-                "description": "Get all services health status",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Health"
-                ],
-                "summary": "Health check",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/entities.HealthResponse"
-                        }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
                     }
-                }
-            }
-        },
-        "/login": {
-            "post": {
-                "description": "Авторизация пользователя. Если включена 2FA - возвращает session_uuid и отправляет письмо на почту, данные необходимо передать в Verify2FA; Если 2FA выключена - возвращает user_uuid и пару токенов",
+                ],
+                "description": "Delete company join code (chief only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -3213,17 +3442,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Company"
                 ],
-                "summary": "Login",
+                "summary": "Delete company join code",
                 "parameters": [
                     {
-                        "description": "Данные пользователя",
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Параметры запроса",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/entities.LoginRequest"
+                            "$ref": "#/definitions/entities.DeleteCompanyJoinCodeRequest"
                         }
                     }
                 ],
@@ -3231,7 +3467,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.LoginResponse"
+                            "$ref": "#/definitions/entities.DeleteCompanyJoinCodeResponse"
                         }
                     },
                     "400": {
@@ -3240,14 +3476,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "429": {
-                        "description": "Too Many Requests",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
@@ -3261,32 +3503,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/refresh": {
-            "post": {
-                "description": "Refresh tokens",
+        "/auth/company/{company_uuid}/code/{code}/redemptions": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get who redeemed the join code and when, newest first (chief only). History is kept after the code expires",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Company"
                 ],
-                "summary": "RefreshToken",
+                "summary": "Get join code redemptions",
                 "parameters": [
                     {
-                        "description": "Refresh token",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entities.RefreshTokenRequest"
-                        }
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Join code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.RefreshTokenResponse"
+                            "$ref": "#/definitions/entities.GetJoinCodeRedemptionsResponse"
                         }
                     },
                     "400": {
@@ -3322,33 +3574,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/register": {
-            "post": {
-                "description": "Register new user",
-                "consumes": [
-                    "application/json"
+        "/auth/company/{company_uuid}/codes": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Get all company join codes with usage counters (chief only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Company"
                 ],
-                "summary": "Register",
+                "summary": "Get all company join codes",
                 "parameters": [
                     {
-                        "description": "Данные пользователя",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entities.RegisterRequest"
-                        }
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.GetCompanyJoinCodesResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -3356,8 +3611,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "429": {
-                        "description": "Too Many Requests",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
@@ -3371,35 +3638,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/reset-password": {
-            "post": {
-                "description": "Reset password using a one-time JWT reset token from email",
-                "consumes": [
-                    "application/json"
+        "/auth/company/{company_uuid}/custom-fields": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Get additional application fields defined by the company (any company employee)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Application"
                 ],
-                "summary": "ResetPassword",
+                "summary": "Get company custom fields",
                 "parameters": [
                     {
-                        "description": "JWT токен и новый пароль",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entities.ResetPasswordRequest"
-                        }
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.ResetPasswordResponse"
+                            "$ref": "#/definitions/entities.GetCompanyCustomFieldsResponse"
                         }
                     },
                     "400": {
@@ -3408,8 +3675,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "429": {
-                        "description": "Too Many Requests",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
@@ -3423,9 +3696,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/restore-account": {
-            "post": {
-                "description": "Restore a soft-deleted account within 30 days of deletion",
+        "/auth/company/{company_uuid}/custom-fields/{key}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create or replace additional application field (chief only). Type of an existing field can not be changed.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3433,17 +3711,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Application"
                 ],
-                "summary": "RestoreAccount",
+                "summary": "Update company custom field",
                 "parameters": [
                     {
-                        "description": "Email и пароль",
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Field key (a-z, 0-9, _)",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Field definition",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/entities.RestoreAccountRequest"
+                            "$ref": "#/definitions/entities.UpdateCompanyCustomFieldRequest"
                         }
                     }
                 ],
@@ -3451,7 +3743,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.RestoreAccountResponse"
+                            "$ref": "#/definitions/entities.UpdateCompanyCustomFieldResponse"
                         }
                     },
                     "400": {
@@ -3460,14 +3752,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "429": {
-                        "description": "Too Many Requests",
+                    "412": {
+                        "description": "Precondition Failed",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
@@ -3479,37 +3777,42 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/user/verify": {
-            "post": {
-                "description": "Verify user account with code from email",
-                "consumes": [
-                    "application/json"
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Remove additional application field (chief only). Values already saved in applications are kept.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Application"
                 ],
-                "summary": "VerifyAccount",
+                "summary": "Delete company custom field",
                 "parameters": [
                     {
-                        "description": "Email и код верификации",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entities.VerifyAccountRequest"
-                        }
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Field key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.VerifyAccountResponse"
+                            "$ref": "#/definitions/entities.DeleteCompanyCustomFieldResponse"
                         }
                     },
                     "400": {
@@ -3518,8 +3821,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
@@ -3533,32 +3848,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/verify/resend": {
-            "post": {
-                "description": "Resend verification code to user's email",
+        "/auth/company/{company_uuid}/deletion": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get company deletion progress (deletion initiator or company chief). Initiator can see it after company data is deleted",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Company"
                 ],
-                "summary": "ResendVerificationCode",
+                "summary": "Get company deletion status",
                 "parameters": [
                     {
-                        "description": "Email пользователя",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/entities.ResendVerificationCodeRequest"
-                        }
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.ResendVerificationCodeResponse"
+                            "$ref": "#/definitions/entities.GetCompanyDeletionStatusResponse"
                         }
                     },
                     "400": {
@@ -3567,6 +3885,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3576,32 +3912,118 @@ const docTemplate = `{
                 }
             }
         },
-        "/verify-2fa": {
+        "/auth/company/{company_uuid}/department": {
             "post": {
-                "description": "Verification confirmation with 2FA enabled",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create new department in a company (chief only)",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Department"
                 ],
-                "summary": "Verify2FA",
+                "summary": "Create department",
                 "parameters": [
                     {
-                        "description": "SessionUUID and email code",
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Параметры запроса",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/entities.Verify2FARequest"
+                            "$ref": "#/definitions/entities.CreateDepartmentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entities.CreateDepartmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
                         }
                     }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/department/{department_uuid}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get department info by uuid",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Department"
+                ],
+                "summary": "Get department info",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Department UUID",
+                        "name": "department_uuid",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entities.Verify2FAResponse"
+                            "$ref": "#/definitions/entities.GetDepartmentResponse"
                         }
                     },
                     "400": {
@@ -3610,14 +4032,89 @@ const docTemplate = `{
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
                     },
-                    "429": {
-                        "description": "Too Many Requests",
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete department by uuid (chief only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Department"
+                ],
+                "summary": "Delete department",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Department UUID",
+                        "name": "department_uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.DeleteDepartmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/Error.HttpError"
                         }
@@ -3630,326 +4127,5436 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "Error.HttpError": {
+        },
+        "/auth/company/{company_uuid}/department/{department_uuid}/employee/{employee_uuid}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add an employee to a department",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Department"
+                ],
+                "summary": "Add employee to department",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Department UUID",
+                        "name": "department_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Employee UUID",
+                        "name": "employee_uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.AddEmployeeToDepartmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove an employee from a department. Open applications the employee loses are processed after the change according to open_applications: block rejects the change while they exist, pool returns them to the department pool, reassign passes them to reassign_to",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Department"
+                ],
+                "summary": "Remove employee from department",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Department UUID",
+                        "name": "department_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Employee UUID",
+                        "name": "employee_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "block",
+                            "pool",
+                            "reassign"
+                        ],
+                        "type": "string",
+                        "description": "Open applications policy",
+                        "name": "open_applications",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Colleague UUID for reassign policy",
+                        "name": "reassign_to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.RemoveEmployeeFromDepartmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/department/{department_uuid}/title": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update department title (chief only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Department"
+                ],
+                "summary": "Update department title",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Department UUID",
+                        "name": "department_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Параметры запроса",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.UpdateDepartmentTitleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.UpdateDepartmentTitleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/departments/list": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get list of all departments in a company",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Department"
+                ],
+                "summary": "Get company departments list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Count",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.GetCompanyDepartmentsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/employee/{employee_uuid}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove company employee by his uuid. Open applications the employee loses are processed after the change according to open_applications: block rejects the change while they exist, pool returns them to the department pool, reassign passes them to reassign_to",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employee"
+                ],
+                "summary": "Remove company employee",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Employee UUID",
+                        "name": "employee_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "block",
+                            "pool",
+                            "reassign"
+                        ],
+                        "type": "string",
+                        "description": "Open applications policy",
+                        "name": "open_applications",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Colleague UUID for reassign policy",
+                        "name": "reassign_to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.RemoveCompanyEmployeeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/employee/{employee_uuid}/info": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get employee info by his uuid",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employee"
+                ],
+                "summary": "Get employee info",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Employee UUID",
+                        "name": "employee_uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.GetCompanyEmployeeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/employee/{employee_uuid}/open-applications": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Open applications that will be affected by removing the employee, moving them out of a department or changing their role (chief only).\nUse the same department_uuid / new_role as in the planned change; open_applications policy of that change decides what happens to them.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employee"
+                ],
+                "summary": "Get employee open applications",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Employee UUID",
+                        "name": "employee_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Department the employee is removed from",
+                        "name": "department_uuid",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "New employee role",
+                        "name": "new_role",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.GetEmployeeOpenApplicationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/employee/{employee_uuid}/role": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update employee role (chief only). Available roles: \"unemployed\", \"engineer\", \"manager\", \"analytic\", \"inspector\", \"chief\". Open applications the employee loses are processed after the change according to open_applications: block rejects the change while they exist, pool returns them to the department pool, reassign passes them to reassign_to",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employee"
+                ],
+                "summary": "Update employee role",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Employee UUID",
+                        "name": "employee_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Параметры запроса",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.UpdateEmployeeRoleRequest"
+                        }
+                    },
+                    {
+                        "enum": [
+                            "block",
+                            "pool",
+                            "reassign"
+                        ],
+                        "type": "string",
+                        "description": "Open applications policy",
+                        "name": "open_applications",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Colleague UUID for reassign policy",
+                        "name": "reassign_to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.UpdateEmployeeRoleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/employees/list": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get company employees filtered by role and/or department",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employee"
+                ],
+                "summary": "Get company employees",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Department UUID",
+                        "name": "department_uuid",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Role",
+                        "name": "role",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Count",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from next_cursor of the previous page (instead of offset)",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.GetCompanyEmployeesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/employees/summary": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get company employees count by each role",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employee"
+                ],
+                "summary": "Get company employees summary",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Department UUID",
+                        "name": "department_uuid",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.GetCompanyEmployeesSummaryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/invitations": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get invitations sent by the company, newest first (chief only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Company"
+                ],
+                "summary": "Get company invitations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "accepted",
+                            "declined",
+                            "revoked",
+                            "expired"
+                        ],
+                        "type": "string",
+                        "description": "Invitation status",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.GetCompanyInvitationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Invite a user by email with a preset role and optional department (chief only). The invitation email is sent to the address. TTL min: 60s, max: 604800s (1 week)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Company"
+                ],
+                "summary": "Create company invitation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Параметры запроса",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.CreateCompanyInvitationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entities.CreateCompanyInvitationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/invitations/{invitation_uuid}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Revoke pending invitation (chief only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Company"
+                ],
+                "summary": "Revoke company invitation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Invitation UUID",
+                        "name": "invitation_uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.RevokeCompanyInvitationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/join-requests": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get join requests created by join code in approval mode, newest first (chief only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Company"
+                ],
+                "summary": "Get company join requests",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "accepted",
+                            "declined"
+                        ],
+                        "type": "string",
+                        "description": "Join request status",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.GetCompanyJoinRequestsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/join-requests/{join_request_uuid}/accept": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Accept pending join request, the user joins the company as \"unemployed\" (chief only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Company"
+                ],
+                "summary": "Accept join request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Join request UUID",
+                        "name": "join_request_uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.DecideCompanyJoinRequestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/join-requests/{join_request_uuid}/decline": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Decline pending join request (chief only). The join code use is not returned",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Company"
+                ],
+                "summary": "Decline join request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Join request UUID",
+                        "name": "join_request_uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.DecideCompanyJoinRequestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/join-settings": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Enable or disable chief approval for joining by code (chief only). When enabled, join by code creates a pending join request",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Company"
+                ],
+                "summary": "Update company join settings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Параметры запроса",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.UpdateCompanyJoinSettingsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.UpdateCompanyJoinSettingsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/leave": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Leave company as employee. Open applications of the employee are released. Company owner must transfer ownership first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employee"
+                ],
+                "summary": "Leave company",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.LeaveCompanyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/location": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a site, building, floor or zone of a company (chief only). Building is placed in a site, floor in a building, zone in a floor",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Location"
+                ],
+                "summary": "Create location",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Параметры запроса",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.CreateLocationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entities.CreateLocationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/location/{location_uuid}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get location by uuid together with the chain of enclosing locations",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Location"
+                ],
+                "summary": "Get location info",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Location UUID",
+                        "name": "location_uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.GetLocationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete location without nested locations (chief only). Applications keep the reference to a deleted location",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Location"
+                ],
+                "summary": "Delete location",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Location UUID",
+                        "name": "location_uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.DeleteLocationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update location title and coordinates (chief only). Kind and parent of a location can not be changed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Location"
+                ],
+                "summary": "Update location",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Location UUID",
+                        "name": "location_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Параметры запроса",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.UpdateLocationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.UpdateLocationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/locations/list": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get locations of a company, optionally only nested in a parent location or of one kind",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Location"
+                ],
+                "summary": "Get company locations list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Parent location UUID",
+                        "name": "parent_uuid",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "site",
+                            "building",
+                            "floor",
+                            "zone"
+                        ],
+                        "type": "string",
+                        "description": "Location kind",
+                        "name": "kind",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Count",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.GetCompanyLocationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/ownership/transfer": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get pending company ownership transfer",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Company"
+                ],
+                "summary": "Get company ownership transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.GetCompanyOwnershipTransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Offer company ownership to employee (owner only). Recipient must accept the transfer",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Company"
+                ],
+                "summary": "Transfer company ownership",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Параметры запроса",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.TransferCompanyOwnershipRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entities.TransferCompanyOwnershipResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Cancel pending ownership transfer (owner only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Company"
+                ],
+                "summary": "Cancel company ownership transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.CancelCompanyOwnershipTransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/ownership/transfer/accept": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Accept ownership transfer addressed to the initiator. Initiator becomes company owner with chief role",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Company"
+                ],
+                "summary": "Accept company ownership transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.AcceptCompanyOwnershipTransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/ownership/transfer/decline": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Decline ownership transfer addressed to the initiator",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Company"
+                ],
+                "summary": "Decline company ownership transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.DeclineCompanyOwnershipTransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Cancel company deletion (chief only). Possible until purge_after, after that company data deletion has started",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Company"
+                ],
+                "summary": "Restore deleted company",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.RestoreCompanyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/sla": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get SLA policies of the company by application priority (any company employee). 0 hours means no deadline.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Application"
+                ],
+                "summary": "Get company SLA policies",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.GetCompanySLAPoliciesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/sla/{priority}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create or replace SLA policy for the application priority (chief only). New deadlines apply to open applications too.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Application"
+                ],
+                "summary": "Update company SLA policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Priority (low, normal, high, critical)",
+                        "name": "priority",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Deadlines in hours",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.UpdateCompanySLAPolicyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.UpdateCompanySLAPolicyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove SLA policy for the application priority (chief only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Application"
+                ],
+                "summary": "Delete company SLA policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Priority (low, normal, high, critical)",
+                        "name": "priority",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.DeleteCompanySLAPolicyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/status": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update company status by company uuid (chief only). Available statuses: \"open\", \"close\"",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Company"
+                ],
+                "summary": "Update company status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Параметры запроса",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.UpdateCompanyStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.UpdateCompanyStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/title": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update company title by company uuid (chief only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Company"
+                ],
+                "summary": "Update company title",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Параметры запроса",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.UpdateCompanyTitleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.UpdateCompanyTitleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/company/{company_uuid}/workflow": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get application workflow of the company: statuses, transitions and roles allowed to perform them (any company employee)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Application"
+                ],
+                "summary": "Get company workflow",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.GetCompanyWorkflowResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replace application workflow of the company (chief only). A status can't be removed while company has applications in it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Application"
+                ],
+                "summary": "Update company workflow",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Workflow",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.UpdateCompanyWorkflowRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.UpdateCompanyWorkflowResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Return the company to the default application workflow (chief only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Application"
+                ],
+                "summary": "Reset company workflow",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company UUID",
+                        "name": "company_uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.ResetCompanyWorkflowResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/user/2fa": {
+            "patch": {
+                "description": "Enable / disable 2FA and select the primary method (email / totp). TOTP must be enrolled first via /auth/user/2fa/totp",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "UpdateUser2FA",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.UpdateUser2FARequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.UpdateUser2FAResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/user/2fa/totp": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Start authenticator app enrollment: returns a TOTP secret and otpauth URI for a QR code. The secret takes effect after ConfirmTOTP",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "EnrollTOTP",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.EnrollTOTPResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/user/2fa/totp/confirm": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Confirm authenticator app enrollment with a code from the app. Enables 2FA with TOTP and returns one-time recovery codes (shown only once)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "ConfirmTOTP",
+                "parameters": [
+                    {
+                        "description": "Code from the authenticator app",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.ConfirmTOTPRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.ConfirmTOTPResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/user/account": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "DeleteUser",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.DeleteUserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/user/bio": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update user firstName, lastName, patronymic",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "UpdateUserBio",
+                "parameters": [
+                    {
+                        "description": "ФИО пользователя",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.UpdateUserBioRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.UpdateUserBioResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/user/password": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Change user password (requires current password verification)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "ChangePassword",
+                "parameters": [
+                    {
+                        "description": "Новый пароль",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.ChangePasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.ChangePasswordResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/user/session": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Revoke session by session UUID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "RevokeSession",
+                "parameters": [
+                    {
+                        "description": "Refresh token hash to revoke",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.RevokeSessionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.RevokeSessionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/user/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get all active sessions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "GetAllActiveSessions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.GetAllActiveSessionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Revoke all user sessions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "RevokeAllSessions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.RevokeAllSessionsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/user/{user_uuid}/info": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get colleague's public profile. Only accessible if the requester and target share at least one company.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "GetUser",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User UUID",
+                        "name": "user_uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.GetUserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/debug/2fa/{session_uuid}/code": {
+            "get": {
+                "description": "Debug endpoint: returns the active 2FA code by session UUID. Available only when APP_ENV=test.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Debug"
+                ],
+                "summary": "Get2FACode",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Session UUID",
+                        "name": "session_uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "501": {
+                        "description": "Not Implemented",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/debug/user/email/{email}/reset-password-token": {
+            "get": {
+                "description": "Debug endpoint: generates and returns a reset password token by email. Available only when APP_ENV=test.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Debug"
+                ],
+                "summary": "GetResetPasswordToken",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Email",
+                        "name": "email",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "501": {
+                        "description": "Not Implemented",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/debug/user/email/{email}/verification-token": {
+            "get": {
+                "description": "Debug endpoint: generates and returns a verification token by email. Available only when APP_ENV=test.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Debug"
+                ],
+                "summary": "GetVerificationToken",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Email",
+                        "name": "email",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "501": {
+                        "description": "Not Implemented",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/forgot-password": {
+            "post": {
+                "description": "Request password recovery — sends a one-time code to the user's email",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "ForgotPassword",
+                "parameters": [
+                    {
+                        "description": "Email пользователя",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.ForgotPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.ForgotPasswordResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Get all services health status",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Health check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/login": {
+            "post": {
+                "description": "Авторизация пользователя. Если включена 2FA - возвращает session_uuid и two_factor_method (email - код отправлен на почту, totp - код из приложения-аутентификатора), данные необходимо передать в Verify2FA; Если 2FA выключена - возвращает user_uuid и пару токенов",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Login",
+                "parameters": [
+                    {
+                        "description": "Данные пользователя",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/refresh": {
+            "post": {
+                "description": "Refresh tokens",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "RefreshToken",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.RefreshTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.RefreshTokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/register": {
+            "post": {
+                "description": "Register new user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Register",
+                "parameters": [
+                    {
+                        "description": "Данные пользователя",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.RegisterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/reset-password": {
+            "post": {
+                "description": "Reset password using a one-time JWT reset token from email",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "ResetPassword",
+                "parameters": [
+                    {
+                        "description": "JWT токен и новый пароль",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.ResetPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.ResetPasswordResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/restore-account": {
+            "post": {
+                "description": "Restore a soft-deleted account within 30 days of deletion",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "RestoreAccount",
+                "parameters": [
+                    {
+                        "description": "Email и пароль",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.RestoreAccountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.RestoreAccountResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/verify": {
+            "post": {
+                "description": "Verify user account with code from email",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "VerifyAccount",
+                "parameters": [
+                    {
+                        "description": "Email и код верификации",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.VerifyAccountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.VerifyAccountResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/verify/resend": {
+            "post": {
+                "description": "Resend verification code to user's email",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "ResendVerificationCode",
+                "parameters": [
+                    {
+                        "description": "Email пользователя",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.ResendVerificationCodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.ResendVerificationCodeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/verify-2fa": {
+            "post": {
+                "description": "Verification confirmation with 2FA enabled. method: email / totp / recovery (one-time recovery code), defaults to the method returned by Login",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Verify2FA",
+                "parameters": [
+                    {
+                        "description": "SessionUUID, code and method",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entities.Verify2FARequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Verify2FAResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Error.HttpError"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "Error.HttpError": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.AcceptCompanyInvitationResponse": {
+            "type": "object",
+            "properties": {
+                "company_uuid": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.AcceptCompanyOwnershipTransferResponse": {
+            "type": "object"
+        },
+        "entities.AddApplicationFixLogRequest": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.AddApplicationFixLogResponse": {
+            "type": "object",
+            "properties": {
+                "fix_log_uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.AddEmployeeToDepartmentResponse": {
+            "type": "object"
+        },
+        "entities.ApplicationCategory": {
+            "type": "object",
+            "properties": {
+                "category_uuid": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "default_department_uuid": {
+                    "description": "пусто - заявки остаются в департаменте инспектора",
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.ApplicationListItem": {
+            "type": "object",
+            "properties": {
+                "application_uuid": {
+                    "type": "string"
+                },
+                "category_uuid": {
+                    "type": "string"
+                },
+                "closed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "custom_fields": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "due_at": {
+                    "type": "string"
+                },
+                "is_overdue": {
+                    "type": "boolean"
+                },
+                "location_uuid": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "string"
+                },
+                "revision_count": {
+                    "type": "integer"
+                },
+                "severity": {
+                    "type": "string"
+                },
+                "sla_breach": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.ApplicationResponse": {
+            "type": "object",
+            "properties": {
+                "application_uuid": {
+                    "type": "string"
+                },
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.AttachmentResponse"
+                    }
+                },
+                "category_uuid": {
+                    "type": "string"
+                },
+                "closed_at": {
+                    "type": "string"
+                },
+                "company_uuid": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "custom_fields": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "deleted_by": {
+                    "type": "string"
+                },
+                "department_uuid": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "due_at": {
+                    "type": "string"
+                },
+                "executed_by": {
+                    "type": "string"
+                },
+                "fix_logs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.FixLogResponse"
+                    }
+                },
+                "inspected_by": {
+                    "type": "string"
+                },
+                "is_overdue": {
+                    "type": "boolean"
+                },
+                "location_uuid": {
+                    "type": "string"
+                },
+                "managed_by": {
+                    "type": "string"
+                },
+                "overdue_at": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "string"
+                },
+                "revision_count": {
+                    "type": "integer"
+                },
+                "severity": {
+                    "type": "string"
+                },
+                "sla_breach": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.ApplicationUpdateEvent": {
+            "type": "object",
+            "properties": {
+                "application_uuid": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "department_uuid": {
+                    "type": "string"
+                },
+                "due_at": {
+                    "type": "string"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "is_overdue": {
+                    "type": "boolean"
+                },
+                "priority": {
+                    "type": "string"
+                },
+                "removed": {
+                    "description": "заявка перестала попадать под фильтр",
+                    "type": "boolean"
+                },
+                "sla_breach": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.AssignApplicationRequest": {
+            "type": "object",
+            "properties": {
+                "target_uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.AssignApplicationResponse": {
+            "type": "object"
+        },
+        "entities.AttachmentResponse": {
+            "type": "object",
+            "properties": {
+                "application_uuid": {
+                    "type": "string"
+                },
+                "attachment_uuid": {
+                    "type": "string"
+                },
+                "content_type": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "file_name": {
+                    "type": "string"
+                },
+                "fix_log_uuid": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.AuditEntry": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "actor_uuid": {
+                    "type": "string"
+                },
+                "after_value": {
+                    "type": "string"
+                },
+                "before_value": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "entry_uuid": {
+                    "type": "string"
+                },
+                "operation_id": {
+                    "type": "string"
+                },
+                "target_id": {
+                    "description": "uuid сотрудника, отдела или компании либо код для вступления",
+                    "type": "string"
+                },
+                "target_type": {
+                    "description": "employee | department | company | join_code",
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CancelCompanyOwnershipTransferResponse": {
+            "type": "object"
+        },
+        "entities.ChangePasswordRequest": {
+            "type": "object",
+            "properties": {
+                "old_password": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.ChangePasswordResponse": {
+            "type": "object"
+        },
+        "entities.CommentEditResponse": {
+            "type": "object",
+            "properties": {
+                "edited_at": {
+                    "type": "string"
+                },
+                "edited_by": {
+                    "type": "string"
+                },
+                "mentioned_uuids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CommentResponse": {
+            "type": "object",
+            "properties": {
+                "application_uuid": {
+                    "type": "string"
+                },
+                "comment_uuid": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "deleted_by": {
+                    "type": "string"
+                },
+                "edited_at": {
+                    "type": "string"
+                },
+                "is_deleted": {
+                    "type": "boolean"
+                },
+                "mentioned_uuids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "parent_comment_uuid": {
+                    "type": "string"
+                },
+                "replies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.CommentResponse"
+                    }
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.ConfirmTOTPRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.ConfirmTOTPResponse": {
+            "type": "object",
+            "properties": {
+                "recovery_codes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "entities.CreateApplicationCategoryRequest": {
+            "type": "object",
+            "properties": {
+                "default_department_uuid": {
+                    "description": "необязательно",
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CreateApplicationCategoryResponse": {
+            "type": "object",
+            "properties": {
+                "category_uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CreateApplicationCommentRequest": {
+            "type": "object",
+            "properties": {
+                "mentioned_uuids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "parent_comment_uuid": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CreateApplicationCommentResponse": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "$ref": "#/definitions/entities.CommentResponse"
+                }
+            }
+        },
+        "entities.CreateApplicationRequest": {
+            "type": "object",
+            "properties": {
+                "category_uuid": {
+                    "description": "необязательно, заявка попадает в департамент по умолчанию категории",
+                    "type": "string"
+                },
+                "company_uuid": {
+                    "type": "string"
+                },
+                "custom_fields": {
+                    "description": "значения дополнительных полей компании по key",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "due_at": {
+                    "description": "RFC3339, необязательно",
+                    "type": "string"
+                },
+                "location_uuid": {
+                    "description": "место дефекта (site, building, floor или zone), необязательно",
+                    "type": "string"
+                },
+                "priority": {
+                    "description": "low, normal (по умолчанию), high, critical",
+                    "type": "string"
+                },
+                "severity": {
+                    "description": "minor, moderate, major, critical, необязательно",
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CreateApplicationResponse": {
+            "type": "object",
+            "properties": {
+                "application_uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CreateCompanyInvitationRequest": {
+            "type": "object",
+            "properties": {
+                "department_uuid": {
+                    "description": "необязательно",
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "engineer"
+                },
+                "ttl": {
+                    "description": "время жизни приглашения в секундах",
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.CreateCompanyInvitationResponse": {
+            "type": "object",
+            "properties": {
+                "invitation_uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CreateCompanyJoinCodeRequest": {
+            "type": "object",
+            "properties": {
+                "code_ttl": {
+                    "type": "integer"
+                },
+                "max_uses": {
+                    "description": "0 - без ограничения",
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.CreateCompanyJoinCodeResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CreateCompanyRequest": {
+            "type": "object",
+            "properties": {
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CreateCompanyResponse": {
+            "type": "object",
+            "properties": {
+                "company_uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CreateDepartmentRequest": {
+            "type": "object",
+            "properties": {
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CreateDepartmentResponse": {
+            "type": "object",
+            "properties": {
+                "department_uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CreateLocationRequest": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "type": "string",
+                    "example": "site"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "parent_uuid": {
+                    "description": "building - in site, floor - in building, zone - in floor; empty for site",
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CreateLocationResponse": {
+            "type": "object",
+            "properties": {
+                "location_uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.CustomField": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string"
+                },
+                "options": {
+                    "description": "только для enum",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "required": {
+                    "type": "boolean"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "description": "text, number, enum, date",
+                    "type": "string",
+                    "example": "enum"
+                }
+            }
+        },
+        "entities.DecideCompanyJoinRequestResponse": {
+            "type": "object"
+        },
+        "entities.DeclineCompanyInvitationResponse": {
+            "type": "object"
+        },
+        "entities.DeclineCompanyOwnershipTransferResponse": {
+            "type": "object"
+        },
+        "entities.DeleteApplicationAttachmentResponse": {
+            "type": "object"
+        },
+        "entities.DeleteApplicationCategoryResponse": {
+            "type": "object"
+        },
+        "entities.DeleteApplicationCommentResponse": {
+            "type": "object"
+        },
+        "entities.DeleteApplicationRequest": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.DeleteApplicationResponse": {
+            "type": "object"
+        },
+        "entities.DeleteCompanyCustomFieldResponse": {
+            "type": "object"
+        },
+        "entities.DeleteCompanyJoinCodeRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.DeleteCompanyJoinCodeResponse": {
+            "type": "object"
+        },
+        "entities.DeleteCompanyResponse": {
+            "type": "object"
+        },
+        "entities.DeleteCompanySLAPolicyResponse": {
+            "type": "object"
+        },
+        "entities.DeleteDepartmentResponse": {
+            "type": "object"
+        },
+        "entities.DeleteLocationResponse": {
+            "type": "object"
+        },
+        "entities.DeleteUserResponse": {
+            "type": "object"
+        },
+        "entities.DepartmentFailureStat": {
+            "type": "object",
+            "properties": {
+                "closed": {
+                    "type": "integer"
+                },
+                "completed": {
+                    "type": "integer"
+                },
+                "department_uuid": {
+                    "type": "string"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "failure_rate": {
+                    "type": "number"
+                }
+            }
+        },
+        "entities.DepartmentListItem": {
+            "type": "object",
+            "properties": {
+                "department_uuid": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.EmployeeOpenApplication": {
+            "type": "object",
+            "properties": {
+                "application_uuid": {
+                    "type": "string"
+                },
+                "department_uuid": {
+                    "type": "string"
+                },
+                "relations": {
+                    "description": "manager | executor | inspector",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.EngineerRevisionStat": {
             "type": "object",
             "properties": {
-                "code": {
+                "applications": {
                     "type": "integer"
                 },
-                "message": {
+                "engineer_uuid": {
                     "type": "string"
+                },
+                "revised_applications": {
+                    "type": "integer"
+                },
+                "revision_rate": {
+                    "type": "number"
+                },
+                "revisions": {
+                    "type": "integer"
                 }
             }
         },
-        "entities.AddApplicationFixLogRequest": {
+        "entities.EnrollTOTPResponse": {
             "type": "object",
             "properties": {
-                "message": {
+                "otpauth_uri": {
+                    "type": "string"
+                },
+                "secret": {
                     "type": "string"
                 }
             }
         },
-        "entities.AddApplicationFixLogResponse": {
-            "type": "object"
-        },
-        "entities.AddEmployeeToDepartmentResponse": {
-            "type": "object"
-        },
-        "entities.ApplicationListItem": {
+        "entities.FixLogResponse": {
             "type": "object",
             "properties": {
-                "application_uuid": {
-                    "type": "string"
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.AttachmentResponse"
+                    }
                 },
                 "created_at": {
                     "type": "string"
                 },
-                "status": {
+                "created_by": {
                     "type": "string"
                 },
-                "title": {
+                "text": {
                     "type": "string"
                 },
-                "updated_at": {
+                "uuid": {
                     "type": "string"
                 }
             }
         },
-        "entities.ApplicationResponse": {
+        "entities.ForgotPasswordRequest": {
             "type": "object",
             "properties": {
-                "application_uuid": {
+                "email": {
                     "type": "string"
+                }
+            }
+        },
+        "entities.ForgotPasswordResponse": {
+            "type": "object"
+        },
+        "entities.GetAllActiveSessionsResponse": {
+            "type": "object",
+            "properties": {
+                "tokens": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.TokenInfo"
+                    }
+                }
+            }
+        },
+        "entities.GetApplicationActionsResponse": {
+            "type": "object",
+            "properties": {
+                "actions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.WorkflowTransition"
+                    }
+                }
+            }
+        },
+        "entities.GetApplicationCategoriesResponse": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.ApplicationCategory"
+                    }
+                }
+            }
+        },
+        "entities.GetApplicationCommentHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "$ref": "#/definitions/entities.CommentResponse"
                 },
-                "closed_at": {
-                    "type": "string"
+                "edits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.CommentEditResponse"
+                    }
+                }
+            }
+        },
+        "entities.GetApplicationCommentsResponse": {
+            "type": "object",
+            "properties": {
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.CommentResponse"
+                    }
                 },
-                "company_uuid": {
+                "next_cursor": {
                     "type": "string"
                 },
-                "created_at": {
-                    "type": "string"
+                "total_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.GetApplicationHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.ApplicationResponse"
+                    }
                 },
-                "created_by": {
+                "next_cursor": {
                     "type": "string"
                 },
-                "deleted_at": {
-                    "type": "string"
+                "total_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.GetApplicationLeadTimesResponse": {
+            "type": "object",
+            "properties": {
+                "assign": {
+                    "description": "создание -\u003e первое назначение инженера",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.LeadTime"
+                        }
+                    ]
                 },
-                "deleted_by": {
-                    "type": "string"
+                "fix": {
+                    "description": "первое назначение -\u003e первая отправка на проверку",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.LeadTime"
+                        }
+                    ]
                 },
-                "department_uuid": {
-                    "type": "string"
+                "verify": {
+                    "description": "последняя отправка на проверку -\u003e закрытие",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.LeadTime"
+                        }
+                    ]
+                }
+            }
+        },
+        "entities.GetApplicationResponse": {
+            "type": "object",
+            "properties": {
+                "application": {
+                    "$ref": "#/definitions/entities.ApplicationResponse"
+                }
+            }
+        },
+        "entities.GetApplicationStatusStatsResponse": {
+            "type": "object",
+            "properties": {
+                "stats": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.StatusStat"
+                    }
+                }
+            }
+        },
+        "entities.GetApplicationsResponse": {
+            "type": "object",
+            "properties": {
+                "applications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.ApplicationListItem"
+                    }
                 },
-                "description": {
+                "next_cursor": {
                     "type": "string"
                 },
-                "executed_by": {
+                "total_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.GetCompaniesResponse": {
+            "type": "object",
+            "properties": {
+                "companies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.GetCompanyResponse"
+                    }
+                },
+                "next_cursor": {
                     "type": "string"
                 },
-                "fix_logs": {
+                "total_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.GetCompanyAuditLogResponse": {
+            "type": "object",
+            "properties": {
+                "entries": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/entities.FixLogResponse"
+                        "$ref": "#/definitions/entities.AuditEntry"
                     }
                 },
-                "inspected_by": {
+                "next_cursor": {
                     "type": "string"
                 },
-                "managed_by": {
+                "total_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.GetCompanyCustomFieldsResponse": {
+            "type": "object",
+            "properties": {
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.CustomField"
+                    }
+                }
+            }
+        },
+        "entities.GetCompanyDeletionStatusResponse": {
+            "type": "object",
+            "properties": {
+                "archive_key": {
+                    "description": "ключ архива заявок компании",
                     "type": "string"
                 },
-                "revision_count": {
+                "archived_applications": {
                     "type": "integer"
                 },
-                "status": {
+                "attempts": {
+                    "description": "неудачные попытки текущего шага",
+                    "type": "integer"
+                },
+                "completed_at": {
                     "type": "string"
                 },
-                "title": {
+                "last_error": {
                     "type": "string"
                 },
-                "updated_at": {
+                "purge_after": {
+                    "description": "до этого времени компанию можно восстановить",
                     "type": "string"
                 },
-                "updated_by": {
+                "requested_at": {
                     "type": "string"
                 },
-                "version": {
-                    "type": "integer"
+                "requested_by": {
+                    "type": "string"
+                },
+                "step": {
+                    "description": "soft_deleted | archiving_applications | purging_join_codes | deleting_company | completed",
+                    "type": "string"
                 }
             }
         },
-        "entities.AssignApplicationRequest": {
+        "entities.GetCompanyDepartmentsResponse": {
             "type": "object",
             "properties": {
-                "target_uuid": {
-                    "type": "string"
+                "departments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.DepartmentListItem"
+                    }
                 }
             }
         },
-        "entities.AssignApplicationResponse": {
-            "type": "object"
-        },
-        "entities.ChangePasswordRequest": {
+        "entities.GetCompanyEmployeeResponse": {
             "type": "object",
             "properties": {
-                "old_password": {
+                "department_uuid": {
                     "type": "string"
                 },
-                "password": {
+                "joined_at": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "user_uuid": {
                     "type": "string"
                 }
             }
         },
-        "entities.ChangePasswordResponse": {
-            "type": "object"
-        },
-        "entities.CreateApplicationRequest": {
+        "entities.GetCompanyEmployeesResponse": {
             "type": "object",
             "properties": {
-                "company_uuid": {
+                "employees": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.GetCompanyEmployeeResponse"
+                    }
+                },
+                "next_cursor": {
                     "type": "string"
                 },
-                "description": {
-                    "type": "string"
+                "total_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.GetCompanyEmployeesSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "analytics_count": {
+                    "type": "integer"
+                },
+                "chief_count": {
+                    "type": "integer"
+                },
+                "engineer_count": {
+                    "type": "integer"
+                },
+                "inspector_count": {
+                    "type": "integer"
                 },
-                "title": {
-                    "type": "string"
+                "manager_count": {
+                    "type": "integer"
+                },
+                "unemployed_count": {
+                    "type": "integer"
                 }
             }
         },
-        "entities.CreateApplicationResponse": {
+        "entities.GetCompanyInvitationsResponse": {
             "type": "object",
             "properties": {
-                "application_uuid": {
-                    "type": "string"
+                "invitations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.InvitationItem"
+                    }
                 }
             }
         },
-        "entities.CreateCompanyJoinCodeRequest": {
+        "entities.GetCompanyJoinCodesResponse": {
             "type": "object",
             "properties": {
-                "code_ttl": {
-                    "type": "integer"
+                "codes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "join_codes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.JoinCodeItem"
+                    }
                 }
             }
         },
-        "entities.CreateCompanyJoinCodeResponse": {
+        "entities.GetCompanyJoinRequestsResponse": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "string"
+                "join_requests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.JoinRequestItem"
+                    }
                 }
             }
         },
-        "entities.CreateCompanyRequest": {
+        "entities.GetCompanyLocationsResponse": {
             "type": "object",
             "properties": {
-                "title": {
-                    "type": "string"
+                "locations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.LocationItem"
+                    }
                 }
             }
         },
-        "entities.CreateCompanyResponse": {
+        "entities.GetCompanyOwnershipTransferResponse": {
             "type": "object",
             "properties": {
                 "company_uuid": {
                     "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "from_uuid": {
+                    "description": "текущий владелец",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "to_uuid": {
+                    "description": "будущий владелец",
+                    "type": "string"
+                },
+                "transfer_uuid": {
+                    "type": "string"
                 }
             }
         },
-        "entities.CreateDepartmentRequest": {
+        "entities.GetCompanyResponse": {
             "type": "object",
             "properties": {
+                "company_uuid": {
+                    "type": "string"
+                },
+                "join_approval_required": {
+                    "description": "только в информации о компании, в списках не заполняется",
+                    "type": "boolean"
+                },
+                "owner_uuid": {
+                    "description": "только в информации о компании, в списках не заполняется",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
                 "title": {
                     "type": "string"
                 }
             }
         },
-        "entities.CreateDepartmentResponse": {
+        "entities.GetCompanySLAPoliciesResponse": {
             "type": "object",
             "properties": {
-                "department_uuid": {
-                    "type": "string"
+                "policies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.SLAPolicy"
+                    }
                 }
             }
         },
-        "entities.DeleteApplicationRequest": {
+        "entities.GetCompanyWorkflowResponse": {
             "type": "object",
             "properties": {
-                "message": {
+                "is_default": {
+                    "type": "boolean"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
                     "type": "string"
+                },
+                "workflow": {
+                    "$ref": "#/definitions/entities.Workflow"
                 }
             }
         },
-        "entities.DeleteApplicationResponse": {
-            "type": "object"
-        },
-        "entities.DeleteCompanyJoinCodeRequest": {
+        "entities.GetDepartmentFailureStatsResponse": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "string"
+                "stats": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.DepartmentFailureStat"
+                    }
                 }
             }
         },
-        "entities.DeleteCompanyJoinCodeResponse": {
-            "type": "object"
-        },
-        "entities.DeleteCompanyResponse": {
-            "type": "object"
-        },
-        "entities.DeleteDepartmentResponse": {
-            "type": "object"
-        },
-        "entities.DeleteUserResponse": {
-            "type": "object"
-        },
-        "entities.DepartmentListItem": {
+        "entities.GetDepartmentResponse": {
             "type": "object",
             "properties": {
-                "department_uuid": {
+                "company_uuid": {
                     "type": "string"
                 },
-                "title": {
-                    "type": "string"
-                }
-            }
-        },
-        "entities.FixLogResponse": {
-            "type": "object",
-            "properties": {
                 "created_at": {
                     "type": "string"
                 },
                 "created_by": {
                     "type": "string"
                 },
-                "text": {
+                "department_uuid": {
                     "type": "string"
                 },
-                "uuid": {
-                    "type": "string"
-                }
-            }
-        },
-        "entities.ForgotPasswordRequest": {
-            "type": "object",
-            "properties": {
-                "email": {
+                "title": {
                     "type": "string"
                 }
             }
         },
-        "entities.ForgotPasswordResponse": {
-            "type": "object"
-        },
-        "entities.GetAllActiveSessionsResponse": {
+        "entities.GetEmployeeOpenApplicationsResponse": {
             "type": "object",
             "properties": {
-                "tokens": {
+                "applications": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/entities.TokenInfo"
+                        "$ref": "#/definitions/entities.EmployeeOpenApplication"
                     }
                 }
             }
         },
-        "entities.GetApplicationHistoryResponse": {
+        "entities.GetEngineerRevisionStatsResponse": {
             "type": "object",
             "properties": {
-                "history": {
+                "stats": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/entities.ApplicationResponse"
+                        "$ref": "#/definitions/entities.EngineerRevisionStat"
                     }
                 }
             }
         },
-        "entities.GetApplicationResponse": {
+        "entities.GetJoinCodeRedemptionsResponse": {
             "type": "object",
             "properties": {
-                "application": {
-                    "$ref": "#/definitions/entities.ApplicationResponse"
+                "redemptions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.JoinCodeRedemptionItem"
+                    }
                 }
             }
         },
-        "entities.GetApplicationsResponse": {
+        "entities.GetLocationResponse": {
             "type": "object",
             "properties": {
-                "applications": {
+                "company_uuid": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "building"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "location_uuid": {
+                    "type": "string"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "parent_uuid": {
+                    "type": "string"
+                },
+                "path": {
+                    "description": "from site down to the location itself",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/entities.ApplicationListItem"
+                        "$ref": "#/definitions/entities.LocationItem"
                     }
+                },
+                "title": {
+                    "type": "string"
                 }
             }
         },
-        "entities.GetCompaniesResponse": {
+        "entities.GetUserCompaniesResponse": {
             "type": "object",
             "properties": {
                 "companies": {
@@ -3960,27 +9567,36 @@ const docTemplate = `{
                 }
             }
         },
-        "entities.GetCompanyDepartmentsResponse": {
+        "entities.GetUserInvitationsResponse": {
             "type": "object",
             "properties": {
-                "departments": {
+                "invitations": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/entities.DepartmentListItem"
+                        "$ref": "#/definitions/entities.InvitationItem"
                     }
                 }
             }
         },
-        "entities.GetCompanyEmployeeResponse": {
+        "entities.GetUserResponse": {
             "type": "object",
             "properties": {
-                "department_uuid": {
+                "created_at": {
                     "type": "string"
                 },
-                "joined_at": {
+                "deleted_at": {
                     "type": "string"
                 },
-                "role": {
+                "description": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "patronymic": {
                     "type": "string"
                 },
                 "user_uuid": {
@@ -3988,154 +9604,186 @@ const docTemplate = `{
                 }
             }
         },
-        "entities.GetCompanyEmployeesResponse": {
+        "entities.HealthResponse": {
             "type": "object",
             "properties": {
-                "employees": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/entities.GetCompanyEmployeeResponse"
-                    }
+                "application_service": {
+                    "$ref": "#/definitions/entities.ServiceHealth"
+                },
+                "auth_service": {
+                    "$ref": "#/definitions/entities.ServiceHealth"
+                },
+                "company_service": {
+                    "$ref": "#/definitions/entities.ServiceHealth"
+                },
+                "gateway": {
+                    "type": "string"
                 }
             }
         },
-        "entities.GetCompanyEmployeesSummaryResponse": {
+        "entities.InvitationItem": {
             "type": "object",
             "properties": {
-                "analytics_count": {
-                    "type": "integer"
+                "accepted_by": {
+                    "type": "string"
                 },
-                "chief_count": {
-                    "type": "integer"
+                "company_title": {
+                    "type": "string"
                 },
-                "engineer_count": {
-                    "type": "integer"
+                "company_uuid": {
+                    "type": "string"
                 },
-                "inspector_count": {
-                    "type": "integer"
+                "created_at": {
+                    "type": "string"
                 },
-                "manager_count": {
-                    "type": "integer"
+                "created_by": {
+                    "type": "string"
                 },
-                "unemployed_count": {
-                    "type": "integer"
+                "decided_at": {
+                    "type": "string"
+                },
+                "department_uuid": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "invitation_uuid": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "pending | accepted | declined | revoked | expired",
+                    "type": "string",
+                    "example": "pending"
                 }
             }
         },
-        "entities.GetCompanyJoinCodesResponse": {
+        "entities.JoinCodeItem": {
             "type": "object",
             "properties": {
-                "codes": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "code": {
+                    "type": "string"
+                },
+                "max_uses": {
+                    "description": "0 - без ограничения",
+                    "type": "integer"
+                },
+                "uses": {
+                    "type": "integer"
                 }
             }
         },
-        "entities.GetCompanyResponse": {
+        "entities.JoinCodeRedemptionItem": {
             "type": "object",
             "properties": {
-                "company_uuid": {
+                "join_request_uuid": {
+                    "description": "пусто - пользователь добавлен сразу, без заявки",
                     "type": "string"
                 },
-                "status": {
+                "redeemed_at": {
                     "type": "string"
                 },
-                "title": {
+                "user_uuid": {
                     "type": "string"
                 }
             }
         },
-        "entities.GetDepartmentResponse": {
+        "entities.JoinCompanyRequest": {
             "type": "object",
             "properties": {
-                "company_uuid": {
+                "code": {
                     "type": "string"
-                },
-                "created_at": {
+                }
+            }
+        },
+        "entities.JoinCompanyResponse": {
+            "type": "object",
+            "properties": {
+                "company_uuid": {
                     "type": "string"
                 },
-                "created_by": {
+                "join_request_uuid": {
+                    "description": "только для pending",
                     "type": "string"
                 },
-                "department_uuid": {
+                "role": {
+                    "description": "пусто, пока заявка не одобрена",
                     "type": "string"
                 },
-                "title": {
-                    "type": "string"
-                }
-            }
-        },
-        "entities.GetUserCompaniesResponse": {
-            "type": "object",
-            "properties": {
-                "companies": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/entities.GetCompanyResponse"
-                    }
+                "status": {
+                    "description": "joined | pending",
+                    "type": "string",
+                    "example": "joined"
                 }
             }
         },
-        "entities.GetUserResponse": {
+        "entities.JoinRequestItem": {
             "type": "object",
             "properties": {
                 "created_at": {
                     "type": "string"
                 },
-                "deleted_at": {
+                "decided_at": {
                     "type": "string"
                 },
-                "description": {
+                "decided_by": {
                     "type": "string"
                 },
-                "first_name": {
+                "join_code": {
                     "type": "string"
                 },
-                "last_name": {
+                "join_request_uuid": {
                     "type": "string"
                 },
-                "patronymic": {
-                    "type": "string"
+                "status": {
+                    "type": "string",
+                    "example": "pending"
                 },
                 "user_uuid": {
                     "type": "string"
                 }
             }
         },
-        "entities.HealthResponse": {
+        "entities.LeadTime": {
             "type": "object",
             "properties": {
-                "application_service": {
-                    "$ref": "#/definitions/entities.ServiceHealth"
-                },
-                "auth_service": {
-                    "$ref": "#/definitions/entities.ServiceHealth"
-                },
-                "company_service": {
-                    "$ref": "#/definitions/entities.ServiceHealth"
+                "mean_seconds": {
+                    "type": "number"
                 },
-                "gateway": {
-                    "type": "string"
+                "samples": {
+                    "type": "integer"
                 }
             }
         },
-        "entities.JoinCompanyRequest": {
-            "type": "object",
-            "properties": {
-                "code": {
-                    "type": "string"
-                }
-            }
+        "entities.LeaveCompanyResponse": {
+            "type": "object"
         },
-        "entities.JoinCompanyResponse": {
+        "entities.LocationItem": {
             "type": "object",
             "properties": {
-                "company_uuid": {
+                "kind": {
+                    "type": "string",
+                    "example": "building"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "location_uuid": {
                     "type": "string"
                 },
-                "role": {
+                "longitude": {
+                    "type": "number"
+                },
+                "parent_uuid": {
+                    "type": "string"
+                },
+                "title": {
                     "type": "string"
                 }
             }
@@ -4163,6 +9811,10 @@ const docTemplate = `{
                 "session_uuid": {
                     "type": "string"
                 },
+                "two_factor_method": {
+                    "description": "email / totp, если включена 2FA",
+                    "type": "string"
+                },
                 "user_uuid": {
                     "type": "string"
                 }
@@ -4260,6 +9912,9 @@ const docTemplate = `{
         "entities.ResendVerificationCodeResponse": {
             "type": "object"
         },
+        "entities.ResetCompanyWorkflowResponse": {
+            "type": "object"
+        },
         "entities.ResetPasswordRequest": {
             "type": "object",
             "properties": {
@@ -4288,9 +9943,15 @@ const docTemplate = `{
         "entities.RestoreAccountResponse": {
             "type": "object"
         },
+        "entities.RestoreCompanyResponse": {
+            "type": "object"
+        },
         "entities.RevokeAllSessionsResponse": {
             "type": "object"
         },
+        "entities.RevokeCompanyInvitationResponse": {
+            "type": "object"
+        },
         "entities.RevokeSessionRequest": {
             "type": "object",
             "properties": {
@@ -4302,6 +9963,33 @@ const docTemplate = `{
         "entities.RevokeSessionResponse": {
             "type": "object"
         },
+        "entities.SLAPolicy": {
+            "type": "object",
+            "properties": {
+                "assign_within_hours": {
+                    "description": "0 - без ограничения",
+                    "type": "integer"
+                },
+                "priority": {
+                    "type": "string"
+                },
+                "verification_within_hours": {
+                    "description": "0 - без ограничения",
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.Send2FAEmailCodeRequest": {
+            "type": "object",
+            "properties": {
+                "session_uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.Send2FAEmailCodeResponse": {
+            "type": "object"
+        },
         "entities.ServiceHealth": {
             "type": "object",
             "properties": {
@@ -4322,6 +10010,23 @@ const docTemplate = `{
                 }
             }
         },
+        "entities.StatusStat": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "department_uuid": {
+                    "type": "string"
+                },
+                "period": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
         "entities.TakeApplicationToVerificationResponse": {
             "type": "object"
         },
@@ -4375,9 +10080,65 @@ const docTemplate = `{
                 }
             }
         },
+        "entities.TransferCompanyOwnershipRequest": {
+            "type": "object",
+            "properties": {
+                "target_uuid": {
+                    "description": "будущий владелец, должен быть сотрудником компании",
+                    "type": "string"
+                }
+            }
+        },
+        "entities.TransferCompanyOwnershipResponse": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string"
+                },
+                "transfer_uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.UpdateApplicationCategoryRequest": {
+            "type": "object",
+            "properties": {
+                "default_department_uuid": {
+                    "description": "пусто - снять департамент по умолчанию",
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.UpdateApplicationCategoryResponse": {
+            "type": "object"
+        },
+        "entities.UpdateApplicationCommentRequest": {
+            "type": "object",
+            "properties": {
+                "mentioned_uuids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.UpdateApplicationCommentResponse": {
+            "type": "object"
+        },
         "entities.UpdateApplicationStatusRequest": {
             "type": "object",
             "properties": {
+                "message": {
+                    "description": "обязательно, если переход workflow требует сообщения",
+                    "type": "string"
+                },
                 "status": {
                     "type": "string"
                 }
@@ -4386,6 +10147,56 @@ const docTemplate = `{
         "entities.UpdateApplicationStatusResponse": {
             "type": "object"
         },
+        "entities.UpdateCompanyCustomFieldRequest": {
+            "type": "object",
+            "properties": {
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "required": {
+                    "type": "boolean"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "enum"
+                }
+            }
+        },
+        "entities.UpdateCompanyCustomFieldResponse": {
+            "type": "object"
+        },
+        "entities.UpdateCompanyJoinSettingsRequest": {
+            "type": "object",
+            "properties": {
+                "join_approval_required": {
+                    "description": "вступление по коду только после одобрения chief",
+                    "type": "boolean"
+                }
+            }
+        },
+        "entities.UpdateCompanyJoinSettingsResponse": {
+            "type": "object"
+        },
+        "entities.UpdateCompanySLAPolicyRequest": {
+            "type": "object",
+            "properties": {
+                "assign_within_hours": {
+                    "type": "integer"
+                },
+                "verification_within_hours": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.UpdateCompanySLAPolicyResponse": {
+            "type": "object"
+        },
         "entities.UpdateCompanyStatusRequest": {
             "type": "object",
             "properties": {
@@ -4408,6 +10219,17 @@ const docTemplate = `{
         "entities.UpdateCompanyTitleResponse": {
             "type": "object"
         },
+        "entities.UpdateCompanyWorkflowRequest": {
+            "type": "object",
+            "properties": {
+                "workflow": {
+                    "$ref": "#/definitions/entities.Workflow"
+                }
+            }
+        },
+        "entities.UpdateCompanyWorkflowResponse": {
+            "type": "object"
+        },
         "entities.UpdateDepartmentTitleRequest": {
             "type": "object",
             "properties": {
@@ -4430,11 +10252,33 @@ const docTemplate = `{
         "entities.UpdateEmployeeRoleResponse": {
             "type": "object"
         },
+        "entities.UpdateLocationRequest": {
+            "type": "object",
+            "properties": {
+                "latitude": {
+                    "description": "coordinates are replaced as a whole, omitted ones are cleared",
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "entities.UpdateLocationResponse": {
+            "type": "object"
+        },
         "entities.UpdateUser2FARequest": {
             "type": "object",
             "properties": {
                 "enable_2fa": {
                     "type": "boolean"
+                },
+                "method": {
+                    "description": "email / totp, пустое значение - оставить текущий способ",
+                    "type": "string"
                 }
             }
         },
@@ -4461,12 +10305,24 @@ const docTemplate = `{
         "entities.UpdateUserBioResponse": {
             "type": "object"
         },
+        "entities.UploadApplicationAttachmentResponse": {
+            "type": "object",
+            "properties": {
+                "attachment": {
+                    "$ref": "#/definitions/entities.AttachmentResponse"
+                }
+            }
+        },
         "entities.Verify2FARequest": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "string"
                 },
+                "method": {
+                    "description": "email / totp / recovery, по умолчанию - способ из ответа Login",
+                    "type": "string"
+                },
                 "session_uuid": {
                     "type": "string"
                 }
@@ -4496,6 +10352,55 @@ const docTemplate = `{
         },
         "entities.VerifyAccountResponse": {
             "type": "object"
+        },
+        "entities.Workflow": {
+            "type": "object",
+            "properties": {
+                "states": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "transitions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.WorkflowTransition"
+                    }
+                }
+            }
+        },
+        "entities.WorkflowTransition": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "from": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "guard": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "require_message": {
+                    "type": "boolean"
+                },
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -1,16 +1,20 @@
 package main
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/redis/go-redis/extra/redisotel/v9"
 	"github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog/log"
 	_ "github.com/unwelcome/FrameWorkTask1/backend/gateway/api/docs"
 	"github.com/unwelcome/FrameWorkTask1/backend/gateway/internal/app"
 	"github.com/unwelcome/FrameWorkTask1/backend/gateway/internal/config"
 	"github.com/unwelcome/FrameWorkTask1/backend/gateway/internal/routes"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/lifecycle"
 	"github.com/unwelcome/FrameWorkTask1/backend/shared/logger"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/tracing"
 )
 
 // @title     Framework task 2 API
@@ -28,26 +32,57 @@ func main() {
 	loggerConf, httpLogger := logger.Setup(cfg.Log.Path, cfg.Log.ConsoleOut)
 	log.Logger = *loggerConf
 
+	// Остановка по SIGINT / SIGTERM: HTTP сервер, затем фоновые задачи, затем соединения (см. lifecycle)
+	lc := lifecycle.New(cfg.ShutdownTimeout)
+
+	// Трассировка: спаны экспортируются в OTLP коллектор, если задан OTEL_EXPORTER_OTLP_ENDPOINT
+	shutdownTracing, err := tracing.Setup(context.Background(), "gateway", cfg.Tracing)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to setup tracing")
+	}
+	lc.OnClose("tracing", shutdownTracing)
+
 	// Подключение cache
 	redisClient := redis.NewClient(cfg.Redis.Options())
+	if err = redisotel.InstrumentTracing(redisClient); err != nil {
+		log.Fatal().Err(err).Msg("failed to instrument redis tracing")
+	}
+	lc.OnClose("redis", lifecycle.Closer(redisClient.Close))
+	lc.ReadinessCheck("redis", func(ctx context.Context) error { return redisClient.Ping(ctx).Err() })
 
 	// Инициализация fiber сервера
 	server := fiber.New(fiber.Config{
 		EnableTrustedProxyCheck: true,
 		TrustedProxies:          cfg.TrustedProxies,
 		ProxyHeader:             fiber.HeaderXForwardedFor,
+		// Тело больше общего лимита (fiber.DefaultBodyLimit) не отклоняется сервером, а отдаётся потоком:
+		// лимит каждой ручки проверяет BodyLimit middleware, у загрузки вложений он больше общего.
+		// Предразбор multipart отключён - иначе форма читалась бы на диск до проверки лимита
+		StreamRequestBody:            true,
+		DisablePreParseMultipartForm: true,
 	})
 
 	// Инициализация всех зависимостей
 	application := app.InitApp(cfg, *httpLogger, redisClient)
+	lc.OnClose("grpc connections", lifecycle.Closer(application.Close))
 	routes.SetupRoutes(server, application)
 
+	// Фоновое обновление ключей подписи; gateway не готов, пока ключи не загружены
+	lc.Go("jwks", application.JWKS.Run)
+	lc.ReadinessCheck("jwks", application.JWKS.Ready)
+
 	// Регистрируем метрики fiber prometheus на том же сервере
 	application.FiberPrometheus.RegisterAt(server, "/metrics")
 
-	// Запуск fiber сервера
+	// /livez и /readyz (и /metrics процесса) на отдельном порту
+	lc.StartProbeServer(cfg.MetricsPort)
+
+	// Запуск fiber сервера; при остановке Shutdown дожидается начатых запросов
+	lc.Serve("http", func() error { return server.Listen(fmt.Sprintf(":%d", cfg.Port)) }, server.ShutdownWithContext)
+	lc.Ready()
+
 	log.Info().Msgf("server listening on http://localhost:%d/api/", cfg.Port)
-	if err := server.Listen(fmt.Sprintf(":%d", cfg.Port)); err != nil {
-		log.Fatal().Err(err).Msg("failed to start http server")
+	if err := lc.Wait(); err != nil {
+		log.Fatal().Err(err).Msg("gateway stopped with error")
 	}
 }
@@ -1,8 +1,12 @@
 package app
 
 import (
+	"context"
+	"errors"
+
 	fiberprometheus "github.com/ansrivas/fiberprometheus/v2"
 	"github.com/gofiber/fiber/v2"
+	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
@@ -12,10 +16,14 @@ import (
 	"github.com/unwelcome/FrameWorkTask1/backend/gateway/internal/config"
 	"github.com/unwelcome/FrameWorkTask1/backend/gateway/internal/handlers"
 	"github.com/unwelcome/FrameWorkTask1/backend/gateway/internal/middlewares"
+	"github.com/unwelcome/FrameWorkTask1/backend/gateway/pkg/jwks"
 	"github.com/unwelcome/FrameWorkTask1/backend/gateway/pkg/session"
 	"github.com/unwelcome/FrameWorkTask1/backend/gateway/pkg/utils"
+	sharedConfig "github.com/unwelcome/FrameWorkTask1/backend/shared/config"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/mtls"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/tracing"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 const (
@@ -31,12 +39,17 @@ type App struct {
 	ApplicationServiceClient application_proto.ApplicationServiceClient
 
 	FiberPrometheus *fiberprometheus.FiberPrometheus
+	JWKS            *jwks.Cache
 
 	PrometheusMiddleware  fiber.Handler
 	OperationIDMiddleware fiber.Handler
+	TracingMiddleware     fiber.Handler
 	LoggerMiddleware      fiber.Handler
 	AuthMiddleware        fiber.Handler
 
+	BodyLimit           fiber.Handler
+	AttachmentBodyLimit fiber.Handler
+
 	PasswordRateLimiter fiber.Handler
 	CodeRateLimiter     fiber.Handler
 	UserRateLimiter     fiber.Handler
@@ -45,21 +58,31 @@ type App struct {
 	AuthHandler        handlers.AuthHandler
 	CompanyHandler     handlers.CompanyHandler
 	ApplicationHandler handlers.ApplicationHandler
+
+	conns []*grpc.ClientConn
 }
 
 func InitApp(cfg *config.Config, httpLogger zerolog.Logger, redisClient *redis.Client) *App {
-	// Загружаем публичный ключ из PEM-файла
-	publicKey, err := utils.LoadPublicKey(cfg.JWT.PublicKeyPath)
-	if err != nil {
-		log.Fatal().Err(err).Str("path", cfg.JWT.PublicKeyPath).Msg("failed to load JWT public key")
-	}
-
 	application := &App{AppEnv: cfg.AppEnv}
 
-	// Подключение к сервисам
-	application.AuthServiceClient = auth_proto.NewAuthServiceClient(dial(cfg.Auth.Addr()))
-	application.CompanyServiceClient = company_proto.NewCompanyServiceClient(dial(cfg.Company.Addr()))
-	application.ApplicationServiceClient = application_proto.NewApplicationServiceClient(dial(cfg.App.Addr()))
+	// Подключение к сервисам по mTLS: сервисы принимают идентичность пользователя только от gateway
+	authConn := dial(cfg.Auth, cfg.TLS)
+	companyConn := dial(cfg.Company, cfg.TLS)
+	// Вложения заявок передаются одним сообщением - поднимаем лимиты gRPC
+	applicationConn := dial(cfg.App, cfg.TLS,
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(validate.AttachmentMaxSize+1<<20),
+			grpc.MaxCallSendMsgSize(validate.AttachmentMaxSize+1<<20),
+		),
+	)
+	application.conns = []*grpc.ClientConn{authConn, companyConn, applicationConn}
+
+	application.AuthServiceClient = auth_proto.NewAuthServiceClient(authConn)
+	application.CompanyServiceClient = company_proto.NewCompanyServiceClient(companyConn)
+	application.ApplicationServiceClient = application_proto.NewApplicationServiceClient(applicationConn)
+
+	// Ключи подписи токенов загружаются из JWKS auth сервиса и обновляются в фоне (JWKS.Run запускается в main)
+	application.JWKS = jwks.New(fetchJWKS(application.AuthServiceClient), cfg.JWT.JWKSRefreshInterval, cfg.JWT.RetiredKeyGrace)
 
 	// Инициализация prometheus middleware
 	fp := fiberprometheus.New("gateway")
@@ -68,8 +91,13 @@ func InitApp(cfg *config.Config, httpLogger zerolog.Logger, redisClient *redis.C
 
 	// Инициализация остальных middleware
 	application.OperationIDMiddleware = middlewares.NewOperationIDMiddleware(OperationIDKey)
+	application.TracingMiddleware = middlewares.NewTracingMiddleware(OperationIDKey)
 	application.LoggerMiddleware = middlewares.NewRequestLoggerMiddleware(OperationIDKey, UserUUIDKey, httpLogger)
-	application.AuthMiddleware = middlewares.NewAuthMiddleware(publicKey, UserUUIDKey)
+	application.AuthMiddleware = middlewares.NewAuthMiddleware(application.JWKS, redisClient, cfg.JWT.RevocationPrefix, UserUUIDKey, cfg.JWT.RevocationFailOpen)
+
+	// Лимиты тела запроса: общий и для загрузки вложений (multipart с вложением заявки + служебные поля формы)
+	application.BodyLimit = middlewares.NewBodyLimitMiddleware(fiber.DefaultBodyLimit)
+	application.AttachmentBodyLimit = middlewares.NewBodyLimitMiddleware(validate.AttachmentMaxSize + 1<<20)
 
 	// Инициализация rate limiter-ов
 	ipKey := func(c *fiber.Ctx) string { return c.IP() }
@@ -96,17 +124,61 @@ func InitApp(cfg *config.Config, httpLogger zerolog.Logger, redisClient *redis.C
 
 	// Инициализация handler-ов
 	application.HealthHandler = handlers.NewHealthHandler(application.AuthServiceClient, application.CompanyServiceClient, application.ApplicationServiceClient, OperationIDKey)
-	application.AuthHandler = handlers.NewAuthHandler(application.AuthServiceClient, application.CompanyServiceClient, OperationIDKey, UserUUIDKey, sessionProvider)
-	application.CompanyHandler = handlers.NewCompanyHandler(application.CompanyServiceClient, OperationIDKey, UserUUIDKey)
-	application.ApplicationHandler = handlers.NewApplicationHandler(application.ApplicationServiceClient, OperationIDKey, UserUUIDKey)
+	application.AuthHandler = handlers.NewAuthHandler(application.AuthServiceClient, application.CompanyServiceClient, OperationIDKey, UserUUIDKey, sessionProvider, application.JWKS)
+	application.CompanyHandler = handlers.NewCompanyHandler(application.CompanyServiceClient, application.AuthServiceClient, OperationIDKey, UserUUIDKey)
+	application.ApplicationHandler = handlers.NewApplicationHandler(application.ApplicationServiceClient, application.AuthServiceClient, OperationIDKey, UserUUIDKey)
 
 	return application
 }
 
-func dial(addr string) *grpc.ClientConn {
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// Close Закрывает соединения с gRPC сервисами. Вызывается после остановки HTTP сервера.
+func (a *App) Close() error {
+	var errs []error
+	for _, conn := range a.conns {
+		if err := conn.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// fetchJWKS Загрузка JWKS из auth сервиса по gRPC
+func fetchJWKS(client auth_proto.AuthServiceClient) jwks.FetchFunc {
+	return func(ctx context.Context) (jwks.Set, error) {
+		res, err := client.GetJWKS(ctx, &empty.Empty{})
+		if err != nil {
+			return jwks.Set{}, err
+		}
+		set := jwks.Set{Keys: make([]jwks.Key, 0, len(res.GetKeys()))}
+		for _, key := range res.GetKeys() {
+			set.Keys = append(set.Keys, jwks.Key{
+				Kid:       key.GetKid(),
+				Kty:       key.GetKty(),
+				Crv:       key.GetCrv(),
+				X:         key.GetX(),
+				Y:         key.GetY(),
+				Alg:       key.GetAlg(),
+				Use:       key.GetUse(),
+				Status:    key.GetStatus(),
+				RetiredAt: key.GetRetiredAt(),
+			})
+		}
+		return set, nil
+	}
+}
+
+func dial(service config.ServiceAddress, tlsCfg sharedConfig.TLSConfig, opts ...grpc.DialOption) *grpc.ClientConn {
+	creds, err := mtls.ClientCredentials(tlsCfg, service.Host)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to load tls credentials")
+	}
+
+	conn, err := grpc.NewClient(service.Addr(), append([]grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		tracing.DialOption(),
+	}, opts...)...)
 	if err != nil {
-		log.Fatal().Err(err).Str("addr", addr).Msg("failed to connect to service")
+		log.Fatal().Err(err).Str("addr", service.Addr()).Msg("failed to connect to service")
 	}
 	return conn
 }
@@ -2,23 +2,27 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	sharedConfig "github.com/unwelcome/FrameWorkTask1/backend/shared/config"
 )
 
 type Config struct {
-	Port           int
-	MetricsPort    int
-	AppEnv         string
-	Log            LogConfig
-	JWT            JWTConfig
-	GeoIP          GeoIPConfig
-	Redis          sharedConfig.RedisConfig
-	RateLimit      RateLimitConfig
-	TrustedProxies []string
-	Auth           ServiceAddress
-	Company        ServiceAddress
-	App            ServiceAddress
+	Port            int
+	MetricsPort     int
+	ShutdownTimeout time.Duration // время на graceful shutdown, должно быть меньше stop_grace_period в docker-compose
+	AppEnv          string
+	Log             LogConfig
+	JWT             JWTConfig
+	GeoIP           GeoIPConfig
+	Redis           sharedConfig.RedisConfig
+	TLS             sharedConfig.TLSConfig
+	Tracing         sharedConfig.TracingConfig
+	RateLimit       RateLimitConfig
+	TrustedProxies  []string
+	Auth            ServiceAddress
+	Company         ServiceAddress
+	App             ServiceAddress
 }
 
 type RateLimitConfig struct {
@@ -48,8 +52,16 @@ type LogConfig struct {
 	ConsoleOut bool
 }
 
+// JWTConfig Проверка access токенов.
+// Ключи подписи загружаются из JWKS auth сервиса и обновляются каждые JWKSRefreshInterval;
+// выведенный ключ принимается ещё RetiredKeyGrace после времени вывода, опубликованного auth сервисом в JWKS.
 type JWTConfig struct {
-	PublicKeyPath string
+	JWKSRefreshInterval time.Duration
+	RetiredKeyGrace     time.Duration
+	// RevocationPrefix — Redis префикс auth сервиса, под которым он пишет ключи отзыва токенов
+	RevocationPrefix string
+	// RevocationFailOpen — пропускать запросы, если отзыв токена не удалось проверить в Redis (по умолчанию - 503)
+	RevocationFailOpen bool
 }
 
 type ServiceAddress struct {
@@ -63,21 +75,27 @@ func (s ServiceAddress) Addr() string {
 
 func NewConfig() *Config {
 	return &Config{
-		Port:        sharedConfig.MustParseInt("GATEWAY_PORT"),
-		MetricsPort: sharedConfig.ParseIntOrDefault("METRICS_PORT", 2112),
-		AppEnv:      sharedConfig.GetEnvOrDefault("APP_ENV", "production"),
+		Port:            sharedConfig.MustParseInt("GATEWAY_PORT"),
+		MetricsPort:     sharedConfig.ParseIntOrDefault("METRICS_PORT", 2112),
+		ShutdownTimeout: sharedConfig.ParseDurationOrDefault("SHUTDOWN_TIMEOUT", 25*time.Second),
+		AppEnv:          sharedConfig.GetEnvOrDefault("APP_ENV", "production"),
 		Log: LogConfig{
 			Path:       sharedConfig.MustGetEnv("LOG_PATH"),
 			ConsoleOut: sharedConfig.MustParseBool("LOG_CONSOLE_OUT"),
 		},
 		JWT: JWTConfig{
-			PublicKeyPath: sharedConfig.MustGetEnv("JWT_PUBLIC_KEY_PATH"),
+			JWKSRefreshInterval: sharedConfig.ParseDurationOrDefault("JWKS_REFRESH_INTERVAL", 5*time.Minute),
+			RetiredKeyGrace:     sharedConfig.ParseDurationOrDefault("JWT_RETIRED_KEY_GRACE", time.Hour),
+			RevocationPrefix:    sharedConfig.GetEnvOrDefault("AUTH_REDIS_PREFIX", "auth_service"),
+			RevocationFailOpen:  sharedConfig.ParseBoolOrDefault("JWT_REVOCATION_FAIL_OPEN", false),
 		},
 		GeoIP: GeoIPConfig{
 			CityDBPath: sharedConfig.GetEnvOrDefault("GEOIP_CITY_DB_PATH", ""),
 			ASNDBPath:  sharedConfig.GetEnvOrDefault("GEOIP_ASN_DB_PATH", ""),
 		},
-		Redis: sharedConfig.NewRedisConfig(),
+		Redis:   sharedConfig.NewRedisConfig(),
+		TLS:     sharedConfig.NewTLSConfig(),
+		Tracing: sharedConfig.NewTracingConfig(),
 		RateLimit: RateLimitConfig{
 			Password: RateLimitTierConfig{
 				Capacity:     sharedConfig.ParseIntOrDefault("RATE_LIMIT_PASSWORD_CAPACITY", 50),
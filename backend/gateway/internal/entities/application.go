@@ -2,6 +2,7 @@ package entities
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
@@ -10,48 +11,108 @@ import (
 // ─── Shared response types ────────────────────────────────────────────────────
 
 type FixLogResponse struct {
-	UUID      string `json:"uuid"`
-	Text      string `json:"text"`
-	CreatedAt string `json:"created_at"`
-	CreatedBy string `json:"created_by"`
+	UUID        string                `json:"uuid"`
+	Text        string                `json:"text"`
+	CreatedAt   string                `json:"created_at"`
+	CreatedBy   string                `json:"created_by"`
+	Attachments []*AttachmentResponse `json:"attachments,omitempty"`
+}
+
+type AttachmentResponse struct {
+	AttachmentUUID  string `json:"attachment_uuid"`
+	ApplicationUUID string `json:"application_uuid"`
+	FixLogUUID      string `json:"fix_log_uuid,omitempty"`
+	FileName        string `json:"file_name"`
+	ContentType     string `json:"content_type"`
+	Size            int64  `json:"size"`
+	CreatedAt       string `json:"created_at"`
+	CreatedBy       string `json:"created_by"`
+}
+
+type CommentResponse struct {
+	CommentUUID       string             `json:"comment_uuid"`
+	ApplicationUUID   string             `json:"application_uuid"`
+	ParentCommentUUID string             `json:"parent_comment_uuid,omitempty"`
+	Text              string             `json:"text"`
+	MentionedUUIDs    []string           `json:"mentioned_uuids,omitempty"`
+	CreatedAt         string             `json:"created_at"`
+	CreatedBy         string             `json:"created_by"`
+	EditedAt          string             `json:"edited_at,omitempty"`
+	IsDeleted         bool               `json:"is_deleted"`
+	DeletedAt         string             `json:"deleted_at,omitempty"`
+	DeletedBy         string             `json:"deleted_by,omitempty"`
+	Replies           []*CommentResponse `json:"replies,omitempty"`
+}
+
+type CommentEditResponse struct {
+	Text           string   `json:"text"`
+	MentionedUUIDs []string `json:"mentioned_uuids,omitempty"`
+	EditedAt       string   `json:"edited_at"`
+	EditedBy       string   `json:"edited_by"`
 }
 
 type ApplicationResponse struct {
+	ApplicationUUID string                `json:"application_uuid"`
+	CompanyUUID     string                `json:"company_uuid"`
+	DepartmentUUID  string                `json:"department_uuid"`
+	LocationUUID    string                `json:"location_uuid"`
+	CategoryUUID    string                `json:"category_uuid"`
+	Severity        string                `json:"severity"`
+	CustomFields    map[string]string     `json:"custom_fields"`
+	Version         int64                 `json:"version"`
+	Title           string                `json:"title"`
+	Description     string                `json:"description"`
+	Status          string                `json:"status"`
+	Priority        string                `json:"priority"`
+	DueAt           string                `json:"due_at"`
+	IsOverdue       bool                  `json:"is_overdue"`
+	SLABreach       string                `json:"sla_breach"`
+	OverdueAt       string                `json:"overdue_at"`
+	RevisionCount   int64                 `json:"revision_count"`
+	CreatedAt       string                `json:"created_at"`
+	CreatedBy       string                `json:"created_by"`
+	UpdatedAt       string                `json:"updated_at"`
+	UpdatedBy       string                `json:"updated_by"`
+	ManagedBy       string                `json:"managed_by"`
+	ExecutedBy      string                `json:"executed_by"`
+	InspectedBy     string                `json:"inspected_by"`
+	ClosedAt        string                `json:"closed_at"`
+	DeletedAt       string                `json:"deleted_at"`
+	DeletedBy       string                `json:"deleted_by"`
+	FixLogs         []*FixLogResponse     `json:"fix_logs,omitempty"`
+	Attachments     []*AttachmentResponse `json:"attachments,omitempty"`
+}
+
+type ApplicationListItem struct {
 	ApplicationUUID string            `json:"application_uuid"`
-	CompanyUUID     string            `json:"company_uuid"`
-	DepartmentUUID  string            `json:"department_uuid"`
-	Version         int64             `json:"version"`
 	Title           string            `json:"title"`
-	Description     string            `json:"description"`
 	Status          string            `json:"status"`
+	Priority        string            `json:"priority"`
+	DueAt           string            `json:"due_at"`
+	IsOverdue       bool              `json:"is_overdue"`
+	SLABreach       string            `json:"sla_breach"`
 	RevisionCount   int64             `json:"revision_count"`
 	CreatedAt       string            `json:"created_at"`
-	CreatedBy       string            `json:"created_by"`
 	UpdatedAt       string            `json:"updated_at"`
-	UpdatedBy       string            `json:"updated_by"`
-	ManagedBy       string            `json:"managed_by"`
-	ExecutedBy      string            `json:"executed_by"`
-	InspectedBy     string            `json:"inspected_by"`
 	ClosedAt        string            `json:"closed_at"`
-	DeletedAt       string            `json:"deleted_at"`
-	DeletedBy       string            `json:"deleted_by"`
-	FixLogs         []*FixLogResponse `json:"fix_logs,omitempty"`
-}
-
-type ApplicationListItem struct {
-	ApplicationUUID string `json:"application_uuid"`
-	Title           string `json:"title"`
-	Status          string `json:"status"`
-	CreatedAt       string `json:"created_at"`
-	UpdatedAt       string `json:"updated_at"`
+	LocationUUID    string            `json:"location_uuid"`
+	CategoryUUID    string            `json:"category_uuid"`
+	Severity        string            `json:"severity"`
+	CustomFields    map[string]string `json:"custom_fields"`
 }
 
 // ─── CreateApplication ────────────────────────────────────────────────────────
 
 type CreateApplicationRequest struct {
-	CompanyUUID string `json:"company_uuid"`
-	Title       string `json:"title"`
-	Description string `json:"description"`
+	CompanyUUID  string            `json:"company_uuid"`
+	Title        string            `json:"title"`
+	Description  string            `json:"description"`
+	Priority     string            `json:"priority"`      // low, normal (по умолчанию), high, critical
+	DueAt        string            `json:"due_at"`        // RFC3339, необязательно
+	LocationUUID string            `json:"location_uuid"` // место дефекта (site, building, floor или zone), необязательно
+	CategoryUUID string            `json:"category_uuid"` // необязательно, заявка попадает в департамент по умолчанию категории
+	Severity     string            `json:"severity"`      // minor, moderate, major, critical, необязательно
+	CustomFields map[string]string `json:"custom_fields"` // значения дополнительных полей компании по key
 }
 type CreateApplicationResponse struct {
 	ApplicationUUID string `json:"application_uuid"`
@@ -70,6 +131,32 @@ func (e *CreateApplicationRequest) Validate() error {
 	if err := validate.ApplicationDescription(e.Description); err != nil {
 		return err
 	}
+	e.Priority = strings.TrimSpace(e.Priority)
+	if err := validate.ApplicationPriority(e.Priority); err != nil && e.Priority != "" {
+		return err
+	}
+	e.DueAt = strings.TrimSpace(e.DueAt)
+	if err := validate.ApplicationDueAt(e.DueAt); err != nil && e.DueAt != "" {
+		return err
+	}
+	e.LocationUUID = strings.TrimSpace(e.LocationUUID)
+	if err := validate.UUID(e.LocationUUID); err != nil && e.LocationUUID != "" {
+		return err
+	}
+	e.CategoryUUID = strings.TrimSpace(e.CategoryUUID)
+	if err := validate.UUID(e.CategoryUUID); err != nil && e.CategoryUUID != "" {
+		return err
+	}
+	e.Severity = strings.TrimSpace(e.Severity)
+	if err := validate.ApplicationSeverity(e.Severity); err != nil && e.Severity != "" {
+		return err
+	}
+	// Значения проверяет application сервис по определениям полей компании
+	for key := range e.CustomFields {
+		if err := validate.CustomFieldKey(key); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -93,19 +180,50 @@ func (e *GetApplicationRequest) Validate() error {
 // ─── GetApplications ──────────────────────────────────────────────────────────
 
 type GetApplicationsRequest struct {
-	CompanyUUID    string   `json:"-"`
-	DepartmentUUID string   `query:"department_uuid"`
-	Statuses       []string `query:"statuses"`
-	Count          int64    `query:"count"`
-	Offset         int64    `query:"offset"`
-	IsDeleted      bool     `query:"is_deleted"`
-	FromPool       bool     `query:"from_pool"`
+	CompanyUUID      string   `json:"-"`
+	DepartmentUUID   string   `query:"department_uuid"`
+	Statuses         []string `query:"statuses"`
+	Count            int64    `query:"count"`
+	Offset           int64    `query:"offset"`
+	IsDeleted        bool     `query:"is_deleted"`
+	FromPool         bool     `query:"from_pool"`
+	IsOverdue        bool     `query:"is_overdue"`
+	Search           string   `query:"search"`
+	CreatedFrom      string   `query:"created_from"`
+	CreatedTo        string   `query:"created_to"`
+	ClosedFrom       string   `query:"closed_from"`
+	ClosedTo         string   `query:"closed_to"`
+	CreatedBy        string   `query:"created_by"`
+	ExecutedBy       string   `query:"executed_by"`
+	ManagedBy        string   `query:"managed_by"`
+	RevisionCountMin *int64   `query:"revision_count_min"`
+	RevisionCountMax *int64   `query:"revision_count_max"`
+	LocationUUID     string   `query:"location_uuid"`
+	CategoryUUID     string   `query:"category_uuid"`
+	Severities       []string `query:"severities"`
+	CustomFields     []string `query:"custom_fields"` // key:value, точное совпадение
+	SortBy           string   `query:"sort_by"`
+	SortOrder        string   `query:"sort_order"`
+	Cursor           string   `query:"cursor"`
 }
 type GetApplicationsResponse struct {
 	Applications []*ApplicationListItem `json:"applications"`
+	NextCursor   string                 `json:"next_cursor"`
+	TotalCount   int64                  `json:"total_count"`
 }
 
 func (e *GetApplicationsRequest) Validate() error {
+	if err := validate.Number(int(e.Count), validate.IntPtr(1), validate.IntPtr(100), "count"); err != nil {
+		return err
+	}
+	if err := validate.Number(int(e.Offset), validate.IntPtr(0), nil, "offset"); err != nil {
+		return err
+	}
+	return e.validateFilters()
+}
+
+// validateFilters Проверка фильтров и сортировки списка заявок (общая для списка и выгрузки)
+func (e *GetApplicationsRequest) validateFilters() error {
 	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
 	if err := validate.UUID(e.CompanyUUID); err != nil {
 		return err
@@ -114,10 +232,137 @@ func (e *GetApplicationsRequest) Validate() error {
 	if err := validate.UUID(e.DepartmentUUID); err != nil && e.DepartmentUUID != "" {
 		return err
 	}
-	if err := validate.Number(int(e.Count), validate.IntPtr(1), validate.IntPtr(100), "count"); err != nil {
+	e.Search = strings.TrimSpace(e.Search)
+	if err := validate.ApplicationSearch(e.Search); err != nil {
 		return err
 	}
-	if err := validate.Number(int(e.Offset), validate.IntPtr(0), nil, "offset"); err != nil {
+	dates := []struct {
+		value string
+		title string
+	}{
+		{e.CreatedFrom, "created_from"},
+		{e.CreatedTo, "created_to"},
+		{e.ClosedFrom, "closed_from"},
+		{e.ClosedTo, "closed_to"},
+	}
+	for _, d := range dates {
+		if err := validate.DateFilter(d.value, d.title); err != nil && d.value != "" {
+			return err
+		}
+	}
+	for _, uuid := range []string{e.CreatedBy, e.ExecutedBy, e.ManagedBy, e.LocationUUID, e.CategoryUUID} {
+		if err := validate.UUID(uuid); err != nil && uuid != "" {
+			return err
+		}
+	}
+	for _, severity := range e.Severities {
+		if err := validate.ApplicationSeverity(severity); err != nil {
+			return err
+		}
+	}
+	if _, err := e.CustomFieldValues(); err != nil {
+		return err
+	}
+	if e.RevisionCountMin != nil {
+		if err := validate.Number(int(*e.RevisionCountMin), validate.IntPtr(0), nil, "revision_count_min"); err != nil {
+			return err
+		}
+	}
+	if e.RevisionCountMax != nil {
+		if err := validate.Number(int(*e.RevisionCountMax), validate.IntPtr(0), nil, "revision_count_max"); err != nil {
+			return err
+		}
+	}
+	if err := validate.ApplicationSortBy(e.SortBy); err != nil && e.SortBy != "" {
+		return err
+	}
+	if err := validate.SortOrder(e.SortOrder); err != nil && e.SortOrder != "" {
+		return err
+	}
+	return nil
+}
+
+// CustomFieldValues Разбор фильтров custom_fields вида key:value в значения по ключу
+func (e *GetApplicationsRequest) CustomFieldValues() (map[string]string, error) {
+	values := make(map[string]string, len(e.CustomFields))
+	for _, item := range e.CustomFields {
+		key, value, found := strings.Cut(item, ":")
+		if !found {
+			return nil, fmt.Errorf("custom field filter must be in key:value format")
+		}
+		if err := validate.CustomFieldKey(key); err != nil {
+			return nil, err
+		}
+		if _, duplicate := values[key]; duplicate {
+			return nil, fmt.Errorf("duplicate custom field filter %q", key)
+		}
+		values[key] = value
+	}
+	return values, nil
+}
+
+// ─── ExportApplications ───────────────────────────────────────────────────────
+
+// ExportFormats Поддерживаемые форматы выгрузки заявок
+var ExportFormats = []string{"csv", "xlsx"}
+
+// ExportApplicationsRequest Фильтры списка заявок и формат файла; count, offset и cursor не используются
+type ExportApplicationsRequest struct {
+	GetApplicationsRequest
+	Format string `query:"format"`
+}
+
+func (e *ExportApplicationsRequest) Validate() error {
+	if err := e.validateFilters(); err != nil {
+		return err
+	}
+	if e.SortBy != "" && e.SortBy != "created_at" {
+		return fmt.Errorf("export is available only for created_at sort")
+	}
+	if e.Format == "" {
+		e.Format = "csv"
+	}
+	if !slices.Contains(ExportFormats, e.Format) {
+		return fmt.Errorf("format must be one of %v", ExportFormats)
+	}
+	return nil
+}
+
+// ─── WatchApplications ────────────────────────────────────────────────────────
+
+type WatchApplicationsRequest struct {
+	CompanyUUID    string   `json:"-"`
+	DepartmentUUID string   `query:"department_uuid"`
+	Statuses       []string `query:"statuses"`
+	IsDeleted      bool     `query:"is_deleted"`
+	FromPool       bool     `query:"from_pool"`
+	IsOverdue      bool     `query:"is_overdue"`
+}
+
+// ApplicationUpdateEvent Данные SSE события "application"
+type ApplicationUpdateEvent struct {
+	EventType       string `json:"event_type"`
+	ApplicationUUID string `json:"application_uuid"`
+	DepartmentUUID  string `json:"department_uuid"`
+	Version         int64  `json:"version"`
+	Title           string `json:"title"`
+	Status          string `json:"status"`
+	Priority        string `json:"priority"`
+	DueAt           string `json:"due_at"`
+	IsOverdue       bool   `json:"is_overdue"`
+	SLABreach       string `json:"sla_breach"`
+	CreatedAt       string `json:"created_at"`
+	UpdatedAt       string `json:"updated_at"`
+	Removed         bool   `json:"removed"` // заявка перестала попадать под фильтр
+}
+
+func (e *WatchApplicationsRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	e.DepartmentUUID = strings.TrimSpace(e.DepartmentUUID)
+	if err := validate.UUID(e.DepartmentUUID); err != nil && e.DepartmentUUID != "" {
 		return err
 	}
 	return nil
@@ -128,6 +373,7 @@ func (e *GetApplicationsRequest) Validate() error {
 type UpdateApplicationStatusRequest struct {
 	ApplicationUUID string `json:"-"`
 	Status          string `json:"status"`
+	Message         string `json:"message,omitempty"` // обязательно, если переход workflow требует сообщения
 }
 type UpdateApplicationStatusResponse struct{}
 
@@ -140,6 +386,7 @@ func (e *UpdateApplicationStatusRequest) Validate() error {
 	if e.Status == "" {
 		return fmt.Errorf("status missed")
 	}
+	e.Message = strings.TrimSpace(e.Message)
 	return nil
 }
 
@@ -249,7 +496,9 @@ type AddApplicationFixLogRequest struct {
 	ApplicationUUID string `json:"-"`
 	Message         string `json:"message"`
 }
-type AddApplicationFixLogResponse struct{}
+type AddApplicationFixLogResponse struct {
+	FixLogUUID string `json:"fix_log_uuid"`
+}
 
 func (e *AddApplicationFixLogRequest) Validate() error {
 	e.ApplicationUUID = strings.TrimSpace(e.ApplicationUUID)
@@ -289,9 +538,12 @@ type GetApplicationHistoryRequest struct {
 	ApplicationUUID string `json:"-"`
 	Count           int64  `query:"count"`
 	Offset          int64  `query:"offset"`
+	Cursor          string `query:"cursor"`
 }
 type GetApplicationHistoryResponse struct {
-	History []*ApplicationResponse `json:"history"`
+	History    []*ApplicationResponse `json:"history"`
+	NextCursor string                 `json:"next_cursor"`
+	TotalCount int64                  `json:"total_count"`
 }
 
 func (e *GetApplicationHistoryRequest) Validate() error {
@@ -307,3 +559,673 @@ func (e *GetApplicationHistoryRequest) Validate() error {
 	}
 	return nil
 }
+
+// ─── UploadApplicationAttachment ─────────────────────────────────────────────
+
+type UploadApplicationAttachmentRequest struct {
+	ApplicationUUID string `json:"-"`
+	FixLogUUID      string `json:"-"`
+	FileName        string `json:"-"`
+	ContentType     string `json:"-"`
+	Size            int64  `json:"-"`
+}
+
+type UploadApplicationAttachmentResponse struct {
+	Attachment *AttachmentResponse `json:"attachment"`
+}
+
+func (e *UploadApplicationAttachmentRequest) Validate() error {
+	e.ApplicationUUID = strings.TrimSpace(e.ApplicationUUID)
+	if err := validate.UUID(e.ApplicationUUID); err != nil {
+		return err
+	}
+	e.FixLogUUID = strings.TrimSpace(e.FixLogUUID)
+	if err := validate.UUID(e.FixLogUUID); err != nil && e.FixLogUUID != "" {
+		return err
+	}
+	if err := validate.AttachmentFileName(e.FileName); err != nil {
+		return err
+	}
+	if err := validate.AttachmentContentType(e.ContentType); err != nil {
+		return err
+	}
+	if err := validate.AttachmentSize(e.Size); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── GetApplicationAttachment ────────────────────────────────────────────────
+
+type GetApplicationAttachmentRequest struct {
+	ApplicationUUID string `json:"-"`
+	AttachmentUUID  string `json:"-"`
+}
+
+func (e *GetApplicationAttachmentRequest) Validate() error {
+	e.ApplicationUUID = strings.TrimSpace(e.ApplicationUUID)
+	if err := validate.UUID(e.ApplicationUUID); err != nil {
+		return err
+	}
+	e.AttachmentUUID = strings.TrimSpace(e.AttachmentUUID)
+	if err := validate.UUID(e.AttachmentUUID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── DeleteApplicationAttachment ─────────────────────────────────────────────
+
+type DeleteApplicationAttachmentRequest struct {
+	ApplicationUUID string `json:"-"`
+	AttachmentUUID  string `json:"-"`
+}
+
+type DeleteApplicationAttachmentResponse struct{}
+
+func (e *DeleteApplicationAttachmentRequest) Validate() error {
+	e.ApplicationUUID = strings.TrimSpace(e.ApplicationUUID)
+	if err := validate.UUID(e.ApplicationUUID); err != nil {
+		return err
+	}
+	e.AttachmentUUID = strings.TrimSpace(e.AttachmentUUID)
+	if err := validate.UUID(e.AttachmentUUID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── CreateApplicationComment ────────────────────────────────────────────────
+
+type CreateApplicationCommentRequest struct {
+	ApplicationUUID   string   `json:"-"`
+	ParentCommentUUID string   `json:"parent_comment_uuid"`
+	Text              string   `json:"text"`
+	MentionedUUIDs    []string `json:"mentioned_uuids"`
+}
+type CreateApplicationCommentResponse struct {
+	Comment *CommentResponse `json:"comment"`
+}
+
+func (e *CreateApplicationCommentRequest) Validate() error {
+	e.ApplicationUUID = strings.TrimSpace(e.ApplicationUUID)
+	if err := validate.UUID(e.ApplicationUUID); err != nil {
+		return err
+	}
+	e.ParentCommentUUID = strings.TrimSpace(e.ParentCommentUUID)
+	if err := validate.UUID(e.ParentCommentUUID); err != nil && e.ParentCommentUUID != "" {
+		return err
+	}
+	e.Text = strings.TrimSpace(e.Text)
+	if err := validate.ApplicationComment(e.Text); err != nil {
+		return err
+	}
+	if err := validate.CommentMentions(e.MentionedUUIDs); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── GetApplicationComments ──────────────────────────────────────────────────
+
+type GetApplicationCommentsRequest struct {
+	ApplicationUUID string `json:"-"`
+	Count           int64  `query:"count"`
+	Offset          int64  `query:"offset"`
+	Cursor          string `query:"cursor"`
+}
+type GetApplicationCommentsResponse struct {
+	Comments   []*CommentResponse `json:"comments"`
+	NextCursor string             `json:"next_cursor"`
+	TotalCount int64              `json:"total_count"`
+}
+
+func (e *GetApplicationCommentsRequest) Validate() error {
+	e.ApplicationUUID = strings.TrimSpace(e.ApplicationUUID)
+	if err := validate.UUID(e.ApplicationUUID); err != nil {
+		return err
+	}
+	if err := validate.Number(int(e.Count), validate.IntPtr(1), validate.IntPtr(100), "count"); err != nil {
+		return err
+	}
+	if err := validate.Number(int(e.Offset), validate.IntPtr(0), nil, "offset"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── UpdateApplicationComment ────────────────────────────────────────────────
+
+type UpdateApplicationCommentRequest struct {
+	ApplicationUUID string   `json:"-"`
+	CommentUUID     string   `json:"-"`
+	Text            string   `json:"text"`
+	MentionedUUIDs  []string `json:"mentioned_uuids"`
+}
+type UpdateApplicationCommentResponse struct{}
+
+func (e *UpdateApplicationCommentRequest) Validate() error {
+	e.ApplicationUUID = strings.TrimSpace(e.ApplicationUUID)
+	if err := validate.UUID(e.ApplicationUUID); err != nil {
+		return err
+	}
+	e.CommentUUID = strings.TrimSpace(e.CommentUUID)
+	if err := validate.UUID(e.CommentUUID); err != nil {
+		return err
+	}
+	e.Text = strings.TrimSpace(e.Text)
+	if err := validate.ApplicationComment(e.Text); err != nil {
+		return err
+	}
+	if err := validate.CommentMentions(e.MentionedUUIDs); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── DeleteApplicationComment ────────────────────────────────────────────────
+
+type DeleteApplicationCommentRequest struct {
+	ApplicationUUID string `json:"-"`
+	CommentUUID     string `json:"-"`
+}
+type DeleteApplicationCommentResponse struct{}
+
+func (e *DeleteApplicationCommentRequest) Validate() error {
+	e.ApplicationUUID = strings.TrimSpace(e.ApplicationUUID)
+	if err := validate.UUID(e.ApplicationUUID); err != nil {
+		return err
+	}
+	e.CommentUUID = strings.TrimSpace(e.CommentUUID)
+	if err := validate.UUID(e.CommentUUID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── GetApplicationCommentHistory ────────────────────────────────────────────
+
+type GetApplicationCommentHistoryRequest struct {
+	ApplicationUUID string `json:"-"`
+	CommentUUID     string `json:"-"`
+}
+type GetApplicationCommentHistoryResponse struct {
+	Comment *CommentResponse       `json:"comment"`
+	Edits   []*CommentEditResponse `json:"edits"`
+}
+
+func (e *GetApplicationCommentHistoryRequest) Validate() error {
+	e.ApplicationUUID = strings.TrimSpace(e.ApplicationUUID)
+	if err := validate.UUID(e.ApplicationUUID); err != nil {
+		return err
+	}
+	e.CommentUUID = strings.TrimSpace(e.CommentUUID)
+	if err := validate.UUID(e.CommentUUID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── Workflow ─────────────────────────────────────────────────────────────────
+
+type WorkflowTransition struct {
+	Name           string   `json:"name"`
+	Action         string   `json:"action"`
+	From           []string `json:"from"`
+	To             string   `json:"to,omitempty"`
+	Roles          []string `json:"roles"`
+	Guard          string   `json:"guard,omitempty"`
+	RequireMessage bool     `json:"require_message"`
+}
+
+type Workflow struct {
+	States      []string              `json:"states"`
+	Transitions []*WorkflowTransition `json:"transitions"`
+}
+
+// ─── GetApplicationActions ────────────────────────────────────────────────────
+
+type GetApplicationActionsRequest struct {
+	ApplicationUUID string `json:"-"`
+}
+type GetApplicationActionsResponse struct {
+	Actions []*WorkflowTransition `json:"actions"`
+}
+
+func (e *GetApplicationActionsRequest) Validate() error {
+	e.ApplicationUUID = strings.TrimSpace(e.ApplicationUUID)
+	if err := validate.UUID(e.ApplicationUUID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── GetCompanyWorkflow ───────────────────────────────────────────────────────
+
+type GetCompanyWorkflowRequest struct {
+	CompanyUUID string `json:"-"`
+}
+type GetCompanyWorkflowResponse struct {
+	Workflow  *Workflow `json:"workflow"`
+	IsDefault bool      `json:"is_default"`
+	UpdatedAt string    `json:"updated_at,omitempty"`
+	UpdatedBy string    `json:"updated_by,omitempty"`
+}
+
+func (e *GetCompanyWorkflowRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── UpdateCompanyWorkflow ────────────────────────────────────────────────────
+
+type UpdateCompanyWorkflowRequest struct {
+	CompanyUUID string    `json:"-"`
+	Workflow    *Workflow `json:"workflow"`
+}
+type UpdateCompanyWorkflowResponse struct{}
+
+func (e *UpdateCompanyWorkflowRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	if e.Workflow == nil {
+		return fmt.Errorf("workflow missed")
+	}
+	for _, t := range e.Workflow.Transitions {
+		if t == nil {
+			return fmt.Errorf("transition missed")
+		}
+	}
+	return nil
+}
+
+// ─── ResetCompanyWorkflow ─────────────────────────────────────────────────────
+
+type ResetCompanyWorkflowRequest struct {
+	CompanyUUID string `json:"-"`
+}
+type ResetCompanyWorkflowResponse struct{}
+
+func (e *ResetCompanyWorkflowRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── SLA ──────────────────────────────────────────────────────────────────────
+
+type SLAPolicy struct {
+	Priority                string `json:"priority"`
+	AssignWithinHours       int32  `json:"assign_within_hours"`       // 0 - без ограничения
+	VerificationWithinHours int32  `json:"verification_within_hours"` // 0 - без ограничения
+}
+
+// ─── GetCompanySLAPolicies ────────────────────────────────────────────────────
+
+type GetCompanySLAPoliciesRequest struct {
+	CompanyUUID string `json:"-"`
+}
+type GetCompanySLAPoliciesResponse struct {
+	Policies []*SLAPolicy `json:"policies"`
+}
+
+func (e *GetCompanySLAPoliciesRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── UpdateCompanySLAPolicy ───────────────────────────────────────────────────
+
+type UpdateCompanySLAPolicyRequest struct {
+	CompanyUUID             string `json:"-"`
+	Priority                string `json:"-"`
+	AssignWithinHours       int32  `json:"assign_within_hours"`
+	VerificationWithinHours int32  `json:"verification_within_hours"`
+}
+type UpdateCompanySLAPolicyResponse struct{}
+
+func (e *UpdateCompanySLAPolicyRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	e.Priority = strings.TrimSpace(e.Priority)
+	if err := validate.ApplicationPriority(e.Priority); err != nil {
+		return err
+	}
+	if err := validate.SLAHours(int(e.AssignWithinHours), "assign_within_hours"); err != nil {
+		return err
+	}
+	if err := validate.SLAHours(int(e.VerificationWithinHours), "verification_within_hours"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── DeleteCompanySLAPolicy ───────────────────────────────────────────────────
+
+type DeleteCompanySLAPolicyRequest struct {
+	CompanyUUID string `json:"-"`
+	Priority    string `json:"-"`
+}
+type DeleteCompanySLAPolicyResponse struct{}
+
+func (e *DeleteCompanySLAPolicyRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	e.Priority = strings.TrimSpace(e.Priority)
+	if err := validate.ApplicationPriority(e.Priority); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── Categories ───────────────────────────────────────────────────────────────
+
+type ApplicationCategory struct {
+	CategoryUUID          string `json:"category_uuid"`
+	Title                 string `json:"title"`
+	DefaultDepartmentUUID string `json:"default_department_uuid"` // пусто - заявки остаются в департаменте инспектора
+	CreatedAt             string `json:"created_at"`
+	CreatedBy             string `json:"created_by"`
+}
+
+// ─── GetApplicationCategories ─────────────────────────────────────────────────
+
+type GetApplicationCategoriesRequest struct {
+	CompanyUUID string `json:"-"`
+}
+type GetApplicationCategoriesResponse struct {
+	Categories []*ApplicationCategory `json:"categories"`
+}
+
+func (e *GetApplicationCategoriesRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── CreateApplicationCategory ────────────────────────────────────────────────
+
+type CreateApplicationCategoryRequest struct {
+	CompanyUUID           string `json:"-"`
+	Title                 string `json:"title"`
+	DefaultDepartmentUUID string `json:"default_department_uuid"` // необязательно
+}
+type CreateApplicationCategoryResponse struct {
+	CategoryUUID string `json:"category_uuid"`
+}
+
+func (e *CreateApplicationCategoryRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	e.Title = strings.TrimSpace(e.Title)
+	if err := validate.CategoryTitle(e.Title); err != nil {
+		return err
+	}
+	e.DefaultDepartmentUUID = strings.TrimSpace(e.DefaultDepartmentUUID)
+	if err := validate.UUID(e.DefaultDepartmentUUID); err != nil && e.DefaultDepartmentUUID != "" {
+		return err
+	}
+	return nil
+}
+
+// ─── UpdateApplicationCategory ────────────────────────────────────────────────
+
+type UpdateApplicationCategoryRequest struct {
+	CompanyUUID           string `json:"-"`
+	CategoryUUID          string `json:"-"`
+	Title                 string `json:"title"`
+	DefaultDepartmentUUID string `json:"default_department_uuid"` // пусто - снять департамент по умолчанию
+}
+type UpdateApplicationCategoryResponse struct{}
+
+func (e *UpdateApplicationCategoryRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	e.CategoryUUID = strings.TrimSpace(e.CategoryUUID)
+	if err := validate.UUID(e.CategoryUUID); err != nil {
+		return err
+	}
+	e.Title = strings.TrimSpace(e.Title)
+	if err := validate.CategoryTitle(e.Title); err != nil {
+		return err
+	}
+	e.DefaultDepartmentUUID = strings.TrimSpace(e.DefaultDepartmentUUID)
+	if err := validate.UUID(e.DefaultDepartmentUUID); err != nil && e.DefaultDepartmentUUID != "" {
+		return err
+	}
+	return nil
+}
+
+// ─── DeleteApplicationCategory ────────────────────────────────────────────────
+
+type DeleteApplicationCategoryRequest struct {
+	CompanyUUID  string `json:"-"`
+	CategoryUUID string `json:"-"`
+}
+type DeleteApplicationCategoryResponse struct{}
+
+func (e *DeleteApplicationCategoryRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	e.CategoryUUID = strings.TrimSpace(e.CategoryUUID)
+	if err := validate.UUID(e.CategoryUUID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── Custom fields ────────────────────────────────────────────────────────────
+
+type CustomField struct {
+	Key      string   `json:"key"`
+	Title    string   `json:"title"`
+	Type     string   `json:"type" example:"enum"` // text, number, enum, date
+	Options  []string `json:"options,omitempty"`   // только для enum
+	Required bool     `json:"required"`
+}
+
+// ─── GetCompanyCustomFields ───────────────────────────────────────────────────
+
+type GetCompanyCustomFieldsRequest struct {
+	CompanyUUID string `json:"-"`
+}
+type GetCompanyCustomFieldsResponse struct {
+	Fields []*CustomField `json:"fields"`
+}
+
+func (e *GetCompanyCustomFieldsRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── UpdateCompanyCustomField ─────────────────────────────────────────────────
+
+type UpdateCompanyCustomFieldRequest struct {
+	CompanyUUID string   `json:"-"`
+	Key         string   `json:"-"`
+	Title       string   `json:"title"`
+	Type        string   `json:"type" example:"enum"`
+	Options     []string `json:"options"`
+	Required    bool     `json:"required"`
+}
+type UpdateCompanyCustomFieldResponse struct{}
+
+func (e *UpdateCompanyCustomFieldRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	e.Key = strings.TrimSpace(e.Key)
+	if err := validate.CustomFieldKey(e.Key); err != nil {
+		return err
+	}
+	e.Title = strings.TrimSpace(e.Title)
+	if err := validate.CustomFieldTitle(e.Title); err != nil {
+		return err
+	}
+	e.Type = strings.TrimSpace(e.Type)
+	if err := validate.CustomFieldType(e.Type); err != nil {
+		return err
+	}
+	if err := validate.CustomFieldOptions(e.Type, e.Options); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── DeleteCompanyCustomField ─────────────────────────────────────────────────
+
+type DeleteCompanyCustomFieldRequest struct {
+	CompanyUUID string `json:"-"`
+	Key         string `json:"-"`
+}
+type DeleteCompanyCustomFieldResponse struct{}
+
+func (e *DeleteCompanyCustomFieldRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	e.Key = strings.TrimSpace(e.Key)
+	if err := validate.CustomFieldKey(e.Key); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── Analytics ────────────────────────────────────────────────────────────────
+
+// AnalyticsRequest Выборка заявок для аналитики: созданные в [created_from, created_to)
+type AnalyticsRequest struct {
+	CompanyUUID    string `json:"-"`
+	DepartmentUUID string `query:"department_uuid"`
+	CreatedFrom    string `query:"created_from"`
+	CreatedTo      string `query:"created_to"`
+	Interval       string `query:"interval"` // только для статистики по статусам: day (по умолчанию), week, month
+}
+
+func (e *AnalyticsRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	e.DepartmentUUID = strings.TrimSpace(e.DepartmentUUID)
+	if err := validate.UUID(e.DepartmentUUID); err != nil && e.DepartmentUUID != "" {
+		return err
+	}
+	if err := validate.DateFilter(e.CreatedFrom, "created_from"); err != nil && e.CreatedFrom != "" {
+		return err
+	}
+	if err := validate.DateFilter(e.CreatedTo, "created_to"); err != nil && e.CreatedTo != "" {
+		return err
+	}
+	return nil
+}
+
+type StatusStat struct {
+	Period         string `json:"period"`
+	DepartmentUUID string `json:"department_uuid"`
+	Status         string `json:"status"`
+	Count          int64  `json:"count"`
+}
+type GetApplicationStatusStatsResponse struct {
+	Stats []*StatusStat `json:"stats"`
+}
+
+type LeadTime struct {
+	MeanSeconds float64 `json:"mean_seconds"`
+	Samples     int64   `json:"samples"`
+}
+type GetApplicationLeadTimesResponse struct {
+	Assign *LeadTime `json:"assign"` // создание -> первое назначение инженера
+	Fix    *LeadTime `json:"fix"`    // первое назначение -> первая отправка на проверку
+	Verify *LeadTime `json:"verify"` // последняя отправка на проверку -> закрытие
+}
+
+type EngineerRevisionStat struct {
+	EngineerUUID        string  `json:"engineer_uuid"`
+	Applications        int64   `json:"applications"`
+	RevisedApplications int64   `json:"revised_applications"`
+	Revisions           int64   `json:"revisions"`
+	RevisionRate        float64 `json:"revision_rate"`
+}
+type GetEngineerRevisionStatsResponse struct {
+	Stats []*EngineerRevisionStat `json:"stats"`
+}
+
+type DepartmentFailureStat struct {
+	DepartmentUUID string  `json:"department_uuid"`
+	Closed         int64   `json:"closed"`
+	Completed      int64   `json:"completed"`
+	Failed         int64   `json:"failed"`
+	FailureRate    float64 `json:"failure_rate"`
+}
+type GetDepartmentFailureStatsResponse struct {
+	Stats []*DepartmentFailureStat `json:"stats"`
+}
+
+// ─── GetEmployeeOpenApplications ──────────────────────────────────────────────
+
+type GetEmployeeOpenApplicationsRequest struct {
+	CompanyUUID    string `json:"-"`
+	EmployeeUUID   string `json:"-"`
+	DepartmentUUID string `query:"department_uuid"` // только заявки отдела, из которого сотрудника переводят
+	NewRole        string `query:"new_role"`        // связи, доступные новой роли, не учитываются
+}
+type EmployeeOpenApplication struct {
+	ApplicationUUID string   `json:"application_uuid"`
+	DepartmentUUID  string   `json:"department_uuid"`
+	Title           string   `json:"title"`
+	Status          string   `json:"status"`
+	Relations       []string `json:"relations"` // manager | executor | inspector
+}
+type GetEmployeeOpenApplicationsResponse struct {
+	Applications []*EmployeeOpenApplication `json:"applications"`
+}
+
+func (e *GetEmployeeOpenApplicationsRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	e.EmployeeUUID = strings.TrimSpace(e.EmployeeUUID)
+	if err := validate.UUID(e.EmployeeUUID); err != nil {
+		return err
+	}
+	e.DepartmentUUID = strings.TrimSpace(e.DepartmentUUID)
+	if e.DepartmentUUID != "" {
+		if err := validate.UUID(e.DepartmentUUID); err != nil {
+			return err
+		}
+	}
+	e.NewRole = strings.TrimSpace(e.NewRole)
+	if e.NewRole != "" && !slices.Contains([]string{"unemployed", "engineer", "manager", "analytic", "inspector", "chief"}, e.NewRole) {
+		return fmt.Errorf("incorrect employee role")
+	}
+	return nil
+}
@@ -70,10 +70,11 @@ type LoginRequest struct {
 	Password string `json:"password"`
 }
 type LoginResponse struct {
-	UserUUID     string `json:"user_uuid"`
-	AccessToken  string `json:"access_token"`
-	RefreshToken string `json:"refresh_token"`
-	SessionUUID  string `json:"session_uuid"`
+	UserUUID        string `json:"user_uuid"`
+	AccessToken     string `json:"access_token"`
+	RefreshToken    string `json:"refresh_token"`
+	SessionUUID     string `json:"session_uuid"`
+	TwoFactorMethod string `json:"two_factor_method"` // email / totp, если включена 2FA
 }
 
 func (e *LoginRequest) Validate() error {
@@ -265,6 +266,7 @@ func (e *ResetPasswordRequest) Validate() error {
 type Verify2FARequest struct {
 	SessionUUID string `json:"session_uuid"`
 	Code        string `json:"code"`
+	Method      string `json:"method"` // email / totp / recovery, по умолчанию - способ из ответа Login
 }
 type Verify2FAResponse struct {
 	UserUUID     string `json:"user_uuid"`
@@ -278,7 +280,16 @@ func (e *Verify2FARequest) Validate() error {
 		return err
 	}
 	e.Code = strings.TrimSpace(e.Code)
-	return validate.User2FACCode(e.Code)
+	e.Method = strings.TrimSpace(e.Method)
+	switch e.Method {
+	case "recovery":
+		e.Code = strings.ToLower(e.Code)
+		return validate.User2FARecoveryCode(e.Code)
+	case "", "email", "totp":
+		return validate.User2FACCode(e.Code)
+	default:
+		return fmt.Errorf("method must be one of [email totp recovery]")
+	}
 }
 
 // ─── RestoreAccount ───────────────────────────────────────────────────────────
@@ -303,10 +314,64 @@ func (e *RestoreAccountRequest) Validate() error {
 type UpdateUser2FARequest struct {
 	UserUUID  string `json:"-"`
 	Enable2FA bool   `json:"enable_2fa"`
+	Method    string `json:"method"` // email / totp, пустое значение - оставить текущий способ
 }
 type UpdateUser2FAResponse struct{}
 
 func (e *UpdateUser2FARequest) Validate() error {
+	e.UserUUID = strings.TrimSpace(e.UserUUID)
+	if err := validate.UUID(e.UserUUID); err != nil {
+		return err
+	}
+	e.Method = strings.TrimSpace(e.Method)
+	if e.Method == "" {
+		return nil
+	}
+	return validate.TwoFAMethod(e.Method)
+}
+
+// ─── EnrollTOTP ───────────────────────────────────────────────────────────────
+
+type EnrollTOTPRequest struct {
+	UserUUID string `json:"-"`
+}
+type EnrollTOTPResponse struct {
+	Secret     string `json:"secret"`
+	OtpauthURI string `json:"otpauth_uri"`
+}
+
+func (e *EnrollTOTPRequest) Validate() error {
 	e.UserUUID = strings.TrimSpace(e.UserUUID)
 	return validate.UUID(e.UserUUID)
 }
+
+// ─── ConfirmTOTP ──────────────────────────────────────────────────────────────
+
+type ConfirmTOTPRequest struct {
+	UserUUID string `json:"-"`
+	Code     string `json:"code"`
+}
+type ConfirmTOTPResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+func (e *ConfirmTOTPRequest) Validate() error {
+	e.UserUUID = strings.TrimSpace(e.UserUUID)
+	if err := validate.UUID(e.UserUUID); err != nil {
+		return err
+	}
+	e.Code = strings.TrimSpace(e.Code)
+	return validate.User2FACCode(e.Code)
+}
+
+// ─── Send2FAEmailCode ─────────────────────────────────────────────────────────
+
+type Send2FAEmailCodeRequest struct {
+	SessionUUID string `json:"session_uuid"`
+}
+type Send2FAEmailCodeResponse struct{}
+
+func (e *Send2FAEmailCodeRequest) Validate() error {
+	e.SessionUUID = strings.TrimSpace(e.SessionUUID)
+	return validate.UUID(e.SessionUUID)
+}
@@ -15,6 +15,15 @@ type DepartmentListItem struct {
 	Title          string `json:"title"`
 }
 
+type LocationItem struct {
+	LocationUUID string   `json:"location_uuid"`
+	ParentUUID   string   `json:"parent_uuid,omitempty"`
+	Kind         string   `json:"kind" example:"building"`
+	Title        string   `json:"title"`
+	Latitude     *float64 `json:"latitude,omitempty"`
+	Longitude    *float64 `json:"longitude,omitempty"`
+}
+
 // ─── CreateCompany ────────────────────────────────────────────────────────────
 
 type CreateCompanyRequest struct {
@@ -38,9 +47,11 @@ type GetCompanyRequest struct {
 	CompanyUUID string `json:"-"`
 }
 type GetCompanyResponse struct {
-	CompanyUUID string `json:"company_uuid"`
-	Title       string `json:"title"`
-	Status      string `json:"status"`
+	CompanyUUID          string `json:"company_uuid"`
+	Title                string `json:"title"`
+	Status               string `json:"status"`
+	JoinApprovalRequired *bool  `json:"join_approval_required,omitempty"` // только в информации о компании, в списках не заполняется
+	OwnerUUID            string `json:"owner_uuid,omitempty"`             // только в информации о компании, в списках не заполняется
 }
 
 func (e *GetCompanyRequest) Validate() error {
@@ -54,11 +65,14 @@ func (e *GetCompanyRequest) Validate() error {
 // ─── GetCompanies ─────────────────────────────────────────────────────────────
 
 type GetCompaniesRequest struct {
-	Offset int64 `query:"offset"`
-	Count  int64 `query:"count"`
+	Offset int64  `query:"offset"`
+	Count  int64  `query:"count"`
+	Cursor string `query:"cursor"`
 }
 type GetCompaniesResponse struct {
-	Companies []*GetCompanyResponse `json:"companies"`
+	Companies  []*GetCompanyResponse `json:"companies"`
+	NextCursor string                `json:"next_cursor"`
+	TotalCount int64                 `json:"total_count"`
 }
 
 func (e *GetCompaniesRequest) Validate() error {
@@ -118,6 +132,22 @@ func (e *UpdateCompanyStatusRequest) Validate() error {
 	return nil
 }
 
+// ─── UpdateCompanyJoinSettings ────────────────────────────────────────────────
+
+type UpdateCompanyJoinSettingsRequest struct {
+	CompanyUUID          string `json:"-"`
+	JoinApprovalRequired bool   `json:"join_approval_required"` // вступление по коду только после одобрения chief
+}
+type UpdateCompanyJoinSettingsResponse struct{}
+
+func (e *UpdateCompanyJoinSettingsRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	return nil
+}
+
 // ─── DeleteCompany ────────────────────────────────────────────────────────────
 
 type DeleteCompanyRequest struct {
@@ -133,11 +163,38 @@ func (e *DeleteCompanyRequest) Validate() error {
 	return nil
 }
 
+// ─── GetCompanyDeletionStatus / RestoreCompany ────────────────────────────────
+
+type CompanyDeletionRequest struct {
+	CompanyUUID string `json:"-"`
+}
+type GetCompanyDeletionStatusResponse struct {
+	Step                 string `json:"step"` // soft_deleted | archiving_applications | purging_join_codes | deleting_company | completed
+	RequestedBy          string `json:"requested_by"`
+	RequestedAt          string `json:"requested_at"`
+	PurgeAfter           string `json:"purge_after"` // до этого времени компанию можно восстановить
+	ArchiveKey           string `json:"archive_key"` // ключ архива заявок компании
+	ArchivedApplications int64  `json:"archived_applications"`
+	Attempts             int32  `json:"attempts"` // неудачные попытки текущего шага
+	LastError            string `json:"last_error"`
+	CompletedAt          string `json:"completed_at"`
+}
+type RestoreCompanyResponse struct{}
+
+func (e *CompanyDeletionRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	return nil
+}
+
 // ─── CreateCompanyJoinCode ────────────────────────────────────────────────────
 
 type CreateCompanyJoinCodeRequest struct {
 	CompanyUUID string `json:"-"`
 	CodeTTL     int64  `json:"code_ttl"`
+	MaxUses     int64  `json:"max_uses"` // 0 - без ограничения
 }
 type CreateCompanyJoinCodeResponse struct {
 	Code string `json:"code"`
@@ -151,6 +208,9 @@ func (e *CreateCompanyJoinCodeRequest) Validate() error {
 	if err := validate.Number(int(e.CodeTTL), validate.IntPtr(60), validate.IntPtr(60*60*24*7), "code_ttl"); err != nil {
 		return err
 	}
+	if err := validate.Number(int(e.MaxUses), validate.IntPtr(0), validate.IntPtr(1000), "max_uses"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -159,8 +219,14 @@ func (e *CreateCompanyJoinCodeRequest) Validate() error {
 type GetCompanyJoinCodesRequest struct {
 	CompanyUUID string `json:"-"`
 }
+type JoinCodeItem struct {
+	Code    string `json:"code"`
+	MaxUses int64  `json:"max_uses"` // 0 - без ограничения
+	Uses    int64  `json:"uses"`
+}
 type GetCompanyJoinCodesResponse struct {
-	Codes []string `json:"codes"`
+	Codes     []string        `json:"codes"`
+	JoinCodes []*JoinCodeItem `json:"join_codes"`
 }
 
 func (e *GetCompanyJoinCodesRequest) Validate() error {
@@ -191,14 +257,43 @@ func (e *DeleteCompanyJoinCodeRequest) Validate() error {
 	return nil
 }
 
+// ─── GetJoinCodeRedemptions ───────────────────────────────────────────────────
+
+type GetJoinCodeRedemptionsRequest struct {
+	CompanyUUID string `json:"-"`
+	Code        string `json:"-"`
+}
+type JoinCodeRedemptionItem struct {
+	UserUUID        string `json:"user_uuid"`
+	RedeemedAt      string `json:"redeemed_at"`
+	JoinRequestUUID string `json:"join_request_uuid,omitempty"` // пусто - пользователь добавлен сразу, без заявки
+}
+type GetJoinCodeRedemptionsResponse struct {
+	Redemptions []*JoinCodeRedemptionItem `json:"redemptions"`
+}
+
+func (e *GetJoinCodeRedemptionsRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	e.Code = strings.TrimSpace(e.Code)
+	if err := validate.CompanyJoinCode(e.Code); err != nil {
+		return err
+	}
+	return nil
+}
+
 // ─── JoinCompany ──────────────────────────────────────────────────────────────
 
 type JoinCompanyRequest struct {
 	Code string `json:"code"`
 }
 type JoinCompanyResponse struct {
-	CompanyUUID string `json:"company_uuid"`
-	Role        string `json:"role"`
+	CompanyUUID     string `json:"company_uuid"`
+	Role            string `json:"role"`                        // пусто, пока заявка не одобрена
+	Status          string `json:"status" example:"joined"`     // joined | pending
+	JoinRequestUUID string `json:"join_request_uuid,omitempty"` // только для pending
 }
 
 func (e *JoinCompanyRequest) Validate() error {
@@ -209,6 +304,176 @@ func (e *JoinCompanyRequest) Validate() error {
 	return nil
 }
 
+// ─── GetCompanyJoinRequests ───────────────────────────────────────────────────
+
+type GetCompanyJoinRequestsRequest struct {
+	CompanyUUID string `json:"-"`
+	Status      string `query:"status"`
+}
+type JoinRequestItem struct {
+	JoinRequestUUID string `json:"join_request_uuid"`
+	UserUUID        string `json:"user_uuid"`
+	JoinCode        string `json:"join_code"`
+	Status          string `json:"status" example:"pending"`
+	CreatedAt       string `json:"created_at"`
+	DecidedAt       string `json:"decided_at,omitempty"`
+	DecidedBy       string `json:"decided_by,omitempty"`
+}
+type GetCompanyJoinRequestsResponse struct {
+	JoinRequests []*JoinRequestItem `json:"join_requests"`
+}
+
+func (e *GetCompanyJoinRequestsRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	e.Status = strings.TrimSpace(e.Status)
+	if e.Status != "" && !helpers.Contains([]string{"pending", "accepted", "declined"}, e.Status) {
+		return fmt.Errorf("incorrect join request status")
+	}
+	return nil
+}
+
+// ─── AcceptCompanyJoinRequest / DeclineCompanyJoinRequest ─────────────────────
+
+type DecideCompanyJoinRequestRequest struct {
+	CompanyUUID     string `json:"-"`
+	JoinRequestUUID string `json:"-"`
+}
+type DecideCompanyJoinRequestResponse struct{}
+
+func (e *DecideCompanyJoinRequestRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	e.JoinRequestUUID = strings.TrimSpace(e.JoinRequestUUID)
+	if err := validate.UUID(e.JoinRequestUUID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── CreateCompanyInvitation ──────────────────────────────────────────────────
+
+type CreateCompanyInvitationRequest struct {
+	CompanyUUID    string `json:"-"`
+	Email          string `json:"email"`
+	Role           string `json:"role" example:"engineer"`
+	DepartmentUUID string `json:"department_uuid"` // необязательно
+	TTL            int64  `json:"ttl"`             // время жизни приглашения в секундах
+}
+type CreateCompanyInvitationResponse struct {
+	InvitationUUID string `json:"invitation_uuid"`
+}
+
+func (e *CreateCompanyInvitationRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	e.Email = strings.TrimSpace(e.Email)
+	if err := validate.Email(e.Email); err != nil {
+		return err
+	}
+	e.Role = strings.TrimSpace(e.Role)
+	if !helpers.Contains([]string{"chief", "analytic", "manager", "engineer", "inspector", "unemployed"}, e.Role) {
+		return fmt.Errorf("incorrect role")
+	}
+	e.DepartmentUUID = strings.TrimSpace(e.DepartmentUUID)
+	if e.DepartmentUUID != "" {
+		if err := validate.UUID(e.DepartmentUUID); err != nil {
+			return err
+		}
+	}
+	if err := validate.Number(int(e.TTL), validate.IntPtr(60), validate.IntPtr(60*60*24*7), "ttl"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── GetCompanyInvitations ────────────────────────────────────────────────────
+
+type GetCompanyInvitationsRequest struct {
+	CompanyUUID string `json:"-"`
+	Status      string `query:"status"`
+}
+type InvitationItem struct {
+	InvitationUUID string `json:"invitation_uuid"`
+	CompanyUUID    string `json:"company_uuid"`
+	CompanyTitle   string `json:"company_title"`
+	Email          string `json:"email"`
+	Role           string `json:"role"`
+	DepartmentUUID string `json:"department_uuid,omitempty"`
+	Status         string `json:"status" example:"pending"` // pending | accepted | declined | revoked | expired
+	ExpiresAt      string `json:"expires_at"`
+	CreatedAt      string `json:"created_at"`
+	CreatedBy      string `json:"created_by"`
+	DecidedAt      string `json:"decided_at,omitempty"`
+	AcceptedBy     string `json:"accepted_by,omitempty"`
+}
+type GetCompanyInvitationsResponse struct {
+	Invitations []*InvitationItem `json:"invitations"`
+}
+
+func (e *GetCompanyInvitationsRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	e.Status = strings.TrimSpace(e.Status)
+	if e.Status != "" && !helpers.Contains([]string{"pending", "accepted", "declined", "revoked", "expired"}, e.Status) {
+		return fmt.Errorf("incorrect invitation status")
+	}
+	return nil
+}
+
+// ─── RevokeCompanyInvitation ──────────────────────────────────────────────────
+
+type RevokeCompanyInvitationRequest struct {
+	CompanyUUID    string `json:"-"`
+	InvitationUUID string `json:"-"`
+}
+type RevokeCompanyInvitationResponse struct{}
+
+func (e *RevokeCompanyInvitationRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	e.InvitationUUID = strings.TrimSpace(e.InvitationUUID)
+	if err := validate.UUID(e.InvitationUUID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── GetUserInvitations ───────────────────────────────────────────────────────
+
+type GetUserInvitationsResponse struct {
+	Invitations []*InvitationItem `json:"invitations"`
+}
+
+// ─── AcceptCompanyInvitation / DeclineCompanyInvitation ───────────────────────
+
+type DecideCompanyInvitationRequest struct {
+	InvitationUUID string `json:"-"`
+}
+type AcceptCompanyInvitationResponse struct {
+	CompanyUUID string `json:"company_uuid"`
+	Role        string `json:"role"`
+}
+type DeclineCompanyInvitationResponse struct{}
+
+func (e *DecideCompanyInvitationRequest) Validate() error {
+	e.InvitationUUID = strings.TrimSpace(e.InvitationUUID)
+	if err := validate.UUID(e.InvitationUUID); err != nil {
+		return err
+	}
+	return nil
+}
+
 // ─── GetCompanyEmployee ───────────────────────────────────────────────────────
 
 type GetCompanyEmployeeRequest struct {
@@ -242,9 +507,12 @@ type GetCompanyEmployeesRequest struct {
 	Role           string `query:"role"`
 	Offset         int64  `query:"offset"`
 	Count          int64  `query:"count"`
+	Cursor         string `query:"cursor"`
 }
 type GetCompanyEmployeesResponse struct {
-	Employees []*GetCompanyEmployeeResponse `json:"employees"`
+	Employees  []*GetCompanyEmployeeResponse `json:"employees"`
+	NextCursor string                        `json:"next_cursor"`
+	TotalCount int64                         `json:"total_count"`
 }
 
 func (e *GetCompanyEmployeesRequest) Validate() error {
@@ -303,9 +571,11 @@ func (e *GetCompanyEmployeesSummaryRequest) Validate() error {
 // ─── UpdateEmployeeRole ───────────────────────────────────────────────────────
 
 type UpdateEmployeeRoleRequest struct {
-	CompanyUUID string `json:"-"`
-	TargetUUID  string `json:"-"`
-	Role        string `json:"role"`
+	CompanyUUID      string `json:"-"`
+	TargetUUID       string `json:"-"`
+	Role             string `json:"role"`
+	OpenApplications string `json:"-" query:"open_applications"` // block | pool | reassign, по умолчанию block
+	ReassignTo       string `json:"-" query:"reassign_to"`       // только для reassign
 }
 type UpdateEmployeeRoleResponse struct{}
 
@@ -322,14 +592,16 @@ func (e *UpdateEmployeeRoleRequest) Validate() error {
 	if !helpers.Contains([]string{"unemployed", "engineer", "manager", "analytic", "inspector", "chief"}, e.Role) {
 		return fmt.Errorf("incorrect employee role")
 	}
-	return nil
+	return validateOffboarding(&e.OpenApplications, &e.ReassignTo)
 }
 
 // ─── RemoveCompanyEmployee ────────────────────────────────────────────────────
 
 type RemoveCompanyEmployeeRequest struct {
-	TargetUUID  string `json:"-"`
-	CompanyUUID string `json:"-"`
+	TargetUUID       string `json:"-"`
+	CompanyUUID      string `json:"-"`
+	OpenApplications string `query:"open_applications"` // block | pool | reassign, по умолчанию block
+	ReassignTo       string `query:"reassign_to"`       // только для reassign
 }
 type RemoveCompanyEmployeeResponse struct{}
 
@@ -342,6 +614,70 @@ func (e *RemoveCompanyEmployeeRequest) Validate() error {
 	if err := validate.UUID(e.TargetUUID); err != nil {
 		return err
 	}
+	return validateOffboarding(&e.OpenApplications, &e.ReassignTo)
+}
+
+// ─── LeaveCompany ─────────────────────────────────────────────────────────────
+
+type LeaveCompanyRequest struct {
+	CompanyUUID string `json:"-"`
+}
+type LeaveCompanyResponse struct{}
+
+func (e *LeaveCompanyRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── TransferCompanyOwnership ─────────────────────────────────────────────────
+
+type TransferCompanyOwnershipRequest struct {
+	CompanyUUID string `json:"-"`
+	TargetUUID  string `json:"target_uuid"` // будущий владелец, должен быть сотрудником компании
+}
+type TransferCompanyOwnershipResponse struct {
+	TransferUUID string `json:"transfer_uuid"`
+	ExpiresAt    string `json:"expires_at"`
+}
+
+func (e *TransferCompanyOwnershipRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	e.TargetUUID = strings.TrimSpace(e.TargetUUID)
+	if err := validate.UUID(e.TargetUUID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── Get / Accept / Decline / Cancel CompanyOwnershipTransfer ─────────────────
+
+type CompanyOwnershipTransferRequest struct {
+	CompanyUUID string `json:"-"`
+}
+type GetCompanyOwnershipTransferResponse struct {
+	TransferUUID string `json:"transfer_uuid"`
+	CompanyUUID  string `json:"company_uuid"`
+	FromUUID     string `json:"from_uuid"` // текущий владелец
+	ToUUID       string `json:"to_uuid"`   // будущий владелец
+	Status       string `json:"status"`
+	ExpiresAt    string `json:"expires_at"`
+	CreatedAt    string `json:"created_at"`
+}
+type AcceptCompanyOwnershipTransferResponse struct{}
+type DeclineCompanyOwnershipTransferResponse struct{}
+type CancelCompanyOwnershipTransferResponse struct{}
+
+func (e *CompanyOwnershipTransferRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -488,9 +824,11 @@ func (e *AddEmployeeToDepartmentRequest) Validate() error {
 // ─── RemoveEmployeeFromDepartment ─────────────────────────────────────────────
 
 type RemoveEmployeeFromDepartmentRequest struct {
-	CompanyUUID    string `json:"-"`
-	DepartmentUUID string `json:"-"`
-	TargetUUID     string `json:"-"`
+	CompanyUUID      string `json:"-"`
+	DepartmentUUID   string `json:"-"`
+	TargetUUID       string `json:"-"`
+	OpenApplications string `query:"open_applications"` // block | pool | reassign, по умолчанию block
+	ReassignTo       string `query:"reassign_to"`       // только для reassign
 }
 type RemoveEmployeeFromDepartmentResponse struct{}
 
@@ -507,5 +845,230 @@ func (e *RemoveEmployeeFromDepartmentRequest) Validate() error {
 	if err := validate.UUID(e.TargetUUID); err != nil {
 		return err
 	}
+	return validateOffboarding(&e.OpenApplications, &e.ReassignTo)
+}
+
+// ─── CreateLocation ───────────────────────────────────────────────────────────
+
+type CreateLocationRequest struct {
+	CompanyUUID string   `json:"-"`
+	ParentUUID  string   `json:"parent_uuid"` // building - in site, floor - in building, zone - in floor; empty for site
+	Kind        string   `json:"kind" example:"site"`
+	Title       string   `json:"title"`
+	Latitude    *float64 `json:"latitude"`
+	Longitude   *float64 `json:"longitude"`
+}
+type CreateLocationResponse struct {
+	LocationUUID string `json:"location_uuid"`
+}
+
+func (e *CreateLocationRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	e.ParentUUID = strings.TrimSpace(e.ParentUUID)
+	if e.ParentUUID != "" {
+		if err := validate.UUID(e.ParentUUID); err != nil {
+			return err
+		}
+	}
+	e.Kind = strings.TrimSpace(e.Kind)
+	if err := validate.LocationKind(e.Kind); err != nil {
+		return err
+	}
+	e.Title = strings.TrimSpace(e.Title)
+	if err := validate.LocationTitle(e.Title); err != nil {
+		return err
+	}
+	return validate.Coordinates(e.Latitude, e.Longitude)
+}
+
+// ─── GetLocation ──────────────────────────────────────────────────────────────
+
+type GetLocationRequest struct {
+	CompanyUUID  string `json:"-"`
+	LocationUUID string `json:"-"`
+}
+type GetLocationResponse struct {
+	LocationItem
+	CompanyUUID string          `json:"company_uuid"`
+	CreatedAt   string          `json:"created_at"`
+	CreatedBy   string          `json:"created_by"`
+	Path        []*LocationItem `json:"path"` // from site down to the location itself
+}
+
+func (e *GetLocationRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	e.LocationUUID = strings.TrimSpace(e.LocationUUID)
+	if err := validate.UUID(e.LocationUUID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── GetCompanyLocations ──────────────────────────────────────────────────────
+
+type GetCompanyLocationsRequest struct {
+	CompanyUUID string `json:"-"`
+	ParentUUID  string `query:"parent_uuid"`
+	Kind        string `query:"kind"`
+	Offset      int64  `query:"offset"`
+	Count       int64  `query:"count"`
+}
+type GetCompanyLocationsResponse struct {
+	Locations []*LocationItem `json:"locations"`
+}
+
+func (e *GetCompanyLocationsRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	e.ParentUUID = strings.TrimSpace(e.ParentUUID)
+	if e.ParentUUID != "" {
+		if err := validate.UUID(e.ParentUUID); err != nil {
+			return err
+		}
+	}
+	e.Kind = strings.TrimSpace(e.Kind)
+	if e.Kind != "" {
+		if err := validate.LocationKind(e.Kind); err != nil {
+			return err
+		}
+	}
+	if err := validate.Number(int(e.Offset), validate.IntPtr(0), nil, "offset"); err != nil {
+		return err
+	}
+	return validate.Number(int(e.Count), validate.IntPtr(1), validate.IntPtr(100), "count")
+}
+
+// ─── UpdateLocation ───────────────────────────────────────────────────────────
+
+type UpdateLocationRequest struct {
+	CompanyUUID  string   `json:"-"`
+	LocationUUID string   `json:"-"`
+	Title        string   `json:"title"`
+	Latitude     *float64 `json:"latitude"` // coordinates are replaced as a whole, omitted ones are cleared
+	Longitude    *float64 `json:"longitude"`
+}
+type UpdateLocationResponse struct{}
+
+func (e *UpdateLocationRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	e.LocationUUID = strings.TrimSpace(e.LocationUUID)
+	if err := validate.UUID(e.LocationUUID); err != nil {
+		return err
+	}
+	e.Title = strings.TrimSpace(e.Title)
+	if err := validate.LocationTitle(e.Title); err != nil {
+		return err
+	}
+	return validate.Coordinates(e.Latitude, e.Longitude)
+}
+
+// ─── DeleteLocation ───────────────────────────────────────────────────────────
+
+type DeleteLocationRequest struct {
+	CompanyUUID  string `json:"-"`
+	LocationUUID string `json:"-"`
+}
+type DeleteLocationResponse struct{}
+
+func (e *DeleteLocationRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	e.LocationUUID = strings.TrimSpace(e.LocationUUID)
+	if err := validate.UUID(e.LocationUUID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ─── GetCompanyAuditLog ───────────────────────────────────────────────────────
+
+type GetCompanyAuditLogRequest struct {
+	CompanyUUID string `json:"-"`
+	ActorUUID   string `query:"actor_uuid"`
+	Action      string `query:"action"`
+	CreatedFrom string `query:"created_from"`
+	CreatedTo   string `query:"created_to"`
+	Offset      int64  `query:"offset"`
+	Count       int64  `query:"count"`
+	Cursor      string `query:"cursor"`
+}
+type AuditEntry struct {
+	EntryUUID   string `json:"entry_uuid"`
+	ActorUUID   string `json:"actor_uuid"`
+	Action      string `json:"action"`
+	TargetType  string `json:"target_type"` // employee | department | company | join_code
+	TargetID    string `json:"target_id"`   // uuid сотрудника, отдела или компании либо код для вступления
+	BeforeValue string `json:"before_value"`
+	AfterValue  string `json:"after_value"`
+	OperationID string `json:"operation_id"`
+	CreatedAt   string `json:"created_at"`
+}
+type GetCompanyAuditLogResponse struct {
+	Entries    []*AuditEntry `json:"entries"`
+	NextCursor string        `json:"next_cursor"`
+	TotalCount int64         `json:"total_count"`
+}
+
+func (e *GetCompanyAuditLogRequest) Validate() error {
+	e.CompanyUUID = strings.TrimSpace(e.CompanyUUID)
+	if err := validate.UUID(e.CompanyUUID); err != nil {
+		return err
+	}
+	e.ActorUUID = strings.TrimSpace(e.ActorUUID)
+	if e.ActorUUID != "" {
+		if err := validate.UUID(e.ActorUUID); err != nil {
+			return err
+		}
+	}
+	e.Action = strings.TrimSpace(e.Action)
+	if !helpers.Contains([]string{"", "employee_role_changed", "employee_removed", "department_created", "department_deleted",
+		"company_title_changed", "department_title_changed", "join_code_created"}, e.Action) {
+		return fmt.Errorf("incorrect audit action")
+	}
+	if err := validate.DateFilter(e.CreatedFrom, "created_from"); err != nil && e.CreatedFrom != "" {
+		return err
+	}
+	if err := validate.DateFilter(e.CreatedTo, "created_to"); err != nil && e.CreatedTo != "" {
+		return err
+	}
+	if err := validate.Number(int(e.Offset), validate.IntPtr(0), nil, "offset"); err != nil {
+		return err
+	}
+	if err := validate.Number(int(e.Count), validate.IntPtr(1), validate.IntPtr(100), "count"); err != nil {
+		return err
+	}
 	return nil
 }
+
+// validateOffboarding Политика обработки открытых заявок сотрудника перед его удалением, переводом или сменой роли
+func validateOffboarding(policy, reassignTo *string) error {
+	*policy = strings.TrimSpace(*policy)
+	if *policy == "" {
+		*policy = "block"
+	}
+	if !helpers.Contains([]string{"block", "pool", "reassign"}, *policy) {
+		return fmt.Errorf("incorrect open applications policy")
+	}
+
+	*reassignTo = strings.TrimSpace(*reassignTo)
+	if *policy != "reassign" {
+		if *reassignTo != "" {
+			return fmt.Errorf("reassign_to is allowed only with reassign policy")
+		}
+		return nil
+	}
+	return validate.UUID(*reassignTo)
+}
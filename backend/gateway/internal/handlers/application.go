@@ -1,18 +1,35 @@
 package handlers
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
 	application_proto "github.com/unwelcome/FrameWorkTask1/backend/contracts/application/generated"
+	auth_proto "github.com/unwelcome/FrameWorkTask1/backend/contracts/auth/generated"
 	"github.com/unwelcome/FrameWorkTask1/backend/gateway/internal/entities"
 	Error "github.com/unwelcome/FrameWorkTask1/backend/gateway/internal/errors"
+	"github.com/unwelcome/FrameWorkTask1/backend/gateway/pkg/csvexport"
 	"github.com/unwelcome/FrameWorkTask1/backend/gateway/pkg/utils"
-	"github.com/unwelcome/FrameWorkTask1/backend/shared/interceptors"
-	"google.golang.org/grpc/metadata"
+	"github.com/unwelcome/FrameWorkTask1/backend/gateway/pkg/xlsx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// watchHeartbeatInterval Период комментариев-пингов в SSE потоке, чтобы прокси не закрывали простаивающее соединение
+const watchHeartbeatInterval = 15 * time.Second
+
 type ApplicationHandler interface {
 	CreateApplication(c *fiber.Ctx) error
 	GetApplication(c *fiber.Ctx) error
@@ -26,19 +43,48 @@ type ApplicationHandler interface {
 	AddApplicationFixLog(c *fiber.Ctx) error
 	DeleteApplication(c *fiber.Ctx) error
 	GetApplicationHistory(c *fiber.Ctx) error
+	UploadApplicationAttachment(c *fiber.Ctx) error
+	GetApplicationAttachment(c *fiber.Ctx) error
+	DeleteApplicationAttachment(c *fiber.Ctx) error
+	CreateApplicationComment(c *fiber.Ctx) error
+	GetApplicationComments(c *fiber.Ctx) error
+	UpdateApplicationComment(c *fiber.Ctx) error
+	DeleteApplicationComment(c *fiber.Ctx) error
+	GetApplicationCommentHistory(c *fiber.Ctx) error
+	WatchApplications(c *fiber.Ctx) error
+	GetApplicationActions(c *fiber.Ctx) error
+	GetCompanyWorkflow(c *fiber.Ctx) error
+	UpdateCompanyWorkflow(c *fiber.Ctx) error
+	ResetCompanyWorkflow(c *fiber.Ctx) error
+	GetCompanySLAPolicies(c *fiber.Ctx) error
+	UpdateCompanySLAPolicy(c *fiber.Ctx) error
+	DeleteCompanySLAPolicy(c *fiber.Ctx) error
+	GetApplicationCategories(c *fiber.Ctx) error
+	CreateApplicationCategory(c *fiber.Ctx) error
+	UpdateApplicationCategory(c *fiber.Ctx) error
+	DeleteApplicationCategory(c *fiber.Ctx) error
+	GetCompanyCustomFields(c *fiber.Ctx) error
+	UpdateCompanyCustomField(c *fiber.Ctx) error
+	DeleteCompanyCustomField(c *fiber.Ctx) error
+	GetApplicationStatusStats(c *fiber.Ctx) error
+	GetApplicationLeadTimes(c *fiber.Ctx) error
+	GetEngineerRevisionStats(c *fiber.Ctx) error
+	GetDepartmentFailureStats(c *fiber.Ctx) error
+	ExportApplications(c *fiber.Ctx) error
+	GetEmployeeOpenApplications(c *fiber.Ctx) error
 }
 
 type applicationHandler struct {
 	ApplicationServiceClient application_proto.ApplicationServiceClient
-	operationIDKey           string
-	userUUIDKey              string
+	AuthServiceClient        auth_proto.AuthServiceClient
+	requestKeys
 }
 
-func NewApplicationHandler(ApplicationServiceClient application_proto.ApplicationServiceClient, operationIDKey string, userUUIDKey string) ApplicationHandler {
+func NewApplicationHandler(ApplicationServiceClient application_proto.ApplicationServiceClient, AuthServiceClient auth_proto.AuthServiceClient, operationIDKey string, userUUIDKey string) ApplicationHandler {
 	return &applicationHandler{
 		ApplicationServiceClient: ApplicationServiceClient,
-		operationIDKey:           operationIDKey,
-		userUUIDKey:              userUUIDKey,
+		AuthServiceClient:        AuthServiceClient,
+		requestKeys:              requestKeys{operationIDKey: operationIDKey, userUUIDKey: userUUIDKey},
 	}
 }
 
@@ -58,11 +104,9 @@ func NewApplicationHandler(ApplicationServiceClient application_proto.Applicatio
 //	@Failure		500		{object}	Error.HttpError
 //	@Router			/auth/application/create [post]
 func (h *applicationHandler) CreateApplication(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	// Парсинг тела запроса
 	httpReq := &entities.CreateApplicationRequest{}
@@ -83,6 +127,12 @@ func (h *applicationHandler) CreateApplication(c *fiber.Ctx) error {
 			Title:       httpReq.Title,
 			Description: httpReq.Description,
 		},
+		Priority:     httpReq.Priority,
+		DueAt:        httpReq.DueAt,
+		LocationUuid: httpReq.LocationUUID,
+		CategoryUuid: httpReq.CategoryUUID,
+		Severity:     httpReq.Severity,
+		CustomFields: customFieldsToProto(httpReq.CustomFields),
 	}
 
 	// Запрос в application сервис
@@ -112,11 +162,9 @@ func (h *applicationHandler) CreateApplication(c *fiber.Ctx) error {
 //	@Failure		500					{object}	Error.HttpError
 //	@Router			/auth/application/{application_uuid} [get]
 func (h *applicationHandler) GetApplication(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.GetApplicationRequest{
 		ApplicationUUID: c.Params("application_uuid", ""),
@@ -139,10 +187,11 @@ func (h *applicationHandler) GetApplication(c *fiber.Ctx) error {
 	fixLogs := make([]*entities.FixLogResponse, 0, len(app.GetFixLogs()))
 	for _, fl := range app.GetFixLogs() {
 		fixLogs = append(fixLogs, &entities.FixLogResponse{
-			UUID:      fl.GetUuid(),
-			Text:      fl.GetText(),
-			CreatedAt: fl.GetCreatedAt(),
-			CreatedBy: fl.GetCreatedBy(),
+			UUID:        fl.GetUuid(),
+			Text:        fl.GetText(),
+			CreatedAt:   fl.GetCreatedAt(),
+			CreatedBy:   fl.GetCreatedBy(),
+			Attachments: attachmentsToResponse(fl.GetAttachments()),
 		})
 	}
 
@@ -151,10 +200,19 @@ func (h *applicationHandler) GetApplication(c *fiber.Ctx) error {
 			ApplicationUUID: app.GetApplicationUuid(),
 			CompanyUUID:     app.GetCompanyUuid(),
 			DepartmentUUID:  app.GetDepartmentUuid(),
+			LocationUUID:    app.GetLocationUuid(),
+			CategoryUUID:    app.GetCategoryUuid(),
+			Severity:        app.GetSeverity(),
+			CustomFields:    customFieldsFromProto(app.GetCustomFields()),
 			Version:         app.GetVersion(),
 			Title:           app.GetTitle(),
 			Description:     app.GetDescription(),
 			Status:          app.GetStatus(),
+			Priority:        app.GetPriority(),
+			DueAt:           app.GetDueAt(),
+			IsOverdue:       app.GetIsOverdue(),
+			SLABreach:       app.GetSlaBreach(),
+			OverdueAt:       app.GetOverdueAt(),
 			RevisionCount:   app.GetRevisionCount(),
 			CreatedAt:       app.GetCreatedAt(),
 			CreatedBy:       app.GetCreatedBy(),
@@ -167,6 +225,7 @@ func (h *applicationHandler) GetApplication(c *fiber.Ctx) error {
 			DeletedAt:       app.GetDeletedAt(),
 			DeletedBy:       app.GetDeletedBy(),
 			FixLogs:         fixLogs,
+			Attachments:     attachmentsToResponse(app.GetAttachments()),
 		},
 	})
 }
@@ -185,6 +244,24 @@ func (h *applicationHandler) GetApplication(c *fiber.Ctx) error {
 //	@Param			offset				query		int			false	"Offset"		default(0)
 //	@Param			is_deleted			query		bool		false	"Include deleted"
 //	@Param			from_pool			query		bool		false	"Pool view (inspector/manager)"
+//	@Param			is_overdue			query		bool		false	"Only applications with breached SLA"
+//	@Param			search				query		string		false	"Full-text search by title, description and fix logs"
+//	@Param			created_from		query		string		false	"Created at or after (RFC3339 or YYYY-MM-DD)"
+//	@Param			created_to			query		string		false	"Created before (RFC3339 or YYYY-MM-DD)"
+//	@Param			closed_from			query		string		false	"Closed at or after (RFC3339 or YYYY-MM-DD)"
+//	@Param			closed_to			query		string		false	"Closed before (RFC3339 or YYYY-MM-DD)"
+//	@Param			created_by			query		string		false	"Creator UUID"
+//	@Param			executed_by			query		string		false	"Executor UUID"
+//	@Param			managed_by			query		string		false	"Manager UUID"
+//	@Param			revision_count_min	query		int			false	"Min revision count"
+//	@Param			revision_count_max	query		int			false	"Max revision count"
+//	@Param			location_uuid		query		string		false	"Location UUID (applications in it and in all nested locations)"
+//	@Param			category_uuid		query		string		false	"Defect category UUID"
+//	@Param			severities			query		[]string	false	"Filter by severities (minor, moderate, major, critical)"
+//	@Param			custom_fields		query		[]string	false	"Filter by custom field values in key:value format (exact match)"
+//	@Param			sort_by				query		string		false	"Sort field"	Enums(created_at, updated_at, closed_at, due_at, priority, revision_count, relevance)	default(created_at)
+//	@Param			sort_order			query		string		false	"Sort order"	Enums(asc, desc)	default(desc)
+//	@Param			cursor				query		string		false	"Cursor from next_cursor of the previous page (created_at sort only, instead of offset)"
 //	@Success		200					{object}	entities.GetApplicationsResponse
 //	@Failure		400					{object}	Error.HttpError
 //	@Failure		401					{object}	Error.HttpError
@@ -192,11 +269,9 @@ func (h *applicationHandler) GetApplication(c *fiber.Ctx) error {
 //	@Failure		500					{object}	Error.HttpError
 //	@Router			/auth/company/{company_uuid}/applications/list [get]
 func (h *applicationHandler) GetApplications(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.GetApplicationsRequest{}
 	if err := c.QueryParser(httpReq); err != nil {
@@ -208,16 +283,7 @@ func (h *applicationHandler) GetApplications(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
 	}
 
-	res, err := h.ApplicationServiceClient.GetApplications(ctx, &application_proto.GetApplicationsRequest{
-		InitiatorUuid:  utils.GetLocal[string](c, h.userUUIDKey),
-		CompanyUuid:    httpReq.CompanyUUID,
-		DepartmentUuid: httpReq.DepartmentUUID,
-		Statuses:       httpReq.Statuses,
-		Count:          httpReq.Count,
-		Offset:         httpReq.Offset,
-		IsDeleted:      httpReq.IsDeleted,
-		FromPool:       httpReq.FromPool,
-	})
+	res, err := h.ApplicationServiceClient.GetApplications(ctx, applicationsQuery(utils.GetLocal[string](c, h.userUUIDKey), httpReq))
 	if err != nil {
 		return Error.GRPCErrorToHTTP(err, c)
 	}
@@ -228,18 +294,226 @@ func (h *applicationHandler) GetApplications(c *fiber.Ctx) error {
 			ApplicationUUID: app.GetApplicationUuid(),
 			Title:           app.GetTitle(),
 			Status:          app.GetStatus(),
+			Priority:        app.GetPriority(),
+			DueAt:           app.GetDueAt(),
+			IsOverdue:       app.GetIsOverdue(),
+			SLABreach:       app.GetSlaBreach(),
+			RevisionCount:   app.GetRevisionCount(),
 			CreatedAt:       app.GetCreatedAt(),
 			UpdatedAt:       app.GetUpdatedAt(),
+			ClosedAt:        app.GetClosedAt(),
+			LocationUUID:    app.GetLocationUuid(),
+			CategoryUUID:    app.GetCategoryUuid(),
+			Severity:        app.GetSeverity(),
+			CustomFields:    customFieldsFromProto(app.GetCustomFields()),
 		})
 	}
 
-	return c.Status(fiber.StatusOK).JSON(&entities.GetApplicationsResponse{Applications: items})
+	return c.Status(fiber.StatusOK).JSON(&entities.GetApplicationsResponse{
+		Applications: items,
+		NextCursor:   res.GetNextCursor(),
+		TotalCount:   res.GetTotalCount(),
+	})
+}
+
+// applicationsQuery Запрос списка заявок с фильтрами из HTTP запроса (список и выгрузка)
+func applicationsQuery(initiatorUUID string, httpReq *entities.GetApplicationsRequest) *application_proto.GetApplicationsRequest {
+	customFields, _ := httpReq.CustomFieldValues() // формат уже проверен в Validate
+
+	return &application_proto.GetApplicationsRequest{
+		InitiatorUuid:    initiatorUUID,
+		CompanyUuid:      httpReq.CompanyUUID,
+		DepartmentUuid:   httpReq.DepartmentUUID,
+		Statuses:         httpReq.Statuses,
+		Count:            httpReq.Count,
+		Offset:           httpReq.Offset,
+		IsDeleted:        httpReq.IsDeleted,
+		FromPool:         httpReq.FromPool,
+		IsOverdue:        httpReq.IsOverdue,
+		Search:           httpReq.Search,
+		CreatedFrom:      httpReq.CreatedFrom,
+		CreatedTo:        httpReq.CreatedTo,
+		ClosedFrom:       httpReq.ClosedFrom,
+		ClosedTo:         httpReq.ClosedTo,
+		CreatedBy:        httpReq.CreatedBy,
+		ExecutedBy:       httpReq.ExecutedBy,
+		ManagedBy:        httpReq.ManagedBy,
+		RevisionCountMin: httpReq.RevisionCountMin,
+		RevisionCountMax: httpReq.RevisionCountMax,
+		LocationUuid:     httpReq.LocationUUID,
+		CategoryUuid:     httpReq.CategoryUUID,
+		Severities:       httpReq.Severities,
+		CustomFields:     customFieldsToProto(customFields),
+		SortBy:           httpReq.SortBy,
+		SortOrder:        httpReq.SortOrder,
+		Cursor:           httpReq.Cursor,
+	}
+}
+
+// WatchApplications
+//
+//	@Summary		Watch application updates
+//	@Description	Server-Sent Events stream of application changes. Visibility rules and filters are the same as for the applications list.
+//	@Description	Each change is sent as event "application" with entities.ApplicationUpdateEvent data; removed=true means the application no longer matches the filter.
+//	@Description	If the client reads too slowly, the stream ends with event "error" and the client should reconnect.
+//	@Tags			Application
+//	@Produce		text/event-stream
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid		path		string		true	"Company UUID"
+//	@Param			department_uuid		query		string		false	"Department UUID (chief/analytic only)"
+//	@Param			statuses			query		[]string	false	"Filter by statuses"
+//	@Param			is_deleted			query		bool		false	"Include deleted"
+//	@Param			from_pool			query		bool		false	"Pool view (inspector/manager)"
+//	@Param			is_overdue			query		bool		false	"Only applications with breached SLA"
+//	@Success		200					{object}	entities.ApplicationUpdateEvent
+//	@Failure		400					{object}	Error.HttpError
+//	@Failure		401					{object}	Error.HttpError
+//	@Failure		403					{object}	Error.HttpError
+//	@Failure		500					{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/applications/stream [get]
+func (h *applicationHandler) WatchApplications(c *fiber.Ctx) error {
+	httpReq := &entities.WatchApplicationsRequest{}
+	if err := c.QueryParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	// Поток живёт, пока клиент не отключится - без таймаута
+	ctx, cancel := context.WithCancel(c.UserContext())
+	ctx = h.outgoingContext(c, ctx)
+
+	stream, err := h.ApplicationServiceClient.WatchApplications(ctx, &application_proto.WatchApplicationsRequest{
+		InitiatorUuid:  utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:    httpReq.CompanyUUID,
+		DepartmentUuid: httpReq.DepartmentUUID,
+		Statuses:       httpReq.Statuses,
+		IsDeleted:      httpReq.IsDeleted,
+		FromPool:       httpReq.FromPool,
+		IsOverdue:      httpReq.IsOverdue,
+	})
+	if err != nil {
+		cancel()
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	// Сервис отправляет заголовки после проверки прав - до этого момента ошибку можно вернуть обычным HTTP ответом
+	if md, err := stream.Header(); err != nil || md == nil {
+		if err == nil {
+			_, err = stream.Recv()
+		}
+		cancel()
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer cancel()
+
+		updates := make(chan *application_proto.ApplicationUpdate)
+		recvErr := make(chan error, 1)
+		go func() {
+			for {
+				update, err := stream.Recv()
+				if err != nil {
+					recvErr <- err
+					return
+				}
+				select {
+				case updates <- update:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+
+		heartbeat := time.NewTicker(watchHeartbeatInterval)
+		defer heartbeat.Stop()
+
+		// Комментарий сразу после подключения - клиент видит, что поток открыт
+		if writeSSEComment(w, "connected") != nil {
+			return
+		}
+
+		for {
+			select {
+			case update := <-updates:
+				app := update.GetApplication()
+				if writeSSEEvent(w, "application", &entities.ApplicationUpdateEvent{
+					EventType:       update.GetEventType(),
+					ApplicationUUID: app.GetApplicationUuid(),
+					DepartmentUUID:  app.GetDepartmentUuid(),
+					Version:         app.GetVersion(),
+					Title:           app.GetTitle(),
+					Status:          app.GetStatus(),
+					Priority:        app.GetPriority(),
+					DueAt:           app.GetDueAt(),
+					IsOverdue:       app.GetIsOverdue(),
+					SLABreach:       app.GetSlaBreach(),
+					CreatedAt:       app.GetCreatedAt(),
+					UpdatedAt:       app.GetUpdatedAt(),
+					Removed:         update.GetRemoved(),
+				}) != nil {
+					return
+				}
+
+			case <-heartbeat.C:
+				// Ошибка записи - клиент отключился
+				if writeSSEComment(w, "ping") != nil {
+					return
+				}
+
+			case err := <-recvErr:
+				st := status.Convert(err)
+				switch st.Code() {
+				case codes.Canceled:
+				case codes.ResourceExhausted:
+					_ = writeSSEEvent(w, "error", &Error.HttpError{Code: fiber.StatusTooManyRequests, Message: st.Message()})
+				case codes.PermissionDenied, codes.NotFound:
+					// Сотрудника уволили или перевели - подписка больше недоступна
+					_ = writeSSEEvent(w, "error", &Error.HttpError{Code: fiber.StatusForbidden, Message: st.Message()})
+				default:
+					_ = writeSSEEvent(w, "error", &Error.HttpError{Code: fiber.StatusServiceUnavailable, Message: st.Message()})
+				}
+				return
+			}
+		}
+	})
+
+	return nil
+}
+
+// writeSSEEvent Запись SSE события с JSON данными
+func writeSSEEvent(w *bufio.Writer, event string, data any) error {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if _, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, body); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// writeSSEComment Запись SSE комментария (игнорируется клиентом)
+func writeSSEComment(w *bufio.Writer, comment string) error {
+	if _, err := fmt.Fprintf(w, ": %s\n\n", comment); err != nil {
+		return err
+	}
+	return w.Flush()
 }
 
 // UpdateApplicationStatus
 //
 //	@Summary		Update application status
-//	@Description	Update application status. Inspector: completed, failed, on_revision. Manager: rejected. Engineer: in_progress, on_hold, pending_verification.
+//	@Description	Update application status. Allowed transitions are defined by company workflow (see /auth/application/{application_uuid}/actions). By default inspector: completed, failed, on_revision. Manager: rejected. Engineer: in_progress, on_hold, pending_verification.
 //	@Tags			Application
 //	@Accept			json
 //	@Produce		json
@@ -251,14 +525,13 @@ func (h *applicationHandler) GetApplications(c *fiber.Ctx) error {
 //	@Failure		401					{object}	Error.HttpError
 //	@Failure		403					{object}	Error.HttpError
 //	@Failure		404					{object}	Error.HttpError
+//	@Failure		412					{object}	Error.HttpError
 //	@Failure		500					{object}	Error.HttpError
 //	@Router			/auth/application/{application_uuid}/status [patch]
 func (h *applicationHandler) UpdateApplicationStatus(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.UpdateApplicationStatusRequest{}
 	if err := c.BodyParser(httpReq); err != nil {
@@ -274,6 +547,7 @@ func (h *applicationHandler) UpdateApplicationStatus(c *fiber.Ctx) error {
 		InitiatorUuid:   utils.GetLocal[string](c, h.userUUIDKey),
 		ApplicationUuid: httpReq.ApplicationUUID,
 		Status:          httpReq.Status,
+		Message:         httpReq.Message,
 	})
 	if err != nil {
 		return Error.GRPCErrorToHTTP(err, c)
@@ -300,11 +574,9 @@ func (h *applicationHandler) UpdateApplicationStatus(c *fiber.Ctx) error {
 //	@Failure		500					{object}	Error.HttpError
 //	@Router			/auth/application/{application_uuid}/assign [patch]
 func (h *applicationHandler) AssignApplication(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.AssignApplicationRequest{}
 	if err := c.BodyParser(httpReq); err != nil {
@@ -346,11 +618,9 @@ func (h *applicationHandler) AssignApplication(c *fiber.Ctx) error {
 //	@Failure		500					{object}	Error.HttpError
 //	@Router			/auth/application/{application_uuid}/redirect [patch]
 func (h *applicationHandler) RedirectApplication(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.RedirectApplicationRequest{}
 	if err := c.BodyParser(httpReq); err != nil {
@@ -393,11 +663,9 @@ func (h *applicationHandler) RedirectApplication(c *fiber.Ctx) error {
 //	@Failure		500					{object}	Error.HttpError
 //	@Router			/auth/application/{application_uuid}/recall [patch]
 func (h *applicationHandler) RecallApplication(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.RecallApplicationRequest{}
 	if err := c.BodyParser(httpReq); err != nil {
@@ -437,11 +705,9 @@ func (h *applicationHandler) RecallApplication(c *fiber.Ctx) error {
 //	@Failure		500					{object}	Error.HttpError
 //	@Router			/auth/application/{application_uuid}/take-verification [patch]
 func (h *applicationHandler) TakeApplicationToVerification(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.TakeApplicationToVerificationRequest{
 		ApplicationUUID: c.Params("application_uuid", ""),
@@ -480,11 +746,9 @@ func (h *applicationHandler) TakeApplicationToVerification(c *fiber.Ctx) error {
 //	@Failure		500					{object}	Error.HttpError
 //	@Router			/auth/application/{application_uuid}/release-verification [patch]
 func (h *applicationHandler) ReleaseApplicationVerification(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.ReleaseApplicationVerificationRequest{}
 	if err := c.BodyParser(httpReq); err != nil {
@@ -526,11 +790,9 @@ func (h *applicationHandler) ReleaseApplicationVerification(c *fiber.Ctx) error
 //	@Failure		500					{object}	Error.HttpError
 //	@Router			/auth/application/{application_uuid}/fix-log [post]
 func (h *applicationHandler) AddApplicationFixLog(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.AddApplicationFixLogRequest{}
 	if err := c.BodyParser(httpReq); err != nil {
@@ -542,7 +804,7 @@ func (h *applicationHandler) AddApplicationFixLog(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
 	}
 
-	_, err := h.ApplicationServiceClient.AddApplicationFixLog(ctx, &application_proto.AddApplicationFixLogRequest{
+	res, err := h.ApplicationServiceClient.AddApplicationFixLog(ctx, &application_proto.AddApplicationFixLogRequest{
 		InitiatorUuid:   utils.GetLocal[string](c, h.userUUIDKey),
 		ApplicationUuid: httpReq.ApplicationUUID,
 		Message:         httpReq.Message,
@@ -551,7 +813,9 @@ func (h *applicationHandler) AddApplicationFixLog(c *fiber.Ctx) error {
 		return Error.GRPCErrorToHTTP(err, c)
 	}
 
-	return c.Status(fiber.StatusCreated).JSON(&entities.AddApplicationFixLogResponse{})
+	return c.Status(fiber.StatusCreated).JSON(&entities.AddApplicationFixLogResponse{
+		FixLogUUID: res.GetFixLogUuid(),
+	})
 }
 
 // DeleteApplication
@@ -572,11 +836,9 @@ func (h *applicationHandler) AddApplicationFixLog(c *fiber.Ctx) error {
 //	@Failure		500					{object}	Error.HttpError
 //	@Router			/auth/application/{application_uuid} [delete]
 func (h *applicationHandler) DeleteApplication(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.DeleteApplicationRequest{}
 	// body необязателен — игнорируем ошибку парсинга
@@ -609,6 +871,7 @@ func (h *applicationHandler) DeleteApplication(c *fiber.Ctx) error {
 //	@Param			application_uuid	path		string	true	"Application UUID"
 //	@Param			count				query		int		false	"Count"		default(10)
 //	@Param			offset				query		int		false	"Offset"	default(0)
+//	@Param			cursor				query		string	false	"Cursor from next_cursor of the previous page (instead of offset)"
 //	@Success		200					{object}	entities.GetApplicationHistoryResponse
 //	@Failure		400					{object}	Error.HttpError
 //	@Failure		401					{object}	Error.HttpError
@@ -617,11 +880,9 @@ func (h *applicationHandler) DeleteApplication(c *fiber.Ctx) error {
 //	@Failure		500					{object}	Error.HttpError
 //	@Router			/auth/application/{application_uuid}/history [get]
 func (h *applicationHandler) GetApplicationHistory(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.GetApplicationHistoryRequest{}
 	if err := c.QueryParser(httpReq); err != nil {
@@ -638,6 +899,7 @@ func (h *applicationHandler) GetApplicationHistory(c *fiber.Ctx) error {
 		ApplicationUuid: httpReq.ApplicationUUID,
 		Count:           httpReq.Count,
 		Offset:          httpReq.Offset,
+		Cursor:          httpReq.Cursor,
 	})
 	if err != nil {
 		return Error.GRPCErrorToHTTP(err, c)
@@ -649,10 +911,19 @@ func (h *applicationHandler) GetApplicationHistory(c *fiber.Ctx) error {
 			ApplicationUUID: app.GetApplicationUuid(),
 			CompanyUUID:     app.GetCompanyUuid(),
 			DepartmentUUID:  app.GetDepartmentUuid(),
+			LocationUUID:    app.GetLocationUuid(),
+			CategoryUUID:    app.GetCategoryUuid(),
+			Severity:        app.GetSeverity(),
+			CustomFields:    customFieldsFromProto(app.GetCustomFields()),
 			Version:         app.GetVersion(),
 			Title:           app.GetTitle(),
 			Description:     app.GetDescription(),
 			Status:          app.GetStatus(),
+			Priority:        app.GetPriority(),
+			DueAt:           app.GetDueAt(),
+			IsOverdue:       app.GetIsOverdue(),
+			SLABreach:       app.GetSlaBreach(),
+			OverdueAt:       app.GetOverdueAt(),
 			RevisionCount:   app.GetRevisionCount(),
 			CreatedAt:       app.GetCreatedAt(),
 			CreatedBy:       app.GetCreatedBy(),
@@ -668,6 +939,1653 @@ func (h *applicationHandler) GetApplicationHistory(c *fiber.Ctx) error {
 	}
 
 	return c.Status(fiber.StatusOK).JSON(&entities.GetApplicationHistoryResponse{
-		History: history,
+		History:    history,
+		NextCursor: res.GetNextCursor(),
+		TotalCount: res.GetTotalCount(),
+	})
+}
+
+// UploadApplicationAttachment
+//
+//	@Summary		Upload application attachment
+//	@Description	Upload a photo, PDF or drawing to an application (creator while status is "created", responsible engineer afterwards). With fix_log_uuid the file is attached to the engineer's fix log entry
+//	@Tags			Application
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			application_uuid	path		string	true	"Application UUID"
+//	@Param			file				formData	file	true	"Файл вложения (jpeg, png, webp, heic, pdf, dwg, dxf)"
+//	@Param			fix_log_uuid		formData	string	false	"Fix log UUID"
+//	@Success		201					{object}	entities.UploadApplicationAttachmentResponse
+//	@Failure		400					{object}	Error.HttpError
+//	@Failure		401					{object}	Error.HttpError
+//	@Failure		403					{object}	Error.HttpError
+//	@Failure		404					{object}	Error.HttpError
+//	@Failure		413					{object}	Error.HttpError
+//	@Failure		500					{object}	Error.HttpError
+//	@Router			/auth/application/{application_uuid}/attachments [post]
+func (h *applicationHandler) UploadApplicationAttachment(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*30)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "file missed"})
+	}
+
+	// Тип берём из multipart, при его отсутствии - по расширению файла
+	contentType, _, _ := mime.ParseMediaType(fileHeader.Header.Get(fiber.HeaderContentType))
+	if contentType == "" || contentType == fiber.MIMEOctetStream {
+		contentType, _, _ = mime.ParseMediaType(mime.TypeByExtension(strings.ToLower(filepath.Ext(fileHeader.Filename))))
+	}
+
+	httpReq := &entities.UploadApplicationAttachmentRequest{
+		ApplicationUUID: c.Params("application_uuid", ""),
+		FixLogUUID:      c.FormValue("fix_log_uuid", ""),
+		FileName:        filepath.Base(fileHeader.Filename),
+		ContentType:     contentType,
+		Size:            fileHeader.Size,
+	}
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid file"})
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid file"})
+	}
+
+	res, err := h.ApplicationServiceClient.UploadApplicationAttachment(ctx, &application_proto.UploadApplicationAttachmentRequest{
+		InitiatorUuid:   utils.GetLocal[string](c, h.userUUIDKey),
+		ApplicationUuid: httpReq.ApplicationUUID,
+		FixLogUuid:      httpReq.FixLogUUID,
+		FileName:        httpReq.FileName,
+		ContentType:     httpReq.ContentType,
+		Content:         content,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(&entities.UploadApplicationAttachmentResponse{
+		Attachment: attachmentToResponse(res.GetAttachment()),
 	})
 }
+
+// GetApplicationAttachment
+//
+//	@Summary		Download application attachment
+//	@Description	Download application attachment content (same access rules as getting the application)
+//	@Tags			Application
+//	@Produce		octet-stream
+//	@Security		ApiKeyAuth
+//	@Param			application_uuid	path		string	true	"Application UUID"
+//	@Param			attachment_uuid		path		string	true	"Attachment UUID"
+//	@Success		200					{file}		file
+//	@Failure		400					{object}	Error.HttpError
+//	@Failure		401					{object}	Error.HttpError
+//	@Failure		403					{object}	Error.HttpError
+//	@Failure		404					{object}	Error.HttpError
+//	@Failure		500					{object}	Error.HttpError
+//	@Router			/auth/application/{application_uuid}/attachments/{attachment_uuid} [get]
+func (h *applicationHandler) GetApplicationAttachment(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*30)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.GetApplicationAttachmentRequest{
+		ApplicationUUID: c.Params("application_uuid", ""),
+		AttachmentUUID:  c.Params("attachment_uuid", ""),
+	}
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.ApplicationServiceClient.GetApplicationAttachment(ctx, &application_proto.GetApplicationAttachmentRequest{
+		InitiatorUuid:   utils.GetLocal[string](c, h.userUUIDKey),
+		ApplicationUuid: httpReq.ApplicationUUID,
+		AttachmentUuid:  httpReq.AttachmentUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	c.Set(fiber.HeaderContentType, res.GetAttachment().GetContentType())
+	c.Set(fiber.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{
+		"filename": res.GetAttachment().GetFileName(),
+	}))
+
+	return c.Status(fiber.StatusOK).Send(res.GetContent())
+}
+
+// DeleteApplicationAttachment
+//
+//	@Summary		Delete application attachment
+//	@Description	Delete application attachment (only its author while still responsible for the application)
+//	@Tags			Application
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			application_uuid	path		string	true	"Application UUID"
+//	@Param			attachment_uuid		path		string	true	"Attachment UUID"
+//	@Success		200					{object}	entities.DeleteApplicationAttachmentResponse
+//	@Failure		400					{object}	Error.HttpError
+//	@Failure		401					{object}	Error.HttpError
+//	@Failure		403					{object}	Error.HttpError
+//	@Failure		404					{object}	Error.HttpError
+//	@Failure		500					{object}	Error.HttpError
+//	@Router			/auth/application/{application_uuid}/attachments/{attachment_uuid} [delete]
+func (h *applicationHandler) DeleteApplicationAttachment(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.DeleteApplicationAttachmentRequest{
+		ApplicationUUID: c.Params("application_uuid", ""),
+		AttachmentUUID:  c.Params("attachment_uuid", ""),
+	}
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	_, err := h.ApplicationServiceClient.DeleteApplicationAttachment(ctx, &application_proto.DeleteApplicationAttachmentRequest{
+		InitiatorUuid:   utils.GetLocal[string](c, h.userUUIDKey),
+		ApplicationUuid: httpReq.ApplicationUUID,
+		AttachmentUuid:  httpReq.AttachmentUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.DeleteApplicationAttachmentResponse{})
+}
+
+func attachmentToResponse(a *application_proto.Attachment) *entities.AttachmentResponse {
+	return &entities.AttachmentResponse{
+		AttachmentUUID:  a.GetAttachmentUuid(),
+		ApplicationUUID: a.GetApplicationUuid(),
+		FixLogUUID:      a.GetFixLogUuid(),
+		FileName:        a.GetFileName(),
+		ContentType:     a.GetContentType(),
+		Size:            a.GetSize(),
+		CreatedAt:       a.GetCreatedAt(),
+		CreatedBy:       a.GetCreatedBy(),
+	}
+}
+
+func attachmentsToResponse(attachments []*application_proto.Attachment) []*entities.AttachmentResponse {
+	if len(attachments) == 0 {
+		return nil
+	}
+	res := make([]*entities.AttachmentResponse, 0, len(attachments))
+	for _, a := range attachments {
+		res = append(res, attachmentToResponse(a))
+	}
+	return res
+}
+
+// CreateApplicationComment
+//
+//	@Summary		Create application comment
+//	@Description	Add a comment to the application or a reply to a top-level comment. Participants and chief can comment, mentioned users must be employees of the application's company who can see its comments
+//	@Tags			Application
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			application_uuid	path		string									true	"Application UUID"
+//	@Param			data				body		entities.CreateApplicationCommentRequest	true	"Текст комментария и упоминания"
+//	@Success		201					{object}	entities.CreateApplicationCommentResponse
+//	@Failure		400					{object}	Error.HttpError
+//	@Failure		401					{object}	Error.HttpError
+//	@Failure		403					{object}	Error.HttpError
+//	@Failure		404					{object}	Error.HttpError
+//	@Failure		412					{object}	Error.HttpError
+//	@Failure		500					{object}	Error.HttpError
+//	@Router			/auth/application/{application_uuid}/comments [post]
+func (h *applicationHandler) CreateApplicationComment(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.CreateApplicationCommentRequest{}
+	if err := c.BodyParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.ApplicationUUID = c.Params("application_uuid", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.ApplicationServiceClient.CreateApplicationComment(ctx, &application_proto.CreateApplicationCommentRequest{
+		InitiatorUuid:     utils.GetLocal[string](c, h.userUUIDKey),
+		ApplicationUuid:   httpReq.ApplicationUUID,
+		ParentCommentUuid: httpReq.ParentCommentUUID,
+		Text:              httpReq.Text,
+		MentionedUuids:    httpReq.MentionedUUIDs,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(&entities.CreateApplicationCommentResponse{
+		Comment: commentToResponse(res.GetComment()),
+	})
+}
+
+// GetApplicationComments
+//
+//	@Summary		Get application comments
+//	@Description	Get paginated comment threads of the application, oldest first, each with all its replies (same access rules as getting the application). Deleted comments keep their place in the thread without text
+//	@Tags			Application
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			application_uuid	path		string	true	"Application UUID"
+//	@Param			count				query		int		false	"Count"		default(10)
+//	@Param			offset				query		int		false	"Offset"	default(0)
+//	@Param			cursor				query		string	false	"Cursor from next_cursor of the previous page (instead of offset)"
+//	@Success		200					{object}	entities.GetApplicationCommentsResponse
+//	@Failure		400					{object}	Error.HttpError
+//	@Failure		401					{object}	Error.HttpError
+//	@Failure		403					{object}	Error.HttpError
+//	@Failure		404					{object}	Error.HttpError
+//	@Failure		500					{object}	Error.HttpError
+//	@Router			/auth/application/{application_uuid}/comments [get]
+func (h *applicationHandler) GetApplicationComments(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.GetApplicationCommentsRequest{}
+	if err := c.QueryParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.ApplicationUUID = c.Params("application_uuid", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.ApplicationServiceClient.GetApplicationComments(ctx, &application_proto.GetApplicationCommentsRequest{
+		InitiatorUuid:   utils.GetLocal[string](c, h.userUUIDKey),
+		ApplicationUuid: httpReq.ApplicationUUID,
+		Count:           httpReq.Count,
+		Offset:          httpReq.Offset,
+		Cursor:          httpReq.Cursor,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	comments := make([]*entities.CommentResponse, 0, len(res.GetComments()))
+	for _, comment := range res.GetComments() {
+		comments = append(comments, commentToResponse(comment))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.GetApplicationCommentsResponse{
+		Comments:   comments,
+		NextCursor: res.GetNextCursor(),
+		TotalCount: res.GetTotalCount(),
+	})
+}
+
+// UpdateApplicationComment
+//
+//	@Summary		Update application comment
+//	@Description	Edit comment text and mentions (author only). The previous version is kept in the comment history
+//	@Tags			Application
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			application_uuid	path		string									true	"Application UUID"
+//	@Param			comment_uuid		path		string									true	"Comment UUID"
+//	@Param			data				body		entities.UpdateApplicationCommentRequest	true	"Новый текст комментария и упоминания"
+//	@Success		200					{object}	entities.UpdateApplicationCommentResponse
+//	@Failure		400					{object}	Error.HttpError
+//	@Failure		401					{object}	Error.HttpError
+//	@Failure		403					{object}	Error.HttpError
+//	@Failure		404					{object}	Error.HttpError
+//	@Failure		412					{object}	Error.HttpError
+//	@Failure		500					{object}	Error.HttpError
+//	@Router			/auth/application/{application_uuid}/comments/{comment_uuid} [patch]
+func (h *applicationHandler) UpdateApplicationComment(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.UpdateApplicationCommentRequest{}
+	if err := c.BodyParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.ApplicationUUID = c.Params("application_uuid", "")
+	httpReq.CommentUUID = c.Params("comment_uuid", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	_, err := h.ApplicationServiceClient.UpdateApplicationComment(ctx, &application_proto.UpdateApplicationCommentRequest{
+		InitiatorUuid:   utils.GetLocal[string](c, h.userUUIDKey),
+		ApplicationUuid: httpReq.ApplicationUUID,
+		CommentUuid:     httpReq.CommentUUID,
+		Text:            httpReq.Text,
+		MentionedUuids:  httpReq.MentionedUUIDs,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.UpdateApplicationCommentResponse{})
+}
+
+// DeleteApplicationComment
+//
+//	@Summary		Delete application comment
+//	@Description	Soft-delete a comment (author or chief). Replies and edit history are kept
+//	@Tags			Application
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			application_uuid	path		string	true	"Application UUID"
+//	@Param			comment_uuid		path		string	true	"Comment UUID"
+//	@Success		200					{object}	entities.DeleteApplicationCommentResponse
+//	@Failure		400					{object}	Error.HttpError
+//	@Failure		401					{object}	Error.HttpError
+//	@Failure		403					{object}	Error.HttpError
+//	@Failure		404					{object}	Error.HttpError
+//	@Failure		412					{object}	Error.HttpError
+//	@Failure		500					{object}	Error.HttpError
+//	@Router			/auth/application/{application_uuid}/comments/{comment_uuid} [delete]
+func (h *applicationHandler) DeleteApplicationComment(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.DeleteApplicationCommentRequest{
+		ApplicationUUID: c.Params("application_uuid", ""),
+		CommentUUID:     c.Params("comment_uuid", ""),
+	}
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	_, err := h.ApplicationServiceClient.DeleteApplicationComment(ctx, &application_proto.DeleteApplicationCommentRequest{
+		InitiatorUuid:   utils.GetLocal[string](c, h.userUUIDKey),
+		ApplicationUuid: httpReq.ApplicationUUID,
+		CommentUuid:     httpReq.CommentUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.DeleteApplicationCommentResponse{})
+}
+
+// GetApplicationCommentHistory
+//
+//	@Summary		Get application comment history
+//	@Description	Get the current version of a comment and its previous versions, newest first (same access rules as getting the application; history of a deleted comment is available to chief only)
+//	@Tags			Application
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			application_uuid	path		string	true	"Application UUID"
+//	@Param			comment_uuid		path		string	true	"Comment UUID"
+//	@Success		200					{object}	entities.GetApplicationCommentHistoryResponse
+//	@Failure		400					{object}	Error.HttpError
+//	@Failure		401					{object}	Error.HttpError
+//	@Failure		403					{object}	Error.HttpError
+//	@Failure		404					{object}	Error.HttpError
+//	@Failure		500					{object}	Error.HttpError
+//	@Router			/auth/application/{application_uuid}/comments/{comment_uuid}/history [get]
+func (h *applicationHandler) GetApplicationCommentHistory(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.GetApplicationCommentHistoryRequest{
+		ApplicationUUID: c.Params("application_uuid", ""),
+		CommentUUID:     c.Params("comment_uuid", ""),
+	}
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.ApplicationServiceClient.GetApplicationCommentHistory(ctx, &application_proto.GetApplicationCommentHistoryRequest{
+		InitiatorUuid:   utils.GetLocal[string](c, h.userUUIDKey),
+		ApplicationUuid: httpReq.ApplicationUUID,
+		CommentUuid:     httpReq.CommentUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	edits := make([]*entities.CommentEditResponse, 0, len(res.GetEdits()))
+	for _, e := range res.GetEdits() {
+		edits = append(edits, &entities.CommentEditResponse{
+			Text:           e.GetText(),
+			MentionedUUIDs: e.GetMentionedUuids(),
+			EditedAt:       e.GetEditedAt(),
+			EditedBy:       e.GetEditedBy(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.GetApplicationCommentHistoryResponse{
+		Comment: commentToResponse(res.GetComment()),
+		Edits:   edits,
+	})
+}
+
+func commentToResponse(comment *application_proto.Comment) *entities.CommentResponse {
+	res := &entities.CommentResponse{
+		CommentUUID:       comment.GetCommentUuid(),
+		ApplicationUUID:   comment.GetApplicationUuid(),
+		ParentCommentUUID: comment.GetParentCommentUuid(),
+		Text:              comment.GetText(),
+		MentionedUUIDs:    comment.GetMentionedUuids(),
+		CreatedAt:         comment.GetCreatedAt(),
+		CreatedBy:         comment.GetCreatedBy(),
+		EditedAt:          comment.GetEditedAt(),
+		IsDeleted:         comment.GetIsDeleted(),
+		DeletedAt:         comment.GetDeletedAt(),
+		DeletedBy:         comment.GetDeletedBy(),
+	}
+	for _, reply := range comment.GetReplies() {
+		res.Replies = append(res.Replies, commentToResponse(reply))
+	}
+	return res
+}
+
+// GetApplicationActions
+//
+//	@Summary		Get available application actions
+//	@Description	Get workflow transitions the current user can perform on the application in its current status
+//	@Tags			Application
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			application_uuid	path		string	true	"Application UUID"
+//	@Success		200					{object}	entities.GetApplicationActionsResponse
+//	@Failure		400					{object}	Error.HttpError
+//	@Failure		401					{object}	Error.HttpError
+//	@Failure		403					{object}	Error.HttpError
+//	@Failure		404					{object}	Error.HttpError
+//	@Failure		500					{object}	Error.HttpError
+//	@Router			/auth/application/{application_uuid}/actions [get]
+func (h *applicationHandler) GetApplicationActions(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.GetApplicationActionsRequest{
+		ApplicationUUID: c.Params("application_uuid", ""),
+	}
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.ApplicationServiceClient.GetApplicationActions(ctx, &application_proto.GetApplicationActionsRequest{
+		InitiatorUuid:   utils.GetLocal[string](c, h.userUUIDKey),
+		ApplicationUuid: httpReq.ApplicationUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.GetApplicationActionsResponse{
+		Actions: workflowTransitionsFromProto(res.GetActions()),
+	})
+}
+
+// GetCompanyWorkflow
+//
+//	@Summary		Get company workflow
+//	@Description	Get application workflow of the company: statuses, transitions and roles allowed to perform them (any company employee)
+//	@Tags			Application
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Success		200				{object}	entities.GetCompanyWorkflowResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/workflow [get]
+func (h *applicationHandler) GetCompanyWorkflow(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.GetCompanyWorkflowRequest{
+		CompanyUUID: c.Params("company_uuid", ""),
+	}
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.ApplicationServiceClient.GetCompanyWorkflow(ctx, &application_proto.GetCompanyWorkflowRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.GetCompanyWorkflowResponse{
+		Workflow: &entities.Workflow{
+			States:      res.GetWorkflow().GetStates(),
+			Transitions: workflowTransitionsFromProto(res.GetWorkflow().GetTransitions()),
+		},
+		IsDefault: res.GetIsDefault(),
+		UpdatedAt: res.GetUpdatedAt(),
+		UpdatedBy: res.GetUpdatedBy(),
+	})
+}
+
+// UpdateCompanyWorkflow
+//
+//	@Summary		Update company workflow
+//	@Description	Replace application workflow of the company (chief only). A status can't be removed while company has applications in it.
+//	@Tags			Application
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string									true	"Company UUID"
+//	@Param			data			body		entities.UpdateCompanyWorkflowRequest	true	"Workflow"
+//	@Success		200				{object}	entities.UpdateCompanyWorkflowResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		412				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/workflow [put]
+func (h *applicationHandler) UpdateCompanyWorkflow(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.UpdateCompanyWorkflowRequest{}
+	if err := c.BodyParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	transitions := make([]*application_proto.WorkflowTransition, 0, len(httpReq.Workflow.Transitions))
+	for _, t := range httpReq.Workflow.Transitions {
+		transitions = append(transitions, &application_proto.WorkflowTransition{
+			Name:           t.Name,
+			Action:         t.Action,
+			From:           t.From,
+			To:             t.To,
+			Roles:          t.Roles,
+			Guard:          t.Guard,
+			RequireMessage: t.RequireMessage,
+		})
+	}
+
+	_, err := h.ApplicationServiceClient.UpdateCompanyWorkflow(ctx, &application_proto.UpdateCompanyWorkflowRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+		Workflow: &application_proto.Workflow{
+			States:      httpReq.Workflow.States,
+			Transitions: transitions,
+		},
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.UpdateCompanyWorkflowResponse{})
+}
+
+// ResetCompanyWorkflow
+//
+//	@Summary		Reset company workflow
+//	@Description	Return the company to the default application workflow (chief only)
+//	@Tags			Application
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Success		200				{object}	entities.ResetCompanyWorkflowResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/workflow [delete]
+func (h *applicationHandler) ResetCompanyWorkflow(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.ResetCompanyWorkflowRequest{
+		CompanyUUID: c.Params("company_uuid", ""),
+	}
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	_, err := h.ApplicationServiceClient.ResetCompanyWorkflow(ctx, &application_proto.ResetCompanyWorkflowRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.ResetCompanyWorkflowResponse{})
+}
+
+// GetCompanySLAPolicies
+//
+//	@Summary		Get company SLA policies
+//	@Description	Get SLA policies of the company by application priority (any company employee). 0 hours means no deadline.
+//	@Tags			Application
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Success		200				{object}	entities.GetCompanySLAPoliciesResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/sla [get]
+func (h *applicationHandler) GetCompanySLAPolicies(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.GetCompanySLAPoliciesRequest{
+		CompanyUUID: c.Params("company_uuid", ""),
+	}
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.ApplicationServiceClient.GetCompanySLAPolicies(ctx, &application_proto.GetCompanySLAPoliciesRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	policies := make([]*entities.SLAPolicy, 0, len(res.GetPolicies()))
+	for _, p := range res.GetPolicies() {
+		policies = append(policies, &entities.SLAPolicy{
+			Priority:                p.GetPriority(),
+			AssignWithinHours:       p.GetAssignWithinHours(),
+			VerificationWithinHours: p.GetVerificationWithinHours(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.GetCompanySLAPoliciesResponse{Policies: policies})
+}
+
+// UpdateCompanySLAPolicy
+//
+//	@Summary		Update company SLA policy
+//	@Description	Create or replace SLA policy for the application priority (chief only). New deadlines apply to open applications too.
+//	@Tags			Application
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string									true	"Company UUID"
+//	@Param			priority		path		string									true	"Priority (low, normal, high, critical)"
+//	@Param			data			body		entities.UpdateCompanySLAPolicyRequest	true	"Deadlines in hours"
+//	@Success		200				{object}	entities.UpdateCompanySLAPolicyResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/sla/{priority} [put]
+func (h *applicationHandler) UpdateCompanySLAPolicy(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.UpdateCompanySLAPolicyRequest{}
+	if err := c.BodyParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
+	httpReq.Priority = c.Params("priority", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	_, err := h.ApplicationServiceClient.UpdateCompanySLAPolicy(ctx, &application_proto.UpdateCompanySLAPolicyRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+		Policy: &application_proto.SLAPolicy{
+			Priority:                httpReq.Priority,
+			AssignWithinHours:       httpReq.AssignWithinHours,
+			VerificationWithinHours: httpReq.VerificationWithinHours,
+		},
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.UpdateCompanySLAPolicyResponse{})
+}
+
+// DeleteCompanySLAPolicy
+//
+//	@Summary		Delete company SLA policy
+//	@Description	Remove SLA policy for the application priority (chief only)
+//	@Tags			Application
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Param			priority		path		string	true	"Priority (low, normal, high, critical)"
+//	@Success		200				{object}	entities.DeleteCompanySLAPolicyResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/sla/{priority} [delete]
+func (h *applicationHandler) DeleteCompanySLAPolicy(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.DeleteCompanySLAPolicyRequest{
+		CompanyUUID: c.Params("company_uuid", ""),
+		Priority:    c.Params("priority", ""),
+	}
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	_, err := h.ApplicationServiceClient.DeleteCompanySLAPolicy(ctx, &application_proto.DeleteCompanySLAPolicyRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+		Priority:      httpReq.Priority,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.DeleteCompanySLAPolicyResponse{})
+}
+
+// GetApplicationCategories
+//
+//	@Summary		Get application categories
+//	@Description	Get defect categories of the company (any company employee)
+//	@Tags			Application
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Success		200				{object}	entities.GetApplicationCategoriesResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/categories [get]
+func (h *applicationHandler) GetApplicationCategories(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.GetApplicationCategoriesRequest{
+		CompanyUUID: c.Params("company_uuid", ""),
+	}
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.ApplicationServiceClient.GetApplicationCategories(ctx, &application_proto.GetApplicationCategoriesRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	categories := make([]*entities.ApplicationCategory, 0, len(res.GetCategories()))
+	for _, category := range res.GetCategories() {
+		categories = append(categories, &entities.ApplicationCategory{
+			CategoryUUID:          category.GetCategoryUuid(),
+			Title:                 category.GetTitle(),
+			DefaultDepartmentUUID: category.GetDefaultDepartmentUuid(),
+			CreatedAt:             category.GetCreatedAt(),
+			CreatedBy:             category.GetCreatedBy(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.GetApplicationCategoriesResponse{Categories: categories})
+}
+
+// CreateApplicationCategory
+//
+//	@Summary		Create application category
+//	@Description	Create defect category (chief only). New applications of the category are routed to its default department if it is set.
+//	@Tags			Application
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string										true	"Company UUID"
+//	@Param			data			body		entities.CreateApplicationCategoryRequest	true	"Category"
+//	@Success		201				{object}	entities.CreateApplicationCategoryResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		409				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/categories [post]
+func (h *applicationHandler) CreateApplicationCategory(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.CreateApplicationCategoryRequest{}
+	if err := c.BodyParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.ApplicationServiceClient.CreateApplicationCategory(ctx, &application_proto.CreateApplicationCategoryRequest{
+		InitiatorUuid:         utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:           httpReq.CompanyUUID,
+		Title:                 httpReq.Title,
+		DefaultDepartmentUuid: httpReq.DefaultDepartmentUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(&entities.CreateApplicationCategoryResponse{CategoryUUID: res.GetCategoryUuid()})
+}
+
+// UpdateApplicationCategory
+//
+//	@Summary		Update application category
+//	@Description	Rename defect category or change its default department (chief only). Existing applications are not moved.
+//	@Tags			Application
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string										true	"Company UUID"
+//	@Param			category_uuid	path		string										true	"Category UUID"
+//	@Param			data			body		entities.UpdateApplicationCategoryRequest	true	"Category"
+//	@Success		200				{object}	entities.UpdateApplicationCategoryResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		409				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/categories/{category_uuid} [patch]
+func (h *applicationHandler) UpdateApplicationCategory(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.UpdateApplicationCategoryRequest{}
+	if err := c.BodyParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
+	httpReq.CategoryUUID = c.Params("category_uuid", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	_, err := h.ApplicationServiceClient.UpdateApplicationCategory(ctx, &application_proto.UpdateApplicationCategoryRequest{
+		InitiatorUuid:         utils.GetLocal[string](c, h.userUUIDKey),
+		CategoryUuid:          httpReq.CategoryUUID,
+		Title:                 httpReq.Title,
+		DefaultDepartmentUuid: httpReq.DefaultDepartmentUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.UpdateApplicationCategoryResponse{})
+}
+
+// DeleteApplicationCategory
+//
+//	@Summary		Delete application category
+//	@Description	Delete defect category that is not used by any application (chief only)
+//	@Tags			Application
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Param			category_uuid	path		string	true	"Category UUID"
+//	@Success		200				{object}	entities.DeleteApplicationCategoryResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		412				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/categories/{category_uuid} [delete]
+func (h *applicationHandler) DeleteApplicationCategory(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.DeleteApplicationCategoryRequest{
+		CompanyUUID:  c.Params("company_uuid", ""),
+		CategoryUUID: c.Params("category_uuid", ""),
+	}
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	_, err := h.ApplicationServiceClient.DeleteApplicationCategory(ctx, &application_proto.DeleteApplicationCategoryRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CategoryUuid:  httpReq.CategoryUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.DeleteApplicationCategoryResponse{})
+}
+
+// GetCompanyCustomFields
+//
+//	@Summary		Get company custom fields
+//	@Description	Get additional application fields defined by the company (any company employee)
+//	@Tags			Application
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Success		200				{object}	entities.GetCompanyCustomFieldsResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/custom-fields [get]
+func (h *applicationHandler) GetCompanyCustomFields(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.GetCompanyCustomFieldsRequest{
+		CompanyUUID: c.Params("company_uuid", ""),
+	}
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.ApplicationServiceClient.GetCompanyCustomFields(ctx, &application_proto.GetCompanyCustomFieldsRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	fields := make([]*entities.CustomField, 0, len(res.GetFields()))
+	for _, field := range res.GetFields() {
+		fields = append(fields, &entities.CustomField{
+			Key:      field.GetKey(),
+			Title:    field.GetTitle(),
+			Type:     field.GetType(),
+			Options:  field.GetOptions(),
+			Required: field.GetRequired(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.GetCompanyCustomFieldsResponse{Fields: fields})
+}
+
+// UpdateCompanyCustomField
+//
+//	@Summary		Update company custom field
+//	@Description	Create or replace additional application field (chief only). Type of an existing field can not be changed.
+//	@Tags			Application
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string									true	"Company UUID"
+//	@Param			key				path		string									true	"Field key (a-z, 0-9, _)"
+//	@Param			data			body		entities.UpdateCompanyCustomFieldRequest	true	"Field definition"
+//	@Success		200				{object}	entities.UpdateCompanyCustomFieldResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		412				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/custom-fields/{key} [put]
+func (h *applicationHandler) UpdateCompanyCustomField(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.UpdateCompanyCustomFieldRequest{}
+	if err := c.BodyParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
+	httpReq.Key = c.Params("key", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	_, err := h.ApplicationServiceClient.UpdateCompanyCustomField(ctx, &application_proto.UpdateCompanyCustomFieldRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+		Field: &application_proto.CustomField{
+			Key:      httpReq.Key,
+			Title:    httpReq.Title,
+			Type:     httpReq.Type,
+			Options:  httpReq.Options,
+			Required: httpReq.Required,
+		},
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.UpdateCompanyCustomFieldResponse{})
+}
+
+// DeleteCompanyCustomField
+//
+//	@Summary		Delete company custom field
+//	@Description	Remove additional application field (chief only). Values already saved in applications are kept.
+//	@Tags			Application
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Param			key				path		string	true	"Field key"
+//	@Success		200				{object}	entities.DeleteCompanyCustomFieldResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/custom-fields/{key} [delete]
+func (h *applicationHandler) DeleteCompanyCustomField(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.DeleteCompanyCustomFieldRequest{
+		CompanyUUID: c.Params("company_uuid", ""),
+		Key:         c.Params("key", ""),
+	}
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	_, err := h.ApplicationServiceClient.DeleteCompanyCustomField(ctx, &application_proto.DeleteCompanyCustomFieldRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+		Key:           httpReq.Key,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.DeleteCompanyCustomFieldResponse{})
+}
+
+func customFieldsToProto(values map[string]string) []*application_proto.CustomFieldValue {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	res := make([]*application_proto.CustomFieldValue, 0, len(keys))
+	for _, key := range keys {
+		res = append(res, &application_proto.CustomFieldValue{Key: key, Value: values[key]})
+	}
+	return res
+}
+
+func customFieldsFromProto(values []*application_proto.CustomFieldValue) map[string]string {
+	res := make(map[string]string, len(values))
+	for _, v := range values {
+		res[v.GetKey()] = v.GetValue()
+	}
+	return res
+}
+
+func workflowTransitionsFromProto(transitions []*application_proto.WorkflowTransition) []*entities.WorkflowTransition {
+	res := make([]*entities.WorkflowTransition, 0, len(transitions))
+	for _, t := range transitions {
+		res = append(res, &entities.WorkflowTransition{
+			Name:           t.GetName(),
+			Action:         t.GetAction(),
+			From:           t.GetFrom(),
+			To:             t.GetTo(),
+			Roles:          t.GetRoles(),
+			Guard:          t.GetGuard(),
+			RequireMessage: t.GetRequireMessage(),
+		})
+	}
+	return res
+}
+
+// GetApplicationStatusStats
+//
+//	@Summary		Get application status stats
+//	@Description	Count applications by status and department, grouped by creation period (chief/analytic only)
+//	@Tags			Analytics
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Param			department_uuid	query		string	false	"Department UUID"
+//	@Param			created_from	query		string	false	"Created at or after (RFC3339 or YYYY-MM-DD)"
+//	@Param			created_to		query		string	false	"Created before (RFC3339 or YYYY-MM-DD)"
+//	@Param			interval		query		string	false	"Group period"	Enums(day, week, month)	default(day)
+//	@Success		200				{object}	entities.GetApplicationStatusStatsResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/analytics/statuses [get]
+func (h *applicationHandler) GetApplicationStatusStats(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.AnalyticsRequest{}
+	if err := c.QueryParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.ApplicationServiceClient.GetApplicationStatusStats(ctx, &application_proto.GetApplicationStatusStatsRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+		Filter:        analyticsFilter(httpReq),
+		Interval:      httpReq.Interval,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	stats := make([]*entities.StatusStat, 0, len(res.GetStats()))
+	for _, stat := range res.GetStats() {
+		stats = append(stats, &entities.StatusStat{
+			Period:         stat.GetPeriod(),
+			DepartmentUUID: stat.GetDepartmentUuid(),
+			Status:         stat.GetStatus(),
+			Count:          stat.GetCount(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.GetApplicationStatusStatsResponse{Stats: stats})
+}
+
+// GetApplicationLeadTimes
+//
+//	@Summary		Get application lead times
+//	@Description	Mean time to assign, fix and verify applications in seconds, restored from application history (chief/analytic only)
+//	@Tags			Analytics
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Param			department_uuid	query		string	false	"Department UUID"
+//	@Param			created_from	query		string	false	"Created at or after (RFC3339 or YYYY-MM-DD)"
+//	@Param			created_to		query		string	false	"Created before (RFC3339 or YYYY-MM-DD)"
+//	@Success		200				{object}	entities.GetApplicationLeadTimesResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/analytics/lead-times [get]
+func (h *applicationHandler) GetApplicationLeadTimes(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.AnalyticsRequest{}
+	if err := c.QueryParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.ApplicationServiceClient.GetApplicationLeadTimes(ctx, &application_proto.GetApplicationLeadTimesRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+		Filter:        analyticsFilter(httpReq),
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.GetApplicationLeadTimesResponse{
+		Assign: leadTime(res.GetAssign()),
+		Fix:    leadTime(res.GetFix()),
+		Verify: leadTime(res.GetVerify()),
+	})
+}
+
+// GetEngineerRevisionStats
+//
+//	@Summary		Get engineer revision stats
+//	@Description	Revision count and rate per engineer (chief/analytic only)
+//	@Tags			Analytics
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Param			department_uuid	query		string	false	"Department UUID"
+//	@Param			created_from	query		string	false	"Created at or after (RFC3339 or YYYY-MM-DD)"
+//	@Param			created_to		query		string	false	"Created before (RFC3339 or YYYY-MM-DD)"
+//	@Success		200				{object}	entities.GetEngineerRevisionStatsResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/analytics/revisions [get]
+func (h *applicationHandler) GetEngineerRevisionStats(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.AnalyticsRequest{}
+	if err := c.QueryParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.ApplicationServiceClient.GetEngineerRevisionStats(ctx, &application_proto.GetEngineerRevisionStatsRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+		Filter:        analyticsFilter(httpReq),
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	stats := make([]*entities.EngineerRevisionStat, 0, len(res.GetStats()))
+	for _, stat := range res.GetStats() {
+		stats = append(stats, &entities.EngineerRevisionStat{
+			EngineerUUID:        stat.GetEngineerUuid(),
+			Applications:        stat.GetApplications(),
+			RevisedApplications: stat.GetRevisedApplications(),
+			Revisions:           stat.GetRevisions(),
+			RevisionRate:        stat.GetRevisionRate(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.GetEngineerRevisionStatsResponse{Stats: stats})
+}
+
+// GetDepartmentFailureStats
+//
+//	@Summary		Get department failure stats
+//	@Description	Completed and failed applications per department and failure rate among closed ones (chief/analytic only)
+//	@Tags			Analytics
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Param			department_uuid	query		string	false	"Department UUID"
+//	@Param			created_from	query		string	false	"Created at or after (RFC3339 or YYYY-MM-DD)"
+//	@Param			created_to		query		string	false	"Created before (RFC3339 or YYYY-MM-DD)"
+//	@Success		200				{object}	entities.GetDepartmentFailureStatsResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/analytics/failures [get]
+func (h *applicationHandler) GetDepartmentFailureStats(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.AnalyticsRequest{}
+	if err := c.QueryParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.ApplicationServiceClient.GetDepartmentFailureStats(ctx, &application_proto.GetDepartmentFailureStatsRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+		Filter:        analyticsFilter(httpReq),
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	stats := make([]*entities.DepartmentFailureStat, 0, len(res.GetStats()))
+	for _, stat := range res.GetStats() {
+		stats = append(stats, &entities.DepartmentFailureStat{
+			DepartmentUUID: stat.GetDepartmentUuid(),
+			Closed:         stat.GetClosed(),
+			Completed:      stat.GetCompleted(),
+			Failed:         stat.GetFailed(),
+			FailureRate:    stat.GetFailureRate(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.GetDepartmentFailureStatsResponse{Stats: stats})
+}
+
+// analyticsFilter Общие параметры аналитических запросов
+func analyticsFilter(httpReq *entities.AnalyticsRequest) *application_proto.AnalyticsFilter {
+	return &application_proto.AnalyticsFilter{
+		DepartmentUuid: httpReq.DepartmentUUID,
+		CreatedFrom:    httpReq.CreatedFrom,
+		CreatedTo:      httpReq.CreatedTo,
+	}
+}
+
+func leadTime(t *application_proto.LeadTime) *entities.LeadTime {
+	return &entities.LeadTime{MeanSeconds: t.GetMeanSeconds(), Samples: t.GetSamples()}
+}
+
+// ExportApplications
+//
+//	@Summary		Export applications
+//	@Description	Stream CSV or XLSX file with company applications, their fix logs and status timeline (only chief/analytic).
+//	@Description	Filters are the same as for the applications list; user UUIDs are replaced with full names.
+//	@Tags			Application
+//	@Produce		text/csv
+//	@Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid		path		string		true	"Company UUID"
+//	@Param			format				query		string		false	"File format"	Enums(csv, xlsx)	default(csv)
+//	@Param			department_uuid		query		string		false	"Department UUID"
+//	@Param			statuses			query		[]string	false	"Filter by statuses"
+//	@Param			is_deleted			query		bool		false	"Include deleted"
+//	@Param			is_overdue			query		bool		false	"Only applications with breached SLA"
+//	@Param			search				query		string		false	"Full-text search by title, description and fix logs"
+//	@Param			created_from		query		string		false	"Created at or after (RFC3339 or YYYY-MM-DD)"
+//	@Param			created_to			query		string		false	"Created before (RFC3339 or YYYY-MM-DD)"
+//	@Param			closed_from			query		string		false	"Closed at or after (RFC3339 or YYYY-MM-DD)"
+//	@Param			closed_to			query		string		false	"Closed before (RFC3339 or YYYY-MM-DD)"
+//	@Param			created_by			query		string		false	"Creator UUID"
+//	@Param			executed_by			query		string		false	"Executor UUID"
+//	@Param			managed_by			query		string		false	"Manager UUID"
+//	@Param			revision_count_min	query		int			false	"Min revision count"
+//	@Param			revision_count_max	query		int			false	"Max revision count"
+//	@Param			location_uuid		query		string		false	"Location UUID (applications in it and in all nested locations)"
+//	@Param			category_uuid		query		string		false	"Defect category UUID"
+//	@Param			severities			query		[]string	false	"Filter by severities (minor, moderate, major, critical)"
+//	@Param			custom_fields		query		[]string	false	"Filter by custom field values in key:value format (exact match)"
+//	@Param			sort_order			query		string		false	"Sort order by creation date"	Enums(asc, desc)	default(desc)
+//	@Success		200					{file}		file
+//	@Failure		400					{object}	Error.HttpError
+//	@Failure		401					{object}	Error.HttpError
+//	@Failure		403					{object}	Error.HttpError
+//	@Failure		500					{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/applications/export [get]
+func (h *applicationHandler) ExportApplications(c *fiber.Ctx) error {
+	operationID := utils.GetLocal[string](c, h.operationIDKey)
+
+	httpReq := &entities.ExportApplicationsRequest{}
+	if err := c.QueryParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	// Выгрузка длится, пока сервис не отдаст все заявки - без таймаута
+	ctx, cancel := context.WithCancel(c.UserContext())
+	ctx = h.outgoingContext(c, ctx)
+
+	stream, err := h.ApplicationServiceClient.ExportApplications(ctx, &application_proto.ExportApplicationsRequest{
+		Query: applicationsQuery(utils.GetLocal[string](c, h.userUUIDKey), &httpReq.GetApplicationsRequest),
+	})
+	if err != nil {
+		cancel()
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	// Первую заявку получаем до начала ответа - отказ в доступе и ошибки фильтров возвращаются обычным HTTP ответом
+	first, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		cancel()
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	filename := fmt.Sprintf("applications_%s.%s", time.Now().UTC().Format("20060102_150405"), httpReq.Format)
+	c.Set(fiber.HeaderContentType, exportContentTypes[httpReq.Format])
+	c.Set(fiber.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer cancel()
+
+		out, err := newExportWriter(w, httpReq.Format)
+		if err != nil {
+			return
+		}
+		users := newUserNames(ctx, h.AuthServiceClient)
+
+		if err = out.Write(exportColumns); err != nil {
+			return
+		}
+		for app, rows := first, 0; app != nil; rows++ {
+			if err = out.Write(exportRow(app, users)); err != nil {
+				return
+			}
+			// Периодически отдаём накопленное клиенту, чтобы загрузка шла по мере чтения заявок
+			if rows%exportFlushRows == 0 {
+				if out.Flush() != nil || w.Flush() != nil {
+					return
+				}
+			}
+
+			app, err = stream.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				// Статус ответа уже отправлен - обрываем файл, чтобы клиент не принял его за полный
+				log.Error().Str("id", operationID).Str("method", "ExportApplications").Err(err).Msg("export interrupted")
+				return
+			}
+		}
+
+		if out.Close() == nil {
+			_ = w.Flush()
+		}
+	})
+
+	return nil
+}
+
+// exportFlushRows Кол-во строк выгрузки между отправками данных клиенту
+const exportFlushRows = 100
+
+// exportContentTypes Content-Type файла выгрузки по формату
+var exportContentTypes = map[string]string{
+	"csv":  "text/csv; charset=utf-8",
+	"xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+// exportColumns Заголовок файла выгрузки заявок
+var exportColumns = []string{
+	"application_uuid", "department_uuid", "location_uuid", "category_uuid", "severity", "title", "description", "status", "priority", "revision_count",
+	"created_at", "created_by", "updated_at", "updated_by", "managed_by", "executed_by", "inspected_by",
+	"due_at", "sla_breach", "overdue_at", "closed_at", "deleted_at", "deleted_by", "fix_logs", "status_timeline",
+}
+
+// exportRow Строка выгрузки: fix log-и и история статусов - многострочные ячейки, по строке на запись
+func exportRow(exported *application_proto.ExportedApplication, users *userNames) []string {
+	app := exported.GetApplication()
+
+	fixLogs := make([]string, 0, len(app.GetFixLogs()))
+	for _, fl := range app.GetFixLogs() {
+		fixLogs = append(fixLogs, fmt.Sprintf("%s %s: %s", fl.GetCreatedAt(), users.name(fl.GetCreatedBy()), fl.GetText()))
+	}
+	timeline := make([]string, 0, len(exported.GetTimeline()))
+	for _, change := range exported.GetTimeline() {
+		timeline = append(timeline, fmt.Sprintf("%s %s (%s)", change.GetChangedAt(), change.GetStatus(), users.name(change.GetChangedBy())))
+	}
+
+	return []string{
+		app.GetApplicationUuid(),
+		app.GetDepartmentUuid(),
+		app.GetLocationUuid(),
+		app.GetCategoryUuid(),
+		app.GetSeverity(),
+		app.GetTitle(),
+		app.GetDescription(),
+		app.GetStatus(),
+		app.GetPriority(),
+		strconv.FormatInt(app.GetRevisionCount(), 10),
+		app.GetCreatedAt(),
+		users.name(app.GetCreatedBy()),
+		app.GetUpdatedAt(),
+		users.name(app.GetUpdatedBy()),
+		users.name(app.GetManagedBy()),
+		users.name(app.GetExecutedBy()),
+		users.name(app.GetInspectedBy()),
+		app.GetDueAt(),
+		app.GetSlaBreach(),
+		app.GetOverdueAt(),
+		app.GetClosedAt(),
+		app.GetDeletedAt(),
+		users.name(app.GetDeletedBy()),
+		strings.Join(fixLogs, "\n"),
+		strings.Join(timeline, "\n"),
+	}
+}
+
+// exportWriter Построчная запись файла выгрузки
+type exportWriter interface {
+	Write(record []string) error
+	Flush() error
+	Close() error
+}
+
+func newExportWriter(w io.Writer, format string) (exportWriter, error) {
+	if format == "xlsx" {
+		return xlsx.NewWriter(w, "Applications")
+	}
+	return csvexport.NewWriter(w), nil
+}
+
+// userNames Кэш ФИО пользователей на время одной выгрузки - один запрос в auth сервис на пользователя
+type userNames struct {
+	ctx    context.Context
+	client auth_proto.AuthServiceClient
+	names  map[string]string
+}
+
+func newUserNames(ctx context.Context, client auth_proto.AuthServiceClient) *userNames {
+	return &userNames{ctx: ctx, client: client, names: make(map[string]string)}
+}
+
+// name ФИО пользователя; если пользователь не найден - его uuid
+func (u *userNames) name(userUUID string) string {
+	if userUUID == "" {
+		return ""
+	}
+	if name, found := u.names[userUUID]; found {
+		return name
+	}
+
+	ctx, cancel := context.WithTimeout(u.ctx, time.Second*5)
+	defer cancel()
+
+	name := userUUID
+	if res, err := u.client.GetUser(ctx, &auth_proto.GetUserRequest{UserUuid: userUUID}); err == nil {
+		fullName := strings.Join(strings.Fields(res.GetLastName()+" "+res.GetFirstName()+" "+res.GetPatronymic()), " ")
+		if fullName != "" {
+			name = fullName
+		}
+	}
+	u.names[userUUID] = name
+	return name
+}
+
+// GetEmployeeOpenApplications
+//
+//	@Summary		Get employee open applications
+//	@Description	Open applications that will be affected by removing the employee, moving them out of a department or changing their role (chief only).
+//	@Description	Use the same department_uuid / new_role as in the planned change; open_applications policy of that change decides what happens to them.
+//	@Tags			Employee
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Param			employee_uuid	path		string	true	"Employee UUID"
+//	@Param			department_uuid	query		string	false	"Department the employee is removed from"
+//	@Param			new_role		query		string	false	"New employee role"
+//	@Success		200				{object}	entities.GetEmployeeOpenApplicationsResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/employee/{employee_uuid}/open-applications [get]
+func (h *applicationHandler) GetEmployeeOpenApplications(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.GetEmployeeOpenApplicationsRequest{}
+	if err := c.QueryParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
+	httpReq.EmployeeUUID = c.Params("employee_uuid", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.ApplicationServiceClient.GetEmployeeOpenApplications(ctx, &application_proto.GetEmployeeOpenApplicationsRequest{
+		InitiatorUuid:  utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:    httpReq.CompanyUUID,
+		UserUuid:       httpReq.EmployeeUUID,
+		DepartmentUuid: httpReq.DepartmentUUID,
+		NewRole:        httpReq.NewRole,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	applications := make([]*entities.EmployeeOpenApplication, 0, len(res.GetApplications()))
+	for _, app := range res.GetApplications() {
+		applications = append(applications, &entities.EmployeeOpenApplication{
+			ApplicationUUID: app.GetApplicationUuid(),
+			DepartmentUUID:  app.GetDepartmentUuid(),
+			Title:           app.GetTitle(),
+			Status:          app.GetStatus(),
+			Relations:       app.GetRelations(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.GetEmployeeOpenApplicationsResponse{Applications: applications})
+}
@@ -9,11 +9,10 @@ import (
 	company_proto "github.com/unwelcome/FrameWorkTask1/backend/contracts/company/generated"
 	"github.com/unwelcome/FrameWorkTask1/backend/gateway/internal/entities"
 	Error "github.com/unwelcome/FrameWorkTask1/backend/gateway/internal/errors"
+	"github.com/unwelcome/FrameWorkTask1/backend/gateway/pkg/jwks"
 	"github.com/unwelcome/FrameWorkTask1/backend/gateway/pkg/session"
 	"github.com/unwelcome/FrameWorkTask1/backend/gateway/pkg/utils"
 	"github.com/unwelcome/FrameWorkTask1/backend/shared/format"
-	"github.com/unwelcome/FrameWorkTask1/backend/shared/interceptors"
-	"google.golang.org/grpc/metadata"
 )
 
 type AuthHandler interface {
@@ -36,24 +35,28 @@ type AuthHandler interface {
 	ResetPassword(c *fiber.Ctx) error
 	Verify2FA(c *fiber.Ctx) error
 	UpdateUser2FA(c *fiber.Ctx) error
+	EnrollTOTP(c *fiber.Ctx) error
+	ConfirmTOTP(c *fiber.Ctx) error
+	Send2FAEmailCode(c *fiber.Ctx) error
 	RestoreAccount(c *fiber.Ctx) error
+	JWKS(c *fiber.Ctx) error
 }
 
 type authHandler struct {
 	AuthServiceClient    auth_proto.AuthServiceClient
 	CompanyServiceClient company_proto.CompanyServiceClient
-	operationIDKey       string
-	userUUIDKey          string
 	session              *session.Provider
+	jwks                 *jwks.Cache
+	requestKeys
 }
 
-func NewAuthHandler(authServiceClient auth_proto.AuthServiceClient, companyServiceClient company_proto.CompanyServiceClient, operationIDKey, userUUIDKey string, sessionProvider *session.Provider) AuthHandler {
+func NewAuthHandler(authServiceClient auth_proto.AuthServiceClient, companyServiceClient company_proto.CompanyServiceClient, operationIDKey, userUUIDKey string, sessionProvider *session.Provider, jwksCache *jwks.Cache) AuthHandler {
 	return &authHandler{
 		AuthServiceClient:    authServiceClient,
 		CompanyServiceClient: companyServiceClient,
-		operationIDKey:       operationIDKey,
-		userUUIDKey:          userUUIDKey,
+		requestKeys:          requestKeys{operationIDKey: operationIDKey, userUUIDKey: userUUIDKey},
 		session:              sessionProvider,
+		jwks:                 jwksCache,
 	}
 }
 
@@ -71,11 +74,9 @@ func NewAuthHandler(authServiceClient auth_proto.AuthServiceClient, companyServi
 //	@Failure      500  {object}  Error.HttpError
 //	@Router       /register [post]
 func (h *authHandler) Register(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.RegisterRequest{}
 	if err := c.BodyParser(&httpReq); err != nil {
@@ -103,7 +104,7 @@ func (h *authHandler) Register(c *fiber.Ctx) error {
 // Login
 //
 //	@Summary      Login
-//	@Description  Авторизация пользователя. Если включена 2FA - возвращает session_uuid и отправляет письмо на почту, данные необходимо передать в Verify2FA; Если 2FA выключена - возвращает user_uuid и пару токенов
+//	@Description  Авторизация пользователя. Если включена 2FA - возвращает session_uuid и two_factor_method (email - код отправлен на почту, totp - код из приложения-аутентификатора), данные необходимо передать в Verify2FA; Если 2FA выключена - возвращает user_uuid и пару токенов
 //	@Tags         Auth
 //	@Accept 			json
 //	@Produce 			json
@@ -115,11 +116,9 @@ func (h *authHandler) Register(c *fiber.Ctx) error {
 //	@Failure      500  {object}  Error.HttpError
 //	@Router       /login [post]
 func (h *authHandler) Login(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	// Парсит тело запроса
 	httpReq := &entities.LoginRequest{}
@@ -148,10 +147,11 @@ func (h *authHandler) Login(c *fiber.Ctx) error {
 
 	// Формируем тело ответа
 	httpRes := &entities.LoginResponse{
-		UserUUID:     res.GetUserUuid(),
-		AccessToken:  res.GetAccessToken(),
-		RefreshToken: res.GetRefreshToken(),
-		SessionUUID:  res.GetSessionUuid(),
+		UserUUID:        res.GetUserUuid(),
+		AccessToken:     res.GetAccessToken(),
+		RefreshToken:    res.GetRefreshToken(),
+		SessionUUID:     res.GetSessionUuid(),
+		TwoFactorMethod: res.GetTwoFactorMethod(),
 	}
 
 	return c.Status(fiber.StatusOK).JSON(httpRes)
@@ -173,11 +173,9 @@ func (h *authHandler) Login(c *fiber.Ctx) error {
 //	@Failure      500  {object}  Error.HttpError
 //	@Router       /auth/user/{user_uuid}/info [get]
 func (h *authHandler) GetUser(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	// Получаем UserUUID из параметров
 	httpReq := &entities.GetUserRequest{
@@ -246,11 +244,9 @@ func (h *authHandler) GetUser(c *fiber.Ctx) error {
 //	@Failure      500  {object}  Error.HttpError
 //	@Router       /auth/user/password [patch]
 func (h *authHandler) ChangePassword(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	// Парсит тело запроса
 	httpReq := &entities.ChangePasswordRequest{}
@@ -300,11 +296,9 @@ func (h *authHandler) ChangePassword(c *fiber.Ctx) error {
 //	@Failure      500  {object}  Error.HttpError
 //	@Router       /auth/user/bio [patch]
 func (h *authHandler) UpdateUserBio(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	// Парсит тело запроса
 	httpReq := &entities.UpdateUserBioRequest{}
@@ -353,11 +347,9 @@ func (h *authHandler) UpdateUserBio(c *fiber.Ctx) error {
 //	@Failure      500  {object}  Error.HttpError
 //	@Router       /auth/user/account [delete]
 func (h *authHandler) DeleteUser(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	req := &auth_proto.DeleteUserRequest{
 		UserUuid: utils.GetLocal[string](c, h.userUUIDKey),
@@ -384,11 +376,9 @@ func (h *authHandler) DeleteUser(c *fiber.Ctx) error {
 //	@Failure      500  {object}  Error.HttpError
 //	@Router       /auth/user/sessions [get]
 func (h *authHandler) GetAllActiveSessions(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	// Формируем тело запроса
 	req := &auth_proto.GetAllActiveSessionsRequest{
@@ -442,11 +432,9 @@ func (h *authHandler) GetAllActiveSessions(c *fiber.Ctx) error {
 //	@Failure      500  {object}  Error.HttpError
 //	@Router       /refresh [post]
 func (h *authHandler) RefreshToken(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	// Парсит тело запроса
 	httpReq := &entities.RefreshTokenRequest{}
@@ -497,11 +485,9 @@ func (h *authHandler) RefreshToken(c *fiber.Ctx) error {
 //	@Failure      500  {object}  Error.HttpError
 //	@Router       /auth/user/session [delete]
 func (h *authHandler) RevokeSession(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	// Парсит тело запроса
 	httpReq := &entities.RevokeSessionRequest{}
@@ -549,11 +535,9 @@ func (h *authHandler) RevokeSession(c *fiber.Ctx) error {
 //	@Failure      500  {object}  Error.HttpError
 //	@Router       /user/verify [post]
 func (h *authHandler) VerifyAccount(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.VerifyAccountRequest{}
 	if err := c.BodyParser(httpReq); err != nil {
@@ -590,11 +574,9 @@ func (h *authHandler) VerifyAccount(c *fiber.Ctx) error {
 //	@Failure      500  {object}  Error.HttpError
 //	@Router       /user/verify/resend [post]
 func (h *authHandler) ResendVerificationCode(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.ResendVerificationCodeRequest{}
 	if err := c.BodyParser(httpReq); err != nil {
@@ -632,11 +614,9 @@ func (h *authHandler) ResendVerificationCode(c *fiber.Ctx) error {
 //	@Failure      501  {object}  Error.HttpError
 //	@Router       /debug/user/email/{email}/verification-token [get]
 func (h *authHandler) GetVerificationToken(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	res, err := h.AuthServiceClient.GetVerificationToken(ctx, &auth_proto.GetVerificationTokenRequest{
 		Email: c.Params("email", ""),
@@ -661,11 +641,9 @@ func (h *authHandler) GetVerificationToken(c *fiber.Ctx) error {
 //	@Failure      501  {object}  Error.HttpError
 //	@Router       /debug/user/email/{email}/reset-password-token [get]
 func (h *authHandler) GetRecoveryCode(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	res, err := h.AuthServiceClient.GetResetPasswordToken(ctx, &auth_proto.GetResetPasswordTokenRequest{
 		Email: c.Params("email", ""),
@@ -690,11 +668,9 @@ func (h *authHandler) GetRecoveryCode(c *fiber.Ctx) error {
 //	@Failure      501  {object}  Error.HttpError
 //	@Router       /debug/2fa/{session_uuid}/code [get]
 func (h *authHandler) Get2FACode(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	req := &auth_proto.Get2FACodeRequest{
 		SessionUuid: c.Params("session_uuid", ""),
@@ -721,11 +697,9 @@ func (h *authHandler) Get2FACode(c *fiber.Ctx) error {
 //	@Failure      500  {object}  Error.HttpError
 //	@Router       /forgot-password [post]
 func (h *authHandler) ForgotPassword(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.ForgotPasswordRequest{}
 	if err := c.BodyParser(httpReq); err != nil {
@@ -760,11 +734,9 @@ func (h *authHandler) ForgotPassword(c *fiber.Ctx) error {
 //	@Failure      500  {object}  Error.HttpError
 //	@Router       /reset-password [post]
 func (h *authHandler) ResetPassword(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.ResetPasswordRequest{}
 	if err := c.BodyParser(httpReq); err != nil {
@@ -798,11 +770,9 @@ func (h *authHandler) ResetPassword(c *fiber.Ctx) error {
 //	@Failure      500  {object}  Error.HttpError
 //	@Router       /auth/user/sessions [delete]
 func (h *authHandler) RevokeAllSessions(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	// Формируем тело запроса
 	req := &auth_proto.RevokeAllSessionsRequest{
@@ -824,10 +794,10 @@ func (h *authHandler) RevokeAllSessions(c *fiber.Ctx) error {
 // Verify2FA
 //
 //	@Summary      Verify2FA
-//	@Description  Verification confirmation with 2FA enabled
+//	@Description  Verification confirmation with 2FA enabled. method: email / totp / recovery (one-time recovery code), defaults to the method returned by Login
 //	@Tags         Auth
 //	@Produce 			json
-//	@Param 				data body entities.Verify2FARequest true "SessionUUID and email code"
+//	@Param 				data body entities.Verify2FARequest true "SessionUUID, code and method"
 //	@Success      200  {object}  entities.Verify2FAResponse
 //	@Failure      400  {object}  Error.HttpError
 //	@Failure      404  {object}  Error.HttpError
@@ -835,11 +805,9 @@ func (h *authHandler) RevokeAllSessions(c *fiber.Ctx) error {
 //	@Failure      500  {object}  Error.HttpError
 //	@Router       /verify-2fa [post]
 func (h *authHandler) Verify2FA(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.Verify2FARequest{}
 	if err := c.BodyParser(httpReq); err != nil {
@@ -854,6 +822,7 @@ func (h *authHandler) Verify2FA(c *fiber.Ctx) error {
 	res, err := h.AuthServiceClient.Verify2FA(ctx, &auth_proto.Verify2FARequest{
 		SessionUuid: httpReq.SessionUUID,
 		Code:        httpReq.Code,
+		Method:      httpReq.Method,
 		Session:     h.session.Extract(c),
 	})
 	if err != nil {
@@ -870,7 +839,7 @@ func (h *authHandler) Verify2FA(c *fiber.Ctx) error {
 // UpdateUser2FA
 //
 //	@Summary      UpdateUser2FA
-//	@Description  Enable / disable 2FA
+//	@Description  Enable / disable 2FA and select the primary method (email / totp). TOTP must be enrolled first via /auth/user/2fa/totp
 //	@Tags         User
 //	@Produce 			json
 //	@Param 				data body entities.UpdateUser2FARequest true "Body"
@@ -879,14 +848,13 @@ func (h *authHandler) Verify2FA(c *fiber.Ctx) error {
 //	@Failure      401  {object}  Error.HttpError
 //	@Failure      403  {object}  Error.HttpError
 //	@Failure      404  {object}  Error.HttpError
+//	@Failure      412  {object}  Error.HttpError
 //	@Failure      500  {object}  Error.HttpError
 //	@Router       /auth/user/2fa [patch]
 func (h *authHandler) UpdateUser2FA(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.UpdateUser2FARequest{}
 	if err := c.BodyParser(httpReq); err != nil {
@@ -902,6 +870,7 @@ func (h *authHandler) UpdateUser2FA(c *fiber.Ctx) error {
 	_, err := h.AuthServiceClient.UpdateUser2FA(ctx, &auth_proto.UpdateUser2FARequest{
 		UserUuid:   httpReq.UserUUID,
 		Enable_2Fa: httpReq.Enable2FA,
+		Method:     httpReq.Method,
 	})
 	if err != nil {
 		return Error.GRPCErrorToHTTP(err, c)
@@ -910,6 +879,127 @@ func (h *authHandler) UpdateUser2FA(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(&entities.UpdateUser2FAResponse{})
 }
 
+// EnrollTOTP
+//
+//	@Summary      EnrollTOTP
+//	@Description  Start authenticator app enrollment: returns a TOTP secret and otpauth URI for a QR code. The secret takes effect after ConfirmTOTP
+//	@Tags         User
+//	@Produce 			json
+//	@Security 		ApiKeyAuth
+//	@Success      200  {object}  entities.EnrollTOTPResponse
+//	@Failure      400  {object}  Error.HttpError
+//	@Failure      401  {object}  Error.HttpError
+//	@Failure      403  {object}  Error.HttpError
+//	@Failure      404  {object}  Error.HttpError
+//	@Failure      500  {object}  Error.HttpError
+//	@Router       /auth/user/2fa/totp [post]
+func (h *authHandler) EnrollTOTP(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.EnrollTOTPRequest{
+		UserUUID: utils.GetLocal[string](c, h.userUUIDKey),
+	}
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.AuthServiceClient.EnrollTOTP(ctx, &auth_proto.EnrollTOTPRequest{
+		UserUuid: httpReq.UserUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.EnrollTOTPResponse{
+		Secret:     res.GetSecret(),
+		OtpauthURI: res.GetOtpauthUri(),
+	})
+}
+
+// ConfirmTOTP
+//
+//	@Summary      ConfirmTOTP
+//	@Description  Confirm authenticator app enrollment with a code from the app. Enables 2FA with TOTP and returns one-time recovery codes (shown only once)
+//	@Tags         User
+//	@Accept 			json
+//	@Produce 			json
+//	@Security 		ApiKeyAuth
+//	@Param 				data body entities.ConfirmTOTPRequest true "Code from the authenticator app"
+//	@Success      200  {object}  entities.ConfirmTOTPResponse
+//	@Failure      400  {object}  Error.HttpError
+//	@Failure      401  {object}  Error.HttpError
+//	@Failure      404  {object}  Error.HttpError
+//	@Failure      429  {object}  Error.HttpError
+//	@Failure      500  {object}  Error.HttpError
+//	@Router       /auth/user/2fa/totp/confirm [post]
+func (h *authHandler) ConfirmTOTP(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.ConfirmTOTPRequest{}
+	if err := c.BodyParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+
+	httpReq.UserUUID = utils.GetLocal[string](c, h.userUUIDKey)
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.AuthServiceClient.ConfirmTOTP(ctx, &auth_proto.ConfirmTOTPRequest{
+		UserUuid: httpReq.UserUUID,
+		Code:     httpReq.Code,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.ConfirmTOTPResponse{
+		RecoveryCodes: res.GetRecoveryCodes(),
+	})
+}
+
+// Send2FAEmailCode
+//
+//	@Summary      Send2FAEmailCode
+//	@Description  Email fallback for 2FA: sends a code to the account email for the login session started by Login. Verify it with method=email
+//	@Tags         Auth
+//	@Accept 			json
+//	@Produce 			json
+//	@Param 				data body entities.Send2FAEmailCodeRequest true "SessionUUID from Login"
+//	@Success      200  {object}  entities.Send2FAEmailCodeResponse
+//	@Failure      400  {object}  Error.HttpError
+//	@Failure      404  {object}  Error.HttpError
+//	@Failure      429  {object}  Error.HttpError
+//	@Failure      500  {object}  Error.HttpError
+//	@Router       /2fa/email-code [post]
+func (h *authHandler) Send2FAEmailCode(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.Send2FAEmailCodeRequest{}
+	if err := c.BodyParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	_, err := h.AuthServiceClient.Send2FAEmailCode(ctx, &auth_proto.Send2FAEmailCodeRequest{
+		SessionUuid: httpReq.SessionUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.Send2FAEmailCodeResponse{})
+}
+
 // RestoreAccount
 //
 //	@Summary      RestoreAccount
@@ -925,11 +1015,9 @@ func (h *authHandler) UpdateUser2FA(c *fiber.Ctx) error {
 //	@Failure      500  {object}  Error.HttpError
 //	@Router       /restore-account [post]
 func (h *authHandler) RestoreAccount(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.RestoreAccountRequest{}
 	if err := c.BodyParser(httpReq); err != nil {
@@ -949,3 +1037,10 @@ func (h *authHandler) RestoreAccount(c *fiber.Ctx) error {
 
 	return c.Status(fiber.StatusOK).JSON(&entities.RestoreAccountResponse{})
 }
+
+// JWKS Публичные ключи подписи токенов из кеша gateway.
+// Маршрут /.well-known/jwks.json находится вне /api, поэтому не описан в swagger.
+func (h *authHandler) JWKS(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderCacheControl, "public, max-age=300")
+	return c.Status(fiber.StatusOK).JSON(h.jwks.Set())
+}
@@ -5,12 +5,11 @@ import (
 	"time"
 
 	"github.com/gofiber/fiber/v2"
+	auth_proto "github.com/unwelcome/FrameWorkTask1/backend/contracts/auth/generated"
 	company_proto "github.com/unwelcome/FrameWorkTask1/backend/contracts/company/generated"
 	"github.com/unwelcome/FrameWorkTask1/backend/gateway/internal/entities"
 	Error "github.com/unwelcome/FrameWorkTask1/backend/gateway/internal/errors"
 	"github.com/unwelcome/FrameWorkTask1/backend/gateway/pkg/utils"
-	"github.com/unwelcome/FrameWorkTask1/backend/shared/interceptors"
-	"google.golang.org/grpc/metadata"
 )
 
 type CompanyHandler interface {
@@ -20,16 +19,35 @@ type CompanyHandler interface {
 	GetUserCompanies(c *fiber.Ctx) error
 	UpdateCompanyTitle(c *fiber.Ctx) error
 	UpdateCompanyStatus(c *fiber.Ctx) error
+	UpdateCompanyJoinSettings(c *fiber.Ctx) error
 	DeleteCompany(c *fiber.Ctx) error
+	GetCompanyDeletionStatus(c *fiber.Ctx) error
+	RestoreCompany(c *fiber.Ctx) error
 	CreateCompanyJoinCode(c *fiber.Ctx) error
 	GetCompanyJoinCodes(c *fiber.Ctx) error
 	DeleteCompanyJoinCode(c *fiber.Ctx) error
+	GetJoinCodeRedemptions(c *fiber.Ctx) error
 	JoinCompany(c *fiber.Ctx) error
+	GetCompanyJoinRequests(c *fiber.Ctx) error
+	AcceptCompanyJoinRequest(c *fiber.Ctx) error
+	DeclineCompanyJoinRequest(c *fiber.Ctx) error
+	CreateCompanyInvitation(c *fiber.Ctx) error
+	GetCompanyInvitations(c *fiber.Ctx) error
+	RevokeCompanyInvitation(c *fiber.Ctx) error
+	GetUserInvitations(c *fiber.Ctx) error
+	AcceptCompanyInvitation(c *fiber.Ctx) error
+	DeclineCompanyInvitation(c *fiber.Ctx) error
 	GetCompanyEmployee(c *fiber.Ctx) error
 	GetCompanyEmployees(c *fiber.Ctx) error
 	GetCompanyEmployeesSummary(c *fiber.Ctx) error
 	UpdateEmployeeRole(c *fiber.Ctx) error
 	RemoveCompanyEmployee(c *fiber.Ctx) error
+	LeaveCompany(c *fiber.Ctx) error
+	TransferCompanyOwnership(c *fiber.Ctx) error
+	GetCompanyOwnershipTransfer(c *fiber.Ctx) error
+	AcceptCompanyOwnershipTransfer(c *fiber.Ctx) error
+	DeclineCompanyOwnershipTransfer(c *fiber.Ctx) error
+	CancelCompanyOwnershipTransfer(c *fiber.Ctx) error
 	CreateDepartment(c *fiber.Ctx) error
 	GetDepartment(c *fiber.Ctx) error
 	GetCompanyDepartments(c *fiber.Ctx) error
@@ -37,16 +55,22 @@ type CompanyHandler interface {
 	DeleteDepartment(c *fiber.Ctx) error
 	AddEmployeeToDepartment(c *fiber.Ctx) error
 	RemoveEmployeeFromDepartment(c *fiber.Ctx) error
+	CreateLocation(c *fiber.Ctx) error
+	GetLocation(c *fiber.Ctx) error
+	GetCompanyLocations(c *fiber.Ctx) error
+	UpdateLocation(c *fiber.Ctx) error
+	DeleteLocation(c *fiber.Ctx) error
+	GetCompanyAuditLog(c *fiber.Ctx) error
 }
 
 type companyHandler struct {
 	CompanyServiceClient company_proto.CompanyServiceClient
-	operationIDKey       string
-	userUUIDKey          string
+	AuthServiceClient    auth_proto.AuthServiceClient
+	requestKeys
 }
 
-func NewCompanyHandler(CompanyServiceClient company_proto.CompanyServiceClient, operationIDKey string, userUUIDKey string) CompanyHandler {
-	return &companyHandler{CompanyServiceClient: CompanyServiceClient, operationIDKey: operationIDKey, userUUIDKey: userUUIDKey}
+func NewCompanyHandler(CompanyServiceClient company_proto.CompanyServiceClient, AuthServiceClient auth_proto.AuthServiceClient, operationIDKey string, userUUIDKey string) CompanyHandler {
+	return &companyHandler{CompanyServiceClient: CompanyServiceClient, AuthServiceClient: AuthServiceClient, requestKeys: requestKeys{operationIDKey: operationIDKey, userUUIDKey: userUUIDKey}}
 }
 
 // CreateCompany
@@ -64,11 +88,9 @@ func NewCompanyHandler(CompanyServiceClient company_proto.CompanyServiceClient,
 //	@Failure		500		{object}	Error.HttpError
 //	@Router			/auth/company/create [post]
 func (h *companyHandler) CreateCompany(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.CreateCompanyRequest{}
 	if err := c.BodyParser(httpReq); err != nil {
@@ -109,11 +131,9 @@ func (h *companyHandler) CreateCompany(c *fiber.Ctx) error {
 //	@Failure		500				{object}	Error.HttpError
 //	@Router			/auth/company/{company_uuid} [get]
 func (h *companyHandler) GetCompany(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.GetCompanyRequest{
 		CompanyUUID: c.Params("company_uuid", ""),
@@ -131,10 +151,13 @@ func (h *companyHandler) GetCompany(c *fiber.Ctx) error {
 		return Error.GRPCErrorToHTTP(err, c)
 	}
 
+	joinApprovalRequired := res.GetJoinApprovalRequired()
 	return c.Status(fiber.StatusOK).JSON(&entities.GetCompanyResponse{
-		CompanyUUID: res.GetCompanyUuid(),
-		Title:       res.GetTitle(),
-		Status:      res.GetStatus(),
+		CompanyUUID:          res.GetCompanyUuid(),
+		Title:                res.GetTitle(),
+		Status:               res.GetStatus(),
+		JoinApprovalRequired: &joinApprovalRequired,
+		OwnerUUID:            res.GetOwnerUuid(),
 	})
 }
 
@@ -147,17 +170,16 @@ func (h *companyHandler) GetCompany(c *fiber.Ctx) error {
 //	@Security		ApiKeyAuth
 //	@Param			offset	query		int	false	"Offset"	default(0)
 //	@Param			count	query		int	false	"Count"		default(10)
+//	@Param			cursor	query		string	false	"Cursor from next_cursor of the previous page (instead of offset)"
 //	@Success		200		{object}	entities.GetCompaniesResponse
 //	@Failure		400		{object}	Error.HttpError
 //	@Failure		401		{object}	Error.HttpError
 //	@Failure		500		{object}	Error.HttpError
 //	@Router			/auth/company/list [get]
 func (h *companyHandler) GetCompanies(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.GetCompaniesRequest{}
 	if err := c.QueryParser(httpReq); err != nil {
@@ -171,6 +193,7 @@ func (h *companyHandler) GetCompanies(c *fiber.Ctx) error {
 	res, err := h.CompanyServiceClient.GetCompanies(ctx, &company_proto.GetCompaniesRequest{
 		Offset: httpReq.Offset,
 		Count:  httpReq.Count,
+		Cursor: httpReq.Cursor,
 	})
 	if err != nil {
 		return Error.GRPCErrorToHTTP(err, c)
@@ -185,7 +208,11 @@ func (h *companyHandler) GetCompanies(c *fiber.Ctx) error {
 		})
 	}
 
-	return c.Status(fiber.StatusOK).JSON(&entities.GetCompaniesResponse{Companies: companies})
+	return c.Status(fiber.StatusOK).JSON(&entities.GetCompaniesResponse{
+		Companies:  companies,
+		NextCursor: res.GetNextCursor(),
+		TotalCount: res.GetTotalCount(),
+	})
 }
 
 // GetUserCompanies
@@ -200,11 +227,9 @@ func (h *companyHandler) GetCompanies(c *fiber.Ctx) error {
 //	@Failure		500	{object}	Error.HttpError
 //	@Router			/auth/company/my [get]
 func (h *companyHandler) GetUserCompanies(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	res, err := h.CompanyServiceClient.GetUserCompanies(ctx, &company_proto.GetUserCompaniesRequest{
 		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
@@ -243,11 +268,9 @@ func (h *companyHandler) GetUserCompanies(c *fiber.Ctx) error {
 //	@Failure		500				{object}	Error.HttpError
 //	@Router			/auth/company/{company_uuid}/title [patch]
 func (h *companyHandler) UpdateCompanyTitle(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.UpdateCompanyTitleRequest{}
 	if err := c.BodyParser(httpReq); err != nil {
@@ -289,11 +312,9 @@ func (h *companyHandler) UpdateCompanyTitle(c *fiber.Ctx) error {
 //	@Failure		500				{object}	Error.HttpError
 //	@Router			/auth/company/{company_uuid}/status [patch]
 func (h *companyHandler) UpdateCompanyStatus(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.UpdateCompanyStatusRequest{}
 	if err := c.BodyParser(httpReq); err != nil {
@@ -317,10 +338,54 @@ func (h *companyHandler) UpdateCompanyStatus(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(&entities.UpdateCompanyStatusResponse{})
 }
 
+// UpdateCompanyJoinSettings
+//
+//	@Summary		Update company join settings
+//	@Description	Enable or disable chief approval for joining by code (chief only). When enabled, join by code creates a pending join request
+//	@Tags			Company
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string										true	"Company UUID"
+//	@Param			data			body		entities.UpdateCompanyJoinSettingsRequest	true	"Параметры запроса"
+//	@Success		200				{object}	entities.UpdateCompanyJoinSettingsResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/join-settings [patch]
+func (h *companyHandler) UpdateCompanyJoinSettings(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.UpdateCompanyJoinSettingsRequest{}
+	if err := c.BodyParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	_, err := h.CompanyServiceClient.UpdateCompanyJoinSettings(ctx, &company_proto.UpdateCompanyJoinSettingsRequest{
+		InitiatorUuid:        utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:          httpReq.CompanyUUID,
+		JoinApprovalRequired: httpReq.JoinApprovalRequired,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.UpdateCompanyJoinSettingsResponse{})
+}
+
 // DeleteCompany
 //
 //	@Summary		Delete company
-//	@Description	Delete company by company uuid (chief only)
+//	@Description	Delete company by company uuid (chief only). Company is hidden immediately and can be restored until purge_after, then its applications are archived, join codes removed and company data deleted in background
 //	@Tags			Company
 //	@Produce		json
 //	@Security		ApiKeyAuth
@@ -333,11 +398,9 @@ func (h *companyHandler) UpdateCompanyStatus(c *fiber.Ctx) error {
 //	@Failure		500				{object}	Error.HttpError
 //	@Router			/auth/company/{company_uuid} [delete]
 func (h *companyHandler) DeleteCompany(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.DeleteCompanyRequest{
 		CompanyUUID: c.Params("company_uuid", ""),
@@ -358,10 +421,99 @@ func (h *companyHandler) DeleteCompany(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(&entities.DeleteCompanyResponse{})
 }
 
+// GetCompanyDeletionStatus
+//
+//	@Summary		Get company deletion status
+//	@Description	Get company deletion progress (deletion initiator or company chief). Initiator can see it after company data is deleted
+//	@Tags			Company
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Success		200				{object}	entities.GetCompanyDeletionStatusResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/deletion [get]
+func (h *companyHandler) GetCompanyDeletionStatus(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.CompanyDeletionRequest{
+		CompanyUUID: c.Params("company_uuid", ""),
+	}
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.CompanyServiceClient.GetCompanyDeletionStatus(ctx, &company_proto.GetCompanyDeletionStatusRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.GetCompanyDeletionStatusResponse{
+		Step:                 res.GetStep(),
+		RequestedBy:          res.GetRequestedBy(),
+		RequestedAt:          res.GetRequestedAt(),
+		PurgeAfter:           res.GetPurgeAfter(),
+		ArchiveKey:           res.GetArchiveKey(),
+		ArchivedApplications: res.GetArchivedApplications(),
+		Attempts:             res.GetAttempts(),
+		LastError:            res.GetLastError(),
+		CompletedAt:          res.GetCompletedAt(),
+	})
+}
+
+// RestoreCompany
+//
+//	@Summary		Restore deleted company
+//	@Description	Cancel company deletion (chief only). Possible until purge_after, after that company data deletion has started
+//	@Tags			Company
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Success		200				{object}	entities.RestoreCompanyResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		412				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/restore [post]
+func (h *companyHandler) RestoreCompany(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.CompanyDeletionRequest{
+		CompanyUUID: c.Params("company_uuid", ""),
+	}
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	_, err := h.CompanyServiceClient.RestoreCompany(ctx, &company_proto.RestoreCompanyRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.RestoreCompanyResponse{})
+}
+
 // CreateCompanyJoinCode
 //
 //	@Summary		Create join company code
-//	@Description	Create code for users to join company (chief only). Min: 60s, Max: 604800s (1 week)
+//	@Description	Create code for users to join company (chief only). Min: 60s, Max: 604800s (1 week). max_uses limits how many users can redeem the code (0 - unlimited, max 1000)
 //	@Tags			Company
 //	@Accept			json
 //	@Produce		json
@@ -376,11 +528,9 @@ func (h *companyHandler) DeleteCompany(c *fiber.Ctx) error {
 //	@Failure		500				{object}	Error.HttpError
 //	@Router			/auth/company/{company_uuid}/code [post]
 func (h *companyHandler) CreateCompanyJoinCode(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.CreateCompanyJoinCodeRequest{}
 	if err := c.BodyParser(httpReq); err != nil {
@@ -396,6 +546,7 @@ func (h *companyHandler) CreateCompanyJoinCode(c *fiber.Ctx) error {
 		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
 		CompanyUuid:   httpReq.CompanyUUID,
 		CodeTtl:       httpReq.CodeTTL,
+		MaxUses:       httpReq.MaxUses,
 	})
 	if err != nil {
 		return Error.GRPCErrorToHTTP(err, c)
@@ -409,7 +560,7 @@ func (h *companyHandler) CreateCompanyJoinCode(c *fiber.Ctx) error {
 // GetCompanyJoinCodes
 //
 //	@Summary		Get all company join codes
-//	@Description	Get all company join codes (chief only)
+//	@Description	Get all company join codes with usage counters (chief only)
 //	@Tags			Company
 //	@Produce		json
 //	@Security		ApiKeyAuth
@@ -422,11 +573,9 @@ func (h *companyHandler) CreateCompanyJoinCode(c *fiber.Ctx) error {
 //	@Failure		500				{object}	Error.HttpError
 //	@Router			/auth/company/{company_uuid}/codes [get]
 func (h *companyHandler) GetCompanyJoinCodes(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.GetCompanyJoinCodesRequest{
 		CompanyUUID: c.Params("company_uuid", ""),
@@ -444,8 +593,18 @@ func (h *companyHandler) GetCompanyJoinCodes(c *fiber.Ctx) error {
 		return Error.GRPCErrorToHTTP(err, c)
 	}
 
+	joinCodes := make([]*entities.JoinCodeItem, 0, len(res.GetJoinCodes()))
+	for _, code := range res.GetJoinCodes() {
+		joinCodes = append(joinCodes, &entities.JoinCodeItem{
+			Code:    code.GetCode(),
+			MaxUses: code.GetMaxUses(),
+			Uses:    code.GetUses(),
+		})
+	}
+
 	return c.Status(fiber.StatusOK).JSON(&entities.GetCompanyJoinCodesResponse{
-		Codes: res.GetCodes(),
+		Codes:     res.GetCodes(),
+		JoinCodes: joinCodes,
 	})
 }
 
@@ -467,11 +626,9 @@ func (h *companyHandler) GetCompanyJoinCodes(c *fiber.Ctx) error {
 //	@Failure		500				{object}	Error.HttpError
 //	@Router			/auth/company/{company_uuid}/code [delete]
 func (h *companyHandler) DeleteCompanyJoinCode(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.DeleteCompanyJoinCodeRequest{}
 	if err := c.BodyParser(httpReq); err != nil {
@@ -495,10 +652,61 @@ func (h *companyHandler) DeleteCompanyJoinCode(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(&entities.DeleteCompanyJoinCodeResponse{})
 }
 
+// GetJoinCodeRedemptions
+//
+//	@Summary		Get join code redemptions
+//	@Description	Get who redeemed the join code and when, newest first (chief only). History is kept after the code expires
+//	@Tags			Company
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Param			code			path		string	true	"Join code"
+//	@Success		200				{object}	entities.GetJoinCodeRedemptionsResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/code/{code}/redemptions [get]
+func (h *companyHandler) GetJoinCodeRedemptions(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.GetJoinCodeRedemptionsRequest{
+		CompanyUUID: c.Params("company_uuid", ""),
+		Code:        c.Params("code", ""),
+	}
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.CompanyServiceClient.GetJoinCodeRedemptions(ctx, &company_proto.GetJoinCodeRedemptionsRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+		Code:          httpReq.Code,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	redemptions := make([]*entities.JoinCodeRedemptionItem, 0, len(res.GetRedemptions()))
+	for _, redemption := range res.GetRedemptions() {
+		redemptions = append(redemptions, &entities.JoinCodeRedemptionItem{
+			UserUUID:        redemption.GetUserUuid(),
+			RedeemedAt:      redemption.GetRedeemedAt(),
+			JoinRequestUUID: redemption.GetJoinRequestUuid(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.GetJoinCodeRedemptionsResponse{Redemptions: redemptions})
+}
+
 // JoinCompany
 //
 //	@Summary		Join company
-//	@Description	Join company by join code
+//	@Description	Join company by join code. If the company requires approval, a pending join request is created instead (status "pending")
 //	@Tags			Employee
 //	@Accept			json
 //	@Produce		json
@@ -507,15 +715,16 @@ func (h *companyHandler) DeleteCompanyJoinCode(c *fiber.Ctx) error {
 //	@Success		200		{object}	entities.JoinCompanyResponse
 //	@Failure		400		{object}	Error.HttpError
 //	@Failure		401		{object}	Error.HttpError
+//	@Failure		403		{object}	Error.HttpError
 //	@Failure		404		{object}	Error.HttpError
+//	@Failure		409		{object}	Error.HttpError
+//	@Failure		429		{object}	Error.HttpError
 //	@Failure		500		{object}	Error.HttpError
 //	@Router			/auth/company/join [post]
 func (h *companyHandler) JoinCompany(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.JoinCompanyRequest{}
 	if err := c.BodyParser(httpReq); err != nil {
@@ -535,146 +744,181 @@ func (h *companyHandler) JoinCompany(c *fiber.Ctx) error {
 	}
 
 	return c.Status(fiber.StatusOK).JSON(&entities.JoinCompanyResponse{
-		CompanyUUID: res.GetCompanyUuid(),
-		Role:        res.GetRole(),
+		CompanyUUID:     res.GetCompanyUuid(),
+		Role:            res.GetRole(),
+		Status:          res.GetStatus(),
+		JoinRequestUUID: res.GetJoinRequestUuid(),
 	})
 }
 
-// GetCompanyEmployee
+// GetCompanyJoinRequests
 //
-//	@Summary		Get employee info
-//	@Description	Get employee info by his uuid
-//	@Tags			Employee
+//	@Summary		Get company join requests
+//	@Description	Get join requests created by join code in approval mode, newest first (chief only)
+//	@Tags			Company
 //	@Produce		json
 //	@Security		ApiKeyAuth
 //	@Param			company_uuid	path		string	true	"Company UUID"
-//	@Param			employee_uuid	path		string	true	"Employee UUID"
-//	@Success		200				{object}	entities.GetCompanyEmployeeResponse
+//	@Param			status			query		string	false	"Join request status"	Enums(pending, accepted, declined)
+//	@Success		200				{object}	entities.GetCompanyJoinRequestsResponse
 //	@Failure		400				{object}	Error.HttpError
 //	@Failure		401				{object}	Error.HttpError
 //	@Failure		403				{object}	Error.HttpError
 //	@Failure		404				{object}	Error.HttpError
 //	@Failure		500				{object}	Error.HttpError
-//	@Router			/auth/company/{company_uuid}/employee/{employee_uuid}/info [get]
-func (h *companyHandler) GetCompanyEmployee(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+//	@Router			/auth/company/{company_uuid}/join-requests [get]
+func (h *companyHandler) GetCompanyJoinRequests(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
-	httpReq := &entities.GetCompanyEmployeeRequest{
-		TargetUUID:  c.Params("employee_uuid", ""),
-		CompanyUUID: c.Params("company_uuid", ""),
+	httpReq := &entities.GetCompanyJoinRequestsRequest{}
+	if err := c.QueryParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
 	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
 
 	if err := httpReq.Validate(); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
 	}
 
-	res, err := h.CompanyServiceClient.GetCompanyEmployee(ctx, &company_proto.GetCompanyEmployeeRequest{
+	res, err := h.CompanyServiceClient.GetCompanyJoinRequests(ctx, &company_proto.GetCompanyJoinRequestsRequest{
 		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
-		TargetUuid:    httpReq.TargetUUID,
 		CompanyUuid:   httpReq.CompanyUUID,
+		Status:        httpReq.Status,
 	})
 	if err != nil {
 		return Error.GRPCErrorToHTTP(err, c)
 	}
 
-	return c.Status(fiber.StatusOK).JSON(&entities.GetCompanyEmployeeResponse{
-		UserUUID:       httpReq.TargetUUID,
-		Role:           res.GetRole(),
-		DepartmentUUID: res.GetDepartmentUuid(),
-		JoinedAt:       res.GetJoinedAt(),
-	})
+	joinRequests := make([]*entities.JoinRequestItem, 0, len(res.GetJoinRequests()))
+	for _, joinRequest := range res.GetJoinRequests() {
+		joinRequests = append(joinRequests, &entities.JoinRequestItem{
+			JoinRequestUUID: joinRequest.GetJoinRequestUuid(),
+			UserUUID:        joinRequest.GetUserUuid(),
+			JoinCode:        joinRequest.GetJoinCode(),
+			Status:          joinRequest.GetStatus(),
+			CreatedAt:       joinRequest.GetCreatedAt(),
+			DecidedAt:       joinRequest.GetDecidedAt(),
+			DecidedBy:       joinRequest.GetDecidedBy(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.GetCompanyJoinRequestsResponse{JoinRequests: joinRequests})
 }
 
-// GetCompanyEmployees
+// AcceptCompanyJoinRequest
 //
-//	@Summary		Get company employees
-//	@Description	Get company employees filtered by role and/or department
-//	@Tags			Employee
+//	@Summary		Accept join request
+//	@Description	Accept pending join request, the user joins the company as "unemployed" (chief only)
+//	@Tags			Company
 //	@Produce		json
 //	@Security		ApiKeyAuth
-//	@Param			company_uuid	path		string	true	"Company UUID"
-//	@Param			department_uuid	query		string	false	"Department UUID"
-//	@Param			role			query		string	false	"Role"
-//	@Param			offset			query		int		false	"Offset"	default(0)
-//	@Param			count			query		int		false	"Count"		default(10)
-//	@Success		200				{object}	entities.GetCompanyEmployeesResponse
-//	@Failure		400				{object}	Error.HttpError
-//	@Failure		401				{object}	Error.HttpError
-//	@Failure		403				{object}	Error.HttpError
-//	@Failure		404				{object}	Error.HttpError
-//	@Failure		500				{object}	Error.HttpError
-//	@Router			/auth/company/{company_uuid}/employees/list [get]
-func (h *companyHandler) GetCompanyEmployees(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+//	@Param			company_uuid		path		string	true	"Company UUID"
+//	@Param			join_request_uuid	path		string	true	"Join request UUID"
+//	@Success		200					{object}	entities.DecideCompanyJoinRequestResponse
+//	@Failure		400					{object}	Error.HttpError
+//	@Failure		401					{object}	Error.HttpError
+//	@Failure		403					{object}	Error.HttpError
+//	@Failure		404					{object}	Error.HttpError
+//	@Failure		409					{object}	Error.HttpError
+//	@Failure		412					{object}	Error.HttpError
+//	@Failure		500					{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/join-requests/{join_request_uuid}/accept [post]
+func (h *companyHandler) AcceptCompanyJoinRequest(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
-	httpReq := &entities.GetCompanyEmployeesRequest{}
-	if err := c.QueryParser(httpReq); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	httpReq := &entities.DecideCompanyJoinRequestRequest{
+		CompanyUUID:     c.Params("company_uuid", ""),
+		JoinRequestUUID: c.Params("join_request_uuid", ""),
 	}
-	httpReq.CompanyUUID = c.Params("company_uuid", "")
 
 	if err := httpReq.Validate(); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
 	}
 
-	res, err := h.CompanyServiceClient.GetCompanyEmployees(ctx, &company_proto.GetCompanyEmployeesRequest{
-		InitiatorUuid:  utils.GetLocal[string](c, h.userUUIDKey),
-		CompanyUuid:    httpReq.CompanyUUID,
-		DepartmentUuid: httpReq.DepartmentUUID,
-		Role:           httpReq.Role,
-		Count:          httpReq.Count,
-		Offset:         httpReq.Offset,
+	_, err := h.CompanyServiceClient.AcceptCompanyJoinRequest(ctx, &company_proto.AcceptCompanyJoinRequestRequest{
+		InitiatorUuid:   utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:     httpReq.CompanyUUID,
+		JoinRequestUuid: httpReq.JoinRequestUUID,
 	})
 	if err != nil {
 		return Error.GRPCErrorToHTTP(err, c)
 	}
 
-	employees := make([]*entities.GetCompanyEmployeeResponse, 0, len(res.GetEmployees()))
-	for _, employee := range res.GetEmployees() {
-		employees = append(employees, &entities.GetCompanyEmployeeResponse{
-			UserUUID:       employee.GetUserUuid(),
-			Role:           employee.GetRole(),
-			DepartmentUUID: employee.GetDepartmentUuid(),
-			JoinedAt:       employee.GetJoinedAt(),
-		})
+	return c.Status(fiber.StatusOK).JSON(&entities.DecideCompanyJoinRequestResponse{})
+}
+
+// DeclineCompanyJoinRequest
+//
+//	@Summary		Decline join request
+//	@Description	Decline pending join request (chief only). The join code use is not returned
+//	@Tags			Company
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid		path		string	true	"Company UUID"
+//	@Param			join_request_uuid	path		string	true	"Join request UUID"
+//	@Success		200					{object}	entities.DecideCompanyJoinRequestResponse
+//	@Failure		400					{object}	Error.HttpError
+//	@Failure		401					{object}	Error.HttpError
+//	@Failure		403					{object}	Error.HttpError
+//	@Failure		404					{object}	Error.HttpError
+//	@Failure		412					{object}	Error.HttpError
+//	@Failure		500					{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/join-requests/{join_request_uuid}/decline [post]
+func (h *companyHandler) DeclineCompanyJoinRequest(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.DecideCompanyJoinRequestRequest{
+		CompanyUUID:     c.Params("company_uuid", ""),
+		JoinRequestUUID: c.Params("join_request_uuid", ""),
+	}
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	_, err := h.CompanyServiceClient.DeclineCompanyJoinRequest(ctx, &company_proto.DeclineCompanyJoinRequestRequest{
+		InitiatorUuid:   utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:     httpReq.CompanyUUID,
+		JoinRequestUuid: httpReq.JoinRequestUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
 	}
 
-	return c.Status(fiber.StatusOK).JSON(&entities.GetCompanyEmployeesResponse{Employees: employees})
+	return c.Status(fiber.StatusOK).JSON(&entities.DecideCompanyJoinRequestResponse{})
 }
 
-// GetCompanyEmployeesSummary
+// CreateCompanyInvitation
 //
-//	@Summary		Get company employees summary
-//	@Description	Get company employees count by each role
-//	@Tags			Employee
+//	@Summary		Create company invitation
+//	@Description	Invite a user by email with a preset role and optional department (chief only). The invitation email is sent to the address. TTL min: 60s, max: 604800s (1 week)
+//	@Tags			Company
+//	@Accept			json
 //	@Produce		json
 //	@Security		ApiKeyAuth
-//	@Param			company_uuid	path		string	true	"Company UUID"
-//	@Param			department_uuid	query		string	false	"Department UUID"
-//	@Success		200				{object}	entities.GetCompanyEmployeesSummaryResponse
+//	@Param			company_uuid	path		string									true	"Company UUID"
+//	@Param			data			body		entities.CreateCompanyInvitationRequest	true	"Параметры запроса"
+//	@Success		201				{object}	entities.CreateCompanyInvitationResponse
 //	@Failure		400				{object}	Error.HttpError
 //	@Failure		401				{object}	Error.HttpError
 //	@Failure		403				{object}	Error.HttpError
 //	@Failure		404				{object}	Error.HttpError
+//	@Failure		409				{object}	Error.HttpError
 //	@Failure		500				{object}	Error.HttpError
-//	@Router			/auth/company/{company_uuid}/employees/summary [get]
-func (h *companyHandler) GetCompanyEmployeesSummary(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+//	@Router			/auth/company/{company_uuid}/invitations [post]
+func (h *companyHandler) CreateCompanyInvitation(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
-	httpReq := &entities.GetCompanyEmployeesSummaryRequest{}
-	if err := c.QueryParser(httpReq); err != nil {
+	httpReq := &entities.CreateCompanyInvitationRequest{}
+	if err := c.BodyParser(httpReq); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
 	}
 	httpReq.CompanyUUID = c.Params("company_uuid", "")
@@ -683,116 +927,754 @@ func (h *companyHandler) GetCompanyEmployeesSummary(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
 	}
 
-	res, err := h.CompanyServiceClient.GetCompanyEmployeesSummary(ctx, &company_proto.GetCompanyEmployeesSummaryRequest{
+	res, err := h.CompanyServiceClient.CreateCompanyInvitation(ctx, &company_proto.CreateCompanyInvitationRequest{
 		InitiatorUuid:  utils.GetLocal[string](c, h.userUUIDKey),
 		CompanyUuid:    httpReq.CompanyUUID,
+		Email:          httpReq.Email,
+		Role:           httpReq.Role,
 		DepartmentUuid: httpReq.DepartmentUUID,
+		Ttl:            httpReq.TTL,
 	})
 	if err != nil {
 		return Error.GRPCErrorToHTTP(err, c)
 	}
 
-	return c.Status(fiber.StatusOK).JSON(&entities.GetCompanyEmployeesSummaryResponse{
-		ChiefCount:      res.GetChiefCount(),
-		AnalyticsCount:  res.GetAnalyticsCount(),
-		ManagerCount:    res.GetManagerCount(),
-		EngineerCount:   res.GetEngineerCount(),
-		InspectorCount:  res.GetInspectorCount(),
-		UnemployedCount: res.GetUnemployedCount(),
+	return c.Status(fiber.StatusCreated).JSON(&entities.CreateCompanyInvitationResponse{
+		InvitationUUID: res.GetInvitationUuid(),
 	})
 }
 
-// UpdateEmployeeRole
+// GetCompanyInvitations
+//
+//	@Summary		Get company invitations
+//	@Description	Get invitations sent by the company, newest first (chief only)
+//	@Tags			Company
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Param			status			query		string	false	"Invitation status"	Enums(pending, accepted, declined, revoked, expired)
+//	@Success		200				{object}	entities.GetCompanyInvitationsResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/invitations [get]
+func (h *companyHandler) GetCompanyInvitations(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.GetCompanyInvitationsRequest{}
+	if err := c.QueryParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.CompanyServiceClient.GetCompanyInvitations(ctx, &company_proto.GetCompanyInvitationsRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+		Status:        httpReq.Status,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.GetCompanyInvitationsResponse{Invitations: invitationsToItems(res.GetInvitations())})
+}
+
+// RevokeCompanyInvitation
+//
+//	@Summary		Revoke company invitation
+//	@Description	Revoke pending invitation (chief only)
+//	@Tags			Company
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Param			invitation_uuid	path		string	true	"Invitation UUID"
+//	@Success		200				{object}	entities.RevokeCompanyInvitationResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		412				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/invitations/{invitation_uuid} [delete]
+func (h *companyHandler) RevokeCompanyInvitation(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.RevokeCompanyInvitationRequest{
+		CompanyUUID:    c.Params("company_uuid", ""),
+		InvitationUUID: c.Params("invitation_uuid", ""),
+	}
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	_, err := h.CompanyServiceClient.RevokeCompanyInvitation(ctx, &company_proto.RevokeCompanyInvitationRequest{
+		InitiatorUuid:  utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:    httpReq.CompanyUUID,
+		InvitationUuid: httpReq.InvitationUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.RevokeCompanyInvitationResponse{})
+}
+
+// GetUserInvitations
+//
+//	@Summary		Get my invitations
+//	@Description	Get pending invitations addressed to the current user's email
+//	@Tags			Employee
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	entities.GetUserInvitationsResponse
+//	@Failure		401	{object}	Error.HttpError
+//	@Failure		404	{object}	Error.HttpError
+//	@Failure		500	{object}	Error.HttpError
+//	@Router			/auth/company/invitations/my [get]
+func (h *companyHandler) GetUserInvitations(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	email, err := h.initiatorEmail(ctx, c)
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	res, err := h.CompanyServiceClient.GetUserInvitations(ctx, &company_proto.GetUserInvitationsRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		Email:         email,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.GetUserInvitationsResponse{Invitations: invitationsToItems(res.GetInvitations())})
+}
+
+// AcceptCompanyInvitation
+//
+//	@Summary		Accept invitation
+//	@Description	Accept invitation addressed to the current user's email, the user joins the company with the role and department from the invitation
+//	@Tags			Employee
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			invitation_uuid	path		string	true	"Invitation UUID"
+//	@Success		200				{object}	entities.AcceptCompanyInvitationResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		409				{object}	Error.HttpError
+//	@Failure		412				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/invitations/{invitation_uuid}/accept [post]
+func (h *companyHandler) AcceptCompanyInvitation(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.DecideCompanyInvitationRequest{
+		InvitationUUID: c.Params("invitation_uuid", ""),
+	}
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	email, err := h.initiatorEmail(ctx, c)
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	res, err := h.CompanyServiceClient.AcceptCompanyInvitation(ctx, &company_proto.AcceptCompanyInvitationRequest{
+		InitiatorUuid:  utils.GetLocal[string](c, h.userUUIDKey),
+		Email:          email,
+		InvitationUuid: httpReq.InvitationUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.AcceptCompanyInvitationResponse{
+		CompanyUUID: res.GetCompanyUuid(),
+		Role:        res.GetRole(),
+	})
+}
+
+// DeclineCompanyInvitation
+//
+//	@Summary		Decline invitation
+//	@Description	Decline invitation addressed to the current user's email
+//	@Tags			Employee
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			invitation_uuid	path		string	true	"Invitation UUID"
+//	@Success		200				{object}	entities.DeclineCompanyInvitationResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		412				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/invitations/{invitation_uuid}/decline [post]
+func (h *companyHandler) DeclineCompanyInvitation(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.DecideCompanyInvitationRequest{
+		InvitationUUID: c.Params("invitation_uuid", ""),
+	}
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	email, err := h.initiatorEmail(ctx, c)
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	_, err = h.CompanyServiceClient.DeclineCompanyInvitation(ctx, &company_proto.DeclineCompanyInvitationRequest{
+		InitiatorUuid:  utils.GetLocal[string](c, h.userUUIDKey),
+		Email:          email,
+		InvitationUuid: httpReq.InvitationUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.DeclineCompanyInvitationResponse{})
+}
+
+// GetCompanyEmployee
+//
+//	@Summary		Get employee info
+//	@Description	Get employee info by his uuid
+//	@Tags			Employee
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Param			employee_uuid	path		string	true	"Employee UUID"
+//	@Success		200				{object}	entities.GetCompanyEmployeeResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/employee/{employee_uuid}/info [get]
+func (h *companyHandler) GetCompanyEmployee(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.GetCompanyEmployeeRequest{
+		TargetUUID:  c.Params("employee_uuid", ""),
+		CompanyUUID: c.Params("company_uuid", ""),
+	}
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.CompanyServiceClient.GetCompanyEmployee(ctx, &company_proto.GetCompanyEmployeeRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		TargetUuid:    httpReq.TargetUUID,
+		CompanyUuid:   httpReq.CompanyUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.GetCompanyEmployeeResponse{
+		UserUUID:       httpReq.TargetUUID,
+		Role:           res.GetRole(),
+		DepartmentUUID: res.GetDepartmentUuid(),
+		JoinedAt:       res.GetJoinedAt(),
+	})
+}
+
+// GetCompanyEmployees
+//
+//	@Summary		Get company employees
+//	@Description	Get company employees filtered by role and/or department
+//	@Tags			Employee
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Param			department_uuid	query		string	false	"Department UUID"
+//	@Param			role			query		string	false	"Role"
+//	@Param			offset			query		int		false	"Offset"	default(0)
+//	@Param			count			query		int		false	"Count"		default(10)
+//	@Param			cursor			query		string	false	"Cursor from next_cursor of the previous page (instead of offset)"
+//	@Success		200				{object}	entities.GetCompanyEmployeesResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/employees/list [get]
+func (h *companyHandler) GetCompanyEmployees(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.GetCompanyEmployeesRequest{}
+	if err := c.QueryParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.CompanyServiceClient.GetCompanyEmployees(ctx, &company_proto.GetCompanyEmployeesRequest{
+		InitiatorUuid:  utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:    httpReq.CompanyUUID,
+		DepartmentUuid: httpReq.DepartmentUUID,
+		Role:           httpReq.Role,
+		Count:          httpReq.Count,
+		Offset:         httpReq.Offset,
+		Cursor:         httpReq.Cursor,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	employees := make([]*entities.GetCompanyEmployeeResponse, 0, len(res.GetEmployees()))
+	for _, employee := range res.GetEmployees() {
+		employees = append(employees, &entities.GetCompanyEmployeeResponse{
+			UserUUID:       employee.GetUserUuid(),
+			Role:           employee.GetRole(),
+			DepartmentUUID: employee.GetDepartmentUuid(),
+			JoinedAt:       employee.GetJoinedAt(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.GetCompanyEmployeesResponse{
+		Employees:  employees,
+		NextCursor: res.GetNextCursor(),
+		TotalCount: res.GetTotalCount(),
+	})
+}
+
+// GetCompanyEmployeesSummary
+//
+//	@Summary		Get company employees summary
+//	@Description	Get company employees count by each role
+//	@Tags			Employee
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Param			department_uuid	query		string	false	"Department UUID"
+//	@Success		200				{object}	entities.GetCompanyEmployeesSummaryResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/employees/summary [get]
+func (h *companyHandler) GetCompanyEmployeesSummary(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.GetCompanyEmployeesSummaryRequest{}
+	if err := c.QueryParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.CompanyServiceClient.GetCompanyEmployeesSummary(ctx, &company_proto.GetCompanyEmployeesSummaryRequest{
+		InitiatorUuid:  utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:    httpReq.CompanyUUID,
+		DepartmentUuid: httpReq.DepartmentUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.GetCompanyEmployeesSummaryResponse{
+		ChiefCount:      res.GetChiefCount(),
+		AnalyticsCount:  res.GetAnalyticsCount(),
+		ManagerCount:    res.GetManagerCount(),
+		EngineerCount:   res.GetEngineerCount(),
+		InspectorCount:  res.GetInspectorCount(),
+		UnemployedCount: res.GetUnemployedCount(),
+	})
+}
+
+// UpdateEmployeeRole
 //
 //	@Summary		Update employee role
-//	@Description	Update employee role (chief only). Available roles: "unemployed", "engineer", "manager", "analytic", "inspector", "chief"
+//	@Description	Update employee role (chief only). Available roles: "unemployed", "engineer", "manager", "analytic", "inspector", "chief". Open applications the employee loses are processed after the change according to open_applications: block rejects the change while they exist, pool returns them to the department pool, reassign passes them to reassign_to
 //	@Tags			Employee
 //	@Accept			json
 //	@Produce		json
 //	@Security		ApiKeyAuth
-//	@Param			company_uuid	path		string								true	"Company UUID"
-//	@Param			employee_uuid	path		string								true	"Employee UUID"
-//	@Param			data			body		entities.UpdateEmployeeRoleRequest	true	"Параметры запроса"
-//	@Success		200				{object}	entities.UpdateEmployeeRoleResponse
+//	@Param			company_uuid		path		string								true	"Company UUID"
+//	@Param			employee_uuid		path		string								true	"Employee UUID"
+//	@Param			data				body		entities.UpdateEmployeeRoleRequest	true	"Параметры запроса"
+//	@Param			open_applications	query		string								false	"Open applications policy"	Enums(block, pool, reassign)
+//	@Param			reassign_to			query		string								false	"Colleague UUID for reassign policy"
+//	@Success		200					{object}	entities.UpdateEmployeeRoleResponse
+//	@Failure		400					{object}	Error.HttpError
+//	@Failure		401					{object}	Error.HttpError
+//	@Failure		403					{object}	Error.HttpError
+//	@Failure		404					{object}	Error.HttpError
+//	@Failure		412					{object}	Error.HttpError
+//	@Failure		500					{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/employee/{employee_uuid}/role [patch]
+func (h *companyHandler) UpdateEmployeeRole(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.UpdateEmployeeRoleRequest{}
+	if err := c.BodyParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	if err := c.QueryParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
+	httpReq.TargetUUID = c.Params("employee_uuid", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	_, err := h.CompanyServiceClient.UpdateEmployeeRole(ctx, &company_proto.UpdateEmployeeRoleRequest{
+		InitiatorUuid:    utils.GetLocal[string](c, h.userUUIDKey),
+		TargetUuid:       httpReq.TargetUUID,
+		CompanyUuid:      httpReq.CompanyUUID,
+		Role:             httpReq.Role,
+		OpenApplications: httpReq.OpenApplications,
+		ReassignTo:       httpReq.ReassignTo,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.UpdateEmployeeRoleResponse{})
+}
+
+// RemoveCompanyEmployee
+//
+//	@Summary		Remove company employee
+//	@Description	Remove company employee by his uuid. Open applications the employee loses are processed after the change according to open_applications: block rejects the change while they exist, pool returns them to the department pool, reassign passes them to reassign_to
+//	@Tags			Employee
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid		path		string	true	"Company UUID"
+//	@Param			employee_uuid		path		string	true	"Employee UUID"
+//	@Param			open_applications	query		string	false	"Open applications policy"	Enums(block, pool, reassign)
+//	@Param			reassign_to			query		string	false	"Colleague UUID for reassign policy"
+//	@Success		200					{object}	entities.RemoveCompanyEmployeeResponse
+//	@Failure		400					{object}	Error.HttpError
+//	@Failure		401					{object}	Error.HttpError
+//	@Failure		403					{object}	Error.HttpError
+//	@Failure		404					{object}	Error.HttpError
+//	@Failure		412					{object}	Error.HttpError
+//	@Failure		500					{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/employee/{employee_uuid} [delete]
+func (h *companyHandler) RemoveCompanyEmployee(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.RemoveCompanyEmployeeRequest{}
+	if err := c.QueryParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
+	httpReq.TargetUUID = c.Params("employee_uuid", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	_, err := h.CompanyServiceClient.RemoveCompanyEmployee(ctx, &company_proto.RemoveCompanyEmployeeRequest{
+		InitiatorUuid:    utils.GetLocal[string](c, h.userUUIDKey),
+		TargetUuid:       httpReq.TargetUUID,
+		CompanyUuid:      httpReq.CompanyUUID,
+		OpenApplications: httpReq.OpenApplications,
+		ReassignTo:       httpReq.ReassignTo,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.RemoveCompanyEmployeeResponse{})
+}
+
+// LeaveCompany
+//
+//	@Summary		Leave company
+//	@Description	Leave company as employee. Open applications of the employee are released. Company owner must transfer ownership first
+//	@Tags			Employee
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Success		200				{object}	entities.LeaveCompanyResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		412				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/leave [post]
+func (h *companyHandler) LeaveCompany(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.LeaveCompanyRequest{
+		CompanyUUID: c.Params("company_uuid", ""),
+	}
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	_, err := h.CompanyServiceClient.LeaveCompany(ctx, &company_proto.LeaveCompanyRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.LeaveCompanyResponse{})
+}
+
+// TransferCompanyOwnership
+//
+//	@Summary		Transfer company ownership
+//	@Description	Offer company ownership to employee (owner only). Recipient must accept the transfer
+//	@Tags			Company
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string									true	"Company UUID"
+//	@Param			data			body		entities.TransferCompanyOwnershipRequest	true	"Параметры запроса"
+//	@Success		201				{object}	entities.TransferCompanyOwnershipResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		409				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/ownership/transfer [post]
+func (h *companyHandler) TransferCompanyOwnership(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.TransferCompanyOwnershipRequest{}
+	if err := c.BodyParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.CompanyServiceClient.TransferCompanyOwnership(ctx, &company_proto.TransferCompanyOwnershipRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+		TargetUuid:    httpReq.TargetUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(&entities.TransferCompanyOwnershipResponse{
+		TransferUUID: res.GetTransferUuid(),
+		ExpiresAt:    res.GetExpiresAt(),
+	})
+}
+
+// GetCompanyOwnershipTransfer
+//
+//	@Summary		Get company ownership transfer
+//	@Description	Get pending company ownership transfer
+//	@Tags			Company
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Success		200				{object}	entities.GetCompanyOwnershipTransferResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/ownership/transfer [get]
+func (h *companyHandler) GetCompanyOwnershipTransfer(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.CompanyOwnershipTransferRequest{
+		CompanyUUID: c.Params("company_uuid", ""),
+	}
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.CompanyServiceClient.GetCompanyOwnershipTransfer(ctx, &company_proto.GetCompanyOwnershipTransferRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	transfer := res.GetTransfer()
+	return c.Status(fiber.StatusOK).JSON(&entities.GetCompanyOwnershipTransferResponse{
+		TransferUUID: transfer.GetTransferUuid(),
+		CompanyUUID:  transfer.GetCompanyUuid(),
+		FromUUID:     transfer.GetFromUuid(),
+		ToUUID:       transfer.GetToUuid(),
+		Status:       transfer.GetStatus(),
+		ExpiresAt:    transfer.GetExpiresAt(),
+		CreatedAt:    transfer.GetCreatedAt(),
+	})
+}
+
+// AcceptCompanyOwnershipTransfer
+//
+//	@Summary		Accept company ownership transfer
+//	@Description	Accept ownership transfer addressed to the initiator. Initiator becomes company owner with chief role
+//	@Tags			Company
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Success		200				{object}	entities.AcceptCompanyOwnershipTransferResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		412				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/ownership/transfer/accept [post]
+func (h *companyHandler) AcceptCompanyOwnershipTransfer(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.CompanyOwnershipTransferRequest{
+		CompanyUUID: c.Params("company_uuid", ""),
+	}
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	_, err := h.CompanyServiceClient.AcceptCompanyOwnershipTransfer(ctx, &company_proto.AcceptCompanyOwnershipTransferRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.AcceptCompanyOwnershipTransferResponse{})
+}
+
+// DeclineCompanyOwnershipTransfer
+//
+//	@Summary		Decline company ownership transfer
+//	@Description	Decline ownership transfer addressed to the initiator
+//	@Tags			Company
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Success		200				{object}	entities.DeclineCompanyOwnershipTransferResponse
 //	@Failure		400				{object}	Error.HttpError
 //	@Failure		401				{object}	Error.HttpError
 //	@Failure		403				{object}	Error.HttpError
 //	@Failure		404				{object}	Error.HttpError
 //	@Failure		500				{object}	Error.HttpError
-//	@Router			/auth/company/{company_uuid}/employee/{employee_uuid}/role [patch]
-func (h *companyHandler) UpdateEmployeeRole(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+//	@Router			/auth/company/{company_uuid}/ownership/transfer/decline [post]
+func (h *companyHandler) DeclineCompanyOwnershipTransfer(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
-	httpReq := &entities.UpdateEmployeeRoleRequest{}
-	if err := c.BodyParser(httpReq); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	httpReq := &entities.CompanyOwnershipTransferRequest{
+		CompanyUUID: c.Params("company_uuid", ""),
 	}
-	httpReq.CompanyUUID = c.Params("company_uuid", "")
-	httpReq.TargetUUID = c.Params("employee_uuid", "")
 
 	if err := httpReq.Validate(); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
 	}
 
-	_, err := h.CompanyServiceClient.UpdateEmployeeRole(ctx, &company_proto.UpdateEmployeeRoleRequest{
+	_, err := h.CompanyServiceClient.DeclineCompanyOwnershipTransfer(ctx, &company_proto.DeclineCompanyOwnershipTransferRequest{
 		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
-		TargetUuid:    httpReq.TargetUUID,
 		CompanyUuid:   httpReq.CompanyUUID,
-		Role:          httpReq.Role,
 	})
 	if err != nil {
 		return Error.GRPCErrorToHTTP(err, c)
 	}
 
-	return c.Status(fiber.StatusOK).JSON(&entities.UpdateEmployeeRoleResponse{})
+	return c.Status(fiber.StatusOK).JSON(&entities.DeclineCompanyOwnershipTransferResponse{})
 }
 
-// RemoveCompanyEmployee
+// CancelCompanyOwnershipTransfer
 //
-//	@Summary		Remove company employee
-//	@Description	Remove company employee by his uuid
-//	@Tags			Employee
+//	@Summary		Cancel company ownership transfer
+//	@Description	Cancel pending ownership transfer (owner only)
+//	@Tags			Company
 //	@Produce		json
 //	@Security		ApiKeyAuth
 //	@Param			company_uuid	path		string	true	"Company UUID"
-//	@Param			employee_uuid	path		string	true	"Employee UUID"
-//	@Success		200				{object}	entities.RemoveCompanyEmployeeResponse
+//	@Success		200				{object}	entities.CancelCompanyOwnershipTransferResponse
 //	@Failure		400				{object}	Error.HttpError
 //	@Failure		401				{object}	Error.HttpError
 //	@Failure		403				{object}	Error.HttpError
 //	@Failure		404				{object}	Error.HttpError
 //	@Failure		500				{object}	Error.HttpError
-//	@Router			/auth/company/{company_uuid}/employee/{employee_uuid} [delete]
-func (h *companyHandler) RemoveCompanyEmployee(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+//	@Router			/auth/company/{company_uuid}/ownership/transfer [delete]
+func (h *companyHandler) CancelCompanyOwnershipTransfer(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
-	httpReq := &entities.RemoveCompanyEmployeeRequest{
+	httpReq := &entities.CompanyOwnershipTransferRequest{
 		CompanyUUID: c.Params("company_uuid", ""),
-		TargetUUID:  c.Params("employee_uuid", ""),
 	}
 
 	if err := httpReq.Validate(); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
 	}
 
-	_, err := h.CompanyServiceClient.RemoveCompanyEmployee(ctx, &company_proto.RemoveCompanyEmployeeRequest{
+	_, err := h.CompanyServiceClient.CancelCompanyOwnershipTransfer(ctx, &company_proto.CancelCompanyOwnershipTransferRequest{
 		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
-		TargetUuid:    httpReq.TargetUUID,
 		CompanyUuid:   httpReq.CompanyUUID,
 	})
 	if err != nil {
 		return Error.GRPCErrorToHTTP(err, c)
 	}
 
-	return c.Status(fiber.StatusOK).JSON(&entities.RemoveCompanyEmployeeResponse{})
+	return c.Status(fiber.StatusOK).JSON(&entities.CancelCompanyOwnershipTransferResponse{})
 }
 
 // CreateDepartment
@@ -813,11 +1695,9 @@ func (h *companyHandler) RemoveCompanyEmployee(c *fiber.Ctx) error {
 //	@Failure		500				{object}	Error.HttpError
 //	@Router			/auth/company/{company_uuid}/department [post]
 func (h *companyHandler) CreateDepartment(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.CreateDepartmentRequest{}
 	if err := c.BodyParser(httpReq); err != nil {
@@ -860,11 +1740,9 @@ func (h *companyHandler) CreateDepartment(c *fiber.Ctx) error {
 //	@Failure		500					{object}	Error.HttpError
 //	@Router			/auth/company/{company_uuid}/department/{department_uuid} [get]
 func (h *companyHandler) GetDepartment(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.GetDepartmentRequest{
 		CompanyUUID:    c.Params("company_uuid", ""),
@@ -910,11 +1788,9 @@ func (h *companyHandler) GetDepartment(c *fiber.Ctx) error {
 //	@Failure		500				{object}	Error.HttpError
 //	@Router			/auth/company/{company_uuid}/departments/list [get]
 func (h *companyHandler) GetCompanyDepartments(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.GetCompanyDepartmentsRequest{}
 	if err := c.QueryParser(httpReq); err != nil {
@@ -966,11 +1842,9 @@ func (h *companyHandler) GetCompanyDepartments(c *fiber.Ctx) error {
 //	@Failure		500					{object}	Error.HttpError
 //	@Router			/auth/company/{company_uuid}/department/{department_uuid}/title [patch]
 func (h *companyHandler) UpdateDepartmentTitle(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.UpdateDepartmentTitleRequest{}
 	if err := c.BodyParser(httpReq); err != nil {
@@ -1012,11 +1886,9 @@ func (h *companyHandler) UpdateDepartmentTitle(c *fiber.Ctx) error {
 //	@Failure		500					{object}	Error.HttpError
 //	@Router			/auth/company/{company_uuid}/department/{department_uuid} [delete]
 func (h *companyHandler) DeleteDepartment(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.DeleteDepartmentRequest{
 		CompanyUUID:    c.Params("company_uuid", ""),
@@ -1056,11 +1928,9 @@ func (h *companyHandler) DeleteDepartment(c *fiber.Ctx) error {
 //	@Failure		500					{object}	Error.HttpError
 //	@Router			/auth/company/{company_uuid}/department/{department_uuid}/employee/{employee_uuid} [post]
 func (h *companyHandler) AddEmployeeToDepartment(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
 	httpReq := &entities.AddEmployeeToDepartmentRequest{
 		CompanyUUID:    c.Params("company_uuid", ""),
@@ -1087,41 +1957,46 @@ func (h *companyHandler) AddEmployeeToDepartment(c *fiber.Ctx) error {
 // RemoveEmployeeFromDepartment
 //
 //	@Summary		Remove employee from department
-//	@Description	Remove an employee from a department
+//	@Description	Remove an employee from a department. Open applications the employee loses are processed after the change according to open_applications: block rejects the change while they exist, pool returns them to the department pool, reassign passes them to reassign_to
 //	@Tags			Department
 //	@Produce		json
 //	@Security		ApiKeyAuth
 //	@Param			company_uuid		path		string	true	"Company UUID"
 //	@Param			department_uuid		path		string	true	"Department UUID"
 //	@Param			employee_uuid		path		string	true	"Employee UUID"
+//	@Param			open_applications	query		string	false	"Open applications policy"	Enums(block, pool, reassign)
+//	@Param			reassign_to			query		string	false	"Colleague UUID for reassign policy"
 //	@Success		200					{object}	entities.RemoveEmployeeFromDepartmentResponse
 //	@Failure		400					{object}	Error.HttpError
 //	@Failure		401					{object}	Error.HttpError
 //	@Failure		403					{object}	Error.HttpError
 //	@Failure		404					{object}	Error.HttpError
+//	@Failure		412					{object}	Error.HttpError
 //	@Failure		500					{object}	Error.HttpError
 //	@Router			/auth/company/{company_uuid}/department/{department_uuid}/employee/{employee_uuid} [delete]
 func (h *companyHandler) RemoveEmployeeFromDepartment(c *fiber.Ctx) error {
-	operationID := utils.GetLocal[string](c, h.operationIDKey)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
+	ctx = h.outgoingContext(c, ctx)
 
-	httpReq := &entities.RemoveEmployeeFromDepartmentRequest{
-		CompanyUUID:    c.Params("company_uuid", ""),
-		DepartmentUUID: c.Params("department_uuid", ""),
-		TargetUUID:     c.Params("employee_uuid", ""),
+	httpReq := &entities.RemoveEmployeeFromDepartmentRequest{}
+	if err := c.QueryParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
 	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
+	httpReq.DepartmentUUID = c.Params("department_uuid", "")
+	httpReq.TargetUUID = c.Params("employee_uuid", "")
 
 	if err := httpReq.Validate(); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
 	}
 
 	_, err := h.CompanyServiceClient.RemoveEmployeeFromDepartment(ctx, &company_proto.RemoveEmployeeFromDepartmentRequest{
-		InitiatorUuid:  utils.GetLocal[string](c, h.userUUIDKey),
-		DepartmentUuid: httpReq.DepartmentUUID,
-		TargetUuid:     httpReq.TargetUUID,
+		InitiatorUuid:    utils.GetLocal[string](c, h.userUUIDKey),
+		DepartmentUuid:   httpReq.DepartmentUUID,
+		TargetUuid:       httpReq.TargetUUID,
+		OpenApplications: httpReq.OpenApplications,
+		ReassignTo:       httpReq.ReassignTo,
 	})
 	if err != nil {
 		return Error.GRPCErrorToHTTP(err, c)
@@ -1129,3 +2004,364 @@ func (h *companyHandler) RemoveEmployeeFromDepartment(c *fiber.Ctx) error {
 
 	return c.Status(fiber.StatusOK).JSON(&entities.RemoveEmployeeFromDepartmentResponse{})
 }
+
+// CreateLocation
+//
+//	@Summary		Create location
+//	@Description	Create a site, building, floor or zone of a company (chief only). Building is placed in a site, floor in a building, zone in a floor
+//	@Tags			Location
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string							true	"Company UUID"
+//	@Param			data			body		entities.CreateLocationRequest	true	"Параметры запроса"
+//	@Success		201				{object}	entities.CreateLocationResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/location [post]
+func (h *companyHandler) CreateLocation(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.CreateLocationRequest{}
+	if err := c.BodyParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.CompanyServiceClient.CreateLocation(ctx, &company_proto.CreateLocationRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+		ParentUuid:    httpReq.ParentUUID,
+		Kind:          httpReq.Kind,
+		Title:         httpReq.Title,
+		Latitude:      httpReq.Latitude,
+		Longitude:     httpReq.Longitude,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(&entities.CreateLocationResponse{
+		LocationUUID: res.GetLocationUuid(),
+	})
+}
+
+// GetLocation
+//
+//	@Summary		Get location info
+//	@Description	Get location by uuid together with the chain of enclosing locations
+//	@Tags			Location
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Param			location_uuid	path		string	true	"Location UUID"
+//	@Success		200				{object}	entities.GetLocationResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/location/{location_uuid} [get]
+func (h *companyHandler) GetLocation(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.GetLocationRequest{
+		CompanyUUID:  c.Params("company_uuid", ""),
+		LocationUUID: c.Params("location_uuid", ""),
+	}
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.CompanyServiceClient.GetLocation(ctx, &company_proto.GetLocationRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		LocationUuid:  httpReq.LocationUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	path := make([]*entities.LocationItem, 0, len(res.GetPath()))
+	for _, item := range res.GetPath() {
+		path = append(path, locationToItem(item))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.GetLocationResponse{
+		LocationItem: *locationToItem(res.GetLocation()),
+		CompanyUUID:  res.GetCompanyUuid(),
+		CreatedAt:    res.GetCreatedAt(),
+		CreatedBy:    res.GetCreatedBy(),
+		Path:         path,
+	})
+}
+
+// GetCompanyLocations
+//
+//	@Summary		Get company locations list
+//	@Description	Get locations of a company, optionally only nested in a parent location or of one kind
+//	@Tags			Location
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Param			parent_uuid		query		string	false	"Parent location UUID"
+//	@Param			kind			query		string	false	"Location kind"	Enums(site, building, floor, zone)
+//	@Param			offset			query		int		false	"Offset"		default(0)
+//	@Param			count			query		int		false	"Count"			default(10)
+//	@Success		200				{object}	entities.GetCompanyLocationsResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/locations/list [get]
+func (h *companyHandler) GetCompanyLocations(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.GetCompanyLocationsRequest{}
+	if err := c.QueryParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.CompanyServiceClient.GetCompanyLocations(ctx, &company_proto.GetCompanyLocationsRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+		ParentUuid:    httpReq.ParentUUID,
+		Kind:          httpReq.Kind,
+		Offset:        httpReq.Offset,
+		Count:         httpReq.Count,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	locations := make([]*entities.LocationItem, 0, len(res.GetLocations()))
+	for _, location := range res.GetLocations() {
+		locations = append(locations, locationToItem(location))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.GetCompanyLocationsResponse{Locations: locations})
+}
+
+// UpdateLocation
+//
+//	@Summary		Update location
+//	@Description	Update location title and coordinates (chief only). Kind and parent of a location can not be changed
+//	@Tags			Location
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string							true	"Company UUID"
+//	@Param			location_uuid	path		string							true	"Location UUID"
+//	@Param			data			body		entities.UpdateLocationRequest	true	"Параметры запроса"
+//	@Success		200				{object}	entities.UpdateLocationResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/location/{location_uuid} [patch]
+func (h *companyHandler) UpdateLocation(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.UpdateLocationRequest{}
+	if err := c.BodyParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
+	httpReq.LocationUUID = c.Params("location_uuid", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	_, err := h.CompanyServiceClient.UpdateLocation(ctx, &company_proto.UpdateLocationRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		LocationUuid:  httpReq.LocationUUID,
+		Title:         httpReq.Title,
+		Latitude:      httpReq.Latitude,
+		Longitude:     httpReq.Longitude,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.UpdateLocationResponse{})
+}
+
+// DeleteLocation
+//
+//	@Summary		Delete location
+//	@Description	Delete location without nested locations (chief only). Applications keep the reference to a deleted location
+//	@Tags			Location
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Param			location_uuid	path		string	true	"Location UUID"
+//	@Success		200				{object}	entities.DeleteLocationResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		412				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/location/{location_uuid} [delete]
+func (h *companyHandler) DeleteLocation(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.DeleteLocationRequest{
+		CompanyUUID:  c.Params("company_uuid", ""),
+		LocationUUID: c.Params("location_uuid", ""),
+	}
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	_, err := h.CompanyServiceClient.DeleteLocation(ctx, &company_proto.DeleteLocationRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		LocationUuid:  httpReq.LocationUUID,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.DeleteLocationResponse{})
+}
+
+func locationToItem(location *company_proto.Location) *entities.LocationItem {
+	return &entities.LocationItem{
+		LocationUUID: location.GetLocationUuid(),
+		ParentUUID:   location.GetParentUuid(),
+		Kind:         location.GetKind(),
+		Title:        location.GetTitle(),
+		Latitude:     location.Latitude,
+		Longitude:    location.Longitude,
+	}
+}
+
+// initiatorEmail email текущего пользователя из auth сервиса - приглашения адресованы на email
+func (h *companyHandler) initiatorEmail(ctx context.Context, c *fiber.Ctx) (string, error) {
+	res, err := h.AuthServiceClient.GetUser(ctx, &auth_proto.GetUserRequest{UserUuid: utils.GetLocal[string](c, h.userUUIDKey)})
+	if err != nil {
+		return "", err
+	}
+	return res.GetEmail(), nil
+}
+
+func invitationsToItems(invitations []*company_proto.Invitation) []*entities.InvitationItem {
+	items := make([]*entities.InvitationItem, 0, len(invitations))
+	for _, invitation := range invitations {
+		items = append(items, &entities.InvitationItem{
+			InvitationUUID: invitation.GetInvitationUuid(),
+			CompanyUUID:    invitation.GetCompanyUuid(),
+			CompanyTitle:   invitation.GetCompanyTitle(),
+			Email:          invitation.GetEmail(),
+			Role:           invitation.GetRole(),
+			DepartmentUUID: invitation.GetDepartmentUuid(),
+			Status:         invitation.GetStatus(),
+			ExpiresAt:      invitation.GetExpiresAt(),
+			CreatedAt:      invitation.GetCreatedAt(),
+			CreatedBy:      invitation.GetCreatedBy(),
+			DecidedAt:      invitation.GetDecidedAt(),
+			AcceptedBy:     invitation.GetAcceptedBy(),
+		})
+	}
+	return items
+}
+
+// GetCompanyAuditLog
+//
+//	@Summary		Get company audit log
+//	@Description	Get log of administrative actions in company (chief only), newest first. Available actions: "employee_role_changed", "employee_removed", "department_created", "department_deleted", "company_title_changed", "department_title_changed", "join_code_created"
+//	@Tags			Company
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			company_uuid	path		string	true	"Company UUID"
+//	@Param			actor_uuid		query		string	false	"UUID of user who performed the action"
+//	@Param			action			query		string	false	"Action"
+//	@Param			created_from	query		string	false	"Created from (RFC3339 or YYYY-MM-DD, inclusive)"
+//	@Param			created_to		query		string	false	"Created to (RFC3339 or YYYY-MM-DD, exclusive)"
+//	@Param			offset			query		int		false	"Offset"	default(0)
+//	@Param			count			query		int		false	"Count"		default(10)
+//	@Param			cursor			query		string	false	"Cursor from next_cursor of the previous page (instead of offset)"
+//	@Success		200				{object}	entities.GetCompanyAuditLogResponse
+//	@Failure		400				{object}	Error.HttpError
+//	@Failure		401				{object}	Error.HttpError
+//	@Failure		403				{object}	Error.HttpError
+//	@Failure		404				{object}	Error.HttpError
+//	@Failure		500				{object}	Error.HttpError
+//	@Router			/auth/company/{company_uuid}/audit-log [get]
+func (h *companyHandler) GetCompanyAuditLog(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
+	defer cancel()
+	ctx = h.outgoingContext(c, ctx)
+
+	httpReq := &entities.GetCompanyAuditLogRequest{}
+	if err := c.QueryParser(httpReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: "invalid input"})
+	}
+	httpReq.CompanyUUID = c.Params("company_uuid", "")
+
+	if err := httpReq.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: 400, Message: err.Error()})
+	}
+
+	res, err := h.CompanyServiceClient.GetCompanyAuditLog(ctx, &company_proto.GetCompanyAuditLogRequest{
+		InitiatorUuid: utils.GetLocal[string](c, h.userUUIDKey),
+		CompanyUuid:   httpReq.CompanyUUID,
+		ActorUuid:     httpReq.ActorUUID,
+		Action:        httpReq.Action,
+		CreatedFrom:   httpReq.CreatedFrom,
+		CreatedTo:     httpReq.CreatedTo,
+		Count:         httpReq.Count,
+		Offset:        httpReq.Offset,
+		Cursor:        httpReq.Cursor,
+	})
+	if err != nil {
+		return Error.GRPCErrorToHTTP(err, c)
+	}
+
+	auditEntries := make([]*entities.AuditEntry, 0, len(res.GetEntries()))
+	for _, entry := range res.GetEntries() {
+		auditEntries = append(auditEntries, &entities.AuditEntry{
+			EntryUUID:   entry.GetEntryUuid(),
+			ActorUUID:   entry.GetActorUuid(),
+			Action:      entry.GetAction(),
+			TargetType:  entry.GetTargetType(),
+			TargetID:    entry.GetTargetId(),
+			BeforeValue: entry.GetBeforeValue(),
+			AfterValue:  entry.GetAfterValue(),
+			OperationID: entry.GetOperationId(),
+			CreatedAt:   entry.GetCreatedAt(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(&entities.GetCompanyAuditLogResponse{
+		Entries:    auditEntries,
+		NextCursor: res.GetNextCursor(),
+		TotalCount: res.GetTotalCount(),
+	})
+}
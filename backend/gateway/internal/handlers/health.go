@@ -48,7 +48,7 @@ func NewHealthHandler(authServiceClient auth_proto.AuthServiceClient, companySer
 func (h *healthHandler) Health(c *fiber.Ctx) error {
 	operationID := utils.GetLocal[string](c, h.operationIDKey)
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	ctx, cancel := context.WithTimeout(c.UserContext(), time.Second*5)
 	defer cancel()
 	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptors.OperationIDMetaKey, operationID))
 
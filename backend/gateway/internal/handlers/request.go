@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/unwelcome/FrameWorkTask1/backend/gateway/pkg/utils"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/interceptors"
+	"google.golang.org/grpc/metadata"
+)
+
+// requestKeys Ключи c.Locals, под которыми middleware сохраняют operation id и UUID пользователя
+type requestKeys struct {
+	operationIDKey string
+	userUUIDKey    string
+}
+
+// outgoingContext Контекст исходящего gRPC вызова: operation id запроса и UUID пользователя, проверенный auth middleware.
+// Сервисы доверяют UUID только из этих метаданных, поэтому вызовы из handler-ов строят контекст только здесь.
+func (k requestKeys) outgoingContext(c *fiber.Ctx, ctx context.Context) context.Context {
+	return metadata.NewOutgoingContext(ctx, metadata.Pairs(
+		interceptors.OperationIDMetaKey, utils.GetLocal[string](c, k.operationIDKey),
+		interceptors.UserUUIDMetaKey, utils.GetLocal[string](c, k.userUUIDKey),
+	))
+}
@@ -1,15 +1,28 @@
 package middlewares
 
 import (
-	"crypto/ecdsa"
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
 	Error "github.com/unwelcome/FrameWorkTask1/backend/gateway/internal/errors"
+	"github.com/unwelcome/FrameWorkTask1/backend/gateway/pkg/jwks"
 	"github.com/unwelcome/FrameWorkTask1/backend/gateway/pkg/utils"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/revocation"
 )
 
-func NewAuthMiddleware(publicKey *ecdsa.PublicKey, userUUIDKey string) fiber.Handler {
+// revocationRedisTimeout ограничивает проверку отзыва токена в Redis
+const revocationRedisTimeout = 100 * time.Millisecond
+
+// NewAuthMiddleware Проверка access токена: подпись (ключом из JWKS по kid), тип и отзыв.
+// Отзыв проверяется по ключам, которые auth сервис пишет в общий Redis под префиксом revocationPrefix.
+// Если Redis недоступен или не ответил за revocationRedisTimeout, запрос отклоняется с 503: иначе отозванные
+// сессии снова принимаются именно тогда, когда Redis перегружен. failOpen пропускает такие запросы - только
+// для окружений, где доступность важнее отзыва токенов.
+func NewAuthMiddleware(keys *jwks.Cache, redisClient *redis.Client, revocationPrefix, userUUIDKey string, failOpen bool) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 
 		// Получаем заголовок авторизации
@@ -27,7 +40,7 @@ func NewAuthMiddleware(publicKey *ecdsa.PublicKey, userUUIDKey string) fiber.Han
 		accessToken := authHeader[7:]
 
 		// Парсим токен
-		tokenClaims, err := utils.ParseToken(accessToken, publicKey)
+		tokenClaims, err := utils.ParseToken(accessToken, keys.PublicKey)
 		if err != nil {
 			return Error.Unauthorized(c, fmt.Errorf("parse token error: %w", err).Error())
 		}
@@ -37,6 +50,25 @@ func NewAuthMiddleware(publicKey *ecdsa.PublicKey, userUUIDKey string) fiber.Han
 			return Error.Unauthorized(c, "invalid token type")
 		}
 
+		// Проверяем, не отозвана ли сессия токена
+		var issuedAt int64
+		if tokenClaims.IssuedAt != nil {
+			issuedAt = tokenClaims.IssuedAt.Unix()
+		}
+		ctx, cancel := context.WithTimeout(c.UserContext(), revocationRedisTimeout)
+		revoked, err := revocation.IsRevoked(ctx, redisClient, revocationPrefix, tokenClaims.SessionUUID, tokenClaims.UserUUID, issuedAt)
+		cancel()
+		if err != nil {
+			if !failOpen {
+				log.Error().Err(err).Msg("auth middleware: revocation check failed")
+				return c.Status(fiber.StatusServiceUnavailable).JSON(Error.HttpError{Code: fiber.StatusServiceUnavailable, Message: "token revocation check unavailable"})
+			}
+			log.Warn().Err(err).Msg("auth middleware: revocation check failed, failing open")
+		}
+		if revoked {
+			return Error.Unauthorized(c, "token revoked")
+		}
+
 		// Устанавливаем UserUUID в контекст
 		c.Locals(userUUIDKey, tokenClaims.UserUUID)
 
@@ -0,0 +1,41 @@
+package middlewares
+
+import (
+	"io"
+
+	"github.com/gofiber/fiber/v2"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/gateway/internal/errors"
+)
+
+// NewBodyLimitMiddleware Ограничение размера тела запроса. Сервер отдаёт тело сверх общего лимита потоком
+// (StreamRequestBody), поэтому тело читается здесь не больше чем на limit+1 байт: запрос с телом больше limit
+// отклоняется с 413, не загружаясь в память целиком. Прочитанное тело подставляется в запрос для handler-ов.
+func NewBodyLimitMiddleware(limit int) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		// Content-Length известен заранее - отклоняем, не читая тело
+		if c.Request().Header.ContentLength() > limit {
+			return tooLarge(c)
+		}
+		if !c.Request().IsBodyStream() {
+			return c.Next()
+		}
+
+		// chunked тело без Content-Length дочитываем до лимита
+		body, err := io.ReadAll(io.LimitReader(c.Request().BodyStream(), int64(limit)+1))
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(Error.HttpError{Code: fiber.StatusBadRequest, Message: "invalid request body"})
+		}
+		if len(body) > limit {
+			return tooLarge(c)
+		}
+		c.Request().SetBody(body)
+
+		return c.Next()
+	}
+}
+
+// tooLarge Ответ 413. Непрочитанный остаток тела остаётся в соединении, поэтому оно закрывается
+func tooLarge(c *fiber.Ctx) error {
+	c.Context().SetConnectionClose()
+	return c.Status(fiber.StatusRequestEntityTooLarge).JSON(Error.HttpError{Code: fiber.StatusRequestEntityTooLarge, Message: "request body too large"})
+}
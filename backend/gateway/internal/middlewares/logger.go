@@ -7,6 +7,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/rs/zerolog"
 	"github.com/unwelcome/FrameWorkTask1/backend/gateway/pkg/utils"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/tracing"
 )
 
 // NewRequestLoggerMiddleware logs every HTTP request after it is handled.
@@ -17,7 +18,8 @@ import (
 //   - response time > 2 s → Warn
 //   - otherwise → Info
 //
-// Fields written for every request: id, method, path, ip, status, duration.
+// Fields written for every request: id, trace_id, method, path, ip, status, duration.
+// trace_id is empty when the request is not traced (NewTracingMiddleware must run before this one).
 // Optional fields:
 //   - user  — user UUID from locals; omitted on unauthenticated routes.
 //   - error — error message from the response body; included on 4xx / 5xx only.
@@ -46,6 +48,7 @@ func NewRequestLoggerMiddleware(operationIDKey, userUUIDKey string, httpLog zero
 		e := httpLog.WithLevel(level).
 			Time("time", time.Now()).
 			Str("id", utils.GetLocal[string](c, operationIDKey)).
+			Str("trace_id", tracing.TraceID(c.UserContext())).
 			Str("method", c.Method()).
 			Str("path", c.Path()).
 			Str("ip", c.IP()).
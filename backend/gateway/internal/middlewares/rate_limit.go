@@ -94,7 +94,7 @@ type RateLimiterConfig struct {
 func NewRateLimiter(client *redis.Client, prefix, tierPrefix string, cfg RateLimiterConfig) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		key := fmt.Sprintf("%s:rl:%s:%s", prefix, tierPrefix, cfg.KeyFn(c))
-		ctx, cancel := context.WithTimeout(context.Background(), rateLimitRedisTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), rateLimitRedisTimeout)
 		defer cancel()
 
 		res, err := tokenBucketScript.Run(
@@ -0,0 +1,56 @@
+package middlewares
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/unwelcome/FrameWorkTask1/backend/gateway/pkg/utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/unwelcome/FrameWorkTask1/backend/gateway"
+
+// NewTracingMiddleware starts a server span for every HTTP request.
+//
+// The trace is continued from the W3C traceparent header when the client sends
+// one. The span context is stored in c.UserContext(): handlers derive their
+// gRPC contexts from it, so calls to downstream services become child spans.
+// The span is named after the matched route (e.g. "POST /api/auth/application/:application_uuid/assign")
+// and carries the operation ID to match it with the request log line.
+func NewTracingMiddleware(operationIDKey string) fiber.Handler {
+	tracer := otel.Tracer(tracerName)
+
+	return func(c *fiber.Ctx) error {
+		ctx := otel.GetTextMapPropagator().Extract(c.UserContext(), propagation.HeaderCarrier(c.GetReqHeaders()))
+
+		ctx, span := tracer.Start(ctx, c.Method()+" "+c.Path(),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(c.Method()),
+				semconv.URLPath(c.Path()),
+				semconv.ClientAddress(c.IP()),
+				attribute.String("operation.id", utils.GetLocal[string](c, operationIDKey)),
+			),
+		)
+		defer span.End()
+
+		c.SetUserContext(ctx)
+		err := c.Next()
+
+		// the matched route is only known once the request has been handled
+		route := c.Route().Path
+		span.SetName(c.Method() + " " + route)
+		span.SetAttributes(
+			semconv.HTTPRoute(route),
+			semconv.HTTPResponseStatusCode(c.Response().StatusCode()),
+		)
+		if c.Response().StatusCode() >= fiber.StatusInternalServerError {
+			span.SetStatus(codes.Error, fiber.ErrInternalServerError.Message)
+		}
+
+		return err
+	}
+}
@@ -10,6 +10,9 @@ func SetupRoutes(router *fiber.App, app *app.App) {
 	// Prometheus middleware - первым, чтобы считать все запросы включая swagger
 	router.Use(app.PrometheusMiddleware)
 
+	// Публичные ключи подписи токенов (RFC 7517), вне /api - стандартный адрес для клиентов JWKS
+	router.Get("/.well-known/jwks.json", app.AuthHandler.JWKS)
+
 	api := router.Group("/api")
 
 	// Инициализация swagger
@@ -18,6 +21,9 @@ func SetupRoutes(router *fiber.App, app *app.App) {
 	// Middleware индексирования операций
 	api.Use(app.OperationIDMiddleware)
 
+	// Middleware трассировки - до логирования, чтобы в лог запроса попал trace_id
+	api.Use(app.TracingMiddleware)
+
 	// Middleware логирования
 	api.Use(app.LoggerMiddleware)
 
@@ -27,6 +33,13 @@ func SetupRoutes(router *fiber.App, app *app.App) {
 	// Аутентифицированные ручки — rate-limit по userUUID
 	auth.Use(app.UserRateLimiter)
 
+	// Загрузка вложений со своим лимитом тела запроса. Регистрируется до общего лимита: fiber выполняет
+	// обработчики в порядке регистрации, и ручка завершает цепочку раньше общего BodyLimit
+	auth.Post("/application/:application_uuid/attachments", app.AttachmentBodyLimit, app.ApplicationHandler.UploadApplicationAttachment)
+
+	// Общий лимит тела запроса для остальных ручек
+	api.Use(app.BodyLimit)
+
 	// Health handler
 	api.Get("/health", app.HealthHandler.Health)
 
@@ -40,6 +53,7 @@ func SetupRoutes(router *fiber.App, app *app.App) {
 	api.Post("/user/verify/resend", app.CodeRateLimiter, app.AuthHandler.ResendVerificationCode)
 	api.Post("/forgot-password", app.CodeRateLimiter, app.AuthHandler.ForgotPassword)
 	api.Post("/verify-2fa", app.CodeRateLimiter, app.AuthHandler.Verify2FA)
+	api.Post("/2fa/email-code", app.CodeRateLimiter, app.AuthHandler.Send2FAEmailCode)
 	// Debug-only routes — доступны только при APP_ENV=test
 	if app.AppEnv == "test" {
 		api.Get("/debug/user/email/:email/verification-token", app.AuthHandler.GetVerificationToken)
@@ -55,36 +69,66 @@ func SetupRoutes(router *fiber.App, app *app.App) {
 	auth.Patch("/user/password", app.AuthHandler.ChangePassword)
 	auth.Patch("/user/bio", app.AuthHandler.UpdateUserBio)
 	auth.Patch("/user/2fa", app.AuthHandler.UpdateUser2FA)
+	auth.Post("/user/2fa/totp", app.AuthHandler.EnrollTOTP)
+	auth.Post("/user/2fa/totp/confirm", app.AuthHandler.ConfirmTOTP)
 	auth.Delete("/user/account", app.AuthHandler.DeleteUser)
 
 	// Company handler
 	auth.Get("/company/my", app.CompanyHandler.GetUserCompanies)
+	auth.Get("/company/invitations/my", app.CompanyHandler.GetUserInvitations)
 	auth.Get("/company/list", app.CompanyHandler.GetCompanies)
 	auth.Get("/company/:company_uuid", app.CompanyHandler.GetCompany)
 	auth.Get("/company/:company_uuid/codes", app.CompanyHandler.GetCompanyJoinCodes)
+	auth.Get("/company/:company_uuid/code/:code/redemptions", app.CompanyHandler.GetJoinCodeRedemptions)
+	auth.Get("/company/:company_uuid/join-requests", app.CompanyHandler.GetCompanyJoinRequests)
+	auth.Get("/company/:company_uuid/invitations", app.CompanyHandler.GetCompanyInvitations)
+	auth.Get("/company/:company_uuid/ownership/transfer", app.CompanyHandler.GetCompanyOwnershipTransfer)
+	auth.Get("/company/:company_uuid/deletion", app.CompanyHandler.GetCompanyDeletionStatus)
+	auth.Get("/company/:company_uuid/audit-log", app.CompanyHandler.GetCompanyAuditLog)
 	auth.Get("/company/:company_uuid/employee/:employee_uuid/info", app.CompanyHandler.GetCompanyEmployee)
 	auth.Get("/company/:company_uuid/employees/list", app.CompanyHandler.GetCompanyEmployees)
 	auth.Get("/company/:company_uuid/employees/summary", app.CompanyHandler.GetCompanyEmployeesSummary)
 	auth.Get("/company/:company_uuid/departments/list", app.CompanyHandler.GetCompanyDepartments)
 	auth.Get("/company/:company_uuid/department/:department_uuid", app.CompanyHandler.GetDepartment)
+	auth.Get("/company/:company_uuid/locations/list", app.CompanyHandler.GetCompanyLocations)
+	auth.Get("/company/:company_uuid/location/:location_uuid", app.CompanyHandler.GetLocation)
 	auth.Post("/company/create", app.CompanyHandler.CreateCompany)
 	auth.Post("/company/join", app.CompanyHandler.JoinCompany)
+	auth.Post("/company/invitations/:invitation_uuid/accept", app.CompanyHandler.AcceptCompanyInvitation)
+	auth.Post("/company/invitations/:invitation_uuid/decline", app.CompanyHandler.DeclineCompanyInvitation)
 	auth.Post("/company/:company_uuid/code", app.CompanyHandler.CreateCompanyJoinCode)
+	auth.Post("/company/:company_uuid/join-requests/:join_request_uuid/accept", app.CompanyHandler.AcceptCompanyJoinRequest)
+	auth.Post("/company/:company_uuid/join-requests/:join_request_uuid/decline", app.CompanyHandler.DeclineCompanyJoinRequest)
+	auth.Post("/company/:company_uuid/invitations", app.CompanyHandler.CreateCompanyInvitation)
+	auth.Post("/company/:company_uuid/ownership/transfer", app.CompanyHandler.TransferCompanyOwnership)
+	auth.Post("/company/:company_uuid/ownership/transfer/accept", app.CompanyHandler.AcceptCompanyOwnershipTransfer)
+	auth.Post("/company/:company_uuid/ownership/transfer/decline", app.CompanyHandler.DeclineCompanyOwnershipTransfer)
+	auth.Post("/company/:company_uuid/leave", app.CompanyHandler.LeaveCompany)
+	auth.Post("/company/:company_uuid/restore", app.CompanyHandler.RestoreCompany)
 	auth.Post("/company/:company_uuid/department", app.CompanyHandler.CreateDepartment)
 	auth.Post("/company/:company_uuid/department/:department_uuid/employee/:employee_uuid", app.CompanyHandler.AddEmployeeToDepartment)
+	auth.Post("/company/:company_uuid/location", app.CompanyHandler.CreateLocation)
 	auth.Patch("/company/:company_uuid/title", app.CompanyHandler.UpdateCompanyTitle)
 	auth.Patch("/company/:company_uuid/status", app.CompanyHandler.UpdateCompanyStatus)
+	auth.Patch("/company/:company_uuid/join-settings", app.CompanyHandler.UpdateCompanyJoinSettings)
 	auth.Patch("/company/:company_uuid/employee/:employee_uuid/role", app.CompanyHandler.UpdateEmployeeRole)
 	auth.Patch("/company/:company_uuid/department/:department_uuid/title", app.CompanyHandler.UpdateDepartmentTitle)
+	auth.Patch("/company/:company_uuid/location/:location_uuid", app.CompanyHandler.UpdateLocation)
 	auth.Delete("/company/:company_uuid", app.CompanyHandler.DeleteCompany)
 	auth.Delete("/company/:company_uuid/code", app.CompanyHandler.DeleteCompanyJoinCode)
+	auth.Delete("/company/:company_uuid/invitations/:invitation_uuid", app.CompanyHandler.RevokeCompanyInvitation)
+	auth.Delete("/company/:company_uuid/ownership/transfer", app.CompanyHandler.CancelCompanyOwnershipTransfer)
 	auth.Delete("/company/:company_uuid/employee/:employee_uuid", app.CompanyHandler.RemoveCompanyEmployee)
 	auth.Delete("/company/:company_uuid/department/:department_uuid", app.CompanyHandler.DeleteDepartment)
 	auth.Delete("/company/:company_uuid/department/:department_uuid/employee/:employee_uuid", app.CompanyHandler.RemoveEmployeeFromDepartment)
+	auth.Delete("/company/:company_uuid/location/:location_uuid", app.CompanyHandler.DeleteLocation)
 
 	// Application handler
 	auth.Get("/application/:application_uuid", app.ApplicationHandler.GetApplication)
 	auth.Get("/company/:company_uuid/applications/list", app.ApplicationHandler.GetApplications)
+	auth.Get("/company/:company_uuid/applications/stream", app.ApplicationHandler.WatchApplications)
+	auth.Get("/company/:company_uuid/applications/export", app.ApplicationHandler.ExportApplications)
+	auth.Get("/company/:company_uuid/employee/:employee_uuid/open-applications", app.ApplicationHandler.GetEmployeeOpenApplications)
 	auth.Post("/application/create", app.ApplicationHandler.CreateApplication)
 	auth.Post("/application/:application_uuid/fix-log", app.ApplicationHandler.AddApplicationFixLog)
 	auth.Patch("/application/:application_uuid/status", app.ApplicationHandler.UpdateApplicationStatus)
@@ -95,4 +139,29 @@ func SetupRoutes(router *fiber.App, app *app.App) {
 	auth.Patch("/application/:application_uuid/release-verification", app.ApplicationHandler.ReleaseApplicationVerification)
 	auth.Delete("/application/:application_uuid", app.ApplicationHandler.DeleteApplication)
 	auth.Get("/application/:application_uuid/history", app.ApplicationHandler.GetApplicationHistory)
+	auth.Get("/application/:application_uuid/attachments/:attachment_uuid", app.ApplicationHandler.GetApplicationAttachment)
+	auth.Delete("/application/:application_uuid/attachments/:attachment_uuid", app.ApplicationHandler.DeleteApplicationAttachment)
+	auth.Post("/application/:application_uuid/comments", app.ApplicationHandler.CreateApplicationComment)
+	auth.Get("/application/:application_uuid/comments", app.ApplicationHandler.GetApplicationComments)
+	auth.Patch("/application/:application_uuid/comments/:comment_uuid", app.ApplicationHandler.UpdateApplicationComment)
+	auth.Delete("/application/:application_uuid/comments/:comment_uuid", app.ApplicationHandler.DeleteApplicationComment)
+	auth.Get("/application/:application_uuid/comments/:comment_uuid/history", app.ApplicationHandler.GetApplicationCommentHistory)
+	auth.Get("/application/:application_uuid/actions", app.ApplicationHandler.GetApplicationActions)
+	auth.Get("/company/:company_uuid/workflow", app.ApplicationHandler.GetCompanyWorkflow)
+	auth.Put("/company/:company_uuid/workflow", app.ApplicationHandler.UpdateCompanyWorkflow)
+	auth.Delete("/company/:company_uuid/workflow", app.ApplicationHandler.ResetCompanyWorkflow)
+	auth.Get("/company/:company_uuid/sla", app.ApplicationHandler.GetCompanySLAPolicies)
+	auth.Put("/company/:company_uuid/sla/:priority", app.ApplicationHandler.UpdateCompanySLAPolicy)
+	auth.Delete("/company/:company_uuid/sla/:priority", app.ApplicationHandler.DeleteCompanySLAPolicy)
+	auth.Get("/company/:company_uuid/categories", app.ApplicationHandler.GetApplicationCategories)
+	auth.Post("/company/:company_uuid/categories", app.ApplicationHandler.CreateApplicationCategory)
+	auth.Patch("/company/:company_uuid/categories/:category_uuid", app.ApplicationHandler.UpdateApplicationCategory)
+	auth.Delete("/company/:company_uuid/categories/:category_uuid", app.ApplicationHandler.DeleteApplicationCategory)
+	auth.Get("/company/:company_uuid/custom-fields", app.ApplicationHandler.GetCompanyCustomFields)
+	auth.Put("/company/:company_uuid/custom-fields/:key", app.ApplicationHandler.UpdateCompanyCustomField)
+	auth.Delete("/company/:company_uuid/custom-fields/:key", app.ApplicationHandler.DeleteCompanyCustomField)
+	auth.Get("/company/:company_uuid/analytics/statuses", app.ApplicationHandler.GetApplicationStatusStats)
+	auth.Get("/company/:company_uuid/analytics/lead-times", app.ApplicationHandler.GetApplicationLeadTimes)
+	auth.Get("/company/:company_uuid/analytics/revisions", app.ApplicationHandler.GetEngineerRevisionStats)
+	auth.Get("/company/:company_uuid/analytics/failures", app.ApplicationHandler.GetDepartmentFailureStats)
 }
@@ -0,0 +1,50 @@
+package csvexport
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+)
+
+// formulaPrefixes Символы, с которых Excel и LibreOffice начинают формулу в ячейке CSV
+const formulaPrefixes = "=+-@\t\r"
+
+// Writer пишет CSV построчно. Ячейки, которые табличный редактор выполнит как формулу,
+// экранируются ведущим апострофом - пользовательский текст всегда открывается как текст.
+type Writer struct {
+	csv    *csv.Writer
+	record []string
+}
+
+// NewWriter создаёт Writer, пишущий CSV в w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{csv: csv.NewWriter(w)}
+}
+
+// Write добавляет строку, экранируя ячейки-формулы.
+func (w *Writer) Write(record []string) error {
+	w.record = w.record[:0]
+	for _, value := range record {
+		w.record = append(w.record, Neutralize(value))
+	}
+	return w.csv.Write(w.record)
+}
+
+// Flush отправляет буферизованные строки в нижележащий io.Writer.
+func (w *Writer) Flush() error {
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+// Close завершает запись файла.
+func (w *Writer) Close() error {
+	return w.Flush()
+}
+
+// Neutralize возвращает значение ячейки, которое не будет выполнено как формула.
+func Neutralize(value string) string {
+	if value != "" && strings.ContainsRune(formulaPrefixes, rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
@@ -0,0 +1,56 @@
+package csvexport
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+)
+
+func TestNeutralize(t *testing.T) {
+	cases := map[string]string{
+		"":                         "",
+		"Leaking pipe":             "Leaking pipe",
+		"2026-01-01T00:00:00Z":     "2026-01-01T00:00:00Z",
+		"a=b":                      "a=b",
+		"=HYPERLINK(\"http://x\")": "'=HYPERLINK(\"http://x\")",
+		"+1+1":                     "'+1+1",
+		"-2+3":                     "'-2+3",
+		"@SUM(A1:A2)":              "'@SUM(A1:A2)",
+		"\t=1+1":                   "'\t=1+1",
+		"\r=1+1":                   "'\r=1+1",
+	}
+	for value, expected := range cases {
+		if got := Neutralize(value); got != expected {
+			t.Errorf("Neutralize(%q) = %q, expected %q", value, got, expected)
+		}
+	}
+}
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+
+	if err := w.Write([]string{"title", "description"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write([]string{"=cmd|' /C calc'!A0", "line 1\nline 2"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(records))
+	}
+	if records[1][0] != "'=cmd|' /C calc'!A0" {
+		t.Errorf("formula cell not neutralized: %q", records[1][0])
+	}
+	if records[1][1] != "line 1\nline 2" {
+		t.Errorf("multiline cell changed: %q", records[1][1])
+	}
+}
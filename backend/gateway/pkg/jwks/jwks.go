@@ -0,0 +1,221 @@
+package jwks
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"encoding/base64"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Статусы ключей в JWKS auth сервиса
+const (
+	StatusActive  = "active"
+	StatusNext    = "next"
+	StatusRetired = "retired"
+)
+
+const (
+	// minRefreshInterval Не чаще одного внепланового обновления при неизвестном kid -
+	// токены с выдуманным kid не должны превращаться в поток запросов к auth сервису
+	minRefreshInterval = 10 * time.Second
+	fetchTimeout       = 3 * time.Second
+)
+
+// Key Публичный ключ подписи в формате RFC 7517
+type Key struct {
+	Kid    string `json:"kid"`
+	Kty    string `json:"kty"`
+	Crv    string `json:"crv"`
+	X      string `json:"x"`
+	Y      string `json:"y"`
+	Alg    string `json:"alg"`
+	Use    string `json:"use"`
+	Status string `json:"status"`
+	// RetiredAt Unix время вывода ключа в auth сервисе, только для retired
+	RetiredAt int64 `json:"retired_at,omitempty"`
+}
+
+// Set Документ /.well-known/jwks.json
+type Set struct {
+	Keys []Key `json:"keys"`
+}
+
+// FetchFunc Загружает актуальный JWKS из auth сервиса
+type FetchFunc func(ctx context.Context) (Set, error)
+
+type entry struct {
+	public    *ecdsa.PublicKey
+	status    string
+	retiredAt time.Time // момент вывода ключа, опубликованный auth сервисом
+}
+
+// Cache Кеш ключей подписи. Обновляется по таймеру и при встрече неизвестного kid.
+// Принимаются все невыведенные ключи (active и next); выведенный ключ принимается ещё retiredGrace
+// от опубликованного в JWKS retired_at, чтобы выданные до ротации access токены не отклонялись разом.
+// Отсчёт от retired_at одинаков на всех репликах и не начинается заново при перезапуске gateway;
+// выведенный ключ без retired_at не принимается. Ключ, удалённый из JWKS, перестаёт
+// приниматься сразу после обновления кеша - так отзывается скомпрометированный ключ.
+type Cache struct {
+	fetch           FetchFunc
+	refreshInterval time.Duration
+	retiredGrace    time.Duration
+
+	mu        sync.RWMutex
+	set       Set
+	keys      map[string]*entry
+	activeKID string
+	lastFetch time.Time
+
+	refreshMu sync.Mutex
+}
+
+func New(fetch FetchFunc, refreshInterval, retiredGrace time.Duration) *Cache {
+	return &Cache{
+		fetch:           fetch,
+		refreshInterval: refreshInterval,
+		retiredGrace:    retiredGrace,
+		set:             Set{Keys: []Key{}},
+		keys:            make(map[string]*entry),
+	}
+}
+
+// Run Периодически обновляет кеш до отмены ctx. Первая загрузка - сразу при старте.
+func (c *Cache) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.refresh(ctx); err != nil {
+			log.Warn().Err(err).Msg("jwks: refresh failed, using cached keys")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PublicKey Ключ проверки подписи по kid. Токены без kid выпущены до ротации ключей - проверяются активным ключом.
+func (c *Cache) PublicKey(kid string) (*ecdsa.PublicKey, error) {
+	key, err := c.lookup(kid)
+	if err == nil {
+		return key, nil
+	}
+
+	// Ключ мог появиться после последнего обновления (ротация или gateway запустился раньше auth)
+	c.mu.RLock()
+	stale := time.Since(c.lastFetch) >= minRefreshInterval
+	c.mu.RUnlock()
+	if !stale {
+		return nil, err
+	}
+
+	if refreshErr := c.refresh(context.Background()); refreshErr != nil {
+		log.Warn().Err(refreshErr).Msg("jwks: on-demand refresh failed")
+		return nil, err
+	}
+	return c.lookup(kid)
+}
+
+// Set Текущий JWKS для публикации
+func (c *Cache) Set() Set {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.set
+}
+
+// Ready Проверка готовности для /readyz: без загруженных ключей gateway отклоняет все токены
+func (c *Cache) Ready(context.Context) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if len(c.keys) == 0 {
+		return fmt.Errorf("no signing keys loaded")
+	}
+	return nil
+}
+
+// ─── Вспомогательные функции ──────────────────────────────────────────────────
+
+func (c *Cache) lookup(kid string) (*ecdsa.PublicKey, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if kid == "" {
+		kid = c.activeKID
+	}
+	key, ok := c.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id")
+	}
+	if key.status == StatusRetired && (key.retiredAt.IsZero() || time.Since(key.retiredAt) > c.retiredGrace) {
+		return nil, fmt.Errorf("key retired")
+	}
+	return key.public, nil
+}
+
+func (c *Cache) refresh(ctx context.Context) error {
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+
+	set, err := c.fetch(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Время попытки фиксируем и при ошибке - иначе недоступный auth сервис получал бы запрос на каждый токен
+	c.lastFetch = time.Now()
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string]*entry, len(set.Keys))
+	activeKID := ""
+	for _, key := range set.Keys {
+		public, parseErr := parseKey(key)
+		if parseErr != nil {
+			log.Warn().Err(parseErr).Str("kid", key.Kid).Msg("jwks: skipping invalid key")
+			continue
+		}
+
+		e := &entry{public: public, status: key.Status}
+		if key.Status == StatusRetired && key.RetiredAt > 0 {
+			e.retiredAt = time.Unix(key.RetiredAt, 0)
+		}
+		if key.Status == StatusActive {
+			activeKID = key.Kid
+		}
+		keys[key.Kid] = e
+	}
+
+	c.set = set
+	c.keys = keys
+	c.activeKID = activeKID
+	return nil
+}
+
+// parseKey Восстанавливает публичный ключ P-256 из координат JWK
+func parseKey(key Key) (*ecdsa.PublicKey, error) {
+	if key.Kty != "EC" || key.Crv != "P-256" {
+		return nil, fmt.Errorf("unsupported key type %s/%s", key.Kty, key.Crv)
+	}
+	x, err := base64.RawURLEncoding.DecodeString(key.X)
+	if err != nil {
+		return nil, fmt.Errorf("decode x: %w", err)
+	}
+	y, err := base64.RawURLEncoding.DecodeString(key.Y)
+	if err != nil {
+		return nil, fmt.Errorf("decode y: %w", err)
+	}
+
+	point := append([]byte{0x04}, append(x, y...)...)
+	return ecdsa.ParseUncompressedPublicKey(elliptic.P256(), point)
+}
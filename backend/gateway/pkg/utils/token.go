@@ -13,18 +13,23 @@ const (
 )
 
 type TokenClaims struct {
-	UserUUID  string `json:"user_uuid"`
-	TokenType string `json:"token_type"`
+	UserUUID    string `json:"user_uuid"`
+	SessionUUID string `json:"sid"`
+	TokenType   string `json:"token_type"`
 	jwt.RegisteredClaims
 }
 
-// ParseToken Парсинг и верификация JWT токена по публичному ключу (ES256)
-func ParseToken(tokenString string, publicKey *ecdsa.PublicKey) (*TokenClaims, error) {
+// PublicKeyFunc Возвращает публичный ключ по kid из заголовка токена
+type PublicKeyFunc func(kid string) (*ecdsa.PublicKey, error)
+
+// ParseToken Парсинг и верификация JWT токена (ES256) ключом, выбранным по kid
+func ParseToken(tokenString string, publicKey PublicKeyFunc) (*TokenClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &TokenClaims{}, func(token *jwt.Token) (any, error) {
 		if token.Method != jwt.SigningMethodES256 {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return publicKey, nil
+		kid, _ := token.Header["kid"].(string)
+		return publicKey(kid)
 	})
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"github.com/unwelcome/FrameWorkTask1/backend/notification/internal/config"
+	"github.com/unwelcome/FrameWorkTask1/backend/notification/internal/messaging"
+	"github.com/unwelcome/FrameWorkTask1/backend/notification/internal/services"
+	"github.com/unwelcome/FrameWorkTask1/backend/notification/internal/templates"
+	"github.com/unwelcome/FrameWorkTask1/backend/notification/internal/transport"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/lifecycle"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/logger"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/tracing"
+)
+
+func main() {
+	cfg := config.NewConfig()
+
+	loggerConf, _ := logger.Setup(cfg.Log.Path, cfg.Log.ConsoleOut)
+	log.Logger = *loggerConf
+
+	// Остановка по SIGINT / SIGTERM: воркеры, затем соединения (см. lifecycle)
+	lc := lifecycle.New(cfg.ShutdownTimeout)
+
+	// Трассировка: спаны экспортируются в OTLP коллектор, если задан OTEL_EXPORTER_OTLP_ENDPOINT
+	shutdownTracing, err := tracing.Setup(context.Background(), "notification_service", cfg.Tracing)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to setup tracing")
+	}
+	lc.OnClose("tracing", shutdownTracing)
+
+	renderer, err := templates.NewRenderer(cfg.DefaultLanguage)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to load email templates")
+	}
+
+	// Транспорт писем: smtp (в локальном окружении - mailpit) или file (.eml файлы для тестов)
+	var mailer transport.Transport
+	switch cfg.Transport {
+	case "smtp":
+		mailer = transport.NewSMTPTransport(cfg.SMTP)
+	case "file":
+		mailer, err = transport.NewFileTransport(cfg.FileOutDir, cfg.SMTP.From)
+		if err != nil {
+			log.Fatal().Err(err).Str("dir", cfg.FileOutDir).Msg("failed to init file transport")
+		}
+	default:
+		log.Fatal().Str("transport", cfg.Transport).Msg("unknown notification transport")
+	}
+
+	notificationService := services.NewNotificationService(renderer, mailer)
+
+	// Consumer - воркер: при остановке отменяет подписки и дожидается обработки уже полученных писем
+	consumer := messaging.NewConsumer(
+		cfg.RabbitMQ.ConnectionString(),
+		services.EmailQueues(),
+		cfg.RetryDelays,
+		cfg.Prefetch,
+		notificationService.HandleEmail,
+	)
+	lc.ReadinessCheck("rabbitMQ", consumer.Ready)
+	lc.Go("email consumer", consumer.Run)
+
+	// /metrics, /livez и /readyz
+	lc.StartProbeServer(cfg.MetricsPort)
+	lc.Ready()
+
+	log.Info().Str("transport", cfg.Transport).Msg("notification service started")
+	if err := lc.Wait(); err != nil {
+		log.Fatal().Err(err).Msg("notification service stopped with error")
+	}
+}
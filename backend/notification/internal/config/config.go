@@ -0,0 +1,78 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	sharedConfig "github.com/unwelcome/FrameWorkTask1/backend/shared/config"
+)
+
+type Config struct {
+	MetricsPort     int
+	ShutdownTimeout time.Duration
+	Log             LogConfig
+	RabbitMQ        sharedConfig.RabbitMQConfig
+	Tracing         sharedConfig.TracingConfig
+	Transport       string // smtp | file
+	SMTP            SMTPConfig
+	FileOutDir      string
+	DefaultLanguage string
+	Prefetch        int
+	RetryDelays     []time.Duration
+}
+
+type LogConfig struct {
+	Path       string
+	ConsoleOut bool
+}
+
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	User     string
+	Password string
+	From     string
+	// StartTLS включает STARTTLS; для mailpit и локальной разработки выключен
+	StartTLS bool
+	Timeout  time.Duration
+}
+
+func NewConfig() *Config {
+	return &Config{
+		MetricsPort:     sharedConfig.ParseIntOrDefault("METRICS_PORT", 2112),
+		ShutdownTimeout: sharedConfig.ParseDurationOrDefault("SHUTDOWN_TIMEOUT", 25*time.Second),
+		Log: LogConfig{
+			Path:       sharedConfig.MustGetEnv("LOG_PATH"),
+			ConsoleOut: sharedConfig.MustParseBool("LOG_CONSOLE_OUT"),
+		},
+		RabbitMQ:  sharedConfig.NewRabbitMQConfig(),
+		Tracing:   sharedConfig.NewTracingConfig(),
+		Transport: sharedConfig.GetEnvOrDefault("NOTIFICATION_TRANSPORT", "smtp"),
+		SMTP: SMTPConfig{
+			Host:     sharedConfig.GetEnvOrDefault("SMTP_HOST", "mailpit"),
+			Port:     sharedConfig.ParseIntOrDefault("SMTP_PORT", 1025),
+			User:     sharedConfig.GetEnvOrDefault("SMTP_USER", ""),
+			Password: sharedConfig.GetEnvOrDefault("SMTP_PASSWORD", ""),
+			From:     sharedConfig.GetEnvOrDefault("SMTP_FROM", "no-reply@framework.local"),
+			StartTLS: sharedConfig.ParseBoolOrDefault("SMTP_STARTTLS", false),
+			Timeout:  sharedConfig.ParseDurationOrDefault("SMTP_TIMEOUT", 10*time.Second),
+		},
+		FileOutDir:      sharedConfig.GetEnvOrDefault("NOTIFICATION_FILE_DIR", "/var/log/app/mail"),
+		DefaultLanguage: sharedConfig.GetEnvOrDefault("NOTIFICATION_DEFAULT_LANGUAGE", "ru"),
+		Prefetch:        sharedConfig.ParseIntOrDefault("NOTIFICATION_PREFETCH", 10),
+		RetryDelays:     parseDurations(sharedConfig.ParseStringSliceOrDefault("NOTIFICATION_RETRY_DELAYS", []string{"10s", "1m", "5m"})),
+	}
+}
+
+// parseDurations Разбор списка задержек повторной отправки (каждая задержка - отдельная retry-очередь)
+func parseDurations(values []string) []time.Duration {
+	durations := make([]time.Duration, 0, len(values))
+	for _, v := range values {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			panic(fmt.Sprintf("environment variable %q must be a list of positive durations (e.g. 10s,1m), got %q", "NOTIFICATION_RETRY_DELAYS", v))
+		}
+		durations = append(durations, d)
+	}
+	return durations
+}
@@ -0,0 +1,31 @@
+package entities
+
+// EmailMsg Сообщение из email-очередей auth сервиса (auth/internal/entities/messages.go)
+// и company сервиса (company/internal/entities/messages.go).
+// Все типы сообщений разбираются в одну структуру - набор обязательных полей
+// зависит от очереди и проверяется при обработке.
+type EmailMsg struct {
+	UserUUID  string `json:"user_uuid"`
+	Email     string `json:"email"`
+	FirstName string `json:"first_name"`
+	Token     string `json:"token"`
+	Code      string `json:"code"`
+	IP        string `json:"ip"`
+	Browser   string `json:"browser"`
+	OS        string `json:"os"`
+	LoginAt   int64  `json:"login_at"`
+	// Приглашение в компанию
+	InvitationUUID string `json:"invitation_uuid"`
+	CompanyTitle   string `json:"company_title"`
+	Role           string `json:"role"`
+	ExpiresAt      int64  `json:"expires_at"`
+	// Language Язык письма (ru/en). Если не указан - используется язык по умолчанию
+	Language string `json:"language,omitempty"`
+}
+
+// Email Готовое к отправке письмо
+type Email struct {
+	To      string
+	Subject string
+	Body    string
+}
@@ -0,0 +1,292 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/rs/zerolog/log"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/rabbitMQ"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/tracing"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// DeadLetterQueue Очередь писем, которые не удалось отправить (некорректные или исчерпавшие попытки)
+	DeadLetterQueue = "email.dead-letter"
+
+	retryAttemptHeader  = "x-retry-attempt"
+	originalQueueHeader = "x-original-queue"
+	errorHeader         = "x-error"
+
+	reconnectDelay = 5 * time.Second
+	handleTimeout  = 30 * time.Second
+)
+
+// Handler Обработчик сообщения очереди. InvalidArgument - сообщение отправляется сразу в DLQ,
+// любой другой код ошибки - повтор с задержкой
+type Handler func(ctx context.Context, queue string, body []byte) Error.CodeError
+
+// Consumer Потребитель email очередей с повторной отправкой и dead-letter очередью.
+//
+// Повтор с backoff реализован через отложенные очереди <queue>.retry.<delay>:
+// сообщение публикуется в очередь с x-message-ttl = delay, по истечении TTL брокер
+// возвращает его в исходную очередь (x-dead-letter-routing-key). Номер попытки хранится
+// в заголовке x-retry-attempt. После последней задержки сообщение уходит в email.dead-letter.
+type Consumer struct {
+	connectString string
+	queues        []string
+	retryDelays   []time.Duration
+	prefetch      int
+	handle        Handler
+
+	subscribed atomic.Bool // подписка на очереди активна - для /readyz
+}
+
+func NewConsumer(connectString string, queues []string, retryDelays []time.Duration, prefetch int, handle Handler) *Consumer {
+	return &Consumer{
+		connectString: connectString,
+		queues:        queues,
+		retryDelays:   retryDelays,
+		prefetch:      prefetch,
+		handle:        handle,
+	}
+}
+
+// Run Обработка очередей до отмены ctx. При потере соединения переподключается через reconnectDelay
+func (c *Consumer) Run(ctx context.Context) {
+	for {
+		err := c.consume(ctx)
+		if ctx.Err() != nil {
+			log.Info().Msg("consumer stopped")
+			return
+		}
+
+		log.Warn().Err(err).Msgf("rabbitMQ connection lost, reconnecting in %s...", reconnectDelay)
+		select {
+		case <-time.After(reconnectDelay):
+		case <-ctx.Done():
+			log.Info().Msg("consumer stopped")
+			return
+		}
+	}
+}
+
+// Ready Проверка готовности для /readyz: consumer подписан на очереди
+func (c *Consumer) Ready(context.Context) error {
+	if !c.subscribed.Load() {
+		return fmt.Errorf("not subscribed to email queues")
+	}
+	return nil
+}
+
+// consume Одна сессия подключения: объявление топологии, подписка на очереди и обработка сообщений
+func (c *Consumer) consume(ctx context.Context) error {
+	conn, ch, err := rabbitMQ.Dial(c.connectString)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if err = c.declareTopology(ch); err != nil {
+		return err
+	}
+	if err = ch.Qos(c.prefetch, 0, false); err != nil {
+		return err
+	}
+	// Подтверждения брокера для публикаций в retry очереди и DLQ
+	if err = ch.Confirm(false); err != nil {
+		return err
+	}
+
+	closed := conn.NotifyClose(make(chan *amqp.Error, 1))
+
+	var wg sync.WaitGroup
+	for _, queue := range c.queues {
+		deliveries, err := ch.Consume(
+			queue,                  // queue
+			consumerTag(queue),     // consumer
+			false,                  // auto-ack
+			false,                  // exclusive
+			false,                  // no-local
+			false,                  // no-wait
+			nil,
+		)
+		if err != nil {
+			return fmt.Errorf("consume %s: %w", queue, err)
+		}
+
+		wg.Add(1)
+		go func(queue string, deliveries <-chan amqp.Delivery) {
+			defer wg.Done()
+			for d := range deliveries {
+				c.process(ctx, ch, queue, d)
+			}
+		}(queue, deliveries)
+	}
+
+	log.Info().Strs("queues", c.queues).Msg("consumer subscribed")
+	c.subscribed.Store(true)
+	defer c.subscribed.Store(false)
+
+	select {
+	case <-ctx.Done():
+		// Отменяем подписки и дожидаемся обработки уже полученных сообщений
+		for _, queue := range c.queues {
+			_ = ch.Cancel(consumerTag(queue), false)
+		}
+		wg.Wait()
+		return nil
+	case amqpErr := <-closed:
+		wg.Wait()
+		if amqpErr == nil {
+			return fmt.Errorf("connection closed")
+		}
+		return amqpErr
+	}
+}
+
+// process Обработка одного сообщения: ack при успехе, иначе перенос в retry очередь или DLQ
+func (c *Consumer) process(ctx context.Context, ch *amqp.Channel, queue string, d amqp.Delivery) {
+	// Уже полученное сообщение дообрабатываем и при остановке сервиса
+	handleCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), handleTimeout)
+	defer cancel()
+
+	// Обработка продолжает трассу запроса, отправившего письмо (контекст трассы в заголовках сообщения)
+	handleCtx, span := tracing.StartConsume(handleCtx, queue, d)
+	handleErr := c.handle(handleCtx, queue, d.Body)
+	tracing.EndSpan(span, handleErr.Err)
+	if handleErr.Code == 0 {
+		_ = d.Ack(false)
+		return
+	}
+
+	attempt := retryAttempt(d.Headers)
+	headers := amqp.Table{
+		originalQueueHeader: queue,
+		errorHeader:         handleErr.Error(),
+		retryAttemptHeader:  int32(attempt + 1),
+	}
+
+	target := DeadLetterQueue
+	if handleErr.Code != codes.InvalidArgument && attempt < len(c.retryDelays) {
+		target = retryQueueName(queue, c.retryDelays[attempt])
+	}
+
+	if err := publish(handleCtx, ch, target, d, headers); err != nil {
+		// Не смогли переложить - возвращаем в исходную очередь, сообщение не теряется
+		log.Error().Err(err).Str("queue", queue).Str("target", target).Msg("failed to reschedule message")
+		_ = d.Nack(false, true)
+		return
+	}
+
+	if target == DeadLetterQueue {
+		log.Error().Err(handleErr).Str("queue", queue).Int("attempt", attempt+1).Msg("message moved to dead-letter queue")
+	} else {
+		log.Warn().Err(handleErr).Str("queue", queue).Int("attempt", attempt+1).Str("retry_queue", target).Msg("message scheduled for retry")
+	}
+	_ = d.Ack(false)
+}
+
+// declareTopology Объявление исходных очередей (с теми же аргументами, что и в auth), retry очередей и DLQ
+func (c *Consumer) declareTopology(ch *amqp.Channel) error {
+	for _, queue := range c.queues {
+		if err := declareQuorumQueue(ch, queue, nil); err != nil {
+			return err
+		}
+
+		for _, delay := range c.retryDelays {
+			err := declareQuorumQueue(ch, retryQueueName(queue, delay), amqp.Table{
+				"x-message-ttl":             delay.Milliseconds(),
+				"x-dead-letter-exchange":    "",
+				"x-dead-letter-routing-key": queue,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return declareQuorumQueue(ch, DeadLetterQueue, nil)
+}
+
+func declareQuorumQueue(ch *amqp.Channel, name string, args amqp.Table) error {
+	if args == nil {
+		args = amqp.Table{}
+	}
+	args[amqp.QueueTypeArg] = amqp.QueueTypeQuorum
+
+	_, err := ch.QueueDeclare(
+		name,  // name
+		true,  // durable
+		false, // delete when unused
+		false, // exclusive
+		false, // no-wait
+		args,
+	)
+	if err != nil {
+		return fmt.Errorf("declare %s queue: %w", name, err)
+	}
+	return nil
+}
+
+// publish Публикация копии сообщения в очередь через default exchange с ожиданием подтверждения
+func publish(ctx context.Context, ch *amqp.Channel, queue string, d amqp.Delivery, headers amqp.Table) error {
+	msg := amqp.Publishing{
+		ContentType:  d.ContentType,
+		DeliveryMode: amqp.Persistent,
+		Headers:      headers,
+		Body:         d.Body,
+	}
+
+	// Повторная попытка остаётся в трассе исходного сообщения
+	ctx, span := tracing.StartPublish(ctx, "", queue, &msg)
+	defer span.End()
+
+	confirmation, err := ch.PublishWithDeferredConfirmWithContext(ctx,
+		"",    // exchange
+		queue, // routing key
+		false, // mandatory
+		false, // immediate
+		msg)
+	if err != nil {
+		return err
+	}
+
+	acked, err := confirmation.WaitContext(ctx)
+	if err != nil {
+		return err
+	}
+	if !acked {
+		return fmt.Errorf("message was not confirmed by broker")
+	}
+	return nil
+}
+
+// retryQueueName Имя отложенной очереди включает задержку, чтобы смена NOTIFICATION_RETRY_DELAYS
+// не конфликтовала с уже объявленными очередями (x-message-ttl нельзя изменить)
+func retryQueueName(queue string, delay time.Duration) string {
+	return fmt.Sprintf("%s.retry.%s", queue, delay)
+}
+
+func consumerTag(queue string) string {
+	return "notification." + queue
+}
+
+// retryAttempt Номер уже выполненной повторной попытки из заголовков сообщения
+func retryAttempt(headers amqp.Table) int {
+	switch v := headers[retryAttemptHeader].(type) {
+	case int32:
+		return int(v)
+	case int64:
+		return int(v)
+	case int:
+		return v
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/notification/internal/entities"
+	"github.com/unwelcome/FrameWorkTask1/backend/notification/internal/templates"
+	"google.golang.org/grpc/codes"
+)
+
+// ─── Mock: Transport ─────────────────────────────────────────────────────────
+
+type mockTransport struct {
+	send func(ctx context.Context, email entities.Email) error
+	sent []entities.Email
+}
+
+func (m *mockTransport) Send(ctx context.Context, email entities.Email) error {
+	if m.send != nil {
+		if err := m.send(ctx, email); err != nil {
+			return err
+		}
+	}
+	m.sent = append(m.sent, email)
+	return nil
+}
+
+// ─── Helpers ─────────────────────────────────────────────────────────────────
+
+func newTestService(t *testing.T, transport *mockTransport) *NotificationService {
+	t.Helper()
+	renderer, err := templates.NewRenderer("ru")
+	if err != nil {
+		t.Fatalf("failed to load templates: %v", err)
+	}
+	return NewNotificationService(renderer, transport)
+}
+
+// assertCode проверяет код ошибки обработки (codes.OK - успех)
+func assertCode(t *testing.T, got codes.Code, want codes.Code) {
+	t.Helper()
+	if got != want {
+		t.Errorf("expected code %v, got %v", want, got)
+	}
+}
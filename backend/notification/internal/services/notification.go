@@ -0,0 +1,100 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/mail"
+	"sort"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/notification/internal/entities"
+	"github.com/unwelcome/FrameWorkTask1/backend/notification/internal/templates"
+	"github.com/unwelcome/FrameWorkTask1/backend/notification/internal/transport"
+	Error "github.com/unwelcome/FrameWorkTask1/backend/shared/errors"
+	"google.golang.org/grpc/codes"
+)
+
+// emailKind Тип письма: шаблон и обязательные поля сообщения
+type emailKind struct {
+	template string
+	required func(msg *entities.EmailMsg) bool
+}
+
+// emailKinds Очереди auth сервиса (auth/internal/messaging/publisher.go)
+// и company сервиса (company/internal/messaging/publisher.go) → тип письма
+var emailKinds = map[string]emailKind{
+	"verification.email":         {template: "verification", required: func(m *entities.EmailMsg) bool { return m.Token != "" }},
+	"recovery.email":             {template: "recovery", required: func(m *entities.EmailMsg) bool { return m.Token != "" }},
+	"2fa.email":                  {template: "2fa", required: func(m *entities.EmailMsg) bool { return m.Code != "" }},
+	"password-changed.email":     {template: "password-changed"},
+	"password-reset.email":       {template: "password-reset"},
+	"registration-attempt.email": {template: "registration-attempt"},
+	"login-notification.email":   {template: "login-notification", required: func(m *entities.EmailMsg) bool { return m.LoginAt != 0 }},
+	"token-reuse-alert.email":    {template: "token-reuse-alert"},
+	"company-invitation.email":   {template: "company-invitation", required: func(m *entities.EmailMsg) bool { return m.CompanyTitle != "" && m.Role != "" && m.ExpiresAt != 0 }},
+}
+
+// EmailQueues Список обрабатываемых очередей
+func EmailQueues() []string {
+	queues := make([]string, 0, len(emailKinds))
+	for queue := range emailKinds {
+		queues = append(queues, queue)
+	}
+	sort.Strings(queues)
+	return queues
+}
+
+type NotificationService struct {
+	renderer  *templates.Renderer
+	transport transport.Transport
+}
+
+func NewNotificationService(renderer *templates.Renderer, transport transport.Transport) *NotificationService {
+	return &NotificationService{
+		renderer:  renderer,
+		transport: transport,
+	}
+}
+
+// HandleEmail Обработка сообщения из email очереди: разбор, рендер шаблона и отправка.
+// InvalidArgument - сообщение некорректно или отклонено сервером, повтор не имеет смысла (сразу в DLQ).
+// Остальные коды - временная ошибка, сообщение будет отправлено повторно.
+func (s *NotificationService) HandleEmail(ctx context.Context, queue string, body []byte) Error.CodeError {
+	kind, ok := emailKinds[queue]
+	if !ok {
+		return Error.Public(codes.InvalidArgument, "unknown email queue")
+	}
+
+	msg := &entities.EmailMsg{}
+	if err := json.Unmarshal(body, msg); err != nil {
+		return Error.Public(codes.InvalidArgument, "invalid message body")
+	}
+
+	// Адрес должен быть голым email - без имени и переводов строк (защита от инъекции заголовков)
+	address, err := mail.ParseAddress(msg.Email)
+	if err != nil || address.Address != msg.Email {
+		return Error.Public(codes.InvalidArgument, "invalid recipient email")
+	}
+
+	if kind.required != nil && !kind.required(msg) {
+		return Error.Public(codes.InvalidArgument, "missing required message fields")
+	}
+
+	subject, text, err := s.renderer.Render(kind.template, msg.Language, msg)
+	if err != nil {
+		return Error.CodeError{Code: codes.InvalidArgument, Err: err}
+	}
+
+	if err = s.transport.Send(ctx, entities.Email{
+		To:      msg.Email,
+		Subject: subject,
+		Body:    text,
+	}); err != nil {
+		if errors.Is(err, transport.ErrRejected) {
+			return Error.CodeError{Code: codes.InvalidArgument, Err: err}
+		}
+		return Error.CodeError{Code: codes.Unavailable, Err: err}
+	}
+
+	return Error.CodeError{}
+}
@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/notification/internal/entities"
+	"github.com/unwelcome/FrameWorkTask1/backend/notification/internal/transport"
+	"google.golang.org/grpc/codes"
+)
+
+// fullMsg — сообщение со всеми полями, подходящее для любой очереди
+func fullMsg(language string) string {
+	return fmt.Sprintf(`{"user_uuid":"u-1","email":"ivan@example.com","first_name":"Ivan","token":"TOKEN-123","code":"654321","ip":"10.0.0.1","browser":"Firefox","os":"Linux","login_at":1700000000,"company_title":"Acme","role":"engineer","expires_at":1700000000,"language":%q}`, language)
+}
+
+// ─── HandleEmail ─────────────────────────────────────────────────────────────
+
+func TestHandleEmail(t *testing.T) {
+	t.Run("every queue renders in both languages", func(t *testing.T) {
+		for _, queue := range EmailQueues() {
+			for _, language := range []string{"ru", "en"} {
+				mt := &mockTransport{}
+				svc := newTestService(t, mt)
+
+				err := svc.HandleEmail(context.Background(), queue, []byte(fullMsg(language)))
+				assertCode(t, err.Code, codes.OK)
+				if len(mt.sent) != 1 {
+					t.Fatalf("%s/%s: expected 1 email, got %d", queue, language, len(mt.sent))
+				}
+
+				email := mt.sent[0]
+				if email.To != "ivan@example.com" {
+					t.Errorf("%s/%s: unexpected recipient %q", queue, language, email.To)
+				}
+				if email.Subject == "" || strings.Contains(email.Subject, "\n") {
+					t.Errorf("%s/%s: invalid subject %q", queue, language, email.Subject)
+				}
+				if !strings.Contains(email.Body, "Ivan") {
+					t.Errorf("%s/%s: body must greet the user, got %q", queue, language, email.Body)
+				}
+			}
+		}
+	})
+
+	t.Run("verification contains token", func(t *testing.T) {
+		mt := &mockTransport{}
+		svc := newTestService(t, mt)
+
+		err := svc.HandleEmail(context.Background(), "verification.email", []byte(`{"email":"ivan@example.com","first_name":"Ivan","token":"TOKEN-123"}`))
+		assertCode(t, err.Code, codes.OK)
+		if !strings.Contains(mt.sent[0].Body, "TOKEN-123") {
+			t.Errorf("expected token in body, got %q", mt.sent[0].Body)
+		}
+	})
+
+	t.Run("company invitation contains company and role", func(t *testing.T) {
+		mt := &mockTransport{}
+		svc := newTestService(t, mt)
+
+		err := svc.HandleEmail(context.Background(), "company-invitation.email",
+			[]byte(`{"invitation_uuid":"i-1","email":"ivan@example.com","company_title":"Acme","role":"engineer","expires_at":1700000000}`))
+		assertCode(t, err.Code, codes.OK)
+		if !strings.Contains(mt.sent[0].Subject, "Acme") || !strings.Contains(mt.sent[0].Body, "engineer") {
+			t.Errorf("expected company and role in email, got %q / %q", mt.sent[0].Subject, mt.sent[0].Body)
+		}
+	})
+
+	t.Run("2fa contains code", func(t *testing.T) {
+		mt := &mockTransport{}
+		svc := newTestService(t, mt)
+
+		err := svc.HandleEmail(context.Background(), "2fa.email", []byte(`{"email":"ivan@example.com","first_name":"Ivan","code":"654321"}`))
+		assertCode(t, err.Code, codes.OK)
+		if !strings.Contains(mt.sent[0].Body, "654321") {
+			t.Errorf("expected code in body, got %q", mt.sent[0].Body)
+		}
+	})
+
+	t.Run("login notification formats time", func(t *testing.T) {
+		mt := &mockTransport{}
+		svc := newTestService(t, mt)
+
+		err := svc.HandleEmail(context.Background(), "login-notification.email", []byte(fullMsg("en")))
+		assertCode(t, err.Code, codes.OK)
+		if !strings.Contains(mt.sent[0].Body, "14.11.2023 22:13 UTC") {
+			t.Errorf("expected formatted login time, got %q", mt.sent[0].Body)
+		}
+	})
+
+	t.Run("default language", func(t *testing.T) {
+		for _, language := range []string{"", "de"} {
+			mt := &mockTransport{}
+			svc := newTestService(t, mt)
+
+			err := svc.HandleEmail(context.Background(), "password-changed.email", []byte(fullMsg(language)))
+			assertCode(t, err.Code, codes.OK)
+			if mt.sent[0].Subject != "Пароль изменён" {
+				t.Errorf("language %q: expected russian subject, got %q", language, mt.sent[0].Subject)
+			}
+		}
+	})
+
+	t.Run("registration attempt without name", func(t *testing.T) {
+		mt := &mockTransport{}
+		svc := newTestService(t, mt)
+
+		err := svc.HandleEmail(context.Background(), "registration-attempt.email", []byte(`{"email":"ivan@example.com"}`))
+		assertCode(t, err.Code, codes.OK)
+		if !strings.HasPrefix(mt.sent[0].Body, "Здравствуйте!") {
+			t.Errorf("unexpected greeting: %q", mt.sent[0].Body)
+		}
+	})
+
+	t.Run("unknown queue", func(t *testing.T) {
+		svc := newTestService(t, &mockTransport{})
+		err := svc.HandleEmail(context.Background(), "unknown.email", []byte(fullMsg("ru")))
+		assertCode(t, err.Code, codes.InvalidArgument)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		svc := newTestService(t, &mockTransport{})
+		err := svc.HandleEmail(context.Background(), "verification.email", []byte(`{"email":`))
+		assertCode(t, err.Code, codes.InvalidArgument)
+	})
+
+	t.Run("invalid email", func(t *testing.T) {
+		for _, email := range []string{"", "not-an-email", "Ivan <ivan@example.com>", "ivan@example.com\r\nBcc: evil@example.com"} {
+			mt := &mockTransport{}
+			svc := newTestService(t, mt)
+
+			body := fmt.Sprintf(`{"email":%q,"first_name":"Ivan","token":"t"}`, email)
+			err := svc.HandleEmail(context.Background(), "verification.email", []byte(body))
+			assertCode(t, err.Code, codes.InvalidArgument)
+			if len(mt.sent) != 0 {
+				t.Errorf("email %q must not be sent", email)
+			}
+		}
+	})
+
+	t.Run("missing required fields", func(t *testing.T) {
+		cases := map[string]string{
+			"verification.email":       `{"email":"ivan@example.com","first_name":"Ivan"}`,
+			"recovery.email":           `{"email":"ivan@example.com","first_name":"Ivan"}`,
+			"2fa.email":                `{"email":"ivan@example.com","first_name":"Ivan"}`,
+			"login-notification.email": `{"email":"ivan@example.com","first_name":"Ivan"}`,
+			"company-invitation.email": `{"email":"ivan@example.com","company_title":"Acme"}`,
+		}
+		for queue, body := range cases {
+			svc := newTestService(t, &mockTransport{})
+			err := svc.HandleEmail(context.Background(), queue, []byte(body))
+			assertCode(t, err.Code, codes.InvalidArgument)
+		}
+	})
+
+	t.Run("rejected by server", func(t *testing.T) {
+		mt := &mockTransport{send: func(_ context.Context, _ entities.Email) error {
+			return fmt.Errorf("%w: 550 mailbox unavailable", transport.ErrRejected)
+		}}
+		svc := newTestService(t, mt)
+
+		err := svc.HandleEmail(context.Background(), "password-reset.email", []byte(fullMsg("ru")))
+		assertCode(t, err.Code, codes.InvalidArgument)
+	})
+
+	t.Run("transport unavailable", func(t *testing.T) {
+		mt := &mockTransport{send: func(_ context.Context, _ entities.Email) error {
+			return errors.New("connection refused")
+		}}
+		svc := newTestService(t, mt)
+
+		err := svc.HandleEmail(context.Background(), "password-reset.email", []byte(fullMsg("ru")))
+		assertCode(t, err.Code, codes.Unavailable)
+	})
+}
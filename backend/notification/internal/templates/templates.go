@@ -0,0 +1,94 @@
+package templates
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+	"text/template"
+	"time"
+)
+
+//go:embed ru/*.tmpl en/*.tmpl
+var templatesFS embed.FS
+
+// ErrTemplateNotFound Для типа письма нет шаблона
+var ErrTemplateNotFound = errors.New("template not found")
+
+// Renderer Шаблоны писем по языкам: <язык>/<тип письма>.tmpl.
+// Каждый шаблон определяет блоки "subject" и "body".
+type Renderer struct {
+	templates       map[string]map[string]*template.Template
+	defaultLanguage string
+}
+
+var funcs = template.FuncMap{
+	// formatTime Unix-время в читаемом виде (UTC)
+	"formatTime": func(unix int64) string {
+		return time.Unix(unix, 0).UTC().Format("02.01.2006 15:04 UTC")
+	},
+}
+
+func NewRenderer(defaultLanguage string) (*Renderer, error) {
+	r := &Renderer{
+		templates:       make(map[string]map[string]*template.Template),
+		defaultLanguage: defaultLanguage,
+	}
+
+	files, err := fs.Glob(templatesFS, "*/*.tmpl")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		language := path.Dir(file)
+		name := strings.TrimSuffix(path.Base(file), ".tmpl")
+
+		tmpl, err := template.New(name).Funcs(funcs).Option("missingkey=error").ParseFS(templatesFS, file)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", file, err)
+		}
+		if tmpl.Lookup("subject") == nil || tmpl.Lookup("body") == nil {
+			return nil, fmt.Errorf("template %s must define subject and body", file)
+		}
+
+		if r.templates[language] == nil {
+			r.templates[language] = make(map[string]*template.Template)
+		}
+		r.templates[language][name] = tmpl
+	}
+
+	if r.templates[defaultLanguage] == nil {
+		return nil, fmt.Errorf("no templates for default language %q", defaultLanguage)
+	}
+
+	return r, nil
+}
+
+// Render Рендер письма указанного типа. Если язык неизвестен, не указан или для него нет варианта письма -
+// используется вариант на языке по умолчанию
+func (r *Renderer) Render(name, language string, data any) (subject, body string, err error) {
+	tmpl, ok := r.templates[language][name]
+	if !ok {
+		tmpl, ok = r.templates[r.defaultLanguage][name]
+	}
+	if !ok {
+		return "", "", fmt.Errorf("%w: %s", ErrTemplateNotFound, name)
+	}
+
+	var buf bytes.Buffer
+	if err = tmpl.ExecuteTemplate(&buf, "subject", data); err != nil {
+		return "", "", err
+	}
+	subject = strings.TrimSpace(buf.String())
+
+	buf.Reset()
+	if err = tmpl.ExecuteTemplate(&buf, "body", data); err != nil {
+		return "", "", err
+	}
+
+	return subject, buf.String(), nil
+}
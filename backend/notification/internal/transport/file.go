@@ -0,0 +1,35 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/notification/internal/entities"
+)
+
+type fileTransport struct {
+	dir  string
+	from string
+}
+
+// NewFileTransport Запись писем в .eml файлы вместо отправки - для тестов и окружений без SMTP
+func NewFileTransport(dir, from string) (Transport, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &fileTransport{dir: dir, from: from}, nil
+}
+
+// Send Сохранение письма в <dir>/<время>-<id>.eml
+func (t *fileTransport) Send(_ context.Context, email entities.Email) error {
+	msg, err := buildMessage(t.from, email)
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s-%s.eml", time.Now().UTC().Format("20060102T150405.000000000"), randomID())
+	return os.WriteFile(filepath.Join(t.dir, name), msg, 0644)
+}
@@ -0,0 +1,91 @@
+package transport
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"time"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/notification/internal/config"
+	"github.com/unwelcome/FrameWorkTask1/backend/notification/internal/entities"
+)
+
+type smtpTransport struct {
+	cfg config.SMTPConfig
+}
+
+// NewSMTPTransport Отправка писем через SMTP сервер (в локальном окружении - mailpit)
+func NewSMTPTransport(cfg config.SMTPConfig) Transport {
+	return &smtpTransport{cfg: cfg}
+}
+
+// Send Отправка письма. На каждое письмо открывается отдельное соединение
+func (t *smtpTransport) Send(ctx context.Context, email entities.Email) error {
+	msg, err := buildMessage(t.cfg.From, email)
+	if err != nil {
+		return err
+	}
+
+	dialer := net.Dialer{Timeout: t.cfg.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(t.cfg.Host, strconv.Itoa(t.cfg.Port)))
+	if err != nil {
+		return err
+	}
+	if err = conn.SetDeadline(time.Now().Add(t.cfg.Timeout)); err != nil {
+		_ = conn.Close()
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, t.cfg.Host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if t.cfg.StartTLS {
+		if err = client.StartTLS(&tls.Config{ServerName: t.cfg.Host}); err != nil {
+			return err
+		}
+	}
+
+	if t.cfg.User != "" {
+		if err = client.Auth(smtp.PlainAuth("", t.cfg.User, t.cfg.Password, t.cfg.Host)); err != nil {
+			return err
+		}
+	}
+
+	if err = client.Mail(t.cfg.From); err != nil {
+		return classify(err)
+	}
+	if err = client.Rcpt(email.To); err != nil {
+		return classify(err)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return classify(err)
+	}
+	if _, err = w.Write(msg); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return classify(err)
+	}
+
+	return client.Quit()
+}
+
+// classify Ответы 5xx означают окончательный отказ сервера (несуществующий адрес и т.п.)
+func classify(err error) error {
+	var protoErr *textproto.Error
+	if errors.As(err, &protoErr) && protoErr.Code >= 500 {
+		return fmt.Errorf("%w: %v", ErrRejected, err)
+	}
+	return err
+}
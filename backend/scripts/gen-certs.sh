@@ -0,0 +1,82 @@
+#!/bin/bash
+set -e
+
+SCRIPT_DIR="$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)"
+CERTS_DIR="$SCRIPT_DIR/../certs"
+TEST_CERTS_DIR="$SCRIPT_DIR/../certs/test"
+
+# Сервисы, участвующие в mTLS: "CN:имя хоста в docker-compose"
+SERVICES=(
+  "gateway:gateway"
+  "auth:auth_service"
+  "company:company_service"
+  "application:application_service"
+)
+
+usage() {
+  echo "Usage: $0 [prod|test|all]"
+  echo ""
+  echo "  $0 prod  — generate production CA and service certificates to backend/certs/"
+  echo "  $0 test  — generate test CA and service certificates to backend/certs/test/"
+  echo "  $0 all   — generate both"
+  echo ""
+  echo "  prod certs are gitignored and used in docker-compose.yml"
+  echo "  test certs are committed and used in docker-compose.test.yml"
+}
+
+gen_certs() {
+  local dir=$1
+  local label=$2
+
+  mkdir -p "$dir"
+
+  if [ -f "$dir/ca.pem" ]; then
+    echo "WARNING: certificates already exist in $dir"
+    read -r -p "Overwrite? [y/N] " confirm
+    if [[ ! "$confirm" =~ ^[Yy]$ ]]; then
+      echo "Skipped $label certificates."
+      return
+    fi
+  fi
+
+  echo "Generating $label CA in $dir ..."
+  openssl ecparam -name prime256v1 -genkey -noout -out "$dir/ca-key.pem" 2>/dev/null
+  openssl req -x509 -new -key "$dir/ca-key.pem" -sha256 -days 3650 \
+    -subj "/CN=FrameWork internal CA" -out "$dir/ca.pem" 2>/dev/null
+
+  for entry in "${SERVICES[@]}"; do
+    local name=${entry%%:*}
+    local host=${entry#*:}
+
+    # CN — имя сервиса, по нему сервисы решают, кому доверять идентичность пользователя.
+    # Сертификат годится и для сервера, и для клиента: application вызывает company, company вызывает application.
+    openssl ecparam -name prime256v1 -genkey -noout -out "$dir/$name-key.pem" 2>/dev/null
+    openssl req -new -key "$dir/$name-key.pem" -subj "/CN=$name" -out "$dir/$name.csr" 2>/dev/null
+    openssl x509 -req -in "$dir/$name.csr" -CA "$dir/ca.pem" -CAkey "$dir/ca-key.pem" -CAcreateserial \
+      -sha256 -days 825 -out "$dir/$name.pem" \
+      -extfile <(printf "subjectAltName=DNS:%s,DNS:localhost\nextendedKeyUsage=serverAuth,clientAuth\nkeyUsage=digitalSignature" "$host") \
+      2>/dev/null
+    rm -f "$dir/$name.csr"
+
+    chmod 600 "$dir/$name-key.pem"
+    chmod 644 "$dir/$name.pem"
+    echo "  $name.pem — CN=$name, SAN=$host"
+  done
+
+  rm -f "$dir/ca.srl"
+  chmod 600 "$dir/ca-key.pem"
+  chmod 644 "$dir/ca.pem"
+
+  echo "Done: $label certificates generated (ca-key.pem is only needed to issue new certificates)."
+}
+
+case "${1:-all}" in
+  prod) gen_certs "$CERTS_DIR"      "production" ;;
+  test) gen_certs "$TEST_CERTS_DIR" "test" ;;
+  all)
+    gen_certs "$CERTS_DIR"      "production"
+    gen_certs "$TEST_CERTS_DIR" "test"
+    ;;
+  -h|--help) usage ;;
+  *) echo "Unknown option: $1"; usage; exit 1 ;;
+esac
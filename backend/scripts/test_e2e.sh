@@ -10,19 +10,22 @@ LOGS_DIR="$SCRIPT_DIR/../../logs/tests"
 rm -f "$LOGS_DIR"/*.log 2>/dev/null || true
 
 # Services that have e2e tests
-E2E_SERVICES=("auth" "company" "application")
+E2E_SERVICES=("auth" "company" "application" "notification")
 
 # Map service name → Go -run pattern
 get_pattern() {
   case "$1" in
     auth)
-      echo "^(TestRegister|TestLogin|TestRefreshToken|TestGetUser|TestUpdateUserBio|TestChangePassword|TestGetAllActiveSessions|TestRevokeSession|TestRevokeAllSessions|TestDeleteUser|TestRestoreAccount|TestAuthFullFlow|TestVerifyAccount|TestResendVerificationCode|TestForgotPassword|TestResetPassword|TestVerify2FA|TestUpdateUser2FA)"
+      echo "^(TestRegister|TestLogin|TestRefreshToken|TestGetUser|TestUpdateUserBio|TestChangePassword|TestGetAllActiveSessions|TestRevokeSession|TestRevokeAllSessions|TestAccessTokenRevocation|TestJWKS|TestDeleteUser|TestRestoreAccount|TestAuthFullFlow|TestVerifyAccount|TestResendVerificationCode|TestForgotPassword|TestResetPassword|TestVerify2FA|TestUpdateUser2FA|TestTOTP2FA)"
       ;;
     company)
-      echo "^(TestCreateCompany|TestGetCompany|TestGetCompaniesList|TestGetMyCompanies|TestUpdateCompanyTitle|TestUpdateCompanyStatus|TestDeleteCompany|TestCreateJoinCode|TestGetJoinCodes|TestJoinCompany|TestDeleteJoinCode|TestCompanyFullWorkflow|TestCreateDepartment|TestGetDepartment|TestGetCompanyDepartments|TestUpdateDepartmentTitle|TestDeleteDepartment|TestAddEmployeeToDepartment|TestRemoveEmployeeFromDepartment|TestDepartmentFullWorkflow|TestGetCompanyEmployee|TestGetCompanyEmployees|TestGetCompanyEmployeesSummary|TestUpdateEmployeeRole|TestRemoveCompanyEmployee|TestEmployeeFullWorkflow)"
+      echo "^(TestCreateCompany|TestGetCompany|TestGetCompaniesList|TestGetMyCompanies|TestUpdateCompanyTitle|TestUpdateCompanyStatus|TestDeleteCompany|TestCreateJoinCode|TestGetJoinCodes|TestJoinCompany|TestDeleteJoinCode|TestCompanyFullWorkflow|TestCreateDepartment|TestGetDepartment|TestGetCompanyDepartments|TestUpdateDepartmentTitle|TestDeleteDepartment|TestAddEmployeeToDepartment|TestRemoveEmployeeFromDepartment|TestDepartmentFullWorkflow|TestGetCompanyEmployee|TestGetCompanyEmployees|TestGetCompanyEmployeesSummary|TestUpdateEmployeeRole|TestRemoveCompanyEmployee|TestEmployeeFullWorkflow|TestJoinCodeMaxUses|TestCompanyInvitations|TestCompanyOwnership|TestLeaveCompany|TestEmployeeOffboarding|TestCompanyDeletion|TestCompanyAuditLog)"
       ;;
     application)
-      echo "^(TestCreateApplication|TestGetApplication|TestGetApplications|TestUpdateApplicationStatus|TestAssignApplication|TestRedirectApplication|TestRecallApplication|TestTakeApplicationToVerification|TestReleaseApplicationVerification|TestAddApplicationFixLog|TestDeleteApplication|TestGetApplicationHistory)"
+      echo "^(TestCreateApplication|TestGetApplication|TestGetApplications|TestUpdateApplicationStatus|TestAssignApplication|TestRedirectApplication|TestRecallApplication|TestTakeApplicationToVerification|TestReleaseApplicationVerification|TestAddApplicationFixLog|TestDeleteApplication|TestGetApplicationHistory|TestApplicationAttachments|TestWatchApplications|TestApplicationWorkflow|TestApplicationSLA|TestSearchApplications|TestApplicationsCursorPagination|TestApplicationAnalytics|TestApplicationsExport|TestApplicationComments|TestApplicationLocations|TestApplicationCategoriesAndCustomFields)"
+      ;;
+    notification)
+      echo "^(TestNotificationEmails)"
       ;;
     *)
       echo "^Test"
@@ -69,6 +72,7 @@ usage() {
   echo "  $0 auth         — run auth service tests only"
   echo "  $0 company      — run company service tests only"
   echo "  $0 application  — run application service tests only"
+  echo "  $0 notification — run notification service tests only"
   echo ""
   echo "Available services: ${E2E_SERVICES[*]}"
 }
@@ -20,7 +20,7 @@ run_service() {
   echo "=== $service: OK ==="
 }
 
-ALL_SERVICES=("auth" "company" "application")
+ALL_SERVICES=("auth" "company" "application" "notification")
 
 if [ -n "$1" ]; then
   run_service "$1"
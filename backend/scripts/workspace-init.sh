@@ -12,6 +12,6 @@ if [ -f go.work ]; then
 fi
 
 go work init
-go work use ./application ./auth ./company ./contracts ./e2e ./gateway ./shared
+go work use ./application ./auth ./company ./contracts ./e2e ./gateway ./notification ./shared
 
 echo "go.work created successfully"
@@ -138,6 +138,40 @@ func (c RabbitMQConfig) ConnectionString() string {
 	return fmt.Sprintf("amqp://%s:%s@%s:%d/", c.User, c.Password, c.Host, c.Port)
 }
 
+// ─── mTLS ─────────────────────────────────────────────────────────────────────
+
+// TLSConfig Сертификат сервиса для взаимной TLS аутентификации между gateway и gRPC сервисами.
+// Все сертификаты выпущены одним CA; CN сертификата — имя сервиса (gateway, auth, company, application).
+type TLSConfig struct {
+	CAPath   string
+	CertPath string
+	KeyPath  string
+}
+
+func NewTLSConfig() TLSConfig {
+	return TLSConfig{
+		CAPath:   MustGetEnv("TLS_CA_PATH"),
+		CertPath: MustGetEnv("TLS_CERT_PATH"),
+		KeyPath:  MustGetEnv("TLS_KEY_PATH"),
+	}
+}
+
+// ─── Tracing ──────────────────────────────────────────────────────────────────
+
+// TracingConfig Экспорт трасс OpenTelemetry. Пустой Endpoint — экспорт выключен.
+// SampleRatio — доля сохраняемых трасс (1 — все).
+type TracingConfig struct {
+	Endpoint    string
+	SampleRatio float64
+}
+
+func NewTracingConfig() TracingConfig {
+	return TracingConfig{
+		Endpoint:    GetEnvOrDefault("OTEL_EXPORTER_OTLP_ENDPOINT", ""),
+		SampleRatio: ParseFloatOrDefault("OTEL_TRACES_SAMPLER_ARG", 1),
+	}
+}
+
 // ─── Утилиты ──────────────────────────────────────────────────────────────────
 
 func MustGetEnv(key string) string {
@@ -173,6 +207,18 @@ func MustParseBool(key string) bool {
 	return b
 }
 
+func ParseBoolOrDefault(key string, defaultVal bool) bool {
+	v := os.Getenv(key)
+	if v == "" {
+		return defaultVal
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		panic(fmt.Sprintf("environment variable %q must be a boolean (true/false), got %q", key, v))
+	}
+	return b
+}
+
 func MustParseDuration(key string) time.Duration {
 	v := MustGetEnv(key)
 	d, err := time.ParseDuration(v)
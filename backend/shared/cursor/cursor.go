@@ -0,0 +1,46 @@
+package cursor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// Cursor Позиция keyset-пагинации: последняя строка предыдущей страницы.
+// Клиенту отдаётся в виде непрозрачной строки, заполняются только поля, нужные конкретному списку.
+type Cursor struct {
+	ID      string `json:"id,omitempty"` // UUIDv7 последней строки
+	Time    string `json:"t,omitempty"`  // значение временной колонки сортировки (если сортировка не по uuid)
+	Version int64  `json:"v,omitempty"`  // версия заявки (история изменений)
+}
+
+// Encode Кодирует позицию в строку для поля next_cursor
+func Encode(c Cursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decode Разбирает строку cursor из запроса. Пустая строка - первая страница.
+func Decode(s string) (Cursor, error) {
+	var c Cursor
+	if s == "" {
+		return c, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, fmt.Errorf("invalid cursor")
+	}
+	if err = json.Unmarshal(data, &c); err != nil {
+		return c, fmt.Errorf("invalid cursor")
+	}
+	return c, nil
+}
+
+// Parse Разбор курсора из запроса списка. Курсор заменяет offset, передавать их вместе нельзя.
+func Parse(s string, offset int64) (Cursor, error) {
+	if s != "" && offset > 0 {
+		return Cursor{}, fmt.Errorf("cursor and offset can't be used together")
+	}
+	return Decode(s)
+}
@@ -37,7 +37,7 @@ type CodeError struct {
 
 // Public создаёт CodeError с публичным сообщением, видимым пользователю.
 func Public(code codes.Code, msg string) CodeError {
-	return CodeError{Code: code, Err: fmt.Errorf(msg), Msg: msg}
+	return CodeError{Code: code, Err: fmt.Errorf("%s", msg), Msg: msg}
 }
 
 // Internal создаёт CodeError для неожиданных ошибок — детали скрыты, пользователь видит "internal error".
@@ -68,5 +68,5 @@ func (e CodeError) GRPCError() error {
 		return status.Errorf(codes.Internal, "internal error")
 	}
 
-	return status.Errorf(e.Code, msg)
+	return status.Error(e.Code, msg)
 }
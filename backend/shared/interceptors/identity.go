@@ -0,0 +1,202 @@
+package interceptors
+
+import (
+	"context"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/mtls"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/validate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// UserUUIDMetaKey is the gRPC metadata key carrying the UUID of the user on
+// whose behalf the call is made. The gateway sets it from the verified access
+// token; services forward it on their own downstream calls.
+const UserUUIDMetaKey = "x-user-uuid"
+
+// initiatorField is the request field that services read the acting user from.
+const initiatorField = "initiator_uuid"
+
+type userUUIDCtxKey struct{}
+
+// NewIdentityInterceptor returns a gRPC unary server interceptor that accepts
+// calls only from the services listed in trustedPeers and binds the caller's
+// user identity to the request:
+//
+//   - the peer name is the CN of the client certificate verified by mTLS
+//     (see mtls.ServerCredentials); unknown peers get PermissionDenied;
+//   - the user UUID is read from metadata (key: x-user-uuid) and stored in the
+//     context, available through UserUUIDFromContext;
+//   - if the request or any message nested in it (e.g. the list filters of an
+//     export request) has an initiator_uuid field, it is overwritten with the
+//     verified user UUID — whatever the caller put in the body is ignored.
+//     A request with initiator_uuid but without an identity is rejected with
+//     Unauthenticated.
+func NewIdentityInterceptor(trustedPeers ...string) grpc.UnaryServerInterceptor {
+	trusted := peerSet(trustedPeers)
+
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		ctx, err := verifyIdentity(ctx, trusted)
+		if err != nil {
+			return nil, err
+		}
+		if err = bindInitiator(ctx, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// NewIdentityStreamInterceptor is the streaming counterpart of
+// NewIdentityInterceptor. The initiator is bound to every received message.
+func NewIdentityStreamInterceptor(trustedPeers ...string) grpc.StreamServerInterceptor {
+	trusted := peerSet(trustedPeers)
+
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, err := verifyIdentity(ss.Context(), trusted)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &identityStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// NewIdentityForwardingInterceptor returns a gRPC unary client interceptor that
+// forwards the verified user identity of the incoming call to downstream
+// services, so that a service calling another one acts on behalf of the same user.
+func NewIdentityForwardingInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if userUUID, ok := UserUUIDFromContext(ctx); ok {
+			ctx = metadata.AppendToOutgoingContext(ctx, UserUUIDMetaKey, userUUID)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// UserUUIDFromContext returns the user UUID verified by the identity interceptor.
+func UserUUIDFromContext(ctx context.Context) (string, bool) {
+	userUUID, ok := ctx.Value(userUUIDCtxKey{}).(string)
+	return userUUID, ok && userUUID != ""
+}
+
+// ContextWithUserUUID stores a verified user UUID in the context.
+// Used by tests and by callers that establish the identity themselves.
+func ContextWithUserUUID(ctx context.Context, userUUID string) context.Context {
+	return context.WithValue(ctx, userUUIDCtxKey{}, userUUID)
+}
+
+// identityStream overrides the stream context and binds the initiator to
+// every message received from the client.
+type identityStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *identityStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *identityStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return bindInitiator(s.ctx, m)
+}
+
+func verifyIdentity(ctx context.Context, trusted map[string]struct{}) (context.Context, error) {
+	peerName, ok := mtls.PeerName(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "client certificate required")
+	}
+	if _, ok = trusted[peerName]; !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "peer %s is not allowed to call this service", peerName)
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	vals := md.Get(UserUUIDMetaKey)
+	if len(vals) == 0 || vals[0] == "" {
+		return ctx, nil
+	}
+	if len(vals) > 1 {
+		return nil, status.Error(codes.Unauthenticated, "ambiguous user identity")
+	}
+	if err := validate.UUID(vals[0]); err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid user identity: %s", err.Error())
+	}
+
+	return ContextWithUserUUID(ctx, vals[0]), nil
+}
+
+func bindInitiator(ctx context.Context, req any) error {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	userUUID, hasIdentity := UserUUIDFromContext(ctx)
+	if setInitiator(msg.ProtoReflect(), userUUID) && !hasIdentity {
+		return status.Error(codes.Unauthenticated, "user identity missed")
+	}
+	return nil
+}
+
+// setInitiator writes userUUID to the initiator_uuid field of m and of every
+// message nested in it, so that a nested request cannot carry its own initiator.
+// Reports whether any initiator_uuid field was found.
+func setInitiator(m protoreflect.Message, userUUID string) bool {
+	found := false
+	field := m.Descriptor().Fields().ByName(initiatorField)
+	if field != nil && field.Kind() == protoreflect.StringKind && !field.IsList() {
+		m.Set(field, protoreflect.ValueOfString(userUUID))
+		found = true
+	}
+
+	// Only populated fields can carry a spoofed initiator
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, item protoreflect.Value) bool {
+				found = setInitiator(item.Message(), userUUID) || found
+				return true
+			})
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				found = setInitiator(list.Get(i).Message(), userUUID) || found
+			}
+		case !fd.IsMap() && !fd.IsList() && fd.Message() != nil:
+			found = setInitiator(v.Message(), userUUID) || found
+		}
+		return true
+	})
+	return found
+}
+
+func peerSet(peers []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(peers))
+	for _, p := range peers {
+		set[p] = struct{}{}
+	}
+	return set
+}
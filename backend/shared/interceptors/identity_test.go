@@ -0,0 +1,118 @@
+package interceptors
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const (
+	verifiedUUID = "11111111-1111-1111-1111-111111111111"
+	spoofedUUID  = "22222222-2222-2222-2222-222222222222"
+)
+
+// exportDescriptors Запрос с инициатором во вложенном запросе, как ExportApplicationsRequest
+// (query: GetApplicationsRequest), и списком вложенных запросов
+func exportDescriptors(t *testing.T) (query, export protoreflect.MessageDescriptor) {
+	t.Helper()
+	optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+	repeated := descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	str := descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()
+	msg := descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("identity_test.proto"),
+		Package: proto.String("identity.test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Query"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: proto.String("initiator_uuid"), JsonName: proto.String("initiatorUuid"), Number: proto.Int32(1), Label: optional, Type: str},
+					{Name: proto.String("company_uuid"), JsonName: proto.String("companyUuid"), Number: proto.Int32(2), Label: optional, Type: str},
+				},
+			},
+			{
+				Name: proto.String("Export"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: proto.String("query"), JsonName: proto.String("query"), Number: proto.Int32(1), Label: optional, Type: msg, TypeName: proto.String(".identity.test.Query")},
+					{Name: proto.String("batch"), JsonName: proto.String("batch"), Number: proto.Int32(2), Label: repeated, Type: msg, TypeName: proto.String(".identity.test.Query")},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("failed to build descriptors: %v", err)
+	}
+	return file.Messages().ByName("Query"), file.Messages().ByName("Export")
+}
+
+func TestBindInitiator(t *testing.T) {
+	queryDesc, exportDesc := exportDescriptors(t)
+
+	newQuery := func(initiator string) *dynamicpb.Message {
+		query := dynamicpb.NewMessage(queryDesc)
+		query.Set(queryDesc.Fields().ByName("initiator_uuid"), protoreflect.ValueOfString(initiator))
+		query.Set(queryDesc.Fields().ByName("company_uuid"), protoreflect.ValueOfString("company"))
+		return query
+	}
+	initiator := func(m protoreflect.Message) string {
+		return m.Get(queryDesc.Fields().ByName("initiator_uuid")).String()
+	}
+
+	t.Run("top level initiator is replaced", func(t *testing.T) {
+		query := newQuery(spoofedUUID)
+		if err := bindInitiator(ContextWithUserUUID(context.Background(), verifiedUUID), query); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if initiator(query) != verifiedUUID {
+			t.Errorf("expected initiator %s, got %s", verifiedUUID, initiator(query))
+		}
+	})
+
+	t.Run("spoofed nested initiator is replaced", func(t *testing.T) {
+		export := dynamicpb.NewMessage(exportDesc)
+		export.Set(exportDesc.Fields().ByName("query"), protoreflect.ValueOfMessage(newQuery(spoofedUUID)))
+		batch := export.Mutable(exportDesc.Fields().ByName("batch")).List()
+		batch.Append(protoreflect.ValueOfMessage(newQuery(spoofedUUID)))
+
+		if err := bindInitiator(ContextWithUserUUID(context.Background(), verifiedUUID), export); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		query := export.Get(exportDesc.Fields().ByName("query")).Message()
+		if initiator(query) != verifiedUUID {
+			t.Errorf("expected nested initiator %s, got %s", verifiedUUID, initiator(query))
+		}
+		if got := initiator(batch.Get(0).Message()); got != verifiedUUID {
+			t.Errorf("expected initiator in list %s, got %s", verifiedUUID, got)
+		}
+		if query.Get(queryDesc.Fields().ByName("company_uuid")).String() != "company" {
+			t.Error("other fields must stay unchanged")
+		}
+	})
+
+	t.Run("nested initiator without identity", func(t *testing.T) {
+		export := dynamicpb.NewMessage(exportDesc)
+		export.Set(exportDesc.Fields().ByName("query"), protoreflect.ValueOfMessage(newQuery(spoofedUUID)))
+
+		err := bindInitiator(context.Background(), export)
+		if status.Code(err) != codes.Unauthenticated {
+			t.Errorf("expected Unauthenticated, got %v", err)
+		}
+	})
+
+	t.Run("request without initiator", func(t *testing.T) {
+		export := dynamicpb.NewMessage(exportDesc)
+		if err := bindInitiator(context.Background(), export); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
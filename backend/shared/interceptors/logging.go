@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/rs/zerolog"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/tracing"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -54,6 +55,7 @@ func NewLoggingInterceptor(logger zerolog.Logger) grpc.UnaryServerInterceptor {
 		e := logger.WithLevel(grpcCodeToZerologLevel(code)).
 			Time("time", time.Now()).
 			Str("id", operationID).
+			Str("trace_id", tracing.TraceID(ctx)).
 			Str("method", method).
 			Int64("duration", duration)
 
@@ -0,0 +1,281 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+)
+
+// Жизненный цикл сервиса: запуск серверов и фоновых воркеров, probes и graceful shutdown.
+//
+// По SIGINT / SIGTERM (или при падении одного из серверов) остановка идёт строго по порядку,
+// всё вместе укладывается в shutdownTimeout:
+//  1. /readyz начинает отвечать 503 — балансировщик перестаёт направлять новые запросы;
+//  2. серверы перестают принимать соединения и дожидаются уже начатых запросов
+//     (gRPC GracefulStop, fiber Shutdown), по истечении времени запросы обрываются;
+//  3. отменяется контекст воркеров, Shutdown ждёт их завершения;
+//  4. закрываются ресурсы в порядке, обратном регистрации: AMQP, Redis, Postgres,
+//     экспорт трасс — то, что открыто первым, закрывается последним.
+
+const probeTimeout = 2 * time.Second
+
+// Lifecycle Управляет запуском и остановкой компонентов сервиса
+type Lifecycle struct {
+	shutdownTimeout time.Duration
+
+	ctx    context.Context // отменяется сигналом остановки
+	stop   context.CancelFunc
+	failed chan error // ошибка сервера, из-за которой сервис останавливается
+
+	workersCtx    context.Context
+	cancelWorkers context.CancelFunc
+	workers       sync.WaitGroup
+
+	mu      sync.Mutex
+	servers []component
+	closers []component
+	checks  []component
+
+	ready atomic.Bool
+}
+
+type component struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+func New(shutdownTimeout time.Duration) *Lifecycle {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	workersCtx, cancelWorkers := context.WithCancel(context.Background())
+
+	return &Lifecycle{
+		shutdownTimeout: shutdownTimeout,
+		ctx:             ctx,
+		stop:            stop,
+		failed:          make(chan error, 1),
+		workersCtx:      workersCtx,
+		cancelWorkers:   cancelWorkers,
+	}
+}
+
+// Go Запускает фоновый воркер. Контекст воркера отменяется после остановки серверов -
+// воркер должен завершиться по ctx.Done(), Shutdown дожидается его до закрытия ресурсов.
+func (l *Lifecycle) Go(name string, worker func(ctx context.Context)) {
+	l.workers.Add(1)
+	go func() {
+		defer l.workers.Done()
+		worker(l.workersCtx)
+		log.Debug().Str("worker", name).Msg("worker stopped")
+	}()
+}
+
+// Serve Запускает сервер в фоне. serve блокируется до остановки сервера; shutdown вызывается
+// при остановке сервиса и должен дождаться завершения начатых запросов, пока не истёк ctx.
+// Ошибка serve вне остановки сервиса останавливает весь сервис.
+func (l *Lifecycle) Serve(name string, serve func() error, shutdown func(ctx context.Context) error) {
+	l.mu.Lock()
+	l.servers = append(l.servers, component{name: name, fn: shutdown})
+	l.mu.Unlock()
+
+	go func() {
+		err := serve()
+		if l.ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			err = errors.New("stopped unexpectedly")
+		}
+		select {
+		case l.failed <- fmt.Errorf("%s: %w", name, err):
+		default:
+		}
+	}()
+}
+
+// OnClose Регистрирует закрытие ресурса (соединения с БД, Redis, брокером). Ресурсы закрываются
+// после остановки серверов и воркеров, в порядке, обратном регистрации.
+func (l *Lifecycle) OnClose(name string, closeFn func(ctx context.Context) error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.closers = append(l.closers, component{name: name, fn: closeFn})
+}
+
+// ReadinessCheck Добавляет проверку зависимости для /readyz
+func (l *Lifecycle) ReadinessCheck(name string, check func(ctx context.Context) error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.checks = append(l.checks, component{name: name, fn: check})
+}
+
+// StartProbeServer Запускает HTTP сервер на port с /metrics, /livez и /readyz.
+// /livez отвечает 200, пока процесс жив; /readyz — 200 после Ready, если все проверки зависимостей прошли.
+// Сервер закрывается последним, чтобы метрики остановки успели собраться.
+func (l *Lifecycle) StartProbeServer(port int) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.HandleFunc("/livez", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok"))
+	})
+	mux.HandleFunc("/readyz", l.handleReadyz)
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		log.Info().Int("port", port).Msg("probe server started")
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal().Err(err).Msg("failed to serve probe server")
+		}
+	}()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.closers = append([]component{{name: "probe server", fn: server.Shutdown}}, l.closers...)
+}
+
+// Ready Отмечает сервис готовым принимать запросы
+func (l *Lifecycle) Ready() {
+	l.ready.Store(true)
+}
+
+// Wait Блокируется до сигнала остановки или падения сервера, затем останавливает сервис.
+// Возвращает ошибку упавшего сервера или ошибку остановки.
+func (l *Lifecycle) Wait() error {
+	var cause error
+	select {
+	case <-l.ctx.Done():
+		log.Info().Msg("shutdown signal received")
+	case cause = <-l.failed:
+		log.Error().Err(cause).Msg("server failed, shutting down")
+	}
+
+	if err := l.Shutdown(); err != nil && cause == nil {
+		cause = err
+	}
+	return cause
+}
+
+// Shutdown Останавливает сервис в порядке, описанном в начале файла
+func (l *Lifecycle) Shutdown() error {
+	l.ready.Store(false)
+	l.stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), l.shutdownTimeout)
+	defer cancel()
+
+	l.mu.Lock()
+	servers := append([]component(nil), l.servers...)
+	closers := append([]component(nil), l.closers...)
+	l.mu.Unlock()
+
+	var errs []error
+
+	// Серверы останавливаются параллельно: каждый дожидается своих запросов
+	var wg sync.WaitGroup
+	var errMu sync.Mutex
+	for _, s := range servers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := s.fn(ctx); err != nil {
+				errMu.Lock()
+				errs = append(errs, fmt.Errorf("stop %s: %w", s.name, err))
+				errMu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	l.cancelWorkers()
+	workersDone := make(chan struct{})
+	go func() {
+		l.workers.Wait()
+		close(workersDone)
+	}()
+	select {
+	case <-workersDone:
+	case <-ctx.Done():
+		errs = append(errs, errors.New("background workers did not stop in time"))
+	}
+
+	for i := len(closers) - 1; i >= 0; i-- {
+		if err := closers[i].fn(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("close %s: %w", closers[i].name, err))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		log.Error().Err(err).Msg("shutdown finished with errors")
+		return err
+	}
+	log.Info().Msg("shutdown finished")
+	return nil
+}
+
+// GRPCShutdown Остановка gRPC сервера для Serve: GracefulStop дожидается начатых RPC,
+// по истечении ctx оставшиеся (в том числе долгие стримы) обрываются через Stop
+func GRPCShutdown(server *grpc.Server) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		done := make(chan struct{})
+		go func() {
+			server.GracefulStop()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			server.Stop()
+			<-done
+			return errors.New("in-flight rpcs were cancelled")
+		}
+	}
+}
+
+// Closer Адаптер для ресурсов с методом Close() error
+func Closer(closeFn func() error) func(ctx context.Context) error {
+	return func(context.Context) error {
+		return closeFn()
+	}
+}
+
+// ─── Вспомогательные функции ──────────────────────────────────────────────────
+
+func (l *Lifecycle) handleReadyz(w http.ResponseWriter, r *http.Request) {
+	if !l.ready.Load() {
+		http.Error(w, "not ready", http.StatusServiceUnavailable)
+		return
+	}
+
+	l.mu.Lock()
+	checks := append([]component(nil), l.checks...)
+	l.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(r.Context(), probeTimeout)
+	defer cancel()
+
+	for _, check := range checks {
+		if err := check.fn(ctx); err != nil {
+			http.Error(w, fmt.Sprintf("%s: %s", check.name, err.Error()), http.StatusServiceUnavailable)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("ok"))
+}
@@ -0,0 +1,57 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/rs/zerolog/log"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/config"
+)
+
+const (
+	maxRetries = 10
+	retryDelay = 3 * time.Second
+)
+
+// Connect подключается к S3-совместимому хранилищу и создаёт бакет, если его ещё нет.
+func Connect(cfg config.MinioConfig) *minio.Client {
+	client, err := minio.New(fmt.Sprintf("%s:%d", cfg.Host, cfg.Port), &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.User, cfg.Password, ""),
+		Secure: cfg.SSL,
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create minio client")
+	}
+
+	var exists bool
+	for attempt := 1; attempt <= maxRetries; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		exists, err = client.BucketExists(ctx, cfg.Bucket)
+		cancel()
+		if err == nil {
+			break
+		}
+		log.Warn().
+			Err(err).
+			Int("attempt", attempt).
+			Int("max", maxRetries).
+			Msgf("failed to ping minio, retrying in %s...", retryDelay)
+		time.Sleep(retryDelay)
+	}
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to ping minio after all retries")
+	}
+
+	if !exists {
+		if err = client.MakeBucket(context.Background(), cfg.Bucket, minio.MakeBucketOptions{}); err != nil {
+			log.Fatal().Err(err).Str("bucket", cfg.Bucket).Msg("failed to create minio bucket")
+		}
+		log.Info().Str("bucket", cfg.Bucket).Msg("minio bucket created")
+	}
+
+	return client
+}
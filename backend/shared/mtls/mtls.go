@@ -0,0 +1,83 @@
+package mtls
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/config"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// Взаимная TLS аутентификация gRPC соединений.
+// Сервер требует клиентский сертификат, подписанный общим CA, клиент проверяет сертификат сервера
+// по имени хоста. Имя сервиса на другом конце соединения — CN его сертификата (см. PeerName).
+
+// ServerCredentials Credentials gRPC сервера: соединения без клиентского сертификата нашего CA отклоняются
+func ServerCredentials(cfg config.TLSConfig) (credentials.TransportCredentials, error) {
+	cert, pool, err := load(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS13,
+	}), nil
+}
+
+// ClientCredentials Credentials gRPC клиента. serverName — имя хоста сервиса, указанное в SAN его сертификата.
+func ClientCredentials(cfg config.TLSConfig, serverName string) (credentials.TransportCredentials, error) {
+	cert, pool, err := load(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		ServerName:   serverName,
+		MinVersion:   tls.VersionTLS13,
+	}), nil
+}
+
+// PeerName Имя сервиса, вызвавшего метод, — CN проверенного клиентского сертификата.
+// false, если соединение без TLS или сертификат не прошёл проверку.
+func PeerName(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+
+	name := info.State.VerifiedChains[0][0].Subject.CommonName
+	return name, name != ""
+}
+
+// ─── Вспомогательные функции ──────────────────────────────────────────────────
+
+func load(cfg config.TLSConfig) (tls.Certificate, *x509.CertPool, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.CertPath, cfg.KeyPath)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("load certificate: %w", err)
+	}
+
+	caPEM, err := os.ReadFile(cfg.CAPath)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("read ca: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return tls.Certificate{}, nil, fmt.Errorf("ca %s contains no certificates", cfg.CAPath)
+	}
+
+	return cert, pool, nil
+}
@@ -4,8 +4,10 @@ import (
 	"database/sql"
 	"time"
 
+	"github.com/XSAM/otelsql"
 	_ "github.com/lib/pq"
 	"github.com/rs/zerolog/log"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
 )
 
 const (
@@ -14,7 +16,15 @@ const (
 )
 
 func Connect(connectString string) *sql.DB {
-	db, err := sql.Open("postgres", connectString)
+	// Запросы с контекстом (QueryContext, ExecContext...) попадают в трассу вызвавшего их метода
+	db, err := otelsql.Open("postgres", connectString,
+		otelsql.WithAttributes(semconv.DBSystemNamePostgreSQL),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			OmitConnResetSession: true,
+			OmitConnPrepare:      true,
+			OmitRows:             true,
+		}),
+	)
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to open postgres connection")
 	}
@@ -12,7 +12,9 @@ const (
 	retryDelay = 3 * time.Second
 )
 
-func Connect(connectString string) *amqp.Channel {
+// Connect Подключение к rabbitMQ с ретраями, при неудаче - log.Fatal.
+// Соединение возвращается вместе с каналом, чтобы закрыть его при остановке сервиса.
+func Connect(connectString string) (*amqp.Connection, *amqp.Channel) {
 	var (
 		conn *amqp.Connection
 		err  error
@@ -39,5 +41,23 @@ func Connect(connectString string) *amqp.Channel {
 		log.Fatal().Err(err).Msg("failed to open a channel to rabbitMQ")
 	}
 
-	return ch
+	return conn, ch
+}
+
+// Dial Однократное подключение к rabbitMQ без ретраев и log.Fatal.
+// Используется долгоживущими публикаторами и потребителями для переподключения после потери соединения.
+// Закрытие соединения - ответственность вызывающего.
+func Dial(connectString string) (*amqp.Connection, *amqp.Channel, error) {
+	conn, err := amqp.Dial(connectString)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ch, err := conn.Channel()
+	if err != nil {
+		_ = conn.Close()
+		return nil, nil, err
+	}
+
+	return conn, ch, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/redis/go-redis/extra/redisotel/v9"
 	"github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog/log"
 )
@@ -15,6 +16,10 @@ const (
 
 func Connect(options *redis.Options) *redis.Client {
 	rdb := redis.NewClient(options)
+	// Команды Redis попадают в трассу вызвавшего их метода
+	if err := redisotel.InstrumentTracing(rdb); err != nil {
+		log.Fatal().Err(err).Msg("failed to instrument redis tracing")
+	}
 
 	var err error
 	for attempt := 1; attempt <= maxRetries; attempt++ {
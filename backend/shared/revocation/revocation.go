@@ -0,0 +1,60 @@
+package revocation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Отзыв access токенов до истечения их срока жизни.
+// Auth сервис пишет ключи в общий Redis при отзыве сессий, gateway проверяет их на каждом запросе.
+// Ключи живут столько же, сколько access токен: после этого отозванный токен отклоняется по exp.
+//
+//   - {prefix}:revoked:session:{session_uuid} — сессия отозвана (выход, отзыв сессии, повторное использование refresh токена);
+//   - {prefix}:revoked:user:{user_uuid}       — эпоха пользователя, unix-время отзыва всех сессий:
+//     токены, выпущенные раньше, недействительны (выход со всех устройств, смена пароля, удаление аккаунта).
+
+// SessionKey Ключ отозванной сессии
+func SessionKey(prefix, sessionUUID string) string {
+	return fmt.Sprintf("%s:revoked:session:%s", prefix, sessionUUID)
+}
+
+// UserKey Ключ эпохи пользователя
+func UserKey(prefix, userUUID string) string {
+	return fmt.Sprintf("%s:revoked:user:%s", prefix, userUUID)
+}
+
+// IsRevoked Проверяет access токен одним запросом к Redis.
+// sessionUUID пустой у токенов, выпущенных до привязки к сессии, - для них проверяется только эпоха.
+// issuedAt — unix-время выпуска токена (claim iat).
+func IsRevoked(ctx context.Context, client *redis.Client, prefix, sessionUUID, userUUID string, issuedAt int64) (bool, error) {
+	keys := []string{UserKey(prefix, userUUID)}
+	if sessionUUID != "" {
+		keys = append(keys, SessionKey(prefix, sessionUUID))
+	}
+
+	values, err := client.MGet(ctx, keys...).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return false, err
+	}
+
+	// Сессия отозвана
+	if len(values) > 1 && values[1] != nil {
+		return true, nil
+	}
+
+	// Токен выпущен до отзыва всех сессий. Сравнение строгое: эпоха хранится с точностью до секунды,
+	// и токен нового входа сразу после отзыва не должен отклоняться
+	if raw, ok := values[0].(string); ok {
+		epoch, parseErr := strconv.ParseInt(raw, 10, 64)
+		if parseErr != nil {
+			return false, fmt.Errorf("parse revocation epoch: %w", parseErr)
+		}
+		return issuedAt < epoch, nil
+	}
+
+	return false, nil
+}
@@ -0,0 +1,157 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/unwelcome/FrameWorkTask1/backend/shared/config"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+// Распределённая трассировка OpenTelemetry.
+// Контекст трассы передаётся между сервисами по W3C Trace Context (заголовок/метаданные traceparent):
+// gateway → gRPC сервисы → RabbitMQ сообщения. Спаны экспортируются по OTLP в коллектор
+// из docker-compose.observability.yml. Без OTEL_EXPORTER_OTLP_ENDPOINT трассировка выключена:
+// контекст по-прежнему пробрасывается, но спаны никуда не отправляются.
+
+const tracerName = "github.com/unwelcome/FrameWorkTask1/backend/shared/tracing"
+
+// Setup Настраивает глобальные TracerProvider и propagator. Возвращает функцию для сброса
+// накопленных спанов при остановке сервиса.
+func Setup(ctx context.Context, serviceName string, cfg config.TracingConfig) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if cfg.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracegrpc.New(ctx,
+		otlptracegrpc.WithEndpoint(cfg.Endpoint),
+		otlptracegrpc.WithInsecure(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create otlp exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("create resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		// Решение о семплировании принимает gateway, сервисы следуют родительскому спану
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// ServerOption Спаны входящих gRPC вызовов с контекстом трассы из метаданных
+func ServerOption() grpc.ServerOption {
+	return grpc.StatsHandler(otelgrpc.NewServerHandler())
+}
+
+// DialOption Спаны исходящих gRPC вызовов, контекст трассы передаётся в метаданных
+func DialOption() grpc.DialOption {
+	return grpc.WithStatsHandler(otelgrpc.NewClientHandler())
+}
+
+// TraceID Идентификатор трассы из контекста для связи логов с трассами. Пустая строка, если трассы нет.
+func TraceID(ctx context.Context) string {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !spanCtx.HasTraceID() {
+		return ""
+	}
+	return spanCtx.TraceID().String()
+}
+
+// StartPublish Начинает спан публикации в RabbitMQ и записывает контекст трассы в заголовки сообщения,
+// чтобы потребитель продолжил ту же трассу (см. StartConsume). Спан завершает вызывающий через EndSpan.
+func StartPublish(ctx context.Context, exchange, routingKey string, msg *amqp.Publishing) (context.Context, trace.Span) {
+	destination := exchange
+	if destination == "" {
+		destination = routingKey
+	}
+
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "publish "+destination,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemRabbitMQ,
+			semconv.MessagingOperationTypeSend,
+			semconv.MessagingDestinationName(destination),
+			semconv.MessagingRabbitMQDestinationRoutingKey(routingKey),
+		),
+	)
+
+	if msg.Headers == nil {
+		msg.Headers = amqp.Table{}
+	}
+	otel.GetTextMapPropagator().Inject(ctx, headersCarrier(msg.Headers))
+
+	return ctx, span
+}
+
+// StartConsume Начинает спан обработки сообщения RabbitMQ как продолжение трассы публикатора
+func StartConsume(ctx context.Context, queue string, msg amqp.Delivery) (context.Context, trace.Span) {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, headersCarrier(msg.Headers))
+
+	return otel.Tracer(tracerName).Start(ctx, "process "+queue,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingSystemRabbitMQ,
+			semconv.MessagingOperationTypeProcess,
+			semconv.MessagingDestinationName(queue),
+			semconv.MessagingMessageID(msg.MessageId),
+		),
+	)
+}
+
+// EndSpan Завершает спан, отмечая его ошибкой при err != nil
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// headersCarrier Заголовки AMQP сообщения как носитель контекста трассы
+type headersCarrier amqp.Table
+
+func (c headersCarrier) Get(key string) string {
+	if v, ok := c[key].(string); ok {
+		return v
+	}
+	return ""
+}
+
+func (c headersCarrier) Set(key, value string) {
+	c[key] = value
+}
+
+func (c headersCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
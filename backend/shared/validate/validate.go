@@ -2,7 +2,12 @@ package validate
 
 import (
 	"fmt"
+	"math"
 	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 )
 
 const (
@@ -16,8 +21,52 @@ const (
 	CompanyTitleMaxLen           = 255
 	DepartmentTitleMaxLen        = 255
 	ApplicationTitleMaxLen       = 255
+	AttachmentFileNameMaxLen     = 255
+	AttachmentMaxSize            = 20 << 20 // 20 MiB
+	SLAHoursMax                  = 24 * 365
+	ApplicationSearchMaxLen      = 200
+	CommentMaxLen                = 4000
+	CommentMentionsMax           = 20
+	LocationTitleMaxLen          = 255
+	CategoryTitleMaxLen          = 255
+	CustomFieldTitleMaxLen       = 255
+	CustomFieldOptionsMax        = 50
+	CustomFieldOptionMaxLen      = 100
+	CustomFieldValueMaxLen       = 1000
+	CustomFieldsMax              = 30
 )
 
+// TwoFAMethods — способы второго фактора, которые пользователь может выбрать основным.
+var TwoFAMethods = []string{"email", "totp"}
+
+// ApplicationPriorities — приоритеты заявки (значения enum application_priority).
+var ApplicationPriorities = []string{"low", "normal", "high", "critical"}
+
+// ApplicationSeverities — степень серьёзности дефекта от наименьшей к наибольшей (значения enum application_severity).
+var ApplicationSeverities = []string{"minor", "moderate", "major", "critical"}
+
+// CustomFieldTypes — типы дополнительных полей заявки, которые задаёт chief компании.
+var CustomFieldTypes = []string{"text", "number", "enum", "date"}
+
+// ApplicationSortFields — поля сортировки списка заявок. relevance доступна только вместе с поиском.
+var ApplicationSortFields = []string{"created_at", "updated_at", "closed_at", "due_at", "priority", "revision_count", "relevance"}
+
+// LocationKinds — уровни иерархии мест дефектов от верхнего к нижнему: объект, здание, этаж, зона.
+var LocationKinds = []string{"site", "building", "floor", "zone"}
+
+// AttachmentContentTypes — допустимые типы вложений: фотографии дефектов, PDF и чертежи.
+var AttachmentContentTypes = []string{
+	"image/jpeg",
+	"image/png",
+	"image/webp",
+	"image/heic",
+	"application/pdf",
+	"image/vnd.dwg",
+	"image/vnd.dxf",
+	"application/acad",
+	"application/dxf",
+}
+
 var (
 	reUUID                 = regexp.MustCompile(`^[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}$`)
 	reEmail                = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
@@ -29,8 +78,10 @@ var (
 	reUserVerificationCode = regexp.MustCompile(`^[0-9]{6}$`)
 	reUserRecoveryCode     = regexp.MustCompile(`^[0-9]{6}$`)
 	reUser2FACode          = regexp.MustCompile(`^[0-9]{6}$`)
+	reUser2FARecoveryCode  = regexp.MustCompile(`^[a-z0-9]{5}-[a-z0-9]{5}$`)
 	reTitle                = regexp.MustCompile(`^[a-zA-Zа-яА-ЯёЁ0-9\s\-_&.,№#()'"°+]+$`)
 	reName                 = regexp.MustCompile(`^[a-zA-Zа-яА-ЯёЁ]+(['\-][a-zA-Zа-яА-ЯёЁ]+)*$`)
+	reCustomFieldKey       = regexp.MustCompile(`^[a-z][a-z0-9_]{0,62}$`)
 )
 
 func UUID(uuid string) error {
@@ -174,6 +225,23 @@ func User2FACCode(code string) error {
 	return nil
 }
 
+func User2FARecoveryCode(code string) error {
+	if code == "" {
+		return fmt.Errorf("recovery code missed")
+	}
+	if !reUser2FARecoveryCode.MatchString(code) {
+		return fmt.Errorf("invalid recovery code")
+	}
+	return nil
+}
+
+func TwoFAMethod(method string) error {
+	if !slices.Contains(TwoFAMethods, method) {
+		return fmt.Errorf("2FA method must be one of %v", TwoFAMethods)
+	}
+	return nil
+}
+
 func CompanyTitle(title string) error {
 	return checkTitle(title, "company", CompanyTitleMaxLen)
 }
@@ -182,6 +250,34 @@ func DepartmentTitle(title string) error {
 	return checkTitle(title, "department", DepartmentTitleMaxLen)
 }
 
+func LocationTitle(title string) error {
+	return checkTitle(title, "location", LocationTitleMaxLen)
+}
+
+func LocationKind(kind string) error {
+	if !slices.Contains(LocationKinds, kind) {
+		return fmt.Errorf("location kind must be one of %s", strings.Join(LocationKinds, ", "))
+	}
+	return nil
+}
+
+// Coordinates — координаты места в WGS 84. Задаются обе или ни одной.
+func Coordinates(latitude, longitude *float64) error {
+	if (latitude == nil) != (longitude == nil) {
+		return fmt.Errorf("latitude and longitude must be set together")
+	}
+	if latitude == nil {
+		return nil
+	}
+	if !(*latitude >= -90 && *latitude <= 90) { // отрицание отсекает и NaN
+		return fmt.Errorf("latitude must be between -90 and 90")
+	}
+	if !(*longitude >= -180 && *longitude <= 180) {
+		return fmt.Errorf("longitude must be between -180 and 180")
+	}
+	return nil
+}
+
 func ApplicationTitle(title string) error {
 	return checkTitle(title, "application", ApplicationTitleMaxLen)
 }
@@ -196,6 +292,225 @@ func ApplicationDescription(description string) error {
 	return nil
 }
 
+func ApplicationPriority(priority string) error {
+	if priority == "" {
+		return fmt.Errorf("priority missed")
+	}
+	for _, p := range ApplicationPriorities {
+		if p == priority {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown priority")
+}
+
+// ApplicationSeverity — степень серьёзности дефекта из ApplicationSeverities. Пустая строка не проходит проверку:
+// необязательное поле вызывающий проверяет только если оно указано.
+func ApplicationSeverity(severity string) error {
+	if !slices.Contains(ApplicationSeverities, severity) {
+		return fmt.Errorf("severity must be one of %s", strings.Join(ApplicationSeverities, ", "))
+	}
+	return nil
+}
+
+func CategoryTitle(title string) error {
+	return checkTitle(title, "category", CategoryTitleMaxLen)
+}
+
+// CustomFieldKey — ключ дополнительного поля: латиница в нижнем регистре, цифры и _, начинается с буквы.
+func CustomFieldKey(key string) error {
+	if key == "" {
+		return fmt.Errorf("custom field key missed")
+	}
+	if !reCustomFieldKey.MatchString(key) {
+		return fmt.Errorf("custom field key must match %s", reCustomFieldKey.String())
+	}
+	return nil
+}
+
+func CustomFieldTitle(title string) error {
+	return checkTitle(title, "custom field", CustomFieldTitleMaxLen)
+}
+
+func CustomFieldType(fieldType string) error {
+	if !slices.Contains(CustomFieldTypes, fieldType) {
+		return fmt.Errorf("custom field type must be one of %s", strings.Join(CustomFieldTypes, ", "))
+	}
+	return nil
+}
+
+// CustomFieldOptions — варианты значения поля. Обязательны для enum и запрещены для остальных типов.
+func CustomFieldOptions(fieldType string, options []string) error {
+	if fieldType != "enum" {
+		if len(options) > 0 {
+			return fmt.Errorf("options are allowed only for enum fields")
+		}
+		return nil
+	}
+	if len(options) == 0 {
+		return fmt.Errorf("enum field requires options")
+	}
+	if len(options) > CustomFieldOptionsMax {
+		return fmt.Errorf("no more than %d options allowed", CustomFieldOptionsMax)
+	}
+	for i, option := range options {
+		if strings.TrimSpace(option) == "" {
+			return fmt.Errorf("option must not be empty")
+		}
+		if len([]rune(option)) > CustomFieldOptionMaxLen {
+			return fmt.Errorf("option must be %d characters or less", CustomFieldOptionMaxLen)
+		}
+		if slices.Contains(options[:i], option) {
+			return fmt.Errorf("duplicate option")
+		}
+	}
+	return nil
+}
+
+// CustomFieldValue — значение дополнительного поля заявки в соответствии с его типом:
+// number - десятичное число, date - YYYY-MM-DD, enum - один из вариантов.
+func CustomFieldValue(fieldType string, options []string, value string) error {
+	if strings.TrimSpace(value) == "" {
+		return fmt.Errorf("value missed")
+	}
+	switch fieldType {
+	case "text":
+		if len([]rune(value)) > CustomFieldValueMaxLen {
+			return fmt.Errorf("value must be %d characters or less", CustomFieldValueMaxLen)
+		}
+	case "number":
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsInf(n, 0) || math.IsNaN(n) {
+			return fmt.Errorf("value must be a number")
+		}
+	case "date":
+		if _, err := time.Parse(time.DateOnly, value); err != nil {
+			return fmt.Errorf("value must be in YYYY-MM-DD format")
+		}
+	case "enum":
+		if !slices.Contains(options, value) {
+			return fmt.Errorf("value must be one of %s", strings.Join(options, ", "))
+		}
+	default:
+		return fmt.Errorf("unknown custom field type")
+	}
+	return nil
+}
+
+// ApplicationDueAt — срок устранения в формате RFC3339, не раньше текущего момента.
+func ApplicationDueAt(dueAt string) error {
+	t, err := time.Parse(time.RFC3339, dueAt)
+	if err != nil {
+		return fmt.Errorf("due_at must be in RFC3339 format")
+	}
+	if !t.After(time.Now()) {
+		return fmt.Errorf("due_at must be in the future")
+	}
+	return nil
+}
+
+// ApplicationSearch — строка полнотекстового поиска по заявкам.
+func ApplicationSearch(search string) error {
+	if len([]rune(search)) > ApplicationSearchMaxLen {
+		return fmt.Errorf("search must be %d characters or less", ApplicationSearchMaxLen)
+	}
+	return nil
+}
+
+func ApplicationSortBy(sortBy string) error {
+	for _, f := range ApplicationSortFields {
+		if f == sortBy {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown sort field")
+}
+
+func SortOrder(order string) error {
+	if order != "asc" && order != "desc" {
+		return fmt.Errorf("sort order must be asc or desc")
+	}
+	return nil
+}
+
+// DateFilter — граница фильтра по дате в формате RFC3339 или YYYY-MM-DD.
+func DateFilter(value, title string) error {
+	if _, err := time.Parse(time.RFC3339, value); err == nil {
+		return nil
+	}
+	if _, err := time.Parse(time.DateOnly, value); err == nil {
+		return nil
+	}
+	return fmt.Errorf("%s must be in RFC3339 or YYYY-MM-DD format", title)
+}
+
+// SLAHours — срок SLA в часах, 0 означает отсутствие ограничения.
+func SLAHours(hours int, title string) error {
+	return Number(hours, IntPtr(0), IntPtr(SLAHoursMax), title)
+}
+
+// ApplicationComment — текст комментария к заявке.
+func ApplicationComment(text string) error {
+	if strings.TrimSpace(text) == "" {
+		return fmt.Errorf("comment text missed")
+	}
+	if len([]rune(text)) > CommentMaxLen {
+		return fmt.Errorf("comment must be %d characters or less", CommentMaxLen)
+	}
+	return nil
+}
+
+// CommentMentions — упомянутые в комментарии пользователи: UUID без повторов.
+func CommentMentions(uuids []string) error {
+	if len(uuids) > CommentMentionsMax {
+		return fmt.Errorf("no more than %d mentions allowed", CommentMentionsMax)
+	}
+	for i, id := range uuids {
+		if err := UUID(id); err != nil {
+			return fmt.Errorf("invalid mentioned uuid")
+		}
+		if slices.Contains(uuids[:i], id) {
+			return fmt.Errorf("duplicate mention")
+		}
+	}
+	return nil
+}
+
+func AttachmentFileName(name string) error {
+	if name == "" {
+		return fmt.Errorf("file name missed")
+	}
+	if len([]rune(name)) > AttachmentFileNameMaxLen {
+		return fmt.Errorf("file name must be %d characters or less", AttachmentFileNameMaxLen)
+	}
+	if strings.ContainsAny(name, "/\\\x00") {
+		return fmt.Errorf("file name contains incorrect characters")
+	}
+	return nil
+}
+
+func AttachmentContentType(contentType string) error {
+	if contentType == "" {
+		return fmt.Errorf("content type missed")
+	}
+	for _, t := range AttachmentContentTypes {
+		if t == contentType {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported content type")
+}
+
+func AttachmentSize(size int64) error {
+	if size <= 0 {
+		return fmt.Errorf("file is empty")
+	}
+	if size > AttachmentMaxSize {
+		return fmt.Errorf("file must be %d bytes or less", AttachmentMaxSize)
+	}
+	return nil
+}
+
 func Number(num int, min, max *int, title string) error {
 	if title == "" {
 		title = "digit"